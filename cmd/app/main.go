@@ -8,11 +8,11 @@ import (
 	"linked-clone/internal/config/server"
 	"linked-clone/internal/infrastructure/database"
 	"linked-clone/pkg/auth"
+	"linked-clone/pkg/geoip"
 	"linked-clone/pkg/logger"
 	"log"
 	"os"
 	"os/signal"
-	"path/filepath"
 	"syscall"
 	"time"
 
@@ -42,6 +42,17 @@ func main() {
 		},
 	})
 
+	keyring, err := database.ConfigureEncryption(cfg.Encryption)
+	if err != nil {
+		loggerService.Fatal("Failed to configure encryption", "error", err)
+	}
+	if !keyring.Enabled() {
+		if cfg.Server.Environment == "production" {
+			loggerService.Fatal("Encryption keys are required in production")
+		}
+		loggerService.Warn("No encryption keys configured, sensitive columns will be stored in plaintext")
+	}
+
 	db, err := database.NewPostgreSQLConnection(cfg.Database)
 	if err != nil {
 		loggerService.LogBusinessEvent(context.Background(), logger.BusinessEventLog{
@@ -64,23 +75,32 @@ func main() {
 		},
 	})
 
-	migrationsDir := filepath.Join("migrations")
-	if err := database.RunMigrations(cfg.Database, migrationsDir); err != nil {
+	if cfg.Database.AutoMigrate {
+		if err := database.RunMigrations(cfg.Database, cfg.Database.MigrationsDir); err != nil {
+			loggerService.LogBusinessEvent(context.Background(), logger.BusinessEventLog{
+				Event:   "database_migration_failed",
+				Entity:  "database",
+				Success: false,
+				Error:   err.Error(),
+			})
+			loggerService.Fatal("Failed to run database migrations", "error", err)
+		}
+
 		loggerService.LogBusinessEvent(context.Background(), logger.BusinessEventLog{
-			Event:   "database_migration_failed",
+			Event:   "database_migrated",
 			Entity:  "database",
-			Success: false,
-			Error:   err.Error(),
+			Success: true,
 		})
-		loggerService.Fatal("Failed to run database migrations", "error", err)
+	} else {
+		report, err := database.VerifyMigrations(cfg.Database, cfg.Database.MigrationsDir)
+		if err != nil {
+			loggerService.Fatal("Database migration verification failed", "error", err)
+		}
+		if report.Pending > 0 {
+			loggerService.Warn("Database has pending migrations; run the migrate command", "pending", report.Pending)
+		}
 	}
 
-	loggerService.LogBusinessEvent(context.Background(), logger.BusinessEventLog{
-		Event:   "database_migrated",
-		Entity:  "database",
-		Success: true,
-	})
-
 	srv, err := server.NewServer(cfg, db, loggerService)
 	if err != nil {
 		loggerService.LogBusinessEvent(context.Background(), logger.BusinessEventLog{
@@ -93,7 +113,10 @@ func main() {
 	}
 
 	sessionRepo := authRepo.NewSessionRepository(db)
-	jwtService := auth.NewJWTService(cfg.JWT.SecretKey, cfg.JWT.ExpiryHours, sessionRepo)
+	jwtService, err := auth.NewJWTService(cfg.JWT.SecretKey, cfg.JWT.ExpiryHours, sessionRepo, geoip.Nop{}, cfg.Encryption.Pepper, nil)
+	if err != nil {
+		loggerService.Fatal("Failed to create JWT service", "error", err)
+	}
 
 	backgroundWorker := background.NewBackgroundWorker(background.WorkerConfig{
 		CleanupInterval: 5 * time.Minute,
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"linked-clone/internal/config"
+	"linked-clone/pkg/backup"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	if err := godotenv.Load(); err != nil {
+		log.Printf("Warning: .env file not found: %v", err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
+	var command string
+	var key string
+	var excludeAnalytics bool
+	var skipPrune bool
+	var yes bool
+	var allowProduction bool
+
+	flag.StringVar(&command, "command", "", "Backup command: backup, list, prune, restore")
+	flag.StringVar(&key, "key", "", "Backup object key to restore, or \"latest\" (for restore command)")
+	flag.BoolVar(&excludeAnalytics, "exclude-analytics", false, "Dump analytics tables without rows (for backup command)")
+	flag.BoolVar(&skipPrune, "skip-prune", false, "Do not apply the retention policy after uploading (for backup command)")
+	flag.BoolVar(&yes, "yes", false, "Skip the interactive confirmation (for restore command)")
+	flag.BoolVar(&allowProduction, "allow-production", false, "Permit restoring into a production environment")
+	flag.Parse()
+
+	if command == "" {
+		fmt.Println("Usage:")
+		fmt.Println("  go run cmd/backup/main.go -command=backup [-exclude-analytics] [-skip-prune]  # Dump the database to S3")
+		fmt.Println("  go run cmd/backup/main.go -command=list                                     # List stored backups")
+		fmt.Println("  go run cmd/backup/main.go -command=prune                                    # Delete backups past retention")
+		fmt.Println("  go run cmd/backup/main.go -command=restore -key=latest                      # Restore a backup")
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	store, err := backup.NewS3Store(cfg.AWS.AccessKeyID, cfg.AWS.SecretAccessKey, cfg.AWS.Region, cfg.Backup.Bucket)
+	if err != nil {
+		log.Fatalf("Failed to create backup store: %v", err)
+	}
+
+	db := backup.Database{
+		Host:     cfg.Database.Host,
+		Port:     cfg.Database.Port,
+		User:     cfg.Database.User,
+		Password: cfg.Database.Password,
+		Name:     cfg.Database.DBName,
+		SSLMode:  cfg.Database.SSLMode,
+	}
+	prefix := strings.Trim(cfg.Backup.Prefix, "/") + "/" + db.Name
+
+	switch command {
+	case "backup":
+		opts := backup.DumpOptions{}
+		if excludeAnalytics {
+			opts.ExcludeDataFor = backup.AnalyticsTables
+		}
+
+		objectKey := backup.ObjectKey(cfg.Backup.Prefix, db.Name, time.Now())
+		start := time.Now()
+
+		reader, writer := io.Pipe()
+		go func() {
+			writer.CloseWithError(backup.Dump(ctx, db, opts, writer))
+		}()
+		if err := store.Upload(ctx, objectKey, reader); err != nil {
+			reader.CloseWithError(err)
+			log.Fatalf("Failed to back up database: %v", err)
+		}
+		fmt.Printf("Backup uploaded to s3://%s/%s in %s\n", cfg.Backup.Bucket, objectKey, time.Since(start).Round(time.Second))
+
+		if !skipPrune {
+			prune(ctx, store, prefix, cfg.Backup)
+		}
+
+	case "list":
+		objects, err := store.List(ctx, prefix)
+		if err != nil {
+			log.Fatalf("Failed to list backups: %v", err)
+		}
+		for _, object := range objects {
+			fmt.Printf("%s  %10d  %s\n", object.LastModified.UTC().Format(time.RFC3339), object.Size, object.Key)
+		}
+		fmt.Printf("%d backups\n", len(objects))
+
+	case "prune":
+		prune(ctx, store, prefix, cfg.Backup)
+
+	case "restore":
+		if cfg.Server.Environment == "production" && !allowProduction {
+			log.Fatal("Refusing to restore into production without -allow-production")
+		}
+		if key == "" {
+			log.Fatal("Backup key is required for restore command (use -key=latest for the newest backup)")
+		}
+		if key == "latest" {
+			objects, err := store.List(ctx, prefix)
+			if err != nil {
+				log.Fatalf("Failed to list backups: %v", err)
+			}
+			if len(objects) == 0 {
+				log.Fatal("No backups found")
+			}
+			key = objects[0].Key
+		}
+
+		fmt.Printf("This will DROP and replace all objects in database %q on %s:%s (environment: %s)\n",
+			db.Name, db.Host, db.Port, cfg.Server.Environment)
+		fmt.Printf("Restoring from s3://%s/%s\n", cfg.Backup.Bucket, key)
+		if !yes && !confirm(db.Name) {
+			log.Fatal("Restore aborted")
+		}
+
+		file, err := os.CreateTemp("", "restore-*.dump")
+		if err != nil {
+			log.Fatalf("Failed to create temporary file: %v", err)
+		}
+		defer os.Remove(file.Name())
+		defer file.Close()
+
+		size, err := store.Download(ctx, key, file)
+		if err != nil {
+			log.Fatalf("Failed to download backup: %v", err)
+		}
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			log.Fatalf("Failed to read downloaded backup: %v", err)
+		}
+
+		start := time.Now()
+		if err := backup.Restore(ctx, db, file); err != nil {
+			log.Fatalf("Failed to restore backup: %v", err)
+		}
+		fmt.Printf("Restored %d bytes from %s in %s\n", size, key, time.Since(start).Round(time.Second))
+
+	default:
+		log.Fatalf("Unknown command: %s", command)
+	}
+}
+
+func prune(ctx context.Context, store backup.Store, prefix string, cfg config.BackupConfig) {
+	if cfg.RetentionDays <= 0 {
+		return
+	}
+
+	deleted, err := backup.Prune(ctx, store, prefix, time.Duration(cfg.RetentionDays)*24*time.Hour, cfg.MinKeep)
+	for _, object := range deleted {
+		fmt.Printf("Deleted expired backup %s\n", object.Key)
+	}
+	if err != nil {
+		log.Fatalf("Failed to apply backup retention: %v", err)
+	}
+}
+
+func confirm(database string) bool {
+	fmt.Printf("Type the database name (%s) to continue: ", database)
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return false
+	}
+	return strings.TrimSpace(answer) == database
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"linked-clone/internal/config"
+	"linked-clone/internal/infrastructure/database"
+	"linked-clone/pkg/backup"
+	"log"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/joho/godotenv"
+)
+
+// Exit codes let cron wrappers tell a failed run from one that found
+// problems it did not fix.
+const (
+	exitClean       = 0
+	exitFailed      = 1
+	exitOutstanding = 2
+)
+
+type report struct {
+	StartedAt   time.Time                     `json:"started_at"`
+	Duration    float64                       `json:"duration_seconds"`
+	Repair      bool                          `json:"repair"`
+	Results     []*database.ConsistencyResult `json:"results"`
+	Outstanding int                           `json:"outstanding"`
+	Error       string                        `json:"error,omitempty"`
+}
+
+func main() {
+	if err := godotenv.Load(); err != nil {
+		log.Printf("Warning: .env file not found: %v", err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
+	var checks string
+	var repair bool
+	var format string
+	var batchSize int
+	var orphanGrace time.Duration
+
+	flag.StringVar(&checks, "checks", "", "Comma-separated checks to run, or \"all\": "+strings.Join(database.ConsistencyChecks, ", "))
+	flag.BoolVar(&repair, "repair", false, "Fix the inconsistencies found instead of only reporting them")
+	flag.StringVar(&format, "format", "text", "Report format: text, json")
+	flag.IntVar(&batchSize, "batch", 500, "Rows per batch")
+	flag.DurationVar(&orphanGrace, "orphan-grace", 24*time.Hour, "Ignore unreferenced objects newer than this (for s3_keys check)")
+	flag.Parse()
+
+	if checks == "" {
+		fmt.Println("Usage:")
+		fmt.Println("  go run cmd/checker/main.go -checks=all                             # Report every inconsistency")
+		fmt.Println("  go run cmd/checker/main.go -checks=reaction_counts,connections     # Run selected checks")
+		fmt.Println("  go run cmd/checker/main.go -checks=all -repair                     # Report and fix")
+		fmt.Println("  go run cmd/checker/main.go -checks=all -format=json                # Machine-readable report")
+		fmt.Println()
+		fmt.Println("Exit status is 0 when nothing is left to fix, 1 when a check fails, 2 when inconsistencies remain.")
+		os.Exit(exitFailed)
+	}
+	if format != "text" && format != "json" {
+		log.Fatalf("Unknown format: %s", format)
+	}
+
+	selected := database.ConsistencyChecks
+	if checks != "all" {
+		selected = strings.Split(checks, ",")
+		for i := range selected {
+			selected[i] = strings.TrimSpace(selected[i])
+			if !slices.Contains(database.ConsistencyChecks, selected[i]) {
+				log.Fatalf("Unknown check: %s", selected[i])
+			}
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Identity document keys are stored encrypted.
+	if _, err := database.ConfigureEncryption(cfg.Encryption); err != nil {
+		log.Fatalf("Failed to configure encryption: %v", err)
+	}
+	db, err := database.NewPostgreSQLConnection(cfg.Database)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+
+	opts := database.ConsistencyOptions{
+		Repair:      repair,
+		BatchSize:   batchSize,
+		OrphanGrace: orphanGrace,
+	}
+	if slices.Contains(selected, "s3_keys") {
+		opts.Store, err = backup.NewS3Store(cfg.AWS.AccessKeyID, cfg.AWS.SecretAccessKey, cfg.AWS.Region, cfg.AWS.S3Bucket)
+		if err != nil {
+			log.Fatalf("Failed to create object store: %v", err)
+		}
+	}
+
+	rep := &report{StartedAt: time.Now().UTC(), Repair: repair}
+	rep.Results, err = database.RunConsistencyChecks(ctx, db, selected, opts)
+	rep.Duration = time.Since(rep.StartedAt).Seconds()
+	for _, result := range rep.Results {
+		rep.Outstanding += result.Outstanding()
+	}
+	if err != nil {
+		rep.Error = err.Error()
+	}
+
+	if format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(rep); err != nil {
+			log.Fatalf("Failed to write report: %v", err)
+		}
+	} else {
+		printReport(rep)
+	}
+
+	switch {
+	case rep.Error != "":
+		os.Exit(exitFailed)
+	case rep.Outstanding > 0:
+		os.Exit(exitOutstanding)
+	default:
+		os.Exit(exitClean)
+	}
+}
+
+func printReport(rep *report) {
+	mode := "report only"
+	if rep.Repair {
+		mode = "repair"
+	}
+	fmt.Printf("Consistency check at %s (%s)\n", rep.StartedAt.Format(time.RFC3339), mode)
+
+	for _, result := range rep.Results {
+		fmt.Printf("%-20s %6d found  %6d repaired\n", result.Check, result.Found, result.Repaired)
+		for _, sample := range result.Samples {
+			fmt.Printf("    %s\n", sample)
+		}
+		if hidden := result.Found - len(result.Samples); hidden > 0 {
+			fmt.Printf("    ... and %d more\n", hidden)
+		}
+	}
+
+	if rep.Error != "" {
+		fmt.Printf("Error: %s\n", rep.Error)
+	}
+	fmt.Printf("%d outstanding in %.1fs\n", rep.Outstanding, rep.Duration)
+}
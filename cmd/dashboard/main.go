@@ -0,0 +1,54 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"linked-clone/internal/config/server/routes"
+	"linked-clone/pkg/httpmetrics"
+	"log"
+	"os"
+
+	"github.com/gin-gonic/gin"
+)
+
+func main() {
+	var out string
+
+	flag.StringVar(&out, "out", "", "File to write the dashboard JSON to, or - for stdout")
+	flag.Parse()
+
+	if out == "" {
+		fmt.Println("Usage:")
+		fmt.Println("  go run cmd/dashboard/main.go -out=deploy/grafana/api-red.json   # Regenerate the shipped dashboard")
+		fmt.Println("  go run cmd/dashboard/main.go -out=-                              # Print it")
+		os.Exit(1)
+	}
+
+	// Registering routes only stores handlers, so empty dependencies are
+	// enough to read the route table without connecting to anything.
+	gin.SetMode(gin.ReleaseMode)
+	router := gin.New()
+	if err := routes.SetupRoutes(router, &routes.Dependencies{}); err != nil {
+		log.Fatalf("Failed to set up routes: %v", err)
+	}
+
+	var table []httpmetrics.Route
+	for _, route := range router.Routes() {
+		table = append(table, httpmetrics.Route{Method: route.Method, Path: route.Path})
+	}
+
+	dashboard, err := httpmetrics.Dashboard(table)
+	if err != nil {
+		log.Fatalf("Failed to build dashboard: %v", err)
+	}
+	dashboard = append(dashboard, '\n')
+
+	if out == "-" {
+		os.Stdout.Write(dashboard)
+		return
+	}
+	if err := os.WriteFile(out, dashboard, 0644); err != nil {
+		log.Fatalf("Failed to write dashboard: %v", err)
+	}
+	log.Printf("Wrote dashboard for %d routes to %s", len(table), out)
+}
@@ -1,13 +1,13 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"linked-clone/internal/config"
 	"linked-clone/internal/infrastructure/database"
 	"log"
 	"os"
-	"path/filepath"
 
 	"github.com/joho/godotenv"
 )
@@ -22,13 +22,15 @@ func main() {
 		log.Fatalf("Failed to load configuration: %v", err)
 	}
 
-	migrationsDir := filepath.Join("internal", "infrastructure", "database", "migrations")
+	migrationsDir := cfg.Database.MigrationsDir
 
 	var command string
 	var name string
+	var batchSize int
 
-	flag.StringVar(&command, "command", "", "Migration command: up, down, status, create, reset")
+	flag.StringVar(&command, "command", "", "Migration command: up, down, status, verify, rehash, create, reset, reencrypt, match-locations")
 	flag.StringVar(&name, "name", "", "Migration name (for create command)")
+	flag.IntVar(&batchSize, "batch", 500, "Rows per batch (for reencrypt and match-locations commands)")
 	flag.Parse()
 
 	if command == "" {
@@ -36,8 +38,12 @@ func main() {
 		fmt.Println("  go run cmd/migrate/main.go -command=up              # Run all pending migrations")
 		fmt.Println("  go run cmd/migrate/main.go -command=down            # Rollback last migration")
 		fmt.Println("  go run cmd/migrate/main.go -command=status          # Show migration status")
+		fmt.Println("  go run cmd/migrate/main.go -command=verify          # Check applied migration files against recorded checksums")
+		fmt.Println("  go run cmd/migrate/main.go -command=rehash          # Accept current migration files as the recorded checksums")
 		fmt.Println("  go run cmd/migrate/main.go -command=create -name=migration_name  # Create new migration")
 		fmt.Println("  go run cmd/migrate/main.go -command=reset           # Reset all migrations")
+		fmt.Println("  go run cmd/migrate/main.go -command=reencrypt       # Encrypt sensitive columns with the primary key")
+		fmt.Println("  go run cmd/migrate/main.go -command=match-locations # Link free-text locations to the locations table")
 		os.Exit(1)
 	}
 
@@ -53,6 +59,23 @@ func main() {
 			log.Fatalf("Failed to get migration status: %v", err)
 		}
 
+	case "verify":
+		report, err := database.VerifyMigrations(cfg.Database, migrationsDir)
+		if report != nil {
+			fmt.Printf("Applied: %d, pending: %d, newly recorded: %d\n", report.Applied, report.Pending, report.Recorded)
+		}
+		if err != nil {
+			log.Fatalf("Migration verification failed: %v", err)
+		}
+		fmt.Println("Migration checksums verified")
+
+	case "rehash":
+		report, err := database.RehashMigrations(cfg.Database, migrationsDir)
+		if err != nil {
+			log.Fatalf("Failed to rehash migrations: %v", err)
+		}
+		fmt.Printf("Recorded checksums for %d migrations\n", report.Recorded)
+
 	case "create":
 		if name == "" {
 			log.Fatal("Migration name is required for create command")
@@ -62,6 +85,38 @@ func main() {
 		}
 		fmt.Printf("Migration created successfully: %s\n", name)
 
+	case "reencrypt":
+		keyring, err := database.ConfigureEncryption(cfg.Encryption)
+		if err != nil {
+			log.Fatalf("Failed to configure encryption: %v", err)
+		}
+		db, err := database.NewPostgreSQLConnection(cfg.Database)
+		if err != nil {
+			log.Fatalf("Failed to connect to database: %v", err)
+		}
+		updated, err := database.ReencryptColumns(context.Background(), db, keyring, batchSize)
+		for column, count := range updated {
+			fmt.Printf("%s: %d rows re-encrypted\n", column, count)
+		}
+		if err != nil {
+			log.Fatalf("Failed to re-encrypt columns: %v", err)
+		}
+		fmt.Println("Re-encryption completed successfully")
+
+	case "match-locations":
+		db, err := database.NewPostgreSQLConnection(cfg.Database)
+		if err != nil {
+			log.Fatalf("Failed to connect to database: %v", err)
+		}
+		matched, err := database.MatchLocations(context.Background(), db, batchSize)
+		for table, count := range matched {
+			fmt.Printf("%s: %d locations matched\n", table, count)
+		}
+		if err != nil {
+			log.Fatalf("Failed to match locations: %v", err)
+		}
+		fmt.Println("Location matching completed successfully")
+
 	default:
 		log.Fatalf("Unknown command: %s", command)
 	}
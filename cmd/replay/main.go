@@ -0,0 +1,273 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"linked-clone/internal/config"
+	"linked-clone/pkg/capture"
+	"linked-clone/pkg/redis"
+	"log"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/joho/godotenv"
+)
+
+const (
+	exitClean    = 0
+	exitFailed   = 1
+	exitMismatch = 2
+
+	sampleSize = 5
+)
+
+type routeReport struct {
+	Route         string   `json:"route"`
+	Replayed      int      `json:"replayed"`
+	Matched       int      `json:"matched"`
+	Mismatched    int      `json:"mismatched"`
+	Failed        int      `json:"failed"`
+	OriginalP50Ms int64    `json:"original_p50_ms"`
+	OriginalP95Ms int64    `json:"original_p95_ms"`
+	ReplayP50Ms   int64    `json:"replay_p50_ms"`
+	ReplayP95Ms   int64    `json:"replay_p95_ms"`
+	Samples       []string `json:"samples,omitempty"`
+
+	originalLatencies []int64
+	replayLatencies   []int64
+}
+
+type report struct {
+	Target     string         `json:"target"`
+	StartedAt  time.Time      `json:"started_at"`
+	Duration   float64        `json:"duration_seconds"`
+	Captured   int            `json:"captured"`
+	Skipped    int            `json:"skipped"`
+	Replayed   int            `json:"replayed"`
+	Mismatched int            `json:"mismatched"`
+	Failed     int            `json:"failed"`
+	Routes     []*routeReport `json:"routes"`
+}
+
+func main() {
+	if err := godotenv.Load(); err != nil {
+		log.Printf("Warning: .env file not found: %v", err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
+	var target string
+	var token string
+	var limit int
+	var methods string
+	var route string
+	var concurrency int
+	var timeout time.Duration
+	var format string
+
+	flag.StringVar(&target, "target", "", "Base URL of the instance to replay against, e.g. https://staging.example.com")
+	flag.StringVar(&token, "token", os.Getenv("REPLAY_TOKEN"), "Bearer token sent with requests that were captured signed in (default $REPLAY_TOKEN)")
+	flag.IntVar(&limit, "limit", 1000, "Number of most recent captured requests to replay")
+	flag.StringVar(&methods, "methods", "GET,HEAD", "Comma-separated HTTP methods to replay")
+	flag.StringVar(&route, "route", "", "Only replay requests whose route starts with this, e.g. /api/v1/posts")
+	flag.IntVar(&concurrency, "concurrency", 4, "Requests in flight at once")
+	flag.DurationVar(&timeout, "timeout", 10*time.Second, "Per-request timeout")
+	flag.StringVar(&format, "format", "text", "Report format: text, json")
+	flag.Parse()
+
+	if target == "" {
+		fmt.Println("Usage:")
+		fmt.Println("  go run cmd/replay/main.go -target=https://staging.example.com                        # Replay captured reads")
+		fmt.Println("  go run cmd/replay/main.go -target=https://staging.example.com -route=/api/v1/posts   # Replay one area")
+		fmt.Println("  go run cmd/replay/main.go -target=https://staging.example.com -methods=GET,POST      # Include writes")
+		fmt.Println()
+		fmt.Println("Exit status is 0 when every replayed status matched, 1 on error, 2 when statuses differ.")
+		os.Exit(exitFailed)
+	}
+	if format != "text" && format != "json" {
+		log.Fatalf("Unknown format: %s", format)
+	}
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	allowed := make(map[string]bool)
+	for _, method := range strings.Split(methods, ",") {
+		if method = strings.ToUpper(strings.TrimSpace(method)); method != "" {
+			allowed[method] = true
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	redisClient, err := redis.NewRedisClient(redis.Config{
+		Mode:             cfg.Redis.Mode,
+		Host:             cfg.Redis.Host,
+		Port:             cfg.Redis.Port,
+		Addrs:            cfg.Redis.Addrs,
+		MasterName:       cfg.Redis.MasterName,
+		Password:         cfg.Redis.Password,
+		SentinelPassword: cfg.Redis.SentinelPassword,
+		DB:               cfg.Redis.DB,
+		DialTimeout:      cfg.Redis.DialTimeout,
+		ReadTimeout:      cfg.Redis.ReadTimeout,
+		WriteTimeout:     cfg.Redis.WriteTimeout,
+		OperationTimeout: cfg.Redis.OperationTimeout,
+		TLSEnabled:       cfg.Redis.TLSEnabled,
+		TLSSkipVerify:    cfg.Redis.TLSSkipVerify,
+	})
+	if err != nil {
+		log.Fatalf("Failed to connect to redis: %v", err)
+	}
+
+	store := capture.NewRedisStore(redisClient, cfg.Capture.MaxEntries, cfg.Capture.TTL)
+	envelopes, err := store.List(ctx, limit)
+	if err != nil {
+		log.Fatalf("Failed to read captured requests: %v", err)
+	}
+
+	rep := &report{Target: target, StartedAt: time.Now().UTC(), Captured: len(envelopes)}
+	replayer := capture.NewReplayer(target, token, timeout)
+
+	// Replay oldest first so requests that depend on each other keep their
+	// original order as far as the concurrency allows.
+	var queue []*capture.Envelope
+	for i := len(envelopes) - 1; i >= 0; i-- {
+		envelope := envelopes[i]
+		if !allowed[envelope.Method] || !strings.HasPrefix(envelope.Route, route) || !replayer.Replayable(envelope) {
+			rep.Skipped++
+			continue
+		}
+		queue = append(queue, envelope)
+	}
+
+	routes := make(map[string]*routeReport)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	work := make(chan *capture.Envelope)
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for envelope := range work {
+				result, err := replayer.Replay(ctx, envelope)
+
+				mu.Lock()
+				record(routes, envelope, result, err)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, envelope := range queue {
+		select {
+		case work <- envelope:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	close(work)
+	wg.Wait()
+
+	for _, r := range routes {
+		r.OriginalP50Ms, r.OriginalP95Ms = percentiles(r.originalLatencies)
+		r.ReplayP50Ms, r.ReplayP95Ms = percentiles(r.replayLatencies)
+		rep.Replayed += r.Replayed
+		rep.Mismatched += r.Mismatched
+		rep.Failed += r.Failed
+		rep.Routes = append(rep.Routes, r)
+	}
+	sort.Slice(rep.Routes, func(i, j int) bool {
+		return rep.Routes[i].Route < rep.Routes[j].Route
+	})
+	rep.Duration = time.Since(rep.StartedAt).Seconds()
+
+	if format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(rep); err != nil {
+			log.Fatalf("Failed to write report: %v", err)
+		}
+	} else {
+		printReport(rep)
+	}
+
+	switch {
+	case ctx.Err() != nil || rep.Failed > 0:
+		os.Exit(exitFailed)
+	case rep.Mismatched > 0:
+		os.Exit(exitMismatch)
+	default:
+		os.Exit(exitClean)
+	}
+}
+
+func record(routes map[string]*routeReport, envelope *capture.Envelope, result *capture.Result, err error) {
+	key := envelope.Method + " " + envelope.Route
+	r, ok := routes[key]
+	if !ok {
+		r = &routeReport{Route: key}
+		routes[key] = r
+	}
+
+	r.Replayed++
+	switch {
+	case err != nil:
+		r.Failed++
+		addSample(r, fmt.Sprintf("%s %s: %v", envelope.Method, envelope.Path, err))
+	case result.Status != envelope.Status:
+		r.Mismatched++
+		addSample(r, fmt.Sprintf("%s %s: captured %d, replayed %d", envelope.Method, envelope.Path, envelope.Status, result.Status))
+	default:
+		r.Matched++
+	}
+
+	if err == nil {
+		r.originalLatencies = append(r.originalLatencies, envelope.LatencyMs)
+		r.replayLatencies = append(r.replayLatencies, result.LatencyMs)
+	}
+}
+
+func addSample(r *routeReport, sample string) {
+	if len(r.Samples) < sampleSize {
+		r.Samples = append(r.Samples, sample)
+	}
+}
+
+func percentiles(latencies []int64) (int64, int64) {
+	if len(latencies) == 0 {
+		return 0, 0
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	return latencies[(len(latencies)-1)/2], latencies[(len(latencies)-1)*95/100]
+}
+
+func printReport(rep *report) {
+	fmt.Printf("Replay against %s at %s\n", rep.Target, rep.StartedAt.Format(time.RFC3339))
+	fmt.Printf("%d captured, %d skipped, %d replayed\n\n", rep.Captured, rep.Skipped, rep.Replayed)
+
+	for _, r := range rep.Routes {
+		fmt.Printf("%s\n", r.Route)
+		fmt.Printf("    %d replayed, %d matched, %d mismatched, %d failed\n", r.Replayed, r.Matched, r.Mismatched, r.Failed)
+		fmt.Printf("    latency p50 %dms -> %dms, p95 %dms -> %dms\n", r.OriginalP50Ms, r.ReplayP50Ms, r.OriginalP95Ms, r.ReplayP95Ms)
+		for _, sample := range r.Samples {
+			fmt.Printf("    %s\n", sample)
+		}
+	}
+
+	fmt.Printf("\n%d mismatched, %d failed in %.1fs\n", rep.Mismatched, rep.Failed, rep.Duration)
+}
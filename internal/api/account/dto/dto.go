@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type RequestDeletionRequest struct {
+	Password string `json:"password" validate:"required"`
+	Reason   string `json:"reason" validate:"omitempty,max=1000"`
+}
+
+type AccountDeletionResponse struct {
+	ID             uint                           `json:"id"`
+	UserID         uint                           `json:"user_id"`
+	Status         entities.AccountDeletionStatus `json:"status"`
+	Reason         string                         `json:"reason,omitempty"`
+	RequestedAt    time.Time                      `json:"requested_at"`
+	PurgeAfter     time.Time                      `json:"purge_after"`
+	CancelledAt    *time.Time                     `json:"cancelled_at,omitempty"`
+	StartedAt      *time.Time                     `json:"started_at,omitempty"`
+	CompletedAt    *time.Time                     `json:"completed_at,omitempty"`
+	CompletedStage entities.PurgeStage            `json:"completed_stage,omitempty"`
+	Attempts       int                            `json:"attempts"`
+	LastError      string                         `json:"last_error,omitempty"`
+	Report         *entities.PurgeReport          `json:"report,omitempty"`
+}
+
+type DeletionVerificationResponse struct {
+	DeletionID  uint             `json:"deletion_id"`
+	Digest      string           `json:"digest"`
+	DigestValid bool             `json:"digest_valid"`
+	Verified    bool             `json:"verified"`
+	Residual    map[string]int64 `json:"residual"`
+	CheckedAt   time.Time        `json:"checked_at"`
+}
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"context"
+	"linked-clone/internal/api/account/dto"
+	"linked-clone/internal/api/account/service"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AccountHandler struct {
+	accountService service.AccountService
+	validator      validation.Validator
+	logger         logger.Logger
+}
+
+func NewAccountHandler(accountService service.AccountService, validator validation.Validator, logger logger.Logger) *AccountHandler {
+	return &AccountHandler{
+		accountService: accountService,
+		validator:      validator,
+		logger:         logger,
+	}
+}
+
+func (h *AccountHandler) RequestDeletion(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.RequestDeletionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	deletion, err := h.accountService.RequestDeletion(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.logger.Error("Failed to schedule account deletion", "error", err)
+		response.Error(c, accountErrorStatus(err), "Failed to delete account", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Account scheduled for deletion", deletion)
+}
+
+func (h *AccountHandler) GetDeletions(c *gin.Context) {
+	status := entities.AccountDeletionStatus(c.Query("status"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	deletions, err := h.accountService.GetDeletions(c.Request.Context(), status, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get account deletions", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get account deletions", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{
+		"deletions": deletions,
+		"limit":     limit,
+		"offset":    offset,
+	})
+}
+
+func (h *AccountHandler) GetDeletion(c *gin.Context) {
+	h.withDeletion(c, func(ctx context.Context, deletionID uint) (interface{}, error) {
+		return h.accountService.GetDeletion(ctx, deletionID)
+	}, "Failed to get account deletion", "")
+}
+
+func (h *AccountHandler) CancelDeletion(c *gin.Context) {
+	adminID := middleware.GetUserID(c)
+	h.withDeletion(c, func(ctx context.Context, deletionID uint) (interface{}, error) {
+		return h.accountService.CancelDeletion(ctx, adminID, deletionID)
+	}, "Failed to cancel account deletion", "Account deletion cancelled")
+}
+
+func (h *AccountHandler) VerifyDeletion(c *gin.Context) {
+	h.withDeletion(c, func(ctx context.Context, deletionID uint) (interface{}, error) {
+		return h.accountService.VerifyDeletion(ctx, deletionID)
+	}, "Failed to verify account deletion", "")
+}
+
+func (h *AccountHandler) withDeletion(c *gin.Context, action func(ctx context.Context, deletionID uint) (interface{}, error), failureMessage, successMessage string) {
+	deletionID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid deletion ID", err.Error())
+		return
+	}
+
+	result, err := action(c.Request.Context(), uint(deletionID))
+	if err != nil {
+		h.logger.Error(failureMessage, "error", err)
+		response.Error(c, accountErrorStatus(err), failureMessage, err.Error())
+		return
+	}
+
+	if successMessage != "" {
+		response.SuccessWithMessage(c, successMessage, result)
+		return
+	}
+	response.Success(c, result)
+}
+
+func accountErrorStatus(err error) int {
+	switch err.Error() {
+	case "user not found", "account deletion not found":
+		return http.StatusNotFound
+	case "invalid password":
+		return http.StatusUnauthorized
+	case "account deletion already scheduled", "account deletion cannot be cancelled", "account deletion not completed":
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
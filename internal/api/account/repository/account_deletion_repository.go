@@ -0,0 +1,573 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const scrubbedContent = "[deleted]"
+
+type accountDeletionRepository struct {
+	db *gorm.DB
+}
+
+func NewAccountDeletionRepository(db *gorm.DB) repositories.AccountDeletionRepository {
+	return &accountDeletionRepository{db: db}
+}
+
+func (r *accountDeletionRepository) Create(ctx context.Context, deletion *entities.AccountDeletion) error {
+	return r.db.WithContext(ctx).Create(deletion).Error
+}
+
+func (r *accountDeletionRepository) GetByID(ctx context.Context, id uint) (*entities.AccountDeletion, error) {
+	var deletion entities.AccountDeletion
+	err := r.db.WithContext(ctx).First(&deletion, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &deletion, nil
+}
+
+func (r *accountDeletionRepository) GetByUserID(ctx context.Context, userID uint) (*entities.AccountDeletion, error) {
+	var deletion entities.AccountDeletion
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&deletion).Error
+	if err != nil {
+		return nil, err
+	}
+	return &deletion, nil
+}
+
+func (r *accountDeletionRepository) GetByStatus(ctx context.Context, status entities.AccountDeletionStatus, limit, offset int) ([]*entities.AccountDeletion, error) {
+	var deletions []*entities.AccountDeletion
+	query := r.db.WithContext(ctx)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	err := query.
+		Order("purge_after ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&deletions).Error
+	return deletions, err
+}
+
+func (r *accountDeletionRepository) Update(ctx context.Context, deletion *entities.AccountDeletion) error {
+	return r.db.WithContext(ctx).Save(deletion).Error
+}
+
+func (r *accountDeletionRepository) ClaimDue(ctx context.Context, now, staleBefore time.Time, maxAttempts, limit int) ([]*entities.AccountDeletion, error) {
+	var deletions []*entities.AccountDeletion
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE account_deletions
+		SET status = ?, attempts = attempts + 1, started_at = COALESCE(started_at, ?), updated_at = ?
+		WHERE id IN (
+			SELECT id FROM account_deletions
+			WHERE purge_after <= ? AND attempts < ?
+				AND (status IN (?, ?) OR (status = ? AND updated_at < ?))
+			ORDER BY purge_after
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		entities.AccountDeletionProcessing, now, now,
+		now, maxAttempts,
+		entities.AccountDeletionScheduled, entities.AccountDeletionFailed, entities.AccountDeletionProcessing, staleBefore,
+		limit,
+	).Scan(&deletions).Error
+	return deletions, err
+}
+
+func (r *accountDeletionRepository) ScrubContent(ctx context.Context, userID uint) (map[string]int64, error) {
+	affected := make(map[string]int64)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		posts := tx.Unscoped().Model(&entities.Post{}).
+			Where("user_id = ? AND (content <> ? OR rendered_content <> '' OR image_alt_text <> '' OR event_data IS NOT NULL)", userID, scrubbedContent).
+			Updates(map[string]interface{}{"content": scrubbedContent, "rendered_content": "", "image_alt_text": "", "event_data": nil})
+		if posts.Error != nil {
+			return fmt.Errorf("failed to scrub posts: %w", posts.Error)
+		}
+		affected["posts"] = posts.RowsAffected
+
+		postHashtags := tx.Where("post_id IN (?)", tx.Unscoped().Model(&entities.Post{}).Select("id").Where("user_id = ?", userID)).
+			Delete(&entities.PostHashtag{})
+		if postHashtags.Error != nil {
+			return fmt.Errorf("failed to delete post hashtags: %w", postHashtags.Error)
+		}
+		affected["post_hashtags"] = postHashtags.RowsAffected
+
+		hashtagFollows := tx.Where("user_id = ?", userID).Delete(&entities.HashtagFollow{})
+		if hashtagFollows.Error != nil {
+			return fmt.Errorf("failed to delete hashtag follows: %w", hashtagFollows.Error)
+		}
+		affected["hashtag_follows"] = hashtagFollows.RowsAffected
+
+		mentions := tx.Where("mentioner_id = ? OR mentioned_user_id = ?", userID, userID).Delete(&entities.Mention{})
+		if mentions.Error != nil {
+			return fmt.Errorf("failed to delete mentions: %w", mentions.Error)
+		}
+		affected["mentions"] = mentions.RowsAffected
+
+		comments := tx.Unscoped().Model(&entities.Comment{}).
+			Where("user_id = ? AND (content <> ? OR rendered_content <> '')", userID, scrubbedContent).
+			Updates(map[string]interface{}{"content": scrubbedContent, "rendered_content": ""})
+		if comments.Error != nil {
+			return fmt.Errorf("failed to scrub comments: %w", comments.Error)
+		}
+		affected["comments"] = comments.RowsAffected
+
+		applications := tx.Unscoped().Model(&entities.Application{}).
+			Where("user_id = ? AND (cover_letter <> '' OR withdrawal_reason <> '')", userID).
+			UpdateColumns(map[string]interface{}{"cover_letter": "", "withdrawal_reason": ""})
+		if applications.Error != nil {
+			return fmt.Errorf("failed to scrub applications: %w", applications.Error)
+		}
+		affected["applications"] = applications.RowsAffected
+
+		statusNotes := tx.Model(&entities.ApplicationStatusHistory{}).
+			Where("note <> '' AND application_id IN (?)", tx.Unscoped().Model(&entities.Application{}).Select("id").Where("user_id = ?", userID)).
+			UpdateColumn("note", "")
+		if statusNotes.Error != nil {
+			return fmt.Errorf("failed to scrub application status notes: %w", statusNotes.Error)
+		}
+		affected["application_status_histories"] = statusNotes.RowsAffected
+
+		imports := tx.Where("user_id = ?", userID).Delete(&entities.ConnectionImport{})
+		if imports.Error != nil {
+			return fmt.Errorf("failed to delete connection imports: %w", imports.Error)
+		}
+		affected["connection_imports"] = imports.RowsAffected
+
+		uploads := tx.Where("user_id = ?", userID).Delete(&entities.UploadJob{})
+		if uploads.Error != nil {
+			return fmt.Errorf("failed to delete upload jobs: %w", uploads.Error)
+		}
+		affected["upload_jobs"] = uploads.RowsAffected
+
+		connectionSuggestions := tx.Where("user_id = ? OR suggested_user_id = ?", userID, userID).Delete(&entities.ConnectionSuggestion{})
+		if connectionSuggestions.Error != nil {
+			return fmt.Errorf("failed to delete connection suggestions: %w", connectionSuggestions.Error)
+		}
+		affected["connection_suggestions"] = connectionSuggestions.RowsAffected
+
+		suggestions := tx.Where("user_id = ?", userID).Delete(&entities.PostSuggestion{})
+		if suggestions.Error != nil {
+			return fmt.Errorf("failed to delete post suggestions: %w", suggestions.Error)
+		}
+		affected["post_suggestions"] = suggestions.RowsAffected
+
+		experiences := tx.Unscoped().Where("user_id = ?", userID).Delete(&entities.Experience{})
+		if experiences.Error != nil {
+			return fmt.Errorf("failed to delete experiences: %w", experiences.Error)
+		}
+		affected["experiences"] = experiences.RowsAffected
+
+		sections := tx.Where("user_id = ?", userID).Delete(&entities.ProfileSection{})
+		if sections.Error != nil {
+			return fmt.Errorf("failed to delete profile sections: %w", sections.Error)
+		}
+		affected["profile_sections"] = sections.RowsAffected
+
+		savedJobs := tx.Where("user_id = ?", userID).Delete(&entities.SavedJob{})
+		if savedJobs.Error != nil {
+			return fmt.Errorf("failed to delete saved jobs: %w", savedJobs.Error)
+		}
+		affected["saved_jobs"] = savedJobs.RowsAffected
+
+		savedPosts := tx.Where("user_id = ?", userID).Delete(&entities.SavedPost{})
+		if savedPosts.Error != nil {
+			return fmt.Errorf("failed to delete saved posts: %w", savedPosts.Error)
+		}
+		affected["saved_posts"] = savedPosts.RowsAffected
+
+		hiddenPosts := tx.Where("user_id = ?", userID).Delete(&entities.HiddenPost{})
+		if hiddenPosts.Error != nil {
+			return fmt.Errorf("failed to delete hidden posts: %w", hiddenPosts.Error)
+		}
+		affected["hidden_posts"] = hiddenPosts.RowsAffected
+
+		jobAlerts := tx.Where("user_id = ?", userID).Delete(&entities.JobAlert{})
+		if jobAlerts.Error != nil {
+			return fmt.Errorf("failed to delete job alerts: %w", jobAlerts.Error)
+		}
+		affected["job_alerts"] = jobAlerts.RowsAffected
+
+		companyFollows := tx.Where("user_id = ?", userID).Delete(&entities.CompanyFollow{})
+		if companyFollows.Error != nil {
+			return fmt.Errorf("failed to delete company follows: %w", companyFollows.Error)
+		}
+		affected["company_follows"] = companyFollows.RowsAffected
+
+		searches := tx.Where("user_id = ?", userID).Delete(&entities.RecentSearch{})
+		if searches.Error != nil {
+			return fmt.Errorf("failed to delete recent searches: %w", searches.Error)
+		}
+		affected["recent_searches"] = searches.RowsAffected
+
+		emails := tx.Where("user_id = ? AND status IN ?", userID, []entities.OutboundEmailStatus{entities.OutboundEmailPending, entities.OutboundEmailSending}).
+			Delete(&entities.OutboundEmail{})
+		if emails.Error != nil {
+			return fmt.Errorf("failed to delete queued emails: %w", emails.Error)
+		}
+		affected["outbound_emails"] = emails.RowsAffected
+
+		assignments := tx.Where("user_id = ?", userID).Delete(&entities.ExperimentAssignment{})
+		if assignments.Error != nil {
+			return fmt.Errorf("failed to delete experiment assignments: %w", assignments.Error)
+		}
+		affected["experiment_assignments"] = assignments.RowsAffected
+
+		badges := tx.Where("user_id = ?", userID).Delete(&entities.SkillBadge{})
+		if badges.Error != nil {
+			return fmt.Errorf("failed to delete skill badges: %w", badges.Error)
+		}
+		affected["skill_badges"] = badges.RowsAffected
+
+		attempts := tx.Where("user_id = ?", userID).Delete(&entities.AssessmentAttempt{})
+		if attempts.Error != nil {
+			return fmt.Errorf("failed to delete assessment attempts: %w", attempts.Error)
+		}
+		affected["assessment_attempts"] = attempts.RowsAffected
+
+		certificates := tx.Where("user_id = ?", userID).Delete(&entities.CourseCertificate{})
+		if certificates.Error != nil {
+			return fmt.Errorf("failed to delete course certificates: %w", certificates.Error)
+		}
+		affected["course_certificates"] = certificates.RowsAffected
+
+		completions := tx.Where("user_id = ?", userID).Delete(&entities.LessonCompletion{})
+		if completions.Error != nil {
+			return fmt.Errorf("failed to delete lesson completions: %w", completions.Error)
+		}
+		affected["lesson_completions"] = completions.RowsAffected
+
+		enrollments := tx.Where("user_id = ?", userID).Delete(&entities.CourseEnrollment{})
+		if enrollments.Error != nil {
+			return fmt.Errorf("failed to delete course enrollments: %w", enrollments.Error)
+		}
+		affected["course_enrollments"] = enrollments.RowsAffected
+
+		matches := tx.Where("mentor_id = ? OR mentee_id = ?", userID, userID).Delete(&entities.MentorshipMatch{})
+		if matches.Error != nil {
+			return fmt.Errorf("failed to delete mentorship matches: %w", matches.Error)
+		}
+		affected["mentorship_matches"] = matches.RowsAffected
+
+		profiles := tx.Where("user_id = ?", userID).Delete(&entities.MentorshipProfile{})
+		if profiles.Error != nil {
+			return fmt.Errorf("failed to delete mentorship profiles: %w", profiles.Error)
+		}
+		affected["mentorship_profiles"] = profiles.RowsAffected
+
+		revisions := tx.Where("recommendation_id IN (?)", tx.Unscoped().Model(&entities.Recommendation{}).Select("id").Where("author_id = ? OR recipient_id = ?", userID, userID)).
+			Delete(&entities.RecommendationRevision{})
+		if revisions.Error != nil {
+			return fmt.Errorf("failed to delete recommendation revisions: %w", revisions.Error)
+		}
+		affected["recommendation_revisions"] = revisions.RowsAffected
+
+		recommendations := tx.Unscoped().Where("author_id = ? OR recipient_id = ?", userID, userID).Delete(&entities.Recommendation{})
+		if recommendations.Error != nil {
+			return fmt.Errorf("failed to delete recommendations: %w", recommendations.Error)
+		}
+		affected["recommendations"] = recommendations.RowsAffected
+		return nil
+	})
+	return affected, err
+}
+
+func (r *accountDeletionRepository) ScrubAuditLogs(ctx context.Context, userID uint) (map[string]int64, error) {
+	affected := make(map[string]int64)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		sessions := tx.Unscoped().Where("user_id = ?", userID).Delete(&entities.Session{})
+		if sessions.Error != nil {
+			return fmt.Errorf("failed to delete sessions: %w", sessions.Error)
+		}
+		affected["sessions"] = sessions.RowsAffected
+
+		codes := tx.Where("user_id = ?", userID).Delete(&entities.RecoveryCode{})
+		if codes.Error != nil {
+			return fmt.Errorf("failed to delete recovery codes: %w", codes.Error)
+		}
+		affected["recovery_codes"] = codes.RowsAffected
+
+		securityEvents := tx.Where("user_id = ?", userID).Delete(&entities.SecurityEvent{})
+		if securityEvents.Error != nil {
+			return fmt.Errorf("failed to delete security events: %w", securityEvents.Error)
+		}
+		affected["security_events"] = securityEvents.RowsAffected
+
+		ssoIdentities := tx.Where("user_id = ?", userID).Delete(&entities.SSOIdentity{})
+		if ssoIdentities.Error != nil {
+			return fmt.Errorf("failed to delete sso identities: %w", ssoIdentities.Error)
+		}
+		affected["sso_identities"] = ssoIdentities.RowsAffected
+
+		apiKeys := tx.Where("user_id = ?", userID).Delete(&entities.APIKey{})
+		if apiKeys.Error != nil {
+			return fmt.Errorf("failed to delete api keys: %w", apiKeys.Error)
+		}
+		affected["api_keys"] = apiKeys.RowsAffected
+
+		invites := tx.Where("inviter_id = ?", userID).Delete(&entities.Invite{})
+		if invites.Error != nil {
+			return fmt.Errorf("failed to delete invites: %w", invites.Error)
+		}
+		affected["invites"] = invites.RowsAffected
+
+		// Reports stay for the moderation record, but no longer say who
+		// filed them or what they wrote.
+		reports := tx.Model(&entities.Report{}).
+			Where("reporter_id = ?", userID).
+			UpdateColumns(map[string]interface{}{"reporter_id": nil, "details": ""})
+		if reports.Error != nil {
+			return fmt.Errorf("failed to scrub reports: %w", reports.Error)
+		}
+		affected["reports"] = reports.RowsAffected
+
+		strikes := tx.Where("user_id = ?", userID).Delete(&entities.Strike{})
+		if strikes.Error != nil {
+			return fmt.Errorf("failed to delete strikes: %w", strikes.Error)
+		}
+		affected["strikes"] = strikes.RowsAffected
+
+		acceptances := tx.Model(&entities.PolicyAcceptance{}).
+			Where("user_id = ? AND (ip_address IS NOT NULL OR user_agent IS NOT NULL)", userID).
+			UpdateColumns(map[string]interface{}{"ip_address": nil, "user_agent": nil})
+		if acceptances.Error != nil {
+			return fmt.Errorf("failed to scrub policy acceptances: %w", acceptances.Error)
+		}
+		affected["policy_acceptances"] = acceptances.RowsAffected
+
+		adminActions := tx.Model(&entities.AdminAuditLog{}).
+			Where("actor_id = ? AND (ip_address IS NOT NULL OR user_agent IS NOT NULL)", userID).
+			UpdateColumns(map[string]interface{}{"ip_address": nil, "user_agent": nil})
+		if adminActions.Error != nil {
+			return fmt.Errorf("failed to scrub admin audit logs: %w", adminActions.Error)
+		}
+		affected["admin_audit_logs"] = adminActions.RowsAffected
+
+		audits := tx.Model(&entities.IdentityVerificationAudit{}).
+			Where("user_id = ? AND note <> ''", userID).
+			Update("note", "")
+		if audits.Error != nil {
+			return fmt.Errorf("failed to scrub identity verification audits: %w", audits.Error)
+		}
+		affected["identity_verification_audits"] = audits.RowsAffected
+
+		verifications := tx.Model(&entities.IdentityVerification{}).
+			Where("user_id = ? AND (review_note <> '' OR provider_reference <> '')", userID).
+			UpdateColumns(map[string]interface{}{"review_note": "", "provider_reference": ""})
+		if verifications.Error != nil {
+			return fmt.Errorf("failed to scrub identity verifications: %w", verifications.Error)
+		}
+		affected["identity_verifications"] = verifications.RowsAffected
+
+		events := tx.Model(&entities.AnalyticsEvent{}).
+			Where("user_id = ?", userID).
+			UpdateColumns(map[string]interface{}{"user_id": nil, "metadata": nil})
+		if events.Error != nil {
+			return fmt.Errorf("failed to scrub analytics events: %w", events.Error)
+		}
+		affected["analytics_events"] = events.RowsAffected
+		return nil
+	})
+	return affected, err
+}
+
+func (r *accountDeletionRepository) GetStorageKeys(ctx context.Context, userID uint) ([]string, error) {
+	var keys []string
+	db := r.db.WithContext(ctx)
+
+	var user entities.User
+	if err := db.Unscoped().Select("profile_picture", "profile_thumbnail", "profile_banner", "profile_banner_large", "profile_banner_small").First(&user, userID).Error; err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	keys = append(keys, user.ProfilePicture, user.ProfileThumbnail, user.ProfileBanner, user.ProfileBannerLarge, user.ProfileBannerSmall)
+
+	var postImages []string
+	if err := db.Unscoped().Model(&entities.Post{}).
+		Where("user_id = ? AND image_url <> ''", userID).
+		Pluck("image_url", &postImages).Error; err != nil {
+		return nil, fmt.Errorf("failed to get post images: %w", err)
+	}
+	keys = append(keys, postImages...)
+
+	var resumes []string
+	if err := db.Unscoped().Model(&entities.Application{}).
+		Where("user_id = ? AND resume_url <> ''", userID).
+		Pluck("resume_url", &resumes).Error; err != nil {
+		return nil, fmt.Errorf("failed to get resumes: %w", err)
+	}
+	keys = append(keys, resumes...)
+
+	var verifications []*entities.IdentityVerification
+	if err := db.Select("id", "document_key", "selfie_key").
+		Where("user_id = ?", userID).
+		Find(&verifications).Error; err != nil {
+		return nil, fmt.Errorf("failed to get identity documents: %w", err)
+	}
+	for _, verification := range verifications {
+		keys = append(keys, verification.DocumentKey, verification.SelfieKey)
+	}
+
+	unique := make([]string, 0, len(keys))
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, key)
+	}
+	return unique, nil
+}
+
+func (r *accountDeletionRepository) ClearStorageReferences(ctx context.Context, userID uint) (map[string]int64, error) {
+	affected := make(map[string]int64)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		users := tx.Unscoped().Model(&entities.User{}).
+			Where("id = ?", userID).
+			UpdateColumns(map[string]interface{}{
+				"profile_picture":      "",
+				"profile_thumbnail":    "",
+				"profile_alt_text":     "",
+				"profile_banner":       "",
+				"profile_banner_large": "",
+				"profile_banner_small": "",
+			})
+		if users.Error != nil {
+			return fmt.Errorf("failed to clear profile images: %w", users.Error)
+		}
+		affected["users"] = users.RowsAffected
+
+		posts := tx.Unscoped().Model(&entities.Post{}).
+			Where("user_id = ? AND image_url <> ''", userID).
+			UpdateColumn("image_url", "")
+		if posts.Error != nil {
+			return fmt.Errorf("failed to clear post images: %w", posts.Error)
+		}
+		affected["posts"] = posts.RowsAffected
+
+		applications := tx.Unscoped().Model(&entities.Application{}).
+			Where("user_id = ? AND resume_url <> ''", userID).
+			UpdateColumn("resume_url", "")
+		if applications.Error != nil {
+			return fmt.Errorf("failed to clear resumes: %w", applications.Error)
+		}
+		affected["applications"] = applications.RowsAffected
+
+		verifications := tx.Model(&entities.IdentityVerification{}).
+			Where("user_id = ? AND (document_key <> '' OR selfie_key <> '')", userID).
+			UpdateColumns(map[string]interface{}{"document_key": "", "selfie_key": ""})
+		if verifications.Error != nil {
+			return fmt.Errorf("failed to clear identity documents: %w", verifications.Error)
+		}
+		affected["identity_verifications"] = verifications.RowsAffected
+		return nil
+	})
+	return affected, err
+}
+
+func (r *accountDeletionRepository) TombstoneUser(ctx context.Context, userID uint) error {
+	return r.db.WithContext(ctx).Unscoped().Model(&entities.User{}).
+		Where("id = ?", userID).
+		UpdateColumns(map[string]interface{}{
+			"email":              tombstoneEmail(userID),
+			"username":           fmt.Sprintf("deleted_%d", userID),
+			"full_name":          "Deleted User",
+			"password":           "",
+			"recovery_email":     "",
+			"totp_secret":        nil,
+			"phone_number":       nil,
+			"phone_verified_at":  nil,
+			"two_factor_enabled": false,
+			"bio":                "",
+			"location":           "",
+			"location_id":        nil,
+			"industry":           "",
+			"industry_id":        nil,
+			"website":            "",
+			"headline":           "",
+			"skills":             "[]",
+			"section_order":      "[]",
+			"date_of_birth":      nil,
+			"region":             nil,
+			"open_to_work":       false,
+			"recruiter_visible":  false,
+			"is_admin":           false,
+			"invite_id":          nil,
+			"invited_by_id":      nil,
+			"deleted_at":         gorm.Expr("COALESCE(deleted_at, NOW())"),
+		}).Error
+}
+
+func (r *accountDeletionRepository) CountResidualData(ctx context.Context, userID uint) (map[string]int64, error) {
+	checks := []struct {
+		name  string
+		query string
+		args  []interface{}
+	}{
+		{"posts", "SELECT COUNT(*) FROM posts WHERE user_id = ? AND (content <> ? OR rendered_content <> '' OR image_url <> '' OR image_alt_text <> '' OR event_data IS NOT NULL)", []interface{}{userID, scrubbedContent}},
+		{"comments", "SELECT COUNT(*) FROM comments WHERE user_id = ? AND (content <> ? OR rendered_content <> '')", []interface{}{userID, scrubbedContent}},
+		{"post_hashtags", "SELECT COUNT(*) FROM post_hashtags WHERE post_id IN (SELECT id FROM posts WHERE user_id = ?)", []interface{}{userID}},
+		{"hashtag_follows", "SELECT COUNT(*) FROM hashtag_follows WHERE user_id = ?", []interface{}{userID}},
+		{"mentions", "SELECT COUNT(*) FROM mentions WHERE mentioner_id = ? OR mentioned_user_id = ?", []interface{}{userID, userID}},
+		{"sessions", "SELECT COUNT(*) FROM sessions WHERE user_id = ?", []interface{}{userID}},
+		{"recovery_codes", "SELECT COUNT(*) FROM recovery_codes WHERE user_id = ?", []interface{}{userID}},
+		{"security_events", "SELECT COUNT(*) FROM security_events WHERE user_id = ?", []interface{}{userID}},
+		{"sso_identities", "SELECT COUNT(*) FROM sso_identities WHERE user_id = ?", []interface{}{userID}},
+		{"api_keys", "SELECT COUNT(*) FROM api_keys WHERE user_id = ?", []interface{}{userID}},
+		{"invites", "SELECT COUNT(*) FROM invites WHERE inviter_id = ?", []interface{}{userID}},
+		{"reports", "SELECT COUNT(*) FROM reports WHERE reporter_id = ?", []interface{}{userID}},
+		{"strikes", "SELECT COUNT(*) FROM strikes WHERE user_id = ?", []interface{}{userID}},
+		{"policy_acceptances", "SELECT COUNT(*) FROM policy_acceptances WHERE user_id = ? AND (ip_address IS NOT NULL OR user_agent IS NOT NULL)", []interface{}{userID}},
+		{"admin_audit_logs", "SELECT COUNT(*) FROM admin_audit_logs WHERE actor_id = ? AND (ip_address IS NOT NULL OR user_agent IS NOT NULL)", []interface{}{userID}},
+		{"identity_verification_audits", "SELECT COUNT(*) FROM identity_verification_audits WHERE user_id = ? AND note <> ''", []interface{}{userID}},
+		{"identity_verifications", "SELECT COUNT(*) FROM identity_verifications WHERE user_id = ? AND (document_key <> '' OR selfie_key <> '' OR review_note <> '' OR provider_reference <> '')", []interface{}{userID}},
+		{"analytics_events", "SELECT COUNT(*) FROM analytics_events WHERE user_id = ?", []interface{}{userID}},
+		{"connection_imports", "SELECT COUNT(*) FROM connection_imports WHERE user_id = ?", []interface{}{userID}},
+		{"upload_jobs", "SELECT COUNT(*) FROM upload_jobs WHERE user_id = ?", []interface{}{userID}},
+		{"connection_suggestions", "SELECT COUNT(*) FROM connection_suggestions WHERE user_id = ? OR suggested_user_id = ?", []interface{}{userID, userID}},
+		{"post_suggestions", "SELECT COUNT(*) FROM post_suggestions WHERE user_id = ?", []interface{}{userID}},
+		{"experiences", "SELECT COUNT(*) FROM experiences WHERE user_id = ?", []interface{}{userID}},
+		{"profile_sections", "SELECT COUNT(*) FROM profile_sections WHERE user_id = ?", []interface{}{userID}},
+		{"saved_jobs", "SELECT COUNT(*) FROM saved_jobs WHERE user_id = ?", []interface{}{userID}},
+		{"saved_posts", "SELECT COUNT(*) FROM saved_posts WHERE user_id = ?", []interface{}{userID}},
+		{"hidden_posts", "SELECT COUNT(*) FROM hidden_posts WHERE user_id = ?", []interface{}{userID}},
+		{"job_alerts", "SELECT COUNT(*) FROM job_alerts WHERE user_id = ?", []interface{}{userID}},
+		{"company_follows", "SELECT COUNT(*) FROM company_follows WHERE user_id = ?", []interface{}{userID}},
+		{"recent_searches", "SELECT COUNT(*) FROM recent_searches WHERE user_id = ?", []interface{}{userID}},
+		{"outbound_emails", "SELECT COUNT(*) FROM outbound_emails WHERE user_id = ? AND status IN ('pending', 'sending')", []interface{}{userID}},
+		{"experiment_assignments", "SELECT COUNT(*) FROM experiment_assignments WHERE user_id = ?", []interface{}{userID}},
+		{"skill_badges", "SELECT COUNT(*) FROM skill_badges WHERE user_id = ?", []interface{}{userID}},
+		{"assessment_attempts", "SELECT COUNT(*) FROM assessment_attempts WHERE user_id = ?", []interface{}{userID}},
+		{"course_certificates", "SELECT COUNT(*) FROM course_certificates WHERE user_id = ?", []interface{}{userID}},
+		{"lesson_completions", "SELECT COUNT(*) FROM lesson_completions WHERE user_id = ?", []interface{}{userID}},
+		{"course_enrollments", "SELECT COUNT(*) FROM course_enrollments WHERE user_id = ?", []interface{}{userID}},
+		{"mentorship_matches", "SELECT COUNT(*) FROM mentorship_matches WHERE mentor_id = ? OR mentee_id = ?", []interface{}{userID, userID}},
+		{"mentorship_profiles", "SELECT COUNT(*) FROM mentorship_profiles WHERE user_id = ?", []interface{}{userID}},
+		{"recommendations", "SELECT COUNT(*) FROM recommendations WHERE author_id = ? OR recipient_id = ?", []interface{}{userID, userID}},
+		{"applications", "SELECT COUNT(*) FROM applications WHERE user_id = ? AND (resume_url <> '' OR cover_letter <> '' OR withdrawal_reason <> '')", []interface{}{userID}},
+		{"application_status_histories", "SELECT COUNT(*) FROM application_status_histories h JOIN applications a ON a.id = h.application_id WHERE a.user_id = ? AND h.note <> ''", []interface{}{userID}},
+		{"users", "SELECT COUNT(*) FROM users WHERE id = ? AND (email <> ? OR recovery_email <> '' OR COALESCE(totp_secret, '') <> '' OR COALESCE(phone_number, '') <> '' OR profile_picture <> '' OR date_of_birth IS NOT NULL OR deleted_at IS NULL)", []interface{}{userID, tombstoneEmail(userID)}},
+	}
+
+	residual := make(map[string]int64, len(checks))
+	for _, check := range checks {
+		var count int64
+		if err := r.db.WithContext(ctx).Raw(check.query, check.args...).Scan(&count).Error; err != nil {
+			return residual, fmt.Errorf("failed to verify %s: %w", check.name, err)
+		}
+		residual[check.name] = count
+	}
+	return residual, nil
+}
+
+func tombstoneEmail(userID uint) string {
+	return fmt.Sprintf("deleted-%d@deleted.invalid", userID)
+}
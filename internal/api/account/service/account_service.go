@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/account/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/auth"
+	"linked-clone/pkg/logger"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+type AccountService interface {
+	RequestDeletion(ctx context.Context, userID uint, req *dto.RequestDeletionRequest) (*dto.AccountDeletionResponse, error)
+
+	GetDeletions(ctx context.Context, status entities.AccountDeletionStatus, limit, offset int) ([]*dto.AccountDeletionResponse, error)
+	GetDeletion(ctx context.Context, deletionID uint) (*dto.AccountDeletionResponse, error)
+	CancelDeletion(ctx context.Context, adminID, deletionID uint) (*dto.AccountDeletionResponse, error)
+	VerifyDeletion(ctx context.Context, deletionID uint) (*dto.DeletionVerificationResponse, error)
+}
+
+type accountService struct {
+	deletionRepo repositories.AccountDeletionRepository
+	userRepo     repositories.UserRepository
+	jwtService   auth.JWTService
+	gracePeriod  time.Duration
+	logger       logger.Logger
+}
+
+func NewAccountService(
+	deletionRepo repositories.AccountDeletionRepository,
+	userRepo repositories.UserRepository,
+	jwtService auth.JWTService,
+	graceDays int,
+	logger logger.Logger,
+) AccountService {
+	return &accountService{
+		deletionRepo: deletionRepo,
+		userRepo:     userRepo,
+		jwtService:   jwtService,
+		gracePeriod:  time.Duration(graceDays) * 24 * time.Hour,
+		logger:       logger,
+	}
+}
+
+func (s *accountService) RequestDeletion(ctx context.Context, userID uint, req *dto.RequestDeletionRequest) (*dto.AccountDeletionResponse, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("user not found")
+		}
+		s.logger.Error("Failed to get user", "error", err)
+		return nil, errors.New("failed to schedule account deletion")
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+		return nil, errors.New("invalid password")
+	}
+
+	now := time.Now()
+	deletion, err := s.deletionRepo.GetByUserID(ctx, userID)
+	switch {
+	case err == nil && deletion.Status != entities.AccountDeletionCancelled:
+		return nil, errors.New("account deletion already scheduled")
+	case err == nil:
+		*deletion = entities.AccountDeletion{
+			ID:        deletion.ID,
+			UserID:    userID,
+			CreatedAt: deletion.CreatedAt,
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		deletion = &entities.AccountDeletion{UserID: userID}
+	default:
+		s.logger.Error("Failed to get account deletion", "error", err)
+		return nil, errors.New("failed to schedule account deletion")
+	}
+
+	deletion.Status = entities.AccountDeletionScheduled
+	deletion.Reason = req.Reason
+	deletion.RequestedAt = now
+	deletion.PurgeAfter = now.Add(s.gracePeriod)
+
+	if deletion.ID == 0 {
+		err = s.deletionRepo.Create(ctx, deletion)
+	} else {
+		err = s.deletionRepo.Update(ctx, deletion)
+	}
+	if err != nil {
+		s.logger.Error("Failed to save account deletion", "error", err)
+		return nil, errors.New("failed to schedule account deletion")
+	}
+
+	if err := s.userRepo.Delete(ctx, userID); err != nil {
+		s.logger.Error("Failed to deactivate user", "error", err, "user_id", userID)
+		return nil, errors.New("failed to schedule account deletion")
+	}
+
+	if err := s.jwtService.RevokeUserSessions(ctx, userID); err != nil {
+		s.logger.Error("Failed to revoke sessions for deleted account", "error", err, "user_id", userID)
+	}
+
+	s.logger.Info("Account deletion scheduled", "user_id", userID, "purge_after", deletion.PurgeAfter)
+
+	return s.mapDeletionToResponse(deletion), nil
+}
+
+func (s *accountService) GetDeletions(ctx context.Context, status entities.AccountDeletionStatus, limit, offset int) ([]*dto.AccountDeletionResponse, error) {
+	deletions, err := s.deletionRepo.GetByStatus(ctx, status, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to get account deletions", "error", err)
+		return nil, errors.New("failed to get account deletions")
+	}
+
+	responses := make([]*dto.AccountDeletionResponse, len(deletions))
+	for i, deletion := range deletions {
+		responses[i] = s.mapDeletionToResponse(deletion)
+	}
+	return responses, nil
+}
+
+func (s *accountService) GetDeletion(ctx context.Context, deletionID uint) (*dto.AccountDeletionResponse, error) {
+	deletion, err := s.getDeletion(ctx, deletionID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := s.mapDeletionToResponse(deletion)
+	response.Report = &deletion.Report
+	return response, nil
+}
+
+func (s *accountService) CancelDeletion(ctx context.Context, adminID, deletionID uint) (*dto.AccountDeletionResponse, error) {
+	deletion, err := s.getDeletion(ctx, deletionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if deletion.Status != entities.AccountDeletionScheduled {
+		return nil, errors.New("account deletion cannot be cancelled")
+	}
+
+	if err := s.userRepo.Restore(ctx, deletion.UserID); err != nil {
+		s.logger.Error("Failed to restore user", "error", err, "user_id", deletion.UserID)
+		return nil, errors.New("failed to cancel account deletion")
+	}
+
+	now := time.Now()
+	deletion.Status = entities.AccountDeletionCancelled
+	deletion.CancelledBy = &adminID
+	deletion.CancelledAt = &now
+
+	if err := s.deletionRepo.Update(ctx, deletion); err != nil {
+		s.logger.Error("Failed to cancel account deletion", "error", err)
+		return nil, errors.New("failed to cancel account deletion")
+	}
+
+	s.logger.Info("Account deletion cancelled", "deletion_id", deletion.ID, "user_id", deletion.UserID, "admin_id", adminID)
+
+	return s.mapDeletionToResponse(deletion), nil
+}
+
+func (s *accountService) VerifyDeletion(ctx context.Context, deletionID uint) (*dto.DeletionVerificationResponse, error) {
+	deletion, err := s.getDeletion(ctx, deletionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if deletion.Status != entities.AccountDeletionCompleted {
+		return nil, errors.New("account deletion not completed")
+	}
+
+	residual, err := s.deletionRepo.CountResidualData(ctx, deletion.UserID)
+	if err != nil {
+		s.logger.Error("Failed to count residual data", "error", err, "user_id", deletion.UserID)
+		return nil, errors.New("failed to verify account deletion")
+	}
+
+	verified := true
+	for _, count := range residual {
+		if count > 0 {
+			verified = false
+		}
+	}
+
+	return &dto.DeletionVerificationResponse{
+		DeletionID:  deletion.ID,
+		Digest:      deletion.Report.Digest,
+		DigestValid: deletion.Report.Digest != "" && deletion.Report.Digest == deletion.Report.ComputeDigest(deletion.UserID),
+		Verified:    verified,
+		Residual:    residual,
+		CheckedAt:   time.Now(),
+	}, nil
+}
+
+func (s *accountService) getDeletion(ctx context.Context, deletionID uint) (*entities.AccountDeletion, error) {
+	deletion, err := s.deletionRepo.GetByID(ctx, deletionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("account deletion not found")
+		}
+		s.logger.Error("Failed to get account deletion", "error", err)
+		return nil, errors.New("failed to get account deletion")
+	}
+	return deletion, nil
+}
+
+func (s *accountService) mapDeletionToResponse(deletion *entities.AccountDeletion) *dto.AccountDeletionResponse {
+	return &dto.AccountDeletionResponse{
+		ID:             deletion.ID,
+		UserID:         deletion.UserID,
+		Status:         deletion.Status,
+		Reason:         deletion.Reason,
+		RequestedAt:    deletion.RequestedAt,
+		PurgeAfter:     deletion.PurgeAfter,
+		CancelledAt:    deletion.CancelledAt,
+		StartedAt:      deletion.StartedAt,
+		CompletedAt:    deletion.CompletedAt,
+		CompletedStage: deletion.CompletedStage,
+		Attempts:       deletion.Attempts,
+		LastError:      deletion.LastError,
+	}
+}
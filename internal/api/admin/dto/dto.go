@@ -0,0 +1,81 @@
+package dto
+
+import (
+	authDto "linked-clone/internal/api/auth/dto"
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type SuspendUserRequest struct {
+	Reason string `json:"reason" validate:"required,min=3,max=1000"`
+}
+
+type UnsuspendUserRequest struct {
+	Reason string `json:"reason" validate:"max=1000"`
+}
+
+type ForcePasswordResetRequest struct {
+	Reason string `json:"reason" validate:"required,min=3,max=1000"`
+}
+
+type UserSummaryResponse struct {
+	ID            uint          `json:"id"`
+	Email         string        `json:"email"`
+	Username      string        `json:"username"`
+	FullName      string        `json:"full_name"`
+	Plan          entities.Plan `json:"plan"`
+	IsAdmin       bool          `json:"is_admin"`
+	EmailVerified bool          `json:"email_verified"`
+	Suspended     bool          `json:"suspended"`
+	SuspendedAt   *time.Time    `json:"suspended_at,omitempty"`
+	LastActiveAt  *time.Time    `json:"last_active_at,omitempty"`
+	CreatedAt     time.Time     `json:"created_at"`
+}
+
+type UserListResponse struct {
+	Users []*UserSummaryResponse `json:"users"`
+	Total int64                  `json:"total"`
+}
+
+type SessionSummaryResponse struct {
+	Active        int64                      `json:"active"`
+	LastSessionAt *time.Time                 `json:"last_session_at,omitempty"`
+	Recent        []*authDto.SessionResponse `json:"recent"`
+}
+
+type ActivitySummaryResponse struct {
+	Posts        int64 `json:"posts"`
+	Comments     int64 `json:"comments"`
+	Jobs         int64 `json:"jobs"`
+	Applications int64 `json:"applications"`
+	Connections  int64 `json:"connections"`
+	APIKeys      int64 `json:"api_keys"`
+}
+
+type UserDetailResponse struct {
+	*UserSummaryResponse
+	SuspensionReason string                           `json:"suspension_reason,omitempty"`
+	TwoFactorEnabled bool                             `json:"two_factor_enabled"`
+	IsVerified       bool                             `json:"is_verified"`
+	InvitedByID      *uint                            `json:"invited_by_id,omitempty"`
+	Sessions         *SessionSummaryResponse          `json:"sessions"`
+	Activity         *ActivitySummaryResponse         `json:"activity"`
+	SecurityEvents   []*authDto.SecurityEventResponse `json:"security_events"`
+}
+
+type AuditLogResponse struct {
+	ID           uint                   `json:"id"`
+	ActorID      uint                   `json:"actor_id"`
+	TargetUserID *uint                  `json:"target_user_id,omitempty"`
+	Action       entities.AdminAction   `json:"action"`
+	Reason       string                 `json:"reason,omitempty"`
+	Details      map[string]interface{} `json:"details,omitempty"`
+	IPAddress    string                 `json:"ip_address,omitempty"`
+	UserAgent    string                 `json:"user_agent,omitempty"`
+	CreatedAt    time.Time              `json:"created_at"`
+}
+
+type AuditLogListResponse struct {
+	Entries []*AuditLogResponse `json:"entries"`
+	Total   int64               `json:"total"`
+}
@@ -0,0 +1,222 @@
+package handler
+
+import (
+	"context"
+	"linked-clone/internal/api/admin/dto"
+	"linked-clone/internal/api/admin/service"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/request"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AdminHandler struct {
+	adminService service.AdminService
+	validator    validation.Validator
+	logger       logger.Logger
+}
+
+func NewAdminHandler(adminService service.AdminService, validator validation.Validator, logger logger.Logger) *AdminHandler {
+	return &AdminHandler{
+		adminService: adminService,
+		validator:    validator,
+		logger:       logger,
+	}
+}
+
+func (h *AdminHandler) GetUsers(c *gin.Context) {
+	limit, offset := request.Pagination(c)
+
+	status := c.Query("status")
+	switch status {
+	case "", "active", "suspended", "admin":
+	default:
+		response.Error(c, http.StatusBadRequest, "Invalid status", "status must be active, suspended or admin")
+		return
+	}
+
+	filter := entities.AdminUserFilter{Query: c.Query("q"), Status: status}
+
+	ctx := context.WithValue(c.Request.Context(), "gin_context", c)
+
+	users, err := h.adminService.ListUsers(ctx, middleware.GetUserID(c), filter, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get users", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get users", err.Error())
+		return
+	}
+
+	response.Success(c, users)
+}
+
+func (h *AdminHandler) GetUser(c *gin.Context) {
+	actorID := middleware.GetUserID(c)
+
+	userID, ok := request.ParseID(c, "id", "Invalid user ID")
+	if !ok {
+		return
+	}
+
+	ctx := context.WithValue(c.Request.Context(), "gin_context", c)
+
+	user, err := h.adminService.GetUser(ctx, actorID, userID)
+	if err != nil {
+		h.logger.Error("Failed to get user", "error", err)
+		response.Error(c, adminErrorStatus(err), "Failed to get user", err.Error())
+		return
+	}
+
+	response.Success(c, user)
+}
+
+func (h *AdminHandler) SuspendUser(c *gin.Context) {
+	actorID := middleware.GetUserID(c)
+
+	userID, ok := request.ParseID(c, "id", "Invalid user ID")
+	if !ok {
+		return
+	}
+
+	var req dto.SuspendUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	ctx := context.WithValue(c.Request.Context(), "gin_context", c)
+
+	if err := h.adminService.SuspendUser(ctx, actorID, userID, &req); err != nil {
+		h.logger.Error("Failed to suspend user", "error", err)
+		response.Error(c, adminErrorStatus(err), "Failed to suspend user", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "User suspended"})
+}
+
+func (h *AdminHandler) UnsuspendUser(c *gin.Context) {
+	actorID := middleware.GetUserID(c)
+
+	userID, ok := request.ParseID(c, "id", "Invalid user ID")
+	if !ok {
+		return
+	}
+
+	// The reason is optional here, so an empty body is accepted.
+	var req dto.UnsuspendUserRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+			return
+		}
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	ctx := context.WithValue(c.Request.Context(), "gin_context", c)
+
+	if err := h.adminService.UnsuspendUser(ctx, actorID, userID, &req); err != nil {
+		h.logger.Error("Failed to unsuspend user", "error", err)
+		response.Error(c, adminErrorStatus(err), "Failed to unsuspend user", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "User unsuspended"})
+}
+
+func (h *AdminHandler) ForcePasswordReset(c *gin.Context) {
+	actorID := middleware.GetUserID(c)
+
+	userID, ok := request.ParseID(c, "id", "Invalid user ID")
+	if !ok {
+		return
+	}
+
+	var req dto.ForcePasswordResetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	ctx := context.WithValue(c.Request.Context(), "gin_context", c)
+
+	if err := h.adminService.ForcePasswordReset(ctx, actorID, userID, &req); err != nil {
+		h.logger.Error("Failed to force password reset", "error", err)
+		response.Error(c, adminErrorStatus(err), "Failed to force password reset", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "User signed out and sent a password reset code"})
+}
+
+func (h *AdminHandler) GetUserAuditLog(c *gin.Context) {
+	userID, ok := request.ParseID(c, "id", "Invalid user ID")
+	if !ok {
+		return
+	}
+	limit, offset := request.Pagination(c)
+
+	entries, err := h.adminService.GetAuditLog(c.Request.Context(), 0, userID, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get audit log", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get audit log", err.Error())
+		return
+	}
+
+	response.Success(c, entries)
+}
+
+func (h *AdminHandler) GetAuditLog(c *gin.Context) {
+	limit, offset := request.Pagination(c)
+
+	var actorID uint
+	if raw := c.Query("actor_id"); raw != "" {
+		id, err := strconv.ParseUint(raw, 10, 32)
+		if err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid actor ID", err.Error())
+			return
+		}
+		actorID = uint(id)
+	}
+
+	entries, err := h.adminService.GetAuditLog(c.Request.Context(), actorID, 0, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get audit log", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get audit log", err.Error())
+		return
+	}
+
+	response.Success(c, entries)
+}
+
+func adminErrorStatus(err error) int {
+	switch err.Error() {
+	case "user not found":
+		return http.StatusNotFound
+	case "cannot suspend your own account", "cannot suspend an admin":
+		return http.StatusForbidden
+	case "user is already suspended", "user is not suspended":
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type adminAuditLogRepository struct {
+	db *gorm.DB
+}
+
+func NewAdminAuditLogRepository(db *gorm.DB) repositories.AdminAuditLogRepository {
+	return &adminAuditLogRepository{db: db}
+}
+
+func (r *adminAuditLogRepository) Create(ctx context.Context, entry *entities.AdminAuditLog) error {
+	return r.db.WithContext(ctx).Create(entry).Error
+}
+
+func (r *adminAuditLogRepository) List(ctx context.Context, actorID, targetUserID uint, limit, offset int) ([]*entities.AdminAuditLog, int64, error) {
+	var entries []*entities.AdminAuditLog
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&entities.AdminAuditLog{})
+	if actorID != 0 {
+		query = query.Where("actor_id = ?", actorID)
+	}
+	if targetUserID != 0 {
+		query = query.Where("target_user_id = ?", targetUserID)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&entries).Error
+	return entries, total, err
+}
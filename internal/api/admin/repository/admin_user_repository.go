@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type adminUserRepository struct {
+	db *gorm.DB
+}
+
+func NewAdminUserRepository(db *gorm.DB) repositories.AdminUserRepository {
+	return &adminUserRepository{db: db}
+}
+
+func (r *adminUserRepository) Search(ctx context.Context, filter entities.AdminUserFilter, limit, offset int) ([]*entities.User, int64, error) {
+	var users []*entities.User
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&entities.User{})
+
+	if q := strings.TrimSpace(filter.Query); q != "" {
+		pattern := "%" + strings.ToLower(q) + "%"
+		query = query.Where("LOWER(email) LIKE ? OR LOWER(username) LIKE ? OR LOWER(full_name) LIKE ?", pattern, pattern, pattern)
+	}
+
+	switch filter.Status {
+	case "active":
+		query = query.Where("suspended_at IS NULL")
+	case "suspended":
+		query = query.Where("suspended_at IS NOT NULL")
+	case "admin":
+		query = query.Where("is_admin = ?", true)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&users).Error
+	return users, total, err
+}
+
+func (r *adminUserRepository) GetActivity(ctx context.Context, userID uint) (*entities.UserActivity, error) {
+	var activity entities.UserActivity
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT
+			(SELECT COUNT(*) FROM sessions WHERE user_id = ? AND status = ? AND expires_at > NOW() AND deleted_at IS NULL) AS active_sessions,
+			(SELECT MAX(COALESCE(last_used_at, created_at)) FROM sessions WHERE user_id = ? AND deleted_at IS NULL) AS last_session_at,
+			(SELECT COUNT(*) FROM posts WHERE user_id = ? AND deleted_at IS NULL) AS post_count,
+			(SELECT COUNT(*) FROM comments WHERE user_id = ? AND deleted_at IS NULL) AS comment_count,
+			(SELECT COUNT(*) FROM jobs WHERE user_id = ? AND deleted_at IS NULL) AS job_count,
+			(SELECT COUNT(*) FROM applications WHERE user_id = ? AND deleted_at IS NULL) AS application_count,
+			(SELECT COUNT(*) FROM connections WHERE (requester_id = ? OR addressee_id = ?) AND status = ? AND deleted_at IS NULL) AS connection_count,
+			(SELECT COUNT(*) FROM api_keys WHERE user_id = ? AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > NOW())) AS active_api_keys`,
+		userID, entities.SessionActive, userID, userID, userID, userID, userID, userID, userID, entities.ConnectionAccepted, userID).
+		Scan(&activity).Error
+	if err != nil {
+		return nil, err
+	}
+	return &activity, nil
+}
+
+func (r *adminUserRepository) Suspend(ctx context.Context, userID uint, reason string, suspendedAt time.Time, audit *entities.AdminAuditLog) error {
+	return r.updateAudited(ctx, userID, map[string]interface{}{
+		"suspended_at":      suspendedAt,
+		"suspension_reason": reason,
+	}, audit)
+}
+
+func (r *adminUserRepository) Unsuspend(ctx context.Context, userID uint, audit *entities.AdminAuditLog) error {
+	return r.updateAudited(ctx, userID, map[string]interface{}{
+		"suspended_at":      nil,
+		"suspension_reason": "",
+	}, audit)
+}
+
+func (r *adminUserRepository) updateAudited(ctx context.Context, userID uint, columns map[string]interface{}, audit *entities.AdminAuditLog) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entities.User{}).
+			Where("id = ?", userID).
+			UpdateColumns(columns)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Create(audit).Error
+	})
+}
@@ -0,0 +1,305 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/admin/dto"
+	authService "linked-clone/internal/api/auth/service"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/auth"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/request"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// recentLimit is how many sessions and security events the user page
+// shows.
+const recentLimit = 10
+
+type AdminService interface {
+	ListUsers(ctx context.Context, actorID uint, filter entities.AdminUserFilter, limit, offset int) (*dto.UserListResponse, error)
+	GetUser(ctx context.Context, actorID, userID uint) (*dto.UserDetailResponse, error)
+	SuspendUser(ctx context.Context, actorID, userID uint, req *dto.SuspendUserRequest) error
+	UnsuspendUser(ctx context.Context, actorID, userID uint, req *dto.UnsuspendUserRequest) error
+	ForcePasswordReset(ctx context.Context, actorID, userID uint, req *dto.ForcePasswordResetRequest) error
+	GetAuditLog(ctx context.Context, actorID, targetUserID uint, limit, offset int) (*dto.AuditLogListResponse, error)
+	Audit(ctx context.Context, actorID, targetUserID uint, action entities.AdminAction, reason string, details map[string]interface{})
+}
+
+type adminService struct {
+	userRepo      repositories.UserRepository
+	adminUserRepo repositories.AdminUserRepository
+	auditRepo     repositories.AdminAuditLogRepository
+	authSvc       authService.AuthService
+	securitySvc   authService.SecurityService
+	jwtService    auth.JWTService
+	logger        logger.Logger
+}
+
+func NewAdminService(
+	userRepo repositories.UserRepository,
+	adminUserRepo repositories.AdminUserRepository,
+	auditRepo repositories.AdminAuditLogRepository,
+	authSvc authService.AuthService,
+	securitySvc authService.SecurityService,
+	jwtService auth.JWTService,
+	logger logger.Logger,
+) AdminService {
+	return &adminService{
+		userRepo:      userRepo,
+		adminUserRepo: adminUserRepo,
+		auditRepo:     auditRepo,
+		authSvc:       authSvc,
+		securitySvc:   securitySvc,
+		jwtService:    jwtService,
+		logger:        logger,
+	}
+}
+
+// ListUsers is audited because a search can turn up accounts by email. The
+// results are only returned once the search is recorded.
+func (s *adminService) ListUsers(ctx context.Context, actorID uint, filter entities.AdminUserFilter, limit, offset int) (*dto.UserListResponse, error) {
+	users, total, err := s.adminUserRepo.Search(ctx, filter, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to search users", "error", err)
+		return nil, errors.New("failed to get users")
+	}
+
+	entry := s.auditEntry(ctx, actorID, nil, entities.AdminActionUsersSearched, "", map[string]interface{}{
+		"query":  filter.Query,
+		"status": filter.Status,
+		"limit":  limit,
+		"offset": offset,
+		"total":  total,
+	})
+	if err := s.record(ctx, entry); err != nil {
+		return nil, errors.New("failed to get users")
+	}
+
+	responses := make([]*dto.UserSummaryResponse, 0, len(users))
+	for _, user := range users {
+		responses = append(responses, toUserSummary(user))
+	}
+	return &dto.UserListResponse{Users: responses, Total: total}, nil
+}
+
+// GetUser is audited like the changes below, since the page shows an
+// account's email, devices, locations and IP addresses. Nothing is shown
+// if the view cannot be recorded.
+func (s *adminService) GetUser(ctx context.Context, actorID, userID uint) (*dto.UserDetailResponse, error) {
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	activity, err := s.adminUserRepo.GetActivity(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get user activity", "error", err, "user_id", userID)
+		return nil, errors.New("failed to get user")
+	}
+
+	sessions, err := s.authSvc.GetUserActiveSessions(ctx, userID, 0, recentLimit, 0)
+	if err != nil {
+		s.logger.Error("Failed to get user sessions", "error", err, "user_id", userID)
+		return nil, errors.New("failed to get user")
+	}
+
+	events, err := s.securitySvc.GetActivity(ctx, userID, recentLimit, 0)
+	if err != nil {
+		return nil, errors.New("failed to get user")
+	}
+
+	if err := s.record(ctx, s.auditEntry(ctx, actorID, &userID, entities.AdminActionUserViewed, "", nil)); err != nil {
+		return nil, errors.New("failed to get user")
+	}
+
+	return &dto.UserDetailResponse{
+		UserSummaryResponse: toUserSummary(user),
+		SuspensionReason:    user.SuspensionReason,
+		TwoFactorEnabled:    user.TwoFactorEnabled,
+		IsVerified:          user.IsVerified,
+		InvitedByID:         user.InvitedByID,
+		Sessions: &dto.SessionSummaryResponse{
+			Active:        activity.ActiveSessions,
+			LastSessionAt: activity.LastSessionAt,
+			Recent:        sessions,
+		},
+		Activity: &dto.ActivitySummaryResponse{
+			Posts:        activity.PostCount,
+			Comments:     activity.CommentCount,
+			Jobs:         activity.JobCount,
+			Applications: activity.ApplicationCount,
+			Connections:  activity.ConnectionCount,
+			APIKeys:      activity.ActiveAPIKeys,
+		},
+		SecurityEvents: events,
+	}, nil
+}
+
+// SuspendUser blocks sign-in, session refresh and API keys and revokes the
+// user's sessions, which also rejects access tokens already issued for
+// them. Admins cannot be suspended; remove the role first.
+func (s *adminService) SuspendUser(ctx context.Context, actorID, userID uint, req *dto.SuspendUserRequest) error {
+	if actorID == userID {
+		return errors.New("cannot suspend your own account")
+	}
+
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.IsAdmin {
+		return errors.New("cannot suspend an admin")
+	}
+	if user.IsSuspended() {
+		return errors.New("user is already suspended")
+	}
+
+	entry := s.auditEntry(ctx, actorID, &userID, entities.AdminActionUserSuspended, req.Reason, nil)
+	if err := s.adminUserRepo.Suspend(ctx, userID, req.Reason, time.Now(), entry); err != nil {
+		s.logger.Error("Failed to suspend user", "error", err, "user_id", userID)
+		return errors.New("failed to suspend user")
+	}
+
+	if err := s.jwtService.RevokeUserSessions(ctx, userID); err != nil {
+		s.logger.Error("Failed to revoke sessions of suspended user", "error", err, "user_id", userID)
+	}
+
+	userAgent, ipAddress := request.ClientInfo(ctx)
+	s.securitySvc.Record(ctx, userID, entities.SecurityEventSuspended, userAgent, ipAddress)
+	return nil
+}
+
+func (s *adminService) UnsuspendUser(ctx context.Context, actorID, userID uint, req *dto.UnsuspendUserRequest) error {
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !user.IsSuspended() {
+		return errors.New("user is not suspended")
+	}
+
+	entry := s.auditEntry(ctx, actorID, &userID, entities.AdminActionUserUnsuspended, req.Reason, map[string]interface{}{
+		"suspended_at":      user.SuspendedAt,
+		"suspension_reason": user.SuspensionReason,
+	})
+	if err := s.adminUserRepo.Unsuspend(ctx, userID, entry); err != nil {
+		s.logger.Error("Failed to unsuspend user", "error", err, "user_id", userID)
+		return errors.New("failed to unsuspend user")
+	}
+
+	userAgent, ipAddress := request.ClientInfo(ctx)
+	s.securitySvc.Record(ctx, userID, entities.SecurityEventUnsuspended, userAgent, ipAddress)
+	return nil
+}
+
+// ForcePasswordReset records the reset before making it: it revokes sessions
+// in Redis as well as clearing the password, so it cannot share a
+// transaction with the entry. If the reset then fails, the entry stands for
+// an attempt the admin is told failed.
+func (s *adminService) ForcePasswordReset(ctx context.Context, actorID, userID uint, req *dto.ForcePasswordResetRequest) error {
+	if _, err := s.getUser(ctx, userID); err != nil {
+		return err
+	}
+
+	if err := s.record(ctx, s.auditEntry(ctx, actorID, &userID, entities.AdminActionPasswordResetForced, req.Reason, nil)); err != nil {
+		return errors.New("failed to force password reset")
+	}
+
+	return s.securitySvc.ForcePasswordReset(ctx, userID)
+}
+
+func (s *adminService) GetAuditLog(ctx context.Context, actorID, targetUserID uint, limit, offset int) (*dto.AuditLogListResponse, error) {
+	entries, total, err := s.auditRepo.List(ctx, actorID, targetUserID, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list admin audit log", "error", err)
+		return nil, errors.New("failed to get audit log")
+	}
+
+	responses := make([]*dto.AuditLogResponse, 0, len(entries))
+	for _, entry := range entries {
+		resp := &dto.AuditLogResponse{
+			ID:           entry.ID,
+			ActorID:      entry.ActorID,
+			TargetUserID: entry.TargetUserID,
+			Action:       entry.Action,
+			Reason:       entry.Reason,
+			Details:      entry.Details,
+			CreatedAt:    entry.CreatedAt,
+		}
+		if entry.IPAddress != nil {
+			resp.IPAddress = *entry.IPAddress
+		}
+		if entry.UserAgent != nil {
+			resp.UserAgent = *entry.UserAgent
+		}
+		responses = append(responses, resp)
+	}
+	return &dto.AuditLogListResponse{Entries: responses, Total: total}, nil
+}
+
+// Audit writes the trail entry for a moderation action that already
+// happened. A failed write cannot undo the action, so the entry goes to the
+// error log in full instead. The admin actions above record their entry
+// before or together with the change, and fail without it.
+func (s *adminService) Audit(ctx context.Context, actorID, targetUserID uint, action entities.AdminAction, reason string, details map[string]interface{}) {
+	s.record(ctx, s.auditEntry(ctx, actorID, &targetUserID, action, reason, details))
+}
+
+func (s *adminService) auditEntry(ctx context.Context, actorID uint, targetUserID *uint, action entities.AdminAction, reason string, details map[string]interface{}) *entities.AdminAuditLog {
+	entry := &entities.AdminAuditLog{
+		ActorID:      actorID,
+		TargetUserID: targetUserID,
+		Action:       action,
+		Reason:       reason,
+		Details:      details,
+	}
+	userAgent, ipAddress := request.ClientInfo(ctx)
+	if userAgent != "" {
+		entry.UserAgent = &userAgent
+	}
+	if ipAddress != "" {
+		entry.IPAddress = &ipAddress
+	}
+	return entry
+}
+
+func (s *adminService) record(ctx context.Context, entry *entities.AdminAuditLog) error {
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		s.logger.Error("Failed to write admin audit log", "error", err,
+			"actor_id", entry.ActorID, "target_user_id", entry.TargetUserID, "action", entry.Action, "reason", entry.Reason)
+		return err
+	}
+	return nil
+}
+
+func (s *adminService) getUser(ctx context.Context, userID uint) (*entities.User, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("user not found")
+		}
+		s.logger.Error("Failed to get user", "error", err, "user_id", userID)
+		return nil, errors.New("failed to get user")
+	}
+	return user, nil
+}
+
+func toUserSummary(user *entities.User) *dto.UserSummaryResponse {
+	return &dto.UserSummaryResponse{
+		ID:            user.ID,
+		Email:         user.Email,
+		Username:      user.Username,
+		FullName:      user.FullName,
+		Plan:          user.Plan,
+		IsAdmin:       user.IsAdmin,
+		EmailVerified: user.EmailVerified,
+		Suspended:     user.IsSuspended(),
+		SuspendedAt:   user.SuspendedAt,
+		LastActiveAt:  user.LastActiveAt,
+		CreatedAt:     user.CreatedAt,
+	}
+}
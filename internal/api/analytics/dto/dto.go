@@ -0,0 +1,19 @@
+package dto
+
+import "time"
+
+type DailyViews struct {
+	Day           time.Time `json:"day"`
+	UniqueViewers int64     `json:"unique_viewers"`
+	TotalViews    int64     `json:"total_views"`
+}
+
+type ViewStatsResponse struct {
+	EntityType    string        `json:"entity_type"`
+	EntityID      uint          `json:"entity_id"`
+	From          time.Time     `json:"from"`
+	To            time.Time     `json:"to"`
+	UniqueViewers int64         `json:"unique_viewers"`
+	TotalViews    int64         `json:"total_views"`
+	Daily         []*DailyViews `json:"daily"`
+}
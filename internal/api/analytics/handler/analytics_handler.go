@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"linked-clone/internal/api/analytics/service"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AnalyticsHandler struct {
+	analyticsService service.AnalyticsService
+	logger           logger.Logger
+}
+
+func NewAnalyticsHandler(analyticsService service.AnalyticsService, logger logger.Logger) *AnalyticsHandler {
+	return &AnalyticsHandler{
+		analyticsService: analyticsService,
+		logger:           logger,
+	}
+}
+
+func (h *AnalyticsHandler) GetPostViews(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	idStr := c.Param("id")
+	postID, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid post ID", err.Error())
+		return
+	}
+
+	days, _ := strconv.Atoi(c.DefaultQuery("days", "30"))
+
+	stats, err := h.analyticsService.GetPostViews(c.Request.Context(), userID, uint(postID), days)
+	if err != nil {
+		h.logger.Error("Failed to get post views", "error", err)
+
+		switch err.Error() {
+		case "post not found":
+			response.Error(c, http.StatusNotFound, "Post not found", "")
+		case "unauthorized to view post analytics":
+			response.Error(c, http.StatusForbidden, "Not allowed to view post analytics", "")
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to get post views", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, stats)
+}
+
+func (h *AnalyticsHandler) GetJobViews(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	idStr := c.Param("id")
+	jobID, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid job ID", err.Error())
+		return
+	}
+
+	days, _ := strconv.Atoi(c.DefaultQuery("days", "30"))
+
+	stats, err := h.analyticsService.GetJobViews(c.Request.Context(), userID, uint(jobID), days)
+	if err != nil {
+		h.logger.Error("Failed to get job views", "error", err)
+
+		switch err.Error() {
+		case "job not found":
+			response.Error(c, http.StatusNotFound, "Job not found", "")
+		case "unauthorized to view job analytics":
+			response.Error(c, http.StatusForbidden, "Not allowed to view job analytics", "")
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to get job views", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, stats)
+}
+
+func (h *AnalyticsHandler) GetProfileViews(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	days, _ := strconv.Atoi(c.DefaultQuery("days", "30"))
+
+	stats, err := h.analyticsService.GetProfileViews(c.Request.Context(), userID, days)
+	if err != nil {
+		h.logger.Error("Failed to get profile views", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get profile views", err.Error())
+		return
+	}
+
+	response.Success(c, stats)
+}
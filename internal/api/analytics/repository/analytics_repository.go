@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type analyticsRepository struct {
+	db *gorm.DB
+}
+
+func NewAnalyticsRepository(db *gorm.DB) repositories.AnalyticsRepository {
+	return &analyticsRepository{db: db}
+}
+
+func (r *analyticsRepository) Create(ctx context.Context, event *entities.AnalyticsEvent) error {
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+	return r.db.WithContext(ctx).Create(event).Error
+}
+
+func (r *analyticsRepository) CreateBatch(ctx context.Context, events []*entities.AnalyticsEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	for _, event := range events {
+		if event.CreatedAt.IsZero() {
+			event.CreatedAt = now
+		}
+	}
+
+	return r.db.WithContext(ctx).CreateInBatches(events, 500).Error
+}
+
+func (r *analyticsRepository) GetByRange(ctx context.Context, eventType string, from, to time.Time, limit, offset int) ([]*entities.AnalyticsEvent, error) {
+	query := r.db.WithContext(ctx).
+		Where("created_at >= ? AND created_at < ?", from, to)
+
+	if eventType != "" {
+		query = query.Where("event_type = ?", eventType)
+	}
+
+	var events []*entities.AnalyticsEvent
+	err := query.
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&events).Error
+	return events, err
+}
+
+func (r *analyticsRepository) CountByType(ctx context.Context, from, to time.Time) (map[string]int64, error) {
+	var rows []struct {
+		EventType string
+		Count     int64
+	}
+
+	err := r.db.WithContext(ctx).Model(&entities.AnalyticsEvent{}).
+		Select("event_type, COUNT(*) AS count").
+		Where("created_at >= ? AND created_at < ?", from, to).
+		Group("event_type").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.EventType] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *analyticsRepository) ArchiveOlderThan(ctx context.Context, cutoff time.Time, batchSize int) (int64, error) {
+	result := r.db.WithContext(ctx).Exec(`
+		WITH batch AS (
+			SELECT id, created_at FROM analytics_events
+			WHERE created_at < ?
+			ORDER BY created_at
+			LIMIT ?
+		), moved AS (
+			DELETE FROM analytics_events e
+			USING batch b
+			WHERE e.id = b.id AND e.created_at = b.created_at
+			RETURNING e.*
+		)
+		INSERT INTO analytics_events_archive
+			(id, user_id, event_type, entity_type, entity_id, metadata, created_at, archived_at)
+		SELECT id, user_id, event_type, entity_type, entity_id, metadata, created_at, NOW()
+		FROM moved`, cutoff, batchSize)
+	return result.RowsAffected, result.Error
+}
+
+func (r *analyticsRepository) DeleteOlderThan(ctx context.Context, cutoff time.Time, batchSize int) (int64, error) {
+	result := r.db.WithContext(ctx).Exec(`
+		DELETE FROM analytics_events e
+		USING (
+			SELECT id, created_at FROM analytics_events
+			WHERE created_at < ?
+			ORDER BY created_at
+			LIMIT ?
+		) b
+		WHERE e.id = b.id AND e.created_at = b.created_at`, cutoff, batchSize)
+	return result.RowsAffected, result.Error
+}
+
+func (r *analyticsRepository) UpsertViewRollups(ctx context.Context, rollups []*entities.ViewRollup) error {
+	if len(rollups) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	for _, rollup := range rollups {
+		rollup.UpdatedAt = now
+	}
+
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "entity_type"}, {Name: "entity_id"}, {Name: "day"}},
+			DoUpdates: clause.AssignmentColumns([]string{"unique_viewers", "total_views", "updated_at"}),
+		}).
+		CreateInBatches(rollups, 500).Error
+}
+
+func (r *analyticsRepository) GetViewRollups(ctx context.Context, entityType string, entityID uint, from, to time.Time) ([]*entities.ViewRollup, error) {
+	var rollups []*entities.ViewRollup
+	err := r.db.WithContext(ctx).
+		Where("entity_type = ? AND entity_id = ? AND day >= ? AND day <= ?", entityType, entityID, from, to).
+		Order("day ASC").
+		Find(&rollups).Error
+	return rollups, err
+}
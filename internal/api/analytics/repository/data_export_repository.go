@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// exportQueries select only identifiers, types and timestamps from each
+// source; free text and event metadata never leave the database.
+var exportQueries = map[string]string{
+	"analytics_events": `SELECT user_id, event_type, COALESCE(entity_type, '') AS entity_type, entity_id, created_at AS occurred_at
+		FROM analytics_events WHERE created_at >= ? AND created_at < ? ORDER BY created_at`,
+	"reactions": `SELECT user_id, type AS event_type, 'post' AS entity_type, post_id AS entity_id, created_at AS occurred_at
+		FROM reactions WHERE deleted_at IS NULL AND created_at >= ? AND created_at < ? ORDER BY created_at`,
+	"comments": `SELECT user_id, CASE WHEN parent_comment_id IS NULL THEN 'comment' ELSE 'reply' END AS event_type, 'post' AS entity_type, post_id AS entity_id, created_at AS occurred_at
+		FROM comments WHERE deleted_at IS NULL AND created_at >= ? AND created_at < ? ORDER BY created_at`,
+	"connections": `SELECT requester_id AS user_id, 'connection_' || status AS event_type, 'user' AS entity_type, addressee_id AS entity_id, requested_at AS occurred_at
+		FROM connections WHERE deleted_at IS NULL AND requested_at >= ? AND requested_at < ? ORDER BY requested_at`,
+	"applications": `SELECT user_id, 'job_application' AS event_type, 'job' AS entity_type, job_id AS entity_id, applied_at AS occurred_at
+		FROM applications WHERE deleted_at IS NULL AND applied_at >= ? AND applied_at < ? ORDER BY applied_at`,
+}
+
+type dataExportRepository struct {
+	db *gorm.DB
+}
+
+func NewDataExportRepository(db *gorm.DB) repositories.DataExportRepository {
+	return &dataExportRepository{db: db}
+}
+
+// Claim takes a day/source slot for this run. Failed slots can be claimed
+// again so the next run retries them.
+func (r *dataExportRepository) Claim(ctx context.Context, run *entities.DataExportRun) (bool, error) {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "day"}, {Name: "source"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"status":       run.Status,
+			"row_count":    0,
+			"error":        "",
+			"started_at":   run.StartedAt,
+			"completed_at": nil,
+		}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Eq{Column: clause.Column{Table: "data_export_runs", Name: "status"}, Value: entities.DataExportRunFailed},
+		}},
+	}).Create(run)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *dataExportRepository) Update(ctx context.Context, run *entities.DataExportRun) error {
+	return r.db.WithContext(ctx).Save(run).Error
+}
+
+func (r *dataExportRepository) StreamRecords(ctx context.Context, source string, from, to time.Time, fn func(record *entities.DataExportRecord) error) error {
+	query, ok := exportQueries[source]
+	if !ok {
+		return fmt.Errorf("unknown export source %q", source)
+	}
+
+	rows, err := r.db.WithContext(ctx).Raw(query, from, to).Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record entities.DataExportRecord
+		if err := r.db.ScanRows(rows, &record); err != nil {
+			return err
+		}
+		if err := fn(&record); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/analytics/dto"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/counter"
+	"linked-clone/pkg/logger"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const maxViewStatsDays = 90
+
+type AnalyticsService interface {
+	GetPostViews(ctx context.Context, userID, postID uint, days int) (*dto.ViewStatsResponse, error)
+	GetProfileViews(ctx context.Context, userID uint, days int) (*dto.ViewStatsResponse, error)
+	GetJobViews(ctx context.Context, userID, jobID uint, days int) (*dto.ViewStatsResponse, error)
+}
+
+type analyticsService struct {
+	analyticsRepo repositories.AnalyticsRepository
+	postRepo      repositories.PostRepository
+	jobRepo       repositories.JobRepository
+	viewCounter   counter.ViewCounter
+	logger        logger.Logger
+}
+
+func NewAnalyticsService(
+	analyticsRepo repositories.AnalyticsRepository,
+	postRepo repositories.PostRepository,
+	jobRepo repositories.JobRepository,
+	viewCounter counter.ViewCounter,
+	logger logger.Logger,
+) AnalyticsService {
+	return &analyticsService{
+		analyticsRepo: analyticsRepo,
+		postRepo:      postRepo,
+		jobRepo:       jobRepo,
+		viewCounter:   viewCounter,
+		logger:        logger,
+	}
+}
+
+func (s *analyticsService) GetPostViews(ctx context.Context, userID, postID uint, days int) (*dto.ViewStatsResponse, error) {
+	post, err := s.postRepo.GetByID(ctx, postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("post not found")
+		}
+		s.logger.Error("Failed to get post", "error", err)
+		return nil, errors.New("failed to get post")
+	}
+
+	if post.UserID != userID {
+		return nil, errors.New("unauthorized to view post analytics")
+	}
+
+	return s.getViewStats(ctx, counter.ViewPost, postID, days)
+}
+
+func (s *analyticsService) GetProfileViews(ctx context.Context, userID uint, days int) (*dto.ViewStatsResponse, error) {
+	return s.getViewStats(ctx, counter.ViewProfile, userID, days)
+}
+
+func (s *analyticsService) GetJobViews(ctx context.Context, userID, jobID uint, days int) (*dto.ViewStatsResponse, error) {
+	job, err := s.jobRepo.GetByID(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("job not found")
+		}
+		s.logger.Error("Failed to get job", "error", err)
+		return nil, errors.New("failed to get job")
+	}
+
+	if job.UserID != userID {
+		return nil, errors.New("unauthorized to view job analytics")
+	}
+
+	return s.getViewStats(ctx, counter.ViewJob, jobID, days)
+}
+
+func (s *analyticsService) getViewStats(ctx context.Context, kind counter.ViewKind, entityID uint, days int) (*dto.ViewStatsResponse, error) {
+	if days <= 0 || days > maxViewStatsDays {
+		days = 30
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	from := today.AddDate(0, 0, -(days - 1))
+
+	rollups, err := s.analyticsRepo.GetViewRollups(ctx, string(kind), entityID, from, today)
+	if err != nil {
+		s.logger.Error("Failed to get view rollups", "error", err)
+		return nil, errors.New("failed to get view statistics")
+	}
+
+	byDay := make(map[string]*dto.DailyViews, len(rollups))
+	for _, rollup := range rollups {
+		day := rollup.Day.UTC().Truncate(24 * time.Hour)
+		byDay[day.Format("2006-01-02")] = &dto.DailyViews{
+			Day:           day,
+			UniqueViewers: rollup.UniqueViewers,
+			TotalViews:    rollup.TotalViews,
+		}
+	}
+
+	live, err := s.viewCounter.Get(ctx, kind, entityID, today)
+	if err != nil {
+		s.logger.Error("Failed to get live view count", "error", err)
+	} else if current, ok := byDay[today.Format("2006-01-02")]; !ok || live.Total > current.TotalViews {
+		byDay[today.Format("2006-01-02")] = &dto.DailyViews{
+			Day:           today,
+			UniqueViewers: live.Unique,
+			TotalViews:    live.Total,
+		}
+	}
+
+	stats := &dto.ViewStatsResponse{
+		EntityType: string(kind),
+		EntityID:   entityID,
+		From:       from,
+		To:         today,
+		Daily:      make([]*dto.DailyViews, 0, days),
+	}
+
+	for day := from; !day.After(today); day = day.AddDate(0, 0, 1) {
+		daily, ok := byDay[day.Format("2006-01-02")]
+		if !ok {
+			daily = &dto.DailyViews{Day: day}
+		}
+
+		stats.UniqueViewers += daily.UniqueViewers
+		stats.TotalViews += daily.TotalViews
+		stats.Daily = append(stats.Daily, daily)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type CreateAPIKeyRequest struct {
+	Name   string                 `json:"name" validate:"required,min=2,max=100"`
+	Scopes []entities.APIKeyScope `json:"scopes" validate:"required,min=1,dive,oneof=jobs:read jobs:write applications:read"`
+	// RateLimit is in requests per minute; zero takes the server default.
+	RateLimit     int `json:"rate_limit" validate:"omitempty,min=1"`
+	ExpiresInDays int `json:"expires_in_days" validate:"omitempty,min=1,max=365"`
+}
+
+type APIKeyResponse struct {
+	ID         uint                   `json:"id"`
+	Name       string                 `json:"name"`
+	Prefix     string                 `json:"prefix"`
+	Scopes     []entities.APIKeyScope `json:"scopes"`
+	RateLimit  int                    `json:"rate_limit"`
+	Active     bool                   `json:"active"`
+	LastUsedAt *time.Time             `json:"last_used_at,omitempty"`
+	ExpiresAt  *time.Time             `json:"expires_at,omitempty"`
+	RevokedAt  *time.Time             `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time              `json:"created_at"`
+}
+
+// CreatedAPIKeyResponse is the only response that carries the key itself;
+// it cannot be shown again.
+type CreatedAPIKeyResponse struct {
+	*APIKeyResponse
+	Key string `json:"key"`
+}
+
+// APIKeyUsageResponse is how many requests a key has made in the current
+// one-minute rate limit window and in the current month. MonthlyQuota is 0
+// and MonthlyRemaining absent when the owner's plan has no monthly quota.
+type APIKeyUsageResponse struct {
+	ID         uint       `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	RateLimit  int        `json:"rate_limit"`
+	Requests   int64      `json:"requests"`
+	Remaining  int64      `json:"remaining"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+
+	MonthlyQuota     int       `json:"monthly_quota"`
+	MonthlyRequests  int64     `json:"monthly_requests"`
+	MonthlyRemaining *int64    `json:"monthly_remaining,omitempty"`
+	MonthResetsAt    time.Time `json:"month_resets_at"`
+}
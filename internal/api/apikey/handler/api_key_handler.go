@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"context"
+	"linked-clone/internal/api/apikey/dto"
+	"linked-clone/internal/api/apikey/service"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+type APIKeyHandler struct {
+	apiKeyService service.APIKeyService
+	validator     validation.Validator
+	logger        logger.Logger
+}
+
+func NewAPIKeyHandler(apiKeyService service.APIKeyService, validator validation.Validator, logger logger.Logger) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyService: apiKeyService,
+		validator:     validator,
+		logger:        logger,
+	}
+}
+
+func (h *APIKeyHandler) CreateKey(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	ctx := context.WithValue(c.Request.Context(), "gin_context", c)
+
+	key, err := h.apiKeyService.CreateKey(ctx, userID, &req)
+	if err != nil {
+		switch {
+		case err.Error() == "api key limit reached":
+			response.Error(c, http.StatusConflict, "API key limit reached", "Revoke an unused key first")
+		case strings.HasPrefix(err.Error(), "rate limit must be"):
+			response.Error(c, http.StatusBadRequest, "Invalid rate limit", err.Error())
+		default:
+			h.logger.Error("Failed to create API key", "error", err)
+			response.Error(c, http.StatusInternalServerError, "Failed to create API key", err.Error())
+		}
+		return
+	}
+
+	response.CreatedWithMessage(c, "API key created; copy it now, it will not be shown again", key)
+}
+
+func (h *APIKeyHandler) GetKeys(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	keys, err := h.apiKeyService.ListKeys(c.Request.Context(), userID)
+	if err != nil {
+		h.logger.Error("Failed to get API keys", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get API keys", err.Error())
+		return
+	}
+
+	response.Success(c, keys)
+}
+
+func (h *APIKeyHandler) RevokeKey(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	keyID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid API key ID", err.Error())
+		return
+	}
+
+	ctx := context.WithValue(c.Request.Context(), "gin_context", c)
+
+	if err := h.apiKeyService.RevokeKey(ctx, userID, uint(keyID)); err != nil {
+		if err.Error() == "api key not found" {
+			response.NotFound(c, "API key not found")
+			return
+		}
+		h.logger.Error("Failed to revoke API key", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to revoke API key", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "API key revoked", nil)
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type apiKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewAPIKeyRepository(db *gorm.DB) repositories.APIKeyRepository {
+	return &apiKeyRepository{db: db}
+}
+
+func (r *apiKeyRepository) Create(ctx context.Context, key *entities.APIKey) error {
+	return r.db.WithContext(ctx).Create(key).Error
+}
+
+func (r *apiKeyRepository) GetByHash(ctx context.Context, keyHash string) (*entities.APIKey, error) {
+	var key entities.APIKey
+	// Keys of suspended or deleted accounts are treated as unknown.
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("key_hash = ?", keyHash).
+		Where("EXISTS (SELECT 1 FROM users WHERE users.id = api_keys.user_id AND users.suspended_at IS NULL AND users.deleted_at IS NULL)").
+		First(&key).Error
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+func (r *apiKeyRepository) ListByUser(ctx context.Context, userID uint) ([]*entities.APIKey, error) {
+	var keys []*entities.APIKey
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("user_id = ?", userID).
+		Order("revoked_at IS NOT NULL, created_at DESC").
+		Find(&keys).Error
+	return keys, err
+}
+
+func (r *apiKeyRepository) CountActiveByUser(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&entities.APIKey{}).
+		Where("user_id = ? AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > ?)", userID, time.Now()).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *apiKeyRepository) Revoke(ctx context.Context, userID, id uint, revokedAt time.Time) error {
+	result := r.db.WithContext(ctx).Model(&entities.APIKey{}).
+		Where("id = ? AND user_id = ? AND revoked_at IS NULL", id, userID).
+		Update("revoked_at", revokedAt)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *apiKeyRepository) UpdateLastUsedAt(ctx context.Context, id uint, lastUsedAt time.Time) error {
+	return r.db.WithContext(ctx).Model(&entities.APIKey{}).
+		Where("id = ?", id).
+		UpdateColumn("last_used_at", lastUsedAt).Error
+}
@@ -0,0 +1,322 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"linked-clone/internal/api/apikey/dto"
+	authService "linked-clone/internal/api/auth/service"
+	"linked-clone/internal/config"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/auth"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/redis"
+	"linked-clone/pkg/request"
+	"strconv"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// lastUsedResolution keeps busy keys from writing their last use on every
+// request.
+const lastUsedResolution = time.Minute
+
+type APIKeyService interface {
+	CreateKey(ctx context.Context, userID uint, req *dto.CreateAPIKeyRequest) (*dto.CreatedAPIKeyResponse, error)
+	ListKeys(ctx context.Context, userID uint) ([]*dto.APIKeyResponse, error)
+	RevokeKey(ctx context.Context, userID, keyID uint) error
+	// Usage reports the requests each of the user's active keys has made
+	// against its limit this minute and against its quota this month.
+	Usage(ctx context.Context, userID uint) ([]*dto.APIKeyUsageResponse, error)
+
+	// Authenticate resolves a key sent in X-API-Key. Revoked, expired and
+	// unknown keys are all reported as "invalid api key".
+	Authenticate(ctx context.Context, key string) (*entities.APIKey, error)
+	// Allow counts a request against the key's per-minute limit.
+	Allow(ctx context.Context, key *entities.APIKey) (bool, error)
+	// Meter counts a request against the key's monthly quota, which comes
+	// from its owner's plan. key must have been loaded by Authenticate.
+	Meter(ctx context.Context, key *entities.APIKey) (bool, error)
+}
+
+type apiKeyService struct {
+	keyRepo     repositories.APIKeyRepository
+	redisClient redis.RedisClient
+	securitySvc authService.SecurityService
+	config      config.APIKeyConfig
+	pepper      []byte
+	logger      logger.Logger
+}
+
+func NewAPIKeyService(
+	keyRepo repositories.APIKeyRepository,
+	redisClient redis.RedisClient,
+	securitySvc authService.SecurityService,
+	config config.APIKeyConfig,
+	pepper string,
+	logger logger.Logger,
+) APIKeyService {
+	return &apiKeyService{
+		keyRepo:     keyRepo,
+		redisClient: redisClient,
+		securitySvc: securitySvc,
+		config:      config,
+		pepper:      []byte(pepper),
+		logger:      logger,
+	}
+}
+
+func (s *apiKeyService) CreateKey(ctx context.Context, userID uint, req *dto.CreateAPIKeyRequest) (*dto.CreatedAPIKeyResponse, error) {
+	rateLimit := req.RateLimit
+	if rateLimit == 0 {
+		rateLimit = s.config.DefaultRateLimit
+	}
+	if rateLimit > s.config.MaxRateLimit {
+		return nil, fmt.Errorf("rate limit must be at most %d requests per minute", s.config.MaxRateLimit)
+	}
+
+	active, err := s.keyRepo.CountActiveByUser(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to count API keys", "error", err, "user_id", userID)
+		return nil, errors.New("failed to create api key")
+	}
+	if active >= int64(s.config.MaxPerUser) {
+		return nil, errors.New("api key limit reached")
+	}
+
+	plaintext, prefix, err := auth.GenerateAPIKey()
+	if err != nil {
+		s.logger.Error("Failed to generate API key", "error", err)
+		return nil, errors.New("failed to create api key")
+	}
+
+	key := &entities.APIKey{
+		UserID:    userID,
+		Name:      req.Name,
+		Prefix:    prefix,
+		KeyHash:   auth.HashAPIKey(s.pepper, plaintext),
+		Scopes:    uniqueScopes(req.Scopes),
+		RateLimit: rateLimit,
+	}
+	if req.ExpiresInDays > 0 {
+		expiresAt := time.Now().AddDate(0, 0, req.ExpiresInDays)
+		key.ExpiresAt = &expiresAt
+	}
+
+	if err := s.keyRepo.Create(ctx, key); err != nil {
+		s.logger.Error("Failed to create API key", "error", err, "user_id", userID)
+		return nil, errors.New("failed to create api key")
+	}
+
+	userAgent, ipAddress := request.ClientInfo(ctx)
+	s.securitySvc.Record(ctx, userID, entities.SecurityEventAPIKeyCreated, userAgent, ipAddress)
+
+	return &dto.CreatedAPIKeyResponse{
+		APIKeyResponse: toAPIKeyResponse(key),
+		Key:            plaintext,
+	}, nil
+}
+
+func (s *apiKeyService) ListKeys(ctx context.Context, userID uint) ([]*dto.APIKeyResponse, error) {
+	keys, err := s.keyRepo.ListByUser(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to list API keys", "error", err, "user_id", userID)
+		return nil, errors.New("failed to get api keys")
+	}
+
+	responses := make([]*dto.APIKeyResponse, 0, len(keys))
+	for _, key := range keys {
+		responses = append(responses, toAPIKeyResponse(key))
+	}
+	return responses, nil
+}
+
+func (s *apiKeyService) RevokeKey(ctx context.Context, userID, keyID uint) error {
+	if err := s.keyRepo.Revoke(ctx, userID, keyID, time.Now()); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("api key not found")
+		}
+		s.logger.Error("Failed to revoke API key", "error", err, "key_id", keyID)
+		return errors.New("failed to revoke api key")
+	}
+
+	userAgent, ipAddress := request.ClientInfo(ctx)
+	s.securitySvc.Record(ctx, userID, entities.SecurityEventAPIKeyRevoked, userAgent, ipAddress)
+	return nil
+}
+
+func (s *apiKeyService) Authenticate(ctx context.Context, plaintext string) (*entities.APIKey, error) {
+	key, err := s.keyRepo.GetByHash(ctx, auth.HashAPIKey(s.pepper, plaintext))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("invalid api key")
+		}
+		s.logger.Error("Failed to look up API key", "error", err)
+		return nil, errors.New("failed to authenticate api key")
+	}
+
+	now := time.Now()
+	if !key.Usable(now) {
+		return nil, errors.New("invalid api key")
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= lastUsedResolution {
+		if err := s.keyRepo.UpdateLastUsedAt(ctx, key.ID, now); err != nil {
+			s.logger.Warn("Failed to record API key use", "error", err, "key_id", key.ID)
+		}
+		key.LastUsedAt = &now
+	}
+
+	return key, nil
+}
+
+// Allow uses a fixed one-minute window in Redis so the limit holds across
+// instances. When Redis is unavailable the request is let through rather
+// than failing every integration at once.
+func (s *apiKeyService) Allow(ctx context.Context, key *entities.APIKey) (bool, error) {
+	counterKey := rateCounterKey(key.ID, time.Now())
+
+	count, err := s.redisClient.IncrBy(ctx, counterKey, 1)
+	if err != nil {
+		return true, err
+	}
+	if count == 1 {
+		if err := s.redisClient.Expire(ctx, counterKey, 2*time.Minute); err != nil {
+			return true, err
+		}
+	}
+	return count <= int64(key.RateLimit), nil
+}
+
+// Meter keeps one Redis counter per key and calendar month (UTC). Like
+// Allow, it lets requests through when Redis is unavailable.
+func (s *apiKeyService) Meter(ctx context.Context, key *entities.APIKey) (bool, error) {
+	now := time.Now().UTC()
+	counterKey := monthlyCounterKey(key.ID, now)
+
+	count, err := s.redisClient.IncrBy(ctx, counterKey, 1)
+	if err != nil {
+		return true, err
+	}
+	if count == 1 {
+		// Kept a day past the month so late reads of it still find it.
+		if err := s.redisClient.Expire(ctx, counterKey, time.Until(nextMonth(now))+24*time.Hour); err != nil {
+			return true, err
+		}
+	}
+
+	quota := s.monthlyQuota(key.User.CurrentPlan())
+	return quota <= 0 || count <= int64(quota), nil
+}
+
+// monthlyQuota is how many requests a key of a user on plan may make per
+// month; 0 means unlimited.
+func (s *apiKeyService) monthlyQuota(plan entities.Plan) int {
+	switch plan {
+	case entities.PlanPremium:
+		return s.config.PremiumMonthlyQuota
+	case entities.PlanRecruiter:
+		return s.config.RecruiterMonthlyQuota
+	default:
+		return s.config.BasicMonthlyQuota
+	}
+}
+
+func (s *apiKeyService) Usage(ctx context.Context, userID uint) ([]*dto.APIKeyUsageResponse, error) {
+	keys, err := s.keyRepo.ListByUser(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to list API keys", "error", err, "user_id", userID)
+		return nil, errors.New("failed to get api key usage")
+	}
+
+	now := time.Now().UTC()
+	usage := make([]*dto.APIKeyUsageResponse, 0, len(keys))
+	for _, key := range keys {
+		if !key.Usable(now) {
+			continue
+		}
+
+		requests := s.readCounter(ctx, rateCounterKey(key.ID, now), key.ID)
+		monthlyRequests := s.readCounter(ctx, monthlyCounterKey(key.ID, now), key.ID)
+
+		response := &dto.APIKeyUsageResponse{
+			ID:              key.ID,
+			Name:            key.Name,
+			Prefix:          key.Prefix,
+			RateLimit:       key.RateLimit,
+			Requests:        requests,
+			Remaining:       max(int64(key.RateLimit)-requests, 0),
+			LastUsedAt:      key.LastUsedAt,
+			MonthlyQuota:    max(s.monthlyQuota(key.User.CurrentPlan()), 0),
+			MonthlyRequests: monthlyRequests,
+			MonthResetsAt:   nextMonth(now),
+		}
+		if response.MonthlyQuota > 0 {
+			remaining := max(int64(response.MonthlyQuota)-monthlyRequests, 0)
+			response.MonthlyRemaining = &remaining
+		}
+		usage = append(usage, response)
+	}
+	return usage, nil
+}
+
+// readCounter returns a usage counter, treating a missing or unreadable one
+// as zero.
+func (s *apiKeyService) readCounter(ctx context.Context, counterKey string, keyID uint) int64 {
+	value, err := s.redisClient.Get(ctx, counterKey)
+	if err != nil {
+		if !errors.Is(err, goredis.Nil) {
+			s.logger.Warn("Failed to read API key usage", "error", err, "key_id", keyID)
+		}
+		return 0
+	}
+	count, _ := strconv.ParseInt(value, 10, 64)
+	return count
+}
+
+// rateCounterKey names the Redis counter for key's one-minute window at now.
+func rateCounterKey(keyID uint, now time.Time) string {
+	return fmt.Sprintf("api_key_rate:%d:%d", keyID, now.Unix()/60)
+}
+
+// monthlyCounterKey names the Redis counter for key's calendar month at now.
+func monthlyCounterKey(keyID uint, now time.Time) string {
+	return fmt.Sprintf("api_key_month:%d:%s", keyID, now.UTC().Format("2006-01"))
+}
+
+// nextMonth is the start of the calendar month (UTC) after now, when
+// monthly quotas reset.
+func nextMonth(now time.Time) time.Time {
+	now = now.UTC()
+	return time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func toAPIKeyResponse(key *entities.APIKey) *dto.APIKeyResponse {
+	return &dto.APIKeyResponse{
+		ID:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     key.Scopes,
+		RateLimit:  key.RateLimit,
+		Active:     key.Usable(time.Now()),
+		LastUsedAt: key.LastUsedAt,
+		ExpiresAt:  key.ExpiresAt,
+		RevokedAt:  key.RevokedAt,
+		CreatedAt:  key.CreatedAt,
+	}
+}
+
+func uniqueScopes(scopes []entities.APIKeyScope) []entities.APIKeyScope {
+	seen := make(map[entities.APIKeyScope]bool, len(scopes))
+	unique := make([]entities.APIKeyScope, 0, len(scopes))
+	for _, scope := range scopes {
+		if !seen[scope] {
+			seen[scope] = true
+			unique = append(unique, scope)
+		}
+	}
+	return unique
+}
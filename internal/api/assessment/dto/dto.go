@@ -0,0 +1,103 @@
+package dto
+
+import (
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type CreateAssessmentRequest struct {
+	Skill              string `json:"skill" validate:"required,max=50"`
+	Title              string `json:"title" validate:"required,max=200"`
+	Description        string `json:"description" validate:"omitempty,max=2000"`
+	QuestionCount      int    `json:"question_count" validate:"required,min=1,max=100"`
+	TimeLimitMinutes   int    `json:"time_limit_minutes" validate:"required,min=1,max=180"`
+	PassPercent        int    `json:"pass_percent" validate:"required,min=1,max=100"`
+	RetakeCooldownDays *int   `json:"retake_cooldown_days" validate:"omitempty,min=0,max=365"`
+}
+
+type UpdateAssessmentRequest struct {
+	Title              string `json:"title" validate:"omitempty,max=200"`
+	Description        string `json:"description" validate:"omitempty,max=2000"`
+	QuestionCount      *int   `json:"question_count" validate:"omitempty,min=1,max=100"`
+	TimeLimitMinutes   *int   `json:"time_limit_minutes" validate:"omitempty,min=1,max=180"`
+	PassPercent        *int   `json:"pass_percent" validate:"omitempty,min=1,max=100"`
+	RetakeCooldownDays *int   `json:"retake_cooldown_days" validate:"omitempty,min=0,max=365"`
+	IsActive           *bool  `json:"is_active"`
+}
+
+type QuestionRequest struct {
+	Prompt        string   `json:"prompt" validate:"required,max=2000"`
+	Options       []string `json:"options" validate:"required,min=2,max=6,dive,required,max=500"`
+	CorrectOption *int     `json:"correct_option" validate:"required,min=0,max=5"`
+}
+
+type AnswerRequest struct {
+	QuestionID uint `json:"question_id" validate:"required"`
+	Option     int  `json:"option" validate:"min=0,max=5"`
+}
+
+type SubmitAttemptRequest struct {
+	Answers []*AnswerRequest `json:"answers" validate:"max=100,dive,required"`
+}
+
+type AssessmentResponse struct {
+	ID                 uint       `json:"id"`
+	Skill              string     `json:"skill"`
+	Title              string     `json:"title"`
+	Description        string     `json:"description,omitempty"`
+	QuestionCount      int        `json:"question_count"`
+	TimeLimitMinutes   int        `json:"time_limit_minutes"`
+	PassPercent        int        `json:"pass_percent"`
+	RetakeCooldownDays int        `json:"retake_cooldown_days"`
+	Passed             bool       `json:"passed"`
+	ActiveAttemptID    *uint      `json:"active_attempt_id,omitempty"`
+	RetakeAvailableAt  *time.Time `json:"retake_available_at,omitempty"`
+}
+
+type AdminAssessmentResponse struct {
+	ID                 uint                `json:"id"`
+	Skill              string              `json:"skill"`
+	Title              string              `json:"title"`
+	Description        string              `json:"description,omitempty"`
+	QuestionCount      int                 `json:"question_count"`
+	TimeLimitMinutes   int                 `json:"time_limit_minutes"`
+	PassPercent        int                 `json:"pass_percent"`
+	RetakeCooldownDays int                 `json:"retake_cooldown_days"`
+	IsActive           bool                `json:"is_active"`
+	BankSize           int64               `json:"bank_size"`
+	Questions          []*QuestionResponse `json:"questions,omitempty"`
+	CreatedBy          *uint               `json:"created_by,omitempty"`
+	CreatedAt          time.Time           `json:"created_at"`
+	UpdatedAt          time.Time           `json:"updated_at"`
+}
+
+type QuestionResponse struct {
+	ID            uint     `json:"id"`
+	Prompt        string   `json:"prompt"`
+	Options       []string `json:"options"`
+	CorrectOption *int     `json:"correct_option,omitempty"`
+}
+
+type AttemptResponse struct {
+	ID           uint                             `json:"id"`
+	AssessmentID uint                             `json:"assessment_id"`
+	Skill        string                           `json:"skill"`
+	Status       entities.AssessmentAttemptStatus `json:"status"`
+	StartedAt    time.Time                        `json:"started_at"`
+	ExpiresAt    time.Time                        `json:"expires_at"`
+	Questions    []*QuestionResponse              `json:"questions"`
+}
+
+type AttemptResultResponse struct {
+	ID                uint                             `json:"id"`
+	AssessmentID      uint                             `json:"assessment_id"`
+	Skill             string                           `json:"skill"`
+	Status            entities.AssessmentAttemptStatus `json:"status"`
+	CorrectCount      int                              `json:"correct_count"`
+	QuestionCount     int                              `json:"question_count"`
+	Score             int                              `json:"score"`
+	PassPercent       int                              `json:"pass_percent"`
+	Passed            bool                             `json:"passed"`
+	FinishedAt        *time.Time                       `json:"finished_at,omitempty"`
+	RetakeAvailableAt *time.Time                       `json:"retake_available_at,omitempty"`
+}
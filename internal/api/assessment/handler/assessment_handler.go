@@ -0,0 +1,284 @@
+package handler
+
+import (
+	"linked-clone/internal/api/assessment/dto"
+	"linked-clone/internal/api/assessment/service"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/request"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AssessmentHandler struct {
+	assessmentService service.AssessmentService
+	validator         validation.Validator
+	logger            logger.Logger
+}
+
+func NewAssessmentHandler(assessmentService service.AssessmentService, validator validation.Validator, logger logger.Logger) *AssessmentHandler {
+	return &AssessmentHandler{
+		assessmentService: assessmentService,
+		validator:         validator,
+		logger:            logger,
+	}
+}
+
+func (h *AssessmentHandler) ListAssessments(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	limit, offset := request.Pagination(c)
+
+	assessments, err := h.assessmentService.ListAssessments(c.Request.Context(), userID, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to list assessments", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get assessments", err.Error())
+		return
+	}
+
+	response.Success(c, assessments)
+}
+
+func (h *AssessmentHandler) GetAssessment(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	id, ok := request.ParseID(c, "id", "Invalid assessment ID")
+	if !ok {
+		return
+	}
+
+	assessment, err := h.assessmentService.GetAssessment(c.Request.Context(), userID, id)
+	if err != nil {
+		h.logger.Error("Failed to get assessment", "error", err)
+		response.Error(c, assessmentErrorStatus(err), "Failed to get assessment", err.Error())
+		return
+	}
+
+	response.Success(c, assessment)
+}
+
+func (h *AssessmentHandler) StartAttempt(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	id, ok := request.ParseID(c, "id", "Invalid assessment ID")
+	if !ok {
+		return
+	}
+
+	attempt, err := h.assessmentService.StartAttempt(c.Request.Context(), userID, id)
+	if err != nil {
+		h.logger.Error("Failed to start assessment", "error", err)
+		response.Error(c, assessmentErrorStatus(err), "Failed to start assessment", err.Error())
+		return
+	}
+
+	response.Success(c, attempt)
+}
+
+func (h *AssessmentHandler) SubmitAttempt(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	attemptID, ok := request.ParseID(c, "attemptId", "Invalid attempt ID")
+	if !ok {
+		return
+	}
+
+	var req dto.SubmitAttemptRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	result, err := h.assessmentService.SubmitAttempt(c.Request.Context(), userID, attemptID, &req)
+	if err != nil {
+		h.logger.Error("Failed to submit assessment", "error", err)
+		response.Error(c, assessmentErrorStatus(err), "Failed to submit assessment", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Assessment submitted", result)
+}
+
+func (h *AssessmentHandler) CreateAssessment(c *gin.Context) {
+	adminID := middleware.GetUserID(c)
+
+	var req dto.CreateAssessmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	assessment, err := h.assessmentService.CreateAssessment(c.Request.Context(), adminID, &req)
+	if err != nil {
+		h.logger.Error("Failed to create assessment", "error", err)
+		response.Error(c, assessmentErrorStatus(err), "Failed to create assessment", err.Error())
+		return
+	}
+
+	response.CreatedWithMessage(c, "Assessment created", assessment)
+}
+
+func (h *AssessmentHandler) ListAllAssessments(c *gin.Context) {
+	limit, offset := request.Pagination(c)
+
+	assessments, err := h.assessmentService.ListAllAssessments(c.Request.Context(), limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to list assessments", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get assessments", err.Error())
+		return
+	}
+
+	response.Success(c, assessments)
+}
+
+func (h *AssessmentHandler) GetAssessmentDetail(c *gin.Context) {
+	id, ok := request.ParseID(c, "id", "Invalid assessment ID")
+	if !ok {
+		return
+	}
+
+	assessment, err := h.assessmentService.GetAssessmentDetail(c.Request.Context(), id)
+	if err != nil {
+		h.logger.Error("Failed to get assessment", "error", err)
+		response.Error(c, assessmentErrorStatus(err), "Failed to get assessment", err.Error())
+		return
+	}
+
+	response.Success(c, assessment)
+}
+
+func (h *AssessmentHandler) UpdateAssessment(c *gin.Context) {
+	id, ok := request.ParseID(c, "id", "Invalid assessment ID")
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateAssessmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	assessment, err := h.assessmentService.UpdateAssessment(c.Request.Context(), id, &req)
+	if err != nil {
+		h.logger.Error("Failed to update assessment", "error", err)
+		response.Error(c, assessmentErrorStatus(err), "Failed to update assessment", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Assessment updated", assessment)
+}
+
+func (h *AssessmentHandler) AddQuestion(c *gin.Context) {
+	id, ok := request.ParseID(c, "id", "Invalid assessment ID")
+	if !ok {
+		return
+	}
+
+	var req dto.QuestionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	question, err := h.assessmentService.AddQuestion(c.Request.Context(), id, &req)
+	if err != nil {
+		h.logger.Error("Failed to add question", "error", err)
+		response.Error(c, assessmentErrorStatus(err), "Failed to add question", err.Error())
+		return
+	}
+
+	response.CreatedWithMessage(c, "Question added", question)
+}
+
+func (h *AssessmentHandler) UpdateQuestion(c *gin.Context) {
+	id, ok := request.ParseID(c, "id", "Invalid assessment ID")
+	if !ok {
+		return
+	}
+
+	questionID, ok := request.ParseID(c, "questionId", "Invalid question ID")
+	if !ok {
+		return
+	}
+
+	var req dto.QuestionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	question, err := h.assessmentService.UpdateQuestion(c.Request.Context(), id, questionID, &req)
+	if err != nil {
+		h.logger.Error("Failed to update question", "error", err)
+		response.Error(c, assessmentErrorStatus(err), "Failed to update question", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Question updated", question)
+}
+
+func (h *AssessmentHandler) DeleteQuestion(c *gin.Context) {
+	id, ok := request.ParseID(c, "id", "Invalid assessment ID")
+	if !ok {
+		return
+	}
+
+	questionID, ok := request.ParseID(c, "questionId", "Invalid question ID")
+	if !ok {
+		return
+	}
+
+	if err := h.assessmentService.DeleteQuestion(c.Request.Context(), id, questionID); err != nil {
+		h.logger.Error("Failed to delete question", "error", err)
+		response.Error(c, assessmentErrorStatus(err), "Failed to delete question", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Question deleted"})
+}
+
+func assessmentErrorStatus(err error) int {
+	switch err.Error() {
+	case "assessment not found", "attempt not found", "question not found":
+		return http.StatusNotFound
+	case "assessment already passed", "attempt already submitted", "assessment is not available",
+		"question bank is smaller than question count":
+		return http.StatusConflict
+	case "retake cooldown active":
+		return http.StatusTooManyRequests
+	case "assessment time expired":
+		return http.StatusGone
+	case "invalid skill", "correct option out of range", "answer does not match a question in this attempt":
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
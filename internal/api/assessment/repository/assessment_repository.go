@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type assessmentRepository struct {
+	db *gorm.DB
+}
+
+func NewAssessmentRepository(db *gorm.DB) repositories.AssessmentRepository {
+	return &assessmentRepository{db: db}
+}
+
+func (r *assessmentRepository) Create(ctx context.Context, assessment *entities.SkillAssessment) error {
+	return r.db.WithContext(ctx).Create(assessment).Error
+}
+
+func (r *assessmentRepository) GetByID(ctx context.Context, id uint) (*entities.SkillAssessment, error) {
+	var assessment entities.SkillAssessment
+	err := r.db.WithContext(ctx).First(&assessment, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &assessment, nil
+}
+
+func (r *assessmentRepository) List(ctx context.Context, activeOnly bool, limit, offset int) ([]*entities.SkillAssessment, error) {
+	query := r.db.WithContext(ctx)
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+
+	var assessments []*entities.SkillAssessment
+	err := query.
+		Order("skill ASC, id ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&assessments).Error
+	return assessments, err
+}
+
+func (r *assessmentRepository) Update(ctx context.Context, assessment *entities.SkillAssessment) error {
+	return r.db.WithContext(ctx).Save(assessment).Error
+}
+
+func (r *assessmentRepository) CreateQuestion(ctx context.Context, question *entities.AssessmentQuestion) error {
+	return r.db.WithContext(ctx).Create(question).Error
+}
+
+func (r *assessmentRepository) GetQuestion(ctx context.Context, id uint) (*entities.AssessmentQuestion, error) {
+	var question entities.AssessmentQuestion
+	err := r.db.WithContext(ctx).First(&question, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &question, nil
+}
+
+func (r *assessmentRepository) GetQuestions(ctx context.Context, assessmentID uint) ([]*entities.AssessmentQuestion, error) {
+	var questions []*entities.AssessmentQuestion
+	err := r.db.WithContext(ctx).
+		Where("assessment_id = ?", assessmentID).
+		Order("id ASC").
+		Find(&questions).Error
+	return questions, err
+}
+
+// GetQuestionsByIDs includes deleted questions, since an attempt keeps the
+// questions it drew even if an admin removes them from the bank mid-quiz.
+func (r *assessmentRepository) GetQuestionsByIDs(ctx context.Context, ids []uint) ([]*entities.AssessmentQuestion, error) {
+	var questions []*entities.AssessmentQuestion
+	if len(ids) == 0 {
+		return questions, nil
+	}
+	err := r.db.WithContext(ctx).
+		Unscoped().
+		Where("id IN ?", ids).
+		Find(&questions).Error
+	return questions, err
+}
+
+func (r *assessmentRepository) UpdateQuestion(ctx context.Context, question *entities.AssessmentQuestion) error {
+	return r.db.WithContext(ctx).Save(question).Error
+}
+
+func (r *assessmentRepository) DeleteQuestion(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&entities.AssessmentQuestion{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *assessmentRepository) CountQuestions(ctx context.Context, assessmentIDs []uint) (map[uint]int64, error) {
+	counts := make(map[uint]int64, len(assessmentIDs))
+	if len(assessmentIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		AssessmentID uint
+		Count        int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&entities.AssessmentQuestion{}).
+		Select("assessment_id, COUNT(*) AS count").
+		Where("assessment_id IN ?", assessmentIDs).
+		Group("assessment_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.AssessmentID] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *assessmentRepository) RandomQuestionIDs(ctx context.Context, assessmentID uint, count int) ([]uint, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).
+		Model(&entities.AssessmentQuestion{}).
+		Where("assessment_id = ?", assessmentID).
+		Order("RANDOM()").
+		Limit(count).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+type assessmentAttemptRepository struct {
+	db *gorm.DB
+}
+
+func NewAssessmentAttemptRepository(db *gorm.DB) repositories.AssessmentAttemptRepository {
+	return &assessmentAttemptRepository{db: db}
+}
+
+func (r *assessmentAttemptRepository) Create(ctx context.Context, attempt *entities.AssessmentAttempt) error {
+	return r.db.WithContext(ctx).Create(attempt).Error
+}
+
+func (r *assessmentAttemptRepository) GetByID(ctx context.Context, id uint) (*entities.AssessmentAttempt, error) {
+	var attempt entities.AssessmentAttempt
+	err := r.db.WithContext(ctx).First(&attempt, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &attempt, nil
+}
+
+// GetLatest returns the user's most recent attempt per assessment.
+func (r *assessmentAttemptRepository) GetLatest(ctx context.Context, userID uint, assessmentIDs []uint) (map[uint]*entities.AssessmentAttempt, error) {
+	latest := make(map[uint]*entities.AssessmentAttempt, len(assessmentIDs))
+	if len(assessmentIDs) == 0 {
+		return latest, nil
+	}
+
+	var attempts []*entities.AssessmentAttempt
+	err := r.db.WithContext(ctx).
+		Raw(`SELECT DISTINCT ON (assessment_id) * FROM assessment_attempts
+			WHERE user_id = ? AND assessment_id IN ?
+			ORDER BY assessment_id, started_at DESC, id DESC`, userID, assessmentIDs).
+		Scan(&attempts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, attempt := range attempts {
+		latest[attempt.AssessmentID] = attempt
+	}
+	return latest, nil
+}
+
+// Finish closes an in-progress attempt and, when badge is set, awards it in
+// the same transaction. It returns gorm.ErrRecordNotFound if the attempt was
+// already finished, so a double submit cannot be scored twice.
+func (r *assessmentAttemptRepository) Finish(ctx context.Context, attempt *entities.AssessmentAttempt, badge *entities.SkillBadge) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(attempt).
+			Where("status = ?", entities.AttemptInProgress).
+			Select("status", "answers", "correct_count", "score", "finished_at", "updated_at").
+			Updates(attempt)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if badge == nil {
+			return nil
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(badge).Error
+	})
+}
+
+type skillBadgeRepository struct {
+	db *gorm.DB
+}
+
+func NewSkillBadgeRepository(db *gorm.DB) repositories.SkillBadgeRepository {
+	return &skillBadgeRepository{db: db}
+}
+
+func (r *skillBadgeRepository) GetByUserID(ctx context.Context, userID uint) ([]*entities.SkillBadge, error) {
+	var badges []*entities.SkillBadge
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("earned_at DESC").
+		Find(&badges).Error
+	return badges, err
+}
@@ -0,0 +1,368 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/assessment/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// submitGracePeriod absorbs network latency for answers sent right as the
+// timer runs out.
+const submitGracePeriod = 30 * time.Second
+
+type AssessmentService interface {
+	ListAssessments(ctx context.Context, userID uint, limit, offset int) ([]*dto.AssessmentResponse, error)
+	GetAssessment(ctx context.Context, userID, id uint) (*dto.AssessmentResponse, error)
+	StartAttempt(ctx context.Context, userID, assessmentID uint) (*dto.AttemptResponse, error)
+	SubmitAttempt(ctx context.Context, userID, attemptID uint, req *dto.SubmitAttemptRequest) (*dto.AttemptResultResponse, error)
+
+	CreateAssessment(ctx context.Context, adminID uint, req *dto.CreateAssessmentRequest) (*dto.AdminAssessmentResponse, error)
+	ListAllAssessments(ctx context.Context, limit, offset int) ([]*dto.AdminAssessmentResponse, error)
+	GetAssessmentDetail(ctx context.Context, id uint) (*dto.AdminAssessmentResponse, error)
+	UpdateAssessment(ctx context.Context, id uint, req *dto.UpdateAssessmentRequest) (*dto.AdminAssessmentResponse, error)
+	AddQuestion(ctx context.Context, assessmentID uint, req *dto.QuestionRequest) (*dto.QuestionResponse, error)
+	UpdateQuestion(ctx context.Context, assessmentID, questionID uint, req *dto.QuestionRequest) (*dto.QuestionResponse, error)
+	DeleteQuestion(ctx context.Context, assessmentID, questionID uint) error
+}
+
+type assessmentService struct {
+	assessmentRepo repositories.AssessmentRepository
+	attemptRepo    repositories.AssessmentAttemptRepository
+	logger         logger.Logger
+}
+
+func NewAssessmentService(
+	assessmentRepo repositories.AssessmentRepository,
+	attemptRepo repositories.AssessmentAttemptRepository,
+	logger logger.Logger,
+) AssessmentService {
+	return &assessmentService{
+		assessmentRepo: assessmentRepo,
+		attemptRepo:    attemptRepo,
+		logger:         logger,
+	}
+}
+
+func (s *assessmentService) ListAssessments(ctx context.Context, userID uint, limit, offset int) ([]*dto.AssessmentResponse, error) {
+	assessments, err := s.assessmentRepo.List(ctx, true, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list assessments", "error", err)
+		return nil, errors.New("failed to get assessments")
+	}
+
+	ids := make([]uint, 0, len(assessments))
+	for _, assessment := range assessments {
+		ids = append(ids, assessment.ID)
+	}
+	latest, err := s.attemptRepo.GetLatest(ctx, userID, ids)
+	if err != nil {
+		s.logger.Error("Failed to get latest attempts", "user_id", userID, "error", err)
+		return nil, errors.New("failed to get assessments")
+	}
+
+	responses := make([]*dto.AssessmentResponse, 0, len(assessments))
+	for _, assessment := range assessments {
+		responses = append(responses, mapAssessment(assessment, latest[assessment.ID]))
+	}
+	return responses, nil
+}
+
+func (s *assessmentService) GetAssessment(ctx context.Context, userID, id uint) (*dto.AssessmentResponse, error) {
+	assessment, err := s.activeAssessment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := s.attemptRepo.GetLatest(ctx, userID, []uint{id})
+	if err != nil {
+		s.logger.Error("Failed to get latest attempt", "user_id", userID, "assessment_id", id, "error", err)
+		return nil, errors.New("failed to get assessment")
+	}
+
+	return mapAssessment(assessment, latest[id]), nil
+}
+
+// StartAttempt draws a fresh set of questions and starts the timer. An
+// unexpired attempt already in progress is resumed instead, and a new one is
+// refused once the skill is passed or while the retake cooldown runs.
+func (s *assessmentService) StartAttempt(ctx context.Context, userID, assessmentID uint) (*dto.AttemptResponse, error) {
+	assessment, err := s.activeAssessment(ctx, assessmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := s.attemptRepo.GetLatest(ctx, userID, []uint{assessmentID})
+	if err != nil {
+		s.logger.Error("Failed to get latest attempt", "user_id", userID, "assessment_id", assessmentID, "error", err)
+		return nil, errors.New("failed to start assessment")
+	}
+
+	now := time.Now()
+	if previous := latest[assessmentID]; previous != nil {
+		if previous.Status == entities.AttemptInProgress {
+			if now.Before(previous.ExpiresAt) {
+				return s.mapAttempt(ctx, assessment, previous)
+			}
+			if err := s.expire(ctx, previous); err != nil {
+				return nil, errors.New("failed to start assessment")
+			}
+		}
+
+		if previous.Status == entities.AttemptPassed {
+			return nil, errors.New("assessment already passed")
+		}
+		if availableAt := retakeAvailableAt(assessment, previous); availableAt != nil && now.Before(*availableAt) {
+			return nil, errors.New("retake cooldown active")
+		}
+	}
+
+	questionIDs, err := s.assessmentRepo.RandomQuestionIDs(ctx, assessmentID, assessment.QuestionCount)
+	if err != nil {
+		s.logger.Error("Failed to draw assessment questions", "assessment_id", assessmentID, "error", err)
+		return nil, errors.New("failed to start assessment")
+	}
+	if len(questionIDs) < assessment.QuestionCount {
+		return nil, errors.New("assessment is not available")
+	}
+
+	attempt := &entities.AssessmentAttempt{
+		UserID:       userID,
+		AssessmentID: assessmentID,
+		Status:       entities.AttemptInProgress,
+		QuestionIDs:  questionIDs,
+		StartedAt:    now,
+		ExpiresAt:    now.Add(time.Duration(assessment.TimeLimitMinutes) * time.Minute),
+	}
+	if err := s.attemptRepo.Create(ctx, attempt); err != nil {
+		s.logger.Error("Failed to create assessment attempt", "user_id", userID, "assessment_id", assessmentID, "error", err)
+		return nil, errors.New("failed to start assessment")
+	}
+
+	s.logger.Info("Assessment started", "user_id", userID, "assessment_id", assessmentID, "attempt_id", attempt.ID)
+
+	return s.mapAttempt(ctx, assessment, attempt)
+}
+
+// SubmitAttempt scores the answers against the questions drawn at start and
+// awards the skill badge on a pass. Unanswered questions count as wrong.
+func (s *assessmentService) SubmitAttempt(ctx context.Context, userID, attemptID uint, req *dto.SubmitAttemptRequest) (*dto.AttemptResultResponse, error) {
+	attempt, err := s.attemptRepo.GetByID(ctx, attemptID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("attempt not found")
+		}
+		s.logger.Error("Failed to get assessment attempt", "attempt_id", attemptID, "error", err)
+		return nil, errors.New("failed to submit assessment")
+	}
+	if attempt.UserID != userID {
+		return nil, errors.New("attempt not found")
+	}
+	if attempt.Status != entities.AttemptInProgress {
+		return nil, errors.New("attempt already submitted")
+	}
+
+	now := time.Now()
+	if now.After(attempt.ExpiresAt.Add(submitGracePeriod)) {
+		if err := s.expire(ctx, attempt); err != nil {
+			return nil, errors.New("failed to submit assessment")
+		}
+		return nil, errors.New("assessment time expired")
+	}
+
+	assessment, err := s.assessmentRepo.GetByID(ctx, attempt.AssessmentID)
+	if err != nil {
+		s.logger.Error("Failed to get assessment", "assessment_id", attempt.AssessmentID, "error", err)
+		return nil, errors.New("failed to submit assessment")
+	}
+
+	questions, err := s.assessmentRepo.GetQuestionsByIDs(ctx, attempt.QuestionIDs)
+	if err != nil {
+		s.logger.Error("Failed to get attempt questions", "attempt_id", attemptID, "error", err)
+		return nil, errors.New("failed to submit assessment")
+	}
+	correctByID := make(map[uint]int, len(questions))
+	for _, question := range questions {
+		correctByID[question.ID] = question.CorrectOption
+	}
+
+	positions := make(map[uint]int, len(attempt.QuestionIDs))
+	answers := make([]int, len(attempt.QuestionIDs))
+	for i, id := range attempt.QuestionIDs {
+		positions[id] = i
+		answers[i] = -1
+	}
+	for _, answer := range req.Answers {
+		position, ok := positions[answer.QuestionID]
+		if !ok {
+			return nil, errors.New("answer does not match a question in this attempt")
+		}
+		answers[position] = answer.Option
+	}
+
+	correct := 0
+	for i, id := range attempt.QuestionIDs {
+		if expected, ok := correctByID[id]; ok && answers[i] == expected {
+			correct++
+		}
+	}
+
+	attempt.Answers = answers
+	attempt.CorrectCount = correct
+	attempt.Score = correct * 100 / len(attempt.QuestionIDs)
+	attempt.FinishedAt = &now
+	attempt.Status = entities.AttemptFailed
+
+	var badge *entities.SkillBadge
+	if attempt.Score >= assessment.PassPercent {
+		attempt.Status = entities.AttemptPassed
+		badge = &entities.SkillBadge{
+			UserID:       userID,
+			AssessmentID: assessment.ID,
+			AttemptID:    attempt.ID,
+			Skill:        assessment.Skill,
+			Score:        attempt.Score,
+			EarnedAt:     now,
+		}
+	}
+
+	if err := s.attemptRepo.Finish(ctx, attempt, badge); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("attempt already submitted")
+		}
+		s.logger.Error("Failed to finish assessment attempt", "attempt_id", attemptID, "error", err)
+		return nil, errors.New("failed to submit assessment")
+	}
+
+	s.logger.Info("Assessment submitted",
+		"user_id", userID,
+		"assessment_id", assessment.ID,
+		"attempt_id", attempt.ID,
+		"score", attempt.Score,
+		"passed", badge != nil)
+
+	return &dto.AttemptResultResponse{
+		ID:                attempt.ID,
+		AssessmentID:      assessment.ID,
+		Skill:             assessment.Skill,
+		Status:            attempt.Status,
+		CorrectCount:      attempt.CorrectCount,
+		QuestionCount:     len(attempt.QuestionIDs),
+		Score:             attempt.Score,
+		PassPercent:       assessment.PassPercent,
+		Passed:            badge != nil,
+		FinishedAt:        attempt.FinishedAt,
+		RetakeAvailableAt: retakeAvailableAt(assessment, attempt),
+	}, nil
+}
+
+func (s *assessmentService) activeAssessment(ctx context.Context, id uint) (*entities.SkillAssessment, error) {
+	assessment, err := s.assessmentRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("assessment not found")
+		}
+		s.logger.Error("Failed to get assessment", "assessment_id", id, "error", err)
+		return nil, errors.New("failed to get assessment")
+	}
+	if !assessment.IsActive {
+		return nil, errors.New("assessment not found")
+	}
+	return assessment, nil
+}
+
+// expire closes an attempt whose timer ran out without a submission. It
+// counts as a failed try for the retake cooldown, starting from the deadline.
+func (s *assessmentService) expire(ctx context.Context, attempt *entities.AssessmentAttempt) error {
+	expiredAt := attempt.ExpiresAt
+	attempt.Status = entities.AttemptExpired
+	attempt.FinishedAt = &expiredAt
+
+	err := s.attemptRepo.Finish(ctx, attempt, nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.logger.Error("Failed to expire assessment attempt", "attempt_id", attempt.ID, "error", err)
+		return err
+	}
+	return nil
+}
+
+func (s *assessmentService) mapAttempt(ctx context.Context, assessment *entities.SkillAssessment, attempt *entities.AssessmentAttempt) (*dto.AttemptResponse, error) {
+	questions, err := s.assessmentRepo.GetQuestionsByIDs(ctx, attempt.QuestionIDs)
+	if err != nil {
+		s.logger.Error("Failed to get attempt questions", "attempt_id", attempt.ID, "error", err)
+		return nil, errors.New("failed to start assessment")
+	}
+	byID := make(map[uint]*entities.AssessmentQuestion, len(questions))
+	for _, question := range questions {
+		byID[question.ID] = question
+	}
+
+	responses := make([]*dto.QuestionResponse, 0, len(attempt.QuestionIDs))
+	for _, id := range attempt.QuestionIDs {
+		if question, ok := byID[id]; ok {
+			responses = append(responses, &dto.QuestionResponse{
+				ID:      question.ID,
+				Prompt:  question.Prompt,
+				Options: question.Options,
+			})
+		}
+	}
+
+	return &dto.AttemptResponse{
+		ID:           attempt.ID,
+		AssessmentID: assessment.ID,
+		Skill:        assessment.Skill,
+		Status:       attempt.Status,
+		StartedAt:    attempt.StartedAt,
+		ExpiresAt:    attempt.ExpiresAt,
+		Questions:    responses,
+	}, nil
+}
+
+func mapAssessment(assessment *entities.SkillAssessment, latest *entities.AssessmentAttempt) *dto.AssessmentResponse {
+	response := &dto.AssessmentResponse{
+		ID:                 assessment.ID,
+		Skill:              assessment.Skill,
+		Title:              assessment.Title,
+		Description:        assessment.Description,
+		QuestionCount:      assessment.QuestionCount,
+		TimeLimitMinutes:   assessment.TimeLimitMinutes,
+		PassPercent:        assessment.PassPercent,
+		RetakeCooldownDays: assessment.RetakeCooldownDays,
+	}
+	if latest == nil {
+		return response
+	}
+
+	switch {
+	case latest.Status == entities.AttemptPassed:
+		response.Passed = true
+	case latest.Status == entities.AttemptInProgress && time.Now().Before(latest.ExpiresAt):
+		response.ActiveAttemptID = &latest.ID
+	default:
+		if availableAt := retakeAvailableAt(assessment, latest); availableAt != nil && time.Now().Before(*availableAt) {
+			response.RetakeAvailableAt = availableAt
+		}
+	}
+	return response
+}
+
+// retakeAvailableAt is when the user may start again after a failed or
+// expired attempt, or nil when no cooldown applies.
+func retakeAvailableAt(assessment *entities.SkillAssessment, attempt *entities.AssessmentAttempt) *time.Time {
+	if attempt.Status == entities.AttemptPassed || assessment.RetakeCooldownDays <= 0 {
+		return nil
+	}
+
+	finishedAt := attempt.ExpiresAt
+	if attempt.FinishedAt != nil {
+		finishedAt = *attempt.FinishedAt
+	}
+	availableAt := finishedAt.AddDate(0, 0, assessment.RetakeCooldownDays)
+	return &availableAt
+}
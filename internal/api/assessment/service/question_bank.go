@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/assessment/dto"
+	"linked-clone/internal/domain/entities"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+const defaultRetakeCooldownDays = 30
+
+// CreateAssessment starts the assessment inactive, since its question bank is
+// still empty; admins activate it once enough questions are added.
+func (s *assessmentService) CreateAssessment(ctx context.Context, adminID uint, req *dto.CreateAssessmentRequest) (*dto.AdminAssessmentResponse, error) {
+	skill := strings.TrimSpace(req.Skill)
+	if skill == "" {
+		return nil, errors.New("invalid skill")
+	}
+
+	cooldown := defaultRetakeCooldownDays
+	if req.RetakeCooldownDays != nil {
+		cooldown = *req.RetakeCooldownDays
+	}
+
+	assessment := &entities.SkillAssessment{
+		Skill:              skill,
+		Title:              strings.TrimSpace(req.Title),
+		Description:        req.Description,
+		QuestionCount:      req.QuestionCount,
+		TimeLimitMinutes:   req.TimeLimitMinutes,
+		PassPercent:        req.PassPercent,
+		RetakeCooldownDays: cooldown,
+		CreatedBy:          &adminID,
+	}
+	if err := s.assessmentRepo.Create(ctx, assessment); err != nil {
+		s.logger.Error("Failed to create assessment", "error", err)
+		return nil, errors.New("failed to create assessment")
+	}
+
+	s.logger.Info("Assessment created", "assessment_id", assessment.ID, "skill", assessment.Skill, "admin_id", adminID)
+
+	return mapAdminAssessment(assessment, 0, nil), nil
+}
+
+func (s *assessmentService) ListAllAssessments(ctx context.Context, limit, offset int) ([]*dto.AdminAssessmentResponse, error) {
+	assessments, err := s.assessmentRepo.List(ctx, false, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list assessments", "error", err)
+		return nil, errors.New("failed to get assessments")
+	}
+
+	ids := make([]uint, 0, len(assessments))
+	for _, assessment := range assessments {
+		ids = append(ids, assessment.ID)
+	}
+	bankSizes, err := s.assessmentRepo.CountQuestions(ctx, ids)
+	if err != nil {
+		s.logger.Error("Failed to count assessment questions", "error", err)
+		return nil, errors.New("failed to get assessments")
+	}
+
+	responses := make([]*dto.AdminAssessmentResponse, 0, len(assessments))
+	for _, assessment := range assessments {
+		responses = append(responses, mapAdminAssessment(assessment, bankSizes[assessment.ID], nil))
+	}
+	return responses, nil
+}
+
+func (s *assessmentService) GetAssessmentDetail(ctx context.Context, id uint) (*dto.AdminAssessmentResponse, error) {
+	assessment, err := s.getAssessment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	questions, err := s.assessmentRepo.GetQuestions(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to get assessment questions", "assessment_id", id, "error", err)
+		return nil, errors.New("failed to get assessment")
+	}
+
+	responses := make([]*dto.QuestionResponse, 0, len(questions))
+	for _, question := range questions {
+		responses = append(responses, mapQuestion(question))
+	}
+	return mapAdminAssessment(assessment, int64(len(questions)), responses), nil
+}
+
+func (s *assessmentService) UpdateAssessment(ctx context.Context, id uint, req *dto.UpdateAssessmentRequest) (*dto.AdminAssessmentResponse, error) {
+	assessment, err := s.getAssessment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Title != "" {
+		assessment.Title = strings.TrimSpace(req.Title)
+	}
+	if req.Description != "" {
+		assessment.Description = req.Description
+	}
+	if req.QuestionCount != nil {
+		assessment.QuestionCount = *req.QuestionCount
+	}
+	if req.TimeLimitMinutes != nil {
+		assessment.TimeLimitMinutes = *req.TimeLimitMinutes
+	}
+	if req.PassPercent != nil {
+		assessment.PassPercent = *req.PassPercent
+	}
+	if req.RetakeCooldownDays != nil {
+		assessment.RetakeCooldownDays = *req.RetakeCooldownDays
+	}
+	if req.IsActive != nil {
+		assessment.IsActive = *req.IsActive
+	}
+
+	bankSizes, err := s.assessmentRepo.CountQuestions(ctx, []uint{id})
+	if err != nil {
+		s.logger.Error("Failed to count assessment questions", "assessment_id", id, "error", err)
+		return nil, errors.New("failed to update assessment")
+	}
+	if assessment.IsActive && bankSizes[id] < int64(assessment.QuestionCount) {
+		return nil, errors.New("question bank is smaller than question count")
+	}
+
+	if err := s.assessmentRepo.Update(ctx, assessment); err != nil {
+		s.logger.Error("Failed to update assessment", "assessment_id", id, "error", err)
+		return nil, errors.New("failed to update assessment")
+	}
+
+	return mapAdminAssessment(assessment, bankSizes[id], nil), nil
+}
+
+func (s *assessmentService) AddQuestion(ctx context.Context, assessmentID uint, req *dto.QuestionRequest) (*dto.QuestionResponse, error) {
+	if _, err := s.getAssessment(ctx, assessmentID); err != nil {
+		return nil, err
+	}
+	if *req.CorrectOption >= len(req.Options) {
+		return nil, errors.New("correct option out of range")
+	}
+
+	question := &entities.AssessmentQuestion{
+		AssessmentID:  assessmentID,
+		Prompt:        strings.TrimSpace(req.Prompt),
+		Options:       req.Options,
+		CorrectOption: *req.CorrectOption,
+	}
+	if err := s.assessmentRepo.CreateQuestion(ctx, question); err != nil {
+		s.logger.Error("Failed to create assessment question", "assessment_id", assessmentID, "error", err)
+		return nil, errors.New("failed to add question")
+	}
+
+	return mapQuestion(question), nil
+}
+
+func (s *assessmentService) UpdateQuestion(ctx context.Context, assessmentID, questionID uint, req *dto.QuestionRequest) (*dto.QuestionResponse, error) {
+	question, err := s.getQuestion(ctx, assessmentID, questionID)
+	if err != nil {
+		return nil, err
+	}
+	if *req.CorrectOption >= len(req.Options) {
+		return nil, errors.New("correct option out of range")
+	}
+
+	question.Prompt = strings.TrimSpace(req.Prompt)
+	question.Options = req.Options
+	question.CorrectOption = *req.CorrectOption
+	if err := s.assessmentRepo.UpdateQuestion(ctx, question); err != nil {
+		s.logger.Error("Failed to update assessment question", "question_id", questionID, "error", err)
+		return nil, errors.New("failed to update question")
+	}
+
+	return mapQuestion(question), nil
+}
+
+// DeleteQuestion refuses to shrink the bank of an active assessment below the
+// number of questions each attempt draws.
+func (s *assessmentService) DeleteQuestion(ctx context.Context, assessmentID, questionID uint) error {
+	assessment, err := s.getAssessment(ctx, assessmentID)
+	if err != nil {
+		return err
+	}
+	if _, err := s.getQuestion(ctx, assessmentID, questionID); err != nil {
+		return err
+	}
+
+	if assessment.IsActive {
+		bankSizes, err := s.assessmentRepo.CountQuestions(ctx, []uint{assessmentID})
+		if err != nil {
+			s.logger.Error("Failed to count assessment questions", "assessment_id", assessmentID, "error", err)
+			return errors.New("failed to delete question")
+		}
+		if bankSizes[assessmentID]-1 < int64(assessment.QuestionCount) {
+			return errors.New("question bank is smaller than question count")
+		}
+	}
+
+	if err := s.assessmentRepo.DeleteQuestion(ctx, questionID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("question not found")
+		}
+		s.logger.Error("Failed to delete assessment question", "question_id", questionID, "error", err)
+		return errors.New("failed to delete question")
+	}
+	return nil
+}
+
+func (s *assessmentService) getAssessment(ctx context.Context, id uint) (*entities.SkillAssessment, error) {
+	assessment, err := s.assessmentRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("assessment not found")
+		}
+		s.logger.Error("Failed to get assessment", "assessment_id", id, "error", err)
+		return nil, errors.New("failed to get assessment")
+	}
+	return assessment, nil
+}
+
+func (s *assessmentService) getQuestion(ctx context.Context, assessmentID, questionID uint) (*entities.AssessmentQuestion, error) {
+	question, err := s.assessmentRepo.GetQuestion(ctx, questionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("question not found")
+		}
+		s.logger.Error("Failed to get assessment question", "question_id", questionID, "error", err)
+		return nil, errors.New("failed to get question")
+	}
+	if question.AssessmentID != assessmentID {
+		return nil, errors.New("question not found")
+	}
+	return question, nil
+}
+
+func mapAdminAssessment(assessment *entities.SkillAssessment, bankSize int64, questions []*dto.QuestionResponse) *dto.AdminAssessmentResponse {
+	return &dto.AdminAssessmentResponse{
+		ID:                 assessment.ID,
+		Skill:              assessment.Skill,
+		Title:              assessment.Title,
+		Description:        assessment.Description,
+		QuestionCount:      assessment.QuestionCount,
+		TimeLimitMinutes:   assessment.TimeLimitMinutes,
+		PassPercent:        assessment.PassPercent,
+		RetakeCooldownDays: assessment.RetakeCooldownDays,
+		IsActive:           assessment.IsActive,
+		BankSize:           bankSize,
+		Questions:          questions,
+		CreatedBy:          assessment.CreatedBy,
+		CreatedAt:          assessment.CreatedAt,
+		UpdatedAt:          assessment.UpdatedAt,
+	}
+}
+
+func mapQuestion(question *entities.AssessmentQuestion) *dto.QuestionResponse {
+	correct := question.CorrectOption
+	return &dto.QuestionResponse{
+		ID:            question.ID,
+		Prompt:        question.Prompt,
+		Options:       question.Options,
+		CorrectOption: &correct,
+	}
+}
@@ -3,10 +3,13 @@ package dto
 import "time"
 
 type RegisterRequest struct {
-	Email    string `json:"email" validate:"required,email"`
-	Username string `json:"username" validate:"required,min=3,max=30,alphanum"`
-	FullName string `json:"full_name" validate:"required,min=2,max=100"`
-	Password string `json:"password" validate:"required,min=8,max=128"`
+	Email       string `json:"email" validate:"required,email"`
+	Username    string `json:"username" validate:"required,min=3,max=30,alphanum"`
+	FullName    string `json:"full_name" validate:"required,min=2,max=100"`
+	Password    string `json:"password" validate:"required,max=128"`
+	DateOfBirth string `json:"date_of_birth" validate:"required,datetime=2006-01-02"`
+	Region      string `json:"region" validate:"required,iso3166_1_alpha2"`
+	InviteCode  string `json:"invite_code" validate:"omitempty,max=32"`
 }
 
 type LoginRequest struct {
@@ -14,6 +17,10 @@ type LoginRequest struct {
 	Password string `json:"password" validate:"required"`
 }
 
+type MagicLinkRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
 type VerifyEmailRequest struct {
 	UserID uint   `json:"-"`
 	Code   string `json:"code" validate:"required,len=6"`
@@ -26,7 +33,21 @@ type ForgotPasswordRequest struct {
 type ResetPasswordRequest struct {
 	Email       string `json:"email" validate:"required,email"`
 	Code        string `json:"code" validate:"required,len=6"`
-	NewPassword string `json:"new_password" validate:"required,min=8,max=128"`
+	NewPassword string `json:"new_password" validate:"required,max=128"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required"`
+	NewPassword     string `json:"new_password" validate:"required,max=128"`
+}
+
+type ChangeEmailRequest struct {
+	NewEmail string `json:"new_email" validate:"required,email"`
+	Password string `json:"password" validate:"required"`
+}
+
+type ConfirmEmailChangeRequest struct {
+	Code string `json:"code" validate:"required,len=6"`
 }
 
 type RefreshTokenRequest struct {
@@ -39,6 +60,10 @@ type AuthResponse struct {
 	RefreshToken     string        `json:"refresh_token"`
 	ExpiresAt        time.Time     `json:"expires_at"`
 	RefreshExpiresAt time.Time     `json:"refresh_expires_at"`
+
+	// Challenge is set instead of the tokens when the account has two-factor
+	// authentication on; the login completes at /auth/2fa/verify.
+	Challenge *TwoFactorChallengeResponse `json:"-"`
 }
 
 type TokenResponse struct {
@@ -55,6 +80,130 @@ type UserResponse struct {
 	FullName       string `json:"full_name"`
 	ProfilePicture string `json:"profile_picture,omitempty"`
 	Bio            string `json:"bio,omitempty"`
+	EmailVerified  bool   `json:"email_verified"`
 	IsVerified     bool   `json:"is_verified"`
 	IsPremium      bool   `json:"is_premium"`
 }
+
+type SetRecoveryEmailRequest struct {
+	Email string `json:"email" validate:"required,email,max=255"`
+}
+
+type VerifyRecoveryEmailRequest struct {
+	Code string `json:"code" validate:"required,len=6"`
+}
+
+type GenerateRecoveryCodesRequest struct {
+	Password string `json:"password" validate:"required"`
+	Code     string `json:"code" validate:"omitempty,len=6,numeric"`
+}
+
+type StartRecoveryRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type RecoverWithCodeRequest struct {
+	Email        string `json:"email" validate:"required,email"`
+	RecoveryCode string `json:"recovery_code" validate:"required,min=10,max=20"`
+	NewPassword  string `json:"new_password" validate:"required,max=128"`
+}
+
+type RecoveryStatusResponse struct {
+	RecoveryEmail  string `json:"recovery_email,omitempty"`
+	RecoveryPhone  string `json:"recovery_phone,omitempty"`
+	CodesRemaining int64  `json:"codes_remaining"`
+}
+
+type RecoveryCodesResponse struct {
+	Codes []string `json:"codes"`
+}
+
+type SecurityEventResponse struct {
+	ID         uint       `json:"id"`
+	Type       string     `json:"type"`
+	UserAgent  string     `json:"user_agent,omitempty"`
+	IPAddress  string     `json:"ip_address,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ReportedAt *time.Time `json:"reported_at,omitempty"`
+}
+
+// SessionResponse is a signed-in device. DeviceType, OS and Browser come
+// from the user agent and Location from the IP address when the session was
+// created; any of them may be empty.
+type SessionResponse struct {
+	ID         uint       `json:"id"`
+	DeviceType string     `json:"device_type"`
+	OS         string     `json:"os,omitempty"`
+	Browser    string     `json:"browser,omitempty"`
+	Location   string     `json:"location,omitempty"`
+	UserAgent  string     `json:"user_agent,omitempty"`
+	IPAddress  string     `json:"ip_address,omitempty"`
+	AuthMethod string     `json:"auth_method"`
+	Current    bool       `json:"current"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+}
+
+type TwoFactorChallengeResponse struct {
+	TwoFactorRequired bool      `json:"two_factor_required"`
+	ChallengeToken    string    `json:"challenge_token"`
+	ExpiresAt         time.Time `json:"expires_at"`
+}
+
+type VerifyTwoFactorRequest struct {
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+	Code           string `json:"code" validate:"required_without_all=RecoveryCode SMSCode,omitempty,len=6,numeric"`
+	RecoveryCode   string `json:"recovery_code" validate:"required_without_all=Code SMSCode,omitempty,min=10,max=20"`
+	SMSCode        string `json:"sms_code" validate:"omitempty,len=6,numeric"`
+}
+
+type SendTwoFactorSMSRequest struct {
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+}
+
+type SMSCodeSentResponse struct {
+	SentTo    string    `json:"sent_to"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type TwoFactorSetupRequest struct {
+	Password string `json:"password" validate:"required"`
+}
+
+type TwoFactorSetupResponse struct {
+	Secret          string    `json:"secret"`
+	ProvisioningURI string    `json:"provisioning_uri"`
+	ExpiresAt       time.Time `json:"expires_at"`
+}
+
+type EnableTwoFactorRequest struct {
+	Code string `json:"code" validate:"required,len=6,numeric"`
+}
+
+type DisableTwoFactorRequest struct {
+	Password     string `json:"password" validate:"required"`
+	Code         string `json:"code" validate:"required_without=RecoveryCode,omitempty,len=6,numeric"`
+	RecoveryCode string `json:"recovery_code" validate:"required_without=Code,omitempty,min=10,max=20"`
+}
+
+type TwoFactorStatusResponse struct {
+	Enabled                bool       `json:"enabled"`
+	EnabledAt              *time.Time `json:"enabled_at,omitempty"`
+	SMSAvailable           bool       `json:"sms_available"`
+	RecoveryCodesRemaining int64      `json:"recovery_codes_remaining"`
+}
+
+type SetPhoneRequest struct {
+	PhoneNumber string `json:"phone_number" validate:"required,max=32"`
+	Password    string `json:"password" validate:"required"`
+}
+
+type VerifyPhoneRequest struct {
+	Code string `json:"code" validate:"required,len=6,numeric"`
+}
+
+type PhoneResponse struct {
+	PhoneNumber string     `json:"phone_number,omitempty"`
+	VerifiedAt  *time.Time `json:"verified_at,omitempty"`
+}
@@ -5,9 +5,12 @@ import (
 	"linked-clone/internal/api/auth/dto"
 	"linked-clone/internal/api/auth/service"
 	"linked-clone/internal/middleware"
+	"linked-clone/pkg/agegate"
 	"linked-clone/pkg/errors"
 	"linked-clone/pkg/logger"
+	"linked-clone/pkg/password"
 	"linked-clone/pkg/response"
+	"linked-clone/pkg/signup"
 	validation "linked-clone/pkg/validator"
 	"strconv"
 
@@ -101,6 +104,23 @@ func (h *AuthHandler) Register(c *gin.Context) {
 			response.Conflict(c, appErr.Message, appErr.Details)
 			return
 
+		case err.Error() == "sso required":
+			response.Forbidden(c, "Your organization requires single sign-on")
+			return
+
+		case err.Error() == "invite code required", err.Error() == "invalid invite code":
+			h.logger.WithTraceID(traceID).LogUserAction(ctx, logger.UserActionLog{
+				Action:      "register_failed",
+				Resource:    "user",
+				IP:          c.ClientIP(),
+				UserAgent:   c.Request.UserAgent(),
+				Success:     false,
+				ErrorReason: "invite_code_invalid",
+			})
+
+			response.FieldValidationError(c, "InviteCode", "invite", "Registration is by invitation; "+err.Error())
+			return
+
 		case err.Error() == "username already taken":
 			appErr := errors.ConflictError("Username already taken").
 				WithContext("username", req.Username).
@@ -122,6 +142,67 @@ func (h *AuthHandler) Register(c *gin.Context) {
 			response.Conflict(c, appErr.Message, appErr.Details)
 			return
 
+		case agegate.IsAgeError(err):
+			h.logger.WithTraceID(traceID).LogUserAction(ctx, logger.UserActionLog{
+				Action:      "register_failed",
+				Resource:    "user",
+				IP:          c.ClientIP(),
+				UserAgent:   c.Request.UserAgent(),
+				Success:     false,
+				ErrorReason: "age_requirement_not_met",
+				Details: map[string]interface{}{
+					"region": req.Region,
+				},
+			})
+
+			response.FieldValidationError(c, "DateOfBirth", "min_age", err.Error())
+			return
+
+		case signup.IsRejected(err):
+			h.logger.WithTraceID(traceID).LogUserAction(ctx, logger.UserActionLog{
+				Action:      "register_failed",
+				Resource:    "user",
+				IP:          c.ClientIP(),
+				UserAgent:   c.Request.UserAgent(),
+				Success:     false,
+				ErrorReason: "email_domain_rejected",
+				Details: map[string]interface{}{
+					"domain": signup.Domain(req.Email),
+				},
+			})
+
+			response.FieldValidationError(c, "Email", "email_domain", err.Error())
+			return
+
+		case password.IsRejected(err):
+			h.logger.WithTraceID(traceID).LogUserAction(ctx, logger.UserActionLog{
+				Action:      "register_failed",
+				Resource:    "user",
+				IP:          c.ClientIP(),
+				UserAgent:   c.Request.UserAgent(),
+				Success:     false,
+				ErrorReason: "password_rejected",
+			})
+
+			response.FieldValidationError(c, "Password", "password_policy", err.Error())
+			return
+
+		case signup.IsThrottled(err):
+			h.logger.WithTraceID(traceID).LogUserAction(ctx, logger.UserActionLog{
+				Action:      "register_failed",
+				Resource:    "user",
+				IP:          c.ClientIP(),
+				UserAgent:   c.Request.UserAgent(),
+				Success:     false,
+				ErrorReason: "registration_throttled",
+				Details: map[string]interface{}{
+					"domain": signup.Domain(req.Email),
+				},
+			})
+
+			response.TooManyRequests(c, err.Error())
+			return
+
 		default:
 			appErr := errors.InternalError("Registration failed").
 				WithContext("original_error", err.Error()).
@@ -210,6 +291,12 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		})
 
 		switch {
+		case err.Error() == "sso required":
+			response.Forbidden(c, "Your organization requires single sign-on")
+			return
+		case err.Error() == "account suspended":
+			response.Forbidden(c, "Your account has been suspended")
+			return
 		case err.Error() == "invalid email or password":
 			appErr := errors.AuthenticationError("Invalid credentials").
 				WithComponent("auth_service").
@@ -226,6 +313,20 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		}
 	}
 
+	if result.Challenge != nil {
+		h.logger.WithTraceID(traceID).LogAuthEvent(ctx, logger.AuthEventLog{
+			Email:     req.Email,
+			Action:    "login_two_factor_required",
+			IP:        c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+			Success:   false,
+		})
+
+		c.Header("Cache-Control", "no-store")
+		response.SuccessWithMessage(c, "Two-factor authentication required", result.Challenge)
+		return
+	}
+
 	h.logger.WithTraceID(traceID).LogAuthEvent(ctx, logger.AuthEventLog{
 		UserID:    result.User.ID,
 		Email:     result.User.Email,
@@ -239,6 +340,183 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	response.Success(c, result)
 }
 
+func (h *AuthHandler) VerifyTwoFactor(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceID := middleware.GetTraceID(c)
+
+	var req dto.VerifyTwoFactorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		appErr := errors.ValidationError("Invalid request body").
+			WithContext("raw_error", err.Error()).
+			WithComponent("auth_handler").
+			WithOperation("verify_two_factor")
+
+		response.BadRequest(c, appErr.Message, appErr.Details)
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	ctxWithGin := context.WithValue(ctx, "gin_context", c)
+
+	result, err := h.authService.VerifyTwoFactor(ctxWithGin, &req)
+	if err != nil {
+		h.logger.WithTraceID(traceID).LogSecurityEvent(ctx, logger.SecurityEventLog{
+			EventType:   "failed_two_factor",
+			Description: "Failed two-factor login attempt",
+			Severity:    "high",
+			IP:          c.ClientIP(),
+			UserAgent:   c.Request.UserAgent(),
+			Details: map[string]interface{}{
+				"error":              err.Error(),
+				"used_recovery_code": req.RecoveryCode != "",
+				"used_sms_code":      req.SMSCode != "",
+			},
+		})
+
+		switch err.Error() {
+		case "two-factor challenge expired or invalid", "invalid two-factor code", "invalid recovery code":
+			response.Unauthorized(c, err.Error())
+		case "too many two-factor attempts":
+			response.TooManyRequests(c, err.Error())
+		case "account suspended":
+			response.Forbidden(c, "Your account has been suspended")
+		default:
+			appErr := errors.InternalError("Login failed").
+				WithContext("original_error", err.Error()).
+				WithComponent("auth_service").
+				WithOperation("verify_two_factor")
+			response.InternalServerError(c, appErr.Message, appErr.UserMessage)
+		}
+		return
+	}
+
+	h.logger.WithTraceID(traceID).LogAuthEvent(ctx, logger.AuthEventLog{
+		UserID:    result.User.ID,
+		Email:     result.User.Email,
+		Action:    "login_success",
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		Success:   true,
+		TokenType: "access_token",
+	})
+
+	response.Success(c, result)
+}
+
+func (h *AuthHandler) RequestMagicLink(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceID := middleware.GetTraceID(c)
+
+	var req dto.MagicLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		appErr := errors.ValidationError("Invalid request body").
+			WithContext("raw_error", err.Error()).
+			WithComponent("auth_handler").
+			WithOperation("request_magic_link")
+		response.BadRequest(c, appErr.Message, appErr.Details)
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	h.logger.WithTraceID(traceID).LogAuthEvent(ctx, logger.AuthEventLog{
+		Email:     req.Email,
+		Action:    "magic_link_request",
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		Success:   true,
+	})
+
+	if err := h.authService.RequestMagicLink(ctx, &req); err != nil {
+		if err.Error() == "sso required" {
+			response.Forbidden(c, "Your organization requires single sign-on")
+			return
+		}
+		h.logger.WithTraceID(traceID).Error("Magic link request failed",
+			"error", err.Error(),
+			"email", req.Email)
+	}
+
+	response.SuccessWithMessage(c, "If an account exists for this email, a sign-in link has been sent to it", nil)
+}
+
+func (h *AuthHandler) VerifyMagicLink(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceID := middleware.GetTraceID(c)
+
+	token := c.Query("token")
+	if token == "" {
+		response.BadRequest(c, "Missing token", "token query parameter is required")
+		return
+	}
+
+	// The token is a bearer credential in the URL; keep it out of caches and
+	// of the Referer header sent to anything the response links to.
+	c.Header("Cache-Control", "no-store")
+	c.Header("Referrer-Policy", "no-referrer")
+
+	ctxWithGin := context.WithValue(ctx, "gin_context", c)
+
+	result, err := h.authService.VerifyMagicLink(ctxWithGin, token)
+	if err != nil {
+		h.logger.WithTraceID(traceID).LogSecurityEvent(ctx, logger.SecurityEventLog{
+			EventType:   "failed_magic_link",
+			Description: "Failed magic link sign-in",
+			Severity:    "medium",
+			IP:          c.ClientIP(),
+			UserAgent:   c.Request.UserAgent(),
+			Details: map[string]interface{}{
+				"error": err.Error(),
+			},
+		})
+
+		switch err.Error() {
+		case "invalid or expired magic link":
+			response.Unauthorized(c, err.Error())
+		case "sso required":
+			response.Forbidden(c, "Your organization requires single sign-on")
+		case "account suspended":
+			response.Forbidden(c, "Your account has been suspended")
+		default:
+			appErr := errors.InternalError("Login failed").
+				WithContext("original_error", err.Error()).
+				WithComponent("auth_service").
+				WithOperation("verify_magic_link")
+			response.InternalServerError(c, appErr.Message, appErr.UserMessage)
+		}
+		return
+	}
+
+	if result.Challenge != nil {
+		response.SuccessWithMessage(c, "Two-factor authentication required", result.Challenge)
+		return
+	}
+
+	h.logger.WithTraceID(traceID).LogAuthEvent(ctx, logger.AuthEventLog{
+		UserID:    result.User.ID,
+		Email:     result.User.Email,
+		Action:    "magic_link_login_success",
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		Success:   true,
+		TokenType: "access_token",
+	})
+
+	response.Success(c, &dto.TokenResponse{
+		AccessToken:      result.AccessToken,
+		RefreshToken:     result.RefreshToken,
+		ExpiresAt:        result.ExpiresAt,
+		RefreshExpiresAt: result.RefreshExpiresAt,
+	})
+}
+
 func (h *AuthHandler) VerifyEmail(c *gin.Context) {
 	ctx := c.Request.Context()
 	traceID := middleware.GetTraceID(c)
@@ -379,7 +657,9 @@ func (h *AuthHandler) ResetPassword(c *gin.Context) {
 		Success:   false,
 	})
 
-	if err := h.authService.ResetPassword(ctx, &req); err != nil {
+	ctxWithGin := context.WithValue(ctx, "gin_context", c)
+
+	if err := h.authService.ResetPassword(ctxWithGin, &req); err != nil {
 		h.logger.WithTraceID(traceID).LogAuthEvent(ctx, logger.AuthEventLog{
 			Email:      req.Email,
 			Action:     "password_reset_failed",
@@ -402,6 +682,9 @@ func (h *AuthHandler) ResetPassword(c *gin.Context) {
 				WithOperation("reset_password")
 			response.BadRequest(c, appErr.Message, appErr.Details)
 			return
+		case password.IsRejected(err):
+			response.FieldValidationError(c, "NewPassword", "password_policy", err.Error())
+			return
 		default:
 			appErr := errors.InternalError("Password reset failed").
 				WithContext("original_error", err.Error()).
@@ -423,6 +706,205 @@ func (h *AuthHandler) ResetPassword(c *gin.Context) {
 	response.Success(c, gin.H{"message": "Password reset successfully"})
 }
 
+func (h *AuthHandler) ChangePassword(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceID := middleware.GetTraceID(c)
+	userID := middleware.GetUserID(c)
+
+	var req dto.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		appErr := errors.ValidationError("Invalid request body").
+			WithContext("raw_error", err.Error()).
+			WithComponent("auth_handler").
+			WithOperation("change_password")
+		response.BadRequest(c, appErr.Message, appErr.Details)
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	ctxWithGin := context.WithValue(ctx, "gin_context", c)
+
+	if err := h.authService.ChangePassword(ctxWithGin, userID, &req); err != nil {
+		h.logger.WithTraceID(traceID).LogAuthEvent(ctx, logger.AuthEventLog{
+			UserID:     userID,
+			Action:     "password_change_failed",
+			IP:         c.ClientIP(),
+			UserAgent:  c.Request.UserAgent(),
+			Success:    false,
+			FailReason: err.Error(),
+		})
+
+		switch {
+		case err.Error() == "invalid current password":
+			response.FieldValidationError(c, "CurrentPassword", "current_password", "Current password is incorrect")
+			return
+		case err.Error() == "new password must differ from the current password":
+			response.FieldValidationError(c, "NewPassword", "password_reused", err.Error())
+			return
+		case password.IsRejected(err):
+			response.FieldValidationError(c, "NewPassword", "password_policy", err.Error())
+			return
+		case err.Error() == "user not found":
+			response.NotFound(c, "User not found")
+			return
+		default:
+			appErr := errors.InternalError("Password change failed").
+				WithContext("original_error", err.Error()).
+				WithComponent("auth_service").
+				WithOperation("change_password")
+			response.InternalServerError(c, appErr.Message, appErr.UserMessage)
+			return
+		}
+	}
+
+	h.logger.WithTraceID(traceID).LogAuthEvent(ctx, logger.AuthEventLog{
+		UserID:    userID,
+		Action:    "password_change_success",
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		Success:   true,
+	})
+
+	response.Success(c, gin.H{"message": "Password changed successfully"})
+}
+
+func (h *AuthHandler) RequestEmailChange(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceID := middleware.GetTraceID(c)
+	userID := middleware.GetUserID(c)
+
+	var req dto.ChangeEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		appErr := errors.ValidationError("Invalid request body").
+			WithContext("raw_error", err.Error()).
+			WithComponent("auth_handler").
+			WithOperation("request_email_change")
+		response.BadRequest(c, appErr.Message, appErr.Details)
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	if err := h.authService.RequestEmailChange(ctx, userID, &req); err != nil {
+		h.logger.WithTraceID(traceID).LogAuthEvent(ctx, logger.AuthEventLog{
+			UserID:     userID,
+			Action:     "email_change_request_failed",
+			IP:         c.ClientIP(),
+			UserAgent:  c.Request.UserAgent(),
+			Success:    false,
+			FailReason: err.Error(),
+		})
+
+		switch {
+		case err.Error() == "invalid password":
+			response.FieldValidationError(c, "Password", "current_password", "Password is incorrect")
+			return
+		case err.Error() == "new email must differ from the current email":
+			response.FieldValidationError(c, "NewEmail", "email_unchanged", err.Error())
+			return
+		case signup.IsRejected(err):
+			response.FieldValidationError(c, "NewEmail", "email_domain", err.Error())
+			return
+		case err.Error() == "sso required":
+			response.Forbidden(c, "That address belongs to an organization that requires single sign-on")
+			return
+		case err.Error() == "email already registered":
+			response.Conflict(c, "Email already registered", err.Error())
+			return
+		case err.Error() == "user not found":
+			response.NotFound(c, "User not found")
+			return
+		default:
+			appErr := errors.InternalError("Email change failed").
+				WithContext("original_error", err.Error()).
+				WithComponent("auth_service").
+				WithOperation("request_email_change")
+			response.InternalServerError(c, appErr.Message, appErr.UserMessage)
+			return
+		}
+	}
+
+	h.logger.WithTraceID(traceID).LogAuthEvent(ctx, logger.AuthEventLog{
+		UserID:    userID,
+		Action:    "email_change_requested",
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		Success:   true,
+	})
+
+	response.Success(c, gin.H{"message": "A verification code has been sent to the new address"})
+}
+
+func (h *AuthHandler) ConfirmEmailChange(c *gin.Context) {
+	ctx := c.Request.Context()
+	traceID := middleware.GetTraceID(c)
+	userID := middleware.GetUserID(c)
+
+	var req dto.ConfirmEmailChangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		appErr := errors.ValidationError("Invalid request body").
+			WithContext("raw_error", err.Error()).
+			WithComponent("auth_handler").
+			WithOperation("confirm_email_change")
+		response.BadRequest(c, appErr.Message, appErr.Details)
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	ctxWithGin := context.WithValue(ctx, "gin_context", c)
+
+	if err := h.authService.ConfirmEmailChange(ctxWithGin, userID, &req); err != nil {
+		h.logger.WithTraceID(traceID).LogAuthEvent(ctx, logger.AuthEventLog{
+			UserID:     userID,
+			Action:     "email_change_failed",
+			IP:         c.ClientIP(),
+			UserAgent:  c.Request.UserAgent(),
+			Success:    false,
+			FailReason: err.Error(),
+		})
+
+		switch err.Error() {
+		case "verification code expired or invalid", "invalid verification code":
+			response.BadRequest(c, "Invalid verification code", err.Error())
+			return
+		case "email already registered":
+			response.Conflict(c, "Email already registered", err.Error())
+			return
+		case "user not found":
+			response.NotFound(c, "User not found")
+			return
+		default:
+			appErr := errors.InternalError("Email change failed").
+				WithContext("original_error", err.Error()).
+				WithComponent("auth_service").
+				WithOperation("confirm_email_change")
+			response.InternalServerError(c, appErr.Message, appErr.UserMessage)
+			return
+		}
+	}
+
+	h.logger.WithTraceID(traceID).LogAuthEvent(ctx, logger.AuthEventLog{
+		UserID:    userID,
+		Action:    "email_changed",
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		Success:   true,
+	})
+
+	response.Success(c, gin.H{"message": "Email changed; all sessions have been signed out"})
+}
+
 func (h *AuthHandler) RefreshToken(c *gin.Context) {
 	ctx := c.Request.Context()
 	traceID := middleware.GetTraceID(c)
@@ -491,6 +973,12 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 		})
 
 		switch {
+		case err.Error() == "sso required":
+			response.Forbidden(c, "Your organization requires single sign-on")
+			return
+		case err.Error() == "account suspended":
+			response.Forbidden(c, "Your account has been suspended")
+			return
 		case err.Error() == "invalid refresh token":
 			appErr := errors.AuthenticationError("Invalid refresh token").
 				WithComponent("auth_service").
@@ -598,7 +1086,7 @@ func (h *AuthHandler) GetActiveSessions(c *gin.Context) {
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
 
-	sessions, err := h.authService.GetUserActiveSessions(ctx, userID, limit, offset)
+	sessions, err := h.authService.GetUserActiveSessions(ctx, userID, middleware.GetSessionID(c), limit, offset)
 	if err != nil {
 		h.logger.Error("Failed to get active sessions", "error", err)
 		response.InternalServerError(c, "Failed to get sessions", err.Error())
@@ -623,7 +1111,9 @@ func (h *AuthHandler) RevokeSession(c *gin.Context) {
 		return
 	}
 
-	if err := h.authService.RevokeSession(ctx, userID, uint(sessionID)); err != nil {
+	ctxWithGin := context.WithValue(ctx, "gin_context", c)
+
+	if err := h.authService.RevokeSession(ctxWithGin, userID, uint(sessionID)); err != nil {
 		h.logger.Error("Failed to revoke session", "error", err)
 		response.InternalServerError(c, "Failed to revoke session", err.Error())
 		return
@@ -636,7 +1126,9 @@ func (h *AuthHandler) RevokeAllSessions(c *gin.Context) {
 	ctx := c.Request.Context()
 	userID := middleware.GetUserID(c)
 
-	if err := h.authService.RevokeAllUserSessions(ctx, userID); err != nil {
+	ctxWithGin := context.WithValue(ctx, "gin_context", c)
+
+	if err := h.authService.RevokeAllUserSessions(ctxWithGin, userID); err != nil {
 		h.logger.Error("Failed to revoke all sessions", "error", err)
 		response.InternalServerError(c, "Failed to revoke sessions", err.Error())
 		return
@@ -644,3 +1136,26 @@ func (h *AuthHandler) RevokeAllSessions(c *gin.Context) {
 
 	response.Success(c, gin.H{"message": "All sessions revoked successfully"})
 }
+
+func (h *AuthHandler) RevokeOtherSessions(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := middleware.GetUserID(c)
+
+	ctxWithGin := context.WithValue(ctx, "gin_context", c)
+
+	revoked, err := h.authService.RevokeOtherSessions(ctxWithGin, userID, middleware.GetSessionID(c))
+	if err != nil {
+		if err.Error() == "current session unknown" {
+			response.BadRequest(c, "Current session unknown", "sign in again to manage other sessions")
+			return
+		}
+		h.logger.Error("Failed to revoke other sessions", "error", err)
+		response.InternalServerError(c, "Failed to revoke sessions", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{
+		"message": "Signed out of all other sessions",
+		"revoked": revoked,
+	})
+}
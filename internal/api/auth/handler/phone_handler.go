@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/auth/dto"
+	"linked-clone/internal/api/auth/service"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	"linked-clone/pkg/sms"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PhoneHandler struct {
+	phoneService service.PhoneService
+	validator    validation.Validator
+	logger       logger.StructuredLogger
+}
+
+func NewPhoneHandler(phoneService service.PhoneService, validator validation.Validator, logger logger.StructuredLogger) *PhoneHandler {
+	return &PhoneHandler{
+		phoneService: phoneService,
+		validator:    validator,
+		logger:       logger,
+	}
+}
+
+func (h *PhoneHandler) GetPhone(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	phone, err := h.phoneService.GetPhone(c.Request.Context(), userID)
+	if err != nil {
+		response.Error(c, phoneErrorStatus(err), "Failed to get phone number", err.Error())
+		return
+	}
+
+	response.Success(c, phone)
+}
+
+func (h *PhoneHandler) SetPhone(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.SetPhoneRequest
+	if !h.bind(c, &req) {
+		return
+	}
+
+	if err := h.phoneService.SetPhone(c.Request.Context(), userID, &req); err != nil {
+		if errors.Is(err, sms.ErrInvalidNumber) {
+			response.FieldValidationError(c, "PhoneNumber", "e164", err.Error())
+			return
+		}
+		response.Error(c, phoneErrorStatus(err), "Failed to set phone number", err.Error())
+		return
+	}
+
+	response.AcceptedWithMessage(c, "Verification code sent to phone", nil)
+}
+
+func (h *PhoneHandler) VerifyPhone(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.VerifyPhoneRequest
+	if !h.bind(c, &req) {
+		return
+	}
+
+	ctxWithGin := context.WithValue(c.Request.Context(), "gin_context", c)
+	phone, err := h.phoneService.VerifyPhone(ctxWithGin, userID, &req)
+	if err != nil {
+		response.Error(c, phoneErrorStatus(err), "Failed to verify phone number", err.Error())
+		return
+	}
+
+	h.logSecurityEvent(c, userID, "phone_changed", "Phone number verified", "medium", nil)
+	response.SuccessWithMessage(c, "Phone number verified", phone)
+}
+
+func (h *PhoneHandler) RemovePhone(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	ctxWithGin := context.WithValue(c.Request.Context(), "gin_context", c)
+	if err := h.phoneService.RemovePhone(ctxWithGin, userID); err != nil {
+		response.Error(c, phoneErrorStatus(err), "Failed to remove phone number", err.Error())
+		return
+	}
+
+	h.logSecurityEvent(c, userID, "phone_changed", "Phone number removed", "medium", nil)
+	response.SuccessWithMessage(c, "Phone number removed", nil)
+}
+
+func (h *PhoneHandler) bind(c *gin.Context, req interface{}) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return false
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		response.ValidationErrors(c, err)
+		return false
+	}
+	return true
+}
+
+func (h *PhoneHandler) logSecurityEvent(c *gin.Context, userID uint, eventType, description, severity string, details map[string]interface{}) {
+	h.logger.WithTraceID(middleware.GetTraceID(c)).LogSecurityEvent(c.Request.Context(), logger.SecurityEventLog{
+		EventType:   eventType,
+		Description: description,
+		Severity:    severity,
+		IP:          c.ClientIP(),
+		UserAgent:   c.Request.UserAgent(),
+		UserID:      userID,
+		Details:     details,
+	})
+}
+
+func phoneErrorStatus(err error) int {
+	switch err.Error() {
+	case "user not found", "phone number not set":
+		return http.StatusNotFound
+	case "invalid password":
+		return http.StatusUnauthorized
+	case "phone number is already verified":
+		return http.StatusConflict
+	case "verification code expired or invalid", "invalid verification code":
+		return http.StatusBadRequest
+	case "too many codes sent, try again later":
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusInternalServerError
+	}
+}
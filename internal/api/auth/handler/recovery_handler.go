@@ -0,0 +1,203 @@
+package handler
+
+import (
+	"context"
+	"linked-clone/internal/api/auth/dto"
+	"linked-clone/internal/api/auth/service"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/password"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RecoveryHandler struct {
+	recoveryService service.RecoveryService
+	validator       validation.Validator
+	logger          logger.StructuredLogger
+}
+
+func NewRecoveryHandler(recoveryService service.RecoveryService, validator validation.Validator, logger logger.StructuredLogger) *RecoveryHandler {
+	return &RecoveryHandler{
+		recoveryService: recoveryService,
+		validator:       validator,
+		logger:          logger,
+	}
+}
+
+func (h *RecoveryHandler) GetStatus(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	status, err := h.recoveryService.GetStatus(c.Request.Context(), userID)
+	if err != nil {
+		response.Error(c, recoveryErrorStatus(err), "Failed to get recovery settings", err.Error())
+		return
+	}
+
+	response.Success(c, status)
+}
+
+func (h *RecoveryHandler) SetRecoveryEmail(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.SetRecoveryEmailRequest
+	if !h.bind(c, &req) {
+		return
+	}
+
+	if err := h.recoveryService.SetRecoveryEmail(c.Request.Context(), userID, &req); err != nil {
+		response.Error(c, recoveryErrorStatus(err), "Failed to set recovery email", err.Error())
+		return
+	}
+
+	response.AcceptedWithMessage(c, "Verification code sent to recovery email", nil)
+}
+
+func (h *RecoveryHandler) VerifyRecoveryEmail(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.VerifyRecoveryEmailRequest
+	if !h.bind(c, &req) {
+		return
+	}
+
+	if err := h.recoveryService.VerifyRecoveryEmail(c.Request.Context(), userID, &req); err != nil {
+		response.Error(c, recoveryErrorStatus(err), "Failed to verify recovery email", err.Error())
+		return
+	}
+
+	h.logSecurityEvent(c, userID, "recovery_email_changed", "Recovery email added", "medium", nil)
+	response.SuccessWithMessage(c, "Recovery email verified", nil)
+}
+
+func (h *RecoveryHandler) RemoveRecoveryEmail(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	if err := h.recoveryService.RemoveRecoveryEmail(c.Request.Context(), userID); err != nil {
+		response.Error(c, recoveryErrorStatus(err), "Failed to remove recovery email", err.Error())
+		return
+	}
+
+	h.logSecurityEvent(c, userID, "recovery_email_changed", "Recovery email removed", "medium", nil)
+	response.SuccessWithMessage(c, "Recovery email removed", nil)
+}
+
+func (h *RecoveryHandler) GenerateRecoveryCodes(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.GenerateRecoveryCodesRequest
+	if !h.bind(c, &req) {
+		return
+	}
+
+	codes, err := h.recoveryService.GenerateRecoveryCodes(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.logSecurityEvent(c, userID, "recovery_codes_failed", "Failed to generate recovery codes", "medium",
+			map[string]interface{}{"error": err.Error()})
+		response.Error(c, recoveryErrorStatus(err), "Failed to generate recovery codes", err.Error())
+		return
+	}
+
+	h.logSecurityEvent(c, userID, "recovery_codes_generated", "Recovery codes regenerated", "medium", nil)
+	c.Header("Cache-Control", "no-store")
+	response.CreatedWithMessage(c, "Store these codes safely; they will not be shown again", codes)
+}
+
+func (h *RecoveryHandler) StartEmailRecovery(c *gin.Context) {
+	var req dto.StartRecoveryRequest
+	if !h.bind(c, &req) {
+		return
+	}
+
+	if err := h.recoveryService.StartEmailRecovery(c.Request.Context(), &req); err != nil {
+		response.Error(c, recoveryErrorStatus(err), "Failed to start recovery", err.Error())
+		return
+	}
+
+	h.logSecurityEvent(c, 0, "recovery_email_requested", "Account recovery via recovery email requested", "low",
+		map[string]interface{}{"email": req.Email})
+	response.SuccessWithMessage(c, "If the account has a recovery email, a reset code has been sent to it", nil)
+}
+
+func (h *RecoveryHandler) StartSMSRecovery(c *gin.Context) {
+	var req dto.StartRecoveryRequest
+	if !h.bind(c, &req) {
+		return
+	}
+
+	if err := h.recoveryService.StartSMSRecovery(c.Request.Context(), &req); err != nil {
+		response.Error(c, recoveryErrorStatus(err), "Failed to start recovery", err.Error())
+		return
+	}
+
+	h.logSecurityEvent(c, 0, "recovery_sms_requested", "Account recovery via phone requested", "low",
+		map[string]interface{}{"email": req.Email})
+	response.SuccessWithMessage(c, "If the account has a verified phone, a reset code has been texted to it", nil)
+}
+
+func (h *RecoveryHandler) RecoverWithCode(c *gin.Context) {
+	var req dto.RecoverWithCodeRequest
+	if !h.bind(c, &req) {
+		return
+	}
+
+	ctxWithGin := context.WithValue(c.Request.Context(), "gin_context", c)
+	userID, err := h.recoveryService.RecoverWithCode(ctxWithGin, &req)
+	if password.IsRejected(err) {
+		response.FieldValidationError(c, "NewPassword", "password_policy", err.Error())
+		return
+	}
+	if err != nil {
+		h.logSecurityEvent(c, userID, "recovery_code_failed", "Failed recovery code attempt", "high",
+			map[string]interface{}{"email": req.Email, "error": err.Error()})
+		response.Error(c, recoveryErrorStatus(err), "Account recovery failed", err.Error())
+		return
+	}
+
+	h.logSecurityEvent(c, userID, "recovery_code_used", "Account recovered with a recovery code", "high", nil)
+	response.SuccessWithMessage(c, "Password reset; all sessions have been signed out", nil)
+}
+
+func (h *RecoveryHandler) bind(c *gin.Context, req interface{}) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return false
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		response.ValidationErrors(c, err)
+		return false
+	}
+	return true
+}
+
+func (h *RecoveryHandler) logSecurityEvent(c *gin.Context, userID uint, eventType, description, severity string, details map[string]interface{}) {
+	h.logger.WithTraceID(middleware.GetTraceID(c)).LogSecurityEvent(c.Request.Context(), logger.SecurityEventLog{
+		EventType:   eventType,
+		Description: description,
+		Severity:    severity,
+		IP:          c.ClientIP(),
+		UserAgent:   c.Request.UserAgent(),
+		UserID:      userID,
+		Details:     details,
+	})
+}
+
+func recoveryErrorStatus(err error) int {
+	switch err.Error() {
+	case "user not found":
+		return http.StatusNotFound
+	case "invalid password", "invalid recovery code", "invalid two-factor code":
+		return http.StatusUnauthorized
+	case "too many recovery attempts":
+		return http.StatusTooManyRequests
+	case "recovery email must differ from your primary email", "verification code expired or invalid", "invalid verification code",
+		"two-factor code required":
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"context"
+	"linked-clone/internal/api/auth/service"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SecurityHandler struct {
+	securityService service.SecurityService
+	logger          logger.StructuredLogger
+}
+
+func NewSecurityHandler(securityService service.SecurityService, logger logger.StructuredLogger) *SecurityHandler {
+	return &SecurityHandler{
+		securityService: securityService,
+		logger:          logger,
+	}
+}
+
+func (h *SecurityHandler) GetActivity(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	events, err := h.securityService.GetActivity(c.Request.Context(), userID, limit, offset)
+	if err != nil {
+		response.Error(c, securityErrorStatus(err), "Failed to get security activity", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{
+		"events": events,
+		"limit":  limit,
+		"offset": offset,
+	})
+}
+
+func (h *SecurityHandler) ReportNotMe(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	eventID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "Invalid event ID", err.Error())
+		return
+	}
+
+	ctxWithGin := context.WithValue(c.Request.Context(), "gin_context", c)
+	if err := h.securityService.ReportNotMe(ctxWithGin, userID, uint(eventID)); err != nil {
+		response.Error(c, securityErrorStatus(err), "Failed to secure account", err.Error())
+		return
+	}
+
+	h.logger.WithTraceID(middleware.GetTraceID(c)).LogSecurityEvent(c.Request.Context(), logger.SecurityEventLog{
+		EventType:   "activity_reported",
+		Description: "User reported account activity as not theirs",
+		Severity:    "high",
+		IP:          c.ClientIP(),
+		UserAgent:   c.Request.UserAgent(),
+		UserID:      userID,
+		Details:     map[string]interface{}{"security_event_id": eventID},
+	})
+
+	response.SuccessWithMessage(c, "All sessions signed out; check your email for a code to set a new password", nil)
+}
+
+func securityErrorStatus(err error) int {
+	switch err.Error() {
+	case "security event not found", "user not found":
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
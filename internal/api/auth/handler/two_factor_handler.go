@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"context"
+	"linked-clone/internal/api/auth/dto"
+	"linked-clone/internal/api/auth/service"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TwoFactorHandler struct {
+	twoFactorService service.TwoFactorService
+	validator        validation.Validator
+	logger           logger.StructuredLogger
+}
+
+func NewTwoFactorHandler(twoFactorService service.TwoFactorService, validator validation.Validator, logger logger.StructuredLogger) *TwoFactorHandler {
+	return &TwoFactorHandler{
+		twoFactorService: twoFactorService,
+		validator:        validator,
+		logger:           logger,
+	}
+}
+
+func (h *TwoFactorHandler) GetStatus(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	status, err := h.twoFactorService.GetStatus(c.Request.Context(), userID)
+	if err != nil {
+		response.Error(c, twoFactorErrorStatus(err), "Failed to get two-factor settings", err.Error())
+		return
+	}
+
+	response.Success(c, status)
+}
+
+func (h *TwoFactorHandler) Setup(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.TwoFactorSetupRequest
+	if !h.bind(c, &req) {
+		return
+	}
+
+	setup, err := h.twoFactorService.Setup(c.Request.Context(), userID, &req)
+	if err != nil {
+		response.Error(c, twoFactorErrorStatus(err), "Failed to start two-factor setup", err.Error())
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	response.SuccessWithMessage(c, "Scan the code with your authenticator app, then confirm with a code from it", setup)
+}
+
+func (h *TwoFactorHandler) Enable(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.EnableTwoFactorRequest
+	if !h.bind(c, &req) {
+		return
+	}
+
+	ctxWithGin := context.WithValue(c.Request.Context(), "gin_context", c)
+	codes, err := h.twoFactorService.Enable(ctxWithGin, userID, &req)
+	if err != nil {
+		response.Error(c, twoFactorErrorStatus(err), "Failed to enable two-factor authentication", err.Error())
+		return
+	}
+
+	h.logSecurityEvent(c, userID, "two_factor_enabled", "Two-factor authentication enabled", "medium", nil)
+	c.Header("Cache-Control", "no-store")
+	response.CreatedWithMessage(c, "Two-factor authentication enabled; store these recovery codes safely, they will not be shown again", codes)
+}
+
+func (h *TwoFactorHandler) Disable(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.DisableTwoFactorRequest
+	if !h.bind(c, &req) {
+		return
+	}
+
+	ctxWithGin := context.WithValue(c.Request.Context(), "gin_context", c)
+	if err := h.twoFactorService.Disable(ctxWithGin, userID, &req); err != nil {
+		h.logSecurityEvent(c, userID, "two_factor_disable_failed", "Failed attempt to disable two-factor authentication", "high",
+			map[string]interface{}{"error": err.Error()})
+		response.Error(c, twoFactorErrorStatus(err), "Failed to disable two-factor authentication", err.Error())
+		return
+	}
+
+	h.logSecurityEvent(c, userID, "two_factor_disabled", "Two-factor authentication disabled", "high", nil)
+	response.SuccessWithMessage(c, "Two-factor authentication disabled", nil)
+}
+
+func (h *TwoFactorHandler) SendSMSCode(c *gin.Context) {
+	var req dto.SendTwoFactorSMSRequest
+	if !h.bind(c, &req) {
+		return
+	}
+
+	sent, err := h.twoFactorService.SendSMSCode(c.Request.Context(), &req)
+	if err != nil {
+		response.Error(c, twoFactorErrorStatus(err), "Failed to send sign-in code", err.Error())
+		return
+	}
+
+	response.AcceptedWithMessage(c, "Sign-in code sent to your phone", sent)
+}
+
+func (h *TwoFactorHandler) bind(c *gin.Context, req interface{}) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return false
+	}
+
+	if err := h.validator.Validate(req); err != nil {
+		response.ValidationErrors(c, err)
+		return false
+	}
+	return true
+}
+
+func (h *TwoFactorHandler) logSecurityEvent(c *gin.Context, userID uint, eventType, description, severity string, details map[string]interface{}) {
+	h.logger.WithTraceID(middleware.GetTraceID(c)).LogSecurityEvent(c.Request.Context(), logger.SecurityEventLog{
+		EventType:   eventType,
+		Description: description,
+		Severity:    severity,
+		IP:          c.ClientIP(),
+		UserAgent:   c.Request.UserAgent(),
+		UserID:      userID,
+		Details:     details,
+	})
+}
+
+func twoFactorErrorStatus(err error) int {
+	switch err.Error() {
+	case "user not found":
+		return http.StatusNotFound
+	case "invalid password", "invalid two-factor code", "invalid recovery code", "two-factor challenge expired or invalid":
+		return http.StatusUnauthorized
+	case "two-factor authentication already enabled", "two-factor authentication not enabled":
+		return http.StatusConflict
+	case "two-factor setup expired or not started", "two-factor code required", "no verified phone number on this account":
+		return http.StatusBadRequest
+	case "too many codes sent, try again later":
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type recoveryCodeRepository struct {
+	db *gorm.DB
+}
+
+func NewRecoveryCodeRepository(db *gorm.DB) repositories.RecoveryCodeRepository {
+	return &recoveryCodeRepository{db: db}
+}
+
+// Replace drops every existing code for the user, used or not, so only the
+// latest batch is ever valid.
+func (r *recoveryCodeRepository) Replace(ctx context.Context, userID uint, codes []*entities.RecoveryCode) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&entities.RecoveryCode{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(codes).Error
+	})
+}
+
+// Consume marks the code as used and reports whether it was still valid. The
+// conditional update makes concurrent use of the same code succeed only once.
+func (r *recoveryCodeRepository) Consume(ctx context.Context, userID uint, codeHash string) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&entities.RecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *recoveryCodeRepository) CountUnused(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&entities.RecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Count(&count).Error
+	return count, err
+}
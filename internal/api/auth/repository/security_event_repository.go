@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type securityEventRepository struct {
+	db *gorm.DB
+}
+
+func NewSecurityEventRepository(db *gorm.DB) repositories.SecurityEventRepository {
+	return &securityEventRepository{db: db}
+}
+
+func (r *securityEventRepository) Create(ctx context.Context, event *entities.SecurityEvent) error {
+	return r.db.WithContext(ctx).Create(event).Error
+}
+
+func (r *securityEventRepository) GetByID(ctx context.Context, id uint) (*entities.SecurityEvent, error) {
+	var event entities.SecurityEvent
+	if err := r.db.WithContext(ctx).First(&event, id).Error; err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+func (r *securityEventRepository) ListByUser(ctx context.Context, userID uint, limit, offset int) ([]*entities.SecurityEvent, error) {
+	var events []*entities.SecurityEvent
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&events).Error
+	return events, err
+}
+
+// HasUserAgent reports whether the user signed in with this user agent since
+// the given time, which is how a new device is recognised.
+func (r *securityEventRepository) HasUserAgent(ctx context.Context, userID uint, userAgent string, since time.Time) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&entities.SecurityEvent{}).
+		Where("user_id = ? AND type = ? AND user_agent = ? AND created_at >= ?",
+			userID, entities.SecurityEventLogin, userAgent, since).
+		Limit(1).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *securityEventRepository) CountByType(ctx context.Context, userID uint, eventType entities.SecurityEventType) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&entities.SecurityEvent{}).
+		Where("user_id = ? AND type = ?", userID, eventType).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *securityEventRepository) MarkReported(ctx context.Context, id uint, reportedAt time.Time) error {
+	return r.db.WithContext(ctx).Model(&entities.SecurityEvent{}).
+		Where("id = ? AND reported_at IS NULL", id).
+		Update("reported_at", reportedAt).Error
+}
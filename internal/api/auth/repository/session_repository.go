@@ -22,13 +22,9 @@ func (r *sessionRepository) Create(ctx context.Context, session *entities.Sessio
 	return r.db.WithContext(ctx).Create(session).Error
 }
 
-func (r *sessionRepository) GetByRefreshToken(ctx context.Context, refreshToken string) (*entities.Session, error) {
+func (r *sessionRepository) GetByID(ctx context.Context, id uint) (*entities.Session, error) {
 	var session entities.Session
-	err := r.db.WithContext(ctx).
-		Preload("User").
-		Where("refresh_token = ? AND status = ? AND expires_at > ?",
-			refreshToken, entities.SessionActive, time.Now()).
-		First(&session).Error
+	err := r.db.WithContext(ctx).Preload("User").First(&session, id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -83,9 +79,16 @@ func (r *sessionRepository) RevokeUserSessions(ctx context.Context, userID uint)
 		Update("status", entities.SessionRevoked).Error
 }
 
-func (r *sessionRepository) RevokeSessionByToken(ctx context.Context, refreshToken string) error {
+func (r *sessionRepository) RevokeUserSessionsExcept(ctx context.Context, userID, keepSessionID uint) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&entities.Session{}).
+		Where("user_id = ? AND status = ? AND id <> ?", userID, entities.SessionActive, keepSessionID).
+		Update("status", entities.SessionRevoked)
+	return result.RowsAffected, result.Error
+}
+
+func (r *sessionRepository) RevokeSessionByTokenHash(ctx context.Context, tokenHash string) error {
 	return r.db.WithContext(ctx).Model(&entities.Session{}).
-		Where("refresh_token = ?", refreshToken).
+		Where("token_hash = ?", tokenHash).
 		Update("status", entities.SessionRevoked).Error
 }
 
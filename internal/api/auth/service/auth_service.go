@@ -4,15 +4,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/gin-gonic/gin"
 	"linked-clone/internal/api/auth/dto"
+	"linked-clone/internal/config"
 	"linked-clone/internal/domain/entities"
 	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/agegate"
 	"linked-clone/pkg/auth"
+	"linked-clone/pkg/featureflag"
 	"linked-clone/pkg/logger"
+	"linked-clone/pkg/password"
 	"linked-clone/pkg/redis"
+	"linked-clone/pkg/request"
+	"linked-clone/pkg/signup"
 	email "linked-clone/pkg/smtp"
+	"linked-clone/pkg/useragent"
 	"linked-clone/pkg/utils"
+	"strings"
 	"time"
 
 	"golang.org/x/crypto/bcrypt"
@@ -20,11 +27,20 @@ import (
 )
 
 type authService struct {
-	userRepo     repositories.UserRepository
-	jwtService   auth.JWTService
-	emailService email.EmailService
-	redisClient  redis.RedisClient
-	logger       logger.Logger
+	userRepo       repositories.UserRepository
+	jwtService     auth.JWTService
+	emailService   email.EmailService
+	redisClient    redis.RedisClient
+	signupGuard    signup.Guard
+	passwordPolicy password.Policy
+	securitySvc    SecurityService
+	ssoRepo        repositories.CompanySSORepository
+	inviteRepo     repositories.InviteRepository
+	flags          *featureflag.Flags
+	factor         *secondFactor
+	twoFactor      config.TwoFactorConfig
+	magicLink      config.MagicLinkConfig
+	logger         logger.Logger
 }
 
 func NewAuthService(
@@ -32,18 +48,54 @@ func NewAuthService(
 	jwtService auth.JWTService,
 	emailService email.EmailService,
 	redisClient redis.RedisClient,
+	signupGuard signup.Guard,
+	passwordPolicy password.Policy,
+	securitySvc SecurityService,
+	ssoRepo repositories.CompanySSORepository,
+	inviteRepo repositories.InviteRepository,
+	flags *featureflag.Flags,
+	codeRepo repositories.RecoveryCodeRepository,
+	pepper string,
+	twoFactor config.TwoFactorConfig,
+	magicLink config.MagicLinkConfig,
 	logger logger.Logger,
 ) AuthService {
 	return &authService{
-		userRepo:     userRepo,
-		jwtService:   jwtService,
-		emailService: emailService,
-		redisClient:  redisClient,
-		logger:       logger,
+		userRepo:       userRepo,
+		jwtService:     jwtService,
+		emailService:   emailService,
+		redisClient:    redisClient,
+		signupGuard:    signupGuard,
+		passwordPolicy: passwordPolicy,
+		securitySvc:    securitySvc,
+		ssoRepo:        ssoRepo,
+		inviteRepo:     inviteRepo,
+		flags:          flags,
+		factor:         newSecondFactor(codeRepo, redisClient, pepper, logger),
+		twoFactor:      twoFactor,
+		magicLink:      magicLink,
+		logger:         logger,
 	}
 }
 
 func (s *authService) Register(ctx context.Context, req *dto.RegisterRequest) (*dto.AuthResponse, error) {
+	userAgent, ipAddress := request.ClientInfo(ctx)
+	if err := s.signupGuard.Check(ctx, req.Email, ipAddress); err != nil {
+		if signup.IsRejected(err) || signup.IsThrottled(err) {
+			return nil, err
+		}
+		s.logger.Error("Failed to check registration limits", "error", err)
+	}
+
+	if s.ssoRequired(ctx, req.Email) {
+		return nil, errors.New("sso required")
+	}
+
+	inviteCode, err := s.checkInvite(ctx, req.InviteCode)
+	if err != nil {
+		return nil, err
+	}
+
 	if _, err := s.userRepo.GetByEmail(ctx, req.Email); err == nil {
 		return nil, errors.New("email already registered")
 	}
@@ -52,6 +104,15 @@ func (s *authService) Register(ctx context.Context, req *dto.RegisterRequest) (*
 		return nil, errors.New("username already taken")
 	}
 
+	region := agegate.NormalizeRegion(req.Region)
+	if err := agegate.CheckRegistration(req.DateOfBirth, region, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := checkPassword(ctx, s.passwordPolicy, s.logger, req.Password, req.Email, req.Username, req.FullName); err != nil {
+		return nil, err
+	}
+
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
 	if err != nil {
 		s.logger.Error("Failed to hash password", "error", err)
@@ -59,17 +120,33 @@ func (s *authService) Register(ctx context.Context, req *dto.RegisterRequest) (*
 	}
 
 	user := &entities.User{
-		Email:    req.Email,
-		Username: req.Username,
-		FullName: req.FullName,
-		Password: string(hashedPassword),
+		Email:       req.Email,
+		Username:    req.Username,
+		FullName:    req.FullName,
+		Password:    string(hashedPassword),
+		DateOfBirth: req.DateOfBirth,
+		Region:      region,
+	}
+
+	invite, err := s.redeemInvite(ctx, inviteCode)
+	if err != nil {
+		return nil, err
+	}
+	if invite != nil {
+		user.InviteID = &invite.ID
+		user.InvitedByID = &invite.InviterID
 	}
 
 	if err := s.userRepo.Create(ctx, user); err != nil {
 		s.logger.Error("Failed to create user", "error", err)
+		s.releaseInvite(ctx, invite)
 		return nil, errors.New("failed to create user")
 	}
 
+	if err := s.signupGuard.Record(ctx, user.Email, ipAddress); err != nil {
+		s.logger.Error("Failed to record registration", "error", err)
+	}
+
 	verificationCode := utils.GenerateRandomCode(6)
 	cacheKey := fmt.Sprintf("email_verification:%d", user.ID)
 
@@ -83,13 +160,14 @@ func (s *authService) Register(ctx context.Context, req *dto.RegisterRequest) (*
 		}()
 	}
 
-	userAgent, ipAddress := s.extractRequestInfo(ctx)
 	tokens, err := s.jwtService.GenerateTokens(ctx, user.ID, user.Email, user.Username, userAgent, ipAddress)
 	if err != nil {
 		s.logger.Error("Failed to generate tokens", "error", err)
 		return nil, errors.New("failed to generate tokens")
 	}
 
+	s.securitySvc.Record(ctx, user.ID, entities.SecurityEventLogin, userAgent, ipAddress)
+
 	return &dto.AuthResponse{
 		User: &dto.UserResponse{
 			ID:             user.ID,
@@ -98,6 +176,7 @@ func (s *authService) Register(ctx context.Context, req *dto.RegisterRequest) (*
 			FullName:       user.FullName,
 			ProfilePicture: user.ProfilePicture,
 			Bio:            user.Bio,
+			EmailVerified:  user.EmailVerified,
 			IsVerified:     user.IsVerified,
 			IsPremium:      user.IsPremium,
 		},
@@ -108,6 +187,10 @@ func (s *authService) Register(ctx context.Context, req *dto.RegisterRequest) (*
 	}, nil
 }
 func (s *authService) Login(ctx context.Context, req *dto.LoginRequest) (*dto.AuthResponse, error) {
+	if s.ssoRequired(ctx, req.Email) {
+		return nil, errors.New("sso required")
+	}
+
 	user, err := s.userRepo.GetByEmail(ctx, req.Email)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -121,12 +204,36 @@ func (s *authService) Login(ctx context.Context, req *dto.LoginRequest) (*dto.Au
 		return nil, errors.New("invalid email or password")
 	}
 
-	userAgent, ipAddress := s.extractRequestInfo(ctx)
+	if user.IsSuspended() {
+		return nil, errors.New("account suspended")
+	}
+
+	if user.TwoFactorEnabled {
+		challenge, err := s.startTwoFactorChallenge(ctx, user.ID)
+		if err != nil {
+			s.logger.Error("Failed to start two-factor challenge", "error", err)
+			return nil, errors.New("failed to authenticate")
+		}
+		return &dto.AuthResponse{Challenge: challenge}, nil
+	}
+
+	return s.issueTokens(ctx, user)
+}
+
+// issueTokens opens a session for a user whose credentials are fully
+// verified.
+func (s *authService) issueTokens(ctx context.Context, user *entities.User) (*dto.AuthResponse, error) {
+	if user.IsSuspended() {
+		return nil, errors.New("account suspended")
+	}
+
+	userAgent, ipAddress := request.ClientInfo(ctx)
 	tokens, err := s.jwtService.GenerateTokens(ctx, user.ID, user.Email, user.Username, userAgent, ipAddress)
 	if err != nil {
 		s.logger.Error("Failed to generate tokens", "error", err)
 		return nil, errors.New("failed to generate tokens")
 	}
+	s.securitySvc.Record(ctx, user.ID, entities.SecurityEventLogin, userAgent, ipAddress)
 
 	return &dto.AuthResponse{
 		User: &dto.UserResponse{
@@ -136,6 +243,7 @@ func (s *authService) Login(ctx context.Context, req *dto.LoginRequest) (*dto.Au
 			FullName:       user.FullName,
 			ProfilePicture: user.ProfilePicture,
 			Bio:            user.Bio,
+			EmailVerified:  user.EmailVerified,
 			IsVerified:     user.IsVerified,
 			IsPremium:      user.IsPremium,
 		},
@@ -147,7 +255,7 @@ func (s *authService) Login(ctx context.Context, req *dto.LoginRequest) (*dto.Au
 }
 
 func (s *authService) RefreshToken(ctx context.Context, req *dto.RefreshTokenRequest) (*dto.AuthResponse, error) {
-	userAgent, ipAddress := s.extractRequestInfo(ctx)
+	userAgent, ipAddress := request.ClientInfo(ctx)
 
 	tokens, err := s.jwtService.RefreshAccessToken(ctx, req.RefreshToken, userAgent, ipAddress)
 	if err != nil {
@@ -168,6 +276,16 @@ func (s *authService) RefreshToken(ctx context.Context, req *dto.RefreshTokenReq
 		return nil, errors.New("failed to refresh token")
 	}
 
+	if user.IsSuspended() {
+		s.jwtService.RevokeRefreshToken(ctx, req.RefreshToken)
+		return nil, errors.New("account suspended")
+	}
+
+	if claims.AuthMethod != entities.AuthMethodSSO && s.ssoRequired(ctx, user.Email) {
+		s.jwtService.RevokeRefreshToken(ctx, req.RefreshToken)
+		return nil, errors.New("sso required")
+	}
+
 	return &dto.AuthResponse{
 		User: &dto.UserResponse{
 			ID:             user.ID,
@@ -176,6 +294,7 @@ func (s *authService) RefreshToken(ctx context.Context, req *dto.RefreshTokenReq
 			FullName:       user.FullName,
 			ProfilePicture: user.ProfilePicture,
 			Bio:            user.Bio,
+			EmailVerified:  user.EmailVerified,
 			IsVerified:     user.IsVerified,
 			IsPremium:      user.IsPremium,
 		},
@@ -259,6 +378,10 @@ func (s *authService) ResetPassword(ctx context.Context, req *dto.ResetPasswordR
 		return errors.New("invalid reset code")
 	}
 
+	if err := checkPassword(ctx, s.passwordPolicy, s.logger, req.NewPassword, user.Email, user.Username, user.FullName); err != nil {
+		return err
+	}
+
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
 	if err != nil {
 		s.logger.Error("Failed to hash password", "error", err)
@@ -273,6 +396,9 @@ func (s *authService) ResetPassword(ctx context.Context, req *dto.ResetPasswordR
 
 	s.redisClient.Delete(ctx, cacheKey)
 
+	userAgent, ipAddress := request.ClientInfo(ctx)
+	s.securitySvc.Record(ctx, user.ID, entities.SecurityEventPasswordChanged, userAgent, ipAddress)
+
 	return nil
 }
 
@@ -280,8 +406,19 @@ func (s *authService) Logout(ctx context.Context, refreshToken string) error {
 	return s.jwtService.RevokeRefreshToken(ctx, refreshToken)
 }
 
-func (s *authService) GetUserActiveSessions(ctx context.Context, userID uint, limit, offset int) ([]*entities.Session, error) {
-	return s.jwtService.GetUserActiveSessions(ctx, userID, limit, offset)
+// GetUserActiveSessions lists the user's signed-in devices, marking the one
+// the request was made from.
+func (s *authService) GetUserActiveSessions(ctx context.Context, userID, currentSessionID uint, limit, offset int) ([]*dto.SessionResponse, error) {
+	sessions, err := s.jwtService.GetUserActiveSessions(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.SessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		responses = append(responses, toSessionResponse(session, currentSessionID))
+	}
+	return responses, nil
 }
 
 func (s *authService) RevokeSession(ctx context.Context, userID, sessionID uint) error {
@@ -303,32 +440,83 @@ func (s *authService) RevokeSession(ctx context.Context, userID, sessionID uint)
 		return errors.New("session not found or does not belong to user")
 	}
 
-	return s.jwtService.RevokeSession(ctx, sessionID)
+	if err := s.jwtService.RevokeSession(ctx, sessionID); err != nil {
+		return err
+	}
+
+	userAgent, ipAddress := request.ClientInfo(ctx)
+	s.securitySvc.Record(ctx, userID, entities.SecurityEventSessionRevoked, userAgent, ipAddress)
+	return nil
 }
 
 func (s *authService) RevokeAllUserSessions(ctx context.Context, userID uint) error {
-	return s.jwtService.RevokeUserSessions(ctx, userID)
+	if err := s.jwtService.RevokeUserSessions(ctx, userID); err != nil {
+		return err
+	}
+
+	userAgent, ipAddress := request.ClientInfo(ctx)
+	s.securitySvc.Record(ctx, userID, entities.SecurityEventSessionsRevoked, userAgent, ipAddress)
+	return nil
 }
 
-func (s *authService) extractRequestInfo(ctx context.Context) (userAgent, ipAddress string) {
+// RevokeOtherSessions signs out every device but the one making the request.
+func (s *authService) RevokeOtherSessions(ctx context.Context, userID, currentSessionID uint) (int64, error) {
+	// Tokens issued before sessions were tracked carry no session, and
+	// revoking "all others" would then sign out the caller as well.
+	if currentSessionID == 0 {
+		return 0, errors.New("current session unknown")
+	}
 
-	if ginCtx, ok := ctx.Value("gin_context").(*gin.Context); ok {
-		userAgent = ginCtx.Request.UserAgent()
-		ipAddress = ginCtx.ClientIP()
-		return userAgent, ipAddress
+	revoked, err := s.jwtService.RevokeOtherUserSessions(ctx, userID, currentSessionID)
+	if err != nil {
+		return 0, err
 	}
 
-	if ua := ctx.Value("user_agent"); ua != nil {
-		if userAgentStr, ok := ua.(string); ok {
-			userAgent = userAgentStr
-		}
+	if revoked > 0 {
+		userAgent, ipAddress := request.ClientInfo(ctx)
+		s.securitySvc.Record(ctx, userID, entities.SecurityEventOtherSessionsRevoked, userAgent, ipAddress)
 	}
+	return revoked, nil
+}
 
-	if ip := ctx.Value("ip_address"); ip != nil {
-		if ipStr, ok := ip.(string); ok {
-			ipAddress = ipStr
+func toSessionResponse(session *entities.Session, currentSessionID uint) *dto.SessionResponse {
+	resp := &dto.SessionResponse{
+		ID:         session.ID,
+		DeviceType: session.DeviceType,
+		OS:         session.OS,
+		Browser:    session.Browser,
+		Location:   session.Location,
+		AuthMethod: session.AuthMethod,
+		Current:    session.ID == currentSessionID,
+		CreatedAt:  session.CreatedAt,
+		LastUsedAt: session.LastUsedAt,
+		ExpiresAt:  session.ExpiresAt,
+	}
+	if session.UserAgent != nil {
+		resp.UserAgent = *session.UserAgent
+		// Sessions created before devices were recorded are described on
+		// the fly; they have no location since none was looked up.
+		if resp.DeviceType == "" {
+			info := useragent.Parse(*session.UserAgent)
+			resp.DeviceType, resp.OS, resp.Browser = info.Device, info.OS, info.Browser
 		}
 	}
+	if session.IPAddress != nil {
+		resp.IPAddress = *session.IPAddress
+	}
+	return resp
+}
 
-	return userAgent, ipAddress
+// ssoRequired reports whether the email belongs to a workspace that enforces
+// SSO, in which case password sign-in is refused.
+func (s *authService) ssoRequired(ctx context.Context, email string) bool {
+	_, domain, _ := strings.Cut(email, "@")
+	ssoConfig, err := s.ssoRepo.GetByDomain(ctx, domain)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("Failed to check sso enforcement", "error", err)
+		}
+		return false
+	}
+	return ssoConfig.Enforced
 }
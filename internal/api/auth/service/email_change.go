@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"linked-clone/internal/api/auth/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/pkg/request"
+	"linked-clone/pkg/signup"
+	email "linked-clone/pkg/smtp"
+	"linked-clone/pkg/utils"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+const emailChangeCodeTTL = 15 * time.Minute
+
+// RequestEmailChange stages a new account email and sends a code to it. The
+// account keeps its current address until the code is confirmed, so a typo
+// or an address the user does not control never locks them out.
+func (s *authService) RequestEmailChange(ctx context.Context, userID uint, req *dto.ChangeEmailRequest) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("user not found")
+		}
+		s.logger.Error("Failed to get user", "error", err)
+		return errors.New("failed to process request")
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+		return errors.New("invalid password")
+	}
+
+	address := strings.ToLower(strings.TrimSpace(req.NewEmail))
+	if strings.EqualFold(address, user.Email) {
+		return errors.New("new email must differ from the current email")
+	}
+
+	// The new address must be one registration would accept, but changing
+	// email does not count towards the signup limits.
+	if err := s.signupGuard.Check(ctx, address, ""); err != nil {
+		if signup.IsRejected(err) {
+			return err
+		}
+		if !signup.IsThrottled(err) {
+			s.logger.Error("Failed to check email domain", "error", err)
+		}
+	}
+
+	if s.ssoRequired(ctx, address) {
+		return errors.New("sso required")
+	}
+
+	if _, err := s.userRepo.GetByEmail(ctx, address); err == nil {
+		return errors.New("email already registered")
+	}
+
+	code := utils.GenerateRandomCode(6)
+	if err := s.redisClient.Set(ctx, emailChangeKey(userID), code+":"+address, emailChangeCodeTTL); err != nil {
+		s.logger.Error("Failed to cache email change code", "error", err)
+		return errors.New("failed to process request")
+	}
+
+	subject, body, err := email.Render(email.TemplateEmailChange, map[string]string{
+		"full_name": user.FullName,
+		"code":      code,
+		"minutes":   strconv.Itoa(int(emailChangeCodeTTL.Minutes())),
+	})
+	if err != nil {
+		s.logger.Error("Failed to render email change email", "error", err)
+		return errors.New("failed to process request")
+	}
+
+	go func() {
+		if err := s.emailService.SendEmail(address, subject, body); err != nil {
+			s.logger.Error("Failed to send email change code", "error", err)
+		}
+	}()
+
+	return nil
+}
+
+// ConfirmEmailChange swaps in the pending address once its code is entered
+// and signs out every session, so anyone holding a session from before the
+// change has to sign in again with the new address. The previous address is
+// told about the change in case it was not the owner who made it.
+func (s *authService) ConfirmEmailChange(ctx context.Context, userID uint, req *dto.ConfirmEmailChangeRequest) error {
+	cacheKey := emailChangeKey(userID)
+
+	cached, err := s.redisClient.Get(ctx, cacheKey)
+	if err != nil {
+		return errors.New("verification code expired or invalid")
+	}
+
+	code, address, ok := strings.Cut(cached, ":")
+	if !ok || code != req.Code {
+		return errors.New("invalid verification code")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("user not found")
+		}
+		s.logger.Error("Failed to get user", "error", err)
+		return errors.New("failed to process request")
+	}
+
+	// Someone may have registered the address while the code was pending.
+	if _, err := s.userRepo.GetByEmail(ctx, address); err == nil {
+		s.redisClient.Delete(ctx, cacheKey)
+		return errors.New("email already registered")
+	}
+
+	previous := user.Email
+	user.Email = address
+	user.EmailVerified = true
+	if strings.EqualFold(user.RecoveryEmail, address) {
+		user.RecoveryEmail = ""
+	}
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		s.logger.Error("Failed to update email", "error", err)
+		return errors.New("failed to update email")
+	}
+
+	s.redisClient.Delete(ctx, cacheKey)
+
+	if err := s.jwtService.RevokeUserSessions(ctx, user.ID); err != nil {
+		s.logger.Error("Failed to revoke sessions after email change", "error", err, "user_id", user.ID)
+	}
+
+	userAgent, ipAddress := request.ClientInfo(ctx)
+	s.securitySvc.Record(ctx, user.ID, entities.SecurityEventEmailChanged, userAgent, ipAddress)
+
+	subject, body, err := email.Render(email.TemplateEmailChanged, map[string]string{
+		"full_name": user.FullName,
+		"new_email": maskEmail(address),
+	})
+	if err != nil {
+		s.logger.Error("Failed to render email changed notice", "error", err)
+		return nil
+	}
+
+	go func() {
+		if err := s.emailService.SendEmail(previous, subject, body); err != nil {
+			s.logger.Error("Failed to send email changed notice", "error", err)
+		}
+	}()
+
+	return nil
+}
+
+func emailChangeKey(userID uint) string {
+	return fmt.Sprintf("email_change:%d", userID)
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/pkg/featureflag"
+	"linked-clone/pkg/signup"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// checkInvite looks at the invite code a registration came with before any
+// other work is done. While registration is invite only a usable code is
+// required; otherwise a code is optional and one that cannot be used is
+// ignored, so a stale link still lets people sign up. It returns the
+// normalized code to redeem, or "" when there is none.
+func (s *authService) checkInvite(ctx context.Context, code string) (string, error) {
+	required := s.flags.Enabled(featureflag.FeatureInviteOnly)
+
+	code = signup.NormalizeInviteCode(code)
+	if code == "" {
+		if required {
+			return "", errors.New("invite code required")
+		}
+		return "", nil
+	}
+
+	invite, err := s.inviteRepo.GetByCode(ctx, code)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.logger.Error("Failed to get invite", "error", err)
+		return "", errors.New("failed to process request")
+	}
+
+	if err != nil || !invite.Usable(time.Now()) {
+		if required {
+			return "", errors.New("invalid invite code")
+		}
+		return "", nil
+	}
+	return code, nil
+}
+
+// redeemInvite takes a use of the code right before the account is
+// created, since checkInvite may have raced with another registration for
+// the last use.
+func (s *authService) redeemInvite(ctx context.Context, code string) (*entities.Invite, error) {
+	if code == "" {
+		return nil, nil
+	}
+
+	invite, err := s.inviteRepo.Redeem(ctx, code, time.Now())
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("Failed to redeem invite", "error", err)
+			return nil, errors.New("failed to process request")
+		}
+		if s.flags.Enabled(featureflag.FeatureInviteOnly) {
+			return nil, errors.New("invalid invite code")
+		}
+		return nil, nil
+	}
+	return invite, nil
+}
+
+func (s *authService) releaseInvite(ctx context.Context, invite *entities.Invite) {
+	if invite == nil {
+		return
+	}
+	if err := s.inviteRepo.Release(ctx, invite.ID); err != nil {
+		s.logger.Error("Failed to release invite", "error", err, "invite_id", invite.ID)
+	}
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"linked-clone/internal/api/auth/dto"
+	"linked-clone/pkg/auth"
+	email "linked-clone/pkg/smtp"
+	"net/url"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+// RequestMagicLink emails a single-use sign-in link. Like ForgotPassword it
+// never reveals whether the account exists, and it sends at most one link
+// per account every ResendAfter so the endpoint cannot flood a mailbox.
+func (s *authService) RequestMagicLink(ctx context.Context, req *dto.MagicLinkRequest) error {
+	if s.ssoRequired(ctx, req.Email) {
+		return errors.New("sso required")
+	}
+
+	user, err := s.userRepo.GetByEmail(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		s.logger.Error("Failed to get user", "error", err)
+		return errors.New("failed to process request")
+	}
+
+	fresh, err := s.redisClient.SetNX(ctx, fmt.Sprintf("magic_link_sent:%d", user.ID), "1", s.magicLink.ResendAfter)
+	if err != nil {
+		s.logger.Error("Failed to throttle magic link", "error", err)
+		return errors.New("failed to process request")
+	}
+	if !fresh {
+		return nil
+	}
+
+	token, nonce, err := auth.NewMagicLinkToken([]byte(s.magicLink.Secret))
+	if err != nil {
+		s.logger.Error("Failed to generate magic link token", "error", err)
+		return errors.New("failed to process request")
+	}
+
+	if err := s.redisClient.Set(ctx, magicLinkKey(nonce), strconv.FormatUint(uint64(user.ID), 10), s.magicLink.TTL); err != nil {
+		s.logger.Error("Failed to store magic link token", "error", err)
+		return errors.New("failed to process request")
+	}
+
+	subject, body, err := email.Render(email.TemplateMagicLink, map[string]string{
+		"full_name": user.FullName,
+		"link":      s.magicLink.RedirectURL + "?token=" + url.QueryEscape(token),
+		"minutes":   strconv.Itoa(int(s.magicLink.TTL.Minutes())),
+	})
+	if err != nil {
+		s.logger.Error("Failed to render magic link email", "error", err)
+		return errors.New("failed to process request")
+	}
+
+	go func() {
+		if err := s.emailService.SendEmail(user.Email, subject, body); err != nil {
+			s.logger.Error("Failed to send magic link email", "error", err)
+		}
+	}()
+
+	return nil
+}
+
+// VerifyMagicLink redeems a sign-in link. The link stands in for the
+// password only: an account with two-factor on gets the usual challenge.
+// Following the link also proves the user reads the address, so an
+// unverified email is marked verified.
+func (s *authService) VerifyMagicLink(ctx context.Context, token string) (*dto.AuthResponse, error) {
+	nonce, ok := auth.VerifyMagicLinkToken([]byte(s.magicLink.Secret), token)
+	if !ok {
+		return nil, errors.New("invalid or expired magic link")
+	}
+
+	key := magicLinkKey(nonce)
+	stored, err := s.redisClient.Get(ctx, key)
+	if err != nil {
+		return nil, errors.New("invalid or expired magic link")
+	}
+
+	// Two clicks racing past the lookup above must not both sign in; only
+	// the one that claims the token goes on.
+	claimed, err := s.redisClient.SetNX(ctx, key+":used", "1", s.magicLink.TTL)
+	if err != nil {
+		s.logger.Error("Failed to claim magic link token", "error", err)
+		return nil, errors.New("failed to authenticate")
+	}
+	if !claimed {
+		return nil, errors.New("invalid or expired magic link")
+	}
+	s.redisClient.Delete(ctx, key)
+
+	userID, err := strconv.ParseUint(stored, 10, 64)
+	if err != nil {
+		return nil, errors.New("invalid or expired magic link")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, uint(userID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("invalid or expired magic link")
+		}
+		s.logger.Error("Failed to get user", "error", err)
+		return nil, errors.New("failed to authenticate")
+	}
+
+	if s.ssoRequired(ctx, user.Email) {
+		return nil, errors.New("sso required")
+	}
+
+	if !user.EmailVerified {
+		if err := s.userRepo.VerifyEmail(ctx, user.ID); err != nil {
+			s.logger.Error("Failed to verify email", "error", err)
+		} else {
+			user.EmailVerified = true
+		}
+	}
+
+	if user.TwoFactorEnabled {
+		challenge, err := s.startTwoFactorChallenge(ctx, user.ID)
+		if err != nil {
+			s.logger.Error("Failed to start two-factor challenge", "error", err)
+			return nil, errors.New("failed to authenticate")
+		}
+		return &dto.AuthResponse{Challenge: challenge}, nil
+	}
+
+	return s.issueTokens(ctx, user)
+}
+
+func magicLinkKey(nonce string) string {
+	return "magic_link:" + nonce
+}
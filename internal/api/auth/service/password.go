@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/auth/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/password"
+	"linked-clone/pkg/request"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// ChangePassword replaces the password of a signed-in user. The current
+// password is asked for again so a stolen session alone cannot lock the
+// owner out.
+func (s *authService) ChangePassword(ctx context.Context, userID uint, req *dto.ChangePasswordRequest) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("user not found")
+		}
+		s.logger.Error("Failed to get user", "error", err)
+		return errors.New("failed to process request")
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.CurrentPassword)); err != nil {
+		return errors.New("invalid current password")
+	}
+
+	if req.NewPassword == req.CurrentPassword {
+		return errors.New("new password must differ from the current password")
+	}
+
+	if err := checkPassword(ctx, s.passwordPolicy, s.logger, req.NewPassword, user.Email, user.Username, user.FullName); err != nil {
+		return err
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		s.logger.Error("Failed to hash password", "error", err)
+		return errors.New("failed to process password")
+	}
+
+	user.Password = string(hashedPassword)
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		s.logger.Error("Failed to update password", "error", err)
+		return errors.New("failed to update password")
+	}
+
+	userAgent, ipAddress := request.ClientInfo(ctx)
+	s.securitySvc.Record(ctx, user.ID, entities.SecurityEventPasswordChanged, userAgent, ipAddress)
+
+	return nil
+}
+
+// checkPassword runs a new password through the policy. When the breach
+// check cannot reach its API the password is judged on the local rules
+// alone, the same way registration goes on when signup limits are down.
+func checkPassword(ctx context.Context, policy password.Policy, log logger.Logger, newPassword string, personal ...string) error {
+	if err := policy.Check(ctx, newPassword, personal...); err != nil {
+		if password.IsRejected(err) {
+			return err
+		}
+		log.Error("Failed to check password against breach list", "error", err)
+	}
+	return nil
+}
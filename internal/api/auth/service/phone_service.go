@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"linked-clone/internal/api/auth/dto"
+	"linked-clone/internal/config"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/redis"
+	"linked-clone/pkg/request"
+	"linked-clone/pkg/sms"
+	"linked-clone/pkg/utils"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+type phoneService struct {
+	userRepo    repositories.UserRepository
+	redisClient redis.RedisClient
+	securitySvc SecurityService
+	codes       *smsCodes
+	codeTTL     time.Duration
+	logger      logger.Logger
+}
+
+func NewPhoneService(
+	userRepo repositories.UserRepository,
+	smsSender sms.Sender,
+	redisClient redis.RedisClient,
+	securitySvc SecurityService,
+	cfg config.SMSConfig,
+	logger logger.Logger,
+) PhoneService {
+	return &phoneService{
+		userRepo:    userRepo,
+		redisClient: redisClient,
+		securitySvc: securitySvc,
+		codes:       newSMSCodes(smsSender, redisClient, cfg.SendLimit, logger),
+		codeTTL:     cfg.CodeTTL,
+		logger:      logger,
+	}
+}
+
+func (s *phoneService) GetPhone(ctx context.Context, userID uint) (*dto.PhoneResponse, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	return &dto.PhoneResponse{
+		PhoneNumber: user.PhoneNumber,
+		VerifiedAt:  user.PhoneVerifiedAt,
+	}, nil
+}
+
+// SetPhone only stages the number; it is saved once the code texted to it is
+// confirmed. The password is asked for because a verified phone can sign in
+// past two-factor and recover the account.
+func (s *phoneService) SetPhone(ctx context.Context, userID uint, req *dto.SetPhoneRequest) error {
+	number, err := sms.Normalize(req.PhoneNumber)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return errors.New("user not found")
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+		return errors.New("invalid password")
+	}
+
+	if number == user.PhoneNumber {
+		return errors.New("phone number is already verified")
+	}
+
+	code := utils.GenerateRandomCode(6)
+	if err := s.redisClient.Set(ctx, phoneVerifyKey(userID), code+":"+number, s.codeTTL); err != nil {
+		s.logger.Error("Failed to cache phone verification code", "error", err)
+		return errors.New("failed to process request")
+	}
+
+	body := fmt.Sprintf("Your verification code is %s. Don't share it with anyone.", code)
+	return s.codes.send(ctx, userID, number, body)
+}
+
+func (s *phoneService) VerifyPhone(ctx context.Context, userID uint, req *dto.VerifyPhoneRequest) (*dto.PhoneResponse, error) {
+	cached, err := s.redisClient.Get(ctx, phoneVerifyKey(userID))
+	if err != nil {
+		return nil, errors.New("verification code expired or invalid")
+	}
+
+	code, number, ok := strings.Cut(cached, ":")
+	if !ok || code != req.Code {
+		return nil, errors.New("invalid verification code")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	now := time.Now()
+	user.PhoneNumber = number
+	user.PhoneVerifiedAt = &now
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		s.logger.Error("Failed to save phone number", "error", err)
+		return nil, errors.New("failed to save phone number")
+	}
+
+	s.redisClient.Delete(ctx, phoneVerifyKey(userID))
+
+	userAgent, ipAddress := request.ClientInfo(ctx)
+	s.securitySvc.Record(ctx, userID, entities.SecurityEventPhoneAdded, userAgent, ipAddress)
+
+	return &dto.PhoneResponse{
+		PhoneNumber: user.PhoneNumber,
+		VerifiedAt:  user.PhoneVerifiedAt,
+	}, nil
+}
+
+func (s *phoneService) RemovePhone(ctx context.Context, userID uint) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return errors.New("user not found")
+	}
+
+	if user.PhoneNumber == "" {
+		return errors.New("phone number not set")
+	}
+
+	user.PhoneNumber = ""
+	user.PhoneVerifiedAt = nil
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		s.logger.Error("Failed to remove phone number", "error", err)
+		return errors.New("failed to remove phone number")
+	}
+
+	userAgent, ipAddress := request.ClientInfo(ctx)
+	s.securitySvc.Record(ctx, userID, entities.SecurityEventPhoneRemoved, userAgent, ipAddress)
+
+	return nil
+}
+
+// hasVerifiedPhone reports whether codes can be texted to user.
+func hasVerifiedPhone(user *entities.User) bool {
+	return user.PhoneNumber != "" && user.PhoneVerifiedAt != nil
+}
+
+func phoneVerifyKey(userID uint) string {
+	return fmt.Sprintf("phone_verify:%d", userID)
+}
@@ -0,0 +1,370 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"linked-clone/internal/api/auth/dto"
+	"linked-clone/internal/config"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/auth"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/password"
+	"linked-clone/pkg/redis"
+	"linked-clone/pkg/request"
+	"linked-clone/pkg/sms"
+	email "linked-clone/pkg/smtp"
+	"linked-clone/pkg/utils"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+const (
+	recoveryEmailCodeTTL  = 15 * time.Minute
+	recoveryAttemptLimit  = 5
+	recoveryAttemptWindow = time.Hour
+)
+
+type recoveryService struct {
+	userRepo       repositories.UserRepository
+	codeRepo       repositories.RecoveryCodeRepository
+	jwtService     auth.JWTService
+	emailService   email.EmailService
+	redisClient    redis.RedisClient
+	passwordPolicy password.Policy
+	securitySvc    SecurityService
+	factor         *secondFactor
+	codes          *smsCodes
+	pepper         []byte
+	logger         logger.Logger
+}
+
+func NewRecoveryService(
+	userRepo repositories.UserRepository,
+	codeRepo repositories.RecoveryCodeRepository,
+	jwtService auth.JWTService,
+	emailService email.EmailService,
+	redisClient redis.RedisClient,
+	passwordPolicy password.Policy,
+	securitySvc SecurityService,
+	smsSender sms.Sender,
+	pepper string,
+	smsCfg config.SMSConfig,
+	logger logger.Logger,
+) RecoveryService {
+	return &recoveryService{
+		userRepo:       userRepo,
+		codeRepo:       codeRepo,
+		jwtService:     jwtService,
+		emailService:   emailService,
+		redisClient:    redisClient,
+		passwordPolicy: passwordPolicy,
+		securitySvc:    securitySvc,
+		factor:         newSecondFactor(codeRepo, redisClient, pepper, logger),
+		codes:          newSMSCodes(smsSender, redisClient, smsCfg.SendLimit, logger),
+		pepper:         []byte(pepper),
+		logger:         logger,
+	}
+}
+
+func (s *recoveryService) GetStatus(ctx context.Context, userID uint) (*dto.RecoveryStatusResponse, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	remaining, err := s.codeRepo.CountUnused(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to count recovery codes", "error", err)
+		return nil, errors.New("failed to get recovery status")
+	}
+
+	recoveryPhone := ""
+	if hasVerifiedPhone(user) {
+		recoveryPhone = sms.Mask(user.PhoneNumber)
+	}
+
+	return &dto.RecoveryStatusResponse{
+		RecoveryEmail:  maskEmail(user.RecoveryEmail),
+		RecoveryPhone:  recoveryPhone,
+		CodesRemaining: remaining,
+	}, nil
+}
+
+// SetRecoveryEmail only stages the address; it is saved once the code sent
+// to it is confirmed, proving the user controls it.
+func (s *recoveryService) SetRecoveryEmail(ctx context.Context, userID uint, req *dto.SetRecoveryEmailRequest) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return errors.New("user not found")
+	}
+
+	address := strings.ToLower(strings.TrimSpace(req.Email))
+	if strings.EqualFold(address, user.Email) {
+		return errors.New("recovery email must differ from your primary email")
+	}
+
+	code := utils.GenerateRandomCode(6)
+	cacheKey := fmt.Sprintf("recovery_email:%d", userID)
+	if err := s.redisClient.Set(ctx, cacheKey, code+":"+address, recoveryEmailCodeTTL); err != nil {
+		s.logger.Error("Failed to cache recovery email code", "error", err)
+		return errors.New("failed to process request")
+	}
+
+	go func() {
+		if err := s.emailService.SendVerificationEmail(address, user.FullName, code); err != nil {
+			s.logger.Error("Failed to send recovery email verification", "error", err)
+		}
+	}()
+
+	return nil
+}
+
+func (s *recoveryService) VerifyRecoveryEmail(ctx context.Context, userID uint, req *dto.VerifyRecoveryEmailRequest) error {
+	cacheKey := fmt.Sprintf("recovery_email:%d", userID)
+
+	cached, err := s.redisClient.Get(ctx, cacheKey)
+	if err != nil {
+		return errors.New("verification code expired or invalid")
+	}
+
+	code, address, ok := strings.Cut(cached, ":")
+	if !ok || code != req.Code {
+		return errors.New("invalid verification code")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return errors.New("user not found")
+	}
+
+	user.RecoveryEmail = address
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		s.logger.Error("Failed to save recovery email", "error", err)
+		return errors.New("failed to save recovery email")
+	}
+
+	s.redisClient.Delete(ctx, cacheKey)
+
+	return nil
+}
+
+func (s *recoveryService) RemoveRecoveryEmail(ctx context.Context, userID uint) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return errors.New("user not found")
+	}
+
+	user.RecoveryEmail = ""
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		s.logger.Error("Failed to remove recovery email", "error", err)
+		return errors.New("failed to remove recovery email")
+	}
+
+	return nil
+}
+
+// GenerateRecoveryCodes requires the password again so a hijacked session
+// cannot mint codes, and invalidates any earlier batch.
+func (s *recoveryService) GenerateRecoveryCodes(ctx context.Context, userID uint, req *dto.GenerateRecoveryCodesRequest) (*dto.RecoveryCodesResponse, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+		return nil, errors.New("invalid password")
+	}
+
+	// With two-factor on, the codes stand in for the authenticator, so
+	// replacing them takes the authenticator too.
+	if user.TwoFactorEnabled {
+		if req.Code == "" {
+			return nil, errors.New("two-factor code required")
+		}
+		if err := s.factor.verify(ctx, user, req.Code, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	codes, err := issueRecoveryCodes(ctx, s.codeRepo, s.pepper, userID)
+	if err != nil {
+		s.logger.Error("Failed to store recovery codes", "error", err)
+		return nil, errors.New("failed to generate recovery codes")
+	}
+
+	return &dto.RecoveryCodesResponse{Codes: codes}, nil
+}
+
+// issueRecoveryCodes generates a fresh batch of codes and stores their
+// hashes in place of any earlier batch.
+func issueRecoveryCodes(ctx context.Context, codeRepo repositories.RecoveryCodeRepository, pepper []byte, userID uint) ([]string, error) {
+	codes, err := auth.GenerateRecoveryCodes(auth.RecoveryCodeCount)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*entities.RecoveryCode, 0, len(codes))
+	for _, code := range codes {
+		records = append(records, &entities.RecoveryCode{
+			UserID:   userID,
+			CodeHash: auth.HashRecoveryCode(pepper, code),
+		})
+	}
+	if err := codeRepo.Replace(ctx, userID, records); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// StartEmailRecovery sends a password reset code to the recovery address,
+// redeemable through the regular reset-password endpoint. Like
+// ForgotPassword it never reveals whether the account exists.
+func (s *recoveryService) StartEmailRecovery(ctx context.Context, req *dto.StartRecoveryRequest) error {
+	user, err := s.userRepo.GetByEmail(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		s.logger.Error("Failed to get user", "error", err)
+		return errors.New("failed to process request")
+	}
+	if user.RecoveryEmail == "" {
+		return nil
+	}
+
+	resetCode := utils.GenerateRandomCode(6)
+	cacheKey := fmt.Sprintf("password_reset:%d", user.ID)
+	if err := s.redisClient.Set(ctx, cacheKey, resetCode, 15*time.Minute); err != nil {
+		s.logger.Error("Failed to cache reset code", "error", err)
+		return errors.New("failed to process request")
+	}
+
+	go func() {
+		if err := s.emailService.SendPasswordResetEmail(user.RecoveryEmail, user.FullName, resetCode); err != nil {
+			s.logger.Error("Failed to send recovery reset email", "error", err)
+		}
+	}()
+
+	return nil
+}
+
+// StartSMSRecovery texts a password reset code to the verified phone,
+// redeemable through the regular reset-password endpoint. Accounts with
+// two-factor on are skipped: the same phone can answer the sign-in
+// challenge, so it would be the only thing standing between an attacker and
+// the account. They recover with a recovery code instead. Like
+// ForgotPassword it never reveals whether the account exists.
+func (s *recoveryService) StartSMSRecovery(ctx context.Context, req *dto.StartRecoveryRequest) error {
+	user, err := s.userRepo.GetByEmail(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		s.logger.Error("Failed to get user", "error", err)
+		return errors.New("failed to process request")
+	}
+	if !hasVerifiedPhone(user) || user.TwoFactorEnabled {
+		return nil
+	}
+
+	resetCode := utils.GenerateRandomCode(6)
+	cacheKey := fmt.Sprintf("password_reset:%d", user.ID)
+	if err := s.redisClient.Set(ctx, cacheKey, resetCode, 15*time.Minute); err != nil {
+		s.logger.Error("Failed to cache reset code", "error", err)
+		return errors.New("failed to process request")
+	}
+
+	body := fmt.Sprintf("Your password reset code is %s. Don't share it with anyone.", resetCode)
+	if err := s.codes.send(ctx, user.ID, user.PhoneNumber, body); err != nil {
+		// Answering differently would reveal that the account exists.
+		s.logger.Warn("SMS recovery code not sent", "user_id", user.ID, "reason", err.Error())
+	}
+
+	return nil
+}
+
+// RecoverWithCode resets the password using a one-time recovery code and
+// signs out every session. Attempts are capped per account, on top of the
+// per-IP route limit, so codes cannot be brute-forced from many addresses.
+// The new password is checked before the account is looked up, so that a
+// rejected password neither uses up a code nor tells whether the email is
+// registered.
+func (s *recoveryService) RecoverWithCode(ctx context.Context, req *dto.RecoverWithCodeRequest) (uint, error) {
+	if err := checkPassword(ctx, s.passwordPolicy, s.logger, req.NewPassword, req.Email); err != nil {
+		return 0, err
+	}
+
+	user, err := s.userRepo.GetByEmail(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, errors.New("invalid recovery code")
+		}
+		s.logger.Error("Failed to get user", "error", err)
+		return 0, errors.New("failed to process request")
+	}
+
+	attemptsKey := fmt.Sprintf("recovery_attempts:%d", user.ID)
+	attempts, err := s.redisClient.IncrBy(ctx, attemptsKey, 1)
+	if err != nil {
+		s.logger.Error("Failed to track recovery attempts", "error", err)
+		return user.ID, errors.New("failed to process request")
+	}
+	if attempts == 1 {
+		s.redisClient.Expire(ctx, attemptsKey, recoveryAttemptWindow)
+	}
+	if attempts > recoveryAttemptLimit {
+		return user.ID, errors.New("too many recovery attempts")
+	}
+
+	consumed, err := s.codeRepo.Consume(ctx, user.ID, auth.HashRecoveryCode(s.pepper, req.RecoveryCode))
+	if err != nil {
+		s.logger.Error("Failed to consume recovery code", "error", err)
+		return user.ID, errors.New("failed to process request")
+	}
+	if !consumed {
+		return user.ID, errors.New("invalid recovery code")
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		s.logger.Error("Failed to hash password", "error", err)
+		return user.ID, errors.New("failed to process password")
+	}
+
+	user.Password = string(hashedPassword)
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		s.logger.Error("Failed to update password", "error", err)
+		return user.ID, errors.New("failed to update password")
+	}
+
+	if err := s.jwtService.RevokeUserSessions(ctx, user.ID); err != nil {
+		s.logger.Error("Failed to revoke sessions after recovery", "error", err, "user_id", user.ID)
+	}
+	s.redisClient.Delete(ctx, attemptsKey)
+
+	userAgent, ipAddress := request.ClientInfo(ctx)
+	s.securitySvc.Record(ctx, user.ID, entities.SecurityEventPasswordChanged, userAgent, ipAddress)
+
+	go func() {
+		body := "A recovery code was just used to reset the password on your account and all sessions were signed out. " +
+			"If this wasn't you, contact support immediately."
+		if err := s.emailService.SendEmail(user.Email, "Your account was recovered", body); err != nil {
+			s.logger.Error("Failed to send recovery notice", "error", err)
+		}
+	}()
+
+	return user.ID, nil
+}
+
+func maskEmail(address string) string {
+	local, domain, ok := strings.Cut(address, "@")
+	if !ok || local == "" {
+		return ""
+	}
+	return local[:1] + "***@" + domain
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"linked-clone/internal/api/auth/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/auth"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/redis"
+	"linked-clone/pkg/request"
+	email "linked-clone/pkg/smtp"
+	"linked-clone/pkg/utils"
+	"time"
+)
+
+// knownDeviceWindow is how far back a sign-in with the same user agent
+// counts as a known device.
+const knownDeviceWindow = 90 * 24 * time.Hour
+
+type securityService struct {
+	eventRepo    repositories.SecurityEventRepository
+	userRepo     repositories.UserRepository
+	jwtService   auth.JWTService
+	emailService email.EmailService
+	redisClient  redis.RedisClient
+	logger       logger.Logger
+}
+
+func NewSecurityService(
+	eventRepo repositories.SecurityEventRepository,
+	userRepo repositories.UserRepository,
+	jwtService auth.JWTService,
+	emailService email.EmailService,
+	redisClient redis.RedisClient,
+	logger logger.Logger,
+) SecurityService {
+	return &securityService{
+		eventRepo:    eventRepo,
+		userRepo:     userRepo,
+		jwtService:   jwtService,
+		emailService: emailService,
+		redisClient:  redisClient,
+		logger:       logger,
+	}
+}
+
+// Record stores a security event for the activity page. Failures are only
+// logged so they never block the sign-in or reset that triggered them. A
+// login from a user agent not seen recently is also recorded as a new
+// device, except for the account's very first sign-in.
+func (s *securityService) Record(ctx context.Context, userID uint, eventType entities.SecurityEventType, userAgent, ipAddress string) {
+	if eventType == entities.SecurityEventLogin && userAgent != "" {
+		if s.isNewDevice(ctx, userID, userAgent) {
+			s.create(ctx, userID, entities.SecurityEventNewDevice, userAgent, ipAddress)
+		}
+	}
+	s.create(ctx, userID, eventType, userAgent, ipAddress)
+}
+
+func (s *securityService) isNewDevice(ctx context.Context, userID uint, userAgent string) bool {
+	logins, err := s.eventRepo.CountByType(ctx, userID, entities.SecurityEventLogin)
+	if err != nil || logins == 0 {
+		return false
+	}
+
+	known, err := s.eventRepo.HasUserAgent(ctx, userID, userAgent, time.Now().Add(-knownDeviceWindow))
+	if err != nil {
+		s.logger.Error("Failed to check known devices", "error", err, "user_id", userID)
+		return false
+	}
+	return !known
+}
+
+func (s *securityService) create(ctx context.Context, userID uint, eventType entities.SecurityEventType, userAgent, ipAddress string) {
+	event := &entities.SecurityEvent{UserID: userID, Type: eventType}
+	if userAgent != "" {
+		event.UserAgent = &userAgent
+	}
+	if ipAddress != "" {
+		event.IPAddress = &ipAddress
+	}
+
+	if err := s.eventRepo.Create(ctx, event); err != nil {
+		s.logger.Error("Failed to record security event", "error", err, "user_id", userID, "type", eventType)
+	}
+}
+
+func (s *securityService) GetActivity(ctx context.Context, userID uint, limit, offset int) ([]*dto.SecurityEventResponse, error) {
+	events, err := s.eventRepo.ListByUser(ctx, userID, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list security events", "error", err)
+		return nil, errors.New("failed to get security activity")
+	}
+
+	responses := make([]*dto.SecurityEventResponse, 0, len(events))
+	for _, event := range events {
+		resp := &dto.SecurityEventResponse{
+			ID:         event.ID,
+			Type:       string(event.Type),
+			CreatedAt:  event.CreatedAt,
+			ReportedAt: event.ReportedAt,
+		}
+		if event.UserAgent != nil {
+			resp.UserAgent = *event.UserAgent
+		}
+		if event.IPAddress != nil {
+			resp.IPAddress = *event.IPAddress
+		}
+		responses = append(responses, resp)
+	}
+	return responses, nil
+}
+
+// ReportNotMe handles "this wasn't me": it signs out every session and
+// clears the password so whoever knows it can no longer sign in, then emails
+// a reset code to the account owner.
+func (s *securityService) ReportNotMe(ctx context.Context, userID, eventID uint) error {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil || event.UserID != userID {
+		return errors.New("security event not found")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return errors.New("user not found")
+	}
+
+	if err := s.eventRepo.MarkReported(ctx, event.ID, time.Now()); err != nil {
+		s.logger.Error("Failed to mark security event reported", "error", err)
+		return errors.New("failed to secure account")
+	}
+
+	if err := s.lockPassword(ctx, user); err != nil {
+		return err
+	}
+
+	userAgent, ipAddress := request.ClientInfo(ctx)
+	s.create(ctx, userID, entities.SecurityEventReported, userAgent, ipAddress)
+	return nil
+}
+
+// ForcePasswordReset is ReportNotMe without an event to report, for an
+// admin who believes the account is compromised.
+func (s *securityService) ForcePasswordReset(ctx context.Context, userID uint) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return errors.New("user not found")
+	}
+
+	if err := s.lockPassword(ctx, user); err != nil {
+		return err
+	}
+
+	userAgent, ipAddress := request.ClientInfo(ctx)
+	s.create(ctx, userID, entities.SecurityEventPasswordResetForced, userAgent, ipAddress)
+	return nil
+}
+
+// lockPassword signs out every session and clears the password, then emails
+// a reset code to the account owner.
+func (s *securityService) lockPassword(ctx context.Context, user *entities.User) error {
+	if err := s.jwtService.RevokeUserSessions(ctx, user.ID); err != nil {
+		s.logger.Error("Failed to revoke sessions", "error", err, "user_id", user.ID)
+		return errors.New("failed to secure account")
+	}
+
+	// An empty hash never matches, so the password must be reset before the
+	// next sign-in.
+	user.Password = ""
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		s.logger.Error("Failed to invalidate password", "error", err, "user_id", user.ID)
+		return errors.New("failed to secure account")
+	}
+
+	resetCode := utils.GenerateRandomCode(6)
+	cacheKey := fmt.Sprintf("password_reset:%d", user.ID)
+	if err := s.redisClient.Set(ctx, cacheKey, resetCode, 15*time.Minute); err != nil {
+		s.logger.Error("Failed to cache reset code", "error", err)
+		return nil
+	}
+
+	go func() {
+		if err := s.emailService.SendPasswordResetEmail(user.Email, user.FullName, resetCode); err != nil {
+			s.logger.Error("Failed to send reset email", "error", err)
+		}
+	}()
+
+	return nil
+}
@@ -9,13 +9,58 @@ import (
 type AuthService interface {
 	Register(ctx context.Context, req *dto.RegisterRequest) (*dto.AuthResponse, error)
 	Login(ctx context.Context, req *dto.LoginRequest) (*dto.AuthResponse, error)
+	VerifyTwoFactor(ctx context.Context, req *dto.VerifyTwoFactorRequest) (*dto.AuthResponse, error)
+	RequestMagicLink(ctx context.Context, req *dto.MagicLinkRequest) error
+	VerifyMagicLink(ctx context.Context, token string) (*dto.AuthResponse, error)
 	VerifyEmail(ctx context.Context, req *dto.VerifyEmailRequest) error
 	ForgotPassword(ctx context.Context, req *dto.ForgotPasswordRequest) error
 	ResetPassword(ctx context.Context, req *dto.ResetPasswordRequest) error
+	ChangePassword(ctx context.Context, userID uint, req *dto.ChangePasswordRequest) error
+	RequestEmailChange(ctx context.Context, userID uint, req *dto.ChangeEmailRequest) error
+	ConfirmEmailChange(ctx context.Context, userID uint, req *dto.ConfirmEmailChangeRequest) error
 	RefreshToken(ctx context.Context, req *dto.RefreshTokenRequest) (*dto.AuthResponse, error)
 
 	Logout(ctx context.Context, refreshToken string) error
-	GetUserActiveSessions(ctx context.Context, userID uint, limit, offset int) ([]*entities.Session, error)
+	GetUserActiveSessions(ctx context.Context, userID, currentSessionID uint, limit, offset int) ([]*dto.SessionResponse, error)
 	RevokeSession(ctx context.Context, userID, sessionID uint) error
 	RevokeAllUserSessions(ctx context.Context, userID uint) error
+	RevokeOtherSessions(ctx context.Context, userID, currentSessionID uint) (int64, error)
+}
+
+type RecoveryService interface {
+	GetStatus(ctx context.Context, userID uint) (*dto.RecoveryStatusResponse, error)
+	SetRecoveryEmail(ctx context.Context, userID uint, req *dto.SetRecoveryEmailRequest) error
+	VerifyRecoveryEmail(ctx context.Context, userID uint, req *dto.VerifyRecoveryEmailRequest) error
+	RemoveRecoveryEmail(ctx context.Context, userID uint) error
+	GenerateRecoveryCodes(ctx context.Context, userID uint, req *dto.GenerateRecoveryCodesRequest) (*dto.RecoveryCodesResponse, error)
+
+	StartEmailRecovery(ctx context.Context, req *dto.StartRecoveryRequest) error
+	StartSMSRecovery(ctx context.Context, req *dto.StartRecoveryRequest) error
+	RecoverWithCode(ctx context.Context, req *dto.RecoverWithCodeRequest) (uint, error)
+}
+
+type TwoFactorService interface {
+	GetStatus(ctx context.Context, userID uint) (*dto.TwoFactorStatusResponse, error)
+	Setup(ctx context.Context, userID uint, req *dto.TwoFactorSetupRequest) (*dto.TwoFactorSetupResponse, error)
+	Enable(ctx context.Context, userID uint, req *dto.EnableTwoFactorRequest) (*dto.RecoveryCodesResponse, error)
+	Disable(ctx context.Context, userID uint, req *dto.DisableTwoFactorRequest) error
+	// SendSMSCode texts a sign-in code for a pending login challenge to the
+	// account's verified phone, as an alternative to the authenticator.
+	SendSMSCode(ctx context.Context, req *dto.SendTwoFactorSMSRequest) (*dto.SMSCodeSentResponse, error)
+}
+
+type PhoneService interface {
+	GetPhone(ctx context.Context, userID uint) (*dto.PhoneResponse, error)
+	SetPhone(ctx context.Context, userID uint, req *dto.SetPhoneRequest) error
+	VerifyPhone(ctx context.Context, userID uint, req *dto.VerifyPhoneRequest) (*dto.PhoneResponse, error)
+	RemovePhone(ctx context.Context, userID uint) error
+}
+
+type SecurityService interface {
+	Record(ctx context.Context, userID uint, eventType entities.SecurityEventType, userAgent, ipAddress string)
+	GetActivity(ctx context.Context, userID uint, limit, offset int) ([]*dto.SecurityEventResponse, error)
+	ReportNotMe(ctx context.Context, userID, eventID uint) error
+	// ForcePasswordReset signs the user out everywhere and makes them set a
+	// new password from an emailed code before signing in again.
+	ForcePasswordReset(ctx context.Context, userID uint) error
 }
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/redis"
+	"linked-clone/pkg/sms"
+	"time"
+)
+
+const smsSendWindow = time.Hour
+
+// smsCodes texts one-time codes to a user's phone. Sends are counted per
+// account across every flow that texts a code, so none of them can be used
+// to run up the SMS bill or flood someone's phone.
+type smsCodes struct {
+	sender      sms.Sender
+	redisClient redis.RedisClient
+	limit       int
+	logger      logger.Logger
+}
+
+func newSMSCodes(sender sms.Sender, redisClient redis.RedisClient, limit int, logger logger.Logger) *smsCodes {
+	return &smsCodes{
+		sender:      sender,
+		redisClient: redisClient,
+		limit:       limit,
+		logger:      logger,
+	}
+}
+
+// send counts the message against userID's hourly limit and texts it in the
+// background, as the emailed codes are sent.
+func (c *smsCodes) send(ctx context.Context, userID uint, number, body string) error {
+	key := fmt.Sprintf("sms_sent:%d", userID)
+	sent, err := c.redisClient.IncrBy(ctx, key, 1)
+	if err != nil {
+		c.logger.Error("Failed to track SMS sends", "error", err)
+		return errors.New("failed to send code")
+	}
+	if sent == 1 {
+		c.redisClient.Expire(ctx, key, smsSendWindow)
+	}
+	if c.limit > 0 && sent > int64(c.limit) {
+		return errors.New("too many codes sent, try again later")
+	}
+
+	go func() {
+		if err := c.sender.Send(context.Background(), number, body); err != nil {
+			c.logger.Error("Failed to send SMS", "error", err, "user_id", userID)
+		}
+	}()
+
+	return nil
+}
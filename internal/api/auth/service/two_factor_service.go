@@ -0,0 +1,402 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"linked-clone/internal/api/auth/dto"
+	"linked-clone/internal/config"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/auth"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/redis"
+	"linked-clone/pkg/request"
+	"linked-clone/pkg/sms"
+	"linked-clone/pkg/utils"
+	"strconv"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// A TOTP code validates for one step either side of its own, so a used
+	// step is remembered for three periods.
+	totpReplayWindow = 3 * auth.TOTPPeriod
+
+	twoFactorAttemptLimit = 5
+)
+
+// secondFactor checks the second factor of an account that has two-factor
+// authentication on: a code from the authenticator app, or one of the
+// account's recovery codes, which is used up.
+type secondFactor struct {
+	codeRepo    repositories.RecoveryCodeRepository
+	redisClient redis.RedisClient
+	pepper      []byte
+	logger      logger.Logger
+}
+
+func newSecondFactor(codeRepo repositories.RecoveryCodeRepository, redisClient redis.RedisClient, pepper string, logger logger.Logger) *secondFactor {
+	return &secondFactor{
+		codeRepo:    codeRepo,
+		redisClient: redisClient,
+		pepper:      []byte(pepper),
+		logger:      logger,
+	}
+}
+
+func (f *secondFactor) verify(ctx context.Context, user *entities.User, code, recoveryCode string) error {
+	if code != "" {
+		step, ok := auth.ValidateTOTP(user.TOTPSecret, code, time.Now())
+		if !ok {
+			return errors.New("invalid two-factor code")
+		}
+
+		// Refuse a code that was already accepted, so one seen over a
+		// shoulder or in a proxy log cannot be replayed within its window.
+		fresh, err := f.redisClient.SetNX(ctx, fmt.Sprintf("totp_used:%d:%d", user.ID, step), "1", totpReplayWindow)
+		if err != nil {
+			f.logger.Error("Failed to record used two-factor code", "error", err)
+			return errors.New("failed to verify two-factor code")
+		}
+		if !fresh {
+			return errors.New("invalid two-factor code")
+		}
+		return nil
+	}
+
+	if recoveryCode != "" {
+		consumed, err := f.codeRepo.Consume(ctx, user.ID, auth.HashRecoveryCode(f.pepper, recoveryCode))
+		if err != nil {
+			f.logger.Error("Failed to consume recovery code", "error", err)
+			return errors.New("failed to verify two-factor code")
+		}
+		if !consumed {
+			return errors.New("invalid recovery code")
+		}
+		return nil
+	}
+
+	return errors.New("two-factor code required")
+}
+
+// verifySMS checks a code texted for the login challenge token. A code works
+// once.
+func (f *secondFactor) verifySMS(ctx context.Context, token, code string) error {
+	key := smsLoginKey(token)
+
+	stored, err := f.redisClient.Get(ctx, key)
+	if err != nil || stored != code {
+		return errors.New("invalid two-factor code")
+	}
+
+	f.redisClient.Delete(ctx, key)
+	return nil
+}
+
+type twoFactorService struct {
+	userRepo    repositories.UserRepository
+	codeRepo    repositories.RecoveryCodeRepository
+	redisClient redis.RedisClient
+	securitySvc SecurityService
+	factor      *secondFactor
+	codes       *smsCodes
+	pepper      []byte
+	cfg         config.TwoFactorConfig
+	logger      logger.Logger
+}
+
+func NewTwoFactorService(
+	userRepo repositories.UserRepository,
+	codeRepo repositories.RecoveryCodeRepository,
+	redisClient redis.RedisClient,
+	securitySvc SecurityService,
+	smsSender sms.Sender,
+	pepper string,
+	cfg config.TwoFactorConfig,
+	smsCfg config.SMSConfig,
+	logger logger.Logger,
+) TwoFactorService {
+	return &twoFactorService{
+		userRepo:    userRepo,
+		codeRepo:    codeRepo,
+		redisClient: redisClient,
+		securitySvc: securitySvc,
+		factor:      newSecondFactor(codeRepo, redisClient, pepper, logger),
+		codes:       newSMSCodes(smsSender, redisClient, smsCfg.SendLimit, logger),
+		pepper:      []byte(pepper),
+		cfg:         cfg,
+		logger:      logger,
+	}
+}
+
+func (s *twoFactorService) GetStatus(ctx context.Context, userID uint) (*dto.TwoFactorStatusResponse, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	remaining, err := s.codeRepo.CountUnused(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to count recovery codes", "error", err)
+		return nil, errors.New("failed to get two-factor status")
+	}
+
+	return &dto.TwoFactorStatusResponse{
+		Enabled:                user.TwoFactorEnabled,
+		EnabledAt:              user.TwoFactorEnabledAt,
+		SMSAvailable:           user.TwoFactorEnabled && hasVerifiedPhone(user),
+		RecoveryCodesRemaining: remaining,
+	}, nil
+}
+
+// Setup starts enrollment. The secret is only held in Redis until a code
+// generated from it is confirmed through Enable, so a mistyped or abandoned
+// setup never locks the user out.
+func (s *twoFactorService) Setup(ctx context.Context, userID uint, req *dto.TwoFactorSetupRequest) (*dto.TwoFactorSetupResponse, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+		return nil, errors.New("invalid password")
+	}
+
+	if user.TwoFactorEnabled {
+		return nil, errors.New("two-factor authentication already enabled")
+	}
+
+	secret, err := auth.GenerateTOTPSecret()
+	if err != nil {
+		s.logger.Error("Failed to generate TOTP secret", "error", err)
+		return nil, errors.New("failed to start two-factor setup")
+	}
+
+	if err := s.redisClient.Set(ctx, pendingSecretKey(userID), secret, s.cfg.PendingTTL); err != nil {
+		s.logger.Error("Failed to cache pending TOTP secret", "error", err)
+		return nil, errors.New("failed to start two-factor setup")
+	}
+
+	return &dto.TwoFactorSetupResponse{
+		Secret:          secret,
+		ProvisioningURI: auth.TOTPProvisioningURI(s.cfg.Issuer, user.Email, secret),
+		ExpiresAt:       time.Now().Add(s.cfg.PendingTTL),
+	}, nil
+}
+
+// Enable confirms the pending secret with a code from the authenticator and
+// turns two-factor on. It returns a fresh batch of recovery codes, replacing
+// any earlier one, as the way back in if the authenticator is lost.
+func (s *twoFactorService) Enable(ctx context.Context, userID uint, req *dto.EnableTwoFactorRequest) (*dto.RecoveryCodesResponse, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	if user.TwoFactorEnabled {
+		return nil, errors.New("two-factor authentication already enabled")
+	}
+
+	secret, err := s.redisClient.Get(ctx, pendingSecretKey(userID))
+	if err != nil {
+		return nil, errors.New("two-factor setup expired or not started")
+	}
+
+	if _, ok := auth.ValidateTOTP(secret, req.Code, time.Now()); !ok {
+		return nil, errors.New("invalid two-factor code")
+	}
+
+	now := time.Now()
+	user.TOTPSecret = secret
+	user.TwoFactorEnabled = true
+	user.TwoFactorEnabledAt = &now
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		s.logger.Error("Failed to enable two-factor authentication", "error", err)
+		return nil, errors.New("failed to enable two-factor authentication")
+	}
+
+	s.redisClient.Delete(ctx, pendingSecretKey(userID))
+
+	userAgent, ipAddress := request.ClientInfo(ctx)
+	s.securitySvc.Record(ctx, userID, entities.SecurityEventTwoFactorOn, userAgent, ipAddress)
+
+	codes, err := issueRecoveryCodes(ctx, s.codeRepo, s.pepper, userID)
+	if err != nil {
+		// Two-factor is on at this point; the user can still mint codes
+		// from the recovery settings with their password and a TOTP code.
+		s.logger.Error("Failed to store recovery codes", "error", err)
+		return nil, errors.New("failed to generate recovery codes")
+	}
+
+	return &dto.RecoveryCodesResponse{Codes: codes}, nil
+}
+
+// Disable takes both the password and a second factor, so neither a stolen
+// session nor a leaked password alone can turn protection off. Unused
+// recovery codes are kept; they still work for account recovery.
+func (s *twoFactorService) Disable(ctx context.Context, userID uint, req *dto.DisableTwoFactorRequest) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return errors.New("user not found")
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+		return errors.New("invalid password")
+	}
+
+	if !user.TwoFactorEnabled {
+		return errors.New("two-factor authentication not enabled")
+	}
+
+	if err := s.factor.verify(ctx, user, req.Code, req.RecoveryCode); err != nil {
+		return err
+	}
+
+	user.TOTPSecret = ""
+	user.TwoFactorEnabled = false
+	user.TwoFactorEnabledAt = nil
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		s.logger.Error("Failed to disable two-factor authentication", "error", err)
+		return errors.New("failed to disable two-factor authentication")
+	}
+
+	userAgent, ipAddress := request.ClientInfo(ctx)
+	s.securitySvc.Record(ctx, userID, entities.SecurityEventTwoFactorOff, userAgent, ipAddress)
+
+	return nil
+}
+
+func (s *twoFactorService) SendSMSCode(ctx context.Context, req *dto.SendTwoFactorSMSRequest) (*dto.SMSCodeSentResponse, error) {
+	stored, err := s.redisClient.Get(ctx, challengeKey(req.ChallengeToken))
+	if err != nil {
+		return nil, errors.New("two-factor challenge expired or invalid")
+	}
+	userID, err := strconv.ParseUint(stored, 10, 64)
+	if err != nil {
+		return nil, errors.New("two-factor challenge expired or invalid")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, uint(userID))
+	if err != nil {
+		s.logger.Error("Failed to get user", "error", err)
+		return nil, errors.New("failed to send code")
+	}
+	if !user.TwoFactorEnabled || !hasVerifiedPhone(user) {
+		return nil, errors.New("no verified phone number on this account")
+	}
+
+	// A new code replaces the last one, and lives no longer than a fresh
+	// challenge would.
+	code := utils.GenerateRandomCode(6)
+	if err := s.redisClient.Set(ctx, smsLoginKey(req.ChallengeToken), code, s.cfg.ChallengeTTL); err != nil {
+		s.logger.Error("Failed to cache sign-in code", "error", err)
+		return nil, errors.New("failed to send code")
+	}
+
+	body := fmt.Sprintf("Your sign-in code is %s. Don't share it with anyone.", code)
+	if err := s.codes.send(ctx, user.ID, user.PhoneNumber, body); err != nil {
+		return nil, err
+	}
+
+	return &dto.SMSCodeSentResponse{
+		SentTo:    sms.Mask(user.PhoneNumber),
+		ExpiresAt: time.Now().Add(s.cfg.ChallengeTTL),
+	}, nil
+}
+
+// startTwoFactorChallenge records that userID passed the password check and
+// returns the token that redeems it, together with a second factor, for
+// session tokens.
+func (s *authService) startTwoFactorChallenge(ctx context.Context, userID uint) (*dto.TwoFactorChallengeResponse, error) {
+	raw := make([]byte, 24)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, err
+	}
+	token := hex.EncodeToString(raw)
+
+	if err := s.redisClient.Set(ctx, challengeKey(token), strconv.FormatUint(uint64(userID), 10), s.twoFactor.ChallengeTTL); err != nil {
+		return nil, err
+	}
+
+	return &dto.TwoFactorChallengeResponse{
+		TwoFactorRequired: true,
+		ChallengeToken:    token,
+		ExpiresAt:         time.Now().Add(s.twoFactor.ChallengeTTL),
+	}, nil
+}
+
+// VerifyTwoFactor completes a password login that was answered with a
+// challenge. A challenge allows a few wrong codes and is gone once used.
+func (s *authService) VerifyTwoFactor(ctx context.Context, req *dto.VerifyTwoFactorRequest) (*dto.AuthResponse, error) {
+	key := challengeKey(req.ChallengeToken)
+
+	stored, err := s.redisClient.Get(ctx, key)
+	if err != nil {
+		return nil, errors.New("two-factor challenge expired or invalid")
+	}
+	userID, err := strconv.ParseUint(stored, 10, 64)
+	if err != nil {
+		return nil, errors.New("two-factor challenge expired or invalid")
+	}
+
+	attemptsKey := key + ":attempts"
+	attempts, err := s.redisClient.IncrBy(ctx, attemptsKey, 1)
+	if err != nil {
+		s.logger.Error("Failed to track two-factor attempts", "error", err)
+		return nil, errors.New("failed to authenticate")
+	}
+	if attempts == 1 {
+		s.redisClient.Expire(ctx, attemptsKey, s.twoFactor.ChallengeTTL)
+	}
+	if attempts > twoFactorAttemptLimit {
+		s.redisClient.Delete(ctx, key)
+		return nil, errors.New("too many two-factor attempts")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, uint(userID))
+	if err != nil {
+		s.logger.Error("Failed to get user", "error", err)
+		return nil, errors.New("failed to authenticate")
+	}
+	if !user.TwoFactorEnabled {
+		// Turned off since the challenge was issued; the password check
+		// that created it is still all the account asks for.
+		s.redisClient.Delete(ctx, key)
+		return s.issueTokens(ctx, user)
+	}
+
+	if req.SMSCode != "" {
+		// The phone may have been removed since the code was texted.
+		if !hasVerifiedPhone(user) {
+			return nil, errors.New("invalid two-factor code")
+		}
+		err = s.factor.verifySMS(ctx, req.ChallengeToken, req.SMSCode)
+	} else {
+		err = s.factor.verify(ctx, user, req.Code, req.RecoveryCode)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.redisClient.Delete(ctx, key)
+	s.redisClient.Delete(ctx, attemptsKey)
+
+	return s.issueTokens(ctx, user)
+}
+
+func pendingSecretKey(userID uint) string {
+	return fmt.Sprintf("two_factor_setup:%d", userID)
+}
+
+func challengeKey(token string) string {
+	return "two_factor_challenge:" + token
+}
+
+func smsLoginKey(token string) string {
+	return "two_factor_sms:" + token
+}
@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type UserInfo struct {
+	ID             uint   `json:"id"`
+	Username       string `json:"username"`
+	FullName       string `json:"full_name"`
+	Headline       string `json:"headline,omitempty"`
+	ProfilePicture string `json:"profile_picture,omitempty"`
+}
+
+type SavedJobResponse struct {
+	JobID               uint                     `json:"job_id"`
+	Title               string                   `json:"title"`
+	Company             string                   `json:"company"`
+	CompanyID           *uint                    `json:"company_id,omitempty"`
+	Location            string                   `json:"location"`
+	JobType             entities.JobType         `json:"job_type"`
+	ExperienceLevel     entities.ExperienceLevel `json:"experience_level"`
+	SalaryMin           *int                     `json:"salary_min,omitempty"`
+	SalaryMax           *int                     `json:"salary_max,omitempty"`
+	IsOpen              bool                     `json:"is_open"`
+	ApplicationDeadline *time.Time               `json:"application_deadline,omitempty"`
+	PostedAt            time.Time                `json:"posted_at"`
+	SavedAt             time.Time                `json:"saved_at"`
+}
+
+type SavedPostResponse struct {
+	PostID          uint              `json:"post_id"`
+	Content         string            `json:"content"`
+	RenderedContent string            `json:"rendered_content"`
+	ImageURL        string            `json:"image_url,omitempty"`
+	ImageAltText    string            `json:"image_alt_text,omitempty"`
+	Type            entities.PostType `json:"type"`
+	ReactionCount   int               `json:"reaction_count"`
+	Author          *UserInfo         `json:"author"`
+	PostedAt        time.Time         `json:"posted_at"`
+	SavedAt         time.Time         `json:"saved_at"`
+}
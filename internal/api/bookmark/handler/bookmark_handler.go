@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"linked-clone/internal/api/bookmark/service"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/request"
+	"linked-clone/pkg/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BookmarkHandler struct {
+	bookmarkService service.BookmarkService
+	logger          logger.Logger
+}
+
+func NewBookmarkHandler(bookmarkService service.BookmarkService, logger logger.Logger) *BookmarkHandler {
+	return &BookmarkHandler{
+		bookmarkService: bookmarkService,
+		logger:          logger,
+	}
+}
+
+func (h *BookmarkHandler) SaveJob(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	jobID, ok := request.ParseID(c, "jobId", "Invalid job ID")
+	if !ok {
+		return
+	}
+
+	if err := h.bookmarkService.SaveJob(c.Request.Context(), userID, jobID); err != nil {
+		h.logger.Error("Failed to save job", "error", err)
+		response.Error(c, bookmarkErrorStatus(err), "Failed to save job", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Job saved"})
+}
+
+func (h *BookmarkHandler) UnsaveJob(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	jobID, ok := request.ParseID(c, "jobId", "Invalid job ID")
+	if !ok {
+		return
+	}
+
+	if err := h.bookmarkService.UnsaveJob(c.Request.Context(), userID, jobID); err != nil {
+		h.logger.Error("Failed to unsave job", "error", err)
+		response.Error(c, bookmarkErrorStatus(err), "Failed to unsave job", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Job removed from saved jobs"})
+}
+
+func (h *BookmarkHandler) GetSavedJobs(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	limit, offset := request.Pagination(c)
+
+	jobs, err := h.bookmarkService.GetSavedJobs(c.Request.Context(), userID, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get saved jobs", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get saved jobs", err.Error())
+		return
+	}
+
+	response.Success(c, jobs)
+}
+
+func (h *BookmarkHandler) SavePost(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	postID, ok := request.ParseID(c, "postId", "Invalid post ID")
+	if !ok {
+		return
+	}
+
+	if err := h.bookmarkService.SavePost(c.Request.Context(), userID, postID); err != nil {
+		h.logger.Error("Failed to save post", "error", err)
+		response.Error(c, bookmarkErrorStatus(err), "Failed to save post", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Post saved"})
+}
+
+func (h *BookmarkHandler) UnsavePost(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	postID, ok := request.ParseID(c, "postId", "Invalid post ID")
+	if !ok {
+		return
+	}
+
+	if err := h.bookmarkService.UnsavePost(c.Request.Context(), userID, postID); err != nil {
+		h.logger.Error("Failed to unsave post", "error", err)
+		response.Error(c, bookmarkErrorStatus(err), "Failed to unsave post", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Post removed from saved posts"})
+}
+
+func (h *BookmarkHandler) GetSavedPosts(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	limit, offset := request.Pagination(c)
+
+	posts, err := h.bookmarkService.GetSavedPosts(c.Request.Context(), userID, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get saved posts", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get saved posts", err.Error())
+		return
+	}
+
+	response.Success(c, posts)
+}
+
+func bookmarkErrorStatus(err error) int {
+	switch err.Error() {
+	case "job not found", "post not found", "saved job not found", "saved post not found":
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type bookmarkRepository struct {
+	db *gorm.DB
+}
+
+func NewBookmarkRepository(db *gorm.DB) repositories.BookmarkRepository {
+	return &bookmarkRepository{db: db}
+}
+
+// SaveJob is idempotent: saving a job that is already saved keeps the
+// original saved time.
+func (r *bookmarkRepository) SaveJob(ctx context.Context, userID, jobID uint) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&entities.SavedJob{UserID: userID, JobID: jobID}).Error
+}
+
+func (r *bookmarkRepository) UnsaveJob(ctx context.Context, userID, jobID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND job_id = ?", userID, jobID).
+		Delete(&entities.SavedJob{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// GetSavedJobs lists the newest saves first and skips jobs that have since
+// been deleted.
+func (r *bookmarkRepository) GetSavedJobs(ctx context.Context, userID uint, limit, offset int) ([]*entities.SavedJob, error) {
+	var saved []*entities.SavedJob
+	err := r.db.WithContext(ctx).
+		Joins("JOIN jobs ON jobs.id = saved_jobs.job_id AND jobs.deleted_at IS NULL").
+		Where("saved_jobs.user_id = ?", userID).
+		Preload("Job").
+		Order("saved_jobs.created_at DESC, saved_jobs.id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&saved).Error
+	return saved, err
+}
+
+func (r *bookmarkRepository) SavePost(ctx context.Context, userID, postID uint) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&entities.SavedPost{UserID: userID, PostID: postID}).Error
+}
+
+func (r *bookmarkRepository) UnsavePost(ctx context.Context, userID, postID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND post_id = ?", userID, postID).
+		Delete(&entities.SavedPost{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *bookmarkRepository) GetSavedPosts(ctx context.Context, userID uint, limit, offset int) ([]*entities.SavedPost, error) {
+	var saved []*entities.SavedPost
+	err := r.db.WithContext(ctx).
+		Joins("JOIN posts ON posts.id = saved_posts.post_id AND posts.deleted_at IS NULL").
+		Where("saved_posts.user_id = ?", userID).
+		Preload("Post").
+		Preload("Post.User").
+		Order("saved_posts.created_at DESC, saved_posts.id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&saved).Error
+	return saved, err
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/bookmark/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/markdown"
+	"linked-clone/pkg/storage"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type BookmarkService interface {
+	SaveJob(ctx context.Context, userID, jobID uint) error
+	UnsaveJob(ctx context.Context, userID, jobID uint) error
+	GetSavedJobs(ctx context.Context, userID uint, limit, offset int) ([]*dto.SavedJobResponse, error)
+
+	SavePost(ctx context.Context, userID, postID uint) error
+	UnsavePost(ctx context.Context, userID, postID uint) error
+	GetSavedPosts(ctx context.Context, userID uint, limit, offset int) ([]*dto.SavedPostResponse, error)
+}
+
+type bookmarkService struct {
+	bookmarkRepo   repositories.BookmarkRepository
+	jobRepo        repositories.JobRepository
+	postRepo       repositories.PostRepository
+	storageService storage.StorageService
+	logger         logger.Logger
+}
+
+func NewBookmarkService(
+	bookmarkRepo repositories.BookmarkRepository,
+	jobRepo repositories.JobRepository,
+	postRepo repositories.PostRepository,
+	storageService storage.StorageService,
+	logger logger.Logger,
+) BookmarkService {
+	return &bookmarkService{
+		bookmarkRepo:   bookmarkRepo,
+		jobRepo:        jobRepo,
+		postRepo:       postRepo,
+		storageService: storageService,
+		logger:         logger,
+	}
+}
+
+// SaveJob only accepts published jobs; a job saved earlier stays on the list
+// after it closes so the user can see what happened to it.
+func (s *bookmarkService) SaveJob(ctx context.Context, userID, jobID uint) error {
+	job, err := s.jobRepo.GetByID(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("job not found")
+		}
+		s.logger.Error("Failed to get job", "job_id", jobID, "error", err)
+		return errors.New("failed to save job")
+	}
+	if job.Status != entities.JobPublished {
+		return errors.New("job not found")
+	}
+
+	if err := s.bookmarkRepo.SaveJob(ctx, userID, jobID); err != nil {
+		s.logger.Error("Failed to save job", "user_id", userID, "job_id", jobID, "error", err)
+		return errors.New("failed to save job")
+	}
+	return nil
+}
+
+func (s *bookmarkService) UnsaveJob(ctx context.Context, userID, jobID uint) error {
+	if err := s.bookmarkRepo.UnsaveJob(ctx, userID, jobID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("saved job not found")
+		}
+		s.logger.Error("Failed to unsave job", "user_id", userID, "job_id", jobID, "error", err)
+		return errors.New("failed to unsave job")
+	}
+	return nil
+}
+
+func (s *bookmarkService) GetSavedJobs(ctx context.Context, userID uint, limit, offset int) ([]*dto.SavedJobResponse, error) {
+	saved, err := s.bookmarkRepo.GetSavedJobs(ctx, userID, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to get saved jobs", "user_id", userID, "error", err)
+		return nil, errors.New("failed to get saved jobs")
+	}
+
+	now := time.Now()
+	responses := make([]*dto.SavedJobResponse, 0, len(saved))
+	for _, item := range saved {
+		job := item.Job
+		responses = append(responses, &dto.SavedJobResponse{
+			JobID:               job.ID,
+			Title:               job.Title,
+			Company:             job.Company,
+			CompanyID:           job.CompanyID,
+			Location:            job.Location,
+			JobType:             job.JobType,
+			ExperienceLevel:     job.ExperienceLevel,
+			SalaryMin:           job.SalaryMin,
+			SalaryMax:           job.SalaryMax,
+			IsOpen:              job.Status == entities.JobPublished && job.IsActive && !job.DeadlinePassed(now),
+			ApplicationDeadline: job.ApplicationDeadline,
+			PostedAt:            job.CreatedAt,
+			SavedAt:             item.CreatedAt,
+		})
+	}
+	return responses, nil
+}
+
+func (s *bookmarkService) SavePost(ctx context.Context, userID, postID uint) error {
+	if _, err := s.postRepo.GetByID(ctx, postID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("post not found")
+		}
+		s.logger.Error("Failed to get post", "post_id", postID, "error", err)
+		return errors.New("failed to save post")
+	}
+
+	if err := s.bookmarkRepo.SavePost(ctx, userID, postID); err != nil {
+		s.logger.Error("Failed to save post", "user_id", userID, "post_id", postID, "error", err)
+		return errors.New("failed to save post")
+	}
+	return nil
+}
+
+func (s *bookmarkService) UnsavePost(ctx context.Context, userID, postID uint) error {
+	if err := s.bookmarkRepo.UnsavePost(ctx, userID, postID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("saved post not found")
+		}
+		s.logger.Error("Failed to unsave post", "user_id", userID, "post_id", postID, "error", err)
+		return errors.New("failed to unsave post")
+	}
+	return nil
+}
+
+func (s *bookmarkService) GetSavedPosts(ctx context.Context, userID uint, limit, offset int) ([]*dto.SavedPostResponse, error) {
+	saved, err := s.bookmarkRepo.GetSavedPosts(ctx, userID, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to get saved posts", "user_id", userID, "error", err)
+		return nil, errors.New("failed to get saved posts")
+	}
+
+	responses := make([]*dto.SavedPostResponse, 0, len(saved))
+	for _, item := range saved {
+		post := item.Post
+		rendered := post.RenderedContent
+		if rendered == "" {
+			rendered = markdown.Render(post.Content)
+		}
+		responses = append(responses, &dto.SavedPostResponse{
+			PostID:          post.ID,
+			Content:         post.Content,
+			RenderedContent: rendered,
+			ImageURL:        s.presign(post.ImageURL),
+			ImageAltText:    post.ImageAltText,
+			Type:            post.Type,
+			ReactionCount:   post.ReactionCount,
+			Author: &dto.UserInfo{
+				ID:             post.User.ID,
+				Username:       post.User.Username,
+				FullName:       post.User.FullName,
+				Headline:       post.User.Headline,
+				ProfilePicture: s.presign(post.User.ProfilePicture),
+			},
+			PostedAt: post.CreatedAt,
+			SavedAt:  item.CreatedAt,
+		})
+	}
+	return responses, nil
+}
+
+func (s *bookmarkService) presign(key string) string {
+	if key == "" {
+		return ""
+	}
+	url, err := s.storageService.GeneratePresignedURL(key, 15*time.Minute)
+	if err != nil {
+		s.logger.Error("Failed to generate presigned URL", "error", err)
+		return key
+	}
+	return url
+}
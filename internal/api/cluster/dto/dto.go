@@ -0,0 +1,21 @@
+package dto
+
+import "time"
+
+type InstanceResponse struct {
+	ID               string    `json:"id"`
+	Hostname         string    `json:"hostname"`
+	PID              int       `json:"pid"`
+	StartedAt        time.Time `json:"started_at"`
+	LastHeartbeat    time.Time `json:"last_heartbeat"`
+	Leader           bool      `json:"leader"`
+	Current          bool      `json:"current"`
+	Responsibilities []string  `json:"responsibilities"`
+}
+
+type ClusterResponse struct {
+	InstanceID       string              `json:"instance_id"`
+	LeaderID         string              `json:"leader_id,omitempty"`
+	Responsibilities []string            `json:"responsibilities"`
+	Instances        []*InstanceResponse `json:"instances"`
+}
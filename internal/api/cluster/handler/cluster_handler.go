@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"linked-clone/internal/api/cluster/service"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ClusterHandler struct {
+	clusterService service.ClusterService
+	logger         logger.Logger
+}
+
+func NewClusterHandler(clusterService service.ClusterService, logger logger.Logger) *ClusterHandler {
+	return &ClusterHandler{
+		clusterService: clusterService,
+		logger:         logger,
+	}
+}
+
+func (h *ClusterHandler) GetInstances(c *gin.Context) {
+	cluster, err := h.clusterService.GetInstances(c.Request.Context())
+	if err != nil {
+		response.Error(c, http.StatusServiceUnavailable, "Failed to get cluster instances", err.Error())
+		return
+	}
+
+	response.Success(c, cluster)
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/cluster/dto"
+	"linked-clone/pkg/cluster"
+	"linked-clone/pkg/logger"
+)
+
+type ClusterService interface {
+	GetInstances(ctx context.Context) (*dto.ClusterResponse, error)
+}
+
+type clusterService struct {
+	coordinator cluster.Coordinator
+	logger      logger.Logger
+}
+
+func NewClusterService(coordinator cluster.Coordinator, logger logger.Logger) ClusterService {
+	return &clusterService{
+		coordinator: coordinator,
+		logger:      logger,
+	}
+}
+
+func (s *clusterService) GetInstances(ctx context.Context) (*dto.ClusterResponse, error) {
+	instances, err := s.coordinator.Instances(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list cluster instances", "error", err)
+		return nil, errors.New("failed to get cluster instances")
+	}
+
+	response := &dto.ClusterResponse{
+		InstanceID:       s.coordinator.ID(),
+		Responsibilities: s.coordinator.Responsibilities(),
+		Instances:        make([]*dto.InstanceResponse, 0, len(instances)),
+	}
+	for _, instance := range instances {
+		responsibilities := instance.Responsibilities
+		if responsibilities == nil {
+			responsibilities = []string{}
+		}
+		if instance.Leader {
+			response.LeaderID = instance.ID
+		}
+		response.Instances = append(response.Instances, &dto.InstanceResponse{
+			ID:               instance.ID,
+			Hostname:         instance.Hostname,
+			PID:              instance.PID,
+			StartedAt:        instance.StartedAt,
+			LastHeartbeat:    instance.LastHeartbeat,
+			Leader:           instance.Leader,
+			Current:          instance.ID == s.coordinator.ID(),
+			Responsibilities: responsibilities,
+		})
+	}
+	return response, nil
+}
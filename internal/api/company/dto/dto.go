@@ -0,0 +1,200 @@
+package dto
+
+import (
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type CreateCompanyRequest struct {
+	Name        string `json:"name" validate:"required,min=2,max=100"`
+	Domain      string `json:"domain" validate:"required,fqdn"`
+	Website     string `json:"website" validate:"omitempty,url"`
+	Description string `json:"description" validate:"omitempty,max=2000"`
+	IndustryID  *uint  `json:"industry_id"`
+}
+
+type BusinessEmailVerificationRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ConfirmEmailVerificationRequest struct {
+	Code string `json:"code" validate:"required,len=6"`
+}
+
+type ReviewVerificationRequest struct {
+	Note string `json:"note" validate:"omitempty,max=1000"`
+}
+
+type AddMemberRequest struct {
+	UserID uint                       `json:"user_id" validate:"required"`
+	Role   entities.CompanyMemberRole `json:"role" validate:"required,oneof=admin recruiter"`
+}
+
+type MemberResponse struct {
+	CompanyID uint                       `json:"company_id"`
+	UserID    uint                       `json:"user_id"`
+	Role      entities.CompanyMemberRole `json:"role"`
+	AddedBy   uint                       `json:"added_by"`
+	User      *UserInfo                  `json:"user,omitempty"`
+	CreatedAt time.Time                  `json:"created_at"`
+}
+
+type CreateTeamRequest struct {
+	Name        string `json:"name" validate:"required,min=2,max=100"`
+	Description string `json:"description" validate:"omitempty,max=1000"`
+	ParentID    *uint  `json:"parent_id"`
+}
+
+type UpdateTeamRequest struct {
+	Name        string  `json:"name" validate:"omitempty,min=2,max=100"`
+	Description *string `json:"description" validate:"omitempty,max=1000"`
+	ParentID    *uint   `json:"parent_id"`
+	ClearParent bool    `json:"clear_parent"`
+}
+
+type AddTeamMemberRequest struct {
+	UserID uint                    `json:"user_id" validate:"required"`
+	Role   entities.TeamMemberRole `json:"role" validate:"required,oneof=lead member"`
+}
+
+type TeamResponse struct {
+	ID          uint      `json:"id"`
+	CompanyID   uint      `json:"company_id"`
+	ParentID    *uint     `json:"parent_id,omitempty"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type TeamMemberResponse struct {
+	TeamID    uint                    `json:"team_id"`
+	UserID    uint                    `json:"user_id"`
+	Role      entities.TeamMemberRole `json:"role"`
+	AddedBy   uint                    `json:"added_by"`
+	User      *UserInfo               `json:"user,omitempty"`
+	CreatedAt time.Time               `json:"created_at"`
+}
+
+type TeamJobResponse struct {
+	ID        uint             `json:"id"`
+	Title     string           `json:"title"`
+	Location  string           `json:"location"`
+	JobType   entities.JobType `json:"job_type"`
+	CreatedAt time.Time        `json:"created_at"`
+}
+
+type TeamPageResponse struct {
+	Team     *TeamResponse         `json:"team"`
+	Company  *CompanyResponse      `json:"company"`
+	Parent   *TeamResponse         `json:"parent,omitempty"`
+	SubTeams []*TeamResponse       `json:"sub_teams"`
+	Members  []*TeamMemberResponse `json:"members"`
+	OpenJobs []*TeamJobResponse    `json:"open_jobs"`
+}
+
+type CompanyResponse struct {
+	ID          uint       `json:"id"`
+	OwnerID     uint       `json:"owner_id"`
+	Name        string     `json:"name"`
+	Domain      string     `json:"domain"`
+	Website     string     `json:"website,omitempty"`
+	Description string     `json:"description,omitempty"`
+	Industry    string     `json:"industry,omitempty"`
+	IndustryID  *uint      `json:"industry_id,omitempty"`
+	IsVerified  bool       `json:"is_verified"`
+	VerifiedAt  *time.Time `json:"verified_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type VerificationResponse struct {
+	ID               uint                               `json:"id"`
+	CompanyID        uint                               `json:"company_id"`
+	Method           entities.CompanyVerificationMethod `json:"method"`
+	Status           entities.CompanyVerificationStatus `json:"status"`
+	BusinessEmail    string                             `json:"business_email,omitempty"`
+	DocumentURL      string                             `json:"document_url,omitempty"`
+	EmailConfirmedAt *time.Time                         `json:"email_confirmed_at,omitempty"`
+	ReviewNote       string                             `json:"review_note,omitempty"`
+	ReviewedAt       *time.Time                         `json:"reviewed_at,omitempty"`
+	Company          *CompanyResponse                   `json:"company,omitempty"`
+	Submitter        *UserInfo                          `json:"submitter,omitempty"`
+	CreatedAt        time.Time                          `json:"created_at"`
+}
+
+type UserInfo struct {
+	ID       uint   `json:"id"`
+	Username string `json:"username"`
+	FullName string `json:"full_name"`
+	Email    string `json:"email,omitempty"`
+}
+
+type DailyFollowers struct {
+	Day          time.Time `json:"day"`
+	Followers    int64     `json:"followers"`
+	NewFollowers int64     `json:"new_followers"`
+}
+
+type FollowerStatsResponse struct {
+	From         time.Time         `json:"from"`
+	To           time.Time         `json:"to"`
+	AsOf         *time.Time        `json:"as_of,omitempty"`
+	Followers    int64             `json:"followers"`
+	NewFollowers int64             `json:"new_followers"`
+	NetGrowth    int64             `json:"net_growth"`
+	Daily        []*DailyFollowers `json:"daily"`
+}
+
+type JobPerformance struct {
+	JobID         uint               `json:"job_id"`
+	Title         string             `json:"title"`
+	Status        entities.JobStatus `json:"status"`
+	Views         int64              `json:"views"`
+	UniqueViewers int64              `json:"unique_viewers"`
+	Saves         int64              `json:"saves"`
+	Applications  int64              `json:"applications"`
+	ApplyRate     float64            `json:"apply_rate"`
+}
+
+type JobPerformanceResponse struct {
+	From          time.Time         `json:"from"`
+	To            time.Time         `json:"to"`
+	AsOf          *time.Time        `json:"as_of,omitempty"`
+	Views         int64             `json:"views"`
+	UniqueViewers int64             `json:"unique_viewers"`
+	Saves         int64             `json:"saves"`
+	Applications  int64             `json:"applications"`
+	ApplyRate     float64           `json:"apply_rate"`
+	Jobs          []*JobPerformance `json:"jobs"`
+}
+
+type DailyAdvocacy struct {
+	Day       time.Time `json:"day"`
+	Posts     int64     `json:"posts"`
+	Reactions int64     `json:"reactions"`
+	Posters   int64     `json:"posters"`
+}
+
+type EmployeeAdvocacyResponse struct {
+	From      time.Time        `json:"from"`
+	To        time.Time        `json:"to"`
+	AsOf      *time.Time       `json:"as_of,omitempty"`
+	Posts     int64            `json:"posts"`
+	Reactions int64            `json:"reactions"`
+	Daily     []*DailyAdvocacy `json:"daily"`
+}
+
+type ApplicationFunnelResponse struct {
+	From         time.Time  `json:"from"`
+	To           time.Time  `json:"to"`
+	AsOf         *time.Time `json:"as_of,omitempty"`
+	Applied      int64      `json:"applied"`
+	Reviewed     int64      `json:"reviewed"`
+	Shortlisted  int64      `json:"shortlisted"`
+	Interviewing int64      `json:"interviewing"`
+	Hired        int64      `json:"hired"`
+	Pending      int64      `json:"pending"`
+	Rejected     int64      `json:"rejected"`
+	Withdrawn    int64      `json:"withdrawn"`
+	ReviewRate   float64    `json:"review_rate"`
+	HireRate     float64    `json:"hire_rate"`
+}
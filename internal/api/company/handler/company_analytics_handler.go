@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"context"
+	"linked-clone/internal/api/company/service"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CompanyAnalyticsHandler struct {
+	analyticsService service.CompanyAnalyticsService
+	logger           logger.Logger
+}
+
+func NewCompanyAnalyticsHandler(analyticsService service.CompanyAnalyticsService, logger logger.Logger) *CompanyAnalyticsHandler {
+	return &CompanyAnalyticsHandler{
+		analyticsService: analyticsService,
+		logger:           logger,
+	}
+}
+
+func (h *CompanyAnalyticsHandler) GetFollowerStats(c *gin.Context) {
+	getCompanyAnalytics(c, h.logger, "follower statistics", h.analyticsService.GetFollowerStats)
+}
+
+func (h *CompanyAnalyticsHandler) GetJobPerformance(c *gin.Context) {
+	getCompanyAnalytics(c, h.logger, "job performance", h.analyticsService.GetJobPerformance)
+}
+
+func (h *CompanyAnalyticsHandler) GetEmployeeAdvocacy(c *gin.Context) {
+	getCompanyAnalytics(c, h.logger, "employee advocacy", h.analyticsService.GetEmployeeAdvocacy)
+}
+
+func (h *CompanyAnalyticsHandler) GetApplicationFunnel(c *gin.Context) {
+	getCompanyAnalytics(c, h.logger, "application funnel", h.analyticsService.GetApplicationFunnel)
+}
+
+func getCompanyAnalytics[T any](c *gin.Context, log logger.Logger, name string, get func(ctx context.Context, userID, companyID uint, days int) (T, error)) {
+	userID := middleware.GetUserID(c)
+
+	companyID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid company ID", err.Error())
+		return
+	}
+
+	days, _ := strconv.Atoi(c.DefaultQuery("days", "30"))
+
+	stats, err := get(c.Request.Context(), userID, uint(companyID), days)
+	if err != nil {
+		log.Error("Failed to get company "+name, "error", err)
+		response.Error(c, companyAnalyticsErrorStatus(err), "Failed to get "+name, err.Error())
+		return
+	}
+
+	response.Success(c, stats)
+}
+
+func companyAnalyticsErrorStatus(err error) int {
+	switch err.Error() {
+	case "company not found":
+		return http.StatusNotFound
+	case "unauthorized to view company analytics":
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,377 @@
+package handler
+
+import (
+	"context"
+	"linked-clone/internal/api/company/dto"
+	"linked-clone/internal/api/company/service"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CompanyHandler struct {
+	companyService service.CompanyService
+	validator      validation.Validator
+	logger         logger.Logger
+}
+
+func NewCompanyHandler(companyService service.CompanyService, validator validation.Validator, logger logger.Logger) *CompanyHandler {
+	return &CompanyHandler{
+		companyService: companyService,
+		validator:      validator,
+		logger:         logger,
+	}
+}
+
+func (h *CompanyHandler) CreateCompany(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.CreateCompanyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	company, err := h.companyService.CreateCompany(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.logger.Error("Failed to create company", "error", err)
+		if err.Error() == "company domain already registered" {
+			response.Error(c, http.StatusConflict, "Company domain already registered", "")
+			return
+		}
+		if err.Error() == "industry not found" {
+			response.Error(c, http.StatusNotFound, "Industry not found", "")
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to create company", err.Error())
+		return
+	}
+
+	response.Success(c, company)
+}
+
+func (h *CompanyHandler) GetCompany(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid company ID", err.Error())
+		return
+	}
+
+	company, err := h.companyService.GetCompany(c.Request.Context(), uint(id))
+	if err != nil {
+		h.logger.Error("Failed to get company", "error", err)
+		response.Error(c, http.StatusNotFound, "Company not found", err.Error())
+		return
+	}
+
+	response.Success(c, company)
+}
+
+func (h *CompanyHandler) GetMyCompanies(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	companies, err := h.companyService.GetMyCompanies(c.Request.Context(), userID)
+	if err != nil {
+		h.logger.Error("Failed to get companies", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get companies", err.Error())
+		return
+	}
+
+	response.Success(c, companies)
+}
+
+func (h *CompanyHandler) FollowCompany(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	companyID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid company ID", err.Error())
+		return
+	}
+
+	if err := h.companyService.FollowCompany(c.Request.Context(), userID, uint(companyID)); err != nil {
+		h.logger.Error("Failed to follow company", "error", err)
+		response.Error(c, verificationErrorStatus(err), "Failed to follow company", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Company followed"})
+}
+
+func (h *CompanyHandler) UnfollowCompany(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	companyID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid company ID", err.Error())
+		return
+	}
+
+	if err := h.companyService.UnfollowCompany(c.Request.Context(), userID, uint(companyID)); err != nil {
+		h.logger.Error("Failed to unfollow company", "error", err)
+		response.Error(c, verificationErrorStatus(err), "Failed to unfollow company", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Company unfollowed"})
+}
+
+func (h *CompanyHandler) SubmitBusinessEmail(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	companyID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid company ID", err.Error())
+		return
+	}
+
+	var req dto.BusinessEmailVerificationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	verification, err := h.companyService.SubmitBusinessEmail(c.Request.Context(), userID, uint(companyID), &req)
+	if err != nil {
+		h.logger.Error("Failed to submit business email verification", "error", err)
+		response.Error(c, verificationErrorStatus(err), "Failed to submit verification", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Verification code sent to business email", verification)
+}
+
+func (h *CompanyHandler) ConfirmBusinessEmail(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	verificationID, err := strconv.ParseUint(c.Param("verificationId"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid verification ID", err.Error())
+		return
+	}
+
+	var req dto.ConfirmEmailVerificationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	verification, err := h.companyService.ConfirmBusinessEmail(c.Request.Context(), userID, uint(verificationID), &req)
+	if err != nil {
+		h.logger.Error("Failed to confirm business email", "error", err)
+		response.Error(c, verificationErrorStatus(err), "Failed to confirm verification", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Business email confirmed, verification submitted for review", verification)
+}
+
+func (h *CompanyHandler) SubmitDocument(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	companyID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid company ID", err.Error())
+		return
+	}
+
+	file, err := c.FormFile("document")
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Document is required", err.Error())
+		return
+	}
+
+	verification, err := h.companyService.SubmitDocument(c.Request.Context(), userID, uint(companyID), file)
+	if err != nil {
+		h.logger.Error("Failed to submit verification document", "error", err)
+		response.Error(c, verificationErrorStatus(err), "Failed to submit verification", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Verification submitted for review", verification)
+}
+
+func (h *CompanyHandler) GetVerifications(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	companyID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid company ID", err.Error())
+		return
+	}
+
+	verifications, err := h.companyService.GetVerifications(c.Request.Context(), userID, uint(companyID))
+	if err != nil {
+		h.logger.Error("Failed to get verifications", "error", err)
+		response.Error(c, verificationErrorStatus(err), "Failed to get verifications", err.Error())
+		return
+	}
+
+	response.Success(c, verifications)
+}
+
+func (h *CompanyHandler) GetMembers(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	companyID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid company ID", err.Error())
+		return
+	}
+
+	members, err := h.companyService.GetMembers(c.Request.Context(), userID, uint(companyID))
+	if err != nil {
+		h.logger.Error("Failed to get company members", "error", err)
+		response.Error(c, verificationErrorStatus(err), "Failed to get members", err.Error())
+		return
+	}
+
+	response.Success(c, members)
+}
+
+func (h *CompanyHandler) AddMember(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	companyID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid company ID", err.Error())
+		return
+	}
+
+	var req dto.AddMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	member, err := h.companyService.AddMember(c.Request.Context(), userID, uint(companyID), &req)
+	if err != nil {
+		h.logger.Error("Failed to add company member", "error", err)
+		response.Error(c, verificationErrorStatus(err), "Failed to add member", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Member added", member)
+}
+
+func (h *CompanyHandler) RemoveMember(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	companyID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid company ID", err.Error())
+		return
+	}
+
+	memberID, err := strconv.ParseUint(c.Param("userId"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid user ID", err.Error())
+		return
+	}
+
+	if err := h.companyService.RemoveMember(c.Request.Context(), userID, uint(companyID), uint(memberID)); err != nil {
+		h.logger.Error("Failed to remove company member", "error", err)
+		response.Error(c, verificationErrorStatus(err), "Failed to remove member", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Member removed"})
+}
+
+func (h *CompanyHandler) GetReviewQueue(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	verifications, err := h.companyService.GetReviewQueue(c.Request.Context(), limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get verification queue", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get verification queue", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{
+		"verifications": verifications,
+		"limit":         limit,
+		"offset":        offset,
+	})
+}
+
+func (h *CompanyHandler) ApproveVerification(c *gin.Context) {
+	h.reviewVerification(c, "Verification approved", h.companyService.ApproveVerification)
+}
+
+func (h *CompanyHandler) RejectVerification(c *gin.Context) {
+	h.reviewVerification(c, "Verification rejected", h.companyService.RejectVerification)
+}
+
+func (h *CompanyHandler) reviewVerification(c *gin.Context, successMessage string, review func(ctx context.Context, adminID, verificationID uint, req *dto.ReviewVerificationRequest) (*dto.VerificationResponse, error)) {
+	adminID := middleware.GetUserID(c)
+
+	verificationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid verification ID", err.Error())
+		return
+	}
+
+	var req dto.ReviewVerificationRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+			return
+		}
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	verification, err := review(c.Request.Context(), adminID, uint(verificationID), &req)
+	if err != nil {
+		h.logger.Error("Failed to review verification", "error", err)
+		response.Error(c, verificationErrorStatus(err), "Failed to review verification", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, successMessage, verification)
+}
+
+func verificationErrorStatus(err error) int {
+	switch err.Error() {
+	case "company not found", "verification not found", "user not found", "not following this company":
+		return http.StatusNotFound
+	case "unauthorized to manage this company", "unauthorized to confirm this verification":
+		return http.StatusForbidden
+	case "company owner is already an admin", "verification already in progress", "verification is not pending review", "verification is not awaiting email confirmation":
+		return http.StatusConflict
+	case "email domain does not match company domain", "invalid verification code", "verification code expired or invalid":
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"linked-clone/internal/api/company/dto"
+	"linked-clone/internal/api/company/service"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TeamHandler struct {
+	teamService service.TeamService
+	validator   validation.Validator
+	logger      logger.Logger
+}
+
+func NewTeamHandler(teamService service.TeamService, validator validation.Validator, logger logger.Logger) *TeamHandler {
+	return &TeamHandler{
+		teamService: teamService,
+		validator:   validator,
+		logger:      logger,
+	}
+}
+
+func (h *TeamHandler) CreateTeam(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	companyID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid company ID", err.Error())
+		return
+	}
+
+	var req dto.CreateTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	team, err := h.teamService.CreateTeam(c.Request.Context(), userID, uint(companyID), &req)
+	if err != nil {
+		h.logger.Error("Failed to create team", "error", err)
+		response.Error(c, teamErrorStatus(err), "Failed to create team", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Team created", team)
+}
+
+func (h *TeamHandler) GetTeams(c *gin.Context) {
+	companyID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid company ID", err.Error())
+		return
+	}
+
+	teams, err := h.teamService.GetTeams(c.Request.Context(), uint(companyID))
+	if err != nil {
+		h.logger.Error("Failed to get teams", "error", err)
+		response.Error(c, teamErrorStatus(err), "Failed to get teams", err.Error())
+		return
+	}
+
+	response.Success(c, teams)
+}
+
+func (h *TeamHandler) GetTeamPage(c *gin.Context) {
+	companyID, teamID, ok := parseTeamParams(c)
+	if !ok {
+		return
+	}
+
+	page, err := h.teamService.GetTeamPage(c.Request.Context(), companyID, teamID)
+	if err != nil {
+		h.logger.Error("Failed to get team", "error", err)
+		response.Error(c, teamErrorStatus(err), "Failed to get team", err.Error())
+		return
+	}
+
+	response.Success(c, page)
+}
+
+func (h *TeamHandler) UpdateTeam(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	companyID, teamID, ok := parseTeamParams(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	team, err := h.teamService.UpdateTeam(c.Request.Context(), userID, companyID, teamID, &req)
+	if err != nil {
+		h.logger.Error("Failed to update team", "error", err)
+		response.Error(c, teamErrorStatus(err), "Failed to update team", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Team updated", team)
+}
+
+func (h *TeamHandler) DeleteTeam(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	companyID, teamID, ok := parseTeamParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.teamService.DeleteTeam(c.Request.Context(), userID, companyID, teamID); err != nil {
+		h.logger.Error("Failed to delete team", "error", err)
+		response.Error(c, teamErrorStatus(err), "Failed to delete team", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Team deleted"})
+}
+
+func (h *TeamHandler) AddTeamMember(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	companyID, teamID, ok := parseTeamParams(c)
+	if !ok {
+		return
+	}
+
+	var req dto.AddTeamMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	member, err := h.teamService.AddTeamMember(c.Request.Context(), userID, companyID, teamID, &req)
+	if err != nil {
+		h.logger.Error("Failed to add team member", "error", err)
+		response.Error(c, teamErrorStatus(err), "Failed to add team member", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Team member added", member)
+}
+
+func (h *TeamHandler) RemoveTeamMember(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	companyID, teamID, ok := parseTeamParams(c)
+	if !ok {
+		return
+	}
+
+	memberID, err := strconv.ParseUint(c.Param("userId"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid user ID", err.Error())
+		return
+	}
+
+	if err := h.teamService.RemoveTeamMember(c.Request.Context(), userID, companyID, teamID, uint(memberID)); err != nil {
+		h.logger.Error("Failed to remove team member", "error", err)
+		response.Error(c, teamErrorStatus(err), "Failed to remove team member", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Team member removed"})
+}
+
+func parseTeamParams(c *gin.Context) (uint, uint, bool) {
+	companyID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid company ID", err.Error())
+		return 0, 0, false
+	}
+
+	teamID, err := strconv.ParseUint(c.Param("teamId"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid team ID", err.Error())
+		return 0, 0, false
+	}
+
+	return uint(companyID), uint(teamID), true
+}
+
+func teamErrorStatus(err error) int {
+	switch {
+	case strings.HasSuffix(err.Error(), "not found"):
+		return http.StatusNotFound
+	case strings.HasPrefix(err.Error(), "unauthorized"), strings.HasPrefix(err.Error(), "only company admins"):
+		return http.StatusForbidden
+	case err.Error() == "team name already exists", err.Error() == "team has sub-teams":
+		return http.StatusConflict
+	case err.Error() == "teams can only be nested one level deep":
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
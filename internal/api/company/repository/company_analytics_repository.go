@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/counter"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type companyAnalyticsRepository struct {
+	db *gorm.DB
+}
+
+func NewCompanyAnalyticsRepository(db *gorm.DB) repositories.CompanyAnalyticsRepository {
+	return &companyAnalyticsRepository{db: db}
+}
+
+// rollupCompanyDaySQL writes one row per company for the day. Employee
+// activity counts posts written that day by anyone tied to the company as
+// owner, company member or team member; reactions are the posts' current
+// totals.
+const rollupCompanyDaySQL = `
+INSERT INTO company_daily_stats (
+	company_id, day, followers, new_followers,
+	employee_posts, employee_post_reactions, employee_posters, updated_at
+)
+SELECT
+	c.id,
+	@day,
+	(SELECT COUNT(*) FROM company_follows f WHERE f.company_id = c.id AND f.created_at < @day_end),
+	(SELECT COUNT(*) FROM company_follows f WHERE f.company_id = c.id AND f.created_at >= @day_start AND f.created_at < @day_end),
+	p.posts,
+	p.reactions,
+	p.posters,
+	@now
+FROM companies c
+CROSS JOIN LATERAL (
+	SELECT
+		COUNT(*) AS posts,
+		COALESCE(SUM(posts.reaction_count), 0) AS reactions,
+		COUNT(DISTINCT posts.user_id) AS posters
+	FROM posts
+	WHERE posts.deleted_at IS NULL
+		AND posts.created_at >= @day_start AND posts.created_at < @day_end
+		AND posts.user_id IN (
+			SELECT c.owner_id
+			UNION
+			SELECT cm.user_id FROM company_members cm WHERE cm.company_id = c.id
+			UNION
+			SELECT tm.user_id FROM team_members tm
+			JOIN company_teams t ON t.id = tm.team_id AND t.deleted_at IS NULL
+			WHERE t.company_id = c.id
+		)
+) p
+WHERE c.deleted_at IS NULL
+ON CONFLICT (company_id, day) DO UPDATE SET
+	followers = EXCLUDED.followers,
+	new_followers = EXCLUDED.new_followers,
+	employee_posts = EXCLUDED.employee_posts,
+	employee_post_reactions = EXCLUDED.employee_post_reactions,
+	employee_posters = EXCLUDED.employee_posters,
+	updated_at = EXCLUDED.updated_at`
+
+// rollupJobDaySQL writes one row per company job that saw any views, saves
+// or applications that day. Views come from view_rollups, so the view rollup
+// has to have flushed the day first to be counted.
+const rollupJobDaySQL = `
+INSERT INTO job_daily_stats (
+	job_id, day, company_id, views, unique_viewers, saves,
+	applications, pending, reviewed, shortlisted, interviewing, hired,
+	rejected, withdrawn, updated_at
+)
+SELECT
+	j.id,
+	@day,
+	j.company_id,
+	COALESCE(v.total_views, 0),
+	COALESCE(v.unique_viewers, 0),
+	s.saves,
+	a.total,
+	a.pending,
+	a.reviewed,
+	a.shortlisted,
+	a.interviewing,
+	a.hired,
+	a.rejected,
+	a.withdrawn,
+	@now
+FROM jobs j
+LEFT JOIN view_rollups v ON v.entity_type = @view_kind AND v.entity_id = j.id AND v.day = @day
+CROSS JOIN LATERAL (
+	SELECT COUNT(*) AS saves FROM saved_jobs sj
+	WHERE sj.job_id = j.id AND sj.created_at >= @day_start AND sj.created_at < @day_end
+) s
+CROSS JOIN LATERAL (
+	SELECT
+		COUNT(*) AS total,
+		COUNT(*) FILTER (WHERE ap.status = @pending) AS pending,
+		COUNT(*) FILTER (WHERE ap.status = @reviewed) AS reviewed,
+		COUNT(*) FILTER (WHERE ap.status = @shortlisted) AS shortlisted,
+		COUNT(*) FILTER (WHERE ap.status = @interviewing) AS interviewing,
+		COUNT(*) FILTER (WHERE ap.status = @hired) AS hired,
+		COUNT(*) FILTER (WHERE ap.status = @rejected) AS rejected,
+		COUNT(*) FILTER (WHERE ap.status = @withdrawn) AS withdrawn
+	FROM applications ap
+	WHERE ap.job_id = j.id AND ap.deleted_at IS NULL
+		AND ap.applied_at >= @day_start AND ap.applied_at < @day_end
+) a
+WHERE j.company_id IS NOT NULL AND j.deleted_at IS NULL
+	AND (v.entity_id IS NOT NULL OR s.saves > 0 OR a.total > 0)
+ON CONFLICT (job_id, day) DO UPDATE SET
+	company_id = EXCLUDED.company_id,
+	views = EXCLUDED.views,
+	unique_viewers = EXCLUDED.unique_viewers,
+	saves = EXCLUDED.saves,
+	applications = EXCLUDED.applications,
+	pending = EXCLUDED.pending,
+	reviewed = EXCLUDED.reviewed,
+	shortlisted = EXCLUDED.shortlisted,
+	interviewing = EXCLUDED.interviewing,
+	hired = EXCLUDED.hired,
+	rejected = EXCLUDED.rejected,
+	withdrawn = EXCLUDED.withdrawn,
+	updated_at = EXCLUDED.updated_at`
+
+func (r *companyAnalyticsRepository) RollupDay(ctx context.Context, day time.Time) (int64, error) {
+	day = day.UTC().Truncate(24 * time.Hour)
+	args := map[string]interface{}{
+		"day":          day,
+		"day_start":    day,
+		"day_end":      day.AddDate(0, 0, 1),
+		"now":          time.Now(),
+		"view_kind":    string(counter.ViewJob),
+		"pending":      entities.ApplicationPending,
+		"reviewed":     entities.ApplicationReviewed,
+		"shortlisted":  entities.ApplicationShortlisted,
+		"interviewing": entities.ApplicationInterviewing,
+		"hired":        entities.ApplicationHired,
+		"rejected":     entities.ApplicationRejected,
+		"withdrawn":    entities.ApplicationWithdrawn,
+	}
+
+	var written int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec(rollupCompanyDaySQL, args)
+		if result.Error != nil {
+			return result.Error
+		}
+		written += result.RowsAffected
+
+		result = tx.Exec(rollupJobDaySQL, args)
+		if result.Error != nil {
+			return result.Error
+		}
+		written += result.RowsAffected
+		return nil
+	})
+	return written, err
+}
+
+func (r *companyAnalyticsRepository) GetDailyStats(ctx context.Context, companyID uint, from, to time.Time) ([]*entities.CompanyDailyStats, error) {
+	var stats []*entities.CompanyDailyStats
+	err := r.db.WithContext(ctx).
+		Where("company_id = ? AND day >= ? AND day <= ?", companyID, from, to).
+		Order("day ASC").
+		Find(&stats).Error
+	return stats, err
+}
+
+func (r *companyAnalyticsRepository) GetJobStats(ctx context.Context, companyID uint, from, to time.Time) ([]*entities.JobDailyStats, error) {
+	var stats []*entities.JobDailyStats
+	err := r.db.WithContext(ctx).
+		Preload("Job", func(db *gorm.DB) *gorm.DB { return db.Unscoped() }).
+		Where("company_id = ? AND day >= ? AND day <= ?", companyID, from, to).
+		Order("day ASC, job_id ASC").
+		Find(&stats).Error
+	return stats, err
+}
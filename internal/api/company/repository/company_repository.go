@@ -0,0 +1,240 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type companyRepository struct {
+	db *gorm.DB
+}
+
+func NewCompanyRepository(db *gorm.DB) repositories.CompanyRepository {
+	return &companyRepository{db: db}
+}
+
+func (r *companyRepository) Create(ctx context.Context, company *entities.Company) error {
+	return r.db.WithContext(ctx).Create(company).Error
+}
+
+func (r *companyRepository) GetByID(ctx context.Context, id uint) (*entities.Company, error) {
+	var company entities.Company
+	err := r.db.WithContext(ctx).First(&company, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &company, nil
+}
+
+func (r *companyRepository) GetByDomain(ctx context.Context, domain string) (*entities.Company, error) {
+	var company entities.Company
+	err := r.db.WithContext(ctx).Where("domain = ?", domain).First(&company).Error
+	if err != nil {
+		return nil, err
+	}
+	return &company, nil
+}
+
+func (r *companyRepository) GetByOwnerID(ctx context.Context, ownerID uint) ([]*entities.Company, error) {
+	var companies []*entities.Company
+	err := r.db.WithContext(ctx).
+		Where("owner_id = ?", ownerID).
+		Order("created_at DESC").
+		Find(&companies).Error
+	return companies, err
+}
+
+func (r *companyRepository) Update(ctx context.Context, company *entities.Company) error {
+	return r.db.WithContext(ctx).Save(company).Error
+}
+
+func (r *companyRepository) SetVerified(ctx context.Context, id uint, verified bool, verifiedAt *time.Time) error {
+	return r.db.WithContext(ctx).Model(&entities.Company{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"is_verified": verified,
+			"verified_at": verifiedAt,
+		}).Error
+}
+
+func (r *companyRepository) SetDomainVerification(ctx context.Context, id uint, token string, verifiedAt *time.Time) error {
+	return r.db.WithContext(ctx).Model(&entities.Company{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"domain_token":       token,
+			"domain_verified_at": verifiedAt,
+		}).Error
+}
+
+type companyVerificationRepository struct {
+	db *gorm.DB
+}
+
+func NewCompanyVerificationRepository(db *gorm.DB) repositories.CompanyVerificationRepository {
+	return &companyVerificationRepository{db: db}
+}
+
+func (r *companyVerificationRepository) Create(ctx context.Context, verification *entities.CompanyVerification) error {
+	return r.db.WithContext(ctx).Create(verification).Error
+}
+
+func (r *companyVerificationRepository) GetByID(ctx context.Context, id uint) (*entities.CompanyVerification, error) {
+	var verification entities.CompanyVerification
+	err := r.db.WithContext(ctx).
+		Preload("Company").
+		Preload("Submitter").
+		First(&verification, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &verification, nil
+}
+
+func (r *companyVerificationRepository) GetByCompanyID(ctx context.Context, companyID uint) ([]*entities.CompanyVerification, error) {
+	var verifications []*entities.CompanyVerification
+	err := r.db.WithContext(ctx).
+		Where("company_id = ?", companyID).
+		Order("created_at DESC").
+		Find(&verifications).Error
+	return verifications, err
+}
+
+func (r *companyVerificationRepository) GetByStatus(ctx context.Context, status entities.CompanyVerificationStatus, limit, offset int) ([]*entities.CompanyVerification, error) {
+	var verifications []*entities.CompanyVerification
+	err := r.db.WithContext(ctx).
+		Preload("Company").
+		Preload("Submitter").
+		Where("status = ?", status).
+		Order("created_at ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&verifications).Error
+	return verifications, err
+}
+
+func (r *companyVerificationRepository) HasPendingRequest(ctx context.Context, companyID uint) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&entities.CompanyVerification{}).
+		Where("company_id = ? AND status = ?", companyID, entities.CompanyVerificationPending).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *companyVerificationRepository) Update(ctx context.Context, verification *entities.CompanyVerification) error {
+	return r.db.WithContext(ctx).Save(verification).Error
+}
+
+type companyMemberRepository struct {
+	db *gorm.DB
+}
+
+func NewCompanyMemberRepository(db *gorm.DB) repositories.CompanyMemberRepository {
+	return &companyMemberRepository{db: db}
+}
+
+func (r *companyMemberRepository) Upsert(ctx context.Context, member *entities.CompanyMember) error {
+	return r.db.WithContext(ctx).
+		Omit("User").
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "company_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"role", "added_by", "updated_at"}),
+		}).
+		Create(member).Error
+}
+
+func (r *companyMemberRepository) Get(ctx context.Context, companyID, userID uint) (*entities.CompanyMember, error) {
+	var member entities.CompanyMember
+	err := r.db.WithContext(ctx).
+		Where("company_id = ? AND user_id = ?", companyID, userID).
+		First(&member).Error
+	if err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+func (r *companyMemberRepository) GetByCompanyID(ctx context.Context, companyID uint) ([]*entities.CompanyMember, error) {
+	var members []*entities.CompanyMember
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("company_id = ?", companyID).
+		Order("created_at ASC").
+		Find(&members).Error
+	return members, err
+}
+
+func (r *companyMemberRepository) GetCompanyIDsByRole(ctx context.Context, userID uint, role entities.CompanyMemberRole) ([]uint, error) {
+	var companyIDs []uint
+	err := r.db.WithContext(ctx).Model(&entities.CompanyMember{}).
+		Where("user_id = ? AND role = ?", userID, role).
+		Pluck("company_id", &companyIDs).Error
+	return companyIDs, err
+}
+
+func (r *companyMemberRepository) GetByUserIDs(ctx context.Context, userIDs []uint) ([]*entities.CompanyMember, error) {
+	var members []*entities.CompanyMember
+	if len(userIDs) == 0 {
+		return members, nil
+	}
+
+	err := r.db.WithContext(ctx).
+		Where("user_id IN ?", userIDs).
+		Find(&members).Error
+	return members, err
+}
+
+func (r *companyMemberRepository) Delete(ctx context.Context, companyID, userID uint) error {
+	return r.db.WithContext(ctx).
+		Where("company_id = ? AND user_id = ?", companyID, userID).
+		Delete(&entities.CompanyMember{}).Error
+}
+
+type companyFollowRepository struct {
+	db *gorm.DB
+}
+
+func NewCompanyFollowRepository(db *gorm.DB) repositories.CompanyFollowRepository {
+	return &companyFollowRepository{db: db}
+}
+
+func (r *companyFollowRepository) Follow(ctx context.Context, companyID, userID uint) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&entities.CompanyFollow{CompanyID: companyID, UserID: userID}).Error
+}
+
+func (r *companyFollowRepository) Unfollow(ctx context.Context, companyID, userID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("company_id = ? AND user_id = ?", companyID, userID).
+		Delete(&entities.CompanyFollow{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *companyFollowRepository) IsFollowing(ctx context.Context, companyID, userID uint) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.CompanyFollow{}).
+		Where("company_id = ? AND user_id = ?", companyID, userID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *companyFollowRepository) CountFollowers(ctx context.Context, companyID uint) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.CompanyFollow{}).
+		Where("company_id = ?", companyID).
+		Count(&count).Error
+	return count, err
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type companyTeamRepository struct {
+	db *gorm.DB
+}
+
+func NewCompanyTeamRepository(db *gorm.DB) repositories.CompanyTeamRepository {
+	return &companyTeamRepository{db: db}
+}
+
+func (r *companyTeamRepository) Create(ctx context.Context, team *entities.CompanyTeam) error {
+	return r.db.WithContext(ctx).Omit("Company").Create(team).Error
+}
+
+func (r *companyTeamRepository) GetByID(ctx context.Context, id uint) (*entities.CompanyTeam, error) {
+	var team entities.CompanyTeam
+	err := r.db.WithContext(ctx).First(&team, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+func (r *companyTeamRepository) GetByCompanyID(ctx context.Context, companyID uint) ([]*entities.CompanyTeam, error) {
+	var teams []*entities.CompanyTeam
+	err := r.db.WithContext(ctx).
+		Where("company_id = ?", companyID).
+		Order("name ASC").
+		Find(&teams).Error
+	return teams, err
+}
+
+func (r *companyTeamRepository) Update(ctx context.Context, team *entities.CompanyTeam) error {
+	return r.db.WithContext(ctx).Omit("Company").Save(team).Error
+}
+
+// Delete removes the team with its memberships and unscopes any jobs that
+// were posted for it, so they fall back to company-wide visibility.
+func (r *companyTeamRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("team_id = ?", id).Delete(&entities.TeamMember{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&entities.Job{}).Where("team_id = ?", id).Update("team_id", nil).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&entities.CompanyTeam{}, id).Error
+	})
+}
+
+type teamMemberRepository struct {
+	db *gorm.DB
+}
+
+func NewTeamMemberRepository(db *gorm.DB) repositories.TeamMemberRepository {
+	return &teamMemberRepository{db: db}
+}
+
+func (r *teamMemberRepository) Upsert(ctx context.Context, member *entities.TeamMember) error {
+	return r.db.WithContext(ctx).
+		Omit("User").
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "team_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"role", "added_by", "updated_at"}),
+		}).
+		Create(member).Error
+}
+
+func (r *teamMemberRepository) Get(ctx context.Context, teamID, userID uint) (*entities.TeamMember, error) {
+	var member entities.TeamMember
+	err := r.db.WithContext(ctx).
+		Where("team_id = ? AND user_id = ?", teamID, userID).
+		First(&member).Error
+	if err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+func (r *teamMemberRepository) GetByTeamID(ctx context.Context, teamID uint) ([]*entities.TeamMember, error) {
+	var members []*entities.TeamMember
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("team_id = ?", teamID).
+		Order("role ASC, created_at ASC").
+		Find(&members).Error
+	return members, err
+}
+
+func (r *teamMemberRepository) Delete(ctx context.Context, teamID, userID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("team_id = ? AND user_id = ?", teamID, userID).
+		Delete(&entities.TeamMember{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
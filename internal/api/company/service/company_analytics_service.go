@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/company/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const maxCompanyAnalyticsDays = 90
+
+// CompanyAnalyticsService serves the org admin dashboard. Everything except
+// the current follower total is read from the daily rollups, so the newest
+// numbers trail live activity by up to one rollup interval.
+type CompanyAnalyticsService interface {
+	GetFollowerStats(ctx context.Context, userID, companyID uint, days int) (*dto.FollowerStatsResponse, error)
+	GetJobPerformance(ctx context.Context, userID, companyID uint, days int) (*dto.JobPerformanceResponse, error)
+	GetEmployeeAdvocacy(ctx context.Context, userID, companyID uint, days int) (*dto.EmployeeAdvocacyResponse, error)
+	GetApplicationFunnel(ctx context.Context, userID, companyID uint, days int) (*dto.ApplicationFunnelResponse, error)
+}
+
+type companyAnalyticsService struct {
+	companyRepo   repositories.CompanyRepository
+	memberRepo    repositories.CompanyMemberRepository
+	followRepo    repositories.CompanyFollowRepository
+	analyticsRepo repositories.CompanyAnalyticsRepository
+	logger        logger.Logger
+}
+
+func NewCompanyAnalyticsService(
+	companyRepo repositories.CompanyRepository,
+	memberRepo repositories.CompanyMemberRepository,
+	followRepo repositories.CompanyFollowRepository,
+	analyticsRepo repositories.CompanyAnalyticsRepository,
+	logger logger.Logger,
+) CompanyAnalyticsService {
+	return &companyAnalyticsService{
+		companyRepo:   companyRepo,
+		memberRepo:    memberRepo,
+		followRepo:    followRepo,
+		analyticsRepo: analyticsRepo,
+		logger:        logger,
+	}
+}
+
+func (s *companyAnalyticsService) GetFollowerStats(ctx context.Context, userID, companyID uint, days int) (*dto.FollowerStatsResponse, error) {
+	stats, from, to, err := s.getDailyStats(ctx, userID, companyID, days)
+	if err != nil {
+		return nil, err
+	}
+
+	followers, err := s.followRepo.CountFollowers(ctx, companyID)
+	if err != nil {
+		s.logger.Error("Failed to count company followers", "error", err)
+		return nil, errors.New("failed to get follower statistics")
+	}
+
+	byDay := make(map[string]*entities.CompanyDailyStats, len(stats))
+	for _, stat := range stats {
+		byDay[dayKey(stat.Day)] = stat
+	}
+
+	response := &dto.FollowerStatsResponse{
+		From:      from,
+		To:        to,
+		AsOf:      latestDailyUpdate(stats),
+		Followers: followers,
+		Daily:     make([]*dto.DailyFollowers, 0, len(stats)),
+	}
+
+	// The range starts from the total before the first rolled-up day; days
+	// without a row (before the rollup first ran) carry the previous total.
+	var previous int64
+	if len(stats) > 0 {
+		previous = stats[0].Followers - stats[0].NewFollowers
+	}
+	start := previous
+
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		daily := &dto.DailyFollowers{Day: day, Followers: previous}
+		if stat, ok := byDay[dayKey(day)]; ok {
+			daily.Followers = stat.Followers
+			daily.NewFollowers = stat.NewFollowers
+		}
+
+		response.NewFollowers += daily.NewFollowers
+		response.Daily = append(response.Daily, daily)
+		previous = daily.Followers
+	}
+	response.NetGrowth = previous - start
+
+	return response, nil
+}
+
+func (s *companyAnalyticsService) GetJobPerformance(ctx context.Context, userID, companyID uint, days int) (*dto.JobPerformanceResponse, error) {
+	stats, from, to, err := s.getJobStats(ctx, userID, companyID, days)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.JobPerformanceResponse{
+		From: from,
+		To:   to,
+		AsOf: latestJobUpdate(stats),
+		Jobs: []*dto.JobPerformance{},
+	}
+
+	byJob := make(map[uint]*dto.JobPerformance)
+	for _, stat := range stats {
+		job, ok := byJob[stat.JobID]
+		if !ok {
+			job = &dto.JobPerformance{
+				JobID:  stat.JobID,
+				Title:  stat.Job.Title,
+				Status: stat.Job.Status,
+			}
+			byJob[stat.JobID] = job
+			response.Jobs = append(response.Jobs, job)
+		}
+
+		job.Views += stat.Views
+		job.UniqueViewers += stat.UniqueViewers
+		job.Saves += stat.Saves
+		job.Applications += stat.Applications
+
+		response.Views += stat.Views
+		response.UniqueViewers += stat.UniqueViewers
+		response.Saves += stat.Saves
+		response.Applications += stat.Applications
+	}
+
+	for _, job := range response.Jobs {
+		job.ApplyRate = rate(job.Applications, job.UniqueViewers)
+	}
+	response.ApplyRate = rate(response.Applications, response.UniqueViewers)
+
+	sort.SliceStable(response.Jobs, func(i, j int) bool {
+		if response.Jobs[i].Applications != response.Jobs[j].Applications {
+			return response.Jobs[i].Applications > response.Jobs[j].Applications
+		}
+		return response.Jobs[i].Views > response.Jobs[j].Views
+	})
+
+	return response, nil
+}
+
+func (s *companyAnalyticsService) GetEmployeeAdvocacy(ctx context.Context, userID, companyID uint, days int) (*dto.EmployeeAdvocacyResponse, error) {
+	stats, from, to, err := s.getDailyStats(ctx, userID, companyID, days)
+	if err != nil {
+		return nil, err
+	}
+
+	byDay := make(map[string]*entities.CompanyDailyStats, len(stats))
+	for _, stat := range stats {
+		byDay[dayKey(stat.Day)] = stat
+	}
+
+	response := &dto.EmployeeAdvocacyResponse{
+		From:  from,
+		To:    to,
+		AsOf:  latestDailyUpdate(stats),
+		Daily: make([]*dto.DailyAdvocacy, 0, len(stats)),
+	}
+
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		daily := &dto.DailyAdvocacy{Day: day}
+		if stat, ok := byDay[dayKey(day)]; ok {
+			daily.Posts = stat.EmployeePosts
+			daily.Reactions = stat.EmployeePostReactions
+			daily.Posters = stat.EmployeePosters
+		}
+
+		response.Posts += daily.Posts
+		response.Reactions += daily.Reactions
+		response.Daily = append(response.Daily, daily)
+	}
+
+	return response, nil
+}
+
+func (s *companyAnalyticsService) GetApplicationFunnel(ctx context.Context, userID, companyID uint, days int) (*dto.ApplicationFunnelResponse, error) {
+	stats, from, to, err := s.getJobStats(ctx, userID, companyID, days)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ApplicationFunnelResponse{
+		From: from,
+		To:   to,
+		AsOf: latestJobUpdate(stats),
+	}
+
+	// Each stage counts the applications that reached it, judged by their
+	// current status. Rejected applications count as reviewed only, since
+	// the rollup does not keep the stage they were rejected at.
+	for _, stat := range stats {
+		response.Applied += stat.Applications
+		response.Pending += stat.Pending
+		response.Rejected += stat.Rejected
+		response.Withdrawn += stat.Withdrawn
+		response.Hired += stat.Hired
+		response.Interviewing += stat.Interviewing + stat.Hired
+		response.Shortlisted += stat.Shortlisted + stat.Interviewing + stat.Hired
+		response.Reviewed += stat.Reviewed + stat.Shortlisted + stat.Interviewing + stat.Hired + stat.Rejected
+	}
+
+	response.ReviewRate = rate(response.Reviewed, response.Applied)
+	response.HireRate = rate(response.Hired, response.Applied)
+
+	return response, nil
+}
+
+func (s *companyAnalyticsService) getDailyStats(ctx context.Context, userID, companyID uint, days int) ([]*entities.CompanyDailyStats, time.Time, time.Time, error) {
+	if err := s.checkAdmin(ctx, userID, companyID); err != nil {
+		return nil, time.Time{}, time.Time{}, err
+	}
+
+	from, to := analyticsRange(days)
+	stats, err := s.analyticsRepo.GetDailyStats(ctx, companyID, from, to)
+	if err != nil {
+		s.logger.Error("Failed to get company daily stats", "error", err)
+		return nil, time.Time{}, time.Time{}, errors.New("failed to get company analytics")
+	}
+
+	return stats, from, to, nil
+}
+
+func (s *companyAnalyticsService) getJobStats(ctx context.Context, userID, companyID uint, days int) ([]*entities.JobDailyStats, time.Time, time.Time, error) {
+	if err := s.checkAdmin(ctx, userID, companyID); err != nil {
+		return nil, time.Time{}, time.Time{}, err
+	}
+
+	from, to := analyticsRange(days)
+	stats, err := s.analyticsRepo.GetJobStats(ctx, companyID, from, to)
+	if err != nil {
+		s.logger.Error("Failed to get company job stats", "error", err)
+		return nil, time.Time{}, time.Time{}, errors.New("failed to get company analytics")
+	}
+
+	return stats, from, to, nil
+}
+
+func (s *companyAnalyticsService) checkAdmin(ctx context.Context, userID, companyID uint) error {
+	company, err := s.companyRepo.GetByID(ctx, companyID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("company not found")
+		}
+		s.logger.Error("Failed to get company", "error", err)
+		return errors.New("failed to get company")
+	}
+
+	if company.OwnerID == userID {
+		return nil
+	}
+
+	member, err := s.memberRepo.Get(ctx, companyID, userID)
+	if err != nil || member.Role != entities.CompanyRoleAdmin {
+		return errors.New("unauthorized to view company analytics")
+	}
+
+	return nil
+}
+
+func analyticsRange(days int) (time.Time, time.Time) {
+	if days <= 0 || days > maxCompanyAnalyticsDays {
+		days = 30
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	return today.AddDate(0, 0, -(days - 1)), today
+}
+
+func dayKey(day time.Time) string {
+	return day.UTC().Format("2006-01-02")
+}
+
+func rate(part, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(part) / float64(total)
+}
+
+func latestDailyUpdate(stats []*entities.CompanyDailyStats) *time.Time {
+	var latest *time.Time
+	for _, stat := range stats {
+		if latest == nil || stat.UpdatedAt.After(*latest) {
+			updated := stat.UpdatedAt
+			latest = &updated
+		}
+	}
+	return latest
+}
+
+func latestJobUpdate(stats []*entities.JobDailyStats) *time.Time {
+	var latest *time.Time
+	for _, stat := range stats {
+		if latest == nil || stat.UpdatedAt.After(*latest) {
+			updated := stat.UpdatedAt
+			latest = &updated
+		}
+	}
+	return latest
+}
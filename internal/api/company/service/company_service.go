@@ -0,0 +1,586 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"linked-clone/internal/api/company/dto"
+	taxonomyService "linked-clone/internal/api/taxonomy/service"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/redis"
+	email "linked-clone/pkg/smtp"
+	"linked-clone/pkg/storage"
+	"linked-clone/pkg/utils"
+	"mime/multipart"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const companyVerificationCodeTTL = 30 * time.Minute
+
+type CompanyService interface {
+	CreateCompany(ctx context.Context, userID uint, req *dto.CreateCompanyRequest) (*dto.CompanyResponse, error)
+	GetCompany(ctx context.Context, id uint) (*dto.CompanyResponse, error)
+	GetMyCompanies(ctx context.Context, userID uint) ([]*dto.CompanyResponse, error)
+	FollowCompany(ctx context.Context, userID, companyID uint) error
+	UnfollowCompany(ctx context.Context, userID, companyID uint) error
+
+	SubmitBusinessEmail(ctx context.Context, userID, companyID uint, req *dto.BusinessEmailVerificationRequest) (*dto.VerificationResponse, error)
+	ConfirmBusinessEmail(ctx context.Context, userID, verificationID uint, req *dto.ConfirmEmailVerificationRequest) (*dto.VerificationResponse, error)
+	SubmitDocument(ctx context.Context, userID, companyID uint, file *multipart.FileHeader) (*dto.VerificationResponse, error)
+	GetVerifications(ctx context.Context, userID, companyID uint) ([]*dto.VerificationResponse, error)
+
+	GetMembers(ctx context.Context, userID, companyID uint) ([]*dto.MemberResponse, error)
+	AddMember(ctx context.Context, userID, companyID uint, req *dto.AddMemberRequest) (*dto.MemberResponse, error)
+	RemoveMember(ctx context.Context, userID, companyID, memberID uint) error
+
+	GetReviewQueue(ctx context.Context, limit, offset int) ([]*dto.VerificationResponse, error)
+	ApproveVerification(ctx context.Context, adminID, verificationID uint, req *dto.ReviewVerificationRequest) (*dto.VerificationResponse, error)
+	RejectVerification(ctx context.Context, adminID, verificationID uint, req *dto.ReviewVerificationRequest) (*dto.VerificationResponse, error)
+}
+
+type companyService struct {
+	companyRepo      repositories.CompanyRepository
+	verificationRepo repositories.CompanyVerificationRepository
+	memberRepo       repositories.CompanyMemberRepository
+	followRepo       repositories.CompanyFollowRepository
+	userRepo         repositories.UserRepository
+	storageService   storage.StorageService
+	emailService     email.EmailService
+	redisClient      redis.RedisClient
+	taxonomySvc      taxonomyService.TaxonomyService
+	logger           logger.Logger
+}
+
+func NewCompanyService(
+	companyRepo repositories.CompanyRepository,
+	verificationRepo repositories.CompanyVerificationRepository,
+	memberRepo repositories.CompanyMemberRepository,
+	followRepo repositories.CompanyFollowRepository,
+	userRepo repositories.UserRepository,
+	storageService storage.StorageService,
+	emailService email.EmailService,
+	redisClient redis.RedisClient,
+	taxonomySvc taxonomyService.TaxonomyService,
+	logger logger.Logger,
+) CompanyService {
+	return &companyService{
+		companyRepo:      companyRepo,
+		verificationRepo: verificationRepo,
+		memberRepo:       memberRepo,
+		followRepo:       followRepo,
+		userRepo:         userRepo,
+		storageService:   storageService,
+		emailService:     emailService,
+		redisClient:      redisClient,
+		taxonomySvc:      taxonomySvc,
+		logger:           logger,
+	}
+}
+
+func (s *companyService) CreateCompany(ctx context.Context, userID uint, req *dto.CreateCompanyRequest) (*dto.CompanyResponse, error) {
+	domain := strings.ToLower(strings.TrimSpace(req.Domain))
+
+	if _, err := s.companyRepo.GetByDomain(ctx, domain); err == nil {
+		return nil, errors.New("company domain already registered")
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.logger.Error("Failed to check company domain", "error", err)
+		return nil, errors.New("failed to create company")
+	}
+
+	company := &entities.Company{
+		OwnerID:     userID,
+		Name:        req.Name,
+		Domain:      domain,
+		Website:     req.Website,
+		Description: req.Description,
+	}
+	if req.IndustryID != nil {
+		industry, err := s.taxonomySvc.GetIndustry(ctx, *req.IndustryID)
+		if err != nil {
+			return nil, err
+		}
+		company.IndustryID = &industry.ID
+		company.Industry = industry.Name
+	}
+
+	if err := s.companyRepo.Create(ctx, company); err != nil {
+		s.logger.Error("Failed to create company", "error", err)
+		return nil, errors.New("failed to create company")
+	}
+
+	return s.mapCompanyToResponse(company), nil
+}
+
+func (s *companyService) GetCompany(ctx context.Context, id uint) (*dto.CompanyResponse, error) {
+	company, err := s.companyRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("company not found")
+		}
+		s.logger.Error("Failed to get company", "error", err)
+		return nil, errors.New("failed to get company")
+	}
+
+	return s.mapCompanyToResponse(company), nil
+}
+
+func (s *companyService) GetMyCompanies(ctx context.Context, userID uint) ([]*dto.CompanyResponse, error) {
+	companies, err := s.companyRepo.GetByOwnerID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get companies", "error", err)
+		return nil, errors.New("failed to get companies")
+	}
+
+	responses := make([]*dto.CompanyResponse, 0, len(companies))
+	for _, company := range companies {
+		responses = append(responses, s.mapCompanyToResponse(company))
+	}
+
+	return responses, nil
+}
+
+func (s *companyService) FollowCompany(ctx context.Context, userID, companyID uint) error {
+	if _, err := s.getCompany(ctx, companyID); err != nil {
+		return err
+	}
+
+	if err := s.followRepo.Follow(ctx, companyID, userID); err != nil {
+		s.logger.Error("Failed to follow company", "error", err)
+		return errors.New("failed to follow company")
+	}
+
+	return nil
+}
+
+func (s *companyService) UnfollowCompany(ctx context.Context, userID, companyID uint) error {
+	if err := s.followRepo.Unfollow(ctx, companyID, userID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("not following this company")
+		}
+		s.logger.Error("Failed to unfollow company", "error", err)
+		return errors.New("failed to unfollow company")
+	}
+
+	return nil
+}
+
+func (s *companyService) SubmitBusinessEmail(ctx context.Context, userID, companyID uint, req *dto.BusinessEmailVerificationRequest) (*dto.VerificationResponse, error) {
+	company, err := s.getOwnedCompany(ctx, userID, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if pending, err := s.verificationRepo.HasPendingRequest(ctx, company.ID); err != nil {
+		s.logger.Error("Failed to check pending verification requests", "error", err)
+		return nil, errors.New("failed to submit verification")
+	} else if pending {
+		return nil, errors.New("verification already in progress")
+	}
+
+	address := strings.ToLower(strings.TrimSpace(req.Email))
+	emailDomain := address[strings.LastIndex(address, "@")+1:]
+	if emailDomain != company.Domain && !strings.HasSuffix(emailDomain, "."+company.Domain) {
+		return nil, errors.New("email domain does not match company domain")
+	}
+
+	verification := &entities.CompanyVerification{
+		CompanyID:     company.ID,
+		SubmittedBy:   userID,
+		Method:        entities.CompanyVerificationBusinessEmail,
+		Status:        entities.CompanyVerificationAwaitingEmail,
+		BusinessEmail: address,
+	}
+
+	if err := s.verificationRepo.Create(ctx, verification); err != nil {
+		s.logger.Error("Failed to create company verification", "error", err)
+		return nil, errors.New("failed to submit verification")
+	}
+
+	code := utils.GenerateRandomCode(6)
+	if err := s.redisClient.Set(ctx, verificationCodeKey(verification.ID), code, companyVerificationCodeTTL); err != nil {
+		s.logger.Error("Failed to cache company verification code", "error", err)
+		return nil, errors.New("failed to submit verification")
+	}
+
+	go func() {
+		if err := s.emailService.SendCompanyVerificationEmail(address, company.Name, code); err != nil {
+			s.logger.Error("Failed to send company verification email", "error", err)
+		}
+	}()
+
+	verification.Company = *company
+	return s.mapVerificationToResponse(verification), nil
+}
+
+func (s *companyService) ConfirmBusinessEmail(ctx context.Context, userID, verificationID uint, req *dto.ConfirmEmailVerificationRequest) (*dto.VerificationResponse, error) {
+	verification, err := s.verificationRepo.GetByID(ctx, verificationID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("verification not found")
+		}
+		s.logger.Error("Failed to get company verification", "error", err)
+		return nil, errors.New("failed to confirm verification")
+	}
+
+	if verification.SubmittedBy != userID {
+		return nil, errors.New("unauthorized to confirm this verification")
+	}
+
+	if verification.Status != entities.CompanyVerificationAwaitingEmail {
+		return nil, errors.New("verification is not awaiting email confirmation")
+	}
+
+	cacheKey := verificationCodeKey(verification.ID)
+	cachedCode, err := s.redisClient.Get(ctx, cacheKey)
+	if err != nil {
+		return nil, errors.New("verification code expired or invalid")
+	}
+
+	if cachedCode != req.Code {
+		return nil, errors.New("invalid verification code")
+	}
+
+	now := time.Now()
+	verification.EmailConfirmedAt = &now
+	verification.Status = entities.CompanyVerificationPending
+
+	if err := s.verificationRepo.Update(ctx, verification); err != nil {
+		s.logger.Error("Failed to update company verification", "error", err)
+		return nil, errors.New("failed to confirm verification")
+	}
+
+	s.redisClient.Delete(ctx, cacheKey)
+
+	return s.mapVerificationToResponse(verification), nil
+}
+
+func (s *companyService) SubmitDocument(ctx context.Context, userID, companyID uint, file *multipart.FileHeader) (*dto.VerificationResponse, error) {
+	company, err := s.getOwnedCompany(ctx, userID, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if pending, err := s.verificationRepo.HasPendingRequest(ctx, company.ID); err != nil {
+		s.logger.Error("Failed to check pending verification requests", "error", err)
+		return nil, errors.New("failed to submit verification")
+	} else if pending {
+		return nil, errors.New("verification already in progress")
+	}
+
+	documentKey, err := s.storageService.UploadFile(ctx, file, fmt.Sprintf("verifications/companies/%d", company.ID))
+	if err != nil {
+		s.logger.Error("Failed to upload verification document", "error", err)
+		return nil, errors.New("failed to upload document")
+	}
+
+	verification := &entities.CompanyVerification{
+		CompanyID:   company.ID,
+		SubmittedBy: userID,
+		Method:      entities.CompanyVerificationDocument,
+		Status:      entities.CompanyVerificationPending,
+		DocumentKey: documentKey,
+	}
+
+	if err := s.verificationRepo.Create(ctx, verification); err != nil {
+		s.logger.Error("Failed to create company verification", "error", err)
+		return nil, errors.New("failed to submit verification")
+	}
+
+	verification.Company = *company
+	return s.mapVerificationToResponse(verification), nil
+}
+
+func (s *companyService) GetVerifications(ctx context.Context, userID, companyID uint) ([]*dto.VerificationResponse, error) {
+	if _, err := s.getOwnedCompany(ctx, userID, companyID); err != nil {
+		return nil, err
+	}
+
+	verifications, err := s.verificationRepo.GetByCompanyID(ctx, companyID)
+	if err != nil {
+		s.logger.Error("Failed to get company verifications", "error", err)
+		return nil, errors.New("failed to get verifications")
+	}
+
+	responses := make([]*dto.VerificationResponse, 0, len(verifications))
+	for _, verification := range verifications {
+		responses = append(responses, s.mapVerificationToResponse(verification))
+	}
+
+	return responses, nil
+}
+
+func (s *companyService) GetMembers(ctx context.Context, userID, companyID uint) ([]*dto.MemberResponse, error) {
+	company, err := s.getCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if company.OwnerID != userID {
+		if _, err := s.memberRepo.Get(ctx, companyID, userID); err != nil {
+			return nil, errors.New("unauthorized to manage this company")
+		}
+	}
+
+	members, err := s.memberRepo.GetByCompanyID(ctx, companyID)
+	if err != nil {
+		s.logger.Error("Failed to get company members", "error", err)
+		return nil, errors.New("failed to get members")
+	}
+
+	responses := make([]*dto.MemberResponse, 0, len(members))
+	for _, member := range members {
+		responses = append(responses, s.mapMemberToResponse(member))
+	}
+
+	return responses, nil
+}
+
+func (s *companyService) AddMember(ctx context.Context, userID, companyID uint, req *dto.AddMemberRequest) (*dto.MemberResponse, error) {
+	company, err := s.getAdministeredCompany(ctx, userID, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.UserID == company.OwnerID {
+		return nil, errors.New("company owner is already an admin")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, req.UserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("user not found")
+		}
+		s.logger.Error("Failed to get user", "error", err)
+		return nil, errors.New("failed to add member")
+	}
+
+	member := &entities.CompanyMember{
+		CompanyID: companyID,
+		UserID:    req.UserID,
+		Role:      req.Role,
+		AddedBy:   userID,
+	}
+
+	if err := s.memberRepo.Upsert(ctx, member); err != nil {
+		s.logger.Error("Failed to add company member", "error", err)
+		return nil, errors.New("failed to add member")
+	}
+
+	member.User = *user
+	return s.mapMemberToResponse(member), nil
+}
+
+func (s *companyService) RemoveMember(ctx context.Context, userID, companyID, memberID uint) error {
+	if _, err := s.getAdministeredCompany(ctx, userID, companyID); err != nil {
+		return err
+	}
+
+	if err := s.memberRepo.Delete(ctx, companyID, memberID); err != nil {
+		s.logger.Error("Failed to remove company member", "error", err)
+		return errors.New("failed to remove member")
+	}
+
+	return nil
+}
+
+func (s *companyService) GetReviewQueue(ctx context.Context, limit, offset int) ([]*dto.VerificationResponse, error) {
+	verifications, err := s.verificationRepo.GetByStatus(ctx, entities.CompanyVerificationPending, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to get verification queue", "error", err)
+		return nil, errors.New("failed to get verification queue")
+	}
+
+	responses := make([]*dto.VerificationResponse, 0, len(verifications))
+	for _, verification := range verifications {
+		responses = append(responses, s.mapVerificationToResponse(verification))
+	}
+
+	return responses, nil
+}
+
+func (s *companyService) ApproveVerification(ctx context.Context, adminID, verificationID uint, req *dto.ReviewVerificationRequest) (*dto.VerificationResponse, error) {
+	verification, err := s.review(ctx, adminID, verificationID, entities.CompanyVerificationApproved, req.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.companyRepo.SetVerified(ctx, verification.CompanyID, true, verification.ReviewedAt); err != nil {
+		s.logger.Error("Failed to mark company verified", "error", err)
+		return nil, errors.New("failed to approve verification")
+	}
+
+	verification.Company.IsVerified = true
+	verification.Company.VerifiedAt = verification.ReviewedAt
+
+	return s.mapVerificationToResponse(verification), nil
+}
+
+func (s *companyService) RejectVerification(ctx context.Context, adminID, verificationID uint, req *dto.ReviewVerificationRequest) (*dto.VerificationResponse, error) {
+	verification, err := s.review(ctx, adminID, verificationID, entities.CompanyVerificationRejected, req.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.mapVerificationToResponse(verification), nil
+}
+
+func (s *companyService) review(ctx context.Context, adminID, verificationID uint, status entities.CompanyVerificationStatus, note string) (*entities.CompanyVerification, error) {
+	verification, err := s.verificationRepo.GetByID(ctx, verificationID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("verification not found")
+		}
+		s.logger.Error("Failed to get company verification", "error", err)
+		return nil, errors.New("failed to review verification")
+	}
+
+	if verification.Status != entities.CompanyVerificationPending {
+		return nil, errors.New("verification is not pending review")
+	}
+
+	now := time.Now()
+	verification.Status = status
+	verification.ReviewerID = &adminID
+	verification.ReviewNote = note
+	verification.ReviewedAt = &now
+
+	if err := s.verificationRepo.Update(ctx, verification); err != nil {
+		s.logger.Error("Failed to update company verification", "error", err)
+		return nil, errors.New("failed to review verification")
+	}
+
+	s.logger.Info("Company verification reviewed",
+		"verification_id", verification.ID,
+		"company_id", verification.CompanyID,
+		"reviewer_id", adminID,
+		"status", status)
+
+	return verification, nil
+}
+
+func (s *companyService) getCompany(ctx context.Context, companyID uint) (*entities.Company, error) {
+	company, err := s.companyRepo.GetByID(ctx, companyID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("company not found")
+		}
+		s.logger.Error("Failed to get company", "error", err)
+		return nil, errors.New("failed to get company")
+	}
+	return company, nil
+}
+
+func (s *companyService) getAdministeredCompany(ctx context.Context, userID, companyID uint) (*entities.Company, error) {
+	company, err := s.getCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if company.OwnerID == userID {
+		return company, nil
+	}
+
+	member, err := s.memberRepo.Get(ctx, companyID, userID)
+	if err != nil || member.Role != entities.CompanyRoleAdmin {
+		return nil, errors.New("unauthorized to manage this company")
+	}
+
+	return company, nil
+}
+
+func (s *companyService) getOwnedCompany(ctx context.Context, userID, companyID uint) (*entities.Company, error) {
+	company, err := s.getCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if company.OwnerID != userID {
+		return nil, errors.New("unauthorized to manage this company")
+	}
+
+	return company, nil
+}
+
+func (s *companyService) mapCompanyToResponse(company *entities.Company) *dto.CompanyResponse {
+	return &dto.CompanyResponse{
+		ID:          company.ID,
+		OwnerID:     company.OwnerID,
+		Name:        company.Name,
+		Domain:      company.Domain,
+		Website:     company.Website,
+		Description: company.Description,
+		Industry:    company.Industry,
+		IndustryID:  company.IndustryID,
+		IsVerified:  company.IsVerified,
+		VerifiedAt:  company.VerifiedAt,
+		CreatedAt:   company.CreatedAt,
+	}
+}
+
+func (s *companyService) mapVerificationToResponse(verification *entities.CompanyVerification) *dto.VerificationResponse {
+	response := &dto.VerificationResponse{
+		ID:               verification.ID,
+		CompanyID:        verification.CompanyID,
+		Method:           verification.Method,
+		Status:           verification.Status,
+		BusinessEmail:    verification.BusinessEmail,
+		EmailConfirmedAt: verification.EmailConfirmedAt,
+		ReviewNote:       verification.ReviewNote,
+		ReviewedAt:       verification.ReviewedAt,
+		CreatedAt:        verification.CreatedAt,
+	}
+
+	if verification.DocumentKey != "" {
+		url, err := s.storageService.GeneratePresignedURL(verification.DocumentKey, 15*time.Minute)
+		if err != nil {
+			s.logger.Error("Failed to generate document presigned URL", "error", err)
+		} else {
+			response.DocumentURL = url
+		}
+	}
+
+	if verification.Company.ID != 0 {
+		response.Company = s.mapCompanyToResponse(&verification.Company)
+	}
+
+	if verification.Submitter.ID != 0 {
+		response.Submitter = &dto.UserInfo{
+			ID:       verification.Submitter.ID,
+			Username: verification.Submitter.Username,
+			FullName: verification.Submitter.FullName,
+			Email:    verification.Submitter.Email,
+		}
+	}
+
+	return response
+}
+
+func (s *companyService) mapMemberToResponse(member *entities.CompanyMember) *dto.MemberResponse {
+	response := &dto.MemberResponse{
+		CompanyID: member.CompanyID,
+		UserID:    member.UserID,
+		Role:      member.Role,
+		AddedBy:   member.AddedBy,
+		CreatedAt: member.CreatedAt,
+	}
+
+	if member.User.ID != 0 {
+		response.User = &dto.UserInfo{
+			ID:       member.User.ID,
+			Username: member.User.Username,
+			FullName: member.User.FullName,
+			Email:    member.User.Email,
+		}
+	}
+
+	return response
+}
+
+func verificationCodeKey(verificationID uint) string {
+	return fmt.Sprintf("company_verification:%d", verificationID)
+}
@@ -0,0 +1,453 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/company/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+const teamPageJobLimit = 20
+
+type TeamService interface {
+	CreateTeam(ctx context.Context, userID, companyID uint, req *dto.CreateTeamRequest) (*dto.TeamResponse, error)
+	GetTeams(ctx context.Context, companyID uint) ([]*dto.TeamResponse, error)
+	GetTeamPage(ctx context.Context, companyID, teamID uint) (*dto.TeamPageResponse, error)
+	UpdateTeam(ctx context.Context, userID, companyID, teamID uint, req *dto.UpdateTeamRequest) (*dto.TeamResponse, error)
+	DeleteTeam(ctx context.Context, userID, companyID, teamID uint) error
+
+	AddTeamMember(ctx context.Context, userID, companyID, teamID uint, req *dto.AddTeamMemberRequest) (*dto.TeamMemberResponse, error)
+	RemoveTeamMember(ctx context.Context, userID, companyID, teamID, memberID uint) error
+}
+
+type teamService struct {
+	companyRepo    repositories.CompanyRepository
+	memberRepo     repositories.CompanyMemberRepository
+	teamRepo       repositories.CompanyTeamRepository
+	teamMemberRepo repositories.TeamMemberRepository
+	userRepo       repositories.UserRepository
+	jobRepo        repositories.JobRepository
+	logger         logger.Logger
+}
+
+func NewTeamService(
+	companyRepo repositories.CompanyRepository,
+	memberRepo repositories.CompanyMemberRepository,
+	teamRepo repositories.CompanyTeamRepository,
+	teamMemberRepo repositories.TeamMemberRepository,
+	userRepo repositories.UserRepository,
+	jobRepo repositories.JobRepository,
+	logger logger.Logger,
+) TeamService {
+	return &teamService{
+		companyRepo:    companyRepo,
+		memberRepo:     memberRepo,
+		teamRepo:       teamRepo,
+		teamMemberRepo: teamMemberRepo,
+		userRepo:       userRepo,
+		jobRepo:        jobRepo,
+		logger:         logger,
+	}
+}
+
+func (s *teamService) CreateTeam(ctx context.Context, userID, companyID uint, req *dto.CreateTeamRequest) (*dto.TeamResponse, error) {
+	if _, err := s.getAdministeredCompany(ctx, userID, companyID); err != nil {
+		return nil, err
+	}
+
+	teams, err := s.teamRepo.GetByCompanyID(ctx, companyID)
+	if err != nil {
+		s.logger.Error("Failed to get company teams", "error", err)
+		return nil, errors.New("failed to create team")
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if nameTaken(teams, name, 0) {
+		return nil, errors.New("team name already exists")
+	}
+	if err := checkParent(teams, req.ParentID, 0); err != nil {
+		return nil, err
+	}
+
+	team := &entities.CompanyTeam{
+		CompanyID:   companyID,
+		ParentID:    req.ParentID,
+		Name:        name,
+		Description: req.Description,
+		CreatedBy:   userID,
+	}
+
+	if err := s.teamRepo.Create(ctx, team); err != nil {
+		s.logger.Error("Failed to create team", "error", err)
+		return nil, errors.New("failed to create team")
+	}
+
+	return mapTeamToResponse(team), nil
+}
+
+func (s *teamService) GetTeams(ctx context.Context, companyID uint) ([]*dto.TeamResponse, error) {
+	if _, err := s.getCompany(ctx, companyID); err != nil {
+		return nil, err
+	}
+
+	teams, err := s.teamRepo.GetByCompanyID(ctx, companyID)
+	if err != nil {
+		s.logger.Error("Failed to get company teams", "error", err)
+		return nil, errors.New("failed to get teams")
+	}
+
+	responses := make([]*dto.TeamResponse, 0, len(teams))
+	for _, team := range teams {
+		responses = append(responses, mapTeamToResponse(team))
+	}
+
+	return responses, nil
+}
+
+func (s *teamService) GetTeamPage(ctx context.Context, companyID, teamID uint) (*dto.TeamPageResponse, error) {
+	company, err := s.getCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := s.teamRepo.GetByCompanyID(ctx, companyID)
+	if err != nil {
+		s.logger.Error("Failed to get company teams", "error", err)
+		return nil, errors.New("failed to get team")
+	}
+
+	page := &dto.TeamPageResponse{
+		Company: &dto.CompanyResponse{
+			ID:         company.ID,
+			Name:       company.Name,
+			Domain:     company.Domain,
+			Website:    company.Website,
+			IsVerified: company.IsVerified,
+		},
+		SubTeams: make([]*dto.TeamResponse, 0),
+		Members:  make([]*dto.TeamMemberResponse, 0),
+		OpenJobs: make([]*dto.TeamJobResponse, 0),
+	}
+	var parentID *uint
+	for _, team := range teams {
+		if team.ID == teamID {
+			page.Team = mapTeamToResponse(team)
+			parentID = team.ParentID
+		}
+		if team.ParentID != nil && *team.ParentID == teamID {
+			page.SubTeams = append(page.SubTeams, mapTeamToResponse(team))
+		}
+	}
+	if page.Team == nil {
+		return nil, errors.New("team not found")
+	}
+	for _, team := range teams {
+		if parentID != nil && team.ID == *parentID {
+			page.Parent = mapTeamToResponse(team)
+		}
+	}
+
+	members, err := s.teamMemberRepo.GetByTeamID(ctx, teamID)
+	if err != nil {
+		s.logger.Error("Failed to get team members", "error", err)
+		return nil, errors.New("failed to get team")
+	}
+	for _, member := range members {
+		page.Members = append(page.Members, mapTeamMemberToResponse(member, false))
+	}
+
+	jobs, err := s.jobRepo.GetAll(ctx, map[string]interface{}{"team_id": teamID}, teamPageJobLimit, 0)
+	if err != nil {
+		s.logger.Error("Failed to get team jobs", "error", err)
+		return nil, errors.New("failed to get team")
+	}
+	for _, job := range jobs {
+		page.OpenJobs = append(page.OpenJobs, &dto.TeamJobResponse{
+			ID:        job.ID,
+			Title:     job.Title,
+			Location:  job.Location,
+			JobType:   job.JobType,
+			CreatedAt: job.CreatedAt,
+		})
+	}
+
+	return page, nil
+}
+
+func (s *teamService) UpdateTeam(ctx context.Context, userID, companyID, teamID uint, req *dto.UpdateTeamRequest) (*dto.TeamResponse, error) {
+	if _, err := s.getAdministeredCompany(ctx, userID, companyID); err != nil {
+		return nil, err
+	}
+
+	teams, err := s.teamRepo.GetByCompanyID(ctx, companyID)
+	if err != nil {
+		s.logger.Error("Failed to get company teams", "error", err)
+		return nil, errors.New("failed to update team")
+	}
+
+	team := findTeam(teams, teamID)
+	if team == nil {
+		return nil, errors.New("team not found")
+	}
+
+	if req.Name != "" {
+		name := strings.TrimSpace(req.Name)
+		if nameTaken(teams, name, team.ID) {
+			return nil, errors.New("team name already exists")
+		}
+		team.Name = name
+	}
+	if req.Description != nil {
+		team.Description = *req.Description
+	}
+	if req.ClearParent {
+		team.ParentID = nil
+	} else if req.ParentID != nil {
+		if err := checkParent(teams, req.ParentID, team.ID); err != nil {
+			return nil, err
+		}
+		team.ParentID = req.ParentID
+	}
+
+	if err := s.teamRepo.Update(ctx, team); err != nil {
+		s.logger.Error("Failed to update team", "error", err)
+		return nil, errors.New("failed to update team")
+	}
+
+	return mapTeamToResponse(team), nil
+}
+
+func (s *teamService) DeleteTeam(ctx context.Context, userID, companyID, teamID uint) error {
+	if _, err := s.getAdministeredCompany(ctx, userID, companyID); err != nil {
+		return err
+	}
+
+	teams, err := s.teamRepo.GetByCompanyID(ctx, companyID)
+	if err != nil {
+		s.logger.Error("Failed to get company teams", "error", err)
+		return errors.New("failed to delete team")
+	}
+
+	if findTeam(teams, teamID) == nil {
+		return errors.New("team not found")
+	}
+	for _, team := range teams {
+		if team.ParentID != nil && *team.ParentID == teamID {
+			return errors.New("team has sub-teams")
+		}
+	}
+
+	if err := s.teamRepo.Delete(ctx, teamID); err != nil {
+		s.logger.Error("Failed to delete team", "error", err)
+		return errors.New("failed to delete team")
+	}
+
+	return nil
+}
+
+func (s *teamService) AddTeamMember(ctx context.Context, userID, companyID, teamID uint, req *dto.AddTeamMemberRequest) (*dto.TeamMemberResponse, error) {
+	team, isAdmin, err := s.getManagedTeam(ctx, userID, companyID, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isAdmin {
+		if req.Role != entities.TeamRoleMember {
+			return nil, errors.New("only company admins can assign team leads")
+		}
+		if existing, err := s.teamMemberRepo.Get(ctx, team.ID, req.UserID); err == nil && existing.Role == entities.TeamRoleLead {
+			return nil, errors.New("only company admins can change team leads")
+		}
+	}
+
+	user, err := s.userRepo.GetByID(ctx, req.UserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("user not found")
+		}
+		s.logger.Error("Failed to get user", "error", err)
+		return nil, errors.New("failed to add team member")
+	}
+
+	member := &entities.TeamMember{
+		TeamID:  team.ID,
+		UserID:  req.UserID,
+		Role:    req.Role,
+		AddedBy: userID,
+	}
+
+	if err := s.teamMemberRepo.Upsert(ctx, member); err != nil {
+		s.logger.Error("Failed to add team member", "error", err)
+		return nil, errors.New("failed to add team member")
+	}
+
+	member.User = *user
+	return mapTeamMemberToResponse(member, true), nil
+}
+
+func (s *teamService) RemoveTeamMember(ctx context.Context, userID, companyID, teamID, memberID uint) error {
+	team, isAdmin, err := s.getManagedTeam(ctx, userID, companyID, teamID)
+	if err != nil {
+		return err
+	}
+
+	if !isAdmin && memberID != userID {
+		if existing, err := s.teamMemberRepo.Get(ctx, team.ID, memberID); err == nil && existing.Role == entities.TeamRoleLead {
+			return errors.New("only company admins can change team leads")
+		}
+	}
+
+	if err := s.teamMemberRepo.Delete(ctx, team.ID, memberID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("team member not found")
+		}
+		s.logger.Error("Failed to remove team member", "error", err)
+		return errors.New("failed to remove team member")
+	}
+
+	return nil
+}
+
+// getManagedTeam loads a team the user may change membership of. Company
+// admins manage every team; team leads manage their own team's members.
+func (s *teamService) getManagedTeam(ctx context.Context, userID, companyID, teamID uint) (*entities.CompanyTeam, bool, error) {
+	company, err := s.getCompany(ctx, companyID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	team, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil || team.CompanyID != companyID {
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("Failed to get team", "error", err)
+			return nil, false, errors.New("failed to get team")
+		}
+		return nil, false, errors.New("team not found")
+	}
+
+	if s.isCompanyAdmin(ctx, company, userID) {
+		return team, true, nil
+	}
+
+	member, err := s.teamMemberRepo.Get(ctx, teamID, userID)
+	if err != nil || member.Role != entities.TeamRoleLead {
+		return nil, false, errors.New("unauthorized to manage this team")
+	}
+
+	return team, false, nil
+}
+
+func (s *teamService) getCompany(ctx context.Context, companyID uint) (*entities.Company, error) {
+	company, err := s.companyRepo.GetByID(ctx, companyID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("company not found")
+		}
+		s.logger.Error("Failed to get company", "error", err)
+		return nil, errors.New("failed to get company")
+	}
+	return company, nil
+}
+
+func (s *teamService) getAdministeredCompany(ctx context.Context, userID, companyID uint) (*entities.Company, error) {
+	company, err := s.getCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.isCompanyAdmin(ctx, company, userID) {
+		return nil, errors.New("unauthorized to manage this company")
+	}
+
+	return company, nil
+}
+
+func (s *teamService) isCompanyAdmin(ctx context.Context, company *entities.Company, userID uint) bool {
+	if company.OwnerID == userID {
+		return true
+	}
+	member, err := s.memberRepo.Get(ctx, company.ID, userID)
+	return err == nil && member.Role == entities.CompanyRoleAdmin
+}
+
+func findTeam(teams []*entities.CompanyTeam, id uint) *entities.CompanyTeam {
+	for _, team := range teams {
+		if team.ID == id {
+			return team
+		}
+	}
+	return nil
+}
+
+func nameTaken(teams []*entities.CompanyTeam, name string, exceptID uint) bool {
+	for _, team := range teams {
+		if team.ID != exceptID && strings.EqualFold(team.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkParent keeps the structure to departments with teams under them: the
+// parent must be a top-level team of the same company, and a team that
+// already has sub-teams cannot itself be moved under another.
+func checkParent(teams []*entities.CompanyTeam, parentID *uint, teamID uint) error {
+	if parentID == nil {
+		return nil
+	}
+
+	parent := findTeam(teams, *parentID)
+	if parent == nil {
+		return errors.New("parent team not found")
+	}
+	if parent.ID == teamID || parent.ParentID != nil {
+		return errors.New("teams can only be nested one level deep")
+	}
+	if teamID != 0 {
+		for _, team := range teams {
+			if team.ParentID != nil && *team.ParentID == teamID {
+				return errors.New("teams can only be nested one level deep")
+			}
+		}
+	}
+	return nil
+}
+
+func mapTeamToResponse(team *entities.CompanyTeam) *dto.TeamResponse {
+	return &dto.TeamResponse{
+		ID:          team.ID,
+		CompanyID:   team.CompanyID,
+		ParentID:    team.ParentID,
+		Name:        team.Name,
+		Description: team.Description,
+		CreatedAt:   team.CreatedAt,
+	}
+}
+
+func mapTeamMemberToResponse(member *entities.TeamMember, includeEmail bool) *dto.TeamMemberResponse {
+	response := &dto.TeamMemberResponse{
+		TeamID:    member.TeamID,
+		UserID:    member.UserID,
+		Role:      member.Role,
+		AddedBy:   member.AddedBy,
+		CreatedAt: member.CreatedAt,
+	}
+
+	if member.User.ID != 0 {
+		response.User = &dto.UserInfo{
+			ID:       member.User.ID,
+			Username: member.User.Username,
+			FullName: member.User.FullName,
+		}
+		if includeEmail {
+			response.User.Email = member.User.Email
+		}
+	}
+
+	return response
+}
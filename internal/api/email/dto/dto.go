@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type EnqueueEmailRequest struct {
+	UserID    *uint
+	Kind      entities.OutboundEmailKind
+	Recipient string
+	Subject   string
+	Body      string
+}
+
+type OutboundEmailResponse struct {
+	ID        uint                         `json:"id"`
+	Kind      entities.OutboundEmailKind   `json:"kind"`
+	Recipient string                       `json:"recipient"`
+	Subject   string                       `json:"subject"`
+	Status    entities.OutboundEmailStatus `json:"status"`
+	SendAfter time.Time                    `json:"send_after"`
+	CreatedAt time.Time                    `json:"created_at"`
+}
+
+type EmailTemplateResponse struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Subject     string            `json:"subject"`
+	Variables   []string          `json:"variables"`
+	Sample      map[string]string `json:"sample"`
+}
+
+type TemplatePreviewResponse struct {
+	Name    string            `json:"name"`
+	Subject string            `json:"subject"`
+	Data    map[string]string `json:"data"`
+	HTML    string            `json:"html"`
+}
+
+type SendTestEmailRequest struct {
+	To   string            `json:"to" validate:"required,email"`
+	Data map[string]string `json:"data" validate:"omitempty,max=20,dive,max=500"`
+}
@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"linked-clone/internal/api/email/dto"
+	"linked-clone/internal/api/email/service"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EmailHandler struct {
+	emailQueueService    service.EmailQueueService
+	emailTemplateService service.EmailTemplateService
+	validator            validation.Validator
+	logger               logger.Logger
+}
+
+func NewEmailHandler(
+	emailQueueService service.EmailQueueService,
+	emailTemplateService service.EmailTemplateService,
+	validator validation.Validator,
+	logger logger.Logger,
+) *EmailHandler {
+	return &EmailHandler{
+		emailQueueService:    emailQueueService,
+		emailTemplateService: emailTemplateService,
+		validator:            validator,
+		logger:               logger,
+	}
+}
+
+func (h *EmailHandler) GetPending(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	emails, err := h.emailQueueService.GetPending(c.Request.Context(), userID, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get pending emails", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get pending emails", err.Error())
+		return
+	}
+
+	response.Success(c, emails)
+}
+
+func (h *EmailHandler) Cancel(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid email ID", err.Error())
+		return
+	}
+
+	if err := h.emailQueueService.Cancel(c.Request.Context(), userID, uint(id)); err != nil {
+		switch err.Error() {
+		case "email not found":
+			response.Error(c, http.StatusNotFound, "Failed to cancel email", err.Error())
+		case "email can no longer be cancelled":
+			response.Error(c, http.StatusConflict, "Failed to cancel email", err.Error())
+		default:
+			h.logger.Error("Failed to cancel email", "error", err)
+			response.Error(c, http.StatusInternalServerError, "Failed to cancel email", err.Error())
+		}
+		return
+	}
+
+	response.SuccessWithMessage(c, "Email cancelled", nil)
+}
+
+func (h *EmailHandler) ListTemplates(c *gin.Context) {
+	response.Success(c, h.emailTemplateService.ListTemplates())
+}
+
+func (h *EmailHandler) PreviewTemplate(c *gin.Context) {
+	overrides := make(map[string]string)
+	for key, values := range c.Request.URL.Query() {
+		if key != "format" && len(values) > 0 {
+			overrides[key] = values[0]
+		}
+	}
+
+	preview, err := h.emailTemplateService.PreviewTemplate(c.Param("name"), overrides)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if err.Error() == "template not found" {
+			status = http.StatusNotFound
+		}
+		response.Error(c, status, "Failed to preview template", err.Error())
+		return
+	}
+
+	if c.Query("format") == "json" {
+		response.Success(c, preview)
+		return
+	}
+	c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(preview.HTML))
+}
+
+func (h *EmailHandler) SendTestEmail(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.SendTestEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	preview, err := h.emailTemplateService.SendTestEmail(c.Request.Context(), userID, c.Param("name"), &req)
+	if err != nil {
+		switch err.Error() {
+		case "template not found":
+			response.Error(c, http.StatusNotFound, "Failed to send test email", err.Error())
+		default:
+			h.logger.Error("Failed to send test email", "error", err)
+			response.Error(c, http.StatusBadGateway, "Failed to send test email", err.Error())
+		}
+		return
+	}
+
+	response.SuccessWithMessage(c, "Test email sent", gin.H{
+		"to":      req.To,
+		"subject": preview.Subject,
+		"data":    preview.Data,
+	})
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type outboundEmailRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboundEmailRepository(db *gorm.DB) repositories.OutboundEmailRepository {
+	return &outboundEmailRepository{db: db}
+}
+
+func (r *outboundEmailRepository) Create(ctx context.Context, email *entities.OutboundEmail) error {
+	return r.db.WithContext(ctx).Create(email).Error
+}
+
+func (r *outboundEmailRepository) GetByID(ctx context.Context, id uint) (*entities.OutboundEmail, error) {
+	var email entities.OutboundEmail
+	err := r.db.WithContext(ctx).First(&email, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &email, nil
+}
+
+func (r *outboundEmailRepository) GetPendingByUserID(ctx context.Context, userID uint, limit, offset int) ([]*entities.OutboundEmail, error) {
+	var emails []*entities.OutboundEmail
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND status = ?", userID, entities.OutboundEmailPending).
+		Order("send_after ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&emails).Error
+	return emails, err
+}
+
+func (r *outboundEmailRepository) Cancel(ctx context.Context, id uint, cancelledAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&entities.OutboundEmail{}).
+		Where("id = ? AND status = ?", id, entities.OutboundEmailPending).
+		Updates(map[string]interface{}{
+			"status":       entities.OutboundEmailCancelled,
+			"cancelled_at": cancelledAt,
+			"updated_at":   cancelledAt,
+		})
+	return result.RowsAffected == 1, result.Error
+}
+
+func (r *outboundEmailRepository) ClaimDue(ctx context.Context, now, staleBefore time.Time, limit int) ([]*entities.OutboundEmail, error) {
+	var emails []*entities.OutboundEmail
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE outbound_emails
+		SET status = ?, attempts = attempts + 1, updated_at = ?
+		WHERE id IN (
+			SELECT id FROM outbound_emails
+			WHERE send_after <= ?
+				AND (status = ? OR (status = ? AND updated_at < ?))
+			ORDER BY send_after
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		entities.OutboundEmailSending, now,
+		now,
+		entities.OutboundEmailPending, entities.OutboundEmailSending, staleBefore,
+		limit,
+	).Scan(&emails).Error
+	return emails, err
+}
+
+func (r *outboundEmailRepository) Update(ctx context.Context, email *entities.OutboundEmail) error {
+	return r.db.WithContext(ctx).Save(email).Error
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/email/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/experiment"
+	"linked-clone/pkg/logger"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type EmailQueueService interface {
+	Enqueue(ctx context.Context, req *dto.EnqueueEmailRequest) (*dto.OutboundEmailResponse, error)
+	GetPending(ctx context.Context, userID uint, limit, offset int) ([]*dto.OutboundEmailResponse, error)
+	Cancel(ctx context.Context, userID, emailID uint) error
+}
+
+type emailQueueService struct {
+	outboundRepo repositories.OutboundEmailRepository
+	experiments  experiment.Assigner
+	sendDelay    time.Duration
+	logger       logger.Logger
+}
+
+func NewEmailQueueService(outboundRepo repositories.OutboundEmailRepository, experiments experiment.Assigner, sendDelay time.Duration, logger logger.Logger) EmailQueueService {
+	return &emailQueueService{
+		outboundRepo: outboundRepo,
+		experiments:  experiments,
+		sendDelay:    sendDelay,
+		logger:       logger,
+	}
+}
+
+func (s *emailQueueService) Enqueue(ctx context.Context, req *dto.EnqueueEmailRequest) (*dto.OutboundEmailResponse, error) {
+	subject := req.Subject
+	if req.UserID != nil {
+		subject = s.experiments.Assign(ctx, experiment.EmailSubject(string(req.Kind)), *req.UserID).Param("subject", subject)
+	}
+
+	email := &entities.OutboundEmail{
+		UserID:    req.UserID,
+		Kind:      req.Kind,
+		Recipient: req.Recipient,
+		Subject:   subject,
+		Body:      req.Body,
+		Status:    entities.OutboundEmailPending,
+		SendAfter: time.Now().Add(s.sendDelay),
+	}
+
+	if err := s.outboundRepo.Create(ctx, email); err != nil {
+		s.logger.Error("Failed to queue email", "error", err, "kind", req.Kind)
+		return nil, errors.New("failed to queue email")
+	}
+	return mapOutboundEmailToResponse(email), nil
+}
+
+func (s *emailQueueService) GetPending(ctx context.Context, userID uint, limit, offset int) ([]*dto.OutboundEmailResponse, error) {
+	emails, err := s.outboundRepo.GetPendingByUserID(ctx, userID, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to get pending emails", "error", err)
+		return nil, errors.New("failed to get pending emails")
+	}
+
+	responses := make([]*dto.OutboundEmailResponse, 0, len(emails))
+	for _, email := range emails {
+		responses = append(responses, mapOutboundEmailToResponse(email))
+	}
+	return responses, nil
+}
+
+func (s *emailQueueService) Cancel(ctx context.Context, userID, emailID uint) error {
+	email, err := s.outboundRepo.GetByID(ctx, emailID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("email not found")
+		}
+		s.logger.Error("Failed to get queued email", "error", err)
+		return errors.New("failed to cancel email")
+	}
+	if email.UserID == nil || *email.UserID != userID {
+		return errors.New("email not found")
+	}
+
+	cancelled, err := s.outboundRepo.Cancel(ctx, emailID, time.Now())
+	if err != nil {
+		s.logger.Error("Failed to cancel queued email", "error", err)
+		return errors.New("failed to cancel email")
+	}
+	if !cancelled {
+		return errors.New("email can no longer be cancelled")
+	}
+	return nil
+}
+
+func mapOutboundEmailToResponse(email *entities.OutboundEmail) *dto.OutboundEmailResponse {
+	return &dto.OutboundEmailResponse{
+		ID:        email.ID,
+		Kind:      email.Kind,
+		Recipient: email.Recipient,
+		Subject:   email.Subject,
+		Status:    email.Status,
+		SendAfter: email.SendAfter,
+		CreatedAt: email.CreatedAt,
+	}
+}
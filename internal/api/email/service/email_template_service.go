@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/email/dto"
+	"linked-clone/pkg/logger"
+	email "linked-clone/pkg/smtp"
+)
+
+const testSubjectPrefix = "[Test] "
+
+type EmailTemplateService interface {
+	ListTemplates() []*dto.EmailTemplateResponse
+	PreviewTemplate(name string, overrides map[string]string) (*dto.TemplatePreviewResponse, error)
+	SendTestEmail(ctx context.Context, adminID uint, name string, req *dto.SendTestEmailRequest) (*dto.TemplatePreviewResponse, error)
+}
+
+type emailTemplateService struct {
+	emailService email.EmailService
+	logger       logger.Logger
+}
+
+func NewEmailTemplateService(emailService email.EmailService, logger logger.Logger) EmailTemplateService {
+	return &emailTemplateService{
+		emailService: emailService,
+		logger:       logger,
+	}
+}
+
+func (s *emailTemplateService) ListTemplates() []*dto.EmailTemplateResponse {
+	templates := email.Templates()
+	responses := make([]*dto.EmailTemplateResponse, 0, len(templates))
+	for _, tmpl := range templates {
+		responses = append(responses, &dto.EmailTemplateResponse{
+			Name:        tmpl.Name,
+			Description: tmpl.Description,
+			Subject:     tmpl.Subject,
+			Variables:   tmpl.Variables,
+			Sample:      tmpl.Sample,
+		})
+	}
+	return responses
+}
+
+func (s *emailTemplateService) PreviewTemplate(name string, overrides map[string]string) (*dto.TemplatePreviewResponse, error) {
+	tmpl, err := email.LookupTemplate(name)
+	if err != nil {
+		return nil, errors.New("template not found")
+	}
+
+	data := make(map[string]string, len(tmpl.Variables))
+	for _, variable := range tmpl.Variables {
+		data[variable] = tmpl.Sample[variable]
+		if value, ok := overrides[variable]; ok {
+			data[variable] = value
+		}
+	}
+
+	subject, html, err := email.Render(name, data)
+	if err != nil {
+		s.logger.Error("Failed to render email template", "error", err, "template", name)
+		return nil, errors.New("failed to render template")
+	}
+
+	return &dto.TemplatePreviewResponse{
+		Name:    name,
+		Subject: subject,
+		Data:    data,
+		HTML:    html,
+	}, nil
+}
+
+func (s *emailTemplateService) SendTestEmail(ctx context.Context, adminID uint, name string, req *dto.SendTestEmailRequest) (*dto.TemplatePreviewResponse, error) {
+	preview, err := s.PreviewTemplate(name, req.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	preview.Subject = testSubjectPrefix + preview.Subject
+	if err := s.emailService.SendEmail(req.To, preview.Subject, preview.HTML); err != nil {
+		s.logger.Error("Failed to send test email", "error", err, "template", name, "admin_id", adminID)
+		return nil, errors.New("failed to send test email")
+	}
+
+	s.logger.Info("Test email sent", "template", name, "admin_id", adminID, "to", req.To)
+	return preview, nil
+}
@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type VariantRequest struct {
+	Name   string            `json:"name" validate:"required,max=50"`
+	Weight int               `json:"weight" validate:"required,min=1,max=10000"`
+	Params map[string]string `json:"params" validate:"omitempty,max=20"`
+}
+
+type CreateExperimentRequest struct {
+	Key         string            `json:"key" validate:"required,max=100"`
+	Description string            `json:"description" validate:"omitempty,max=2000"`
+	Variants    []*VariantRequest `json:"variants" validate:"required,min=2,max=10,dive,required"`
+}
+
+type StopExperimentRequest struct {
+	Winner string `json:"winner" validate:"omitempty,max=50"`
+}
+
+type VariantResponse struct {
+	Name        string            `json:"name"`
+	Weight      int               `json:"weight"`
+	Params      map[string]string `json:"params,omitempty"`
+	Assignments int64             `json:"assignments"`
+	Exposures   int64             `json:"exposures"`
+}
+
+type ExperimentResponse struct {
+	ID          uint                      `json:"id"`
+	Key         string                    `json:"key"`
+	Description string                    `json:"description,omitempty"`
+	Status      entities.ExperimentStatus `json:"status"`
+	Winner      string                    `json:"winner,omitempty"`
+	Variants    []*VariantResponse        `json:"variants"`
+	CreatedBy   *uint                     `json:"created_by,omitempty"`
+	StartedAt   time.Time                 `json:"started_at"`
+	StoppedAt   *time.Time                `json:"stopped_at,omitempty"`
+}
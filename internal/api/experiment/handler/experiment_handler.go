@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"linked-clone/internal/api/experiment/dto"
+	"linked-clone/internal/api/experiment/service"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ExperimentHandler struct {
+	experimentService service.ExperimentService
+	validator         validation.Validator
+	logger            logger.Logger
+}
+
+func NewExperimentHandler(experimentService service.ExperimentService, validator validation.Validator, logger logger.Logger) *ExperimentHandler {
+	return &ExperimentHandler{
+		experimentService: experimentService,
+		validator:         validator,
+		logger:            logger,
+	}
+}
+
+func (h *ExperimentHandler) CreateExperiment(c *gin.Context) {
+	adminID := middleware.GetUserID(c)
+
+	var req dto.CreateExperimentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	experiment, err := h.experimentService.CreateExperiment(c.Request.Context(), adminID, &req)
+	if err != nil {
+		h.logger.Error("Failed to create experiment", "error", err)
+		response.Error(c, experimentErrorStatus(err), "Failed to create experiment", err.Error())
+		return
+	}
+
+	response.CreatedWithMessage(c, "Experiment started", experiment)
+}
+
+func (h *ExperimentHandler) ListExperiments(c *gin.Context) {
+	status := entities.ExperimentStatus(c.Query("status"))
+	if status != "" && status != entities.ExperimentRunning && status != entities.ExperimentStopped {
+		response.Error(c, http.StatusBadRequest, "Invalid experiment status", "")
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	experiments, err := h.experimentService.ListExperiments(c.Request.Context(), status, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to list experiments", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get experiments", err.Error())
+		return
+	}
+
+	response.Success(c, experiments)
+}
+
+func (h *ExperimentHandler) GetExperiment(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid experiment ID", err.Error())
+		return
+	}
+
+	experiment, err := h.experimentService.GetExperiment(c.Request.Context(), uint(id))
+	if err != nil {
+		h.logger.Error("Failed to get experiment", "error", err)
+		response.Error(c, experimentErrorStatus(err), "Failed to get experiment", err.Error())
+		return
+	}
+
+	response.Success(c, experiment)
+}
+
+func (h *ExperimentHandler) StopExperiment(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid experiment ID", err.Error())
+		return
+	}
+
+	var req dto.StopExperimentRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+			return
+		}
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	experiment, err := h.experimentService.StopExperiment(c.Request.Context(), uint(id), &req)
+	if err != nil {
+		h.logger.Error("Failed to stop experiment", "error", err)
+		response.Error(c, experimentErrorStatus(err), "Failed to stop experiment", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Experiment stopped", experiment)
+}
+
+func experimentErrorStatus(err error) int {
+	switch err.Error() {
+	case "experiment not found":
+		return http.StatusNotFound
+	case "experiment already running", "experiment is not running":
+		return http.StatusConflict
+	case "invalid experiment key", "invalid variant name", "duplicate variant name", "winner is not a variant of this experiment":
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type experimentRepository struct {
+	db *gorm.DB
+}
+
+func NewExperimentRepository(db *gorm.DB) repositories.ExperimentRepository {
+	return &experimentRepository{db: db}
+}
+
+func (r *experimentRepository) Create(ctx context.Context, experiment *entities.Experiment) error {
+	return r.db.WithContext(ctx).Create(experiment).Error
+}
+
+func (r *experimentRepository) GetByID(ctx context.Context, id uint) (*entities.Experiment, error) {
+	var experiment entities.Experiment
+	err := r.db.WithContext(ctx).First(&experiment, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &experiment, nil
+}
+
+// GetCurrentByKey returns the running experiment for key, or the most recently
+// stopped one so its winner keeps being served.
+func (r *experimentRepository) GetCurrentByKey(ctx context.Context, key string) (*entities.Experiment, error) {
+	var experiment entities.Experiment
+	err := r.db.WithContext(ctx).
+		Where("key = ?", key).
+		Order(clause.Expr{SQL: "status = ? DESC, started_at DESC", Vars: []interface{}{entities.ExperimentRunning}}).
+		First(&experiment).Error
+	if err != nil {
+		return nil, err
+	}
+	return &experiment, nil
+}
+
+func (r *experimentRepository) List(ctx context.Context, status entities.ExperimentStatus, limit, offset int) ([]*entities.Experiment, error) {
+	query := r.db.WithContext(ctx)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var experiments []*entities.Experiment
+	err := query.
+		Order("started_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&experiments).Error
+	return experiments, err
+}
+
+func (r *experimentRepository) Update(ctx context.Context, experiment *entities.Experiment) error {
+	return r.db.WithContext(ctx).Save(experiment).Error
+}
+
+// Assign stores the assignment unless the user already has one for the
+// experiment, and returns whichever assignment is persisted.
+func (r *experimentRepository) Assign(ctx context.Context, assignment *entities.ExperimentAssignment) (*entities.ExperimentAssignment, error) {
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(assignment).Error; err != nil {
+		return nil, err
+	}
+
+	var persisted entities.ExperimentAssignment
+	err := r.db.WithContext(ctx).
+		Where("experiment_id = ? AND user_id = ?", assignment.ExperimentID, assignment.UserID).
+		First(&persisted).Error
+	if err != nil {
+		return nil, err
+	}
+	return &persisted, nil
+}
+
+func (r *experimentRepository) CountAssignments(ctx context.Context, experimentID uint) (map[string]int64, error) {
+	var rows []struct {
+		Variant string
+		Count   int64
+	}
+
+	err := r.db.WithContext(ctx).Model(&entities.ExperimentAssignment{}).
+		Select("variant, COUNT(*) AS count").
+		Where("experiment_id = ?", experimentID).
+		Group("variant").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Variant] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *experimentRepository) CountExposures(ctx context.Context, experimentID uint) (map[string]int64, error) {
+	var rows []struct {
+		Variant string
+		Count   int64
+	}
+
+	err := r.db.WithContext(ctx).Model(&entities.AnalyticsEvent{}).
+		Select("metadata->>'variant' AS variant, COUNT(DISTINCT user_id) AS count").
+		Where("event_type = ? AND entity_type = ? AND entity_id = ?", entities.EventExperimentExposure, "experiment", experimentID).
+		Group("metadata->>'variant'").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Variant] = row.Count
+	}
+	return counts, nil
+}
@@ -0,0 +1,325 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"linked-clone/internal/api/experiment/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/experiment"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/redis"
+	"regexp"
+	"sync"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+const (
+	experimentCacheTTL = 30 * time.Second
+	exposureTTL        = 25 * time.Hour
+)
+
+var experimentNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_]*$`)
+
+type ExperimentService interface {
+	experiment.Assigner
+
+	CreateExperiment(ctx context.Context, adminID uint, req *dto.CreateExperimentRequest) (*dto.ExperimentResponse, error)
+	ListExperiments(ctx context.Context, status entities.ExperimentStatus, limit, offset int) ([]*dto.ExperimentResponse, error)
+	GetExperiment(ctx context.Context, id uint) (*dto.ExperimentResponse, error)
+	StopExperiment(ctx context.Context, id uint, req *dto.StopExperimentRequest) (*dto.ExperimentResponse, error)
+}
+
+type cachedExperiment struct {
+	experiment *entities.Experiment
+	expiresAt  time.Time
+}
+
+type experimentService struct {
+	experimentRepo repositories.ExperimentRepository
+	analyticsRepo  repositories.AnalyticsRepository
+	redisClient    redis.RedisClient
+	logger         logger.Logger
+
+	mu    sync.RWMutex
+	cache map[string]cachedExperiment
+}
+
+func NewExperimentService(
+	experimentRepo repositories.ExperimentRepository,
+	analyticsRepo repositories.AnalyticsRepository,
+	redisClient redis.RedisClient,
+	logger logger.Logger,
+) ExperimentService {
+	return &experimentService{
+		experimentRepo: experimentRepo,
+		analyticsRepo:  analyticsRepo,
+		redisClient:    redisClient,
+		logger:         logger,
+		cache:          make(map[string]cachedExperiment),
+	}
+}
+
+// Assign returns the user's variant for the experiment with the given key, or
+// nil when none is running so callers fall back to their default behaviour.
+// Stopped experiments with a winner serve the winner to everyone. The first
+// assignment each day is recorded as an exposure event.
+func (s *experimentService) Assign(ctx context.Context, key string, userID uint) *experiment.Assignment {
+	exp := s.lookup(ctx, key)
+	if exp == nil {
+		return nil
+	}
+
+	if exp.Status != entities.ExperimentRunning {
+		winner := exp.Variant(exp.Winner)
+		if winner == nil {
+			return nil
+		}
+		return newAssignment(exp, winner)
+	}
+
+	exposureKey := fmt.Sprintf("experiments:exposure:%d:%d:%s", exp.ID, userID, time.Now().UTC().Format("20060102"))
+	if name, err := s.redisClient.Get(ctx, exposureKey); err == nil {
+		if variant := exp.Variant(name); variant != nil {
+			return newAssignment(exp, variant)
+		}
+	} else if !errors.Is(err, goredis.Nil) {
+		s.logger.Warn("Failed to read experiment exposure", "error", err, "experiment", key)
+	}
+
+	picked := experiment.Pick(fmt.Sprintf("%s:%d", exp.Key, exp.ID), userID, exp.Variants)
+	if picked == nil {
+		return nil
+	}
+
+	assignment, err := s.experimentRepo.Assign(ctx, &entities.ExperimentAssignment{
+		ExperimentID: exp.ID,
+		UserID:       userID,
+		Variant:      picked.Name,
+	})
+	if err != nil {
+		s.logger.Error("Failed to persist experiment assignment", "error", err, "experiment", key, "user_id", userID)
+		return nil
+	}
+	variant := exp.Variant(assignment.Variant)
+	if variant == nil {
+		return nil
+	}
+
+	first, err := s.redisClient.SetNX(ctx, exposureKey, variant.Name, exposureTTL)
+	if err != nil {
+		s.logger.Warn("Failed to record experiment exposure marker", "error", err, "experiment", key)
+		first = true
+	}
+	if first {
+		s.logExposure(ctx, exp, variant.Name, userID)
+	}
+	return newAssignment(exp, variant)
+}
+
+func (s *experimentService) CreateExperiment(ctx context.Context, adminID uint, req *dto.CreateExperimentRequest) (*dto.ExperimentResponse, error) {
+	if !experimentNamePattern.MatchString(req.Key) {
+		return nil, errors.New("invalid experiment key")
+	}
+
+	variants := make([]entities.ExperimentVariant, 0, len(req.Variants))
+	seen := make(map[string]bool, len(req.Variants))
+	for _, variant := range req.Variants {
+		if !experimentNamePattern.MatchString(variant.Name) {
+			return nil, errors.New("invalid variant name")
+		}
+		if seen[variant.Name] {
+			return nil, errors.New("duplicate variant name")
+		}
+		seen[variant.Name] = true
+		variants = append(variants, entities.ExperimentVariant{
+			Name:   variant.Name,
+			Weight: variant.Weight,
+			Params: variant.Params,
+		})
+	}
+
+	current, err := s.experimentRepo.GetCurrentByKey(ctx, req.Key)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.logger.Error("Failed to check existing experiment", "error", err)
+		return nil, errors.New("failed to create experiment")
+	}
+	if current != nil && current.Status == entities.ExperimentRunning {
+		return nil, errors.New("experiment already running")
+	}
+
+	exp := &entities.Experiment{
+		Key:         req.Key,
+		Description: req.Description,
+		Status:      entities.ExperimentRunning,
+		Variants:    variants,
+		CreatedBy:   &adminID,
+		StartedAt:   time.Now(),
+	}
+	if err := s.experimentRepo.Create(ctx, exp); err != nil {
+		s.logger.Error("Failed to create experiment", "error", err)
+		return nil, errors.New("failed to create experiment")
+	}
+	s.invalidate(exp.Key)
+
+	s.logger.Info("Experiment started", "experiment_id", exp.ID, "key", exp.Key, "admin_id", adminID)
+	return s.mapExperimentToResponse(exp, nil, nil), nil
+}
+
+func (s *experimentService) ListExperiments(ctx context.Context, status entities.ExperimentStatus, limit, offset int) ([]*dto.ExperimentResponse, error) {
+	experiments, err := s.experimentRepo.List(ctx, status, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list experiments", "error", err)
+		return nil, errors.New("failed to get experiments")
+	}
+
+	responses := make([]*dto.ExperimentResponse, 0, len(experiments))
+	for _, exp := range experiments {
+		responses = append(responses, s.mapExperimentToResponse(exp, nil, nil))
+	}
+	return responses, nil
+}
+
+func (s *experimentService) GetExperiment(ctx context.Context, id uint) (*dto.ExperimentResponse, error) {
+	exp, err := s.experimentRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("experiment not found")
+		}
+		s.logger.Error("Failed to get experiment", "error", err)
+		return nil, errors.New("failed to get experiment")
+	}
+
+	assignments, err := s.experimentRepo.CountAssignments(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to count experiment assignments", "error", err)
+		return nil, errors.New("failed to get experiment")
+	}
+	exposures, err := s.experimentRepo.CountExposures(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to count experiment exposures", "error", err)
+		return nil, errors.New("failed to get experiment")
+	}
+
+	return s.mapExperimentToResponse(exp, assignments, exposures), nil
+}
+
+func (s *experimentService) StopExperiment(ctx context.Context, id uint, req *dto.StopExperimentRequest) (*dto.ExperimentResponse, error) {
+	exp, err := s.experimentRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("experiment not found")
+		}
+		s.logger.Error("Failed to get experiment", "error", err)
+		return nil, errors.New("failed to stop experiment")
+	}
+	if exp.Status != entities.ExperimentRunning {
+		return nil, errors.New("experiment is not running")
+	}
+	if req.Winner != "" && exp.Variant(req.Winner) == nil {
+		return nil, errors.New("winner is not a variant of this experiment")
+	}
+
+	now := time.Now()
+	exp.Status = entities.ExperimentStopped
+	exp.Winner = req.Winner
+	exp.StoppedAt = &now
+	if err := s.experimentRepo.Update(ctx, exp); err != nil {
+		s.logger.Error("Failed to stop experiment", "error", err)
+		return nil, errors.New("failed to stop experiment")
+	}
+	s.invalidate(exp.Key)
+
+	s.logger.Info("Experiment stopped", "experiment_id", exp.ID, "key", exp.Key, "winner", exp.Winner)
+	return s.GetExperiment(ctx, id)
+}
+
+// lookup caches experiments, including missing ones, for a short TTL since
+// Assign sits on hot paths such as the feed. Changes made through another
+// instance take up to experimentCacheTTL to apply here.
+func (s *experimentService) lookup(ctx context.Context, key string) *entities.Experiment {
+	s.mu.RLock()
+	cached, ok := s.cache[key]
+	s.mu.RUnlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached.experiment
+	}
+
+	exp, err := s.experimentRepo.GetCurrentByKey(ctx, key)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("Failed to load experiment", "error", err, "experiment", key)
+			return nil
+		}
+		exp = nil
+	}
+
+	s.mu.Lock()
+	s.cache[key] = cachedExperiment{experiment: exp, expiresAt: time.Now().Add(experimentCacheTTL)}
+	s.mu.Unlock()
+	return exp
+}
+
+func (s *experimentService) invalidate(key string) {
+	s.mu.Lock()
+	delete(s.cache, key)
+	s.mu.Unlock()
+}
+
+func (s *experimentService) logExposure(ctx context.Context, exp *entities.Experiment, variant string, userID uint) {
+	metadata, err := json.Marshal(map[string]string{"key": exp.Key, "variant": variant})
+	if err != nil {
+		return
+	}
+
+	entityID := exp.ID
+	event := &entities.AnalyticsEvent{
+		UserID:     &userID,
+		EventType:  entities.EventExperimentExposure,
+		EntityType: "experiment",
+		EntityID:   &entityID,
+		Metadata:   string(metadata),
+	}
+	if err := s.analyticsRepo.Create(ctx, event); err != nil {
+		s.logger.Warn("Failed to log experiment exposure", "error", err, "experiment", exp.Key)
+	}
+}
+
+func newAssignment(exp *entities.Experiment, variant *entities.ExperimentVariant) *experiment.Assignment {
+	return &experiment.Assignment{
+		ExperimentID: exp.ID,
+		Key:          exp.Key,
+		Variant:      variant.Name,
+		Params:       variant.Params,
+	}
+}
+
+func (s *experimentService) mapExperimentToResponse(exp *entities.Experiment, assignments, exposures map[string]int64) *dto.ExperimentResponse {
+	variants := make([]*dto.VariantResponse, 0, len(exp.Variants))
+	for _, variant := range exp.Variants {
+		variants = append(variants, &dto.VariantResponse{
+			Name:        variant.Name,
+			Weight:      variant.Weight,
+			Params:      variant.Params,
+			Assignments: assignments[variant.Name],
+			Exposures:   exposures[variant.Name],
+		})
+	}
+
+	return &dto.ExperimentResponse{
+		ID:          exp.ID,
+		Key:         exp.Key,
+		Description: exp.Description,
+		Status:      exp.Status,
+		Winner:      exp.Winner,
+		Variants:    variants,
+		CreatedBy:   exp.CreatedBy,
+		StartedAt:   exp.StartedAt,
+		StoppedAt:   exp.StoppedAt,
+	}
+}
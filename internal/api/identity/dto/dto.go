@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type SubmitVerificationRequest struct {
+	DocumentType entities.IdentityDocumentType `form:"document_type" validate:"required,oneof=passport national_id drivers_license"`
+}
+
+type ReviewVerificationRequest struct {
+	Note string `json:"note" validate:"omitempty,max=1000"`
+}
+
+type VerificationResponse struct {
+	ID                uint                                `json:"id"`
+	UserID            uint                                `json:"user_id"`
+	DocumentType      entities.IdentityDocumentType       `json:"document_type"`
+	Status            entities.IdentityVerificationStatus `json:"status"`
+	Provider          string                              `json:"provider"`
+	ProviderReference string                              `json:"provider_reference,omitempty"`
+	DocumentURL       string                              `json:"document_url,omitempty"`
+	SelfieURL         string                              `json:"selfie_url,omitempty"`
+	ReviewNote        string                              `json:"review_note,omitempty"`
+	ReviewedAt        *time.Time                          `json:"reviewed_at,omitempty"`
+	User              *UserInfo                           `json:"user,omitempty"`
+	CreatedAt         time.Time                           `json:"created_at"`
+}
+
+type VerificationStatusResponse struct {
+	IsVerified         bool                  `json:"is_verified"`
+	IdentityVerifiedAt *time.Time            `json:"identity_verified_at,omitempty"`
+	Latest             *VerificationResponse `json:"latest,omitempty"`
+}
+
+type AuditResponse struct {
+	ID         uint                                `json:"id"`
+	ActorID    *uint                               `json:"actor_id,omitempty"`
+	Action     string                              `json:"action"`
+	FromStatus entities.IdentityVerificationStatus `json:"from_status,omitempty"`
+	ToStatus   entities.IdentityVerificationStatus `json:"to_status"`
+	Note       string                              `json:"note,omitempty"`
+	CreatedAt  time.Time                           `json:"created_at"`
+}
+
+type UserInfo struct {
+	ID       uint   `json:"id"`
+	Username string `json:"username"`
+	FullName string `json:"full_name"`
+	Email    string `json:"email"`
+}
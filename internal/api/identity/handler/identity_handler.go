@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"context"
+	"linked-clone/internal/api/identity/dto"
+	"linked-clone/internal/api/identity/service"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IdentityHandler struct {
+	identityService service.IdentityService
+	validator       validation.Validator
+	logger          logger.Logger
+}
+
+func NewIdentityHandler(identityService service.IdentityService, validator validation.Validator, logger logger.Logger) *IdentityHandler {
+	return &IdentityHandler{
+		identityService: identityService,
+		validator:       validator,
+		logger:          logger,
+	}
+}
+
+func (h *IdentityHandler) SubmitVerification(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.SubmitVerificationRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	document, err := c.FormFile("document")
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Document is required", err.Error())
+		return
+	}
+
+	selfie, _ := c.FormFile("selfie")
+
+	verification, err := h.identityService.SubmitVerification(c.Request.Context(), userID, &req, document, selfie)
+	if err != nil {
+		h.logger.Error("Failed to submit identity verification", "error", err)
+		response.Error(c, identityErrorStatus(err), "Failed to submit verification", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Identity verification submitted", verification)
+}
+
+func (h *IdentityHandler) GetStatus(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	status, err := h.identityService.GetStatus(c.Request.Context(), userID)
+	if err != nil {
+		h.logger.Error("Failed to get identity verification status", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get verification status", err.Error())
+		return
+	}
+
+	response.Success(c, status)
+}
+
+func (h *IdentityHandler) GetReviewQueue(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	verifications, err := h.identityService.GetReviewQueue(c.Request.Context(), limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get identity verification queue", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get verification queue", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{
+		"verifications": verifications,
+		"limit":         limit,
+		"offset":        offset,
+	})
+}
+
+func (h *IdentityHandler) GetAuditTrail(c *gin.Context) {
+	verificationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid verification ID", err.Error())
+		return
+	}
+
+	audits, err := h.identityService.GetAuditTrail(c.Request.Context(), uint(verificationID))
+	if err != nil {
+		h.logger.Error("Failed to get identity verification audit trail", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get audit trail", err.Error())
+		return
+	}
+
+	response.Success(c, audits)
+}
+
+func (h *IdentityHandler) ApproveVerification(c *gin.Context) {
+	h.reviewVerification(c, "Identity verification approved", h.identityService.ApproveVerification)
+}
+
+func (h *IdentityHandler) RejectVerification(c *gin.Context) {
+	h.reviewVerification(c, "Identity verification rejected", h.identityService.RejectVerification)
+}
+
+func (h *IdentityHandler) RevokeVerification(c *gin.Context) {
+	h.reviewVerification(c, "Identity verification revoked", h.identityService.RevokeVerification)
+}
+
+func (h *IdentityHandler) reviewVerification(c *gin.Context, successMessage string, review func(ctx context.Context, adminID, verificationID uint, req *dto.ReviewVerificationRequest) (*dto.VerificationResponse, error)) {
+	adminID := middleware.GetUserID(c)
+
+	verificationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid verification ID", err.Error())
+		return
+	}
+
+	var req dto.ReviewVerificationRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+			return
+		}
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	verification, err := review(c.Request.Context(), adminID, uint(verificationID), &req)
+	if err != nil {
+		h.logger.Error("Failed to review identity verification", "error", err)
+		response.Error(c, identityErrorStatus(err), "Failed to review verification", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, successMessage, verification)
+}
+
+func identityErrorStatus(err error) int {
+	switch {
+	case err.Error() == "verification not found":
+		return http.StatusNotFound
+	case err.Error() == "identity already verified",
+		err.Error() == "verification already in progress",
+		strings.HasPrefix(err.Error(), "verification is not"):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type identityVerificationRepository struct {
+	db *gorm.DB
+}
+
+func NewIdentityVerificationRepository(db *gorm.DB) repositories.IdentityVerificationRepository {
+	return &identityVerificationRepository{db: db}
+}
+
+func (r *identityVerificationRepository) Create(ctx context.Context, verification *entities.IdentityVerification) error {
+	return r.db.WithContext(ctx).Create(verification).Error
+}
+
+func (r *identityVerificationRepository) GetByID(ctx context.Context, id uint) (*entities.IdentityVerification, error) {
+	var verification entities.IdentityVerification
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		First(&verification, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &verification, nil
+}
+
+func (r *identityVerificationRepository) GetLatestByUserID(ctx context.Context, userID uint) (*entities.IdentityVerification, error) {
+	var verification entities.IdentityVerification
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		First(&verification).Error
+	if err != nil {
+		return nil, err
+	}
+	return &verification, nil
+}
+
+func (r *identityVerificationRepository) GetByStatus(ctx context.Context, status entities.IdentityVerificationStatus, limit, offset int) ([]*entities.IdentityVerification, error) {
+	var verifications []*entities.IdentityVerification
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("status = ?", status).
+		Order("created_at ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&verifications).Error
+	return verifications, err
+}
+
+func (r *identityVerificationRepository) HasOpenRequest(ctx context.Context, userID uint) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&entities.IdentityVerification{}).
+		Where("user_id = ? AND status IN ?", userID, []entities.IdentityVerificationStatus{
+			entities.IdentityVerificationPending,
+			entities.IdentityVerificationInReview,
+		}).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *identityVerificationRepository) Update(ctx context.Context, verification *entities.IdentityVerification) error {
+	return r.db.WithContext(ctx).Omit("User").Save(verification).Error
+}
+
+func (r *identityVerificationRepository) CreateAudit(ctx context.Context, audit *entities.IdentityVerificationAudit) error {
+	return r.db.WithContext(ctx).Create(audit).Error
+}
+
+func (r *identityVerificationRepository) GetAuditTrail(ctx context.Context, verificationID uint) ([]*entities.IdentityVerificationAudit, error) {
+	var audits []*entities.IdentityVerificationAudit
+	err := r.db.WithContext(ctx).
+		Where("verification_id = ?", verificationID).
+		Order("created_at ASC").
+		Find(&audits).Error
+	return audits, err
+}
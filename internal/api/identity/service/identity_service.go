@@ -0,0 +1,328 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"linked-clone/internal/api/identity/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/identity"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/storage"
+	"mime/multipart"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	identityDocumentFolder = "secure/identity"
+	identityDocumentURLTTL = 5 * time.Minute
+)
+
+type IdentityService interface {
+	SubmitVerification(ctx context.Context, userID uint, req *dto.SubmitVerificationRequest, document, selfie *multipart.FileHeader) (*dto.VerificationResponse, error)
+	GetStatus(ctx context.Context, userID uint) (*dto.VerificationStatusResponse, error)
+
+	GetReviewQueue(ctx context.Context, limit, offset int) ([]*dto.VerificationResponse, error)
+	GetAuditTrail(ctx context.Context, verificationID uint) ([]*dto.AuditResponse, error)
+	ApproveVerification(ctx context.Context, adminID, verificationID uint, req *dto.ReviewVerificationRequest) (*dto.VerificationResponse, error)
+	RejectVerification(ctx context.Context, adminID, verificationID uint, req *dto.ReviewVerificationRequest) (*dto.VerificationResponse, error)
+	RevokeVerification(ctx context.Context, adminID, verificationID uint, req *dto.ReviewVerificationRequest) (*dto.VerificationResponse, error)
+}
+
+type identityService struct {
+	verificationRepo repositories.IdentityVerificationRepository
+	userRepo         repositories.UserRepository
+	provider         identity.Provider
+	storageService   storage.StorageService
+	logger           logger.Logger
+}
+
+func NewIdentityService(
+	verificationRepo repositories.IdentityVerificationRepository,
+	userRepo repositories.UserRepository,
+	provider identity.Provider,
+	storageService storage.StorageService,
+	logger logger.Logger,
+) IdentityService {
+	return &identityService{
+		verificationRepo: verificationRepo,
+		userRepo:         userRepo,
+		provider:         provider,
+		storageService:   storageService,
+		logger:           logger,
+	}
+}
+
+func (s *identityService) SubmitVerification(ctx context.Context, userID uint, req *dto.SubmitVerificationRequest, document, selfie *multipart.FileHeader) (*dto.VerificationResponse, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get user", "error", err)
+		return nil, errors.New("failed to submit verification")
+	}
+
+	if user.IsVerified {
+		return nil, errors.New("identity already verified")
+	}
+
+	if open, err := s.verificationRepo.HasOpenRequest(ctx, userID); err != nil {
+		s.logger.Error("Failed to check open identity verifications", "error", err)
+		return nil, errors.New("failed to submit verification")
+	} else if open {
+		return nil, errors.New("verification already in progress")
+	}
+
+	folder := fmt.Sprintf("%s/%d", identityDocumentFolder, userID)
+
+	documentKey, err := s.storageService.UploadFile(ctx, document, folder)
+	if err != nil {
+		s.logger.Error("Failed to upload identity document", "error", err)
+		return nil, errors.New("failed to upload document")
+	}
+
+	var selfieKey string
+	if selfie != nil {
+		selfieKey, err = s.storageService.UploadFile(ctx, selfie, folder)
+		if err != nil {
+			s.logger.Error("Failed to upload identity selfie", "error", err)
+			return nil, errors.New("failed to upload selfie")
+		}
+	}
+
+	verification := &entities.IdentityVerification{
+		UserID:       userID,
+		DocumentType: req.DocumentType,
+		DocumentKey:  documentKey,
+		SelfieKey:    selfieKey,
+		Status:       entities.IdentityVerificationPending,
+		Provider:     s.provider.Name(),
+	}
+
+	if err := s.verificationRepo.Create(ctx, verification); err != nil {
+		s.logger.Error("Failed to create identity verification", "error", err)
+		return nil, errors.New("failed to submit verification")
+	}
+
+	s.audit(ctx, verification, &userID, "submitted", "", "")
+
+	result, err := s.provider.Verify(ctx, &identity.VerificationRequest{
+		UserID:       userID,
+		FullName:     user.FullName,
+		DocumentType: string(req.DocumentType),
+		DocumentKey:  documentKey,
+		SelfieKey:    selfieKey,
+	})
+	if err != nil {
+		s.logger.Error("Identity provider verification failed", "error", err, "provider", s.provider.Name())
+		result = &identity.VerificationResult{
+			Decision: identity.DecisionReview,
+			Reason:   "provider error, falling back to manual review",
+		}
+	}
+
+	verification.ProviderReference = result.Reference
+
+	switch result.Decision {
+	case identity.DecisionApproved:
+		err = s.transition(ctx, verification, nil, "provider_approved", entities.IdentityVerificationApproved, result.Reason)
+	case identity.DecisionRejected:
+		err = s.transition(ctx, verification, nil, "provider_rejected", entities.IdentityVerificationRejected, result.Reason)
+	default:
+		err = s.transition(ctx, verification, nil, "queued_for_review", entities.IdentityVerificationInReview, result.Reason)
+	}
+	if err != nil {
+		return nil, errors.New("failed to submit verification")
+	}
+
+	return s.mapVerificationToResponse(verification, false), nil
+}
+
+func (s *identityService) GetStatus(ctx context.Context, userID uint) (*dto.VerificationStatusResponse, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get user", "error", err)
+		return nil, errors.New("failed to get verification status")
+	}
+
+	status := &dto.VerificationStatusResponse{
+		IsVerified:         user.IsVerified,
+		IdentityVerifiedAt: user.IdentityVerifiedAt,
+	}
+
+	latest, err := s.verificationRepo.GetLatestByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return status, nil
+		}
+		s.logger.Error("Failed to get identity verification", "error", err)
+		return nil, errors.New("failed to get verification status")
+	}
+
+	status.Latest = s.mapVerificationToResponse(latest, false)
+	return status, nil
+}
+
+func (s *identityService) GetReviewQueue(ctx context.Context, limit, offset int) ([]*dto.VerificationResponse, error) {
+	verifications, err := s.verificationRepo.GetByStatus(ctx, entities.IdentityVerificationInReview, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to get identity verification queue", "error", err)
+		return nil, errors.New("failed to get verification queue")
+	}
+
+	responses := make([]*dto.VerificationResponse, 0, len(verifications))
+	for _, verification := range verifications {
+		responses = append(responses, s.mapVerificationToResponse(verification, true))
+	}
+
+	return responses, nil
+}
+
+func (s *identityService) GetAuditTrail(ctx context.Context, verificationID uint) ([]*dto.AuditResponse, error) {
+	audits, err := s.verificationRepo.GetAuditTrail(ctx, verificationID)
+	if err != nil {
+		s.logger.Error("Failed to get identity verification audit trail", "error", err)
+		return nil, errors.New("failed to get audit trail")
+	}
+
+	responses := make([]*dto.AuditResponse, 0, len(audits))
+	for _, audit := range audits {
+		responses = append(responses, &dto.AuditResponse{
+			ID:         audit.ID,
+			ActorID:    audit.ActorID,
+			Action:     audit.Action,
+			FromStatus: audit.FromStatus,
+			ToStatus:   audit.ToStatus,
+			Note:       audit.Note,
+			CreatedAt:  audit.CreatedAt,
+		})
+	}
+
+	return responses, nil
+}
+
+func (s *identityService) ApproveVerification(ctx context.Context, adminID, verificationID uint, req *dto.ReviewVerificationRequest) (*dto.VerificationResponse, error) {
+	return s.review(ctx, adminID, verificationID, entities.IdentityVerificationInReview, "approved", entities.IdentityVerificationApproved, req.Note)
+}
+
+func (s *identityService) RejectVerification(ctx context.Context, adminID, verificationID uint, req *dto.ReviewVerificationRequest) (*dto.VerificationResponse, error) {
+	return s.review(ctx, adminID, verificationID, entities.IdentityVerificationInReview, "rejected", entities.IdentityVerificationRejected, req.Note)
+}
+
+func (s *identityService) RevokeVerification(ctx context.Context, adminID, verificationID uint, req *dto.ReviewVerificationRequest) (*dto.VerificationResponse, error) {
+	return s.review(ctx, adminID, verificationID, entities.IdentityVerificationApproved, "revoked", entities.IdentityVerificationRevoked, req.Note)
+}
+
+func (s *identityService) review(ctx context.Context, adminID, verificationID uint, expected entities.IdentityVerificationStatus, action string, status entities.IdentityVerificationStatus, note string) (*dto.VerificationResponse, error) {
+	verification, err := s.verificationRepo.GetByID(ctx, verificationID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("verification not found")
+		}
+		s.logger.Error("Failed to get identity verification", "error", err)
+		return nil, errors.New("failed to review verification")
+	}
+
+	if verification.Status != expected {
+		return nil, fmt.Errorf("verification is not %s", expected)
+	}
+
+	if err := s.transition(ctx, verification, &adminID, action, status, note); err != nil {
+		return nil, errors.New("failed to review verification")
+	}
+
+	return s.mapVerificationToResponse(verification, true), nil
+}
+
+func (s *identityService) transition(ctx context.Context, verification *entities.IdentityVerification, actorID *uint, action string, status entities.IdentityVerificationStatus, note string) error {
+	from := verification.Status
+	now := time.Now()
+
+	verification.Status = status
+	verification.ReviewNote = note
+	if status != entities.IdentityVerificationInReview {
+		verification.ReviewerID = actorID
+		verification.ReviewedAt = &now
+	}
+
+	if err := s.verificationRepo.Update(ctx, verification); err != nil {
+		s.logger.Error("Failed to update identity verification", "error", err)
+		return err
+	}
+
+	switch status {
+	case entities.IdentityVerificationApproved:
+		if err := s.userRepo.SetIdentityVerified(ctx, verification.UserID, true, &now); err != nil {
+			s.logger.Error("Failed to mark user identity verified", "error", err)
+			return err
+		}
+	case entities.IdentityVerificationRevoked:
+		if err := s.userRepo.SetIdentityVerified(ctx, verification.UserID, false, nil); err != nil {
+			s.logger.Error("Failed to revoke user identity verification", "error", err)
+			return err
+		}
+	}
+
+	s.audit(ctx, verification, actorID, action, from, note)
+	return nil
+}
+
+func (s *identityService) audit(ctx context.Context, verification *entities.IdentityVerification, actorID *uint, action string, from entities.IdentityVerificationStatus, note string) {
+	audit := &entities.IdentityVerificationAudit{
+		VerificationID: verification.ID,
+		UserID:         verification.UserID,
+		ActorID:        actorID,
+		Action:         action,
+		FromStatus:     from,
+		ToStatus:       verification.Status,
+		Note:           note,
+	}
+
+	if err := s.verificationRepo.CreateAudit(ctx, audit); err != nil {
+		s.logger.Error("Failed to write identity verification audit", "error", err, "verification_id", verification.ID)
+	}
+}
+
+func (s *identityService) mapVerificationToResponse(verification *entities.IdentityVerification, includeDocuments bool) *dto.VerificationResponse {
+	response := &dto.VerificationResponse{
+		ID:                verification.ID,
+		UserID:            verification.UserID,
+		DocumentType:      verification.DocumentType,
+		Status:            verification.Status,
+		Provider:          verification.Provider,
+		ProviderReference: verification.ProviderReference,
+		ReviewNote:        verification.ReviewNote,
+		ReviewedAt:        verification.ReviewedAt,
+		CreatedAt:         verification.CreatedAt,
+	}
+
+	if includeDocuments {
+		response.DocumentURL = s.presign(verification.DocumentKey)
+		response.SelfieURL = s.presign(verification.SelfieKey)
+	}
+
+	if verification.User.ID != 0 {
+		response.User = &dto.UserInfo{
+			ID:       verification.User.ID,
+			Username: verification.User.Username,
+			FullName: verification.User.FullName,
+			Email:    verification.User.Email,
+		}
+	}
+
+	return response
+}
+
+func (s *identityService) presign(key string) string {
+	if key == "" {
+		return ""
+	}
+
+	url, err := s.storageService.GeneratePresignedURL(key, identityDocumentURLTTL)
+	if err != nil {
+		s.logger.Error("Failed to generate identity document presigned URL", "error", err)
+		return ""
+	}
+	return url
+}
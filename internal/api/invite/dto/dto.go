@@ -0,0 +1,70 @@
+package dto
+
+import "time"
+
+type CreateInviteRequest struct {
+	Note          string `json:"note" validate:"max=200"`
+	MaxUses       int    `json:"max_uses" validate:"omitempty,min=1"`
+	ExpiresInDays int    `json:"expires_in_days" validate:"omitempty,min=1,max=365"`
+}
+
+// UpdateInviteRequest changes only the fields that are sent. An
+// expires_in_days of 0 removes the expiry.
+type UpdateInviteRequest struct {
+	Note          *string `json:"note" validate:"omitempty,max=200"`
+	MaxUses       *int    `json:"max_uses" validate:"omitempty,min=1"`
+	ExpiresInDays *int    `json:"expires_in_days" validate:"omitempty,min=0,max=365"`
+}
+
+type UserInfo struct {
+	ID             uint   `json:"id"`
+	Username       string `json:"username"`
+	FullName       string `json:"full_name"`
+	Headline       string `json:"headline,omitempty"`
+	ProfilePicture string `json:"profile_picture,omitempty"`
+}
+
+type InviteResponse struct {
+	ID        uint       `json:"id"`
+	Code      string     `json:"code"`
+	Note      string     `json:"note,omitempty"`
+	MaxUses   int        `json:"max_uses"`
+	UseCount  int        `json:"use_count"`
+	Active    bool       `json:"active"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type InviteeResponse struct {
+	User     *UserInfo `json:"user"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+type InviteDetailResponse struct {
+	*InviteResponse
+	Invitees []*InviteeResponse `json:"invitees"`
+}
+
+// QuotaResponse counts seats: the people a user's invites can admit. An
+// unlimited quota, as admins have, is reported with a nil Limit.
+type QuotaResponse struct {
+	Limit     *int  `json:"limit"`
+	Used      int64 `json:"used"`
+	Remaining *int  `json:"remaining"`
+}
+
+type InviteListResponse struct {
+	Invites    []*InviteResponse `json:"invites"`
+	Total      int64             `json:"total"`
+	Quota      *QuotaResponse    `json:"quota"`
+	InviteOnly bool              `json:"invite_only"`
+}
+
+// InviteCheckResponse tells a registration form whether it needs a code
+// and whether the one entered will be accepted.
+type InviteCheckResponse struct {
+	InviteOnly bool      `json:"invite_only"`
+	Valid      bool      `json:"valid"`
+	InvitedBy  *UserInfo `json:"invited_by,omitempty"`
+}
@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"linked-clone/internal/api/invite/dto"
+	"linked-clone/internal/api/invite/service"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/request"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InviteHandler struct {
+	inviteService service.InviteService
+	validator     validation.Validator
+	logger        logger.Logger
+}
+
+func NewInviteHandler(inviteService service.InviteService, validator validation.Validator, logger logger.Logger) *InviteHandler {
+	return &InviteHandler{
+		inviteService: inviteService,
+		validator:     validator,
+		logger:        logger,
+	}
+}
+
+func (h *InviteHandler) CreateInvite(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.CreateInviteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	invite, err := h.inviteService.CreateInvite(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.logger.Error("Failed to create invite", "error", err)
+		response.Error(c, inviteErrorStatus(err), "Failed to create invite", err.Error())
+		return
+	}
+
+	response.Created(c, invite)
+}
+
+func (h *InviteHandler) GetInvites(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	limit, offset := request.Pagination(c)
+
+	invites, err := h.inviteService.ListInvites(c.Request.Context(), userID, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get invites", "error", err)
+		response.Error(c, inviteErrorStatus(err), "Failed to get invites", err.Error())
+		return
+	}
+
+	response.Success(c, invites)
+}
+
+func (h *InviteHandler) GetInvite(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	inviteID, ok := request.ParseID(c, "id", "Invalid invite ID")
+	if !ok {
+		return
+	}
+
+	invite, err := h.inviteService.GetInvite(c.Request.Context(), userID, inviteID)
+	if err != nil {
+		h.logger.Error("Failed to get invite", "error", err)
+		response.Error(c, inviteErrorStatus(err), "Failed to get invite", err.Error())
+		return
+	}
+
+	response.Success(c, invite)
+}
+
+func (h *InviteHandler) UpdateInvite(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	inviteID, ok := request.ParseID(c, "id", "Invalid invite ID")
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateInviteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	invite, err := h.inviteService.UpdateInvite(c.Request.Context(), userID, inviteID, &req)
+	if err != nil {
+		h.logger.Error("Failed to update invite", "error", err)
+		response.Error(c, inviteErrorStatus(err), "Failed to update invite", err.Error())
+		return
+	}
+
+	response.Success(c, invite)
+}
+
+func (h *InviteHandler) RevokeInvite(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	inviteID, ok := request.ParseID(c, "id", "Invalid invite ID")
+	if !ok {
+		return
+	}
+
+	if err := h.inviteService.RevokeInvite(c.Request.Context(), userID, inviteID); err != nil {
+		h.logger.Error("Failed to revoke invite", "error", err)
+		response.Error(c, inviteErrorStatus(err), "Failed to revoke invite", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Invite revoked"})
+}
+
+func (h *InviteHandler) CheckInvite(c *gin.Context) {
+	result, err := h.inviteService.CheckInvite(c.Request.Context(), c.Query("code"))
+	if err != nil {
+		h.logger.Error("Failed to check invite", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to check invite", err.Error())
+		return
+	}
+
+	response.Success(c, result)
+}
+
+func inviteErrorStatus(err error) int {
+	switch {
+	case err.Error() == "invite not found", err.Error() == "user not found":
+		return http.StatusNotFound
+	case err.Error() == "invite quota exceeded":
+		return http.StatusForbidden
+	case err.Error() == "invite has been revoked":
+		return http.StatusConflict
+	case err.Error() == "max uses cannot be below the uses already taken",
+		strings.HasPrefix(err.Error(), "an invite can be used at most"):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
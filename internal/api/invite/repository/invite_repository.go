@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type inviteRepository struct {
+	db *gorm.DB
+}
+
+func NewInviteRepository(db *gorm.DB) repositories.InviteRepository {
+	return &inviteRepository{db: db}
+}
+
+func (r *inviteRepository) Create(ctx context.Context, invite *entities.Invite) error {
+	return r.db.WithContext(ctx).Create(invite).Error
+}
+
+func (r *inviteRepository) GetByID(ctx context.Context, id uint) (*entities.Invite, error) {
+	var invite entities.Invite
+	err := r.db.WithContext(ctx).First(&invite, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &invite, nil
+}
+
+func (r *inviteRepository) GetByCode(ctx context.Context, code string) (*entities.Invite, error) {
+	var invite entities.Invite
+	err := r.db.WithContext(ctx).Where("code = ?", code).First(&invite).Error
+	if err != nil {
+		return nil, err
+	}
+	return &invite, nil
+}
+
+func (r *inviteRepository) Update(ctx context.Context, invite *entities.Invite) error {
+	return r.db.WithContext(ctx).Save(invite).Error
+}
+
+func (r *inviteRepository) ListByInviter(ctx context.Context, inviterID uint, limit, offset int) ([]*entities.Invite, int64, error) {
+	var invites []*entities.Invite
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&entities.Invite{}).Where("inviter_id = ?", inviterID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&invites).Error
+	return invites, total, err
+}
+
+func (r *inviteRepository) CountSeats(ctx context.Context, inviterID uint) (int64, error) {
+	var seats int64
+	err := r.db.WithContext(ctx).Model(&entities.Invite{}).
+		Select("COALESCE(SUM(CASE WHEN revoked_at IS NULL THEN max_uses ELSE use_count END), 0)").
+		Where("inviter_id = ?", inviterID).
+		Scan(&seats).Error
+	return seats, err
+}
+
+func (r *inviteRepository) Redeem(ctx context.Context, code string, now time.Time) (*entities.Invite, error) {
+	var invites []*entities.Invite
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE invites
+		SET use_count = use_count + 1, updated_at = ?
+		WHERE code = ? AND revoked_at IS NULL AND use_count < max_uses
+			AND (expires_at IS NULL OR expires_at > ?)
+		RETURNING *`,
+		now, code, now,
+	).Scan(&invites).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(invites) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return invites[0], nil
+}
+
+func (r *inviteRepository) Release(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Model(&entities.Invite{}).
+		Where("id = ? AND use_count > 0", id).
+		UpdateColumn("use_count", gorm.Expr("use_count - 1")).Error
+}
+
+func (r *inviteRepository) GetInvitees(ctx context.Context, inviteID uint) ([]*entities.User, error) {
+	var users []*entities.User
+	err := r.db.WithContext(ctx).
+		Where("invite_id = ?", inviteID).
+		Order("created_at ASC").
+		Find(&users).Error
+	return users, err
+}
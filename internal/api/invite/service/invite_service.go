@@ -0,0 +1,349 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"linked-clone/internal/api/invite/dto"
+	"linked-clone/internal/config"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/featureflag"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/signup"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type InviteService interface {
+	CreateInvite(ctx context.Context, userID uint, req *dto.CreateInviteRequest) (*dto.InviteResponse, error)
+	ListInvites(ctx context.Context, userID uint, limit, offset int) (*dto.InviteListResponse, error)
+	GetInvite(ctx context.Context, userID, inviteID uint) (*dto.InviteDetailResponse, error)
+	UpdateInvite(ctx context.Context, userID, inviteID uint, req *dto.UpdateInviteRequest) (*dto.InviteResponse, error)
+	RevokeInvite(ctx context.Context, userID, inviteID uint) error
+	CheckInvite(ctx context.Context, code string) (*dto.InviteCheckResponse, error)
+	GetQuota(ctx context.Context, userID uint) (*dto.QuotaResponse, error)
+}
+
+type inviteService struct {
+	inviteRepo repositories.InviteRepository
+	userRepo   repositories.UserRepository
+	flags      *featureflag.Flags
+	config     config.InviteConfig
+	logger     logger.Logger
+}
+
+func NewInviteService(
+	inviteRepo repositories.InviteRepository,
+	userRepo repositories.UserRepository,
+	flags *featureflag.Flags,
+	config config.InviteConfig,
+	logger logger.Logger,
+) InviteService {
+	return &inviteService{
+		inviteRepo: inviteRepo,
+		userRepo:   userRepo,
+		flags:      flags,
+		config:     config,
+		logger:     logger,
+	}
+}
+
+func (s *inviteService) CreateInvite(ctx context.Context, userID uint, req *dto.CreateInviteRequest) (*dto.InviteResponse, error) {
+	maxUses := req.MaxUses
+	if maxUses == 0 {
+		maxUses = 1
+	}
+	if maxUses > s.config.MaxUses {
+		return nil, fmt.Errorf("an invite can be used at most %d times", s.config.MaxUses)
+	}
+
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkQuota(ctx, user, maxUses); err != nil {
+		return nil, err
+	}
+
+	invite := &entities.Invite{
+		InviterID: userID,
+		Note:      req.Note,
+		MaxUses:   maxUses,
+	}
+	days := req.ExpiresInDays
+	if days == 0 {
+		days = s.config.DefaultDays
+	}
+	if days > 0 {
+		expiresAt := time.Now().AddDate(0, 0, days)
+		invite.ExpiresAt = &expiresAt
+	}
+
+	// Codes are drawn from 31^12 values, so a clash with an existing code
+	// is left to the unique index rather than checked for.
+	invite.Code, err = signup.GenerateInviteCode()
+	if err != nil {
+		s.logger.Error("Failed to generate invite code", "error", err)
+		return nil, errors.New("failed to create invite")
+	}
+
+	if err := s.inviteRepo.Create(ctx, invite); err != nil {
+		s.logger.Error("Failed to create invite", "error", err, "user_id", userID)
+		return nil, errors.New("failed to create invite")
+	}
+
+	return toInviteResponse(invite), nil
+}
+
+func (s *inviteService) ListInvites(ctx context.Context, userID uint, limit, offset int) (*dto.InviteListResponse, error) {
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	invites, total, err := s.inviteRepo.ListByInviter(ctx, userID, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list invites", "error", err, "user_id", userID)
+		return nil, errors.New("failed to get invites")
+	}
+
+	quota, err := s.quota(ctx, user)
+	if err != nil {
+		return nil, errors.New("failed to get invites")
+	}
+
+	responses := make([]*dto.InviteResponse, 0, len(invites))
+	for _, invite := range invites {
+		responses = append(responses, toInviteResponse(invite))
+	}
+
+	return &dto.InviteListResponse{
+		Invites:    responses,
+		Total:      total,
+		Quota:      quota,
+		InviteOnly: s.flags.Enabled(featureflag.FeatureInviteOnly),
+	}, nil
+}
+
+func (s *inviteService) GetInvite(ctx context.Context, userID, inviteID uint) (*dto.InviteDetailResponse, error) {
+	invite, err := s.getOwnInvite(ctx, userID, inviteID)
+	if err != nil {
+		return nil, err
+	}
+
+	invitees, err := s.inviteRepo.GetInvitees(ctx, invite.ID)
+	if err != nil {
+		s.logger.Error("Failed to get invitees", "error", err, "invite_id", invite.ID)
+		return nil, errors.New("failed to get invite")
+	}
+
+	detail := &dto.InviteDetailResponse{
+		InviteResponse: toInviteResponse(invite),
+		Invitees:       make([]*dto.InviteeResponse, 0, len(invitees)),
+	}
+	for _, invitee := range invitees {
+		detail.Invitees = append(detail.Invitees, &dto.InviteeResponse{
+			User:     toUserInfo(invitee),
+			JoinedAt: invitee.CreatedAt,
+		})
+	}
+	return detail, nil
+}
+
+func (s *inviteService) UpdateInvite(ctx context.Context, userID, inviteID uint, req *dto.UpdateInviteRequest) (*dto.InviteResponse, error) {
+	invite, err := s.getOwnInvite(ctx, userID, inviteID)
+	if err != nil {
+		return nil, err
+	}
+	if invite.RevokedAt != nil {
+		return nil, errors.New("invite has been revoked")
+	}
+
+	if req.Note != nil {
+		invite.Note = *req.Note
+	}
+
+	if req.MaxUses != nil && *req.MaxUses != invite.MaxUses {
+		if *req.MaxUses > s.config.MaxUses {
+			return nil, fmt.Errorf("an invite can be used at most %d times", s.config.MaxUses)
+		}
+		if *req.MaxUses < invite.UseCount {
+			return nil, errors.New("max uses cannot be below the uses already taken")
+		}
+		if extra := *req.MaxUses - invite.MaxUses; extra > 0 {
+			user, err := s.getUser(ctx, userID)
+			if err != nil {
+				return nil, err
+			}
+			if err := s.checkQuota(ctx, user, extra); err != nil {
+				return nil, err
+			}
+		}
+		invite.MaxUses = *req.MaxUses
+	}
+
+	if req.ExpiresInDays != nil {
+		invite.ExpiresAt = nil
+		if *req.ExpiresInDays > 0 {
+			expiresAt := time.Now().AddDate(0, 0, *req.ExpiresInDays)
+			invite.ExpiresAt = &expiresAt
+		}
+	}
+
+	if err := s.inviteRepo.Update(ctx, invite); err != nil {
+		s.logger.Error("Failed to update invite", "error", err, "invite_id", invite.ID)
+		return nil, errors.New("failed to update invite")
+	}
+
+	return toInviteResponse(invite), nil
+}
+
+// RevokeInvite stops a code from being used again. People who already
+// joined with it keep their attribution, and its unused seats go back to
+// the quota.
+func (s *inviteService) RevokeInvite(ctx context.Context, userID, inviteID uint) error {
+	invite, err := s.getOwnInvite(ctx, userID, inviteID)
+	if err != nil {
+		return err
+	}
+	if invite.RevokedAt != nil {
+		return nil
+	}
+
+	now := time.Now()
+	invite.RevokedAt = &now
+	if err := s.inviteRepo.Update(ctx, invite); err != nil {
+		s.logger.Error("Failed to revoke invite", "error", err, "invite_id", invite.ID)
+		return errors.New("failed to revoke invite")
+	}
+	return nil
+}
+
+func (s *inviteService) CheckInvite(ctx context.Context, code string) (*dto.InviteCheckResponse, error) {
+	result := &dto.InviteCheckResponse{InviteOnly: s.flags.Enabled(featureflag.FeatureInviteOnly)}
+
+	code = signup.NormalizeInviteCode(code)
+	if code == "" {
+		return result, nil
+	}
+
+	invite, err := s.inviteRepo.GetByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return result, nil
+		}
+		s.logger.Error("Failed to get invite", "error", err)
+		return nil, errors.New("failed to check invite")
+	}
+	if !invite.Usable(time.Now()) {
+		return result, nil
+	}
+
+	result.Valid = true
+	if inviter, err := s.userRepo.GetByID(ctx, invite.InviterID); err == nil {
+		result.InvitedBy = toUserInfo(inviter)
+	}
+	return result, nil
+}
+
+// checkQuota refuses seats beyond the inviter's quota. Admins seed the
+// network and are not limited.
+func (s *inviteService) GetQuota(ctx context.Context, userID uint) (*dto.QuotaResponse, error) {
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	quota, err := s.quota(ctx, user)
+	if err != nil {
+		return nil, errors.New("failed to get invite quota")
+	}
+	return quota, nil
+}
+
+func (s *inviteService) quota(ctx context.Context, user *entities.User) (*dto.QuotaResponse, error) {
+	seats, err := s.inviteRepo.CountSeats(ctx, user.ID)
+	if err != nil {
+		s.logger.Error("Failed to count invite seats", "error", err, "user_id", user.ID)
+		return nil, err
+	}
+
+	quota := &dto.QuotaResponse{Used: seats}
+	if !user.IsAdmin {
+		limit := s.config.Quota
+		remaining := max(limit-int(seats), 0)
+		quota.Limit = &limit
+		quota.Remaining = &remaining
+	}
+	return quota, nil
+}
+
+func (s *inviteService) checkQuota(ctx context.Context, user *entities.User, seats int) error {
+	if user.IsAdmin {
+		return nil
+	}
+
+	used, err := s.inviteRepo.CountSeats(ctx, user.ID)
+	if err != nil {
+		s.logger.Error("Failed to count invite seats", "error", err, "user_id", user.ID)
+		return errors.New("failed to create invite")
+	}
+	if used+int64(seats) > int64(s.config.Quota) {
+		return errors.New("invite quota exceeded")
+	}
+	return nil
+}
+
+func (s *inviteService) getUser(ctx context.Context, userID uint) (*entities.User, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("user not found")
+		}
+		s.logger.Error("Failed to get user", "error", err, "user_id", userID)
+		return nil, errors.New("failed to process request")
+	}
+	return user, nil
+}
+
+func (s *inviteService) getOwnInvite(ctx context.Context, userID, inviteID uint) (*entities.Invite, error) {
+	invite, err := s.inviteRepo.GetByID(ctx, inviteID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("invite not found")
+		}
+		s.logger.Error("Failed to get invite", "error", err, "invite_id", inviteID)
+		return nil, errors.New("failed to get invite")
+	}
+	if invite.InviterID != userID {
+		return nil, errors.New("invite not found")
+	}
+	return invite, nil
+}
+
+func toInviteResponse(invite *entities.Invite) *dto.InviteResponse {
+	return &dto.InviteResponse{
+		ID:        invite.ID,
+		Code:      invite.Code,
+		Note:      invite.Note,
+		MaxUses:   invite.MaxUses,
+		UseCount:  invite.UseCount,
+		Active:    invite.Usable(time.Now()),
+		ExpiresAt: invite.ExpiresAt,
+		RevokedAt: invite.RevokedAt,
+		CreatedAt: invite.CreatedAt,
+	}
+}
+
+func toUserInfo(user *entities.User) *dto.UserInfo {
+	return &dto.UserInfo{
+		ID:             user.ID,
+		Username:       user.Username,
+		FullName:       user.FullName,
+		Headline:       user.Headline,
+		ProfilePicture: user.ProfilePicture,
+	}
+}
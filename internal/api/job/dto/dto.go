@@ -6,62 +6,203 @@ import (
 )
 
 type CreateJobRequest struct {
-	Title           string                   `json:"title" validate:"required,min=5,max=200"`
-	Company         string                   `json:"company" validate:"required,min=2,max=100"`
-	Location        string                   `json:"location" validate:"required,min=2,max=100"`
-	Description     string                   `json:"description" validate:"required,min=50,max=5000"`
-	Requirements    string                   `json:"requirements" validate:"omitempty,max=3000"`
-	JobType         entities.JobType         `json:"job_type" validate:"required,oneof=full_time part_time contract internship"`
-	ExperienceLevel entities.ExperienceLevel `json:"experience_level" validate:"required,oneof=entry mid senior executive"`
-	SalaryMin       *int                     `json:"salary_min" validate:"omitempty,min=0"`
-	SalaryMax       *int                     `json:"salary_max" validate:"omitempty,min=0"`
+	Title               string                   `json:"title" validate:"required,min=5,max=200"`
+	Company             string                   `json:"company" validate:"required_without=CompanyID,omitempty,min=2,max=100"`
+	CompanyID           *uint                    `json:"company_id"`
+	TeamID              *uint                    `json:"team_id"`
+	Location            string                   `json:"location" validate:"required_without=LocationID,omitempty,min=2,max=100"`
+	LocationID          *uint                    `json:"location_id"`
+	IndustryID          *uint                    `json:"industry_id"`
+	Description         string                   `json:"description" validate:"required,min=50,max=5000"`
+	Requirements        string                   `json:"requirements" validate:"omitempty,max=3000"`
+	JobType             entities.JobType         `json:"job_type" validate:"required,oneof=full_time part_time contract internship"`
+	ExperienceLevel     entities.ExperienceLevel `json:"experience_level" validate:"required,oneof=entry mid senior executive"`
+	SalaryMin           *int                     `json:"salary_min" validate:"omitempty,min=0"`
+	SalaryMax           *int                     `json:"salary_max" validate:"omitempty,min=0"`
+	ReapplyCooldownDays *int                     `json:"reapply_cooldown_days" validate:"omitempty,min=0,max=365"`
+	ApplicationDeadline *time.Time               `json:"application_deadline"`
+	DeadlineTimezone    string                   `json:"deadline_timezone" validate:"omitempty,timezone"`
+	ScreeningQuestions  []ScreeningQuestion      `json:"screening_questions" validate:"omitempty,max=20,dive"`
+	Draft               bool                     `json:"draft"`
 }
 
 type UpdateJobRequest struct {
-	Title           string                    `json:"title" validate:"omitempty,min=5,max=200"`
-	Company         string                    `json:"company" validate:"omitempty,min=2,max=100"`
-	Location        string                    `json:"location" validate:"omitempty,min=2,max=100"`
-	Description     string                    `json:"description" validate:"omitempty,min=50,max=5000"`
-	Requirements    string                    `json:"requirements" validate:"omitempty,max=3000"`
-	JobType         *entities.JobType         `json:"job_type" validate:"omitempty,oneof=full_time part_time contract internship"`
-	ExperienceLevel *entities.ExperienceLevel `json:"experience_level" validate:"omitempty,oneof=entry mid senior executive"`
-	SalaryMin       *int                      `json:"salary_min" validate:"omitempty,min=0"`
-	SalaryMax       *int                      `json:"salary_max" validate:"omitempty,min=0"`
-	IsActive        *bool                     `json:"is_active"`
+	Title               string                    `json:"title" validate:"omitempty,min=5,max=200"`
+	Company             string                    `json:"company" validate:"omitempty,min=2,max=100"`
+	Location            string                    `json:"location" validate:"omitempty,min=2,max=100"`
+	LocationID          *uint                     `json:"location_id"`
+	IndustryID          *uint                     `json:"industry_id"`
+	Description         string                    `json:"description" validate:"omitempty,min=50,max=5000"`
+	Requirements        string                    `json:"requirements" validate:"omitempty,max=3000"`
+	JobType             *entities.JobType         `json:"job_type" validate:"omitempty,oneof=full_time part_time contract internship"`
+	ExperienceLevel     *entities.ExperienceLevel `json:"experience_level" validate:"omitempty,oneof=entry mid senior executive"`
+	SalaryMin           *int                      `json:"salary_min" validate:"omitempty,min=0"`
+	SalaryMax           *int                      `json:"salary_max" validate:"omitempty,min=0"`
+	ReapplyCooldownDays *int                      `json:"reapply_cooldown_days" validate:"omitempty,min=0,max=365"`
+	ApplicationDeadline *time.Time                `json:"application_deadline"`
+	ClearDeadline       bool                      `json:"clear_deadline"`
+	TeamID              *uint                     `json:"team_id"`
+	ClearTeam           bool                      `json:"clear_team"`
+	DeadlineTimezone    string                    `json:"deadline_timezone" validate:"omitempty,timezone"`
+	ScreeningQuestions  []ScreeningQuestion       `json:"screening_questions" validate:"omitempty,max=20,dive"`
+	IsActive            *bool                     `json:"is_active"`
 }
 
 type JobResponse struct {
-	ID               uint                     `json:"id"`
-	Title            string                   `json:"title"`
-	Company          string                   `json:"company"`
-	Location         string                   `json:"location"`
-	Description      string                   `json:"description"`
-	Requirements     string                   `json:"requirements"`
-	JobType          entities.JobType         `json:"job_type"`
-	ExperienceLevel  entities.ExperienceLevel `json:"experience_level"`
-	SalaryMin        *int                     `json:"salary_min,omitempty"`
-	SalaryMax        *int                     `json:"salary_max,omitempty"`
-	IsActive         bool                     `json:"is_active"`
-	ApplicationCount int                      `json:"application_count"`
-	User             *UserInfo                `json:"user"`
-	CreatedAt        time.Time                `json:"created_at"`
-	UpdatedAt        time.Time                `json:"updated_at"`
+	ID                   uint                     `json:"id"`
+	Title                string                   `json:"title"`
+	Company              string                   `json:"company"`
+	CompanyID            *uint                    `json:"company_id,omitempty"`
+	CompanyVerified      bool                     `json:"company_verified"`
+	TeamID               *uint                    `json:"team_id,omitempty"`
+	TeamName             string                   `json:"team_name,omitempty"`
+	Location             string                   `json:"location"`
+	LocationID           *uint                    `json:"location_id,omitempty"`
+	Industry             string                   `json:"industry,omitempty"`
+	IndustryID           *uint                    `json:"industry_id,omitempty"`
+	Description          string                   `json:"description"`
+	Requirements         string                   `json:"requirements"`
+	JobType              entities.JobType         `json:"job_type"`
+	JobTypeLabel         string                   `json:"job_type_label"`
+	ExperienceLevel      entities.ExperienceLevel `json:"experience_level"`
+	ExperienceLevelLabel string                   `json:"experience_level_label"`
+	SalaryMin            *int                     `json:"salary_min,omitempty"`
+	SalaryMax            *int                     `json:"salary_max,omitempty"`
+	IsActive             bool                     `json:"is_active"`
+	Status               entities.JobStatus       `json:"status"`
+	StatusLabel          string                   `json:"status_label"`
+	ReviewNote           string                   `json:"review_note,omitempty"`
+	ReviewedAt           *time.Time               `json:"reviewed_at,omitempty"`
+	PublishedAt          *time.Time               `json:"published_at,omitempty"`
+	HiddenAt             *time.Time               `json:"hidden_at,omitempty"`
+	ApplicationCount     int                      `json:"application_count"`
+	ViewCount            int64                    `json:"view_count"`
+	ReapplyCooldownDays  int                      `json:"reapply_cooldown_days"`
+	ApplicationDeadline  *time.Time               `json:"application_deadline,omitempty"`
+	DeadlineTimezone     string                   `json:"deadline_timezone,omitempty"`
+	DeadlineCountdown    *DeadlineCountdown       `json:"deadline_countdown,omitempty"`
+	ScreeningQuestions   []ScreeningQuestion      `json:"screening_questions"`
+	User                 *UserInfo                `json:"user"`
+	CreatedAt            time.Time                `json:"created_at"`
+	UpdatedAt            time.Time                `json:"updated_at"`
+}
+
+type ScreeningQuestion struct {
+	Question string                         `json:"question" validate:"required,min=3,max=500"`
+	Type     entities.ScreeningQuestionType `json:"type" validate:"required,oneof=text yes_no"`
+	Required bool                           `json:"required"`
+}
+
+type CreateJobTemplateRequest struct {
+	Name               string                   `json:"name" validate:"required,min=2,max=100"`
+	CompanyID          *uint                    `json:"company_id"`
+	Title              string                   `json:"title" validate:"required,min=5,max=200"`
+	Description        string                   `json:"description" validate:"required,min=50,max=5000"`
+	Requirements       string                   `json:"requirements" validate:"omitempty,max=3000"`
+	JobType            entities.JobType         `json:"job_type" validate:"omitempty,oneof=full_time part_time contract internship"`
+	ExperienceLevel    entities.ExperienceLevel `json:"experience_level" validate:"omitempty,oneof=entry mid senior executive"`
+	ScreeningQuestions []ScreeningQuestion      `json:"screening_questions" validate:"omitempty,max=20,dive"`
+}
+
+type UpdateJobTemplateRequest struct {
+	Name               string                    `json:"name" validate:"omitempty,min=2,max=100"`
+	Title              string                    `json:"title" validate:"omitempty,min=5,max=200"`
+	Description        string                    `json:"description" validate:"omitempty,min=50,max=5000"`
+	Requirements       *string                   `json:"requirements" validate:"omitempty,max=3000"`
+	JobType            *entities.JobType         `json:"job_type" validate:"omitempty,oneof=full_time part_time contract internship"`
+	ExperienceLevel    *entities.ExperienceLevel `json:"experience_level" validate:"omitempty,oneof=entry mid senior executive"`
+	ScreeningQuestions []ScreeningQuestion       `json:"screening_questions" validate:"omitempty,max=20,dive"`
+}
+
+type CreateJobFromTemplateRequest struct {
+	Company             string                    `json:"company" validate:"omitempty,min=2,max=100"`
+	Location            string                    `json:"location" validate:"required_without=LocationID,omitempty,min=2,max=100"`
+	LocationID          *uint                     `json:"location_id"`
+	IndustryID          *uint                     `json:"industry_id"`
+	JobType             *entities.JobType         `json:"job_type" validate:"omitempty,oneof=full_time part_time contract internship"`
+	ExperienceLevel     *entities.ExperienceLevel `json:"experience_level" validate:"omitempty,oneof=entry mid senior executive"`
+	SalaryMin           *int                      `json:"salary_min" validate:"omitempty,min=0"`
+	SalaryMax           *int                      `json:"salary_max" validate:"omitempty,min=0"`
+	ReapplyCooldownDays *int                      `json:"reapply_cooldown_days" validate:"omitempty,min=0,max=365"`
+	ApplicationDeadline *time.Time                `json:"application_deadline"`
+	DeadlineTimezone    string                    `json:"deadline_timezone" validate:"omitempty,timezone"`
+	Draft               bool                      `json:"draft"`
+}
+
+type JobTemplateResponse struct {
+	ID                 uint                     `json:"id"`
+	UserID             uint                     `json:"user_id"`
+	CompanyID          *uint                    `json:"company_id,omitempty"`
+	Name               string                   `json:"name"`
+	Title              string                   `json:"title"`
+	Description        string                   `json:"description"`
+	Requirements       string                   `json:"requirements"`
+	JobType            entities.JobType         `json:"job_type,omitempty"`
+	ExperienceLevel    entities.ExperienceLevel `json:"experience_level,omitempty"`
+	ScreeningQuestions []ScreeningQuestion      `json:"screening_questions"`
+	CreatedAt          time.Time                `json:"created_at"`
+	UpdatedAt          time.Time                `json:"updated_at"`
+}
+
+type DeadlineCountdown struct {
+	LocalDeadline    string `json:"local_deadline"`
+	SecondsRemaining int64  `json:"seconds_remaining"`
+	DaysRemaining    int    `json:"days_remaining"`
+	Passed           bool   `json:"passed"`
+}
+
+type ReviewJobRequest struct {
+	Note string `json:"note" validate:"omitempty,max=1000"`
+}
+
+type NetworkJobResponse struct {
+	Job    *JobResponse `json:"job"`
+	Degree int          `json:"degree"`
+	Reason string       `json:"reason"`
+}
+
+type JobViewResponse struct {
+	JobID     uint  `json:"job_id"`
+	Counted   bool  `json:"counted"`
+	ViewCount int64 `json:"view_count"`
 }
 
 type ApplyJobRequest struct {
 	CoverLetter string `form:"cover_letter" validate:"omitempty,max=2000"`
 }
 
+type WithdrawApplicationRequest struct {
+	Reason string `json:"reason" validate:"omitempty,max=500"`
+}
+
+type UpdateApplicationStatusRequest struct {
+	Status entities.ApplicationStatus `json:"status" validate:"required,oneof=reviewed shortlisted interviewing rejected hired"`
+	Note   string                     `json:"note" validate:"omitempty,max=1000"`
+}
+
+type ApplicationStatusChangeResponse struct {
+	FromStatus      entities.ApplicationStatus `json:"from_status"`
+	FromStatusLabel string                     `json:"from_status_label"`
+	ToStatus        entities.ApplicationStatus `json:"to_status"`
+	ToStatusLabel   string                     `json:"to_status_label"`
+	Note            string                     `json:"note,omitempty"`
+	ChangedBy       *UserInfo                  `json:"changed_by,omitempty"`
+	CreatedAt       time.Time                  `json:"created_at"`
+}
+
 type ApplicationResponse struct {
-	ID          uint                       `json:"id"`
-	JobID       uint                       `json:"job_id"`
-	CoverLetter string                     `json:"cover_letter"`
-	ResumeURL   string                     `json:"resume_url,omitempty"`
-	Status      entities.ApplicationStatus `json:"status"`
-	Job         *JobInfo                   `json:"job,omitempty"`
-	User        *UserInfo                  `json:"user,omitempty"`
-	AppliedAt   time.Time                  `json:"applied_at"`
-	CreatedAt   time.Time                  `json:"created_at"`
+	ID               uint                       `json:"id"`
+	JobID            uint                       `json:"job_id"`
+	CoverLetter      string                     `json:"cover_letter"`
+	ResumeURL        string                     `json:"resume_url,omitempty"`
+	Status           entities.ApplicationStatus `json:"status"`
+	StatusLabel      string                     `json:"status_label"`
+	WithdrawnAt      *time.Time                 `json:"withdrawn_at,omitempty"`
+	WithdrawalReason string                     `json:"withdrawal_reason,omitempty"`
+	Job              *JobInfo                   `json:"job,omitempty"`
+	User             *UserInfo                  `json:"user,omitempty"`
+	AppliedAt        time.Time                  `json:"applied_at"`
+	CreatedAt        time.Time                  `json:"created_at"`
 }
 
 type JobInfo struct {
@@ -76,3 +217,30 @@ type UserInfo struct {
 	FullName       string `json:"full_name"`
 	ProfilePicture string `json:"profile_picture,omitempty"`
 }
+
+type JobAlertRequest struct {
+	Name            string                     `json:"name" validate:"required,min=2,max=100"`
+	Query           string                     `json:"query" validate:"omitempty,max=200"`
+	Location        string                     `json:"location" validate:"omitempty,max=100"`
+	LocationID      *uint                      `json:"location_id"`
+	IndustryID      *uint                      `json:"industry_id"`
+	CompanyID       *uint                      `json:"company_id"`
+	JobType         entities.JobType           `json:"job_type" validate:"omitempty,oneof=full_time part_time contract internship"`
+	ExperienceLevel entities.ExperienceLevel   `json:"experience_level" validate:"omitempty,oneof=entry mid senior executive"`
+	SalaryMin       *int                       `json:"salary_min" validate:"omitempty,min=0"`
+	Frequency       entities.JobAlertFrequency `json:"frequency" validate:"required,oneof=daily weekly"`
+	IsActive        *bool                      `json:"is_active"`
+}
+
+type JobAlertResponse struct {
+	ID         uint                       `json:"id"`
+	Name       string                     `json:"name"`
+	Query      string                     `json:"query,omitempty"`
+	Filters    entities.JobAlertFilters   `json:"filters"`
+	Frequency  entities.JobAlertFrequency `json:"frequency"`
+	IsActive   bool                       `json:"is_active"`
+	NextRunAt  time.Time                  `json:"next_run_at"`
+	LastSentAt *time.Time                 `json:"last_sent_at,omitempty"`
+	CreatedAt  time.Time                  `json:"created_at"`
+	UpdatedAt  time.Time                  `json:"updated_at"`
+}
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"linked-clone/internal/api/job/dto"
+	"linked-clone/internal/api/job/service"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type JobAlertHandler struct {
+	alertService service.JobAlertService
+	validator    validation.Validator
+	logger       logger.Logger
+}
+
+func NewJobAlertHandler(alertService service.JobAlertService, validator validation.Validator, logger logger.Logger) *JobAlertHandler {
+	return &JobAlertHandler{
+		alertService: alertService,
+		validator:    validator,
+		logger:       logger,
+	}
+}
+
+func (h *JobAlertHandler) CreateAlert(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.JobAlertRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	alert, err := h.alertService.CreateAlert(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.logger.Error("Failed to create job alert", "error", err)
+		response.Error(c, alertErrorStatus(err), "Failed to create job alert", err.Error())
+		return
+	}
+
+	response.CreatedWithMessage(c, "Job alert created", alert)
+}
+
+func (h *JobAlertHandler) GetAlerts(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	alerts, err := h.alertService.GetAlerts(c.Request.Context(), userID)
+	if err != nil {
+		h.logger.Error("Failed to get job alerts", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get job alerts", err.Error())
+		return
+	}
+
+	response.Success(c, alerts)
+}
+
+func (h *JobAlertHandler) UpdateAlert(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	alertID, err := strconv.ParseUint(c.Param("alertId"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid alert ID", err.Error())
+		return
+	}
+
+	var req dto.JobAlertRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	alert, err := h.alertService.UpdateAlert(c.Request.Context(), userID, uint(alertID), &req)
+	if err != nil {
+		h.logger.Error("Failed to update job alert", "error", err)
+		response.Error(c, alertErrorStatus(err), "Failed to update job alert", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Job alert updated", alert)
+}
+
+func (h *JobAlertHandler) DeleteAlert(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	alertID, err := strconv.ParseUint(c.Param("alertId"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid alert ID", err.Error())
+		return
+	}
+
+	if err := h.alertService.DeleteAlert(c.Request.Context(), userID, uint(alertID)); err != nil {
+		h.logger.Error("Failed to delete job alert", "error", err)
+		response.Error(c, alertErrorStatus(err), "Failed to delete job alert", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Job alert deleted"})
+}
+
+func (h *JobAlertHandler) Unsubscribe(c *gin.Context) {
+	if err := h.alertService.Unsubscribe(c.Request.Context(), c.Query("token")); err != nil {
+		h.logger.Error("Failed to unsubscribe from job alert", "error", err)
+		response.Error(c, alertErrorStatus(err), "Failed to unsubscribe", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "You will no longer receive emails for this job alert"})
+}
+
+func alertErrorStatus(err error) int {
+	switch err.Error() {
+	case "job alert not found", "location not found", "industry not found":
+		return http.StatusNotFound
+	case "job alert limit reached":
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
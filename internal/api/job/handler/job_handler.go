@@ -1,6 +1,9 @@
 package handler
 
 import (
+	"context"
+	"errors"
+	"io"
 	"linked-clone/internal/api/job/dto"
 	"linked-clone/internal/api/job/service"
 	"linked-clone/internal/middleware"
@@ -9,6 +12,7 @@ import (
 	validation "linked-clone/pkg/validator"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -44,7 +48,7 @@ func (h *JobHandler) CreateJob(c *gin.Context) {
 	job, err := h.jobService.CreateJob(c.Request.Context(), userID, &req)
 	if err != nil {
 		h.logger.Error("Failed to create job", "error", err)
-		response.Error(c, http.StatusInternalServerError, "Failed to create job", err.Error())
+		response.Error(c, jobErrorStatus(err), "Failed to create job", err.Error())
 		return
 	}
 
@@ -59,6 +63,12 @@ func (h *JobHandler) GetJob(c *gin.Context) {
 		return
 	}
 
+	fields, err := response.ParseFields(c.Query("fields"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid fields parameter", err.Error())
+		return
+	}
+
 	job, err := h.jobService.GetJob(c.Request.Context(), uint(id))
 	if err != nil {
 		h.logger.Error("Failed to get job", "error", err)
@@ -66,7 +76,25 @@ func (h *JobHandler) GetJob(c *gin.Context) {
 		return
 	}
 
-	response.Success(c, job)
+	response.Success(c, response.Project(job, fields))
+}
+
+func (h *JobHandler) RecordView(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid job ID", err.Error())
+		return
+	}
+
+	view, err := h.jobService.RecordView(c.Request.Context(), uint(id), middleware.GetUserID(c), middleware.GetViewerKey(c))
+	if err != nil {
+		h.logger.Error("Failed to record job view", "error", err)
+		response.Error(c, jobErrorStatus(err), "Failed to record job view", err.Error())
+		return
+	}
+
+	response.Success(c, view)
 }
 
 func (h *JobHandler) GetJobs(c *gin.Context) {
@@ -80,9 +108,20 @@ func (h *JobHandler) GetJobs(c *gin.Context) {
 	if expLevel := c.Query("experience_level"); expLevel != "" {
 		filters["experience_level"] = expLevel
 	}
-	if location := c.Query("location"); location != "" {
+	if locationID, err := strconv.ParseUint(c.Query("location_id"), 10, 32); err == nil {
+		filters["location_id"] = uint(locationID)
+	} else if location := c.Query("location"); location != "" {
 		filters["location"] = location
 	}
+	if industryID, err := strconv.ParseUint(c.Query("industry_id"), 10, 32); err == nil {
+		filters["industry_id"] = uint(industryID)
+	}
+	if teamID, err := strconv.ParseUint(c.Query("team_id"), 10, 32); err == nil {
+		filters["team_id"] = uint(teamID)
+	}
+	if c.Query("sort") == "popular" {
+		filters["sort"] = "popular"
+	}
 
 	jobs, err := h.jobService.GetAllJobs(c.Request.Context(), filters, limit, offset)
 	if err != nil {
@@ -116,11 +155,16 @@ func (h *JobHandler) SearchJobs(c *gin.Context) {
 	if expLevel := c.Query("experience_level"); expLevel != "" {
 		filters["experience_level"] = expLevel
 	}
-	if location := c.Query("location"); location != "" {
+	if locationID, err := strconv.ParseUint(c.Query("location_id"), 10, 32); err == nil {
+		filters["location_id"] = uint(locationID)
+	} else if location := c.Query("location"); location != "" {
 		filters["location"] = location
 	}
+	if industryID, err := strconv.ParseUint(c.Query("industry_id"), 10, 32); err == nil {
+		filters["industry_id"] = uint(industryID)
+	}
 
-	jobs, err := h.jobService.SearchJobs(c.Request.Context(), query, filters, limit, offset)
+	jobs, err := h.jobService.SearchJobs(c.Request.Context(), middleware.GetUserID(c), query, filters, limit, offset)
 	if err != nil {
 		h.logger.Error("Failed to search jobs", "error", err)
 		response.Error(c, http.StatusInternalServerError, "Failed to search jobs", err.Error())
@@ -210,6 +254,10 @@ func (h *JobHandler) ApplyJob(c *gin.Context) {
 	resume, _ := c.FormFile("resume")
 
 	application, err := h.jobService.ApplyJob(c.Request.Context(), userID, uint(jobID), &req, resume)
+	if errors.Is(err, service.ErrApplicationDeadlinePassed) {
+		response.ErrorWithCode(c, http.StatusUnprocessableEntity, response.ErrCodeApplicationDeadlinePast, "Application deadline has passed", err.Error())
+		return
+	}
 	if err != nil {
 		h.logger.Error("Failed to apply for job", "error", err)
 		response.Error(c, http.StatusBadRequest, "Failed to apply for job", err.Error())
@@ -219,6 +267,26 @@ func (h *JobHandler) ApplyJob(c *gin.Context) {
 	response.Success(c, application)
 }
 
+func (h *JobHandler) GetNetworkHiring(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	jobs, err := h.jobService.GetNetworkHiring(c.Request.Context(), userID, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get network jobs", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get network jobs", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{
+		"jobs":   jobs,
+		"limit":  limit,
+		"offset": offset,
+	})
+}
+
 func (h *JobHandler) GetMyApplications(c *gin.Context) {
 	userID := middleware.GetUserID(c)
 
@@ -267,6 +335,183 @@ func (h *JobHandler) GetJobApplications(c *gin.Context) {
 	})
 }
 
+func (h *JobHandler) SubmitJob(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	jobID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid job ID", err.Error())
+		return
+	}
+
+	job, err := h.jobService.SubmitJob(c.Request.Context(), userID, uint(jobID))
+	if err != nil {
+		h.logger.Error("Failed to submit job", "error", err)
+		response.Error(c, jobErrorStatus(err), "Failed to submit job", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Job submitted", job)
+}
+
+func (h *JobHandler) GetPendingApprovals(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	jobs, err := h.jobService.GetPendingApprovals(c.Request.Context(), userID, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get pending approvals", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get pending approvals", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{
+		"jobs":   jobs,
+		"limit":  limit,
+		"offset": offset,
+	})
+}
+
+func (h *JobHandler) ApproveJob(c *gin.Context) {
+	h.reviewJob(c, "Job approved", h.jobService.ApproveJob)
+}
+
+func (h *JobHandler) RejectJob(c *gin.Context) {
+	h.reviewJob(c, "Job rejected", h.jobService.RejectJob)
+}
+
+func (h *JobHandler) reviewJob(c *gin.Context, successMessage string, review func(ctx context.Context, userID, jobID uint, req *dto.ReviewJobRequest) (*dto.JobResponse, error)) {
+	userID := middleware.GetUserID(c)
+
+	jobID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid job ID", err.Error())
+		return
+	}
+
+	var req dto.ReviewJobRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+			return
+		}
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	job, err := review(c.Request.Context(), userID, uint(jobID), &req)
+	if err != nil {
+		h.logger.Error("Failed to review job", "error", err)
+		response.Error(c, jobErrorStatus(err), "Failed to review job", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, successMessage, job)
+}
+
+func (h *JobHandler) WithdrawApplication(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	idStr := c.Param("applicationId")
+	applicationID, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid application ID", err.Error())
+		return
+	}
+
+	var req dto.WithdrawApplicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		response.Error(c, http.StatusBadRequest, "Invalid request", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	application, err := h.jobService.WithdrawApplication(c.Request.Context(), userID, uint(applicationID), &req)
+	if err != nil {
+		h.logger.Error("Failed to withdraw application", "error", err)
+		response.Error(c, jobErrorStatus(err), "Failed to withdraw application", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Application withdrawn successfully", application)
+}
+
+func (h *JobHandler) UpdateApplicationStatus(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	idStr := c.Param("applicationId")
+	applicationID, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid application ID", err.Error())
+		return
+	}
+
+	var req dto.UpdateApplicationStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	application, err := h.jobService.UpdateApplicationStatus(c.Request.Context(), userID, uint(applicationID), &req)
+	if err != nil {
+		h.logger.Error("Failed to update application status", "error", err)
+		response.Error(c, jobErrorStatus(err), "Failed to update application status", err.Error())
+		return
+	}
+
+	response.Success(c, application)
+}
+
+func (h *JobHandler) GetApplicationHistory(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	applicationID, err := strconv.ParseUint(c.Param("applicationId"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid application ID", err.Error())
+		return
+	}
+
+	history, err := h.jobService.GetApplicationHistory(c.Request.Context(), userID, uint(applicationID))
+	if err != nil {
+		h.logger.Error("Failed to get application history", "error", err)
+		response.Error(c, jobErrorStatus(err), "Failed to get application history", err.Error())
+		return
+	}
+
+	response.Success(c, history)
+}
+
+func jobErrorStatus(err error) int {
+	switch {
+	case err.Error() == "application not found", err.Error() == "job not found", err.Error() == "company not found", err.Error() == "team not found",
+		err.Error() == "location not found", err.Error() == "industry not found":
+		return http.StatusNotFound
+	case strings.HasPrefix(err.Error(), "unauthorized"):
+		return http.StatusForbidden
+	case err.Error() == "team does not belong to this company", err.Error() == "only company jobs can be scoped to a team":
+		return http.StatusBadRequest
+	case strings.HasPrefix(err.Error(), "cannot "), err.Error() == "job is not pending approval", err.Error() == "job does not require approval",
+		err.Error() == "application status changed, reload and try again":
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 func (h *JobHandler) GetMyJobs(c *gin.Context) {
 	userID := middleware.GetUserID(c)
 
@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"linked-clone/internal/api/job/dto"
+	"linked-clone/internal/api/job/service"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+type JobTemplateHandler struct {
+	templateService service.JobTemplateService
+	validator       validation.Validator
+	logger          logger.Logger
+}
+
+func NewJobTemplateHandler(templateService service.JobTemplateService, validator validation.Validator, logger logger.Logger) *JobTemplateHandler {
+	return &JobTemplateHandler{
+		templateService: templateService,
+		validator:       validator,
+		logger:          logger,
+	}
+}
+
+func (h *JobTemplateHandler) CreateTemplate(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.CreateJobTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	template, err := h.templateService.CreateTemplate(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.logger.Error("Failed to create job template", "error", err)
+		response.Error(c, templateErrorStatus(err), "Failed to create template", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Template created", template)
+}
+
+func (h *JobTemplateHandler) GetTemplates(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var companyID *uint
+	if raw := c.Query("company_id"); raw != "" {
+		id, err := strconv.ParseUint(raw, 10, 32)
+		if err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid company ID", err.Error())
+			return
+		}
+		value := uint(id)
+		companyID = &value
+	}
+
+	templates, err := h.templateService.GetTemplates(c.Request.Context(), userID, companyID)
+	if err != nil {
+		h.logger.Error("Failed to get job templates", "error", err)
+		response.Error(c, templateErrorStatus(err), "Failed to get templates", err.Error())
+		return
+	}
+
+	response.Success(c, templates)
+}
+
+func (h *JobTemplateHandler) GetTemplate(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	templateID, err := strconv.ParseUint(c.Param("templateId"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid template ID", err.Error())
+		return
+	}
+
+	template, err := h.templateService.GetTemplate(c.Request.Context(), userID, uint(templateID))
+	if err != nil {
+		h.logger.Error("Failed to get job template", "error", err)
+		response.Error(c, templateErrorStatus(err), "Failed to get template", err.Error())
+		return
+	}
+
+	response.Success(c, template)
+}
+
+func (h *JobTemplateHandler) UpdateTemplate(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	templateID, err := strconv.ParseUint(c.Param("templateId"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid template ID", err.Error())
+		return
+	}
+
+	var req dto.UpdateJobTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	template, err := h.templateService.UpdateTemplate(c.Request.Context(), userID, uint(templateID), &req)
+	if err != nil {
+		h.logger.Error("Failed to update job template", "error", err)
+		response.Error(c, templateErrorStatus(err), "Failed to update template", err.Error())
+		return
+	}
+
+	response.Success(c, template)
+}
+
+func (h *JobTemplateHandler) DeleteTemplate(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	templateID, err := strconv.ParseUint(c.Param("templateId"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid template ID", err.Error())
+		return
+	}
+
+	if err := h.templateService.DeleteTemplate(c.Request.Context(), userID, uint(templateID)); err != nil {
+		h.logger.Error("Failed to delete job template", "error", err)
+		response.Error(c, templateErrorStatus(err), "Failed to delete template", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Template deleted successfully"})
+}
+
+func (h *JobTemplateHandler) CreateJobFromTemplate(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	templateID, err := strconv.ParseUint(c.Param("templateId"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid template ID", err.Error())
+		return
+	}
+
+	var req dto.CreateJobFromTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	job, err := h.templateService.CreateJobFromTemplate(c.Request.Context(), userID, uint(templateID), &req)
+	if err != nil {
+		h.logger.Error("Failed to create job from template", "error", err)
+		response.Error(c, templateErrorStatus(err), "Failed to create job", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Job created from template", job)
+}
+
+func templateErrorStatus(err error) int {
+	switch {
+	case err.Error() == "template not found", err.Error() == "company not found":
+		return http.StatusNotFound
+	case strings.HasPrefix(err.Error(), "unauthorized"):
+		return http.StatusForbidden
+	case strings.HasPrefix(err.Error(), "failed"):
+		return http.StatusInternalServerError
+	default:
+		return http.StatusBadRequest
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"linked-clone/internal/domain/entities"
 	"linked-clone/internal/domain/repositories"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -58,7 +59,44 @@ func (r *applicationRepository) GetByJobID(ctx context.Context, jobID uint, limi
 }
 
 func (r *applicationRepository) Update(ctx context.Context, application *entities.Application) error {
-	return r.db.WithContext(ctx).Save(application).Error
+	return r.db.WithContext(ctx).Omit("User", "Job").Save(application).Error
+}
+
+// UpdateStatus saves the application only if it is still in status from, and
+// records the change in the same transaction. It reports false when another
+// update got there first.
+func (r *applicationRepository) UpdateStatus(ctx context.Context, application *entities.Application, from entities.ApplicationStatus, change *entities.ApplicationStatusHistory) (bool, error) {
+	updated := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entities.Application{}).
+			Where("id = ? AND status = ?", application.ID, from).
+			Updates(map[string]interface{}{
+				"status":            application.Status,
+				"withdrawn_at":      application.WithdrawnAt,
+				"withdrawal_reason": application.WithdrawalReason,
+				"updated_at":        time.Now(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		updated = true
+		return tx.Omit("ChangedByUser").Create(change).Error
+	})
+	return updated, err
+}
+
+func (r *applicationRepository) GetStatusHistory(ctx context.Context, applicationID uint) ([]*entities.ApplicationStatusHistory, error) {
+	var history []*entities.ApplicationStatusHistory
+	err := r.db.WithContext(ctx).
+		Preload("ChangedByUser").
+		Where("application_id = ?", applicationID).
+		Order("created_at ASC, id ASC").
+		Find(&history).Error
+	return history, err
 }
 
 func (r *applicationRepository) Delete(ctx context.Context, id uint) error {
@@ -69,6 +107,7 @@ func (r *applicationRepository) FindByUserAndJob(ctx context.Context, userID, jo
 	var application entities.Application
 	err := r.db.WithContext(ctx).
 		Where("user_id = ? AND job_id = ?", userID, jobID).
+		Order("created_at DESC").
 		First(&application).Error
 	if err != nil {
 		return nil, err
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type jobAlertRepository struct {
+	db *gorm.DB
+}
+
+func NewJobAlertRepository(db *gorm.DB) repositories.JobAlertRepository {
+	return &jobAlertRepository{db: db}
+}
+
+func (r *jobAlertRepository) Create(ctx context.Context, alert *entities.JobAlert) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Create(alert).Error
+}
+
+func (r *jobAlertRepository) GetByID(ctx context.Context, id uint) (*entities.JobAlert, error) {
+	var alert entities.JobAlert
+	if err := r.db.WithContext(ctx).First(&alert, id).Error; err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
+func (r *jobAlertRepository) GetByUserID(ctx context.Context, userID uint) ([]*entities.JobAlert, error) {
+	var alerts []*entities.JobAlert
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&alerts).Error
+	return alerts, err
+}
+
+func (r *jobAlertRepository) GetByUnsubscribeToken(ctx context.Context, token string) (*entities.JobAlert, error) {
+	var alert entities.JobAlert
+	if err := r.db.WithContext(ctx).Where("unsubscribe_token = ?", token).First(&alert).Error; err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
+func (r *jobAlertRepository) CountByUserID(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&entities.JobAlert{}).Where("user_id = ?", userID).Count(&count).Error
+	return count, err
+}
+
+func (r *jobAlertRepository) Update(ctx context.Context, alert *entities.JobAlert) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Save(alert).Error
+}
+
+func (r *jobAlertRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&entities.JobAlert{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// GetDue returns active alerts whose next run has passed, skipping owners who
+// are deleted or have not verified their email address.
+func (r *jobAlertRepository) GetDue(ctx context.Context, now time.Time, limit int) ([]*entities.JobAlert, error) {
+	var alerts []*entities.JobAlert
+	err := r.db.WithContext(ctx).
+		Joins("JOIN users ON users.id = job_alerts.user_id AND users.deleted_at IS NULL AND users.email_verified = true").
+		Where("job_alerts.is_active = true AND job_alerts.next_run_at <= ?", now).
+		Preload("User").
+		Order("job_alerts.next_run_at ASC").
+		Limit(limit).
+		Find(&alerts).Error
+	return alerts, err
+}
+
+func (r *jobAlertRepository) MarkChecked(ctx context.Context, id uint, checkedAt, nextRunAt time.Time, sent bool) error {
+	updates := map[string]interface{}{
+		"last_checked_at": checkedAt,
+		"next_run_at":     nextRunAt,
+	}
+	if sent {
+		updates["last_sent_at"] = checkedAt
+	}
+	return r.db.WithContext(ctx).Model(&entities.JobAlert{}).Where("id = ?", id).UpdateColumns(updates).Error
+}
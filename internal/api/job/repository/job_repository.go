@@ -4,6 +4,8 @@ import (
 	"context"
 	"linked-clone/internal/domain/entities"
 	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/taxonomy"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -24,6 +26,8 @@ func (r *jobRepository) GetByID(ctx context.Context, id uint) (*entities.Job, er
 	var job entities.Job
 	err := r.db.WithContext(ctx).
 		Preload("User").
+		Preload("CompanyProfile").
+		Preload("Team").
 		First(&job, id).Error
 	if err != nil {
 		return nil, err
@@ -35,6 +39,7 @@ func (r *jobRepository) GetByUserID(ctx context.Context, userID uint, limit, off
 	var jobs []*entities.Job
 	err := r.db.WithContext(ctx).
 		Preload("User").
+		Preload("CompanyProfile").
 		Where("user_id = ?", userID).
 		Order("created_at DESC").
 		Limit(limit).
@@ -45,7 +50,8 @@ func (r *jobRepository) GetByUserID(ctx context.Context, userID uint, limit, off
 
 func (r *jobRepository) GetAll(ctx context.Context, filters map[string]interface{}, limit, offset int) ([]*entities.Job, error) {
 	var jobs []*entities.Job
-	query := r.db.WithContext(ctx).Preload("User").Where("is_active = true")
+	query := r.db.WithContext(ctx).Preload("User").Preload("CompanyProfile").Preload("Team").Where("is_active = true")
+	order := "created_at DESC"
 
 	for key, value := range filters {
 		switch key {
@@ -53,12 +59,22 @@ func (r *jobRepository) GetAll(ctx context.Context, filters map[string]interface
 			query = query.Where("job_type = ?", value)
 		case "experience_level":
 			query = query.Where("experience_level = ?", value)
+		case "team_id":
+			query = query.Where("team_id = ?", value)
 		case "location":
 			query = query.Where("location I LIKE ?", "%"+value.(string)+"%")
+		case "location_id":
+			query = query.Where("location_id IN (?)", r.db.Raw(taxonomy.LocationScopeSQL, value))
+		case "industry_id":
+			query = query.Where("industry_id = ?", value)
+		case "sort":
+			if value == "popular" {
+				order = "view_count DESC, created_at DESC"
+			}
 		}
 	}
 
-	err := query.Order("created_at DESC").
+	err := query.Order(order).
 		Limit(limit).
 		Offset(offset).
 		Find(&jobs).Error
@@ -73,19 +89,106 @@ func (r *jobRepository) Delete(ctx context.Context, id uint) error {
 	return r.db.WithContext(ctx).Delete(&entities.Job{}, id).Error
 }
 
+func (r *jobRepository) GetByCompanyIDsAndStatus(ctx context.Context, companyIDs []uint, status entities.JobStatus, limit, offset int) ([]*entities.Job, error) {
+	var jobs []*entities.Job
+	if len(companyIDs) == 0 {
+		return jobs, nil
+	}
+
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Preload("CompanyProfile").
+		Where("company_id IN ? AND status = ?", companyIDs, status).
+		Order("updated_at ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&jobs).Error
+	return jobs, err
+}
+
+func (r *jobRepository) GetActiveByPostersOrCompanies(ctx context.Context, posterIDs, companyIDs []uint, excludeUserID uint, limit, offset int) ([]*entities.Job, error) {
+	var jobs []*entities.Job
+	if len(posterIDs) == 0 && len(companyIDs) == 0 {
+		return jobs, nil
+	}
+
+	network := r.db.Where("1 = 0")
+	if len(posterIDs) > 0 {
+		network = network.Or("user_id IN ?", posterIDs)
+	}
+	if len(companyIDs) > 0 {
+		network = network.Or("company_id IN ?", companyIDs)
+	}
+
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Preload("CompanyProfile").
+		Where("is_active = ? AND status = ? AND user_id <> ?", true, entities.JobPublished, excludeUserID).
+		Where(network).
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&jobs).Error
+	return jobs, err
+}
+
 func (r *jobRepository) IncrementApplicationCount(ctx context.Context, jobID uint) error {
 	return r.db.WithContext(ctx).Model(&entities.Job{}).
 		Where("id = ?", jobID).
 		Update("application_count", gorm.Expr("application_count + 1")).Error
 }
 
-func (r *jobRepository) Search(ctx context.Context, query string, filters map[string]interface{}, limit, offset int) ([]*entities.Job, error) {
+func (r *jobRepository) IncrementViewCount(ctx context.Context, jobID uint) error {
+	return r.db.WithContext(ctx).Model(&entities.Job{}).
+		Where("id = ?", jobID).
+		UpdateColumn("view_count", gorm.Expr("view_count + 1")).Error
+}
+
+func (r *jobRepository) DeactivatePastDeadline(ctx context.Context, now time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&entities.Job{}).
+		Where("is_active = true AND application_deadline IS NOT NULL AND application_deadline <= ?", now).
+		Update("is_active", false)
+	return result.RowsAffected, result.Error
+}
+
+// GetByIDs returns the jobs in the order of ids, skipping any that no longer
+// exist.
+func (r *jobRepository) GetByIDs(ctx context.Context, ids []uint) ([]*entities.Job, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var found []*entities.Job
+	if err := r.db.WithContext(ctx).Preload("User").Preload("CompanyProfile").Where("id IN ?", ids).Find(&found).Error; err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uint]*entities.Job, len(found))
+	for _, job := range found {
+		byID[job.ID] = job
+	}
+
+	jobs := make([]*entities.Job, 0, len(found))
+	for _, id := range ids {
+		if job, ok := byID[id]; ok {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs, nil
+}
+
+// GetPublishedBetween returns open jobs published in (since, until] that match
+// the query and filters, oldest first so a digest reads in posting order.
+func (r *jobRepository) GetPublishedBetween(ctx context.Context, query string, filters map[string]interface{}, since, until time.Time, limit int) ([]*entities.Job, error) {
 	var jobs []*entities.Job
 	dbQuery := r.db.WithContext(ctx).
-		Preload("User").
-		Where("is_active = true").
-		Where("title I LIKE ? OR company I LIKE ? OR description I LIKE ?",
-			"%"+query+"%", "%"+query+"%", "%"+query+"%")
+		Where("is_active = true AND status = ?", entities.JobPublished).
+		Where("published_at > ? AND published_at <= ?", since, until)
+
+	if query != "" {
+		pattern := "%" + query + "%"
+		dbQuery = dbQuery.Where("title ILIKE ? OR company ILIKE ? OR description ILIKE ?", pattern, pattern, pattern)
+	}
 
 	for key, value := range filters {
 		switch key {
@@ -93,14 +196,23 @@ func (r *jobRepository) Search(ctx context.Context, query string, filters map[st
 			dbQuery = dbQuery.Where("job_type = ?", value)
 		case "experience_level":
 			dbQuery = dbQuery.Where("experience_level = ?", value)
+		case "company_id":
+			dbQuery = dbQuery.Where("company_id = ?", value)
 		case "location":
-			dbQuery = dbQuery.Where("location I LIKE ?", "%"+value.(string)+"%")
+			dbQuery = dbQuery.Where("location ILIKE ?", "%"+value.(string)+"%")
+		case "location_id":
+			dbQuery = dbQuery.Where("location_id IN (?)", r.db.Raw(taxonomy.LocationScopeSQL, value))
+		case "industry_id":
+			dbQuery = dbQuery.Where("industry_id = ?", value)
+		case "salary_min":
+			dbQuery = dbQuery.Where("COALESCE(salary_max, salary_min) >= ?", value)
+		case "exclude_user_id":
+			dbQuery = dbQuery.Where("user_id <> ?", value)
 		}
 	}
 
-	err := dbQuery.Order("created_at DESC").
+	err := dbQuery.Order("published_at ASC").
 		Limit(limit).
-		Offset(offset).
 		Find(&jobs).Error
 	return jobs, err
 }
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type jobTemplateRepository struct {
+	db *gorm.DB
+}
+
+func NewJobTemplateRepository(db *gorm.DB) repositories.JobTemplateRepository {
+	return &jobTemplateRepository{db: db}
+}
+
+func (r *jobTemplateRepository) Create(ctx context.Context, template *entities.JobTemplate) error {
+	return r.db.WithContext(ctx).Create(template).Error
+}
+
+func (r *jobTemplateRepository) GetByID(ctx context.Context, id uint) (*entities.JobTemplate, error) {
+	var template entities.JobTemplate
+	err := r.db.WithContext(ctx).
+		Preload("Company").
+		First(&template, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+func (r *jobTemplateRepository) GetPersonal(ctx context.Context, userID uint) ([]*entities.JobTemplate, error) {
+	var templates []*entities.JobTemplate
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND company_id IS NULL", userID).
+		Order("updated_at DESC").
+		Find(&templates).Error
+	return templates, err
+}
+
+func (r *jobTemplateRepository) GetByCompanyID(ctx context.Context, companyID uint) ([]*entities.JobTemplate, error) {
+	var templates []*entities.JobTemplate
+	err := r.db.WithContext(ctx).
+		Where("company_id = ?", companyID).
+		Order("updated_at DESC").
+		Find(&templates).Error
+	return templates, err
+}
+
+func (r *jobTemplateRepository) Update(ctx context.Context, template *entities.JobTemplate) error {
+	return r.db.WithContext(ctx).Omit("User", "Company").Save(template).Error
+}
+
+func (r *jobTemplateRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&entities.JobTemplate{}, id).Error
+}
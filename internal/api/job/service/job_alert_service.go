@@ -0,0 +1,348 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html/template"
+	"linked-clone/internal/api/job/dto"
+	taxonomyService "linked-clone/internal/api/taxonomy/service"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+	email "linked-clone/pkg/smtp"
+	"net/url"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	maxJobAlertsPerUser = 20
+	digestBatchSize     = 200
+	digestJobLimit      = 25
+)
+
+var digestTemplate = template.Must(template.New("job_alert_digest").Parse(`
+		<html>
+		<body>
+			<h2>New jobs for "{{.Name}}"</h2>
+			<p>Hi {{.FullName}},</p>
+			<p>These jobs were posted since your last update:</p>
+			<ul>
+			{{range .Jobs}}<li><strong>{{.Title}}</strong> at {{.Company}} &middot; {{.Location}}</li>
+			{{end}}</ul>
+			<p>You are receiving this {{.Frequency}} digest because you created a job alert.
+			<a href="{{.UnsubscribeURL}}">Unsubscribe from this alert</a>.</p>
+			<br>
+			<p>Best regards,<br>LinkedIn Clone Team</p>
+		</body>
+		</html>
+	`))
+
+type JobAlertService interface {
+	CreateAlert(ctx context.Context, userID uint, req *dto.JobAlertRequest) (*dto.JobAlertResponse, error)
+	GetAlerts(ctx context.Context, userID uint) ([]*dto.JobAlertResponse, error)
+	UpdateAlert(ctx context.Context, userID, alertID uint, req *dto.JobAlertRequest) (*dto.JobAlertResponse, error)
+	DeleteAlert(ctx context.Context, userID, alertID uint) error
+	Unsubscribe(ctx context.Context, token string) error
+	SendDigests(ctx context.Context, now time.Time) (int, error)
+}
+
+type jobAlertService struct {
+	alertRepo      repositories.JobAlertRepository
+	jobRepo        repositories.JobRepository
+	taxonomySvc    taxonomyService.TaxonomyService
+	emailService   email.EmailService
+	unsubscribeURL string
+	logger         logger.Logger
+}
+
+// NewJobAlertService takes the public API base URL that unsubscribe links in
+// digest emails point at.
+func NewJobAlertService(
+	alertRepo repositories.JobAlertRepository,
+	jobRepo repositories.JobRepository,
+	taxonomySvc taxonomyService.TaxonomyService,
+	emailService email.EmailService,
+	publicAPIURL string,
+	logger logger.Logger,
+) JobAlertService {
+	return &jobAlertService{
+		alertRepo:      alertRepo,
+		jobRepo:        jobRepo,
+		taxonomySvc:    taxonomySvc,
+		emailService:   emailService,
+		unsubscribeURL: strings.TrimSuffix(publicAPIURL, "/") + "/jobs/alerts/unsubscribe",
+		logger:         logger,
+	}
+}
+
+func (s *jobAlertService) CreateAlert(ctx context.Context, userID uint, req *dto.JobAlertRequest) (*dto.JobAlertResponse, error) {
+	count, err := s.alertRepo.CountByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to count job alerts", "user_id", userID, "error", err)
+		return nil, errors.New("failed to create job alert")
+	}
+	if count >= maxJobAlertsPerUser {
+		return nil, errors.New("job alert limit reached")
+	}
+
+	token, err := newUnsubscribeToken()
+	if err != nil {
+		s.logger.Error("Failed to generate unsubscribe token", "error", err)
+		return nil, errors.New("failed to create job alert")
+	}
+
+	now := time.Now()
+	alert := &entities.JobAlert{
+		UserID:           userID,
+		IsActive:         true,
+		UnsubscribeToken: token,
+		LastCheckedAt:    now,
+	}
+	if err := s.applyAlert(ctx, alert, req); err != nil {
+		return nil, err
+	}
+	alert.NextRunAt = now.Add(alert.Frequency.Interval())
+
+	if err := s.alertRepo.Create(ctx, alert); err != nil {
+		s.logger.Error("Failed to create job alert", "user_id", userID, "error", err)
+		return nil, errors.New("failed to create job alert")
+	}
+
+	return mapJobAlert(alert), nil
+}
+
+func (s *jobAlertService) GetAlerts(ctx context.Context, userID uint) ([]*dto.JobAlertResponse, error) {
+	alerts, err := s.alertRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get job alerts", "user_id", userID, "error", err)
+		return nil, errors.New("failed to get job alerts")
+	}
+
+	responses := make([]*dto.JobAlertResponse, 0, len(alerts))
+	for _, alert := range alerts {
+		responses = append(responses, mapJobAlert(alert))
+	}
+	return responses, nil
+}
+
+// UpdateAlert replaces the alert's search. Reactivating an alert starts its
+// window from now, so it does not send everything posted while it was off.
+func (s *jobAlertService) UpdateAlert(ctx context.Context, userID, alertID uint, req *dto.JobAlertRequest) (*dto.JobAlertResponse, error) {
+	alert, err := s.getOwnedAlert(ctx, userID, alertID)
+	if err != nil {
+		return nil, err
+	}
+
+	wasActive := alert.IsActive
+	if err := s.applyAlert(ctx, alert, req); err != nil {
+		return nil, err
+	}
+	if alert.IsActive && !wasActive {
+		alert.LastCheckedAt = time.Now()
+	}
+	alert.NextRunAt = alert.LastCheckedAt.Add(alert.Frequency.Interval())
+
+	if err := s.alertRepo.Update(ctx, alert); err != nil {
+		s.logger.Error("Failed to update job alert", "alert_id", alertID, "error", err)
+		return nil, errors.New("failed to update job alert")
+	}
+
+	return mapJobAlert(alert), nil
+}
+
+func (s *jobAlertService) DeleteAlert(ctx context.Context, userID, alertID uint) error {
+	if _, err := s.getOwnedAlert(ctx, userID, alertID); err != nil {
+		return err
+	}
+
+	if err := s.alertRepo.Delete(ctx, alertID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("job alert not found")
+		}
+		s.logger.Error("Failed to delete job alert", "alert_id", alertID, "error", err)
+		return errors.New("failed to delete job alert")
+	}
+	return nil
+}
+
+// Unsubscribe deactivates the alert behind a digest's unsubscribe link. It
+// keeps the alert so the owner can turn it back on.
+func (s *jobAlertService) Unsubscribe(ctx context.Context, token string) error {
+	if token == "" {
+		return errors.New("job alert not found")
+	}
+
+	alert, err := s.alertRepo.GetByUnsubscribeToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("job alert not found")
+		}
+		s.logger.Error("Failed to get job alert by token", "error", err)
+		return errors.New("failed to unsubscribe")
+	}
+	if !alert.IsActive {
+		return nil
+	}
+
+	alert.IsActive = false
+	if err := s.alertRepo.Update(ctx, alert); err != nil {
+		s.logger.Error("Failed to deactivate job alert", "alert_id", alert.ID, "error", err)
+		return errors.New("failed to unsubscribe")
+	}
+
+	s.logger.Info("Job alert unsubscribed", "alert_id", alert.ID, "user_id", alert.UserID)
+	return nil
+}
+
+// SendDigests handles one batch of due alerts. An alert whose email fails to
+// send keeps its window and is retried on the next run.
+func (s *jobAlertService) SendDigests(ctx context.Context, now time.Time) (int, error) {
+	alerts, err := s.alertRepo.GetDue(ctx, now, digestBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get due job alerts: %w", err)
+	}
+
+	sent := 0
+	for _, alert := range alerts {
+		jobs, err := s.jobRepo.GetPublishedBetween(ctx, alert.Query, alertFilters(alert), alert.LastCheckedAt, now, digestJobLimit)
+		if err != nil {
+			return sent, fmt.Errorf("failed to match jobs for alert %d: %w", alert.ID, err)
+		}
+
+		if len(jobs) > 0 {
+			if err := s.sendDigest(alert, jobs); err != nil {
+				s.logger.Error("Failed to send job alert digest", "alert_id", alert.ID, "error", err)
+				continue
+			}
+			sent++
+		}
+
+		if err := s.alertRepo.MarkChecked(ctx, alert.ID, now, now.Add(alert.Frequency.Interval()), len(jobs) > 0); err != nil {
+			return sent, fmt.Errorf("failed to update alert %d: %w", alert.ID, err)
+		}
+	}
+	return sent, nil
+}
+
+func (s *jobAlertService) sendDigest(alert *entities.JobAlert, jobs []*entities.Job) error {
+	var body bytes.Buffer
+	err := digestTemplate.Execute(&body, map[string]interface{}{
+		"Name":           alert.Name,
+		"FullName":       alert.User.FullName,
+		"Jobs":           jobs,
+		"Frequency":      string(alert.Frequency),
+		"UnsubscribeURL": s.unsubscribeURL + "?token=" + url.QueryEscape(alert.UnsubscribeToken),
+	})
+	if err != nil {
+		return err
+	}
+
+	subject := fmt.Sprintf("%d new jobs for \"%s\" - LinkedIn Clone", len(jobs), alert.Name)
+	if len(jobs) == 1 {
+		subject = fmt.Sprintf("1 new job for \"%s\" - LinkedIn Clone", alert.Name)
+	}
+	return s.emailService.SendEmail(alert.User.Email, subject, body.String())
+}
+
+func (s *jobAlertService) applyAlert(ctx context.Context, alert *entities.JobAlert, req *dto.JobAlertRequest) error {
+	if req.LocationID != nil {
+		if _, err := s.taxonomySvc.GetLocation(ctx, *req.LocationID); err != nil {
+			return err
+		}
+	}
+	if req.IndustryID != nil {
+		if _, err := s.taxonomySvc.GetIndustry(ctx, *req.IndustryID); err != nil {
+			return err
+		}
+	}
+
+	alert.Name = strings.TrimSpace(req.Name)
+	alert.Query = strings.TrimSpace(req.Query)
+	alert.Frequency = req.Frequency
+	alert.Filters = entities.JobAlertFilters{
+		Location:        strings.TrimSpace(req.Location),
+		LocationID:      req.LocationID,
+		IndustryID:      req.IndustryID,
+		CompanyID:       req.CompanyID,
+		JobType:         req.JobType,
+		ExperienceLevel: req.ExperienceLevel,
+		SalaryMin:       req.SalaryMin,
+	}
+	if req.IsActive != nil {
+		alert.IsActive = *req.IsActive
+	}
+	return nil
+}
+
+func (s *jobAlertService) getOwnedAlert(ctx context.Context, userID, alertID uint) (*entities.JobAlert, error) {
+	alert, err := s.alertRepo.GetByID(ctx, alertID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("job alert not found")
+		}
+		s.logger.Error("Failed to get job alert", "alert_id", alertID, "error", err)
+		return nil, errors.New("failed to get job alert")
+	}
+	if alert.UserID != userID {
+		return nil, errors.New("job alert not found")
+	}
+	return alert, nil
+}
+
+// alertFilters translates the stored filters into the keys understood by the
+// job repository. A location ID takes precedence over free text.
+func alertFilters(alert *entities.JobAlert) map[string]interface{} {
+	f := alert.Filters
+	filters := map[string]interface{}{"exclude_user_id": alert.UserID}
+	if f.LocationID != nil {
+		filters["location_id"] = *f.LocationID
+	} else if f.Location != "" {
+		filters["location"] = f.Location
+	}
+	if f.IndustryID != nil {
+		filters["industry_id"] = *f.IndustryID
+	}
+	if f.CompanyID != nil {
+		filters["company_id"] = *f.CompanyID
+	}
+	if f.JobType != "" {
+		filters["job_type"] = f.JobType
+	}
+	if f.ExperienceLevel != "" {
+		filters["experience_level"] = f.ExperienceLevel
+	}
+	if f.SalaryMin != nil {
+		filters["salary_min"] = *f.SalaryMin
+	}
+	return filters
+}
+
+func newUnsubscribeToken() (string, error) {
+	raw := make([]byte, 24)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(raw), nil
+}
+
+func mapJobAlert(alert *entities.JobAlert) *dto.JobAlertResponse {
+	return &dto.JobAlertResponse{
+		ID:         alert.ID,
+		Name:       alert.Name,
+		Query:      alert.Query,
+		Filters:    alert.Filters,
+		Frequency:  alert.Frequency,
+		IsActive:   alert.IsActive,
+		NextRunAt:  alert.NextRunAt,
+		LastSentAt: alert.LastSentAt,
+		CreatedAt:  alert.CreatedAt,
+		UpdatedAt:  alert.UpdatedAt,
+	}
+}
@@ -3,18 +3,41 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	emailDto "linked-clone/internal/api/email/dto"
+	emailService "linked-clone/internal/api/email/service"
 	"linked-clone/internal/api/job/dto"
+	notificationDto "linked-clone/internal/api/notification/dto"
+	notificationService "linked-clone/internal/api/notification/service"
+	searchService "linked-clone/internal/api/search/service"
+	taxonomyService "linked-clone/internal/api/taxonomy/service"
 	"linked-clone/internal/domain/entities"
 	"linked-clone/internal/domain/repositories"
+	"linked-clone/internal/infrastructure/search"
+	"linked-clone/pkg/affinity"
+	"linked-clone/pkg/counter"
+	"linked-clone/pkg/graph"
+	"linked-clone/pkg/i18n"
 	"linked-clone/pkg/logger"
-
+	email "linked-clone/pkg/smtp"
 	"linked-clone/pkg/storage"
 	"mime/multipart"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
 )
 
+const (
+	jobViewDedupWindow = 30 * time.Minute
+	maxNetworkReach    = 5000
+
+	NetworkReasonPostedByConnection = "posted_by_connection"
+	NetworkReasonConnectionWorksAt  = "connection_works_here"
+)
+
+var ErrApplicationDeadlinePassed = errors.New("application deadline has passed")
+
 type JobService interface {
 	CreateJob(ctx context.Context, userID uint, req *dto.CreateJobRequest) (*dto.JobResponse, error)
 	GetJob(ctx context.Context, id uint) (*dto.JobResponse, error)
@@ -22,17 +45,37 @@ type JobService interface {
 	GetAllJobs(ctx context.Context, filters map[string]interface{}, limit, offset int) ([]*dto.JobResponse, error)
 	UpdateJob(ctx context.Context, userID, jobID uint, req *dto.UpdateJobRequest) (*dto.JobResponse, error)
 	DeleteJob(ctx context.Context, userID, jobID uint) error
-	SearchJobs(ctx context.Context, query string, filters map[string]interface{}, limit, offset int) ([]*dto.JobResponse, error)
+	SearchJobs(ctx context.Context, viewerID uint, query string, filters map[string]interface{}, limit, offset int) ([]*dto.JobResponse, error)
+	GetNetworkHiring(ctx context.Context, userID uint, limit, offset int) ([]*dto.NetworkJobResponse, error)
 	ApplyJob(ctx context.Context, userID, jobID uint, req *dto.ApplyJobRequest, resume *multipart.FileHeader) (*dto.ApplicationResponse, error)
 	GetUserApplications(ctx context.Context, userID uint, limit, offset int) ([]*dto.ApplicationResponse, error)
 	GetJobApplications(ctx context.Context, userID, jobID uint, limit, offset int) ([]*dto.ApplicationResponse, error)
+	RecordView(ctx context.Context, jobID, viewerID uint, viewer string) (*dto.JobViewResponse, error)
+	SubmitJob(ctx context.Context, userID, jobID uint) (*dto.JobResponse, error)
+	GetPendingApprovals(ctx context.Context, userID uint, limit, offset int) ([]*dto.JobResponse, error)
+	ApproveJob(ctx context.Context, userID, jobID uint, req *dto.ReviewJobRequest) (*dto.JobResponse, error)
+	RejectJob(ctx context.Context, userID, jobID uint, req *dto.ReviewJobRequest) (*dto.JobResponse, error)
+	WithdrawApplication(ctx context.Context, userID, applicationID uint, req *dto.WithdrawApplicationRequest) (*dto.ApplicationResponse, error)
+	UpdateApplicationStatus(ctx context.Context, userID, applicationID uint, req *dto.UpdateApplicationStatusRequest) (*dto.ApplicationResponse, error)
+	GetApplicationHistory(ctx context.Context, userID, applicationID uint) ([]*dto.ApplicationStatusChangeResponse, error)
 }
 
 type jobService struct {
 	jobRepo         repositories.JobRepository
 	applicationRepo repositories.ApplicationRepository
 	userRepo        repositories.UserRepository
+	companyRepo     repositories.CompanyRepository
+	memberRepo      repositories.CompanyMemberRepository
+	teamRepo        repositories.CompanyTeamRepository
+	teamMemberRepo  repositories.TeamMemberRepository
+	notificationSvc notificationService.NotificationService
+	emailQueue      emailService.EmailQueueService
+	viewCounter     counter.ViewCounter
 	storageService  storage.StorageService
+	graph           graph.Graph
+	searchSvc       searchService.SearchService
+	taxonomySvc     taxonomyService.TaxonomyService
+	locales         *i18n.Bundle
 	logger          logger.Logger
 }
 
@@ -40,23 +83,88 @@ func NewJobService(
 	jobRepo repositories.JobRepository,
 	applicationRepo repositories.ApplicationRepository,
 	userRepo repositories.UserRepository,
+	companyRepo repositories.CompanyRepository,
+	memberRepo repositories.CompanyMemberRepository,
+	teamRepo repositories.CompanyTeamRepository,
+	teamMemberRepo repositories.TeamMemberRepository,
+	notificationSvc notificationService.NotificationService,
+	emailQueue emailService.EmailQueueService,
+	viewCounter counter.ViewCounter,
 	storageService storage.StorageService,
+	graph graph.Graph,
+	searchSvc searchService.SearchService,
+	taxonomySvc taxonomyService.TaxonomyService,
+	locales *i18n.Bundle,
 	logger logger.Logger,
 ) JobService {
 	return &jobService{
 		jobRepo:         jobRepo,
 		applicationRepo: applicationRepo,
 		userRepo:        userRepo,
+		companyRepo:     companyRepo,
+		memberRepo:      memberRepo,
+		teamRepo:        teamRepo,
+		teamMemberRepo:  teamMemberRepo,
+		notificationSvc: notificationSvc,
+		emailQueue:      emailQueue,
+		viewCounter:     viewCounter,
 		storageService:  storageService,
+		graph:           graph,
+		searchSvc:       searchSvc,
+		taxonomySvc:     taxonomySvc,
+		locales:         locales,
 		logger:          logger,
 	}
 }
 
 func (s *jobService) CreateJob(ctx context.Context, userID uint, req *dto.CreateJobRequest) (*dto.JobResponse, error) {
+	companyName := req.Company
+	industryID := req.IndustryID
+	status := entities.JobPublished
+	if req.CompanyID != nil {
+		company, err := s.companyRepo.GetByID(ctx, *req.CompanyID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errors.New("company not found")
+			}
+			s.logger.Error("Failed to get company", "error", err)
+			return nil, errors.New("failed to create job")
+		}
+
+		role, ok := s.companyRole(ctx, company, userID)
+		if !ok {
+			return nil, errors.New("unauthorized to post jobs for this company")
+		}
+		if role != entities.CompanyRoleAdmin {
+			status = entities.JobPendingApproval
+		}
+		companyName = company.Name
+		if industryID == nil {
+			industryID = company.IndustryID
+		}
+
+		if req.TeamID != nil {
+			if err := s.checkJobTeam(ctx, *req.TeamID, company.ID); err != nil {
+				return nil, err
+			}
+		}
+	} else if req.TeamID != nil {
+		return nil, errors.New("only company jobs can be scoped to a team")
+	}
+	if req.Draft {
+		status = entities.JobDraft
+	}
+
+	if req.ApplicationDeadline != nil && !req.ApplicationDeadline.After(time.Now()) {
+		return nil, errors.New("application deadline must be in the future")
+	}
+
 	job := &entities.Job{
 		UserID:          userID,
+		CompanyID:       req.CompanyID,
+		TeamID:          req.TeamID,
 		Title:           req.Title,
-		Company:         req.Company,
+		Company:         companyName,
 		Location:        req.Location,
 		Description:     req.Description,
 		Requirements:    req.Requirements,
@@ -64,7 +172,34 @@ func (s *jobService) CreateJob(ctx context.Context, userID uint, req *dto.Create
 		ExperienceLevel: req.ExperienceLevel,
 		SalaryMin:       req.SalaryMin,
 		SalaryMax:       req.SalaryMax,
-		IsActive:        true,
+		IsActive:        status == entities.JobPublished,
+		Status:          status,
+	}
+	if status == entities.JobPublished {
+		now := time.Now()
+		job.PublishedAt = &now
+	}
+	if req.ReapplyCooldownDays != nil {
+		job.ReapplyCooldownDays = *req.ReapplyCooldownDays
+	}
+	if req.ApplicationDeadline != nil {
+		deadline := req.ApplicationDeadline.UTC()
+		job.ApplicationDeadline = &deadline
+	}
+	if req.DeadlineTimezone != "" {
+		job.DeadlineTimezone = req.DeadlineTimezone
+	}
+	if req.ScreeningQuestions != nil {
+		job.ScreeningQuestions = toScreeningQuestions(req.ScreeningQuestions)
+	}
+
+	if err := s.applyJobLocation(ctx, job, req.LocationID, req.Location); err != nil {
+		return nil, err
+	}
+	if industryID != nil {
+		if err := s.applyJobIndustry(ctx, job, *industryID); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := s.jobRepo.Create(ctx, job); err != nil {
@@ -84,7 +219,7 @@ func (s *jobService) GetJob(ctx context.Context, id uint) (*dto.JobResponse, err
 		return nil, errors.New("failed to get job")
 	}
 
-	return s.mapJobToResponse(job), nil
+	return s.mapJobToResponse(ctx, job), nil
 }
 
 func (s *jobService) GetUserJobs(ctx context.Context, userID uint, limit, offset int) ([]*dto.JobResponse, error) {
@@ -95,7 +230,7 @@ func (s *jobService) GetUserJobs(ctx context.Context, userID uint, limit, offset
 
 	var responses []*dto.JobResponse
 	for _, job := range jobs {
-		responses = append(responses, s.mapJobToResponse(job))
+		responses = append(responses, s.mapJobToResponse(ctx, job))
 	}
 
 	return responses, nil
@@ -109,7 +244,7 @@ func (s *jobService) GetAllJobs(ctx context.Context, filters map[string]interfac
 
 	var responses []*dto.JobResponse
 	for _, job := range jobs {
-		responses = append(responses, s.mapJobToResponse(job))
+		responses = append(responses, s.mapJobToResponse(ctx, job))
 	}
 
 	return responses, nil
@@ -131,11 +266,31 @@ func (s *jobService) UpdateJob(ctx context.Context, userID, jobID uint, req *dto
 	if req.Title != "" {
 		job.Title = req.Title
 	}
-	if req.Company != "" {
+	if req.Company != "" && job.CompanyID == nil {
 		job.Company = req.Company
 	}
-	if req.Location != "" {
-		job.Location = req.Location
+	if req.ClearTeam {
+		job.TeamID = nil
+		job.Team = nil
+	} else if req.TeamID != nil {
+		if job.CompanyID == nil {
+			return nil, errors.New("only company jobs can be scoped to a team")
+		}
+		if err := s.checkJobTeam(ctx, *req.TeamID, *job.CompanyID); err != nil {
+			return nil, err
+		}
+		job.TeamID = req.TeamID
+		job.Team = nil
+	}
+	if req.LocationID != nil || req.Location != "" {
+		if err := s.applyJobLocation(ctx, job, req.LocationID, req.Location); err != nil {
+			return nil, err
+		}
+	}
+	if req.IndustryID != nil {
+		if err := s.applyJobIndustry(ctx, job, *req.IndustryID); err != nil {
+			return nil, err
+		}
 	}
 	if req.Description != "" {
 		job.Description = req.Description
@@ -155,7 +310,34 @@ func (s *jobService) UpdateJob(ctx context.Context, userID, jobID uint, req *dto
 	if req.SalaryMax != nil {
 		job.SalaryMax = req.SalaryMax
 	}
+	if req.ReapplyCooldownDays != nil {
+		job.ReapplyCooldownDays = *req.ReapplyCooldownDays
+	}
+	if req.ClearDeadline {
+		job.ApplicationDeadline = nil
+	} else if req.ApplicationDeadline != nil {
+		if !req.ApplicationDeadline.After(time.Now()) {
+			return nil, errors.New("application deadline must be in the future")
+		}
+		deadline := req.ApplicationDeadline.UTC()
+		job.ApplicationDeadline = &deadline
+	}
+	if req.DeadlineTimezone != "" {
+		job.DeadlineTimezone = req.DeadlineTimezone
+	}
+	if req.ScreeningQuestions != nil {
+		job.ScreeningQuestions = toScreeningQuestions(req.ScreeningQuestions)
+	}
 	if req.IsActive != nil {
+		if *req.IsActive && job.Status != entities.JobPublished {
+			return nil, errors.New("job is not published")
+		}
+		if *req.IsActive && job.HiddenAt != nil {
+			return nil, errors.New("job was hidden by a moderator")
+		}
+		if *req.IsActive && job.DeadlinePassed(time.Now()) {
+			return nil, ErrApplicationDeadlinePassed
+		}
 		job.IsActive = *req.IsActive
 	}
 
@@ -186,17 +368,104 @@ func (s *jobService) DeleteJob(ctx context.Context, userID, jobID uint) error {
 	return nil
 }
 
-func (s *jobService) SearchJobs(ctx context.Context, query string, filters map[string]interface{}, limit, offset int) ([]*dto.JobResponse, error) {
-	jobs, err := s.jobRepo.Search(ctx, query, filters, limit, offset)
+func (s *jobService) SearchJobs(ctx context.Context, viewerID uint, query string, filters map[string]interface{}, limit, offset int) ([]*dto.JobResponse, error) {
+	fetchLimit, fetchOffset := searchService.FetchWindow(limit, offset)
+	hits, err := s.searchSvc.Find(ctx, search.Query{Text: query, Types: []search.DocumentType{search.TypeJob}, Filters: filters, Limit: fetchLimit, Offset: fetchOffset})
+	if err != nil {
+		s.logger.Error("Failed to search jobs", "error", err)
+		return nil, errors.New("failed to search jobs")
+	}
+	jobs, err := s.jobRepo.GetByIDs(ctx, search.IDs(hits))
 	if err != nil {
+		s.logger.Error("Failed to load jobs for search", "error", err)
 		return nil, errors.New("failed to search jobs")
 	}
 
+	if offset == 0 {
+		recorded := make(map[string]string, len(filters))
+		for key, value := range filters {
+			recorded[key] = fmt.Sprint(value)
+		}
+		s.searchSvc.RecordSearch(ctx, viewerID, entities.SearchTypeJobs, query, recorded)
+	}
+
+	candidates := make([]searchService.Candidate, 0, len(jobs))
+	for _, job := range jobs {
+		candidates = append(candidates, searchService.Candidate{UserID: job.UserID, CompanyID: job.CompanyID})
+	}
+	jobs = searchService.Pick(jobs, s.searchSvc.Personalize(ctx, viewerID, candidates, limit, offset))
+
 	var responses []*dto.JobResponse
 	for _, job := range jobs {
-		responses = append(responses, s.mapJobToResponse(job))
+		responses = append(responses, s.mapJobToResponse(ctx, job))
+	}
+
+	return responses, nil
+}
+
+func (s *jobService) GetNetworkHiring(ctx context.Context, userID uint, limit, offset int) ([]*dto.NetworkJobResponse, error) {
+	first, err := s.graph.Connections(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to load connections", "error", err)
+		return nil, errors.New("failed to get network jobs")
+	}
+	second, err := s.graph.SecondDegree(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to load second-degree connections", "error", err)
+		return nil, errors.New("failed to get network jobs")
+	}
+	if len(second) > maxNetworkReach {
+		second = second[:maxNetworkReach]
+	}
+
+	degrees := make(map[uint]int, len(first)+len(second))
+	network := make([]uint, 0, len(first)+len(second))
+	for _, id := range first {
+		degrees[id] = graph.DegreeFirst
+		network = append(network, id)
+	}
+	for _, id := range second {
+		degrees[id] = graph.DegreeSecond
+		network = append(network, id)
+	}
+
+	members, err := s.memberRepo.GetByUserIDs(ctx, network)
+	if err != nil {
+		s.logger.Error("Failed to load network company memberships", "error", err)
+		return nil, errors.New("failed to get network jobs")
+	}
+	companyDegrees := make(map[uint]int)
+	for _, member := range members {
+		if current, ok := companyDegrees[member.CompanyID]; !ok || degrees[member.UserID] < current {
+			companyDegrees[member.CompanyID] = degrees[member.UserID]
+		}
+	}
+	companyIDs := make([]uint, 0, len(companyDegrees))
+	for id := range companyDegrees {
+		companyIDs = append(companyIDs, id)
 	}
 
+	jobs, err := s.jobRepo.GetActiveByPostersOrCompanies(ctx, network, companyIDs, userID, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to get network jobs", "error", err)
+		return nil, errors.New("failed to get network jobs")
+	}
+
+	responses := make([]*dto.NetworkJobResponse, 0, len(jobs))
+	for _, job := range jobs {
+		degree, reason := degrees[job.UserID], NetworkReasonPostedByConnection
+		if job.CompanyID != nil {
+			if companyDegree, ok := companyDegrees[*job.CompanyID]; ok && (degree == 0 || companyDegree < degree) {
+				degree, reason = companyDegree, NetworkReasonConnectionWorksAt
+			}
+		}
+
+		responses = append(responses, &dto.NetworkJobResponse{
+			Job:    s.mapJobToResponse(ctx, job),
+			Degree: degree,
+			Reason: reason,
+		})
+	}
 	return responses, nil
 }
 
@@ -210,13 +479,24 @@ func (s *jobService) ApplyJob(ctx context.Context, userID, jobID uint, req *dto.
 		return nil, errors.New("failed to get job")
 	}
 
+	if job.DeadlinePassed(time.Now()) {
+		return nil, ErrApplicationDeadlinePassed
+	}
+
 	if !job.IsActive {
 		return nil, errors.New("job is not active")
 	}
 
 	existingApp, _ := s.applicationRepo.FindByUserAndJob(ctx, userID, jobID)
 	if existingApp != nil {
-		return nil, errors.New("already applied to this job")
+		if existingApp.Status != entities.ApplicationWithdrawn || existingApp.WithdrawnAt == nil {
+			return nil, errors.New("already applied to this job")
+		}
+
+		reapplyAt := existingApp.WithdrawnAt.AddDate(0, 0, job.ReapplyCooldownDays)
+		if time.Now().Before(reapplyAt) {
+			return nil, fmt.Errorf("reapply cooldown active until %s", reapplyAt.Format(time.RFC3339))
+		}
 	}
 
 	var resumeURL string
@@ -242,13 +522,14 @@ func (s *jobService) ApplyJob(ctx context.Context, userID, jobID uint, req *dto.
 	}
 
 	s.jobRepo.IncrementApplicationCount(ctx, jobID)
+	s.recordInteraction(ctx, userID, job, affinity.WeightApply)
 
 	fullApp, err := s.applicationRepo.GetByID(ctx, application.ID)
 	if err != nil {
 		return nil, errors.New("failed to get application")
 	}
 
-	return s.mapApplicationToResponse(fullApp), nil
+	return s.mapApplicationToResponse(ctx, fullApp), nil
 }
 
 func (s *jobService) GetUserApplications(ctx context.Context, userID uint, limit, offset int) ([]*dto.ApplicationResponse, error) {
@@ -259,7 +540,7 @@ func (s *jobService) GetUserApplications(ctx context.Context, userID uint, limit
 
 	var responses []*dto.ApplicationResponse
 	for _, app := range applications {
-		responses = append(responses, s.mapApplicationToResponse(app))
+		responses = append(responses, s.mapApplicationToResponse(ctx, app))
 	}
 
 	return responses, nil
@@ -272,7 +553,7 @@ func (s *jobService) GetJobApplications(ctx context.Context, userID, jobID uint,
 		return nil, errors.New("job not found")
 	}
 
-	if job.UserID != userID {
+	if canView, _ := s.applicationAccess(ctx, job, userID); !canView {
 		return nil, errors.New("unauthorized to view applications")
 	}
 
@@ -283,28 +564,582 @@ func (s *jobService) GetJobApplications(ctx context.Context, userID, jobID uint,
 
 	var responses []*dto.ApplicationResponse
 	for _, app := range applications {
-		responses = append(responses, s.mapApplicationToResponse(app))
+		responses = append(responses, s.mapApplicationToResponse(ctx, app))
 	}
 
 	return responses, nil
 }
 
-func (s *jobService) mapJobToResponse(job *entities.Job) *dto.JobResponse {
+func (s *jobService) RecordView(ctx context.Context, jobID, viewerID uint, viewer string) (*dto.JobViewResponse, error) {
+	job, err := s.jobRepo.GetByID(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("job not found")
+		}
+		return nil, errors.New("failed to get job")
+	}
+
+	result := &dto.JobViewResponse{JobID: job.ID, ViewCount: job.ViewCount}
+	if !job.IsActive || job.UserID == viewerID {
+		return result, nil
+	}
+
+	if viewerID != 0 {
+		s.recordInteraction(ctx, viewerID, job, affinity.WeightView)
+	}
+
+	counted, err := s.viewCounter.RecordOnce(ctx, counter.ViewJob, job.ID, viewer, jobViewDedupWindow)
+	if err != nil {
+		s.logger.Error("Failed to record job view", "error", err, "job_id", job.ID)
+		return result, nil
+	}
+
+	if counted {
+		if err := s.jobRepo.IncrementViewCount(ctx, job.ID); err != nil {
+			s.logger.Error("Failed to increment job view count", "error", err, "job_id", job.ID)
+			return result, nil
+		}
+		result.Counted = true
+		result.ViewCount++
+	}
+
+	return result, nil
+}
+
+func (s *jobService) recordInteraction(ctx context.Context, userID uint, job *entities.Job, weight int64) {
+	s.searchSvc.RecordInteraction(ctx, userID, affinity.KindUser, job.UserID, weight)
+	if job.CompanyID != nil {
+		s.searchSvc.RecordInteraction(ctx, userID, affinity.KindCompany, *job.CompanyID, weight)
+	}
+}
+
+func (s *jobService) SubmitJob(ctx context.Context, userID, jobID uint) (*dto.JobResponse, error) {
+	job, err := s.jobRepo.GetByID(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("job not found")
+		}
+		return nil, errors.New("failed to get job")
+	}
+
+	if job.UserID != userID {
+		return nil, errors.New("unauthorized to update this job")
+	}
+
+	next := entities.JobPublished
+	if job.CompanyProfile != nil {
+		if role, ok := s.companyRole(ctx, job.CompanyProfile, userID); !ok || role != entities.CompanyRoleAdmin {
+			next = entities.JobPendingApproval
+		}
+	}
+
+	if !job.Status.CanTransitionTo(next) {
+		return nil, fmt.Errorf("cannot submit %s job", job.Status)
+	}
+
+	job.Status = next
+	job.IsActive = next == entities.JobPublished
+	if next == entities.JobPublished {
+		now := time.Now()
+		job.PublishedAt = &now
+	}
+
+	if err := s.jobRepo.Update(ctx, job); err != nil {
+		s.logger.Error("Failed to submit job", "error", err)
+		return nil, errors.New("failed to submit job")
+	}
+
+	return s.mapJobToResponse(ctx, job), nil
+}
+
+func (s *jobService) GetPendingApprovals(ctx context.Context, userID uint, limit, offset int) ([]*dto.JobResponse, error) {
+	companyIDs, err := s.memberRepo.GetCompanyIDsByRole(ctx, userID, entities.CompanyRoleAdmin)
+	if err != nil {
+		s.logger.Error("Failed to get administered companies", "error", err)
+		return nil, errors.New("failed to get pending approvals")
+	}
+
+	owned, err := s.companyRepo.GetByOwnerID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get owned companies", "error", err)
+		return nil, errors.New("failed to get pending approvals")
+	}
+	for _, company := range owned {
+		companyIDs = append(companyIDs, company.ID)
+	}
+
+	jobs, err := s.jobRepo.GetByCompanyIDsAndStatus(ctx, companyIDs, entities.JobPendingApproval, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to get pending jobs", "error", err)
+		return nil, errors.New("failed to get pending approvals")
+	}
+
+	responses := make([]*dto.JobResponse, 0, len(jobs))
+	for _, job := range jobs {
+		responses = append(responses, s.mapJobToResponse(ctx, job))
+	}
+
+	return responses, nil
+}
+
+func (s *jobService) ApproveJob(ctx context.Context, userID, jobID uint, req *dto.ReviewJobRequest) (*dto.JobResponse, error) {
+	job, err := s.reviewJob(ctx, userID, jobID, entities.JobPublished, req.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyJobReview(ctx, job, userID, entities.NotificationJobApproved,
+		fmt.Sprintf("Your job post %s was approved and is now live", job.Title))
+
+	return s.mapJobToResponse(ctx, job), nil
+}
+
+func (s *jobService) RejectJob(ctx context.Context, userID, jobID uint, req *dto.ReviewJobRequest) (*dto.JobResponse, error) {
+	job, err := s.reviewJob(ctx, userID, jobID, entities.JobRejected, req.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyJobReview(ctx, job, userID, entities.NotificationJobRejected,
+		fmt.Sprintf("Your job post %s was rejected", job.Title))
+
+	return s.mapJobToResponse(ctx, job), nil
+}
+
+func (s *jobService) reviewJob(ctx context.Context, userID, jobID uint, next entities.JobStatus, note string) (*entities.Job, error) {
+	job, err := s.jobRepo.GetByID(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("job not found")
+		}
+		return nil, errors.New("failed to get job")
+	}
+
+	if job.CompanyProfile == nil {
+		return nil, errors.New("job does not require approval")
+	}
+
+	if role, ok := s.companyRole(ctx, job.CompanyProfile, userID); !ok || role != entities.CompanyRoleAdmin {
+		return nil, errors.New("unauthorized to review this job")
+	}
+
+	if job.Status != entities.JobPendingApproval || !job.Status.CanTransitionTo(next) {
+		return nil, errors.New("job is not pending approval")
+	}
+
+	now := time.Now()
+	job.Status = next
+	job.IsActive = next == entities.JobPublished
+	job.ReviewerID = &userID
+	job.ReviewNote = note
+	job.ReviewedAt = &now
+	if next == entities.JobPublished {
+		job.PublishedAt = &now
+	}
+
+	if err := s.jobRepo.Update(ctx, job); err != nil {
+		s.logger.Error("Failed to review job", "error", err)
+		return nil, errors.New("failed to review job")
+	}
+
+	return job, nil
+}
+
+func (s *jobService) notifyJobReview(ctx context.Context, job *entities.Job, reviewerID uint, notificationType entities.NotificationType, message string) {
+	if s.notificationSvc == nil {
+		return
+	}
+
+	jobID := job.ID
+	if _, err := s.notificationSvc.Notify(ctx, &notificationDto.CreateNotificationRequest{
+		UserID:     job.UserID,
+		ActorID:    &reviewerID,
+		Type:       notificationType,
+		EntityType: "job",
+		EntityID:   &jobID,
+		Message:    message,
+	}); err != nil {
+		s.logger.Error("Failed to send job review notification", "error", err, "job_id", job.ID)
+	}
+}
+
+func (s *jobService) companyRole(ctx context.Context, company *entities.Company, userID uint) (entities.CompanyMemberRole, bool) {
+	return resolveCompanyRole(ctx, s.memberRepo, company, userID)
+}
+
+func resolveCompanyRole(ctx context.Context, memberRepo repositories.CompanyMemberRepository, company *entities.Company, userID uint) (entities.CompanyMemberRole, bool) {
+	if company.OwnerID == userID {
+		return entities.CompanyRoleAdmin, true
+	}
+
+	member, err := memberRepo.Get(ctx, company.ID, userID)
+	if err != nil {
+		return "", false
+	}
+	return member.Role, true
+}
+
+func (s *jobService) checkJobTeam(ctx context.Context, teamID, companyID uint) error {
+	team, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("team not found")
+		}
+		s.logger.Error("Failed to get team", "error", err)
+		return errors.New("failed to get team")
+	}
+	if team.CompanyID != companyID {
+		return errors.New("team does not belong to this company")
+	}
+	return nil
+}
+
+// applicationAccess reports whether userID may view a job's applications and
+// whether they may also move candidates through the pipeline. The poster and
+// company admins can do both. For a team-scoped job, team members can view,
+// and leads of the team or of its department can manage.
+func (s *jobService) applicationAccess(ctx context.Context, job *entities.Job, userID uint) (bool, bool) {
+	if job.UserID == userID {
+		return true, true
+	}
+	if job.CompanyID == nil {
+		return false, false
+	}
+
+	company := job.CompanyProfile
+	if company == nil {
+		loaded, err := s.companyRepo.GetByID(ctx, *job.CompanyID)
+		if err != nil {
+			return false, false
+		}
+		company = loaded
+	}
+	if role, ok := s.companyRole(ctx, company, userID); ok && role == entities.CompanyRoleAdmin {
+		return true, true
+	}
+
+	if job.TeamID == nil {
+		return false, false
+	}
+	if member, err := s.teamMemberRepo.Get(ctx, *job.TeamID, userID); err == nil {
+		return true, member.Role == entities.TeamRoleLead
+	}
+
+	team, err := s.teamRepo.GetByID(ctx, *job.TeamID)
+	if err != nil || team.ParentID == nil {
+		return false, false
+	}
+	if member, err := s.teamMemberRepo.Get(ctx, *team.ParentID, userID); err == nil && member.Role == entities.TeamRoleLead {
+		return true, true
+	}
+	return false, false
+}
+
+func (s *jobService) WithdrawApplication(ctx context.Context, userID, applicationID uint, req *dto.WithdrawApplicationRequest) (*dto.ApplicationResponse, error) {
+	application, err := s.applicationRepo.GetByID(ctx, applicationID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("application not found")
+		}
+		return nil, errors.New("failed to get application")
+	}
+
+	if application.UserID != userID {
+		return nil, errors.New("unauthorized to withdraw this application")
+	}
+
+	if !application.Status.CanTransitionTo(entities.ApplicationWithdrawn) {
+		return nil, fmt.Errorf("cannot withdraw %s application", application.Status)
+	}
+
+	from := application.Status
+	now := time.Now()
+	application.Status = entities.ApplicationWithdrawn
+	application.WithdrawnAt = &now
+	application.WithdrawalReason = req.Reason
+
+	updated, err := s.applicationRepo.UpdateStatus(ctx, application, from, &entities.ApplicationStatusHistory{
+		ApplicationID: application.ID,
+		FromStatus:    from,
+		ToStatus:      entities.ApplicationWithdrawn,
+		ChangedBy:     userID,
+	})
+	if err != nil {
+		s.logger.Error("Failed to withdraw application", "error", err)
+		return nil, errors.New("failed to withdraw application")
+	}
+	if !updated {
+		return nil, errors.New("application status changed, reload and try again")
+	}
+
+	s.notifyApplication(ctx, application.Job.UserID, userID, entities.NotificationApplicationWithdrawn, application,
+		fmt.Sprintf("%s withdrew their application for %s", application.User.FullName, application.Job.Title))
+
+	return s.mapApplicationToResponse(ctx, application), nil
+}
+
+func (s *jobService) UpdateApplicationStatus(ctx context.Context, userID, applicationID uint, req *dto.UpdateApplicationStatusRequest) (*dto.ApplicationResponse, error) {
+	application, err := s.applicationRepo.GetByID(ctx, applicationID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("application not found")
+		}
+		return nil, errors.New("failed to get application")
+	}
+
+	if _, canManage := s.applicationAccess(ctx, &application.Job, userID); !canManage {
+		return nil, errors.New("unauthorized to update this application")
+	}
+
+	if !application.Status.CanTransitionTo(req.Status) {
+		return nil, fmt.Errorf("cannot change application from %s to %s", application.Status, req.Status)
+	}
+
+	from := application.Status
+	application.Status = req.Status
+
+	updated, err := s.applicationRepo.UpdateStatus(ctx, application, from, &entities.ApplicationStatusHistory{
+		ApplicationID: application.ID,
+		FromStatus:    from,
+		ToStatus:      req.Status,
+		ChangedBy:     userID,
+		Note:          req.Note,
+	})
+	if err != nil {
+		s.logger.Error("Failed to update application status", "error", err)
+		return nil, errors.New("failed to update application status")
+	}
+	if !updated {
+		return nil, errors.New("application status changed, reload and try again")
+	}
+
+	// The applicant reads these later, so they are written in the default
+	// locale rather than the one the hiring team's request came in.
+	statusLabel := s.locales.Label(s.locales.Default(), "application_status", string(req.Status))
+	s.notifyApplication(ctx, application.UserID, userID, entities.NotificationApplicationUpdated, application,
+		fmt.Sprintf("Your application for %s was moved to %s", application.Job.Title, statusLabel))
+	s.emailApplicationStatus(ctx, application)
+
+	return s.mapApplicationToResponse(ctx, application), nil
+}
+
+// GetApplicationHistory returns the status timeline. The applicant sees the
+// stages only; notes and who made each change stay with the hiring side.
+func (s *jobService) GetApplicationHistory(ctx context.Context, userID, applicationID uint) ([]*dto.ApplicationStatusChangeResponse, error) {
+	application, err := s.applicationRepo.GetByID(ctx, applicationID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("application not found")
+		}
+		return nil, errors.New("failed to get application")
+	}
+
+	isApplicant := application.UserID == userID
+	if !isApplicant {
+		if canView, _ := s.applicationAccess(ctx, &application.Job, userID); !canView {
+			return nil, errors.New("unauthorized to view this application")
+		}
+	}
+
+	history, err := s.applicationRepo.GetStatusHistory(ctx, applicationID)
+	if err != nil {
+		s.logger.Error("Failed to get application history", "error", err)
+		return nil, errors.New("failed to get application history")
+	}
+
+	locale := i18n.FromContext(ctx)
+	responses := make([]*dto.ApplicationStatusChangeResponse, 0, len(history))
+	for _, change := range history {
+		response := &dto.ApplicationStatusChangeResponse{
+			FromStatus:      change.FromStatus,
+			FromStatusLabel: s.locales.Label(locale, "application_status", string(change.FromStatus)),
+			ToStatus:        change.ToStatus,
+			ToStatusLabel:   s.locales.Label(locale, "application_status", string(change.ToStatus)),
+			CreatedAt:       change.CreatedAt,
+		}
+		if !isApplicant {
+			response.Note = change.Note
+			response.ChangedBy = &dto.UserInfo{
+				ID:       change.ChangedByUser.ID,
+				Username: change.ChangedByUser.Username,
+				FullName: change.ChangedByUser.FullName,
+			}
+		}
+		responses = append(responses, response)
+	}
+
+	return responses, nil
+}
+
+func (s *jobService) emailApplicationStatus(ctx context.Context, application *entities.Application) {
+	if s.emailQueue == nil || application.User.Email == "" {
+		return
+	}
+
+	subject, body, err := email.Render(email.TemplateApplicationStatus, map[string]string{
+		"full_name": application.User.FullName,
+		"job_title": application.Job.Title,
+		"company":   application.Job.Company,
+		"status":    s.locales.Label(s.locales.Default(), "application_status", string(application.Status)),
+	})
+	if err != nil {
+		s.logger.Error("Failed to render application status email", "error", err, "application_id", application.ID)
+		return
+	}
+
+	userID := application.UserID
+	if _, err := s.emailQueue.Enqueue(ctx, &emailDto.EnqueueEmailRequest{
+		UserID:    &userID,
+		Kind:      entities.OutboundEmailApplicationStatus,
+		Recipient: application.User.Email,
+		Subject:   subject,
+		Body:      body,
+	}); err != nil {
+		s.logger.Error("Failed to queue application status email", "error", err, "application_id", application.ID)
+	}
+}
+
+func (s *jobService) notifyApplication(ctx context.Context, recipientID, actorID uint, notificationType entities.NotificationType, application *entities.Application, message string) {
+	if s.notificationSvc == nil || recipientID == 0 {
+		return
+	}
+
+	entityID := application.ID
+	if _, err := s.notificationSvc.Notify(ctx, &notificationDto.CreateNotificationRequest{
+		UserID:     recipientID,
+		ActorID:    &actorID,
+		Type:       notificationType,
+		EntityType: "application",
+		EntityID:   &entityID,
+		Message:    message,
+	}); err != nil {
+		s.logger.Error("Failed to send application notification", "error", err, "application_id", application.ID)
+	}
+}
+
+func toScreeningQuestions(questions []dto.ScreeningQuestion) []entities.ScreeningQuestion {
+	result := make([]entities.ScreeningQuestion, 0, len(questions))
+	for _, q := range questions {
+		result = append(result, entities.ScreeningQuestion{
+			Question: q.Question,
+			Type:     q.Type,
+			Required: q.Required,
+		})
+	}
+	return result
+}
+
+func fromScreeningQuestions(questions []entities.ScreeningQuestion) []dto.ScreeningQuestion {
+	result := make([]dto.ScreeningQuestion, 0, len(questions))
+	for _, q := range questions {
+		result = append(result, dto.ScreeningQuestion{
+			Question: q.Question,
+			Type:     q.Type,
+			Required: q.Required,
+		})
+	}
+	return result
+}
+
+func deadlineCountdown(job *entities.Job, now time.Time) *dto.DeadlineCountdown {
+	if job.ApplicationDeadline == nil {
+		return nil
+	}
+
+	location, err := time.LoadLocation(job.DeadlineTimezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	remaining := job.ApplicationDeadline.Sub(now)
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	return &dto.DeadlineCountdown{
+		LocalDeadline:    job.ApplicationDeadline.In(location).Format(time.RFC3339),
+		SecondsRemaining: int64(remaining.Seconds()),
+		DaysRemaining:    int(remaining.Hours() / 24),
+		Passed:           job.DeadlinePassed(now),
+	}
+}
+
+// applyJobLocation stores the matched location's label when the location is
+// known, and the text as typed otherwise.
+func (s *jobService) applyJobLocation(ctx context.Context, job *entities.Job, locationID *uint, text string) error {
+	location, err := s.taxonomySvc.ResolveLocation(ctx, locationID, text)
+	if err != nil {
+		return err
+	}
+	if location == nil {
+		job.LocationID = nil
+		job.Location = strings.TrimSpace(text)
+		return nil
+	}
+	job.LocationID = &location.ID
+	job.Location = location.DisplayName()
+	return nil
+}
+
+func (s *jobService) applyJobIndustry(ctx context.Context, job *entities.Job, industryID uint) error {
+	if industryID == 0 {
+		job.IndustryID = nil
+		job.Industry = ""
+		return nil
+	}
+
+	industry, err := s.taxonomySvc.GetIndustry(ctx, industryID)
+	if err != nil {
+		return err
+	}
+	job.IndustryID = &industry.ID
+	job.Industry = industry.Name
+	return nil
+}
+
+func (s *jobService) mapJobToResponse(ctx context.Context, job *entities.Job) *dto.JobResponse {
+	locale := i18n.FromContext(ctx)
 	response := &dto.JobResponse{
-		ID:               job.ID,
-		Title:            job.Title,
-		Company:          job.Company,
-		Location:         job.Location,
-		Description:      job.Description,
-		Requirements:     job.Requirements,
-		JobType:          job.JobType,
-		ExperienceLevel:  job.ExperienceLevel,
-		SalaryMin:        job.SalaryMin,
-		SalaryMax:        job.SalaryMax,
-		IsActive:         job.IsActive,
-		ApplicationCount: job.ApplicationCount,
-		CreatedAt:        job.CreatedAt,
-		UpdatedAt:        job.UpdatedAt,
+		ID:                   job.ID,
+		Title:                job.Title,
+		Company:              job.Company,
+		CompanyID:            job.CompanyID,
+		TeamID:               job.TeamID,
+		Location:             job.Location,
+		LocationID:           job.LocationID,
+		Industry:             job.Industry,
+		IndustryID:           job.IndustryID,
+		Description:          job.Description,
+		Requirements:         job.Requirements,
+		JobType:              job.JobType,
+		JobTypeLabel:         s.locales.Label(locale, "job_type", string(job.JobType)),
+		ExperienceLevel:      job.ExperienceLevel,
+		ExperienceLevelLabel: s.locales.Label(locale, "experience_level", string(job.ExperienceLevel)),
+		SalaryMin:            job.SalaryMin,
+		SalaryMax:            job.SalaryMax,
+		IsActive:             job.IsActive,
+		Status:               job.Status,
+		StatusLabel:          s.locales.Label(locale, "job_status", string(job.Status)),
+		ReviewNote:           job.ReviewNote,
+		ReviewedAt:           job.ReviewedAt,
+		PublishedAt:          job.PublishedAt,
+		HiddenAt:             job.HiddenAt,
+		ApplicationCount:     job.ApplicationCount,
+		ViewCount:            job.ViewCount,
+		ReapplyCooldownDays:  job.ReapplyCooldownDays,
+		ApplicationDeadline:  job.ApplicationDeadline,
+		DeadlineTimezone:     job.DeadlineTimezone,
+		DeadlineCountdown:    deadlineCountdown(job, time.Now()),
+		ScreeningQuestions:   fromScreeningQuestions(job.ScreeningQuestions),
+		CreatedAt:            job.CreatedAt,
+		UpdatedAt:            job.UpdatedAt,
+	}
+
+	if job.CompanyProfile != nil {
+		response.CompanyVerified = job.CompanyProfile.IsVerified
+	}
+	if job.Team != nil {
+		response.TeamName = job.Team.Name
 	}
 
 	if job.User.ID != 0 {
@@ -319,14 +1154,17 @@ func (s *jobService) mapJobToResponse(job *entities.Job) *dto.JobResponse {
 	return response
 }
 
-func (s *jobService) mapApplicationToResponse(app *entities.Application) *dto.ApplicationResponse {
+func (s *jobService) mapApplicationToResponse(ctx context.Context, app *entities.Application) *dto.ApplicationResponse {
 	response := &dto.ApplicationResponse{
-		ID:          app.ID,
-		JobID:       app.JobID,
-		CoverLetter: app.CoverLetter,
-		Status:      app.Status,
-		AppliedAt:   app.AppliedAt,
-		CreatedAt:   app.CreatedAt,
+		ID:               app.ID,
+		JobID:            app.JobID,
+		CoverLetter:      app.CoverLetter,
+		Status:           app.Status,
+		StatusLabel:      s.locales.Label(i18n.FromContext(ctx), "application_status", string(app.Status)),
+		WithdrawnAt:      app.WithdrawnAt,
+		WithdrawalReason: app.WithdrawalReason,
+		AppliedAt:        app.AppliedAt,
+		CreatedAt:        app.CreatedAt,
 	}
 
 	if app.ResumeURL != "" {
@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/job/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+
+	"gorm.io/gorm"
+)
+
+type JobTemplateService interface {
+	CreateTemplate(ctx context.Context, userID uint, req *dto.CreateJobTemplateRequest) (*dto.JobTemplateResponse, error)
+	GetTemplate(ctx context.Context, userID, templateID uint) (*dto.JobTemplateResponse, error)
+	GetTemplates(ctx context.Context, userID uint, companyID *uint) ([]*dto.JobTemplateResponse, error)
+	UpdateTemplate(ctx context.Context, userID, templateID uint, req *dto.UpdateJobTemplateRequest) (*dto.JobTemplateResponse, error)
+	DeleteTemplate(ctx context.Context, userID, templateID uint) error
+	CreateJobFromTemplate(ctx context.Context, userID, templateID uint, req *dto.CreateJobFromTemplateRequest) (*dto.JobResponse, error)
+}
+
+type jobTemplateService struct {
+	templateRepo repositories.JobTemplateRepository
+	companyRepo  repositories.CompanyRepository
+	memberRepo   repositories.CompanyMemberRepository
+	jobService   JobService
+	logger       logger.Logger
+}
+
+func NewJobTemplateService(
+	templateRepo repositories.JobTemplateRepository,
+	companyRepo repositories.CompanyRepository,
+	memberRepo repositories.CompanyMemberRepository,
+	jobService JobService,
+	logger logger.Logger,
+) JobTemplateService {
+	return &jobTemplateService{
+		templateRepo: templateRepo,
+		companyRepo:  companyRepo,
+		memberRepo:   memberRepo,
+		jobService:   jobService,
+		logger:       logger,
+	}
+}
+
+func (s *jobTemplateService) CreateTemplate(ctx context.Context, userID uint, req *dto.CreateJobTemplateRequest) (*dto.JobTemplateResponse, error) {
+	if req.CompanyID != nil {
+		company, err := s.getCompany(ctx, *req.CompanyID)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := resolveCompanyRole(ctx, s.memberRepo, company, userID); !ok {
+			return nil, errors.New("unauthorized to manage templates for this company")
+		}
+	}
+
+	template := &entities.JobTemplate{
+		UserID:             userID,
+		CompanyID:          req.CompanyID,
+		Name:               req.Name,
+		Title:              req.Title,
+		Description:        req.Description,
+		Requirements:       req.Requirements,
+		JobType:            req.JobType,
+		ExperienceLevel:    req.ExperienceLevel,
+		ScreeningQuestions: toScreeningQuestions(req.ScreeningQuestions),
+	}
+
+	if err := s.templateRepo.Create(ctx, template); err != nil {
+		s.logger.Error("Failed to create job template", "error", err)
+		return nil, errors.New("failed to create template")
+	}
+
+	return s.mapTemplateToResponse(template), nil
+}
+
+func (s *jobTemplateService) GetTemplate(ctx context.Context, userID, templateID uint) (*dto.JobTemplateResponse, error) {
+	template, err := s.getTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := s.access(ctx, template, userID); !ok {
+		return nil, errors.New("template not found")
+	}
+
+	return s.mapTemplateToResponse(template), nil
+}
+
+func (s *jobTemplateService) GetTemplates(ctx context.Context, userID uint, companyID *uint) ([]*dto.JobTemplateResponse, error) {
+	var templates []*entities.JobTemplate
+	var err error
+
+	if companyID != nil {
+		company, err := s.getCompany(ctx, *companyID)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := resolveCompanyRole(ctx, s.memberRepo, company, userID); !ok {
+			return nil, errors.New("unauthorized to manage templates for this company")
+		}
+		templates, err = s.templateRepo.GetByCompanyID(ctx, *companyID)
+		if err != nil {
+			s.logger.Error("Failed to get company job templates", "error", err)
+			return nil, errors.New("failed to get templates")
+		}
+	} else {
+		templates, err = s.templateRepo.GetPersonal(ctx, userID)
+		if err != nil {
+			s.logger.Error("Failed to get job templates", "error", err)
+			return nil, errors.New("failed to get templates")
+		}
+	}
+
+	responses := make([]*dto.JobTemplateResponse, 0, len(templates))
+	for _, template := range templates {
+		responses = append(responses, s.mapTemplateToResponse(template))
+	}
+
+	return responses, nil
+}
+
+func (s *jobTemplateService) UpdateTemplate(ctx context.Context, userID, templateID uint, req *dto.UpdateJobTemplateRequest) (*dto.JobTemplateResponse, error) {
+	template, err := s.getEditableTemplate(ctx, userID, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != "" {
+		template.Name = req.Name
+	}
+	if req.Title != "" {
+		template.Title = req.Title
+	}
+	if req.Description != "" {
+		template.Description = req.Description
+	}
+	if req.Requirements != nil {
+		template.Requirements = *req.Requirements
+	}
+	if req.JobType != nil {
+		template.JobType = *req.JobType
+	}
+	if req.ExperienceLevel != nil {
+		template.ExperienceLevel = *req.ExperienceLevel
+	}
+	if req.ScreeningQuestions != nil {
+		template.ScreeningQuestions = toScreeningQuestions(req.ScreeningQuestions)
+	}
+
+	if err := s.templateRepo.Update(ctx, template); err != nil {
+		s.logger.Error("Failed to update job template", "error", err)
+		return nil, errors.New("failed to update template")
+	}
+
+	return s.mapTemplateToResponse(template), nil
+}
+
+func (s *jobTemplateService) DeleteTemplate(ctx context.Context, userID, templateID uint) error {
+	if _, err := s.getEditableTemplate(ctx, userID, templateID); err != nil {
+		return err
+	}
+
+	if err := s.templateRepo.Delete(ctx, templateID); err != nil {
+		s.logger.Error("Failed to delete job template", "error", err)
+		return errors.New("failed to delete template")
+	}
+
+	return nil
+}
+
+func (s *jobTemplateService) CreateJobFromTemplate(ctx context.Context, userID, templateID uint, req *dto.CreateJobFromTemplateRequest) (*dto.JobResponse, error) {
+	template, err := s.getTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := s.access(ctx, template, userID); !ok {
+		return nil, errors.New("template not found")
+	}
+
+	jobReq := &dto.CreateJobRequest{
+		Title:               template.Title,
+		Company:             req.Company,
+		CompanyID:           template.CompanyID,
+		Location:            req.Location,
+		LocationID:          req.LocationID,
+		IndustryID:          req.IndustryID,
+		Description:         template.Description,
+		Requirements:        template.Requirements,
+		JobType:             template.JobType,
+		ExperienceLevel:     template.ExperienceLevel,
+		SalaryMin:           req.SalaryMin,
+		SalaryMax:           req.SalaryMax,
+		ReapplyCooldownDays: req.ReapplyCooldownDays,
+		ApplicationDeadline: req.ApplicationDeadline,
+		DeadlineTimezone:    req.DeadlineTimezone,
+		ScreeningQuestions:  fromScreeningQuestions(template.ScreeningQuestions),
+		Draft:               req.Draft,
+	}
+	if req.JobType != nil {
+		jobReq.JobType = *req.JobType
+	}
+	if req.ExperienceLevel != nil {
+		jobReq.ExperienceLevel = *req.ExperienceLevel
+	}
+
+	if jobReq.CompanyID == nil && jobReq.Company == "" {
+		return nil, errors.New("company is required for personal templates")
+	}
+	if jobReq.JobType == "" || jobReq.ExperienceLevel == "" {
+		return nil, errors.New("job type and experience level are required")
+	}
+
+	return s.jobService.CreateJob(ctx, userID, jobReq)
+}
+
+func (s *jobTemplateService) access(ctx context.Context, template *entities.JobTemplate, userID uint) (entities.CompanyMemberRole, bool) {
+	if template.CompanyID == nil {
+		return entities.CompanyRoleAdmin, template.UserID == userID
+	}
+	if template.Company == nil {
+		return "", false
+	}
+	return resolveCompanyRole(ctx, s.memberRepo, template.Company, userID)
+}
+
+func (s *jobTemplateService) getEditableTemplate(ctx context.Context, userID, templateID uint) (*entities.JobTemplate, error) {
+	template, err := s.getTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	role, ok := s.access(ctx, template, userID)
+	if !ok {
+		return nil, errors.New("template not found")
+	}
+	if template.UserID != userID && role != entities.CompanyRoleAdmin {
+		return nil, errors.New("unauthorized to manage this template")
+	}
+
+	return template, nil
+}
+
+func (s *jobTemplateService) getTemplate(ctx context.Context, templateID uint) (*entities.JobTemplate, error) {
+	template, err := s.templateRepo.GetByID(ctx, templateID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("template not found")
+		}
+		s.logger.Error("Failed to get job template", "error", err)
+		return nil, errors.New("failed to get template")
+	}
+	return template, nil
+}
+
+func (s *jobTemplateService) getCompany(ctx context.Context, companyID uint) (*entities.Company, error) {
+	company, err := s.companyRepo.GetByID(ctx, companyID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("company not found")
+		}
+		s.logger.Error("Failed to get company", "error", err)
+		return nil, errors.New("failed to get company")
+	}
+	return company, nil
+}
+
+func (s *jobTemplateService) mapTemplateToResponse(template *entities.JobTemplate) *dto.JobTemplateResponse {
+	return &dto.JobTemplateResponse{
+		ID:                 template.ID,
+		UserID:             template.UserID,
+		CompanyID:          template.CompanyID,
+		Name:               template.Name,
+		Title:              template.Title,
+		Description:        template.Description,
+		Requirements:       template.Requirements,
+		JobType:            template.JobType,
+		ExperienceLevel:    template.ExperienceLevel,
+		ScreeningQuestions: fromScreeningQuestions(template.ScreeningQuestions),
+		CreatedAt:          template.CreatedAt,
+		UpdatedAt:          template.UpdatedAt,
+	}
+}
@@ -0,0 +1,73 @@
+package dto
+
+import (
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type CreateCourseRequest struct {
+	Title       string               `json:"title" validate:"required,max=200"`
+	Description string               `json:"description" validate:"omitempty,max=5000"`
+	Skill       string               `json:"skill" validate:"omitempty,max=50"`
+	Level       entities.CourseLevel `json:"level" validate:"omitempty,oneof=beginner intermediate advanced"`
+}
+
+type UpdateCourseRequest struct {
+	Title       string               `json:"title" validate:"omitempty,max=200"`
+	Description string               `json:"description" validate:"omitempty,max=5000"`
+	Skill       *string              `json:"skill" validate:"omitempty,max=50"`
+	Level       entities.CourseLevel `json:"level" validate:"omitempty,oneof=beginner intermediate advanced"`
+	IsPublished *bool                `json:"is_published"`
+}
+
+type LessonRequest struct {
+	Title           string `json:"title" validate:"required,max=200"`
+	Content         string `json:"content" validate:"omitempty,max=50000"`
+	VideoURL        string `json:"video_url" validate:"omitempty,url,max=500"`
+	DurationMinutes int    `json:"duration_minutes" validate:"min=0,max=600"`
+	Position        *int   `json:"position" validate:"omitempty,min=0"`
+}
+
+type LessonResponse struct {
+	ID              uint   `json:"id"`
+	Position        int    `json:"position"`
+	Title           string `json:"title"`
+	Content         string `json:"content,omitempty"`
+	VideoURL        string `json:"video_url,omitempty"`
+	DurationMinutes int    `json:"duration_minutes"`
+	Completed       bool   `json:"completed"`
+}
+
+type CourseResponse struct {
+	ID          uint                 `json:"id"`
+	Title       string               `json:"title"`
+	Description string               `json:"description,omitempty"`
+	Skill       string               `json:"skill,omitempty"`
+	Level       entities.CourseLevel `json:"level"`
+	IsPublished bool                 `json:"is_published"`
+	LessonCount int64                `json:"lesson_count"`
+	Lessons     []*LessonResponse    `json:"lessons,omitempty"`
+	Progress    *ProgressResponse    `json:"progress,omitempty"`
+	CreatedAt   time.Time            `json:"created_at"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+}
+
+type ProgressResponse struct {
+	CourseID         uint                 `json:"course_id"`
+	Enrolled         bool                 `json:"enrolled"`
+	CompletedLessons int64                `json:"completed_lessons"`
+	TotalLessons     int64                `json:"total_lessons"`
+	Percent          int                  `json:"percent"`
+	EnrolledAt       *time.Time           `json:"enrolled_at,omitempty"`
+	CompletedAt      *time.Time           `json:"completed_at,omitempty"`
+	Certificate      *CertificateResponse `json:"certificate,omitempty"`
+}
+
+type CertificateResponse struct {
+	Code        string    `json:"code"`
+	CourseID    uint      `json:"course_id"`
+	CourseTitle string    `json:"course_title"`
+	UserID      uint      `json:"user_id"`
+	FullName    string    `json:"full_name,omitempty"`
+	IssuedAt    time.Time `json:"issued_at"`
+}
@@ -0,0 +1,333 @@
+package handler
+
+import (
+	"linked-clone/internal/api/learning/dto"
+	"linked-clone/internal/api/learning/service"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/request"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LearningHandler struct {
+	learningService service.LearningService
+	validator       validation.Validator
+	logger          logger.Logger
+}
+
+func NewLearningHandler(learningService service.LearningService, validator validation.Validator, logger logger.Logger) *LearningHandler {
+	return &LearningHandler{
+		learningService: learningService,
+		validator:       validator,
+		logger:          logger,
+	}
+}
+
+func (h *LearningHandler) ListCourses(c *gin.Context) {
+	viewerID := middleware.GetUserID(c)
+	limit, offset := request.Pagination(c)
+
+	courses, err := h.learningService.ListCourses(c.Request.Context(), viewerID, c.Query("skill"), limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to list courses", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get courses", err.Error())
+		return
+	}
+
+	response.Success(c, courses)
+}
+
+func (h *LearningHandler) GetCourse(c *gin.Context) {
+	viewerID := middleware.GetUserID(c)
+
+	id, ok := request.ParseID(c, "id", "Invalid course ID")
+	if !ok {
+		return
+	}
+
+	course, err := h.learningService.GetCourse(c.Request.Context(), viewerID, id)
+	if err != nil {
+		h.logger.Error("Failed to get course", "error", err)
+		response.Error(c, learningErrorStatus(err), "Failed to get course", err.Error())
+		return
+	}
+
+	response.Success(c, course)
+}
+
+func (h *LearningHandler) Enroll(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	id, ok := request.ParseID(c, "id", "Invalid course ID")
+	if !ok {
+		return
+	}
+
+	progress, err := h.learningService.Enroll(c.Request.Context(), userID, id)
+	if err != nil {
+		h.logger.Error("Failed to enroll in course", "error", err)
+		response.Error(c, learningErrorStatus(err), "Failed to enroll in course", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Enrolled in course", progress)
+}
+
+func (h *LearningHandler) Unenroll(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	id, ok := request.ParseID(c, "id", "Invalid course ID")
+	if !ok {
+		return
+	}
+
+	if err := h.learningService.Unenroll(c.Request.Context(), userID, id); err != nil {
+		h.logger.Error("Failed to unenroll from course", "error", err)
+		response.Error(c, learningErrorStatus(err), "Failed to unenroll from course", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Unenrolled from course"})
+}
+
+func (h *LearningHandler) GetMyCourses(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	limit, offset := request.Pagination(c)
+
+	courses, err := h.learningService.GetMyCourses(c.Request.Context(), userID, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get enrolled courses", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get courses", err.Error())
+		return
+	}
+
+	response.Success(c, courses)
+}
+
+func (h *LearningHandler) GetProgress(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	id, ok := request.ParseID(c, "id", "Invalid course ID")
+	if !ok {
+		return
+	}
+
+	progress, err := h.learningService.GetProgress(c.Request.Context(), userID, id)
+	if err != nil {
+		h.logger.Error("Failed to get course progress", "error", err)
+		response.Error(c, learningErrorStatus(err), "Failed to get progress", err.Error())
+		return
+	}
+
+	response.Success(c, progress)
+}
+
+func (h *LearningHandler) CompleteLesson(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	id, ok := request.ParseID(c, "id", "Invalid course ID")
+	if !ok {
+		return
+	}
+
+	lessonID, ok := request.ParseID(c, "lessonId", "Invalid lesson ID")
+	if !ok {
+		return
+	}
+
+	progress, err := h.learningService.CompleteLesson(c.Request.Context(), userID, id, lessonID)
+	if err != nil {
+		h.logger.Error("Failed to complete lesson", "error", err)
+		response.Error(c, learningErrorStatus(err), "Failed to complete lesson", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Lesson completed", progress)
+}
+
+func (h *LearningHandler) VerifyCertificate(c *gin.Context) {
+	certificate, err := h.learningService.VerifyCertificate(c.Request.Context(), c.Param("code"))
+	if err != nil {
+		h.logger.Error("Failed to verify certificate", "error", err)
+		response.Error(c, learningErrorStatus(err), "Failed to verify certificate", err.Error())
+		return
+	}
+
+	response.Success(c, certificate)
+}
+
+func (h *LearningHandler) CreateCourse(c *gin.Context) {
+	adminID := middleware.GetUserID(c)
+
+	var req dto.CreateCourseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	course, err := h.learningService.CreateCourse(c.Request.Context(), adminID, &req)
+	if err != nil {
+		h.logger.Error("Failed to create course", "error", err)
+		response.Error(c, learningErrorStatus(err), "Failed to create course", err.Error())
+		return
+	}
+
+	response.CreatedWithMessage(c, "Course created", course)
+}
+
+func (h *LearningHandler) ListAllCourses(c *gin.Context) {
+	limit, offset := request.Pagination(c)
+
+	courses, err := h.learningService.ListAllCourses(c.Request.Context(), limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to list courses", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get courses", err.Error())
+		return
+	}
+
+	response.Success(c, courses)
+}
+
+func (h *LearningHandler) GetCourseDetail(c *gin.Context) {
+	id, ok := request.ParseID(c, "id", "Invalid course ID")
+	if !ok {
+		return
+	}
+
+	course, err := h.learningService.GetCourseDetail(c.Request.Context(), id)
+	if err != nil {
+		h.logger.Error("Failed to get course", "error", err)
+		response.Error(c, learningErrorStatus(err), "Failed to get course", err.Error())
+		return
+	}
+
+	response.Success(c, course)
+}
+
+func (h *LearningHandler) UpdateCourse(c *gin.Context) {
+	id, ok := request.ParseID(c, "id", "Invalid course ID")
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateCourseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	course, err := h.learningService.UpdateCourse(c.Request.Context(), id, &req)
+	if err != nil {
+		h.logger.Error("Failed to update course", "error", err)
+		response.Error(c, learningErrorStatus(err), "Failed to update course", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Course updated", course)
+}
+
+func (h *LearningHandler) AddLesson(c *gin.Context) {
+	id, ok := request.ParseID(c, "id", "Invalid course ID")
+	if !ok {
+		return
+	}
+
+	var req dto.LessonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	lesson, err := h.learningService.AddLesson(c.Request.Context(), id, &req)
+	if err != nil {
+		h.logger.Error("Failed to add lesson", "error", err)
+		response.Error(c, learningErrorStatus(err), "Failed to add lesson", err.Error())
+		return
+	}
+
+	response.CreatedWithMessage(c, "Lesson added", lesson)
+}
+
+func (h *LearningHandler) UpdateLesson(c *gin.Context) {
+	id, ok := request.ParseID(c, "id", "Invalid course ID")
+	if !ok {
+		return
+	}
+
+	lessonID, ok := request.ParseID(c, "lessonId", "Invalid lesson ID")
+	if !ok {
+		return
+	}
+
+	var req dto.LessonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	lesson, err := h.learningService.UpdateLesson(c.Request.Context(), id, lessonID, &req)
+	if err != nil {
+		h.logger.Error("Failed to update lesson", "error", err)
+		response.Error(c, learningErrorStatus(err), "Failed to update lesson", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Lesson updated", lesson)
+}
+
+func (h *LearningHandler) DeleteLesson(c *gin.Context) {
+	id, ok := request.ParseID(c, "id", "Invalid course ID")
+	if !ok {
+		return
+	}
+
+	lessonID, ok := request.ParseID(c, "lessonId", "Invalid lesson ID")
+	if !ok {
+		return
+	}
+
+	if err := h.learningService.DeleteLesson(c.Request.Context(), id, lessonID); err != nil {
+		h.logger.Error("Failed to delete lesson", "error", err)
+		response.Error(c, learningErrorStatus(err), "Failed to delete lesson", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Lesson deleted"})
+}
+
+func learningErrorStatus(err error) int {
+	switch err.Error() {
+	case "course not found", "lesson not found", "certificate not found":
+		return http.StatusNotFound
+	case "not enrolled in this course":
+		return http.StatusForbidden
+	case "course has no lessons":
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
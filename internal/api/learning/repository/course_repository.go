@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type courseRepository struct {
+	db *gorm.DB
+}
+
+func NewCourseRepository(db *gorm.DB) repositories.CourseRepository {
+	return &courseRepository{db: db}
+}
+
+func (r *courseRepository) Create(ctx context.Context, course *entities.Course) error {
+	return r.db.WithContext(ctx).Create(course).Error
+}
+
+func (r *courseRepository) GetByID(ctx context.Context, id uint) (*entities.Course, error) {
+	var course entities.Course
+	err := r.db.WithContext(ctx).First(&course, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &course, nil
+}
+
+func (r *courseRepository) List(ctx context.Context, publishedOnly bool, skill string, limit, offset int) ([]*entities.Course, error) {
+	query := r.db.WithContext(ctx)
+	if publishedOnly {
+		query = query.Where("is_published = ?", true)
+	}
+	if skill != "" {
+		query = query.Where("LOWER(skill) = LOWER(?)", skill)
+	}
+
+	var courses []*entities.Course
+	err := query.
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&courses).Error
+	return courses, err
+}
+
+func (r *courseRepository) Update(ctx context.Context, course *entities.Course) error {
+	return r.db.WithContext(ctx).Save(course).Error
+}
+
+func (r *courseRepository) CreateLesson(ctx context.Context, lesson *entities.Lesson) error {
+	return r.db.WithContext(ctx).Create(lesson).Error
+}
+
+func (r *courseRepository) GetLesson(ctx context.Context, id uint) (*entities.Lesson, error) {
+	var lesson entities.Lesson
+	err := r.db.WithContext(ctx).First(&lesson, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &lesson, nil
+}
+
+func (r *courseRepository) GetLessons(ctx context.Context, courseID uint) ([]*entities.Lesson, error) {
+	var lessons []*entities.Lesson
+	err := r.db.WithContext(ctx).
+		Where("course_id = ?", courseID).
+		Order("position ASC, id ASC").
+		Find(&lessons).Error
+	return lessons, err
+}
+
+func (r *courseRepository) UpdateLesson(ctx context.Context, lesson *entities.Lesson) error {
+	return r.db.WithContext(ctx).Save(lesson).Error
+}
+
+func (r *courseRepository) DeleteLesson(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&entities.Lesson{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *courseRepository) CountLessons(ctx context.Context, courseIDs []uint) (map[uint]int64, error) {
+	counts := make(map[uint]int64, len(courseIDs))
+	if len(courseIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		CourseID uint
+		Count    int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&entities.Lesson{}).
+		Select("course_id, COUNT(*) AS count").
+		Where("course_id IN ?", courseIDs).
+		Group("course_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.CourseID] = row.Count
+	}
+	return counts, nil
+}
@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type enrollmentRepository struct {
+	db *gorm.DB
+}
+
+func NewEnrollmentRepository(db *gorm.DB) repositories.EnrollmentRepository {
+	return &enrollmentRepository{db: db}
+}
+
+func (r *enrollmentRepository) Enroll(ctx context.Context, enrollment *entities.CourseEnrollment) error {
+	return r.db.WithContext(ctx).
+		Omit("Course").
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(enrollment).Error
+}
+
+// Unenroll drops the enrollment but keeps lesson completions, so progress is
+// restored if the user enrolls again.
+func (r *enrollmentRepository) Unenroll(ctx context.Context, userID, courseID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND course_id = ?", userID, courseID).
+		Delete(&entities.CourseEnrollment{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *enrollmentRepository) Get(ctx context.Context, userID, courseID uint) (*entities.CourseEnrollment, error) {
+	var enrollment entities.CourseEnrollment
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND course_id = ?", userID, courseID).
+		First(&enrollment).Error
+	if err != nil {
+		return nil, err
+	}
+	return &enrollment, nil
+}
+
+func (r *enrollmentRepository) GetByUserID(ctx context.Context, userID uint, limit, offset int) ([]*entities.CourseEnrollment, error) {
+	var enrollments []*entities.CourseEnrollment
+	err := r.db.WithContext(ctx).
+		Preload("Course").
+		Where("user_id = ?", userID).
+		Order("completed_at IS NULL DESC, enrolled_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&enrollments).Error
+	return enrollments, err
+}
+
+func (r *enrollmentRepository) GetByCourseIDs(ctx context.Context, userID uint, courseIDs []uint) (map[uint]*entities.CourseEnrollment, error) {
+	byCourse := make(map[uint]*entities.CourseEnrollment, len(courseIDs))
+	if len(courseIDs) == 0 {
+		return byCourse, nil
+	}
+
+	var enrollments []*entities.CourseEnrollment
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND course_id IN ?", userID, courseIDs).
+		Find(&enrollments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, enrollment := range enrollments {
+		byCourse[enrollment.CourseID] = enrollment
+	}
+	return byCourse, nil
+}
+
+func (r *enrollmentRepository) CompleteLesson(ctx context.Context, completion *entities.LessonCompletion) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(completion).Error
+}
+
+// GetCompletedLessonIDs returns the completed lessons that are still part of
+// the course.
+func (r *enrollmentRepository) GetCompletedLessonIDs(ctx context.Context, userID, courseID uint) ([]uint, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).
+		Model(&entities.LessonCompletion{}).
+		Joins("JOIN lessons ON lessons.id = lesson_completions.lesson_id AND lessons.deleted_at IS NULL").
+		Where("lesson_completions.user_id = ? AND lesson_completions.course_id = ?", userID, courseID).
+		Pluck("lesson_completions.lesson_id", &ids).Error
+	return ids, err
+}
+
+func (r *enrollmentRepository) CountCompletedLessons(ctx context.Context, userID uint, courseIDs []uint) (map[uint]int64, error) {
+	counts := make(map[uint]int64, len(courseIDs))
+	if len(courseIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		CourseID uint
+		Count    int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&entities.LessonCompletion{}).
+		Select("lesson_completions.course_id, COUNT(*) AS count").
+		Joins("JOIN lessons ON lessons.id = lesson_completions.lesson_id AND lessons.deleted_at IS NULL").
+		Where("lesson_completions.user_id = ? AND lesson_completions.course_id IN ?", userID, courseIDs).
+		Group("lesson_completions.course_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.CourseID] = row.Count
+	}
+	return counts, nil
+}
+
+// CompleteCourse marks the enrollment completed and issues the certificate
+// unless one already exists, returning whichever certificate is persisted.
+func (r *enrollmentRepository) CompleteCourse(ctx context.Context, userID, courseID uint, certificate *entities.CourseCertificate) (*entities.CourseCertificate, error) {
+	var persisted entities.CourseCertificate
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&entities.CourseEnrollment{}).
+			Where("user_id = ? AND course_id = ? AND completed_at IS NULL", userID, courseID).
+			Update("completed_at", certificate.IssuedAt).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Omit("Course", "User").
+			Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "user_id"}, {Name: "course_id"}},
+				DoNothing: true,
+			}).
+			Create(certificate).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("user_id = ? AND course_id = ?", userID, courseID).First(&persisted).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &persisted, nil
+}
+
+type certificateRepository struct {
+	db *gorm.DB
+}
+
+func NewCertificateRepository(db *gorm.DB) repositories.CertificateRepository {
+	return &certificateRepository{db: db}
+}
+
+func (r *certificateRepository) GetByUserID(ctx context.Context, userID uint) ([]*entities.CourseCertificate, error) {
+	var certificates []*entities.CourseCertificate
+	err := r.db.WithContext(ctx).
+		Preload("Course").
+		Where("user_id = ?", userID).
+		Order("issued_at DESC").
+		Find(&certificates).Error
+	return certificates, err
+}
+
+func (r *certificateRepository) GetByCode(ctx context.Context, code string) (*entities.CourseCertificate, error) {
+	var certificate entities.CourseCertificate
+	err := r.db.WithContext(ctx).
+		Preload("Course").
+		Preload("User").
+		Where("code = ?", code).
+		First(&certificate).Error
+	if err != nil {
+		return nil, err
+	}
+	return &certificate, nil
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/learning/dto"
+	"linked-clone/internal/domain/entities"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// CreateCourse starts the course as an unpublished draft so lessons can be
+// added before it appears in the catalog.
+func (s *learningService) CreateCourse(ctx context.Context, adminID uint, req *dto.CreateCourseRequest) (*dto.CourseResponse, error) {
+	level := req.Level
+	if level == "" {
+		level = entities.CourseBeginner
+	}
+
+	course := &entities.Course{
+		Title:       strings.TrimSpace(req.Title),
+		Description: req.Description,
+		Skill:       strings.TrimSpace(req.Skill),
+		Level:       level,
+		CreatedBy:   &adminID,
+	}
+	if err := s.courseRepo.Create(ctx, course); err != nil {
+		s.logger.Error("Failed to create course", "error", err)
+		return nil, errors.New("failed to create course")
+	}
+
+	s.logger.Info("Course created", "course_id", course.ID, "admin_id", adminID)
+
+	return mapCourse(course, 0), nil
+}
+
+func (s *learningService) ListAllCourses(ctx context.Context, limit, offset int) ([]*dto.CourseResponse, error) {
+	courses, err := s.courseRepo.List(ctx, false, "", limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list courses", "error", err)
+		return nil, errors.New("failed to get courses")
+	}
+
+	return s.mapCourses(ctx, 0, courses)
+}
+
+func (s *learningService) GetCourseDetail(ctx context.Context, id uint) (*dto.CourseResponse, error) {
+	course, err := s.getCourse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	lessons, err := s.courseRepo.GetLessons(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to get lessons", "course_id", id, "error", err)
+		return nil, errors.New("failed to get course")
+	}
+
+	resp := mapCourse(course, int64(len(lessons)))
+	for _, lesson := range lessons {
+		resp.Lessons = append(resp.Lessons, mapLesson(lesson, true))
+	}
+	return resp, nil
+}
+
+func (s *learningService) UpdateCourse(ctx context.Context, id uint, req *dto.UpdateCourseRequest) (*dto.CourseResponse, error) {
+	course, err := s.getCourse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Title != "" {
+		course.Title = strings.TrimSpace(req.Title)
+	}
+	if req.Description != "" {
+		course.Description = req.Description
+	}
+	if req.Skill != nil {
+		course.Skill = strings.TrimSpace(*req.Skill)
+	}
+	if req.Level != "" {
+		course.Level = req.Level
+	}
+	if req.IsPublished != nil {
+		course.IsPublished = *req.IsPublished
+	}
+
+	totals, err := s.courseRepo.CountLessons(ctx, []uint{id})
+	if err != nil {
+		s.logger.Error("Failed to count lessons", "course_id", id, "error", err)
+		return nil, errors.New("failed to update course")
+	}
+	if course.IsPublished && totals[id] == 0 {
+		return nil, errors.New("course has no lessons")
+	}
+
+	if err := s.courseRepo.Update(ctx, course); err != nil {
+		s.logger.Error("Failed to update course", "course_id", id, "error", err)
+		return nil, errors.New("failed to update course")
+	}
+
+	return mapCourse(course, totals[id]), nil
+}
+
+// AddLesson appends the lesson to the end of the course unless a position is
+// given.
+func (s *learningService) AddLesson(ctx context.Context, courseID uint, req *dto.LessonRequest) (*dto.LessonResponse, error) {
+	if _, err := s.getCourse(ctx, courseID); err != nil {
+		return nil, err
+	}
+
+	lesson := &entities.Lesson{
+		CourseID:        courseID,
+		Title:           strings.TrimSpace(req.Title),
+		Content:         req.Content,
+		VideoURL:        req.VideoURL,
+		DurationMinutes: req.DurationMinutes,
+	}
+	if req.Position != nil {
+		lesson.Position = *req.Position
+	} else {
+		lessons, err := s.courseRepo.GetLessons(ctx, courseID)
+		if err != nil {
+			s.logger.Error("Failed to get lessons", "course_id", courseID, "error", err)
+			return nil, errors.New("failed to add lesson")
+		}
+		if len(lessons) > 0 {
+			lesson.Position = lessons[len(lessons)-1].Position + 1
+		}
+	}
+
+	if err := s.courseRepo.CreateLesson(ctx, lesson); err != nil {
+		s.logger.Error("Failed to create lesson", "course_id", courseID, "error", err)
+		return nil, errors.New("failed to add lesson")
+	}
+
+	return mapLesson(lesson, true), nil
+}
+
+func (s *learningService) UpdateLesson(ctx context.Context, courseID, lessonID uint, req *dto.LessonRequest) (*dto.LessonResponse, error) {
+	lesson, err := s.lesson(ctx, courseID, lessonID)
+	if err != nil {
+		return nil, err
+	}
+
+	lesson.Title = strings.TrimSpace(req.Title)
+	lesson.Content = req.Content
+	lesson.VideoURL = req.VideoURL
+	lesson.DurationMinutes = req.DurationMinutes
+	if req.Position != nil {
+		lesson.Position = *req.Position
+	}
+
+	if err := s.courseRepo.UpdateLesson(ctx, lesson); err != nil {
+		s.logger.Error("Failed to update lesson", "lesson_id", lessonID, "error", err)
+		return nil, errors.New("failed to update lesson")
+	}
+
+	return mapLesson(lesson, true), nil
+}
+
+// DeleteLesson refuses to empty a published course; unpublish it first.
+func (s *learningService) DeleteLesson(ctx context.Context, courseID, lessonID uint) error {
+	course, err := s.getCourse(ctx, courseID)
+	if err != nil {
+		return err
+	}
+	if _, err := s.lesson(ctx, courseID, lessonID); err != nil {
+		return err
+	}
+
+	if course.IsPublished {
+		totals, err := s.courseRepo.CountLessons(ctx, []uint{courseID})
+		if err != nil {
+			s.logger.Error("Failed to count lessons", "course_id", courseID, "error", err)
+			return errors.New("failed to delete lesson")
+		}
+		if totals[courseID] <= 1 {
+			return errors.New("course has no lessons")
+		}
+	}
+
+	if err := s.courseRepo.DeleteLesson(ctx, lessonID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("lesson not found")
+		}
+		s.logger.Error("Failed to delete lesson", "lesson_id", lessonID, "error", err)
+		return errors.New("failed to delete lesson")
+	}
+	return nil
+}
+
+func (s *learningService) getCourse(ctx context.Context, id uint) (*entities.Course, error) {
+	course, err := s.courseRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("course not found")
+		}
+		s.logger.Error("Failed to get course", "course_id", id, "error", err)
+		return nil, errors.New("failed to get course")
+	}
+	return course, nil
+}
+
+func (s *learningService) lesson(ctx context.Context, courseID, lessonID uint) (*entities.Lesson, error) {
+	lesson, err := s.courseRepo.GetLesson(ctx, lessonID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("lesson not found")
+		}
+		s.logger.Error("Failed to get lesson", "lesson_id", lessonID, "error", err)
+		return nil, errors.New("failed to get lesson")
+	}
+	if lesson.CourseID != courseID {
+		return nil, errors.New("lesson not found")
+	}
+	return lesson, nil
+}
@@ -0,0 +1,410 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"linked-clone/internal/api/learning/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type LearningService interface {
+	ListCourses(ctx context.Context, viewerID uint, skill string, limit, offset int) ([]*dto.CourseResponse, error)
+	GetCourse(ctx context.Context, viewerID, id uint) (*dto.CourseResponse, error)
+	Enroll(ctx context.Context, userID, courseID uint) (*dto.ProgressResponse, error)
+	Unenroll(ctx context.Context, userID, courseID uint) error
+	GetMyCourses(ctx context.Context, userID uint, limit, offset int) ([]*dto.CourseResponse, error)
+	GetProgress(ctx context.Context, userID, courseID uint) (*dto.ProgressResponse, error)
+	CompleteLesson(ctx context.Context, userID, courseID, lessonID uint) (*dto.ProgressResponse, error)
+	VerifyCertificate(ctx context.Context, code string) (*dto.CertificateResponse, error)
+
+	CreateCourse(ctx context.Context, adminID uint, req *dto.CreateCourseRequest) (*dto.CourseResponse, error)
+	ListAllCourses(ctx context.Context, limit, offset int) ([]*dto.CourseResponse, error)
+	GetCourseDetail(ctx context.Context, id uint) (*dto.CourseResponse, error)
+	UpdateCourse(ctx context.Context, id uint, req *dto.UpdateCourseRequest) (*dto.CourseResponse, error)
+	AddLesson(ctx context.Context, courseID uint, req *dto.LessonRequest) (*dto.LessonResponse, error)
+	UpdateLesson(ctx context.Context, courseID, lessonID uint, req *dto.LessonRequest) (*dto.LessonResponse, error)
+	DeleteLesson(ctx context.Context, courseID, lessonID uint) error
+}
+
+type learningService struct {
+	courseRepo      repositories.CourseRepository
+	enrollmentRepo  repositories.EnrollmentRepository
+	certificateRepo repositories.CertificateRepository
+	logger          logger.Logger
+}
+
+func NewLearningService(
+	courseRepo repositories.CourseRepository,
+	enrollmentRepo repositories.EnrollmentRepository,
+	certificateRepo repositories.CertificateRepository,
+	logger logger.Logger,
+) LearningService {
+	return &learningService{
+		courseRepo:      courseRepo,
+		enrollmentRepo:  enrollmentRepo,
+		certificateRepo: certificateRepo,
+		logger:          logger,
+	}
+}
+
+func (s *learningService) ListCourses(ctx context.Context, viewerID uint, skill string, limit, offset int) ([]*dto.CourseResponse, error) {
+	courses, err := s.courseRepo.List(ctx, true, strings.TrimSpace(skill), limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list courses", "error", err)
+		return nil, errors.New("failed to get courses")
+	}
+
+	return s.mapCourses(ctx, viewerID, courses)
+}
+
+// GetCourse returns a published course with its syllabus. Lesson content is
+// only included for enrolled users.
+func (s *learningService) GetCourse(ctx context.Context, viewerID, id uint) (*dto.CourseResponse, error) {
+	course, err := s.publishedCourse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	lessons, err := s.courseRepo.GetLessons(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to get lessons", "course_id", id, "error", err)
+		return nil, errors.New("failed to get course")
+	}
+
+	var enrollment *entities.CourseEnrollment
+	completed := make(map[uint]bool)
+	if viewerID != 0 {
+		enrollment, err = s.enrollment(ctx, viewerID, id)
+		if err != nil {
+			return nil, err
+		}
+		completedIDs, err := s.enrollmentRepo.GetCompletedLessonIDs(ctx, viewerID, id)
+		if err != nil {
+			s.logger.Error("Failed to get completed lessons", "user_id", viewerID, "course_id", id, "error", err)
+			return nil, errors.New("failed to get course")
+		}
+		for _, lessonID := range completedIDs {
+			completed[lessonID] = true
+		}
+	}
+
+	resp := mapCourse(course, int64(len(lessons)))
+	for _, lesson := range lessons {
+		lessonResp := mapLesson(lesson, enrollment != nil)
+		lessonResp.Completed = completed[lesson.ID]
+		resp.Lessons = append(resp.Lessons, lessonResp)
+	}
+	if enrollment != nil {
+		resp.Progress, err = s.progress(ctx, enrollment, int64(len(completed)), int64(len(lessons)))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (s *learningService) Enroll(ctx context.Context, userID, courseID uint) (*dto.ProgressResponse, error) {
+	if _, err := s.publishedCourse(ctx, courseID); err != nil {
+		return nil, err
+	}
+
+	if err := s.enrollmentRepo.Enroll(ctx, &entities.CourseEnrollment{
+		UserID:     userID,
+		CourseID:   courseID,
+		EnrolledAt: time.Now(),
+	}); err != nil {
+		s.logger.Error("Failed to enroll in course", "user_id", userID, "course_id", courseID, "error", err)
+		return nil, errors.New("failed to enroll in course")
+	}
+
+	return s.GetProgress(ctx, userID, courseID)
+}
+
+func (s *learningService) Unenroll(ctx context.Context, userID, courseID uint) error {
+	if err := s.enrollmentRepo.Unenroll(ctx, userID, courseID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("not enrolled in this course")
+		}
+		s.logger.Error("Failed to unenroll from course", "user_id", userID, "course_id", courseID, "error", err)
+		return errors.New("failed to unenroll from course")
+	}
+	return nil
+}
+
+func (s *learningService) GetMyCourses(ctx context.Context, userID uint, limit, offset int) ([]*dto.CourseResponse, error) {
+	enrollments, err := s.enrollmentRepo.GetByUserID(ctx, userID, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to get enrollments", "user_id", userID, "error", err)
+		return nil, errors.New("failed to get courses")
+	}
+
+	courses := make([]*entities.Course, 0, len(enrollments))
+	for _, enrollment := range enrollments {
+		courses = append(courses, &enrollment.Course)
+	}
+	return s.mapCourses(ctx, userID, courses)
+}
+
+func (s *learningService) GetProgress(ctx context.Context, userID, courseID uint) (*dto.ProgressResponse, error) {
+	enrollment, err := s.enrollment(ctx, userID, courseID)
+	if err != nil {
+		return nil, err
+	}
+	if enrollment == nil {
+		return nil, errors.New("not enrolled in this course")
+	}
+
+	totals, err := s.courseRepo.CountLessons(ctx, []uint{courseID})
+	if err != nil {
+		s.logger.Error("Failed to count lessons", "course_id", courseID, "error", err)
+		return nil, errors.New("failed to get progress")
+	}
+	completed, err := s.enrollmentRepo.CountCompletedLessons(ctx, userID, []uint{courseID})
+	if err != nil {
+		s.logger.Error("Failed to count completed lessons", "user_id", userID, "course_id", courseID, "error", err)
+		return nil, errors.New("failed to get progress")
+	}
+
+	return s.progress(ctx, enrollment, completed[courseID], totals[courseID])
+}
+
+// CompleteLesson records a finished lesson. Finishing the last outstanding
+// lesson completes the course and issues its certificate; lessons added
+// afterwards show up in progress but never revoke a certificate.
+func (s *learningService) CompleteLesson(ctx context.Context, userID, courseID, lessonID uint) (*dto.ProgressResponse, error) {
+	enrollment, err := s.enrollment(ctx, userID, courseID)
+	if err != nil {
+		return nil, err
+	}
+	if enrollment == nil {
+		return nil, errors.New("not enrolled in this course")
+	}
+
+	if _, err := s.lesson(ctx, courseID, lessonID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := s.enrollmentRepo.CompleteLesson(ctx, &entities.LessonCompletion{
+		UserID:      userID,
+		LessonID:    lessonID,
+		CourseID:    courseID,
+		CompletedAt: now,
+	}); err != nil {
+		s.logger.Error("Failed to complete lesson", "user_id", userID, "lesson_id", lessonID, "error", err)
+		return nil, errors.New("failed to complete lesson")
+	}
+
+	if enrollment.CompletedAt == nil {
+		totals, err := s.courseRepo.CountLessons(ctx, []uint{courseID})
+		if err != nil {
+			s.logger.Error("Failed to count lessons", "course_id", courseID, "error", err)
+			return nil, errors.New("failed to complete lesson")
+		}
+		completed, err := s.enrollmentRepo.CountCompletedLessons(ctx, userID, []uint{courseID})
+		if err != nil {
+			s.logger.Error("Failed to count completed lessons", "user_id", userID, "course_id", courseID, "error", err)
+			return nil, errors.New("failed to complete lesson")
+		}
+
+		if totals[courseID] > 0 && completed[courseID] >= totals[courseID] {
+			code, err := certificateCode()
+			if err != nil {
+				s.logger.Error("Failed to generate certificate code", "error", err)
+				return nil, errors.New("failed to complete lesson")
+			}
+			if _, err := s.enrollmentRepo.CompleteCourse(ctx, userID, courseID, &entities.CourseCertificate{
+				UserID:   userID,
+				CourseID: courseID,
+				Code:     code,
+				IssuedAt: now,
+			}); err != nil {
+				s.logger.Error("Failed to complete course", "user_id", userID, "course_id", courseID, "error", err)
+				return nil, errors.New("failed to complete lesson")
+			}
+			s.logger.Info("Course completed", "user_id", userID, "course_id", courseID)
+		}
+	}
+
+	return s.GetProgress(ctx, userID, courseID)
+}
+
+func (s *learningService) VerifyCertificate(ctx context.Context, code string) (*dto.CertificateResponse, error) {
+	certificate, err := s.certificateRepo.GetByCode(ctx, strings.ToUpper(strings.TrimSpace(code)))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("certificate not found")
+		}
+		s.logger.Error("Failed to get certificate", "error", err)
+		return nil, errors.New("failed to get certificate")
+	}
+	if certificate.User.ID == 0 {
+		return nil, errors.New("certificate not found")
+	}
+
+	resp := mapCertificate(certificate)
+	resp.FullName = certificate.User.FullName
+	return resp, nil
+}
+
+func (s *learningService) publishedCourse(ctx context.Context, id uint) (*entities.Course, error) {
+	course, err := s.getCourse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !course.IsPublished {
+		return nil, errors.New("course not found")
+	}
+	return course, nil
+}
+
+func (s *learningService) enrollment(ctx context.Context, userID, courseID uint) (*entities.CourseEnrollment, error) {
+	enrollment, err := s.enrollmentRepo.Get(ctx, userID, courseID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		s.logger.Error("Failed to get enrollment", "user_id", userID, "course_id", courseID, "error", err)
+		return nil, errors.New("failed to get enrollment")
+	}
+	return enrollment, nil
+}
+
+func (s *learningService) progress(ctx context.Context, enrollment *entities.CourseEnrollment, completed, total int64) (*dto.ProgressResponse, error) {
+	enrolledAt := enrollment.EnrolledAt
+	resp := &dto.ProgressResponse{
+		CourseID:         enrollment.CourseID,
+		Enrolled:         true,
+		CompletedLessons: completed,
+		TotalLessons:     total,
+		Percent:          percent(completed, total),
+		EnrolledAt:       &enrolledAt,
+		CompletedAt:      enrollment.CompletedAt,
+	}
+
+	if enrollment.CompletedAt == nil {
+		return resp, nil
+	}
+	certificates, err := s.certificateRepo.GetByUserID(ctx, enrollment.UserID)
+	if err != nil {
+		s.logger.Error("Failed to get certificates", "user_id", enrollment.UserID, "error", err)
+		return nil, errors.New("failed to get progress")
+	}
+	for _, certificate := range certificates {
+		if certificate.CourseID == enrollment.CourseID {
+			resp.Certificate = mapCertificate(certificate)
+		}
+	}
+	return resp, nil
+}
+
+// mapCourses renders catalog entries with lesson counts and, for enrolled
+// viewers, their progress.
+func (s *learningService) mapCourses(ctx context.Context, viewerID uint, courses []*entities.Course) ([]*dto.CourseResponse, error) {
+	ids := make([]uint, 0, len(courses))
+	for _, course := range courses {
+		ids = append(ids, course.ID)
+	}
+
+	totals, err := s.courseRepo.CountLessons(ctx, ids)
+	if err != nil {
+		s.logger.Error("Failed to count lessons", "error", err)
+		return nil, errors.New("failed to get courses")
+	}
+
+	enrollments := make(map[uint]*entities.CourseEnrollment)
+	completed := make(map[uint]int64)
+	if viewerID != 0 {
+		if enrollments, err = s.enrollmentRepo.GetByCourseIDs(ctx, viewerID, ids); err != nil {
+			s.logger.Error("Failed to get enrollments", "user_id", viewerID, "error", err)
+			return nil, errors.New("failed to get courses")
+		}
+		if completed, err = s.enrollmentRepo.CountCompletedLessons(ctx, viewerID, ids); err != nil {
+			s.logger.Error("Failed to count completed lessons", "user_id", viewerID, "error", err)
+			return nil, errors.New("failed to get courses")
+		}
+	}
+
+	responses := make([]*dto.CourseResponse, 0, len(courses))
+	for _, course := range courses {
+		resp := mapCourse(course, totals[course.ID])
+		if enrollment := enrollments[course.ID]; enrollment != nil {
+			enrolledAt := enrollment.EnrolledAt
+			resp.Progress = &dto.ProgressResponse{
+				CourseID:         course.ID,
+				Enrolled:         true,
+				CompletedLessons: completed[course.ID],
+				TotalLessons:     totals[course.ID],
+				Percent:          percent(completed[course.ID], totals[course.ID]),
+				EnrolledAt:       &enrolledAt,
+				CompletedAt:      enrollment.CompletedAt,
+			}
+		}
+		responses = append(responses, resp)
+	}
+	return responses, nil
+}
+
+func mapCertificate(certificate *entities.CourseCertificate) *dto.CertificateResponse {
+	return &dto.CertificateResponse{
+		Code:        certificate.Code,
+		CourseID:    certificate.CourseID,
+		CourseTitle: certificate.Course.Title,
+		UserID:      certificate.UserID,
+		IssuedAt:    certificate.IssuedAt,
+	}
+}
+
+func mapCourse(course *entities.Course, lessonCount int64) *dto.CourseResponse {
+	return &dto.CourseResponse{
+		ID:          course.ID,
+		Title:       course.Title,
+		Description: course.Description,
+		Skill:       course.Skill,
+		Level:       course.Level,
+		IsPublished: course.IsPublished,
+		LessonCount: lessonCount,
+		CreatedAt:   course.CreatedAt,
+		UpdatedAt:   course.UpdatedAt,
+	}
+}
+
+func mapLesson(lesson *entities.Lesson, withContent bool) *dto.LessonResponse {
+	resp := &dto.LessonResponse{
+		ID:              lesson.ID,
+		Position:        lesson.Position,
+		Title:           lesson.Title,
+		DurationMinutes: lesson.DurationMinutes,
+	}
+	if withContent {
+		resp.Content = lesson.Content
+		resp.VideoURL = lesson.VideoURL
+	}
+	return resp
+}
+
+func percent(completed, total int64) int {
+	if total == 0 {
+		return 0
+	}
+	if completed >= total {
+		return 100
+	}
+	return int(completed * 100 / total)
+}
+
+func certificateCode() (string, error) {
+	bytes := make([]byte, 8)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return strings.ToUpper(hex.EncodeToString(bytes)), nil
+}
@@ -0,0 +1,19 @@
+package dto
+
+type LocalesResponse struct {
+	Locale    string   `json:"locale"`
+	Default   string   `json:"default"`
+	Available []string `json:"available"`
+}
+
+type EnumLabel struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+}
+
+// EnumsResponse holds every labelled enum, keyed by group such as
+// "job_type", with the values in the order clients should offer them.
+type EnumsResponse struct {
+	Locale string                 `json:"locale"`
+	Enums  map[string][]EnumLabel `json:"enums"`
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"linked-clone/internal/api/locale/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/i18n"
+	"linked-clone/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// enumGroups lists the values of each labelled enum in display order. A
+// value added to an entity belongs here too, and in the locale files.
+var enumGroups = map[string][]string{
+	"job_type": {
+		string(entities.JobTypeFullTime),
+		string(entities.JobTypePartTime),
+		string(entities.JobTypeContract),
+		string(entities.JobTypeInternship),
+	},
+	"experience_level": {
+		string(entities.ExperienceEntry),
+		string(entities.ExperienceMid),
+		string(entities.ExperienceSenior),
+		string(entities.ExperienceExecutive),
+	},
+	"job_status": {
+		string(entities.JobDraft),
+		string(entities.JobPendingApproval),
+		string(entities.JobPublished),
+		string(entities.JobRejected),
+	},
+	"application_status": {
+		string(entities.ApplicationPending),
+		string(entities.ApplicationReviewed),
+		string(entities.ApplicationShortlisted),
+		string(entities.ApplicationInterviewing),
+		string(entities.ApplicationHired),
+		string(entities.ApplicationRejected),
+		string(entities.ApplicationWithdrawn),
+	},
+}
+
+type LocaleHandler struct {
+	bundle *i18n.Bundle
+}
+
+func NewLocaleHandler(bundle *i18n.Bundle) *LocaleHandler {
+	return &LocaleHandler{bundle: bundle}
+}
+
+func (h *LocaleHandler) GetLocales(c *gin.Context) {
+	response.Success(c, &dto.LocalesResponse{
+		Locale:    middleware.GetLocale(c),
+		Default:   h.bundle.Default(),
+		Available: h.bundle.Locales(),
+	})
+}
+
+func (h *LocaleHandler) GetEnums(c *gin.Context) {
+	locale := middleware.GetLocale(c)
+
+	enums := make(map[string][]dto.EnumLabel, len(enumGroups))
+	for group, values := range enumGroups {
+		labels := make([]dto.EnumLabel, 0, len(values))
+		for _, value := range values {
+			labels = append(labels, dto.EnumLabel{
+				Value: value,
+				Label: h.bundle.Label(locale, group, value),
+			})
+		}
+		enums[group] = labels
+	}
+
+	response.Success(c, &dto.EnumsResponse{Locale: locale, Enums: enums})
+}
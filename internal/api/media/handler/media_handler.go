@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"linked-clone/internal/api/media/service"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/media"
+	"linked-clone/pkg/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MediaHandler struct {
+	mediaService service.MediaService
+	logger       logger.Logger
+}
+
+func NewMediaHandler(mediaService service.MediaService, logger logger.Logger) *MediaHandler {
+	return &MediaHandler{
+		mediaService: mediaService,
+		logger:       logger,
+	}
+}
+
+func (h *MediaHandler) Redirect(c *gin.Context) {
+	url, err := h.mediaService.Resolve(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		response.Error(c, mediaErrorStatus(err), "Failed to get media", err.Error())
+		return
+	}
+
+	c.Header("Cache-Control", "private, no-store")
+	c.Redirect(http.StatusFound, url)
+}
+
+func mediaErrorStatus(err error) int {
+	switch err.Error() {
+	case media.ErrInvalidToken.Error(), media.ErrExpiredToken.Error():
+		return http.StatusForbidden
+	case "media not found":
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
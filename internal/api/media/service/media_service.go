@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/media"
+	"linked-clone/pkg/storage"
+	"time"
+)
+
+type MediaService interface {
+	Resolve(ctx context.Context, token string) (string, error)
+}
+
+type mediaService struct {
+	signer           *media.Signer
+	conversationRepo repositories.ConversationRepository
+	messageRepo      repositories.MessageRepository
+	storageService   storage.StorageService
+	urlTTL           time.Duration
+	logger           logger.Logger
+}
+
+func NewMediaService(
+	signer *media.Signer,
+	conversationRepo repositories.ConversationRepository,
+	messageRepo repositories.MessageRepository,
+	storageService storage.StorageService,
+	urlTTL time.Duration,
+	logger logger.Logger,
+) MediaService {
+	return &mediaService{
+		signer:           signer,
+		conversationRepo: conversationRepo,
+		messageRepo:      messageRepo,
+		storageService:   storageService,
+		urlTTL:           urlTTL,
+		logger:           logger,
+	}
+}
+
+// Resolve checks that the token's viewer may still see the object and returns
+// a presigned URL valid for urlTTL only, so a leaked link dies quickly.
+func (s *mediaService) Resolve(ctx context.Context, token string) (string, error) {
+	claims, err := s.signer.Verify(token)
+	if err != nil {
+		return "", err
+	}
+
+	var key string
+	switch claims.Kind {
+	case media.KindMessageAttachment:
+		key, err = s.messageAttachmentKey(ctx, claims)
+	default:
+		err = media.ErrInvalidToken
+	}
+	if err != nil {
+		return "", err
+	}
+
+	url, err := s.storageService.GeneratePresignedURL(key, s.urlTTL)
+	if err != nil {
+		s.logger.Error("Failed to generate media presigned URL", "error", err, "kind", claims.Kind)
+		return "", errors.New("failed to resolve media")
+	}
+	return url, nil
+}
+
+func (s *mediaService) messageAttachmentKey(ctx context.Context, claims *media.Claims) (string, error) {
+	message, err := s.messageRepo.GetByID(ctx, claims.ParentID)
+	if err != nil {
+		return "", errors.New("media not found")
+	}
+
+	participant, err := s.conversationRepo.GetParticipant(ctx, message.ConversationID, claims.ViewerID)
+	if err != nil {
+		return "", errors.New("media not found")
+	}
+	if participant.ClearedAt != nil && !message.CreatedAt.After(*participant.ClearedAt) {
+		return "", errors.New("media not found")
+	}
+
+	for _, attachment := range message.Attachments {
+		if attachment.ID != claims.ID {
+			continue
+		}
+		if claims.Thumbnail {
+			if attachment.ThumbnailKey == "" {
+				return "", errors.New("media not found")
+			}
+			return attachment.ThumbnailKey, nil
+		}
+		return attachment.FileKey, nil
+	}
+	return "", errors.New("media not found")
+}
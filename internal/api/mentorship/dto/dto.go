@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type ProfileRequest struct {
+	Topics       []string `json:"topics" validate:"required,min=1,max=10,dive,required,max=50"`
+	Availability string   `json:"availability" validate:"omitempty,max=500"`
+	Bio          string   `json:"bio" validate:"omitempty,max=2000"`
+	MaxMentees   *int     `json:"max_mentees" validate:"omitempty,min=1,max=20"`
+	IsActive     *bool    `json:"is_active"`
+}
+
+type ProfileResponse struct {
+	Role         entities.MentorshipRole `json:"role"`
+	Topics       []string                `json:"topics"`
+	Availability string                  `json:"availability,omitempty"`
+	Bio          string                  `json:"bio,omitempty"`
+	MaxMentees   int                     `json:"max_mentees,omitempty"`
+	IsActive     bool                    `json:"is_active"`
+	UpdatedAt    time.Time               `json:"updated_at"`
+}
+
+type UserInfo struct {
+	ID             uint   `json:"id"`
+	Username       string `json:"username"`
+	FullName       string `json:"full_name"`
+	Headline       string `json:"headline,omitempty"`
+	ProfilePicture string `json:"profile_picture,omitempty"`
+}
+
+type MatchResponse struct {
+	ID                    uint                           `json:"id"`
+	Role                  entities.MentorshipRole        `json:"role"`
+	Counterpart           *UserInfo                      `json:"counterpart"`
+	Topics                []string                       `json:"topics"`
+	Score                 int                            `json:"score"`
+	Status                entities.MentorshipMatchStatus `json:"status"`
+	AcceptedByMe          bool                           `json:"accepted_by_me"`
+	AcceptedByCounterpart bool                           `json:"accepted_by_counterpart"`
+	ConversationID        *uint                          `json:"conversation_id,omitempty"`
+	ProposedAt            time.Time                      `json:"proposed_at"`
+	AcceptedAt            *time.Time                     `json:"accepted_at,omitempty"`
+	EndedAt               *time.Time                     `json:"ended_at,omitempty"`
+	LastCheckInAt         *time.Time                     `json:"last_check_in_at,omitempty"`
+	NextCheckInAt         *time.Time                     `json:"next_check_in_at,omitempty"`
+}
@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"linked-clone/internal/api/mentorship/dto"
+	"linked-clone/internal/api/mentorship/service"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/request"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MentorshipHandler struct {
+	mentorshipService service.MentorshipService
+	validator         validation.Validator
+	logger            logger.Logger
+}
+
+func NewMentorshipHandler(mentorshipService service.MentorshipService, validator validation.Validator, logger logger.Logger) *MentorshipHandler {
+	return &MentorshipHandler{
+		mentorshipService: mentorshipService,
+		validator:         validator,
+		logger:            logger,
+	}
+}
+
+func (h *MentorshipHandler) GetProfiles(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	profiles, err := h.mentorshipService.GetProfiles(c.Request.Context(), userID)
+	if err != nil {
+		h.logger.Error("Failed to get mentorship profiles", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get mentorship profiles", err.Error())
+		return
+	}
+
+	response.Success(c, profiles)
+}
+
+func (h *MentorshipHandler) UpsertProfile(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.ProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	profile, err := h.mentorshipService.UpsertProfile(c.Request.Context(), userID, entities.MentorshipRole(c.Param("role")), &req)
+	if err != nil {
+		h.logger.Error("Failed to save mentorship profile", "error", err)
+		response.Error(c, mentorshipErrorStatus(err), "Failed to save mentorship profile", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Mentorship profile saved", profile)
+}
+
+func (h *MentorshipHandler) DeleteProfile(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	if err := h.mentorshipService.DeleteProfile(c.Request.Context(), userID, entities.MentorshipRole(c.Param("role"))); err != nil {
+		h.logger.Error("Failed to delete mentorship profile", "error", err)
+		response.Error(c, mentorshipErrorStatus(err), "Failed to delete mentorship profile", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Left the mentorship program"})
+}
+
+func (h *MentorshipHandler) GetMatches(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	limit, offset := request.Pagination(c)
+
+	matches, err := h.mentorshipService.GetMatches(c.Request.Context(), userID, entities.MentorshipMatchStatus(c.Query("status")), limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get mentorship matches", "error", err)
+		response.Error(c, mentorshipErrorStatus(err), "Failed to get matches", err.Error())
+		return
+	}
+
+	response.Success(c, matches)
+}
+
+func (h *MentorshipHandler) AcceptMatch(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	id, ok := request.ParseID(c, "id", "Invalid match ID")
+	if !ok {
+		return
+	}
+
+	match, err := h.mentorshipService.AcceptMatch(c.Request.Context(), userID, id)
+	if err != nil {
+		h.logger.Error("Failed to accept mentorship match", "error", err)
+		response.Error(c, mentorshipErrorStatus(err), "Failed to accept match", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Match accepted", match)
+}
+
+func (h *MentorshipHandler) DeclineMatch(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	id, ok := request.ParseID(c, "id", "Invalid match ID")
+	if !ok {
+		return
+	}
+
+	match, err := h.mentorshipService.DeclineMatch(c.Request.Context(), userID, id)
+	if err != nil {
+		h.logger.Error("Failed to decline mentorship match", "error", err)
+		response.Error(c, mentorshipErrorStatus(err), "Failed to decline match", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Match declined", match)
+}
+
+func (h *MentorshipHandler) EndMatch(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	id, ok := request.ParseID(c, "id", "Invalid match ID")
+	if !ok {
+		return
+	}
+
+	match, err := h.mentorshipService.EndMatch(c.Request.Context(), userID, id)
+	if err != nil {
+		h.logger.Error("Failed to end mentorship match", "error", err)
+		response.Error(c, mentorshipErrorStatus(err), "Failed to end match", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Mentorship ended", match)
+}
+
+func (h *MentorshipHandler) CheckIn(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	id, ok := request.ParseID(c, "id", "Invalid match ID")
+	if !ok {
+		return
+	}
+
+	match, err := h.mentorshipService.CheckIn(c.Request.Context(), userID, id)
+	if err != nil {
+		h.logger.Error("Failed to record mentorship check-in", "error", err)
+		response.Error(c, mentorshipErrorStatus(err), "Failed to record check-in", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Check-in recorded", match)
+}
+
+func mentorshipErrorStatus(err error) int {
+	switch err.Error() {
+	case "mentorship profile not found", "match not found":
+		return http.StatusNotFound
+	case "match is not pending", "match is not active":
+		return http.StatusConflict
+	case "invalid role", "invalid status", "invalid topics":
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,263 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type mentorshipProfileRepository struct {
+	db *gorm.DB
+}
+
+func NewMentorshipProfileRepository(db *gorm.DB) repositories.MentorshipProfileRepository {
+	return &mentorshipProfileRepository{db: db}
+}
+
+func (r *mentorshipProfileRepository) Upsert(ctx context.Context, profile *entities.MentorshipProfile) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "role"}},
+			DoUpdates: clause.AssignmentColumns([]string{"topics", "availability", "bio", "max_mentees", "is_active", "updated_at"}),
+		}).
+		Create(profile).Error
+}
+
+func (r *mentorshipProfileRepository) Get(ctx context.Context, userID uint, role entities.MentorshipRole) (*entities.MentorshipProfile, error) {
+	var profile entities.MentorshipProfile
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND role = ?", userID, role).
+		First(&profile).Error
+	if err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+func (r *mentorshipProfileRepository) GetByUserID(ctx context.Context, userID uint) ([]*entities.MentorshipProfile, error) {
+	var profiles []*entities.MentorshipProfile
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("role ASC").
+		Find(&profiles).Error
+	return profiles, err
+}
+
+func (r *mentorshipProfileRepository) GetActive(ctx context.Context, role entities.MentorshipRole) ([]*entities.MentorshipProfile, error) {
+	var profiles []*entities.MentorshipProfile
+	err := r.db.WithContext(ctx).
+		Joins("JOIN users ON users.id = mentorship_profiles.user_id AND users.deleted_at IS NULL").
+		Where("mentorship_profiles.role = ? AND mentorship_profiles.is_active = ?", role, true).
+		Order("mentorship_profiles.id ASC").
+		Find(&profiles).Error
+	return profiles, err
+}
+
+func (r *mentorshipProfileRepository) Delete(ctx context.Context, userID uint, role entities.MentorshipRole) error {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND role = ?", userID, role).
+		Delete(&entities.MentorshipProfile{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+type mentorshipMatchRepository struct {
+	db *gorm.DB
+}
+
+func NewMentorshipMatchRepository(db *gorm.DB) repositories.MentorshipMatchRepository {
+	return &mentorshipMatchRepository{db: db}
+}
+
+func (r *mentorshipMatchRepository) Create(ctx context.Context, match *entities.MentorshipMatch) error {
+	return r.db.WithContext(ctx).Create(match).Error
+}
+
+func (r *mentorshipMatchRepository) GetByID(ctx context.Context, id uint) (*entities.MentorshipMatch, error) {
+	var match entities.MentorshipMatch
+	err := r.db.WithContext(ctx).
+		Preload("Mentor").
+		Preload("Mentee").
+		First(&match, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &match, nil
+}
+
+func (r *mentorshipMatchRepository) GetByUserID(ctx context.Context, userID uint, status entities.MentorshipMatchStatus, limit, offset int) ([]*entities.MentorshipMatch, error) {
+	query := r.db.WithContext(ctx).
+		Preload("Mentor").
+		Preload("Mentee").
+		Where("mentor_id = ? OR mentee_id = ?", userID, userID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var matches []*entities.MentorshipMatch
+	err := query.
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&matches).Error
+	return matches, err
+}
+
+// GetPairs returns every pairing ever recorded, keyed by mentee then mentor,
+// so matching never re-proposes a pair regardless of how it ended.
+func (r *mentorshipMatchRepository) GetPairs(ctx context.Context) (map[uint]map[uint]entities.MentorshipMatchStatus, error) {
+	var rows []struct {
+		MentorID uint
+		MenteeID uint
+		Status   entities.MentorshipMatchStatus
+	}
+	err := r.db.WithContext(ctx).
+		Model(&entities.MentorshipMatch{}).
+		Select("mentor_id, mentee_id, status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := make(map[uint]map[uint]entities.MentorshipMatchStatus)
+	for _, row := range rows {
+		if pairs[row.MenteeID] == nil {
+			pairs[row.MenteeID] = make(map[uint]entities.MentorshipMatchStatus)
+		}
+		pairs[row.MenteeID][row.MentorID] = row.Status
+	}
+	return pairs, nil
+}
+
+func (r *mentorshipMatchRepository) CountOpenByMentor(ctx context.Context) (map[uint]int64, error) {
+	var rows []struct {
+		MentorID uint
+		Count    int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&entities.MentorshipMatch{}).
+		Select("mentor_id, COUNT(*) AS count").
+		Where("status IN ?", []entities.MentorshipMatchStatus{entities.MentorshipMatchProposed, entities.MentorshipMatchAccepted}).
+		Group("mentor_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uint]int64, len(rows))
+	for _, row := range rows {
+		counts[row.MentorID] = row.Count
+	}
+	return counts, nil
+}
+
+// RecordAcceptance stamps one side's acceptance on a proposed match and
+// returns the fresh row, so the caller sees whether the other side already
+// accepted. It returns gorm.ErrRecordNotFound if the match is not proposed.
+func (r *mentorshipMatchRepository) RecordAcceptance(ctx context.Context, id uint, role entities.MentorshipRole, at time.Time) (*entities.MentorshipMatch, error) {
+	column := "mentee_accepted_at"
+	if role == entities.MentorshipMentor {
+		column = "mentor_accepted_at"
+	}
+
+	result := r.db.WithContext(ctx).
+		Model(&entities.MentorshipMatch{}).
+		Where("id = ? AND status = ?", id, entities.MentorshipMatchProposed).
+		Updates(map[string]interface{}{
+			column:       gorm.Expr("COALESCE("+column+", ?)", at),
+			"updated_at": at,
+		})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return r.GetByID(ctx, id)
+}
+
+// Transition moves a match between statuses only if it is still in the
+// expected one, so concurrent requests cannot both apply the same change.
+func (r *mentorshipMatchRepository) Transition(ctx context.Context, id uint, from, to entities.MentorshipMatchStatus, updates map[string]interface{}) (bool, error) {
+	values := map[string]interface{}{"status": to, "updated_at": time.Now()}
+	for column, value := range updates {
+		values[column] = value
+	}
+
+	result := r.db.WithContext(ctx).
+		Model(&entities.MentorshipMatch{}).
+		Where("id = ? AND status = ?", id, from).
+		Updates(values)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *mentorshipMatchRepository) Update(ctx context.Context, match *entities.MentorshipMatch) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Save(match).Error
+}
+
+func (r *mentorshipMatchRepository) DeclineOpenForRole(ctx context.Context, userID uint, role entities.MentorshipRole) (int64, error) {
+	column := "mentee_id"
+	if role == entities.MentorshipMentor {
+		column = "mentor_id"
+	}
+
+	result := r.db.WithContext(ctx).
+		Model(&entities.MentorshipMatch{}).
+		Where(column+" = ? AND status = ?", userID, entities.MentorshipMatchProposed).
+		Updates(map[string]interface{}{
+			"status":     entities.MentorshipMatchDeclined,
+			"updated_at": time.Now(),
+		})
+	return result.RowsAffected, result.Error
+}
+
+func (r *mentorshipMatchRepository) ExpireProposals(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entities.MentorshipMatch{}).
+		Where("status = ? AND created_at < ?", entities.MentorshipMatchProposed, before).
+		Updates(map[string]interface{}{
+			"status":     entities.MentorshipMatchExpired,
+			"updated_at": time.Now(),
+		})
+	return result.RowsAffected, result.Error
+}
+
+func (r *mentorshipMatchRepository) GetDueCheckIns(ctx context.Context, now time.Time, limit int) ([]*entities.MentorshipMatch, error) {
+	var matches []*entities.MentorshipMatch
+	err := r.db.WithContext(ctx).
+		Preload("Mentor").
+		Preload("Mentee").
+		Where("status = ? AND next_check_in_at <= ?", entities.MentorshipMatchAccepted, now).
+		Order("next_check_in_at ASC, id ASC").
+		Limit(limit).
+		Find(&matches).Error
+	return matches, err
+}
+
+// ScheduleCheckIn moves the next check-in forward only if nobody else has
+// since rescheduled it, so each due reminder is sent once.
+func (r *mentorshipMatchRepository) ScheduleCheckIn(ctx context.Context, id uint, previous, next time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entities.MentorshipMatch{}).
+		Where("id = ? AND status = ? AND next_check_in_at = ?", id, entities.MentorshipMatchAccepted, previous).
+		Updates(map[string]interface{}{
+			"next_check_in_at": next,
+			"updated_at":       time.Now(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"linked-clone/internal/domain/entities"
+	"sort"
+	"time"
+)
+
+const (
+	proposalsPerMentee = 3
+	proposalTTL        = 14 * 24 * time.Hour
+	checkInBatchSize   = 200
+)
+
+type mentorCandidate struct {
+	profile *entities.MentorshipProfile
+	topics  []string
+	load    int64
+}
+
+// ProposeMatches expires stale proposals, then tops up every active mentee to
+// proposalsPerMentee open matches with the mentors sharing the most topics.
+// Mentors are never proposed beyond their capacity, and pairs that were ever
+// matched before, or where either side blocked the other, are skipped.
+func (s *mentorshipService) ProposeMatches(ctx context.Context) (int, error) {
+	if _, err := s.matchRepo.ExpireProposals(ctx, time.Now().Add(-proposalTTL)); err != nil {
+		return 0, fmt.Errorf("failed to expire proposals: %w", err)
+	}
+
+	mentors, err := s.profileRepo.GetActive(ctx, entities.MentorshipMentor)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load mentors: %w", err)
+	}
+	mentees, err := s.profileRepo.GetActive(ctx, entities.MentorshipMentee)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load mentees: %w", err)
+	}
+	if len(mentors) == 0 || len(mentees) == 0 {
+		return 0, nil
+	}
+
+	pairs, err := s.matchRepo.GetPairs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load existing matches: %w", err)
+	}
+	load, err := s.matchRepo.CountOpenByMentor(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load mentor capacity: %w", err)
+	}
+
+	proposed := 0
+	for _, mentee := range mentees {
+		slots := proposalsPerMentee
+		for _, status := range pairs[mentee.UserID] {
+			if status == entities.MentorshipMatchProposed || status == entities.MentorshipMatchAccepted {
+				slots--
+			}
+		}
+		if slots <= 0 {
+			continue
+		}
+
+		for _, candidate := range rankMentors(mentee, mentors, pairs[mentee.UserID], load) {
+			if slots == 0 {
+				break
+			}
+
+			blocked, err := s.graph.IsBlocked(ctx, mentee.UserID, candidate.profile.UserID)
+			if err != nil {
+				s.logger.Warn("Failed to check block for mentorship match", "error", err, "mentee_id", mentee.UserID, "mentor_id", candidate.profile.UserID)
+				continue
+			}
+			if blocked {
+				continue
+			}
+
+			match := &entities.MentorshipMatch{
+				MentorID: candidate.profile.UserID,
+				MenteeID: mentee.UserID,
+				Topics:   candidate.topics,
+				Score:    len(candidate.topics),
+				Status:   entities.MentorshipMatchProposed,
+			}
+			if err := s.matchRepo.Create(ctx, match); err != nil {
+				s.logger.Warn("Failed to propose mentorship match", "error", err, "mentee_id", mentee.UserID, "mentor_id", candidate.profile.UserID)
+				continue
+			}
+
+			load[candidate.profile.UserID]++
+			slots--
+			proposed++
+			s.notifyProposal(ctx, match)
+		}
+	}
+	return proposed, nil
+}
+
+// SendCheckInReminders nudges both sides of every active match whose check-in
+// is due, then schedules the next one an interval later.
+func (s *mentorshipService) SendCheckInReminders(ctx context.Context, now time.Time) (int, error) {
+	sent := 0
+	for {
+		matches, err := s.matchRepo.GetDueCheckIns(ctx, now, checkInBatchSize)
+		if err != nil {
+			return sent, fmt.Errorf("failed to load due check-ins: %w", err)
+		}
+
+		for _, match := range matches {
+			scheduled, err := s.matchRepo.ScheduleCheckIn(ctx, match.ID, *match.NextCheckInAt, now.Add(checkInInterval))
+			if err != nil {
+				return sent, fmt.Errorf("failed to schedule check-in: %w", err)
+			}
+			if !scheduled {
+				continue
+			}
+
+			s.notify(ctx, match.MentorID, match.MenteeID, entities.NotificationMentorshipCheckIn, match.ID,
+				fmt.Sprintf("Time for a mentorship check-in with %s", match.Mentee.FullName))
+			s.notify(ctx, match.MenteeID, match.MentorID, entities.NotificationMentorshipCheckIn, match.ID,
+				fmt.Sprintf("Time for a mentorship check-in with %s", match.Mentor.FullName))
+			sent += 2
+		}
+
+		if len(matches) < checkInBatchSize {
+			return sent, nil
+		}
+	}
+}
+
+func (s *mentorshipService) notifyProposal(ctx context.Context, match *entities.MentorshipMatch) {
+	match, err := s.matchRepo.GetByID(ctx, match.ID)
+	if err != nil {
+		s.logger.Error("Failed to load proposed mentorship match", "error", err)
+		return
+	}
+
+	s.notify(ctx, match.MenteeID, match.MentorID, entities.NotificationMentorshipProposed, match.ID,
+		fmt.Sprintf("%s could be a good mentor for you", match.Mentor.FullName))
+	s.notify(ctx, match.MentorID, match.MenteeID, entities.NotificationMentorshipProposed, match.ID,
+		fmt.Sprintf("%s is looking for a mentor in topics you cover", match.Mentee.FullName))
+}
+
+func rankMentors(mentee *entities.MentorshipProfile, mentors []*entities.MentorshipProfile, existing map[uint]entities.MentorshipMatchStatus, load map[uint]int64) []*mentorCandidate {
+	wanted := make(map[string]bool, len(mentee.Topics))
+	for _, topic := range mentee.Topics {
+		wanted[topic] = true
+	}
+
+	var candidates []*mentorCandidate
+	for _, mentor := range mentors {
+		if mentor.UserID == mentee.UserID || load[mentor.UserID] >= int64(mentor.MaxMentees) {
+			continue
+		}
+		if _, ok := existing[mentor.UserID]; ok {
+			continue
+		}
+
+		var shared []string
+		for _, topic := range mentor.Topics {
+			if wanted[topic] {
+				shared = append(shared, topic)
+			}
+		}
+		if len(shared) == 0 {
+			continue
+		}
+		candidates = append(candidates, &mentorCandidate{profile: mentor, topics: shared, load: load[mentor.UserID]})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if len(candidates[i].topics) != len(candidates[j].topics) {
+			return len(candidates[i].topics) > len(candidates[j].topics)
+		}
+		return candidates[i].load < candidates[j].load
+	})
+	return candidates
+}
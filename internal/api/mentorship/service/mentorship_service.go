@@ -0,0 +1,438 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"linked-clone/internal/api/mentorship/dto"
+	notificationDto "linked-clone/internal/api/notification/dto"
+	notificationService "linked-clone/internal/api/notification/service"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/graph"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/storage"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	defaultMaxMentees = 3
+	checkInInterval   = 14 * 24 * time.Hour
+)
+
+type MentorshipService interface {
+	GetProfiles(ctx context.Context, userID uint) ([]*dto.ProfileResponse, error)
+	UpsertProfile(ctx context.Context, userID uint, role entities.MentorshipRole, req *dto.ProfileRequest) (*dto.ProfileResponse, error)
+	DeleteProfile(ctx context.Context, userID uint, role entities.MentorshipRole) error
+
+	GetMatches(ctx context.Context, userID uint, status entities.MentorshipMatchStatus, limit, offset int) ([]*dto.MatchResponse, error)
+	AcceptMatch(ctx context.Context, userID, matchID uint) (*dto.MatchResponse, error)
+	DeclineMatch(ctx context.Context, userID, matchID uint) (*dto.MatchResponse, error)
+	EndMatch(ctx context.Context, userID, matchID uint) (*dto.MatchResponse, error)
+	CheckIn(ctx context.Context, userID, matchID uint) (*dto.MatchResponse, error)
+
+	ProposeMatches(ctx context.Context) (int, error)
+	SendCheckInReminders(ctx context.Context, now time.Time) (int, error)
+}
+
+type mentorshipService struct {
+	profileRepo      repositories.MentorshipProfileRepository
+	matchRepo        repositories.MentorshipMatchRepository
+	conversationRepo repositories.ConversationRepository
+	notificationSvc  notificationService.NotificationService
+	graph            graph.Graph
+	storageService   storage.StorageService
+	logger           logger.Logger
+}
+
+func NewMentorshipService(
+	profileRepo repositories.MentorshipProfileRepository,
+	matchRepo repositories.MentorshipMatchRepository,
+	conversationRepo repositories.ConversationRepository,
+	notificationSvc notificationService.NotificationService,
+	graph graph.Graph,
+	storageService storage.StorageService,
+	logger logger.Logger,
+) MentorshipService {
+	return &mentorshipService{
+		profileRepo:      profileRepo,
+		matchRepo:        matchRepo,
+		conversationRepo: conversationRepo,
+		notificationSvc:  notificationSvc,
+		graph:            graph,
+		storageService:   storageService,
+		logger:           logger,
+	}
+}
+
+func (s *mentorshipService) GetProfiles(ctx context.Context, userID uint) ([]*dto.ProfileResponse, error) {
+	profiles, err := s.profileRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get mentorship profiles", "user_id", userID, "error", err)
+		return nil, errors.New("failed to get mentorship profiles")
+	}
+
+	responses := make([]*dto.ProfileResponse, 0, len(profiles))
+	for _, profile := range profiles {
+		responses = append(responses, mapProfile(profile))
+	}
+	return responses, nil
+}
+
+// UpsertProfile opts the user in for a role. Pausing a profile withdraws its
+// pending proposals but leaves accepted matches running.
+func (s *mentorshipService) UpsertProfile(ctx context.Context, userID uint, role entities.MentorshipRole, req *dto.ProfileRequest) (*dto.ProfileResponse, error) {
+	if !validRole(role) {
+		return nil, errors.New("invalid role")
+	}
+
+	topics := normalizeTopics(req.Topics)
+	if len(topics) == 0 {
+		return nil, errors.New("invalid topics")
+	}
+
+	profile := &entities.MentorshipProfile{
+		UserID:       userID,
+		Role:         role,
+		Topics:       topics,
+		Availability: strings.TrimSpace(req.Availability),
+		Bio:          req.Bio,
+		IsActive:     true,
+		UpdatedAt:    time.Now(),
+	}
+	if role == entities.MentorshipMentor {
+		profile.MaxMentees = defaultMaxMentees
+		if req.MaxMentees != nil {
+			profile.MaxMentees = *req.MaxMentees
+		}
+	}
+	if req.IsActive != nil {
+		profile.IsActive = *req.IsActive
+	}
+
+	if err := s.profileRepo.Upsert(ctx, profile); err != nil {
+		s.logger.Error("Failed to save mentorship profile", "user_id", userID, "role", role, "error", err)
+		return nil, errors.New("failed to save mentorship profile")
+	}
+
+	if !profile.IsActive {
+		s.withdrawProposals(ctx, userID, role)
+	}
+
+	return mapProfile(profile), nil
+}
+
+func (s *mentorshipService) DeleteProfile(ctx context.Context, userID uint, role entities.MentorshipRole) error {
+	if !validRole(role) {
+		return errors.New("invalid role")
+	}
+
+	if err := s.profileRepo.Delete(ctx, userID, role); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("mentorship profile not found")
+		}
+		s.logger.Error("Failed to delete mentorship profile", "user_id", userID, "role", role, "error", err)
+		return errors.New("failed to delete mentorship profile")
+	}
+
+	s.withdrawProposals(ctx, userID, role)
+	return nil
+}
+
+func (s *mentorshipService) GetMatches(ctx context.Context, userID uint, status entities.MentorshipMatchStatus, limit, offset int) ([]*dto.MatchResponse, error) {
+	if status != "" && !validStatus(status) {
+		return nil, errors.New("invalid status")
+	}
+
+	matches, err := s.matchRepo.GetByUserID(ctx, userID, status, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to get mentorship matches", "user_id", userID, "error", err)
+		return nil, errors.New("failed to get matches")
+	}
+
+	responses := make([]*dto.MatchResponse, 0, len(matches))
+	for _, match := range matches {
+		responses = append(responses, s.mapMatch(match, userID))
+	}
+	return responses, nil
+}
+
+// AcceptMatch records the caller's acceptance. The second acceptance
+// activates the match, opens its conversation and schedules the first
+// check-in; only the request that wins the status transition does so.
+func (s *mentorshipService) AcceptMatch(ctx context.Context, userID, matchID uint) (*dto.MatchResponse, error) {
+	match, err := s.getMatch(ctx, userID, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	match, err = s.matchRepo.RecordAcceptance(ctx, matchID, roleIn(match, userID), time.Now())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("match is not pending")
+		}
+		s.logger.Error("Failed to accept mentorship match", "match_id", matchID, "error", err)
+		return nil, errors.New("failed to accept match")
+	}
+
+	if match.MentorAcceptedAt == nil || match.MenteeAcceptedAt == nil {
+		return s.mapMatch(match, userID), nil
+	}
+
+	now := time.Now()
+	nextCheckIn := now.Add(checkInInterval)
+	activated, err := s.matchRepo.Transition(ctx, matchID, entities.MentorshipMatchProposed, entities.MentorshipMatchAccepted, map[string]interface{}{
+		"accepted_at":      now,
+		"next_check_in_at": nextCheckIn,
+	})
+	if err != nil {
+		s.logger.Error("Failed to activate mentorship match", "match_id", matchID, "error", err)
+		return nil, errors.New("failed to accept match")
+	}
+
+	if activated {
+		match.Status = entities.MentorshipMatchAccepted
+		match.AcceptedAt = &now
+		match.NextCheckInAt = &nextCheckIn
+		s.openConversation(ctx, match)
+
+		s.notify(ctx, match.MenteeID, match.MentorID, entities.NotificationMentorshipAccepted, match.ID,
+			fmt.Sprintf("Your mentorship with %s is confirmed", match.Mentor.FullName))
+		s.notify(ctx, match.MentorID, match.MenteeID, entities.NotificationMentorshipAccepted, match.ID,
+			fmt.Sprintf("Your mentorship with %s is confirmed", match.Mentee.FullName))
+	}
+
+	return s.reload(ctx, match, userID)
+}
+
+func (s *mentorshipService) DeclineMatch(ctx context.Context, userID, matchID uint) (*dto.MatchResponse, error) {
+	match, err := s.getMatch(ctx, userID, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	declined, err := s.matchRepo.Transition(ctx, matchID, entities.MentorshipMatchProposed, entities.MentorshipMatchDeclined, nil)
+	if err != nil {
+		s.logger.Error("Failed to decline mentorship match", "match_id", matchID, "error", err)
+		return nil, errors.New("failed to decline match")
+	}
+	if !declined {
+		return nil, errors.New("match is not pending")
+	}
+
+	return s.reload(ctx, match, userID)
+}
+
+func (s *mentorshipService) EndMatch(ctx context.Context, userID, matchID uint) (*dto.MatchResponse, error) {
+	match, err := s.getMatch(ctx, userID, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	ended, err := s.matchRepo.Transition(ctx, matchID, entities.MentorshipMatchAccepted, entities.MentorshipMatchEnded, map[string]interface{}{
+		"ended_at":         time.Now(),
+		"next_check_in_at": nil,
+	})
+	if err != nil {
+		s.logger.Error("Failed to end mentorship match", "match_id", matchID, "error", err)
+		return nil, errors.New("failed to end match")
+	}
+	if !ended {
+		return nil, errors.New("match is not active")
+	}
+
+	return s.reload(ctx, match, userID)
+}
+
+// CheckIn records that the pair met and pushes the next reminder a full
+// interval out.
+func (s *mentorshipService) CheckIn(ctx context.Context, userID, matchID uint) (*dto.MatchResponse, error) {
+	match, err := s.getMatch(ctx, userID, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if match.Status != entities.MentorshipMatchAccepted {
+		return nil, errors.New("match is not active")
+	}
+
+	now := time.Now()
+	nextCheckIn := now.Add(checkInInterval)
+	match.LastCheckInAt = &now
+	match.NextCheckInAt = &nextCheckIn
+	if err := s.matchRepo.Update(ctx, match); err != nil {
+		s.logger.Error("Failed to record mentorship check-in", "match_id", matchID, "error", err)
+		return nil, errors.New("failed to record check-in")
+	}
+
+	return s.mapMatch(match, userID), nil
+}
+
+func (s *mentorshipService) getMatch(ctx context.Context, userID, matchID uint) (*entities.MentorshipMatch, error) {
+	match, err := s.matchRepo.GetByID(ctx, matchID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("match not found")
+		}
+		s.logger.Error("Failed to get mentorship match", "match_id", matchID, "error", err)
+		return nil, errors.New("failed to get match")
+	}
+	if match.MentorID != userID && match.MenteeID != userID {
+		return nil, errors.New("match not found")
+	}
+	return match, nil
+}
+
+func (s *mentorshipService) reload(ctx context.Context, match *entities.MentorshipMatch, userID uint) (*dto.MatchResponse, error) {
+	fresh, err := s.matchRepo.GetByID(ctx, match.ID)
+	if err != nil {
+		s.logger.Error("Failed to reload mentorship match", "match_id", match.ID, "error", err)
+		return s.mapMatch(match, userID), nil
+	}
+	return s.mapMatch(fresh, userID), nil
+}
+
+// openConversation gives the pair their own conversation, separate from any
+// direct thread they already share. A failure is logged rather than undoing
+// the acceptance.
+func (s *mentorshipService) openConversation(ctx context.Context, match *entities.MentorshipMatch) {
+	subject := []rune("Mentorship: " + strings.Join(match.Topics, ", "))
+	if len(subject) > 200 {
+		subject = subject[:200]
+	}
+
+	conversation := &entities.Conversation{
+		Subject: string(subject),
+		Participants: []entities.ConversationParticipant{
+			{UserID: match.MentorID},
+			{UserID: match.MenteeID},
+		},
+	}
+
+	if err := s.conversationRepo.Create(ctx, conversation); err != nil {
+		s.logger.Error("Failed to create mentorship conversation", "match_id", match.ID, "error", err)
+		return
+	}
+
+	match.ConversationID = &conversation.ID
+	if err := s.matchRepo.Update(ctx, match); err != nil {
+		s.logger.Error("Failed to link mentorship conversation", "match_id", match.ID, "conversation_id", conversation.ID, "error", err)
+	}
+}
+
+func (s *mentorshipService) withdrawProposals(ctx context.Context, userID uint, role entities.MentorshipRole) {
+	if _, err := s.matchRepo.DeclineOpenForRole(ctx, userID, role); err != nil {
+		s.logger.Error("Failed to withdraw mentorship proposals", "user_id", userID, "role", role, "error", err)
+	}
+}
+
+func (s *mentorshipService) notify(ctx context.Context, recipientID, actorID uint, notificationType entities.NotificationType, matchID uint, message string) {
+	if _, err := s.notificationSvc.Notify(ctx, &notificationDto.CreateNotificationRequest{
+		UserID:     recipientID,
+		ActorID:    &actorID,
+		Type:       notificationType,
+		EntityType: "mentorship_match",
+		EntityID:   &matchID,
+		Message:    message,
+	}); err != nil {
+		s.logger.Error("Failed to send mentorship notification", "error", err, "match_id", matchID, "user_id", recipientID)
+	}
+}
+
+func (s *mentorshipService) mapMatch(match *entities.MentorshipMatch, viewerID uint) *dto.MatchResponse {
+	role := roleIn(match, viewerID)
+	counterpart := &match.Mentor
+	acceptedByMe, acceptedByCounterpart := match.MenteeAcceptedAt != nil, match.MentorAcceptedAt != nil
+	if role == entities.MentorshipMentor {
+		counterpart = &match.Mentee
+		acceptedByMe, acceptedByCounterpart = acceptedByCounterpart, acceptedByMe
+	}
+
+	return &dto.MatchResponse{
+		ID:                    match.ID,
+		Role:                  role,
+		Counterpart:           s.mapUserInfo(counterpart),
+		Topics:                match.Topics,
+		Score:                 match.Score,
+		Status:                match.Status,
+		AcceptedByMe:          acceptedByMe,
+		AcceptedByCounterpart: acceptedByCounterpart,
+		ConversationID:        match.ConversationID,
+		ProposedAt:            match.CreatedAt,
+		AcceptedAt:            match.AcceptedAt,
+		EndedAt:               match.EndedAt,
+		LastCheckInAt:         match.LastCheckInAt,
+		NextCheckInAt:         match.NextCheckInAt,
+	}
+}
+
+func (s *mentorshipService) mapUserInfo(user *entities.User) *dto.UserInfo {
+	profilePicture := user.ProfilePicture
+	if profilePicture != "" {
+		url, err := s.storageService.GeneratePresignedURL(profilePicture, 15*time.Minute)
+		if err != nil {
+			s.logger.Error("Failed to generate profile picture presigned URL", "error", err)
+		} else {
+			profilePicture = url
+		}
+	}
+
+	return &dto.UserInfo{
+		ID:             user.ID,
+		Username:       user.Username,
+		FullName:       user.FullName,
+		Headline:       user.Headline,
+		ProfilePicture: profilePicture,
+	}
+}
+
+func mapProfile(profile *entities.MentorshipProfile) *dto.ProfileResponse {
+	return &dto.ProfileResponse{
+		Role:         profile.Role,
+		Topics:       profile.Topics,
+		Availability: profile.Availability,
+		Bio:          profile.Bio,
+		MaxMentees:   profile.MaxMentees,
+		IsActive:     profile.IsActive,
+		UpdatedAt:    profile.UpdatedAt,
+	}
+}
+
+func roleIn(match *entities.MentorshipMatch, userID uint) entities.MentorshipRole {
+	if match.MentorID == userID {
+		return entities.MentorshipMentor
+	}
+	return entities.MentorshipMentee
+}
+
+func validRole(role entities.MentorshipRole) bool {
+	return role == entities.MentorshipMentor || role == entities.MentorshipMentee
+}
+
+func validStatus(status entities.MentorshipMatchStatus) bool {
+	switch status {
+	case entities.MentorshipMatchProposed, entities.MentorshipMatchAccepted, entities.MentorshipMatchDeclined,
+		entities.MentorshipMatchExpired, entities.MentorshipMatchEnded:
+		return true
+	default:
+		return false
+	}
+}
+
+// normalizeTopics trims and lowercases topics so matching compares them
+// case-insensitively, dropping blanks and duplicates.
+func normalizeTopics(topics []string) []string {
+	seen := make(map[string]bool, len(topics))
+	normalized := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		topic = strings.ToLower(strings.TrimSpace(topic))
+		if topic == "" || seen[topic] {
+			continue
+		}
+		seen[topic] = true
+		normalized = append(normalized, topic)
+	}
+	return normalized
+}
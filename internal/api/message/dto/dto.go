@@ -0,0 +1,93 @@
+package dto
+
+import (
+	"linked-clone/pkg/presence"
+	"time"
+)
+
+const (
+	MessageStatusSent      = "sent"
+	MessageStatusDelivered = "delivered"
+	MessageStatusRead      = "read"
+)
+
+type StartConversationRequest struct {
+	RecipientID uint `json:"recipient_id" validate:"required"`
+}
+
+type SendMessageRequest struct {
+	Content         string `json:"content" form:"content" validate:"omitempty,max=2000"`
+	DurationSeconds *int   `json:"duration_seconds" form:"duration_seconds" validate:"omitempty,min=1,max=600"`
+}
+
+type AttachmentResponse struct {
+	ID              uint   `json:"id"`
+	Type            string `json:"type"`
+	FileName        string `json:"file_name"`
+	ContentType     string `json:"content_type"`
+	Size            int64  `json:"size"`
+	DurationSeconds *int   `json:"duration_seconds,omitempty"`
+	URL             string `json:"url,omitempty"`
+	ThumbnailURL    string `json:"thumbnail_url,omitempty"`
+}
+
+type UpdateMessageSettingsRequest struct {
+	ReadReceipts *bool `json:"read_receipts" validate:"required"`
+}
+
+type MessageSettingsResponse struct {
+	ReadReceipts bool `json:"read_receipts"`
+}
+
+type TypingEvent struct {
+	ConversationID uint `json:"conversation_id"`
+	UserID         uint `json:"user_id"`
+	IsTyping       bool `json:"is_typing"`
+}
+
+type ReceiptEvent struct {
+	ConversationID uint      `json:"conversation_id"`
+	UserID         uint      `json:"user_id"`
+	MessageID      uint      `json:"message_id,omitempty"`
+	At             time.Time `json:"at"`
+}
+
+type ConversationResponse struct {
+	ID            uint        `json:"id"`
+	Subject       string      `json:"subject,omitempty"`
+	Participants  []*UserInfo `json:"participants"`
+	LastMessageAt *time.Time  `json:"last_message_at,omitempty"`
+	UnreadCount   int64       `json:"unread_count"`
+	IsArchived    bool        `json:"is_archived"`
+	IsMuted       bool        `json:"is_muted"`
+	CreatedAt     time.Time   `json:"created_at"`
+}
+
+type MessageResponse struct {
+	ID             uint                  `json:"id"`
+	ConversationID uint                  `json:"conversation_id"`
+	Content        string                `json:"content"`
+	Status         string                `json:"status"`
+	DeliveredAt    *time.Time            `json:"delivered_at,omitempty"`
+	ReadAt         *time.Time            `json:"read_at,omitempty"`
+	Attachments    []*AttachmentResponse `json:"attachments,omitempty"`
+	Sender         *UserInfo             `json:"sender"`
+	CreatedAt      time.Time             `json:"created_at"`
+}
+
+type UnreadCountResponse struct {
+	Messages int64 `json:"messages"`
+}
+
+type UserInfo struct {
+	ID             uint              `json:"id"`
+	Username       string            `json:"username"`
+	FullName       string            `json:"full_name"`
+	ProfilePicture string            `json:"profile_picture,omitempty"`
+	Presence       *PresenceResponse `json:"presence,omitempty"`
+}
+
+type PresenceResponse struct {
+	Status       presence.Status `json:"status"`
+	LastActiveAt *time.Time      `json:"last_active_at,omitempty"`
+}
@@ -0,0 +1,273 @@
+package handler
+
+import (
+	"linked-clone/internal/api/message/dto"
+	"linked-clone/internal/api/message/service"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MessageHandler struct {
+	messageService service.MessageService
+	validator      validation.Validator
+	logger         logger.Logger
+}
+
+func NewMessageHandler(messageService service.MessageService, validator validation.Validator, logger logger.Logger) *MessageHandler {
+	return &MessageHandler{
+		messageService: messageService,
+		validator:      validator,
+		logger:         logger,
+	}
+}
+
+func (h *MessageHandler) StartConversation(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.StartConversationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	conversation, err := h.messageService.StartConversation(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.logger.Error("Failed to start conversation", "error", err)
+		response.Error(c, http.StatusBadRequest, "Failed to start conversation", err.Error())
+		return
+	}
+
+	response.Success(c, conversation)
+}
+
+func (h *MessageHandler) GetConversations(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	filter := entities.ConversationFilter(c.DefaultQuery("filter", string(entities.ConversationFilterInbox)))
+
+	switch filter {
+	case entities.ConversationFilterInbox, entities.ConversationFilterArchived,
+		entities.ConversationFilterMuted, entities.ConversationFilterAll:
+	default:
+		response.Error(c, http.StatusBadRequest, "Invalid filter", "filter must be one of inbox, archived, muted, all")
+		return
+	}
+
+	conversations, err := h.messageService.GetConversations(c.Request.Context(), userID, filter, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get conversations", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get conversations", err.Error())
+		return
+	}
+
+	response.Success(c, conversations)
+}
+
+func (h *MessageHandler) GetMessages(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid conversation ID", err.Error())
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	messages, err := h.messageService.GetMessages(c.Request.Context(), userID, uint(id), limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get messages", "error", err)
+		response.Error(c, http.StatusNotFound, "Failed to get messages", err.Error())
+		return
+	}
+
+	response.Success(c, messages)
+}
+
+func (h *MessageHandler) SendMessage(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid conversation ID", err.Error())
+		return
+	}
+
+	var req dto.SendMessageRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	file, _ := c.FormFile("attachment")
+
+	message, err := h.messageService.SendMessage(c.Request.Context(), userID, uint(id), &req, file)
+	if err != nil {
+		h.logger.Error("Failed to send message", "error", err)
+		response.Error(c, http.StatusBadRequest, "Failed to send message", err.Error())
+		return
+	}
+
+	response.Success(c, message)
+}
+
+func (h *MessageHandler) MarkConversationRead(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid conversation ID", err.Error())
+		return
+	}
+
+	if err := h.messageService.MarkConversationRead(c.Request.Context(), userID, uint(id)); err != nil {
+		h.logger.Error("Failed to mark conversation as read", "error", err)
+		response.Error(c, http.StatusNotFound, "Failed to mark conversation as read", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Conversation marked as read", nil)
+}
+
+func (h *MessageHandler) MarkMessageRead(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid conversation ID", err.Error())
+		return
+	}
+
+	messageIDStr := c.Param("messageId")
+	messageID, err := strconv.ParseUint(messageIDStr, 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid message ID", err.Error())
+		return
+	}
+
+	if err := h.messageService.MarkMessageRead(c.Request.Context(), userID, uint(id), uint(messageID)); err != nil {
+		h.logger.Error("Failed to mark message as read", "error", err)
+		response.Error(c, http.StatusNotFound, "Failed to mark message as read", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Message marked as read", nil)
+}
+
+func (h *MessageHandler) ArchiveConversation(c *gin.Context) {
+	h.updateConversationState(c, "Conversation archived", func(userID, id uint) error {
+		return h.messageService.ArchiveConversation(c.Request.Context(), userID, id, true)
+	})
+}
+
+func (h *MessageHandler) UnarchiveConversation(c *gin.Context) {
+	h.updateConversationState(c, "Conversation moved to inbox", func(userID, id uint) error {
+		return h.messageService.ArchiveConversation(c.Request.Context(), userID, id, false)
+	})
+}
+
+func (h *MessageHandler) MuteConversation(c *gin.Context) {
+	h.updateConversationState(c, "Conversation muted", func(userID, id uint) error {
+		return h.messageService.MuteConversation(c.Request.Context(), userID, id, true)
+	})
+}
+
+func (h *MessageHandler) UnmuteConversation(c *gin.Context) {
+	h.updateConversationState(c, "Conversation unmuted", func(userID, id uint) error {
+		return h.messageService.MuteConversation(c.Request.Context(), userID, id, false)
+	})
+}
+
+func (h *MessageHandler) DeleteConversation(c *gin.Context) {
+	h.updateConversationState(c, "Conversation deleted", func(userID, id uint) error {
+		return h.messageService.DeleteConversation(c.Request.Context(), userID, id)
+	})
+}
+
+func (h *MessageHandler) updateConversationState(c *gin.Context, successMessage string, update func(userID, id uint) error) {
+	userID := middleware.GetUserID(c)
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid conversation ID", err.Error())
+		return
+	}
+
+	if err := update(userID, uint(id)); err != nil {
+		h.logger.Error("Failed to update conversation", "error", err)
+		response.Error(c, http.StatusNotFound, "Failed to update conversation", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, successMessage, nil)
+}
+
+func (h *MessageHandler) GetSettings(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	settings, err := h.messageService.GetSettings(c.Request.Context(), userID)
+	if err != nil {
+		h.logger.Error("Failed to get message settings", "error", err)
+		response.Error(c, http.StatusNotFound, "Failed to get message settings", err.Error())
+		return
+	}
+
+	response.Success(c, settings)
+}
+
+func (h *MessageHandler) UpdateSettings(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.UpdateMessageSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	settings, err := h.messageService.UpdateSettings(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.logger.Error("Failed to update message settings", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to update message settings", err.Error())
+		return
+	}
+
+	response.Success(c, settings)
+}
+
+func (h *MessageHandler) GetUnreadCount(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	count, err := h.messageService.GetUnreadCount(c.Request.Context(), userID)
+	if err != nil {
+		h.logger.Error("Failed to get unread message count", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get unread message count", err.Error())
+		return
+	}
+
+	response.Success(c, count)
+}
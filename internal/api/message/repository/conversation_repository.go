@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type conversationRepository struct {
+	db *gorm.DB
+}
+
+func NewConversationRepository(db *gorm.DB) repositories.ConversationRepository {
+	return &conversationRepository{db: db}
+}
+
+func (r *conversationRepository) Create(ctx context.Context, conversation *entities.Conversation) error {
+	return r.db.WithContext(ctx).Create(conversation).Error
+}
+
+func (r *conversationRepository) GetByID(ctx context.Context, id uint) (*entities.Conversation, error) {
+	var conversation entities.Conversation
+	err := r.db.WithContext(ctx).
+		Preload("Participants").
+		Preload("Participants.User").
+		First(&conversation, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &conversation, nil
+}
+
+func (r *conversationRepository) FindDirect(ctx context.Context, userID1, userID2 uint) (*entities.Conversation, error) {
+	var conversation entities.Conversation
+	err := r.db.WithContext(ctx).
+		Preload("Participants").
+		Preload("Participants.User").
+		Where("subject = ''").
+		Where("id IN (?)", r.db.WithContext(ctx).Table("conversation_participants").
+			Select("conversation_id").
+			Where("user_id IN ?", []uint{userID1, userID2}).
+			Group("conversation_id").
+			Having("COUNT(DISTINCT user_id) = 2")).
+		First(&conversation).Error
+	if err != nil {
+		return nil, err
+	}
+	return &conversation, nil
+}
+
+func (r *conversationRepository) GetUserConversations(ctx context.Context, userID uint, filter entities.ConversationFilter, limit, offset int) ([]*entities.Conversation, error) {
+	query := r.db.WithContext(ctx).
+		Preload("Participants").
+		Preload("Participants.User").
+		Joins("JOIN conversation_participants cp ON cp.conversation_id = conversations.id").
+		Where("cp.user_id = ?", userID).
+		Where("(cp.cleared_at IS NULL OR conversations.last_message_at > cp.cleared_at)")
+
+	switch filter {
+	case entities.ConversationFilterArchived:
+		query = query.Where("cp.archived_at IS NOT NULL")
+	case entities.ConversationFilterMuted:
+		query = query.Where("cp.muted_at IS NOT NULL")
+	case entities.ConversationFilterAll:
+	default:
+		query = query.Where("cp.archived_at IS NULL")
+	}
+
+	var conversations []*entities.Conversation
+	err := query.
+		Order("conversations.last_message_at DESC NULLS LAST, conversations.created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&conversations).Error
+	return conversations, err
+}
+
+func (r *conversationRepository) GetParticipant(ctx context.Context, conversationID, userID uint) (*entities.ConversationParticipant, error) {
+	var participant entities.ConversationParticipant
+	err := r.db.WithContext(ctx).
+		Where("conversation_id = ? AND user_id = ?", conversationID, userID).
+		First(&participant).Error
+	if err != nil {
+		return nil, err
+	}
+	return &participant, nil
+}
+
+func (r *conversationRepository) UpdateLastMessageAt(ctx context.Context, conversationID uint, lastMessageAt time.Time) error {
+	return r.db.WithContext(ctx).Model(&entities.Conversation{}).
+		Where("id = ?", conversationID).
+		Update("last_message_at", lastMessageAt).Error
+}
+
+func (r *conversationRepository) UpdateLastReadAt(ctx context.Context, conversationID, userID uint, lastReadAt time.Time) error {
+	return r.db.WithContext(ctx).Model(&entities.ConversationParticipant{}).
+		Where("conversation_id = ? AND user_id = ?", conversationID, userID).
+		Where("last_read_at IS NULL OR last_read_at < ?", lastReadAt).
+		Update("last_read_at", lastReadAt).Error
+}
+
+func (r *conversationRepository) UpdateParticipant(ctx context.Context, participant *entities.ConversationParticipant) error {
+	return r.db.WithContext(ctx).Save(participant).Error
+}
+
+func (r *conversationRepository) Unarchive(ctx context.Context, conversationID uint) error {
+	return r.db.WithContext(ctx).Model(&entities.ConversationParticipant{}).
+		Where("conversation_id = ? AND archived_at IS NOT NULL", conversationID).
+		Update("archived_at", nil).Error
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type messageRepository struct {
+	db *gorm.DB
+}
+
+func NewMessageRepository(db *gorm.DB) repositories.MessageRepository {
+	return &messageRepository{db: db}
+}
+
+// Create stores the message with its attachments. The message's created_at
+// is fixed up front, at the database's microsecond precision, because the
+// attachments reference it as part of the message's key.
+func (r *messageRepository) Create(ctx context.Context, message *entities.Message) error {
+	if message.CreatedAt.IsZero() {
+		message.CreatedAt = time.Now().Truncate(time.Microsecond)
+	}
+	for i := range message.Attachments {
+		message.Attachments[i].MessageCreatedAt = message.CreatedAt
+	}
+	return r.db.WithContext(ctx).Create(message).Error
+}
+
+func (r *messageRepository) GetByID(ctx context.Context, id uint) (*entities.Message, error) {
+	var message entities.Message
+	err := r.db.WithContext(ctx).
+		Preload("Sender").
+		Preload("Attachments").
+		First(&message, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &message, nil
+}
+
+// GetByIDAt looks a message up by its full key, so only the partition for
+// createdAt is read.
+func (r *messageRepository) GetByIDAt(ctx context.Context, id uint, createdAt time.Time) (*entities.Message, error) {
+	var message entities.Message
+	err := r.db.WithContext(ctx).
+		Preload("Sender").
+		Preload("Attachments").
+		Where("id = ? AND created_at = ?", id, createdAt).
+		First(&message).Error
+	if err != nil {
+		return nil, err
+	}
+	return &message, nil
+}
+
+// The conversation queries below take since, the earliest a message in the
+// conversation can have been created. Bounding created_at lets Postgres
+// skip the monthly partitions from before it.
+
+func (r *messageRepository) GetByConversationID(ctx context.Context, conversationID uint, since time.Time, limit, offset int) ([]*entities.Message, error) {
+	var messages []*entities.Message
+	err := r.db.WithContext(ctx).
+		Preload("Sender").
+		Preload("Attachments").
+		Where("conversation_id = ? AND created_at >= ?", conversationID, since).
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&messages).Error
+	return messages, err
+}
+
+func (r *messageRepository) CountUnreadInConversation(ctx context.Context, conversationID, userID uint, since time.Time) (int64, error) {
+	var count int64
+	err := r.unreadQuery(ctx).
+		Where("messages.conversation_id = ? AND cp.user_id = ?", conversationID, userID).
+		Where("messages.created_at >= ?", since).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *messageRepository) CountUnread(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+	err := r.unreadQuery(ctx).
+		Where("cp.user_id = ? AND cp.muted_at IS NULL", userID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *messageRepository) CountUnreadByUser(ctx context.Context) (map[uint]int64, error) {
+	var rows []struct {
+		UserID uint
+		Count  int64
+	}
+
+	err := r.unreadQuery(ctx).
+		Select("cp.user_id AS user_id, COUNT(*) AS count").
+		Where("cp.muted_at IS NULL").
+		Group("cp.user_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uint]int64, len(rows))
+	for _, row := range rows {
+		counts[row.UserID] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *messageRepository) MarkDelivered(ctx context.Context, conversationID, recipientID uint, since, deliveredAt time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&entities.Message{}).
+		Where("conversation_id = ? AND sender_id <> ? AND delivered_at IS NULL", conversationID, recipientID).
+		Where("created_at >= ?", since).
+		Update("delivered_at", deliveredAt)
+	return result.RowsAffected, result.Error
+}
+
+func (r *messageRepository) MarkRead(ctx context.Context, conversationID, readerID uint, since, upTo, readAt time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&entities.Message{}).
+		Where("conversation_id = ? AND sender_id <> ? AND read_at IS NULL", conversationID, readerID).
+		Where("created_at >= ? AND created_at <= ?", since, upTo).
+		Updates(map[string]interface{}{
+			"read_at":      readAt,
+			"delivered_at": gorm.Expr("COALESCE(delivered_at, ?)", readAt),
+		})
+	return result.RowsAffected, result.Error
+}
+
+func (r *messageRepository) unreadQuery(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Model(&entities.Message{}).
+		Joins("JOIN conversation_participants cp ON cp.conversation_id = messages.conversation_id").
+		Where("messages.sender_id <> cp.user_id").
+		Where("(cp.last_read_at IS NULL OR messages.created_at > cp.last_read_at)")
+}
@@ -0,0 +1,715 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"linked-clone/internal/api/message/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/counter"
+	"linked-clone/pkg/imaging"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/media"
+	"linked-clone/pkg/presence"
+	"linked-clone/pkg/realtime"
+	"linked-clone/pkg/scanner"
+	"linked-clone/pkg/storage"
+	"mime/multipart"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type MessageService interface {
+	StartConversation(ctx context.Context, userID uint, req *dto.StartConversationRequest) (*dto.ConversationResponse, error)
+	GetConversations(ctx context.Context, userID uint, filter entities.ConversationFilter, limit, offset int) ([]*dto.ConversationResponse, error)
+	GetMessages(ctx context.Context, userID, conversationID uint, limit, offset int) ([]*dto.MessageResponse, error)
+	SendMessage(ctx context.Context, userID, conversationID uint, req *dto.SendMessageRequest, file *multipart.FileHeader) (*dto.MessageResponse, error)
+	MarkConversationRead(ctx context.Context, userID, conversationID uint) error
+	MarkMessageRead(ctx context.Context, userID, conversationID, messageID uint) error
+	ArchiveConversation(ctx context.Context, userID, conversationID uint, archived bool) error
+	MuteConversation(ctx context.Context, userID, conversationID uint, muted bool) error
+	DeleteConversation(ctx context.Context, userID, conversationID uint) error
+	GetUnreadCount(ctx context.Context, userID uint) (*dto.UnreadCountResponse, error)
+	GetSettings(ctx context.Context, userID uint) (*dto.MessageSettingsResponse, error)
+	UpdateSettings(ctx context.Context, userID uint, req *dto.UpdateMessageSettingsRequest) (*dto.MessageSettingsResponse, error)
+	HandleTyping(ctx context.Context, userID uint, data json.RawMessage)
+}
+
+type messageService struct {
+	conversationRepo repositories.ConversationRepository
+	messageRepo      repositories.MessageRepository
+	userRepo         repositories.UserRepository
+	unreadCounter    counter.UnreadCounter
+	hub              realtime.Hub
+	presence         presence.Tracker
+	storageService   storage.StorageService
+	mediaSigner      *media.Signer
+	scanner          scanner.Scanner
+	logger           logger.Logger
+}
+
+type attachmentRule struct {
+	attachmentType entities.AttachmentType
+	folder         string
+	maxSize        int64
+}
+
+var attachmentRules = map[string]attachmentRule{
+	".jpg":  {entities.AttachmentImage, "messages/images", 10 << 20},
+	".jpeg": {entities.AttachmentImage, "messages/images", 10 << 20},
+	".png":  {entities.AttachmentImage, "messages/images", 10 << 20},
+	".gif":  {entities.AttachmentImage, "messages/images", 10 << 20},
+	".webp": {entities.AttachmentImage, "messages/images", 10 << 20},
+	".mp3":  {entities.AttachmentVoice, "messages/voice", 5 << 20},
+	".m4a":  {entities.AttachmentVoice, "messages/voice", 5 << 20},
+	".ogg":  {entities.AttachmentVoice, "messages/voice", 5 << 20},
+	".wav":  {entities.AttachmentVoice, "messages/voice", 5 << 20},
+	".webm": {entities.AttachmentVoice, "messages/voice", 5 << 20},
+	".pdf":  {entities.AttachmentFile, "messages/files", 25 << 20},
+	".doc":  {entities.AttachmentFile, "messages/files", 25 << 20},
+	".docx": {entities.AttachmentFile, "messages/files", 25 << 20},
+	".txt":  {entities.AttachmentFile, "messages/files", 25 << 20},
+	".csv":  {entities.AttachmentFile, "messages/files", 25 << 20},
+	".xls":  {entities.AttachmentFile, "messages/files", 25 << 20},
+	".xlsx": {entities.AttachmentFile, "messages/files", 25 << 20},
+}
+
+func NewMessageService(
+	conversationRepo repositories.ConversationRepository,
+	messageRepo repositories.MessageRepository,
+	userRepo repositories.UserRepository,
+	unreadCounter counter.UnreadCounter,
+	hub realtime.Hub,
+	presence presence.Tracker,
+	storageService storage.StorageService,
+	mediaSigner *media.Signer,
+	scanner scanner.Scanner,
+	logger logger.Logger,
+) MessageService {
+	return &messageService{
+		conversationRepo: conversationRepo,
+		messageRepo:      messageRepo,
+		userRepo:         userRepo,
+		unreadCounter:    unreadCounter,
+		hub:              hub,
+		presence:         presence,
+		storageService:   storageService,
+		mediaSigner:      mediaSigner,
+		scanner:          scanner,
+		logger:           logger,
+	}
+}
+
+func (s *messageService) StartConversation(ctx context.Context, userID uint, req *dto.StartConversationRequest) (*dto.ConversationResponse, error) {
+	if userID == req.RecipientID {
+		return nil, errors.New("cannot start a conversation with yourself")
+	}
+
+	if _, err := s.userRepo.GetByID(ctx, req.RecipientID); err != nil {
+		return nil, errors.New("recipient not found")
+	}
+
+	conversation, err := s.conversationRepo.FindDirect(ctx, userID, req.RecipientID)
+	if err == nil {
+		return s.mapConversationToResponse(ctx, conversation, userID), nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.logger.Error("Failed to find conversation", "error", err)
+		return nil, errors.New("failed to start conversation")
+	}
+
+	conversation = &entities.Conversation{
+		Participants: []entities.ConversationParticipant{
+			{UserID: userID},
+			{UserID: req.RecipientID},
+		},
+	}
+
+	if err := s.conversationRepo.Create(ctx, conversation); err != nil {
+		s.logger.Error("Failed to create conversation", "error", err)
+		return nil, errors.New("failed to start conversation")
+	}
+
+	conversation, err = s.conversationRepo.GetByID(ctx, conversation.ID)
+	if err != nil {
+		return nil, errors.New("failed to get conversation")
+	}
+
+	return s.mapConversationToResponse(ctx, conversation, userID), nil
+}
+
+func (s *messageService) GetConversations(ctx context.Context, userID uint, filter entities.ConversationFilter, limit, offset int) ([]*dto.ConversationResponse, error) {
+	conversations, err := s.conversationRepo.GetUserConversations(ctx, userID, filter, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to get conversations", "error", err)
+		return nil, errors.New("failed to get conversations")
+	}
+
+	var responses []*dto.ConversationResponse
+	for _, conversation := range conversations {
+		responses = append(responses, s.mapConversationToResponse(ctx, conversation, userID))
+	}
+
+	return responses, nil
+}
+
+func (s *messageService) GetMessages(ctx context.Context, userID, conversationID uint, limit, offset int) ([]*dto.MessageResponse, error) {
+	conversation, err := s.getParticipatingConversation(ctx, conversationID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.markDelivered(ctx, conversation, userID)
+
+	since := conversation.CreatedAt
+	for _, participant := range conversation.Participants {
+		if participant.UserID == userID && participant.ClearedAt != nil && participant.ClearedAt.After(since) {
+			since = *participant.ClearedAt
+		}
+	}
+
+	messages, err := s.messageRepo.GetByConversationID(ctx, conversationID, since, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to get messages", "error", err)
+		return nil, errors.New("failed to get messages")
+	}
+
+	var responses []*dto.MessageResponse
+	for _, message := range messages {
+		responses = append(responses, s.mapMessageToResponse(message, userID))
+	}
+
+	return responses, nil
+}
+
+func (s *messageService) SendMessage(ctx context.Context, userID, conversationID uint, req *dto.SendMessageRequest, file *multipart.FileHeader) (*dto.MessageResponse, error) {
+	if strings.TrimSpace(req.Content) == "" && file == nil {
+		return nil, errors.New("message must have content or an attachment")
+	}
+
+	conversation, err := s.getParticipatingConversation(ctx, conversationID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	message := &entities.Message{
+		ConversationID: conversationID,
+		SenderID:       userID,
+		Content:        req.Content,
+	}
+
+	if file != nil {
+		attachment, err := s.uploadAttachment(ctx, file, req.DurationSeconds)
+		if err != nil {
+			return nil, err
+		}
+		message.Attachments = []entities.MessageAttachment{*attachment}
+	}
+
+	if err := s.messageRepo.Create(ctx, message); err != nil {
+		s.logger.Error("Failed to create message", "error", err)
+		for _, attachment := range message.Attachments {
+			s.deleteAttachmentFiles(ctx, &attachment)
+		}
+		return nil, errors.New("failed to send message")
+	}
+
+	if err := s.conversationRepo.UpdateLastMessageAt(ctx, conversationID, message.CreatedAt); err != nil {
+		s.logger.Error("Failed to update conversation", "error", err, "conversation_id", conversationID)
+	}
+
+	if err := s.conversationRepo.UpdateLastReadAt(ctx, conversationID, userID, message.CreatedAt); err != nil {
+		s.logger.Error("Failed to update sender read marker", "error", err, "conversation_id", conversationID)
+	}
+
+	if err := s.conversationRepo.Unarchive(ctx, conversationID); err != nil {
+		s.logger.Error("Failed to unarchive conversation", "error", err, "conversation_id", conversationID)
+	}
+
+	for _, participant := range conversation.Participants {
+		if participant.UserID == userID || participant.MutedAt != nil {
+			continue
+		}
+		if _, err := s.unreadCounter.Increment(ctx, counter.UnreadMessages, participant.UserID, 1); err != nil {
+			s.logger.Error("Failed to increment unread message counter", "error", err, "user_id", participant.UserID)
+		}
+	}
+
+	message, err = s.messageRepo.GetByIDAt(ctx, message.ID, message.CreatedAt)
+	if err != nil {
+		return nil, errors.New("failed to get message")
+	}
+
+	for _, participant := range conversation.Participants {
+		if participant.UserID == userID {
+			continue
+		}
+		if s.hub.SendToUser(participant.UserID, &realtime.Event{Type: realtime.EventMessageNew, Data: s.mapMessageToResponse(message, participant.UserID)}) {
+			s.markDelivered(ctx, conversation, participant.UserID)
+		}
+	}
+
+	return s.mapMessageToResponse(message, userID), nil
+}
+
+func (s *messageService) MarkConversationRead(ctx context.Context, userID, conversationID uint) error {
+	conversation, err := s.getParticipatingConversation(ctx, conversationID, userID)
+	if err != nil {
+		return err
+	}
+
+	return s.markRead(ctx, conversation, userID, 0, time.Now())
+}
+
+func (s *messageService) MarkMessageRead(ctx context.Context, userID, conversationID, messageID uint) error {
+	conversation, err := s.getParticipatingConversation(ctx, conversationID, userID)
+	if err != nil {
+		return err
+	}
+
+	message, err := s.messageRepo.GetByID(ctx, messageID)
+	if err != nil || message.ConversationID != conversationID {
+		return errors.New("message not found")
+	}
+
+	return s.markRead(ctx, conversation, userID, message.ID, message.CreatedAt)
+}
+
+func (s *messageService) markRead(ctx context.Context, conversation *entities.Conversation, userID, messageID uint, upTo time.Time) error {
+	before, err := s.messageRepo.CountUnreadInConversation(ctx, conversation.ID, userID, conversation.CreatedAt)
+	if err != nil {
+		s.logger.Error("Failed to count unread messages", "error", err)
+		return errors.New("failed to mark conversation as read")
+	}
+
+	if err := s.conversationRepo.UpdateLastReadAt(ctx, conversation.ID, userID, upTo); err != nil {
+		s.logger.Error("Failed to update read marker", "error", err)
+		return errors.New("failed to mark conversation as read")
+	}
+
+	after, err := s.messageRepo.CountUnreadInConversation(ctx, conversation.ID, userID, conversation.CreatedAt)
+	if err != nil {
+		s.logger.Error("Failed to count unread messages", "error", err)
+		after = 0
+	}
+
+	if before > after && !isMuted(conversation, userID) {
+		if _, err := s.unreadCounter.Decrement(ctx, counter.UnreadMessages, userID, before-after); err != nil {
+			s.logger.Error("Failed to decrement unread message counter", "error", err, "user_id", userID)
+		}
+	}
+
+	if !s.readReceiptsEnabled(ctx, userID) {
+		return nil
+	}
+
+	readAt := time.Now()
+	updated, err := s.messageRepo.MarkRead(ctx, conversation.ID, userID, conversation.CreatedAt, upTo, readAt)
+	if err != nil {
+		s.logger.Error("Failed to record read receipts", "error", err, "conversation_id", conversation.ID)
+		return nil
+	}
+
+	if updated > 0 {
+		s.broadcast(conversation, userID, &realtime.Event{
+			Type: realtime.EventMessageRead,
+			Data: &dto.ReceiptEvent{
+				ConversationID: conversation.ID,
+				UserID:         userID,
+				MessageID:      messageID,
+				At:             readAt,
+			},
+		})
+	}
+
+	return nil
+}
+
+func (s *messageService) ArchiveConversation(ctx context.Context, userID, conversationID uint, archived bool) error {
+	participant, err := s.conversationRepo.GetParticipant(ctx, conversationID, userID)
+	if err != nil {
+		return errors.New("conversation not found")
+	}
+
+	participant.ArchivedAt = nil
+	if archived {
+		now := time.Now()
+		participant.ArchivedAt = &now
+	}
+
+	if err := s.conversationRepo.UpdateParticipant(ctx, participant); err != nil {
+		s.logger.Error("Failed to update conversation archive state", "error", err)
+		return errors.New("failed to update conversation")
+	}
+
+	return nil
+}
+
+func (s *messageService) MuteConversation(ctx context.Context, userID, conversationID uint, muted bool) error {
+	participant, err := s.conversationRepo.GetParticipant(ctx, conversationID, userID)
+	if err != nil {
+		return errors.New("conversation not found")
+	}
+
+	participant.MutedAt = nil
+	if muted {
+		now := time.Now()
+		participant.MutedAt = &now
+	}
+
+	if err := s.conversationRepo.UpdateParticipant(ctx, participant); err != nil {
+		s.logger.Error("Failed to update conversation mute state", "error", err)
+		return errors.New("failed to update conversation")
+	}
+
+	s.syncUnreadCounter(ctx, userID)
+	return nil
+}
+
+func (s *messageService) DeleteConversation(ctx context.Context, userID, conversationID uint) error {
+	participant, err := s.conversationRepo.GetParticipant(ctx, conversationID, userID)
+	if err != nil {
+		return errors.New("conversation not found")
+	}
+
+	now := time.Now()
+	participant.ClearedAt = &now
+	participant.LastReadAt = &now
+	participant.ArchivedAt = nil
+
+	if err := s.conversationRepo.UpdateParticipant(ctx, participant); err != nil {
+		s.logger.Error("Failed to delete conversation", "error", err)
+		return errors.New("failed to delete conversation")
+	}
+
+	s.syncUnreadCounter(ctx, userID)
+	return nil
+}
+
+func (s *messageService) syncUnreadCounter(ctx context.Context, userID uint) {
+	count, err := s.messageRepo.CountUnread(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to count unread messages", "error", err, "user_id", userID)
+		return
+	}
+
+	if err := s.unreadCounter.Set(ctx, counter.UnreadMessages, userID, count); err != nil {
+		s.logger.Error("Failed to update unread message counter", "error", err, "user_id", userID)
+	}
+}
+
+func isMuted(conversation *entities.Conversation, userID uint) bool {
+	for _, participant := range conversation.Participants {
+		if participant.UserID == userID {
+			return participant.MutedAt != nil
+		}
+	}
+	return false
+}
+
+func (s *messageService) GetUnreadCount(ctx context.Context, userID uint) (*dto.UnreadCountResponse, error) {
+	value, found, err := s.unreadCounter.Get(ctx, counter.UnreadMessages, userID)
+	if err == nil && found {
+		return &dto.UnreadCountResponse{Messages: value}, nil
+	}
+	if err != nil {
+		s.logger.Error("Failed to read unread message counter, falling back to database", "error", err, "user_id", userID)
+	}
+
+	count, err := s.messageRepo.CountUnread(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to count unread messages", "error", err)
+		return nil, errors.New("failed to get unread message count")
+	}
+
+	if err := s.unreadCounter.Set(ctx, counter.UnreadMessages, userID, count); err != nil {
+		s.logger.Error("Failed to seed unread message counter", "error", err, "user_id", userID)
+	}
+
+	return &dto.UnreadCountResponse{Messages: count}, nil
+}
+
+func (s *messageService) uploadAttachment(ctx context.Context, file *multipart.FileHeader, durationSeconds *int) (*entities.MessageAttachment, error) {
+	ext := strings.ToLower(filepath.Ext(file.Filename))
+	rule, ok := attachmentRules[ext]
+	if !ok {
+		return nil, fmt.Errorf("unsupported attachment type: %s", ext)
+	}
+
+	if file.Size > rule.maxSize {
+		return nil, fmt.Errorf("%s attachment too large: max %d MB", rule.attachmentType, rule.maxSize>>20)
+	}
+
+	if err := s.scanner.Scan(ctx, file); err != nil {
+		s.logger.Warn("Attachment rejected by scanner", "error", err, "filename", file.Filename)
+		return nil, errors.New("attachment failed security scan")
+	}
+
+	fileKey, err := s.storageService.UploadFile(ctx, file, rule.folder)
+	if err != nil {
+		s.logger.Error("Failed to upload attachment", "error", err)
+		return nil, errors.New("failed to upload attachment")
+	}
+
+	attachment := &entities.MessageAttachment{
+		Type:        rule.attachmentType,
+		FileKey:     fileKey,
+		FileName:    file.Filename,
+		ContentType: storage.GetContentType(file.Filename),
+		Size:        file.Size,
+	}
+
+	switch rule.attachmentType {
+	case entities.AttachmentVoice:
+		attachment.DurationSeconds = durationSeconds
+	case entities.AttachmentImage:
+		attachment.ThumbnailKey = s.createThumbnail(ctx, file)
+	}
+
+	return attachment, nil
+}
+
+func (s *messageService) createThumbnail(ctx context.Context, file *multipart.FileHeader) string {
+	src, err := file.Open()
+	if err != nil {
+		return ""
+	}
+	defer src.Close()
+
+	thumbnail, err := imaging.Thumbnail(src, 320)
+	if err != nil {
+		s.logger.Debug("Skipping thumbnail generation", "error", err, "filename", file.Filename)
+		return ""
+	}
+
+	key, err := s.storageService.UploadBytes(ctx, thumbnail, "messages/thumbnails", ".jpg")
+	if err != nil {
+		s.logger.Error("Failed to upload thumbnail", "error", err)
+		return ""
+	}
+
+	return key
+}
+
+func (s *messageService) deleteAttachmentFiles(ctx context.Context, attachment *entities.MessageAttachment) {
+	if err := s.storageService.DeleteFile(ctx, attachment.FileKey); err != nil {
+		s.logger.Error("Failed to delete attachment", "error", err, "key", attachment.FileKey)
+	}
+	if attachment.ThumbnailKey != "" {
+		if err := s.storageService.DeleteFile(ctx, attachment.ThumbnailKey); err != nil {
+			s.logger.Error("Failed to delete thumbnail", "error", err, "key", attachment.ThumbnailKey)
+		}
+	}
+}
+
+func (s *messageService) GetSettings(ctx context.Context, userID uint) (*dto.MessageSettingsResponse, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	return &dto.MessageSettingsResponse{ReadReceipts: user.ReadReceipts}, nil
+}
+
+func (s *messageService) UpdateSettings(ctx context.Context, userID uint, req *dto.UpdateMessageSettingsRequest) (*dto.MessageSettingsResponse, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	user.ReadReceipts = *req.ReadReceipts
+
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		s.logger.Error("Failed to update message settings", "error", err)
+		return nil, errors.New("failed to update message settings")
+	}
+
+	return &dto.MessageSettingsResponse{ReadReceipts: user.ReadReceipts}, nil
+}
+
+func (s *messageService) HandleTyping(ctx context.Context, userID uint, data json.RawMessage) {
+	var event dto.TypingEvent
+	if err := json.Unmarshal(data, &event); err != nil || event.ConversationID == 0 {
+		return
+	}
+
+	if !s.readReceiptsEnabled(ctx, userID) {
+		return
+	}
+
+	conversation, err := s.getParticipatingConversation(ctx, event.ConversationID, userID)
+	if err != nil {
+		return
+	}
+
+	event.UserID = userID
+	s.broadcast(conversation, userID, &realtime.Event{Type: realtime.EventTyping, Data: &event})
+}
+
+func (s *messageService) getParticipatingConversation(ctx context.Context, conversationID, userID uint) (*entities.Conversation, error) {
+	conversation, err := s.conversationRepo.GetByID(ctx, conversationID)
+	if err != nil {
+		return nil, errors.New("conversation not found")
+	}
+
+	for _, participant := range conversation.Participants {
+		if participant.UserID == userID {
+			return conversation, nil
+		}
+	}
+
+	return nil, errors.New("conversation not found")
+}
+
+func (s *messageService) markDelivered(ctx context.Context, conversation *entities.Conversation, recipientID uint) {
+	deliveredAt := time.Now()
+	updated, err := s.messageRepo.MarkDelivered(ctx, conversation.ID, recipientID, conversation.CreatedAt, deliveredAt)
+	if err != nil {
+		s.logger.Error("Failed to mark messages as delivered", "error", err, "conversation_id", conversation.ID)
+		return
+	}
+	if updated == 0 {
+		return
+	}
+
+	s.broadcast(conversation, recipientID, &realtime.Event{
+		Type: realtime.EventMessageDelivered,
+		Data: &dto.ReceiptEvent{
+			ConversationID: conversation.ID,
+			UserID:         recipientID,
+			At:             deliveredAt,
+		},
+	})
+}
+
+func (s *messageService) broadcast(conversation *entities.Conversation, senderID uint, event *realtime.Event) {
+	for _, participant := range conversation.Participants {
+		if participant.UserID != senderID {
+			s.hub.SendToUser(participant.UserID, event)
+		}
+	}
+}
+
+func (s *messageService) readReceiptsEnabled(ctx context.Context, userID uint) bool {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return false
+	}
+	return user.ReadReceipts
+}
+
+func (s *messageService) mapConversationToResponse(ctx context.Context, conversation *entities.Conversation, userID uint) *dto.ConversationResponse {
+	response := &dto.ConversationResponse{
+		ID:            conversation.ID,
+		Subject:       conversation.Subject,
+		LastMessageAt: conversation.LastMessageAt,
+		CreatedAt:     conversation.CreatedAt,
+	}
+
+	for _, participant := range conversation.Participants {
+		info := s.mapUserInfo(&participant.User)
+		if participant.UserID != userID {
+			info.Presence = s.presenceFor(ctx, &participant.User)
+		}
+		response.Participants = append(response.Participants, info)
+		if participant.UserID == userID {
+			response.IsArchived = participant.ArchivedAt != nil
+			response.IsMuted = participant.MutedAt != nil
+		}
+	}
+
+	unread, err := s.messageRepo.CountUnreadInConversation(ctx, conversation.ID, userID, conversation.CreatedAt)
+	if err != nil {
+		s.logger.Error("Failed to count unread messages", "error", err, "conversation_id", conversation.ID)
+	}
+	response.UnreadCount = unread
+
+	return response
+}
+
+func (s *messageService) mapMessageToResponse(message *entities.Message, viewerID uint) *dto.MessageResponse {
+	status := dto.MessageStatusSent
+	if message.ReadAt != nil {
+		status = dto.MessageStatusRead
+	} else if message.DeliveredAt != nil {
+		status = dto.MessageStatusDelivered
+	}
+
+	var attachments []*dto.AttachmentResponse
+	for _, attachment := range message.Attachments {
+		attachments = append(attachments, s.mapAttachmentToResponse(&attachment, viewerID))
+	}
+
+	return &dto.MessageResponse{
+		ID:             message.ID,
+		ConversationID: message.ConversationID,
+		Content:        message.Content,
+		Status:         status,
+		DeliveredAt:    message.DeliveredAt,
+		ReadAt:         message.ReadAt,
+		Attachments:    attachments,
+		Sender:         s.mapUserInfo(&message.Sender),
+		CreatedAt:      message.CreatedAt,
+	}
+}
+
+// mapAttachmentToResponse links attachments through the media proxy rather
+// than presigning them, so a forwarded URL only works for viewerID and stops
+// working once they leave the conversation.
+func (s *messageService) mapAttachmentToResponse(attachment *entities.MessageAttachment, viewerID uint) *dto.AttachmentResponse {
+	response := &dto.AttachmentResponse{
+		ID:              attachment.ID,
+		Type:            string(attachment.Type),
+		FileName:        attachment.FileName,
+		ContentType:     attachment.ContentType,
+		Size:            attachment.Size,
+		DurationSeconds: attachment.DurationSeconds,
+	}
+
+	claims := media.Claims{
+		ViewerID: viewerID,
+		Kind:     media.KindMessageAttachment,
+		ParentID: attachment.MessageID,
+		ID:       attachment.ID,
+	}
+	response.URL = s.mediaSigner.URL(claims)
+	if attachment.ThumbnailKey != "" {
+		claims.Thumbnail = true
+		response.ThumbnailURL = s.mediaSigner.URL(claims)
+	}
+
+	return response
+}
+
+func (s *messageService) presenceFor(ctx context.Context, user *entities.User) *dto.PresenceResponse {
+	if s.presence == nil {
+		return nil
+	}
+	if !user.ShowPresence {
+		return &dto.PresenceResponse{Status: presence.StatusHidden}
+	}
+
+	status, lastActiveAt := s.presence.Resolve(ctx, user.ID, user.LastActiveAt)
+	return &dto.PresenceResponse{Status: status, LastActiveAt: lastActiveAt}
+}
+
+func (s *messageService) mapUserInfo(user *entities.User) *dto.UserInfo {
+	profilePicture := ""
+	if user.ProfilePicture != "" {
+		if url, err := s.storageService.GeneratePresignedURL(user.ProfilePicture, 15*time.Minute); err == nil {
+			profilePicture = url
+		}
+	}
+
+	return &dto.UserInfo{
+		ID:             user.ID,
+		Username:       user.Username,
+		FullName:       user.FullName,
+		ProfilePicture: profilePicture,
+	}
+}
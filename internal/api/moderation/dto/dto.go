@@ -0,0 +1,102 @@
+package dto
+
+import (
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type CreateReportRequest struct {
+	TargetType entities.ReportTargetType `json:"target_type" validate:"required,oneof=post comment job user"`
+	TargetID   uint                      `json:"target_id" validate:"required"`
+	Reason     entities.ReportReason     `json:"reason" validate:"required,oneof=spam harassment hate_speech violence sexual_content misinformation scam impersonation other"`
+	Details    string                    `json:"details" validate:"max=2000"`
+}
+
+type DismissReportRequest struct {
+	Note string `json:"note" validate:"max=2000"`
+}
+
+// ActionReportRequest must ask for at least one of hiding the content and
+// issuing a strike.
+type ActionReportRequest struct {
+	HideContent bool   `json:"hide_content"`
+	IssueStrike bool   `json:"issue_strike"`
+	Note        string `json:"note" validate:"required,min=3,max=2000"`
+}
+
+type ContentActionRequest struct {
+	Reason string `json:"reason" validate:"required,min=3,max=1000"`
+}
+
+type IssueStrikeRequest struct {
+	Reason string `json:"reason" validate:"required,min=3,max=1000"`
+}
+
+type RevokeStrikeRequest struct {
+	Reason string `json:"reason" validate:"required,min=3,max=1000"`
+}
+
+// ReportResponse is what reporters see of their own reports; the moderator
+// and their note are only shown to moderators.
+type ReportResponse struct {
+	ID         uint                      `json:"id"`
+	TargetType entities.ReportTargetType `json:"target_type"`
+	TargetID   uint                      `json:"target_id"`
+	Reason     entities.ReportReason     `json:"reason"`
+	Details    string                    `json:"details,omitempty"`
+	Status     entities.ReportStatus     `json:"status"`
+	ResolvedAt *time.Time                `json:"resolved_at,omitempty"`
+	CreatedAt  time.Time                 `json:"created_at"`
+}
+
+type ModerationReportResponse struct {
+	*ReportResponse
+	ReporterID    *uint     `json:"reporter_id,omitempty"`
+	TargetUserID  *uint     `json:"target_user_id,omitempty"`
+	ModeratorID   *uint     `json:"moderator_id,omitempty"`
+	ModeratorNote string    `json:"moderator_note,omitempty"`
+	ContentHidden bool      `json:"content_hidden"`
+	StrikeID      *uint     `json:"strike_id,omitempty"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type ReportListResponse struct {
+	Reports []*ModerationReportResponse `json:"reports"`
+	Total   int64                       `json:"total"`
+}
+
+// ReportTargetResponse is nil on a report detail when the target has since
+// been deleted.
+type ReportTargetResponse struct {
+	Type     entities.ReportTargetType `json:"type"`
+	ID       uint                      `json:"id"`
+	UserID   uint                      `json:"user_id"`
+	Summary  string                    `json:"summary"`
+	Hidden   bool                      `json:"hidden"`
+	HiddenAt *time.Time                `json:"hidden_at,omitempty"`
+}
+
+type ReportDetailResponse struct {
+	*ModerationReportResponse
+	Target            *ReportTargetResponse `json:"target"`
+	UnresolvedReports int64                 `json:"unresolved_reports"`
+	ActiveStrikes     int64                 `json:"active_strikes"`
+}
+
+type StrikeResponse struct {
+	ID          uint       `json:"id"`
+	UserID      uint       `json:"user_id"`
+	ReportID    *uint      `json:"report_id,omitempty"`
+	ModeratorID *uint      `json:"moderator_id,omitempty"`
+	Reason      string     `json:"reason"`
+	Active      bool       `json:"active"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type StrikeListResponse struct {
+	Strikes     []*StrikeResponse `json:"strikes"`
+	Active      int64             `json:"active"`
+	StrikeLimit int               `json:"strike_limit"`
+}
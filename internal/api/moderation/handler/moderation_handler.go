@@ -0,0 +1,354 @@
+package handler
+
+import (
+	"context"
+	"linked-clone/internal/api/moderation/dto"
+	"linked-clone/internal/api/moderation/service"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/request"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ModerationHandler struct {
+	moderationService service.ModerationService
+	validator         validation.Validator
+	logger            logger.Logger
+}
+
+func NewModerationHandler(moderationService service.ModerationService, validator validation.Validator, logger logger.Logger) *ModerationHandler {
+	return &ModerationHandler{
+		moderationService: moderationService,
+		validator:         validator,
+		logger:            logger,
+	}
+}
+
+func (h *ModerationHandler) CreateReport(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.CreateReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	report, err := h.moderationService.CreateReport(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.logger.Error("Failed to create report", "error", err)
+		response.Error(c, moderationErrorStatus(err), "Failed to create report", err.Error())
+		return
+	}
+
+	response.CreatedWithMessage(c, "Report submitted", report)
+}
+
+func (h *ModerationHandler) GetMyReports(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	limit, offset := request.Pagination(c)
+
+	reports, err := h.moderationService.GetMyReports(c.Request.Context(), userID, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get reports", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get reports", err.Error())
+		return
+	}
+
+	response.Success(c, reports)
+}
+
+func (h *ModerationHandler) GetReports(c *gin.Context) {
+	limit, offset := request.Pagination(c)
+
+	filter := entities.ReportFilter{
+		Status:     entities.ReportStatus(c.Query("status")),
+		TargetType: entities.ReportTargetType(c.Query("target_type")),
+		Reason:     entities.ReportReason(c.Query("reason")),
+	}
+	switch filter.Status {
+	case "", entities.ReportOpen, entities.ReportInReview, entities.ReportActioned, entities.ReportDismissed:
+	default:
+		response.Error(c, http.StatusBadRequest, "Invalid status", "status must be open, in_review, actioned or dismissed")
+		return
+	}
+	switch filter.TargetType {
+	case "", entities.ReportTargetPost, entities.ReportTargetComment, entities.ReportTargetJob, entities.ReportTargetUser:
+	default:
+		response.Error(c, http.StatusBadRequest, "Invalid target type", "target_type must be post, comment, job or user")
+		return
+	}
+
+	reports, err := h.moderationService.ListReports(c.Request.Context(), filter, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get reports", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get reports", err.Error())
+		return
+	}
+
+	response.Success(c, reports)
+}
+
+func (h *ModerationHandler) GetReport(c *gin.Context) {
+	reportID, ok := request.ParseID(c, "id", "Invalid report ID")
+	if !ok {
+		return
+	}
+
+	report, err := h.moderationService.GetReport(c.Request.Context(), reportID)
+	if err != nil {
+		h.logger.Error("Failed to get report", "error", err)
+		response.Error(c, moderationErrorStatus(err), "Failed to get report", err.Error())
+		return
+	}
+
+	response.Success(c, report)
+}
+
+func (h *ModerationHandler) ClaimReport(c *gin.Context) {
+	h.moveReport(c, "claim", h.moderationService.ClaimReport)
+}
+
+func (h *ModerationHandler) ReleaseReport(c *gin.Context) {
+	h.moveReport(c, "release", h.moderationService.ReleaseReport)
+}
+
+func (h *ModerationHandler) ReopenReport(c *gin.Context) {
+	h.moveReport(c, "reopen", h.moderationService.ReopenReport)
+}
+
+func (h *ModerationHandler) moveReport(c *gin.Context, action string, move func(ctx context.Context, moderatorID, reportID uint) (*dto.ModerationReportResponse, error)) {
+	moderatorID := middleware.GetUserID(c)
+
+	reportID, ok := request.ParseID(c, "id", "Invalid report ID")
+	if !ok {
+		return
+	}
+
+	report, err := move(c.Request.Context(), moderatorID, reportID)
+	if err != nil {
+		h.logger.Error("Failed to "+action+" report", "error", err)
+		response.Error(c, moderationErrorStatus(err), "Failed to "+action+" report", err.Error())
+		return
+	}
+
+	response.Success(c, report)
+}
+
+func (h *ModerationHandler) DismissReport(c *gin.Context) {
+	moderatorID := middleware.GetUserID(c)
+
+	reportID, ok := request.ParseID(c, "id", "Invalid report ID")
+	if !ok {
+		return
+	}
+
+	// The note is optional here, so an empty body is accepted.
+	var req dto.DismissReportRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+			return
+		}
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	ctx := context.WithValue(c.Request.Context(), "gin_context", c)
+
+	report, err := h.moderationService.DismissReport(ctx, moderatorID, reportID, &req)
+	if err != nil {
+		h.logger.Error("Failed to dismiss report", "error", err)
+		response.Error(c, moderationErrorStatus(err), "Failed to dismiss report", err.Error())
+		return
+	}
+
+	response.Success(c, report)
+}
+
+func (h *ModerationHandler) ActionReport(c *gin.Context) {
+	moderatorID := middleware.GetUserID(c)
+
+	reportID, ok := request.ParseID(c, "id", "Invalid report ID")
+	if !ok {
+		return
+	}
+
+	var req dto.ActionReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	ctx := context.WithValue(c.Request.Context(), "gin_context", c)
+
+	report, err := h.moderationService.ActionReport(ctx, moderatorID, reportID, &req)
+	if err != nil {
+		h.logger.Error("Failed to action report", "error", err)
+		response.Error(c, moderationErrorStatus(err), "Failed to action report", err.Error())
+		return
+	}
+
+	response.Success(c, report)
+}
+
+func (h *ModerationHandler) HideContent(c *gin.Context) {
+	h.changeContent(c, "hide", "Content hidden", h.moderationService.HideContent)
+}
+
+func (h *ModerationHandler) RestoreContent(c *gin.Context) {
+	h.changeContent(c, "restore", "Content restored", h.moderationService.RestoreContent)
+}
+
+func (h *ModerationHandler) changeContent(c *gin.Context, action, message string, change func(ctx context.Context, moderatorID uint, targetType entities.ReportTargetType, targetID uint, req *dto.ContentActionRequest) error) {
+	moderatorID := middleware.GetUserID(c)
+
+	targetType := entities.ReportTargetType(c.Param("type"))
+	if !targetType.Hideable() {
+		response.Error(c, http.StatusBadRequest, "Invalid content type", "type must be post, comment or job")
+		return
+	}
+
+	targetID, ok := request.ParseID(c, "id", "Invalid content ID")
+	if !ok {
+		return
+	}
+
+	var req dto.ContentActionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	ctx := context.WithValue(c.Request.Context(), "gin_context", c)
+
+	if err := change(ctx, moderatorID, targetType, targetID, &req); err != nil {
+		h.logger.Error("Failed to "+action+" content", "error", err)
+		response.Error(c, moderationErrorStatus(err), "Failed to "+action+" content", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": message})
+}
+
+func (h *ModerationHandler) GetStrikes(c *gin.Context) {
+	userID, ok := request.ParseID(c, "id", "Invalid user ID")
+	if !ok {
+		return
+	}
+
+	strikes, err := h.moderationService.GetStrikes(c.Request.Context(), userID)
+	if err != nil {
+		h.logger.Error("Failed to get strikes", "error", err)
+		response.Error(c, moderationErrorStatus(err), "Failed to get strikes", err.Error())
+		return
+	}
+
+	response.Success(c, strikes)
+}
+
+func (h *ModerationHandler) IssueStrike(c *gin.Context) {
+	moderatorID := middleware.GetUserID(c)
+
+	userID, ok := request.ParseID(c, "id", "Invalid user ID")
+	if !ok {
+		return
+	}
+
+	var req dto.IssueStrikeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	ctx := context.WithValue(c.Request.Context(), "gin_context", c)
+
+	strike, err := h.moderationService.IssueStrike(ctx, moderatorID, userID, &req)
+	if err != nil {
+		h.logger.Error("Failed to issue strike", "error", err)
+		response.Error(c, moderationErrorStatus(err), "Failed to issue strike", err.Error())
+		return
+	}
+
+	response.Created(c, strike)
+}
+
+func (h *ModerationHandler) RevokeStrike(c *gin.Context) {
+	moderatorID := middleware.GetUserID(c)
+
+	strikeID, ok := request.ParseID(c, "id", "Invalid strike ID")
+	if !ok {
+		return
+	}
+
+	var req dto.RevokeStrikeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	ctx := context.WithValue(c.Request.Context(), "gin_context", c)
+
+	strike, err := h.moderationService.RevokeStrike(ctx, moderatorID, strikeID, &req)
+	if err != nil {
+		h.logger.Error("Failed to revoke strike", "error", err)
+		response.Error(c, moderationErrorStatus(err), "Failed to revoke strike", err.Error())
+		return
+	}
+
+	response.Success(c, strike)
+}
+
+func moderationErrorStatus(err error) int {
+	if strings.HasPrefix(err.Error(), "report cannot move from") {
+		return http.StatusConflict
+	}
+
+	switch err.Error() {
+	case "report not found", "report target not found", "user not found", "strike not found":
+		return http.StatusNotFound
+	case "cannot report your own content":
+		return http.StatusForbidden
+	case "choose at least one action", "content cannot be hidden":
+		return http.StatusBadRequest
+	case "already reported", "content is already hidden", "content is not hidden",
+		"strike is already revoked", "reported account no longer exists",
+		"report was changed by another moderator":
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
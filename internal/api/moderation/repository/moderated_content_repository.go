@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type moderatedContentRepository struct {
+	db *gorm.DB
+}
+
+func NewModeratedContentRepository(db *gorm.DB) repositories.ModeratedContentRepository {
+	return &moderatedContentRepository{db: db}
+}
+
+// targetQueries select each kind of target as id, user_id, summary and
+// hidden_at.
+var targetQueries = map[entities.ReportTargetType]struct {
+	table  string
+	fields string
+}{
+	entities.ReportTargetPost:    {"posts", "id, user_id, content AS summary, hidden_at"},
+	entities.ReportTargetComment: {"comments", "id, user_id, content AS summary, hidden_at"},
+	entities.ReportTargetJob:     {"jobs", "id, user_id, title || ' at ' || company AS summary, hidden_at"},
+	entities.ReportTargetUser:    {"users", "id, id AS user_id, full_name AS summary, NULL AS hidden_at"},
+}
+
+func (r *moderatedContentRepository) GetTarget(ctx context.Context, targetType entities.ReportTargetType, targetID uint) (*entities.ReportTarget, error) {
+	query, ok := targetQueries[targetType]
+	if !ok {
+		return nil, errors.New("unknown report target type")
+	}
+
+	var target entities.ReportTarget
+	err := r.db.WithContext(ctx).
+		Table(query.table).
+		Select(query.fields).
+		Where("id = ? AND deleted_at IS NULL", targetID).
+		Take(&target).Error
+	if err != nil {
+		return nil, err
+	}
+	target.Type = targetType
+	return &target, nil
+}
+
+func (r *moderatedContentRepository) SetHidden(ctx context.Context, targetType entities.ReportTargetType, targetID uint, hiddenAt *time.Time) error {
+	if !targetType.Hideable() {
+		return errors.New("report target cannot be hidden")
+	}
+
+	updates := map[string]interface{}{"hidden_at": hiddenAt}
+	if targetType == entities.ReportTargetJob && hiddenAt != nil {
+		updates["is_active"] = false
+	}
+
+	result := r.db.WithContext(ctx).
+		Table(targetQueries[targetType].table).
+		Where("id = ? AND deleted_at IS NULL", targetID).
+		UpdateColumns(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
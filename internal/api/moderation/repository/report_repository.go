@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type reportRepository struct {
+	db *gorm.DB
+}
+
+func NewReportRepository(db *gorm.DB) repositories.ReportRepository {
+	return &reportRepository{db: db}
+}
+
+func (r *reportRepository) Create(ctx context.Context, report *entities.Report) error {
+	return r.db.WithContext(ctx).Create(report).Error
+}
+
+func (r *reportRepository) GetByID(ctx context.Context, id uint) (*entities.Report, error) {
+	var report entities.Report
+	if err := r.db.WithContext(ctx).First(&report, id).Error; err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+func (r *reportRepository) UpdateStatus(ctx context.Context, report *entities.Report, from entities.ReportStatus) (bool, error) {
+	report.UpdatedAt = time.Now()
+	result := r.db.WithContext(ctx).
+		Model(&entities.Report{}).
+		Where("id = ? AND status = ?", report.ID, from).
+		Updates(map[string]interface{}{
+			"status":         report.Status,
+			"moderator_id":   report.ModeratorID,
+			"moderator_note": report.ModeratorNote,
+			"resolved_at":    report.ResolvedAt,
+			"updated_at":     report.UpdatedAt,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *reportRepository) Decide(ctx context.Context, report *entities.Report, strike *entities.Strike) (bool, error) {
+	decided := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		report.UpdatedAt = time.Now()
+		result := tx.Model(&entities.Report{}).
+			Where("id = ? AND status IN ?", report.ID, []entities.ReportStatus{entities.ReportOpen, entities.ReportInReview}).
+			Updates(map[string]interface{}{
+				"status":         report.Status,
+				"moderator_id":   report.ModeratorID,
+				"moderator_note": report.ModeratorNote,
+				"content_hidden": report.ContentHidden,
+				"resolved_at":    report.ResolvedAt,
+				"updated_at":     report.UpdatedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		if report.ContentHidden {
+			content := &moderatedContentRepository{db: tx}
+			if err := content.SetHidden(ctx, report.TargetType, report.TargetID, report.ResolvedAt); err != nil {
+				return err
+			}
+		}
+
+		if strike != nil {
+			if err := tx.Create(strike).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(&entities.Report{}).Where("id = ?", report.ID).Update("strike_id", strike.ID).Error; err != nil {
+				return err
+			}
+			report.StrikeID = &strike.ID
+		}
+
+		decided = true
+		return nil
+	})
+	return decided, err
+}
+
+func (r *reportRepository) List(ctx context.Context, filter entities.ReportFilter, limit, offset int) ([]*entities.Report, int64, error) {
+	var reports []*entities.Report
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&entities.Report{})
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.TargetType != "" {
+		query = query.Where("target_type = ?", filter.TargetType)
+	}
+	if filter.Reason != "" {
+		query = query.Where("reason = ?", filter.Reason)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Order("created_at ASC, id ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&reports).Error
+	return reports, total, err
+}
+
+func (r *reportRepository) ListByReporter(ctx context.Context, reporterID uint, limit, offset int) ([]*entities.Report, error) {
+	var reports []*entities.Report
+	err := r.db.WithContext(ctx).
+		Where("reporter_id = ?", reporterID).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&reports).Error
+	return reports, err
+}
+
+func (r *reportRepository) HasReported(ctx context.Context, reporterID uint, targetType entities.ReportTargetType, targetID uint) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.Report{}).
+		Where("reporter_id = ? AND target_type = ? AND target_id = ?", reporterID, targetType, targetID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *reportRepository) CountUnresolvedForTarget(ctx context.Context, targetType entities.ReportTargetType, targetID uint) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.Report{}).
+		Where("target_type = ? AND target_id = ? AND status IN ?", targetType, targetID,
+			[]entities.ReportStatus{entities.ReportOpen, entities.ReportInReview}).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *reportRepository) ResolveForTarget(ctx context.Context, decided *entities.Report) ([]*entities.Report, error) {
+	var resolved []*entities.Report
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE reports
+		SET status = ?, moderator_id = ?, moderator_note = ?, content_hidden = ?, resolved_at = ?, updated_at = ?
+		WHERE target_type = ? AND target_id = ? AND id <> ? AND status IN (?, ?)
+		RETURNING *`,
+		decided.Status, decided.ModeratorID, decided.ModeratorNote, decided.ContentHidden, decided.ResolvedAt, decided.ResolvedAt,
+		decided.TargetType, decided.TargetID, decided.ID, entities.ReportOpen, entities.ReportInReview,
+	).Scan(&resolved).Error
+	return resolved, err
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type strikeRepository struct {
+	db *gorm.DB
+}
+
+func NewStrikeRepository(db *gorm.DB) repositories.StrikeRepository {
+	return &strikeRepository{db: db}
+}
+
+func (r *strikeRepository) Create(ctx context.Context, strike *entities.Strike) error {
+	return r.db.WithContext(ctx).Create(strike).Error
+}
+
+func (r *strikeRepository) GetByID(ctx context.Context, id uint) (*entities.Strike, error) {
+	var strike entities.Strike
+	if err := r.db.WithContext(ctx).First(&strike, id).Error; err != nil {
+		return nil, err
+	}
+	return &strike, nil
+}
+
+func (r *strikeRepository) Update(ctx context.Context, strike *entities.Strike) error {
+	return r.db.WithContext(ctx).Save(strike).Error
+}
+
+func (r *strikeRepository) ListByUser(ctx context.Context, userID uint) ([]*entities.Strike, error) {
+	var strikes []*entities.Strike
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC, id DESC").
+		Find(&strikes).Error
+	return strikes, err
+}
+
+func (r *strikeRepository) CountActive(ctx context.Context, userID uint, now time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.Strike{}).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, now).
+		Count(&count).Error
+	return count, err
+}
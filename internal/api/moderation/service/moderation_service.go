@@ -0,0 +1,663 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	adminDto "linked-clone/internal/api/admin/dto"
+	adminService "linked-clone/internal/api/admin/service"
+	"linked-clone/internal/api/moderation/dto"
+	notificationDto "linked-clone/internal/api/notification/dto"
+	notificationService "linked-clone/internal/api/notification/service"
+	"linked-clone/internal/config"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+)
+
+const maxSummaryLen = 280
+
+type ModerationService interface {
+	CreateReport(ctx context.Context, reporterID uint, req *dto.CreateReportRequest) (*dto.ReportResponse, error)
+	GetMyReports(ctx context.Context, reporterID uint, limit, offset int) ([]*dto.ReportResponse, error)
+	ListReports(ctx context.Context, filter entities.ReportFilter, limit, offset int) (*dto.ReportListResponse, error)
+	GetReport(ctx context.Context, reportID uint) (*dto.ReportDetailResponse, error)
+	ClaimReport(ctx context.Context, moderatorID, reportID uint) (*dto.ModerationReportResponse, error)
+	ReleaseReport(ctx context.Context, moderatorID, reportID uint) (*dto.ModerationReportResponse, error)
+	ReopenReport(ctx context.Context, moderatorID, reportID uint) (*dto.ModerationReportResponse, error)
+	DismissReport(ctx context.Context, moderatorID, reportID uint, req *dto.DismissReportRequest) (*dto.ModerationReportResponse, error)
+	ActionReport(ctx context.Context, moderatorID, reportID uint, req *dto.ActionReportRequest) (*dto.ModerationReportResponse, error)
+	HideContent(ctx context.Context, moderatorID uint, targetType entities.ReportTargetType, targetID uint, req *dto.ContentActionRequest) error
+	RestoreContent(ctx context.Context, moderatorID uint, targetType entities.ReportTargetType, targetID uint, req *dto.ContentActionRequest) error
+	GetStrikes(ctx context.Context, userID uint) (*dto.StrikeListResponse, error)
+	IssueStrike(ctx context.Context, moderatorID, userID uint, req *dto.IssueStrikeRequest) (*dto.StrikeResponse, error)
+	RevokeStrike(ctx context.Context, moderatorID, strikeID uint, req *dto.RevokeStrikeRequest) (*dto.StrikeResponse, error)
+}
+
+type moderationService struct {
+	reportRepo      repositories.ReportRepository
+	contentRepo     repositories.ModeratedContentRepository
+	strikeRepo      repositories.StrikeRepository
+	userRepo        repositories.UserRepository
+	adminSvc        adminService.AdminService
+	notificationSvc notificationService.NotificationService
+	config          config.ModerationConfig
+	logger          logger.Logger
+}
+
+func NewModerationService(
+	reportRepo repositories.ReportRepository,
+	contentRepo repositories.ModeratedContentRepository,
+	strikeRepo repositories.StrikeRepository,
+	userRepo repositories.UserRepository,
+	adminSvc adminService.AdminService,
+	notificationSvc notificationService.NotificationService,
+	config config.ModerationConfig,
+	logger logger.Logger,
+) ModerationService {
+	return &moderationService{
+		reportRepo:      reportRepo,
+		contentRepo:     contentRepo,
+		strikeRepo:      strikeRepo,
+		userRepo:        userRepo,
+		adminSvc:        adminSvc,
+		notificationSvc: notificationSvc,
+		config:          config,
+		logger:          logger,
+	}
+}
+
+// CreateReport files a report against content the reporter can see. Each
+// user can report a target once, so repeated reports do not push it up the
+// queue.
+func (s *moderationService) CreateReport(ctx context.Context, reporterID uint, req *dto.CreateReportRequest) (*dto.ReportResponse, error) {
+	target, err := s.getTarget(ctx, req.TargetType, req.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	if target.HiddenAt != nil {
+		return nil, errors.New("report target not found")
+	}
+	if target.UserID == reporterID {
+		return nil, errors.New("cannot report your own content")
+	}
+
+	reported, err := s.reportRepo.HasReported(ctx, reporterID, req.TargetType, req.TargetID)
+	if err != nil {
+		s.logger.Error("Failed to check existing report", "error", err)
+		return nil, errors.New("failed to create report")
+	}
+	if reported {
+		return nil, errors.New("already reported")
+	}
+
+	targetUserID := target.UserID
+	report := &entities.Report{
+		ReporterID:   &reporterID,
+		TargetType:   req.TargetType,
+		TargetID:     req.TargetID,
+		TargetUserID: &targetUserID,
+		Reason:       req.Reason,
+		Details:      strings.TrimSpace(req.Details),
+		Status:       entities.ReportOpen,
+	}
+	if err := s.reportRepo.Create(ctx, report); err != nil {
+		s.logger.Error("Failed to create report", "error", err)
+		return nil, errors.New("failed to create report")
+	}
+
+	return toReportResponse(report), nil
+}
+
+func (s *moderationService) GetMyReports(ctx context.Context, reporterID uint, limit, offset int) ([]*dto.ReportResponse, error) {
+	reports, err := s.reportRepo.ListByReporter(ctx, reporterID, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list reports", "error", err)
+		return nil, errors.New("failed to get reports")
+	}
+
+	responses := make([]*dto.ReportResponse, 0, len(reports))
+	for _, report := range reports {
+		responses = append(responses, toReportResponse(report))
+	}
+	return responses, nil
+}
+
+func (s *moderationService) ListReports(ctx context.Context, filter entities.ReportFilter, limit, offset int) (*dto.ReportListResponse, error) {
+	reports, total, err := s.reportRepo.List(ctx, filter, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list reports", "error", err)
+		return nil, errors.New("failed to get reports")
+	}
+
+	responses := make([]*dto.ModerationReportResponse, 0, len(reports))
+	for _, report := range reports {
+		responses = append(responses, toModerationReportResponse(report))
+	}
+	return &dto.ReportListResponse{Reports: responses, Total: total}, nil
+}
+
+func (s *moderationService) GetReport(ctx context.Context, reportID uint) (*dto.ReportDetailResponse, error) {
+	report, err := s.getReport(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &dto.ReportDetailResponse{ModerationReportResponse: toModerationReportResponse(report)}
+
+	if target, err := s.contentRepo.GetTarget(ctx, report.TargetType, report.TargetID); err == nil {
+		detail.Target = &dto.ReportTargetResponse{
+			Type:     target.Type,
+			ID:       target.ID,
+			UserID:   target.UserID,
+			Summary:  truncate(target.Summary, maxSummaryLen),
+			Hidden:   target.HiddenAt != nil,
+			HiddenAt: target.HiddenAt,
+		}
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.logger.Error("Failed to get report target", "error", err, "report_id", reportID)
+	}
+
+	if count, err := s.reportRepo.CountUnresolvedForTarget(ctx, report.TargetType, report.TargetID); err == nil {
+		detail.UnresolvedReports = count
+	} else {
+		s.logger.Error("Failed to count reports of target", "error", err, "report_id", reportID)
+	}
+
+	if report.TargetUserID != nil {
+		if count, err := s.strikeRepo.CountActive(ctx, *report.TargetUserID, time.Now()); err == nil {
+			detail.ActiveStrikes = count
+		} else {
+			s.logger.Error("Failed to count strikes", "error", err, "user_id", *report.TargetUserID)
+		}
+	}
+
+	return detail, nil
+}
+
+// ClaimReport marks a report as being reviewed so other moderators can pass
+// over it. A claim does not stop anyone else deciding the report.
+func (s *moderationService) ClaimReport(ctx context.Context, moderatorID, reportID uint) (*dto.ModerationReportResponse, error) {
+	return s.transition(ctx, reportID, entities.ReportInReview, func(report *entities.Report) {
+		report.ModeratorID = &moderatorID
+	})
+}
+
+func (s *moderationService) ReleaseReport(ctx context.Context, moderatorID, reportID uint) (*dto.ModerationReportResponse, error) {
+	return s.transition(ctx, reportID, entities.ReportOpen, func(report *entities.Report) {
+		report.ModeratorID = nil
+	})
+}
+
+// ReopenReport puts a dismissed report back in the queue, for when a
+// dismissal turns out to be wrong.
+func (s *moderationService) ReopenReport(ctx context.Context, moderatorID, reportID uint) (*dto.ModerationReportResponse, error) {
+	return s.transition(ctx, reportID, entities.ReportOpen, func(report *entities.Report) {
+		report.ModeratorID = nil
+		report.ModeratorNote = ""
+		report.ResolvedAt = nil
+	})
+}
+
+func (s *moderationService) DismissReport(ctx context.Context, moderatorID, reportID uint, req *dto.DismissReportRequest) (*dto.ModerationReportResponse, error) {
+	now := time.Now()
+	report, _, err := s.decide(ctx, reportID, entities.ReportDismissed, func(report *entities.Report) (*entities.Strike, error) {
+		report.ModeratorID = &moderatorID
+		report.ModeratorNote = req.Note
+		report.ResolvedAt = &now
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyReporter(ctx, report)
+	if report.TargetUserID != nil {
+		s.adminSvc.Audit(ctx, moderatorID, *report.TargetUserID, entities.AdminActionReportDismissed, req.Note, map[string]interface{}{
+			"report_id":   report.ID,
+			"target_type": report.TargetType,
+			"target_id":   report.TargetID,
+		})
+	}
+
+	return toModerationReportResponse(report), nil
+}
+
+// ActionReport hides the reported content, strikes its owner, or both.
+func (s *moderationService) ActionReport(ctx context.Context, moderatorID, reportID uint, req *dto.ActionReportRequest) (*dto.ModerationReportResponse, error) {
+	if !req.HideContent && !req.IssueStrike {
+		return nil, errors.New("choose at least one action")
+	}
+
+	now := time.Now()
+	report, strike, err := s.decide(ctx, reportID, entities.ReportActioned, func(report *entities.Report) (*entities.Strike, error) {
+		if req.HideContent {
+			if !report.TargetType.Hideable() {
+				return nil, errors.New("content cannot be hidden")
+			}
+			report.ContentHidden = true
+		}
+
+		var strike *entities.Strike
+		if req.IssueStrike {
+			if report.TargetUserID == nil {
+				return nil, errors.New("reported account no longer exists")
+			}
+			strike = s.newStrike(moderatorID, *report.TargetUserID, &report.ID, req.Note, now)
+		}
+
+		report.ModeratorID = &moderatorID
+		report.ModeratorNote = req.Note
+		report.ResolvedAt = &now
+		return strike, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyReporter(ctx, report)
+
+	if report.TargetUserID != nil {
+		if report.ContentHidden {
+			s.notify(ctx, *report.TargetUserID, entities.NotificationContentHidden, string(report.TargetType), report.TargetID,
+				fmt.Sprintf("Your %s was hidden for going against our policies on %s", report.TargetType, reasonText(report.Reason)))
+		}
+		s.adminSvc.Audit(ctx, moderatorID, *report.TargetUserID, entities.AdminActionReportActioned, req.Note, map[string]interface{}{
+			"report_id":      report.ID,
+			"target_type":    report.TargetType,
+			"target_id":      report.TargetID,
+			"content_hidden": report.ContentHidden,
+			"strike_id":      report.StrikeID,
+		})
+	}
+	if strike != nil {
+		s.afterStrike(ctx, moderatorID, strike)
+	}
+
+	return toModerationReportResponse(report), nil
+}
+
+// HideContent hides an item without a report, such as one found while
+// reviewing another.
+func (s *moderationService) HideContent(ctx context.Context, moderatorID uint, targetType entities.ReportTargetType, targetID uint, req *dto.ContentActionRequest) error {
+	target, err := s.getTarget(ctx, targetType, targetID)
+	if err != nil {
+		return err
+	}
+	if target.HiddenAt != nil {
+		return errors.New("content is already hidden")
+	}
+
+	if err := s.hide(ctx, targetType, targetID, time.Now()); err != nil {
+		return err
+	}
+
+	s.notify(ctx, target.UserID, entities.NotificationContentHidden, string(targetType), targetID,
+		fmt.Sprintf("Your %s was hidden for going against our policies", targetType))
+	s.adminSvc.Audit(ctx, moderatorID, target.UserID, entities.AdminActionContentHidden, req.Reason, map[string]interface{}{
+		"target_type": targetType,
+		"target_id":   targetID,
+	})
+	return nil
+}
+
+// RestoreContent makes a hidden item visible again. Reports already
+// actioned stay actioned; a restored job stays closed until its owner
+// reopens it.
+func (s *moderationService) RestoreContent(ctx context.Context, moderatorID uint, targetType entities.ReportTargetType, targetID uint, req *dto.ContentActionRequest) error {
+	target, err := s.getTarget(ctx, targetType, targetID)
+	if err != nil {
+		return err
+	}
+	if !targetType.Hideable() {
+		return errors.New("content cannot be hidden")
+	}
+	if target.HiddenAt == nil {
+		return errors.New("content is not hidden")
+	}
+
+	if err := s.contentRepo.SetHidden(ctx, targetType, targetID, nil); err != nil {
+		s.logger.Error("Failed to restore content", "error", err, "target_type", targetType, "target_id", targetID)
+		return errors.New("failed to restore content")
+	}
+
+	s.adminSvc.Audit(ctx, moderatorID, target.UserID, entities.AdminActionContentRestored, req.Reason, map[string]interface{}{
+		"target_type": targetType,
+		"target_id":   targetID,
+		"hidden_at":   target.HiddenAt,
+	})
+	return nil
+}
+
+func (s *moderationService) GetStrikes(ctx context.Context, userID uint) (*dto.StrikeListResponse, error) {
+	if _, err := s.getUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	strikes, err := s.strikeRepo.ListByUser(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to list strikes", "error", err, "user_id", userID)
+		return nil, errors.New("failed to get strikes")
+	}
+
+	now := time.Now()
+	response := &dto.StrikeListResponse{
+		Strikes:     make([]*dto.StrikeResponse, 0, len(strikes)),
+		StrikeLimit: s.config.StrikeLimit,
+	}
+	for _, strike := range strikes {
+		if strike.Active(now) {
+			response.Active++
+		}
+		response.Strikes = append(response.Strikes, toStrikeResponse(strike, now))
+	}
+	return response, nil
+}
+
+func (s *moderationService) IssueStrike(ctx context.Context, moderatorID, userID uint, req *dto.IssueStrikeRequest) (*dto.StrikeResponse, error) {
+	if _, err := s.getUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	strike, err := s.issueStrike(ctx, moderatorID, userID, nil, req.Reason, now)
+	if err != nil {
+		return nil, err
+	}
+
+	s.afterStrike(ctx, moderatorID, strike)
+	return toStrikeResponse(strike, now), nil
+}
+
+// RevokeStrike takes back a strike, for example after an appeal. It does
+// not lift a suspension the strike led to; that is done separately.
+func (s *moderationService) RevokeStrike(ctx context.Context, moderatorID, strikeID uint, req *dto.RevokeStrikeRequest) (*dto.StrikeResponse, error) {
+	strike, err := s.strikeRepo.GetByID(ctx, strikeID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("strike not found")
+		}
+		s.logger.Error("Failed to get strike", "error", err, "strike_id", strikeID)
+		return nil, errors.New("failed to get strike")
+	}
+	if strike.RevokedAt != nil {
+		return nil, errors.New("strike is already revoked")
+	}
+
+	now := time.Now()
+	strike.RevokedAt = &now
+	if err := s.strikeRepo.Update(ctx, strike); err != nil {
+		s.logger.Error("Failed to revoke strike", "error", err, "strike_id", strikeID)
+		return nil, errors.New("failed to revoke strike")
+	}
+
+	s.adminSvc.Audit(ctx, moderatorID, strike.UserID, entities.AdminActionStrikeRevoked, req.Reason, map[string]interface{}{
+		"strike_id": strike.ID,
+	})
+	return toStrikeResponse(strike, now), nil
+}
+
+// transition moves a report between the undecided states.
+func (s *moderationService) transition(ctx context.Context, reportID uint, next entities.ReportStatus, apply func(*entities.Report)) (*dto.ModerationReportResponse, error) {
+	report, err := s.getReport(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+	if !report.Status.CanTransitionTo(next) {
+		return nil, fmt.Errorf("report cannot move from %s to %s", report.Status, next)
+	}
+
+	from := report.Status
+	report.Status = next
+	apply(report)
+	updated, err := s.reportRepo.UpdateStatus(ctx, report, from)
+	if err != nil {
+		s.logger.Error("Failed to update report", "error", err, "report_id", reportID)
+		return nil, errors.New("failed to update report")
+	}
+	if !updated {
+		return nil, errors.New("report was changed by another moderator")
+	}
+	return toModerationReportResponse(report), nil
+}
+
+// decide resolves a report. apply checks the moderator's action and fills
+// in the outcome, returning the strike to issue if there is one; the report
+// is then saved in one transaction with hiding its target and the strike,
+// so when two moderators decide at once only the first decision is applied.
+// Once a target has been actioned, every other unresolved report of it is
+// closed the same way; a dismissal only closes its own report, since others
+// may give a better reason.
+func (s *moderationService) decide(ctx context.Context, reportID uint, next entities.ReportStatus, apply func(*entities.Report) (*entities.Strike, error)) (*entities.Report, *entities.Strike, error) {
+	report, err := s.getReport(ctx, reportID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !report.Status.CanTransitionTo(next) {
+		return nil, nil, fmt.Errorf("report cannot move from %s to %s", report.Status, next)
+	}
+
+	strike, err := apply(report)
+	if err != nil {
+		return nil, nil, err
+	}
+	report.Status = next
+	decided, err := s.reportRepo.Decide(ctx, report, strike)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, errors.New("report target not found")
+		}
+		s.logger.Error("Failed to decide report", "error", err, "report_id", reportID)
+		return nil, nil, errors.New("failed to update report")
+	}
+	if !decided {
+		return nil, nil, errors.New("report was changed by another moderator")
+	}
+
+	if next != entities.ReportActioned {
+		return report, strike, nil
+	}
+
+	duplicates, err := s.reportRepo.ResolveForTarget(ctx, report)
+	if err != nil {
+		s.logger.Error("Failed to resolve other reports of target", "error", err, "report_id", reportID)
+	}
+	for _, duplicate := range duplicates {
+		s.notifyReporter(ctx, duplicate)
+	}
+
+	return report, strike, nil
+}
+
+func (s *moderationService) hide(ctx context.Context, targetType entities.ReportTargetType, targetID uint, now time.Time) error {
+	if !targetType.Hideable() {
+		return errors.New("content cannot be hidden")
+	}
+
+	if err := s.contentRepo.SetHidden(ctx, targetType, targetID, &now); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("report target not found")
+		}
+		s.logger.Error("Failed to hide content", "error", err, "target_type", targetType, "target_id", targetID)
+		return errors.New("failed to hide content")
+	}
+	return nil
+}
+
+func (s *moderationService) newStrike(moderatorID, userID uint, reportID *uint, reason string, now time.Time) *entities.Strike {
+	return &entities.Strike{
+		UserID:      userID,
+		ReportID:    reportID,
+		ModeratorID: &moderatorID,
+		Reason:      reason,
+		ExpiresAt:   now.AddDate(0, 0, s.config.StrikeExpiryDays),
+	}
+}
+
+func (s *moderationService) issueStrike(ctx context.Context, moderatorID, userID uint, reportID *uint, reason string, now time.Time) (*entities.Strike, error) {
+	strike := s.newStrike(moderatorID, userID, reportID, reason, now)
+	if err := s.strikeRepo.Create(ctx, strike); err != nil {
+		s.logger.Error("Failed to create strike", "error", err, "user_id", userID)
+		return nil, errors.New("failed to issue strike")
+	}
+	return strike, nil
+}
+
+// afterStrike tells the user about a new strike and suspends the account
+// once its active strikes reach the limit.
+func (s *moderationService) afterStrike(ctx context.Context, moderatorID uint, strike *entities.Strike) {
+	s.adminSvc.Audit(ctx, moderatorID, strike.UserID, entities.AdminActionStrikeIssued, strike.Reason, map[string]interface{}{
+		"strike_id": strike.ID,
+		"report_id": strike.ReportID,
+	})
+
+	active, err := s.strikeRepo.CountActive(ctx, strike.UserID, time.Now())
+	if err != nil {
+		s.logger.Error("Failed to count strikes", "error", err, "user_id", strike.UserID)
+		return
+	}
+
+	message := fmt.Sprintf("Your account received a strike: %s", strike.Reason)
+	if s.config.StrikeLimit > 0 {
+		message = fmt.Sprintf("%s. You have %d of %d strikes before your account is suspended", message, active, s.config.StrikeLimit)
+	}
+	s.notify(ctx, strike.UserID, entities.NotificationStrikeIssued, "strike", strike.ID, message)
+
+	if s.config.StrikeLimit <= 0 || active < int64(s.config.StrikeLimit) {
+		return
+	}
+
+	user, err := s.getUser(ctx, strike.UserID)
+	if err != nil || user.IsSuspended() || user.IsAdmin {
+		return
+	}
+
+	reason := fmt.Sprintf("Reached %d active moderation strikes", active)
+	if err := s.adminSvc.SuspendUser(ctx, moderatorID, strike.UserID, &adminDto.SuspendUserRequest{Reason: reason}); err != nil {
+		s.logger.Error("Failed to suspend user after strikes", "error", err, "user_id", strike.UserID)
+	}
+}
+
+// notifyReporter tells the reporter how their report was decided, without
+// saying what happened to the other account.
+func (s *moderationService) notifyReporter(ctx context.Context, report *entities.Report) {
+	if report.ReporterID == nil {
+		return
+	}
+
+	message := fmt.Sprintf("We reviewed the %s you reported and found it does not go against our policies", report.TargetType)
+	if report.Status == entities.ReportActioned {
+		message = fmt.Sprintf("Thanks for your report. We took action on the %s you reported", report.TargetType)
+	}
+	s.notify(ctx, *report.ReporterID, entities.NotificationReportResolved, "report", report.ID, message)
+}
+
+// notify sends a notification with no actor, so neither moderators
+// nor reporters are named to the people they act on.
+func (s *moderationService) notify(ctx context.Context, userID uint, notificationType entities.NotificationType, entityType string, entityID uint, message string) {
+	if s.notificationSvc == nil {
+		return
+	}
+
+	if _, err := s.notificationSvc.Notify(ctx, &notificationDto.CreateNotificationRequest{
+		UserID:     userID,
+		Type:       notificationType,
+		EntityType: entityType,
+		EntityID:   &entityID,
+		Message:    message,
+	}); err != nil {
+		s.logger.Error("Failed to send moderation notification", "error", err, "user_id", userID, "type", notificationType)
+	}
+}
+
+func (s *moderationService) getTarget(ctx context.Context, targetType entities.ReportTargetType, targetID uint) (*entities.ReportTarget, error) {
+	target, err := s.contentRepo.GetTarget(ctx, targetType, targetID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("report target not found")
+		}
+		s.logger.Error("Failed to get report target", "error", err, "target_type", targetType, "target_id", targetID)
+		return nil, errors.New("failed to get report target")
+	}
+	return target, nil
+}
+
+func (s *moderationService) getReport(ctx context.Context, reportID uint) (*entities.Report, error) {
+	report, err := s.reportRepo.GetByID(ctx, reportID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("report not found")
+		}
+		s.logger.Error("Failed to get report", "error", err, "report_id", reportID)
+		return nil, errors.New("failed to get report")
+	}
+	return report, nil
+}
+
+func (s *moderationService) getUser(ctx context.Context, userID uint) (*entities.User, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("user not found")
+		}
+		s.logger.Error("Failed to get user", "error", err, "user_id", userID)
+		return nil, errors.New("failed to get user")
+	}
+	return user, nil
+}
+
+func reasonText(reason entities.ReportReason) string {
+	return strings.ReplaceAll(string(reason), "_", " ")
+}
+
+func truncate(text string, limit int) string {
+	if utf8.RuneCountInString(text) <= limit {
+		return text
+	}
+	return string([]rune(text)[:limit]) + "…"
+}
+
+func toReportResponse(report *entities.Report) *dto.ReportResponse {
+	return &dto.ReportResponse{
+		ID:         report.ID,
+		TargetType: report.TargetType,
+		TargetID:   report.TargetID,
+		Reason:     report.Reason,
+		Details:    report.Details,
+		Status:     report.Status,
+		ResolvedAt: report.ResolvedAt,
+		CreatedAt:  report.CreatedAt,
+	}
+}
+
+func toModerationReportResponse(report *entities.Report) *dto.ModerationReportResponse {
+	return &dto.ModerationReportResponse{
+		ReportResponse: toReportResponse(report),
+		ReporterID:     report.ReporterID,
+		TargetUserID:   report.TargetUserID,
+		ModeratorID:    report.ModeratorID,
+		ModeratorNote:  report.ModeratorNote,
+		ContentHidden:  report.ContentHidden,
+		StrikeID:       report.StrikeID,
+		UpdatedAt:      report.UpdatedAt,
+	}
+}
+
+func toStrikeResponse(strike *entities.Strike, now time.Time) *dto.StrikeResponse {
+	return &dto.StrikeResponse{
+		ID:          strike.ID,
+		UserID:      strike.UserID,
+		ReportID:    strike.ReportID,
+		ModeratorID: strike.ModeratorID,
+		Reason:      strike.Reason,
+		Active:      strike.Active(now),
+		ExpiresAt:   strike.ExpiresAt,
+		RevokedAt:   strike.RevokedAt,
+		CreatedAt:   strike.CreatedAt,
+	}
+}
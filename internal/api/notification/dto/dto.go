@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type CreateNotificationRequest struct {
+	UserID     uint
+	ActorID    *uint
+	Type       entities.NotificationType
+	EntityType string
+	EntityID   *uint
+	Message    string
+}
+
+type NotificationResponse struct {
+	ID         uint                      `json:"id"`
+	Type       entities.NotificationType `json:"type"`
+	EntityType string                    `json:"entity_type,omitempty"`
+	EntityID   *uint                     `json:"entity_id,omitempty"`
+	Message    string                    `json:"message"`
+	IsRead     bool                      `json:"is_read"`
+	ReadAt     *time.Time                `json:"read_at,omitempty"`
+	Actor      *UserInfo                 `json:"actor,omitempty"`
+	CreatedAt  time.Time                 `json:"created_at"`
+}
+
+type UnreadCountResponse struct {
+	Notifications int64 `json:"notifications"`
+	Messages      int64 `json:"messages"`
+}
+
+type UpdateNotificationSettingsRequest struct {
+	LifeEventReminders *bool `json:"life_event_reminders"`
+	ShareBirthday      *bool `json:"share_birthday"`
+}
+
+type NotificationSettingsResponse struct {
+	LifeEventReminders bool `json:"life_event_reminders"`
+	ShareBirthday      bool `json:"share_birthday"`
+}
+
+type UserInfo struct {
+	ID             uint   `json:"id"`
+	Username       string `json:"username"`
+	FullName       string `json:"full_name"`
+	ProfilePicture string `json:"profile_picture,omitempty"`
+}
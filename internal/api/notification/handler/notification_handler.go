@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"linked-clone/internal/api/notification/dto"
+	"linked-clone/internal/api/notification/service"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationHandler struct {
+	notificationService service.NotificationService
+	validator           validation.Validator
+	logger              logger.Logger
+}
+
+func NewNotificationHandler(notificationService service.NotificationService, validator validation.Validator, logger logger.Logger) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+		validator:           validator,
+		logger:              logger,
+	}
+}
+
+func (h *NotificationHandler) GetNotifications(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	unreadOnly := c.Query("unread") == "true"
+
+	notifications, err := h.notificationService.GetNotifications(c.Request.Context(), userID, unreadOnly, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get notifications", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get notifications", err.Error())
+		return
+	}
+
+	response.Success(c, notifications)
+}
+
+func (h *NotificationHandler) GetUnreadCount(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	counts, err := h.notificationService.GetUnreadCount(c.Request.Context(), userID)
+	if err != nil {
+		h.logger.Error("Failed to get unread count", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get unread count", err.Error())
+		return
+	}
+
+	response.Success(c, counts)
+}
+
+func (h *NotificationHandler) MarkAsRead(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid notification ID", err.Error())
+		return
+	}
+
+	if err := h.notificationService.MarkAsRead(c.Request.Context(), userID, uint(id)); err != nil {
+		h.logger.Error("Failed to mark notification as read", "error", err)
+		response.Error(c, http.StatusNotFound, "Failed to mark notification as read", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Notification marked as read", nil)
+}
+
+func (h *NotificationHandler) MarkAllAsRead(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	if err := h.notificationService.MarkAllAsRead(c.Request.Context(), userID); err != nil {
+		h.logger.Error("Failed to mark all notifications as read", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to mark notifications as read", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "All notifications marked as read", nil)
+}
+
+func (h *NotificationHandler) GetSettings(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	settings, err := h.notificationService.GetSettings(c.Request.Context(), userID)
+	if err != nil {
+		h.logger.Error("Failed to get notification settings", "error", err)
+		response.Error(c, http.StatusNotFound, "Failed to get notification settings", err.Error())
+		return
+	}
+
+	response.Success(c, settings)
+}
+
+func (h *NotificationHandler) UpdateSettings(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.UpdateNotificationSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	settings, err := h.notificationService.UpdateSettings(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.logger.Error("Failed to update notification settings", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to update notification settings", err.Error())
+		return
+	}
+
+	response.Success(c, settings)
+}
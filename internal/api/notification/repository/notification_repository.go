@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type notificationRepository struct {
+	db            *gorm.DB
+	retentionDays int
+}
+
+// NewNotificationRepository creates the repository. With retentionDays set,
+// reads and updates only look at notifications inside the retention period,
+// which lets Postgres skip the monthly partitions that retention is about
+// to empty.
+func NewNotificationRepository(db *gorm.DB, retentionDays int) repositories.NotificationRepository {
+	return &notificationRepository{db: db, retentionDays: retentionDays}
+}
+
+func (r *notificationRepository) retained(query *gorm.DB) *gorm.DB {
+	if r.retentionDays <= 0 {
+		return query
+	}
+	return query.Where("created_at >= ?", time.Now().AddDate(0, 0, -r.retentionDays))
+}
+
+func (r *notificationRepository) Create(ctx context.Context, notification *entities.Notification) error {
+	return r.db.WithContext(ctx).Create(notification).Error
+}
+
+func (r *notificationRepository) GetByID(ctx context.Context, id uint) (*entities.Notification, error) {
+	var notification entities.Notification
+	err := r.db.WithContext(ctx).
+		Preload("Actor").
+		First(&notification, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &notification, nil
+}
+
+func (r *notificationRepository) GetByUserID(ctx context.Context, userID uint, unreadOnly bool, limit, offset int) ([]*entities.Notification, error) {
+	var notifications []*entities.Notification
+	query := r.retained(r.db.WithContext(ctx)).
+		Preload("Actor").
+		Where("user_id = ?", userID)
+
+	if unreadOnly {
+		query = query.Where("is_read = false")
+	}
+
+	err := query.Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&notifications).Error
+	return notifications, err
+}
+
+func (r *notificationRepository) MarkAsRead(ctx context.Context, userID, notificationID uint) (bool, error) {
+	result := r.retained(r.db.WithContext(ctx).Model(&entities.Notification{})).
+		Where("id = ? AND user_id = ? AND is_read = false", notificationID, userID).
+		Updates(map[string]interface{}{
+			"is_read": true,
+			"read_at": time.Now(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *notificationRepository) MarkAllAsRead(ctx context.Context, userID uint) (int64, error) {
+	result := r.retained(r.db.WithContext(ctx).Model(&entities.Notification{})).
+		Where("user_id = ? AND is_read = false", userID).
+		Updates(map[string]interface{}{
+			"is_read": true,
+			"read_at": time.Now(),
+		})
+	return result.RowsAffected, result.Error
+}
+
+func (r *notificationRepository) CountUnread(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+	err := r.retained(r.db.WithContext(ctx).Model(&entities.Notification{})).
+		Where("user_id = ? AND is_read = false", userID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *notificationRepository) CountUnreadByUser(ctx context.Context) (map[uint]int64, error) {
+	var rows []struct {
+		UserID uint
+		Count  int64
+	}
+
+	err := r.retained(r.db.WithContext(ctx).Model(&entities.Notification{})).
+		Select("user_id, COUNT(*) AS count").
+		Where("is_read = false").
+		Group("user_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uint]int64, len(rows))
+	for _, row := range rows {
+		counts[row.UserID] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *notificationRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&entities.Notification{}, id).Error
+}
+
+func (r *notificationRepository) ArchiveOlderThan(ctx context.Context, cutoff time.Time, batchSize int) (int64, error) {
+	result := r.db.WithContext(ctx).Exec(`
+		WITH batch AS (
+			SELECT id, created_at FROM notifications
+			WHERE created_at < ?
+			ORDER BY created_at
+			LIMIT ?
+		), moved AS (
+			DELETE FROM notifications n
+			USING batch b
+			WHERE n.id = b.id AND n.created_at = b.created_at
+			RETURNING n.*
+		)
+		INSERT INTO notifications_archive
+			(id, user_id, actor_id, type, entity_type, entity_id, message, is_read, read_at, created_at, updated_at, deleted_at, archived_at)
+		SELECT id, user_id, actor_id, type, entity_type, entity_id, message, is_read, read_at, created_at, updated_at, deleted_at, NOW()
+		FROM moved`, cutoff, batchSize)
+	return result.RowsAffected, result.Error
+}
+
+func (r *notificationRepository) DeleteOlderThan(ctx context.Context, cutoff time.Time, batchSize int) (int64, error) {
+	result := r.db.WithContext(ctx).Exec(`
+		DELETE FROM notifications n
+		USING (
+			SELECT id, created_at FROM notifications
+			WHERE created_at < ?
+			ORDER BY created_at
+			LIMIT ?
+		) b
+		WHERE n.id = b.id AND n.created_at = b.created_at`, cutoff, batchSize)
+	return result.RowsAffected, result.Error
+}
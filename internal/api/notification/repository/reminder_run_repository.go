@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type reminderRunRepository struct {
+	db *gorm.DB
+}
+
+func NewReminderRunRepository(db *gorm.DB) repositories.ReminderRunRepository {
+	return &reminderRunRepository{db: db}
+}
+
+func (r *reminderRunRepository) Claim(ctx context.Context, run *entities.ReminderRun) (bool, error) {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(run)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *reminderRunRepository) Update(ctx context.Context, run *entities.ReminderRun) error {
+	return r.db.WithContext(ctx).Save(run).Error
+}
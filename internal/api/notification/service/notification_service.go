@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"linked-clone/internal/api/notification/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/counter"
+	"linked-clone/pkg/eventbus"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/storage"
+	"time"
+)
+
+const eventNotifyTimeout = 10 * time.Second
+
+var eventNotifications = map[string]struct {
+	notificationType entities.NotificationType
+	format           string
+}{
+	eventbus.ConnectionRequested: {entities.NotificationConnectionRequest, "%s sent you a connection request"},
+	eventbus.ConnectionAccepted:  {entities.NotificationConnectionAccepted, "%s accepted your connection request"},
+	eventbus.PostLiked:           {entities.NotificationPostLiked, "%s reacted to your post"},
+	eventbus.PostCommented:       {entities.NotificationPostCommented, "%s commented on your post"},
+	eventbus.PostShared:          {entities.NotificationPostShared, "%s shared your post"},
+	eventbus.CommentReplied:      {entities.NotificationCommentReplied, "%s replied to your comment"},
+	eventbus.CommentLiked:        {entities.NotificationCommentLiked, "%s reacted to your comment"},
+	eventbus.PostMentioned:       {entities.NotificationMentionedInPost, "%s mentioned you in a post"},
+	eventbus.CommentMentioned:    {entities.NotificationMentionedInComment, "%s mentioned you in a comment"},
+}
+
+type NotificationService interface {
+	Notify(ctx context.Context, req *dto.CreateNotificationRequest) (*dto.NotificationResponse, error)
+	HandleEvent(ctx context.Context, event *eventbus.Event)
+	GetNotifications(ctx context.Context, userID uint, unreadOnly bool, limit, offset int) ([]*dto.NotificationResponse, error)
+	MarkAsRead(ctx context.Context, userID, notificationID uint) error
+	MarkAllAsRead(ctx context.Context, userID uint) error
+	GetUnreadCount(ctx context.Context, userID uint) (*dto.UnreadCountResponse, error)
+	GetSettings(ctx context.Context, userID uint) (*dto.NotificationSettingsResponse, error)
+	UpdateSettings(ctx context.Context, userID uint, req *dto.UpdateNotificationSettingsRequest) (*dto.NotificationSettingsResponse, error)
+}
+
+type notificationService struct {
+	notificationRepo repositories.NotificationRepository
+	messageRepo      repositories.MessageRepository
+	userRepo         repositories.UserRepository
+	unreadCounter    counter.UnreadCounter
+	storageService   storage.StorageService
+	logger           logger.Logger
+}
+
+func NewNotificationService(
+	notificationRepo repositories.NotificationRepository,
+	messageRepo repositories.MessageRepository,
+	userRepo repositories.UserRepository,
+	unreadCounter counter.UnreadCounter,
+	storageService storage.StorageService,
+	logger logger.Logger,
+) NotificationService {
+	return &notificationService{
+		notificationRepo: notificationRepo,
+		messageRepo:      messageRepo,
+		userRepo:         userRepo,
+		unreadCounter:    unreadCounter,
+		storageService:   storageService,
+		logger:           logger,
+	}
+}
+
+func (s *notificationService) Notify(ctx context.Context, req *dto.CreateNotificationRequest) (*dto.NotificationResponse, error) {
+	if req.ActorID != nil && *req.ActorID == req.UserID {
+		return nil, nil
+	}
+
+	notification := &entities.Notification{
+		UserID:     req.UserID,
+		ActorID:    req.ActorID,
+		Type:       req.Type,
+		EntityType: req.EntityType,
+		EntityID:   req.EntityID,
+		Message:    req.Message,
+	}
+
+	if err := s.notificationRepo.Create(ctx, notification); err != nil {
+		s.logger.Error("Failed to create notification", "error", err, "user_id", req.UserID)
+		return nil, errors.New("failed to create notification")
+	}
+
+	if _, err := s.unreadCounter.Increment(ctx, counter.UnreadNotifications, req.UserID, 1); err != nil {
+		s.logger.Error("Failed to increment unread notification counter", "error", err, "user_id", req.UserID)
+	}
+
+	return s.mapNotificationToResponse(notification), nil
+}
+
+// HandleEvent turns bus events into persisted notifications. It returns
+// immediately and writes in the background so publishers are not held up.
+func (s *notificationService) HandleEvent(_ context.Context, event *eventbus.Event) {
+	kind, ok := eventNotifications[event.Type]
+	if !ok || event.RecipientID == 0 {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), eventNotifyTimeout)
+		defer cancel()
+
+		actor, err := s.userRepo.GetByID(ctx, event.ActorID)
+		if err != nil {
+			s.logger.Error("Failed to get notification actor", "error", err, "actor_id", event.ActorID)
+			return
+		}
+
+		actorID := event.ActorID
+		entityID := event.EntityID
+		if _, err := s.Notify(ctx, &dto.CreateNotificationRequest{
+			UserID:     event.RecipientID,
+			ActorID:    &actorID,
+			Type:       kind.notificationType,
+			EntityType: event.EntityType,
+			EntityID:   &entityID,
+			Message:    fmt.Sprintf(kind.format, actor.FullName),
+		}); err != nil {
+			s.logger.Error("Failed to send event notification", "error", err, "event", event.Type)
+		}
+	}()
+}
+
+func (s *notificationService) GetNotifications(ctx context.Context, userID uint, unreadOnly bool, limit, offset int) ([]*dto.NotificationResponse, error) {
+	notifications, err := s.notificationRepo.GetByUserID(ctx, userID, unreadOnly, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to get notifications", "error", err)
+		return nil, errors.New("failed to get notifications")
+	}
+
+	var responses []*dto.NotificationResponse
+	for _, notification := range notifications {
+		responses = append(responses, s.mapNotificationToResponse(notification))
+	}
+
+	return responses, nil
+}
+
+func (s *notificationService) MarkAsRead(ctx context.Context, userID, notificationID uint) error {
+	notification, err := s.notificationRepo.GetByID(ctx, notificationID)
+	if err != nil || notification.UserID != userID {
+		return errors.New("notification not found")
+	}
+
+	updated, err := s.notificationRepo.MarkAsRead(ctx, userID, notificationID)
+	if err != nil {
+		s.logger.Error("Failed to mark notification as read", "error", err)
+		return errors.New("failed to mark notification as read")
+	}
+
+	if updated {
+		if _, err := s.unreadCounter.Decrement(ctx, counter.UnreadNotifications, userID, 1); err != nil {
+			s.logger.Error("Failed to decrement unread notification counter", "error", err, "user_id", userID)
+		}
+	}
+
+	return nil
+}
+
+func (s *notificationService) MarkAllAsRead(ctx context.Context, userID uint) error {
+	if _, err := s.notificationRepo.MarkAllAsRead(ctx, userID); err != nil {
+		s.logger.Error("Failed to mark all notifications as read", "error", err)
+		return errors.New("failed to mark notifications as read")
+	}
+
+	if err := s.unreadCounter.Set(ctx, counter.UnreadNotifications, userID, 0); err != nil {
+		s.logger.Error("Failed to reset unread notification counter", "error", err, "user_id", userID)
+	}
+
+	return nil
+}
+
+func (s *notificationService) GetUnreadCount(ctx context.Context, userID uint) (*dto.UnreadCountResponse, error) {
+	notifications, err := s.getCount(ctx, counter.UnreadNotifications, userID, s.notificationRepo.CountUnread)
+	if err != nil {
+		return nil, errors.New("failed to get unread notification count")
+	}
+
+	messages, err := s.getCount(ctx, counter.UnreadMessages, userID, s.messageRepo.CountUnread)
+	if err != nil {
+		return nil, errors.New("failed to get unread message count")
+	}
+
+	return &dto.UnreadCountResponse{
+		Notifications: notifications,
+		Messages:      messages,
+	}, nil
+}
+
+func (s *notificationService) GetSettings(ctx context.Context, userID uint) (*dto.NotificationSettingsResponse, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	return &dto.NotificationSettingsResponse{
+		LifeEventReminders: user.LifeEventReminders,
+		ShareBirthday:      user.ShareBirthday,
+	}, nil
+}
+
+func (s *notificationService) UpdateSettings(ctx context.Context, userID uint, req *dto.UpdateNotificationSettingsRequest) (*dto.NotificationSettingsResponse, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	if req.LifeEventReminders != nil {
+		user.LifeEventReminders = *req.LifeEventReminders
+	}
+	if req.ShareBirthday != nil {
+		user.ShareBirthday = *req.ShareBirthday
+	}
+
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		s.logger.Error("Failed to update notification settings", "error", err)
+		return nil, errors.New("failed to update notification settings")
+	}
+
+	return &dto.NotificationSettingsResponse{
+		LifeEventReminders: user.LifeEventReminders,
+		ShareBirthday:      user.ShareBirthday,
+	}, nil
+}
+
+func (s *notificationService) getCount(ctx context.Context, kind counter.UnreadKind, userID uint, fallback func(context.Context, uint) (int64, error)) (int64, error) {
+	value, found, err := s.unreadCounter.Get(ctx, kind, userID)
+	if err == nil && found {
+		return value, nil
+	}
+	if err != nil {
+		s.logger.Error("Failed to read unread counter, falling back to database",
+			"error", err, "kind", kind, "user_id", userID)
+	}
+
+	count, err := fallback(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to count unread items", "error", err, "kind", kind, "user_id", userID)
+		return 0, err
+	}
+
+	if err := s.unreadCounter.Set(ctx, kind, userID, count); err != nil {
+		s.logger.Error("Failed to seed unread counter", "error", err, "kind", kind, "user_id", userID)
+	}
+
+	return count, nil
+}
+
+func (s *notificationService) mapNotificationToResponse(notification *entities.Notification) *dto.NotificationResponse {
+	response := &dto.NotificationResponse{
+		ID:         notification.ID,
+		Type:       notification.Type,
+		EntityType: notification.EntityType,
+		EntityID:   notification.EntityID,
+		Message:    notification.Message,
+		IsRead:     notification.IsRead,
+		ReadAt:     notification.ReadAt,
+		CreatedAt:  notification.CreatedAt,
+	}
+
+	if notification.Actor != nil && notification.Actor.ID != 0 {
+		profilePicture := ""
+		if notification.Actor.ProfilePicture != "" {
+			if url, err := s.storageService.GeneratePresignedURL(notification.Actor.ProfilePicture, 15*time.Minute); err == nil {
+				profilePicture = url
+			}
+		}
+
+		response.Actor = &dto.UserInfo{
+			ID:             notification.Actor.ID,
+			Username:       notification.Actor.Username,
+			FullName:       notification.Actor.FullName,
+			ProfilePicture: profilePicture,
+		}
+	}
+
+	return response
+}
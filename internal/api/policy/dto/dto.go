@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type PublishPolicyRequest struct {
+	Type        entities.PolicyType `json:"type" validate:"required,oneof=terms_of_service privacy_policy"`
+	Version     string              `json:"version" validate:"required,max=50"`
+	Title       string              `json:"title" validate:"required,max=200"`
+	Content     string              `json:"content" validate:"required_without=URL"`
+	URL         string              `json:"url" validate:"omitempty,url"`
+	Summary     string              `json:"summary" validate:"omitempty,max=2000"`
+	EffectiveAt *time.Time          `json:"effective_at"`
+}
+
+type AcceptPoliciesRequest struct {
+	PolicyVersionIDs []uint `json:"policy_version_ids" validate:"required,min=1,max=10,dive,required"`
+}
+
+type PolicyVersionResponse struct {
+	ID          uint                `json:"id"`
+	Type        entities.PolicyType `json:"type"`
+	Version     string              `json:"version"`
+	Title       string              `json:"title"`
+	Content     string              `json:"content,omitempty"`
+	URL         string              `json:"url,omitempty"`
+	Summary     string              `json:"summary,omitempty"`
+	PublishedBy *uint               `json:"published_by,omitempty"`
+	PublishedAt time.Time           `json:"published_at"`
+}
+
+type PolicyAcceptanceResponse struct {
+	PolicyVersionID uint                `json:"policy_version_id"`
+	Type            entities.PolicyType `json:"type"`
+	Version         string              `json:"version"`
+	AcceptedAt      time.Time           `json:"accepted_at"`
+}
+
+type PolicyStatusResponse struct {
+	RequiresAcceptance bool                        `json:"requires_acceptance"`
+	Pending            []*PolicyVersionResponse    `json:"pending"`
+	Accepted           []*PolicyAcceptanceResponse `json:"accepted"`
+}
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"linked-clone/internal/api/policy/dto"
+	"linked-clone/internal/api/policy/service"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PolicyHandler struct {
+	policyService service.PolicyService
+	validator     validation.Validator
+	logger        logger.Logger
+}
+
+func NewPolicyHandler(policyService service.PolicyService, validator validation.Validator, logger logger.Logger) *PolicyHandler {
+	return &PolicyHandler{
+		policyService: policyService,
+		validator:     validator,
+		logger:        logger,
+	}
+}
+
+func (h *PolicyHandler) GetCurrentPolicies(c *gin.Context) {
+	policies, err := h.policyService.GetCurrentPolicies(c.Request.Context())
+	if err != nil {
+		h.logger.Error("Failed to get current policies", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get policies", err.Error())
+		return
+	}
+
+	response.Success(c, policies)
+}
+
+func (h *PolicyHandler) GetStatus(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	status, err := h.policyService.GetStatus(c.Request.Context(), userID)
+	if err != nil {
+		h.logger.Error("Failed to get policy status", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get policy status", err.Error())
+		return
+	}
+
+	response.Success(c, status)
+}
+
+func (h *PolicyHandler) AcceptPolicies(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.AcceptPoliciesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	status, err := h.policyService.AcceptPolicies(c.Request.Context(), userID, &req, c.ClientIP(), c.Request.UserAgent())
+	if err != nil {
+		h.logger.Error("Failed to accept policies", "error", err)
+		response.Error(c, policyErrorStatus(err), "Failed to accept policies", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Policies accepted", status)
+}
+
+func (h *PolicyHandler) PublishVersion(c *gin.Context) {
+	adminID := middleware.GetUserID(c)
+
+	var req dto.PublishPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	version, err := h.policyService.PublishVersion(c.Request.Context(), adminID, &req)
+	if err != nil {
+		h.logger.Error("Failed to publish policy version", "error", err)
+		response.Error(c, policyErrorStatus(err), "Failed to publish policy", err.Error())
+		return
+	}
+
+	response.CreatedWithMessage(c, "Policy version published", version)
+}
+
+func (h *PolicyHandler) GetVersions(c *gin.Context) {
+	policyType := entities.PolicyType(c.Param("type"))
+	if policyType != entities.PolicyTermsOfService && policyType != entities.PolicyPrivacyPolicy {
+		response.Error(c, http.StatusBadRequest, "Invalid policy type", "")
+		return
+	}
+
+	versions, err := h.policyService.GetVersions(c.Request.Context(), policyType)
+	if err != nil {
+		h.logger.Error("Failed to get policy versions", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get policy versions", err.Error())
+		return
+	}
+
+	response.Success(c, versions)
+}
+
+func policyErrorStatus(err error) int {
+	switch err.Error() {
+	case "policy version not found":
+		return http.StatusNotFound
+	case "policy version already exists":
+		return http.StatusConflict
+	case "policy version is not current", "effective date cannot be in the past":
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type policyRepository struct {
+	db *gorm.DB
+}
+
+func NewPolicyRepository(db *gorm.DB) repositories.PolicyRepository {
+	return &policyRepository{db: db}
+}
+
+func (r *policyRepository) CreateVersion(ctx context.Context, version *entities.PolicyVersion) error {
+	return r.db.WithContext(ctx).Create(version).Error
+}
+
+func (r *policyRepository) GetVersionByID(ctx context.Context, id uint) (*entities.PolicyVersion, error) {
+	var version entities.PolicyVersion
+	err := r.db.WithContext(ctx).First(&version, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &version, nil
+}
+
+func (r *policyRepository) GetVersion(ctx context.Context, policyType entities.PolicyType, version string) (*entities.PolicyVersion, error) {
+	var policy entities.PolicyVersion
+	err := r.db.WithContext(ctx).
+		Where("type = ? AND version = ?", policyType, version).
+		First(&policy).Error
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+func (r *policyRepository) GetVersionsByType(ctx context.Context, policyType entities.PolicyType) ([]*entities.PolicyVersion, error) {
+	var versions []*entities.PolicyVersion
+	err := r.db.WithContext(ctx).
+		Where("type = ?", policyType).
+		Order("published_at DESC").
+		Find(&versions).Error
+	return versions, err
+}
+
+func (r *policyRepository) GetCurrentVersions(ctx context.Context) ([]*entities.PolicyVersion, error) {
+	var versions []*entities.PolicyVersion
+	err := r.db.WithContext(ctx).
+		Raw(`SELECT DISTINCT ON (type) * FROM policy_versions
+			WHERE published_at <= NOW()
+			ORDER BY type, published_at DESC, id DESC`).
+		Scan(&versions).Error
+	return versions, err
+}
+
+func (r *policyRepository) GetPendingForUser(ctx context.Context, userID uint) ([]*entities.PolicyVersion, error) {
+	var versions []*entities.PolicyVersion
+	err := r.db.WithContext(ctx).
+		Raw(`SELECT latest.* FROM (
+				SELECT DISTINCT ON (type) * FROM policy_versions
+				WHERE published_at <= NOW()
+				ORDER BY type, published_at DESC, id DESC
+			) latest
+			WHERE NOT EXISTS (
+				SELECT 1 FROM policy_acceptances pa
+				WHERE pa.policy_version_id = latest.id AND pa.user_id = ?
+			)
+			ORDER BY latest.type`, userID).
+		Scan(&versions).Error
+	return versions, err
+}
+
+func (r *policyRepository) CreateAcceptance(ctx context.Context, acceptance *entities.PolicyAcceptance) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "policy_version_id"}},
+			DoNothing: true,
+		}).
+		Create(acceptance).Error
+}
+
+func (r *policyRepository) GetUserAcceptances(ctx context.Context, userID uint) ([]*entities.PolicyAcceptance, error) {
+	var acceptances []*entities.PolicyAcceptance
+	err := r.db.WithContext(ctx).
+		Preload("PolicyVersion").
+		Where("user_id = ?", userID).
+		Order("accepted_at DESC").
+		Find(&acceptances).Error
+	return acceptances, err
+}
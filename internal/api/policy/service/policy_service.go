@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/policy/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type PolicyService interface {
+	GetCurrentPolicies(ctx context.Context) ([]*dto.PolicyVersionResponse, error)
+	GetStatus(ctx context.Context, userID uint) (*dto.PolicyStatusResponse, error)
+	AcceptPolicies(ctx context.Context, userID uint, req *dto.AcceptPoliciesRequest, ipAddress, userAgent string) (*dto.PolicyStatusResponse, error)
+
+	PublishVersion(ctx context.Context, adminID uint, req *dto.PublishPolicyRequest) (*dto.PolicyVersionResponse, error)
+	GetVersions(ctx context.Context, policyType entities.PolicyType) ([]*dto.PolicyVersionResponse, error)
+}
+
+type policyService struct {
+	policyRepo repositories.PolicyRepository
+	logger     logger.Logger
+}
+
+func NewPolicyService(policyRepo repositories.PolicyRepository, logger logger.Logger) PolicyService {
+	return &policyService{
+		policyRepo: policyRepo,
+		logger:     logger,
+	}
+}
+
+func (s *policyService) GetCurrentPolicies(ctx context.Context) ([]*dto.PolicyVersionResponse, error) {
+	versions, err := s.policyRepo.GetCurrentVersions(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get current policy versions", "error", err)
+		return nil, errors.New("failed to get policies")
+	}
+
+	return s.mapVersionsToResponse(versions), nil
+}
+
+func (s *policyService) GetStatus(ctx context.Context, userID uint) (*dto.PolicyStatusResponse, error) {
+	pending, err := s.policyRepo.GetPendingForUser(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get pending policies", "error", err, "user_id", userID)
+		return nil, errors.New("failed to get policy status")
+	}
+
+	acceptances, err := s.policyRepo.GetUserAcceptances(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get policy acceptances", "error", err, "user_id", userID)
+		return nil, errors.New("failed to get policy status")
+	}
+
+	accepted := make([]*dto.PolicyAcceptanceResponse, len(acceptances))
+	for i, acceptance := range acceptances {
+		accepted[i] = &dto.PolicyAcceptanceResponse{
+			PolicyVersionID: acceptance.PolicyVersionID,
+			Type:            acceptance.PolicyVersion.Type,
+			Version:         acceptance.PolicyVersion.Version,
+			AcceptedAt:      acceptance.AcceptedAt,
+		}
+	}
+
+	return &dto.PolicyStatusResponse{
+		RequiresAcceptance: len(pending) > 0,
+		Pending:            s.mapVersionsToResponse(pending),
+		Accepted:           accepted,
+	}, nil
+}
+
+func (s *policyService) AcceptPolicies(ctx context.Context, userID uint, req *dto.AcceptPoliciesRequest, ipAddress, userAgent string) (*dto.PolicyStatusResponse, error) {
+	current, err := s.policyRepo.GetCurrentVersions(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get current policy versions", "error", err)
+		return nil, errors.New("failed to accept policies")
+	}
+
+	currentIDs := make(map[uint]bool, len(current))
+	for _, version := range current {
+		currentIDs[version.ID] = true
+	}
+
+	for _, versionID := range req.PolicyVersionIDs {
+		if currentIDs[versionID] {
+			continue
+		}
+		if _, err := s.policyRepo.GetVersionByID(ctx, versionID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errors.New("policy version not found")
+			}
+			s.logger.Error("Failed to get policy version", "error", err, "policy_version_id", versionID)
+			return nil, errors.New("failed to accept policies")
+		}
+		return nil, errors.New("policy version is not current")
+	}
+
+	now := time.Now()
+	for _, versionID := range req.PolicyVersionIDs {
+		acceptance := &entities.PolicyAcceptance{
+			UserID:          userID,
+			PolicyVersionID: versionID,
+			IPAddress:       ipAddress,
+			UserAgent:       userAgent,
+			AcceptedAt:      now,
+		}
+		if err := s.policyRepo.CreateAcceptance(ctx, acceptance); err != nil {
+			s.logger.Error("Failed to record policy acceptance", "error", err, "user_id", userID, "policy_version_id", versionID)
+			return nil, errors.New("failed to accept policies")
+		}
+	}
+
+	s.logger.Info("Policies accepted", "user_id", userID, "policy_version_ids", req.PolicyVersionIDs)
+
+	return s.GetStatus(ctx, userID)
+}
+
+func (s *policyService) PublishVersion(ctx context.Context, adminID uint, req *dto.PublishPolicyRequest) (*dto.PolicyVersionResponse, error) {
+	if _, err := s.policyRepo.GetVersion(ctx, req.Type, req.Version); err == nil {
+		return nil, errors.New("policy version already exists")
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.logger.Error("Failed to check existing policy version", "error", err)
+		return nil, errors.New("failed to publish policy")
+	}
+
+	publishedAt := time.Now()
+	if req.EffectiveAt != nil {
+		if req.EffectiveAt.Before(publishedAt) {
+			return nil, errors.New("effective date cannot be in the past")
+		}
+		publishedAt = *req.EffectiveAt
+	}
+
+	version := &entities.PolicyVersion{
+		Type:        req.Type,
+		Version:     req.Version,
+		Title:       req.Title,
+		Content:     req.Content,
+		URL:         req.URL,
+		Summary:     req.Summary,
+		PublishedBy: &adminID,
+		PublishedAt: publishedAt,
+	}
+
+	if err := s.policyRepo.CreateVersion(ctx, version); err != nil {
+		s.logger.Error("Failed to create policy version", "error", err)
+		return nil, errors.New("failed to publish policy")
+	}
+
+	s.logger.Info("Policy version published", "type", version.Type, "version", version.Version, "admin_id", adminID, "published_at", version.PublishedAt)
+
+	return s.mapVersionToResponse(version), nil
+}
+
+func (s *policyService) GetVersions(ctx context.Context, policyType entities.PolicyType) ([]*dto.PolicyVersionResponse, error) {
+	versions, err := s.policyRepo.GetVersionsByType(ctx, policyType)
+	if err != nil {
+		s.logger.Error("Failed to get policy versions", "error", err, "type", policyType)
+		return nil, errors.New("failed to get policy versions")
+	}
+
+	return s.mapVersionsToResponse(versions), nil
+}
+
+func (s *policyService) mapVersionsToResponse(versions []*entities.PolicyVersion) []*dto.PolicyVersionResponse {
+	responses := make([]*dto.PolicyVersionResponse, len(versions))
+	for i, version := range versions {
+		responses[i] = s.mapVersionToResponse(version)
+	}
+	return responses
+}
+
+func (s *policyService) mapVersionToResponse(version *entities.PolicyVersion) *dto.PolicyVersionResponse {
+	return &dto.PolicyVersionResponse{
+		ID:          version.ID,
+		Type:        version.Type,
+		Version:     version.Version,
+		Title:       version.Title,
+		Content:     version.Content,
+		URL:         version.URL,
+		Summary:     version.Summary,
+		PublishedBy: version.PublishedBy,
+		PublishedAt: version.PublishedAt,
+	}
+}
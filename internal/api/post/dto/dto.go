@@ -1,23 +1,75 @@
 package dto
 
-import "time"
+import (
+	"linked-clone/internal/domain/entities"
+	"time"
+)
 
 type CreatePostRequest struct {
 	Content string `form:"content" validate:"required,min=1,max=2000"`
+	AltText string `form:"alt_text" validate:"omitempty,max=1000"`
 }
 
 type UpdatePostRequest struct {
-	Content string `json:"content" validate:"required,min=1,max=2000"`
+	Content string  `json:"content" validate:"required,min=1,max=2000"`
+	AltText *string `json:"alt_text" validate:"omitempty,max=1000"`
+}
+
+type CreateLifeEventPostRequest struct {
+	Type          entities.PostType `json:"type" validate:"required,oneof=new_job work_anniversary promotion certification"`
+	ExperienceID  *uint             `json:"experience_id" validate:"required_unless=Type certification"`
+	PreviousTitle string            `json:"previous_title" validate:"omitempty,max=200"`
+	Certification string            `json:"certification" validate:"required_if=Type certification,max=200"`
+	Issuer        string            `json:"issuer" validate:"omitempty,max=200"`
+	Content       string            `json:"content" validate:"omitempty,max=2000"`
+}
+
+type PublishSuggestionRequest struct {
+	Content string `json:"content" validate:"omitempty,max=2000"`
+}
+
+type SharePostRequest struct {
+	Content string `json:"content" validate:"omitempty,max=2000"`
 }
 
 type PostResponse struct {
-	ID        uint      `json:"id"`
-	Content   string    `json:"content"`
-	ImageURL  string    `json:"image_url,omitempty"`
-	LikeCount int       `json:"like_count"`
-	User      *UserInfo `json:"user"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID              uint                            `json:"id"`
+	Type            entities.PostType               `json:"type"`
+	Content         string                          `json:"content"`
+	RenderedContent string                          `json:"rendered_content"`
+	Event           *PostEventResponse              `json:"event,omitempty"`
+	ImageURL        string                          `json:"image_url,omitempty"`
+	ImageAltText    string                          `json:"image_alt_text,omitempty"`
+	ReactionCount   int                             `json:"reaction_count"`
+	ReactionCounts  map[entities.ReactionType]int64 `json:"reaction_counts"`
+	Reactions       *ReactionSummaryResponse        `json:"reactions"`
+	User            *UserInfo                       `json:"user"`
+	Mentions        []*MentionResponse              `json:"mentions,omitempty"`
+	SharedPostID    *uint                           `json:"shared_post_id,omitempty"`
+	SharedPost      *PostResponse                   `json:"shared_post,omitempty"`
+	CreatedAt       time.Time                       `json:"created_at"`
+	UpdatedAt       time.Time                       `json:"updated_at"`
+}
+
+type PostEventResponse struct {
+	Template      string            `json:"template"`
+	Variables     map[string]string `json:"variables"`
+	Text          string            `json:"text"`
+	ExperienceID  *uint             `json:"experience_id,omitempty"`
+	Title         string            `json:"title,omitempty"`
+	PreviousTitle string            `json:"previous_title,omitempty"`
+	Company       string            `json:"company,omitempty"`
+	Years         int               `json:"years,omitempty"`
+	Certification string            `json:"certification,omitempty"`
+	Issuer        string            `json:"issuer,omitempty"`
+	Date          *time.Time        `json:"date,omitempty"`
+}
+
+type PostSuggestionResponse struct {
+	ID        uint               `json:"id"`
+	Type      entities.PostType  `json:"type"`
+	Event     *PostEventResponse `json:"event"`
+	CreatedAt time.Time          `json:"created_at"`
 }
 
 type UserInfo struct {
@@ -25,12 +77,49 @@ type UserInfo struct {
 	Username       string `json:"username"`
 	FullName       string `json:"full_name"`
 	ProfilePicture string `json:"profile_picture,omitempty"`
+	ProfileAltText string `json:"profile_alt_text,omitempty"`
+}
+
+// MentionResponse identifies a user @mentioned in the content, so clients
+// can link each @username to the profile.
+type MentionResponse struct {
+	UserID   uint   `json:"user_id"`
+	Username string `json:"username"`
+	FullName string `json:"full_name"`
+}
+
+// ReactionSummaryResponse backs the "Ana, Ben and 12 others" line under a
+// post: its most used reaction types and the first people to react.
+type ReactionSummaryResponse struct {
+	TopReactions []ReactionTypeCount `json:"top_reactions"`
+	Reactors     []*ReactorResponse  `json:"reactors"`
+	OthersCount  int64               `json:"others_count"`
+}
+
+type ReactionTypeCount struct {
+	Type  entities.ReactionType `json:"type"`
+	Count int64                 `json:"count"`
+}
+
+type ReactorResponse struct {
+	UserID   uint   `json:"user_id"`
+	Username string `json:"username"`
+	FullName string `json:"full_name"`
+}
+
+type ReactRequest struct {
+	Type entities.ReactionType `json:"type" validate:"required,oneof=like celebrate support insightful funny"`
+}
+
+type PostFeedbackRequest struct {
+	Feedback entities.FeedFeedback `json:"feedback" validate:"required,oneof=show_less hide"`
 }
 
-type LikeResponse struct {
-	ID     uint `json:"id"`
-	UserID uint `json:"user_id"`
-	PostID uint `json:"post_id"`
+type ReactionResponse struct {
+	ID     uint                  `json:"id"`
+	UserID uint                  `json:"user_id"`
+	PostID uint                  `json:"post_id"`
+	Type   entities.ReactionType `json:"type"`
 }
 
 type AddCommentRequest struct {
@@ -38,8 +127,40 @@ type AddCommentRequest struct {
 }
 
 type CommentResponse struct {
-	ID        uint      `json:"id"`
-	Content   string    `json:"content"`
-	User      *UserInfo `json:"user"`
-	CreatedAt time.Time `json:"created_at"`
+	ID              uint                            `json:"id"`
+	ParentCommentID *uint                           `json:"parent_comment_id,omitempty"`
+	Content         string                          `json:"content"`
+	RenderedContent string                          `json:"rendered_content"`
+	User            *UserInfo                       `json:"user"`
+	Mentions        []*MentionResponse              `json:"mentions,omitempty"`
+	ReactionCount   int64                           `json:"reaction_count"`
+	ReactionCounts  map[entities.ReactionType]int64 `json:"reaction_counts"`
+	ReplyCount      int64                           `json:"reply_count"`
+	Replies         []*CommentResponse              `json:"replies,omitempty"`
+	CreatedAt       time.Time                       `json:"created_at"`
+}
+
+type CommentReactionResponse struct {
+	ID        uint                  `json:"id"`
+	UserID    uint                  `json:"user_id"`
+	CommentID uint                  `json:"comment_id"`
+	Type      entities.ReactionType `json:"type"`
+}
+
+type PostActivityEvent struct {
+	PostID   uint                  `json:"post_id"`
+	Actor    *UserInfo             `json:"actor"`
+	Reaction entities.ReactionType `json:"reaction,omitempty"`
+	Comment  *CommentResponse      `json:"comment,omitempty"`
+}
+
+type HashtagResponse struct {
+	Name          string `json:"name"`
+	FollowerCount int64  `json:"follower_count"`
+	Following     bool   `json:"following"`
+}
+
+type TrendingHashtagResponse struct {
+	Name      string `json:"name"`
+	PostCount int64  `json:"post_count"`
 }
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *PostHandler) GetHashtagPosts(c *gin.Context) {
+	viewerID := middleware.GetUserID(c)
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	hashtag, posts, err := h.postService.GetHashtagPosts(c.Request.Context(), viewerID, c.Param("tag"), limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get hashtag posts", "error", err)
+		response.Error(c, hashtagErrorStatus(err), "Failed to get hashtag posts", err.Error())
+		return
+	}
+
+	h.postService.RecordImpressions(c.Request.Context(), viewerID, middleware.GetViewerKey(c), posts...)
+
+	response.Success(c, gin.H{
+		"hashtag": hashtag,
+		"posts":   posts,
+		"limit":   limit,
+		"offset":  offset,
+	})
+}
+
+func (h *PostHandler) GetTrendingHashtags(c *gin.Context) {
+	days, _ := strconv.Atoi(c.DefaultQuery("days", "7"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	hashtags, err := h.postService.GetTrendingHashtags(c.Request.Context(), days, limit)
+	if err != nil {
+		h.logger.Error("Failed to get trending hashtags", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get trending hashtags", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"hashtags": hashtags})
+}
+
+func (h *PostHandler) GetFollowedHashtags(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	hashtags, err := h.postService.GetFollowedHashtags(c.Request.Context(), userID)
+	if err != nil {
+		h.logger.Error("Failed to get followed hashtags", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get followed hashtags", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"hashtags": hashtags})
+}
+
+func (h *PostHandler) FollowHashtag(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	hashtag, err := h.postService.FollowHashtag(c.Request.Context(), userID, c.Param("tag"))
+	if err != nil {
+		h.logger.Error("Failed to follow hashtag", "error", err)
+		response.Error(c, hashtagErrorStatus(err), "Failed to follow hashtag", err.Error())
+		return
+	}
+
+	response.Success(c, hashtag)
+}
+
+func (h *PostHandler) UnfollowHashtag(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	if err := h.postService.UnfollowHashtag(c.Request.Context(), userID, c.Param("tag")); err != nil {
+		h.logger.Error("Failed to unfollow hashtag", "error", err)
+		response.Error(c, hashtagErrorStatus(err), "Failed to unfollow hashtag", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Hashtag unfollowed"})
+}
+
+func hashtagErrorStatus(err error) int {
+	switch err.Error() {
+	case "invalid hashtag":
+		return http.StatusBadRequest
+	case "hashtag not found", "not following this hashtag":
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
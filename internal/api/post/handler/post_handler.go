@@ -3,6 +3,7 @@ package handler
 import (
 	"linked-clone/internal/api/post/dto"
 	"linked-clone/internal/api/post/service"
+	"linked-clone/internal/domain/entities"
 	"linked-clone/internal/middleware"
 	"linked-clone/pkg/logger"
 	"linked-clone/pkg/response"
@@ -68,6 +69,8 @@ func (h *PostHandler) GetPost(c *gin.Context) {
 		return
 	}
 
+	h.postService.RecordImpressions(c.Request.Context(), middleware.GetUserID(c), middleware.GetViewerKey(c), post)
+
 	response.Success(c, post)
 }
 
@@ -77,6 +80,12 @@ func (h *PostHandler) GetFeed(c *gin.Context) {
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
 
+	fields, err := response.ParseFields(c.Query("fields"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid fields parameter", err.Error())
+		return
+	}
+
 	posts, err := h.postService.GetFeed(c.Request.Context(), userID, limit, offset)
 	if err != nil {
 		h.logger.Error("Failed to get feed", "error", err)
@@ -84,8 +93,10 @@ func (h *PostHandler) GetFeed(c *gin.Context) {
 		return
 	}
 
+	h.postService.RecordImpressions(c.Request.Context(), userID, middleware.GetViewerKey(c), posts...)
+
 	response.Success(c, gin.H{
-		"posts":  posts,
+		"posts":  response.Project(posts, fields),
 		"limit":  limit,
 		"offset": offset,
 	})
@@ -167,7 +178,7 @@ func (h *PostHandler) DeletePost(c *gin.Context) {
 	response.Success(c, gin.H{"message": "Post deleted successfully"})
 }
 
-func (h *PostHandler) LikePost(c *gin.Context) {
+func (h *PostHandler) SharePost(c *gin.Context) {
 	userID := middleware.GetUserID(c)
 
 	idStr := c.Param("id")
@@ -177,28 +188,73 @@ func (h *PostHandler) LikePost(c *gin.Context) {
 		return
 	}
 
-	like, err := h.postService.LikePost(c.Request.Context(), userID, uint(postID))
+	var req dto.SharePostRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+			return
+		}
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	post, err := h.postService.SharePost(c.Request.Context(), userID, uint(postID), &req)
 	if err != nil {
-		h.logger.Error("Failed to like post", "error", err)
+		h.logger.Error("Failed to share post", "error", err)
 
-		if err.Error() == "post already liked" {
-			response.Error(c, http.StatusConflict, "Post already liked", "")
+		if err.Error() == "post not found" {
+			response.Error(c, http.StatusNotFound, "Post not found", "")
 			return
 		}
 
+		response.Error(c, http.StatusInternalServerError, "Failed to share post", err.Error())
+		return
+	}
+
+	response.Success(c, post)
+}
+
+func (h *PostHandler) ReactToPost(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	idStr := c.Param("id")
+	postID, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid post ID", err.Error())
+		return
+	}
+
+	var req dto.ReactRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	reaction, err := h.postService.ReactToPost(c.Request.Context(), userID, uint(postID), &req)
+	if err != nil {
+		h.logger.Error("Failed to react to post", "error", err)
+
 		if err.Error() == "post not found" {
 			response.Error(c, http.StatusNotFound, "Post not found", "")
 			return
 		}
 
-		response.Error(c, http.StatusInternalServerError, "Failed to like post", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to react to post", err.Error())
 		return
 	}
 
-	response.Success(c, like)
+	response.Success(c, reaction)
 }
 
-func (h *PostHandler) UnlikePost(c *gin.Context) {
+func (h *PostHandler) RemoveReaction(c *gin.Context) {
 	userID := middleware.GetUserID(c)
 
 	idStr := c.Param("id")
@@ -208,22 +264,85 @@ func (h *PostHandler) UnlikePost(c *gin.Context) {
 		return
 	}
 
-	if err := h.postService.UnlikePost(c.Request.Context(), userID, uint(postID)); err != nil {
-		h.logger.Error("Failed to unlike post", "error", err)
+	if err := h.postService.RemoveReaction(c.Request.Context(), userID, uint(postID)); err != nil {
+		h.logger.Error("Failed to remove reaction", "error", err)
 
-		if err.Error() == "like not found" {
-			response.Error(c, http.StatusNotFound, "Like not found", "")
+		if err.Error() == "reaction not found" {
+			response.Error(c, http.StatusNotFound, "Reaction not found", "")
 			return
 		}
 
-		response.Error(c, http.StatusInternalServerError, "Failed to unlike post", err.Error())
+		response.Error(c, http.StatusInternalServerError, "Failed to remove reaction", err.Error())
 		return
 	}
 
-	response.Success(c, gin.H{"message": "Post unliked successfully"})
+	response.Success(c, gin.H{"message": "Reaction removed successfully"})
 }
 
-func (h *PostHandler) GetPostLikes(c *gin.Context) {
+func (h *PostHandler) GiveFeedback(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	idStr := c.Param("id")
+	postID, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid post ID", err.Error())
+		return
+	}
+
+	var req dto.PostFeedbackRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	if err := h.postService.GiveFeedback(c.Request.Context(), userID, uint(postID), &req); err != nil {
+		h.logger.Error("Failed to give feed feedback", "error", err)
+
+		switch err.Error() {
+		case "post not found":
+			response.Error(c, http.StatusNotFound, "Post not found", "")
+		case "cannot give feedback on your own post":
+			response.Error(c, http.StatusBadRequest, "Cannot give feedback on your own post", "")
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to save feedback", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Thanks, you will see fewer posts like this"})
+}
+
+func (h *PostHandler) RemoveFeedback(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	idStr := c.Param("id")
+	postID, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid post ID", err.Error())
+		return
+	}
+
+	if err := h.postService.RemoveFeedback(c.Request.Context(), userID, uint(postID)); err != nil {
+		h.logger.Error("Failed to remove feed feedback", "error", err)
+
+		if err.Error() == "feedback not found" {
+			response.Error(c, http.StatusNotFound, "Feedback not found", "")
+			return
+		}
+
+		response.Error(c, http.StatusInternalServerError, "Failed to remove feedback", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Feedback removed successfully"})
+}
+
+func (h *PostHandler) GetPostReactions(c *gin.Context) {
 	idStr := c.Param("id")
 	postID, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
@@ -233,19 +352,27 @@ func (h *PostHandler) GetPostLikes(c *gin.Context) {
 
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	reactionType := entities.ReactionType(c.Query("type"))
 
-	likes, err := h.postService.GetPostLikes(c.Request.Context(), uint(postID), limit, offset)
+	reactions, err := h.postService.GetPostReactions(c.Request.Context(), uint(postID), reactionType, limit, offset)
 	if err != nil {
-		h.logger.Error("Failed to get post likes", "error", err)
-		response.Error(c, http.StatusInternalServerError, "Failed to get post likes", err.Error())
+		h.logger.Error("Failed to get post reactions", "error", err)
+
+		if err.Error() == "post not found" {
+			response.Error(c, http.StatusNotFound, "Post not found", "")
+			return
+		}
+
+		response.Error(c, http.StatusInternalServerError, "Failed to get post reactions", err.Error())
 		return
 	}
 
 	response.Success(c, gin.H{
-		"likes":   likes,
-		"post_id": postID,
-		"limit":   limit,
-		"offset":  offset,
+		"reactions": reactions,
+		"post_id":   postID,
+		"type":      reactionType,
+		"limit":     limit,
+		"offset":    offset,
 	})
 }
 
@@ -392,3 +519,241 @@ func (h *PostHandler) DeleteComment(c *gin.Context) {
 
 	response.Success(c, gin.H{"message": "Comment deleted successfully"})
 }
+
+func (h *PostHandler) ReplyToComment(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	commentIDStr := c.Param("commentId")
+	commentID, err := strconv.ParseUint(commentIDStr, 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid comment ID", err.Error())
+		return
+	}
+
+	var req dto.AddCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	reply, err := h.postService.ReplyToComment(c.Request.Context(), userID, uint(commentID), &req)
+	if err != nil {
+		h.logger.Error("Failed to reply to comment", "error", err)
+
+		if err.Error() == "comment not found" || err.Error() == "post not found" {
+			response.Error(c, http.StatusNotFound, "Comment not found", "")
+			return
+		}
+
+		response.Error(c, http.StatusInternalServerError, "Failed to reply to comment", err.Error())
+		return
+	}
+
+	response.Success(c, reply)
+}
+
+func (h *PostHandler) GetCommentReplies(c *gin.Context) {
+	commentIDStr := c.Param("commentId")
+	commentID, err := strconv.ParseUint(commentIDStr, 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid comment ID", err.Error())
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+
+	replies, err := h.postService.GetCommentReplies(c.Request.Context(), uint(commentID), limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get comment replies", "error", err)
+
+		if err.Error() == "comment not found" {
+			response.Error(c, http.StatusNotFound, "Comment not found", "")
+			return
+		}
+
+		response.Error(c, http.StatusInternalServerError, "Failed to get replies", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{
+		"replies":    replies,
+		"comment_id": commentID,
+		"limit":      limit,
+		"offset":     offset,
+	})
+}
+
+func (h *PostHandler) ReactToComment(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	commentIDStr := c.Param("commentId")
+	commentID, err := strconv.ParseUint(commentIDStr, 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid comment ID", err.Error())
+		return
+	}
+
+	var req dto.ReactRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	reaction, err := h.postService.ReactToComment(c.Request.Context(), userID, uint(commentID), &req)
+	if err != nil {
+		h.logger.Error("Failed to react to comment", "error", err)
+
+		if err.Error() == "comment not found" {
+			response.Error(c, http.StatusNotFound, "Comment not found", "")
+			return
+		}
+
+		response.Error(c, http.StatusInternalServerError, "Failed to react to comment", err.Error())
+		return
+	}
+
+	response.Success(c, reaction)
+}
+
+func (h *PostHandler) RemoveCommentReaction(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	commentIDStr := c.Param("commentId")
+	commentID, err := strconv.ParseUint(commentIDStr, 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid comment ID", err.Error())
+		return
+	}
+
+	if err := h.postService.RemoveCommentReaction(c.Request.Context(), userID, uint(commentID)); err != nil {
+		h.logger.Error("Failed to remove comment reaction", "error", err)
+
+		if err.Error() == "reaction not found" {
+			response.Error(c, http.StatusNotFound, "Reaction not found", "")
+			return
+		}
+
+		response.Error(c, http.StatusInternalServerError, "Failed to remove reaction", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Reaction removed successfully"})
+}
+
+func (h *PostHandler) CreateLifeEventPost(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.CreateLifeEventPostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	post, err := h.postService.CreateLifeEventPost(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.logger.Error("Failed to create life event post", "error", err)
+
+		switch err.Error() {
+		case "experience not found":
+			response.Error(c, http.StatusNotFound, "Experience not found", "")
+		case "work anniversaries can only be shared for a current position",
+			"work anniversary requires at least one year in the position":
+			response.Error(c, http.StatusUnprocessableEntity, "Failed to create post", err.Error())
+		default:
+			response.Error(c, http.StatusInternalServerError, "Failed to create post", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, post)
+}
+
+func (h *PostHandler) GetPostSuggestions(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	suggestions, err := h.postService.GetPostSuggestions(c.Request.Context(), userID)
+	if err != nil {
+		h.logger.Error("Failed to get post suggestions", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get post suggestions", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"suggestions": suggestions})
+}
+
+func (h *PostHandler) PublishSuggestion(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	suggestionID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid suggestion ID", err.Error())
+		return
+	}
+
+	var req dto.PublishSuggestionRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+			return
+		}
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	post, err := h.postService.PublishSuggestion(c.Request.Context(), userID, uint(suggestionID), &req)
+	if err != nil {
+		h.logger.Error("Failed to publish post suggestion", "error", err)
+		h.suggestionError(c, err, "Failed to publish suggestion")
+		return
+	}
+
+	response.Success(c, post)
+}
+
+func (h *PostHandler) DismissSuggestion(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	suggestionID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid suggestion ID", err.Error())
+		return
+	}
+
+	if err := h.postService.DismissSuggestion(c.Request.Context(), userID, uint(suggestionID)); err != nil {
+		h.logger.Error("Failed to dismiss post suggestion", "error", err)
+		h.suggestionError(c, err, "Failed to dismiss suggestion")
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Suggestion dismissed successfully"})
+}
+
+func (h *PostHandler) suggestionError(c *gin.Context, err error, message string) {
+	switch err.Error() {
+	case "suggestion not found":
+		response.Error(c, http.StatusNotFound, "Suggestion not found", "")
+	case "suggestion already handled":
+		response.Error(c, http.StatusConflict, "Suggestion already handled", "")
+	default:
+		response.Error(c, http.StatusInternalServerError, message, err.Error())
+	}
+}
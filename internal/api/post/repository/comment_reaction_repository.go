@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type commentReactionRepository struct {
+	db *gorm.DB
+}
+
+func NewCommentReactionRepository(db *gorm.DB) repositories.CommentReactionRepository {
+	return &commentReactionRepository{db: db}
+}
+
+// Create revives a previously removed reaction in place, the same way post
+// reactions do, since (user_id, comment_id) is unique.
+func (r *commentReactionRepository) Create(ctx context.Context, reaction *entities.CommentReaction) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}, {Name: "comment_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"type":       reaction.Type,
+				"deleted_at": nil,
+				"created_at": gorm.Expr("EXCLUDED.created_at"),
+				"updated_at": gorm.Expr("EXCLUDED.updated_at"),
+			}),
+		}).
+		Create(reaction).Error
+}
+
+func (r *commentReactionRepository) UpdateType(ctx context.Context, reactionID uint, reactionType entities.ReactionType) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.CommentReaction{}).
+		Where("id = ?", reactionID).
+		Update("type", reactionType).Error
+}
+
+func (r *commentReactionRepository) Delete(ctx context.Context, userID, commentID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND comment_id = ?", userID, commentID).
+		Delete(&entities.CommentReaction{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *commentReactionRepository) FindByUserAndComment(ctx context.Context, userID, commentID uint) (*entities.CommentReaction, error) {
+	var reaction entities.CommentReaction
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND comment_id = ?", userID, commentID).
+		First(&reaction).Error
+	if err != nil {
+		return nil, err
+	}
+	return &reaction, nil
+}
+
+func (r *commentReactionRepository) CountByCommentIDs(ctx context.Context, commentIDs []uint) (map[uint]map[entities.ReactionType]int64, error) {
+	counts := make(map[uint]map[entities.ReactionType]int64, len(commentIDs))
+	if len(commentIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		CommentID uint
+		Type      entities.ReactionType
+		Count     int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&entities.CommentReaction{}).
+		Select("comment_id, type, COUNT(*) AS count").
+		Where("comment_id IN ?", commentIDs).
+		Group("comment_id, type").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		if counts[row.CommentID] == nil {
+			counts[row.CommentID] = make(map[entities.ReactionType]int64)
+		}
+		counts[row.CommentID][row.Type] = row.Count
+	}
+	return counts, nil
+}
@@ -24,6 +24,7 @@ func (r *commentRepository) GetByID(ctx context.Context, id uint) (*entities.Com
 	var comment entities.Comment
 	err := r.db.WithContext(ctx).
 		Preload("User").
+		Where(notModerated).
 		First(&comment, id).Error
 	if err != nil {
 		return nil, err
@@ -35,7 +36,8 @@ func (r *commentRepository) GetByPostID(ctx context.Context, postID uint, limit,
 	var comments []*entities.Comment
 	err := r.db.WithContext(ctx).
 		Preload("User").
-		Where("post_id = ?", postID).
+		Where("post_id = ? AND parent_comment_id IS NULL", postID).
+		Where(notModerated).
 		Order("created_at ASC").
 		Limit(limit).
 		Offset(offset).
@@ -43,10 +45,112 @@ func (r *commentRepository) GetByPostID(ctx context.Context, postID uint, limit,
 	return comments, err
 }
 
+func (r *commentRepository) GetReplies(ctx context.Context, parentID uint, limit, offset int) ([]*entities.Comment, error) {
+	var replies []*entities.Comment
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("parent_comment_id = ?", parentID).
+		Where(notModerated).
+		Order("created_at ASC, id ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&replies).Error
+	return replies, err
+}
+
+// GetReplyPreviews loads the first perThread replies of each thread in a
+// single query, keyed by parent comment ID.
+func (r *commentRepository) GetReplyPreviews(ctx context.Context, parentIDs []uint, perThread int) (map[uint][]*entities.Comment, error) {
+	previews := make(map[uint][]*entities.Comment, len(parentIDs))
+	if len(parentIDs) == 0 || perThread <= 0 {
+		return previews, nil
+	}
+
+	ranked := r.db.Model(&entities.Comment{}).
+		Select("id, ROW_NUMBER() OVER (PARTITION BY parent_comment_id ORDER BY created_at ASC, id ASC) AS thread_rank").
+		Where("parent_comment_id IN ?", parentIDs).
+		Where(notModerated)
+
+	var replies []*entities.Comment
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("id IN (?)", r.db.Table("(?) AS ranked", ranked).Select("id").Where("thread_rank <= ?", perThread)).
+		Order("created_at ASC, id ASC").
+		Find(&replies).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, reply := range replies {
+		previews[*reply.ParentCommentID] = append(previews[*reply.ParentCommentID], reply)
+	}
+	return previews, nil
+}
+
+func (r *commentRepository) CountReplies(ctx context.Context, parentIDs []uint) (map[uint]int64, error) {
+	counts := make(map[uint]int64, len(parentIDs))
+	if len(parentIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		ParentCommentID uint
+		Count           int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&entities.Comment{}).
+		Select("parent_comment_id, COUNT(*) AS count").
+		Where("parent_comment_id IN ?", parentIDs).
+		Where(notModerated).
+		Group("parent_comment_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.ParentCommentID] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *commentRepository) CountByPostIDs(ctx context.Context, postIDs []uint) (map[uint]int64, error) {
+	counts := make(map[uint]int64, len(postIDs))
+	if len(postIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		PostID uint
+		Count  int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&entities.Comment{}).
+		Select("post_id, COUNT(*) AS count").
+		Where("post_id IN ?", postIDs).
+		Where(notModerated).
+		Group("post_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.PostID] = row.Count
+	}
+	return counts, nil
+}
+
 func (r *commentRepository) Update(ctx context.Context, comment *entities.Comment) error {
 	return r.db.WithContext(ctx).Save(comment).Error
 }
 
+// Delete removes the comment together with any replies in its thread.
 func (r *commentRepository) Delete(ctx context.Context, id uint) error {
-	return r.db.WithContext(ctx).Delete(&entities.Comment{}, id).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("parent_comment_id = ?", id).Delete(&entities.Comment{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&entities.Comment{}, id).Error
+	})
 }
@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type hashtagRepository struct {
+	db *gorm.DB
+}
+
+func NewHashtagRepository(db *gorm.DB) repositories.HashtagRepository {
+	return &hashtagRepository{db: db}
+}
+
+// SetPostHashtags makes the post's tags exactly names, creating any hashtag
+// seen for the first time and detaching tags that were edited out.
+func (r *hashtagRepository) SetPostHashtags(ctx context.Context, postID uint, names []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var hashtagIDs []uint
+		if len(names) > 0 {
+			hashtags := make([]entities.Hashtag, 0, len(names))
+			for _, name := range names {
+				hashtags = append(hashtags, entities.Hashtag{Name: name})
+			}
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&hashtags).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(&entities.Hashtag{}).Where("name IN ?", names).Pluck("id", &hashtagIDs).Error; err != nil {
+				return err
+			}
+		}
+
+		stale := tx.Where("post_id = ?", postID)
+		if len(hashtagIDs) > 0 {
+			stale = stale.Where("hashtag_id NOT IN ?", hashtagIDs)
+		}
+		if err := stale.Delete(&entities.PostHashtag{}).Error; err != nil {
+			return err
+		}
+
+		if len(hashtagIDs) == 0 {
+			return nil
+		}
+		links := make([]entities.PostHashtag, 0, len(hashtagIDs))
+		for _, hashtagID := range hashtagIDs {
+			links = append(links, entities.PostHashtag{PostID: postID, HashtagID: hashtagID})
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&links).Error
+	})
+}
+
+func (r *hashtagRepository) GetOrCreate(ctx context.Context, name string) (*entities.Hashtag, error) {
+	hashtag := entities.Hashtag{Name: name}
+	err := r.db.WithContext(ctx).
+		Where(entities.Hashtag{Name: name}).
+		FirstOrCreate(&hashtag).Error
+	if err != nil {
+		return nil, err
+	}
+	return &hashtag, nil
+}
+
+func (r *hashtagRepository) GetByName(ctx context.Context, name string) (*entities.Hashtag, error) {
+	var hashtag entities.Hashtag
+	err := r.db.WithContext(ctx).
+		Where("name = ?", name).
+		First(&hashtag).Error
+	if err != nil {
+		return nil, err
+	}
+	return &hashtag, nil
+}
+
+func (r *hashtagRepository) GetPostIDs(ctx context.Context, hashtagID uint, limit, offset int) ([]uint, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).
+		Table("post_hashtags").
+		Joins("JOIN posts ON posts.id = post_hashtags.post_id AND posts.deleted_at IS NULL AND posts.hidden_at IS NULL").
+		Where("post_hashtags.hashtag_id = ?", hashtagID).
+		Order("posts.created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Pluck("post_hashtags.post_id", &ids).Error
+	return ids, err
+}
+
+// GetTrending ranks hashtags by how many live posts created since the given
+// time use them.
+func (r *hashtagRepository) GetTrending(ctx context.Context, since time.Time, limit int) ([]*entities.HashtagStats, error) {
+	var trending []*entities.HashtagStats
+	err := r.db.WithContext(ctx).
+		Table("post_hashtags").
+		Select("hashtags.name, COUNT(*) AS post_count").
+		Joins("JOIN hashtags ON hashtags.id = post_hashtags.hashtag_id").
+		Joins("JOIN posts ON posts.id = post_hashtags.post_id AND posts.deleted_at IS NULL AND posts.hidden_at IS NULL").
+		Where("posts.created_at >= ?", since).
+		Group("hashtags.name").
+		Order("post_count DESC, hashtags.name ASC").
+		Limit(limit).
+		Scan(&trending).Error
+	return trending, err
+}
+
+func (r *hashtagRepository) Follow(ctx context.Context, userID, hashtagID uint) error {
+	return r.db.WithContext(ctx).
+		Omit("Hashtag").
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&entities.HashtagFollow{UserID: userID, HashtagID: hashtagID}).Error
+}
+
+func (r *hashtagRepository) Unfollow(ctx context.Context, userID, hashtagID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND hashtag_id = ?", userID, hashtagID).
+		Delete(&entities.HashtagFollow{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *hashtagRepository) IsFollowing(ctx context.Context, userID, hashtagID uint) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.HashtagFollow{}).
+		Where("user_id = ? AND hashtag_id = ?", userID, hashtagID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *hashtagRepository) CountFollowers(ctx context.Context, hashtagID uint) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.HashtagFollow{}).
+		Where("hashtag_id = ?", hashtagID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *hashtagRepository) GetFollowed(ctx context.Context, userID uint) ([]*entities.Hashtag, error) {
+	var hashtags []*entities.Hashtag
+	err := r.db.WithContext(ctx).
+		Joins("JOIN hashtag_follows ON hashtag_follows.hashtag_id = hashtags.id").
+		Where("hashtag_follows.user_id = ?", userID).
+		Order("hashtags.name ASC").
+		Find(&hashtags).Error
+	return hashtags, err
+}
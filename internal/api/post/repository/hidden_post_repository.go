@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type hiddenPostRepository struct {
+	db *gorm.DB
+}
+
+func NewHiddenPostRepository(db *gorm.DB) repositories.HiddenPostRepository {
+	return &hiddenPostRepository{db: db}
+}
+
+// Hide records the feedback, replacing any given on the post before.
+func (r *hiddenPostRepository) Hide(ctx context.Context, hidden *entities.HiddenPost) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "post_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"feedback", "created_at"}),
+		}).
+		Create(hidden).Error
+}
+
+func (r *hiddenPostRepository) Unhide(ctx context.Context, userID, postID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND post_id = ?", userID, postID).
+		Delete(&entities.HiddenPost{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *hiddenPostRepository) CountByAuthor(ctx context.Context, userID uint, since time.Time) (map[uint]map[entities.FeedFeedback]int64, error) {
+	var rows []struct {
+		AuthorID uint
+		Feedback entities.FeedFeedback
+		Count    int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&entities.HiddenPost{}).
+		Select("posts.user_id AS author_id, hidden_posts.feedback, COUNT(*) AS count").
+		Joins("JOIN posts ON posts.id = hidden_posts.post_id").
+		Where("hidden_posts.user_id = ? AND hidden_posts.created_at >= ?", userID, since).
+		Group("posts.user_id, hidden_posts.feedback").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uint]map[entities.FeedFeedback]int64)
+	for _, row := range rows {
+		if counts[row.AuthorID] == nil {
+			counts[row.AuthorID] = make(map[entities.FeedFeedback]int64)
+		}
+		counts[row.AuthorID][row.Feedback] = row.Count
+	}
+	return counts, nil
+}
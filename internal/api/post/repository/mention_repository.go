@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type mentionRepository struct {
+	db *gorm.DB
+}
+
+func NewMentionRepository(db *gorm.DB) repositories.MentionRepository {
+	return &mentionRepository{db: db}
+}
+
+// SetMentions makes the entity's mentions exactly userIDs and returns the
+// users that were not mentioned before, so an edit only notifies newcomers.
+func (r *mentionRepository) SetMentions(ctx context.Context, entityType entities.MentionEntityType, entityID, mentionerID uint, userIDs []uint) ([]uint, error) {
+	var added []uint
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		stale := tx.Where("entity_type = ? AND entity_id = ?", entityType, entityID)
+		if len(userIDs) > 0 {
+			stale = stale.Where("mentioned_user_id NOT IN ?", userIDs)
+		}
+		if err := stale.Delete(&entities.Mention{}).Error; err != nil {
+			return err
+		}
+
+		var existing []uint
+		if err := tx.Model(&entities.Mention{}).
+			Where("entity_type = ? AND entity_id = ?", entityType, entityID).
+			Pluck("mentioned_user_id", &existing).Error; err != nil {
+			return err
+		}
+		known := make(map[uint]bool, len(existing))
+		for _, userID := range existing {
+			known[userID] = true
+		}
+
+		mentions := make([]*entities.Mention, 0, len(userIDs))
+		for _, userID := range userIDs {
+			if known[userID] {
+				continue
+			}
+			added = append(added, userID)
+			mentions = append(mentions, &entities.Mention{
+				EntityType:      entityType,
+				EntityID:        entityID,
+				MentionedUserID: userID,
+				MentionerID:     mentionerID,
+			})
+		}
+		if len(mentions) == 0 {
+			return nil
+		}
+		return tx.Omit("MentionedUser").Clauses(clause.OnConflict{DoNothing: true}).Create(&mentions).Error
+	})
+	return added, err
+}
+
+func (r *mentionRepository) GetByEntityIDs(ctx context.Context, entityType entities.MentionEntityType, entityIDs []uint) (map[uint][]*entities.Mention, error) {
+	byEntity := make(map[uint][]*entities.Mention, len(entityIDs))
+	if len(entityIDs) == 0 {
+		return byEntity, nil
+	}
+
+	var mentions []*entities.Mention
+	err := r.db.WithContext(ctx).
+		Preload("MentionedUser").
+		Where("entity_type = ? AND entity_id IN ?", entityType, entityIDs).
+		Order("id ASC").
+		Find(&mentions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, mention := range mentions {
+		byEntity[mention.EntityID] = append(byEntity[mention.EntityID], mention)
+	}
+	return byEntity, nil
+}
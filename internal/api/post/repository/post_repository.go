@@ -24,8 +24,11 @@ func (r *postRepository) GetByID(ctx context.Context, id uint) (*entities.Post,
 	var post entities.Post
 	err := r.db.WithContext(ctx).
 		Preload("User").
-		Preload("Comments").
+		Preload("SharedPost", notModerated).
+		Preload("SharedPost.User").
+		Preload("Comments", notModerated).
 		Preload("Comments.User").
+		Where(notModerated).
 		First(&post, id).Error
 	if err != nil {
 		return nil, err
@@ -37,7 +40,10 @@ func (r *postRepository) GetByUserID(ctx context.Context, userID uint, limit, of
 	var posts []*entities.Post
 	err := r.db.WithContext(ctx).
 		Preload("User").
+		Preload("SharedPost", notModerated).
+		Preload("SharedPost.User").
 		Where("user_id = ?", userID).
+		Where(notModerated).
 		Order("created_at DESC").
 		Limit(limit).
 		Offset(offset).
@@ -45,11 +51,22 @@ func (r *postRepository) GetByUserID(ctx context.Context, userID uint, limit, of
 	return posts, err
 }
 
-func (r *postRepository) GetFeed(ctx context.Context, userID uint, limit, offset int) ([]*entities.Post, error) {
+// notModerated leaves out posts and comments a moderator has hidden.
+const notModerated = "hidden_at IS NULL"
+
+// notHiddenFrom leaves out posts the viewer has hidden, along with reshares
+// of them.
+const notHiddenFrom = "NOT EXISTS (SELECT 1 FROM hidden_posts WHERE hidden_posts.user_id = ? AND hidden_posts.post_id IN (posts.id, posts.shared_post_id))"
+
+func (r *postRepository) GetFeed(ctx context.Context, viewerID uint, authorIDs []uint, limit, offset int) ([]*entities.Post, error) {
 	var posts []*entities.Post
 	err := r.db.WithContext(ctx).
 		Preload("User").
-		Where("user_id = ?", userID).
+		Preload("SharedPost", notModerated).
+		Preload("SharedPost.User").
+		Where("user_id IN ?", authorIDs).
+		Where(notModerated).
+		Where(notHiddenFrom, viewerID).
 		Order("created_at DESC").
 		Limit(limit).
 		Offset(offset).
@@ -57,6 +74,43 @@ func (r *postRepository) GetFeed(ctx context.Context, userID uint, limit, offset
 	return posts, err
 }
 
+func (r *postRepository) GetFeedPostIDs(ctx context.Context, viewerID uint, authorIDs []uint, limit int) ([]uint, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).
+		Model(&entities.Post{}).
+		Where("user_id IN ?", authorIDs).
+		Where(notModerated).
+		Where(notHiddenFrom, viewerID).
+		Order("created_at DESC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+func (r *postRepository) GetByIDs(ctx context.Context, ids []uint) ([]*entities.Post, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var found []*entities.Post
+	if err := r.db.WithContext(ctx).Preload("User").Preload("SharedPost", notModerated).Preload("SharedPost.User").Where("id IN ?", ids).Where(notModerated).Find(&found).Error; err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uint]*entities.Post, len(found))
+	for _, post := range found {
+		byID[post.ID] = post
+	}
+
+	posts := make([]*entities.Post, 0, len(found))
+	for _, id := range ids {
+		if post, ok := byID[id]; ok {
+			posts = append(posts, post)
+		}
+	}
+	return posts, nil
+}
+
 func (r *postRepository) Update(ctx context.Context, post *entities.Post) error {
 	return r.db.WithContext(ctx).Save(post).Error
 }
@@ -65,14 +119,14 @@ func (r *postRepository) Delete(ctx context.Context, id uint) error {
 	return r.db.WithContext(ctx).Delete(&entities.Post{}, id).Error
 }
 
-func (r *postRepository) IncrementLikeCount(ctx context.Context, postID uint) error {
+func (r *postRepository) IncrementReactionCount(ctx context.Context, postID uint) error {
 	return r.db.WithContext(ctx).Model(&entities.Post{}).
 		Where("id = ?", postID).
-		Update("like_count", gorm.Expr("like_count + 1")).Error
+		Update("reaction_count", gorm.Expr("reaction_count + 1")).Error
 }
 
-func (r *postRepository) DecrementLikeCount(ctx context.Context, postID uint) error {
+func (r *postRepository) DecrementReactionCount(ctx context.Context, postID uint) error {
 	return r.db.WithContext(ctx).Model(&entities.Post{}).
 		Where("id = ?", postID).
-		Update("like_count", gorm.Expr("like_count - 1")).Error
+		Update("reaction_count", gorm.Expr("reaction_count - 1")).Error
 }
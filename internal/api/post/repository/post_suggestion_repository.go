@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type postSuggestionRepository struct {
+	db *gorm.DB
+}
+
+func NewPostSuggestionRepository(db *gorm.DB) repositories.PostSuggestionRepository {
+	return &postSuggestionRepository{db: db}
+}
+
+func (r *postSuggestionRepository) Create(ctx context.Context, suggestion *entities.PostSuggestion) error {
+	return r.db.WithContext(ctx).Create(suggestion).Error
+}
+
+func (r *postSuggestionRepository) GetByID(ctx context.Context, id uint) (*entities.PostSuggestion, error) {
+	var suggestion entities.PostSuggestion
+	if err := r.db.WithContext(ctx).First(&suggestion, id).Error; err != nil {
+		return nil, err
+	}
+	return &suggestion, nil
+}
+
+func (r *postSuggestionRepository) GetPendingByUserID(ctx context.Context, userID uint) ([]*entities.PostSuggestion, error) {
+	var suggestions []*entities.PostSuggestion
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND status = ?", userID, entities.PostSuggestionPending).
+		Order("created_at DESC").
+		Find(&suggestions).Error
+	return suggestions, err
+}
+
+func (r *postSuggestionRepository) ExistsForExperience(ctx context.Context, experienceID uint, postType entities.PostType) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&entities.PostSuggestion{}).
+		Where("experience_id = ? AND type = ?", experienceID, postType).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *postSuggestionRepository) Update(ctx context.Context, suggestion *entities.PostSuggestion) error {
+	return r.db.WithContext(ctx).Save(suggestion).Error
+}
@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type reactionRepository struct {
+	db *gorm.DB
+}
+
+func NewReactionRepository(db *gorm.DB) repositories.ReactionRepository {
+	return &reactionRepository{db: db}
+}
+
+// Create revives a previously removed reaction in place, since removing one
+// only soft-deletes the row and (user_id, post_id) is unique.
+func (r *reactionRepository) Create(ctx context.Context, reaction *entities.Reaction) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}, {Name: "post_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"type":       reaction.Type,
+				"deleted_at": nil,
+				"created_at": gorm.Expr("EXCLUDED.created_at"),
+				"updated_at": gorm.Expr("EXCLUDED.updated_at"),
+			}),
+		}).
+		Create(reaction).Error
+}
+
+func (r *reactionRepository) UpdateType(ctx context.Context, reactionID uint, reactionType entities.ReactionType) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.Reaction{}).
+		Where("id = ?", reactionID).
+		Update("type", reactionType).Error
+}
+
+func (r *reactionRepository) Delete(ctx context.Context, userID, postID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND post_id = ?", userID, postID).
+		Delete(&entities.Reaction{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *reactionRepository) FindByUserAndPost(ctx context.Context, userID, postID uint) (*entities.Reaction, error) {
+	var reaction entities.Reaction
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND post_id = ?", userID, postID).
+		First(&reaction).Error
+	if err != nil {
+		return nil, err
+	}
+	return &reaction, nil
+}
+
+func (r *reactionRepository) GetPostReactions(ctx context.Context, postID uint, reactionType entities.ReactionType, limit, offset int) ([]*entities.Reaction, error) {
+	var reactions []*entities.Reaction
+	query := r.db.WithContext(ctx).
+		Preload("User").
+		Where("post_id = ?", postID)
+	if reactionType != "" {
+		query = query.Where("type = ?", reactionType)
+	}
+	err := query.
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&reactions).Error
+	return reactions, err
+}
+
+// SummarizeByPostIDs counts each post's reactions by type and picks its
+// first reactorLimit reactors in one pass: every row carries its type's
+// count, and only the first row of each type and the first reactors of each
+// post are returned.
+func (r *reactionRepository) SummarizeByPostIDs(ctx context.Context, postIDs []uint, reactorLimit int) (map[uint]*entities.PostReactionSummary, error) {
+	summaries := make(map[uint]*entities.PostReactionSummary, len(postIDs))
+	if len(postIDs) == 0 {
+		return summaries, nil
+	}
+
+	var rows []struct {
+		PostID      uint
+		Type        entities.ReactionType
+		TypeCount   int64
+		TypeRank    int
+		ReactorRank int
+		UserID      uint
+		Username    string
+		FullName    string
+	}
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT post_id, type, type_count, type_rank, reactor_rank, user_id, username, full_name
+		FROM (
+			SELECT r.post_id, r.type, r.user_id, u.username, u.full_name,
+				COUNT(*) OVER (PARTITION BY r.post_id, r.type) AS type_count,
+				ROW_NUMBER() OVER (PARTITION BY r.post_id, r.type ORDER BY r.created_at, r.id) AS type_rank,
+				ROW_NUMBER() OVER (PARTITION BY r.post_id ORDER BY r.created_at, r.id) AS reactor_rank
+			FROM reactions r
+			JOIN users u ON u.id = r.user_id
+			WHERE r.post_id IN ? AND r.deleted_at IS NULL
+		) ranked
+		WHERE type_rank = 1 OR reactor_rank <= ?
+		ORDER BY post_id, reactor_rank`,
+		postIDs, reactorLimit,
+	).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		summary := summaries[row.PostID]
+		if summary == nil {
+			summary = &entities.PostReactionSummary{Counts: make(map[entities.ReactionType]int64)}
+			summaries[row.PostID] = summary
+		}
+		if row.TypeRank == 1 {
+			summary.Counts[row.Type] = row.TypeCount
+		}
+		if row.ReactorRank <= reactorLimit {
+			summary.FirstReactors = append(summary.FirstReactors, entities.User{
+				ID:       row.UserID,
+				Username: row.Username,
+				FullName: row.FullName,
+			})
+		}
+	}
+	return summaries, nil
+}
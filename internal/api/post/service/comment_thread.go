@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/post/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/pkg/affinity"
+	"linked-clone/pkg/eventbus"
+	"linked-clone/pkg/markdown"
+
+	"gorm.io/gorm"
+)
+
+// replyPreviewSize is how many replies each thread shows inline when a
+// post's comments are listed; the rest are paged through GetCommentReplies.
+const replyPreviewSize = 3
+
+// ReplyToComment adds a reply to a comment thread. Threads are one level
+// deep, so replying to a reply attaches to the same top-level comment while
+// still notifying the author being replied to.
+func (s *postService) ReplyToComment(ctx context.Context, userID, commentID uint, req *dto.AddCommentRequest) (*dto.CommentResponse, error) {
+	parent, err := s.commentRepo.GetByID(ctx, commentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("comment not found")
+		}
+		s.logger.Error("Failed to get comment", "error", err)
+		return nil, errors.New("failed to get comment")
+	}
+
+	post, err := s.postRepo.GetByID(ctx, parent.PostID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("post not found")
+		}
+		s.logger.Error("Failed to get post", "error", err)
+		return nil, errors.New("failed to get post")
+	}
+
+	threadID := parent.ID
+	if parent.ParentCommentID != nil {
+		threadID = *parent.ParentCommentID
+	}
+
+	reply := &entities.Comment{
+		UserID:          userID,
+		PostID:          post.ID,
+		ParentCommentID: &threadID,
+		Content:         req.Content,
+		RenderedContent: markdown.Render(req.Content),
+	}
+
+	if err := s.commentRepo.Create(ctx, reply); err != nil {
+		s.logger.Error("Failed to create reply", "error", err)
+		return nil, errors.New("failed to add reply")
+	}
+	mentioned := s.syncMentions(ctx, entities.MentionInComment, reply.ID, userID, reply.Content)
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get user", "error", err)
+		return nil, errors.New("failed to get user details")
+	}
+	reply.User = *user
+
+	resp := s.mapComments(ctx, []*entities.Comment{reply})[0]
+	activity := &dto.PostActivityEvent{
+		PostID:  post.ID,
+		Actor:   resp.User,
+		Comment: resp,
+	}
+
+	s.recordAffinity(ctx, userID, parent.UserID, affinity.WeightComment)
+	s.events.Publish(ctx, &eventbus.Event{
+		Type:        eventbus.CommentReplied,
+		RecipientID: parent.UserID,
+		ActorID:     userID,
+		EntityType:  "comment",
+		EntityID:    threadID,
+		Data:        activity,
+	})
+	if post.UserID != parent.UserID {
+		s.events.Publish(ctx, &eventbus.Event{
+			Type:        eventbus.PostCommented,
+			RecipientID: post.UserID,
+			ActorID:     userID,
+			EntityType:  "post",
+			EntityID:    post.ID,
+			Data:        activity,
+		})
+	}
+	s.notifyMentioned(ctx, eventbus.CommentMentioned, entities.MentionInComment, reply.ID, mentioned, activity)
+
+	return resp, nil
+}
+
+func (s *postService) GetCommentReplies(ctx context.Context, commentID uint, limit, offset int) ([]*dto.CommentResponse, error) {
+	if _, err := s.commentRepo.GetByID(ctx, commentID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("comment not found")
+		}
+		return nil, errors.New("failed to get comment")
+	}
+
+	replies, err := s.commentRepo.GetReplies(ctx, commentID, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to get replies", "error", err)
+		return nil, errors.New("failed to get replies")
+	}
+
+	return s.mapComments(ctx, replies), nil
+}
+
+func (s *postService) ReactToComment(ctx context.Context, userID, commentID uint, req *dto.ReactRequest) (*dto.CommentReactionResponse, error) {
+	comment, err := s.commentRepo.GetByID(ctx, commentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("comment not found")
+		}
+		s.logger.Error("Failed to get comment", "error", err)
+		return nil, errors.New("failed to get comment")
+	}
+
+	existing, _ := s.commentReactionRepo.FindByUserAndComment(ctx, userID, commentID)
+	if existing != nil {
+		if existing.Type != req.Type {
+			if err := s.commentReactionRepo.UpdateType(ctx, existing.ID, req.Type); err != nil {
+				s.logger.Error("Failed to update comment reaction", "error", err)
+				return nil, errors.New("failed to react to comment")
+			}
+		}
+		return &dto.CommentReactionResponse{
+			ID:        existing.ID,
+			UserID:    userID,
+			CommentID: commentID,
+			Type:      req.Type,
+		}, nil
+	}
+
+	reaction := &entities.CommentReaction{
+		UserID:    userID,
+		CommentID: commentID,
+		Type:      req.Type,
+	}
+
+	if err := s.commentReactionRepo.Create(ctx, reaction); err != nil {
+		s.logger.Error("Failed to create comment reaction", "error", err)
+		return nil, errors.New("failed to react to comment")
+	}
+
+	if comment.UserID != userID {
+		s.recordAffinity(ctx, userID, comment.UserID, affinity.WeightLike)
+		if user, err := s.userRepo.GetByID(ctx, userID); err == nil {
+			s.events.Publish(ctx, &eventbus.Event{
+				Type:        eventbus.CommentLiked,
+				RecipientID: comment.UserID,
+				ActorID:     userID,
+				EntityType:  "comment",
+				EntityID:    commentID,
+				Data: &dto.PostActivityEvent{
+					PostID:   comment.PostID,
+					Actor:    s.mapUserInfo(user),
+					Reaction: req.Type,
+				},
+			})
+		}
+	}
+
+	return &dto.CommentReactionResponse{
+		ID:        reaction.ID,
+		UserID:    userID,
+		CommentID: commentID,
+		Type:      reaction.Type,
+	}, nil
+}
+
+func (s *postService) RemoveCommentReaction(ctx context.Context, userID, commentID uint) error {
+	if err := s.commentReactionRepo.Delete(ctx, userID, commentID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("reaction not found")
+		}
+		s.logger.Error("Failed to delete comment reaction", "error", err)
+		return errors.New("failed to remove reaction")
+	}
+	return nil
+}
+
+// mapCommentThreads renders top-level comments with their reply counts and
+// the first few replies of each thread.
+func (s *postService) mapCommentThreads(ctx context.Context, comments []*entities.Comment) []*dto.CommentResponse {
+	parentIDs := make([]uint, 0, len(comments))
+	for _, comment := range comments {
+		parentIDs = append(parentIDs, comment.ID)
+	}
+
+	replyCounts, err := s.commentRepo.CountReplies(ctx, parentIDs)
+	if err != nil {
+		s.logger.Warn("Failed to count comment replies", "error", err)
+		replyCounts = make(map[uint]int64)
+	}
+	previews, err := s.commentRepo.GetReplyPreviews(ctx, parentIDs, replyPreviewSize)
+	if err != nil {
+		s.logger.Warn("Failed to load reply previews", "error", err)
+		previews = make(map[uint][]*entities.Comment)
+	}
+
+	all := append([]*entities.Comment{}, comments...)
+	for _, replies := range previews {
+		all = append(all, replies...)
+	}
+	mapped := s.mapComments(ctx, all)
+
+	byID := make(map[uint]*dto.CommentResponse, len(mapped))
+	for _, resp := range mapped {
+		byID[resp.ID] = resp
+	}
+
+	responses := make([]*dto.CommentResponse, 0, len(comments))
+	for _, comment := range comments {
+		resp := byID[comment.ID]
+		resp.ReplyCount = replyCounts[comment.ID]
+		for _, reply := range previews[comment.ID] {
+			resp.Replies = append(resp.Replies, byID[reply.ID])
+		}
+		responses = append(responses, resp)
+	}
+	return responses
+}
+
+func (s *postService) mapComments(ctx context.Context, comments []*entities.Comment) []*dto.CommentResponse {
+	commentIDs := make([]uint, 0, len(comments))
+	for _, comment := range comments {
+		commentIDs = append(commentIDs, comment.ID)
+	}
+
+	reactionCounts, err := s.commentReactionRepo.CountByCommentIDs(ctx, commentIDs)
+	if err != nil {
+		s.logger.Warn("Failed to count comment reactions", "error", err)
+		reactionCounts = make(map[uint]map[entities.ReactionType]int64)
+	}
+	mentions := s.mentionsFor(ctx, entities.MentionInComment, commentIDs)
+
+	responses := make([]*dto.CommentResponse, 0, len(comments))
+	for _, comment := range comments {
+		counts := reactionCounts[comment.ID]
+		if counts == nil {
+			counts = make(map[entities.ReactionType]int64)
+		}
+		var total int64
+		for _, count := range counts {
+			total += count
+		}
+
+		responses = append(responses, &dto.CommentResponse{
+			ID:              comment.ID,
+			ParentCommentID: comment.ParentCommentID,
+			Content:         comment.Content,
+			RenderedContent: renderedContent(comment.Content, comment.RenderedContent),
+			User:            s.mapUserInfo(&comment.User),
+			Mentions:        mentions[comment.ID],
+			ReactionCount:   total,
+			ReactionCounts:  counts,
+			CreatedAt:       comment.CreatedAt,
+		})
+	}
+	return responses
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/post/dto"
+	"linked-clone/internal/domain/entities"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// feedbackWindow is how long feedback keeps counting against an author,
+// matching how long interaction affinity lasts.
+const feedbackWindow = 90 * 24 * time.Hour
+
+// feedbackWeights rank "show fewer like this" above hiding a single post,
+// since it is a statement about the author rather than one post.
+var feedbackWeights = map[entities.FeedFeedback]int64{
+	entities.FeedbackShowLess: 2,
+	entities.FeedbackHide:     1,
+}
+
+// GiveFeedback takes the post out of the user's feed and counts against
+// its author when the feed is ranked. Giving feedback again on the same
+// post replaces the earlier one.
+func (s *postService) GiveFeedback(ctx context.Context, userID, postID uint, req *dto.PostFeedbackRequest) error {
+	post, err := s.postRepo.GetByID(ctx, postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("post not found")
+		}
+		s.logger.Error("Failed to get post", "error", err)
+		return errors.New("failed to get post")
+	}
+	if post.UserID == userID {
+		return errors.New("cannot give feedback on your own post")
+	}
+
+	hidden := &entities.HiddenPost{UserID: userID, PostID: postID, Feedback: req.Feedback}
+	if err := s.hiddenPostRepo.Hide(ctx, hidden); err != nil {
+		s.logger.Error("Failed to save feed feedback", "error", err)
+		return errors.New("failed to save feedback")
+	}
+
+	s.invalidateFeed(ctx, userID)
+	return nil
+}
+
+func (s *postService) RemoveFeedback(ctx context.Context, userID, postID uint) error {
+	if err := s.hiddenPostRepo.Unhide(ctx, userID, postID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("feedback not found")
+		}
+		s.logger.Error("Failed to remove feed feedback", "error", err)
+		return errors.New("failed to remove feedback")
+	}
+
+	s.invalidateFeed(ctx, userID)
+	return nil
+}
+
+// invalidateFeed drops the user's precomputed feed so it is rebuilt
+// without the posts they hid.
+func (s *postService) invalidateFeed(ctx context.Context, userID uint) {
+	if !s.fanoutEnabled() {
+		return
+	}
+	if err := s.feedStore.Invalidate(ctx, userID); err != nil {
+		s.logger.Warn("Failed to invalidate precomputed feed", "error", err, "user_id", userID)
+	}
+}
+
+// feedbackPenalties weighs the user's recent feedback by author.
+func (s *postService) feedbackPenalties(ctx context.Context, userID uint) (map[uint]int64, error) {
+	counts, err := s.hiddenPostRepo.CountByAuthor(ctx, userID, time.Now().Add(-feedbackWindow))
+	if err != nil {
+		return nil, err
+	}
+
+	penalties := make(map[uint]int64, len(counts))
+	for authorID, byFeedback := range counts {
+		for feedback, count := range byFeedback {
+			penalties[authorID] += feedbackWeights[feedback] * count
+		}
+	}
+	return penalties, nil
+}
+
+// dedupeFeed keeps the first post carrying each piece of content, so a post
+// and its reshares, or several reshares of one post, show up once. posts
+// must be in display order.
+func dedupeFeed(posts []*entities.Post) []*entities.Post {
+	seen := make(map[uint]bool, len(posts))
+	kept := make([]*entities.Post, 0, len(posts))
+	for _, post := range posts {
+		content := post.ID
+		if post.SharedPostID != nil {
+			content = *post.SharedPostID
+		}
+		if seen[content] {
+			continue
+		}
+		seen[content] = true
+		kept = append(kept, post)
+	}
+	return kept
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/post/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/pkg/hashtag"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	defaultTrendingDays  = 7
+	maxTrendingDays      = 30
+	defaultTrendingLimit = 10
+	maxTrendingLimit     = 50
+)
+
+// syncHashtags re-indexes the post's hashtags from its content. Failures are
+// only logged; the post itself is already saved and the next edit retries.
+func (s *postService) syncHashtags(ctx context.Context, post *entities.Post) {
+	if err := s.hashtagRepo.SetPostHashtags(ctx, post.ID, hashtag.Extract(post.Content)); err != nil {
+		s.logger.Warn("Failed to index post hashtags", "error", err, "post_id", post.ID)
+	}
+}
+
+func (s *postService) GetHashtagPosts(ctx context.Context, viewerID uint, tag string, limit, offset int) (*dto.HashtagResponse, []*dto.PostResponse, error) {
+	found, err := s.findHashtag(ctx, tag)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids, err := s.hashtagRepo.GetPostIDs(ctx, found.ID, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to get hashtag posts", "error", err)
+		return nil, nil, errors.New("failed to get posts")
+	}
+
+	posts, err := s.postRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		s.logger.Error("Failed to load hashtag posts", "error", err)
+		return nil, nil, errors.New("failed to get posts")
+	}
+
+	return s.mapHashtag(ctx, viewerID, found), s.mapPosts(ctx, posts), nil
+}
+
+func (s *postService) GetTrendingHashtags(ctx context.Context, days, limit int) ([]*dto.TrendingHashtagResponse, error) {
+	if days <= 0 || days > maxTrendingDays {
+		days = defaultTrendingDays
+	}
+	if limit <= 0 || limit > maxTrendingLimit {
+		limit = defaultTrendingLimit
+	}
+
+	trending, err := s.hashtagRepo.GetTrending(ctx, time.Now().AddDate(0, 0, -days), limit)
+	if err != nil {
+		s.logger.Error("Failed to get trending hashtags", "error", err)
+		return nil, errors.New("failed to get trending hashtags")
+	}
+
+	responses := make([]*dto.TrendingHashtagResponse, 0, len(trending))
+	for _, stats := range trending {
+		responses = append(responses, &dto.TrendingHashtagResponse{
+			Name:      stats.Name,
+			PostCount: stats.PostCount,
+		})
+	}
+	return responses, nil
+}
+
+func (s *postService) GetFollowedHashtags(ctx context.Context, userID uint) ([]*dto.HashtagResponse, error) {
+	hashtags, err := s.hashtagRepo.GetFollowed(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get followed hashtags", "error", err)
+		return nil, errors.New("failed to get followed hashtags")
+	}
+
+	responses := make([]*dto.HashtagResponse, 0, len(hashtags))
+	for _, followed := range hashtags {
+		response := s.mapHashtag(ctx, 0, followed)
+		response.Following = true
+		responses = append(responses, response)
+	}
+	return responses, nil
+}
+
+func (s *postService) FollowHashtag(ctx context.Context, userID uint, tag string) (*dto.HashtagResponse, error) {
+	name, ok := hashtag.Normalize(tag)
+	if !ok {
+		return nil, errors.New("invalid hashtag")
+	}
+
+	found, err := s.hashtagRepo.GetOrCreate(ctx, name)
+	if err != nil {
+		s.logger.Error("Failed to get hashtag", "error", err)
+		return nil, errors.New("failed to follow hashtag")
+	}
+
+	if err := s.hashtagRepo.Follow(ctx, userID, found.ID); err != nil {
+		s.logger.Error("Failed to follow hashtag", "error", err)
+		return nil, errors.New("failed to follow hashtag")
+	}
+
+	return s.mapHashtag(ctx, userID, found), nil
+}
+
+func (s *postService) UnfollowHashtag(ctx context.Context, userID uint, tag string) error {
+	found, err := s.findHashtag(ctx, tag)
+	if err != nil {
+		return err
+	}
+
+	if err := s.hashtagRepo.Unfollow(ctx, userID, found.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("not following this hashtag")
+		}
+		s.logger.Error("Failed to unfollow hashtag", "error", err)
+		return errors.New("failed to unfollow hashtag")
+	}
+	return nil
+}
+
+func (s *postService) findHashtag(ctx context.Context, tag string) (*entities.Hashtag, error) {
+	name, ok := hashtag.Normalize(tag)
+	if !ok {
+		return nil, errors.New("invalid hashtag")
+	}
+
+	found, err := s.hashtagRepo.GetByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("hashtag not found")
+		}
+		s.logger.Error("Failed to get hashtag", "error", err)
+		return nil, errors.New("failed to get hashtag")
+	}
+	return found, nil
+}
+
+func (s *postService) mapHashtag(ctx context.Context, viewerID uint, found *entities.Hashtag) *dto.HashtagResponse {
+	response := &dto.HashtagResponse{Name: found.Name}
+
+	followers, err := s.hashtagRepo.CountFollowers(ctx, found.ID)
+	if err != nil {
+		s.logger.Warn("Failed to count hashtag followers", "error", err, "hashtag", found.Name)
+	}
+	response.FollowerCount = followers
+
+	if viewerID != 0 {
+		following, err := s.hashtagRepo.IsFollowing(ctx, viewerID, found.ID)
+		if err != nil {
+			s.logger.Warn("Failed to check hashtag follow", "error", err, "hashtag", found.Name)
+		}
+		response.Following = following
+	}
+	return response
+}
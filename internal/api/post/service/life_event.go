@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/post/dto"
+	"linked-clone/internal/domain/entities"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var lifeEventTemplates = map[entities.PostType]string{
+	entities.PostTypeNewJob:          "{{name}} started a new position as {{title}} at {{company}}",
+	entities.PostTypePromotion:       "{{name}} was promoted to {{title}} at {{company}}",
+	entities.PostTypeWorkAnniversary: "{{name}} is celebrating {{years}} {{years_label}} at {{company}}",
+	entities.PostTypeCertification:   "{{name}} earned the {{certification}} certification",
+}
+
+func (s *postService) CreateLifeEventPost(ctx context.Context, userID uint, req *dto.CreateLifeEventPostRequest) (*dto.PostResponse, error) {
+	event := entities.PostEvent{
+		PreviousTitle: req.PreviousTitle,
+		Certification: strings.TrimSpace(req.Certification),
+		Issuer:        strings.TrimSpace(req.Issuer),
+	}
+
+	now := time.Now()
+	if req.Type == entities.PostTypeCertification {
+		event.Date = &now
+	} else {
+		experience, err := s.experienceRepo.GetByID(ctx, *req.ExperienceID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("Failed to get experience", "error", err)
+			return nil, errors.New("failed to get experience")
+		}
+		if experience == nil || experience.UserID != userID {
+			return nil, errors.New("experience not found")
+		}
+
+		event.ExperienceID = &experience.ID
+		event.Title = experience.Title
+		event.Company = experience.Company
+		event.Date = &experience.StartDate
+
+		if req.Type == entities.PostTypeWorkAnniversary {
+			if !experience.IsCurrent() {
+				return nil, errors.New("work anniversaries can only be shared for a current position")
+			}
+			event.Years = experience.YearsAt(now)
+			if event.Years < 1 {
+				return nil, errors.New("work anniversary requires at least one year in the position")
+			}
+			event.Date = &now
+		}
+	}
+
+	return s.createEventPost(ctx, userID, req.Type, event, req.Content)
+}
+
+func (s *postService) GetPostSuggestions(ctx context.Context, userID uint) ([]*dto.PostSuggestionResponse, error) {
+	suggestions, err := s.suggestionRepo.GetPendingByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get post suggestions", "error", err)
+		return nil, errors.New("failed to get post suggestions")
+	}
+
+	responses := make([]*dto.PostSuggestionResponse, 0, len(suggestions))
+	if len(suggestions) == 0 {
+		return responses, nil
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get user", "error", err)
+		return nil, errors.New("failed to get post suggestions")
+	}
+
+	for _, suggestion := range suggestions {
+		event := suggestion.Event
+		responses = append(responses, &dto.PostSuggestionResponse{
+			ID:        suggestion.ID,
+			Type:      suggestion.Type,
+			Event:     renderPostEvent(suggestion.Type, &event, user.FullName),
+			CreatedAt: suggestion.CreatedAt,
+		})
+	}
+	return responses, nil
+}
+
+func (s *postService) PublishSuggestion(ctx context.Context, userID, suggestionID uint, req *dto.PublishSuggestionRequest) (*dto.PostResponse, error) {
+	suggestion, err := s.getPendingSuggestion(ctx, userID, suggestionID)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := s.createEventPost(ctx, userID, suggestion.Type, suggestion.Event, req.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestion.Status = entities.PostSuggestionPublished
+	suggestion.PostID = &post.ID
+	if err := s.suggestionRepo.Update(ctx, suggestion); err != nil {
+		s.logger.Error("Failed to mark post suggestion as published", "error", err, "suggestion_id", suggestionID)
+	}
+
+	return post, nil
+}
+
+func (s *postService) DismissSuggestion(ctx context.Context, userID, suggestionID uint) error {
+	suggestion, err := s.getPendingSuggestion(ctx, userID, suggestionID)
+	if err != nil {
+		return err
+	}
+
+	suggestion.Status = entities.PostSuggestionDismissed
+	if err := s.suggestionRepo.Update(ctx, suggestion); err != nil {
+		s.logger.Error("Failed to dismiss post suggestion", "error", err)
+		return errors.New("failed to dismiss suggestion")
+	}
+	return nil
+}
+
+func (s *postService) getPendingSuggestion(ctx context.Context, userID, suggestionID uint) (*entities.PostSuggestion, error) {
+	suggestion, err := s.suggestionRepo.GetByID(ctx, suggestionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("suggestion not found")
+		}
+		s.logger.Error("Failed to get post suggestion", "error", err)
+		return nil, errors.New("failed to get suggestion")
+	}
+	if suggestion.UserID != userID {
+		return nil, errors.New("suggestion not found")
+	}
+	if suggestion.Status != entities.PostSuggestionPending {
+		return nil, errors.New("suggestion already handled")
+	}
+	return suggestion, nil
+}
+
+func (s *postService) createEventPost(ctx context.Context, userID uint, postType entities.PostType, event entities.PostEvent, content string) (*dto.PostResponse, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		user, err := s.userRepo.GetByID(ctx, userID)
+		if err != nil {
+			s.logger.Error("Failed to get user", "error", err)
+			return nil, errors.New("failed to create post")
+		}
+		content = renderPostEvent(postType, &event, user.FullName).Text
+	}
+
+	post := &entities.Post{
+		UserID:  userID,
+		Type:    postType,
+		Content: content,
+		Event:   &event,
+	}
+	return s.publishPost(ctx, post)
+}
+
+func renderPostEvent(postType entities.PostType, event *entities.PostEvent, name string) *dto.PostEventResponse {
+	template, ok := lifeEventTemplates[postType]
+	if !ok || event == nil {
+		return nil
+	}
+
+	yearsLabel := "years"
+	if event.Years == 1 {
+		yearsLabel = "year"
+	}
+	variables := map[string]string{
+		"name":           name,
+		"title":          event.Title,
+		"previous_title": event.PreviousTitle,
+		"company":        event.Company,
+		"years":          strconv.Itoa(event.Years),
+		"years_label":    yearsLabel,
+		"certification":  event.Certification,
+		"issuer":         event.Issuer,
+	}
+
+	if postType == entities.PostTypeCertification && event.Issuer != "" {
+		template += " from {{issuer}}"
+	}
+
+	text := template
+	for key, value := range variables {
+		text = strings.ReplaceAll(text, "{{"+key+"}}", value)
+	}
+
+	return &dto.PostEventResponse{
+		Template:      template,
+		Variables:     variables,
+		Text:          text,
+		ExperienceID:  event.ExperienceID,
+		Title:         event.Title,
+		PreviousTitle: event.PreviousTitle,
+		Company:       event.Company,
+		Years:         event.Years,
+		Certification: event.Certification,
+		Issuer:        event.Issuer,
+		Date:          event.Date,
+	}
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"linked-clone/internal/api/post/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/pkg/eventbus"
+	"linked-clone/pkg/mention"
+)
+
+// syncMentions resolves the @usernames in content to existing users and
+// stores them against the post or comment. Unknown usernames are left as
+// plain text, and users on either side of a block with the author are
+// skipped. It returns the users mentioned for the first time. Failures are
+// only logged, like hashtag indexing.
+func (s *postService) syncMentions(ctx context.Context, entityType entities.MentionEntityType, entityID, authorID uint, content string) []uint {
+	users, err := s.userRepo.FindByUsernames(ctx, mention.Extract(content))
+	if err != nil {
+		s.logger.Warn("Failed to resolve mentions", "error", err, "entity_type", entityType, "entity_id", entityID)
+		return nil
+	}
+
+	userIDs := make([]uint, 0, len(users))
+	for _, user := range users {
+		if user.ID != authorID {
+			blocked, err := s.graph.IsBlocked(ctx, authorID, user.ID)
+			if err != nil {
+				s.logger.Warn("Failed to check block before mention", "error", err, "user_id", user.ID)
+				continue
+			}
+			if blocked {
+				continue
+			}
+		}
+		userIDs = append(userIDs, user.ID)
+	}
+
+	added, err := s.mentionRepo.SetMentions(ctx, entityType, entityID, authorID, userIDs)
+	if err != nil {
+		s.logger.Warn("Failed to store mentions", "error", err, "entity_type", entityType, "entity_id", entityID)
+		return nil
+	}
+	return added
+}
+
+// notifyMentioned tells each newly mentioned user, other than the author,
+// that they were mentioned.
+func (s *postService) notifyMentioned(ctx context.Context, eventType string, entityType entities.MentionEntityType, entityID uint, userIDs []uint, activity *dto.PostActivityEvent) {
+	for _, userID := range userIDs {
+		if userID == activity.Actor.ID {
+			continue
+		}
+		s.events.Publish(ctx, &eventbus.Event{
+			Type:        eventType,
+			RecipientID: userID,
+			ActorID:     activity.Actor.ID,
+			EntityType:  string(entityType),
+			EntityID:    entityID,
+			Data:        activity,
+		})
+	}
+}
+
+// mentionsFor loads the mentions of the given posts or comments for
+// rendering. A failed lookup only drops the metadata; the content still
+// shows the raw @username.
+func (s *postService) mentionsFor(ctx context.Context, entityType entities.MentionEntityType, entityIDs []uint) map[uint][]*dto.MentionResponse {
+	mentions, err := s.mentionRepo.GetByEntityIDs(ctx, entityType, entityIDs)
+	if err != nil {
+		s.logger.Warn("Failed to load mentions", "error", err, "entity_type", entityType)
+		return make(map[uint][]*dto.MentionResponse)
+	}
+
+	responses := make(map[uint][]*dto.MentionResponse, len(mentions))
+	for entityID, entityMentions := range mentions {
+		for _, m := range entityMentions {
+			responses[entityID] = append(responses[entityID], &dto.MentionResponse{
+				UserID:   m.MentionedUserID,
+				Username: m.MentionedUser.Username,
+				FullName: m.MentionedUser.FullName,
+			})
+		}
+	}
+	return responses
+}
@@ -6,8 +6,18 @@ import (
 	"linked-clone/internal/api/post/dto"
 	"linked-clone/internal/domain/entities"
 	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/affinity"
+	"linked-clone/pkg/counter"
+	"linked-clone/pkg/eventbus"
+	"linked-clone/pkg/experiment"
+	"linked-clone/pkg/featureflag"
+	"linked-clone/pkg/feed"
+	"linked-clone/pkg/graph"
 	"linked-clone/pkg/logger"
+	"linked-clone/pkg/markdown"
 	"linked-clone/pkg/storage"
+	"sort"
+	"strconv"
 	"time"
 
 	"mime/multipart"
@@ -22,41 +32,105 @@ type PostService interface {
 	GetFeed(ctx context.Context, userID uint, limit, offset int) ([]*dto.PostResponse, error)
 	UpdatePost(ctx context.Context, userID, postID uint, req *dto.UpdatePostRequest) (*dto.PostResponse, error)
 	DeletePost(ctx context.Context, userID, postID uint) error
+	SharePost(ctx context.Context, userID, postID uint, req *dto.SharePostRequest) (*dto.PostResponse, error)
 
-	LikePost(ctx context.Context, userID, postID uint) (*dto.LikeResponse, error)
-	UnlikePost(ctx context.Context, userID, postID uint) error
-	GetPostLikes(ctx context.Context, postID uint, limit, offset int) ([]*dto.LikeResponse, error)
+	ReactToPost(ctx context.Context, userID, postID uint, req *dto.ReactRequest) (*dto.ReactionResponse, error)
+	RemoveReaction(ctx context.Context, userID, postID uint) error
+	GiveFeedback(ctx context.Context, userID, postID uint, req *dto.PostFeedbackRequest) error
+	RemoveFeedback(ctx context.Context, userID, postID uint) error
+	GetPostReactions(ctx context.Context, postID uint, reactionType entities.ReactionType, limit, offset int) ([]*dto.ReactionResponse, error)
 
 	AddComment(ctx context.Context, userID, postID uint, req *dto.AddCommentRequest) (*dto.CommentResponse, error)
 	GetComments(ctx context.Context, postID uint, limit, offset int) ([]*dto.CommentResponse, error)
 	UpdateComment(ctx context.Context, userID, commentID uint, content string) (*dto.CommentResponse, error)
 	DeleteComment(ctx context.Context, userID, commentID uint) error
+	ReplyToComment(ctx context.Context, userID, commentID uint, req *dto.AddCommentRequest) (*dto.CommentResponse, error)
+	GetCommentReplies(ctx context.Context, commentID uint, limit, offset int) ([]*dto.CommentResponse, error)
+	ReactToComment(ctx context.Context, userID, commentID uint, req *dto.ReactRequest) (*dto.CommentReactionResponse, error)
+	RemoveCommentReaction(ctx context.Context, userID, commentID uint) error
+
+	RecordImpressions(ctx context.Context, viewerID uint, viewer string, posts ...*dto.PostResponse)
+
+	CreateLifeEventPost(ctx context.Context, userID uint, req *dto.CreateLifeEventPostRequest) (*dto.PostResponse, error)
+	GetPostSuggestions(ctx context.Context, userID uint) ([]*dto.PostSuggestionResponse, error)
+	PublishSuggestion(ctx context.Context, userID, suggestionID uint, req *dto.PublishSuggestionRequest) (*dto.PostResponse, error)
+	DismissSuggestion(ctx context.Context, userID, suggestionID uint) error
+
+	GetHashtagPosts(ctx context.Context, viewerID uint, tag string, limit, offset int) (*dto.HashtagResponse, []*dto.PostResponse, error)
+	GetTrendingHashtags(ctx context.Context, days, limit int) ([]*dto.TrendingHashtagResponse, error)
+	GetFollowedHashtags(ctx context.Context, userID uint) ([]*dto.HashtagResponse, error)
+	FollowHashtag(ctx context.Context, userID uint, tag string) (*dto.HashtagResponse, error)
+	UnfollowHashtag(ctx context.Context, userID uint, tag string) error
 }
 
 type postService struct {
-	postRepo       repositories.PostRepository
-	userRepo       repositories.UserRepository
-	likeRepo       repositories.LikeRepository
-	commentRepo    repositories.CommentRepository
-	storageService storage.StorageService
-	logger         logger.Logger
+	postRepo            repositories.PostRepository
+	userRepo            repositories.UserRepository
+	reactionRepo        repositories.ReactionRepository
+	commentRepo         repositories.CommentRepository
+	commentReactionRepo repositories.CommentReactionRepository
+	experienceRepo      repositories.ExperienceRepository
+	suggestionRepo      repositories.PostSuggestionRepository
+	hashtagRepo         repositories.HashtagRepository
+	mentionRepo         repositories.MentionRepository
+	hiddenPostRepo      repositories.HiddenPostRepository
+	graph               graph.Graph
+	affinity            affinity.Tracker
+	storageService      storage.StorageService
+	viewCounter         counter.ViewCounter
+	feedStore           feed.Store
+	ranking             feed.RankingConfig
+	flags               *featureflag.Flags
+	experiments         experiment.Assigner
+	events              eventbus.Bus
+	logger              logger.Logger
 }
 
+const feedFanoutTimeout = 30 * time.Second
+
 func NewPostService(
 	postRepo repositories.PostRepository,
 	userRepo repositories.UserRepository,
-	likeRepo repositories.LikeRepository,
+	reactionRepo repositories.ReactionRepository,
 	commentRepo repositories.CommentRepository,
+	commentReactionRepo repositories.CommentReactionRepository,
+	experienceRepo repositories.ExperienceRepository,
+	suggestionRepo repositories.PostSuggestionRepository,
+	hashtagRepo repositories.HashtagRepository,
+	mentionRepo repositories.MentionRepository,
+	hiddenPostRepo repositories.HiddenPostRepository,
+	graph graph.Graph,
+	affinity affinity.Tracker,
 	storageService storage.StorageService,
+	viewCounter counter.ViewCounter,
+	feedStore feed.Store,
+	ranking feed.RankingConfig,
+	flags *featureflag.Flags,
+	experiments experiment.Assigner,
+	events eventbus.Bus,
 	logger logger.Logger,
 ) PostService {
 	return &postService{
-		postRepo:       postRepo,
-		userRepo:       userRepo,
-		likeRepo:       likeRepo,
-		commentRepo:    commentRepo,
-		storageService: storageService,
-		logger:         logger,
+		postRepo:            postRepo,
+		userRepo:            userRepo,
+		reactionRepo:        reactionRepo,
+		commentRepo:         commentRepo,
+		commentReactionRepo: commentReactionRepo,
+		experienceRepo:      experienceRepo,
+		suggestionRepo:      suggestionRepo,
+		hashtagRepo:         hashtagRepo,
+		mentionRepo:         mentionRepo,
+		hiddenPostRepo:      hiddenPostRepo,
+		graph:               graph,
+		affinity:            affinity,
+		storageService:      storageService,
+		viewCounter:         viewCounter,
+		feedStore:           feedStore,
+		ranking:             ranking,
+		flags:               flags,
+		experiments:         experiments,
+		events:              events,
+		logger:              logger,
 	}
 }
 
@@ -74,16 +148,248 @@ func (s *postService) CreatePost(ctx context.Context, userID uint, req *dto.Crea
 
 	post := &entities.Post{
 		UserID:   userID,
+		Type:     entities.PostTypeStandard,
 		Content:  req.Content,
 		ImageURL: imageURL,
 	}
+	if imageURL != "" {
+		post.ImageAltText = req.AltText
+	}
+
+	return s.publishPost(ctx, post)
+}
 
+func (s *postService) publishPost(ctx context.Context, post *entities.Post) (*dto.PostResponse, error) {
+	post.RenderedContent = markdown.Render(post.Content)
 	if err := s.postRepo.Create(ctx, post); err != nil {
 		s.logger.Error("Failed to create post", "error", err)
 		return nil, errors.New("failed to create post")
 	}
+	s.syncHashtags(ctx, post)
+	mentioned := s.syncMentions(ctx, entities.MentionInPost, post.ID, post.UserID, post.Content)
+
+	if s.fanoutEnabled() {
+		go s.fanoutPost(post.ID, post.UserID)
+	}
+
+	resp, err := s.GetPost(ctx, post.ID)
+	if err != nil {
+		return nil, err
+	}
+	s.notifyMentioned(ctx, eventbus.PostMentioned, entities.MentionInPost, post.ID, mentioned, &dto.PostActivityEvent{
+		PostID: post.ID,
+		Actor:  resp.User,
+	})
+	return resp, nil
+}
+
+func (s *postService) fanoutEnabled() bool {
+	return s.flags.Enabled(featureflag.FeatureFeedFanout) && s.flags.Enabled(featureflag.FeatureCache)
+}
+
+func (s *postService) fanoutPost(postID, authorID uint) {
+	ctx, cancel := context.WithTimeout(context.Background(), feedFanoutTimeout)
+	defer cancel()
+
+	recipients, err := s.feedAuthors(ctx, authorID)
+	if err != nil {
+		s.logger.Error("Failed to load connections for feed fan-out", "error", err, "post_id", postID)
+		return
+	}
+
+	if err := s.feedStore.Push(ctx, postID, recipients); err != nil {
+		s.logger.Warn("Failed to fan out post to feeds", "error", err, "post_id", postID)
+	}
+}
+
+func (s *postService) feedAuthors(ctx context.Context, userID uint) ([]uint, error) {
+	connections, err := s.graph.Connections(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return append(connections, userID), nil
+}
+
+func (s *postService) loadFeed(ctx context.Context, userID uint, limit, offset int) ([]*entities.Post, error) {
+	precomputed := s.fanoutEnabled() && offset+limit <= feed.MaxItems
+	if precomputed {
+		ids, cached, err := s.feedStore.Range(ctx, userID, offset, limit)
+		if err != nil {
+			s.logger.Warn("Failed to read precomputed feed, falling back to query", "error", err, "user_id", userID)
+			precomputed = false
+		} else if cached {
+			return s.postRepo.GetByIDs(ctx, ids)
+		}
+	}
+
+	authorIDs, err := s.feedAuthors(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !precomputed {
+		return s.postRepo.GetFeed(ctx, userID, authorIDs, limit, offset)
+	}
+
+	all, err := s.postRepo.GetFeedPostIDs(ctx, userID, authorIDs, feed.MaxItems)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.feedStore.Fill(ctx, userID, all); err != nil {
+		s.logger.Warn("Failed to store precomputed feed", "error", err, "user_id", userID)
+	}
+
+	if offset >= len(all) {
+		return nil, nil
+	}
+	return s.postRepo.GetByIDs(ctx, all[offset:min(offset+limit, len(all))])
+}
+
+// loadRankedFeed scores the newest ranking.Window feed posts and serves pages
+// from that order. Pages past the window, and users in chronological mode,
+// read straight from loadFeed. Repeats of the same content are dropped
+// across the whole window when ranked, but only within the page otherwise.
+func (s *postService) loadRankedFeed(ctx context.Context, userID uint, limit, offset int) ([]*entities.Post, error) {
+	ranking := s.rankingFor(ctx, userID)
+	if ranking.Mode != feed.ModeRanked || offset >= ranking.Window {
+		posts, err := s.loadFeed(ctx, userID, limit, offset)
+		if err != nil {
+			return nil, err
+		}
+		return dedupeFeed(posts), nil
+	}
+
+	ids, err := s.feedCandidateIDs(ctx, userID, ranking.Window)
+	if err != nil {
+		return nil, err
+	}
+	posts, err := s.postRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := s.feedCandidates(ctx, userID, posts)
+	if err != nil {
+		return nil, err
+	}
+	ranked := feed.Rank(ranking.Weights, candidates, time.Now())
+
+	byID := make(map[uint]*entities.Post, len(posts))
+	for _, post := range posts {
+		byID[post.ID] = post
+	}
+	ordered := make([]*entities.Post, 0, len(ranked))
+	for _, id := range ranked {
+		ordered = append(ordered, byID[id])
+	}
+	ordered = dedupeFeed(ordered)
+	if offset >= len(ordered) {
+		return nil, nil
+	}
+	return ordered[offset:min(offset+limit, len(ordered))], nil
+}
+
+func (s *postService) feedCandidateIDs(ctx context.Context, userID uint, window int) ([]uint, error) {
+	if s.fanoutEnabled() {
+		ids, cached, err := s.feedStore.Range(ctx, userID, 0, window)
+		if err != nil {
+			s.logger.Warn("Failed to read precomputed feed, falling back to query", "error", err, "user_id", userID)
+		} else if cached {
+			return ids, nil
+		}
+	}
+
+	authorIDs, err := s.feedAuthors(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.postRepo.GetFeedPostIDs(ctx, userID, authorIDs, window)
+}
+
+// feedCandidates gathers the ranking signals for posts. Missing affinity
+// scores or feedback only weaken the ranking, so those lookups may fail.
+func (s *postService) feedCandidates(ctx context.Context, userID uint, posts []*entities.Post) ([]feed.Candidate, error) {
+	postIDs := make([]uint, 0, len(posts))
+	authorIDs := make([]uint, 0, len(posts))
+	for _, post := range posts {
+		postIDs = append(postIDs, post.ID)
+		authorIDs = append(authorIDs, post.UserID)
+	}
+
+	comments, err := s.commentRepo.CountByPostIDs(ctx, postIDs)
+	if err != nil {
+		return nil, err
+	}
+	connections, err := s.graph.Connections(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	connected := make(map[uint]bool, len(connections))
+	for _, id := range connections {
+		connected[id] = true
+	}
+	scores, err := s.affinity.Scores(ctx, userID, affinity.KindUser, authorIDs)
+	if err != nil {
+		s.logger.Warn("Failed to load author affinity for feed ranking", "error", err, "user_id", userID)
+	}
+	penalties, err := s.feedbackPenalties(ctx, userID)
+	if err != nil {
+		s.logger.Warn("Failed to load feed feedback for ranking", "error", err, "user_id", userID)
+	}
+
+	candidates := make([]feed.Candidate, 0, len(posts))
+	for _, post := range posts {
+		degree := graph.DegreeSelf
+		if connected[post.UserID] {
+			degree = graph.DegreeFirst
+		}
+		feedback := penalties[post.UserID]
+		if post.SharedPost != nil && post.SharedPost.UserID != post.UserID {
+			feedback += penalties[post.SharedPost.UserID]
+		}
+		candidates = append(candidates, feed.Candidate{
+			PostID:    post.ID,
+			CreatedAt: post.CreatedAt,
+			Reactions: int64(post.ReactionCount),
+			Comments:  comments[post.ID],
+			Degree:    degree,
+			Affinity:  scores[post.UserID],
+			Feedback:  feedback,
+		})
+	}
+	return candidates, nil
+}
+
+// rankingFor applies the user's feed ranking experiment arm, if any, on top
+// of the configured ranking. The arm may switch the mode with "ranking" and
+// override any weight, e.g. "weight_affinity" or "half_life_hours".
+func (s *postService) rankingFor(ctx context.Context, userID uint) feed.RankingConfig {
+	ranking := s.ranking
+	assignment := s.experiments.Assign(ctx, experiment.FeedRanking, userID)
+	if assignment == nil {
+		return ranking
+	}
 
-	return s.GetPost(ctx, post.ID)
+	switch assignment.Param("ranking", "") {
+	case string(feed.ModeChronological):
+		ranking.Mode = feed.ModeChronological
+	case string(feed.ModeRanked), "engagement":
+		ranking.Mode = feed.ModeRanked
+	}
+
+	overrides := map[string]*float64{
+		"weight_recency":    &ranking.Weights.Recency,
+		"weight_degree":     &ranking.Weights.Degree,
+		"weight_engagement": &ranking.Weights.Engagement,
+		"weight_affinity":   &ranking.Weights.Affinity,
+		"weight_feedback":   &ranking.Weights.Feedback,
+		"half_life_hours":   &ranking.Weights.HalfLifeHours,
+	}
+	for name, target := range overrides {
+		if value, err := strconv.ParseFloat(assignment.Param(name, ""), 64); err == nil && value >= 0 {
+			*target = value
+		}
+	}
+	return ranking
 }
 
 func (s *postService) GetPost(ctx context.Context, id uint) (*dto.PostResponse, error) {
@@ -116,16 +422,28 @@ func (s *postService) GetPost(ctx context.Context, id uint) (*dto.PostResponse,
 		}
 	}
 
+	reactions := s.reactionSummaries(ctx, []*entities.Post{post})
+	mentions := s.postMentions(ctx, []*entities.Post{post})
 	return &dto.PostResponse{
-		ID:        post.ID,
-		Content:   post.Content,
-		ImageURL:  imageURL,
-		LikeCount: post.LikeCount,
+		ID:              post.ID,
+		Type:            post.Type,
+		Content:         post.Content,
+		RenderedContent: renderedContent(post.Content, post.RenderedContent),
+		Event:           renderPostEvent(post.Type, post.Event, post.User.FullName),
+		ImageURL:        imageURL,
+		ImageAltText:    post.ImageAltText,
+		ReactionCount:   post.ReactionCount,
+		ReactionCounts:  reactions[post.ID].Counts,
+		Reactions:       mapReactionSummary(reactions[post.ID]),
+		Mentions:        mentions[post.ID],
+		SharedPostID:    post.SharedPostID,
+		SharedPost:      s.mapSharedPost(post.SharedPost, reactions, mentions),
 		User: &dto.UserInfo{
 			ID:             post.User.ID,
 			Username:       post.User.Username,
 			FullName:       post.User.FullName,
 			ProfilePicture: profilePicture,
+			ProfileAltText: post.User.ProfileAltText,
 		},
 		CreatedAt: post.CreatedAt,
 		UpdatedAt: post.UpdatedAt,
@@ -139,50 +457,96 @@ func (s *postService) GetUserPosts(ctx context.Context, userID uint, limit, offs
 		return nil, errors.New("failed to get posts")
 	}
 
-	var responses []*dto.PostResponse
-	for _, post := range posts {
-		var imageURL, profilePicture string
-		if post.ImageURL != "" {
-			signed, err := s.storageService.GeneratePresignedURL(post.ImageURL, 15*time.Minute)
-			if err != nil {
-				s.logger.Error("Failed to generate image presigned URL", "error", err)
-			} else {
-				imageURL = signed
-			}
-		}
-		if post.User.ProfilePicture != "" {
-			signed, err := s.storageService.GeneratePresignedURL(post.User.ProfilePicture, 15*time.Minute)
-			if err != nil {
-				s.logger.Error("Failed to generate profile picture presigned URL", "error", err)
-			} else {
-				profilePicture = signed
-			}
-		}
-		responses = append(responses, &dto.PostResponse{
-			ID:        post.ID,
-			Content:   post.Content,
-			ImageURL:  imageURL,
-			LikeCount: post.LikeCount,
-			User: &dto.UserInfo{
-				ID:             post.User.ID,
-				Username:       post.User.Username,
-				FullName:       post.User.FullName,
-				ProfilePicture: profilePicture,
-			},
-			CreatedAt: post.CreatedAt,
-			UpdatedAt: post.UpdatedAt,
-		})
-	}
-	return responses, nil
+	return s.mapPosts(ctx, posts), nil
 }
 
 func (s *postService) GetFeed(ctx context.Context, userID uint, limit, offset int) ([]*dto.PostResponse, error) {
-	posts, err := s.postRepo.GetFeed(ctx, userID, limit, offset)
+	posts, err := s.loadRankedFeed(ctx, userID, limit, offset)
 	if err != nil {
 		s.logger.Error("Failed to get feed", "error", err)
 		return nil, errors.New("failed to get feed")
 	}
 
+	return s.mapPosts(ctx, posts), nil
+}
+
+func (s *postService) UpdatePost(ctx context.Context, userID, postID uint, req *dto.UpdatePostRequest) (*dto.PostResponse, error) {
+	post, err := s.postRepo.GetByID(ctx, postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("post not found")
+		}
+		s.logger.Error("Failed to get post", "error", err)
+		return nil, errors.New("failed to get post")
+	}
+
+	if post.UserID != userID {
+		return nil, errors.New("unauthorized to update this post")
+	}
+
+	contentChanged := req.Content != "" && req.Content != post.Content
+	if req.Content != "" {
+		post.Content = req.Content
+		post.RenderedContent = markdown.Render(post.Content)
+	}
+	if req.AltText != nil && post.ImageURL != "" {
+		post.ImageAltText = *req.AltText
+	}
+
+	if err := s.postRepo.Update(ctx, post); err != nil {
+		s.logger.Error("Failed to update post", "error", err)
+		return nil, errors.New("failed to update post")
+	}
+	var mentioned []uint
+	if contentChanged {
+		s.syncHashtags(ctx, post)
+		mentioned = s.syncMentions(ctx, entities.MentionInPost, post.ID, post.UserID, post.Content)
+	}
+
+	resp, err := s.GetPost(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	s.notifyMentioned(ctx, eventbus.PostMentioned, entities.MentionInPost, post.ID, mentioned, &dto.PostActivityEvent{
+		PostID: post.ID,
+		Actor:  resp.User,
+	})
+	return resp, nil
+}
+
+func (s *postService) DeletePost(ctx context.Context, userID, postID uint) error {
+	post, err := s.postRepo.GetByID(ctx, postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("post not found")
+		}
+		s.logger.Error("Failed to get post", "error", err)
+		return errors.New("failed to get post")
+	}
+
+	if post.UserID != userID {
+		return errors.New("unauthorized to delete this post")
+	}
+
+	if post.ImageURL != "" {
+		go func() {
+			if err := s.storageService.DeleteFile(ctx, post.ImageURL); err != nil {
+				s.logger.Error("Failed to delete post image", "error", err)
+			}
+		}()
+	}
+
+	if err := s.postRepo.Delete(ctx, postID); err != nil {
+		s.logger.Error("Failed to delete post", "error", err)
+		return errors.New("failed to delete post")
+	}
+
+	return nil
+}
+
+func (s *postService) mapPosts(ctx context.Context, posts []*entities.Post) []*dto.PostResponse {
+	reactions := s.reactionSummaries(ctx, posts)
+	mentions := s.postMentions(ctx, posts)
 	var responses []*dto.PostResponse
 	for _, post := range posts {
 		var imageURL, profilePicture string
@@ -203,121 +567,278 @@ func (s *postService) GetFeed(ctx context.Context, userID uint, limit, offset in
 			}
 		}
 		responses = append(responses, &dto.PostResponse{
-			ID:        post.ID,
-			Content:   post.Content,
-			ImageURL:  imageURL,
-			LikeCount: post.LikeCount,
+			ID:              post.ID,
+			Type:            post.Type,
+			Content:         post.Content,
+			RenderedContent: renderedContent(post.Content, post.RenderedContent),
+			Event:           renderPostEvent(post.Type, post.Event, post.User.FullName),
+			ImageURL:        imageURL,
+			ImageAltText:    post.ImageAltText,
+			ReactionCount:   post.ReactionCount,
+			ReactionCounts:  reactions[post.ID].Counts,
+			Reactions:       mapReactionSummary(reactions[post.ID]),
+			Mentions:        mentions[post.ID],
+			SharedPostID:    post.SharedPostID,
+			SharedPost:      s.mapSharedPost(post.SharedPost, reactions, mentions),
 			User: &dto.UserInfo{
 				ID:             post.User.ID,
 				Username:       post.User.Username,
 				FullName:       post.User.FullName,
 				ProfilePicture: profilePicture,
+				ProfileAltText: post.User.ProfileAltText,
 			},
 			CreatedAt: post.CreatedAt,
 			UpdatedAt: post.UpdatedAt,
 		})
 	}
-	return responses, nil
+	return responses
 }
 
-func (s *postService) UpdatePost(ctx context.Context, userID, postID uint, req *dto.UpdatePostRequest) (*dto.PostResponse, error) {
-	post, err := s.postRepo.GetByID(ctx, postID)
+const (
+	summaryReactionTypes = 3
+	summaryReactors      = 2
+)
+
+// reactionSummaries returns the reaction summary of each post and of the
+// originals of any reposts among them. A failed lookup only drops the
+// breakdown; reaction_count on the post is still exact.
+func (s *postService) reactionSummaries(ctx context.Context, posts []*entities.Post) map[uint]*entities.PostReactionSummary {
+	postIDs := make([]uint, 0, len(posts))
+	for _, post := range posts {
+		postIDs = append(postIDs, post.ID)
+		if post.SharedPost != nil {
+			postIDs = append(postIDs, post.SharedPost.ID)
+		}
+	}
+
+	summaries, err := s.reactionRepo.SummarizeByPostIDs(ctx, postIDs, summaryReactors)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("post not found")
+		s.logger.Warn("Failed to summarize post reactions", "error", err)
+		summaries = make(map[uint]*entities.PostReactionSummary, len(postIDs))
+	}
+	for _, id := range postIDs {
+		if summaries[id] == nil {
+			summaries[id] = &entities.PostReactionSummary{Counts: make(map[entities.ReactionType]int64)}
 		}
-		s.logger.Error("Failed to get post", "error", err)
-		return nil, errors.New("failed to get post")
 	}
+	return summaries
+}
 
-	if post.UserID != userID {
-		return nil, errors.New("unauthorized to update this post")
+// mapReactionSummary keeps the summaryReactionTypes most used reaction
+// types, breaking ties by name so the order is stable.
+func mapReactionSummary(summary *entities.PostReactionSummary) *dto.ReactionSummaryResponse {
+	var total int64
+	top := make([]dto.ReactionTypeCount, 0, len(summary.Counts))
+	for reactionType, count := range summary.Counts {
+		total += count
+		top = append(top, dto.ReactionTypeCount{Type: reactionType, Count: count})
+	}
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].Count != top[j].Count {
+			return top[i].Count > top[j].Count
+		}
+		return top[i].Type < top[j].Type
+	})
+	if len(top) > summaryReactionTypes {
+		top = top[:summaryReactionTypes]
 	}
 
-	if req.Content != "" {
-		post.Content = req.Content
+	reactors := make([]*dto.ReactorResponse, 0, len(summary.FirstReactors))
+	for _, user := range summary.FirstReactors {
+		reactors = append(reactors, &dto.ReactorResponse{
+			UserID:   user.ID,
+			Username: user.Username,
+			FullName: user.FullName,
+		})
 	}
 
-	if err := s.postRepo.Update(ctx, post); err != nil {
-		s.logger.Error("Failed to update post", "error", err)
-		return nil, errors.New("failed to update post")
+	return &dto.ReactionSummaryResponse{
+		TopReactions: top,
+		Reactors:     reactors,
+		OthersCount:  max(total-int64(len(reactors)), 0),
 	}
+}
 
-	return s.GetPost(ctx, postID)
+// postMentions loads the mentions of each post and of the originals of any
+// reposts among them.
+func (s *postService) postMentions(ctx context.Context, posts []*entities.Post) map[uint][]*dto.MentionResponse {
+	postIDs := make([]uint, 0, len(posts))
+	for _, post := range posts {
+		postIDs = append(postIDs, post.ID)
+		if post.SharedPost != nil {
+			postIDs = append(postIDs, post.SharedPost.ID)
+		}
+	}
+	return s.mentionsFor(ctx, entities.MentionInPost, postIDs)
 }
 
-func (s *postService) DeletePost(ctx context.Context, userID, postID uint) error {
-	post, err := s.postRepo.GetByID(ctx, postID)
+// mapSharedPost renders the original embedded in a repost. It is nil when the
+// original has since been deleted, while the repost keeps its shared_post_id.
+func (s *postService) mapSharedPost(post *entities.Post, reactions map[uint]*entities.PostReactionSummary, mentions map[uint][]*dto.MentionResponse) *dto.PostResponse {
+	if post == nil {
+		return nil
+	}
+
+	var imageURL string
+	if post.ImageURL != "" {
+		signed, err := s.storageService.GeneratePresignedURL(post.ImageURL, 15*time.Minute)
+		if err != nil {
+			s.logger.Error("Failed to generate image presigned URL", "error", err)
+		} else {
+			imageURL = signed
+		}
+	}
+
+	return &dto.PostResponse{
+		ID:              post.ID,
+		Type:            post.Type,
+		Content:         post.Content,
+		RenderedContent: renderedContent(post.Content, post.RenderedContent),
+		Event:           renderPostEvent(post.Type, post.Event, post.User.FullName),
+		ImageURL:        imageURL,
+		ImageAltText:    post.ImageAltText,
+		ReactionCount:   post.ReactionCount,
+		ReactionCounts:  reactions[post.ID].Counts,
+		Reactions:       mapReactionSummary(reactions[post.ID]),
+		User:            s.mapUserInfo(&post.User),
+		Mentions:        mentions[post.ID],
+		CreatedAt:       post.CreatedAt,
+		UpdatedAt:       post.UpdatedAt,
+	}
+}
+
+func (s *postService) SharePost(ctx context.Context, userID, postID uint, req *dto.SharePostRequest) (*dto.PostResponse, error) {
+	original, err := s.postRepo.GetByID(ctx, postID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errors.New("post not found")
+			return nil, errors.New("post not found")
 		}
 		s.logger.Error("Failed to get post", "error", err)
-		return errors.New("failed to get post")
+		return nil, errors.New("failed to get post")
 	}
 
-	if post.UserID != userID {
-		return errors.New("unauthorized to delete this post")
+	// Sharing a repost shares its original, so reposts never nest.
+	if original.SharedPostID != nil {
+		if original.SharedPost == nil {
+			return nil, errors.New("post not found")
+		}
+		original = original.SharedPost
 	}
 
-	if post.ImageURL != "" {
-		go func() {
-			if err := s.storageService.DeleteFile(ctx, post.ImageURL); err != nil {
-				s.logger.Error("Failed to delete post image", "error", err)
-			}
-		}()
+	post := &entities.Post{
+		UserID:       userID,
+		Type:         entities.PostTypeStandard,
+		Content:      req.Content,
+		SharedPostID: &original.ID,
 	}
 
-	if err := s.postRepo.Delete(ctx, postID); err != nil {
-		s.logger.Error("Failed to delete post", "error", err)
-		return errors.New("failed to delete post")
+	resp, err := s.publishPost(ctx, post)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	if original.UserID != userID {
+		s.recordAffinity(ctx, userID, original.UserID, affinity.WeightShare)
+		s.events.Publish(ctx, &eventbus.Event{
+			Type:        eventbus.PostShared,
+			RecipientID: original.UserID,
+			ActorID:     userID,
+			EntityType:  "post",
+			EntityID:    original.ID,
+			Data: &dto.PostActivityEvent{
+				PostID: original.ID,
+				Actor:  resp.User,
+			},
+		})
+	}
+
+	return resp, nil
 }
 
-func (s *postService) LikePost(ctx context.Context, userID, postID uint) (*dto.LikeResponse, error) {
+func (s *postService) ReactToPost(ctx context.Context, userID, postID uint, req *dto.ReactRequest) (*dto.ReactionResponse, error) {
+	post, err := s.postRepo.GetByID(ctx, postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("post not found")
+		}
+		s.logger.Error("Failed to get post", "error", err)
+		return nil, errors.New("failed to get post")
+	}
 
-	existingLike, _ := s.likeRepo.FindByUserAndPost(ctx, userID, postID)
-	if existingLike != nil {
-		return nil, errors.New("post already liked")
+	existing, _ := s.reactionRepo.FindByUserAndPost(ctx, userID, postID)
+	if existing != nil {
+		if existing.Type != req.Type {
+			if err := s.reactionRepo.UpdateType(ctx, existing.ID, req.Type); err != nil {
+				s.logger.Error("Failed to update reaction", "error", err)
+				return nil, errors.New("failed to react to post")
+			}
+		}
+		return &dto.ReactionResponse{
+			ID:     existing.ID,
+			UserID: userID,
+			PostID: postID,
+			Type:   req.Type,
+		}, nil
 	}
 
-	like := &entities.Like{
+	reaction := &entities.Reaction{
 		UserID: userID,
 		PostID: postID,
+		Type:   req.Type,
+	}
+
+	if err := s.reactionRepo.Create(ctx, reaction); err != nil {
+		s.logger.Error("Failed to create reaction", "error", err)
+		return nil, errors.New("failed to react to post")
 	}
 
-	if err := s.likeRepo.Create(ctx, like); err != nil {
-		s.logger.Error("Failed to create like", "error", err)
-		return nil, errors.New("failed to like post")
+	if err := s.postRepo.IncrementReactionCount(ctx, postID); err != nil {
+		s.logger.Error("Failed to increment reaction count", "error", err)
 	}
 
-	if err := s.postRepo.IncrementLikeCount(ctx, postID); err != nil {
-		s.logger.Error("Failed to increment like count", "error", err)
+	if post.UserID != userID {
+		s.recordAffinity(ctx, userID, post.UserID, affinity.WeightLike)
+		if user, err := s.userRepo.GetByID(ctx, userID); err == nil {
+			s.events.Publish(ctx, &eventbus.Event{
+				Type:        eventbus.PostLiked,
+				RecipientID: post.UserID,
+				ActorID:     userID,
+				EntityType:  "post",
+				EntityID:    postID,
+				Data: &dto.PostActivityEvent{
+					PostID:   postID,
+					Actor:    s.mapUserInfo(user),
+					Reaction: req.Type,
+				},
+			})
+		}
 	}
 
-	return &dto.LikeResponse{
-		ID:     like.ID,
+	return &dto.ReactionResponse{
+		ID:     reaction.ID,
 		UserID: userID,
 		PostID: postID,
+		Type:   reaction.Type,
 	}, nil
 }
 
-func (s *postService) UnlikePost(ctx context.Context, userID, postID uint) error {
-	if err := s.likeRepo.Delete(ctx, userID, postID); err != nil {
-		s.logger.Error("Failed to delete like", "error", err)
-		return errors.New("failed to unlike post")
+func (s *postService) RemoveReaction(ctx context.Context, userID, postID uint) error {
+	if err := s.reactionRepo.Delete(ctx, userID, postID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("reaction not found")
+		}
+		s.logger.Error("Failed to delete reaction", "error", err)
+		return errors.New("failed to remove reaction")
 	}
 
-	if err := s.postRepo.DecrementLikeCount(ctx, postID); err != nil {
-		s.logger.Error("Failed to decrement like count", "error", err)
+	if err := s.postRepo.DecrementReactionCount(ctx, postID); err != nil {
+		s.logger.Error("Failed to decrement reaction count", "error", err)
 	}
 
 	return nil
 }
 
-func (s *postService) GetPostLikes(ctx context.Context, postID uint, limit, offset int) ([]*dto.LikeResponse, error) {
+func (s *postService) GetPostReactions(ctx context.Context, postID uint, reactionType entities.ReactionType, limit, offset int) ([]*dto.ReactionResponse, error) {
 
 	_, err := s.postRepo.GetByID(ctx, postID)
 	if err != nil {
@@ -328,18 +849,19 @@ func (s *postService) GetPostLikes(ctx context.Context, postID uint, limit, offs
 		return nil, errors.New("failed to get post")
 	}
 
-	likes, err := s.likeRepo.GetPostLikes(ctx, postID, limit, offset)
+	reactions, err := s.reactionRepo.GetPostReactions(ctx, postID, reactionType, limit, offset)
 	if err != nil {
-		s.logger.Error("Failed to get post likes", "error", err)
-		return nil, errors.New("failed to get post likes")
+		s.logger.Error("Failed to get post reactions", "error", err)
+		return nil, errors.New("failed to get post reactions")
 	}
 
-	var responses []*dto.LikeResponse
-	for _, like := range likes {
-		responses = append(responses, &dto.LikeResponse{
-			ID:     like.ID,
-			UserID: like.UserID,
-			PostID: like.PostID,
+	var responses []*dto.ReactionResponse
+	for _, reaction := range reactions {
+		responses = append(responses, &dto.ReactionResponse{
+			ID:     reaction.ID,
+			UserID: reaction.UserID,
+			PostID: reaction.PostID,
+			Type:   reaction.Type,
 		})
 	}
 
@@ -348,7 +870,7 @@ func (s *postService) GetPostLikes(ctx context.Context, postID uint, limit, offs
 
 func (s *postService) AddComment(ctx context.Context, userID, postID uint, req *dto.AddCommentRequest) (*dto.CommentResponse, error) {
 
-	_, err := s.postRepo.GetByID(ctx, postID)
+	post, err := s.postRepo.GetByID(ctx, postID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errors.New("post not found")
@@ -358,15 +880,17 @@ func (s *postService) AddComment(ctx context.Context, userID, postID uint, req *
 	}
 
 	comment := &entities.Comment{
-		UserID:  userID,
-		PostID:  postID,
-		Content: req.Content,
+		UserID:          userID,
+		PostID:          postID,
+		Content:         req.Content,
+		RenderedContent: markdown.Render(req.Content),
 	}
 
 	if err := s.commentRepo.Create(ctx, comment); err != nil {
 		s.logger.Error("Failed to create comment", "error", err)
 		return nil, errors.New("failed to add comment")
 	}
+	mentioned := s.syncMentions(ctx, entities.MentionInComment, comment.ID, userID, comment.Content)
 
 	user, err := s.userRepo.GetByID(ctx, userID)
 	if err != nil {
@@ -374,6 +898,42 @@ func (s *postService) AddComment(ctx context.Context, userID, postID uint, req *
 		return nil, errors.New("failed to get user details")
 	}
 
+	resp := &dto.CommentResponse{
+		ID:              comment.ID,
+		Content:         comment.Content,
+		RenderedContent: comment.RenderedContent,
+		User:            s.mapUserInfo(user),
+		Mentions:        s.mentionsFor(ctx, entities.MentionInComment, []uint{comment.ID})[comment.ID],
+		ReactionCounts:  make(map[entities.ReactionType]int64),
+		CreatedAt:       comment.CreatedAt,
+	}
+	activity := &dto.PostActivityEvent{
+		PostID:  postID,
+		Actor:   resp.User,
+		Comment: resp,
+	}
+
+	s.recordAffinity(ctx, userID, post.UserID, affinity.WeightComment)
+	s.events.Publish(ctx, &eventbus.Event{
+		Type:        eventbus.PostCommented,
+		RecipientID: post.UserID,
+		ActorID:     userID,
+		EntityType:  "post",
+		EntityID:    postID,
+		Data:        activity,
+	})
+	s.notifyMentioned(ctx, eventbus.CommentMentioned, entities.MentionInComment, comment.ID, mentioned, activity)
+
+	return resp, nil
+}
+
+func (s *postService) recordAffinity(ctx context.Context, userID, authorID uint, weight int64) {
+	if err := s.affinity.Record(ctx, userID, affinity.KindUser, authorID, weight); err != nil {
+		s.logger.Warn("Failed to record author affinity", "error", err, "user_id", userID)
+	}
+}
+
+func (s *postService) mapUserInfo(user *entities.User) *dto.UserInfo {
 	profilePictureURL := ""
 	if user.ProfilePicture != "" {
 		if presignedURL, err := s.storageService.GeneratePresignedURL(user.ProfilePicture, 24*time.Hour); err == nil {
@@ -383,17 +943,13 @@ func (s *postService) AddComment(ctx context.Context, userID, postID uint, req *
 		}
 	}
 
-	return &dto.CommentResponse{
-		ID:      comment.ID,
-		Content: comment.Content,
-		User: &dto.UserInfo{
-			ID:             user.ID,
-			Username:       user.Username,
-			FullName:       user.FullName,
-			ProfilePicture: profilePictureURL,
-		},
-		CreatedAt: comment.CreatedAt,
-	}, nil
+	return &dto.UserInfo{
+		ID:             user.ID,
+		Username:       user.Username,
+		FullName:       user.FullName,
+		ProfilePicture: profilePictureURL,
+		ProfileAltText: user.ProfileAltText,
+	}
 }
 
 func (s *postService) GetComments(ctx context.Context, postID uint, limit, offset int) ([]*dto.CommentResponse, error) {
@@ -412,31 +968,7 @@ func (s *postService) GetComments(ctx context.Context, postID uint, limit, offse
 		return nil, errors.New("failed to get comments")
 	}
 
-	var responses []*dto.CommentResponse
-	for _, comment := range comments {
-		profilePictureURL := ""
-		if comment.User.ProfilePicture != "" {
-			if presignedURL, err := s.storageService.GeneratePresignedURL(comment.User.ProfilePicture, 24*time.Hour); err == nil {
-				profilePictureURL = presignedURL
-			} else {
-				s.logger.Error("Failed to generate profile picture presigned URL", "error", err)
-			}
-		}
-
-		responses = append(responses, &dto.CommentResponse{
-			ID:      comment.ID,
-			Content: comment.Content,
-			User: &dto.UserInfo{
-				ID:             comment.User.ID,
-				Username:       comment.User.Username,
-				FullName:       comment.User.FullName,
-				ProfilePicture: profilePictureURL,
-			},
-			CreatedAt: comment.CreatedAt,
-		})
-	}
-
-	return responses, nil
+	return s.mapCommentThreads(ctx, comments), nil
 }
 
 func (s *postService) UpdateComment(ctx context.Context, userID, commentID uint, content string) (*dto.CommentResponse, error) {
@@ -452,38 +984,26 @@ func (s *postService) UpdateComment(ctx context.Context, userID, commentID uint,
 		return nil, errors.New("unauthorized to update this comment")
 	}
 
+	contentChanged := content != comment.Content
 	comment.Content = content
+	comment.RenderedContent = markdown.Render(content)
 	if err := s.commentRepo.Update(ctx, comment); err != nil {
 		s.logger.Error("Failed to update comment", "error", err)
 		return nil, errors.New("failed to update comment")
 	}
 
-	user, err := s.userRepo.GetByID(ctx, userID)
-	if err != nil {
-		s.logger.Error("Failed to get user", "error", err)
-		return nil, errors.New("failed to get user details")
-	}
-
-	profilePictureURL := ""
-	if user.ProfilePicture != "" {
-		if presignedURL, err := s.storageService.GeneratePresignedURL(user.ProfilePicture, 24*time.Hour); err == nil {
-			profilePictureURL = presignedURL
-		} else {
-			s.logger.Error("Failed to generate profile picture presigned URL", "error", err)
-		}
+	var mentioned []uint
+	if contentChanged {
+		mentioned = s.syncMentions(ctx, entities.MentionInComment, comment.ID, userID, comment.Content)
 	}
 
-	return &dto.CommentResponse{
-		ID:      comment.ID,
-		Content: comment.Content,
-		User: &dto.UserInfo{
-			ID:             user.ID,
-			Username:       user.Username,
-			FullName:       user.FullName,
-			ProfilePicture: profilePictureURL,
-		},
-		CreatedAt: comment.CreatedAt,
-	}, nil
+	resp := s.mapComments(ctx, []*entities.Comment{comment})[0]
+	s.notifyMentioned(ctx, eventbus.CommentMentioned, entities.MentionInComment, comment.ID, mentioned, &dto.PostActivityEvent{
+		PostID:  comment.PostID,
+		Actor:   resp.User,
+		Comment: resp,
+	})
+	return resp, nil
 }
 
 func (s *postService) DeleteComment(ctx context.Context, userID, commentID uint) error {
@@ -506,3 +1026,24 @@ func (s *postService) DeleteComment(ctx context.Context, userID, commentID uint)
 
 	return nil
 }
+
+// renderedContent returns the stored HTML of a post or comment, rendering
+// it for content saved before rendered forms were stored.
+func renderedContent(content, rendered string) string {
+	if rendered == "" && content != "" {
+		return markdown.Render(content)
+	}
+	return rendered
+}
+
+func (s *postService) RecordImpressions(ctx context.Context, viewerID uint, viewer string, posts ...*dto.PostResponse) {
+	for _, post := range posts {
+		if post.User != nil && post.User.ID == viewerID {
+			continue
+		}
+
+		if err := s.viewCounter.Record(ctx, counter.ViewPost, post.ID, viewer); err != nil {
+			s.logger.Error("Failed to record post impression", "error", err, "post_id", post.ID)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"context"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/auth"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/realtime"
+	"linked-clone/pkg/response"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+type WebSocketHandler struct {
+	hub        realtime.Hub
+	jwtService auth.JWTService
+	upgrader   websocket.Upgrader
+	logger     logger.Logger
+}
+
+func NewWebSocketHandler(hub realtime.Hub, jwtService auth.JWTService, allowedOrigins []string, logger logger.Logger) *WebSocketHandler {
+	return &WebSocketHandler{
+		hub:        hub,
+		jwtService: jwtService,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+			CheckOrigin:     checkOrigin(allowedOrigins),
+		},
+		logger: logger,
+	}
+}
+
+// checkOrigin only lets the browser origins allowed by CORS open a socket,
+// so another site cannot connect with a token it got hold of. A request
+// without an Origin header does not come from a browser page and is let
+// through.
+func checkOrigin(allowedOrigins []string) func(r *http.Request) bool {
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+		for _, allowed := range allowedOrigins {
+			if strings.EqualFold(origin, allowed) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func (h *WebSocketHandler) Connect(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		token = strings.TrimPrefix(c.GetHeader(middleware.AuthorizationHeader), middleware.BearerPrefix)
+	}
+	if token == "" {
+		response.Error(c, http.StatusUnauthorized, "Token required", "")
+		return
+	}
+
+	claims, err := h.jwtService.ValidateToken(c.Request.Context(), token)
+	if err != nil {
+		h.logger.Error("WebSocket token validation failed", "error", err)
+		response.Error(c, http.StatusUnauthorized, "Invalid or expired token", err.Error())
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		h.logger.Error("Failed to upgrade WebSocket connection", "error", err, "user_id", claims.UserID)
+		return
+	}
+
+	h.logger.Debug("WebSocket client connected", "user_id", claims.UserID)
+
+	client := realtime.NewClient(h.hub, conn, claims.UserID)
+	client.Serve(context.WithoutCancel(c.Request.Context()))
+
+	h.logger.Debug("WebSocket client disconnected", "user_id", claims.UserID)
+}
@@ -0,0 +1,69 @@
+package dto
+
+import (
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type RequestRecommendationRequest struct {
+	AuthorID     uint   `json:"author_id" validate:"required"`
+	Relationship string `json:"relationship" validate:"omitempty,max=100"`
+	Message      string `json:"message" validate:"omitempty,max=1000"`
+}
+
+type WriteRecommendationRequest struct {
+	RecipientID  uint   `json:"recipient_id" validate:"required"`
+	Relationship string `json:"relationship" validate:"omitempty,max=100"`
+	Body         string `json:"body" validate:"omitempty,max=3000"`
+	Submit       bool   `json:"submit"`
+}
+
+type UpdateRecommendationRequest struct {
+	Relationship *string `json:"relationship" validate:"omitempty,max=100"`
+	Body         *string `json:"body" validate:"omitempty,max=3000"`
+	Submit       bool    `json:"submit"`
+}
+
+type RevisionRequest struct {
+	Note string `json:"note" validate:"required,max=1000"`
+}
+
+type VisibilityRequest struct {
+	Hidden *bool `json:"hidden" validate:"required"`
+}
+
+type ReorderRequest struct {
+	IDs []uint `json:"ids" validate:"required,min=1,max=100,dive,required"`
+}
+
+type UserInfo struct {
+	ID             uint   `json:"id"`
+	Username       string `json:"username"`
+	FullName       string `json:"full_name"`
+	Headline       string `json:"headline,omitempty"`
+	ProfilePicture string `json:"profile_picture,omitempty"`
+}
+
+type RecommendationResponse struct {
+	ID             uint                          `json:"id"`
+	Author         *UserInfo                     `json:"author,omitempty"`
+	Recipient      *UserInfo                     `json:"recipient,omitempty"`
+	Relationship   string                        `json:"relationship,omitempty"`
+	Body           string                        `json:"body,omitempty"`
+	Status         entities.RecommendationStatus `json:"status"`
+	RequestMessage string                        `json:"request_message,omitempty"`
+	RevisionNote   string                        `json:"revision_note,omitempty"`
+	IsHidden       bool                          `json:"is_hidden"`
+	Position       int                           `json:"position,omitempty"`
+	SubmittedAt    *time.Time                    `json:"submitted_at,omitempty"`
+	ApprovedAt     *time.Time                    `json:"approved_at,omitempty"`
+	CreatedAt      time.Time                     `json:"created_at"`
+	UpdatedAt      time.Time                     `json:"updated_at"`
+	Revisions      []*RevisionResponse           `json:"revisions,omitempty"`
+}
+
+type RevisionResponse struct {
+	Relationship string    `json:"relationship,omitempty"`
+	Body         string    `json:"body"`
+	CreatedAt    time.Time `json:"created_at"`
+}
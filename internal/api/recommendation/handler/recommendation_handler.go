@@ -0,0 +1,320 @@
+package handler
+
+import (
+	"linked-clone/internal/api/recommendation/dto"
+	"linked-clone/internal/api/recommendation/service"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/request"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RecommendationHandler struct {
+	recommendationService service.RecommendationService
+	validator             validation.Validator
+	logger                logger.Logger
+}
+
+func NewRecommendationHandler(recommendationService service.RecommendationService, validator validation.Validator, logger logger.Logger) *RecommendationHandler {
+	return &RecommendationHandler{
+		recommendationService: recommendationService,
+		validator:             validator,
+		logger:                logger,
+	}
+}
+
+func (h *RecommendationHandler) RequestRecommendation(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.RequestRecommendationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	recommendation, err := h.recommendationService.RequestRecommendation(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.logger.Error("Failed to request recommendation", "error", err)
+		response.Error(c, recommendationErrorStatus(err), "Failed to request recommendation", err.Error())
+		return
+	}
+
+	response.CreatedWithMessage(c, "Recommendation requested", recommendation)
+}
+
+func (h *RecommendationHandler) WriteRecommendation(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.WriteRecommendationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	recommendation, err := h.recommendationService.WriteRecommendation(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.logger.Error("Failed to write recommendation", "error", err)
+		response.Error(c, recommendationErrorStatus(err), "Failed to write recommendation", err.Error())
+		return
+	}
+
+	response.CreatedWithMessage(c, "Recommendation saved", recommendation)
+}
+
+func (h *RecommendationHandler) GetReceived(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	limit, offset := request.Pagination(c)
+
+	recommendations, err := h.recommendationService.GetReceived(c.Request.Context(), userID, entities.RecommendationStatus(c.Query("status")), limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get received recommendations", "error", err)
+		response.Error(c, recommendationErrorStatus(err), "Failed to get recommendations", err.Error())
+		return
+	}
+
+	response.Success(c, recommendations)
+}
+
+func (h *RecommendationHandler) GetGiven(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	limit, offset := request.Pagination(c)
+
+	recommendations, err := h.recommendationService.GetGiven(c.Request.Context(), userID, entities.RecommendationStatus(c.Query("status")), limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get given recommendations", "error", err)
+		response.Error(c, recommendationErrorStatus(err), "Failed to get recommendations", err.Error())
+		return
+	}
+
+	response.Success(c, recommendations)
+}
+
+func (h *RecommendationHandler) GetUserRecommendations(c *gin.Context) {
+	userID, ok := request.ParseID(c, "userId", "Invalid user ID")
+	if !ok {
+		return
+	}
+	limit, offset := request.Pagination(c)
+
+	recommendations, err := h.recommendationService.GetUserRecommendations(c.Request.Context(), userID, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get user recommendations", "error", err)
+		response.Error(c, recommendationErrorStatus(err), "Failed to get recommendations", err.Error())
+		return
+	}
+
+	response.Success(c, recommendations)
+}
+
+func (h *RecommendationHandler) Reorder(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.ReorderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	recommendations, err := h.recommendationService.Reorder(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.logger.Error("Failed to reorder recommendations", "error", err)
+		response.Error(c, recommendationErrorStatus(err), "Failed to reorder recommendations", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Recommendations reordered", recommendations)
+}
+
+func (h *RecommendationHandler) GetRecommendation(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	id, ok := request.ParseID(c, "id", "Invalid recommendation ID")
+	if !ok {
+		return
+	}
+
+	recommendation, err := h.recommendationService.GetRecommendation(c.Request.Context(), userID, id)
+	if err != nil {
+		h.logger.Error("Failed to get recommendation", "error", err)
+		response.Error(c, recommendationErrorStatus(err), "Failed to get recommendation", err.Error())
+		return
+	}
+
+	response.Success(c, recommendation)
+}
+
+func (h *RecommendationHandler) UpdateRecommendation(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	id, ok := request.ParseID(c, "id", "Invalid recommendation ID")
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateRecommendationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	recommendation, err := h.recommendationService.UpdateRecommendation(c.Request.Context(), userID, id, &req)
+	if err != nil {
+		h.logger.Error("Failed to update recommendation", "error", err)
+		response.Error(c, recommendationErrorStatus(err), "Failed to update recommendation", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Recommendation updated", recommendation)
+}
+
+func (h *RecommendationHandler) DeleteRecommendation(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	id, ok := request.ParseID(c, "id", "Invalid recommendation ID")
+	if !ok {
+		return
+	}
+
+	if err := h.recommendationService.DeleteRecommendation(c.Request.Context(), userID, id); err != nil {
+		h.logger.Error("Failed to delete recommendation", "error", err)
+		response.Error(c, recommendationErrorStatus(err), "Failed to delete recommendation", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Recommendation deleted"})
+}
+
+func (h *RecommendationHandler) Approve(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	id, ok := request.ParseID(c, "id", "Invalid recommendation ID")
+	if !ok {
+		return
+	}
+
+	recommendation, err := h.recommendationService.Approve(c.Request.Context(), userID, id)
+	if err != nil {
+		h.logger.Error("Failed to approve recommendation", "error", err)
+		response.Error(c, recommendationErrorStatus(err), "Failed to approve recommendation", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Recommendation approved", recommendation)
+}
+
+func (h *RecommendationHandler) Decline(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	id, ok := request.ParseID(c, "id", "Invalid recommendation ID")
+	if !ok {
+		return
+	}
+
+	recommendation, err := h.recommendationService.Decline(c.Request.Context(), userID, id)
+	if err != nil {
+		h.logger.Error("Failed to decline recommendation", "error", err)
+		response.Error(c, recommendationErrorStatus(err), "Failed to decline recommendation", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Recommendation declined", recommendation)
+}
+
+func (h *RecommendationHandler) RequestRevision(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	id, ok := request.ParseID(c, "id", "Invalid recommendation ID")
+	if !ok {
+		return
+	}
+
+	var req dto.RevisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	recommendation, err := h.recommendationService.RequestRevision(c.Request.Context(), userID, id, &req)
+	if err != nil {
+		h.logger.Error("Failed to request recommendation revision", "error", err)
+		response.Error(c, recommendationErrorStatus(err), "Failed to request revision", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Revision requested", recommendation)
+}
+
+func (h *RecommendationHandler) SetVisibility(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	id, ok := request.ParseID(c, "id", "Invalid recommendation ID")
+	if !ok {
+		return
+	}
+
+	var req dto.VisibilityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	recommendation, err := h.recommendationService.SetVisibility(c.Request.Context(), userID, id, *req.Hidden)
+	if err != nil {
+		h.logger.Error("Failed to update recommendation visibility", "error", err)
+		response.Error(c, recommendationErrorStatus(err), "Failed to update recommendation", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Recommendation updated", recommendation)
+}
+
+func recommendationErrorStatus(err error) int {
+	switch err.Error() {
+	case "recommendation not found", "user not found":
+		return http.StatusNotFound
+	case "recommendations are limited to connections":
+		return http.StatusForbidden
+	case "a recommendation between you already exists", "recommendation cannot be edited",
+		"recommendation is not awaiting approval", "recommendation cannot be declined", "recommendation is not approved":
+		return http.StatusConflict
+	case "cannot recommend yourself", "recommendation body is required", "invalid recommendation order", "invalid status":
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type recommendationRepository struct {
+	db *gorm.DB
+}
+
+func NewRecommendationRepository(db *gorm.DB) repositories.RecommendationRepository {
+	return &recommendationRepository{db: db}
+}
+
+func (r *recommendationRepository) Create(ctx context.Context, recommendation *entities.Recommendation) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Create(recommendation).Error
+}
+
+func (r *recommendationRepository) GetByID(ctx context.Context, id uint) (*entities.Recommendation, error) {
+	var recommendation entities.Recommendation
+	err := r.db.WithContext(ctx).
+		Preload("Author").
+		Preload("Recipient").
+		First(&recommendation, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &recommendation, nil
+}
+
+// FindOpen returns the recommendation from author to recipient that is still
+// in play, i.e. anything not declined.
+func (r *recommendationRepository) FindOpen(ctx context.Context, authorID, recipientID uint) (*entities.Recommendation, error) {
+	var recommendation entities.Recommendation
+	err := r.db.WithContext(ctx).
+		Where("author_id = ? AND recipient_id = ? AND status <> ?", authorID, recipientID, entities.RecommendationDeclined).
+		First(&recommendation).Error
+	if err != nil {
+		return nil, err
+	}
+	return &recommendation, nil
+}
+
+func (r *recommendationRepository) Update(ctx context.Context, recommendation *entities.Recommendation) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Save(recommendation).Error
+}
+
+// Submit saves the recommendation and records the submitted text as a new
+// revision in the same transaction.
+func (r *recommendationRepository) Submit(ctx context.Context, recommendation *entities.Recommendation) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(recommendation).Error; err != nil {
+			return err
+		}
+		return tx.Create(&entities.RecommendationRevision{
+			RecommendationID: recommendation.ID,
+			Relationship:     recommendation.Relationship,
+			Body:             recommendation.Body,
+		}).Error
+	})
+}
+
+func (r *recommendationRepository) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&entities.Recommendation{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *recommendationRepository) GetReceived(ctx context.Context, recipientID uint, status entities.RecommendationStatus, limit, offset int) ([]*entities.Recommendation, error) {
+	query := r.db.WithContext(ctx).
+		Preload("Author").
+		Where("recipient_id = ?", recipientID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	} else {
+		// Drafts belong to the author until submitted.
+		query = query.Where("status <> ?", entities.RecommendationDraft)
+	}
+
+	var recommendations []*entities.Recommendation
+	err := query.
+		Order("updated_at DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&recommendations).Error
+	return recommendations, err
+}
+
+func (r *recommendationRepository) GetGiven(ctx context.Context, authorID uint, status entities.RecommendationStatus, limit, offset int) ([]*entities.Recommendation, error) {
+	query := r.db.WithContext(ctx).
+		Preload("Recipient").
+		Where("author_id = ?", authorID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var recommendations []*entities.Recommendation
+	err := query.
+		Order("updated_at DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&recommendations).Error
+	return recommendations, err
+}
+
+func (r *recommendationRepository) GetVisible(ctx context.Context, recipientID uint, limit, offset int) ([]*entities.Recommendation, error) {
+	var recommendations []*entities.Recommendation
+	err := r.db.WithContext(ctx).
+		Preload("Author").
+		Joins("JOIN users ON users.id = recommendations.author_id AND users.deleted_at IS NULL").
+		Where("recommendations.recipient_id = ? AND recommendations.status = ? AND recommendations.is_hidden = ?", recipientID, entities.RecommendationApproved, false).
+		Order("recommendations.position ASC, recommendations.approved_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&recommendations).Error
+	return recommendations, err
+}
+
+func (r *recommendationRepository) GetApprovedIDs(ctx context.Context, recipientID uint) ([]uint, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).
+		Model(&entities.Recommendation{}).
+		Where("recipient_id = ? AND status = ?", recipientID, entities.RecommendationApproved).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+func (r *recommendationRepository) NextPosition(ctx context.Context, recipientID uint) (int, error) {
+	var position int
+	err := r.db.WithContext(ctx).
+		Model(&entities.Recommendation{}).
+		Select("COALESCE(MAX(position), 0) + 1").
+		Where("recipient_id = ? AND status = ?", recipientID, entities.RecommendationApproved).
+		Scan(&position).Error
+	return position, err
+}
+
+// Reorder assigns positions 1..n in the order given.
+func (r *recommendationRepository) Reorder(ctx context.Context, recipientID uint, ids []uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i, id := range ids {
+			result := tx.Model(&entities.Recommendation{}).
+				Where("id = ? AND recipient_id = ? AND status = ?", id, recipientID, entities.RecommendationApproved).
+				Update("position", i+1)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return gorm.ErrRecordNotFound
+			}
+		}
+		return nil
+	})
+}
+
+func (r *recommendationRepository) GetRevisions(ctx context.Context, recommendationID uint) ([]*entities.RecommendationRevision, error) {
+	var revisions []*entities.RecommendationRevision
+	err := r.db.WithContext(ctx).
+		Where("recommendation_id = ?", recommendationID).
+		Order("id ASC").
+		Find(&revisions).Error
+	return revisions, err
+}
@@ -0,0 +1,414 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	notificationDto "linked-clone/internal/api/notification/dto"
+	notificationService "linked-clone/internal/api/notification/service"
+	"linked-clone/internal/api/recommendation/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/graph"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/storage"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type RecommendationService interface {
+	RequestRecommendation(ctx context.Context, recipientID uint, req *dto.RequestRecommendationRequest) (*dto.RecommendationResponse, error)
+	WriteRecommendation(ctx context.Context, authorID uint, req *dto.WriteRecommendationRequest) (*dto.RecommendationResponse, error)
+	UpdateRecommendation(ctx context.Context, authorID, id uint, req *dto.UpdateRecommendationRequest) (*dto.RecommendationResponse, error)
+	DeleteRecommendation(ctx context.Context, authorID, id uint) error
+	GetRecommendation(ctx context.Context, userID, id uint) (*dto.RecommendationResponse, error)
+	GetReceived(ctx context.Context, userID uint, status entities.RecommendationStatus, limit, offset int) ([]*dto.RecommendationResponse, error)
+	GetGiven(ctx context.Context, userID uint, status entities.RecommendationStatus, limit, offset int) ([]*dto.RecommendationResponse, error)
+	GetUserRecommendations(ctx context.Context, userID uint, limit, offset int) ([]*dto.RecommendationResponse, error)
+
+	Approve(ctx context.Context, recipientID, id uint) (*dto.RecommendationResponse, error)
+	RequestRevision(ctx context.Context, recipientID, id uint, req *dto.RevisionRequest) (*dto.RecommendationResponse, error)
+	Decline(ctx context.Context, userID, id uint) (*dto.RecommendationResponse, error)
+	SetVisibility(ctx context.Context, recipientID, id uint, hidden bool) (*dto.RecommendationResponse, error)
+	Reorder(ctx context.Context, recipientID uint, req *dto.ReorderRequest) ([]*dto.RecommendationResponse, error)
+}
+
+type recommendationService struct {
+	recommendationRepo repositories.RecommendationRepository
+	userRepo           repositories.UserRepository
+	graph              graph.Graph
+	notificationSvc    notificationService.NotificationService
+	storageService     storage.StorageService
+	logger             logger.Logger
+}
+
+func NewRecommendationService(
+	recommendationRepo repositories.RecommendationRepository,
+	userRepo repositories.UserRepository,
+	graph graph.Graph,
+	notificationSvc notificationService.NotificationService,
+	storageService storage.StorageService,
+	logger logger.Logger,
+) RecommendationService {
+	return &recommendationService{
+		recommendationRepo: recommendationRepo,
+		userRepo:           userRepo,
+		graph:              graph,
+		notificationSvc:    notificationSvc,
+		storageService:     storageService,
+		logger:             logger,
+	}
+}
+
+func (s *recommendationService) RequestRecommendation(ctx context.Context, recipientID uint, req *dto.RequestRecommendationRequest) (*dto.RecommendationResponse, error) {
+	if err := s.checkPair(ctx, req.AuthorID, recipientID, req.AuthorID); err != nil {
+		return nil, err
+	}
+
+	recommendation := &entities.Recommendation{
+		AuthorID:       req.AuthorID,
+		RecipientID:    recipientID,
+		Relationship:   strings.TrimSpace(req.Relationship),
+		Status:         entities.RecommendationRequested,
+		RequestMessage: strings.TrimSpace(req.Message),
+	}
+	if err := s.recommendationRepo.Create(ctx, recommendation); err != nil {
+		s.logger.Error("Failed to create recommendation request", "error", err)
+		return nil, errors.New("failed to request recommendation")
+	}
+
+	recommendation, err := s.getRecommendation(ctx, recommendation.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notify(ctx, recommendation.AuthorID, recipientID, entities.NotificationRecommendationRequested, recommendation.ID,
+		fmt.Sprintf("%s asked you for a recommendation", recommendation.Recipient.FullName))
+
+	return s.mapRecommendation(recommendation, nil), nil
+}
+
+// WriteRecommendation starts a recommendation from the author's side. If the
+// recipient already asked for one, the request is fulfilled instead of
+// creating a second recommendation.
+func (s *recommendationService) WriteRecommendation(ctx context.Context, authorID uint, req *dto.WriteRecommendationRequest) (*dto.RecommendationResponse, error) {
+	recommendation, err := s.recommendationRepo.FindOpen(ctx, authorID, req.RecipientID)
+	switch {
+	case err == nil:
+		if recommendation.Status != entities.RecommendationRequested {
+			return nil, errors.New("a recommendation between you already exists")
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		if err := s.checkPair(ctx, authorID, req.RecipientID, req.RecipientID); err != nil {
+			return nil, err
+		}
+		recommendation = &entities.Recommendation{
+			AuthorID:    authorID,
+			RecipientID: req.RecipientID,
+		}
+	default:
+		s.logger.Error("Failed to check existing recommendation", "error", err)
+		return nil, errors.New("failed to write recommendation")
+	}
+
+	if relationship := strings.TrimSpace(req.Relationship); relationship != "" {
+		recommendation.Relationship = relationship
+	}
+	recommendation.Body = strings.TrimSpace(req.Body)
+
+	return s.save(ctx, recommendation, req.Submit)
+}
+
+// UpdateRecommendation lets the author edit at any stage short of a decline.
+// Saving without submitting keeps it as a draft; submitting sends it to the
+// recipient for approval, which also applies to edits of an approved
+// recommendation, taking it off the profile until re-approved.
+func (s *recommendationService) UpdateRecommendation(ctx context.Context, authorID, id uint, req *dto.UpdateRecommendationRequest) (*dto.RecommendationResponse, error) {
+	recommendation, err := s.getRecommendation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if recommendation.AuthorID != authorID {
+		return nil, errors.New("recommendation not found")
+	}
+	if recommendation.Status == entities.RecommendationDeclined {
+		return nil, errors.New("recommendation cannot be edited")
+	}
+
+	if req.Relationship != nil {
+		recommendation.Relationship = strings.TrimSpace(*req.Relationship)
+	}
+	if req.Body != nil {
+		recommendation.Body = strings.TrimSpace(*req.Body)
+	}
+
+	return s.save(ctx, recommendation, req.Submit)
+}
+
+func (s *recommendationService) DeleteRecommendation(ctx context.Context, authorID, id uint) error {
+	recommendation, err := s.getRecommendation(ctx, id)
+	if err != nil {
+		return err
+	}
+	if recommendation.AuthorID != authorID {
+		return errors.New("recommendation not found")
+	}
+
+	if err := s.recommendationRepo.Delete(ctx, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("recommendation not found")
+		}
+		s.logger.Error("Failed to delete recommendation", "recommendation_id", id, "error", err)
+		return errors.New("failed to delete recommendation")
+	}
+	return nil
+}
+
+func (s *recommendationService) GetRecommendation(ctx context.Context, userID, id uint) (*dto.RecommendationResponse, error) {
+	recommendation, err := s.getRecommendation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !canView(recommendation, userID) {
+		return nil, errors.New("recommendation not found")
+	}
+
+	revisions, err := s.recommendationRepo.GetRevisions(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to get recommendation revisions", "recommendation_id", id, "error", err)
+		return nil, errors.New("failed to get recommendation")
+	}
+
+	return s.mapRecommendation(recommendation, revisions), nil
+}
+
+func (s *recommendationService) GetReceived(ctx context.Context, userID uint, status entities.RecommendationStatus, limit, offset int) ([]*dto.RecommendationResponse, error) {
+	if status == entities.RecommendationDraft || (status != "" && !validStatus(status)) {
+		return nil, errors.New("invalid status")
+	}
+
+	recommendations, err := s.recommendationRepo.GetReceived(ctx, userID, status, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to get received recommendations", "user_id", userID, "error", err)
+		return nil, errors.New("failed to get recommendations")
+	}
+	return s.mapRecommendations(recommendations), nil
+}
+
+func (s *recommendationService) GetGiven(ctx context.Context, userID uint, status entities.RecommendationStatus, limit, offset int) ([]*dto.RecommendationResponse, error) {
+	if status != "" && !validStatus(status) {
+		return nil, errors.New("invalid status")
+	}
+
+	recommendations, err := s.recommendationRepo.GetGiven(ctx, userID, status, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to get given recommendations", "user_id", userID, "error", err)
+		return nil, errors.New("failed to get recommendations")
+	}
+	return s.mapRecommendations(recommendations), nil
+}
+
+func (s *recommendationService) GetUserRecommendations(ctx context.Context, userID uint, limit, offset int) ([]*dto.RecommendationResponse, error) {
+	recommendations, err := s.recommendationRepo.GetVisible(ctx, userID, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to get user recommendations", "user_id", userID, "error", err)
+		return nil, errors.New("failed to get recommendations")
+	}
+	return s.mapRecommendations(recommendations), nil
+}
+
+func (s *recommendationService) save(ctx context.Context, recommendation *entities.Recommendation, submit bool) (*dto.RecommendationResponse, error) {
+	if !submit {
+		recommendation.Status = entities.RecommendationDraft
+		if err := s.persist(ctx, recommendation); err != nil {
+			return nil, err
+		}
+		return s.reload(ctx, recommendation.ID)
+	}
+
+	if recommendation.Body == "" {
+		return nil, errors.New("recommendation body is required")
+	}
+
+	now := time.Now()
+	recommendation.Status = entities.RecommendationPending
+	recommendation.SubmittedAt = &now
+	recommendation.RevisionNote = ""
+	if recommendation.ID == 0 {
+		if err := s.persist(ctx, recommendation); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.recommendationRepo.Submit(ctx, recommendation); err != nil {
+		s.logger.Error("Failed to submit recommendation", "recommendation_id", recommendation.ID, "error", err)
+		return nil, errors.New("failed to submit recommendation")
+	}
+
+	response, err := s.reload(ctx, recommendation.ID)
+	if err != nil {
+		return nil, err
+	}
+	s.notify(ctx, recommendation.RecipientID, recommendation.AuthorID, entities.NotificationRecommendationReceived, recommendation.ID,
+		fmt.Sprintf("%s wrote you a recommendation", response.Author.FullName))
+	return response, nil
+}
+
+func (s *recommendationService) persist(ctx context.Context, recommendation *entities.Recommendation) error {
+	var err error
+	if recommendation.ID == 0 {
+		err = s.recommendationRepo.Create(ctx, recommendation)
+	} else {
+		err = s.recommendationRepo.Update(ctx, recommendation)
+	}
+	if err != nil {
+		s.logger.Error("Failed to save recommendation", "recommendation_id", recommendation.ID, "error", err)
+		return errors.New("failed to save recommendation")
+	}
+	return nil
+}
+
+// checkPair enforces that recommendations are only exchanged between
+// connections and that a pair has at most one open recommendation per
+// direction. otherID is whichever side of the pair is not the caller.
+func (s *recommendationService) checkPair(ctx context.Context, authorID, recipientID, otherID uint) error {
+	if authorID == recipientID {
+		return errors.New("cannot recommend yourself")
+	}
+
+	if _, err := s.userRepo.GetByID(ctx, otherID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("user not found")
+		}
+		s.logger.Error("Failed to get user", "user_id", otherID, "error", err)
+		return errors.New("failed to get user")
+	}
+
+	connected, err := s.graph.IsConnected(ctx, authorID, recipientID)
+	if err != nil {
+		s.logger.Error("Failed to check connection", "error", err)
+		return errors.New("failed to check connection")
+	}
+	if !connected {
+		return errors.New("recommendations are limited to connections")
+	}
+
+	if _, err := s.recommendationRepo.FindOpen(ctx, authorID, recipientID); err == nil {
+		return errors.New("a recommendation between you already exists")
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.logger.Error("Failed to check existing recommendation", "error", err)
+		return errors.New("failed to check existing recommendation")
+	}
+	return nil
+}
+
+func (s *recommendationService) getRecommendation(ctx context.Context, id uint) (*entities.Recommendation, error) {
+	recommendation, err := s.recommendationRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("recommendation not found")
+		}
+		s.logger.Error("Failed to get recommendation", "recommendation_id", id, "error", err)
+		return nil, errors.New("failed to get recommendation")
+	}
+	return recommendation, nil
+}
+
+func (s *recommendationService) reload(ctx context.Context, id uint) (*dto.RecommendationResponse, error) {
+	recommendation, err := s.getRecommendation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.mapRecommendation(recommendation, nil), nil
+}
+
+func (s *recommendationService) notify(ctx context.Context, recipientID, actorID uint, notificationType entities.NotificationType, recommendationID uint, message string) {
+	if _, err := s.notificationSvc.Notify(ctx, &notificationDto.CreateNotificationRequest{
+		UserID:     recipientID,
+		ActorID:    &actorID,
+		Type:       notificationType,
+		EntityType: "recommendation",
+		EntityID:   &recommendationID,
+		Message:    message,
+	}); err != nil {
+		s.logger.Error("Failed to send recommendation notification", "error", err, "recommendation_id", recommendationID, "user_id", recipientID)
+	}
+}
+
+func (s *recommendationService) mapRecommendations(recommendations []*entities.Recommendation) []*dto.RecommendationResponse {
+	responses := make([]*dto.RecommendationResponse, 0, len(recommendations))
+	for _, recommendation := range recommendations {
+		responses = append(responses, s.mapRecommendation(recommendation, nil))
+	}
+	return responses
+}
+
+func (s *recommendationService) mapRecommendation(recommendation *entities.Recommendation, revisions []*entities.RecommendationRevision) *dto.RecommendationResponse {
+	response := &dto.RecommendationResponse{
+		ID:             recommendation.ID,
+		Relationship:   recommendation.Relationship,
+		Body:           recommendation.Body,
+		Status:         recommendation.Status,
+		RequestMessage: recommendation.RequestMessage,
+		RevisionNote:   recommendation.RevisionNote,
+		IsHidden:       recommendation.IsHidden,
+		Position:       recommendation.Position,
+		SubmittedAt:    recommendation.SubmittedAt,
+		ApprovedAt:     recommendation.ApprovedAt,
+		CreatedAt:      recommendation.CreatedAt,
+		UpdatedAt:      recommendation.UpdatedAt,
+	}
+	if recommendation.Author.ID != 0 {
+		response.Author = s.mapUserInfo(&recommendation.Author)
+	}
+	if recommendation.Recipient.ID != 0 {
+		response.Recipient = s.mapUserInfo(&recommendation.Recipient)
+	}
+	for _, revision := range revisions {
+		response.Revisions = append(response.Revisions, &dto.RevisionResponse{
+			Relationship: revision.Relationship,
+			Body:         revision.Body,
+			CreatedAt:    revision.CreatedAt,
+		})
+	}
+	return response
+}
+
+func (s *recommendationService) mapUserInfo(user *entities.User) *dto.UserInfo {
+	profilePicture := user.ProfilePicture
+	if profilePicture != "" {
+		url, err := s.storageService.GeneratePresignedURL(profilePicture, 15*time.Minute)
+		if err != nil {
+			s.logger.Error("Failed to generate profile picture presigned URL", "error", err)
+		} else {
+			profilePicture = url
+		}
+	}
+
+	return &dto.UserInfo{
+		ID:             user.ID,
+		Username:       user.Username,
+		FullName:       user.FullName,
+		Headline:       user.Headline,
+		ProfilePicture: profilePicture,
+	}
+}
+
+// canView hides drafts from the recipient; the author sees every stage.
+func canView(recommendation *entities.Recommendation, userID uint) bool {
+	if recommendation.AuthorID == userID {
+		return true
+	}
+	return recommendation.RecipientID == userID && recommendation.Status != entities.RecommendationDraft
+}
+
+func validStatus(status entities.RecommendationStatus) bool {
+	switch status {
+	case entities.RecommendationRequested, entities.RecommendationDraft, entities.RecommendationPending,
+		entities.RecommendationRevisionRequested, entities.RecommendationApproved, entities.RecommendationDeclined:
+		return true
+	default:
+		return false
+	}
+}
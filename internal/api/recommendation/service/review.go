@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"linked-clone/internal/api/recommendation/dto"
+	"linked-clone/internal/domain/entities"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Approve publishes a pending recommendation on the recipient's profile. A
+// re-approved edit keeps its earlier slot; new ones are appended at the end.
+func (s *recommendationService) Approve(ctx context.Context, recipientID, id uint) (*dto.RecommendationResponse, error) {
+	recommendation, err := s.getPending(ctx, recipientID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if recommendation.Position == 0 {
+		position, err := s.recommendationRepo.NextPosition(ctx, recipientID)
+		if err != nil {
+			s.logger.Error("Failed to get next recommendation position", "user_id", recipientID, "error", err)
+			return nil, errors.New("failed to approve recommendation")
+		}
+		recommendation.Position = position
+	}
+
+	now := time.Now()
+	recommendation.Status = entities.RecommendationApproved
+	recommendation.ApprovedAt = &now
+	recommendation.RevisionNote = ""
+	if err := s.recommendationRepo.Update(ctx, recommendation); err != nil {
+		s.logger.Error("Failed to approve recommendation", "recommendation_id", id, "error", err)
+		return nil, errors.New("failed to approve recommendation")
+	}
+
+	s.notify(ctx, recommendation.AuthorID, recipientID, entities.NotificationRecommendationApproved, id,
+		fmt.Sprintf("%s added your recommendation to their profile", recommendation.Recipient.FullName))
+
+	return s.mapRecommendation(recommendation, nil), nil
+}
+
+func (s *recommendationService) RequestRevision(ctx context.Context, recipientID, id uint, req *dto.RevisionRequest) (*dto.RecommendationResponse, error) {
+	recommendation, err := s.getPending(ctx, recipientID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	recommendation.Status = entities.RecommendationRevisionRequested
+	recommendation.RevisionNote = strings.TrimSpace(req.Note)
+	if err := s.recommendationRepo.Update(ctx, recommendation); err != nil {
+		s.logger.Error("Failed to request recommendation revision", "recommendation_id", id, "error", err)
+		return nil, errors.New("failed to request revision")
+	}
+
+	s.notify(ctx, recommendation.AuthorID, recipientID, entities.NotificationRecommendationRevisionRequested, id,
+		fmt.Sprintf("%s asked you to revise your recommendation", recommendation.Recipient.FullName))
+
+	return s.mapRecommendation(recommendation, nil), nil
+}
+
+// Decline closes a recommendation for good. The recipient can decline one
+// that was submitted or already approved; the author can only turn down a
+// request they have not started on.
+func (s *recommendationService) Decline(ctx context.Context, userID, id uint) (*dto.RecommendationResponse, error) {
+	recommendation, err := s.getRecommendation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !canView(recommendation, userID) {
+		return nil, errors.New("recommendation not found")
+	}
+
+	allowed := false
+	switch recommendation.Status {
+	case entities.RecommendationRequested:
+		allowed = recommendation.AuthorID == userID
+	case entities.RecommendationPending, entities.RecommendationRevisionRequested, entities.RecommendationApproved:
+		allowed = recommendation.RecipientID == userID
+	}
+	if !allowed {
+		return nil, errors.New("recommendation cannot be declined")
+	}
+
+	recommendation.Status = entities.RecommendationDeclined
+	recommendation.Position = 0
+	if err := s.recommendationRepo.Update(ctx, recommendation); err != nil {
+		s.logger.Error("Failed to decline recommendation", "recommendation_id", id, "error", err)
+		return nil, errors.New("failed to decline recommendation")
+	}
+
+	return s.mapRecommendation(recommendation, nil), nil
+}
+
+func (s *recommendationService) SetVisibility(ctx context.Context, recipientID, id uint, hidden bool) (*dto.RecommendationResponse, error) {
+	recommendation, err := s.getRecommendation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if recommendation.RecipientID != recipientID {
+		return nil, errors.New("recommendation not found")
+	}
+	if recommendation.Status != entities.RecommendationApproved {
+		return nil, errors.New("recommendation is not approved")
+	}
+
+	recommendation.IsHidden = hidden
+	if err := s.recommendationRepo.Update(ctx, recommendation); err != nil {
+		s.logger.Error("Failed to update recommendation visibility", "recommendation_id", id, "error", err)
+		return nil, errors.New("failed to update recommendation")
+	}
+
+	return s.mapRecommendation(recommendation, nil), nil
+}
+
+// Reorder takes the full list of the recipient's approved recommendations,
+// hidden ones included, so positions stay dense and unambiguous.
+func (s *recommendationService) Reorder(ctx context.Context, recipientID uint, req *dto.ReorderRequest) ([]*dto.RecommendationResponse, error) {
+	approved, err := s.recommendationRepo.GetApprovedIDs(ctx, recipientID)
+	if err != nil {
+		s.logger.Error("Failed to get approved recommendations", "user_id", recipientID, "error", err)
+		return nil, errors.New("failed to reorder recommendations")
+	}
+
+	if len(approved) != len(req.IDs) {
+		return nil, errors.New("invalid recommendation order")
+	}
+	remaining := make(map[uint]bool, len(approved))
+	for _, id := range approved {
+		remaining[id] = true
+	}
+	for _, id := range req.IDs {
+		if !remaining[id] {
+			return nil, errors.New("invalid recommendation order")
+		}
+		delete(remaining, id)
+	}
+
+	if err := s.recommendationRepo.Reorder(ctx, recipientID, req.IDs); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("invalid recommendation order")
+		}
+		s.logger.Error("Failed to reorder recommendations", "user_id", recipientID, "error", err)
+		return nil, errors.New("failed to reorder recommendations")
+	}
+
+	return s.GetUserRecommendations(ctx, recipientID, len(req.IDs), 0)
+}
+
+func (s *recommendationService) getPending(ctx context.Context, recipientID, id uint) (*entities.Recommendation, error) {
+	recommendation, err := s.getRecommendation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !canView(recommendation, recipientID) || recommendation.RecipientID != recipientID {
+		return nil, errors.New("recommendation not found")
+	}
+	if recommendation.Status != entities.RecommendationPending {
+		return nil, errors.New("recommendation is not awaiting approval")
+	}
+	return recommendation, nil
+}
@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"linked-clone/internal/domain/entities"
+	fulltext "linked-clone/internal/infrastructure/search"
+	"time"
+)
+
+type UpdateSearchSettingsRequest struct {
+	PersonalizedSearch *bool `json:"personalized_search"`
+}
+
+type SearchSettingsResponse struct {
+	PersonalizedSearch bool `json:"personalized_search"`
+}
+
+type RecentSearchResponse struct {
+	ID         uint                `json:"id"`
+	Type       entities.SearchType `json:"type"`
+	Query      string              `json:"query"`
+	Filters    map[string]string   `json:"filters,omitempty"`
+	SearchedAt time.Time           `json:"searched_at"`
+}
+
+type SearchResultResponse struct {
+	Type  fulltext.DocumentType `json:"type"`
+	ID    uint                  `json:"id"`
+	Score float64               `json:"score"`
+	User  *UserResult           `json:"user,omitempty"`
+	Job   *JobResult            `json:"job,omitempty"`
+	Post  *PostResult           `json:"post,omitempty"`
+}
+
+type UserResult struct {
+	ID             uint   `json:"id"`
+	Username       string `json:"username"`
+	FullName       string `json:"full_name"`
+	Headline       string `json:"headline,omitempty"`
+	ProfilePicture string `json:"profile_picture,omitempty"`
+	IsVerified     bool   `json:"is_verified"`
+}
+
+type JobResult struct {
+	ID        uint             `json:"id"`
+	Title     string           `json:"title"`
+	Company   string           `json:"company"`
+	Location  string           `json:"location"`
+	JobType   entities.JobType `json:"job_type"`
+	CreatedAt time.Time        `json:"created_at"`
+}
+
+type PostResult struct {
+	ID            uint      `json:"id"`
+	UserID        uint      `json:"user_id"`
+	AuthorName    string    `json:"author_name"`
+	Content       string    `json:"content"`
+	ReactionCount int       `json:"reaction_count"`
+	CreatedAt     time.Time `json:"created_at"`
+}
@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"linked-clone/internal/api/search/dto"
+	"linked-clone/internal/api/search/service"
+	"linked-clone/internal/domain/entities"
+	fulltext "linked-clone/internal/infrastructure/search"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SearchHandler struct {
+	searchService service.SearchService
+	validator     validation.Validator
+	logger        logger.Logger
+}
+
+func NewSearchHandler(searchService service.SearchService, validator validation.Validator, logger logger.Logger) *SearchHandler {
+	return &SearchHandler{
+		searchService: searchService,
+		validator:     validator,
+		logger:        logger,
+	}
+}
+
+func (h *SearchHandler) Search(c *gin.Context) {
+	query := strings.TrimSpace(c.Query("q"))
+	if query == "" {
+		response.Error(c, http.StatusBadRequest, "Search query is required", "")
+		return
+	}
+
+	var types []fulltext.DocumentType
+	if raw := c.Query("types"); raw != "" {
+		for _, name := range strings.Split(raw, ",") {
+			docType := fulltext.DocumentType(strings.TrimSpace(name))
+			if !fulltext.ValidType(docType) {
+				response.Error(c, http.StatusBadRequest, "Invalid search type", "types must be a comma-separated list of user, job and post")
+				return
+			}
+			types = append(types, docType)
+		}
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	results, err := h.searchService.Search(c.Request.Context(), query, types, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to search", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Search failed", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{
+		"results": results,
+		"query":   query,
+		"limit":   limit,
+		"offset":  offset,
+	})
+}
+
+func (h *SearchHandler) GetRecentSearches(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	searchType := entities.SearchType(c.Query("type"))
+	switch searchType {
+	case "", entities.SearchTypePeople, entities.SearchTypeJobs:
+	default:
+		response.Error(c, http.StatusBadRequest, "Invalid search type", "type must be people or jobs")
+		return
+	}
+
+	searches, err := h.searchService.GetRecentSearches(c.Request.Context(), userID, searchType)
+	if err != nil {
+		h.logger.Error("Failed to get recent searches", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get recent searches", err.Error())
+		return
+	}
+
+	response.Success(c, searches)
+}
+
+func (h *SearchHandler) DeleteRecentSearch(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid search ID", err.Error())
+		return
+	}
+
+	if err := h.searchService.DeleteRecentSearch(c.Request.Context(), userID, uint(id)); err != nil {
+		status := http.StatusInternalServerError
+		if err.Error() == "recent search not found" {
+			status = http.StatusNotFound
+		}
+		response.Error(c, status, "Failed to delete recent search", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Recent search deleted", nil)
+}
+
+func (h *SearchHandler) ClearRecentSearches(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	if err := h.searchService.ClearRecentSearches(c.Request.Context(), userID); err != nil {
+		h.logger.Error("Failed to clear recent searches", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to clear recent searches", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Recent searches cleared", nil)
+}
+
+func (h *SearchHandler) GetSettings(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	settings, err := h.searchService.GetSettings(c.Request.Context(), userID)
+	if err != nil {
+		h.logger.Error("Failed to get search settings", "error", err)
+		response.Error(c, http.StatusNotFound, "Failed to get search settings", err.Error())
+		return
+	}
+
+	response.Success(c, settings)
+}
+
+func (h *SearchHandler) UpdateSettings(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.UpdateSearchSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	settings, err := h.searchService.UpdateSettings(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.logger.Error("Failed to update search settings", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to update search settings", err.Error())
+		return
+	}
+
+	response.Success(c, settings)
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type recentSearchRepository struct {
+	db *gorm.DB
+}
+
+func NewRecentSearchRepository(db *gorm.DB) repositories.RecentSearchRepository {
+	return &recentSearchRepository{db: db}
+}
+
+func (r *recentSearchRepository) Upsert(ctx context.Context, search *entities.RecentSearch) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "type"}, {Name: "query"}},
+		DoUpdates: clause.AssignmentColumns([]string{"filters", "searched_at"}),
+	}).Create(search).Error
+}
+
+func (r *recentSearchRepository) GetByID(ctx context.Context, id uint) (*entities.RecentSearch, error) {
+	var search entities.RecentSearch
+	err := r.db.WithContext(ctx).First(&search, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &search, nil
+}
+
+func (r *recentSearchRepository) GetByUserID(ctx context.Context, userID uint, searchType entities.SearchType, limit int) ([]*entities.RecentSearch, error) {
+	var searches []*entities.RecentSearch
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if searchType != "" {
+		query = query.Where("type = ?", searchType)
+	}
+	err := query.Order("searched_at DESC").Limit(limit).Find(&searches).Error
+	return searches, err
+}
+
+func (r *recentSearchRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&entities.RecentSearch{}, id).Error
+}
+
+func (r *recentSearchRepository) DeleteByUserID(ctx context.Context, userID uint) error {
+	return r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&entities.RecentSearch{}).Error
+}
+
+func (r *recentSearchRepository) Prune(ctx context.Context, userID uint, keep int) error {
+	recent := r.db.Model(&entities.RecentSearch{}).
+		Select("id").
+		Where("user_id = ?", userID).
+		Order("searched_at DESC").
+		Limit(keep)
+
+	return r.db.WithContext(ctx).
+		Where("user_id = ? AND id NOT IN (?)", userID, recent).
+		Delete(&entities.RecentSearch{}).Error
+}
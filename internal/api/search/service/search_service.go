@@ -0,0 +1,409 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/search/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	fulltext "linked-clone/internal/infrastructure/search"
+	"linked-clone/pkg/affinity"
+	"linked-clone/pkg/graph"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/storage"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+)
+
+const (
+	PersonalizationWindow = 100
+
+	maxRecentSearches   = 20
+	maxRecentQueryLen   = 200
+	firstDegreeBoost    = 10
+	secondDegreeBoost   = 4
+	maxInteractionBoost = 10
+	maxPostSnippetLen   = 280
+)
+
+type Candidate struct {
+	UserID    uint
+	CompanyID *uint
+}
+
+type SearchService interface {
+	Find(ctx context.Context, query fulltext.Query) ([]fulltext.Hit, error)
+	Search(ctx context.Context, query string, types []fulltext.DocumentType, limit, offset int) ([]*dto.SearchResultResponse, error)
+	RecordSearch(ctx context.Context, userID uint, searchType entities.SearchType, query string, filters map[string]string)
+	GetRecentSearches(ctx context.Context, userID uint, searchType entities.SearchType) ([]*dto.RecentSearchResponse, error)
+	DeleteRecentSearch(ctx context.Context, userID, searchID uint) error
+	ClearRecentSearches(ctx context.Context, userID uint) error
+	GetSettings(ctx context.Context, userID uint) (*dto.SearchSettingsResponse, error)
+	UpdateSettings(ctx context.Context, userID uint, req *dto.UpdateSearchSettingsRequest) (*dto.SearchSettingsResponse, error)
+	RecordInteraction(ctx context.Context, userID uint, kind affinity.Kind, targetID uint, weight int64)
+	Personalize(ctx context.Context, viewerID uint, candidates []Candidate, limit, offset int) []int
+}
+
+type searchService struct {
+	recentRepo     repositories.RecentSearchRepository
+	userRepo       repositories.UserRepository
+	jobRepo        repositories.JobRepository
+	postRepo       repositories.PostRepository
+	index          fulltext.SearchService
+	storageService storage.StorageService
+	graph          graph.Graph
+	affinity       affinity.Tracker
+	logger         logger.Logger
+}
+
+func NewSearchService(
+	recentRepo repositories.RecentSearchRepository,
+	userRepo repositories.UserRepository,
+	jobRepo repositories.JobRepository,
+	postRepo repositories.PostRepository,
+	index fulltext.SearchService,
+	storageService storage.StorageService,
+	graph graph.Graph,
+	affinity affinity.Tracker,
+	logger logger.Logger,
+) SearchService {
+	return &searchService{
+		recentRepo:     recentRepo,
+		userRepo:       userRepo,
+		jobRepo:        jobRepo,
+		postRepo:       postRepo,
+		index:          index,
+		storageService: storageService,
+		graph:          graph,
+		affinity:       affinity,
+		logger:         logger,
+	}
+}
+
+func FetchWindow(limit, offset int) (int, int) {
+	if offset >= PersonalizationWindow {
+		return limit, offset
+	}
+	if offset+limit > PersonalizationWindow {
+		return offset + limit, 0
+	}
+	return PersonalizationWindow, 0
+}
+
+func Pick[T any](items []T, indices []int) []T {
+	picked := make([]T, 0, len(indices))
+	for _, i := range indices {
+		picked = append(picked, items[i])
+	}
+	return picked
+}
+
+// Find runs a text query against the search index. The people and job
+// searches use it to get ranked IDs before loading and personalizing them.
+func (s *searchService) Find(ctx context.Context, query fulltext.Query) ([]fulltext.Hit, error) {
+	return s.index.Search(ctx, query)
+}
+
+// Search returns users, jobs and posts matching query in one ranked list.
+func (s *searchService) Search(ctx context.Context, query string, types []fulltext.DocumentType, limit, offset int) ([]*dto.SearchResultResponse, error) {
+	hits, err := s.index.Search(ctx, fulltext.Query{Text: query, Types: types, Limit: limit, Offset: offset})
+	if err != nil {
+		s.logger.Error("Failed to search", "error", err)
+		return nil, errors.New("failed to search")
+	}
+
+	ids := make(map[fulltext.DocumentType][]uint)
+	for _, hit := range hits {
+		ids[hit.Type] = append(ids[hit.Type], hit.ID)
+	}
+
+	users, err := s.userRepo.GetByIDs(ctx, ids[fulltext.TypeUser])
+	if err != nil {
+		s.logger.Error("Failed to load users for search", "error", err)
+		return nil, errors.New("failed to search")
+	}
+	jobs, err := s.jobRepo.GetByIDs(ctx, ids[fulltext.TypeJob])
+	if err != nil {
+		s.logger.Error("Failed to load jobs for search", "error", err)
+		return nil, errors.New("failed to search")
+	}
+	posts, err := s.postRepo.GetByIDs(ctx, ids[fulltext.TypePost])
+	if err != nil {
+		s.logger.Error("Failed to load posts for search", "error", err)
+		return nil, errors.New("failed to search")
+	}
+
+	userResults := make(map[uint]*dto.UserResult, len(users))
+	for _, user := range users {
+		userResults[user.ID] = s.userResult(user)
+	}
+	jobResults := make(map[uint]*dto.JobResult, len(jobs))
+	for _, job := range jobs {
+		jobResults[job.ID] = &dto.JobResult{
+			ID:        job.ID,
+			Title:     job.Title,
+			Company:   job.Company,
+			Location:  job.Location,
+			JobType:   job.JobType,
+			CreatedAt: job.CreatedAt,
+		}
+	}
+	postResults := make(map[uint]*dto.PostResult, len(posts))
+	for _, post := range posts {
+		postResults[post.ID] = &dto.PostResult{
+			ID:            post.ID,
+			UserID:        post.UserID,
+			AuthorName:    post.User.FullName,
+			Content:       snippet(post.Content, maxPostSnippetLen),
+			ReactionCount: post.ReactionCount,
+			CreatedAt:     post.CreatedAt,
+		}
+	}
+
+	// A document can disappear between the index query and loading it; it is
+	// dropped rather than returned empty.
+	results := make([]*dto.SearchResultResponse, 0, len(hits))
+	for _, hit := range hits {
+		result := &dto.SearchResultResponse{Type: hit.Type, ID: hit.ID, Score: hit.Score}
+		switch hit.Type {
+		case fulltext.TypeUser:
+			result.User = userResults[hit.ID]
+		case fulltext.TypeJob:
+			result.Job = jobResults[hit.ID]
+		case fulltext.TypePost:
+			result.Post = postResults[hit.ID]
+		}
+		if result.User == nil && result.Job == nil && result.Post == nil {
+			continue
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (s *searchService) userResult(user *entities.User) *dto.UserResult {
+	result := &dto.UserResult{
+		ID:         user.ID,
+		Username:   user.Username,
+		FullName:   user.FullName,
+		Headline:   user.Headline,
+		IsVerified: user.IsVerified,
+	}
+	if user.ProfilePicture != "" {
+		if url, err := s.storageService.GeneratePresignedURL(user.ProfilePicture, 24*time.Hour); err == nil {
+			result.ProfilePicture = url
+		} else {
+			s.logger.Error("Failed to generate presigned URL for user in search", "user_id", user.ID, "error", err)
+		}
+	}
+	return result
+}
+
+func snippet(content string, maxLen int) string {
+	if utf8.RuneCountInString(content) <= maxLen {
+		return content
+	}
+	return string([]rune(content)[:maxLen]) + "…"
+}
+
+func (s *searchService) RecordSearch(ctx context.Context, userID uint, searchType entities.SearchType, query string, filters map[string]string) {
+	query = strings.Join(strings.Fields(query), " ")
+	if userID == 0 || query == "" {
+		return
+	}
+	if len(query) > maxRecentQueryLen {
+		query = query[:maxRecentQueryLen]
+	}
+
+	search := &entities.RecentSearch{
+		UserID:     userID,
+		Type:       searchType,
+		Query:      query,
+		Filters:    filters,
+		SearchedAt: time.Now(),
+	}
+	if err := s.recentRepo.Upsert(ctx, search); err != nil {
+		s.logger.Error("Failed to record recent search", "error", err, "user_id", userID)
+		return
+	}
+	if err := s.recentRepo.Prune(ctx, userID, maxRecentSearches); err != nil {
+		s.logger.Error("Failed to prune recent searches", "error", err, "user_id", userID)
+	}
+}
+
+func (s *searchService) GetRecentSearches(ctx context.Context, userID uint, searchType entities.SearchType) ([]*dto.RecentSearchResponse, error) {
+	searches, err := s.recentRepo.GetByUserID(ctx, userID, searchType, maxRecentSearches)
+	if err != nil {
+		s.logger.Error("Failed to get recent searches", "error", err)
+		return nil, errors.New("failed to get recent searches")
+	}
+
+	responses := make([]*dto.RecentSearchResponse, 0, len(searches))
+	for _, search := range searches {
+		responses = append(responses, &dto.RecentSearchResponse{
+			ID:         search.ID,
+			Type:       search.Type,
+			Query:      search.Query,
+			Filters:    search.Filters,
+			SearchedAt: search.SearchedAt,
+		})
+	}
+	return responses, nil
+}
+
+func (s *searchService) DeleteRecentSearch(ctx context.Context, userID, searchID uint) error {
+	search, err := s.recentRepo.GetByID(ctx, searchID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("recent search not found")
+		}
+		s.logger.Error("Failed to get recent search", "error", err)
+		return errors.New("failed to delete recent search")
+	}
+	if search.UserID != userID {
+		return errors.New("recent search not found")
+	}
+
+	if err := s.recentRepo.Delete(ctx, searchID); err != nil {
+		s.logger.Error("Failed to delete recent search", "error", err)
+		return errors.New("failed to delete recent search")
+	}
+	return nil
+}
+
+func (s *searchService) ClearRecentSearches(ctx context.Context, userID uint) error {
+	if err := s.recentRepo.DeleteByUserID(ctx, userID); err != nil {
+		s.logger.Error("Failed to clear recent searches", "error", err)
+		return errors.New("failed to clear recent searches")
+	}
+	return nil
+}
+
+func (s *searchService) GetSettings(ctx context.Context, userID uint) (*dto.SearchSettingsResponse, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	return &dto.SearchSettingsResponse{PersonalizedSearch: user.PersonalizedSearch}, nil
+}
+
+func (s *searchService) UpdateSettings(ctx context.Context, userID uint, req *dto.UpdateSearchSettingsRequest) (*dto.SearchSettingsResponse, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	if req.PersonalizedSearch != nil {
+		user.PersonalizedSearch = *req.PersonalizedSearch
+	}
+
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		s.logger.Error("Failed to update search settings", "error", err)
+		return nil, errors.New("failed to update search settings")
+	}
+
+	return &dto.SearchSettingsResponse{PersonalizedSearch: user.PersonalizedSearch}, nil
+}
+
+func (s *searchService) RecordInteraction(ctx context.Context, userID uint, kind affinity.Kind, targetID uint, weight int64) {
+	if err := s.affinity.Record(ctx, userID, kind, targetID, weight); err != nil {
+		s.logger.Warn("Failed to record search interaction", "error", err, "user_id", userID, "kind", kind)
+	}
+}
+
+func (s *searchService) Personalize(ctx context.Context, viewerID uint, candidates []Candidate, limit, offset int) []int {
+	order := make([]int, len(candidates))
+	for i := range order {
+		order[i] = i
+	}
+	if offset >= PersonalizationWindow {
+		return order
+	}
+
+	window := order[:min(len(order), PersonalizationWindow)]
+	if scores := s.scores(ctx, viewerID, candidates[:len(window)]); scores != nil {
+		sort.SliceStable(window, func(i, j int) bool {
+			return scores[window[i]] > scores[window[j]]
+		})
+	}
+
+	if offset >= len(order) {
+		return nil
+	}
+	return order[offset:min(offset+limit, len(order))]
+}
+
+func (s *searchService) scores(ctx context.Context, viewerID uint, candidates []Candidate) []int64 {
+	if viewerID == 0 || len(candidates) == 0 {
+		return nil
+	}
+
+	user, err := s.userRepo.GetByID(ctx, viewerID)
+	if err != nil || !user.PersonalizedSearch {
+		return nil
+	}
+
+	first, err := s.graph.Connections(ctx, viewerID)
+	if err != nil {
+		s.logger.Warn("Failed to load connections for search personalization", "error", err)
+		return nil
+	}
+	second, err := s.graph.SecondDegree(ctx, viewerID)
+	if err != nil {
+		s.logger.Warn("Failed to load second-degree connections for search personalization", "error", err)
+		return nil
+	}
+
+	degrees := make(map[uint]int, len(first)+len(second))
+	for _, id := range second {
+		degrees[id] = graph.DegreeSecond
+	}
+	for _, id := range first {
+		degrees[id] = graph.DegreeFirst
+	}
+
+	userIDs := make([]uint, 0, len(candidates))
+	var companyIDs []uint
+	for _, candidate := range candidates {
+		userIDs = append(userIDs, candidate.UserID)
+		if candidate.CompanyID != nil {
+			companyIDs = append(companyIDs, *candidate.CompanyID)
+		}
+	}
+
+	userAffinity, err := s.affinity.Scores(ctx, viewerID, affinity.KindUser, userIDs)
+	if err != nil {
+		s.logger.Warn("Failed to load user affinity for search personalization", "error", err)
+	}
+	companyAffinity, err := s.affinity.Scores(ctx, viewerID, affinity.KindCompany, companyIDs)
+	if err != nil {
+		s.logger.Warn("Failed to load company affinity for search personalization", "error", err)
+	}
+
+	scores := make([]int64, len(candidates))
+	for i, candidate := range candidates {
+		switch degrees[candidate.UserID] {
+		case graph.DegreeFirst:
+			scores[i] += firstDegreeBoost
+		case graph.DegreeSecond:
+			scores[i] += secondDegreeBoost
+		}
+		scores[i] += capBoost(userAffinity[candidate.UserID])
+		if candidate.CompanyID != nil {
+			scores[i] += capBoost(companyAffinity[*candidate.CompanyID])
+		}
+	}
+	return scores
+}
+
+func capBoost(score int64) int64 {
+	if score > maxInteractionBoost {
+		return maxInteractionBoost
+	}
+	return score
+}
@@ -0,0 +1,62 @@
+package dto
+
+import (
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type UpsertSSOConfigRequest struct {
+	Protocol         entities.SSOProtocol `json:"protocol" validate:"required,oneof=oidc saml"`
+	OIDCIssuer       string               `json:"oidc_issuer" validate:"required_if=Protocol oidc,omitempty,url,max=255"`
+	OIDCClientID     string               `json:"oidc_client_id" validate:"required_if=Protocol oidc,max=255"`
+	OIDCClientSecret string               `json:"oidc_client_secret" validate:"max=1024"`
+	SAMLEntityID     string               `json:"saml_entity_id" validate:"required_if=Protocol saml,max=255"`
+	SAMLSSOURL       string               `json:"saml_sso_url" validate:"required_if=Protocol saml,omitempty,url,max=255"`
+	SAMLCertificate  string               `json:"saml_certificate" validate:"max=16384"`
+	Enforced         bool                 `json:"enforced"`
+	JITProvisioning  *bool                `json:"jit_provisioning"`
+}
+
+type SSOConfigResponse struct {
+	CompanyID       uint                 `json:"company_id"`
+	Protocol        entities.SSOProtocol `json:"protocol"`
+	OIDCIssuer      string               `json:"oidc_issuer,omitempty"`
+	OIDCClientID    string               `json:"oidc_client_id,omitempty"`
+	HasClientSecret bool                 `json:"has_client_secret"`
+	SAMLEntityID    string               `json:"saml_entity_id,omitempty"`
+	SAMLSSOURL      string               `json:"saml_sso_url,omitempty"`
+	HasCertificate  bool                 `json:"has_certificate"`
+	Enforced        bool                 `json:"enforced"`
+	JITProvisioning bool                 `json:"jit_provisioning"`
+	RedirectURI     string               `json:"redirect_uri,omitempty"`
+	ACSURL          string               `json:"acs_url,omitempty"`
+	SPEntityID      string               `json:"sp_entity_id,omitempty"`
+	StartURL        string               `json:"start_url"`
+	UpdatedBy       uint                 `json:"updated_by"`
+	UpdatedAt       time.Time            `json:"updated_at"`
+}
+
+type DiscoverResponse struct {
+	SSOAvailable bool   `json:"sso_available"`
+	SSORequired  bool   `json:"sso_required"`
+	CompanyID    uint   `json:"company_id,omitempty"`
+	StartURL     string `json:"start_url,omitempty"`
+}
+
+type ExchangeRequest struct {
+	Code string `json:"code" validate:"required,max=128"`
+}
+
+// DomainVerificationResponse is the DNS TXT record that proves a company
+// controls its domain.
+type DomainVerificationResponse struct {
+	Domain      string     `json:"domain"`
+	RecordName  string     `json:"record_name"`
+	RecordValue string     `json:"record_value"`
+	Verified    bool       `json:"verified"`
+	VerifiedAt  *time.Time `json:"verified_at,omitempty"`
+}
+
+type ConfirmLinkRequest struct {
+	Token string `json:"token" validate:"required,max=128"`
+}
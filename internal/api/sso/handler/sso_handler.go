@@ -0,0 +1,295 @@
+package handler
+
+import (
+	"linked-clone/internal/api/sso/dto"
+	"linked-clone/internal/api/sso/service"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SSOHandler struct {
+	ssoService          service.SSOService
+	validator           validation.Validator
+	frontendRedirectURL string
+	logger              logger.StructuredLogger
+}
+
+func NewSSOHandler(ssoService service.SSOService, validator validation.Validator, frontendRedirectURL string, logger logger.StructuredLogger) *SSOHandler {
+	return &SSOHandler{
+		ssoService:          ssoService,
+		validator:           validator,
+		frontendRedirectURL: frontendRedirectURL,
+		logger:              logger,
+	}
+}
+
+func (h *SSOHandler) GetConfig(c *gin.Context) {
+	companyID, ok := companyIDParam(c)
+	if !ok {
+		return
+	}
+
+	config, err := h.ssoService.GetConfig(c.Request.Context(), middleware.GetUserID(c), companyID)
+	if err != nil {
+		response.Error(c, ssoErrorStatus(err), "Failed to get SSO configuration", err.Error())
+		return
+	}
+
+	response.Success(c, config)
+}
+
+func (h *SSOHandler) UpsertConfig(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	companyID, ok := companyIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpsertSSOConfigRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	config, err := h.ssoService.UpsertConfig(c.Request.Context(), userID, companyID, &req)
+	if err != nil {
+		response.Error(c, ssoErrorStatus(err), "Failed to save SSO configuration", err.Error())
+		return
+	}
+
+	h.logSecurityEvent(c, userID, "sso_config_updated", "Organization SSO configuration changed",
+		map[string]interface{}{"company_id": companyID, "protocol": req.Protocol, "enforced": req.Enforced})
+	response.SuccessWithMessage(c, "SSO configuration saved", config)
+}
+
+func (h *SSOHandler) DeleteConfig(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	companyID, ok := companyIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.ssoService.DeleteConfig(c.Request.Context(), userID, companyID); err != nil {
+		response.Error(c, ssoErrorStatus(err), "Failed to remove SSO configuration", err.Error())
+		return
+	}
+
+	h.logSecurityEvent(c, userID, "sso_config_removed", "Organization SSO configuration removed",
+		map[string]interface{}{"company_id": companyID})
+	response.SuccessWithMessage(c, "SSO configuration removed", nil)
+}
+
+func (h *SSOHandler) GetDomainVerification(c *gin.Context) {
+	companyID, ok := companyIDParam(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.ssoService.GetDomainVerification(c.Request.Context(), middleware.GetUserID(c), companyID)
+	if err != nil {
+		response.Error(c, ssoErrorStatus(err), "Failed to get domain verification", err.Error())
+		return
+	}
+
+	response.Success(c, result)
+}
+
+func (h *SSOHandler) VerifyDomain(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	companyID, ok := companyIDParam(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.ssoService.VerifyDomain(c.Request.Context(), userID, companyID)
+	if err != nil {
+		response.Error(c, ssoErrorStatus(err), "Failed to verify domain", err.Error())
+		return
+	}
+
+	h.logSecurityEvent(c, userID, "sso_domain_verified", "Organization domain verified for SSO",
+		map[string]interface{}{"company_id": companyID, "domain": result.Domain})
+	response.SuccessWithMessage(c, "Domain verified", result)
+}
+
+func (h *SSOHandler) Discover(c *gin.Context) {
+	email := c.Query("email")
+	if email == "" {
+		response.BadRequest(c, "Email is required", "email query parameter is missing")
+		return
+	}
+
+	result, err := h.ssoService.Discover(c.Request.Context(), email)
+	if err != nil {
+		response.Error(c, ssoErrorStatus(err), "Failed to look up SSO", err.Error())
+		return
+	}
+
+	response.Success(c, result)
+}
+
+func (h *SSOHandler) Start(c *gin.Context) {
+	companyID, ok := companyIDParam(c)
+	if !ok {
+		return
+	}
+
+	redirect, err := h.ssoService.Start(c.Request.Context(), companyID)
+	if err != nil {
+		response.Error(c, ssoErrorStatus(err), "Failed to start SSO", err.Error())
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.Redirect(http.StatusFound, redirect)
+}
+
+func (h *SSOHandler) OIDCCallback(c *gin.Context) {
+	if idpError := c.Query("error"); idpError != "" {
+		h.redirectToFrontend(c, "", "sso login was cancelled or denied")
+		return
+	}
+
+	code, err := h.ssoService.HandleOIDCCallback(c.Request.Context(), c.Query("state"), c.Query("code"))
+	h.finishLogin(c, code, err)
+}
+
+func (h *SSOHandler) SAMLACS(c *gin.Context) {
+	code, err := h.ssoService.HandleSAMLResponse(c.Request.Context(), c.PostForm("RelayState"), c.PostForm("SAMLResponse"))
+	h.finishLogin(c, code, err)
+}
+
+func (h *SSOHandler) SAMLMetadata(c *gin.Context) {
+	c.Data(http.StatusOK, "application/samlmetadata+xml", []byte(h.ssoService.SAMLMetadata()))
+}
+
+func (h *SSOHandler) Exchange(c *gin.Context) {
+	var req dto.ExchangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	result, err := h.ssoService.Exchange(c.Request.Context(), &req, c.Request.UserAgent(), c.ClientIP())
+	if err != nil {
+		response.Error(c, ssoErrorStatus(err), "SSO login failed", err.Error())
+		return
+	}
+
+	h.logger.WithTraceID(middleware.GetTraceID(c)).LogAuthEvent(c.Request.Context(), logger.AuthEventLog{
+		UserID:    result.User.ID,
+		Email:     result.User.Email,
+		Action:    "sso_login_success",
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		Success:   true,
+		TokenType: "access_token",
+	})
+	response.Success(c, result)
+}
+
+func (h *SSOHandler) ConfirmLink(c *gin.Context) {
+	var req dto.ConfirmLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request body", err.Error())
+		return
+	}
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	result, err := h.ssoService.ConfirmLink(c.Request.Context(), &req, c.Request.UserAgent(), c.ClientIP())
+	if err != nil {
+		response.Error(c, ssoErrorStatus(err), "Failed to connect SSO login", err.Error())
+		return
+	}
+
+	h.logSecurityEvent(c, result.User.ID, "sso_account_linked", "SSO login connected to an existing account", nil)
+	response.Success(c, result)
+}
+
+func (h *SSOHandler) finishLogin(c *gin.Context, code string, err error) {
+	if err != nil {
+		h.logSecurityEvent(c, 0, "sso_login_failed", "SSO login rejected", map[string]interface{}{"error": err.Error()})
+		h.redirectToFrontend(c, "", err.Error())
+		return
+	}
+	h.redirectToFrontend(c, code, "")
+}
+
+// redirectToFrontend hands the browser back to the app with either a
+// one-time code to exchange or an error message to show.
+func (h *SSOHandler) redirectToFrontend(c *gin.Context, code, message string) {
+	target, err := url.Parse(h.frontendRedirectURL)
+	if err != nil {
+		response.InternalServerError(c, "SSO redirect is misconfigured", err.Error())
+		return
+	}
+	query := target.Query()
+	if code != "" {
+		query.Set("code", code)
+	} else {
+		query.Set("error", message)
+	}
+	target.RawQuery = query.Encode()
+
+	c.Header("Cache-Control", "no-store")
+	c.Redirect(http.StatusFound, target.String())
+}
+
+func (h *SSOHandler) logSecurityEvent(c *gin.Context, userID uint, eventType, description string, details map[string]interface{}) {
+	h.logger.WithTraceID(middleware.GetTraceID(c)).LogSecurityEvent(c.Request.Context(), logger.SecurityEventLog{
+		EventType:   eventType,
+		Description: description,
+		Severity:    "medium",
+		IP:          c.ClientIP(),
+		UserAgent:   c.Request.UserAgent(),
+		UserID:      userID,
+		Details:     details,
+	})
+}
+
+func companyIDParam(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "Invalid company ID", err.Error())
+		return 0, false
+	}
+	return uint(id), true
+}
+
+func ssoErrorStatus(err error) int {
+	switch err.Error() {
+	case "company not found", "sso is not configured", "user not found":
+		return http.StatusNotFound
+	case "unauthorized to manage this company", "account has not been provisioned", "email is not on the organization's domain", "account suspended",
+		"sso is not available for free email domains":
+		return http.StatusForbidden
+	case "invalid or expired sso code", "sso login failed", "invalid or expired sso link":
+		return http.StatusUnauthorized
+	case "company must be verified to configure sso", "company domain must be verified to configure sso", "invalid saml certificate",
+		"saml certificate is required", "sso login expired, please try again", "request a domain verification record first",
+		"domain verification record not found":
+		return http.StatusBadRequest
+	case "identity provider unavailable", "failed to look up domain verification record":
+		return http.StatusBadGateway
+	default:
+		return http.StatusInternalServerError
+	}
+}
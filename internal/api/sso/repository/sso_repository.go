@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type companySSORepository struct {
+	db *gorm.DB
+}
+
+func NewCompanySSORepository(db *gorm.DB) repositories.CompanySSORepository {
+	return &companySSORepository{db: db}
+}
+
+func (r *companySSORepository) GetByCompanyID(ctx context.Context, companyID uint) (*entities.CompanySSOConfig, error) {
+	var config entities.CompanySSOConfig
+	if err := r.db.WithContext(ctx).Where("company_id = ?", companyID).First(&config).Error; err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// GetByDomain finds the SSO config of the verified company that owns the
+// email domain. Companies that are unverified or have not proven they
+// control the domain are ignored, since anyone can claim a domain before
+// that.
+func (r *companySSORepository) GetByDomain(ctx context.Context, domain string) (*entities.CompanySSOConfig, error) {
+	var config entities.CompanySSOConfig
+	err := r.db.WithContext(ctx).
+		Joins("JOIN companies ON companies.id = company_sso_configs.company_id").
+		Where("LOWER(companies.domain) = ? AND companies.is_verified = true AND companies.domain_verified_at IS NOT NULL AND companies.deleted_at IS NULL", strings.ToLower(domain)).
+		First(&config).Error
+	if err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+func (r *companySSORepository) Save(ctx context.Context, config *entities.CompanySSOConfig) error {
+	return r.db.WithContext(ctx).Save(config).Error
+}
+
+func (r *companySSORepository) Delete(ctx context.Context, companyID uint) error {
+	return r.db.WithContext(ctx).Where("company_id = ?", companyID).Delete(&entities.CompanySSOConfig{}).Error
+}
+
+type ssoIdentityRepository struct {
+	db *gorm.DB
+}
+
+func NewSSOIdentityRepository(db *gorm.DB) repositories.SSOIdentityRepository {
+	return &ssoIdentityRepository{db: db}
+}
+
+func (r *ssoIdentityRepository) GetBySubject(ctx context.Context, companyID uint, subject string) (*entities.SSOIdentity, error) {
+	var identity entities.SSOIdentity
+	err := r.db.WithContext(ctx).Where("company_id = ? AND subject = ?", companyID, subject).First(&identity).Error
+	if err != nil {
+		return nil, err
+	}
+	return &identity, nil
+}
+
+func (r *ssoIdentityRepository) Create(ctx context.Context, identity *entities.SSOIdentity) error {
+	return r.db.WithContext(ctx).Create(identity).Error
+}
+
+func (r *ssoIdentityRepository) TouchLastLogin(ctx context.Context, id uint, at time.Time) error {
+	return r.db.WithContext(ctx).Model(&entities.SSOIdentity{}).Where("id = ?", id).Update("last_login_at", at).Error
+}
@@ -0,0 +1,866 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	authDto "linked-clone/internal/api/auth/dto"
+	authService "linked-clone/internal/api/auth/service"
+	"linked-clone/internal/api/sso/dto"
+	"linked-clone/internal/config"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/auth"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/redis"
+	email "linked-clone/pkg/smtp"
+	"linked-clone/pkg/sso"
+	"net"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const loginCodeTTL = time.Minute
+
+// A company proves it controls its domain by publishing
+// domainRecordValuePrefix followed by its token in a TXT record at
+// domainRecordPrefix plus the domain.
+const (
+	domainRecordPrefix      = "_linkedclone-verification."
+	domainRecordValuePrefix = "linkedclone-domain-verification="
+)
+
+var usernameUnsafe = regexp.MustCompile(`[^a-z0-9_]+`)
+
+type SSOService interface {
+	GetConfig(ctx context.Context, userID, companyID uint) (*dto.SSOConfigResponse, error)
+	UpsertConfig(ctx context.Context, userID, companyID uint, req *dto.UpsertSSOConfigRequest) (*dto.SSOConfigResponse, error)
+	DeleteConfig(ctx context.Context, userID, companyID uint) error
+	GetDomainVerification(ctx context.Context, userID, companyID uint) (*dto.DomainVerificationResponse, error)
+	VerifyDomain(ctx context.Context, userID, companyID uint) (*dto.DomainVerificationResponse, error)
+
+	Discover(ctx context.Context, email string) (*dto.DiscoverResponse, error)
+	Start(ctx context.Context, companyID uint) (string, error)
+	HandleOIDCCallback(ctx context.Context, state, code string) (string, error)
+	HandleSAMLResponse(ctx context.Context, relayState, samlResponse string) (string, error)
+	Exchange(ctx context.Context, req *dto.ExchangeRequest, userAgent, ipAddress string) (*authDto.AuthResponse, error)
+	ConfirmLink(ctx context.Context, req *dto.ConfirmLinkRequest, userAgent, ipAddress string) (*authDto.AuthResponse, error)
+	SAMLMetadata() string
+}
+
+// loginState is what survives the round trip to the identity provider.
+type loginState struct {
+	CompanyID uint   `json:"company_id"`
+	Nonce     string `json:"nonce,omitempty"`
+	Verifier  string `json:"verifier,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+type loginGrant struct {
+	UserID    uint `json:"user_id"`
+	CompanyID uint `json:"company_id"`
+}
+
+// pendingLink is an SSO login that matched an existing account by email,
+// waiting for the account owner to confirm it.
+type pendingLink struct {
+	UserID    uint   `json:"user_id"`
+	CompanyID uint   `json:"company_id"`
+	Subject   string `json:"subject"`
+	Email     string `json:"email"`
+}
+
+type ssoService struct {
+	ssoRepo          repositories.CompanySSORepository
+	identityRepo     repositories.SSOIdentityRepository
+	companyRepo      repositories.CompanyRepository
+	verificationRepo repositories.CompanyVerificationRepository
+	memberRepo       repositories.CompanyMemberRepository
+	userRepo         repositories.UserRepository
+	jwtService       auth.JWTService
+	securitySvc      authService.SecurityService
+	emailService     email.EmailService
+	redisClient      redis.RedisClient
+	oidc             *sso.OIDCClient
+	lookupTXT        func(ctx context.Context, name string) ([]string, error)
+	freeMailDomains  map[string]bool
+	cfg              config.SSOConfig
+	logger           logger.Logger
+}
+
+func NewSSOService(
+	ssoRepo repositories.CompanySSORepository,
+	identityRepo repositories.SSOIdentityRepository,
+	companyRepo repositories.CompanyRepository,
+	verificationRepo repositories.CompanyVerificationRepository,
+	memberRepo repositories.CompanyMemberRepository,
+	userRepo repositories.UserRepository,
+	jwtService auth.JWTService,
+	securitySvc authService.SecurityService,
+	emailService email.EmailService,
+	redisClient redis.RedisClient,
+	cfg config.SSOConfig,
+	logger logger.Logger,
+) SSOService {
+	freeMailDomains := make(map[string]bool, len(cfg.FreeMailDomains))
+	for _, domain := range cfg.FreeMailDomains {
+		freeMailDomains[strings.ToLower(domain)] = true
+	}
+
+	return &ssoService{
+		ssoRepo:          ssoRepo,
+		identityRepo:     identityRepo,
+		companyRepo:      companyRepo,
+		verificationRepo: verificationRepo,
+		memberRepo:       memberRepo,
+		userRepo:         userRepo,
+		jwtService:       jwtService,
+		securitySvc:      securitySvc,
+		emailService:     emailService,
+		redisClient:      redisClient,
+		oidc:             sso.NewOIDCClient(cfg.HTTPTimeout),
+		lookupTXT:        net.DefaultResolver.LookupTXT,
+		freeMailDomains:  freeMailDomains,
+		cfg:              cfg,
+		logger:           logger,
+	}
+}
+
+func (s *ssoService) GetConfig(ctx context.Context, userID, companyID uint) (*dto.SSOConfigResponse, error) {
+	if _, err := s.getAdministeredCompany(ctx, userID, companyID); err != nil {
+		return nil, err
+	}
+
+	ssoConfig, err := s.ssoRepo.GetByCompanyID(ctx, companyID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("sso is not configured")
+		}
+		s.logger.Error("Failed to get sso config", "error", err)
+		return nil, errors.New("failed to get sso config")
+	}
+	return s.mapConfigToResponse(ssoConfig), nil
+}
+
+// UpsertConfig requires a verified company that has proven it controls its
+// email domain, since enforcement and login both trust the identity
+// provider for every address on it. Secrets left blank keep their stored
+// value so admins need not resend them.
+func (s *ssoService) UpsertConfig(ctx context.Context, userID, companyID uint, req *dto.UpsertSSOConfigRequest) (*dto.SSOConfigResponse, error) {
+	company, err := s.getAdministeredCompany(ctx, userID, companyID)
+	if err != nil {
+		return nil, err
+	}
+	if s.isFreeMailDomain(company.Domain) {
+		return nil, errors.New("sso is not available for free email domains")
+	}
+	if !company.IsVerified {
+		return nil, errors.New("company must be verified to configure sso")
+	}
+	controlled, err := s.controlsDomain(ctx, company)
+	if err != nil {
+		return nil, errors.New("failed to save sso config")
+	}
+	if !controlled {
+		return nil, errors.New("company domain must be verified to configure sso")
+	}
+
+	ssoConfig, err := s.ssoRepo.GetByCompanyID(ctx, companyID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("Failed to get sso config", "error", err)
+			return nil, errors.New("failed to save sso config")
+		}
+		ssoConfig = &entities.CompanySSOConfig{CompanyID: companyID, JITProvisioning: true}
+	}
+
+	if ssoConfig.Protocol != req.Protocol {
+		ssoConfig.OIDCClientSecret = ""
+		ssoConfig.SAMLCertificate = ""
+	}
+	ssoConfig.Protocol = req.Protocol
+	ssoConfig.Enforced = req.Enforced
+	if req.JITProvisioning != nil {
+		ssoConfig.JITProvisioning = *req.JITProvisioning
+	}
+	ssoConfig.UpdatedBy = userID
+
+	switch req.Protocol {
+	case entities.SSOProtocolOIDC:
+		ssoConfig.OIDCIssuer = strings.TrimSuffix(req.OIDCIssuer, "/")
+		ssoConfig.OIDCClientID = req.OIDCClientID
+		if req.OIDCClientSecret != "" {
+			ssoConfig.OIDCClientSecret = req.OIDCClientSecret
+		}
+		ssoConfig.SAMLEntityID, ssoConfig.SAMLSSOURL, ssoConfig.SAMLCertificate = "", "", ""
+	case entities.SSOProtocolSAML:
+		if req.SAMLCertificate != "" {
+			if _, err := sso.ParseCertificate(req.SAMLCertificate); err != nil {
+				return nil, errors.New("invalid saml certificate")
+			}
+			ssoConfig.SAMLCertificate = req.SAMLCertificate
+		}
+		if ssoConfig.SAMLCertificate == "" {
+			return nil, errors.New("saml certificate is required")
+		}
+		ssoConfig.SAMLEntityID = req.SAMLEntityID
+		ssoConfig.SAMLSSOURL = req.SAMLSSOURL
+		ssoConfig.OIDCIssuer, ssoConfig.OIDCClientID, ssoConfig.OIDCClientSecret = "", "", ""
+	}
+
+	if err := s.ssoRepo.Save(ctx, ssoConfig); err != nil {
+		s.logger.Error("Failed to save sso config", "error", err)
+		return nil, errors.New("failed to save sso config")
+	}
+	return s.mapConfigToResponse(ssoConfig), nil
+}
+
+func (s *ssoService) DeleteConfig(ctx context.Context, userID, companyID uint) error {
+	if _, err := s.getAdministeredCompany(ctx, userID, companyID); err != nil {
+		return err
+	}
+	if err := s.ssoRepo.Delete(ctx, companyID); err != nil {
+		s.logger.Error("Failed to delete sso config", "error", err)
+		return errors.New("failed to delete sso config")
+	}
+	return nil
+}
+
+// GetDomainVerification returns the DNS TXT record that proves the company
+// controls its domain, creating its token on first use.
+func (s *ssoService) GetDomainVerification(ctx context.Context, userID, companyID uint) (*dto.DomainVerificationResponse, error) {
+	company, err := s.getAdministeredCompany(ctx, userID, companyID)
+	if err != nil {
+		return nil, err
+	}
+	if s.isFreeMailDomain(company.Domain) {
+		return nil, errors.New("sso is not available for free email domains")
+	}
+
+	if company.DomainToken == "" {
+		token, err := sso.RandomToken(24)
+		if err != nil {
+			return nil, errors.New("failed to start domain verification")
+		}
+		if err := s.companyRepo.SetDomainVerification(ctx, company.ID, token, nil); err != nil {
+			s.logger.Error("Failed to save domain verification token", "error", err, "company_id", company.ID)
+			return nil, errors.New("failed to start domain verification")
+		}
+		company.DomainToken = token
+	}
+	return mapDomainVerification(company), nil
+}
+
+// VerifyDomain looks up the company's TXT record and, when it holds the
+// token, records that the company controls its domain. A confirmed business
+// email on the domain is accepted in place of the record.
+func (s *ssoService) VerifyDomain(ctx context.Context, userID, companyID uint) (*dto.DomainVerificationResponse, error) {
+	company, err := s.getAdministeredCompany(ctx, userID, companyID)
+	if err != nil {
+		return nil, err
+	}
+	if s.isFreeMailDomain(company.Domain) {
+		return nil, errors.New("sso is not available for free email domains")
+	}
+
+	controlled, err := s.controlsDomain(ctx, company)
+	if err != nil {
+		return nil, errors.New("failed to verify domain")
+	}
+	if controlled {
+		return mapDomainVerification(company), nil
+	}
+	if company.DomainToken == "" {
+		return nil, errors.New("request a domain verification record first")
+	}
+
+	lookupCtx, cancel := context.WithTimeout(ctx, s.cfg.HTTPTimeout)
+	defer cancel()
+	records, err := s.lookupTXT(lookupCtx, domainRecordPrefix+company.Domain)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if !errors.As(err, &dnsErr) || !dnsErr.IsNotFound {
+			s.logger.Warn("Failed to look up domain verification record", "error", err, "company_id", company.ID)
+			return nil, errors.New("failed to look up domain verification record")
+		}
+	}
+
+	expected := domainRecordValuePrefix + company.DomainToken
+	for _, record := range records {
+		if strings.TrimSpace(record) == expected {
+			now := time.Now()
+			if err := s.companyRepo.SetDomainVerification(ctx, company.ID, company.DomainToken, &now); err != nil {
+				s.logger.Error("Failed to record domain verification", "error", err, "company_id", company.ID)
+				return nil, errors.New("failed to verify domain")
+			}
+			company.DomainVerifiedAt = &now
+			return mapDomainVerification(company), nil
+		}
+	}
+	return nil, errors.New("domain verification record not found")
+}
+
+// Discover tells the login page whether the email belongs to an SSO
+// workspace, so it can send the user to the identity provider instead of
+// asking for a password.
+func (s *ssoService) Discover(ctx context.Context, email string) (*dto.DiscoverResponse, error) {
+	domain := emailDomain(email)
+	if s.isFreeMailDomain(domain) {
+		return &dto.DiscoverResponse{}, nil
+	}
+
+	ssoConfig, err := s.ssoRepo.GetByDomain(ctx, domain)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &dto.DiscoverResponse{}, nil
+		}
+		s.logger.Error("Failed to look up sso config", "error", err)
+		return nil, errors.New("failed to look up sso")
+	}
+
+	return &dto.DiscoverResponse{
+		SSOAvailable: true,
+		SSORequired:  ssoConfig.Enforced,
+		CompanyID:    ssoConfig.CompanyID,
+		StartURL:     s.startURL(ssoConfig.CompanyID),
+	}, nil
+}
+
+func (s *ssoService) Start(ctx context.Context, companyID uint) (string, error) {
+	ssoConfig, err := s.getActiveConfig(ctx, companyID)
+	if err != nil {
+		return "", err
+	}
+
+	state, err := sso.RandomToken(24)
+	if err != nil {
+		return "", errors.New("failed to start sso")
+	}
+	pending := &loginState{CompanyID: companyID}
+
+	var redirect string
+	switch ssoConfig.Protocol {
+	case entities.SSOProtocolOIDC:
+		if pending.Nonce, err = sso.RandomToken(24); err != nil {
+			return "", errors.New("failed to start sso")
+		}
+		if pending.Verifier, err = sso.RandomToken(32); err != nil {
+			return "", errors.New("failed to start sso")
+		}
+		redirect, err = s.oidc.AuthCodeURL(ctx, oidcConfig(ssoConfig), s.oidcRedirectURI(), state, pending.Nonce, pending.Verifier)
+		if err != nil {
+			s.logger.Error("Failed to build oidc login url", "error", err, "company_id", companyID)
+			return "", errors.New("identity provider unavailable")
+		}
+	case entities.SSOProtocolSAML:
+		redirect, pending.RequestID, err = sso.SAMLRequestURL(s.samlConfig(ssoConfig), state, time.Now())
+		if err != nil {
+			s.logger.Error("Failed to build saml request", "error", err, "company_id", companyID)
+			return "", errors.New("identity provider unavailable")
+		}
+	default:
+		return "", errors.New("sso is not configured")
+	}
+
+	payload, _ := json.Marshal(pending)
+	if err := s.redisClient.Set(ctx, stateKey(state), string(payload), s.cfg.StateTTL); err != nil {
+		s.logger.Error("Failed to store sso state", "error", err)
+		return "", errors.New("failed to start sso")
+	}
+	return redirect, nil
+}
+
+func (s *ssoService) HandleOIDCCallback(ctx context.Context, state, code string) (string, error) {
+	pending, ssoConfig, err := s.resumeLogin(ctx, state, entities.SSOProtocolOIDC)
+	if err != nil {
+		return "", err
+	}
+
+	identity, err := s.oidc.Exchange(ctx, oidcConfig(ssoConfig), s.oidcRedirectURI(), code, pending.Nonce, pending.Verifier)
+	if err != nil {
+		s.logger.Warn("OIDC login rejected", "error", err, "company_id", ssoConfig.CompanyID)
+		return "", errors.New("sso login failed")
+	}
+	return s.completeLogin(ctx, ssoConfig, identity)
+}
+
+func (s *ssoService) HandleSAMLResponse(ctx context.Context, relayState, samlResponse string) (string, error) {
+	pending, ssoConfig, err := s.resumeLogin(ctx, relayState, entities.SSOProtocolSAML)
+	if err != nil {
+		return "", err
+	}
+
+	identity, err := sso.ParseSAMLResponse(s.samlConfig(ssoConfig), samlResponse, pending.RequestID, time.Now())
+	if err != nil {
+		s.logger.Warn("SAML login rejected", "error", err, "company_id", ssoConfig.CompanyID)
+		return "", errors.New("sso login failed")
+	}
+	return s.completeLogin(ctx, ssoConfig, identity)
+}
+
+// Exchange trades the one-time code handed to the frontend for a session.
+// Tokens never appear in a redirect URL this way.
+func (s *ssoService) Exchange(ctx context.Context, req *dto.ExchangeRequest, userAgent, ipAddress string) (*authDto.AuthResponse, error) {
+	raw, err := s.take(ctx, grantKey(req.Code))
+	if err != nil {
+		return nil, errors.New("invalid or expired sso code")
+	}
+	var grant loginGrant
+	if err := json.Unmarshal([]byte(raw), &grant); err != nil {
+		return nil, errors.New("invalid or expired sso code")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, grant.UserID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+	if user.IsSuspended() {
+		return nil, errors.New("account suspended")
+	}
+	return s.startSession(ctx, user, grant.CompanyID, userAgent, ipAddress)
+}
+
+// ConfirmLink connects an SSO identity to the existing account it matched
+// once the owner follows the emailed link, and signs them in.
+func (s *ssoService) ConfirmLink(ctx context.Context, req *dto.ConfirmLinkRequest, userAgent, ipAddress string) (*authDto.AuthResponse, error) {
+	raw, err := s.take(ctx, linkKey(req.Token))
+	if err != nil {
+		return nil, errors.New("invalid or expired sso link")
+	}
+	var link pendingLink
+	if err := json.Unmarshal([]byte(raw), &link); err != nil {
+		return nil, errors.New("invalid or expired sso link")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, link.UserID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+	// The link was sent to the address the account had then.
+	if !strings.EqualFold(user.Email, link.Email) {
+		return nil, errors.New("invalid or expired sso link")
+	}
+	if user.IsSuspended() {
+		return nil, errors.New("account suspended")
+	}
+	if _, err := s.getActiveConfig(ctx, link.CompanyID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := s.identityRepo.Create(ctx, &entities.SSOIdentity{
+		CompanyID:   link.CompanyID,
+		Subject:     link.Subject,
+		UserID:      user.ID,
+		LastLoginAt: &now,
+	}); err != nil {
+		s.logger.Error("Failed to link sso identity", "error", err)
+		return nil, errors.New("failed to connect sso login")
+	}
+	return s.startSession(ctx, user, link.CompanyID, userAgent, ipAddress)
+}
+
+func (s *ssoService) startSession(ctx context.Context, user *entities.User, companyID uint, userAgent, ipAddress string) (*authDto.AuthResponse, error) {
+	tokens, err := s.jwtService.GenerateSSOTokens(ctx, user.ID, user.Email, user.Username, userAgent, ipAddress, companyID)
+	if err != nil {
+		s.logger.Error("Failed to generate tokens", "error", err)
+		return nil, errors.New("failed to generate tokens")
+	}
+	s.securitySvc.Record(ctx, user.ID, entities.SecurityEventLogin, userAgent, ipAddress)
+
+	return &authDto.AuthResponse{
+		User: &authDto.UserResponse{
+			ID:             user.ID,
+			Email:          user.Email,
+			Username:       user.Username,
+			FullName:       user.FullName,
+			ProfilePicture: user.ProfilePicture,
+			Bio:            user.Bio,
+			EmailVerified:  user.EmailVerified,
+			IsVerified:     user.IsVerified,
+			IsPremium:      user.IsPremium,
+		},
+		AccessToken:      tokens.AccessToken,
+		RefreshToken:     tokens.RefreshToken,
+		ExpiresAt:        tokens.ExpiresAt,
+		RefreshExpiresAt: tokens.RefreshExpiresAt,
+	}, nil
+}
+
+func (s *ssoService) SAMLMetadata() string {
+	return fmt.Sprintf(`<?xml version="1.0"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="%s">
+  <md:SPSSODescriptor AuthnRequestsSigned="false" WantAssertionsSigned="true" protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress</md:NameIDFormat>
+    <md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="%s" index="0" isDefault="true"/>
+  </md:SPSSODescriptor>
+</md:EntityDescriptor>
+`, xmlEscape(s.cfg.SPEntityID), xmlEscape(s.samlACSURL()))
+}
+
+func (s *ssoService) resumeLogin(ctx context.Context, state string, protocol entities.SSOProtocol) (*loginState, *entities.CompanySSOConfig, error) {
+	raw, err := s.take(ctx, stateKey(state))
+	if err != nil {
+		return nil, nil, errors.New("sso login expired, please try again")
+	}
+	var pending loginState
+	if err := json.Unmarshal([]byte(raw), &pending); err != nil {
+		return nil, nil, errors.New("sso login expired, please try again")
+	}
+
+	ssoConfig, err := s.getActiveConfig(ctx, pending.CompanyID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ssoConfig.Protocol != protocol {
+		return nil, nil, errors.New("sso login failed")
+	}
+	return &pending, ssoConfig, nil
+}
+
+// completeLogin maps the asserted identity to a local user and returns a
+// one-time code for Exchange. The IdP may only vouch for addresses on a
+// domain the company proved it controls; otherwise one workspace could take
+// over any account by asserting its email.
+func (s *ssoService) completeLogin(ctx context.Context, ssoConfig *entities.CompanySSOConfig, identity *sso.Identity) (string, error) {
+	company, err := s.companyRepo.GetByID(ctx, ssoConfig.CompanyID)
+	if err != nil {
+		return "", errors.New("sso is not configured")
+	}
+	if company.DomainVerifiedAt == nil || s.isFreeMailDomain(company.Domain) {
+		return "", errors.New("sso is not configured")
+	}
+	email := strings.ToLower(strings.TrimSpace(identity.Email))
+	if !strings.EqualFold(emailDomain(email), company.Domain) {
+		return "", errors.New("email is not on the organization's domain")
+	}
+
+	user, err := s.resolveUser(ctx, ssoConfig, company, identity, email)
+	if err != nil {
+		return "", err
+	}
+
+	code, err := sso.RandomToken(32)
+	if err != nil {
+		return "", errors.New("sso login failed")
+	}
+	payload, _ := json.Marshal(&loginGrant{UserID: user.ID, CompanyID: ssoConfig.CompanyID})
+	if err := s.redisClient.Set(ctx, grantKey(code), string(payload), loginCodeTTL); err != nil {
+		s.logger.Error("Failed to store sso grant", "error", err)
+		return "", errors.New("sso login failed")
+	}
+	return code, nil
+}
+
+// resolveUser finds the account for an identity, provisioning one when JIT
+// is on. An account that already exists under the email is not linked here:
+// its owner is emailed a link to confirm it, so an identity provider cannot
+// take over an account just by asserting its address.
+func (s *ssoService) resolveUser(ctx context.Context, ssoConfig *entities.CompanySSOConfig, company *entities.Company, identity *sso.Identity, email string) (*entities.User, error) {
+	now := time.Now()
+
+	linked, err := s.identityRepo.GetBySubject(ctx, ssoConfig.CompanyID, identity.Subject)
+	if err == nil {
+		user, err := s.userRepo.GetByID(ctx, linked.UserID)
+		if err != nil {
+			return nil, errors.New("user not found")
+		}
+		s.identityRepo.TouchLastLogin(ctx, linked.ID, now)
+		return user, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.logger.Error("Failed to get sso identity", "error", err)
+		return nil, errors.New("sso login failed")
+	}
+
+	user, err := s.userRepo.GetByEmail(ctx, email)
+	if err == nil {
+		return nil, s.requestLink(ctx, company, identity, user)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.logger.Error("Failed to get user", "error", err)
+		return nil, errors.New("sso login failed")
+	}
+	if !ssoConfig.JITProvisioning {
+		return nil, errors.New("account has not been provisioned")
+	}
+	if user, err = s.provisionUser(ctx, identity, email); err != nil {
+		return nil, err
+	}
+
+	if err := s.identityRepo.Create(ctx, &entities.SSOIdentity{
+		CompanyID:   ssoConfig.CompanyID,
+		Subject:     identity.Subject,
+		UserID:      user.ID,
+		LastLoginAt: &now,
+	}); err != nil {
+		s.logger.Error("Failed to link sso identity", "error", err)
+		return nil, errors.New("sso login failed")
+	}
+	return user, nil
+}
+
+// requestLink emails the owner of an existing account a one-time link that
+// connects the identity to it. The returned error is what the login page
+// shows.
+func (s *ssoService) requestLink(ctx context.Context, company *entities.Company, identity *sso.Identity, user *entities.User) error {
+	if user.IsSuspended() {
+		return errors.New("account suspended")
+	}
+
+	token, err := sso.RandomToken(32)
+	if err != nil {
+		return errors.New("sso login failed")
+	}
+	payload, _ := json.Marshal(&pendingLink{
+		UserID:    user.ID,
+		CompanyID: company.ID,
+		Subject:   identity.Subject,
+		Email:     user.Email,
+	})
+	if err := s.redisClient.Set(ctx, linkKey(token), string(payload), s.cfg.LinkTTL); err != nil {
+		s.logger.Error("Failed to store sso link", "error", err)
+		return errors.New("sso login failed")
+	}
+
+	subject, body, err := email.Render(email.TemplateSSOLink, map[string]string{
+		"full_name": user.FullName,
+		"company":   company.Name,
+		"link":      s.cfg.LinkRedirectURL + "?token=" + url.QueryEscape(token),
+		"minutes":   strconv.Itoa(int(s.cfg.LinkTTL.Minutes())),
+	})
+	if err != nil {
+		s.logger.Error("Failed to render sso link email", "error", err)
+		return errors.New("sso login failed")
+	}
+
+	go func() {
+		if err := s.emailService.SendEmail(user.Email, subject, body); err != nil {
+			s.logger.Error("Failed to send sso link email", "error", err, "user_id", user.ID)
+		}
+	}()
+
+	return errors.New("an account with this email already exists; follow the link we emailed to connect it")
+}
+
+// provisionUser creates the account on first SSO login. It has no password,
+// so it can only sign in through the identity provider until one is set via
+// password reset.
+func (s *ssoService) provisionUser(ctx context.Context, identity *sso.Identity, email string) (*entities.User, error) {
+	local := email[:strings.Index(email, "@")]
+	base := strings.Trim(usernameUnsafe.ReplaceAllString(strings.ToLower(local), "_"), "_")
+	if len(base) < 3 {
+		base = "user_" + base
+	}
+	if len(base) > 40 {
+		base = base[:40]
+	}
+
+	username := base
+	for attempt := 0; ; attempt++ {
+		if _, err := s.userRepo.GetByUsername(ctx, username); errors.Is(err, gorm.ErrRecordNotFound) {
+			break
+		}
+		if attempt == 5 {
+			return nil, errors.New("failed to provision account")
+		}
+		suffix, err := sso.RandomToken(3)
+		if err != nil {
+			return nil, errors.New("failed to provision account")
+		}
+		username = base + "_" + strings.ToLower(usernameUnsafe.ReplaceAllString(suffix, ""))
+	}
+
+	fullName := strings.TrimSpace(identity.Name)
+	if fullName == "" {
+		fullName = local
+	}
+
+	user := &entities.User{
+		Email:         email,
+		Username:      username,
+		FullName:      fullName,
+		EmailVerified: true,
+	}
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		s.logger.Error("Failed to provision sso user", "error", err)
+		return nil, errors.New("failed to provision account")
+	}
+	return user, nil
+}
+
+// controlsDomain reports whether the company proved it controls its domain.
+// Besides the DNS record, an approved verification through a confirmed
+// business email on exactly the domain counts, and is recorded as proof the
+// first time it is found. Document verification proves the company exists,
+// not that it runs the domain's mail.
+func (s *ssoService) controlsDomain(ctx context.Context, company *entities.Company) (bool, error) {
+	if company.DomainVerifiedAt != nil {
+		return true, nil
+	}
+
+	verifications, err := s.verificationRepo.GetByCompanyID(ctx, company.ID)
+	if err != nil {
+		s.logger.Error("Failed to get company verifications", "error", err, "company_id", company.ID)
+		return false, err
+	}
+	for _, v := range verifications {
+		if v.Method != entities.CompanyVerificationBusinessEmail || v.Status != entities.CompanyVerificationApproved ||
+			v.EmailConfirmedAt == nil || !strings.EqualFold(emailDomain(v.BusinessEmail), company.Domain) {
+			continue
+		}
+		now := time.Now()
+		if err := s.companyRepo.SetDomainVerification(ctx, company.ID, company.DomainToken, &now); err != nil {
+			s.logger.Error("Failed to record domain verification", "error", err, "company_id", company.ID)
+			return false, err
+		}
+		company.DomainVerifiedAt = &now
+		return true, nil
+	}
+	return false, nil
+}
+
+func (s *ssoService) isFreeMailDomain(domain string) bool {
+	return s.freeMailDomains[strings.ToLower(strings.TrimSpace(domain))]
+}
+
+func (s *ssoService) getActiveConfig(ctx context.Context, companyID uint) (*entities.CompanySSOConfig, error) {
+	ssoConfig, err := s.ssoRepo.GetByCompanyID(ctx, companyID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("sso is not configured")
+		}
+		s.logger.Error("Failed to get sso config", "error", err)
+		return nil, errors.New("failed to get sso config")
+	}
+	return ssoConfig, nil
+}
+
+func (s *ssoService) getAdministeredCompany(ctx context.Context, userID, companyID uint) (*entities.Company, error) {
+	company, err := s.companyRepo.GetByID(ctx, companyID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("company not found")
+		}
+		s.logger.Error("Failed to get company", "error", err)
+		return nil, errors.New("failed to get company")
+	}
+
+	if company.OwnerID == userID {
+		return company, nil
+	}
+
+	member, err := s.memberRepo.Get(ctx, companyID, userID)
+	if err != nil || member.Role != entities.CompanyRoleAdmin {
+		return nil, errors.New("unauthorized to manage this company")
+	}
+	return company, nil
+}
+
+// take reads a single-use key. The SetNX claim makes sure two concurrent
+// callbacks cannot both redeem it.
+func (s *ssoService) take(ctx context.Context, key string) (string, error) {
+	value, err := s.redisClient.Get(ctx, key)
+	if err != nil {
+		return "", err
+	}
+	claimed, err := s.redisClient.SetNX(ctx, key+":claimed", 1, s.cfg.StateTTL)
+	if err != nil || !claimed {
+		return "", errors.New("already used")
+	}
+	s.redisClient.Delete(ctx, key)
+	return value, nil
+}
+
+func (s *ssoService) mapConfigToResponse(ssoConfig *entities.CompanySSOConfig) *dto.SSOConfigResponse {
+	resp := &dto.SSOConfigResponse{
+		CompanyID:       ssoConfig.CompanyID,
+		Protocol:        ssoConfig.Protocol,
+		OIDCIssuer:      ssoConfig.OIDCIssuer,
+		OIDCClientID:    ssoConfig.OIDCClientID,
+		HasClientSecret: ssoConfig.OIDCClientSecret != "",
+		SAMLEntityID:    ssoConfig.SAMLEntityID,
+		SAMLSSOURL:      ssoConfig.SAMLSSOURL,
+		HasCertificate:  ssoConfig.SAMLCertificate != "",
+		Enforced:        ssoConfig.Enforced,
+		JITProvisioning: ssoConfig.JITProvisioning,
+		StartURL:        s.startURL(ssoConfig.CompanyID),
+		UpdatedBy:       ssoConfig.UpdatedBy,
+		UpdatedAt:       ssoConfig.UpdatedAt,
+	}
+	switch ssoConfig.Protocol {
+	case entities.SSOProtocolOIDC:
+		resp.RedirectURI = s.oidcRedirectURI()
+	case entities.SSOProtocolSAML:
+		resp.ACSURL = s.samlACSURL()
+		resp.SPEntityID = s.cfg.SPEntityID
+	}
+	return resp
+}
+
+func (s *ssoService) samlConfig(ssoConfig *entities.CompanySSOConfig) sso.SAMLConfig {
+	return sso.SAMLConfig{
+		IdPEntityID:    ssoConfig.SAMLEntityID,
+		IdPSSOURL:      ssoConfig.SAMLSSOURL,
+		IdPCertificate: ssoConfig.SAMLCertificate,
+		SPEntityID:     s.cfg.SPEntityID,
+		ACSURL:         s.samlACSURL(),
+	}
+}
+
+func mapDomainVerification(company *entities.Company) *dto.DomainVerificationResponse {
+	return &dto.DomainVerificationResponse{
+		Domain:      company.Domain,
+		RecordName:  domainRecordPrefix + company.Domain,
+		RecordValue: domainRecordValuePrefix + company.DomainToken,
+		Verified:    company.DomainVerifiedAt != nil,
+		VerifiedAt:  company.DomainVerifiedAt,
+	}
+}
+
+func oidcConfig(ssoConfig *entities.CompanySSOConfig) sso.OIDCConfig {
+	return sso.OIDCConfig{
+		Issuer:       ssoConfig.OIDCIssuer,
+		ClientID:     ssoConfig.OIDCClientID,
+		ClientSecret: ssoConfig.OIDCClientSecret,
+	}
+}
+
+func (s *ssoService) startURL(companyID uint) string {
+	return fmt.Sprintf("%s/auth/sso/start/%d", s.cfg.BaseURL, companyID)
+}
+
+func (s *ssoService) oidcRedirectURI() string {
+	return s.cfg.BaseURL + "/auth/sso/oidc/callback"
+}
+
+func (s *ssoService) samlACSURL() string {
+	return s.cfg.BaseURL + "/auth/sso/saml/acs"
+}
+
+func stateKey(state string) string {
+	return "sso_state:" + state
+}
+
+func grantKey(code string) string {
+	return "sso_grant:" + code
+}
+
+func linkKey(token string) string {
+	return "sso_link:" + token
+}
+
+func emailDomain(email string) string {
+	_, domain, _ := strings.Cut(strings.TrimSpace(email), "@")
+	return strings.ToLower(domain)
+}
+
+func xmlEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;").Replace(s)
+}
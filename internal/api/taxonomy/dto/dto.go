@@ -0,0 +1,28 @@
+package dto
+
+type CreateIndustryRequest struct {
+	Name string `json:"name" validate:"required,min=2,max=100"`
+}
+
+type CreateLocationRequest struct {
+	City        string   `json:"city" validate:"omitempty,max=100"`
+	Region      string   `json:"region" validate:"omitempty,max=100"`
+	Country     string   `json:"country" validate:"required,iso3166_1_alpha2"`
+	CountryName string   `json:"country_name" validate:"required,max=100"`
+	Aliases     []string `json:"aliases" validate:"omitempty,max=10,dive,required,max=100"`
+}
+
+type IndustryResponse struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+type LocationResponse struct {
+	ID          uint   `json:"id"`
+	DisplayName string `json:"display_name"`
+	City        string `json:"city,omitempty"`
+	Region      string `json:"region,omitempty"`
+	Country     string `json:"country"`
+	CountryName string `json:"country_name"`
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"linked-clone/internal/api/taxonomy/dto"
+	"linked-clone/internal/api/taxonomy/service"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	validation "linked-clone/pkg/validator"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TaxonomyHandler struct {
+	taxonomyService service.TaxonomyService
+	validator       validation.Validator
+	logger          logger.Logger
+}
+
+func NewTaxonomyHandler(taxonomyService service.TaxonomyService, validator validation.Validator, logger logger.Logger) *TaxonomyHandler {
+	return &TaxonomyHandler{
+		taxonomyService: taxonomyService,
+		validator:       validator,
+		logger:          logger,
+	}
+}
+
+func (h *TaxonomyHandler) SearchIndustries(c *gin.Context) {
+	industries, err := h.taxonomyService.SearchIndustries(c.Request.Context(), c.Query("q"), autocompleteLimit(c))
+	if err != nil {
+		h.logger.Error("Failed to search industries", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to search industries", err.Error())
+		return
+	}
+
+	response.Success(c, industries)
+}
+
+func (h *TaxonomyHandler) SearchLocations(c *gin.Context) {
+	locations, err := h.taxonomyService.SearchLocations(c.Request.Context(), c.Query("q"), c.Query("country"), autocompleteLimit(c))
+	if err != nil {
+		h.logger.Error("Failed to search locations", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to search locations", err.Error())
+		return
+	}
+
+	response.Success(c, locations)
+}
+
+func (h *TaxonomyHandler) CreateIndustry(c *gin.Context) {
+	var req dto.CreateIndustryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	industry, err := h.taxonomyService.CreateIndustry(c.Request.Context(), &req)
+	if err != nil {
+		h.logger.Error("Failed to create industry", "error", err)
+		response.Error(c, taxonomyErrorStatus(err), "Failed to create industry", err.Error())
+		return
+	}
+
+	response.CreatedWithMessage(c, "Industry created", industry)
+}
+
+func (h *TaxonomyHandler) CreateLocation(c *gin.Context) {
+	var req dto.CreateLocationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	location, err := h.taxonomyService.CreateLocation(c.Request.Context(), &req)
+	if err != nil {
+		h.logger.Error("Failed to create location", "error", err)
+		response.Error(c, taxonomyErrorStatus(err), "Failed to create location", err.Error())
+		return
+	}
+
+	response.CreatedWithMessage(c, "Location created", location)
+}
+
+func autocompleteLimit(c *gin.Context) int {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if limit <= 0 || limit > 50 {
+		limit = 10
+	}
+	return limit
+}
+
+func taxonomyErrorStatus(err error) int {
+	switch err.Error() {
+	case "industry already exists", "location already exists":
+		return http.StatusConflict
+	case "invalid industry name":
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type industryRepository struct {
+	db *gorm.DB
+}
+
+func NewIndustryRepository(db *gorm.DB) repositories.IndustryRepository {
+	return &industryRepository{db: db}
+}
+
+// Create reports false when an industry with the same name or slug exists.
+func (r *industryRepository) Create(ctx context.Context, industry *entities.Industry) (bool, error) {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(industry)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *industryRepository) GetByID(ctx context.Context, id uint) (*entities.Industry, error) {
+	var industry entities.Industry
+	if err := r.db.WithContext(ctx).First(&industry, id).Error; err != nil {
+		return nil, err
+	}
+	return &industry, nil
+}
+
+// Search ranks names starting with the query ahead of names merely
+// containing it.
+func (r *industryRepository) Search(ctx context.Context, query string, limit int) ([]*entities.Industry, error) {
+	var industries []*entities.Industry
+	db := r.db.WithContext(ctx)
+	if query = strings.TrimSpace(query); query != "" {
+		db = db.Where("name ILIKE ?", "%"+escapeLike(query)+"%").
+			Order(gorm.Expr("CASE WHEN name ILIKE ? THEN 0 ELSE 1 END", escapeLike(query)+"%"))
+	}
+	err := db.Order("name ASC").Limit(limit).Find(&industries).Error
+	return industries, err
+}
+
+type locationRepository struct {
+	db *gorm.DB
+}
+
+func NewLocationRepository(db *gorm.DB) repositories.LocationRepository {
+	return &locationRepository{db: db}
+}
+
+// Create reports false when the same city, region and country exist.
+func (r *locationRepository) Create(ctx context.Context, location *entities.Location) (bool, error) {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(location)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *locationRepository) GetByID(ctx context.Context, id uint) (*entities.Location, error) {
+	var location entities.Location
+	if err := r.db.WithContext(ctx).First(&location, id).Error; err != nil {
+		return nil, err
+	}
+	return &location, nil
+}
+
+// Search matches the start of the city, region or country name, preferring
+// cities, then regions, then whole countries.
+func (r *locationRepository) Search(ctx context.Context, query, country string, limit int) ([]*entities.Location, error) {
+	var locations []*entities.Location
+	db := r.db.WithContext(ctx)
+	if country != "" {
+		db = db.Where("country = ?", strings.ToUpper(country))
+	}
+	if query = strings.TrimSpace(query); query != "" {
+		prefix := escapeLike(query) + "%"
+		db = db.Where("city ILIKE ? OR region ILIKE ? OR country_name ILIKE ?", prefix, prefix, prefix).
+			Order(gorm.Expr("CASE WHEN city ILIKE ? THEN 0 WHEN region ILIKE ? THEN 1 ELSE 2 END", prefix, prefix))
+	}
+	err := db.Order("country_name ASC, region ASC, city ASC").Limit(limit).Find(&locations).Error
+	return locations, err
+}
+
+func (r *locationRepository) GetAll(ctx context.Context) ([]*entities.Location, error) {
+	var locations []*entities.Location
+	err := r.db.WithContext(ctx).Order("id ASC").Find(&locations).Error
+	return locations, err
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/taxonomy/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/taxonomy"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type TaxonomyService interface {
+	SearchIndustries(ctx context.Context, query string, limit int) ([]*dto.IndustryResponse, error)
+	SearchLocations(ctx context.Context, query, country string, limit int) ([]*dto.LocationResponse, error)
+	CreateIndustry(ctx context.Context, req *dto.CreateIndustryRequest) (*dto.IndustryResponse, error)
+	CreateLocation(ctx context.Context, req *dto.CreateLocationRequest) (*dto.LocationResponse, error)
+
+	GetIndustry(ctx context.Context, id uint) (*entities.Industry, error)
+	GetLocation(ctx context.Context, id uint) (*entities.Location, error)
+	MatchLocation(ctx context.Context, text string) *entities.Location
+	ResolveLocation(ctx context.Context, locationID *uint, text string) (*entities.Location, error)
+}
+
+// matcherTTL bounds how stale the in-memory matcher gets on instances that
+// did not handle a location being added.
+const matcherTTL = 10 * time.Minute
+
+var slugPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+type taxonomyService struct {
+	industryRepo repositories.IndustryRepository
+	locationRepo repositories.LocationRepository
+	logger       logger.Logger
+
+	mu        sync.Mutex
+	matcher   *taxonomy.Matcher
+	locations map[uint]*entities.Location
+	builtAt   time.Time
+}
+
+func NewTaxonomyService(industryRepo repositories.IndustryRepository, locationRepo repositories.LocationRepository, logger logger.Logger) TaxonomyService {
+	return &taxonomyService{
+		industryRepo: industryRepo,
+		locationRepo: locationRepo,
+		logger:       logger,
+	}
+}
+
+func (s *taxonomyService) SearchIndustries(ctx context.Context, query string, limit int) ([]*dto.IndustryResponse, error) {
+	industries, err := s.industryRepo.Search(ctx, query, limit)
+	if err != nil {
+		s.logger.Error("Failed to search industries", "error", err)
+		return nil, errors.New("failed to search industries")
+	}
+
+	responses := make([]*dto.IndustryResponse, 0, len(industries))
+	for _, industry := range industries {
+		responses = append(responses, mapIndustry(industry))
+	}
+	return responses, nil
+}
+
+func (s *taxonomyService) SearchLocations(ctx context.Context, query, country string, limit int) ([]*dto.LocationResponse, error) {
+	locations, err := s.locationRepo.Search(ctx, query, country, limit)
+	if err != nil {
+		s.logger.Error("Failed to search locations", "error", err)
+		return nil, errors.New("failed to search locations")
+	}
+
+	responses := make([]*dto.LocationResponse, 0, len(locations))
+	for _, location := range locations {
+		responses = append(responses, mapLocation(location))
+	}
+	return responses, nil
+}
+
+func (s *taxonomyService) CreateIndustry(ctx context.Context, req *dto.CreateIndustryRequest) (*dto.IndustryResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	industry := &entities.Industry{
+		Name: name,
+		Slug: strings.Trim(slugPattern.ReplaceAllString(strings.ToLower(name), "-"), "-"),
+	}
+	if industry.Slug == "" {
+		return nil, errors.New("invalid industry name")
+	}
+
+	created, err := s.industryRepo.Create(ctx, industry)
+	if err != nil {
+		s.logger.Error("Failed to create industry", "error", err)
+		return nil, errors.New("failed to create industry")
+	}
+	if !created {
+		return nil, errors.New("industry already exists")
+	}
+	return mapIndustry(industry), nil
+}
+
+func (s *taxonomyService) CreateLocation(ctx context.Context, req *dto.CreateLocationRequest) (*dto.LocationResponse, error) {
+	location := &entities.Location{
+		City:        strings.TrimSpace(req.City),
+		Region:      strings.TrimSpace(req.Region),
+		Country:     strings.ToUpper(req.Country),
+		CountryName: strings.TrimSpace(req.CountryName),
+		Aliases:     req.Aliases,
+	}
+	if location.Aliases == nil {
+		location.Aliases = []string{}
+	}
+
+	created, err := s.locationRepo.Create(ctx, location)
+	if err != nil {
+		s.logger.Error("Failed to create location", "error", err)
+		return nil, errors.New("failed to create location")
+	}
+	if !created {
+		return nil, errors.New("location already exists")
+	}
+
+	s.mu.Lock()
+	s.matcher = nil
+	s.mu.Unlock()
+
+	return mapLocation(location), nil
+}
+
+func (s *taxonomyService) GetIndustry(ctx context.Context, id uint) (*entities.Industry, error) {
+	industry, err := s.industryRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("industry not found")
+		}
+		s.logger.Error("Failed to get industry", "industry_id", id, "error", err)
+		return nil, errors.New("failed to get industry")
+	}
+	return industry, nil
+}
+
+func (s *taxonomyService) GetLocation(ctx context.Context, id uint) (*entities.Location, error) {
+	location, err := s.locationRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("location not found")
+		}
+		s.logger.Error("Failed to get location", "location_id", id, "error", err)
+		return nil, errors.New("failed to get location")
+	}
+	return location, nil
+}
+
+// MatchLocation maps free text from clients that do not send a location ID
+// onto a known location. It returns nil when nothing matches unambiguously;
+// callers keep the text as typed in that case.
+func (s *taxonomyService) MatchLocation(ctx context.Context, text string) *entities.Location {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.matcher == nil || time.Since(s.builtAt) > matcherTTL {
+		locations, err := s.locationRepo.GetAll(ctx)
+		if err != nil {
+			s.logger.Error("Failed to load locations for matching", "error", err)
+			return nil
+		}
+
+		s.matcher = taxonomy.NewMatcher()
+		s.locations = make(map[uint]*entities.Location, len(locations))
+		for _, location := range locations {
+			s.matcher.Add(location.ID, location.MatchNames()...)
+			s.locations[location.ID] = location
+		}
+		s.builtAt = time.Now()
+	}
+
+	id, ok := s.matcher.Match(text)
+	if !ok {
+		return nil
+	}
+	return s.locations[id]
+}
+
+// ResolveLocation prefers an explicit location ID and falls back to matching
+// the free text. A nil location with no error means the text did not match
+// and should be stored as typed.
+func (s *taxonomyService) ResolveLocation(ctx context.Context, locationID *uint, text string) (*entities.Location, error) {
+	if locationID != nil && *locationID != 0 {
+		return s.GetLocation(ctx, *locationID)
+	}
+	if strings.TrimSpace(text) == "" {
+		return nil, nil
+	}
+	return s.MatchLocation(ctx, text), nil
+}
+
+func mapIndustry(industry *entities.Industry) *dto.IndustryResponse {
+	return &dto.IndustryResponse{
+		ID:   industry.ID,
+		Name: industry.Name,
+		Slug: industry.Slug,
+	}
+}
+
+func mapLocation(location *entities.Location) *dto.LocationResponse {
+	return &dto.LocationResponse{
+		ID:          location.ID,
+		DisplayName: location.DisplayName(),
+		City:        location.City,
+		Region:      location.Region,
+		Country:     location.Country,
+		CountryName: location.CountryName,
+	}
+}
@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+const (
+	StagePending    = "pending"
+	StageInProgress = "in_progress"
+	StageCompleted  = "completed"
+	StageFailed     = "failed"
+)
+
+type UploadStageResponse struct {
+	Name        string     `json:"name"`
+	Status      string     `json:"status"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+type UploadStatusResponse struct {
+	ID             uint                  `json:"id"`
+	Kind           entities.UploadKind   `json:"kind"`
+	ResourceID     *uint                 `json:"resource_id,omitempty"`
+	Filename       string                `json:"filename"`
+	Size           int64                 `json:"size"`
+	Stage          entities.UploadStage  `json:"stage"`
+	Stages         []UploadStageResponse `json:"stages"`
+	TotalItems     int                   `json:"total_items"`
+	ProcessedItems int                   `json:"processed_items"`
+	Progress       float64               `json:"progress"`
+	Error          string                `json:"error,omitempty"`
+	CreatedAt      time.Time             `json:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+	CompletedAt    *time.Time            `json:"completed_at,omitempty"`
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"linked-clone/internal/api/upload/service"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UploadHandler struct {
+	uploadService service.UploadService
+	logger        logger.Logger
+}
+
+func NewUploadHandler(uploadService service.UploadService, logger logger.Logger) *UploadHandler {
+	return &UploadHandler{
+		uploadService: uploadService,
+		logger:        logger,
+	}
+}
+
+func (h *UploadHandler) GetStatus(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	uploadID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid upload ID", err.Error())
+		return
+	}
+
+	status, err := h.uploadService.GetStatus(c.Request.Context(), userID, uint(uploadID))
+	if err != nil {
+		code := http.StatusInternalServerError
+		if err.Error() == "upload not found" {
+			code = http.StatusNotFound
+		}
+		response.Error(c, code, "Failed to get upload status", err.Error())
+		return
+	}
+
+	// Clients poll this; make sure nothing in between serves a stale stage.
+	c.Header("Cache-Control", "no-store")
+	response.Success(c, status)
+}
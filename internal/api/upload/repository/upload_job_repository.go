@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type uploadJobRepository struct {
+	db *gorm.DB
+}
+
+func NewUploadJobRepository(db *gorm.DB) repositories.UploadJobRepository {
+	return &uploadJobRepository{db: db}
+}
+
+func (r *uploadJobRepository) Create(ctx context.Context, job *entities.UploadJob) error {
+	return r.db.WithContext(ctx).Create(job).Error
+}
+
+func (r *uploadJobRepository) GetByID(ctx context.Context, id uint) (*entities.UploadJob, error) {
+	var job entities.UploadJob
+	if err := r.db.WithContext(ctx).First(&job, id).Error; err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (r *uploadJobRepository) Update(ctx context.Context, job *entities.UploadJob) error {
+	return r.db.WithContext(ctx).Save(job).Error
+}
+
+func (r *uploadJobRepository) UpdateProgress(ctx context.Context, id uint, processed, total int) error {
+	return r.db.WithContext(ctx).Model(&entities.UploadJob{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"processed_items": processed,
+			"total_items":     total,
+			"updated_at":      time.Now(),
+		}).Error
+}
+
+func (r *uploadJobRepository) FailStale(ctx context.Context, staleBefore time.Time, reason string) ([]*entities.UploadJob, error) {
+	var jobs []*entities.UploadJob
+	now := time.Now()
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE upload_jobs
+		SET failed_stage = stage, stage = ?, error = ?, completed_at = ?, updated_at = ?
+		WHERE stage NOT IN (?, ?) AND updated_at < ?
+		RETURNING *`,
+		entities.UploadFailed, reason, now, now,
+		entities.UploadCompleted, entities.UploadFailed, staleBefore,
+	).Scan(&jobs).Error
+	return jobs, err
+}
@@ -0,0 +1,340 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/upload/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/scanner"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Processor is the kind-specific part of the upload pipeline. Validate
+// rejects files that cannot be used, Process does the work and reports
+// progress, and Abort lets the owner of the job's resource record that the
+// upload failed, whichever stage it failed in. Errors returned by Validate
+// and Process are shown to the user.
+type Processor interface {
+	Validate(ctx context.Context, job *entities.UploadJob, data []byte) error
+	Process(ctx context.Context, job *entities.UploadJob, data []byte, progress ProgressFunc) error
+	Abort(ctx context.Context, job *entities.UploadJob, reason string)
+}
+
+type ProgressFunc func(processed, total int)
+
+// Task is a queued upload together with the file it carries.
+type Task struct {
+	Job  *entities.UploadJob
+	data []byte
+}
+
+type UploadService interface {
+	Register(kind entities.UploadKind, processor Processor)
+	Submit(ctx context.Context, userID uint, kind entities.UploadKind, filename string, data []byte, resourceID *uint) (*entities.UploadJob, error)
+	GetStatus(ctx context.Context, userID, uploadID uint) (*dto.UploadStatusResponse, error)
+
+	Tasks() <-chan *Task
+	Run(ctx context.Context, task *Task) error
+	FailStale(ctx context.Context, staleBefore time.Time) (int, error)
+	QueueDepth() int64
+	DeadLetterCount() int64
+}
+
+// pipelineStages are the stages reported by the status endpoint, in order.
+var pipelineStages = []struct {
+	name  string
+	stage entities.UploadStage
+}{
+	{"validation", entities.UploadValidating},
+	{"scanning", entities.UploadScanning},
+	{"processing", entities.UploadProcessing},
+}
+
+type uploadService struct {
+	uploadRepo repositories.UploadJobRepository
+	scanner    scanner.Scanner
+	maxBytes   int64
+	logger     logger.Logger
+
+	mu         sync.RWMutex
+	processors map[entities.UploadKind]Processor
+	queue      chan *Task
+	failed     int64
+}
+
+// NewUploadService keeps accepted files in a bounded in-memory queue that
+// the upload pipeline workers drain. Files are never written to storage, so
+// a job queued on an instance that stops is failed by the stale sweep.
+func NewUploadService(
+	uploadRepo repositories.UploadJobRepository,
+	scanner scanner.Scanner,
+	queueSize int,
+	maxBytes int64,
+	logger logger.Logger,
+) UploadService {
+	if queueSize <= 0 {
+		queueSize = 100
+	}
+	return &uploadService{
+		uploadRepo: uploadRepo,
+		scanner:    scanner,
+		maxBytes:   maxBytes,
+		logger:     logger,
+		processors: make(map[entities.UploadKind]Processor),
+		queue:      make(chan *Task, queueSize),
+	}
+}
+
+func (s *uploadService) Register(kind entities.UploadKind, processor Processor) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.processors[kind] = processor
+}
+
+func (s *uploadService) processor(kind entities.UploadKind) Processor {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.processors[kind]
+}
+
+func (s *uploadService) Submit(ctx context.Context, userID uint, kind entities.UploadKind, filename string, data []byte, resourceID *uint) (*entities.UploadJob, error) {
+	if s.processor(kind) == nil {
+		return nil, errors.New("unsupported upload kind")
+	}
+	if len(data) == 0 {
+		return nil, errors.New("file is empty")
+	}
+	if s.maxBytes > 0 && int64(len(data)) > s.maxBytes {
+		return nil, errors.New("file too large")
+	}
+
+	job := &entities.UploadJob{
+		UserID:     userID,
+		Kind:       kind,
+		ResourceID: resourceID,
+		Filename:   filename,
+		Size:       int64(len(data)),
+		Stage:      entities.UploadQueued,
+	}
+	if err := s.uploadRepo.Create(ctx, job); err != nil {
+		s.logger.Error("Failed to create upload job", "error", err)
+		return nil, errors.New("failed to accept upload")
+	}
+
+	queued := *job
+	select {
+	case s.queue <- &Task{Job: &queued, data: data}:
+		return job, nil
+	default:
+		s.fail(ctx, job, "upload queue is full, try again later")
+		return nil, errors.New("upload queue full")
+	}
+}
+
+func (s *uploadService) GetStatus(ctx context.Context, userID, uploadID uint) (*dto.UploadStatusResponse, error) {
+	job, err := s.uploadRepo.GetByID(ctx, uploadID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("upload not found")
+		}
+		s.logger.Error("Failed to get upload job", "error", err)
+		return nil, errors.New("failed to get upload status")
+	}
+	if job.UserID != userID {
+		return nil, errors.New("upload not found")
+	}
+
+	return mapUploadToResponse(job), nil
+}
+
+func (s *uploadService) Tasks() <-chan *Task {
+	return s.queue
+}
+
+// Run takes one task through validation, scanning and processing, saving
+// the job at every stage so the status endpoint can follow it from any
+// instance. It returns an error when the job fails.
+func (s *uploadService) Run(ctx context.Context, task *Task) error {
+	job := task.Job
+
+	// The stale sweep may have failed the job while it waited in the queue.
+	if current, err := s.uploadRepo.GetByID(ctx, job.ID); err == nil && current.Finished() {
+		return errors.New(current.Error)
+	}
+
+	processor := s.processor(job.Kind)
+	if processor == nil {
+		return s.fail(ctx, job, "unsupported upload kind")
+	}
+
+	if err := s.advance(ctx, job, entities.UploadValidating); err != nil {
+		return err
+	}
+	if err := processor.Validate(ctx, job, task.data); err != nil {
+		return s.fail(ctx, job, err.Error())
+	}
+	validatedAt := time.Now()
+	job.ValidatedAt = &validatedAt
+
+	if err := s.advance(ctx, job, entities.UploadScanning); err != nil {
+		return err
+	}
+	if err := s.scanner.ScanData(ctx, job.Filename, task.data); err != nil {
+		s.logger.Warn("Upload rejected by scanner", "error", err, "upload_id", job.ID, "filename", job.Filename)
+		return s.fail(ctx, job, "file failed security scan")
+	}
+	scannedAt := time.Now()
+	job.ScannedAt = &scannedAt
+
+	if err := s.advance(ctx, job, entities.UploadProcessing); err != nil {
+		return err
+	}
+	err := processor.Process(ctx, job, task.data, func(processed, total int) {
+		job.ProcessedItems, job.TotalItems = processed, total
+		if err := s.uploadRepo.UpdateProgress(ctx, job.ID, processed, total); err != nil {
+			s.logger.Warn("Failed to update upload progress", "error", err, "upload_id", job.ID)
+		}
+	})
+	if err != nil {
+		return s.fail(ctx, job, err.Error())
+	}
+
+	completedAt := time.Now()
+	job.Stage = entities.UploadCompleted
+	job.CompletedAt = &completedAt
+	if err := s.uploadRepo.Update(ctx, job); err != nil {
+		s.logger.Error("Failed to complete upload job", "error", err, "upload_id", job.ID)
+		return err
+	}
+	return nil
+}
+
+func (s *uploadService) advance(ctx context.Context, job *entities.UploadJob, stage entities.UploadStage) error {
+	job.Stage = stage
+	if err := s.uploadRepo.Update(ctx, job); err != nil {
+		s.logger.Error("Failed to update upload stage", "error", err, "upload_id", job.ID, "stage", stage)
+		return s.fail(ctx, job, "failed to process upload")
+	}
+	return nil
+}
+
+// fail records where the job stopped and tells its processor. It returns
+// reason as an error for the caller to pass on.
+func (s *uploadService) fail(ctx context.Context, job *entities.UploadJob, reason string) error {
+	now := time.Now()
+	job.FailedStage = job.Stage
+	job.Stage = entities.UploadFailed
+	job.Error = reason
+	job.CompletedAt = &now
+	if err := s.uploadRepo.Update(ctx, job); err != nil {
+		s.logger.Error("Failed to record upload failure", "error", err, "upload_id", job.ID)
+	}
+
+	if processor := s.processor(job.Kind); processor != nil {
+		processor.Abort(ctx, job, reason)
+	}
+	atomic.AddInt64(&s.failed, 1)
+	return errors.New(reason)
+}
+
+func (s *uploadService) FailStale(ctx context.Context, staleBefore time.Time) (int, error) {
+	jobs, err := s.uploadRepo.FailStale(ctx, staleBefore, "upload processing was interrupted, please upload the file again")
+	if err != nil {
+		return 0, err
+	}
+
+	for _, job := range jobs {
+		if processor := s.processor(job.Kind); processor != nil {
+			processor.Abort(ctx, job, job.Error)
+		}
+	}
+	atomic.AddInt64(&s.failed, int64(len(jobs)))
+	return len(jobs), nil
+}
+
+func (s *uploadService) QueueDepth() int64 {
+	return int64(len(s.queue))
+}
+
+// DeadLetterCount is the number of uploads this instance has failed.
+func (s *uploadService) DeadLetterCount() int64 {
+	return atomic.LoadInt64(&s.failed)
+}
+
+func mapUploadToResponse(job *entities.UploadJob) *dto.UploadStatusResponse {
+	current := stageIndex(job.Stage)
+	if job.Stage == entities.UploadFailed {
+		current = stageIndex(job.FailedStage)
+	}
+
+	stages := make([]dto.UploadStageResponse, 0, len(pipelineStages))
+	for i, stage := range pipelineStages {
+		status := dto.StagePending
+		switch {
+		case i < current:
+			status = dto.StageCompleted
+		case i == current && job.Stage == entities.UploadFailed:
+			status = dto.StageFailed
+		case i == current:
+			status = dto.StageInProgress
+		}
+
+		var completedAt *time.Time
+		if status == dto.StageCompleted {
+			switch stage.stage {
+			case entities.UploadValidating:
+				completedAt = job.ValidatedAt
+			case entities.UploadScanning:
+				completedAt = job.ScannedAt
+			case entities.UploadProcessing:
+				completedAt = job.CompletedAt
+			}
+		}
+
+		stages = append(stages, dto.UploadStageResponse{Name: stage.name, Status: status, CompletedAt: completedAt})
+	}
+
+	progress := 0.0
+	if job.Stage == entities.UploadCompleted {
+		progress = 100
+	} else if job.TotalItems > 0 {
+		progress = float64(job.ProcessedItems) / float64(job.TotalItems) * 100
+	}
+
+	return &dto.UploadStatusResponse{
+		ID:             job.ID,
+		Kind:           job.Kind,
+		ResourceID:     job.ResourceID,
+		Filename:       job.Filename,
+		Size:           job.Size,
+		Stage:          job.Stage,
+		Stages:         stages,
+		TotalItems:     job.TotalItems,
+		ProcessedItems: job.ProcessedItems,
+		Progress:       progress,
+		Error:          job.Error,
+		CreatedAt:      job.CreatedAt,
+		UpdatedAt:      job.UpdatedAt,
+		CompletedAt:    job.CompletedAt,
+	}
+}
+
+// stageIndex is the position of stage in pipelineStages: -1 before the
+// pipeline starts, len(pipelineStages) once it is done.
+func stageIndex(stage entities.UploadStage) int {
+	if stage == entities.UploadCompleted {
+		return len(pipelineStages)
+	}
+	for i, s := range pipelineStages {
+		if s.stage == stage {
+			return i
+		}
+	}
+	return -1
+}
@@ -1,45 +1,311 @@
 package dto
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	apiKeyDto "linked-clone/internal/api/apikey/dto"
+	inviteDto "linked-clone/internal/api/invite/dto"
 	"linked-clone/internal/domain/entities"
+	"linked-clone/pkg/presence"
 	"time"
 )
 
 type UpdateProfileRequest struct {
-	FullName string `json:"full_name" validate:"omitempty,min=2,max=100"`
-	Bio      string `json:"bio" validate:"omitempty,max=500"`
-	Location string `json:"location" validate:"omitempty,max=100"`
-	Website  string `json:"website" validate:"omitempty,url"`
+	FullName          string   `json:"full_name" validate:"omitempty,min=2,max=100"`
+	Bio               string   `json:"bio" validate:"omitempty,max=500"`
+	Location          string   `json:"location" validate:"omitempty,max=100"`
+	LocationID        *uint    `json:"location_id"`
+	IndustryID        *uint    `json:"industry_id"`
+	Website           string   `json:"website" validate:"omitempty,url"`
+	ProfileAltText    *string  `json:"profile_alt_text" validate:"omitempty,max=1000"`
+	Headline          *string  `json:"headline" validate:"omitempty,max=200"`
+	Skills            []string `json:"skills" validate:"omitempty,max=50,dive,min=1,max=50"`
+	YearsOfExperience *int     `json:"years_of_experience" validate:"omitempty,min=0,max=70"`
+	OpenToWork        *bool    `json:"open_to_work"`
+	RecruiterVisible  *bool    `json:"recruiter_visible"`
+	ShowPresence      *bool    `json:"show_presence"`
+	DateOfBirth       *string  `json:"date_of_birth" validate:"omitempty,datetime=2006-01-02"`
+	Region            *string  `json:"region" validate:"omitempty,iso3166_1_alpha2"`
+}
+
+type ExperienceRequest struct {
+	Title       string  `json:"title" validate:"required,min=2,max=200"`
+	Company     string  `json:"company" validate:"required,min=1,max=200"`
+	Location    string  `json:"location" validate:"omitempty,max=100"`
+	LocationID  *uint   `json:"location_id"`
+	Description string  `json:"description" validate:"omitempty,max=2000"`
+	StartDate   string  `json:"start_date" validate:"required,datetime=2006-01-02"`
+	EndDate     *string `json:"end_date" validate:"omitempty,datetime=2006-01-02"`
+}
+
+type ProfileSectionRequest struct {
+	Type    entities.ProfileSectionType `json:"type" validate:"required,oneof=projects publications volunteering languages"`
+	Payload json.RawMessage             `json:"payload" validate:"required"`
+}
+
+type SectionOrderRequest struct {
+	Sections []string `json:"sections" validate:"required,min=1,max=20,unique,dive,required"`
+}
+
+// SectionPayload is the typed body of a custom profile section entry. Period
+// returns the entry's start and end dates, if it has any.
+type SectionPayload interface {
+	Period() (string, string)
+}
+
+type ProjectPayload struct {
+	Name        string `json:"name" validate:"required,max=200"`
+	Description string `json:"description,omitempty" validate:"omitempty,max=2000"`
+	URL         string `json:"url,omitempty" validate:"omitempty,url,max=500"`
+	StartDate   string `json:"start_date,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	EndDate     string `json:"end_date,omitempty" validate:"omitempty,datetime=2006-01-02"`
+}
+
+func (p *ProjectPayload) Period() (string, string) { return p.StartDate, p.EndDate }
+
+type PublicationPayload struct {
+	Title       string `json:"title" validate:"required,max=200"`
+	Publisher   string `json:"publisher,omitempty" validate:"omitempty,max=200"`
+	URL         string `json:"url,omitempty" validate:"omitempty,url,max=500"`
+	PublishedOn string `json:"published_on,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	Description string `json:"description,omitempty" validate:"omitempty,max=2000"`
+}
+
+func (p *PublicationPayload) Period() (string, string) { return p.PublishedOn, "" }
+
+type VolunteeringPayload struct {
+	Organization string `json:"organization" validate:"required,max=200"`
+	Role         string `json:"role" validate:"required,max=200"`
+	Cause        string `json:"cause,omitempty" validate:"omitempty,max=100"`
+	Description  string `json:"description,omitempty" validate:"omitempty,max=2000"`
+	StartDate    string `json:"start_date,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	EndDate      string `json:"end_date,omitempty" validate:"omitempty,datetime=2006-01-02"`
+}
+
+func (p *VolunteeringPayload) Period() (string, string) { return p.StartDate, p.EndDate }
+
+type LanguagePayload struct {
+	Name        string `json:"name" validate:"required,max=50"`
+	Proficiency string `json:"proficiency" validate:"required,oneof=elementary limited_working professional_working full_professional native"`
+}
+
+func (p *LanguagePayload) Period() (string, string) { return "", "" }
+
+// DecodeSectionPayload parses raw into the payload type for sectionType,
+// rejecting fields that type does not define.
+func DecodeSectionPayload(sectionType entities.ProfileSectionType, raw json.RawMessage) (SectionPayload, error) {
+	var payload SectionPayload
+	switch sectionType {
+	case entities.ProfileSectionProjects:
+		payload = &ProjectPayload{}
+	case entities.ProfileSectionPublications:
+		payload = &PublicationPayload{}
+	case entities.ProfileSectionVolunteering:
+		payload = &VolunteeringPayload{}
+	case entities.ProfileSectionLanguages:
+		payload = &LanguagePayload{}
+	default:
+		return nil, errors.New("invalid section type")
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+type TalentSearchRequest struct {
+	Skills        []string
+	Title         string `validate:"omitempty,max=200"`
+	Location      string `validate:"omitempty,max=100"`
+	LocationID    *uint
+	IndustryID    *uint
+	MinExperience *int `validate:"omitempty,min=0,max=70"`
+	MaxExperience *int `validate:"omitempty,min=0,max=70"`
+	OpenToWork    *bool
+}
+
+type UploadProfilePictureRequest struct {
+	AltText string `form:"alt_text" validate:"omitempty,max=1000"`
 }
 
 type UserProfileResponse struct {
-	ID             uint      `json:"id"`
-	Email          string    `json:"email"`
-	Username       string    `json:"username"`
-	FullName       string    `json:"full_name"`
-	ProfilePicture string    `json:"profile_picture,omitempty"`
-	Bio            string    `json:"bio,omitempty"`
-	Location       string    `json:"location,omitempty"`
-	Website        string    `json:"website,omitempty"`
-	IsVerified     bool      `json:"is_verified"`
-	IsPremium      bool      `json:"is_premium"`
-	CreatedAt      time.Time `json:"created_at"`
+	ID                 uint                      `json:"id"`
+	Email              string                    `json:"email"`
+	Username           string                    `json:"username"`
+	FullName           string                    `json:"full_name"`
+	ProfilePicture     string                    `json:"profile_picture,omitempty"`
+	ProfileThumbnail   string                    `json:"profile_thumbnail,omitempty"`
+	ProfileAltText     string                    `json:"profile_alt_text,omitempty"`
+	ProfileBanner      string                    `json:"profile_banner,omitempty"`
+	ProfileBannerLarge string                    `json:"profile_banner_large,omitempty"`
+	ProfileBannerSmall string                    `json:"profile_banner_small,omitempty"`
+	Bio                string                    `json:"bio,omitempty"`
+	Location           string                    `json:"location,omitempty"`
+	LocationID         *uint                     `json:"location_id,omitempty"`
+	Industry           string                    `json:"industry,omitempty"`
+	IndustryID         *uint                     `json:"industry_id,omitempty"`
+	Website            string                    `json:"website,omitempty"`
+	Headline           string                    `json:"headline,omitempty"`
+	Skills             []string                  `json:"skills"`
+	Badges             []*SkillBadgeResponse     `json:"badges"`
+	Certificates       []*CertificateResponse    `json:"certificates"`
+	Recommendations    []*RecommendationResponse `json:"recommendations"`
+	Sections           []*ProfileSectionGroup    `json:"sections"`
+	SectionOrder       []string                  `json:"section_order"`
+	YearsOfExperience  int                       `json:"years_of_experience"`
+	OpenToWork         bool                      `json:"open_to_work"`
+	RecruiterVisible   bool                      `json:"recruiter_visible"`
+	ShowPresence       bool                      `json:"show_presence"`
+	EmailVerified      bool                      `json:"email_verified"`
+	PhoneNumber        string                    `json:"phone_number,omitempty"`
+	PhoneVerifiedAt    *time.Time                `json:"phone_verified_at,omitempty"`
+	IsVerified         bool                      `json:"is_verified"`
+	IsPremium          bool                      `json:"is_premium"`
+	Plan               entities.Plan             `json:"plan"`
+	CreatedAt          time.Time                 `json:"created_at"`
 }
 
 type UserResponse struct {
-	ID             uint   `json:"id"`
-	Username       string `json:"username"`
-	FullName       string `json:"full_name"`
-	ProfilePicture string `json:"profile_picture,omitempty"`
-	Bio            string `json:"bio,omitempty"`
-	Location       string `json:"location,omitempty"`
-	Website        string `json:"website,omitempty"`
-	IsVerified     bool   `json:"is_verified"`
-	IsPremium      bool   `json:"is_premium"`
+	ID                     uint                      `json:"id"`
+	Username               string                    `json:"username"`
+	FullName               string                    `json:"full_name"`
+	ProfilePicture         string                    `json:"profile_picture,omitempty"`
+	ProfileAltText         string                    `json:"profile_alt_text,omitempty"`
+	ProfileBanner          string                    `json:"profile_banner,omitempty"`
+	ProfileBannerLarge     string                    `json:"profile_banner_large,omitempty"`
+	ProfileBannerSmall     string                    `json:"profile_banner_small,omitempty"`
+	Bio                    string                    `json:"bio,omitempty"`
+	Location               string                    `json:"location,omitempty"`
+	LocationID             *uint                     `json:"location_id,omitempty"`
+	Industry               string                    `json:"industry,omitempty"`
+	IndustryID             *uint                     `json:"industry_id,omitempty"`
+	Website                string                    `json:"website,omitempty"`
+	IsVerified             bool                      `json:"is_verified"`
+	IsPremium              bool                      `json:"is_premium"`
+	Badges                 []*SkillBadgeResponse     `json:"badges"`
+	Certificates           []*CertificateResponse    `json:"certificates"`
+	Recommendations        []*RecommendationResponse `json:"recommendations"`
+	Sections               []*ProfileSectionGroup    `json:"sections"`
+	SectionOrder           []string                  `json:"section_order"`
+	MutualConnectionsCount *int                      `json:"mutual_connections_count,omitempty"`
+	Presence               *PresenceResponse         `json:"presence,omitempty"`
+}
+
+type SkillBadgeResponse struct {
+	AssessmentID uint      `json:"assessment_id"`
+	Skill        string    `json:"skill"`
+	Score        int       `json:"score"`
+	EarnedAt     time.Time `json:"earned_at"`
+}
+
+type CertificateResponse struct {
+	Code        string    `json:"code"`
+	CourseID    uint      `json:"course_id"`
+	CourseTitle string    `json:"course_title"`
+	IssuedAt    time.Time `json:"issued_at"`
+}
+
+type RecommendationResponse struct {
+	ID             uint       `json:"id"`
+	AuthorID       uint       `json:"author_id"`
+	AuthorName     string     `json:"author_name"`
+	AuthorHeadline string     `json:"author_headline,omitempty"`
+	Relationship   string     `json:"relationship,omitempty"`
+	Body           string     `json:"body"`
+	ApprovedAt     *time.Time `json:"approved_at,omitempty"`
+}
+
+type PresenceResponse struct {
+	Status       presence.Status `json:"status"`
+	LastActiveAt *time.Time      `json:"last_active_at,omitempty"`
+}
+
+type TalentResponse struct {
+	ID                uint     `json:"id"`
+	Username          string   `json:"username"`
+	FullName          string   `json:"full_name"`
+	ProfilePicture    string   `json:"profile_picture,omitempty"`
+	ProfileAltText    string   `json:"profile_alt_text,omitempty"`
+	Headline          string   `json:"headline,omitempty"`
+	Location          string   `json:"location,omitempty"`
+	LocationID        *uint    `json:"location_id,omitempty"`
+	Industry          string   `json:"industry,omitempty"`
+	IndustryID        *uint    `json:"industry_id,omitempty"`
+	Skills            []string `json:"skills"`
+	YearsOfExperience int      `json:"years_of_experience"`
+	OpenToWork        bool     `json:"open_to_work"`
+	IsVerified        bool     `json:"is_verified"`
+}
+
+type ExperienceResponse struct {
+	ID          uint       `json:"id"`
+	Title       string     `json:"title"`
+	Company     string     `json:"company"`
+	Location    string     `json:"location,omitempty"`
+	LocationID  *uint      `json:"location_id,omitempty"`
+	Description string     `json:"description,omitempty"`
+	StartDate   time.Time  `json:"start_date"`
+	EndDate     *time.Time `json:"end_date,omitempty"`
+	IsCurrent   bool       `json:"is_current"`
+}
+
+type ProfileSectionResponse struct {
+	ID        uint                        `json:"id"`
+	Type      entities.ProfileSectionType `json:"type"`
+	Payload   map[string]interface{}      `json:"payload"`
+	CreatedAt time.Time                   `json:"created_at"`
+	UpdatedAt time.Time                   `json:"updated_at"`
+}
+
+type ProfileSectionGroup struct {
+	Type    entities.ProfileSectionType `json:"type"`
+	Entries []*ProfileSectionResponse   `json:"entries"`
 }
 
 type UploadResponse struct {
-	URL string `json:"url"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	AltText      string `json:"alt_text,omitempty"`
+}
+
+type BannerResponse struct {
+	URL      string `json:"url"`
+	LargeURL string `json:"large_url,omitempty"`
+	SmallURL string `json:"small_url,omitempty"`
+}
+
+// UsageResponse covers the resources the service keeps per account. Plans
+// do not cap storage or connection invitations yet, so only their current
+// usage is reported. Invites are the registration codes from /invites,
+// counted against the invite quota, and APIKeys shows each active key's
+// requests in the current rate limit window.
+type UsageResponse struct {
+	Plan        PlanUsageResponse                `json:"plan"`
+	Storage     StorageUsageResponse             `json:"storage"`
+	Invitations InvitationUsageResponse          `json:"invitations"`
+	Invites     *inviteDto.QuotaResponse         `json:"invites"`
+	APIKeys     []*apiKeyDto.APIKeyUsageResponse `json:"api_keys"`
+}
+
+type PlanUsageResponse struct {
+	Name         entities.Plan          `json:"name"`
+	PremiumUntil *time.Time             `json:"premium_until,omitempty"`
+	Entitlements []entities.Entitlement `json:"entitlements"`
+}
+
+// StorageUsageResponse counts the files the user has sent in messages,
+// the only uploads kept with a recorded size.
+type StorageUsageResponse struct {
+	AttachmentCount int64 `json:"attachment_count"`
+	UsedBytes       int64 `json:"used_bytes"`
+}
+
+type InvitationUsageResponse struct {
+	PendingSent int64 `json:"pending_sent"`
 }
 
 type ConnectionRequest struct {
@@ -58,6 +324,55 @@ type ConnectionResponse struct {
 	CreatedAt   time.Time                 `json:"created_at"`
 }
 
+type BulkConnectionActionRequest struct {
+	ConnectionIDs []uint `json:"connection_ids" validate:"required,min=1,max=100,dive,required"`
+	Action        string `json:"action" validate:"required,oneof=accept reject"`
+}
+
+type BulkConnectionFailure struct {
+	ConnectionID uint   `json:"connection_id"`
+	Error        string `json:"error"`
+}
+
+type BulkConnectionActionResponse struct {
+	Action    string                  `json:"action"`
+	Processed []uint                  `json:"processed"`
+	Failed    []BulkConnectionFailure `json:"failed"`
+}
+
+type ConnectionImportResponse struct {
+	ID            uint                             `json:"id"`
+	UploadID      *uint                            `json:"upload_id,omitempty"`
+	Filename      string                           `json:"filename"`
+	Status        entities.ConnectionImportStatus  `json:"status"`
+	TotalRows     int                              `json:"total_rows"`
+	ProcessedRows int                              `json:"processed_rows"`
+	Progress      float64                          `json:"progress"`
+	Matches       []entities.ConnectionImportMatch `json:"matches"`
+	Error         string                           `json:"error,omitempty"`
+	StartedAt     *time.Time                       `json:"started_at,omitempty"`
+	CompletedAt   *time.Time                       `json:"completed_at,omitempty"`
+	CreatedAt     time.Time                        `json:"created_at"`
+}
+
+type ConnectionDegreeResponse struct {
+	UserID uint `json:"user_id"`
+	Degree int  `json:"degree"`
+}
+
+type ConnectionSuggestionResponse struct {
+	User              *UserResponse                       `json:"user"`
+	Reason            entities.ConnectionSuggestionReason `json:"reason"`
+	Score             int                                 `json:"score"`
+	MutualCount       int                                 `json:"mutual_count"`
+	SharedLocation    bool                                `json:"shared_location"`
+	RecentInteraction bool                                `json:"recent_interaction"`
+	Company           string                              `json:"company,omitempty"`
+	OverlapStart      *time.Time                          `json:"overlap_start,omitempty"`
+	OverlapEnd        *time.Time                          `json:"overlap_end,omitempty"`
+	OverlapDays       int                                 `json:"overlap_days,omitempty"`
+}
+
 type ConnectionStatusUpdate struct {
 	Status entities.ConnectionStatus `json:"status" validate:"required,oneof=accepted blocked"`
 }
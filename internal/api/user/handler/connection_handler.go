@@ -71,6 +71,30 @@ func (h *ConnectionHandler) AcceptConnectionRequest(c *gin.Context) {
 	response.Success(c, connection)
 }
 
+func (h *ConnectionHandler) BulkRespondToRequests(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	var req dto.BulkConnectionActionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	result, err := h.connectionService.BulkRespondToRequests(c.Request.Context(), userID, &req)
+	if err != nil {
+		h.logger.Error("Failed to process connection requests", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to process connection requests", err.Error())
+		return
+	}
+
+	response.Success(c, result)
+}
+
 func (h *ConnectionHandler) RejectConnectionRequest(c *gin.Context) {
 	userID := middleware.GetUserID(c)
 
@@ -209,13 +233,107 @@ func (h *ConnectionHandler) GetMutualConnections(c *gin.Context) {
 		return
 	}
 
+	total, err := h.connectionService.GetMutualConnectionsCount(c.Request.Context(), userID, uint(targetUserID))
+	if err != nil {
+		h.logger.Error("Failed to count mutual connections", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get mutual connections", err.Error())
+		return
+	}
+
 	response.Success(c, gin.H{
 		"mutual_connections": connections,
+		"total":              total,
 		"limit":              limit,
 		"offset":             offset,
 	})
 }
 
+func (h *ConnectionHandler) GetConnectionDegree(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	userIDStr := c.Param("userId")
+	targetUserID, err := strconv.ParseUint(userIDStr, 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid user ID", err.Error())
+		return
+	}
+
+	degree, err := h.connectionService.GetConnectionDegree(c.Request.Context(), userID, uint(targetUserID))
+	if err != nil {
+		h.logger.Error("Failed to get connection degree", "error", err)
+		status := http.StatusInternalServerError
+		if err.Error() == "target user not found" {
+			status = http.StatusNotFound
+		}
+		response.Error(c, status, "Failed to get connection degree", err.Error())
+		return
+	}
+
+	response.Success(c, degree)
+}
+
+func (h *ConnectionHandler) ExportConnections(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	data, err := h.connectionService.ExportConnections(c.Request.Context(), userID)
+	if err != nil {
+		h.logger.Error("Failed to export connections", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to export connections", err.Error())
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="connections.csv"`)
+	c.Data(http.StatusOK, "text/csv", data)
+}
+
+func (h *ConnectionHandler) ImportConnections(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Import file is required", err.Error())
+		return
+	}
+
+	connectionImport, err := h.connectionService.StartImport(c.Request.Context(), userID, file)
+	if err != nil {
+		h.logger.Error("Failed to import connections", "error", err)
+		status := http.StatusInternalServerError
+		switch err.Error() {
+		case "file is empty", "file too large":
+			status = http.StatusBadRequest
+		case "upload queue full":
+			status = http.StatusServiceUnavailable
+		}
+		response.Error(c, status, "Failed to import connections", err.Error())
+		return
+	}
+
+	response.AcceptedWithMessage(c, "Import started", connectionImport)
+}
+
+func (h *ConnectionHandler) GetImport(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	importID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid import ID", err.Error())
+		return
+	}
+
+	connectionImport, err := h.connectionService.GetImport(c.Request.Context(), userID, uint(importID))
+	if err != nil {
+		status := http.StatusInternalServerError
+		if err.Error() == "connection import not found" {
+			status = http.StatusNotFound
+		}
+		response.Error(c, status, "Failed to get connection import", err.Error())
+		return
+	}
+
+	response.Success(c, connectionImport)
+}
+
 func (h *ConnectionHandler) BlockUser(c *gin.Context) {
 	userID := middleware.GetUserID(c)
 
@@ -253,3 +371,52 @@ func (h *ConnectionHandler) UnblockUser(c *gin.Context) {
 
 	response.Success(c, gin.H{"message": "User unblocked successfully"})
 }
+
+func (h *ConnectionHandler) GetSuggestions(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if limit <= 0 || limit > 50 {
+		limit = 10
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	suggestions, err := h.connectionService.GetSuggestions(c.Request.Context(), userID, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to get connection suggestions", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get connection suggestions", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{
+		"suggestions": suggestions,
+		"limit":       limit,
+		"offset":      offset,
+	})
+}
+
+func (h *ConnectionHandler) DismissSuggestion(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	userIDStr := c.Param("userId")
+	suggestedUserID, err := strconv.ParseUint(userIDStr, 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid user ID", err.Error())
+		return
+	}
+
+	if err := h.connectionService.DismissSuggestion(c.Request.Context(), userID, uint(suggestedUserID)); err != nil {
+		h.logger.Error("Failed to dismiss connection suggestion", "error", err)
+		status := http.StatusInternalServerError
+		if err.Error() == "suggestion not found" {
+			status = http.StatusNotFound
+		}
+		response.Error(c, status, "Failed to dismiss suggestion", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Suggestion dismissed"})
+}
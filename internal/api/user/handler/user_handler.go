@@ -1,14 +1,18 @@
 package handler
 
 import (
+	"errors"
 	"linked-clone/internal/api/user/dto"
 	"linked-clone/internal/api/user/service"
 	"linked-clone/internal/middleware"
+	"linked-clone/pkg/imaging"
 	"linked-clone/pkg/logger"
+	"linked-clone/pkg/moderation"
 	"linked-clone/pkg/response"
 	validation "linked-clone/pkg/validator"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -30,6 +34,12 @@ func NewUserHandler(userService service.UserService, validator validation.Valida
 func (h *UserHandler) GetProfile(c *gin.Context) {
 	userID := middleware.GetUserID(c)
 
+	fields, err := response.ParseFields(c.Query("fields"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid fields parameter", err.Error())
+		return
+	}
+
 	profile, err := h.userService.GetProfile(c.Request.Context(), userID)
 	if err != nil {
 		h.logger.Error("Failed to get profile", "error", err)
@@ -37,7 +47,22 @@ func (h *UserHandler) GetProfile(c *gin.Context) {
 		return
 	}
 
-	response.Success(c, profile)
+	response.Success(c, response.Project(profile, fields))
+}
+
+func (h *UserHandler) GetUsage(c *gin.Context) {
+	usage, err := h.userService.GetUsage(c.Request.Context(), middleware.GetUserID(c))
+	if err != nil {
+		h.logger.Error("Failed to get usage", "error", err)
+		if err.Error() == "user not found" {
+			response.Error(c, http.StatusNotFound, "User not found", "")
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to get usage", err.Error())
+		return
+	}
+
+	response.Success(c, usage)
 }
 
 func (h *UserHandler) UpdateProfile(c *gin.Context) {
@@ -67,15 +92,30 @@ func (h *UserHandler) UpdateProfile(c *gin.Context) {
 func (h *UserHandler) UploadProfilePicture(c *gin.Context) {
 	userID := middleware.GetUserID(c)
 
+	var req dto.UploadProfilePictureRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
 	file, err := c.FormFile("image")
 	if err != nil {
 		response.Error(c, http.StatusBadRequest, "No image file provided", err.Error())
 		return
 	}
 
-	result, err := h.userService.UploadProfilePicture(c.Request.Context(), userID, file)
+	result, err := h.userService.UploadProfilePicture(c.Request.Context(), userID, &req, file)
 	if err != nil {
 		h.logger.Error("Failed to upload profile picture", "error", err)
+		if errors.Is(err, moderation.ErrImageRejected) {
+			response.ErrorWithCode(c, http.StatusUnprocessableEntity, response.ErrCodeContentRejected, "Image rejected by moderation", err.Error())
+			return
+		}
 		response.Error(c, http.StatusInternalServerError, "Upload failed", err.Error())
 		return
 	}
@@ -83,6 +123,47 @@ func (h *UserHandler) UploadProfilePicture(c *gin.Context) {
 	response.Success(c, result)
 }
 
+func (h *UserHandler) UploadProfileBanner(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	file, err := c.FormFile("image")
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "No image file provided", err.Error())
+		return
+	}
+
+	result, err := h.userService.UploadProfileBanner(c.Request.Context(), userID, file)
+	if err != nil {
+		h.logger.Error("Failed to upload profile banner", "error", err)
+		switch {
+		case errors.Is(err, moderation.ErrImageRejected):
+			response.ErrorWithCode(c, http.StatusUnprocessableEntity, response.ErrCodeContentRejected, "Image rejected by moderation", err.Error())
+		case errors.Is(err, imaging.ErrBannerDimensions), err.Error() == "invalid image":
+			response.Error(c, http.StatusBadRequest, "Invalid banner image", err.Error())
+		default:
+			response.Error(c, http.StatusInternalServerError, "Upload failed", err.Error())
+		}
+		return
+	}
+
+	response.Success(c, result)
+}
+
+func (h *UserHandler) DeleteProfileBanner(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	if err := h.userService.DeleteProfileBanner(c.Request.Context(), userID); err != nil {
+		if err.Error() == "profile banner not found" {
+			response.NotFound(c, "Profile banner not found")
+			return
+		}
+		response.Error(c, http.StatusInternalServerError, "Failed to remove profile banner", err.Error())
+		return
+	}
+
+	response.SuccessWithMessage(c, "Profile banner removed", nil)
+}
+
 func (h *UserHandler) SearchUsers(c *gin.Context) {
 	query := c.Query("q")
 	if query == "" {
@@ -93,7 +174,7 @@ func (h *UserHandler) SearchUsers(c *gin.Context) {
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
 
-	users, err := h.userService.SearchUsers(c.Request.Context(), query, limit, offset)
+	users, err := h.userService.SearchUsers(c.Request.Context(), middleware.GetUserID(c), query, limit, offset)
 	if err != nil {
 		h.logger.Error("Failed to search users", "error", err)
 		response.Error(c, http.StatusInternalServerError, "Search failed", err.Error())
@@ -108,6 +189,71 @@ func (h *UserHandler) SearchUsers(c *gin.Context) {
 	})
 }
 
+func (h *UserHandler) SearchTalent(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+
+	req := dto.TalentSearchRequest{
+		Title:    c.Query("title"),
+		Location: c.Query("location"),
+	}
+	if skills := c.Query("skills"); skills != "" {
+		req.Skills = strings.Split(skills, ",")
+	}
+	for param, target := range map[string]**int{"min_experience": &req.MinExperience, "max_experience": &req.MaxExperience} {
+		if raw := c.Query(param); raw != "" {
+			value, err := strconv.Atoi(raw)
+			if err != nil {
+				response.Error(c, http.StatusBadRequest, "Invalid "+param, err.Error())
+				return
+			}
+			*target = &value
+		}
+	}
+	for param, target := range map[string]**uint{"location_id": &req.LocationID, "industry_id": &req.IndustryID} {
+		if raw := c.Query(param); raw != "" {
+			value, err := strconv.ParseUint(raw, 10, 32)
+			if err != nil {
+				response.Error(c, http.StatusBadRequest, "Invalid "+param, err.Error())
+				return
+			}
+			id := uint(value)
+			*target = &id
+		}
+	}
+	if raw := c.Query("open_to_work"); raw != "" {
+		openToWork, err := strconv.ParseBool(raw)
+		if err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid open_to_work", err.Error())
+			return
+		}
+		req.OpenToWork = &openToWork
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+
+	candidates, err := h.userService.SearchTalent(c.Request.Context(), userID, &req, limit, offset)
+	if err != nil {
+		h.logger.Error("Failed to search talent", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Talent search failed", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{
+		"candidates": candidates,
+		"limit":      limit,
+		"offset":     offset,
+	})
+}
+
 func (h *UserHandler) GetUserByID(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
@@ -116,12 +262,282 @@ func (h *UserHandler) GetUserByID(c *gin.Context) {
 		return
 	}
 
-	user, err := h.userService.GetUserByID(c.Request.Context(), uint(id))
+	fields, err := response.ParseFields(c.Query("fields"))
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid fields parameter", err.Error())
+		return
+	}
+
+	user, err := h.userService.GetUserByID(c.Request.Context(), middleware.GetUserID(c), uint(id))
 	if err != nil {
 		h.logger.Error("Failed to get user", "error", err)
 		response.Error(c, http.StatusNotFound, "User not found", err.Error())
 		return
 	}
 
-	response.Success(c, user)
+	h.userService.RecordProfileView(c.Request.Context(), uint(id), middleware.GetUserID(c), middleware.GetViewerKey(c))
+
+	response.Success(c, response.Project(user, fields))
+}
+
+func (h *UserHandler) GetExperiences(c *gin.Context) {
+	experiences, err := h.userService.GetExperiences(c.Request.Context(), middleware.GetUserID(c))
+	if err != nil {
+		h.logger.Error("Failed to get experiences", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get experiences", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"experiences": experiences})
+}
+
+func (h *UserHandler) GetUserExperiences(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid user ID", err.Error())
+		return
+	}
+
+	experiences, err := h.userService.GetExperiences(c.Request.Context(), uint(id))
+	if err != nil {
+		h.logger.Error("Failed to get experiences", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get experiences", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"experiences": experiences, "user_id": id})
+}
+
+func (h *UserHandler) AddExperience(c *gin.Context) {
+	var req dto.ExperienceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	experience, err := h.userService.AddExperience(c.Request.Context(), middleware.GetUserID(c), &req)
+	if err != nil {
+		h.logger.Error("Failed to add experience", "error", err)
+		response.Error(c, http.StatusBadRequest, "Failed to add experience", err.Error())
+		return
+	}
+
+	response.Success(c, experience)
+}
+
+func (h *UserHandler) UpdateExperience(c *gin.Context) {
+	experienceID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid experience ID", err.Error())
+		return
+	}
+
+	var req dto.ExperienceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	experience, err := h.userService.UpdateExperience(c.Request.Context(), middleware.GetUserID(c), uint(experienceID), &req)
+	if err != nil {
+		h.logger.Error("Failed to update experience", "error", err)
+
+		if err.Error() == "experience not found" {
+			response.Error(c, http.StatusNotFound, "Experience not found", "")
+			return
+		}
+
+		response.Error(c, http.StatusBadRequest, "Failed to update experience", err.Error())
+		return
+	}
+
+	response.Success(c, experience)
+}
+
+func (h *UserHandler) DeleteExperience(c *gin.Context) {
+	experienceID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid experience ID", err.Error())
+		return
+	}
+
+	if err := h.userService.DeleteExperience(c.Request.Context(), middleware.GetUserID(c), uint(experienceID)); err != nil {
+		h.logger.Error("Failed to delete experience", "error", err)
+
+		if err.Error() == "experience not found" {
+			response.Error(c, http.StatusNotFound, "Experience not found", "")
+			return
+		}
+
+		response.Error(c, http.StatusInternalServerError, "Failed to delete experience", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Experience deleted successfully"})
+}
+
+func (h *UserHandler) GetProfileSections(c *gin.Context) {
+	sections, err := h.userService.GetProfileSections(c.Request.Context(), middleware.GetUserID(c))
+	if err != nil {
+		h.logger.Error("Failed to get profile sections", "error", err)
+		response.Error(c, http.StatusInternalServerError, "Failed to get profile sections", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"sections": sections})
+}
+
+func (h *UserHandler) GetUserProfileSections(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid user ID", err.Error())
+		return
+	}
+
+	sections, err := h.userService.GetProfileSections(c.Request.Context(), uint(id))
+	if err != nil {
+		h.logger.Error("Failed to get profile sections", "error", err)
+
+		if err.Error() == "user not found" {
+			response.Error(c, http.StatusNotFound, "User not found", "")
+			return
+		}
+
+		response.Error(c, http.StatusInternalServerError, "Failed to get profile sections", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"sections": sections, "user_id": id})
+}
+
+func (h *UserHandler) AddProfileSection(c *gin.Context) {
+	req, payload, ok := h.bindProfileSection(c)
+	if !ok {
+		return
+	}
+
+	section, err := h.userService.AddProfileSection(c.Request.Context(), middleware.GetUserID(c), req.Type, payload)
+	if err != nil {
+		h.logger.Error("Failed to add profile section", "error", err)
+
+		if err.Error() == "profile section limit reached" {
+			response.Error(c, http.StatusConflict, "Failed to add profile section", err.Error())
+			return
+		}
+
+		response.Error(c, http.StatusBadRequest, "Failed to add profile section", err.Error())
+		return
+	}
+
+	response.Success(c, section)
+}
+
+func (h *UserHandler) UpdateProfileSection(c *gin.Context) {
+	sectionID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid section ID", err.Error())
+		return
+	}
+
+	req, payload, ok := h.bindProfileSection(c)
+	if !ok {
+		return
+	}
+
+	section, err := h.userService.UpdateProfileSection(c.Request.Context(), middleware.GetUserID(c), uint(sectionID), req.Type, payload)
+	if err != nil {
+		h.logger.Error("Failed to update profile section", "error", err)
+
+		if err.Error() == "profile section not found" {
+			response.Error(c, http.StatusNotFound, "Profile section not found", "")
+			return
+		}
+
+		response.Error(c, http.StatusBadRequest, "Failed to update profile section", err.Error())
+		return
+	}
+
+	response.Success(c, section)
+}
+
+func (h *UserHandler) DeleteProfileSection(c *gin.Context) {
+	sectionID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid section ID", err.Error())
+		return
+	}
+
+	if err := h.userService.DeleteProfileSection(c.Request.Context(), middleware.GetUserID(c), uint(sectionID)); err != nil {
+		h.logger.Error("Failed to delete profile section", "error", err)
+
+		if err.Error() == "profile section not found" {
+			response.Error(c, http.StatusNotFound, "Profile section not found", "")
+			return
+		}
+
+		response.Error(c, http.StatusInternalServerError, "Failed to delete profile section", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Profile section deleted successfully"})
+}
+
+func (h *UserHandler) UpdateSectionOrder(c *gin.Context) {
+	var req dto.SectionOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return
+	}
+
+	order, err := h.userService.UpdateSectionOrder(c.Request.Context(), middleware.GetUserID(c), &req)
+	if err != nil {
+		h.logger.Error("Failed to update section order", "error", err)
+		response.Error(c, http.StatusBadRequest, "Failed to update section order", err.Error())
+		return
+	}
+
+	response.Success(c, gin.H{"section_order": order})
+}
+
+// bindProfileSection validates the envelope and then the payload against the
+// shape for its section type.
+func (h *UserHandler) bindProfileSection(c *gin.Context) (*dto.ProfileSectionRequest, dto.SectionPayload, bool) {
+	var req dto.ProfileSectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid request body", err.Error())
+		return nil, nil, false
+	}
+
+	if err := h.validator.Validate(&req); err != nil {
+		response.ValidationErrors(c, err)
+		return nil, nil, false
+	}
+
+	payload, err := dto.DecodeSectionPayload(req.Type, req.Payload)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, "Invalid section payload", err.Error())
+		return nil, nil, false
+	}
+
+	if err := h.validator.Validate(payload); err != nil {
+		response.ValidationErrors(c, err)
+		return nil, nil, false
+	}
+
+	return &req, payload, true
 }
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type connectionImportRepository struct {
+	db *gorm.DB
+}
+
+func NewConnectionImportRepository(db *gorm.DB) repositories.ConnectionImportRepository {
+	return &connectionImportRepository{db: db}
+}
+
+func (r *connectionImportRepository) Create(ctx context.Context, connectionImport *entities.ConnectionImport) error {
+	return r.db.WithContext(ctx).Create(connectionImport).Error
+}
+
+func (r *connectionImportRepository) GetByID(ctx context.Context, id uint) (*entities.ConnectionImport, error) {
+	var connectionImport entities.ConnectionImport
+	if err := r.db.WithContext(ctx).First(&connectionImport, id).Error; err != nil {
+		return nil, err
+	}
+	return &connectionImport, nil
+}
+
+func (r *connectionImportRepository) Update(ctx context.Context, connectionImport *entities.ConnectionImport) error {
+	return r.db.WithContext(ctx).Save(connectionImport).Error
+}
+
+func (r *connectionImportRepository) UpdateProgress(ctx context.Context, id uint, processedRows int) error {
+	return r.db.WithContext(ctx).Model(&entities.ConnectionImport{}).
+		Where("id = ?", id).
+		Update("processed_rows", processedRows).Error
+}
@@ -2,10 +2,13 @@ package repository
 
 import (
 	"context"
+	"fmt"
 	"linked-clone/internal/domain/entities"
 	"linked-clone/internal/domain/repositories"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type connectionRepository struct {
@@ -94,23 +97,84 @@ func (r *connectionRepository) Delete(ctx context.Context, id uint) error {
 
 func (r *connectionRepository) GetMutualConnections(ctx context.Context, userID1, userID2 uint, limit, offset int) ([]*entities.Connection, error) {
 	var connections []*entities.Connection
+	if userID1 == userID2 {
+		return connections, nil
+	}
 
-	subQuery1 := r.db.Select("CASE WHEN requester_id = ? THEN addressee_id ELSE requester_id END as connected_user", userID1).
-		Where("(requester_id = ? OR addressee_id = ?) AND status = ?", userID1, userID1, entities.ConnectionAccepted).
-		Table("connections")
-
-	subQuery2 := r.db.Select("CASE WHEN requester_id = ? THEN addressee_id ELSE requester_id END as connected_user", userID2).
-		Where("(requester_id = ? OR addressee_id = ?) AND status = ?", userID2, userID2, entities.ConnectionAccepted).
-		Table("connections")
+	connected := r.relatedUserIDsQuery(userID2, entities.ConnectionAccepted)
 
 	err := r.db.WithContext(ctx).
 		Preload("Requester").
 		Preload("Addressee").
-		Where("(requester_id IN (?) OR addressee_id IN (?)) AND status = ?", subQuery1, subQuery1, entities.ConnectionAccepted).
-		Where("(requester_id IN (?) OR addressee_id IN (?))", subQuery2, subQuery2).
+		Where("status = ?", entities.ConnectionAccepted).
+		Where("(requester_id = ? AND addressee_id IN (?)) OR (addressee_id = ? AND requester_id IN (?))",
+			userID1, connected, userID1, connected).
+		Order("id").
 		Limit(limit).
 		Offset(offset).
 		Find(&connections).Error
 
 	return connections, err
 }
+
+func (r *connectionRepository) RespondToRequests(ctx context.Context, addresseeID uint, ids []uint, accept bool) ([]*entities.Connection, error) {
+	var connections []*entities.Connection
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ? AND addressee_id = ? AND status = ?", ids, addresseeID, entities.ConnectionPending).
+			Find(&connections).Error; err != nil {
+			return fmt.Errorf("failed to lock connection requests: %w", err)
+		}
+		if len(connections) == 0 {
+			return nil
+		}
+
+		found := make([]uint, 0, len(connections))
+		for _, connection := range connections {
+			found = append(found, connection.ID)
+		}
+
+		if !accept {
+			if err := tx.Where("id IN ?", found).Delete(&entities.Connection{}).Error; err != nil {
+				return fmt.Errorf("failed to reject connection requests: %w", err)
+			}
+			return nil
+		}
+
+		now := time.Now()
+		if err := tx.Model(&entities.Connection{}).
+			Where("id IN ?", found).
+			Updates(map[string]interface{}{"status": entities.ConnectionAccepted, "accepted_at": now}).Error; err != nil {
+			return fmt.Errorf("failed to accept connection requests: %w", err)
+		}
+		for _, connection := range connections {
+			connection.Status = entities.ConnectionAccepted
+			connection.AcceptedAt = &now
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connections, nil
+}
+
+func (r *connectionRepository) GetConnectionIDs(ctx context.Context, userID uint) ([]uint, error) {
+	return r.relatedUserIDs(ctx, userID, entities.ConnectionAccepted)
+}
+
+func (r *connectionRepository) GetBlockedIDs(ctx context.Context, userID uint) ([]uint, error) {
+	return r.relatedUserIDs(ctx, userID, entities.ConnectionBlocked)
+}
+
+func (r *connectionRepository) relatedUserIDs(ctx context.Context, userID uint, status entities.ConnectionStatus) ([]uint, error) {
+	var ids []uint
+	err := r.relatedUserIDsQuery(userID, status).WithContext(ctx).Scan(&ids).Error
+	return ids, err
+}
+
+func (r *connectionRepository) relatedUserIDsQuery(userID uint, status entities.ConnectionStatus) *gorm.DB {
+	return r.db.Model(&entities.Connection{}).
+		Select("CASE WHEN requester_id = ? THEN addressee_id ELSE requester_id END AS user_id", userID).
+		Where("(requester_id = ? OR addressee_id = ?) AND status = ?", userID, userID, status)
+}
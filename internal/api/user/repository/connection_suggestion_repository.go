@@ -0,0 +1,242 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type connectionSuggestionRepository struct {
+	db *gorm.DB
+}
+
+func NewConnectionSuggestionRepository(db *gorm.DB) repositories.ConnectionSuggestionRepository {
+	return &connectionSuggestionRepository{db: db}
+}
+
+// Suggestion score weights. Mutual connections count per shared connection;
+// engagement is the like/comment weight summed over the interaction window in
+// both directions and capped so one busy thread cannot dominate.
+const (
+	suggestionMutualWeight          = 3
+	suggestionCurrentColleagueScore = 10
+	suggestionFormerColleagueScore  = 6
+	suggestionLocationScore         = 2
+	suggestionInteractionCap        = 10
+	suggestionLikeWeight            = 1
+	suggestionCommentWeight         = 2
+	suggestionInteractionWindow     = 30 * 24 * time.Hour
+)
+
+// rebuildSuggestionsSQL gathers candidates from three sources: friends of
+// friends, colleagues whose experience at the same company overlapped, and
+// people who recently reacted to or commented on each other's posts. Each
+// pair is scored from those signals plus a shared location, and the top N
+// pairs per user are kept. People who already share a connection row
+// (accepted, pending or blocked) are never suggested.
+const rebuildSuggestionsSQL = `
+WITH edges AS (
+	SELECT requester_id AS user_id, addressee_id AS other_id FROM connections
+	WHERE status = @accepted AND deleted_at IS NULL
+	UNION ALL
+	SELECT addressee_id, requester_id FROM connections
+	WHERE status = @accepted AND deleted_at IS NULL
+),
+mutuals AS (
+	SELECT a.user_id, b.other_id AS suggested_user_id, COUNT(*) AS mutual_count
+	FROM edges a
+	JOIN edges b ON b.user_id = a.other_id AND b.other_id <> a.user_id
+	GROUP BY a.user_id, b.other_id
+),
+colleagues AS (
+	SELECT DISTINCT ON (a.user_id, b.user_id)
+		a.user_id,
+		b.user_id AS suggested_user_id,
+		(a.end_date IS NULL AND b.end_date IS NULL) AS is_current,
+		a.company,
+		GREATEST(a.start_date, b.start_date) AS overlap_start,
+		CASE WHEN a.end_date IS NULL AND b.end_date IS NULL THEN NULL
+			ELSE LEAST(COALESCE(a.end_date, b.end_date), COALESCE(b.end_date, a.end_date)) END AS overlap_end,
+		LEAST(COALESCE(a.end_date, CURRENT_DATE), COALESCE(b.end_date, CURRENT_DATE)) - GREATEST(a.start_date, b.start_date) AS overlap_days
+	FROM experiences a
+	JOIN experiences b ON b.user_id <> a.user_id
+		AND LOWER(TRIM(b.company)) = LOWER(TRIM(a.company))
+		AND b.start_date <= COALESCE(a.end_date, CURRENT_DATE)
+		AND a.start_date <= COALESCE(b.end_date, CURRENT_DATE)
+		AND b.deleted_at IS NULL
+	WHERE a.deleted_at IS NULL
+	ORDER BY a.user_id, b.user_id, (a.end_date IS NULL AND b.end_date IS NULL) DESC, overlap_days DESC
+),
+engagements AS (
+	SELECT r.user_id AS actor_id, p.user_id AS author_id, CAST(@like_weight AS INTEGER) AS weight
+	FROM reactions r
+	JOIN posts p ON p.id = r.post_id AND p.deleted_at IS NULL
+	WHERE r.deleted_at IS NULL AND r.created_at >= @since AND r.user_id <> p.user_id
+	UNION ALL
+	SELECT c.user_id, p.user_id, CAST(@comment_weight AS INTEGER)
+	FROM comments c
+	JOIN posts p ON p.id = c.post_id AND p.deleted_at IS NULL
+	WHERE c.deleted_at IS NULL AND c.created_at >= @since AND c.user_id <> p.user_id
+),
+interactions AS (
+	SELECT user_id, suggested_user_id, SUM(weight) AS interaction_score
+	FROM (
+		SELECT actor_id AS user_id, author_id AS suggested_user_id, weight FROM engagements
+		UNION ALL
+		SELECT author_id, actor_id, weight FROM engagements
+	) pairs
+	GROUP BY user_id, suggested_user_id
+),
+candidates AS (
+	SELECT user_id, suggested_user_id FROM mutuals
+	UNION
+	SELECT user_id, suggested_user_id FROM colleagues
+	UNION
+	SELECT user_id, suggested_user_id FROM interactions
+),
+scored AS (
+	SELECT
+		cand.user_id,
+		cand.suggested_user_id,
+		col.is_current,
+		COALESCE(col.company, '') AS company,
+		col.overlap_start,
+		col.overlap_end,
+		COALESCE(col.overlap_days, 0) AS overlap_days,
+		COALESCE(m.mutual_count, 0) AS mutual_count,
+		COALESCE(COALESCE(ua.location, '') <> '' AND LOWER(TRIM(ua.location)) = LOWER(TRIM(ub.location)), FALSE) AS shared_location,
+		COALESCE(i.interaction_score, 0) AS interaction_score
+	FROM candidates cand
+	JOIN users ua ON ua.id = cand.user_id AND ua.deleted_at IS NULL
+	JOIN users ub ON ub.id = cand.suggested_user_id AND ub.deleted_at IS NULL
+	LEFT JOIN mutuals m ON m.user_id = cand.user_id AND m.suggested_user_id = cand.suggested_user_id
+	LEFT JOIN colleagues col ON col.user_id = cand.user_id AND col.suggested_user_id = cand.suggested_user_id
+	LEFT JOIN interactions i ON i.user_id = cand.user_id AND i.suggested_user_id = cand.suggested_user_id
+	WHERE NOT EXISTS (
+		SELECT 1 FROM connections c
+		WHERE c.deleted_at IS NULL
+			AND ((c.requester_id = cand.user_id AND c.addressee_id = cand.suggested_user_id)
+				OR (c.requester_id = cand.suggested_user_id AND c.addressee_id = cand.user_id))
+	)
+),
+weighted AS (
+	SELECT scored.*,
+		mutual_count * @mutual_weight AS mutual_score,
+		CASE WHEN is_current THEN @current_score WHEN is_current IS NOT NULL THEN @former_score ELSE 0 END AS colleague_score,
+		LEAST(interaction_score, @interaction_cap) AS engagement_score
+	FROM scored
+),
+ranked AS (
+	SELECT weighted.*,
+		mutual_score + colleague_score + engagement_score + CASE WHEN shared_location THEN @location_score ELSE 0 END AS score,
+		CASE
+			WHEN colleague_score > 0 AND colleague_score >= mutual_score AND colleague_score >= engagement_score THEN
+				CASE WHEN is_current THEN @current ELSE @former END
+			WHEN mutual_score > 0 AND mutual_score >= engagement_score THEN @mutual
+			ELSE @interaction
+		END AS reason
+	FROM weighted
+)
+INSERT INTO connection_suggestions (user_id, suggested_user_id, reason, company, overlap_start, overlap_end, overlap_days, mutual_count, shared_location, interaction_score, score, computed_at)
+SELECT user_id, suggested_user_id, reason, company, overlap_start, overlap_end, overlap_days, mutual_count, shared_location, interaction_score, score, @computed_at
+FROM (
+	SELECT ranked.*, ROW_NUMBER() OVER (
+		PARTITION BY ranked.user_id
+		ORDER BY ranked.score DESC, ranked.suggested_user_id
+	) AS suggestion_rank
+	FROM ranked
+) top
+WHERE suggestion_rank <= @per_user_limit
+ON CONFLICT (user_id, suggested_user_id) DO UPDATE SET
+	reason = EXCLUDED.reason,
+	company = EXCLUDED.company,
+	overlap_start = EXCLUDED.overlap_start,
+	overlap_end = EXCLUDED.overlap_end,
+	overlap_days = EXCLUDED.overlap_days,
+	mutual_count = EXCLUDED.mutual_count,
+	shared_location = EXCLUDED.shared_location,
+	interaction_score = EXCLUDED.interaction_score,
+	score = EXCLUDED.score,
+	computed_at = EXCLUDED.computed_at`
+
+// Rebuild recomputes every suggestion in one pass and drops the ones that no
+// longer hold. Dismissals survive a rebuild so they stay hidden.
+func (r *connectionSuggestionRepository) Rebuild(ctx context.Context, computedAt time.Time, perUserLimit int) (int64, error) {
+	var computed int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec(rebuildSuggestionsSQL, map[string]interface{}{
+			"computed_at":     computedAt,
+			"since":           computedAt.Add(-suggestionInteractionWindow),
+			"accepted":        entities.ConnectionAccepted,
+			"current":         entities.SuggestionCurrentColleague,
+			"former":          entities.SuggestionFormerColleague,
+			"mutual":          entities.SuggestionMutualConnections,
+			"interaction":     entities.SuggestionRecentInteraction,
+			"mutual_weight":   suggestionMutualWeight,
+			"current_score":   suggestionCurrentColleagueScore,
+			"former_score":    suggestionFormerColleagueScore,
+			"location_score":  suggestionLocationScore,
+			"interaction_cap": suggestionInteractionCap,
+			"like_weight":     suggestionLikeWeight,
+			"comment_weight":  suggestionCommentWeight,
+			"per_user_limit":  perUserLimit,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		computed = result.RowsAffected
+
+		return tx.Where("computed_at < ? AND dismissed_at IS NULL", computedAt).
+			Delete(&entities.ConnectionSuggestion{}).Error
+	})
+	return computed, err
+}
+
+func (r *connectionSuggestionRepository) LatestComputedAt(ctx context.Context) (*time.Time, error) {
+	var latest sql.NullTime
+	err := r.db.WithContext(ctx).
+		Model(&entities.ConnectionSuggestion{}).
+		Select("MAX(computed_at)").
+		Row().
+		Scan(&latest)
+	if err != nil || !latest.Valid {
+		return nil, err
+	}
+	return &latest.Time, nil
+}
+
+func (r *connectionSuggestionRepository) GetByUserID(ctx context.Context, userID uint, limit, offset int) ([]*entities.ConnectionSuggestion, error) {
+	var suggestions []*entities.ConnectionSuggestion
+	err := r.db.WithContext(ctx).
+		Preload("SuggestedUser").
+		Where("user_id = ? AND dismissed_at IS NULL", userID).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM connections c
+			WHERE c.deleted_at IS NULL
+				AND ((c.requester_id = connection_suggestions.user_id AND c.addressee_id = connection_suggestions.suggested_user_id)
+					OR (c.requester_id = connection_suggestions.suggested_user_id AND c.addressee_id = connection_suggestions.user_id))
+		)`).
+		Order("score DESC, suggested_user_id ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&suggestions).Error
+	return suggestions, err
+}
+
+func (r *connectionSuggestionRepository) Dismiss(ctx context.Context, userID, suggestedUserID uint) error {
+	result := r.db.WithContext(ctx).
+		Model(&entities.ConnectionSuggestion{}).
+		Where("user_id = ? AND suggested_user_id = ? AND dismissed_at IS NULL", userID, suggestedUserID).
+		Update("dismissed_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
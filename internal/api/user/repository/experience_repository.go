@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type experienceRepository struct {
+	db *gorm.DB
+}
+
+func NewExperienceRepository(db *gorm.DB) repositories.ExperienceRepository {
+	return &experienceRepository{db: db}
+}
+
+func (r *experienceRepository) Create(ctx context.Context, experience *entities.Experience) error {
+	return r.db.WithContext(ctx).Create(experience).Error
+}
+
+func (r *experienceRepository) GetByID(ctx context.Context, id uint) (*entities.Experience, error) {
+	var experience entities.Experience
+	if err := r.db.WithContext(ctx).First(&experience, id).Error; err != nil {
+		return nil, err
+	}
+	return &experience, nil
+}
+
+func (r *experienceRepository) GetByUserID(ctx context.Context, userID uint) ([]*entities.Experience, error) {
+	var experiences []*entities.Experience
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("end_date DESC NULLS FIRST, start_date DESC").
+		Find(&experiences).Error
+	return experiences, err
+}
+
+func (r *experienceRepository) Update(ctx context.Context, experience *entities.Experience) error {
+	return r.db.WithContext(ctx).Save(experience).Error
+}
+
+func (r *experienceRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&entities.Experience{}, id).Error
+}
+
+func (r *experienceRepository) GetCurrentStartingOn(ctx context.Context, month int, days []int, afterID uint, limit int) ([]*entities.Experience, error) {
+	var experiences []*entities.Experience
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("end_date IS NULL AND id > ?", afterID).
+		Where("EXTRACT(MONTH FROM start_date) = ? AND EXTRACT(DAY FROM start_date) IN ?", month, days).
+		Order("id ASC").
+		Limit(limit).
+		Find(&experiences).Error
+	return experiences, err
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type profileSectionRepository struct {
+	db *gorm.DB
+}
+
+func NewProfileSectionRepository(db *gorm.DB) repositories.ProfileSectionRepository {
+	return &profileSectionRepository{db: db}
+}
+
+func (r *profileSectionRepository) Create(ctx context.Context, section *entities.ProfileSection) error {
+	return r.db.WithContext(ctx).Create(section).Error
+}
+
+func (r *profileSectionRepository) GetByID(ctx context.Context, id uint) (*entities.ProfileSection, error) {
+	var section entities.ProfileSection
+	if err := r.db.WithContext(ctx).First(&section, id).Error; err != nil {
+		return nil, err
+	}
+	return &section, nil
+}
+
+func (r *profileSectionRepository) GetByUserID(ctx context.Context, userID uint) ([]*entities.ProfileSection, error) {
+	var sections []*entities.ProfileSection
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("type ASC, id ASC").
+		Find(&sections).Error
+	return sections, err
+}
+
+func (r *profileSectionRepository) CountByType(ctx context.Context, userID uint, sectionType entities.ProfileSectionType) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.ProfileSection{}).
+		Where("user_id = ? AND type = ?", userID, sectionType).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *profileSectionRepository) Update(ctx context.Context, section *entities.ProfileSection) error {
+	return r.db.WithContext(ctx).Save(section).Error
+}
+
+func (r *profileSectionRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&entities.ProfileSection{}, id).Error
+}
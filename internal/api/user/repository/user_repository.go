@@ -2,8 +2,10 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"linked-clone/internal/domain/entities"
 	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/taxonomy"
 	"time"
 
 	"gorm.io/gorm"
@@ -56,21 +58,143 @@ func (r *userRepository) Delete(ctx context.Context, id uint) error {
 	return r.db.WithContext(ctx).Delete(&entities.User{}, id).Error
 }
 
-func (r *userRepository) Search(ctx context.Context, query string, limit, offset int) ([]*entities.User, error) {
+func (r *userRepository) Restore(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Unscoped().Model(&entities.User{}).
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error
+}
+
+// GetByIDs returns the users in the order of ids, skipping any that no longer
+// exist.
+func (r *userRepository) GetByIDs(ctx context.Context, ids []uint) ([]*entities.User, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var found []*entities.User
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&found).Error; err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uint]*entities.User, len(found))
+	for _, user := range found {
+		byID[user.ID] = user
+	}
+
+	users := make([]*entities.User, 0, len(found))
+	for _, id := range ids {
+		if user, ok := byID[id]; ok {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+func (r *userRepository) FindByEmails(ctx context.Context, emails []string) ([]*entities.User, error) {
+	var users []*entities.User
+	if len(emails) == 0 {
+		return users, nil
+	}
+	err := r.db.WithContext(ctx).Where("LOWER(email) IN ?", emails).Find(&users).Error
+	return users, err
+}
+
+func (r *userRepository) FindByUsernames(ctx context.Context, usernames []string) ([]*entities.User, error) {
+	var users []*entities.User
+	if len(usernames) == 0 {
+		return users, nil
+	}
+	err := r.db.WithContext(ctx).Where("LOWER(username) IN ?", usernames).Find(&users).Error
+	return users, err
+}
+
+func (r *userRepository) FindByFullNames(ctx context.Context, names []string) ([]*entities.User, error) {
+	var users []*entities.User
+	if len(names) == 0 {
+		return users, nil
+	}
+	err := r.db.WithContext(ctx).Where("LOWER(full_name) IN ?", names).Find(&users).Error
+	return users, err
+}
+
+func (r *userRepository) GetBirthdaySharers(ctx context.Context, afterID uint, limit int) ([]*entities.User, error) {
 	var users []*entities.User
 	err := r.db.WithContext(ctx).
-		Where("full_name ILIKE ? OR username ILIKE ? OR email ILIKE ?",
-			"%"+query+"%", "%"+query+"%", "%"+query+"%").
+		Where("share_birthday = true AND date_of_birth IS NOT NULL AND id > ?", afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&users).Error
+	return users, err
+}
+
+func (r *userRepository) FilterReminderRecipients(ctx context.Context, ids []uint) ([]uint, error) {
+	var recipients []uint
+	if len(ids) == 0 {
+		return recipients, nil
+	}
+	err := r.db.WithContext(ctx).Model(&entities.User{}).
+		Where("id IN ? AND life_event_reminders = true", ids).
+		Pluck("id", &recipients).Error
+	return recipients, err
+}
+
+func (r *userRepository) SearchTalent(ctx context.Context, filters map[string]interface{}, limit, offset int) ([]*entities.User, error) {
+	var users []*entities.User
+	query := r.db.WithContext(ctx).Where("recruiter_visible = true")
+
+	for key, value := range filters {
+		switch key {
+		case "exclude_user_id":
+			query = query.Where("id <> ?", value)
+		case "skills":
+			skills, err := json.Marshal(value)
+			if err != nil {
+				return nil, err
+			}
+			query = query.Where("skills @> ?::jsonb", string(skills))
+		case "title":
+			query = query.Where("headline ILIKE ?", "%"+value.(string)+"%")
+		case "location":
+			query = query.Where("location ILIKE ?", "%"+value.(string)+"%")
+		case "location_id":
+			query = query.Where("location_id IN (?)", r.db.Raw(taxonomy.LocationScopeSQL, value))
+		case "industry_id":
+			query = query.Where("industry_id = ?", value)
+		case "min_experience":
+			query = query.Where("years_of_experience >= ?", value)
+		case "max_experience":
+			query = query.Where("years_of_experience <= ?", value)
+		case "open_to_work":
+			query = query.Where("open_to_work = ?", value)
+		}
+	}
+
+	err := query.Order("open_to_work DESC, years_of_experience DESC, id ASC").
 		Limit(limit).
 		Offset(offset).
 		Find(&users).Error
 	return users, err
 }
 
+func (r *userRepository) UpdateLastActive(ctx context.Context, userID uint, lastActiveAt time.Time) error {
+	return r.db.WithContext(ctx).Model(&entities.User{}).
+		Where("id = ? AND (last_active_at IS NULL OR last_active_at < ?)", userID, lastActiveAt).
+		UpdateColumn("last_active_at", lastActiveAt).Error
+}
+
 func (r *userRepository) VerifyEmail(ctx context.Context, userID uint) error {
 	return r.db.WithContext(ctx).Model(&entities.User{}).
 		Where("id = ?", userID).
-		Update("is_verified", true).Error
+		Update("email_verified", true).Error
+}
+
+func (r *userRepository) SetIdentityVerified(ctx context.Context, userID uint, verified bool, verifiedAt *time.Time) error {
+	return r.db.WithContext(ctx).Model(&entities.User{}).
+		Where("id = ?", userID).
+		Updates(map[string]interface{}{
+			"is_verified":          verified,
+			"identity_verified_at": verifiedAt,
+		}).Error
 }
 
 func (r *userRepository) UpdatePremiumStatus(ctx context.Context, userID uint, isPremium bool, premiumUntil *time.Time) error {
@@ -86,3 +210,20 @@ func (r *userRepository) UpdatePremiumStatus(ctx context.Context, userID uint, i
 		Where("id = ?", userID).
 		Updates(updates).Error
 }
+
+func (r *userRepository) GetUsage(ctx context.Context, userID uint) (*entities.UserUsage, error) {
+	var usage entities.UserUsage
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT
+			(SELECT COUNT(*) FROM message_attachments a JOIN messages m ON m.id = a.message_id AND m.created_at = a.message_created_at
+				WHERE m.sender_id = ? AND m.deleted_at IS NULL) AS attachment_count,
+			(SELECT COALESCE(SUM(a.size), 0) FROM message_attachments a JOIN messages m ON m.id = a.message_id AND m.created_at = a.message_created_at
+				WHERE m.sender_id = ? AND m.deleted_at IS NULL) AS attachment_bytes,
+			(SELECT COUNT(*) FROM connections WHERE requester_id = ? AND status = ? AND deleted_at IS NULL) AS pending_invitations`,
+		userID, userID, userID, entities.ConnectionPending).
+		Scan(&usage).Error
+	if err != nil {
+		return nil, err
+	}
+	return &usage, nil
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"linked-clone/internal/api/user/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/pkg/imaging"
+	"linked-clone/pkg/moderation"
+	"mime/multipart"
+	"time"
+)
+
+const profileBannerFolder = "profile-banners"
+
+// Banner variants are cropped to 4:1, the shape profiles show them in. The
+// original is kept as uploaded so new sizes can be cut from it later.
+var profileBannerVariants = []struct {
+	name          string
+	width, height int
+}{
+	{"large", 1584, 396},
+	{"small", 792, 198},
+}
+
+func (s *userService) UploadProfileBanner(ctx context.Context, userID uint, file *multipart.FileHeader) (*dto.BannerResponse, error) {
+	data, err := readFile(file)
+	if err != nil {
+		s.logger.Error("Failed to read uploaded image", "error", err)
+		return nil, errors.New("failed to read image")
+	}
+
+	if err := imaging.CheckBanner(bytes.NewReader(data)); err != nil {
+		if errors.Is(err, imaging.ErrBannerDimensions) {
+			return nil, err
+		}
+		return nil, errors.New("invalid image")
+	}
+
+	if s.moderator != nil {
+		if err := s.moderator.Check(ctx, data); err != nil {
+			if errors.Is(err, moderation.ErrImageRejected) {
+				s.logger.Warn("Profile banner rejected by moderation", "user_id", userID, "reason", err.Error())
+				return nil, err
+			}
+			s.logger.Error("Failed to moderate profile banner", "error", err)
+			return nil, errors.New("failed to moderate image")
+		}
+	}
+
+	variants := make(map[string]string, len(profileBannerVariants))
+	for _, variant := range profileBannerVariants {
+		cropped, err := imaging.CoverCrop(bytes.NewReader(data), variant.width, variant.height)
+		if err != nil {
+			s.logger.Error("Failed to generate profile banner variant", "variant", variant.name, "error", err)
+			continue
+		}
+		key, err := s.storageService.UploadBytes(ctx, cropped, profileBannerFolder+"/"+variant.name, ".jpg")
+		if err != nil {
+			s.logger.Error("Failed to upload profile banner variant", "variant", variant.name, "error", err)
+			continue
+		}
+		variants[variant.name] = key
+	}
+
+	fileKey, err := s.storageService.UploadImage(ctx, file, profileBannerFolder)
+	if err != nil {
+		s.logger.Error("Failed to upload image", "error", err)
+		s.deleteBannerKeys(variants["large"], variants["small"])
+		return nil, errors.New("failed to upload image")
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get user", "error", err)
+		return nil, errors.New("failed to get user")
+	}
+
+	previous := []string{user.ProfileBanner, user.ProfileBannerLarge, user.ProfileBannerSmall}
+
+	user.ProfileBanner = fileKey
+	user.ProfileBannerLarge = variants["large"]
+	user.ProfileBannerSmall = variants["small"]
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		s.logger.Error("Failed to update user profile banner", "error", err)
+		s.deleteBannerKeys(fileKey, variants["large"], variants["small"])
+		return nil, errors.New("failed to update profile banner")
+	}
+
+	s.deleteBannerKeys(previous...)
+
+	url, large, small := s.profileBannerURLs(user)
+	if url == "" {
+		return nil, errors.New("failed to generate access URL for uploaded image")
+	}
+
+	return &dto.BannerResponse{
+		URL:      url,
+		LargeURL: large,
+		SmallURL: small,
+	}, nil
+}
+
+func (s *userService) DeleteProfileBanner(ctx context.Context, userID uint) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get user", "error", err)
+		return errors.New("failed to get user")
+	}
+
+	if user.ProfileBanner == "" {
+		return errors.New("profile banner not found")
+	}
+
+	previous := []string{user.ProfileBanner, user.ProfileBannerLarge, user.ProfileBannerSmall}
+
+	user.ProfileBanner = ""
+	user.ProfileBannerLarge = ""
+	user.ProfileBannerSmall = ""
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		s.logger.Error("Failed to remove user profile banner", "error", err)
+		return errors.New("failed to remove profile banner")
+	}
+
+	s.deleteBannerKeys(previous...)
+	return nil
+}
+
+// profileBannerURLs returns presigned URLs for the original and each variant.
+// A variant that failed to generate is left out rather than failing the
+// profile.
+func (s *userService) profileBannerURLs(user *entities.User) (string, string, string) {
+	urls := make([]string, 3)
+	for i, key := range []string{user.ProfileBanner, user.ProfileBannerLarge, user.ProfileBannerSmall} {
+		if key == "" {
+			continue
+		}
+		presignedURL, err := s.storageService.GeneratePresignedURL(key, 24*time.Hour)
+		if err != nil {
+			s.logger.Error("Failed to generate presigned URL for profile banner",
+				"user_id", user.ID,
+				"error", err.Error())
+			continue
+		}
+		urls[i] = presignedURL
+	}
+	return urls[0], urls[1], urls[2]
+}
+
+func (s *userService) deleteBannerKeys(keys ...string) {
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		go func(key string) {
+			if err := s.storageService.DeleteFile(context.Background(), key); err != nil {
+				s.logger.Error("Failed to delete profile banner", "error", err)
+			}
+		}(key)
+	}
+}
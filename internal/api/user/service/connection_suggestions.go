@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/user/dto"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func (s *connectionService) GetSuggestions(ctx context.Context, userID uint, limit, offset int) ([]*dto.ConnectionSuggestionResponse, error) {
+	suggestions, err := s.suggestionRepo.GetByUserID(ctx, userID, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to get connection suggestions", "error", err)
+		return nil, errors.New("failed to get connection suggestions")
+	}
+
+	responses := make([]*dto.ConnectionSuggestionResponse, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		user := suggestion.SuggestedUser
+		if user.ID == 0 {
+			continue
+		}
+
+		profilePicture := ""
+		if user.ProfilePicture != "" {
+			if presignedURL, err := s.storageService.GeneratePresignedURL(user.ProfilePicture, 24*time.Hour); err == nil {
+				profilePicture = presignedURL
+			}
+		}
+
+		userResponse := &dto.UserResponse{
+			ID:             user.ID,
+			Username:       user.Username,
+			FullName:       user.FullName,
+			ProfilePicture: profilePicture,
+			ProfileAltText: user.ProfileAltText,
+			Bio:            user.Bio,
+			Location:       user.Location,
+			Website:        user.Website,
+			IsVerified:     user.IsVerified,
+			IsPremium:      user.IsPremium,
+		}
+		if mutual, err := s.graph.MutualConnections(ctx, userID, user.ID); err == nil {
+			count := len(mutual)
+			userResponse.MutualConnectionsCount = &count
+		}
+
+		responses = append(responses, &dto.ConnectionSuggestionResponse{
+			User:              userResponse,
+			Reason:            suggestion.Reason,
+			Score:             suggestion.Score,
+			MutualCount:       suggestion.MutualCount,
+			SharedLocation:    suggestion.SharedLocation,
+			RecentInteraction: suggestion.InteractionScore > 0,
+			Company:           suggestion.Company,
+			OverlapStart:      suggestion.OverlapStart,
+			OverlapEnd:        suggestion.OverlapEnd,
+			OverlapDays:       suggestion.OverlapDays,
+		})
+	}
+
+	return responses, nil
+}
+
+func (s *connectionService) DismissSuggestion(ctx context.Context, userID, suggestedUserID uint) error {
+	if err := s.suggestionRepo.Dismiss(ctx, userID, suggestedUserID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("suggestion not found")
+		}
+		s.logger.Error("Failed to dismiss connection suggestion", "error", err)
+		return errors.New("failed to dismiss suggestion")
+	}
+	return nil
+}
@@ -0,0 +1,327 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	uploadService "linked-clone/internal/api/upload/service"
+	"linked-clone/internal/api/user/dto"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/pkg/linkedin"
+	"mime/multipart"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	exportBatchSize = 500
+	importBatchSize = 200
+)
+
+func (s *connectionService) ExportConnections(ctx context.Context, userID uint) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write([]string{"Full Name", "Username", "Headline", "Location", "Connected On"}); err != nil {
+		return nil, errors.New("failed to export connections")
+	}
+
+	for offset := 0; ; offset += exportBatchSize {
+		connections, err := s.connectionRepo.GetUserConnections(ctx, userID, entities.ConnectionAccepted, exportBatchSize, offset)
+		if err != nil {
+			s.logger.Error("Failed to load connections for export", "error", err)
+			return nil, errors.New("failed to export connections")
+		}
+
+		for _, connection := range connections {
+			other := connection.Addressee
+			if connection.AddresseeID == userID {
+				other = connection.Requester
+			}
+
+			connectedOn := connection.CreatedAt
+			if connection.AcceptedAt != nil {
+				connectedOn = *connection.AcceptedAt
+			}
+
+			if err := writer.Write([]string{
+				other.FullName,
+				other.Username,
+				other.Headline,
+				other.Location,
+				connectedOn.Format("2006-01-02"),
+			}); err != nil {
+				return nil, errors.New("failed to export connections")
+			}
+		}
+
+		if len(connections) < exportBatchSize {
+			break
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, errors.New("failed to export connections")
+	}
+	return buf.Bytes(), nil
+}
+
+// StartImport records the import and hands the file to the upload pipeline,
+// which validates, scans and matches it in the background. The response
+// carries the upload ID to follow at /uploads/:id/status.
+func (s *connectionService) StartImport(ctx context.Context, userID uint, file *multipart.FileHeader) (*dto.ConnectionImportResponse, error) {
+	src, err := file.Open()
+	if err != nil {
+		return nil, errors.New("failed to read import file")
+	}
+	defer src.Close()
+
+	data, err := io.ReadAll(src)
+	if err != nil {
+		return nil, errors.New("failed to read import file")
+	}
+
+	connectionImport := &entities.ConnectionImport{
+		UserID:   userID,
+		Filename: file.Filename,
+		Status:   entities.ConnectionImportPending,
+		Matches:  []entities.ConnectionImportMatch{},
+	}
+	if err := s.importRepo.Create(ctx, connectionImport); err != nil {
+		s.logger.Error("Failed to create connection import", "error", err)
+		return nil, errors.New("failed to start import")
+	}
+
+	upload, err := s.uploads.Submit(ctx, userID, entities.UploadKindConnectionsImport, file.Filename, data, &connectionImport.ID)
+	if err != nil {
+		s.failImport(ctx, connectionImport, err.Error())
+		return nil, err
+	}
+
+	connectionImport.UploadID = &upload.ID
+	if err := s.importRepo.Update(ctx, connectionImport); err != nil {
+		s.logger.Warn("Failed to link connection import to upload", "error", err, "import_id", connectionImport.ID)
+	}
+
+	return mapImportToResponse(connectionImport), nil
+}
+
+func (s *connectionService) GetImport(ctx context.Context, userID, importID uint) (*dto.ConnectionImportResponse, error) {
+	connectionImport, err := s.importRepo.GetByID(ctx, importID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("connection import not found")
+		}
+		s.logger.Error("Failed to get connection import", "error", err)
+		return nil, errors.New("failed to get connection import")
+	}
+	if connectionImport.UserID != userID {
+		return nil, errors.New("connection import not found")
+	}
+
+	return mapImportToResponse(connectionImport), nil
+}
+
+// connectionImportProcessor runs connection imports through the upload
+// pipeline, keeping the import record in step with the upload job.
+type connectionImportProcessor struct {
+	s *connectionService
+}
+
+func (p *connectionImportProcessor) Validate(ctx context.Context, job *entities.UploadJob, data []byte) error {
+	contacts, err := linkedin.ParseExport(job.Filename, data)
+	if err != nil {
+		p.s.logger.Warn("Failed to parse connections import", "error", err, "user_id", job.UserID)
+		return errors.New("invalid connections export file")
+	}
+
+	connectionImport, err := p.s.uploadImport(ctx, job)
+	if err != nil {
+		return err
+	}
+	connectionImport.TotalRows = len(contacts)
+	if err := p.s.importRepo.Update(ctx, connectionImport); err != nil {
+		p.s.logger.Warn("Failed to save connection import size", "error", err, "import_id", connectionImport.ID)
+	}
+	return nil
+}
+
+func (p *connectionImportProcessor) Process(ctx context.Context, job *entities.UploadJob, data []byte, progress uploadService.ProgressFunc) error {
+	contacts, err := linkedin.ParseExport(job.Filename, data)
+	if err != nil {
+		return errors.New("invalid connections export file")
+	}
+
+	connectionImport, err := p.s.uploadImport(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	connectionImport.Status = entities.ConnectionImportProcessing
+	connectionImport.TotalRows = len(contacts)
+	connectionImport.StartedAt = &now
+	if err := p.s.importRepo.Update(ctx, connectionImport); err != nil {
+		p.s.logger.Error("Failed to start connection import", "error", err, "import_id", connectionImport.ID)
+		return errors.New("failed to start import")
+	}
+
+	matches, err := p.s.matchContacts(ctx, connectionImport, contacts, func(processed int) {
+		if err := p.s.importRepo.UpdateProgress(ctx, connectionImport.ID, processed); err != nil {
+			p.s.logger.Warn("Failed to update connection import progress", "error", err, "import_id", connectionImport.ID)
+		}
+		progress(processed, len(contacts))
+	})
+	if err != nil {
+		p.s.logger.Error("Failed to process connection import", "error", err, "import_id", connectionImport.ID)
+		return errors.New("failed to match contacts")
+	}
+
+	completedAt := time.Now()
+	connectionImport.Status = entities.ConnectionImportCompleted
+	connectionImport.ProcessedRows = len(contacts)
+	connectionImport.Matches = matches
+	connectionImport.CompletedAt = &completedAt
+	if err := p.s.importRepo.Update(ctx, connectionImport); err != nil {
+		p.s.logger.Error("Failed to save connection import", "error", err, "import_id", connectionImport.ID)
+		return errors.New("failed to save import results")
+	}
+	return nil
+}
+
+func (p *connectionImportProcessor) Abort(ctx context.Context, job *entities.UploadJob, reason string) {
+	connectionImport, err := p.s.uploadImport(ctx, job)
+	if err != nil {
+		return
+	}
+	p.s.failImport(ctx, connectionImport, reason)
+}
+
+func (s *connectionService) uploadImport(ctx context.Context, job *entities.UploadJob) (*entities.ConnectionImport, error) {
+	if job.ResourceID == nil {
+		return nil, errors.New("connection import not found")
+	}
+	connectionImport, err := s.importRepo.GetByID(ctx, *job.ResourceID)
+	if err != nil {
+		s.logger.Error("Failed to get connection import", "error", err, "upload_id", job.ID)
+		return nil, errors.New("connection import not found")
+	}
+	return connectionImport, nil
+}
+
+func (s *connectionService) failImport(ctx context.Context, connectionImport *entities.ConnectionImport, reason string) {
+	if connectionImport.Status == entities.ConnectionImportCompleted || connectionImport.Status == entities.ConnectionImportFailed {
+		return
+	}
+
+	now := time.Now()
+	connectionImport.Status = entities.ConnectionImportFailed
+	connectionImport.Error = reason
+	connectionImport.CompletedAt = &now
+	if err := s.importRepo.Update(ctx, connectionImport); err != nil {
+		s.logger.Error("Failed to save connection import", "error", err, "import_id", connectionImport.ID)
+	}
+}
+
+func (s *connectionService) matchContacts(ctx context.Context, connectionImport *entities.ConnectionImport, contacts []linkedin.Contact, progress func(processed int)) ([]entities.ConnectionImportMatch, error) {
+	userID := connectionImport.UserID
+	existing, err := s.graph.Connections(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	skip := map[uint]bool{userID: true}
+	for _, id := range existing {
+		skip[id] = true
+	}
+
+	matches := []entities.ConnectionImportMatch{}
+	for start := 0; start < len(contacts); start += importBatchSize {
+		batch := contacts[start:min(start+importBatchSize, len(contacts))]
+
+		var emails, names []string
+		for _, contact := range batch {
+			if contact.Email != "" {
+				emails = append(emails, contact.Email)
+			}
+			if name := strings.ToLower(contact.FullName()); name != "" {
+				names = append(names, name)
+			}
+		}
+
+		byEmail, err := s.userRepo.FindByEmails(ctx, emails)
+		if err != nil {
+			return nil, err
+		}
+		emailIndex := make(map[string]*entities.User, len(byEmail))
+		for _, user := range byEmail {
+			emailIndex[strings.ToLower(user.Email)] = user
+		}
+
+		byName, err := s.userRepo.FindByFullNames(ctx, names)
+		if err != nil {
+			return nil, err
+		}
+		nameIndex := make(map[string][]*entities.User, len(byName))
+		for _, user := range byName {
+			key := strings.ToLower(user.FullName)
+			nameIndex[key] = append(nameIndex[key], user)
+		}
+
+		for _, contact := range batch {
+			user, matchedBy := emailIndex[contact.Email], "email"
+			if user == nil {
+				user, matchedBy = nil, "name"
+				if candidates := nameIndex[strings.ToLower(contact.FullName())]; len(candidates) == 1 {
+					user = candidates[0]
+				}
+			}
+			if user == nil || skip[user.ID] {
+				continue
+			}
+
+			skip[user.ID] = true
+			matches = append(matches, entities.ConnectionImportMatch{
+				UserID:      user.ID,
+				Username:    user.Username,
+				FullName:    user.FullName,
+				MatchedBy:   matchedBy,
+				ContactName: contact.FullName(),
+				Company:     contact.Company,
+				Position:    contact.Position,
+			})
+		}
+
+		progress(start + len(batch))
+	}
+
+	return matches, nil
+}
+
+func mapImportToResponse(connectionImport *entities.ConnectionImport) *dto.ConnectionImportResponse {
+	progress := 0.0
+	if connectionImport.TotalRows > 0 {
+		progress = float64(connectionImport.ProcessedRows) / float64(connectionImport.TotalRows) * 100
+	} else if connectionImport.Status == entities.ConnectionImportCompleted {
+		progress = 100
+	}
+
+	return &dto.ConnectionImportResponse{
+		ID:            connectionImport.ID,
+		UploadID:      connectionImport.UploadID,
+		Filename:      connectionImport.Filename,
+		Status:        connectionImport.Status,
+		TotalRows:     connectionImport.TotalRows,
+		ProcessedRows: connectionImport.ProcessedRows,
+		Progress:      progress,
+		Matches:       connectionImport.Matches,
+		Error:         connectionImport.Error,
+		StartedAt:     connectionImport.StartedAt,
+		CompletedAt:   connectionImport.CompletedAt,
+		CreatedAt:     connectionImport.CreatedAt,
+	}
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/api/user/dto"
+	"linked-clone/internal/domain/entities"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const lifeEventWindow = 90 * 24 * time.Hour
+
+func (s *userService) GetExperiences(ctx context.Context, userID uint) ([]*dto.ExperienceResponse, error) {
+	experiences, err := s.experienceRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get experiences", "error", err)
+		return nil, errors.New("failed to get experiences")
+	}
+
+	responses := make([]*dto.ExperienceResponse, 0, len(experiences))
+	for _, experience := range experiences {
+		responses = append(responses, mapExperienceToResponse(experience))
+	}
+	return responses, nil
+}
+
+func (s *userService) AddExperience(ctx context.Context, userID uint, req *dto.ExperienceRequest) (*dto.ExperienceResponse, error) {
+	experience := &entities.Experience{UserID: userID}
+	if err := applyExperience(experience, req); err != nil {
+		return nil, err
+	}
+	if err := s.applyExperienceLocation(ctx, experience, req); err != nil {
+		return nil, err
+	}
+
+	previous, err := s.experienceRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get experiences", "error", err)
+		return nil, errors.New("failed to add experience")
+	}
+
+	if err := s.experienceRepo.Create(ctx, experience); err != nil {
+		s.logger.Error("Failed to create experience", "error", err)
+		return nil, errors.New("failed to add experience")
+	}
+
+	s.suggestNewPosition(ctx, experience, previous)
+
+	return mapExperienceToResponse(experience), nil
+}
+
+func (s *userService) UpdateExperience(ctx context.Context, userID, experienceID uint, req *dto.ExperienceRequest) (*dto.ExperienceResponse, error) {
+	experience, err := s.getOwnExperience(ctx, userID, experienceID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *experience
+	if err := applyExperience(experience, req); err != nil {
+		return nil, err
+	}
+	if err := s.applyExperienceLocation(ctx, experience, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.experienceRepo.Update(ctx, experience); err != nil {
+		s.logger.Error("Failed to update experience", "error", err)
+		return nil, errors.New("failed to update experience")
+	}
+
+	if experience.IsCurrent() &&
+		strings.EqualFold(before.Company, experience.Company) &&
+		!strings.EqualFold(before.Title, experience.Title) {
+		now := time.Now()
+		s.suggestPost(ctx, experience, entities.PostTypePromotion, entities.PostEvent{
+			PreviousTitle: before.Title,
+			Date:          &now,
+		})
+	}
+
+	return mapExperienceToResponse(experience), nil
+}
+
+func (s *userService) DeleteExperience(ctx context.Context, userID, experienceID uint) error {
+	if _, err := s.getOwnExperience(ctx, userID, experienceID); err != nil {
+		return err
+	}
+
+	if err := s.experienceRepo.Delete(ctx, experienceID); err != nil {
+		s.logger.Error("Failed to delete experience", "error", err)
+		return errors.New("failed to delete experience")
+	}
+	return nil
+}
+
+func (s *userService) getOwnExperience(ctx context.Context, userID, experienceID uint) (*entities.Experience, error) {
+	experience, err := s.experienceRepo.GetByID(ctx, experienceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("experience not found")
+		}
+		s.logger.Error("Failed to get experience", "error", err)
+		return nil, errors.New("failed to get experience")
+	}
+	if experience.UserID != userID {
+		return nil, errors.New("experience not found")
+	}
+	return experience, nil
+}
+
+func (s *userService) suggestNewPosition(ctx context.Context, experience *entities.Experience, previous []*entities.Experience) {
+	if !experience.IsCurrent() || time.Since(experience.StartDate) > lifeEventWindow {
+		return
+	}
+
+	for _, other := range previous {
+		if strings.EqualFold(other.Company, experience.Company) && !strings.EqualFold(other.Title, experience.Title) {
+			s.suggestPost(ctx, experience, entities.PostTypePromotion, entities.PostEvent{
+				PreviousTitle: other.Title,
+				Date:          &experience.StartDate,
+			})
+			return
+		}
+	}
+
+	s.suggestPost(ctx, experience, entities.PostTypeNewJob, entities.PostEvent{Date: &experience.StartDate})
+}
+
+func (s *userService) suggestPost(ctx context.Context, experience *entities.Experience, postType entities.PostType, event entities.PostEvent) {
+	if postType == entities.PostTypeNewJob {
+		exists, err := s.suggestionRepo.ExistsForExperience(ctx, experience.ID, postType)
+		if err != nil || exists {
+			return
+		}
+	}
+
+	event.ExperienceID = &experience.ID
+	event.Title = experience.Title
+	event.Company = experience.Company
+
+	suggestion := &entities.PostSuggestion{
+		UserID:       experience.UserID,
+		ExperienceID: &experience.ID,
+		Type:         postType,
+		Event:        event,
+		Status:       entities.PostSuggestionPending,
+	}
+	if err := s.suggestionRepo.Create(ctx, suggestion); err != nil {
+		s.logger.Warn("Failed to create post suggestion", "error", err, "user_id", experience.UserID, "type", postType)
+	}
+}
+
+func (s *userService) applyExperienceLocation(ctx context.Context, experience *entities.Experience, req *dto.ExperienceRequest) error {
+	locationID, location, err := s.resolveLocation(ctx, req.LocationID, req.Location)
+	if err != nil {
+		return err
+	}
+	experience.LocationID = locationID
+	experience.Location = location
+	return nil
+}
+
+func applyExperience(experience *entities.Experience, req *dto.ExperienceRequest) error {
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		return errors.New("invalid start date")
+	}
+	if startDate.After(time.Now()) {
+		return errors.New("start date cannot be in the future")
+	}
+
+	var endDate *time.Time
+	if req.EndDate != nil && *req.EndDate != "" {
+		parsed, err := time.Parse("2006-01-02", *req.EndDate)
+		if err != nil {
+			return errors.New("invalid end date")
+		}
+		if parsed.Before(startDate) {
+			return errors.New("end date cannot be before start date")
+		}
+		endDate = &parsed
+	}
+
+	experience.Title = strings.TrimSpace(req.Title)
+	experience.Company = strings.TrimSpace(req.Company)
+	experience.Description = req.Description
+	experience.StartDate = startDate
+	experience.EndDate = endDate
+	return nil
+}
+
+func mapExperienceToResponse(experience *entities.Experience) *dto.ExperienceResponse {
+	return &dto.ExperienceResponse{
+		ID:          experience.ID,
+		Title:       experience.Title,
+		Company:     experience.Company,
+		Location:    experience.Location,
+		LocationID:  experience.LocationID,
+		Description: experience.Description,
+		StartDate:   experience.StartDate,
+		EndDate:     experience.EndDate,
+		IsCurrent:   experience.IsCurrent(),
+	}
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"linked-clone/internal/api/user/dto"
+	"linked-clone/internal/domain/entities"
+	"slices"
+
+	"gorm.io/gorm"
+)
+
+const maxEntriesPerSection = 50
+
+func (s *userService) GetProfileSections(ctx context.Context, userID uint) ([]*dto.ProfileSectionGroup, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("user not found")
+		}
+		s.logger.Error("Failed to get user", "error", err)
+		return nil, errors.New("failed to get profile sections")
+	}
+
+	sections, err := s.profileSectionRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get profile sections", "user_id", userID, "error", err)
+		return nil, errors.New("failed to get profile sections")
+	}
+	return groupProfileSections(sections, resolveSectionOrder(user.SectionOrder)), nil
+}
+
+func (s *userService) AddProfileSection(ctx context.Context, userID uint, sectionType entities.ProfileSectionType, payload dto.SectionPayload) (*dto.ProfileSectionResponse, error) {
+	fields, err := sectionFields(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.profileSectionRepo.CountByType(ctx, userID, sectionType)
+	if err != nil {
+		s.logger.Error("Failed to count profile sections", "user_id", userID, "error", err)
+		return nil, errors.New("failed to add profile section")
+	}
+	if count >= maxEntriesPerSection {
+		return nil, errors.New("profile section limit reached")
+	}
+
+	section := &entities.ProfileSection{
+		UserID:  userID,
+		Type:    sectionType,
+		Payload: fields,
+	}
+	if err := s.profileSectionRepo.Create(ctx, section); err != nil {
+		s.logger.Error("Failed to create profile section", "user_id", userID, "error", err)
+		return nil, errors.New("failed to add profile section")
+	}
+
+	return mapProfileSection(section), nil
+}
+
+func (s *userService) UpdateProfileSection(ctx context.Context, userID, sectionID uint, sectionType entities.ProfileSectionType, payload dto.SectionPayload) (*dto.ProfileSectionResponse, error) {
+	section, err := s.getOwnProfileSection(ctx, userID, sectionID)
+	if err != nil {
+		return nil, err
+	}
+	if section.Type != sectionType {
+		return nil, errors.New("section type cannot be changed")
+	}
+
+	fields, err := sectionFields(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	section.Payload = fields
+	if err := s.profileSectionRepo.Update(ctx, section); err != nil {
+		s.logger.Error("Failed to update profile section", "section_id", sectionID, "error", err)
+		return nil, errors.New("failed to update profile section")
+	}
+
+	return mapProfileSection(section), nil
+}
+
+func (s *userService) DeleteProfileSection(ctx context.Context, userID, sectionID uint) error {
+	if _, err := s.getOwnProfileSection(ctx, userID, sectionID); err != nil {
+		return err
+	}
+
+	if err := s.profileSectionRepo.Delete(ctx, sectionID); err != nil {
+		s.logger.Error("Failed to delete profile section", "section_id", sectionID, "error", err)
+		return errors.New("failed to delete profile section")
+	}
+	return nil
+}
+
+// UpdateSectionOrder stores the sections the user placed explicitly; any
+// section left out keeps its default relative position after them.
+func (s *userService) UpdateSectionOrder(ctx context.Context, userID uint, req *dto.SectionOrderRequest) ([]string, error) {
+	for _, section := range req.Sections {
+		if !slices.Contains(entities.DefaultProfileSectionOrder, section) {
+			return nil, errors.New("unknown profile section")
+		}
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("user not found")
+		}
+		s.logger.Error("Failed to get user", "error", err)
+		return nil, errors.New("failed to get user")
+	}
+
+	user.SectionOrder = req.Sections
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		s.logger.Error("Failed to update section order", "user_id", userID, "error", err)
+		return nil, errors.New("failed to update section order")
+	}
+
+	return resolveSectionOrder(user.SectionOrder), nil
+}
+
+func (s *userService) getOwnProfileSection(ctx context.Context, userID, sectionID uint) (*entities.ProfileSection, error) {
+	section, err := s.profileSectionRepo.GetByID(ctx, sectionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("profile section not found")
+		}
+		s.logger.Error("Failed to get profile section", "error", err)
+		return nil, errors.New("failed to get profile section")
+	}
+	if section.UserID != userID {
+		return nil, errors.New("profile section not found")
+	}
+	return section, nil
+}
+
+// profileSections is the best-effort variant used when assembling a profile.
+func (s *userService) profileSections(ctx context.Context, user *entities.User) []*dto.ProfileSectionGroup {
+	sections, err := s.profileSectionRepo.GetByUserID(ctx, user.ID)
+	if err != nil {
+		s.logger.Error("Failed to get profile sections", "user_id", user.ID, "error", err)
+		return nil
+	}
+	return groupProfileSections(sections, resolveSectionOrder(user.SectionOrder))
+}
+
+func resolveSectionOrder(stored []string) []string {
+	order := make([]string, 0, len(entities.DefaultProfileSectionOrder))
+	for _, section := range stored {
+		if slices.Contains(entities.DefaultProfileSectionOrder, section) && !slices.Contains(order, section) {
+			order = append(order, section)
+		}
+	}
+	for _, section := range entities.DefaultProfileSectionOrder {
+		if !slices.Contains(order, section) {
+			order = append(order, section)
+		}
+	}
+	return order
+}
+
+func groupProfileSections(sections []*entities.ProfileSection, order []string) []*dto.ProfileSectionGroup {
+	byType := make(map[entities.ProfileSectionType][]*dto.ProfileSectionResponse)
+	for _, section := range sections {
+		byType[section.Type] = append(byType[section.Type], mapProfileSection(section))
+	}
+
+	groups := make([]*dto.ProfileSectionGroup, 0, len(byType))
+	for _, key := range order {
+		if entries, ok := byType[entities.ProfileSectionType(key)]; ok {
+			groups = append(groups, &dto.ProfileSectionGroup{Type: entities.ProfileSectionType(key), Entries: entries})
+		}
+	}
+	return groups
+}
+
+// sectionFields checks the payload's dates and flattens it for storage.
+// Dates are ISO formatted, so they compare correctly as strings.
+func sectionFields(payload dto.SectionPayload) (map[string]interface{}, error) {
+	if start, end := payload.Period(); start != "" && end != "" && end < start {
+		return nil, errors.New("end date cannot be before start date")
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.New("invalid section payload")
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, errors.New("invalid section payload")
+	}
+	return fields, nil
+}
+
+func mapProfileSection(section *entities.ProfileSection) *dto.ProfileSectionResponse {
+	return &dto.ProfileSectionResponse{
+		ID:        section.ID,
+		Type:      section.Type,
+		Payload:   section.Payload,
+		CreatedAt: section.CreatedAt,
+		UpdatedAt: section.UpdatedAt,
+	}
+}
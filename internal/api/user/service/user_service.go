@@ -1,14 +1,33 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	apiKeyService "linked-clone/internal/api/apikey/service"
+	inviteService "linked-clone/internal/api/invite/service"
+	searchService "linked-clone/internal/api/search/service"
+	taxonomyService "linked-clone/internal/api/taxonomy/service"
+	uploadService "linked-clone/internal/api/upload/service"
 	"linked-clone/internal/api/user/dto"
 	"linked-clone/internal/domain/entities"
 	"linked-clone/internal/domain/repositories"
+	"linked-clone/internal/infrastructure/search"
+	"linked-clone/pkg/affinity"
+	"linked-clone/pkg/agegate"
+	"linked-clone/pkg/counter"
+	"linked-clone/pkg/eventbus"
+	"linked-clone/pkg/feed"
+	"linked-clone/pkg/graph"
+	"linked-clone/pkg/imaging"
 	"linked-clone/pkg/logger"
+	"linked-clone/pkg/moderation"
+	"linked-clone/pkg/presence"
 	"linked-clone/pkg/storage"
 	"mime/multipart"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
@@ -16,27 +35,90 @@ import (
 
 type UserService interface {
 	GetProfile(ctx context.Context, userID uint) (*dto.UserProfileResponse, error)
+	GetUsage(ctx context.Context, userID uint) (*dto.UsageResponse, error)
 	UpdateProfile(ctx context.Context, userID uint, req *dto.UpdateProfileRequest) (*dto.UserProfileResponse, error)
-	UploadProfilePicture(ctx context.Context, userID uint, file *multipart.FileHeader) (*dto.UploadResponse, error)
-	SearchUsers(ctx context.Context, query string, limit, offset int) ([]*dto.UserResponse, error)
-	GetUserByID(ctx context.Context, id uint) (*dto.UserResponse, error)
+	UploadProfilePicture(ctx context.Context, userID uint, req *dto.UploadProfilePictureRequest, file *multipart.FileHeader) (*dto.UploadResponse, error)
+	UploadProfileBanner(ctx context.Context, userID uint, file *multipart.FileHeader) (*dto.BannerResponse, error)
+	DeleteProfileBanner(ctx context.Context, userID uint) error
+	SearchUsers(ctx context.Context, viewerID uint, query string, limit, offset int) ([]*dto.UserResponse, error)
+	GetUserByID(ctx context.Context, viewerID, id uint) (*dto.UserResponse, error)
+	SearchTalent(ctx context.Context, recruiterID uint, req *dto.TalentSearchRequest, limit, offset int) ([]*dto.TalentResponse, error)
+	RecordProfileView(ctx context.Context, profileID, viewerID uint, viewer string)
+
+	GetExperiences(ctx context.Context, userID uint) ([]*dto.ExperienceResponse, error)
+	AddExperience(ctx context.Context, userID uint, req *dto.ExperienceRequest) (*dto.ExperienceResponse, error)
+	UpdateExperience(ctx context.Context, userID, experienceID uint, req *dto.ExperienceRequest) (*dto.ExperienceResponse, error)
+	DeleteExperience(ctx context.Context, userID, experienceID uint) error
+
+	GetProfileSections(ctx context.Context, userID uint) ([]*dto.ProfileSectionGroup, error)
+	AddProfileSection(ctx context.Context, userID uint, sectionType entities.ProfileSectionType, payload dto.SectionPayload) (*dto.ProfileSectionResponse, error)
+	UpdateProfileSection(ctx context.Context, userID, sectionID uint, sectionType entities.ProfileSectionType, payload dto.SectionPayload) (*dto.ProfileSectionResponse, error)
+	DeleteProfileSection(ctx context.Context, userID, sectionID uint) error
+	UpdateSectionOrder(ctx context.Context, userID uint, req *dto.SectionOrderRequest) ([]string, error)
 }
 
+const profileThumbnailSize = 256
+
 type userService struct {
-	userRepo       repositories.UserRepository
-	storageService storage.StorageService
-	logger         logger.Logger
+	userRepo           repositories.UserRepository
+	experienceRepo     repositories.ExperienceRepository
+	suggestionRepo     repositories.PostSuggestionRepository
+	badgeRepo          repositories.SkillBadgeRepository
+	certificateRepo    repositories.CertificateRepository
+	recommendationRepo repositories.RecommendationRepository
+	profileSectionRepo repositories.ProfileSectionRepository
+	storageService     storage.StorageService
+	viewCounter        counter.ViewCounter
+	moderator          moderation.ImageModerator
+	faceDetector       imaging.FaceDetector
+	graph              graph.Graph
+	presence           presence.Tracker
+	searchSvc          searchService.SearchService
+	taxonomySvc        taxonomyService.TaxonomyService
+	inviteSvc          inviteService.InviteService
+	apiKeySvc          apiKeyService.APIKeyService
+	logger             logger.Logger
 }
 
 func NewUserService(
 	userRepo repositories.UserRepository,
+	experienceRepo repositories.ExperienceRepository,
+	suggestionRepo repositories.PostSuggestionRepository,
+	badgeRepo repositories.SkillBadgeRepository,
+	certificateRepo repositories.CertificateRepository,
+	recommendationRepo repositories.RecommendationRepository,
+	profileSectionRepo repositories.ProfileSectionRepository,
 	storageService storage.StorageService,
+	viewCounter counter.ViewCounter,
+	moderator moderation.ImageModerator,
+	faceDetector imaging.FaceDetector,
+	graph graph.Graph,
+	presence presence.Tracker,
+	searchSvc searchService.SearchService,
+	taxonomySvc taxonomyService.TaxonomyService,
+	inviteSvc inviteService.InviteService,
+	apiKeySvc apiKeyService.APIKeyService,
 	logger logger.Logger,
 ) UserService {
 	return &userService{
-		userRepo:       userRepo,
-		storageService: storageService,
-		logger:         logger,
+		userRepo:           userRepo,
+		experienceRepo:     experienceRepo,
+		suggestionRepo:     suggestionRepo,
+		badgeRepo:          badgeRepo,
+		certificateRepo:    certificateRepo,
+		recommendationRepo: recommendationRepo,
+		profileSectionRepo: profileSectionRepo,
+		storageService:     storageService,
+		viewCounter:        viewCounter,
+		moderator:          moderator,
+		faceDetector:       faceDetector,
+		graph:              graph,
+		presence:           presence,
+		searchSvc:          searchSvc,
+		taxonomySvc:        taxonomySvc,
+		inviteSvc:          inviteSvc,
+		apiKeySvc:          apiKeySvc,
+		logger:             logger,
 	}
 }
 
@@ -63,18 +145,103 @@ func (s *userService) GetProfile(ctx context.Context, userID uint) (*dto.UserPro
 		}
 	}
 
+	profileThumbnailURL := ""
+	if user.ProfileThumbnail != "" {
+		if presignedURL, err := s.storageService.GeneratePresignedURL(user.ProfileThumbnail, 24*time.Hour); err == nil {
+			profileThumbnailURL = presignedURL
+		} else {
+			s.logger.Error("Failed to generate presigned URL for profile thumbnail",
+				"user_id", userID,
+				"error", err.Error())
+		}
+	}
+
+	bannerURL, bannerLargeURL, bannerSmallURL := s.profileBannerURLs(user)
+
 	return &dto.UserProfileResponse{
-		ID:             user.ID,
-		Email:          user.Email,
-		Username:       user.Username,
-		FullName:       user.FullName,
-		ProfilePicture: profilePictureURL,
-		Bio:            user.Bio,
-		Location:       user.Location,
-		Website:        user.Website,
-		IsVerified:     user.IsVerified,
-		IsPremium:      user.IsPremium,
-		CreatedAt:      user.CreatedAt,
+		ID:                 user.ID,
+		Email:              user.Email,
+		Username:           user.Username,
+		FullName:           user.FullName,
+		ProfilePicture:     profilePictureURL,
+		ProfileThumbnail:   profileThumbnailURL,
+		ProfileAltText:     user.ProfileAltText,
+		ProfileBanner:      bannerURL,
+		ProfileBannerLarge: bannerLargeURL,
+		ProfileBannerSmall: bannerSmallURL,
+		Bio:                user.Bio,
+		Location:           user.Location,
+		LocationID:         user.LocationID,
+		Industry:           user.Industry,
+		IndustryID:         user.IndustryID,
+		Website:            user.Website,
+		Headline:           user.Headline,
+		Skills:             user.Skills,
+		Badges:             s.skillBadges(ctx, user.ID),
+		Certificates:       s.courseCertificates(ctx, user.ID),
+		Recommendations:    s.profileRecommendations(ctx, user.ID),
+		Sections:           s.profileSections(ctx, user),
+		SectionOrder:       resolveSectionOrder(user.SectionOrder),
+		YearsOfExperience:  user.YearsOfExperience,
+		OpenToWork:         user.OpenToWork,
+		RecruiterVisible:   user.RecruiterVisible,
+		ShowPresence:       user.ShowPresence,
+		EmailVerified:      user.EmailVerified,
+		PhoneNumber:        user.PhoneNumber,
+		PhoneVerifiedAt:    user.PhoneVerifiedAt,
+		IsVerified:         user.IsVerified,
+		IsPremium:          user.IsPremium,
+		Plan:               user.Plan,
+		CreatedAt:          user.CreatedAt,
+	}, nil
+}
+
+func (s *userService) GetUsage(ctx context.Context, userID uint) (*dto.UsageResponse, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("user not found")
+		}
+		s.logger.Error("Failed to get user", "error", err)
+		return nil, errors.New("failed to get usage")
+	}
+
+	usage, err := s.userRepo.GetUsage(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get usage", "error", err, "user_id", userID)
+		return nil, errors.New("failed to get usage")
+	}
+
+	invites, err := s.inviteSvc.GetQuota(ctx, userID)
+	if err != nil {
+		return nil, errors.New("failed to get usage")
+	}
+
+	apiKeys, err := s.apiKeySvc.Usage(ctx, userID)
+	if err != nil {
+		return nil, errors.New("failed to get usage")
+	}
+
+	entitlements := user.Entitlements()
+	if entitlements == nil {
+		entitlements = []entities.Entitlement{}
+	}
+
+	return &dto.UsageResponse{
+		Plan: dto.PlanUsageResponse{
+			Name:         user.Plan,
+			PremiumUntil: user.PremiumUntil,
+			Entitlements: entitlements,
+		},
+		Storage: dto.StorageUsageResponse{
+			AttachmentCount: usage.AttachmentCount,
+			UsedBytes:       usage.AttachmentBytes,
+		},
+		Invitations: dto.InvitationUsageResponse{
+			PendingSent: usage.PendingInvitations,
+		},
+		Invites: invites,
+		APIKeys: apiKeys,
 	}, nil
 }
 
@@ -94,12 +261,46 @@ func (s *userService) UpdateProfile(ctx context.Context, userID uint, req *dto.U
 	if req.Bio != "" {
 		user.Bio = req.Bio
 	}
-	if req.Location != "" {
-		user.Location = req.Location
+	if req.LocationID != nil || req.Location != "" {
+		locationID, location, err := s.resolveLocation(ctx, req.LocationID, req.Location)
+		if err != nil {
+			return nil, err
+		}
+		user.LocationID = locationID
+		user.Location = location
+	}
+	if req.IndustryID != nil {
+		if err := s.applyIndustry(ctx, user, *req.IndustryID); err != nil {
+			return nil, err
+		}
 	}
 	if req.Website != "" {
 		user.Website = req.Website
 	}
+	if req.ProfileAltText != nil && user.ProfilePicture != "" {
+		user.ProfileAltText = *req.ProfileAltText
+	}
+	if req.Headline != nil {
+		user.Headline = *req.Headline
+	}
+	if req.Skills != nil {
+		user.Skills = normalizeSkills(req.Skills)
+	}
+	if req.YearsOfExperience != nil {
+		user.YearsOfExperience = *req.YearsOfExperience
+	}
+	if req.OpenToWork != nil {
+		user.OpenToWork = *req.OpenToWork
+	}
+	if req.RecruiterVisible != nil {
+		user.RecruiterVisible = *req.RecruiterVisible
+	}
+	if req.ShowPresence != nil {
+		user.ShowPresence = *req.ShowPresence
+	}
+	if err := applyAgeDetails(user, req); err != nil {
+		return nil, err
+	}
 
 	if err := s.userRepo.Update(ctx, user); err != nil {
 		s.logger.Error("Failed to update user", "error", err)
@@ -109,7 +310,23 @@ func (s *userService) UpdateProfile(ctx context.Context, userID uint, req *dto.U
 	return s.GetProfile(ctx, userID)
 }
 
-func (s *userService) UploadProfilePicture(ctx context.Context, userID uint, file *multipart.FileHeader) (*dto.UploadResponse, error) {
+func (s *userService) UploadProfilePicture(ctx context.Context, userID uint, req *dto.UploadProfilePictureRequest, file *multipart.FileHeader) (*dto.UploadResponse, error) {
+	data, err := readFile(file)
+	if err != nil {
+		s.logger.Error("Failed to read uploaded image", "error", err)
+		return nil, errors.New("failed to read image")
+	}
+
+	if s.moderator != nil {
+		if err := s.moderator.Check(ctx, data); err != nil {
+			if errors.Is(err, moderation.ErrImageRejected) {
+				s.logger.Warn("Profile picture rejected by moderation", "user_id", userID, "reason", err.Error())
+				return nil, err
+			}
+			s.logger.Error("Failed to moderate profile picture", "error", err)
+			return nil, errors.New("failed to moderate image")
+		}
+	}
 
 	fileKey, err := s.storageService.UploadImage(ctx, file, "profile-pictures")
 	if err != nil {
@@ -117,21 +334,35 @@ func (s *userService) UploadProfilePicture(ctx context.Context, userID uint, fil
 		return nil, errors.New("failed to upload image")
 	}
 
+	var thumbnailKey string
+	thumbnail, err := imaging.FaceCrop(ctx, bytes.NewReader(data), s.faceDetector, profileThumbnailSize)
+	if err != nil {
+		s.logger.Error("Failed to generate profile thumbnail", "error", err)
+	} else if thumbnailKey, err = s.storageService.UploadBytes(ctx, thumbnail, "profile-pictures/thumbnails", ".jpg"); err != nil {
+		s.logger.Error("Failed to upload profile thumbnail", "error", err)
+		thumbnailKey = ""
+	}
+
 	user, err := s.userRepo.GetByID(ctx, userID)
 	if err != nil {
 		s.logger.Error("Failed to get user", "error", err)
 		return nil, errors.New("failed to get user")
 	}
 
-	if user.ProfilePicture != "" {
-		go func() {
-			if err := s.storageService.DeleteFile(context.Background(), user.ProfilePicture); err != nil {
+	for _, oldKey := range []string{user.ProfilePicture, user.ProfileThumbnail} {
+		if oldKey == "" {
+			continue
+		}
+		go func(key string) {
+			if err := s.storageService.DeleteFile(context.Background(), key); err != nil {
 				s.logger.Error("Failed to delete old profile picture", "error", err)
 			}
-		}()
+		}(oldKey)
 	}
 
 	user.ProfilePicture = fileKey
+	user.ProfileThumbnail = thumbnailKey
+	user.ProfileAltText = req.AltText
 	if err := s.userRepo.Update(ctx, user); err != nil {
 		s.logger.Error("Failed to update user profile picture", "error", err)
 		return nil, errors.New("failed to update profile picture")
@@ -145,17 +376,54 @@ func (s *userService) UploadProfilePicture(ctx context.Context, userID uint, fil
 		return nil, errors.New("failed to generate access URL for uploaded image")
 	}
 
-	return &dto.UploadResponse{
-		URL: presignedURL,
-	}, nil
+	response := &dto.UploadResponse{
+		URL:     presignedURL,
+		AltText: user.ProfileAltText,
+	}
+
+	if thumbnailKey != "" {
+		if thumbnailURL, err := s.storageService.GeneratePresignedURL(thumbnailKey, 24*time.Hour); err == nil {
+			response.ThumbnailURL = thumbnailURL
+		} else {
+			s.logger.Error("Failed to generate thumbnail presigned URL", "error", err)
+		}
+	}
+
+	return response, nil
+}
+
+func readFile(file *multipart.FileHeader) ([]byte, error) {
+	src, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer src.Close()
+
+	return io.ReadAll(src)
 }
 
-func (s *userService) SearchUsers(ctx context.Context, query string, limit, offset int) ([]*dto.UserResponse, error) {
-	users, err := s.userRepo.Search(ctx, query, limit, offset)
+func (s *userService) SearchUsers(ctx context.Context, viewerID uint, query string, limit, offset int) ([]*dto.UserResponse, error) {
+	fetchLimit, fetchOffset := searchService.FetchWindow(limit, offset)
+	hits, err := s.searchSvc.Find(ctx, search.Query{Text: query, Types: []search.DocumentType{search.TypeUser}, Limit: fetchLimit, Offset: fetchOffset})
 	if err != nil {
 		s.logger.Error("Failed to search users", "error", err)
 		return nil, errors.New("failed to search users")
 	}
+	users, err := s.userRepo.GetByIDs(ctx, search.IDs(hits))
+	if err != nil {
+		s.logger.Error("Failed to load users for search", "error", err)
+		return nil, errors.New("failed to search users")
+	}
+
+	if offset == 0 {
+		s.searchSvc.RecordSearch(ctx, viewerID, entities.SearchTypePeople, query, nil)
+	}
+
+	candidates := make([]searchService.Candidate, 0, len(users))
+	for _, user := range users {
+		candidates = append(candidates, searchService.Candidate{UserID: user.ID})
+	}
+	users = searchService.Pick(users, s.searchSvc.Personalize(ctx, viewerID, candidates, limit, offset))
 
 	var responses []*dto.UserResponse
 	for _, user := range users {
@@ -171,22 +439,27 @@ func (s *userService) SearchUsers(ctx context.Context, query string, limit, offs
 		}
 
 		responses = append(responses, &dto.UserResponse{
-			ID:             user.ID,
-			Username:       user.Username,
-			FullName:       user.FullName,
-			ProfilePicture: profilePictureURL,
-			Bio:            user.Bio,
-			Location:       user.Location,
-			Website:        user.Website,
-			IsVerified:     user.IsVerified,
-			IsPremium:      user.IsPremium,
+			ID:                     user.ID,
+			Username:               user.Username,
+			FullName:               user.FullName,
+			ProfilePicture:         profilePictureURL,
+			ProfileAltText:         user.ProfileAltText,
+			Bio:                    user.Bio,
+			Location:               user.Location,
+			LocationID:             user.LocationID,
+			Industry:               user.Industry,
+			IndustryID:             user.IndustryID,
+			Website:                user.Website,
+			IsVerified:             user.IsVerified,
+			IsPremium:              user.IsPremium,
+			MutualConnectionsCount: s.mutualConnectionsCount(ctx, viewerID, user.ID),
 		})
 	}
 
 	return responses, nil
 }
 
-func (s *userService) GetUserByID(ctx context.Context, id uint) (*dto.UserResponse, error) {
+func (s *userService) GetUserByID(ctx context.Context, viewerID, id uint) (*dto.UserResponse, error) {
 	user, err := s.userRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -207,52 +480,310 @@ func (s *userService) GetUserByID(ctx context.Context, id uint) (*dto.UserRespon
 		}
 	}
 
+	bannerURL, bannerLargeURL, bannerSmallURL := s.profileBannerURLs(user)
+
 	return &dto.UserResponse{
-		ID:             user.ID,
-		Username:       user.Username,
-		FullName:       user.FullName,
-		ProfilePicture: profilePictureURL,
-		Bio:            user.Bio,
-		Location:       user.Location,
-		Website:        user.Website,
-		IsVerified:     user.IsVerified,
-		IsPremium:      user.IsPremium,
+		ID:                     user.ID,
+		Username:               user.Username,
+		FullName:               user.FullName,
+		ProfilePicture:         profilePictureURL,
+		ProfileAltText:         user.ProfileAltText,
+		ProfileBanner:          bannerURL,
+		ProfileBannerLarge:     bannerLargeURL,
+		ProfileBannerSmall:     bannerSmallURL,
+		Bio:                    user.Bio,
+		Location:               user.Location,
+		LocationID:             user.LocationID,
+		Industry:               user.Industry,
+		IndustryID:             user.IndustryID,
+		Website:                user.Website,
+		IsVerified:             user.IsVerified,
+		IsPremium:              user.IsPremium,
+		Badges:                 s.skillBadges(ctx, user.ID),
+		Certificates:           s.courseCertificates(ctx, user.ID),
+		Recommendations:        s.profileRecommendations(ctx, user.ID),
+		Sections:               s.profileSections(ctx, user),
+		SectionOrder:           resolveSectionOrder(user.SectionOrder),
+		MutualConnectionsCount: s.mutualConnectionsCount(ctx, viewerID, user.ID),
+		Presence:               s.presenceFor(ctx, viewerID, user),
 	}, nil
 }
 
+func (s *userService) skillBadges(ctx context.Context, userID uint) []*dto.SkillBadgeResponse {
+	badges, err := s.badgeRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get skill badges", "user_id", userID, "error", err)
+		return nil
+	}
+
+	responses := make([]*dto.SkillBadgeResponse, 0, len(badges))
+	for _, badge := range badges {
+		responses = append(responses, &dto.SkillBadgeResponse{
+			AssessmentID: badge.AssessmentID,
+			Skill:        badge.Skill,
+			Score:        badge.Score,
+			EarnedAt:     badge.EarnedAt,
+		})
+	}
+	return responses
+}
+
+func (s *userService) courseCertificates(ctx context.Context, userID uint) []*dto.CertificateResponse {
+	certificates, err := s.certificateRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get course certificates", "user_id", userID, "error", err)
+		return nil
+	}
+
+	responses := make([]*dto.CertificateResponse, 0, len(certificates))
+	for _, certificate := range certificates {
+		responses = append(responses, &dto.CertificateResponse{
+			Code:        certificate.Code,
+			CourseID:    certificate.CourseID,
+			CourseTitle: certificate.Course.Title,
+			IssuedAt:    certificate.IssuedAt,
+		})
+	}
+	return responses
+}
+
+func (s *userService) profileRecommendations(ctx context.Context, userID uint) []*dto.RecommendationResponse {
+	recommendations, err := s.recommendationRepo.GetVisible(ctx, userID, 50, 0)
+	if err != nil {
+		s.logger.Error("Failed to get recommendations", "user_id", userID, "error", err)
+		return nil
+	}
+
+	responses := make([]*dto.RecommendationResponse, 0, len(recommendations))
+	for _, recommendation := range recommendations {
+		responses = append(responses, &dto.RecommendationResponse{
+			ID:             recommendation.ID,
+			AuthorID:       recommendation.AuthorID,
+			AuthorName:     recommendation.Author.FullName,
+			AuthorHeadline: recommendation.Author.Headline,
+			Relationship:   recommendation.Relationship,
+			Body:           recommendation.Body,
+			ApprovedAt:     recommendation.ApprovedAt,
+		})
+	}
+	return responses
+}
+
+func (s *userService) presenceFor(ctx context.Context, viewerID uint, user *entities.User) *dto.PresenceResponse {
+	if viewerID == 0 || s.presence == nil {
+		return nil
+	}
+	if !user.ShowPresence && viewerID != user.ID {
+		return &dto.PresenceResponse{Status: presence.StatusHidden}
+	}
+
+	status, lastActiveAt := s.presence.Resolve(ctx, user.ID, user.LastActiveAt)
+	return &dto.PresenceResponse{Status: status, LastActiveAt: lastActiveAt}
+}
+
+func (s *userService) mutualConnectionsCount(ctx context.Context, viewerID, userID uint) *int {
+	if viewerID == 0 || viewerID == userID {
+		return nil
+	}
+
+	mutual, err := s.graph.MutualConnections(ctx, viewerID, userID)
+	if err != nil {
+		s.logger.Error("Failed to count mutual connections", "user_id", userID, "error", err)
+		return nil
+	}
+	count := len(mutual)
+	return &count
+}
+
+func (s *userService) SearchTalent(ctx context.Context, recruiterID uint, req *dto.TalentSearchRequest, limit, offset int) ([]*dto.TalentResponse, error) {
+	filters := map[string]interface{}{"exclude_user_id": recruiterID}
+	if skills := normalizeSkills(req.Skills); len(skills) > 0 {
+		filters["skills"] = skills
+	}
+	if req.Title != "" {
+		filters["title"] = req.Title
+	}
+	if req.LocationID != nil {
+		filters["location_id"] = *req.LocationID
+	} else if req.Location != "" {
+		filters["location"] = req.Location
+	}
+	if req.IndustryID != nil {
+		filters["industry_id"] = *req.IndustryID
+	}
+	if req.MinExperience != nil {
+		filters["min_experience"] = *req.MinExperience
+	}
+	if req.MaxExperience != nil {
+		filters["max_experience"] = *req.MaxExperience
+	}
+	if req.OpenToWork != nil {
+		filters["open_to_work"] = *req.OpenToWork
+	}
+
+	users, err := s.userRepo.SearchTalent(ctx, filters, limit, offset)
+	if err != nil {
+		s.logger.Error("Failed to search talent", "error", err)
+		return nil, errors.New("failed to search talent")
+	}
+
+	responses := make([]*dto.TalentResponse, 0, len(users))
+	for _, user := range users {
+		profilePictureURL := ""
+		if user.ProfilePicture != "" {
+			if presignedURL, err := s.storageService.GeneratePresignedURL(user.ProfilePicture, 24*time.Hour); err == nil {
+				profilePictureURL = presignedURL
+			} else {
+				s.logger.Error("Failed to generate presigned URL for user in talent search",
+					"user_id", user.ID,
+					"error", err.Error())
+			}
+		}
+
+		responses = append(responses, &dto.TalentResponse{
+			ID:                user.ID,
+			Username:          user.Username,
+			FullName:          user.FullName,
+			ProfilePicture:    profilePictureURL,
+			ProfileAltText:    user.ProfileAltText,
+			Headline:          user.Headline,
+			Location:          user.Location,
+			LocationID:        user.LocationID,
+			Industry:          user.Industry,
+			IndustryID:        user.IndustryID,
+			Skills:            user.Skills,
+			YearsOfExperience: user.YearsOfExperience,
+			OpenToWork:        user.OpenToWork,
+			IsVerified:        user.IsVerified,
+		})
+	}
+
+	s.logger.Info("Talent search performed", "recruiter_id", recruiterID, "results", len(responses))
+
+	return responses, nil
+}
+
+// resolveLocation returns the location ID and label to store for a request
+// carrying a location ID, free text, or both. Unmatched text is kept as typed
+// without an ID; a zero ID with no text clears the location.
+func (s *userService) resolveLocation(ctx context.Context, locationID *uint, text string) (*uint, string, error) {
+	location, err := s.taxonomySvc.ResolveLocation(ctx, locationID, text)
+	if err != nil {
+		return nil, "", err
+	}
+	if location == nil {
+		return nil, strings.TrimSpace(text), nil
+	}
+	return &location.ID, location.DisplayName(), nil
+}
+
+func (s *userService) applyIndustry(ctx context.Context, user *entities.User, industryID uint) error {
+	if industryID == 0 {
+		user.IndustryID = nil
+		user.Industry = ""
+		return nil
+	}
+
+	industry, err := s.taxonomySvc.GetIndustry(ctx, industryID)
+	if err != nil {
+		return err
+	}
+	user.IndustryID = &industry.ID
+	user.Industry = industry.Name
+	return nil
+}
+
+func normalizeSkills(skills []string) []string {
+	seen := make(map[string]bool, len(skills))
+	normalized := make([]string, 0, len(skills))
+	for _, skill := range skills {
+		skill = strings.ToLower(strings.TrimSpace(skill))
+		if skill == "" || seen[skill] {
+			continue
+		}
+		seen[skill] = true
+		normalized = append(normalized, skill)
+	}
+	return normalized
+}
+
+func (s *userService) RecordProfileView(ctx context.Context, profileID, viewerID uint, viewer string) {
+	if profileID == viewerID {
+		return
+	}
+
+	if err := s.viewCounter.Record(ctx, counter.ViewProfile, profileID, viewer); err != nil {
+		s.logger.Error("Failed to record profile view", "error", err, "user_id", profileID)
+	}
+
+	if viewerID != 0 {
+		s.searchSvc.RecordInteraction(ctx, viewerID, affinity.KindUser, profileID, affinity.WeightView)
+	}
+}
+
 type ConnectionService interface {
 	SendConnectionRequest(ctx context.Context, requesterID, addresseeID uint) (*dto.ConnectionResponse, error)
 	AcceptConnectionRequest(ctx context.Context, userID, connectionID uint) (*dto.ConnectionResponse, error)
 	RejectConnectionRequest(ctx context.Context, userID, connectionID uint) error
 	RemoveConnection(ctx context.Context, userID, connectionID uint) error
+	BulkRespondToRequests(ctx context.Context, userID uint, req *dto.BulkConnectionActionRequest) (*dto.BulkConnectionActionResponse, error)
 	GetUserConnections(ctx context.Context, userID uint, limit, offset int) ([]*dto.ConnectionResponse, error)
 	GetConnectionRequests(ctx context.Context, userID uint, limit, offset int) ([]*dto.ConnectionResponse, error)
 	GetSentRequests(ctx context.Context, userID uint, limit, offset int) ([]*dto.ConnectionResponse, error)
 	GetConnectionStatus(ctx context.Context, userID1, userID2 uint) (*dto.ConnectionResponse, error)
 	GetMutualConnections(ctx context.Context, userID1, userID2 uint, limit, offset int) ([]*dto.ConnectionResponse, error)
+	GetMutualConnectionsCount(ctx context.Context, userID1, userID2 uint) (int, error)
+	GetConnectionDegree(ctx context.Context, userID, targetUserID uint) (*dto.ConnectionDegreeResponse, error)
 	BlockUser(ctx context.Context, userID, targetUserID uint) error
 	UnblockUser(ctx context.Context, userID, targetUserID uint) error
+
+	ExportConnections(ctx context.Context, userID uint) ([]byte, error)
+	StartImport(ctx context.Context, userID uint, file *multipart.FileHeader) (*dto.ConnectionImportResponse, error)
+	GetImport(ctx context.Context, userID, importID uint) (*dto.ConnectionImportResponse, error)
+
+	GetSuggestions(ctx context.Context, userID uint, limit, offset int) ([]*dto.ConnectionSuggestionResponse, error)
+	DismissSuggestion(ctx context.Context, userID, suggestedUserID uint) error
 }
 
 type connectionService struct {
 	connectionRepo repositories.ConnectionRepository
+	importRepo     repositories.ConnectionImportRepository
+	suggestionRepo repositories.ConnectionSuggestionRepository
 	userRepo       repositories.UserRepository
 	storageService storage.StorageService
+	feedStore      feed.Store
+	graph          graph.Graph
+	events         eventbus.Bus
+	uploads        uploadService.UploadService
 	logger         logger.Logger
 }
 
 func NewConnectionService(
 	connectionRepo repositories.ConnectionRepository,
+	importRepo repositories.ConnectionImportRepository,
+	suggestionRepo repositories.ConnectionSuggestionRepository,
 	userRepo repositories.UserRepository,
 	storageService storage.StorageService,
+	feedStore feed.Store,
+	graph graph.Graph,
+	events eventbus.Bus,
+	uploads uploadService.UploadService,
 	logger logger.Logger,
 ) ConnectionService {
-	return &connectionService{
+	s := &connectionService{
 		connectionRepo: connectionRepo,
+		importRepo:     importRepo,
+		suggestionRepo: suggestionRepo,
 		userRepo:       userRepo,
 		storageService: storageService,
+		feedStore:      feedStore,
+		graph:          graph,
+		events:         events,
+		uploads:        uploads,
 		logger:         logger,
 	}
+	uploads.Register(entities.UploadKindConnectionsImport, &connectionImportProcessor{s: s})
+	return s
 }
 
 func (s *connectionService) SendConnectionRequest(ctx context.Context, requesterID, addresseeID uint) (*dto.ConnectionResponse, error) {
@@ -271,6 +802,15 @@ func (s *connectionService) SendConnectionRequest(ctx context.Context, requester
 		return nil, errors.New("cannot send connection request to yourself")
 	}
 
+	blocked, err := s.graph.IsBlocked(ctx, requesterID, addresseeID)
+	if err != nil {
+		s.logger.Error("Failed to check blocked users", "error", err)
+		return nil, errors.New("failed to send connection request")
+	}
+	if blocked {
+		return nil, errors.New("cannot send connection request")
+	}
+
 	existingConnection, _ := s.connectionRepo.FindConnection(ctx, requesterID, addresseeID)
 	if existingConnection != nil {
 		switch existingConnection.Status {
@@ -295,7 +835,17 @@ func (s *connectionService) SendConnectionRequest(ctx context.Context, requester
 		return nil, errors.New("failed to send connection request")
 	}
 
-	return s.mapConnectionToResponse(connection, requester, addressee), nil
+	resp := s.mapConnectionToResponse(connection, requester, addressee)
+	s.events.Publish(ctx, &eventbus.Event{
+		Type:        eventbus.ConnectionRequested,
+		RecipientID: addresseeID,
+		ActorID:     requesterID,
+		EntityType:  "connection",
+		EntityID:    connection.ID,
+		Data:        resp,
+	})
+
+	return resp, nil
 }
 
 func (s *connectionService) AcceptConnectionRequest(ctx context.Context, userID, connectionID uint) (*dto.ConnectionResponse, error) {
@@ -323,13 +873,32 @@ func (s *connectionService) AcceptConnectionRequest(ctx context.Context, userID,
 		s.logger.Error("Failed to accept connection request", "error", err)
 		return nil, errors.New("failed to accept connection request")
 	}
+	if err := s.graph.Connect(ctx, connection.RequesterID, connection.AddresseeID); err != nil {
+		s.logger.Warn("Failed to update cached connection graph", "error", err)
+	}
+	s.invalidateFeeds(ctx, connection.RequesterID, connection.AddresseeID)
 
 	updatedConnection, err := s.connectionRepo.GetByID(ctx, connectionID)
 	if err != nil {
 		return nil, errors.New("failed to get updated connection")
 	}
 
-	return s.mapConnectionToResponse(updatedConnection, &updatedConnection.Requester, &updatedConnection.Addressee), nil
+	return s.publishAccepted(ctx, userID, updatedConnection), nil
+}
+
+// publishAccepted tells the requester that userID accepted their request,
+// with the connection as the payload.
+func (s *connectionService) publishAccepted(ctx context.Context, userID uint, connection *entities.Connection) *dto.ConnectionResponse {
+	resp := s.mapConnectionToResponse(connection, &connection.Requester, &connection.Addressee)
+	s.events.Publish(ctx, &eventbus.Event{
+		Type:        eventbus.ConnectionAccepted,
+		RecipientID: connection.RequesterID,
+		ActorID:     userID,
+		EntityType:  "connection",
+		EntityID:    connection.ID,
+		Data:        resp,
+	})
+	return resp
 }
 
 func (s *connectionService) RejectConnectionRequest(ctx context.Context, userID, connectionID uint) error {
@@ -357,6 +926,61 @@ func (s *connectionService) RejectConnectionRequest(ctx context.Context, userID,
 	return nil
 }
 
+func (s *connectionService) BulkRespondToRequests(ctx context.Context, userID uint, req *dto.BulkConnectionActionRequest) (*dto.BulkConnectionActionResponse, error) {
+	ids := make([]uint, 0, len(req.ConnectionIDs))
+	seen := make(map[uint]bool, len(req.ConnectionIDs))
+	for _, id := range req.ConnectionIDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	accept := req.Action == "accept"
+	connections, err := s.connectionRepo.RespondToRequests(ctx, userID, ids, accept)
+	if err != nil {
+		s.logger.Error("Failed to process connection requests", "error", err, "action", req.Action)
+		return nil, fmt.Errorf("failed to %s connection requests", req.Action)
+	}
+
+	processed := make(map[uint]bool, len(connections))
+	for _, connection := range connections {
+		processed[connection.ID] = true
+		if !accept {
+			continue
+		}
+		if err := s.graph.Connect(ctx, connection.RequesterID, connection.AddresseeID); err != nil {
+			s.logger.Warn("Failed to update cached connection graph", "error", err)
+		}
+		s.invalidateFeeds(ctx, connection.RequesterID, connection.AddresseeID)
+
+		accepted, err := s.connectionRepo.GetByID(ctx, connection.ID)
+		if err != nil {
+			s.logger.Warn("Failed to load accepted connection", "error", err, "connection_id", connection.ID)
+			continue
+		}
+		s.publishAccepted(ctx, userID, accepted)
+	}
+
+	result := &dto.BulkConnectionActionResponse{
+		Action:    req.Action,
+		Processed: make([]uint, 0, len(connections)),
+		Failed:    make([]dto.BulkConnectionFailure, 0),
+	}
+	for _, id := range ids {
+		if processed[id] {
+			result.Processed = append(result.Processed, id)
+			continue
+		}
+		result.Failed = append(result.Failed, dto.BulkConnectionFailure{
+			ConnectionID: id,
+			Error:        "connection request not found or not pending",
+		})
+	}
+
+	return result, nil
+}
+
 func (s *connectionService) RemoveConnection(ctx context.Context, userID, connectionID uint) error {
 	connection, err := s.connectionRepo.GetByID(ctx, connectionID)
 	if err != nil {
@@ -374,6 +998,10 @@ func (s *connectionService) RemoveConnection(ctx context.Context, userID, connec
 		s.logger.Error("Failed to remove connection", "error", err)
 		return errors.New("failed to remove connection")
 	}
+	if err := s.graph.Disconnect(ctx, connection.RequesterID, connection.AddresseeID); err != nil {
+		s.logger.Warn("Failed to update cached connection graph", "error", err)
+	}
+	s.invalidateFeeds(ctx, connection.RequesterID, connection.AddresseeID)
 
 	return nil
 }
@@ -460,6 +1088,29 @@ func (s *connectionService) GetMutualConnections(ctx context.Context, userID1, u
 	return responses, nil
 }
 
+func (s *connectionService) GetMutualConnectionsCount(ctx context.Context, userID1, userID2 uint) (int, error) {
+	mutual, err := s.graph.MutualConnections(ctx, userID1, userID2)
+	if err != nil {
+		s.logger.Error("Failed to count mutual connections", "error", err)
+		return 0, errors.New("failed to get mutual connections")
+	}
+	return len(mutual), nil
+}
+
+func (s *connectionService) GetConnectionDegree(ctx context.Context, userID, targetUserID uint) (*dto.ConnectionDegreeResponse, error) {
+	if _, err := s.userRepo.GetByID(ctx, targetUserID); err != nil {
+		return nil, errors.New("target user not found")
+	}
+
+	degree, err := s.graph.Degree(ctx, userID, targetUserID)
+	if err != nil {
+		s.logger.Error("Failed to compute connection degree", "error", err)
+		return nil, errors.New("failed to get connection degree")
+	}
+
+	return &dto.ConnectionDegreeResponse{UserID: targetUserID, Degree: degree}, nil
+}
+
 func (s *connectionService) BlockUser(ctx context.Context, userID, targetUserID uint) error {
 	if userID == targetUserID {
 		return errors.New("cannot block yourself")
@@ -474,11 +1125,15 @@ func (s *connectionService) BlockUser(ctx context.Context, userID, targetUserID
 
 	if existingConnection != nil {
 
+		wasConnected := existingConnection.Status == entities.ConnectionAccepted
 		existingConnection.Status = entities.ConnectionBlocked
 		if err := s.connectionRepo.Update(ctx, existingConnection); err != nil {
 			s.logger.Error("Failed to update connection to blocked", "error", err)
 			return errors.New("failed to block user")
 		}
+		if wasConnected {
+			s.invalidateFeeds(ctx, userID, targetUserID)
+		}
 	} else {
 
 		connection := &entities.Connection{
@@ -494,6 +1149,10 @@ func (s *connectionService) BlockUser(ctx context.Context, userID, targetUserID
 		}
 	}
 
+	if err := s.graph.Block(ctx, userID, targetUserID); err != nil {
+		s.logger.Warn("Failed to update cached connection graph", "error", err)
+	}
+
 	return nil
 }
 
@@ -511,10 +1170,19 @@ func (s *connectionService) UnblockUser(ctx context.Context, userID, targetUserI
 		s.logger.Error("Failed to unblock user", "error", err)
 		return errors.New("failed to unblock user")
 	}
+	if err := s.graph.Unblock(ctx, userID, targetUserID); err != nil {
+		s.logger.Warn("Failed to update cached connection graph", "error", err)
+	}
 
 	return nil
 }
 
+func (s *connectionService) invalidateFeeds(ctx context.Context, userIDs ...uint) {
+	if err := s.feedStore.Invalidate(ctx, userIDs...); err != nil {
+		s.logger.Warn("Failed to invalidate precomputed feeds", "error", err)
+	}
+}
+
 func (s *connectionService) mapConnectionToResponse(connection *entities.Connection, requester *entities.User, addressee *entities.User) *dto.ConnectionResponse {
 	response := &dto.ConnectionResponse{
 		ID:          connection.ID,
@@ -570,3 +1238,29 @@ func (s *connectionService) mapConnectionToResponse(connection *entities.Connect
 
 	return response
 }
+
+func applyAgeDetails(user *entities.User, req *dto.UpdateProfileRequest) error {
+	region := user.Region
+	if req.Region != nil {
+		if user.Region != "" && agegate.NormalizeRegion(*req.Region) != user.Region {
+			return errors.New("region cannot be changed")
+		}
+		region = agegate.NormalizeRegion(*req.Region)
+	}
+
+	if req.DateOfBirth != nil {
+		if user.DateOfBirth != "" {
+			return errors.New("date of birth cannot be changed")
+		}
+		if region == "" {
+			return errors.New("region is required to set date of birth")
+		}
+		if err := agegate.CheckRegistration(*req.DateOfBirth, region, time.Now()); err != nil {
+			return err
+		}
+		user.DateOfBirth = *req.DateOfBirth
+	}
+
+	user.Region = region
+	return nil
+}
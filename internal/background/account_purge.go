@@ -0,0 +1,359 @@
+package background
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/storage"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// AccountPurgeConfig sets how often accounts past their grace period are
+// purged, how many per run and how many times a failing one is tried.
+type AccountPurgeConfig struct {
+	Interval    time.Duration
+	BatchSize   int
+	MaxAttempts int
+}
+
+type AccountPurgeService struct {
+	deletionRepo   repositories.AccountDeletionRepository
+	storageService storage.StorageService
+	leader         LeaderElector
+	logger         logger.StructuredLogger
+	ticker         *time.Ticker
+	stopChan       chan struct{}
+	wg             sync.WaitGroup
+	mu             sync.Mutex
+	running        bool
+
+	interval        time.Duration
+	batchSize       int
+	maxAttempts     int
+	totalRuns       int64
+	failedRuns      int64
+	accountsPurged  int64
+	accountsFailed  int64
+	objectsDeleted  int64
+	lastRunTime     time.Time
+	lastRunDuration time.Duration
+	lastRunStatus   string
+}
+
+func NewAccountPurgeService(
+	deletionRepo repositories.AccountDeletionRepository,
+	storageService storage.StorageService,
+	cfg AccountPurgeConfig,
+	leader LeaderElector,
+	logger logger.StructuredLogger,
+) *AccountPurgeService {
+	if cfg.Interval <= 0 {
+		cfg.Interval = time.Hour
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 20
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 5
+	}
+
+	return &AccountPurgeService{
+		deletionRepo:   deletionRepo,
+		storageService: storageService,
+		leader:         leader,
+		logger:         logger,
+		stopChan:       make(chan struct{}),
+		lastRunStatus:  "never_run",
+		interval:       cfg.Interval,
+		batchSize:      cfg.BatchSize,
+		maxAttempts:    cfg.MaxAttempts,
+	}
+}
+
+func (s *AccountPurgeService) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		s.logger.Warn("Account purge service already running")
+		return
+	}
+
+	s.ticker = time.NewTicker(s.interval)
+	s.running = true
+	s.wg.Add(1)
+
+	s.logger.Info("Starting account purge service",
+		"interval", s.interval.String(),
+		"batch_size", s.batchSize,
+		"max_attempts", s.maxAttempts)
+
+	go func() {
+		defer s.wg.Done()
+		defer s.logger.Info("Account purge service stopped")
+
+		s.performPurge(ctx)
+
+		for {
+			select {
+			case <-s.ticker.C:
+				s.performPurge(ctx)
+			case <-s.stopChan:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (s *AccountPurgeService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.running {
+		return
+	}
+
+	s.logger.Info("Stopping account purge service...")
+
+	s.running = false
+	if s.ticker != nil {
+		s.ticker.Stop()
+	}
+	close(s.stopChan)
+	s.wg.Wait()
+}
+
+func (s *AccountPurgeService) IsRunning() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+func (s *AccountPurgeService) GetMetrics() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return map[string]interface{}{
+		"interval":                  s.interval.String(),
+		"batch_size":                s.batchSize,
+		"max_attempts":              s.maxAttempts,
+		"total_runs":                atomic.LoadInt64(&s.totalRuns),
+		"failed_runs":               atomic.LoadInt64(&s.failedRuns),
+		"accounts_purged":           atomic.LoadInt64(&s.accountsPurged),
+		"accounts_failed":           atomic.LoadInt64(&s.accountsFailed),
+		"objects_deleted":           atomic.LoadInt64(&s.objectsDeleted),
+		"last_run":                  s.lastRunTime.Format(time.RFC3339),
+		"last_run_duration_seconds": s.lastRunDuration.Seconds(),
+		"last_run_status":           s.lastRunStatus,
+	}
+}
+
+func (s *AccountPurgeService) performPurge(ctx context.Context) {
+	if !s.leader.IsLeader() {
+		return
+	}
+
+	start := time.Now()
+	atomic.AddInt64(&s.totalRuns, 1)
+
+	deletions, err := s.deletionRepo.ClaimDue(ctx, start, start.Add(-2*s.interval), s.maxAttempts, s.batchSize)
+
+	status := "success"
+	purged, failed := 0, 0
+	if err != nil {
+		status = "failed"
+	} else {
+		for _, deletion := range deletions {
+			select {
+			case <-ctx.Done():
+				err = ctx.Err()
+			case <-s.stopChan:
+				err = errors.New("account purge service stopping")
+			default:
+			}
+			if err != nil {
+				status = "failed"
+				break
+			}
+
+			if purgeErr := s.purgeAccount(ctx, deletion); purgeErr != nil {
+				failed++
+				status = "partial"
+				continue
+			}
+			purged++
+		}
+	}
+
+	s.mu.Lock()
+	s.lastRunTime = start
+	s.lastRunDuration = time.Since(start)
+	s.lastRunStatus = status
+	s.mu.Unlock()
+
+	if err != nil {
+		atomic.AddInt64(&s.failedRuns, 1)
+		s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+			Event:    "account_purge_failed",
+			Entity:   "account_deletion",
+			Success:  false,
+			Duration: time.Since(start),
+			Error:    err.Error(),
+		})
+		return
+	}
+
+	s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+		Event:    "account_purge_completed",
+		Entity:   "account_deletion",
+		Success:  failed == 0,
+		Duration: time.Since(start),
+		Details: map[string]interface{}{
+			"claimed": len(deletions),
+			"purged":  purged,
+			"failed":  failed,
+		},
+	})
+}
+
+func (s *AccountPurgeService) purgeAccount(ctx context.Context, deletion *entities.AccountDeletion) error {
+	for _, stage := range entities.PurgeStages {
+		if deletion.StageCompleted(stage) {
+			continue
+		}
+
+		affected, err := s.runStage(ctx, deletion, stage)
+		if err != nil {
+			atomic.AddInt64(&s.accountsFailed, 1)
+			deletion.Status = entities.AccountDeletionFailed
+			deletion.LastError = fmt.Sprintf("%s: %v", stage, err)
+			if stage == entities.PurgeStageVerify {
+				deletion.CompletedStage = ""
+				deletion.Report.Stages = nil
+			}
+			s.saveDeletion(ctx, deletion)
+
+			s.logger.Error("Account purge stage failed",
+				"error", err,
+				"deletion_id", deletion.ID,
+				"user_id", deletion.UserID,
+				"stage", stage,
+				"attempt", deletion.Attempts)
+			return err
+		}
+
+		deletion.CompletedStage = stage
+		deletion.Report.Stages = append(deletion.Report.Stages, entities.PurgeStageResult{
+			Stage:       stage,
+			Affected:    affected,
+			CompletedAt: time.Now(),
+		})
+		if stage != entities.PurgeStageVerify {
+			s.saveDeletion(ctx, deletion)
+		}
+	}
+
+	now := time.Now()
+	deletion.Status = entities.AccountDeletionCompleted
+	deletion.CompletedAt = &now
+	deletion.Reason = ""
+	deletion.LastError = ""
+	deletion.Report.Digest = deletion.Report.ComputeDigest(deletion.UserID)
+	if err := s.deletionRepo.Update(ctx, deletion); err != nil {
+		s.logger.Error("Failed to record account purge completion", "error", err, "deletion_id", deletion.ID)
+		return err
+	}
+
+	atomic.AddInt64(&s.accountsPurged, 1)
+
+	s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+		Event:    "account_purged",
+		Entity:   "account_deletion",
+		EntityID: fmt.Sprintf("%d", deletion.ID),
+		Success:  true,
+		Details: map[string]interface{}{
+			"user_id": deletion.UserID,
+			"digest":  deletion.Report.Digest,
+		},
+	})
+	return nil
+}
+
+func (s *AccountPurgeService) runStage(ctx context.Context, deletion *entities.AccountDeletion, stage entities.PurgeStage) (map[string]int64, error) {
+	switch stage {
+	case entities.PurgeStageContent:
+		return s.deletionRepo.ScrubContent(ctx, deletion.UserID)
+	case entities.PurgeStageAuditLogs:
+		return s.deletionRepo.ScrubAuditLogs(ctx, deletion.UserID)
+	case entities.PurgeStageStorage:
+		return s.purgeStorage(ctx, deletion.UserID)
+	case entities.PurgeStageTombstone:
+		if err := s.deletionRepo.TombstoneUser(ctx, deletion.UserID); err != nil {
+			return nil, err
+		}
+		return map[string]int64{"users": 1}, nil
+	case entities.PurgeStageVerify:
+		return s.verify(ctx, deletion)
+	default:
+		return nil, fmt.Errorf("unknown purge stage %q", stage)
+	}
+}
+
+func (s *AccountPurgeService) purgeStorage(ctx context.Context, userID uint) (map[string]int64, error) {
+	keys, err := s.deletionRepo.GetStorageKeys(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted, failed int64
+	for _, key := range keys {
+		if err := s.storageService.DeleteFile(ctx, key); err != nil && !errors.Is(err, storage.ErrFileNotFound) {
+			failed++
+			s.logger.Warn("Failed to delete storage object for purged account", "error", err, "user_id", userID)
+			continue
+		}
+		deleted++
+	}
+	atomic.AddInt64(&s.objectsDeleted, deleted)
+
+	if failed > 0 {
+		return nil, fmt.Errorf("failed to delete %d of %d storage objects", failed, len(keys))
+	}
+
+	affected, err := s.deletionRepo.ClearStorageReferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	affected["objects_deleted"] = deleted
+	return affected, nil
+}
+
+func (s *AccountPurgeService) verify(ctx context.Context, deletion *entities.AccountDeletion) (map[string]int64, error) {
+	residual, err := s.deletionRepo.CountResidualData(ctx, deletion.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	deletion.Report.Residual = residual
+	deletion.Report.Verified = true
+	for table, count := range residual {
+		if count > 0 {
+			deletion.Report.Verified = false
+			return nil, fmt.Errorf("%d residual rows remain in %s", count, table)
+		}
+	}
+	return residual, nil
+}
+
+func (s *AccountPurgeService) saveDeletion(ctx context.Context, deletion *entities.AccountDeletion) {
+	if err := s.deletionRepo.Update(ctx, deletion); err != nil {
+		s.logger.Error("Failed to save account purge progress", "error", err, "deletion_id", deletion.ID)
+	}
+}
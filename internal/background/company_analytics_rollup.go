@@ -0,0 +1,191 @@
+package background
+
+import (
+	"context"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// CompanyAnalyticsRollupConfig sets how often company dashboards are rolled
+// up and how many past days each run recomputes.
+type CompanyAnalyticsRollupConfig struct {
+	Interval     time.Duration
+	LookbackDays int
+}
+
+type CompanyAnalyticsRollupService struct {
+	analyticsRepo repositories.CompanyAnalyticsRepository
+	leader        LeaderElector
+	logger        logger.StructuredLogger
+	ticker        *time.Ticker
+	stopChan      chan struct{}
+	wg            sync.WaitGroup
+	mu            sync.Mutex
+	running       bool
+
+	interval        time.Duration
+	lookbackDays    int
+	totalRuns       int64
+	failedRuns      int64
+	rowsWritten     int64
+	lastRunTime     time.Time
+	lastRunDuration time.Duration
+	lastRunStatus   string
+}
+
+func NewCompanyAnalyticsRollupService(analyticsRepo repositories.CompanyAnalyticsRepository, cfg CompanyAnalyticsRollupConfig, leader LeaderElector, logger logger.StructuredLogger) *CompanyAnalyticsRollupService {
+	if cfg.Interval <= 0 {
+		cfg.Interval = time.Hour
+	}
+	// Past days are rolled up again for a while so application status
+	// changes and late view flushes still reach the dashboard.
+	if cfg.LookbackDays < 1 {
+		cfg.LookbackDays = 1
+	}
+
+	return &CompanyAnalyticsRollupService{
+		analyticsRepo: analyticsRepo,
+		leader:        leader,
+		logger:        logger,
+		stopChan:      make(chan struct{}),
+		lastRunStatus: "never_run",
+		interval:      cfg.Interval,
+		lookbackDays:  cfg.LookbackDays,
+	}
+}
+
+func (s *CompanyAnalyticsRollupService) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		s.logger.Warn("Company analytics rollup service already running")
+		return
+	}
+
+	s.ticker = time.NewTicker(s.interval)
+	s.running = true
+	s.wg.Add(1)
+
+	s.logger.Info("Starting company analytics rollup service",
+		"interval", s.interval.String(),
+		"lookback_days", s.lookbackDays)
+
+	go func() {
+		defer s.wg.Done()
+		defer s.logger.Info("Company analytics rollup service stopped")
+
+		s.performRun(ctx)
+
+		for {
+			select {
+			case <-s.ticker.C:
+				s.performRun(ctx)
+			case <-s.stopChan:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (s *CompanyAnalyticsRollupService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.running {
+		return
+	}
+
+	s.logger.Info("Stopping company analytics rollup service...")
+
+	s.running = false
+	if s.ticker != nil {
+		s.ticker.Stop()
+	}
+	close(s.stopChan)
+	s.wg.Wait()
+}
+
+func (s *CompanyAnalyticsRollupService) IsRunning() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+func (s *CompanyAnalyticsRollupService) GetMetrics() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return map[string]interface{}{
+		"interval":                  s.interval.String(),
+		"lookback_days":             s.lookbackDays,
+		"total_runs":                atomic.LoadInt64(&s.totalRuns),
+		"failed_runs":               atomic.LoadInt64(&s.failedRuns),
+		"rows_written":              atomic.LoadInt64(&s.rowsWritten),
+		"last_run":                  s.lastRunTime.Format(time.RFC3339),
+		"last_run_duration_seconds": s.lastRunDuration.Seconds(),
+		"last_run_status":           s.lastRunStatus,
+	}
+}
+
+func (s *CompanyAnalyticsRollupService) performRun(ctx context.Context) {
+	if !s.leader.IsLeader() {
+		return
+	}
+
+	start := time.Now()
+	atomic.AddInt64(&s.totalRuns, 1)
+
+	today := start.UTC().Truncate(24 * time.Hour)
+
+	var written int64
+	var err error
+	for offset := s.lookbackDays - 1; offset >= 0; offset-- {
+		var rows int64
+		rows, err = s.analyticsRepo.RollupDay(ctx, today.AddDate(0, 0, -offset))
+		written += rows
+		if err != nil {
+			break
+		}
+	}
+
+	atomic.AddInt64(&s.rowsWritten, written)
+
+	s.mu.Lock()
+	s.lastRunTime = start
+	s.lastRunDuration = time.Since(start)
+	if err != nil {
+		s.lastRunStatus = "failed"
+	} else {
+		s.lastRunStatus = "success"
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		atomic.AddInt64(&s.failedRuns, 1)
+		s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+			Event:    "company_analytics_rollup_failed",
+			Entity:   "company",
+			Success:  false,
+			Duration: time.Since(start),
+			Error:    err.Error(),
+		})
+		return
+	}
+
+	s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+		Event:    "company_analytics_rollup_completed",
+		Entity:   "company",
+		Success:  true,
+		Duration: time.Since(start),
+		Details: map[string]interface{}{
+			"rows": written,
+			"days": s.lookbackDays,
+		},
+	})
+}
@@ -0,0 +1,187 @@
+package background
+
+import (
+	"context"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ConnectionSuggestionConfig sets how often suggestions are rebuilt and how
+// many are kept per user.
+type ConnectionSuggestionConfig struct {
+	RefreshInterval time.Duration
+	PerUserLimit    int
+}
+
+type ConnectionSuggestionService struct {
+	suggestionRepo repositories.ConnectionSuggestionRepository
+	leader         LeaderElector
+	logger         logger.StructuredLogger
+	ticker         *time.Ticker
+	stopChan       chan struct{}
+	wg             sync.WaitGroup
+	mu             sync.Mutex
+	running        bool
+
+	refreshInterval    time.Duration
+	perUserLimit       int
+	totalRuns          int64
+	failedRuns         int64
+	suggestionsWritten int64
+	lastRunTime        time.Time
+	lastRunDuration    time.Duration
+	lastRunStatus      string
+}
+
+func NewConnectionSuggestionService(suggestionRepo repositories.ConnectionSuggestionRepository, cfg ConnectionSuggestionConfig, leader LeaderElector, logger logger.StructuredLogger) *ConnectionSuggestionService {
+	if cfg.RefreshInterval <= 0 {
+		cfg.RefreshInterval = 24 * time.Hour
+	}
+	if cfg.PerUserLimit <= 0 {
+		cfg.PerUserLimit = 50
+	}
+
+	return &ConnectionSuggestionService{
+		suggestionRepo:  suggestionRepo,
+		leader:          leader,
+		logger:          logger,
+		stopChan:        make(chan struct{}),
+		lastRunStatus:   "never_run",
+		refreshInterval: cfg.RefreshInterval,
+		perUserLimit:    cfg.PerUserLimit,
+	}
+}
+
+func (s *ConnectionSuggestionService) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		s.logger.Warn("Connection suggestion service already running")
+		return
+	}
+
+	// Check hourly but only rebuild once the last computation is older than
+	// the refresh interval, so restarts and leader changes don't recompute.
+	s.ticker = time.NewTicker(time.Hour)
+	s.running = true
+	s.wg.Add(1)
+
+	s.logger.Info("Starting connection suggestion service", "refresh_interval", s.refreshInterval.String())
+
+	go func() {
+		defer s.wg.Done()
+		defer s.logger.Info("Connection suggestion service stopped")
+
+		s.performRun(ctx)
+
+		for {
+			select {
+			case <-s.ticker.C:
+				s.performRun(ctx)
+			case <-s.stopChan:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (s *ConnectionSuggestionService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.running {
+		return
+	}
+
+	s.logger.Info("Stopping connection suggestion service...")
+
+	s.running = false
+	if s.ticker != nil {
+		s.ticker.Stop()
+	}
+	close(s.stopChan)
+	s.wg.Wait()
+}
+
+func (s *ConnectionSuggestionService) IsRunning() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+func (s *ConnectionSuggestionService) GetMetrics() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return map[string]interface{}{
+		"refresh_interval":          s.refreshInterval.String(),
+		"per_user_limit":            s.perUserLimit,
+		"total_runs":                atomic.LoadInt64(&s.totalRuns),
+		"failed_runs":               atomic.LoadInt64(&s.failedRuns),
+		"suggestions_written":       atomic.LoadInt64(&s.suggestionsWritten),
+		"last_run":                  s.lastRunTime.Format(time.RFC3339),
+		"last_run_duration_seconds": s.lastRunDuration.Seconds(),
+		"last_run_status":           s.lastRunStatus,
+	}
+}
+
+func (s *ConnectionSuggestionService) performRun(ctx context.Context) {
+	if !s.leader.IsLeader() {
+		return
+	}
+
+	start := time.Now()
+
+	latest, err := s.suggestionRepo.LatestComputedAt(ctx)
+	if err != nil {
+		s.logger.Error("Failed to check last connection suggestion run", "error", err)
+		return
+	}
+	if latest != nil && start.Sub(*latest) < s.refreshInterval {
+		return
+	}
+
+	atomic.AddInt64(&s.totalRuns, 1)
+
+	written, err := s.suggestionRepo.Rebuild(ctx, start.UTC(), s.perUserLimit)
+
+	s.mu.Lock()
+	s.lastRunTime = start
+	s.lastRunDuration = time.Since(start)
+	if err != nil {
+		s.lastRunStatus = "failed"
+	} else {
+		s.lastRunStatus = "success"
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		atomic.AddInt64(&s.failedRuns, 1)
+		s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+			Event:    "connection_suggestions_failed",
+			Entity:   "connection",
+			Success:  false,
+			Duration: time.Since(start),
+			Error:    err.Error(),
+		})
+		return
+	}
+
+	atomic.AddInt64(&s.suggestionsWritten, written)
+
+	s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+		Event:    "connection_suggestions_completed",
+		Entity:   "connection",
+		Success:  true,
+		Duration: time.Since(start),
+		Details: map[string]interface{}{
+			"written": written,
+		},
+	})
+}
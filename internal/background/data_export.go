@@ -0,0 +1,291 @@
+package background
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/backup"
+	"linked-clone/pkg/dataexport"
+	"linked-clone/pkg/logger"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DataExportSchedule sets how often the export runs and how many past days
+// each run covers; which tables are exported is in dataexport.Config.
+type DataExportSchedule struct {
+	Interval     time.Duration
+	LookbackDays int
+}
+
+type DataExportService struct {
+	exportRepo repositories.DataExportRepository
+	store      backup.Store
+	config     *dataexport.Config
+	leader     LeaderElector
+	logger     logger.StructuredLogger
+	ticker     *time.Ticker
+	stopChan   chan struct{}
+	wg         sync.WaitGroup
+	mu         sync.Mutex
+	running    bool
+
+	interval        time.Duration
+	lookbackDays    int
+	totalRuns       int64
+	failedExports   int64
+	exportedFiles   int64
+	exportedRows    int64
+	lastRunTime     time.Time
+	lastRunDuration time.Duration
+	lastRunStatus   string
+}
+
+func NewDataExportService(
+	exportRepo repositories.DataExportRepository,
+	store backup.Store,
+	config *dataexport.Config,
+	cfg DataExportSchedule,
+	leader LeaderElector,
+	logger logger.StructuredLogger,
+) *DataExportService {
+	if cfg.Interval <= 0 {
+		cfg.Interval = 60 * time.Minute
+	}
+	if cfg.LookbackDays <= 0 {
+		cfg.LookbackDays = 1
+	}
+
+	return &DataExportService{
+		exportRepo:    exportRepo,
+		store:         store,
+		config:        config,
+		leader:        leader,
+		logger:        logger,
+		stopChan:      make(chan struct{}),
+		lastRunStatus: "never_run",
+		interval:      cfg.Interval,
+		lookbackDays:  cfg.LookbackDays,
+	}
+}
+
+func (s *DataExportService) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		s.logger.Warn("Data export service already running")
+		return
+	}
+	if !s.config.Enabled() {
+		s.lastRunStatus = "disabled"
+		s.logger.Info("Data export disabled, no tables opted in")
+		return
+	}
+
+	s.ticker = time.NewTicker(s.interval)
+	s.running = true
+	s.wg.Add(1)
+
+	s.logger.Info("Starting data export service", "interval", s.interval.String(), "tables", len(s.config.Tables))
+
+	go func() {
+		defer s.wg.Done()
+		defer s.logger.Info("Data export service stopped")
+
+		s.performRun(ctx)
+
+		for {
+			select {
+			case <-s.ticker.C:
+				s.performRun(ctx)
+			case <-s.stopChan:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (s *DataExportService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.running {
+		return
+	}
+
+	s.logger.Info("Stopping data export service...")
+
+	s.running = false
+	if s.ticker != nil {
+		s.ticker.Stop()
+	}
+	close(s.stopChan)
+	s.wg.Wait()
+}
+
+func (s *DataExportService) IsRunning() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+func (s *DataExportService) GetMetrics() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tables := make([]string, len(s.config.Tables))
+	for i, table := range s.config.Tables {
+		tables[i] = table.Name
+	}
+
+	return map[string]interface{}{
+		"interval":                  s.interval.String(),
+		"lookback_days":             s.lookbackDays,
+		"tables":                    tables,
+		"total_runs":                atomic.LoadInt64(&s.totalRuns),
+		"failed_exports":            atomic.LoadInt64(&s.failedExports),
+		"exported_files":            atomic.LoadInt64(&s.exportedFiles),
+		"exported_rows":             atomic.LoadInt64(&s.exportedRows),
+		"last_run":                  s.lastRunTime.Format(time.RFC3339),
+		"last_run_duration_seconds": s.lastRunDuration.Seconds(),
+		"last_run_status":           s.lastRunStatus,
+	}
+}
+
+// performRun exports every completed UTC day within the lookback window that
+// hasn't been exported yet, so a missed or failed day is picked up later.
+func (s *DataExportService) performRun(ctx context.Context) {
+	if !s.leader.IsLeader() {
+		return
+	}
+
+	start := time.Now()
+	atomic.AddInt64(&s.totalRuns, 1)
+
+	status := "success"
+	if err := s.uploadManifest(ctx); err != nil {
+		status = "failed"
+		s.logger.Error("Failed to upload data export schema", "error", err)
+	}
+
+	today := start.UTC().Truncate(24 * time.Hour)
+	for offset := s.lookbackDays; offset >= 1; offset-- {
+		day := today.AddDate(0, 0, -offset)
+		for _, table := range s.config.Tables {
+			if err := s.exportDay(ctx, table, day); err != nil {
+				status = "failed"
+			}
+		}
+	}
+
+	s.mu.Lock()
+	s.lastRunTime = start
+	s.lastRunDuration = time.Since(start)
+	s.lastRunStatus = status
+	s.mu.Unlock()
+}
+
+func (s *DataExportService) exportDay(ctx context.Context, table dataexport.Table, day time.Time) error {
+	start := time.Now()
+	run := &entities.DataExportRun{
+		Day:       day,
+		Source:    table.Name,
+		Status:    entities.DataExportRunProcessing,
+		StartedAt: start,
+	}
+	claimed, err := s.exportRepo.Claim(ctx, run)
+	if err != nil {
+		s.logger.Error("Failed to claim data export run", "error", err, "table", table.Name, "day", day.Format("2006-01-02"))
+		return err
+	}
+	if !claimed {
+		return nil
+	}
+
+	run.ObjectKey = dataexport.ObjectKey(s.config.Prefix, table.Name, day)
+	rows, err := s.upload(ctx, table.Name, day, run.ObjectKey)
+
+	completedAt := time.Now()
+	run.RowCount = rows
+	run.CompletedAt = &completedAt
+	run.Status = entities.DataExportRunCompleted
+	if err != nil {
+		run.Status = entities.DataExportRunFailed
+		run.Error = err.Error()
+	}
+	if updateErr := s.exportRepo.Update(ctx, run); updateErr != nil {
+		s.logger.Error("Failed to record data export run", "error", updateErr, "table", table.Name, "day", day.Format("2006-01-02"))
+	}
+
+	if err != nil {
+		atomic.AddInt64(&s.failedExports, 1)
+		s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+			Event:    "data_export_failed",
+			Entity:   "analytics",
+			Success:  false,
+			Duration: time.Since(start),
+			Error:    err.Error(),
+			Details: map[string]interface{}{
+				"table": table.Name,
+				"day":   day.Format("2006-01-02"),
+			},
+		})
+		return err
+	}
+
+	atomic.AddInt64(&s.exportedFiles, 1)
+	atomic.AddInt64(&s.exportedRows, rows)
+	s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+		Event:    "data_export_completed",
+		Entity:   "analytics",
+		Success:  true,
+		Duration: time.Since(start),
+		Details: map[string]interface{}{
+			"table":      table.Name,
+			"day":        day.Format("2006-01-02"),
+			"rows":       rows,
+			"object_key": run.ObjectKey,
+		},
+	})
+	return nil
+}
+
+func (s *DataExportService) upload(ctx context.Context, source string, day time.Time, key string) (int64, error) {
+	reader, writer := io.Pipe()
+
+	var rows int64
+	go func() {
+		out, err := dataexport.NewWriter(writer, s.config.Hasher)
+		if err != nil {
+			writer.CloseWithError(err)
+			return
+		}
+		err = s.exportRepo.StreamRecords(ctx, source, day, day.AddDate(0, 0, 1), out.Write)
+		if closeErr := out.Close(); err == nil {
+			err = closeErr
+		}
+		rows = out.Rows()
+		writer.CloseWithError(err)
+	}()
+
+	if err := s.store.Upload(ctx, key, reader); err != nil {
+		reader.CloseWithError(err)
+		return 0, fmt.Errorf("failed to upload %s: %w", key, err)
+	}
+	return rows, nil
+}
+
+func (s *DataExportService) uploadManifest(ctx context.Context) error {
+	manifest, err := dataexport.NewManifest(s.config.Tables).JSON()
+	if err != nil {
+		return err
+	}
+	return s.store.Upload(ctx, dataexport.ManifestKey(s.config.Prefix), bytes.NewReader(manifest))
+}
@@ -0,0 +1,293 @@
+package background
+
+import (
+	"context"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+	"sync"
+	"time"
+)
+
+const (
+	RetentionModeArchive = "archive"
+	RetentionModeDelete  = "delete"
+)
+
+// RetentionConfig is filled from config.RetentionConfig; this package cannot
+// import internal/config, which imports it.
+type RetentionConfig struct {
+	Mode             string
+	BatchSize        int
+	Interval         time.Duration
+	NotificationDays int
+	AnalyticsDays    int
+}
+
+// PartitionDropper drops monthly partitions that retention has emptied, so
+// their tables do not linger once every row has been archived or deleted.
+type PartitionDropper interface {
+	DropPartitionsBefore(ctx context.Context, table string, before time.Time) ([]string, error)
+}
+
+type retentionTarget struct {
+	name          string
+	retentionDays int
+	archive       func(ctx context.Context, cutoff time.Time, batchSize int) (int64, error)
+	delete        func(ctx context.Context, cutoff time.Time, batchSize int) (int64, error)
+}
+
+type retentionStats struct {
+	RetentionDays int       `json:"retention_days"`
+	LastProcessed int64     `json:"last_processed"`
+	TotalArchived int64     `json:"total_archived"`
+	TotalDeleted  int64     `json:"total_deleted"`
+	LastCutoff    time.Time `json:"last_cutoff"`
+	LastError     string    `json:"last_error,omitempty"`
+}
+
+type DataRetentionService struct {
+	targets    []retentionTarget
+	partitions PartitionDropper
+	leader     LeaderElector
+	logger     logger.StructuredLogger
+	ticker     *time.Ticker
+	stopChan   chan struct{}
+	wg         sync.WaitGroup
+	mu         sync.Mutex
+	running    bool
+
+	mode            string
+	batchSize       int
+	interval        time.Duration
+	totalRuns       int64
+	lastRunTime     time.Time
+	lastRunDuration time.Duration
+	lastRunStatus   string
+	stats           map[string]*retentionStats
+}
+
+func NewDataRetentionService(
+	notificationRepo repositories.NotificationRepository,
+	analyticsRepo repositories.AnalyticsRepository,
+	partitions PartitionDropper,
+	cfg RetentionConfig,
+	leader LeaderElector,
+	logger logger.StructuredLogger,
+) *DataRetentionService {
+	mode := cfg.Mode
+	if mode != RetentionModeDelete {
+		mode = RetentionModeArchive
+	}
+
+	return &DataRetentionService{
+		targets: []retentionTarget{
+			{
+				name:          "notifications",
+				retentionDays: cfg.NotificationDays,
+				archive:       notificationRepo.ArchiveOlderThan,
+				delete:        notificationRepo.DeleteOlderThan,
+			},
+			{
+				name:          "analytics_events",
+				retentionDays: cfg.AnalyticsDays,
+				archive:       analyticsRepo.ArchiveOlderThan,
+				delete:        analyticsRepo.DeleteOlderThan,
+			},
+		},
+		partitions:    partitions,
+		leader:        leader,
+		logger:        logger,
+		mode:          mode,
+		batchSize:     cfg.BatchSize,
+		interval:      cfg.Interval,
+		stopChan:      make(chan struct{}),
+		lastRunStatus: "never_run",
+		stats:         make(map[string]*retentionStats),
+	}
+}
+
+func (s *DataRetentionService) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		s.logger.Warn("Data retention service already running")
+		return
+	}
+
+	for _, target := range s.targets {
+		s.stats[target.name] = &retentionStats{RetentionDays: target.retentionDays}
+	}
+
+	s.ticker = time.NewTicker(s.interval)
+	s.running = true
+	s.wg.Add(1)
+
+	s.logger.Info("Starting data retention service",
+		"mode", s.mode,
+		"batch_size", s.batchSize,
+		"interval", s.interval.String())
+
+	go func() {
+		defer s.wg.Done()
+		defer s.logger.Info("Data retention service stopped")
+
+		for {
+			select {
+			case <-s.ticker.C:
+				s.performRetention(ctx)
+			case <-s.stopChan:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (s *DataRetentionService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.running {
+		return
+	}
+
+	s.running = false
+	if s.ticker != nil {
+		s.ticker.Stop()
+	}
+	close(s.stopChan)
+	s.wg.Wait()
+}
+
+func (s *DataRetentionService) IsRunning() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+func (s *DataRetentionService) GetMetrics() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tables := make(map[string]retentionStats, len(s.stats))
+	for name, stats := range s.stats {
+		tables[name] = *stats
+	}
+
+	return map[string]interface{}{
+		"mode":                      s.mode,
+		"batch_size":                s.batchSize,
+		"interval":                  s.interval.String(),
+		"total_runs":                s.totalRuns,
+		"last_run":                  s.lastRunTime.Format(time.RFC3339),
+		"last_run_duration_seconds": s.lastRunDuration.Seconds(),
+		"last_run_status":           s.lastRunStatus,
+		"tables":                    tables,
+	}
+}
+
+func (s *DataRetentionService) performRetention(ctx context.Context) {
+	if !s.leader.IsLeader() {
+		return
+	}
+
+	start := time.Now()
+	status := "success"
+
+	for _, target := range s.targets {
+		if target.retentionDays <= 0 {
+			continue
+		}
+
+		cutoff := start.AddDate(0, 0, -target.retentionDays)
+		processed, err := s.processTarget(ctx, target, cutoff)
+		if err == nil {
+			err = s.dropPartitions(ctx, target, cutoff)
+		}
+
+		s.mu.Lock()
+		stats := s.stats[target.name]
+		stats.LastProcessed = processed
+		stats.LastCutoff = cutoff
+		stats.LastError = ""
+		if s.mode == RetentionModeArchive {
+			stats.TotalArchived += processed
+		} else {
+			stats.TotalDeleted += processed
+		}
+		if err != nil {
+			stats.LastError = err.Error()
+			status = "failed"
+		}
+		s.mu.Unlock()
+	}
+
+	s.mu.Lock()
+	s.totalRuns++
+	s.lastRunTime = start
+	s.lastRunDuration = time.Since(start)
+	s.lastRunStatus = status
+	s.mu.Unlock()
+
+	s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+		Event:    "data_retention_completed",
+		Entity:   "retention",
+		Success:  status == "success",
+		Duration: time.Since(start),
+		Details:  s.GetMetrics(),
+	})
+}
+
+func (s *DataRetentionService) processTarget(ctx context.Context, target retentionTarget, cutoff time.Time) (int64, error) {
+	process := target.archive
+	if s.mode == RetentionModeDelete {
+		process = target.delete
+	}
+
+	var total int64
+	for batch := 1; ; batch++ {
+		select {
+		case <-ctx.Done():
+			return total, ctx.Err()
+		case <-s.stopChan:
+			return total, nil
+		default:
+		}
+
+		affected, err := process(ctx, cutoff, s.batchSize)
+		if err != nil {
+			s.logger.Error("Data retention batch failed",
+				"error", err,
+				"table", target.name,
+				"batch", batch)
+			return total, err
+		}
+
+		total += affected
+		s.logger.Info("Data retention batch processed",
+			"table", target.name,
+			"mode", s.mode,
+			"batch", batch,
+			"affected", affected,
+			"total", total)
+
+		if affected < int64(s.batchSize) {
+			return total, nil
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func (s *DataRetentionService) dropPartitions(ctx context.Context, target retentionTarget, cutoff time.Time) error {
+	dropped, err := s.partitions.DropPartitionsBefore(ctx, target.name, cutoff)
+	if len(dropped) > 0 {
+		s.logger.Info("Dropped emptied partitions", "table", target.name, "partitions", dropped)
+	}
+	if err != nil {
+		s.logger.Error("Failed to drop emptied partitions", "error", err, "table", target.name)
+	}
+	return err
+}
@@ -0,0 +1,242 @@
+package background
+
+import (
+	"context"
+	"errors"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+	email "linked-clone/pkg/smtp"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const emailClaimTimeout = 15 * time.Minute
+
+// EmailDispatchConfig sets how often queued emails are sent, how many per
+// run and how many times one is tried before it is given up on.
+type EmailDispatchConfig struct {
+	Interval    time.Duration
+	BatchSize   int
+	MaxAttempts int
+}
+
+type EmailDispatchService struct {
+	outboundRepo repositories.OutboundEmailRepository
+	emailService email.EmailService
+	logger       logger.StructuredLogger
+	ticker       *time.Ticker
+	stopChan     chan struct{}
+	wg           sync.WaitGroup
+	mu           sync.Mutex
+	running      bool
+
+	interval        time.Duration
+	batchSize       int
+	maxAttempts     int
+	totalRuns       int64
+	failedRuns      int64
+	emailsSent      int64
+	emailsFailed    int64
+	lastRunTime     time.Time
+	lastRunDuration time.Duration
+	lastRunStatus   string
+}
+
+func NewEmailDispatchService(
+	outboundRepo repositories.OutboundEmailRepository,
+	emailService email.EmailService,
+	cfg EmailDispatchConfig,
+	logger logger.StructuredLogger,
+) *EmailDispatchService {
+	if cfg.Interval <= 0 {
+		cfg.Interval = 15 * time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 50
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 5
+	}
+
+	return &EmailDispatchService{
+		outboundRepo:  outboundRepo,
+		emailService:  emailService,
+		logger:        logger,
+		stopChan:      make(chan struct{}),
+		lastRunStatus: "never_run",
+		interval:      cfg.Interval,
+		batchSize:     cfg.BatchSize,
+		maxAttempts:   cfg.MaxAttempts,
+	}
+}
+
+func (s *EmailDispatchService) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		s.logger.Warn("Email dispatch service already running")
+		return
+	}
+
+	s.ticker = time.NewTicker(s.interval)
+	s.running = true
+	s.wg.Add(1)
+
+	s.logger.Info("Starting email dispatch service", "interval", s.interval.String(), "batch_size", s.batchSize)
+
+	go func() {
+		defer s.wg.Done()
+		defer s.logger.Info("Email dispatch service stopped")
+
+		for {
+			select {
+			case <-s.ticker.C:
+				s.performDispatch(ctx)
+			case <-s.stopChan:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (s *EmailDispatchService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.running {
+		return
+	}
+
+	s.logger.Info("Stopping email dispatch service...")
+
+	s.running = false
+	if s.ticker != nil {
+		s.ticker.Stop()
+	}
+	close(s.stopChan)
+	s.wg.Wait()
+}
+
+func (s *EmailDispatchService) IsRunning() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+func (s *EmailDispatchService) GetMetrics() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return map[string]interface{}{
+		"interval":                  s.interval.String(),
+		"batch_size":                s.batchSize,
+		"max_attempts":              s.maxAttempts,
+		"total_runs":                atomic.LoadInt64(&s.totalRuns),
+		"failed_runs":               atomic.LoadInt64(&s.failedRuns),
+		"emails_sent":               atomic.LoadInt64(&s.emailsSent),
+		"emails_failed":             atomic.LoadInt64(&s.emailsFailed),
+		"last_run":                  s.lastRunTime.Format(time.RFC3339),
+		"last_run_duration_seconds": s.lastRunDuration.Seconds(),
+		"last_run_status":           s.lastRunStatus,
+	}
+}
+
+func (s *EmailDispatchService) performDispatch(ctx context.Context) {
+	start := time.Now()
+	atomic.AddInt64(&s.totalRuns, 1)
+
+	emails, err := s.outboundRepo.ClaimDue(ctx, start, start.Add(-emailClaimTimeout), s.batchSize)
+
+	status := "success"
+	sent, failed := 0, 0
+	if err != nil {
+		status = "failed"
+	} else {
+		for _, outbound := range emails {
+			select {
+			case <-ctx.Done():
+				err = ctx.Err()
+			case <-s.stopChan:
+				err = errors.New("email dispatch service stopping")
+			default:
+			}
+			if err != nil {
+				status = "failed"
+				break
+			}
+
+			if sendErr := s.deliver(ctx, outbound); sendErr != nil {
+				failed++
+				status = "partial"
+				continue
+			}
+			sent++
+		}
+	}
+
+	s.mu.Lock()
+	s.lastRunTime = start
+	s.lastRunDuration = time.Since(start)
+	s.lastRunStatus = status
+	s.mu.Unlock()
+
+	if err != nil {
+		atomic.AddInt64(&s.failedRuns, 1)
+		s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+			Event:    "email_dispatch_failed",
+			Entity:   "outbound_email",
+			Success:  false,
+			Duration: time.Since(start),
+			Error:    err.Error(),
+		})
+		return
+	}
+
+	if len(emails) > 0 {
+		s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+			Event:    "email_dispatch_completed",
+			Entity:   "outbound_email",
+			Success:  failed == 0,
+			Duration: time.Since(start),
+			Details: map[string]interface{}{
+				"claimed": len(emails),
+				"sent":    sent,
+				"failed":  failed,
+			},
+		})
+	}
+}
+
+func (s *EmailDispatchService) deliver(ctx context.Context, outbound *entities.OutboundEmail) error {
+	sendErr := s.emailService.SendEmail(outbound.Recipient, outbound.Subject, outbound.Body)
+
+	now := time.Now()
+	if sendErr == nil {
+		atomic.AddInt64(&s.emailsSent, 1)
+		outbound.Status = entities.OutboundEmailSent
+		outbound.SentAt = &now
+		outbound.LastError = ""
+	} else {
+		atomic.AddInt64(&s.emailsFailed, 1)
+		outbound.LastError = sendErr.Error()
+		outbound.Status = entities.OutboundEmailPending
+		outbound.SendAfter = now.Add(time.Duration(outbound.Attempts) * s.interval)
+		if outbound.Attempts >= s.maxAttempts {
+			outbound.Status = entities.OutboundEmailFailed
+		}
+		s.logger.Warn("Failed to send queued email", "error", sendErr, "email_id", outbound.ID, "attempts", outbound.Attempts)
+	}
+
+	if err := s.outboundRepo.Update(ctx, outbound); err != nil {
+		s.logger.Error("Failed to update queued email", "error", err, "email_id", outbound.ID)
+		if sendErr == nil {
+			return err
+		}
+	}
+	return sendErr
+}
@@ -0,0 +1,186 @@
+package background
+
+import (
+	"context"
+	"linked-clone/pkg/cluster"
+	"linked-clone/pkg/logger"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var LeaderOnlyServices = []string{
+	"partition_maintenance",
+	"data_retention",
+	"job_deadline",
+	"account_purge",
+	"life_event_reminders",
+	"data_export",
+	"mentorship_matching",
+}
+
+type LeaderElector interface {
+	IsLeader() bool
+}
+
+type InstanceHeartbeatService struct {
+	coordinator cluster.Coordinator
+	logger      logger.StructuredLogger
+	ticker      *time.Ticker
+	stopChan    chan struct{}
+	wg          sync.WaitGroup
+	mu          sync.Mutex
+	running     bool
+
+	interval         time.Duration
+	totalRuns        int64
+	failedRuns       int64
+	leadershipGained int64
+	leadershipLost   int64
+	wasLeader        bool
+	lastRunTime      time.Time
+	lastRunStatus    string
+}
+
+func NewInstanceHeartbeatService(coordinator cluster.Coordinator, interval time.Duration, logger logger.StructuredLogger) *InstanceHeartbeatService {
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+
+	return &InstanceHeartbeatService{
+		coordinator:   coordinator,
+		logger:        logger,
+		stopChan:      make(chan struct{}),
+		lastRunStatus: "never_run",
+		interval:      interval,
+	}
+}
+
+func (s *InstanceHeartbeatService) Start(ctx context.Context) {
+	s.mu.Lock()
+	if s.running {
+		s.mu.Unlock()
+		s.logger.Warn("Instance heartbeat service already running")
+		return
+	}
+
+	s.ticker = time.NewTicker(s.interval)
+	s.running = true
+	s.wg.Add(1)
+	s.mu.Unlock()
+
+	s.logger.Info("Starting instance heartbeat service", "interval", s.interval.String(), "instance_id", s.coordinator.ID())
+
+	// Heartbeat before returning so leader-only services started next see the elected state.
+	s.performHeartbeat(ctx)
+
+	go func() {
+		defer s.wg.Done()
+		defer s.logger.Info("Instance heartbeat service stopped")
+
+		for {
+			select {
+			case <-s.ticker.C:
+				s.performHeartbeat(ctx)
+			case <-s.stopChan:
+				s.leave()
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (s *InstanceHeartbeatService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.running {
+		return
+	}
+
+	s.logger.Info("Stopping instance heartbeat service...")
+
+	s.running = false
+	if s.ticker != nil {
+		s.ticker.Stop()
+	}
+	close(s.stopChan)
+	s.wg.Wait()
+}
+
+func (s *InstanceHeartbeatService) IsRunning() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+func (s *InstanceHeartbeatService) GetMetrics() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return map[string]interface{}{
+		"interval":          s.interval.String(),
+		"instance_id":       s.coordinator.ID(),
+		"leader":            s.coordinator.IsLeader(),
+		"total_runs":        atomic.LoadInt64(&s.totalRuns),
+		"failed_runs":       atomic.LoadInt64(&s.failedRuns),
+		"leadership_gained": atomic.LoadInt64(&s.leadershipGained),
+		"leadership_lost":   atomic.LoadInt64(&s.leadershipLost),
+		"last_run":          s.lastRunTime.Format(time.RFC3339),
+		"last_run_status":   s.lastRunStatus,
+	}
+}
+
+func (s *InstanceHeartbeatService) performHeartbeat(ctx context.Context) {
+	start := time.Now()
+	atomic.AddInt64(&s.totalRuns, 1)
+
+	err := s.coordinator.Heartbeat(ctx)
+	leader := s.coordinator.IsLeader()
+
+	s.mu.Lock()
+	s.lastRunTime = start
+	if err != nil {
+		s.lastRunStatus = "failed"
+	} else {
+		s.lastRunStatus = "success"
+	}
+	changed := leader != s.wasLeader
+	s.wasLeader = leader
+	s.mu.Unlock()
+
+	if err != nil {
+		atomic.AddInt64(&s.failedRuns, 1)
+		s.logger.Warn("Instance heartbeat failed", "error", err, "instance_id", s.coordinator.ID(), "leader", leader)
+	}
+
+	if !changed {
+		return
+	}
+	if leader {
+		atomic.AddInt64(&s.leadershipGained, 1)
+	} else {
+		atomic.AddInt64(&s.leadershipLost, 1)
+	}
+	s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+		Event:   "cluster_leadership_changed",
+		Entity:  "instance",
+		Success: true,
+		Details: map[string]interface{}{
+			"instance_id":      s.coordinator.ID(),
+			"leader":           leader,
+			"responsibilities": s.coordinator.Responsibilities(),
+		},
+	})
+}
+
+func (s *InstanceHeartbeatService) leave() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := s.coordinator.Leave(ctx); err != nil {
+		s.logger.Warn("Failed to leave cluster", "error", err, "instance_id", s.coordinator.ID())
+	}
+}
@@ -0,0 +1,163 @@
+package background
+
+import (
+	"context"
+	jobService "linked-clone/internal/api/job/service"
+	"linked-clone/pkg/logger"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type JobAlertDigestService struct {
+	alertSvc jobService.JobAlertService
+	leader   LeaderElector
+	logger   logger.StructuredLogger
+	ticker   *time.Ticker
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+	mu       sync.Mutex
+	running  bool
+
+	interval        time.Duration
+	totalRuns       int64
+	failedRuns      int64
+	digestsSent     int64
+	lastRunTime     time.Time
+	lastRunDuration time.Duration
+	lastRunStatus   string
+}
+
+func NewJobAlertDigestService(alertSvc jobService.JobAlertService, interval time.Duration, leader LeaderElector, logger logger.StructuredLogger) *JobAlertDigestService {
+	// Alerts are daily or weekly, so the interval only bounds how late a
+	// digest can go out after it becomes due.
+	if interval <= 0 {
+		interval = 15 * time.Minute
+	}
+
+	return &JobAlertDigestService{
+		alertSvc:      alertSvc,
+		leader:        leader,
+		logger:        logger,
+		stopChan:      make(chan struct{}),
+		lastRunStatus: "never_run",
+		interval:      interval,
+	}
+}
+
+func (s *JobAlertDigestService) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		s.logger.Warn("Job alert digest service already running")
+		return
+	}
+
+	s.ticker = time.NewTicker(s.interval)
+	s.running = true
+	s.wg.Add(1)
+
+	s.logger.Info("Starting job alert digest service", "interval", s.interval.String())
+
+	go func() {
+		defer s.wg.Done()
+		defer s.logger.Info("Job alert digest service stopped")
+
+		s.performRun(ctx)
+
+		for {
+			select {
+			case <-s.ticker.C:
+				s.performRun(ctx)
+			case <-s.stopChan:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (s *JobAlertDigestService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.running {
+		return
+	}
+
+	s.logger.Info("Stopping job alert digest service...")
+
+	s.running = false
+	if s.ticker != nil {
+		s.ticker.Stop()
+	}
+	close(s.stopChan)
+	s.wg.Wait()
+}
+
+func (s *JobAlertDigestService) IsRunning() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+func (s *JobAlertDigestService) GetMetrics() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return map[string]interface{}{
+		"interval":                  s.interval.String(),
+		"total_runs":                atomic.LoadInt64(&s.totalRuns),
+		"failed_runs":               atomic.LoadInt64(&s.failedRuns),
+		"digests_sent":              atomic.LoadInt64(&s.digestsSent),
+		"last_run":                  s.lastRunTime.Format(time.RFC3339),
+		"last_run_duration_seconds": s.lastRunDuration.Seconds(),
+		"last_run_status":           s.lastRunStatus,
+	}
+}
+
+func (s *JobAlertDigestService) performRun(ctx context.Context) {
+	if !s.leader.IsLeader() {
+		return
+	}
+
+	start := time.Now()
+	atomic.AddInt64(&s.totalRuns, 1)
+
+	sent, err := s.alertSvc.SendDigests(ctx, start)
+	atomic.AddInt64(&s.digestsSent, int64(sent))
+
+	s.mu.Lock()
+	s.lastRunTime = start
+	s.lastRunDuration = time.Since(start)
+	if err != nil {
+		s.lastRunStatus = "failed"
+	} else {
+		s.lastRunStatus = "success"
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		atomic.AddInt64(&s.failedRuns, 1)
+		s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+			Event:    "job_alert_digest_failed",
+			Entity:   "job_alert",
+			Success:  false,
+			Duration: time.Since(start),
+			Error:    err.Error(),
+		})
+		return
+	}
+
+	s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+		Event:    "job_alert_digest_completed",
+		Entity:   "job_alert",
+		Success:  true,
+		Duration: time.Since(start),
+		Details: map[string]interface{}{
+			"sent": sent,
+		},
+	})
+}
@@ -0,0 +1,162 @@
+package background
+
+import (
+	"context"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type JobDeadlineService struct {
+	jobRepo  repositories.JobRepository
+	leader   LeaderElector
+	logger   logger.StructuredLogger
+	ticker   *time.Ticker
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+	mu       sync.Mutex
+	running  bool
+
+	interval        time.Duration
+	totalRuns       int64
+	failedRuns      int64
+	jobsDeactivated int64
+	lastRunTime     time.Time
+	lastRunDuration time.Duration
+	lastRunStatus   string
+}
+
+func NewJobDeadlineService(jobRepo repositories.JobRepository, interval time.Duration, leader LeaderElector, logger logger.StructuredLogger) *JobDeadlineService {
+	if interval <= 0 {
+		interval = 10 * time.Minute
+	}
+
+	return &JobDeadlineService{
+		jobRepo:       jobRepo,
+		leader:        leader,
+		logger:        logger,
+		stopChan:      make(chan struct{}),
+		lastRunStatus: "never_run",
+		interval:      interval,
+	}
+}
+
+func (s *JobDeadlineService) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		s.logger.Warn("Job deadline service already running")
+		return
+	}
+
+	s.ticker = time.NewTicker(s.interval)
+	s.running = true
+	s.wg.Add(1)
+
+	s.logger.Info("Starting job deadline service", "interval", s.interval.String())
+
+	go func() {
+		defer s.wg.Done()
+		defer s.logger.Info("Job deadline service stopped")
+
+		s.performSweep(ctx)
+
+		for {
+			select {
+			case <-s.ticker.C:
+				s.performSweep(ctx)
+			case <-s.stopChan:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (s *JobDeadlineService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.running {
+		return
+	}
+
+	s.logger.Info("Stopping job deadline service...")
+
+	s.running = false
+	if s.ticker != nil {
+		s.ticker.Stop()
+	}
+	close(s.stopChan)
+	s.wg.Wait()
+}
+
+func (s *JobDeadlineService) IsRunning() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+func (s *JobDeadlineService) GetMetrics() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return map[string]interface{}{
+		"interval":                  s.interval.String(),
+		"total_runs":                atomic.LoadInt64(&s.totalRuns),
+		"failed_runs":               atomic.LoadInt64(&s.failedRuns),
+		"jobs_deactivated":          atomic.LoadInt64(&s.jobsDeactivated),
+		"last_run":                  s.lastRunTime.Format(time.RFC3339),
+		"last_run_duration_seconds": s.lastRunDuration.Seconds(),
+		"last_run_status":           s.lastRunStatus,
+	}
+}
+
+func (s *JobDeadlineService) performSweep(ctx context.Context) {
+	if !s.leader.IsLeader() {
+		return
+	}
+
+	start := time.Now()
+	atomic.AddInt64(&s.totalRuns, 1)
+
+	deactivated, err := s.jobRepo.DeactivatePastDeadline(ctx, start.UTC())
+
+	s.mu.Lock()
+	s.lastRunTime = start
+	s.lastRunDuration = time.Since(start)
+	if err != nil {
+		s.lastRunStatus = "failed"
+	} else {
+		s.lastRunStatus = "success"
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		atomic.AddInt64(&s.failedRuns, 1)
+		s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+			Event:    "job_deadline_sweep_failed",
+			Entity:   "job",
+			Success:  false,
+			Duration: time.Since(start),
+			Error:    err.Error(),
+		})
+		return
+	}
+
+	atomic.AddInt64(&s.jobsDeactivated, deactivated)
+
+	s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+		Event:    "job_deadline_sweep_completed",
+		Entity:   "job",
+		Success:  true,
+		Duration: time.Since(start),
+		Details: map[string]interface{}{
+			"deactivated": deactivated,
+		},
+	})
+}
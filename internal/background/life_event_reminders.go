@@ -0,0 +1,338 @@
+package background
+
+import (
+	"context"
+	"fmt"
+	notificationDto "linked-clone/internal/api/notification/dto"
+	notificationService "linked-clone/internal/api/notification/service"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const reminderBatchSize = 200
+
+type LifeEventReminderService struct {
+	reminderRepo    repositories.ReminderRunRepository
+	experienceRepo  repositories.ExperienceRepository
+	userRepo        repositories.UserRepository
+	connectionRepo  repositories.ConnectionRepository
+	notificationSvc notificationService.NotificationService
+	leader          LeaderElector
+	logger          logger.StructuredLogger
+	ticker          *time.Ticker
+	stopChan        chan struct{}
+	wg              sync.WaitGroup
+	mu              sync.Mutex
+	running         bool
+
+	interval        time.Duration
+	totalRuns       int64
+	failedRuns      int64
+	remindersSent   int64
+	lastRunTime     time.Time
+	lastRunDuration time.Duration
+	lastRunStatus   string
+}
+
+func NewLifeEventReminderService(
+	reminderRepo repositories.ReminderRunRepository,
+	experienceRepo repositories.ExperienceRepository,
+	userRepo repositories.UserRepository,
+	connectionRepo repositories.ConnectionRepository,
+	notificationSvc notificationService.NotificationService,
+	interval time.Duration,
+	leader LeaderElector,
+	logger logger.StructuredLogger,
+) *LifeEventReminderService {
+	if interval <= 0 {
+		interval = 60 * time.Minute
+	}
+
+	return &LifeEventReminderService{
+		reminderRepo:    reminderRepo,
+		experienceRepo:  experienceRepo,
+		userRepo:        userRepo,
+		connectionRepo:  connectionRepo,
+		notificationSvc: notificationSvc,
+		leader:          leader,
+		logger:          logger,
+		stopChan:        make(chan struct{}),
+		lastRunStatus:   "never_run",
+		interval:        interval,
+	}
+}
+
+func (s *LifeEventReminderService) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		s.logger.Warn("Life event reminder service already running")
+		return
+	}
+
+	s.ticker = time.NewTicker(s.interval)
+	s.running = true
+	s.wg.Add(1)
+
+	s.logger.Info("Starting life event reminder service", "interval", s.interval.String())
+
+	go func() {
+		defer s.wg.Done()
+		defer s.logger.Info("Life event reminder service stopped")
+
+		s.performRun(ctx)
+
+		for {
+			select {
+			case <-s.ticker.C:
+				s.performRun(ctx)
+			case <-s.stopChan:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (s *LifeEventReminderService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.running {
+		return
+	}
+
+	s.logger.Info("Stopping life event reminder service...")
+
+	s.running = false
+	if s.ticker != nil {
+		s.ticker.Stop()
+	}
+	close(s.stopChan)
+	s.wg.Wait()
+}
+
+func (s *LifeEventReminderService) IsRunning() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+func (s *LifeEventReminderService) GetMetrics() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return map[string]interface{}{
+		"interval":                  s.interval.String(),
+		"total_runs":                atomic.LoadInt64(&s.totalRuns),
+		"failed_runs":               atomic.LoadInt64(&s.failedRuns),
+		"reminders_sent":            atomic.LoadInt64(&s.remindersSent),
+		"last_run":                  s.lastRunTime.Format(time.RFC3339),
+		"last_run_duration_seconds": s.lastRunDuration.Seconds(),
+		"last_run_status":           s.lastRunStatus,
+	}
+}
+
+func (s *LifeEventReminderService) performRun(ctx context.Context) {
+	if !s.leader.IsLeader() {
+		return
+	}
+
+	start := time.Now()
+	day := start.UTC().Truncate(24 * time.Hour)
+
+	run := &entities.ReminderRun{
+		Day:       day,
+		Status:    entities.ReminderRunProcessing,
+		StartedAt: start,
+	}
+	claimed, err := s.reminderRepo.Claim(ctx, run)
+	if err != nil {
+		s.logger.Error("Failed to claim life event reminder run", "error", err, "day", day.Format("2006-01-02"))
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	atomic.AddInt64(&s.totalRuns, 1)
+
+	sent, err := s.sendWorkReminders(ctx, day)
+	if err == nil {
+		var birthdays int
+		birthdays, err = s.sendBirthdayReminders(ctx, day)
+		sent += birthdays
+	}
+	atomic.AddInt64(&s.remindersSent, int64(sent))
+
+	completedAt := time.Now()
+	run.Sent = sent
+	run.CompletedAt = &completedAt
+	run.Status = entities.ReminderRunCompleted
+	if err != nil {
+		run.Status = entities.ReminderRunFailed
+		run.Error = err.Error()
+	}
+	if updateErr := s.reminderRepo.Update(ctx, run); updateErr != nil {
+		s.logger.Error("Failed to record life event reminder run", "error", updateErr, "day", day.Format("2006-01-02"))
+	}
+
+	s.mu.Lock()
+	s.lastRunTime = start
+	s.lastRunDuration = time.Since(start)
+	s.lastRunStatus = string(run.Status)
+	s.mu.Unlock()
+
+	if err != nil {
+		atomic.AddInt64(&s.failedRuns, 1)
+		s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+			Event:    "life_event_reminders_failed",
+			Entity:   "notification",
+			Success:  false,
+			Duration: time.Since(start),
+			Error:    err.Error(),
+		})
+		return
+	}
+
+	s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+		Event:    "life_event_reminders_completed",
+		Entity:   "notification",
+		Success:  true,
+		Duration: time.Since(start),
+		Details: map[string]interface{}{
+			"sent": sent,
+		},
+	})
+}
+
+func (s *LifeEventReminderService) sendWorkReminders(ctx context.Context, day time.Time) (int, error) {
+	sent := 0
+	var afterID uint
+	for {
+		experiences, err := s.experienceRepo.GetCurrentStartingOn(ctx, int(day.Month()), reminderDays(day), afterID, reminderBatchSize)
+		if err != nil {
+			return sent, fmt.Errorf("failed to load experiences: %w", err)
+		}
+
+		for _, experience := range experiences {
+			afterID = experience.ID
+			if experience.User.ID == 0 {
+				continue
+			}
+
+			years := day.Year() - experience.StartDate.Year()
+			if years < 0 {
+				continue
+			}
+
+			notificationType := entities.NotificationNewPosition
+			message := fmt.Sprintf("%s started a new position as %s at %s", experience.User.FullName, experience.Title, experience.Company)
+			if years > 0 {
+				notificationType = entities.NotificationWorkAnniversary
+				message = fmt.Sprintf("%s is celebrating %d %s at %s", experience.User.FullName, years, pluralYears(years), experience.Company)
+			}
+
+			count, err := s.notifyConnections(ctx, experience.UserID, notificationType, "experience", experience.ID, message)
+			sent += count
+			if err != nil {
+				return sent, err
+			}
+		}
+
+		if len(experiences) < reminderBatchSize {
+			return sent, nil
+		}
+	}
+}
+
+func (s *LifeEventReminderService) sendBirthdayReminders(ctx context.Context, day time.Time) (int, error) {
+	days := reminderDays(day)
+	sent := 0
+	var afterID uint
+	for {
+		users, err := s.userRepo.GetBirthdaySharers(ctx, afterID, reminderBatchSize)
+		if err != nil {
+			return sent, fmt.Errorf("failed to load birthdays: %w", err)
+		}
+
+		for _, user := range users {
+			afterID = user.ID
+
+			dateOfBirth, err := time.Parse("2006-01-02", user.DateOfBirth)
+			if err != nil || dateOfBirth.Month() != day.Month() || !containsDay(days, dateOfBirth.Day()) {
+				continue
+			}
+
+			message := fmt.Sprintf("Today is %s's birthday", user.FullName)
+			count, err := s.notifyConnections(ctx, user.ID, entities.NotificationBirthday, "user", user.ID, message)
+			sent += count
+			if err != nil {
+				return sent, err
+			}
+		}
+
+		if len(users) < reminderBatchSize {
+			return sent, nil
+		}
+	}
+}
+
+func (s *LifeEventReminderService) notifyConnections(ctx context.Context, subjectID uint, notificationType entities.NotificationType, entityType string, entityID uint, message string) (int, error) {
+	connections, err := s.connectionRepo.GetConnectionIDs(ctx, subjectID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load connections: %w", err)
+	}
+
+	recipients, err := s.userRepo.FilterReminderRecipients(ctx, connections)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load reminder recipients: %w", err)
+	}
+
+	sent := 0
+	for _, recipientID := range recipients {
+		if _, err := s.notificationSvc.Notify(ctx, &notificationDto.CreateNotificationRequest{
+			UserID:     recipientID,
+			ActorID:    &subjectID,
+			Type:       notificationType,
+			EntityType: entityType,
+			EntityID:   &entityID,
+			Message:    message,
+		}); err != nil {
+			s.logger.Warn("Failed to send life event reminder", "error", err, "user_id", recipientID, "subject_id", subjectID)
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+func reminderDays(day time.Time) []int {
+	days := []int{day.Day()}
+	if day.Month() == time.February && day.Day() == 28 && day.AddDate(0, 0, 1).Month() == time.March {
+		days = append(days, 29)
+	}
+	return days
+}
+
+func containsDay(days []int, day int) bool {
+	for _, d := range days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+func pluralYears(years int) string {
+	if years == 1 {
+		return "year"
+	}
+	return "years"
+}
@@ -0,0 +1,195 @@
+package background
+
+import (
+	"context"
+	mentorshipService "linked-clone/internal/api/mentorship/service"
+	"linked-clone/pkg/logger"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// MentorshipMatchingConfig sets how often check-in reminders go out and how
+// often new mentors are matched.
+type MentorshipMatchingConfig struct {
+	CheckInInterval time.Duration
+	MatchInterval   time.Duration
+}
+
+type MentorshipMatchingService struct {
+	mentorshipSvc mentorshipService.MentorshipService
+	leader        LeaderElector
+	logger        logger.StructuredLogger
+	ticker        *time.Ticker
+	stopChan      chan struct{}
+	wg            sync.WaitGroup
+	mu            sync.Mutex
+	running       bool
+
+	checkInterval   time.Duration
+	matchInterval   time.Duration
+	lastMatchRun    time.Time
+	totalRuns       int64
+	failedRuns      int64
+	matchesProposed int64
+	remindersSent   int64
+	lastRunTime     time.Time
+	lastRunDuration time.Duration
+	lastRunStatus   string
+}
+
+func NewMentorshipMatchingService(mentorshipSvc mentorshipService.MentorshipService, cfg MentorshipMatchingConfig, leader LeaderElector, logger logger.StructuredLogger) *MentorshipMatchingService {
+	if cfg.CheckInInterval <= 0 {
+		cfg.CheckInInterval = 60 * time.Minute
+	}
+	if cfg.MatchInterval <= 0 {
+		cfg.MatchInterval = 24 * time.Hour
+	}
+
+	return &MentorshipMatchingService{
+		mentorshipSvc: mentorshipSvc,
+		leader:        leader,
+		logger:        logger,
+		stopChan:      make(chan struct{}),
+		lastRunStatus: "never_run",
+		checkInterval: cfg.CheckInInterval,
+		matchInterval: cfg.MatchInterval,
+	}
+}
+
+func (s *MentorshipMatchingService) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		s.logger.Warn("Mentorship matching service already running")
+		return
+	}
+
+	// Check-in reminders are sent on every tick; matching only runs once the
+	// match interval has passed since the last successful round.
+	s.ticker = time.NewTicker(s.checkInterval)
+	s.running = true
+	s.wg.Add(1)
+
+	s.logger.Info("Starting mentorship matching service", "check_interval", s.checkInterval.String(), "match_interval", s.matchInterval.String())
+
+	go func() {
+		defer s.wg.Done()
+		defer s.logger.Info("Mentorship matching service stopped")
+
+		s.performRun(ctx)
+
+		for {
+			select {
+			case <-s.ticker.C:
+				s.performRun(ctx)
+			case <-s.stopChan:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (s *MentorshipMatchingService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.running {
+		return
+	}
+
+	s.logger.Info("Stopping mentorship matching service...")
+
+	s.running = false
+	if s.ticker != nil {
+		s.ticker.Stop()
+	}
+	close(s.stopChan)
+	s.wg.Wait()
+}
+
+func (s *MentorshipMatchingService) IsRunning() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+func (s *MentorshipMatchingService) GetMetrics() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return map[string]interface{}{
+		"check_interval":            s.checkInterval.String(),
+		"match_interval":            s.matchInterval.String(),
+		"total_runs":                atomic.LoadInt64(&s.totalRuns),
+		"failed_runs":               atomic.LoadInt64(&s.failedRuns),
+		"matches_proposed":          atomic.LoadInt64(&s.matchesProposed),
+		"reminders_sent":            atomic.LoadInt64(&s.remindersSent),
+		"last_match_run":            s.lastMatchRun.Format(time.RFC3339),
+		"last_run":                  s.lastRunTime.Format(time.RFC3339),
+		"last_run_duration_seconds": s.lastRunDuration.Seconds(),
+		"last_run_status":           s.lastRunStatus,
+	}
+}
+
+func (s *MentorshipMatchingService) performRun(ctx context.Context) {
+	if !s.leader.IsLeader() {
+		return
+	}
+
+	start := time.Now()
+	atomic.AddInt64(&s.totalRuns, 1)
+
+	sent, err := s.mentorshipSvc.SendCheckInReminders(ctx, start)
+	atomic.AddInt64(&s.remindersSent, int64(sent))
+
+	proposed := 0
+	s.mu.Lock()
+	matchDue := start.Sub(s.lastMatchRun) >= s.matchInterval
+	s.mu.Unlock()
+	if err == nil && matchDue {
+		proposed, err = s.mentorshipSvc.ProposeMatches(ctx)
+		atomic.AddInt64(&s.matchesProposed, int64(proposed))
+		if err == nil {
+			s.mu.Lock()
+			s.lastMatchRun = start
+			s.mu.Unlock()
+		}
+	}
+
+	s.mu.Lock()
+	s.lastRunTime = start
+	s.lastRunDuration = time.Since(start)
+	if err != nil {
+		s.lastRunStatus = "failed"
+	} else {
+		s.lastRunStatus = "success"
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		atomic.AddInt64(&s.failedRuns, 1)
+		s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+			Event:    "mentorship_matching_failed",
+			Entity:   "mentorship",
+			Success:  false,
+			Duration: time.Since(start),
+			Error:    err.Error(),
+		})
+		return
+	}
+
+	s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+		Event:    "mentorship_matching_completed",
+		Entity:   "mentorship",
+		Success:  true,
+		Duration: time.Since(start),
+		Details: map[string]interface{}{
+			"proposed":       proposed,
+			"reminders_sent": sent,
+		},
+	})
+}
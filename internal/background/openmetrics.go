@@ -0,0 +1,127 @@
+package background
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const OpenMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+
+type metricSample struct {
+	label string
+	value float64
+}
+
+type metricFamily struct {
+	name    string
+	kind    string
+	help    string
+	samples []metricSample
+}
+
+func (f *metricFamily) add(label string, value float64) {
+	f.samples = append(f.samples, metricSample{label: label, value: value})
+}
+
+// WriteOpenMetrics writes the task and queue families. The caller ends the
+// exposition with "# EOF" once every family is written.
+func (r *Registry) WriteOpenMetrics(w io.Writer) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	running := &metricFamily{name: "background_task_running", kind: "gauge", help: "Whether the background task is running."}
+	runs := &metricFamily{name: "background_task_runs", kind: "counter", help: "Total runs of the background task."}
+	successes := &metricFamily{name: "background_task_successes", kind: "counter", help: "Successful runs of the background task."}
+	failures := &metricFamily{name: "background_task_failures", kind: "counter", help: "Failed runs of the background task."}
+	lastRun := &metricFamily{name: "background_task_last_run_timestamp_seconds", kind: "gauge", help: "Unix time the background task last ran."}
+	lastSuccess := &metricFamily{name: "background_task_last_run_success", kind: "gauge", help: "Whether the last run of the background task succeeded."}
+	latency := &metricFamily{name: "background_task_last_run_duration_seconds", kind: "gauge", help: "Duration of the last run of the background task."}
+
+	for _, name := range sortedKeys(r.services) {
+		service := r.services[name]
+		metrics := service.GetMetrics()
+		label := "task=" + strconv.Quote(name)
+
+		running.add(label, boolValue(service.IsRunning()))
+		total, hasTotal := numberValue(metrics["total_runs"])
+		failed, hasFailed := numberValue(metrics["failed_runs"])
+		if hasTotal {
+			runs.add(label, total)
+		}
+		if hasFailed {
+			failures.add(label, failed)
+		}
+		if hasTotal && hasFailed {
+			successes.add(label, total-failed)
+		}
+		if value, ok := metrics["last_run"].(string); ok {
+			if t, err := time.Parse(time.RFC3339, value); err == nil && t.Year() > 1 {
+				lastRun.add(label, float64(t.Unix()))
+			}
+		}
+		if status, ok := metrics["last_run_status"].(string); ok && status != "never_run" {
+			lastSuccess.add(label, boolValue(status == "success"))
+		}
+		if value, ok := numberValue(metrics["last_run_duration_seconds"]); ok {
+			latency.add(label, value)
+		}
+	}
+
+	depth := &metricFamily{name: "background_queue_depth", kind: "gauge", help: "Items waiting in the queue."}
+	deadLetters := &metricFamily{name: "background_queue_dead_letters", kind: "gauge", help: "Items dropped from the queue since startup."}
+
+	for _, name := range sortedKeys(r.queues) {
+		queue := r.queues[name]
+		label := "queue=" + strconv.Quote(name)
+		depth.add(label, float64(queue.QueueDepth()))
+		deadLetters.add(label, float64(queue.DeadLetterCount()))
+	}
+
+	buf := bufio.NewWriter(w)
+	for _, family := range []*metricFamily{running, runs, successes, failures, lastRun, lastSuccess, latency, depth, deadLetters} {
+		fmt.Fprintf(buf, "# TYPE %s %s\n", family.name, family.kind)
+		fmt.Fprintf(buf, "# HELP %s %s\n", family.name, family.help)
+
+		suffix := ""
+		if family.kind == "counter" {
+			suffix = "_total"
+		}
+		for _, sample := range family.samples {
+			fmt.Fprintf(buf, "%s%s{%s} %s\n", family.name, suffix, sample.label, strconv.FormatFloat(sample.value, 'g', -1, 64))
+		}
+	}
+	return buf.Flush()
+}
+
+func sortedKeys[V any](entries map[string]V) []string {
+	names := make([]string, 0, len(entries))
+	for name := range entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func boolValue(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+func numberValue(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,148 @@
+package background
+
+import (
+	"context"
+	"linked-clone/pkg/logger"
+	"sync"
+	"time"
+)
+
+type PartitionManager interface {
+	EnsureMonthlyPartitions(ctx context.Context, table string, monthsAhead int) (int, error)
+}
+
+type PartitionMaintenanceService struct {
+	partitionManager PartitionManager
+	tables           []string
+	leader           LeaderElector
+	logger           logger.StructuredLogger
+	ticker           *time.Ticker
+	stopChan         chan struct{}
+	wg               sync.WaitGroup
+	mu               sync.Mutex
+	running          bool
+
+	monthsAhead     int
+	lastRunTime     time.Time
+	lastRunDuration time.Duration
+	lastRunStatus   string
+}
+
+func NewPartitionMaintenanceService(partitionManager PartitionManager, tables []string, monthsAhead int, leader LeaderElector, logger logger.StructuredLogger) *PartitionMaintenanceService {
+	if monthsAhead <= 0 {
+		monthsAhead = 3
+	}
+
+	return &PartitionMaintenanceService{
+		partitionManager: partitionManager,
+		tables:           tables,
+		leader:           leader,
+		logger:           logger,
+		stopChan:         make(chan struct{}),
+		lastRunStatus:    "never_run",
+		monthsAhead:      monthsAhead,
+	}
+}
+
+func (s *PartitionMaintenanceService) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		s.logger.Warn("Partition maintenance service already running")
+		return
+	}
+
+	s.ticker = time.NewTicker(24 * time.Hour)
+	s.running = true
+	s.wg.Add(1)
+
+	s.logger.Info("Starting partition maintenance service", "months_ahead", s.monthsAhead)
+
+	go func() {
+		defer s.wg.Done()
+		defer s.logger.Info("Partition maintenance service stopped")
+
+		s.performMaintenance(ctx)
+
+		for {
+			select {
+			case <-s.ticker.C:
+				s.performMaintenance(ctx)
+			case <-s.stopChan:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (s *PartitionMaintenanceService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.running {
+		return
+	}
+
+	s.running = false
+	if s.ticker != nil {
+		s.ticker.Stop()
+	}
+	close(s.stopChan)
+	s.wg.Wait()
+}
+
+func (s *PartitionMaintenanceService) IsRunning() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+func (s *PartitionMaintenanceService) GetMetrics() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return map[string]interface{}{
+		"months_ahead":              s.monthsAhead,
+		"last_run":                  s.lastRunTime.Format(time.RFC3339),
+		"last_run_duration_seconds": s.lastRunDuration.Seconds(),
+		"last_run_status":           s.lastRunStatus,
+		"tables":                    s.tables,
+	}
+}
+
+func (s *PartitionMaintenanceService) performMaintenance(ctx context.Context) {
+	if !s.leader.IsLeader() {
+		return
+	}
+
+	start := time.Now()
+	status := "success"
+
+	for _, table := range s.tables {
+		created, err := s.partitionManager.EnsureMonthlyPartitions(ctx, table, s.monthsAhead)
+		if err != nil {
+			status = "failed"
+			s.logger.Error("Failed to maintain partitions", "error", err, "table", table)
+			continue
+		}
+		if created > 0 {
+			s.logger.Info("Created table partitions", "table", table, "created", created)
+		}
+	}
+
+	s.mu.Lock()
+	s.lastRunTime = start
+	s.lastRunDuration = time.Since(start)
+	s.lastRunStatus = status
+	s.mu.Unlock()
+
+	s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+		Event:    "partition_maintenance_completed",
+		Entity:   "partition",
+		Success:  status == "success",
+		Duration: time.Since(start),
+	})
+}
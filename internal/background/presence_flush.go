@@ -0,0 +1,168 @@
+package background
+
+import (
+	"context"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/presence"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type PresenceFlushService struct {
+	tracker  presence.Tracker
+	userRepo repositories.UserRepository
+	logger   logger.StructuredLogger
+	ticker   *time.Ticker
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+	mu       sync.Mutex
+	running  bool
+
+	interval        time.Duration
+	totalRuns       int64
+	failedRuns      int64
+	usersFlushed    int64
+	lastRunTime     time.Time
+	lastRunDuration time.Duration
+	lastRunStatus   string
+}
+
+func NewPresenceFlushService(tracker presence.Tracker, userRepo repositories.UserRepository, interval time.Duration, logger logger.StructuredLogger) *PresenceFlushService {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	return &PresenceFlushService{
+		tracker:       tracker,
+		userRepo:      userRepo,
+		logger:        logger,
+		stopChan:      make(chan struct{}),
+		lastRunStatus: "never_run",
+		interval:      interval,
+	}
+}
+
+func (s *PresenceFlushService) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		s.logger.Warn("Presence flush service already running")
+		return
+	}
+
+	s.ticker = time.NewTicker(s.interval)
+	s.running = true
+	s.wg.Add(1)
+
+	s.logger.Info("Starting presence flush service", "interval", s.interval.String())
+
+	go func() {
+		defer s.wg.Done()
+		defer s.logger.Info("Presence flush service stopped")
+
+		for {
+			select {
+			case <-s.ticker.C:
+				s.performFlush(ctx)
+			case <-s.stopChan:
+				s.performFlush(context.Background())
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (s *PresenceFlushService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.running {
+		return
+	}
+
+	s.logger.Info("Stopping presence flush service...")
+
+	s.running = false
+	if s.ticker != nil {
+		s.ticker.Stop()
+	}
+	close(s.stopChan)
+	s.wg.Wait()
+}
+
+func (s *PresenceFlushService) IsRunning() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+func (s *PresenceFlushService) GetMetrics() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return map[string]interface{}{
+		"interval":                  s.interval.String(),
+		"total_runs":                atomic.LoadInt64(&s.totalRuns),
+		"failed_runs":               atomic.LoadInt64(&s.failedRuns),
+		"users_flushed":             atomic.LoadInt64(&s.usersFlushed),
+		"last_run":                  s.lastRunTime.Format(time.RFC3339),
+		"last_run_duration_seconds": s.lastRunDuration.Seconds(),
+		"last_run_status":           s.lastRunStatus,
+	}
+}
+
+func (s *PresenceFlushService) performFlush(ctx context.Context) {
+	start := time.Now()
+	atomic.AddInt64(&s.totalRuns, 1)
+
+	flushed := 0
+	activity, err := s.tracker.Drain(ctx)
+	for userID, lastActiveAt := range activity {
+		if updateErr := s.userRepo.UpdateLastActive(ctx, userID, lastActiveAt); updateErr != nil {
+			err = updateErr
+			continue
+		}
+		flushed++
+	}
+
+	atomic.AddInt64(&s.usersFlushed, int64(flushed))
+
+	s.mu.Lock()
+	s.lastRunTime = start
+	s.lastRunDuration = time.Since(start)
+	if err != nil {
+		s.lastRunStatus = "failed"
+	} else {
+		s.lastRunStatus = "success"
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		atomic.AddInt64(&s.failedRuns, 1)
+		s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+			Event:    "presence_flush_failed",
+			Entity:   "user",
+			Success:  false,
+			Duration: time.Since(start),
+			Error:    err.Error(),
+		})
+		return
+	}
+
+	if flushed > 0 {
+		s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+			Event:    "presence_flush_completed",
+			Entity:   "user",
+			Success:  true,
+			Duration: time.Since(start),
+			Details: map[string]interface{}{
+				"users": flushed,
+			},
+		})
+	}
+}
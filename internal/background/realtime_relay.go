@@ -0,0 +1,175 @@
+package background
+
+import (
+	"context"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/realtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	relayRetryBaseDelay = time.Second
+	relayRetryMaxDelay  = 30 * time.Second
+)
+
+type RealtimeRelayService struct {
+	relay    realtime.Relay
+	logger   logger.StructuredLogger
+	ticker   *time.Ticker
+	cancel   context.CancelFunc
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+	mu       sync.Mutex
+	running  bool
+
+	interval       time.Duration
+	subscribed     int32
+	reconnects     int64
+	refreshRuns    int64
+	failedRefresh  int64
+	lastListenErr  string
+	lastRefreshRun time.Time
+}
+
+func NewRealtimeRelayService(relay realtime.Relay, interval time.Duration, logger logger.StructuredLogger) *RealtimeRelayService {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	return &RealtimeRelayService{
+		relay:    relay,
+		logger:   logger,
+		stopChan: make(chan struct{}),
+		interval: interval,
+	}
+}
+
+func (s *RealtimeRelayService) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		s.logger.Warn("Realtime relay service already running")
+		return
+	}
+
+	listenCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+	s.ticker = time.NewTicker(s.interval)
+	s.running = true
+	s.wg.Add(2)
+
+	s.logger.Info("Starting realtime relay service", "route_refresh_interval", s.interval.String())
+
+	go func() {
+		defer s.wg.Done()
+		s.listen(listenCtx)
+	}()
+
+	go func() {
+		defer s.wg.Done()
+		defer s.logger.Info("Realtime relay service stopped")
+
+		for {
+			select {
+			case <-s.ticker.C:
+				s.performRefresh(ctx)
+			case <-s.stopChan:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (s *RealtimeRelayService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.running {
+		return
+	}
+
+	s.logger.Info("Stopping realtime relay service...")
+
+	s.running = false
+	if s.ticker != nil {
+		s.ticker.Stop()
+	}
+	s.cancel()
+	close(s.stopChan)
+	s.wg.Wait()
+}
+
+func (s *RealtimeRelayService) IsRunning() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+func (s *RealtimeRelayService) GetMetrics() map[string]interface{} {
+	metrics := s.relay.GetMetrics()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	metrics["route_refresh_interval"] = s.interval.String()
+	metrics["subscribed"] = atomic.LoadInt32(&s.subscribed) == 1
+	metrics["reconnects"] = atomic.LoadInt64(&s.reconnects)
+	metrics["route_refresh_runs"] = atomic.LoadInt64(&s.refreshRuns)
+	metrics["failed_route_refresh"] = atomic.LoadInt64(&s.failedRefresh)
+	metrics["last_route_refresh"] = s.lastRefreshRun.Format(time.RFC3339)
+	metrics["last_listen_error"] = s.lastListenErr
+	return metrics
+}
+
+func (s *RealtimeRelayService) listen(ctx context.Context) {
+	delay := relayRetryBaseDelay
+	for {
+		start := time.Now()
+		atomic.StoreInt32(&s.subscribed, 1)
+		err := s.relay.Listen(ctx)
+		atomic.StoreInt32(&s.subscribed, 0)
+
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			s.mu.Lock()
+			s.lastListenErr = err.Error()
+			s.mu.Unlock()
+			s.logger.Warn("Realtime relay subscription failed", "error", err, "retry_in", delay.String())
+		}
+
+		if time.Since(start) > relayRetryMaxDelay {
+			delay = relayRetryBaseDelay
+		}
+		atomic.AddInt64(&s.reconnects, 1)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
+		}
+		if delay *= 2; delay > relayRetryMaxDelay {
+			delay = relayRetryMaxDelay
+		}
+	}
+}
+
+func (s *RealtimeRelayService) performRefresh(ctx context.Context) {
+	atomic.AddInt64(&s.refreshRuns, 1)
+
+	err := s.relay.RefreshRoutes(ctx)
+
+	s.mu.Lock()
+	s.lastRefreshRun = time.Now()
+	s.mu.Unlock()
+
+	if err != nil {
+		atomic.AddInt64(&s.failedRefresh, 1)
+		s.logger.Warn("Failed to refresh realtime routes", "error", err)
+	}
+}
@@ -0,0 +1,63 @@
+package background
+
+import (
+	"sync"
+)
+
+type StatusReporter interface {
+	IsRunning() bool
+	GetMetrics() map[string]interface{}
+}
+
+type QueueReporter interface {
+	QueueDepth() int64
+	DeadLetterCount() int64
+}
+
+type Registry struct {
+	mu       sync.RWMutex
+	services map[string]StatusReporter
+	queues   map[string]QueueReporter
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		services: make(map[string]StatusReporter),
+		queues:   make(map[string]QueueReporter),
+	}
+}
+
+func (r *Registry) RegisterQueue(name string, queue QueueReporter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.queues[name] = queue
+}
+
+func (r *Registry) Register(name string, service StatusReporter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.services[name] = service
+}
+
+func (r *Registry) Get(name string) (StatusReporter, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	service, ok := r.services[name]
+	return service, ok
+}
+
+func (r *Registry) Snapshot() map[string]interface{} {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	snapshot := make(map[string]interface{}, len(r.services))
+	for name, service := range r.services {
+		metrics := service.GetMetrics()
+		if metrics == nil {
+			metrics = make(map[string]interface{})
+		}
+		metrics["running"] = service.IsRunning()
+		snapshot[name] = metrics
+	}
+	return snapshot
+}
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"linked-clone/pkg/auth"
 	"linked-clone/pkg/logger"
-	"os"
-	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -24,16 +22,22 @@ type SessionCleanupService struct {
 	totalRuns       int64
 	failedRuns      int64
 	lastRunTime     time.Time
+	lastRunDuration time.Duration
 	lastRunStatus   string
 	cleanupInterval time.Duration
 }
 
-func NewSessionCleanupService(jwtService auth.JWTService, logger logger.StructuredLogger) *SessionCleanupService {
+func NewSessionCleanupService(jwtService auth.JWTService, interval time.Duration, logger logger.StructuredLogger) *SessionCleanupService {
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+
 	return &SessionCleanupService{
-		jwtService:    jwtService,
-		logger:        logger,
-		stopChan:      make(chan struct{}),
-		lastRunStatus: "never_run",
+		jwtService:      jwtService,
+		logger:          logger,
+		stopChan:        make(chan struct{}),
+		lastRunStatus:   "never_run",
+		cleanupInterval: interval,
 	}
 }
 
@@ -46,8 +50,6 @@ func (s *SessionCleanupService) Start(ctx context.Context) {
 		return
 	}
 
-	s.cleanupInterval = s.getCleanupInterval()
-
 	s.ticker = time.NewTicker(s.cleanupInterval)
 	s.running = true
 	s.wg.Add(1)
@@ -137,14 +139,15 @@ func (s *SessionCleanupService) GetMetrics() map[string]interface{} {
 				return "stopped"
 			}
 		}(),
-		"total_runs":               atomic.LoadInt64(&s.totalRuns),
-		"failed_runs":              atomic.LoadInt64(&s.failedRuns),
-		"success_rate":             s.calculateSuccessRate(),
-		"last_run":                 s.lastRunTime.Format(time.RFC3339),
-		"last_run_status":          s.lastRunStatus,
-		"next_run":                 nextRun.Format(time.RFC3339),
-		"cleanup_interval":         s.cleanupInterval.String(),
-		"cleanup_interval_minutes": s.cleanupInterval.Minutes(),
+		"total_runs":                atomic.LoadInt64(&s.totalRuns),
+		"failed_runs":               atomic.LoadInt64(&s.failedRuns),
+		"success_rate":              s.calculateSuccessRate(),
+		"last_run":                  s.lastRunTime.Format(time.RFC3339),
+		"last_run_duration_seconds": s.lastRunDuration.Seconds(),
+		"last_run_status":           s.lastRunStatus,
+		"next_run":                  nextRun.Format(time.RFC3339),
+		"cleanup_interval":          s.cleanupInterval.String(),
+		"cleanup_interval_minutes":  s.cleanupInterval.Minutes(),
 	}
 }
 
@@ -160,6 +163,7 @@ func (s *SessionCleanupService) performCleanup(ctx context.Context) {
 	duration := time.Since(start)
 	s.mu.Lock()
 	s.lastRunTime = start
+	s.lastRunDuration = duration
 	s.mu.Unlock()
 
 	if err != nil {
@@ -221,38 +225,6 @@ func (s *SessionCleanupService) calculateSuccessRate() float64 {
 	return (float64(successRuns) / float64(totalRuns)) * 100.0
 }
 
-func (s *SessionCleanupService) getCleanupInterval() time.Duration {
-
-	defaultInterval := 5 * time.Minute
-
-	envValue := os.Getenv("SESSION_CLEANUP_INTERVAL_MINUTES")
-	if envValue == "" {
-		return defaultInterval
-	}
-
-	minutes, err := strconv.Atoi(envValue)
-	if err != nil {
-		s.logger.Warn("Invalid SESSION_CLEANUP_INTERVAL_MINUTES value, using default",
-			"env_value", envValue,
-			"default_minutes", int(defaultInterval.Minutes()))
-		return defaultInterval
-	}
-
-	if minutes <= 0 {
-		s.logger.Warn("SESSION_CLEANUP_INTERVAL_MINUTES must be positive, using default",
-			"env_value", minutes,
-			"default_minutes", int(defaultInterval.Minutes()))
-		return defaultInterval
-	}
-
-	interval := time.Duration(minutes) * time.Minute
-	s.logger.Info("Using custom cleanup interval from environment",
-		"interval_minutes", minutes,
-		"interval", interval.String())
-
-	return interval
-}
-
 func (s *SessionCleanupService) ForceCleanup(ctx context.Context) error {
 	if !s.IsRunning() {
 		return fmt.Errorf("cleanup service is not running")
@@ -0,0 +1,203 @@
+package background
+
+import (
+	"context"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/counter"
+	"linked-clone/pkg/logger"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type UnreadReconciliationService struct {
+	notificationRepo repositories.NotificationRepository
+	messageRepo      repositories.MessageRepository
+	unreadCounter    counter.UnreadCounter
+	logger           logger.StructuredLogger
+	stopChan         chan struct{}
+	wg               sync.WaitGroup
+	mu               sync.Mutex
+	running          bool
+
+	totalRuns       int64
+	failedRuns      int64
+	lastRunTime     time.Time
+	lastRunDuration time.Duration
+	lastRunStatus   string
+	runHour         int
+}
+
+func NewUnreadReconciliationService(
+	notificationRepo repositories.NotificationRepository,
+	messageRepo repositories.MessageRepository,
+	unreadCounter counter.UnreadCounter,
+	runHour int,
+	logger logger.StructuredLogger,
+) *UnreadReconciliationService {
+	if runHour < 0 || runHour > 23 {
+		runHour = 3
+	}
+
+	return &UnreadReconciliationService{
+		notificationRepo: notificationRepo,
+		messageRepo:      messageRepo,
+		unreadCounter:    unreadCounter,
+		logger:           logger,
+		stopChan:         make(chan struct{}),
+		lastRunStatus:    "never_run",
+		runHour:          runHour,
+	}
+}
+
+func (s *UnreadReconciliationService) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		s.logger.Warn("Unread reconciliation service already running")
+		return
+	}
+
+	s.running = true
+	s.wg.Add(1)
+
+	s.logger.Info("Starting unread reconciliation service", "run_hour", s.runHour)
+
+	go func() {
+		defer s.wg.Done()
+		defer s.logger.Info("Unread reconciliation service stopped")
+
+		for {
+			timer := time.NewTimer(time.Until(s.nextRun(time.Now())))
+			select {
+			case <-timer.C:
+				s.performReconciliation(ctx)
+			case <-s.stopChan:
+				timer.Stop()
+				s.logger.Info("Unread reconciliation service received stop signal")
+				return
+			case <-ctx.Done():
+				timer.Stop()
+				s.logger.Info("Unread reconciliation service context cancelled")
+				return
+			}
+		}
+	}()
+}
+
+func (s *UnreadReconciliationService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.running {
+		return
+	}
+
+	s.logger.Info("Stopping unread reconciliation service...")
+
+	s.running = false
+	close(s.stopChan)
+	s.wg.Wait()
+}
+
+func (s *UnreadReconciliationService) IsRunning() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+func (s *UnreadReconciliationService) GetMetrics() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return map[string]interface{}{
+		"total_runs":                atomic.LoadInt64(&s.totalRuns),
+		"failed_runs":               atomic.LoadInt64(&s.failedRuns),
+		"last_run":                  s.lastRunTime.Format(time.RFC3339),
+		"last_run_duration_seconds": s.lastRunDuration.Seconds(),
+		"last_run_status":           s.lastRunStatus,
+		"next_run":                  s.nextRun(time.Now()).Format(time.RFC3339),
+	}
+}
+
+func (s *UnreadReconciliationService) performReconciliation(ctx context.Context) {
+	start := time.Now()
+	atomic.AddInt64(&s.totalRuns, 1)
+
+	err := s.reconcile(ctx, counter.UnreadNotifications, s.notificationRepo.CountUnreadByUser)
+	if err == nil {
+		err = s.reconcile(ctx, counter.UnreadMessages, s.messageRepo.CountUnreadByUser)
+	}
+
+	duration := time.Since(start)
+	s.mu.Lock()
+	s.lastRunTime = start
+	s.lastRunDuration = duration
+	if err != nil {
+		s.lastRunStatus = "failed"
+	} else {
+		s.lastRunStatus = "success"
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		atomic.AddInt64(&s.failedRuns, 1)
+		s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+			Event:    "unread_reconciliation_failed",
+			Entity:   "unread_counter",
+			Success:  false,
+			Duration: duration,
+			Error:    err.Error(),
+		})
+		return
+	}
+
+	s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+		Event:    "unread_reconciliation_completed",
+		Entity:   "unread_counter",
+		Success:  true,
+		Duration: duration,
+		Details: map[string]interface{}{
+			"run_number": atomic.LoadInt64(&s.totalRuns),
+		},
+	})
+}
+
+func (s *UnreadReconciliationService) reconcile(ctx context.Context, kind counter.UnreadKind, countByUser func(context.Context) (map[uint]int64, error)) error {
+	counts, err := countByUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	for userID, count := range counts {
+		if err := s.unreadCounter.Set(ctx, kind, userID, count); err != nil {
+			return err
+		}
+	}
+
+	tracked, err := s.unreadCounter.TrackedUsers(ctx, kind)
+	if err != nil {
+		return err
+	}
+
+	for _, userID := range tracked {
+		if _, ok := counts[userID]; ok {
+			continue
+		}
+		if err := s.unreadCounter.Set(ctx, kind, userID, 0); err != nil {
+			return err
+		}
+	}
+
+	s.logger.Debug("Unread counters reconciled", "kind", kind, "users", len(counts))
+	return nil
+}
+
+func (s *UnreadReconciliationService) nextRun(now time.Time) time.Time {
+	next := time.Date(now.Year(), now.Month(), now.Day(), s.runHour, 0, 0, 0, now.Location())
+	if !next.After(now) {
+		next = next.Add(24 * time.Hour)
+	}
+	return next
+}
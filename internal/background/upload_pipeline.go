@@ -0,0 +1,218 @@
+package background
+
+import (
+	"context"
+	uploadService "linked-clone/internal/api/upload/service"
+	"linked-clone/pkg/logger"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// UploadPipelineConfig sizes the upload worker pool and sets when an
+// unfinished upload counts as interrupted and how long one task may run.
+type UploadPipelineConfig struct {
+	Workers     int
+	StaleAfter  time.Duration
+	TaskTimeout time.Duration
+}
+
+// UploadPipelineService runs queued uploads through validation, scanning and
+// processing on a pool of workers. The leader also fails uploads that have
+// not moved for a while, which are those queued on an instance that stopped.
+type UploadPipelineService struct {
+	uploads  uploadService.UploadService
+	leader   LeaderElector
+	logger   logger.StructuredLogger
+	ticker   *time.Ticker
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+	mu       sync.Mutex
+	running  bool
+
+	workers        int
+	staleAfter     time.Duration
+	taskTimeout    time.Duration
+	active         int64
+	processed      int64
+	failed         int64
+	staleFailed    int64
+	lastSweepTime  time.Time
+	lastSweepError string
+}
+
+func NewUploadPipelineService(uploads uploadService.UploadService, cfg UploadPipelineConfig, leader LeaderElector, logger logger.StructuredLogger) *UploadPipelineService {
+	if cfg.Workers <= 0 {
+		cfg.Workers = 2
+	}
+	if cfg.StaleAfter <= 0 {
+		cfg.StaleAfter = 15 * time.Minute
+	}
+	if cfg.TaskTimeout <= 0 {
+		cfg.TaskTimeout = 10 * time.Minute
+	}
+
+	return &UploadPipelineService{
+		uploads:     uploads,
+		leader:      leader,
+		logger:      logger,
+		stopChan:    make(chan struct{}),
+		workers:     cfg.Workers,
+		staleAfter:  cfg.StaleAfter,
+		taskTimeout: cfg.TaskTimeout,
+	}
+}
+
+func (s *UploadPipelineService) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		s.logger.Warn("Upload pipeline service already running")
+		return
+	}
+
+	// Sweeping a few times per stale window keeps interrupted uploads from
+	// showing as in progress for much longer than the window itself.
+	s.ticker = time.NewTicker(s.staleAfter / 3)
+	s.running = true
+	s.wg.Add(s.workers + 1)
+
+	s.logger.Info("Starting upload pipeline service",
+		"workers", s.workers,
+		"stale_after", s.staleAfter.String(),
+		"task_timeout", s.taskTimeout.String())
+
+	for i := 0; i < s.workers; i++ {
+		go func() {
+			defer s.wg.Done()
+			s.work(ctx)
+		}()
+	}
+
+	go func() {
+		defer s.wg.Done()
+		defer s.logger.Info("Upload pipeline service stopped")
+
+		for {
+			select {
+			case <-s.ticker.C:
+				s.performSweep(ctx)
+			case <-s.stopChan:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (s *UploadPipelineService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.running {
+		return
+	}
+
+	s.logger.Info("Stopping upload pipeline service...")
+
+	s.running = false
+	if s.ticker != nil {
+		s.ticker.Stop()
+	}
+	close(s.stopChan)
+	s.wg.Wait()
+}
+
+func (s *UploadPipelineService) IsRunning() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+func (s *UploadPipelineService) GetMetrics() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return map[string]interface{}{
+		"workers":          s.workers,
+		"stale_after":      s.staleAfter.String(),
+		"task_timeout":     s.taskTimeout.String(),
+		"queue_depth":      s.uploads.QueueDepth(),
+		"active":           atomic.LoadInt64(&s.active),
+		"processed":        atomic.LoadInt64(&s.processed),
+		"failed":           atomic.LoadInt64(&s.failed),
+		"stale_failed":     atomic.LoadInt64(&s.staleFailed),
+		"last_sweep":       s.lastSweepTime.Format(time.RFC3339),
+		"last_sweep_error": s.lastSweepError,
+	}
+}
+
+// work drains the upload queue until the service stops. A task already
+// taken is finished before the worker exits, so stopping waits for at most
+// one task timeout.
+func (s *UploadPipelineService) work(ctx context.Context) {
+	tasks := s.uploads.Tasks()
+	for {
+		select {
+		case task := <-tasks:
+			s.run(ctx, task)
+		case <-s.stopChan:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *UploadPipelineService) run(ctx context.Context, task *uploadService.Task) {
+	taskCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.taskTimeout)
+	defer cancel()
+
+	atomic.AddInt64(&s.active, 1)
+	defer atomic.AddInt64(&s.active, -1)
+
+	start := time.Now()
+	err := s.uploads.Run(taskCtx, task)
+	atomic.AddInt64(&s.processed, 1)
+
+	if err != nil {
+		atomic.AddInt64(&s.failed, 1)
+		s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+			Event:    "upload_failed",
+			Entity:   "upload",
+			EntityID: strconv.FormatUint(uint64(task.Job.ID), 10),
+			UserID:   task.Job.UserID,
+			Success:  false,
+			Duration: time.Since(start),
+			Error:    err.Error(),
+		})
+	}
+}
+
+func (s *UploadPipelineService) performSweep(ctx context.Context) {
+	if !s.leader.IsLeader() {
+		return
+	}
+
+	failed, err := s.uploads.FailStale(ctx, time.Now().Add(-s.staleAfter))
+	atomic.AddInt64(&s.staleFailed, int64(failed))
+
+	s.mu.Lock()
+	s.lastSweepTime = time.Now()
+	s.lastSweepError = ""
+	if err != nil {
+		s.lastSweepError = err.Error()
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		s.logger.Error("Failed to sweep stale uploads", "error", err)
+		return
+	}
+	if failed > 0 {
+		s.logger.Warn("Failed stale uploads", "count", failed)
+	}
+}
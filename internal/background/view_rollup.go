@@ -0,0 +1,210 @@
+package background
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/counter"
+	"linked-clone/pkg/logger"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type ViewRollupService struct {
+	analyticsRepo repositories.AnalyticsRepository
+	viewCounter   counter.ViewCounter
+	logger        logger.StructuredLogger
+	ticker        *time.Ticker
+	stopChan      chan struct{}
+	wg            sync.WaitGroup
+	mu            sync.Mutex
+	running       bool
+
+	interval        time.Duration
+	totalRuns       int64
+	failedRuns      int64
+	rollupsFlushed  int64
+	lastRunTime     time.Time
+	lastRunDuration time.Duration
+	lastRunStatus   string
+}
+
+func NewViewRollupService(
+	analyticsRepo repositories.AnalyticsRepository,
+	viewCounter counter.ViewCounter,
+	interval time.Duration,
+	logger logger.StructuredLogger,
+) *ViewRollupService {
+	if interval <= 0 {
+		interval = 15 * time.Minute
+	}
+
+	return &ViewRollupService{
+		analyticsRepo: analyticsRepo,
+		viewCounter:   viewCounter,
+		logger:        logger,
+		stopChan:      make(chan struct{}),
+		lastRunStatus: "never_run",
+		interval:      interval,
+	}
+}
+
+func (s *ViewRollupService) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.running {
+		s.logger.Warn("View rollup service already running")
+		return
+	}
+
+	s.ticker = time.NewTicker(s.interval)
+	s.running = true
+	s.wg.Add(1)
+
+	s.logger.Info("Starting view rollup service", "interval", s.interval.String())
+
+	go func() {
+		defer s.wg.Done()
+		defer s.logger.Info("View rollup service stopped")
+
+		for {
+			select {
+			case <-s.ticker.C:
+				s.performFlush(ctx)
+			case <-s.stopChan:
+				s.performFlush(context.Background())
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (s *ViewRollupService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.running {
+		return
+	}
+
+	s.logger.Info("Stopping view rollup service...")
+
+	s.running = false
+	if s.ticker != nil {
+		s.ticker.Stop()
+	}
+	close(s.stopChan)
+	s.wg.Wait()
+}
+
+func (s *ViewRollupService) IsRunning() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running
+}
+
+func (s *ViewRollupService) GetMetrics() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return map[string]interface{}{
+		"interval":                  s.interval.String(),
+		"total_runs":                atomic.LoadInt64(&s.totalRuns),
+		"failed_runs":               atomic.LoadInt64(&s.failedRuns),
+		"rollups_flushed":           atomic.LoadInt64(&s.rollupsFlushed),
+		"last_run":                  s.lastRunTime.Format(time.RFC3339),
+		"last_run_duration_seconds": s.lastRunDuration.Seconds(),
+		"last_run_status":           s.lastRunStatus,
+	}
+}
+
+func (s *ViewRollupService) performFlush(ctx context.Context) {
+	start := time.Now()
+	atomic.AddInt64(&s.totalRuns, 1)
+
+	today := start.UTC().Truncate(24 * time.Hour)
+	days := []time.Time{today.AddDate(0, 0, -1), today}
+
+	var flushed int
+	var err error
+	for _, kind := range []counter.ViewKind{counter.ViewPost, counter.ViewProfile, counter.ViewJob} {
+		for _, day := range days {
+			var count int
+			count, err = s.flush(ctx, kind, day)
+			flushed += count
+			if err != nil {
+				break
+			}
+		}
+		if err != nil {
+			break
+		}
+	}
+
+	atomic.AddInt64(&s.rollupsFlushed, int64(flushed))
+
+	s.mu.Lock()
+	s.lastRunTime = start
+	s.lastRunDuration = time.Since(start)
+	if err != nil {
+		s.lastRunStatus = "failed"
+	} else {
+		s.lastRunStatus = "success"
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		atomic.AddInt64(&s.failedRuns, 1)
+		s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+			Event:    "view_rollup_failed",
+			Entity:   "view_rollup",
+			Success:  false,
+			Duration: time.Since(start),
+			Error:    err.Error(),
+		})
+		return
+	}
+
+	s.logger.LogBusinessEvent(ctx, logger.BusinessEventLog{
+		Event:    "view_rollup_completed",
+		Entity:   "view_rollup",
+		Success:  true,
+		Duration: time.Since(start),
+		Details: map[string]interface{}{
+			"rollups": flushed,
+		},
+	})
+}
+
+func (s *ViewRollupService) flush(ctx context.Context, kind counter.ViewKind, day time.Time) (int, error) {
+	entityIDs, err := s.viewCounter.TrackedEntities(ctx, kind, day)
+	if err != nil {
+		return 0, err
+	}
+
+	rollups := make([]*entities.ViewRollup, 0, len(entityIDs))
+	for _, entityID := range entityIDs {
+		count, err := s.viewCounter.Get(ctx, kind, entityID, day)
+		if err != nil {
+			return 0, err
+		}
+
+		rollups = append(rollups, &entities.ViewRollup{
+			EntityType:    string(kind),
+			EntityID:      entityID,
+			Day:           day,
+			UniqueViewers: count.Unique,
+			TotalViews:    count.Total,
+		})
+	}
+
+	if err := s.analyticsRepo.UpsertViewRollups(ctx, rollups); err != nil {
+		return 0, err
+	}
+
+	return len(rollups), nil
+}
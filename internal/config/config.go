@@ -1,42 +1,97 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Redis    RedisConfig
-	JWT      JWTConfig
-	AWS      AWSConfig
-	Midtrans MidtransConfig
-	SMTP     SMTPConfig
+	Server     ServerConfig
+	Database   DatabaseConfig
+	Redis      RedisConfig
+	JWT        JWTConfig
+	AWS        AWSConfig
+	Midtrans   MidtransConfig
+	SMTP       SMTPConfig
+	Breaker    BreakerConfig
+	Retry      RetryConfig
+	Startup    StartupConfig
+	Encryption EncryptionConfig
+	Privacy    PrivacyConfig
+	Feed       FeedConfig
+	Signup     SignupConfig
+	Password   PasswordConfig
+	GeoIP      GeoIPConfig
+	Locale     LocaleConfig
+	APIKey     APIKeyConfig
+	Invite     InviteConfig
+	Moderation ModerationConfig
+	SMS        SMSConfig
+	Email      EmailConfig
+	Cluster    ClusterConfig
+	Backup     BackupConfig
+	Retention  RetentionConfig
+	Export     ExportConfig
+	Media      MediaConfig
+	SSO        SSOConfig
+	TwoFactor  TwoFactorConfig
+	MagicLink  MagicLinkConfig
+	Upload     UploadConfig
+	Capture    CaptureConfig
+	Chaos      ChaosConfig
+	Background BackgroundConfig
 }
 
 type ServerConfig struct {
-	Port         string
-	Environment  string
-	ReadTimeout  time.Duration
-	WriteTimeout time.Duration
+	Port           string
+	Environment    string
+	ReadTimeout    time.Duration
+	WriteTimeout   time.Duration
+	RequestTimeout time.Duration
+	// Route groups that need a shorter or longer request timeout than
+	// RequestTimeout declare it with one of these.
+	AuthRequestTimeout   time.Duration
+	UploadRequestTimeout time.Duration
+	ExportRequestTimeout time.Duration
+	// AllowedOrigins are the browser origins allowed to call the API and
+	// open realtime sockets.
+	AllowedOrigins []string
 }
 
 type DatabaseConfig struct {
-	Host     string
-	Port     string
-	User     string
-	Password string
-	DBName   string
-	SSLMode  string
+	Host                 string
+	Port                 string
+	User                 string
+	Password             string
+	DBName               string
+	SSLMode              string
+	StatementTimeout     time.Duration
+	QueryTimeout         time.Duration
+	AutoMigrate          bool
+	MigrationsDir        string
+	MigrationLockTimeout time.Duration
 }
 
 type RedisConfig struct {
-	Host     string
-	Port     string
-	Password string
-	DB       int
+	Mode             string
+	Host             string
+	Port             string
+	Addrs            []string
+	MasterName       string
+	Password         string
+	SentinelPassword string
+	DB               int
+	PoolSize         int
+	MinIdleConns     int
+	DialTimeout      time.Duration
+	ReadTimeout      time.Duration
+	WriteTimeout     time.Duration
+	OperationTimeout time.Duration
+	TLSEnabled       bool
+	TLSSkipVerify    bool
 }
 
 type JWTConfig struct {
@@ -58,38 +113,513 @@ type MidtransConfig struct {
 }
 
 type SMTPConfig struct {
-	Host     string
-	Port     int
-	Username string
-	Password string
+	Host               string
+	Port               int
+	Username           string
+	Password           string
+	MaxConcurrency     int
+	IdleTimeout        time.Duration
+	AcquireTimeout     time.Duration
+	RateLimit          int
+	ProviderRateLimits []string
+}
+
+type BreakerConfig struct {
+	MaxFailures int
+	OpenTimeout time.Duration
+}
+
+type RetryConfig struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+type StartupConfig struct {
+	ProbeTimeout         time.Duration
+	RequiredDependencies []string
+}
+
+type EncryptionConfig struct {
+	Keys         string
+	PrimaryKeyID string
+	Pepper       string
+}
+
+type PrivacyConfig struct {
+	DeletionGraceDays int
+}
+
+type FeedConfig struct {
+	FanoutEnabled    bool
+	RankingMode      string
+	RankingWindow    int
+	WeightRecency    float64
+	WeightDegree     float64
+	WeightEngagement float64
+	WeightAffinity   float64
+	WeightFeedback   float64
+	HalfLifeHours    float64
+}
+
+type EmailConfig struct {
+	SendDelay   time.Duration
+	LinkBaseURL string
+}
+
+type MediaConfig struct {
+	TokenSecret string
+	TokenTTL    time.Duration
+	URLTTL      time.Duration
+}
+
+// SSOConfig configures organization single sign-on. FreeMailDomains can
+// never be claimed by a workspace, since nobody controls every mailbox on
+// them.
+type SSOConfig struct {
+	BaseURL             string
+	FrontendRedirectURL string
+	LinkRedirectURL     string
+	SPEntityID          string
+	StateTTL            time.Duration
+	LinkTTL             time.Duration
+	HTTPTimeout         time.Duration
+	FreeMailDomains     []string
+}
+
+type PasswordConfig struct {
+	MinLength           int
+	MaxLength           int
+	MinCharacterClasses int
+	BlockCommon         bool
+	CommonPasswordsFile string
+	BreachCheck         bool
+	BreachAPIURL        string
+	BreachTimeout       time.Duration
+	BreachThreshold     int
+}
+
+type GeoIPConfig struct {
+	DatabaseFile string
+}
+
+type LocaleConfig struct {
+	Default string
+	Dir     string
+}
+
+// APIKeyConfig limits integration keys. Rate limits are requests per
+// minute for each key; monthly quotas are requests per key per calendar
+// month, set by the owner's plan, with 0 meaning unlimited.
+type APIKeyConfig struct {
+	MaxPerUser       int
+	DefaultRateLimit int
+	MaxRateLimit     int
+
+	BasicMonthlyQuota     int
+	PremiumMonthlyQuota   int
+	RecruiterMonthlyQuota int
+}
+
+// InviteConfig controls invite-only registration. Quota is how many people
+// a user who is not an admin may invite in total.
+type InviteConfig struct {
+	Required    bool
+	Quota       int
+	MaxUses     int
+	DefaultDays int
+}
+
+// ModerationConfig sets when strikes suspend an account: StrikeLimit active
+// strikes within StrikeExpiryDays of each other.
+type ModerationConfig struct {
+	StrikeLimit      int
+	StrikeExpiryDays int
+}
+
+// SMSConfig picks the provider for text messages. Driver is "log", which
+// sends nothing and is refused in production, or "twilio". SendLimit caps
+// the codes texted to one account per hour.
+type SMSConfig struct {
+	Driver           string
+	TwilioAccountSID string
+	TwilioAuthToken  string
+	TwilioFrom       string
+	Timeout          time.Duration
+	CodeTTL          time.Duration
+	SendLimit        int
+}
+
+type TwoFactorConfig struct {
+	Issuer       string
+	ChallengeTTL time.Duration
+	PendingTTL   time.Duration
+}
+
+type MagicLinkConfig struct {
+	Secret      string
+	TTL         time.Duration
+	RedirectURL string
+	ResendAfter time.Duration
+}
+
+type UploadConfig struct {
+	QueueSize int
+	MaxBytes  int64
+}
+
+type CaptureConfig struct {
+	Enabled      bool
+	SampleRate   float64
+	MaxEntries   int
+	TTL          time.Duration
+	MaxBodyBytes int64
+}
+
+type ChaosConfig struct {
+	Enabled bool
+	Rules   string
+}
+
+type ClusterConfig struct {
+	InstanceID string
+	LeaseTTL   time.Duration
+}
+
+type BackupConfig struct {
+	Bucket        string
+	Prefix        string
+	RetentionDays int
+	MinKeep       int
+}
+
+// RetentionConfig sets how many days notifications and analytics events
+// are kept, zero keeping them forever, and whether older rows are archived
+// or deleted.
+type RetentionConfig struct {
+	Mode             string
+	BatchSize        int
+	Interval         time.Duration
+	NotificationDays int
+	AnalyticsDays    int
+}
+
+// BackgroundConfig tunes the background services: how often each one runs
+// and how much work it takes on per run.
+type BackgroundConfig struct {
+	SessionCleanupInterval   time.Duration
+	UnreadReconciliationHour int
+	PartitionMonthsAhead     int
+	ViewRollupInterval       time.Duration
+
+	CompanyAnalyticsInterval     time.Duration
+	CompanyAnalyticsLookbackDays int
+
+	JobDeadlineInterval time.Duration
+	JobAlertInterval    time.Duration
+
+	AccountPurgeInterval    time.Duration
+	AccountPurgeBatchSize   int
+	AccountPurgeMaxAttempts int
+
+	LifeEventReminderInterval time.Duration
+
+	ConnectionSuggestionRefresh time.Duration
+	ConnectionSuggestionPerUser int
+
+	MentorshipCheckInInterval time.Duration
+	MentorshipMatchInterval   time.Duration
+
+	ExportInterval     time.Duration
+	ExportLookbackDays int
+
+	PresenceFlushInterval time.Duration
+
+	EmailDispatchInterval    time.Duration
+	EmailDispatchBatchSize   int
+	EmailDispatchMaxAttempts int
+
+	HeartbeatInterval    time.Duration
+	RouteRefreshInterval time.Duration
+
+	UploadWorkers     int
+	UploadStaleAfter  time.Duration
+	UploadTaskTimeout time.Duration
+}
+
+type ExportConfig struct {
+	Tables  []string
+	Bucket  string
+	Prefix  string
+	HashKey string
+}
+
+type SignupConfig struct {
+	IPLimit               int
+	IPWindow              time.Duration
+	DomainLimit           int
+	DomainWindow          time.Duration
+	DomainLimitExempt     []string
+	BlockDisposable       bool
+	DisposableDomainsFile string
+	AllowedDomains        []string
 }
 
 func Load() (*Config, error) {
-	redisDB, _ := strconv.Atoi(getEnv("REDIS_DB", "0"))
-	jwtExpiry, _ := strconv.Atoi(getEnv("JWT_EXPIRY_HOURS", "24"))
-	smtpPort, _ := strconv.Atoi(getEnv("SMTP_PORT", "587"))
-	isProduction, _ := strconv.ParseBool(getEnv("MIDTRANS_IS_PRODUCTION", "false"))
+	redisDB, err := getEnvInt("REDIS_DB", 0)
+	if err != nil {
+		return nil, err
+	}
+	redisPoolSize, err := getEnvInt("REDIS_POOL_SIZE", 0)
+	if err != nil {
+		return nil, err
+	}
+	redisMinIdleConns, err := getEnvInt("REDIS_MIN_IDLE_CONNS", 0)
+	if err != nil {
+		return nil, err
+	}
+	jwtExpiry, err := getEnvInt("JWT_EXPIRY_HOURS", 24)
+	if err != nil {
+		return nil, err
+	}
+	smtpPort, err := getEnvInt("SMTP_PORT", 587)
+	if err != nil {
+		return nil, err
+	}
+	smtpMaxConcurrency, err := getEnvInt("SMTP_MAX_CONCURRENCY", 4)
+	if err != nil {
+		return nil, err
+	}
+	smtpRateLimit, err := getEnvInt("SMTP_RATE_LIMIT_PER_SECOND", 10)
+	if err != nil {
+		return nil, err
+	}
+	isProduction, err := strconv.ParseBool(getEnv("MIDTRANS_IS_PRODUCTION", "false"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid MIDTRANS_IS_PRODUCTION: %w", err)
+	}
+	breakerMaxFailures, err := getEnvInt("BREAKER_MAX_FAILURES", 5)
+	if err != nil {
+		return nil, err
+	}
+	retryMaxAttempts, err := getEnvInt("RETRY_MAX_ATTEMPTS", 3)
+	if err != nil {
+		return nil, err
+	}
+	deletionGraceDays, err := getEnvInt("ACCOUNT_DELETION_GRACE_DAYS", 30)
+	if err != nil {
+		return nil, err
+	}
+	signupIPLimit, err := getEnvInt("SIGNUP_IP_LIMIT", 5)
+	if err != nil {
+		return nil, err
+	}
+	signupDomainLimit, err := getEnvInt("SIGNUP_DOMAIN_LIMIT", 20)
+	if err != nil {
+		return nil, err
+	}
+	passwordMinLength, err := getEnvInt("PASSWORD_MIN_LENGTH", 8)
+	if err != nil {
+		return nil, err
+	}
+	passwordMaxLength, err := getEnvInt("PASSWORD_MAX_LENGTH", 72)
+	if err != nil {
+		return nil, err
+	}
+	passwordMinClasses, err := getEnvInt("PASSWORD_MIN_CHARACTER_CLASSES", 3)
+	if err != nil {
+		return nil, err
+	}
+	passwordBreachThreshold, err := getEnvInt("PASSWORD_BREACH_THRESHOLD", 1)
+	if err != nil {
+		return nil, err
+	}
+	apiKeyMaxPerUser, err := getEnvInt("API_KEY_MAX_PER_USER", 10)
+	if err != nil {
+		return nil, err
+	}
+	apiKeyDefaultRateLimit, err := getEnvInt("API_KEY_DEFAULT_RATE_LIMIT", 60)
+	if err != nil {
+		return nil, err
+	}
+	apiKeyMaxRateLimit, err := getEnvInt("API_KEY_MAX_RATE_LIMIT", 600)
+	if err != nil {
+		return nil, err
+	}
+	apiKeyBasicMonthlyQuota, err := getEnvInt("API_KEY_MONTHLY_QUOTA_BASIC", 10000)
+	if err != nil {
+		return nil, err
+	}
+	apiKeyPremiumMonthlyQuota, err := getEnvInt("API_KEY_MONTHLY_QUOTA_PREMIUM", 100000)
+	if err != nil {
+		return nil, err
+	}
+	apiKeyRecruiterMonthlyQuota, err := getEnvInt("API_KEY_MONTHLY_QUOTA_RECRUITER", 1000000)
+	if err != nil {
+		return nil, err
+	}
+	inviteQuota, err := getEnvInt("INVITE_QUOTA_PER_USER", 5)
+	if err != nil {
+		return nil, err
+	}
+	inviteMaxUses, err := getEnvInt("INVITE_MAX_USES", 25)
+	if err != nil {
+		return nil, err
+	}
+	inviteDefaultDays, err := getEnvInt("INVITE_EXPIRY_DAYS", 30)
+	if err != nil {
+		return nil, err
+	}
+	strikeLimit, err := getEnvInt("MODERATION_STRIKE_LIMIT", 3)
+	if err != nil {
+		return nil, err
+	}
+	strikeExpiryDays, err := getEnvInt("MODERATION_STRIKE_EXPIRY_DAYS", 180)
+	if err != nil {
+		return nil, err
+	}
+	smsSendLimit, err := getEnvInt("SMS_SEND_LIMIT_PER_HOUR", 5)
+	if err != nil {
+		return nil, err
+	}
+
+	backupRetentionDays, err := getEnvInt("BACKUP_RETENTION_DAYS", 30)
+	if err != nil {
+		return nil, err
+	}
+	backupMinKeep, err := getEnvInt("BACKUP_MIN_KEEP", 3)
+	if err != nil {
+		return nil, err
+	}
+	retentionBatchSize, err := getEnvInt("RETENTION_BATCH_SIZE", 1000)
+	if err != nil {
+		return nil, err
+	}
+	retentionIntervalHours, err := getEnvInt("RETENTION_INTERVAL_HOURS", 24)
+	if err != nil {
+		return nil, err
+	}
+	if retentionBatchSize <= 0 || retentionIntervalHours <= 0 {
+		return nil, fmt.Errorf("invalid RETENTION_BATCH_SIZE or RETENTION_INTERVAL_HOURS: must be positive")
+	}
+	retentionNotificationDays, err := getEnvInt("RETENTION_NOTIFICATION_DAYS", 90)
+	if err != nil {
+		return nil, err
+	}
+	retentionAnalyticsDays, err := getEnvInt("RETENTION_ANALYTICS_DAYS", 365)
+	if err != nil {
+		return nil, err
+	}
+	feedRankingWindow, err := getEnvInt("FEED_RANKING_WINDOW", 200)
+	if err != nil {
+		return nil, err
+	}
+	captureMaxEntries, err := getEnvInt("CAPTURE_MAX_ENTRIES", 10000)
+	if err != nil {
+		return nil, err
+	}
+	captureMaxBodyBytes, err := getEnvInt("CAPTURE_MAX_BODY_BYTES", 65536)
+	if err != nil {
+		return nil, err
+	}
+	uploadQueueSize, err := getEnvInt("UPLOAD_QUEUE_SIZE", 100)
+	if err != nil {
+		return nil, err
+	}
+	uploadMaxBytes, err := getEnvInt("UPLOAD_MAX_BYTES", 20<<20)
+	if err != nil {
+		return nil, err
+	}
+	unreadReconciliationHour, err := getEnvInt("UNREAD_RECONCILIATION_HOUR", 3)
+	if err != nil {
+		return nil, err
+	}
+	if unreadReconciliationHour < 0 || unreadReconciliationHour > 23 {
+		return nil, fmt.Errorf("invalid UNREAD_RECONCILIATION_HOUR: must be between 0 and 23")
+	}
+	partitionMonthsAhead, err := getEnvInt("PARTITION_MONTHS_AHEAD", 3)
+	if err != nil {
+		return nil, err
+	}
+	companyAnalyticsLookbackDays, err := getEnvInt("COMPANY_ANALYTICS_LOOKBACK_DAYS", 7)
+	if err != nil {
+		return nil, err
+	}
+	accountPurgeBatchSize, err := getEnvInt("ACCOUNT_PURGE_BATCH_SIZE", 20)
+	if err != nil {
+		return nil, err
+	}
+	accountPurgeMaxAttempts, err := getEnvInt("ACCOUNT_PURGE_MAX_ATTEMPTS", 5)
+	if err != nil {
+		return nil, err
+	}
+	connectionSuggestionPerUser, err := getEnvInt("CONNECTION_SUGGESTION_PER_USER", 50)
+	if err != nil {
+		return nil, err
+	}
+	exportLookbackDays, err := getEnvInt("ANALYTICS_EXPORT_LOOKBACK_DAYS", 3)
+	if err != nil {
+		return nil, err
+	}
+	emailDispatchBatchSize, err := getEnvInt("EMAIL_DISPATCH_BATCH_SIZE", 50)
+	if err != nil {
+		return nil, err
+	}
+	emailDispatchMaxAttempts, err := getEnvInt("EMAIL_DISPATCH_MAX_ATTEMPTS", 5)
+	if err != nil {
+		return nil, err
+	}
+	uploadWorkers, err := getEnvInt("UPLOAD_PIPELINE_WORKERS", 2)
+	if err != nil {
+		return nil, err
+	}
+	environment := getEnv("ENVIRONMENT", "development")
+	ssoBaseURL := strings.TrimSuffix(getEnv("SSO_BASE_URL", "http://localhost:8080/api/v1"), "/")
 
 	return &Config{
 		Server: ServerConfig{
-			Port:         getEnv("PORT", "8080"),
-			Environment:  getEnv("ENVIRONMENT", "development"),
-			ReadTimeout:  15 * time.Second,
-			WriteTimeout: 15 * time.Second,
+			Port:           getEnv("PORT", "8080"),
+			Environment:    environment,
+			ReadTimeout:    15 * time.Second,
+			WriteTimeout:   15 * time.Second,
+			RequestTimeout: getEnvSeconds("REQUEST_TIMEOUT_SECONDS", 30),
+
+			AuthRequestTimeout:   getEnvSeconds("REQUEST_TIMEOUT_AUTH_SECONDS", 10),
+			UploadRequestTimeout: getEnvSeconds("REQUEST_TIMEOUT_UPLOAD_SECONDS", 120),
+			ExportRequestTimeout: getEnvSeconds("REQUEST_TIMEOUT_EXPORT_SECONDS", 300),
+
+			AllowedOrigins: splitList(getEnv("CORS_ALLOWED_ORIGINS", "http://localhost:3000,http://localhost:3001,https://yourdomain.com")),
 		},
 		Database: DatabaseConfig{
-			Host:     getEnv("DB_HOST", "localhost"),
-			Port:     getEnv("DB_PORT", "5432"),
-			User:     getEnv("DB_USER", "postgres"),
-			Password: getEnv("DB_PASSWORD", ""),
-			DBName:   getEnv("DB_NAME", "linkedin_clone"),
-			SSLMode:  getEnv("DB_SSLMODE", "disable"),
+			Host:                 getEnv("DB_HOST", "localhost"),
+			Port:                 getEnv("DB_PORT", "5432"),
+			User:                 getEnv("DB_USER", "postgres"),
+			Password:             getEnv("DB_PASSWORD", ""),
+			DBName:               getEnv("DB_NAME", "linkedin_clone"),
+			SSLMode:              getEnv("DB_SSLMODE", "disable"),
+			StatementTimeout:     getEnvSeconds("DB_STATEMENT_TIMEOUT_SECONDS", 10),
+			QueryTimeout:         getEnvSeconds("DB_QUERY_TIMEOUT_SECONDS", 15),
+			AutoMigrate:          getEnvBool("DB_AUTO_MIGRATE", environment != "production"),
+			MigrationsDir:        getEnv("DB_MIGRATIONS_DIR", "internal/infrastructure/database/migrations"),
+			MigrationLockTimeout: getEnvSeconds("DB_MIGRATION_LOCK_TIMEOUT_SECONDS", 300),
 		},
 		Redis: RedisConfig{
-			Host:     getEnv("REDIS_HOST", "localhost"),
-			Port:     getEnv("REDIS_PORT", "6379"),
-			Password: getEnv("REDIS_PASSWORD", ""),
-			DB:       redisDB,
+			Mode:             getEnv("REDIS_MODE", "standalone"),
+			Host:             getEnv("REDIS_HOST", "localhost"),
+			Port:             getEnv("REDIS_PORT", "6379"),
+			Addrs:            getEnvList("REDIS_ADDRS"),
+			MasterName:       getEnv("REDIS_MASTER_NAME", ""),
+			Password:         getEnv("REDIS_PASSWORD", ""),
+			SentinelPassword: getEnv("REDIS_SENTINEL_PASSWORD", ""),
+			DB:               redisDB,
+			PoolSize:         redisPoolSize,
+			MinIdleConns:     redisMinIdleConns,
+			DialTimeout:      getEnvMillis("REDIS_DIAL_TIMEOUT_MS", 5000),
+			ReadTimeout:      getEnvMillis("REDIS_READ_TIMEOUT_MS", 3000),
+			WriteTimeout:     getEnvMillis("REDIS_WRITE_TIMEOUT_MS", 3000),
+			OperationTimeout: getEnvMillis("REDIS_OPERATION_TIMEOUT_MS", 5000),
+			TLSEnabled:       getEnvBool("REDIS_TLS_ENABLED", false),
+			TLSSkipVerify:    getEnvBool("REDIS_TLS_SKIP_VERIFY", false),
 		},
 		JWT: JWTConfig{
 			SecretKey:   getEnv("JWT_SECRET", "your-secret-key"),
@@ -107,10 +637,211 @@ func Load() (*Config, error) {
 			IsProduction: isProduction,
 		},
 		SMTP: SMTPConfig{
-			Host:     getEnv("SMTP_HOST", "smtp.gmail.com"),
-			Port:     smtpPort,
-			Username: getEnv("SMTP_USERNAME", ""),
-			Password: getEnv("SMTP_PASSWORD", ""),
+			Host:               getEnv("SMTP_HOST", "smtp.gmail.com"),
+			Port:               smtpPort,
+			Username:           getEnv("SMTP_USERNAME", ""),
+			Password:           getEnv("SMTP_PASSWORD", ""),
+			MaxConcurrency:     smtpMaxConcurrency,
+			IdleTimeout:        getEnvSeconds("SMTP_IDLE_TIMEOUT_SECONDS", 30),
+			AcquireTimeout:     getEnvSeconds("SMTP_ACQUIRE_TIMEOUT_SECONDS", 30),
+			RateLimit:          smtpRateLimit,
+			ProviderRateLimits: getEnvList("SMTP_PROVIDER_RATE_LIMITS"),
+		},
+		Breaker: BreakerConfig{
+			MaxFailures: breakerMaxFailures,
+			OpenTimeout: getEnvSeconds("BREAKER_OPEN_TIMEOUT_SECONDS", 30),
+		},
+		Retry: RetryConfig{
+			MaxAttempts: retryMaxAttempts,
+			BaseDelay:   getEnvMillis("RETRY_BASE_DELAY_MS", 200),
+			MaxDelay:    getEnvMillis("RETRY_MAX_DELAY_MS", 2000),
+		},
+		Startup: StartupConfig{
+			ProbeTimeout:         getEnvSeconds("STARTUP_PROBE_TIMEOUT_SECONDS", 5),
+			RequiredDependencies: getEnvList("STARTUP_REQUIRED_DEPENDENCIES"),
+		},
+		Encryption: EncryptionConfig{
+			Keys:         getEnv("ENCRYPTION_KEYS", ""),
+			PrimaryKeyID: getEnv("ENCRYPTION_PRIMARY_KEY_ID", ""),
+			Pepper:       getEnv("TOKEN_PEPPER", ""),
+		},
+		Privacy: PrivacyConfig{
+			DeletionGraceDays: deletionGraceDays,
+		},
+		Feed: FeedConfig{
+			FanoutEnabled:    getEnvBool("FEED_FANOUT_ENABLED", false),
+			RankingMode:      getEnv("FEED_RANKING_MODE", "ranked"),
+			RankingWindow:    feedRankingWindow,
+			WeightRecency:    getEnvFloat("FEED_WEIGHT_RECENCY", 1.0),
+			WeightDegree:     getEnvFloat("FEED_WEIGHT_DEGREE", 0.5),
+			WeightEngagement: getEnvFloat("FEED_WEIGHT_ENGAGEMENT", 1.0),
+			WeightAffinity:   getEnvFloat("FEED_WEIGHT_AFFINITY", 0.5),
+			WeightFeedback:   getEnvFloat("FEED_WEIGHT_FEEDBACK", 1.0),
+			HalfLifeHours:    getEnvFloat("FEED_HALF_LIFE_HOURS", 24),
+		},
+		Signup: SignupConfig{
+			IPLimit:               signupIPLimit,
+			IPWindow:              getEnvSeconds("SIGNUP_IP_WINDOW_SECONDS", 3600),
+			DomainLimit:           signupDomainLimit,
+			DomainWindow:          getEnvSeconds("SIGNUP_DOMAIN_WINDOW_SECONDS", 3600),
+			DomainLimitExempt:     splitList(getEnv("SIGNUP_DOMAIN_LIMIT_EXEMPT", "gmail.com,googlemail.com,outlook.com,hotmail.com,live.com,yahoo.com,icloud.com,proton.me")),
+			BlockDisposable:       getEnvBool("SIGNUP_BLOCK_DISPOSABLE", true),
+			DisposableDomainsFile: getEnv("SIGNUP_DISPOSABLE_DOMAINS_FILE", ""),
+			AllowedDomains:        getEnvList("SIGNUP_ALLOWED_DOMAINS"),
+		},
+		Password: PasswordConfig{
+			MinLength:           passwordMinLength,
+			MaxLength:           passwordMaxLength,
+			MinCharacterClasses: passwordMinClasses,
+			BlockCommon:         getEnvBool("PASSWORD_BLOCK_COMMON", true),
+			CommonPasswordsFile: getEnv("PASSWORD_COMMON_LIST_FILE", ""),
+			BreachCheck:         getEnvBool("PASSWORD_BREACH_CHECK", false),
+			BreachAPIURL:        getEnv("PASSWORD_BREACH_API_URL", "https://api.pwnedpasswords.com/range/"),
+			BreachTimeout:       getEnvSeconds("PASSWORD_BREACH_TIMEOUT_SECONDS", 3),
+			BreachThreshold:     passwordBreachThreshold,
+		},
+		GeoIP: GeoIPConfig{
+			DatabaseFile: getEnv("GEOIP_DATABASE_FILE", ""),
+		},
+		Locale: LocaleConfig{
+			Default: getEnv("LOCALE_DEFAULT", "en"),
+			Dir:     getEnv("LOCALE_DIR", ""),
+		},
+		APIKey: APIKeyConfig{
+			MaxPerUser:       apiKeyMaxPerUser,
+			DefaultRateLimit: apiKeyDefaultRateLimit,
+			MaxRateLimit:     apiKeyMaxRateLimit,
+
+			BasicMonthlyQuota:     apiKeyBasicMonthlyQuota,
+			PremiumMonthlyQuota:   apiKeyPremiumMonthlyQuota,
+			RecruiterMonthlyQuota: apiKeyRecruiterMonthlyQuota,
+		},
+		Invite: InviteConfig{
+			Required:    getEnvBool("REGISTRATION_INVITE_ONLY", false),
+			Quota:       inviteQuota,
+			MaxUses:     inviteMaxUses,
+			DefaultDays: inviteDefaultDays,
+		},
+		Moderation: ModerationConfig{
+			StrikeLimit:      strikeLimit,
+			StrikeExpiryDays: strikeExpiryDays,
+		},
+		SMS: SMSConfig{
+			Driver:           getEnv("SMS_DRIVER", "log"),
+			TwilioAccountSID: getEnv("TWILIO_ACCOUNT_SID", ""),
+			TwilioAuthToken:  getEnv("TWILIO_AUTH_TOKEN", ""),
+			TwilioFrom:       getEnv("TWILIO_FROM", ""),
+			Timeout:          getEnvSeconds("SMS_TIMEOUT_SECONDS", 10),
+			CodeTTL:          getEnvSeconds("SMS_CODE_TTL_SECONDS", 600),
+			SendLimit:        smsSendLimit,
+		},
+		Email: EmailConfig{
+			SendDelay:   getEnvSeconds("EMAIL_SEND_DELAY_SECONDS", 60),
+			LinkBaseURL: strings.TrimSuffix(getEnv("EMAIL_LINK_BASE_URL", "http://localhost:8080/api/v1"), "/"),
+		},
+		Backup: BackupConfig{
+			Bucket:        getEnv("BACKUP_S3_BUCKET", getEnv("S3_BUCKET", "linkedin-clone-bucket")),
+			Prefix:        getEnv("BACKUP_PREFIX", "backups"),
+			RetentionDays: backupRetentionDays,
+			MinKeep:       backupMinKeep,
+		},
+		Retention: RetentionConfig{
+			Mode:             strings.ToLower(getEnv("RETENTION_MODE", "archive")),
+			BatchSize:        retentionBatchSize,
+			Interval:         time.Duration(retentionIntervalHours) * time.Hour,
+			NotificationDays: retentionNotificationDays,
+			AnalyticsDays:    retentionAnalyticsDays,
+		},
+		Export: ExportConfig{
+			Tables:  getEnvList("ANALYTICS_EXPORT_TABLES"),
+			Bucket:  getEnv("ANALYTICS_EXPORT_S3_BUCKET", getEnv("S3_BUCKET", "linkedin-clone-bucket")),
+			Prefix:  getEnv("ANALYTICS_EXPORT_PREFIX", "exports/interactions"),
+			HashKey: getEnv("ANALYTICS_EXPORT_HASH_KEY", getEnv("TOKEN_PEPPER", "")),
+		},
+		Media: MediaConfig{
+			TokenSecret: getEnv("MEDIA_TOKEN_SECRET", getEnv("JWT_SECRET", "your-secret-key")),
+			TokenTTL:    getEnvSeconds("MEDIA_TOKEN_TTL_SECONDS", 3600),
+			URLTTL:      getEnvSeconds("MEDIA_URL_TTL_SECONDS", 60),
+		},
+		SSO: SSOConfig{
+			BaseURL:             ssoBaseURL,
+			FrontendRedirectURL: getEnv("SSO_FRONTEND_REDIRECT_URL", "http://localhost:3000/sso/callback"),
+			LinkRedirectURL:     getEnv("SSO_LINK_REDIRECT_URL", "http://localhost:3000/sso/link"),
+			SPEntityID:          getEnv("SSO_SP_ENTITY_ID", ssoBaseURL+"/auth/sso/saml/metadata"),
+			StateTTL:            getEnvSeconds("SSO_STATE_TTL_SECONDS", 600),
+			LinkTTL:             getEnvMinutes("SSO_LINK_TTL_MINUTES", 30),
+			HTTPTimeout:         getEnvSeconds("SSO_HTTP_TIMEOUT_SECONDS", 10),
+			FreeMailDomains:     splitList(strings.ToLower(getEnv("SSO_FREE_MAIL_DOMAINS", defaultFreeMailDomains))),
+		},
+		TwoFactor: TwoFactorConfig{
+			Issuer:       getEnv("TWO_FACTOR_ISSUER", "LinkedIn Clone"),
+			ChallengeTTL: getEnvSeconds("TWO_FACTOR_CHALLENGE_TTL_SECONDS", 300),
+			PendingTTL:   getEnvSeconds("TWO_FACTOR_SETUP_TTL_SECONDS", 600),
+		},
+		MagicLink: MagicLinkConfig{
+			Secret:      getEnv("MAGIC_LINK_SECRET", getEnv("JWT_SECRET", "your-secret-key")),
+			TTL:         getEnvSeconds("MAGIC_LINK_TTL_SECONDS", 900),
+			RedirectURL: getEnv("MAGIC_LINK_URL", "http://localhost:3000/auth/magic-link"),
+			ResendAfter: getEnvSeconds("MAGIC_LINK_RESEND_SECONDS", 60),
+		},
+		Cluster: ClusterConfig{
+			InstanceID: getEnv("INSTANCE_ID", ""),
+			LeaseTTL:   getEnvSeconds("CLUSTER_LEASE_SECONDS", 30),
+		},
+		Upload: UploadConfig{
+			QueueSize: uploadQueueSize,
+			MaxBytes:  int64(uploadMaxBytes),
+		},
+		Capture: CaptureConfig{
+			Enabled:      getEnvBool("CAPTURE_ENABLED", false),
+			SampleRate:   getEnvFloat("CAPTURE_SAMPLE_RATE", 0.01),
+			MaxEntries:   captureMaxEntries,
+			TTL:          getEnvSeconds("CAPTURE_TTL_SECONDS", 259200),
+			MaxBodyBytes: int64(captureMaxBodyBytes),
+		},
+		Chaos: ChaosConfig{
+			Enabled: getEnvBool("CHAOS_ENABLED", false),
+			Rules:   getEnv("CHAOS_RULES", ""),
+		},
+		Background: BackgroundConfig{
+			SessionCleanupInterval:   getEnvMinutes("SESSION_CLEANUP_INTERVAL_MINUTES", 5),
+			UnreadReconciliationHour: unreadReconciliationHour,
+			PartitionMonthsAhead:     partitionMonthsAhead,
+			ViewRollupInterval:       getEnvMinutes("VIEW_ROLLUP_INTERVAL_MINUTES", 15),
+
+			CompanyAnalyticsInterval:     getEnvMinutes("COMPANY_ANALYTICS_ROLLUP_INTERVAL_MINUTES", 60),
+			CompanyAnalyticsLookbackDays: companyAnalyticsLookbackDays,
+
+			JobDeadlineInterval: getEnvMinutes("JOB_DEADLINE_INTERVAL_MINUTES", 10),
+			JobAlertInterval:    getEnvMinutes("JOB_ALERT_INTERVAL_MINUTES", 15),
+
+			AccountPurgeInterval:    getEnvMinutes("ACCOUNT_PURGE_INTERVAL_MINUTES", 60),
+			AccountPurgeBatchSize:   accountPurgeBatchSize,
+			AccountPurgeMaxAttempts: accountPurgeMaxAttempts,
+
+			LifeEventReminderInterval: getEnvMinutes("LIFE_EVENT_REMINDER_INTERVAL_MINUTES", 60),
+
+			ConnectionSuggestionRefresh: getEnvHours("CONNECTION_SUGGESTION_REFRESH_HOURS", 24),
+			ConnectionSuggestionPerUser: connectionSuggestionPerUser,
+
+			MentorshipCheckInInterval: getEnvMinutes("MENTORSHIP_CHECK_IN_INTERVAL_MINUTES", 60),
+			MentorshipMatchInterval:   getEnvHours("MENTORSHIP_MATCH_INTERVAL_HOURS", 24),
+
+			ExportInterval:     getEnvMinutes("ANALYTICS_EXPORT_INTERVAL_MINUTES", 60),
+			ExportLookbackDays: exportLookbackDays,
+
+			PresenceFlushInterval: getEnvMinutes("PRESENCE_FLUSH_INTERVAL_MINUTES", 1),
+
+			EmailDispatchInterval:    getEnvSeconds("EMAIL_DISPATCH_INTERVAL_SECONDS", 15),
+			EmailDispatchBatchSize:   emailDispatchBatchSize,
+			EmailDispatchMaxAttempts: emailDispatchMaxAttempts,
+
+			HeartbeatInterval:    getEnvSeconds("CLUSTER_HEARTBEAT_INTERVAL_SECONDS", 10),
+			RouteRefreshInterval: getEnvSeconds("REALTIME_ROUTE_REFRESH_SECONDS", 60),
+
+			UploadWorkers:     uploadWorkers,
+			UploadStaleAfter:  getEnvMinutes("UPLOAD_STALE_MINUTES", 15),
+			UploadTaskTimeout: getEnvMinutes("UPLOAD_TASK_TIMEOUT_MINUTES", 10),
 		},
 	}, nil
 }
@@ -121,3 +852,76 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+func getEnvInt(key string, defaultValue int) (int, error) {
+	value, err := strconv.Atoi(getEnv(key, strconv.Itoa(defaultValue)))
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return value, nil
+}
+
+func getEnvBool(key string, defaultValue bool) bool {
+	value, err := strconv.ParseBool(getEnv(key, strconv.FormatBool(defaultValue)))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+func getEnvFloat(key string, defaultValue float64) float64 {
+	value, err := strconv.ParseFloat(getEnv(key, strconv.FormatFloat(defaultValue, 'f', -1, 64)), 64)
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+func getEnvSeconds(key string, defaultValue int) time.Duration {
+	seconds, err := strconv.Atoi(getEnv(key, strconv.Itoa(defaultValue)))
+	if err != nil {
+		seconds = defaultValue
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func getEnvMinutes(key string, defaultValue int) time.Duration {
+	minutes, err := strconv.Atoi(getEnv(key, strconv.Itoa(defaultValue)))
+	if err != nil {
+		minutes = defaultValue
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+func getEnvHours(key string, defaultValue int) time.Duration {
+	hours, err := strconv.Atoi(getEnv(key, strconv.Itoa(defaultValue)))
+	if err != nil {
+		hours = defaultValue
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+func getEnvMillis(key string, defaultValue int) time.Duration {
+	millis, err := strconv.Atoi(getEnv(key, strconv.Itoa(defaultValue)))
+	if err != nil {
+		millis = defaultValue
+	}
+	return time.Duration(millis) * time.Millisecond
+}
+
+func getEnvList(key string) []string {
+	return splitList(os.Getenv(key))
+}
+
+// defaultFreeMailDomains are webmail providers anyone can sign up with.
+const defaultFreeMailDomains = "gmail.com,googlemail.com,outlook.com,hotmail.com,live.com,msn.com,yahoo.com,ymail.com,aol.com,icloud.com,me.com,mac.com,proton.me,protonmail.com,gmx.com,gmx.net,mail.com,zoho.com,yandex.com,yandex.ru,qq.com,163.com,126.com,fastmail.com,tutanota.com,hey.com"
+
+func splitList(raw string) []string {
+	var values []string
+	for _, value := range strings.Split(raw, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
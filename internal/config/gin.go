@@ -3,13 +3,17 @@ package config
 import (
 	"linked-clone/internal/background"
 	"linked-clone/internal/middleware"
+	"linked-clone/pkg/breaker"
+	"linked-clone/pkg/featureflag"
+	"linked-clone/pkg/httpmetrics"
 	"linked-clone/pkg/logger"
+	email "linked-clone/pkg/smtp"
 	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
-func NewGinEngine(cfg *Config, logger logger.StructuredLogger) *gin.Engine {
+func NewGinEngine(cfg *Config, logger logger.StructuredLogger, backgroundRegistry *background.Registry, httpMetrics *httpmetrics.Registry, routeTimeouts *middleware.RouteTimeouts, featureFlags *featureflag.Flags) *gin.Engine {
 
 	if cfg.Server.Environment == "production" {
 		gin.SetMode(gin.ReleaseMode)
@@ -34,7 +38,7 @@ func NewGinEngine(cfg *Config, logger logger.StructuredLogger) *gin.Engine {
 	r.Use(middleware.InputValidation(logger))
 	r.Use(middleware.SecurityMonitoring(logger))
 
-	r.Use(middleware.CORSMiddleware())
+	r.Use(middleware.CORSMiddleware(cfg.Server.AllowedOrigins))
 
 	r.Use(middleware.LoggerMiddleware(logger))
 
@@ -46,7 +50,7 @@ func NewGinEngine(cfg *Config, logger logger.StructuredLogger) *gin.Engine {
 
 	r.Use(middleware.PerformanceMiddleware(logger))
 
-	r.Use(middleware.TimeoutMiddleware(30*time.Second, logger))
+	r.Use(middleware.TimeoutMiddleware(routeTimeouts, logger))
 
 	fileUploadConfig := middleware.FileUploadMiddleware(
 		10<<20,
@@ -61,13 +65,11 @@ func NewGinEngine(cfg *Config, logger logger.StructuredLogger) *gin.Engine {
 	r.GET("/health", func(c *gin.Context) {
 
 		cleanupStatus := "unknown"
-		if sessionCleanupService, exists := c.Get("session_cleanup_service"); exists {
-			if service, ok := sessionCleanupService.(*background.SessionCleanupService); ok {
-				if service.IsRunning() {
-					cleanupStatus = "running"
-				} else {
-					cleanupStatus = "stopped"
-				}
+		if service, exists := backgroundRegistry.Get("session_cleanup"); exists {
+			if service.IsRunning() {
+				cleanupStatus = "running"
+			} else {
+				cleanupStatus = "stopped"
 			}
 		}
 
@@ -86,85 +88,58 @@ func NewGinEngine(cfg *Config, logger logger.StructuredLogger) *gin.Engine {
 
 	r.GET("/ready", func(c *gin.Context) {
 
-		checks := gin.H{
-			"database":        "ok",
-			"redis":           "ok",
-			"storage":         "ok",
-			"session_cleanup": "ok",
+		status := "ready"
+		if featureFlags.Degraded() {
+			status = "degraded"
 		}
 
-		allHealthy := true
-		for _, status := range checks {
-			if status != "ok" {
-				allHealthy = false
-				break
-			}
-		}
-
-		statusCode := 200
-		if !allHealthy {
-			statusCode = 503
-		}
-
-		c.JSON(statusCode, gin.H{
-			"status": map[string]string{"ready": "ok", "degraded": "not_ready"}[func() string {
-				if allHealthy {
-					return "ready"
-				} else {
-					return "degraded"
-				}
-			}()],
+		c.JSON(200, gin.H{
+			"status":    status,
 			"timestamp": time.Now().UTC().Format(time.RFC3339),
-			"checks":    checks,
+			"checks": gin.H{
+				"database": "ok",
+				"redis":    featureStatus(featureFlags, featureflag.FeatureCache),
+				"storage":  featureStatus(featureFlags, featureflag.FeatureUploads),
+				"smtp":     featureStatus(featureFlags, featureflag.FeatureEmail),
+			},
+			"features": featureFlags.Snapshot(),
 		})
 	})
 
 	r.GET("/background", func(c *gin.Context) {
 
-		backgroundInfo := gin.H{
-			"session_cleanup": gin.H{
-				"status":           "running",
-				"last_run":         "2025-07-02T01:30:00Z",
-				"next_run":         "2025-07-02T01:35:00Z",
-				"cleanup_interval": "5m",
-				"total_runs":       42,
-				"failed_runs":      0,
-			},
-		}
-
 		c.JSON(200, gin.H{
-			"background_tasks": backgroundInfo,
+			"background_tasks": backgroundRegistry.Snapshot(),
 			"timestamp":        time.Now().UTC().Format(time.RFC3339),
 		})
 	})
 
-	r.GET("/ready", func(c *gin.Context) {
+	r.GET("/metrics", func(c *gin.Context) {
 
 		c.JSON(200, gin.H{
-			"status":    "ready",
-			"timestamp": time.Now().UTC().Format(time.RFC3339),
-			"checks": gin.H{
-				"database": "ok",
-				"redis":    "ok",
-				"storage":  "ok",
-			},
+			"service":          "linkedin-clone",
+			"version":          "1.0.0",
+			"environment":      cfg.Server.Environment,
+			"timestamp":        time.Now().UTC().Format(time.RFC3339),
+			"metrics":          httpMetrics.Summary(),
+			"circuit_breakers": breaker.Snapshot(),
+			"smtp_pools":       email.Snapshot(),
 		})
 	})
 
-	r.GET("/metrics", func(c *gin.Context) {
+	r.GET("/metrics/prometheus", func(c *gin.Context) {
 
-		c.JSON(200, gin.H{
-			"service":     "linkedin-clone",
-			"version":     "1.0.0",
-			"environment": cfg.Server.Environment,
-			"timestamp":   time.Now().UTC().Format(time.RFC3339),
-			"metrics": gin.H{
-				"requests_total":      0,
-				"requests_per_second": 0,
-				"error_rate":          0,
-				"avg_response_time":   0,
-			},
-		})
+		c.Header("Content-Type", background.OpenMetricsContentType)
+		c.Status(200)
+		if err := httpMetrics.WriteOpenMetrics(c.Writer); err != nil {
+			logger.Error("Failed to write OpenMetrics", "error", err)
+			return
+		}
+		if err := backgroundRegistry.WriteOpenMetrics(c.Writer); err != nil {
+			logger.Error("Failed to write OpenMetrics", "error", err)
+			return
+		}
+		c.Writer.WriteString("# EOF\n")
 	})
 
 	r.GET("/", func(c *gin.Context) {
@@ -174,11 +149,12 @@ func NewGinEngine(cfg *Config, logger logger.StructuredLogger) *gin.Engine {
 			"environment": cfg.Server.Environment,
 			"description": "A LinkedIn clone API built with Go and Gin",
 			"endpoints": gin.H{
-				"health":  "/health",
-				"ready":   "/ready",
-				"metrics": "/metrics",
-				"api":     "/api/v1",
-				"docs":    "/api/v1/docs",
+				"health":     "/health",
+				"ready":      "/ready",
+				"metrics":    "/metrics",
+				"prometheus": "/metrics/prometheus",
+				"api":        "/api/v1",
+				"docs":       "/api/v1/docs",
 			},
 			"support": gin.H{
 				"email": "support@linkedin-clone.com",
@@ -190,6 +166,13 @@ func NewGinEngine(cfg *Config, logger logger.StructuredLogger) *gin.Engine {
 	return r
 }
 
+func featureStatus(flags *featureflag.Flags, feature featureflag.Feature) string {
+	if flags.Enabled(feature) {
+		return "ok"
+	}
+	return "degraded"
+}
+
 func ApplyFileUploadMiddleware(c *gin.Context) {
 	if middleware, exists := c.Get("fileUploadMiddleware"); exists {
 		if mw, ok := middleware.(gin.HandlerFunc); ok {
@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+)
+
+func AccountRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+	adminMiddleware := middleware.AdminMiddleware(deps.UserRepository, deps.Logger)
+
+	account := rg.Group("/account", authMiddleware)
+	{
+		account.POST("/deletion", deps.AccountHandler.RequestDeletion)
+	}
+
+	admin := rg.Group("/admin/account-deletions", authMiddleware, adminMiddleware)
+	{
+		admin.GET("", deps.AccountHandler.GetDeletions)
+		admin.GET("/:id", deps.AccountHandler.GetDeletion)
+		admin.POST("/:id/cancel", deps.AccountHandler.CancelDeletion)
+		admin.POST("/:id/verify", deps.AccountHandler.VerifyDeletion)
+	}
+}
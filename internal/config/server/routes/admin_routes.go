@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+)
+
+func AdminRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+	adminMiddleware := middleware.AdminMiddleware(deps.UserRepository, deps.Logger)
+
+	users := rg.Group("/admin/users", authMiddleware, adminMiddleware)
+	{
+		users.GET("", deps.AdminHandler.GetUsers)
+		users.GET("/:id", deps.AdminHandler.GetUser)
+		users.GET("/:id/audit", deps.AdminHandler.GetUserAuditLog)
+		users.POST("/:id/suspend", deps.AdminHandler.SuspendUser)
+		users.POST("/:id/unsuspend", deps.AdminHandler.UnsuspendUser)
+		users.POST("/:id/force-password-reset", deps.AdminHandler.ForcePasswordReset)
+	}
+
+	rg.GET("/admin/audit-log", authMiddleware, adminMiddleware, deps.AdminHandler.GetAuditLog)
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+)
+
+func AnalyticsRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+
+	analytics := rg.Group("/analytics", authMiddleware)
+	{
+		analytics.GET("/posts/:id/views", deps.AnalyticsHandler.GetPostViews)
+		analytics.GET("/profile/views", deps.AnalyticsHandler.GetProfileViews)
+		analytics.GET("/jobs/:id/views", deps.AnalyticsHandler.GetJobViews)
+	}
+}
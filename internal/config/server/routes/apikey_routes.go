@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+	"time"
+)
+
+func APIKeyRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+
+	keys := rg.Group("/api-keys", authMiddleware)
+	{
+		keys.GET("", deps.APIKeyHandler.GetKeys)
+		keys.POST("",
+			middleware.RateLimitMiddleware(time.Minute, 10, deps.Logger),
+			deps.APIKeyHandler.CreateKey)
+		keys.DELETE("/:id", deps.APIKeyHandler.RevokeKey)
+	}
+}
@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+)
+
+func AssessmentRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+	adminMiddleware := middleware.AdminMiddleware(deps.UserRepository, deps.Logger)
+
+	assessments := rg.Group("/assessments", authMiddleware)
+	{
+		assessments.GET("", deps.AssessmentHandler.ListAssessments)
+		assessments.GET("/:id", deps.AssessmentHandler.GetAssessment)
+		assessments.POST("/:id/start", deps.AssessmentHandler.StartAttempt)
+		assessments.POST("/attempts/:attemptId/submit", deps.AssessmentHandler.SubmitAttempt)
+	}
+
+	admin := rg.Group("/admin/assessments", authMiddleware, adminMiddleware)
+	{
+		admin.POST("", deps.AssessmentHandler.CreateAssessment)
+		admin.GET("", deps.AssessmentHandler.ListAllAssessments)
+		admin.GET("/:id", deps.AssessmentHandler.GetAssessmentDetail)
+		admin.PUT("/:id", deps.AssessmentHandler.UpdateAssessment)
+		admin.POST("/:id/questions", deps.AssessmentHandler.AddQuestion)
+		admin.PUT("/:id/questions/:questionId", deps.AssessmentHandler.UpdateQuestion)
+		admin.DELETE("/:id/questions/:questionId", deps.AssessmentHandler.DeleteQuestion)
+	}
+}
@@ -3,6 +3,7 @@ package routes
 import (
 	"github.com/gin-gonic/gin"
 	"linked-clone/internal/middleware"
+	"linked-clone/pkg/featureflag"
 	"os"
 	"time"
 )
@@ -13,6 +14,7 @@ func AuthRoutes(rg *gin.RouterGroup, deps *Dependencies) {
 	_ = middleware.CSRFProtection(os.Getenv("CSRF_SECRET"), deps.Logger)
 
 	auth := rg.Group("/auth")
+	groupTimeout(deps, auth, deps.Server.AuthRequestTimeout)
 	{
 
 		auth.POST("/register",
@@ -24,19 +26,39 @@ func AuthRoutes(rg *gin.RouterGroup, deps *Dependencies) {
 			deps.AuthHandler.Login)
 
 		auth.POST("/forgot-password",
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureEmail, deps.Logger),
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
 			middleware.RateLimitMiddleware(time.Minute, 3, deps.Logger),
 			deps.AuthHandler.ForgotPassword)
 
 		auth.POST("/reset-password",
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
 			middleware.RateLimitMiddleware(time.Minute, 5, deps.Logger),
 			deps.AuthHandler.ResetPassword)
 
+		auth.POST("/magic-link",
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureEmail, deps.Logger),
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
+			middleware.RateLimitMiddleware(time.Minute, 3, deps.Logger),
+			deps.AuthHandler.RequestMagicLink)
+
+		auth.GET("/magic-link/verify",
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
+			middleware.RateLimitMiddleware(time.Minute, 10, deps.Logger),
+			deps.AuthHandler.VerifyMagicLink)
+
+		auth.POST("/2fa/verify",
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
+			middleware.RateLimitMiddleware(time.Minute, 10, deps.Logger),
+			deps.AuthHandler.VerifyTwoFactor)
+
 		auth.POST("/refresh",
 			middleware.RateLimitMiddleware(time.Minute, 20, deps.Logger),
 			deps.AuthHandler.RefreshToken)
 
 		auth.POST("/verify-email",
 			authMiddleware,
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
 			middleware.RateLimitMiddleware(time.Minute, 10, deps.Logger),
 			deps.AuthHandler.VerifyEmail)
 
@@ -45,11 +67,21 @@ func AuthRoutes(rg *gin.RouterGroup, deps *Dependencies) {
 			middleware.RateLimitMiddleware(time.Minute, 10, deps.Logger),
 			deps.AuthHandler.Logout)
 
+		auth.POST("/change-password",
+			authMiddleware,
+			middleware.RateLimitMiddleware(time.Minute, 5, deps.Logger),
+			deps.AuthHandler.ChangePassword)
+
 		auth.GET("/sessions",
 			authMiddleware,
 			middleware.RateLimitMiddleware(time.Minute, 30, deps.Logger),
 			deps.AuthHandler.GetActiveSessions)
 
+		auth.DELETE("/sessions/others",
+			authMiddleware,
+			middleware.RateLimitMiddleware(time.Minute, 5, deps.Logger),
+			deps.AuthHandler.RevokeOtherSessions)
+
 		auth.DELETE("/sessions/:sessionId",
 			authMiddleware,
 			middleware.RateLimitMiddleware(time.Minute, 20, deps.Logger),
@@ -60,4 +92,104 @@ func AuthRoutes(rg *gin.RouterGroup, deps *Dependencies) {
 			middleware.RateLimitMiddleware(time.Minute, 5, deps.Logger),
 			deps.AuthHandler.RevokeAllSessions)
 	}
+
+	twoFactor := rg.Group("/auth/2fa")
+	{
+		twoFactor.GET("",
+			authMiddleware,
+			deps.TwoFactorHandler.GetStatus)
+
+		twoFactor.POST("/setup",
+			authMiddleware,
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
+			middleware.RateLimitMiddleware(time.Minute, 5, deps.Logger),
+			deps.TwoFactorHandler.Setup)
+
+		twoFactor.POST("/enable",
+			authMiddleware,
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
+			middleware.RateLimitMiddleware(time.Minute, 5, deps.Logger),
+			deps.TwoFactorHandler.Enable)
+
+		twoFactor.POST("/disable",
+			authMiddleware,
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
+			middleware.RateLimitMiddleware(time.Minute, 3, deps.Logger),
+			deps.TwoFactorHandler.Disable)
+
+		twoFactor.POST("/sms",
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
+			middleware.RateLimitMiddleware(time.Minute, 3, deps.Logger),
+			deps.TwoFactorHandler.SendSMSCode)
+	}
+
+	recovery := rg.Group("/auth/recovery")
+	{
+		recovery.GET("",
+			authMiddleware,
+			deps.RecoveryHandler.GetStatus)
+
+		recovery.PUT("/email",
+			authMiddleware,
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureEmail, deps.Logger),
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
+			middleware.RateLimitMiddleware(time.Minute, 3, deps.Logger),
+			deps.RecoveryHandler.SetRecoveryEmail)
+
+		recovery.POST("/email/verify",
+			authMiddleware,
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
+			middleware.RateLimitMiddleware(time.Minute, 5, deps.Logger),
+			deps.RecoveryHandler.VerifyRecoveryEmail)
+
+		recovery.DELETE("/email",
+			authMiddleware,
+			middleware.RateLimitMiddleware(time.Minute, 5, deps.Logger),
+			deps.RecoveryHandler.RemoveRecoveryEmail)
+
+		recovery.POST("/codes",
+			authMiddleware,
+			middleware.RateLimitMiddleware(time.Minute, 3, deps.Logger),
+			deps.RecoveryHandler.GenerateRecoveryCodes)
+
+		recovery.POST("/start",
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureEmail, deps.Logger),
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
+			middleware.RateLimitMiddleware(time.Minute, 3, deps.Logger),
+			deps.RecoveryHandler.StartEmailRecovery)
+
+		recovery.POST("/sms",
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
+			middleware.RateLimitMiddleware(time.Minute, 3, deps.Logger),
+			deps.RecoveryHandler.StartSMSRecovery)
+
+		recovery.POST("/code",
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
+			middleware.RateLimitMiddleware(time.Minute, 3, deps.Logger),
+			deps.RecoveryHandler.RecoverWithCode)
+	}
+
+	phone := rg.Group("/auth/phone")
+	{
+		phone.GET("",
+			authMiddleware,
+			deps.PhoneHandler.GetPhone)
+
+		phone.PUT("",
+			authMiddleware,
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
+			middleware.RateLimitMiddleware(time.Minute, 3, deps.Logger),
+			deps.PhoneHandler.SetPhone)
+
+		phone.POST("/verify",
+			authMiddleware,
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
+			middleware.RateLimitMiddleware(time.Minute, 5, deps.Logger),
+			deps.PhoneHandler.VerifyPhone)
+
+		phone.DELETE("",
+			authMiddleware,
+			middleware.RateLimitMiddleware(time.Minute, 5, deps.Logger),
+			deps.PhoneHandler.RemovePhone)
+	}
 }
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+)
+
+func BookmarkRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+
+	bookmarks := rg.Group("/bookmarks", authMiddleware)
+	{
+		bookmarks.GET("/jobs", deps.BookmarkHandler.GetSavedJobs)
+		bookmarks.PUT("/jobs/:jobId", deps.BookmarkHandler.SaveJob)
+		bookmarks.DELETE("/jobs/:jobId", deps.BookmarkHandler.UnsaveJob)
+
+		bookmarks.GET("/posts", deps.BookmarkHandler.GetSavedPosts)
+		bookmarks.PUT("/posts/:postId", deps.BookmarkHandler.SavePost)
+		bookmarks.DELETE("/posts/:postId", deps.BookmarkHandler.UnsavePost)
+	}
+}
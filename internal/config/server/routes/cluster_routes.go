@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+)
+
+func ClusterRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+	adminMiddleware := middleware.AdminMiddleware(deps.UserRepository, deps.Logger)
+
+	admin := rg.Group("/admin/cluster", authMiddleware, adminMiddleware)
+	{
+		admin.GET("/instances", deps.ClusterHandler.GetInstances)
+	}
+}
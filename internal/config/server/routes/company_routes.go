@@ -0,0 +1,67 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/featureflag"
+)
+
+func CompanyRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+	adminMiddleware := middleware.AdminMiddleware(deps.UserRepository, deps.Logger)
+
+	companies := rg.Group("/companies")
+	{
+		companies.GET("/:id", deps.CompanyHandler.GetCompany)
+
+		companies.POST("", authMiddleware, deps.CompanyHandler.CreateCompany)
+		companies.GET("/my", authMiddleware, deps.CompanyHandler.GetMyCompanies)
+
+		companies.POST("/:id/follow", authMiddleware, deps.CompanyHandler.FollowCompany)
+		companies.DELETE("/:id/follow", authMiddleware, deps.CompanyHandler.UnfollowCompany)
+
+		companies.GET("/:id/analytics/followers", authMiddleware, deps.CompanyAnalyticsHandler.GetFollowerStats)
+		companies.GET("/:id/analytics/jobs", authMiddleware, deps.CompanyAnalyticsHandler.GetJobPerformance)
+		companies.GET("/:id/analytics/advocacy", authMiddleware, deps.CompanyAnalyticsHandler.GetEmployeeAdvocacy)
+		companies.GET("/:id/analytics/funnel", authMiddleware, deps.CompanyAnalyticsHandler.GetApplicationFunnel)
+
+		companies.GET("/:id/members", authMiddleware, deps.CompanyHandler.GetMembers)
+		companies.POST("/:id/members", authMiddleware, deps.CompanyHandler.AddMember)
+		companies.DELETE("/:id/members/:userId", authMiddleware, deps.CompanyHandler.RemoveMember)
+
+		companies.GET("/:id/teams", deps.TeamHandler.GetTeams)
+		companies.POST("/:id/teams", authMiddleware, deps.TeamHandler.CreateTeam)
+		companies.GET("/:id/teams/:teamId", authMiddleware, deps.TeamHandler.GetTeamPage)
+		companies.PUT("/:id/teams/:teamId", authMiddleware, deps.TeamHandler.UpdateTeam)
+		companies.DELETE("/:id/teams/:teamId", authMiddleware, deps.TeamHandler.DeleteTeam)
+		companies.POST("/:id/teams/:teamId/members", authMiddleware, deps.TeamHandler.AddTeamMember)
+		companies.DELETE("/:id/teams/:teamId/members/:userId", authMiddleware, deps.TeamHandler.RemoveTeamMember)
+
+		companies.GET("/:id/verifications", authMiddleware, deps.CompanyHandler.GetVerifications)
+		companies.POST("/:id/verifications/email",
+			authMiddleware,
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureEmail, deps.Logger),
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
+			deps.CompanyHandler.SubmitBusinessEmail,
+		)
+		companies.POST("/verifications/:verificationId/confirm",
+			authMiddleware,
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
+			deps.CompanyHandler.ConfirmBusinessEmail,
+		)
+		routeTimeout(deps, companies, "POST", "/:id/verifications/document", deps.Server.UploadRequestTimeout)
+		companies.POST("/:id/verifications/document",
+			authMiddleware,
+			middleware.FileUploadMiddleware(10<<20, []string{".pdf", ".jpg", ".jpeg", ".png"}),
+			middleware.UploadFeatureMiddleware(deps.FeatureFlags, deps.Logger),
+			deps.CompanyHandler.SubmitDocument,
+		)
+	}
+
+	admin := rg.Group("/admin/company-verifications", authMiddleware, adminMiddleware)
+	{
+		admin.GET("", deps.CompanyHandler.GetReviewQueue)
+		admin.POST("/:id/approve", deps.CompanyHandler.ApproveVerification)
+		admin.POST("/:id/reject", deps.CompanyHandler.RejectVerification)
+	}
+}
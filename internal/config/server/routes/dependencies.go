@@ -1,15 +1,44 @@
 package routes
 
 import (
+	"fmt"
+	"linked-clone/internal/background"
 	"linked-clone/internal/config"
+	"linked-clone/pkg/affinity"
 	"linked-clone/pkg/auth"
+	"linked-clone/pkg/backup"
+	"linked-clone/pkg/breaker"
+	"linked-clone/pkg/capture"
+	"linked-clone/pkg/chaos"
+	"linked-clone/pkg/cluster"
+	"linked-clone/pkg/counter"
+	"linked-clone/pkg/dataexport"
+	"linked-clone/pkg/eventbus"
+	"linked-clone/pkg/featureflag"
+	"linked-clone/pkg/feed"
+	"linked-clone/pkg/geoip"
+	"linked-clone/pkg/graph"
+	"linked-clone/pkg/httpmetrics"
+	"linked-clone/pkg/i18n"
+	"linked-clone/pkg/identity"
+	"linked-clone/pkg/imaging"
 	"linked-clone/pkg/logger"
+	"linked-clone/pkg/media"
+	"linked-clone/pkg/moderation"
+	"linked-clone/pkg/password"
+	"linked-clone/pkg/presence"
+	"linked-clone/pkg/realtime"
 	"linked-clone/pkg/redis"
+	"linked-clone/pkg/retry"
+	"linked-clone/pkg/scanner"
+	"linked-clone/pkg/signup"
+	"linked-clone/pkg/sms"
 	email "linked-clone/pkg/smtp"
 	"linked-clone/pkg/storage"
 	validation "linked-clone/pkg/validator"
 
 	"linked-clone/internal/domain/repositories"
+	"linked-clone/internal/middleware"
 
 	authHandler "linked-clone/internal/api/auth/handler"
 	authRepo "linked-clone/internal/api/auth/repository"
@@ -23,78 +52,610 @@ import (
 	postRepo "linked-clone/internal/api/post/repository"
 	postService "linked-clone/internal/api/post/service"
 
+	companyHandler "linked-clone/internal/api/company/handler"
+	companyRepo "linked-clone/internal/api/company/repository"
+	companyService "linked-clone/internal/api/company/service"
+
+	identityHandler "linked-clone/internal/api/identity/handler"
+	identityRepo "linked-clone/internal/api/identity/repository"
+	identityService "linked-clone/internal/api/identity/service"
+
 	jobHandler "linked-clone/internal/api/job/handler"
 	jobRepo "linked-clone/internal/api/job/repository"
 	jobService "linked-clone/internal/api/job/service"
 
+	notificationHandler "linked-clone/internal/api/notification/handler"
+	notificationRepo "linked-clone/internal/api/notification/repository"
+	notificationService "linked-clone/internal/api/notification/service"
+
+	messageHandler "linked-clone/internal/api/message/handler"
+	messageRepo "linked-clone/internal/api/message/repository"
+	messageService "linked-clone/internal/api/message/service"
+
+	realtimeHandler "linked-clone/internal/api/realtime/handler"
+
+	analyticsHandler "linked-clone/internal/api/analytics/handler"
+	analyticsRepo "linked-clone/internal/api/analytics/repository"
+	analyticsService "linked-clone/internal/api/analytics/service"
+
+	policyHandler "linked-clone/internal/api/policy/handler"
+	policyRepo "linked-clone/internal/api/policy/repository"
+	policyService "linked-clone/internal/api/policy/service"
+
+	accountHandler "linked-clone/internal/api/account/handler"
+	accountRepo "linked-clone/internal/api/account/repository"
+	accountService "linked-clone/internal/api/account/service"
+
+	emailHandler "linked-clone/internal/api/email/handler"
+	emailRepo "linked-clone/internal/api/email/repository"
+	emailSvc "linked-clone/internal/api/email/service"
+
+	clusterHandler "linked-clone/internal/api/cluster/handler"
+	clusterService "linked-clone/internal/api/cluster/service"
+
+	experimentHandler "linked-clone/internal/api/experiment/handler"
+	experimentRepo "linked-clone/internal/api/experiment/repository"
+	experimentService "linked-clone/internal/api/experiment/service"
+
+	assessmentHandler "linked-clone/internal/api/assessment/handler"
+	assessmentRepo "linked-clone/internal/api/assessment/repository"
+	assessmentService "linked-clone/internal/api/assessment/service"
+
+	learningHandler "linked-clone/internal/api/learning/handler"
+	learningRepo "linked-clone/internal/api/learning/repository"
+	learningService "linked-clone/internal/api/learning/service"
+	localeHandler "linked-clone/internal/api/locale/handler"
+
+	adminHandler "linked-clone/internal/api/admin/handler"
+	adminRepo "linked-clone/internal/api/admin/repository"
+	adminService "linked-clone/internal/api/admin/service"
+	apiKeyHandler "linked-clone/internal/api/apikey/handler"
+	apiKeyRepo "linked-clone/internal/api/apikey/repository"
+	apiKeyService "linked-clone/internal/api/apikey/service"
+	bookmarkHandler "linked-clone/internal/api/bookmark/handler"
+	bookmarkRepo "linked-clone/internal/api/bookmark/repository"
+	bookmarkService "linked-clone/internal/api/bookmark/service"
+	inviteHandler "linked-clone/internal/api/invite/handler"
+	inviteRepo "linked-clone/internal/api/invite/repository"
+	inviteService "linked-clone/internal/api/invite/service"
+	mentorshipHandler "linked-clone/internal/api/mentorship/handler"
+	mentorshipRepo "linked-clone/internal/api/mentorship/repository"
+	mentorshipService "linked-clone/internal/api/mentorship/service"
+	moderationHandler "linked-clone/internal/api/moderation/handler"
+	moderationRepo "linked-clone/internal/api/moderation/repository"
+	moderationService "linked-clone/internal/api/moderation/service"
+	recommendationHandler "linked-clone/internal/api/recommendation/handler"
+	recommendationRepo "linked-clone/internal/api/recommendation/repository"
+	recommendationService "linked-clone/internal/api/recommendation/service"
+	taxonomyHandler "linked-clone/internal/api/taxonomy/handler"
+	taxonomyRepo "linked-clone/internal/api/taxonomy/repository"
+	taxonomyService "linked-clone/internal/api/taxonomy/service"
+
+	mediaHandler "linked-clone/internal/api/media/handler"
+	mediaService "linked-clone/internal/api/media/service"
+
+	ssoHandler "linked-clone/internal/api/sso/handler"
+	ssoRepo "linked-clone/internal/api/sso/repository"
+	ssoService "linked-clone/internal/api/sso/service"
+
+	searchHandler "linked-clone/internal/api/search/handler"
+	searchRepo "linked-clone/internal/api/search/repository"
+	searchService "linked-clone/internal/api/search/service"
+	fulltext "linked-clone/internal/infrastructure/search"
+
+	uploadHandler "linked-clone/internal/api/upload/handler"
+	uploadRepo "linked-clone/internal/api/upload/repository"
+	uploadService "linked-clone/internal/api/upload/service"
+
 	"gorm.io/gorm"
 )
 
 type Dependencies struct {
-	JWTService     auth.JWTService
-	StorageService storage.StorageService
-	RedisClient    redis.RedisClient
-	EmailService   email.EmailService
-	Validator      validation.Validator
-	Logger         logger.StructuredLogger
-
-	UserRepository       repositories.UserRepository
-	ConnectionRepository repositories.ConnectionRepository
-	SessionRepository    repositories.SessionRepository
-
-	AuthHandler       *authHandler.AuthHandler
-	UserHandler       *userHandler.UserHandler
-	ConnectionHandler *userHandler.ConnectionHandler
-	PostHandler       *postHandler.PostHandler
-	JobHandler        *jobHandler.JobHandler
+	JWTService      auth.JWTService
+	StorageService  storage.StorageService
+	RedisClient     redis.RedisClient
+	EmailService    email.EmailService
+	Validator       validation.Validator
+	FeatureFlags    *featureflag.Flags
+	UnreadCounter   counter.UnreadCounter
+	ViewCounter     counter.ViewCounter
+	RealtimeHub     realtime.Hub
+	RealtimeRelay   realtime.Relay
+	PresenceTracker presence.Tracker
+	RequestCapture  *capture.Recorder
+	Chaos           *chaos.Injector
+	HTTPMetrics     *httpmetrics.Registry
+	Locales         *i18n.Bundle
+	RouteTimeouts   *middleware.RouteTimeouts
+	Server          config.ServerConfig
+	Coordinator     cluster.Coordinator
+	ExportStore     backup.Store
+	ExportConfig    *dataexport.Config
+	Logger          logger.StructuredLogger
+
+	UserRepository                 repositories.UserRepository
+	JobRepository                  repositories.JobRepository
+	ConnectionRepository           repositories.ConnectionRepository
+	ConnectionSuggestionRepository repositories.ConnectionSuggestionRepository
+	SessionRepository              repositories.SessionRepository
+	NotificationRepository         repositories.NotificationRepository
+	MessageRepository              repositories.MessageRepository
+	AnalyticsRepository            repositories.AnalyticsRepository
+	PolicyRepository               repositories.PolicyRepository
+	AccountDeletionRepository      repositories.AccountDeletionRepository
+	ExperienceRepository           repositories.ExperienceRepository
+	ReminderRunRepository          repositories.ReminderRunRepository
+	OutboundEmailRepository        repositories.OutboundEmailRepository
+	DataExportRepository           repositories.DataExportRepository
+	CompanyAnalyticsRepository     repositories.CompanyAnalyticsRepository
+
+	NotificationService notificationService.NotificationService
+	EmailQueueService   emailSvc.EmailQueueService
+	MentorshipService   mentorshipService.MentorshipService
+	JobAlertService     jobService.JobAlertService
+	UploadService       uploadService.UploadService
+	APIKeyService       apiKeyService.APIKeyService
+
+	AuthHandler             *authHandler.AuthHandler
+	RecoveryHandler         *authHandler.RecoveryHandler
+	TwoFactorHandler        *authHandler.TwoFactorHandler
+	PhoneHandler            *authHandler.PhoneHandler
+	SecurityHandler         *authHandler.SecurityHandler
+	SSOHandler              *ssoHandler.SSOHandler
+	UserHandler             *userHandler.UserHandler
+	ConnectionHandler       *userHandler.ConnectionHandler
+	PostHandler             *postHandler.PostHandler
+	JobHandler              *jobHandler.JobHandler
+	JobTemplateHandler      *jobHandler.JobTemplateHandler
+	JobAlertHandler         *jobHandler.JobAlertHandler
+	CompanyHandler          *companyHandler.CompanyHandler
+	TeamHandler             *companyHandler.TeamHandler
+	CompanyAnalyticsHandler *companyHandler.CompanyAnalyticsHandler
+	IdentityHandler         *identityHandler.IdentityHandler
+	NotificationHandler     *notificationHandler.NotificationHandler
+	MessageHandler          *messageHandler.MessageHandler
+	WebSocketHandler        *realtimeHandler.WebSocketHandler
+	AnalyticsHandler        *analyticsHandler.AnalyticsHandler
+	PolicyHandler           *policyHandler.PolicyHandler
+	AccountHandler          *accountHandler.AccountHandler
+	SearchHandler           *searchHandler.SearchHandler
+	EmailHandler            *emailHandler.EmailHandler
+	ClusterHandler          *clusterHandler.ClusterHandler
+	ExperimentHandler       *experimentHandler.ExperimentHandler
+	AssessmentHandler       *assessmentHandler.AssessmentHandler
+	LearningHandler         *learningHandler.LearningHandler
+	MentorshipHandler       *mentorshipHandler.MentorshipHandler
+	RecommendationHandler   *recommendationHandler.RecommendationHandler
+	TaxonomyHandler         *taxonomyHandler.TaxonomyHandler
+	BookmarkHandler         *bookmarkHandler.BookmarkHandler
+	MediaHandler            *mediaHandler.MediaHandler
+	UploadHandler           *uploadHandler.UploadHandler
+	LocaleHandler           *localeHandler.LocaleHandler
+	APIKeyHandler           *apiKeyHandler.APIKeyHandler
+	InviteHandler           *inviteHandler.InviteHandler
+	AdminHandler            *adminHandler.AdminHandler
+	ModerationHandler       *moderationHandler.ModerationHandler
 }
 
 func InitializeDependencies(cfg *config.Config, db *gorm.DB, logger logger.StructuredLogger) (*Dependencies, error) {
 
 	userRepository := userRepo.NewUserRepository(db)
 	connectionRepository := userRepo.NewConnectionRepository(db)
+	connectionImportRepository := userRepo.NewConnectionImportRepository(db)
+	connectionSuggestionRepository := userRepo.NewConnectionSuggestionRepository(db)
+	experienceRepository := userRepo.NewExperienceRepository(db)
 	sessionRepository := authRepo.NewSessionRepository(db)
+	recoveryCodeRepository := authRepo.NewRecoveryCodeRepository(db)
+	securityEventRepository := authRepo.NewSecurityEventRepository(db)
+	companySSORepository := ssoRepo.NewCompanySSORepository(db)
+	ssoIdentityRepository := ssoRepo.NewSSOIdentityRepository(db)
 	postRepository := postRepo.NewPostRepository(db)
-	likeRepository := postRepo.NewLikeRepository(db)
+	reactionRepository := postRepo.NewReactionRepository(db)
 	commentRepository := postRepo.NewCommentRepository(db)
+	commentReactionRepository := postRepo.NewCommentReactionRepository(db)
+	hashtagRepository := postRepo.NewHashtagRepository(db)
+	mentionRepository := postRepo.NewMentionRepository(db)
+	hiddenPostRepository := postRepo.NewHiddenPostRepository(db)
+	postSuggestionRepository := postRepo.NewPostSuggestionRepository(db)
 	jobRepository := jobRepo.NewJobRepository(db)
+	jobTemplateRepository := jobRepo.NewJobTemplateRepository(db)
+	jobAlertRepository := jobRepo.NewJobAlertRepository(db)
 	applicationRepository := jobRepo.NewApplicationRepository(db)
+	companyRepository := companyRepo.NewCompanyRepository(db)
+	companyVerificationRepository := companyRepo.NewCompanyVerificationRepository(db)
+	companyMemberRepository := companyRepo.NewCompanyMemberRepository(db)
+	companyTeamRepository := companyRepo.NewCompanyTeamRepository(db)
+	teamMemberRepository := companyRepo.NewTeamMemberRepository(db)
+	companyFollowRepository := companyRepo.NewCompanyFollowRepository(db)
+	companyAnalyticsRepository := companyRepo.NewCompanyAnalyticsRepository(db)
+	identityVerificationRepository := identityRepo.NewIdentityVerificationRepository(db)
+	notificationRepository := notificationRepo.NewNotificationRepository(db, cfg.Retention.NotificationDays)
+	reminderRunRepository := notificationRepo.NewReminderRunRepository(db)
+	conversationRepository := messageRepo.NewConversationRepository(db)
+	messageRepository := messageRepo.NewMessageRepository(db)
+	analyticsRepository := analyticsRepo.NewAnalyticsRepository(db)
+	policyRepository := policyRepo.NewPolicyRepository(db)
+	accountDeletionRepository := accountRepo.NewAccountDeletionRepository(db)
+	recentSearchRepository := searchRepo.NewRecentSearchRepository(db)
+	outboundEmailRepository := emailRepo.NewOutboundEmailRepository(db)
+	dataExportRepository := analyticsRepo.NewDataExportRepository(db)
+	experimentRepository := experimentRepo.NewExperimentRepository(db)
+	assessmentRepository := assessmentRepo.NewAssessmentRepository(db)
+	assessmentAttemptRepository := assessmentRepo.NewAssessmentAttemptRepository(db)
+	skillBadgeRepository := assessmentRepo.NewSkillBadgeRepository(db)
+	courseRepository := learningRepo.NewCourseRepository(db)
+	enrollmentRepository := learningRepo.NewEnrollmentRepository(db)
+	certificateRepository := learningRepo.NewCertificateRepository(db)
+	mentorshipProfileRepository := mentorshipRepo.NewMentorshipProfileRepository(db)
+	mentorshipMatchRepository := mentorshipRepo.NewMentorshipMatchRepository(db)
+	recommendationRepository := recommendationRepo.NewRecommendationRepository(db)
+	profileSectionRepository := userRepo.NewProfileSectionRepository(db)
+	industryRepository := taxonomyRepo.NewIndustryRepository(db)
+	locationRepository := taxonomyRepo.NewLocationRepository(db)
+	bookmarkRepository := bookmarkRepo.NewBookmarkRepository(db)
+	apiKeyRepository := apiKeyRepo.NewAPIKeyRepository(db)
+	inviteRepository := inviteRepo.NewInviteRepository(db)
+	adminUserRepository := adminRepo.NewAdminUserRepository(db)
+	adminAuditLogRepository := adminRepo.NewAdminAuditLogRepository(db)
+	reportRepository := moderationRepo.NewReportRepository(db)
+	moderatedContentRepository := moderationRepo.NewModeratedContentRepository(db)
+	strikeRepository := moderationRepo.NewStrikeRepository(db)
+	uploadJobRepository := uploadRepo.NewUploadJobRepository(db)
+
+	locales, err := i18n.NewBundle(cfg.Locale.Default, cfg.Locale.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load locales: %w", err)
+	}
+
+	geoLocator, err := geoip.Open(cfg.GeoIP.DatabaseFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load geoip database: %w", err)
+	}
+
+	var chaosInjector *chaos.Injector
+	if cfg.Chaos.Enabled {
+		if env := cfg.Server.Environment; env != "development" && env != "test" {
+			return nil, fmt.Errorf("fault injection can only be enabled in development or test, not %q", env)
+		}
+		chaosRules, err := chaos.ParseRules(cfg.Chaos.Rules)
+		if err != nil {
+			return nil, err
+		}
+		chaosInjector = chaos.NewInjector(chaosRules)
+		logger.Warn("Fault injection is enabled", "rules", len(chaosRules))
+	}
+
+	s3Storage, err := storage.NewS3StorageService(cfg.AWS.AccessKeyID, cfg.AWS.SecretAccessKey, cfg.AWS.Region, cfg.AWS.S3Bucket, newRetryPolicy(cfg))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create storage service: %w", err)
+	}
+	if chaosInjector != nil {
+		s3Storage = storage.NewChaosStorageService(s3Storage, chaosInjector)
+	}
+	storageService := storage.NewCircuitBreakerStorageService(s3Storage, newCircuitBreaker(cfg, "s3", storage.IsFailure))
+
+	exportConfig, err := dataexport.NewConfig(cfg.Export.Tables, cfg.Export.Prefix, cfg.Export.HashKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid analytics export configuration: %w", err)
+	}
+	exportStore, err := backup.NewS3Store(cfg.AWS.AccessKeyID, cfg.AWS.SecretAccessKey, cfg.AWS.Region, cfg.Export.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create analytics export store: %w", err)
+	}
+
+	baseRedisClient, err := redis.NewRedisClient(redis.Config{
+		Mode:             cfg.Redis.Mode,
+		Host:             cfg.Redis.Host,
+		Port:             cfg.Redis.Port,
+		Addrs:            cfg.Redis.Addrs,
+		MasterName:       cfg.Redis.MasterName,
+		Password:         cfg.Redis.Password,
+		SentinelPassword: cfg.Redis.SentinelPassword,
+		DB:               cfg.Redis.DB,
+		PoolSize:         cfg.Redis.PoolSize,
+		MinIdleConns:     cfg.Redis.MinIdleConns,
+		DialTimeout:      cfg.Redis.DialTimeout,
+		ReadTimeout:      cfg.Redis.ReadTimeout,
+		WriteTimeout:     cfg.Redis.WriteTimeout,
+		OperationTimeout: cfg.Redis.OperationTimeout,
+		TLSEnabled:       cfg.Redis.TLSEnabled,
+		TLSSkipVerify:    cfg.Redis.TLSSkipVerify,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create redis client: %w", err)
+	}
+	redisClient := redis.NewCircuitBreakerClient(baseRedisClient, newCircuitBreaker(cfg, "redis", redis.IsFailure))
+
+	jwtService, err := auth.NewJWTService(cfg.JWT.SecretKey, cfg.JWT.ExpiryHours, sessionRepository, geoLocator, cfg.Encryption.Pepper, redisClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create JWT service: %w", err)
+	}
+
+	providerRateLimits, err := email.ParseProviderLimits(cfg.SMTP.ProviderRateLimits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse SMTP provider rate limits: %w", err)
+	}
+	smtpService, err := email.NewEmailService(cfg.SMTP.Host, cfg.SMTP.Port, cfg.SMTP.Username, cfg.SMTP.Password, newRetryPolicy(cfg), email.PoolConfig{
+		MaxConcurrency: cfg.SMTP.MaxConcurrency,
+		IdleTimeout:    cfg.SMTP.IdleTimeout,
+		AcquireTimeout: cfg.SMTP.AcquireTimeout,
+		RateLimit:      cfg.SMTP.RateLimit,
+		ProviderLimits: providerRateLimits,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create email service: %w", err)
+	}
+	if chaosInjector != nil {
+		smtpService = email.NewChaosEmailService(smtpService, chaosInjector)
+	}
+	emailService := email.NewCircuitBreakerEmailService(smtpService, newCircuitBreaker(cfg, "smtp", nil))
+
+	var smsSender sms.Sender
+	switch cfg.SMS.Driver {
+	case sms.DriverTwilio:
+		smsSender, err = sms.NewTwilioSender(cfg.SMS.TwilioAccountSID, cfg.SMS.TwilioAuthToken, cfg.SMS.TwilioFrom, cfg.SMS.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create SMS sender: %w", err)
+		}
+	case sms.DriverLog:
+		if cfg.Server.Environment == "production" {
+			return nil, fmt.Errorf("SMS driver %q cannot be used in production", cfg.SMS.Driver)
+		}
+		smsSender = sms.NewLogSender(logger)
+	default:
+		return nil, fmt.Errorf("unknown SMS driver %q", cfg.SMS.Driver)
+	}
+	smsSender = sms.NewCircuitBreakerSender(smsSender, newCircuitBreaker(cfg, "sms", nil))
 
-	jwtService := auth.NewJWTService(cfg.JWT.SecretKey, cfg.JWT.ExpiryHours, sessionRepository)
-	storageService := storage.NewS3StorageService(cfg.AWS.AccessKeyID, cfg.AWS.SecretAccessKey, cfg.AWS.Region, cfg.AWS.S3Bucket)
-	redisClient := redis.NewRedisClient(cfg.Redis.Host, cfg.Redis.Port, cfg.Redis.Password, cfg.Redis.DB)
-	emailService := email.NewEmailService(cfg.SMTP.Host, cfg.SMTP.Port, cfg.SMTP.Username, cfg.SMTP.Password)
+	featureFlags := featureflag.New(featureflag.FeatureUploads, featureflag.FeatureEmail, featureflag.FeatureCache)
+	featureFlags.Configure(featureflag.FeatureFeedFanout, cfg.Feed.FanoutEnabled)
+	featureFlags.Configure(featureflag.FeatureInviteOnly, cfg.Invite.Required)
+	if err := probeDependencies(cfg, storageService, redisClient, emailService, featureFlags, logger); err != nil {
+		return nil, err
+	}
 	validator := validation.NewValidator()
+	unreadCounter := counter.NewUnreadCounter(redisClient)
+	viewCounter := counter.NewViewCounter(redisClient)
+	feedStore := feed.NewRedisStore(redisClient)
+	connectionGraph := graph.NewRedisGraph(redisClient, connectionRepository.GetConnectionIDs, connectionRepository.GetBlockedIDs)
+	coordinator := cluster.NewRedisCoordinator(redisClient, cfg.Cluster.InstanceID, cfg.Cluster.LeaseTTL, background.LeaderOnlyServices)
+	realtimeHub := realtime.NewDistributedHub(redisClient, coordinator.ID())
+	presenceTracker := presence.NewRedisTracker(redisClient, realtimeHub.IsOnline)
+	var requestCapture *capture.Recorder
+	if cfg.Capture.Enabled {
+		requestCapture = capture.NewRecorder(capture.NewRedisStore(redisClient, cfg.Capture.MaxEntries, cfg.Capture.TTL), cfg.Capture.SampleRate, cfg.Capture.MaxBodyBytes)
+	}
+	eventBus := eventbus.New(logger)
+	mediaSigner := media.NewSigner(cfg.Media.TokenSecret, cfg.Media.TokenTTL, "/api/v1/media")
+	affinityTracker := affinity.NewRedisTracker(redisClient)
+	feedRanking := feed.RankingConfig{
+		Mode:   feed.Mode(cfg.Feed.RankingMode),
+		Window: cfg.Feed.RankingWindow,
+		Weights: feed.Weights{
+			Recency:       cfg.Feed.WeightRecency,
+			Degree:        cfg.Feed.WeightDegree,
+			Engagement:    cfg.Feed.WeightEngagement,
+			Affinity:      cfg.Feed.WeightAffinity,
+			Feedback:      cfg.Feed.WeightFeedback,
+			HalfLifeHours: cfg.Feed.HalfLifeHours,
+		},
+	}
 
-	authSvc := authService.NewAuthService(userRepository, jwtService, emailService, redisClient, logger)
-	userSvc := userService.NewUserService(userRepository, storageService, logger)
-	connectionSvc := userService.NewConnectionService(connectionRepository, userRepository, storageService, logger)
-	postSvc := postService.NewPostService(postRepository, userRepository, likeRepository, commentRepository, storageService, logger)
-	jobSvc := jobService.NewJobService(jobRepository, applicationRepository, userRepository, storageService, logger)
+	signupGuard, err := signup.NewGuard(redisClient, signup.Config{
+		IPLimit:               cfg.Signup.IPLimit,
+		IPWindow:              cfg.Signup.IPWindow,
+		DomainLimit:           cfg.Signup.DomainLimit,
+		DomainWindow:          cfg.Signup.DomainWindow,
+		DomainLimitExempt:     cfg.Signup.DomainLimitExempt,
+		BlockDisposable:       cfg.Signup.BlockDisposable,
+		DisposableDomainsFile: cfg.Signup.DisposableDomainsFile,
+		AllowedDomains:        cfg.Signup.AllowedDomains,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signup guard: %w", err)
+	}
+
+	passwordPolicy, err := password.NewPolicy(password.Config{
+		MinLength:           cfg.Password.MinLength,
+		MaxLength:           cfg.Password.MaxLength,
+		MinCharacterClasses: cfg.Password.MinCharacterClasses,
+		BlockCommon:         cfg.Password.BlockCommon,
+		CommonPasswordsFile: cfg.Password.CommonPasswordsFile,
+		BreachCheck:         cfg.Password.BreachCheck,
+		BreachAPIURL:        cfg.Password.BreachAPIURL,
+		BreachTimeout:       cfg.Password.BreachTimeout,
+		BreachThreshold:     cfg.Password.BreachThreshold,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create password policy: %w", err)
+	}
+
+	securitySvc := authService.NewSecurityService(securityEventRepository, userRepository, jwtService, emailService, redisClient, logger)
+	authSvc := authService.NewAuthService(userRepository, jwtService, emailService, redisClient, signupGuard, passwordPolicy, securitySvc, companySSORepository, inviteRepository, featureFlags, recoveryCodeRepository, cfg.Encryption.Pepper, cfg.TwoFactor, cfg.MagicLink, logger)
+	recoverySvc := authService.NewRecoveryService(userRepository, recoveryCodeRepository, jwtService, emailService, redisClient, passwordPolicy, securitySvc, smsSender, cfg.Encryption.Pepper, cfg.SMS, logger)
+	apiKeySvc := apiKeyService.NewAPIKeyService(apiKeyRepository, redisClient, securitySvc, cfg.APIKey, cfg.Encryption.Pepper, logger)
+	inviteSvc := inviteService.NewInviteService(inviteRepository, userRepository, featureFlags, cfg.Invite, logger)
+	twoFactorSvc := authService.NewTwoFactorService(userRepository, recoveryCodeRepository, redisClient, securitySvc, smsSender, cfg.Encryption.Pepper, cfg.TwoFactor, cfg.SMS, logger)
+	phoneSvc := authService.NewPhoneService(userRepository, smsSender, redisClient, securitySvc, cfg.SMS, logger)
+	experimentSvc := experimentService.NewExperimentService(experimentRepository, analyticsRepository, redisClient, logger)
+	emailQueueSvc := emailSvc.NewEmailQueueService(outboundEmailRepository, experimentSvc, cfg.Email.SendDelay, logger)
+	emailTemplateSvc := emailSvc.NewEmailTemplateService(emailService, logger)
+	searchSvc := searchService.NewSearchService(recentSearchRepository, userRepository, jobRepository, postRepository, fulltext.NewPostgresSearchService(db), storageService, connectionGraph, affinityTracker, logger)
+	taxonomySvc := taxonomyService.NewTaxonomyService(industryRepository, locationRepository, logger)
+	userSvc := userService.NewUserService(userRepository, experienceRepository, postSuggestionRepository, skillBadgeRepository, certificateRepository, recommendationRepository, profileSectionRepository, storageService, viewCounter, moderation.NewImageModerator(moderation.NewNoopClassifier(), nil), imaging.NewNoopFaceDetector(), connectionGraph, presenceTracker, searchSvc, taxonomySvc, inviteSvc, apiKeySvc, logger)
+	uploadSvc := uploadService.NewUploadService(uploadJobRepository, scanner.NewNoopScanner(), cfg.Upload.QueueSize, cfg.Upload.MaxBytes, logger)
+	connectionSvc := userService.NewConnectionService(connectionRepository, connectionImportRepository, connectionSuggestionRepository, userRepository, storageService, feedStore, connectionGraph, eventBus, uploadSvc, logger)
+	postSvc := postService.NewPostService(postRepository, userRepository, reactionRepository, commentRepository, commentReactionRepository, experienceRepository, postSuggestionRepository, hashtagRepository, mentionRepository, hiddenPostRepository, connectionGraph, affinityTracker, storageService, viewCounter, feedStore, feedRanking, featureFlags, experimentSvc, eventBus, logger)
+	notificationSvc := notificationService.NewNotificationService(notificationRepository, messageRepository, userRepository, unreadCounter, storageService, logger)
+	jobSvc := jobService.NewJobService(jobRepository, applicationRepository, userRepository, companyRepository, companyMemberRepository, companyTeamRepository, teamMemberRepository, notificationSvc, emailQueueSvc, viewCounter, storageService, connectionGraph, searchSvc, taxonomySvc, locales, logger)
+	jobTemplateSvc := jobService.NewJobTemplateService(jobTemplateRepository, companyRepository, companyMemberRepository, jobSvc, logger)
+	jobAlertSvc := jobService.NewJobAlertService(jobAlertRepository, jobRepository, taxonomySvc, emailService, cfg.Email.LinkBaseURL, logger)
+	identitySvc := identityService.NewIdentityService(identityVerificationRepository, userRepository, identity.NewManualReviewProvider(), storageService, logger)
+	companySvc := companyService.NewCompanyService(companyRepository, companyVerificationRepository, companyMemberRepository, companyFollowRepository, userRepository, storageService, emailService, redisClient, taxonomySvc, logger)
+	teamSvc := companyService.NewTeamService(companyRepository, companyMemberRepository, companyTeamRepository, teamMemberRepository, userRepository, jobRepository, logger)
+	companyAnalyticsSvc := companyService.NewCompanyAnalyticsService(companyRepository, companyMemberRepository, companyFollowRepository, companyAnalyticsRepository, logger)
+	analyticsSvc := analyticsService.NewAnalyticsService(analyticsRepository, postRepository, jobRepository, viewCounter, logger)
+	policySvc := policyService.NewPolicyService(policyRepository, logger)
+	accountSvc := accountService.NewAccountService(accountDeletionRepository, userRepository, jwtService, cfg.Privacy.DeletionGraceDays, logger)
+	ssoSvc := ssoService.NewSSOService(companySSORepository, ssoIdentityRepository, companyRepository, companyVerificationRepository, companyMemberRepository, userRepository, jwtService, securitySvc, emailService, redisClient, cfg.SSO, logger)
+	mentorshipSvc := mentorshipService.NewMentorshipService(mentorshipProfileRepository, mentorshipMatchRepository, conversationRepository, notificationSvc, connectionGraph, storageService, logger)
+	messageSvc := messageService.NewMessageService(conversationRepository, messageRepository, userRepository, unreadCounter, realtimeHub, presenceTracker, storageService, mediaSigner, scanner.NewNoopScanner(), logger)
 
 	authHand := authHandler.NewAuthHandler(authSvc, validator, logger)
+	recoveryHand := authHandler.NewRecoveryHandler(recoverySvc, validator, logger)
+	twoFactorHand := authHandler.NewTwoFactorHandler(twoFactorSvc, validator, logger)
+	phoneHand := authHandler.NewPhoneHandler(phoneSvc, validator, logger)
+	securityHand := authHandler.NewSecurityHandler(securitySvc, logger)
+	ssoHand := ssoHandler.NewSSOHandler(ssoSvc, validator, cfg.SSO.FrontendRedirectURL, logger)
 	userHand := userHandler.NewUserHandler(userSvc, validator, logger)
 	connectionHand := userHandler.NewConnectionHandler(connectionSvc, validator, logger)
 	postHand := postHandler.NewPostHandler(postSvc, validator, logger)
 	jobHand := jobHandler.NewJobHandler(jobSvc, validator, logger)
+	jobTemplateHand := jobHandler.NewJobTemplateHandler(jobTemplateSvc, validator, logger)
+	jobAlertHand := jobHandler.NewJobAlertHandler(jobAlertSvc, validator, logger)
+	companyHand := companyHandler.NewCompanyHandler(companySvc, validator, logger)
+	teamHand := companyHandler.NewTeamHandler(teamSvc, validator, logger)
+	companyAnalyticsHand := companyHandler.NewCompanyAnalyticsHandler(companyAnalyticsSvc, logger)
+	identityHand := identityHandler.NewIdentityHandler(identitySvc, validator, logger)
+	notificationHand := notificationHandler.NewNotificationHandler(notificationSvc, validator, logger)
+	messageHand := messageHandler.NewMessageHandler(messageSvc, validator, logger)
+	webSocketHand := realtimeHandler.NewWebSocketHandler(realtimeHub, jwtService, cfg.Server.AllowedOrigins, logger)
+	analyticsHand := analyticsHandler.NewAnalyticsHandler(analyticsSvc, logger)
+	policyHand := policyHandler.NewPolicyHandler(policySvc, validator, logger)
+	accountHand := accountHandler.NewAccountHandler(accountSvc, validator, logger)
+	searchHand := searchHandler.NewSearchHandler(searchSvc, validator, logger)
+	emailHand := emailHandler.NewEmailHandler(emailQueueSvc, emailTemplateSvc, validator, logger)
+	clusterHand := clusterHandler.NewClusterHandler(clusterService.NewClusterService(coordinator, logger), logger)
+	experimentHand := experimentHandler.NewExperimentHandler(experimentSvc, validator, logger)
+	assessmentHand := assessmentHandler.NewAssessmentHandler(assessmentService.NewAssessmentService(assessmentRepository, assessmentAttemptRepository, logger), validator, logger)
+	learningHand := learningHandler.NewLearningHandler(learningService.NewLearningService(courseRepository, enrollmentRepository, certificateRepository, logger), validator, logger)
+	mentorshipHand := mentorshipHandler.NewMentorshipHandler(mentorshipSvc, validator, logger)
+	recommendationHand := recommendationHandler.NewRecommendationHandler(recommendationService.NewRecommendationService(recommendationRepository, userRepository, connectionGraph, notificationSvc, storageService, logger), validator, logger)
+	taxonomyHand := taxonomyHandler.NewTaxonomyHandler(taxonomySvc, validator, logger)
+	bookmarkHand := bookmarkHandler.NewBookmarkHandler(bookmarkService.NewBookmarkService(bookmarkRepository, jobRepository, postRepository, storageService, logger), logger)
+	mediaHand := mediaHandler.NewMediaHandler(mediaService.NewMediaService(mediaSigner, conversationRepository, messageRepository, storageService, cfg.Media.URLTTL, logger), logger)
+	uploadHand := uploadHandler.NewUploadHandler(uploadSvc, logger)
+	adminSvc := adminService.NewAdminService(userRepository, adminUserRepository, adminAuditLogRepository, authSvc, securitySvc, jwtService, logger)
+	moderationHand := moderationHandler.NewModerationHandler(moderationService.NewModerationService(reportRepository, moderatedContentRepository, strikeRepository, userRepository, adminSvc, notificationSvc, cfg.Moderation, logger), validator, logger)
+
+	realtimeHub.HandleFunc(realtime.EventTyping, messageSvc.HandleTyping)
+	for _, eventType := range []string{eventbus.ConnectionRequested, eventbus.ConnectionAccepted, eventbus.PostLiked, eventbus.PostCommented, eventbus.PostShared, eventbus.CommentReplied, eventbus.CommentLiked, eventbus.PostMentioned, eventbus.CommentMentioned} {
+		eventBus.Subscribe(eventType, notificationSvc.HandleEvent)
+	}
+	realtime.Forward(eventBus, realtimeHub,
+		realtime.EventConnectionRequest,
+		realtime.EventConnectionAccepted,
+		realtime.EventPostLiked,
+		realtime.EventPostCommented,
+		realtime.EventPostShared,
+		realtime.EventCommentReplied,
+		realtime.EventCommentLiked,
+		realtime.EventPostMentioned,
+		realtime.EventCommentMentioned,
+	)
 
 	return &Dependencies{
 
-		JWTService:     jwtService,
-		StorageService: storageService,
-		RedisClient:    redisClient,
-		EmailService:   emailService,
-		Validator:      validator,
-		Logger:         logger,
-
-		UserRepository:       userRepository,
-		ConnectionRepository: connectionRepository,
-		SessionRepository:    sessionRepository,
-
-		AuthHandler:       authHand,
-		UserHandler:       userHand,
-		ConnectionHandler: connectionHand,
-		PostHandler:       postHand,
-		JobHandler:        jobHand,
+		JWTService:      jwtService,
+		StorageService:  storageService,
+		RedisClient:     redisClient,
+		EmailService:    emailService,
+		Validator:       validator,
+		FeatureFlags:    featureFlags,
+		UnreadCounter:   unreadCounter,
+		ViewCounter:     viewCounter,
+		RealtimeHub:     realtimeHub,
+		RealtimeRelay:   realtimeHub,
+		PresenceTracker: presenceTracker,
+		RequestCapture:  requestCapture,
+		Chaos:           chaosInjector,
+		HTTPMetrics:     httpmetrics.NewRegistry(),
+		Locales:         locales,
+		RouteTimeouts:   middleware.NewRouteTimeouts(cfg.Server.RequestTimeout),
+		Server:          cfg.Server,
+		Coordinator:     coordinator,
+		ExportStore:     exportStore,
+		ExportConfig:    exportConfig,
+		Logger:          logger,
+
+		UserRepository:                 userRepository,
+		JobRepository:                  jobRepository,
+		ConnectionRepository:           connectionRepository,
+		ConnectionSuggestionRepository: connectionSuggestionRepository,
+		SessionRepository:              sessionRepository,
+		NotificationRepository:         notificationRepository,
+		MessageRepository:              messageRepository,
+		AnalyticsRepository:            analyticsRepository,
+		CompanyAnalyticsRepository:     companyAnalyticsRepository,
+		PolicyRepository:               policyRepository,
+		AccountDeletionRepository:      accountDeletionRepository,
+		ExperienceRepository:           experienceRepository,
+		ReminderRunRepository:          reminderRunRepository,
+		OutboundEmailRepository:        outboundEmailRepository,
+		DataExportRepository:           dataExportRepository,
+
+		NotificationService: notificationSvc,
+		EmailQueueService:   emailQueueSvc,
+		MentorshipService:   mentorshipSvc,
+		JobAlertService:     jobAlertSvc,
+		UploadService:       uploadSvc,
+		APIKeyService:       apiKeySvc,
+
+		AuthHandler:             authHand,
+		RecoveryHandler:         recoveryHand,
+		TwoFactorHandler:        twoFactorHand,
+		PhoneHandler:            phoneHand,
+		SecurityHandler:         securityHand,
+		SSOHandler:              ssoHand,
+		UserHandler:             userHand,
+		ConnectionHandler:       connectionHand,
+		PostHandler:             postHand,
+		JobHandler:              jobHand,
+		JobTemplateHandler:      jobTemplateHand,
+		JobAlertHandler:         jobAlertHand,
+		CompanyHandler:          companyHand,
+		TeamHandler:             teamHand,
+		CompanyAnalyticsHandler: companyAnalyticsHand,
+		IdentityHandler:         identityHand,
+		NotificationHandler:     notificationHand,
+		MessageHandler:          messageHand,
+		WebSocketHandler:        webSocketHand,
+		AnalyticsHandler:        analyticsHand,
+		PolicyHandler:           policyHand,
+		AccountHandler:          accountHand,
+		SearchHandler:           searchHand,
+		EmailHandler:            emailHand,
+		ClusterHandler:          clusterHand,
+		ExperimentHandler:       experimentHand,
+		AssessmentHandler:       assessmentHand,
+		LearningHandler:         learningHand,
+		MentorshipHandler:       mentorshipHand,
+		RecommendationHandler:   recommendationHand,
+		TaxonomyHandler:         taxonomyHand,
+		BookmarkHandler:         bookmarkHand,
+		MediaHandler:            mediaHand,
+		UploadHandler:           uploadHand,
+		LocaleHandler:           localeHandler.NewLocaleHandler(locales),
+		APIKeyHandler:           apiKeyHandler.NewAPIKeyHandler(apiKeySvc, validator, logger),
+		InviteHandler:           inviteHandler.NewInviteHandler(inviteSvc, validator, logger),
+		AdminHandler:            adminHandler.NewAdminHandler(adminSvc, validator, logger),
+		ModerationHandler:       moderationHand,
 	}, nil
 }
+
+func newCircuitBreaker(cfg *config.Config, name string, isFailure func(error) bool) *breaker.CircuitBreaker {
+	return breaker.Register(breaker.New(breaker.Settings{
+		Name:        name,
+		MaxFailures: uint32(cfg.Breaker.MaxFailures),
+		OpenTimeout: cfg.Breaker.OpenTimeout,
+		IsFailure:   isFailure,
+	}))
+}
+
+func newRetryPolicy(cfg *config.Config) retry.Policy {
+	policy := retry.DefaultPolicy()
+	policy.MaxAttempts = cfg.Retry.MaxAttempts
+	policy.BaseDelay = cfg.Retry.BaseDelay
+	policy.MaxDelay = cfg.Retry.MaxDelay
+	return policy
+}
@@ -0,0 +1,29 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/featureflag"
+	"time"
+)
+
+func EmailRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+	adminMiddleware := middleware.AdminMiddleware(deps.UserRepository, deps.Logger)
+
+	outbox := rg.Group("/emails/outbox", authMiddleware)
+	{
+		outbox.GET("", deps.EmailHandler.GetPending)
+		outbox.DELETE("/:id", deps.EmailHandler.Cancel)
+	}
+
+	templates := rg.Group("/admin/email/templates", authMiddleware, adminMiddleware)
+	{
+		templates.GET("", deps.EmailHandler.ListTemplates)
+		templates.GET("/:name/preview", deps.EmailHandler.PreviewTemplate)
+		templates.POST("/:name/test",
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureEmail, deps.Logger),
+			middleware.RateLimitMiddleware(time.Minute, 10, deps.Logger),
+			deps.EmailHandler.SendTestEmail)
+	}
+}
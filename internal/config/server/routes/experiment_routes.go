@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+)
+
+func ExperimentRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+	adminMiddleware := middleware.AdminMiddleware(deps.UserRepository, deps.Logger)
+
+	admin := rg.Group("/admin/experiments", authMiddleware, adminMiddleware)
+	{
+		admin.POST("", deps.ExperimentHandler.CreateExperiment)
+		admin.GET("", deps.ExperimentHandler.ListExperiments)
+		admin.GET("/:id", deps.ExperimentHandler.GetExperiment)
+		admin.POST("/:id/stop", deps.ExperimentHandler.StopExperiment)
+	}
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+)
+
+func HashtagRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+	optionalAuthMiddleware := middleware.OptionalAuthMiddleware(deps.JWTService)
+
+	hashtags := rg.Group("/hashtags")
+	{
+		hashtags.GET("/trending", deps.PostHandler.GetTrendingHashtags)
+		hashtags.GET("/following", authMiddleware, deps.PostHandler.GetFollowedHashtags)
+		hashtags.GET("/:tag/posts", optionalAuthMiddleware, deps.PostHandler.GetHashtagPosts)
+
+		hashtags.POST("/:tag/follow", authMiddleware, deps.PostHandler.FollowHashtag)
+		hashtags.DELETE("/:tag/follow", authMiddleware, deps.PostHandler.UnfollowHashtag)
+	}
+}
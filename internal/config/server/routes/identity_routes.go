@@ -0,0 +1,31 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+)
+
+func IdentityRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+	adminMiddleware := middleware.AdminMiddleware(deps.UserRepository, deps.Logger)
+
+	identity := rg.Group("/identity", authMiddleware)
+	{
+		identity.GET("/verification", deps.IdentityHandler.GetStatus)
+		routeTimeout(deps, identity, "POST", "/verification", deps.Server.UploadRequestTimeout)
+		identity.POST("/verification",
+			middleware.FileUploadMiddleware(10<<20, []string{".pdf", ".jpg", ".jpeg", ".png"}),
+			middleware.UploadFeatureMiddleware(deps.FeatureFlags, deps.Logger),
+			deps.IdentityHandler.SubmitVerification,
+		)
+	}
+
+	admin := rg.Group("/admin/identity-verifications", authMiddleware, adminMiddleware)
+	{
+		admin.GET("", deps.IdentityHandler.GetReviewQueue)
+		admin.GET("/:id/audit", deps.IdentityHandler.GetAuditTrail)
+		admin.POST("/:id/approve", deps.IdentityHandler.ApproveVerification)
+		admin.POST("/:id/reject", deps.IdentityHandler.RejectVerification)
+		admin.POST("/:id/revoke", deps.IdentityHandler.RevokeVerification)
+	}
+}
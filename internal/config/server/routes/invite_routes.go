@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+	"time"
+)
+
+func InviteRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+
+	invites := rg.Group("/invites")
+	{
+		invites.GET("/check",
+			middleware.RateLimitMiddleware(time.Minute, 20, deps.Logger),
+			deps.InviteHandler.CheckInvite)
+
+		invites.GET("", authMiddleware, deps.InviteHandler.GetInvites)
+		invites.POST("",
+			authMiddleware,
+			middleware.RateLimitMiddleware(time.Minute, 10, deps.Logger),
+			deps.InviteHandler.CreateInvite)
+		invites.GET("/:id", authMiddleware, deps.InviteHandler.GetInvite)
+		invites.PUT("/:id", authMiddleware, deps.InviteHandler.UpdateInvite)
+		invites.DELETE("/:id", authMiddleware, deps.InviteHandler.RevokeInvite)
+	}
+}
@@ -2,12 +2,18 @@ package routes
 
 import (
 	"github.com/gin-gonic/gin"
+	"linked-clone/internal/domain/entities"
 	"linked-clone/internal/middleware"
+	"linked-clone/pkg/agegate"
 	"time"
 )
 
 func JobRoutes(rg *gin.RouterGroup, deps *Dependencies) {
 	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+	optionalAuthMiddleware := middleware.OptionalAuthMiddleware(deps.JWTService)
+	apiKeyMiddleware := func(scope entities.APIKeyScope) gin.HandlerFunc {
+		return middleware.APIKeyMiddleware(deps.APIKeyService, scope, authMiddleware, deps.Logger)
+	}
 
 	jobs := rg.Group("/jobs")
 	{
@@ -17,6 +23,7 @@ func JobRoutes(rg *gin.RouterGroup, deps *Dependencies) {
 			deps.JobHandler.GetJobs)
 
 		jobs.GET("/search",
+			optionalAuthMiddleware,
 			middleware.RateLimitMiddleware(time.Minute, 50, deps.Logger),
 			deps.JobHandler.SearchJobs)
 
@@ -24,28 +31,34 @@ func JobRoutes(rg *gin.RouterGroup, deps *Dependencies) {
 			middleware.RateLimitMiddleware(time.Minute, 200, deps.Logger),
 			deps.JobHandler.GetJob)
 
+		jobs.POST("/:id/view",
+			optionalAuthMiddleware,
+			middleware.RateLimitMiddleware(time.Minute, 60, deps.Logger),
+			deps.JobHandler.RecordView)
+
 		jobs.POST("",
-			authMiddleware,
+			apiKeyMiddleware(entities.APIKeyScopeJobsWrite),
 			middleware.RateLimitMiddleware(time.Minute, 10, deps.Logger),
+			middleware.AgeGateMiddleware(deps.UserRepository, agegate.FeatureJobPosting, deps.Logger),
 			deps.JobHandler.CreateJob)
 
 		jobs.PUT("/:id",
-			authMiddleware,
+			apiKeyMiddleware(entities.APIKeyScopeJobsWrite),
 			middleware.RateLimitMiddleware(time.Minute, 20, deps.Logger),
 			deps.JobHandler.UpdateJob)
 
 		jobs.DELETE("/:id",
-			authMiddleware,
+			apiKeyMiddleware(entities.APIKeyScopeJobsWrite),
 			middleware.RateLimitMiddleware(time.Minute, 10, deps.Logger),
 			deps.JobHandler.DeleteJob)
 
 		jobs.GET("/:id/applications",
-			authMiddleware,
+			apiKeyMiddleware(entities.APIKeyScopeApplicationsRead),
 			middleware.RateLimitMiddleware(time.Minute, 50, deps.Logger),
 			deps.JobHandler.GetJobApplications)
 
 		jobs.GET("/my/jobs",
-			authMiddleware,
+			apiKeyMiddleware(entities.APIKeyScopeJobsRead),
 			middleware.RateLimitMiddleware(time.Minute, 50, deps.Logger),
 			deps.JobHandler.GetMyJobs)
 
@@ -54,12 +67,74 @@ func JobRoutes(rg *gin.RouterGroup, deps *Dependencies) {
 			middleware.RateLimitMiddleware(time.Minute, 50, deps.Logger),
 			deps.JobHandler.GetMyApplications)
 
+		routeTimeout(deps, jobs, "POST", "/:id/apply", deps.Server.UploadRequestTimeout)
 		jobs.POST("/:id/apply",
 			authMiddleware,
 			middleware.RateLimitMiddleware(time.Minute, 5, deps.Logger),
+			middleware.AgeGateMiddleware(deps.UserRepository, agegate.FeatureJobApplications, deps.Logger),
 			middleware.FileUploadMiddleware(5<<20, []string{".pdf", ".doc", ".docx"}),
+			middleware.UploadFeatureMiddleware(deps.FeatureFlags, deps.Logger),
 			middleware.SecurityMonitoring(deps.Logger),
 			deps.JobHandler.ApplyJob,
 		)
+
+		templates := jobs.Group("/templates", authMiddleware)
+		{
+			templates.GET("", deps.JobTemplateHandler.GetTemplates)
+			templates.POST("", deps.JobTemplateHandler.CreateTemplate)
+			templates.GET("/:templateId", deps.JobTemplateHandler.GetTemplate)
+			templates.PUT("/:templateId", deps.JobTemplateHandler.UpdateTemplate)
+			templates.DELETE("/:templateId", deps.JobTemplateHandler.DeleteTemplate)
+			templates.POST("/:templateId/jobs",
+				middleware.RateLimitMiddleware(time.Minute, 10, deps.Logger),
+				middleware.AgeGateMiddleware(deps.UserRepository, agegate.FeatureJobPosting, deps.Logger),
+				deps.JobTemplateHandler.CreateJobFromTemplate)
+		}
+
+		jobs.GET("/alerts/unsubscribe",
+			middleware.RateLimitMiddleware(time.Minute, 20, deps.Logger),
+			deps.JobAlertHandler.Unsubscribe)
+
+		alerts := jobs.Group("/alerts", authMiddleware)
+		{
+			alerts.GET("", deps.JobAlertHandler.GetAlerts)
+			alerts.POST("", deps.JobAlertHandler.CreateAlert)
+			alerts.PUT("/:alertId", deps.JobAlertHandler.UpdateAlert)
+			alerts.DELETE("/:alertId", deps.JobAlertHandler.DeleteAlert)
+		}
+
+		jobs.GET("/approvals",
+			authMiddleware,
+			middleware.RateLimitMiddleware(time.Minute, 50, deps.Logger),
+			deps.JobHandler.GetPendingApprovals)
+
+		jobs.POST("/:id/submit",
+			authMiddleware,
+			middleware.RateLimitMiddleware(time.Minute, 20, deps.Logger),
+			deps.JobHandler.SubmitJob)
+
+		jobs.POST("/:id/approve",
+			authMiddleware,
+			middleware.RateLimitMiddleware(time.Minute, 50, deps.Logger),
+			deps.JobHandler.ApproveJob)
+
+		jobs.POST("/:id/reject",
+			authMiddleware,
+			middleware.RateLimitMiddleware(time.Minute, 50, deps.Logger),
+			deps.JobHandler.RejectJob)
+
+		jobs.POST("/applications/:applicationId/withdraw",
+			authMiddleware,
+			middleware.RateLimitMiddleware(time.Minute, 10, deps.Logger),
+			deps.JobHandler.WithdrawApplication)
+
+		jobs.PUT("/applications/:applicationId/status",
+			authMiddleware,
+			middleware.RateLimitMiddleware(time.Minute, 30, deps.Logger),
+			deps.JobHandler.UpdateApplicationStatus)
+
+		jobs.GET("/applications/:applicationId/history",
+			authMiddleware,
+			deps.JobHandler.GetApplicationHistory)
 	}
 }
@@ -0,0 +1,36 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+)
+
+func LearningRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+	optionalAuthMiddleware := middleware.OptionalAuthMiddleware(deps.JWTService)
+	adminMiddleware := middleware.AdminMiddleware(deps.UserRepository, deps.Logger)
+
+	courses := rg.Group("/courses")
+	{
+		courses.GET("", optionalAuthMiddleware, deps.LearningHandler.ListCourses)
+		courses.GET("/my", authMiddleware, deps.LearningHandler.GetMyCourses)
+		courses.GET("/certificates/:code", deps.LearningHandler.VerifyCertificate)
+		courses.GET("/:id", optionalAuthMiddleware, deps.LearningHandler.GetCourse)
+
+		courses.POST("/:id/enroll", authMiddleware, deps.LearningHandler.Enroll)
+		courses.DELETE("/:id/enroll", authMiddleware, deps.LearningHandler.Unenroll)
+		courses.GET("/:id/progress", authMiddleware, deps.LearningHandler.GetProgress)
+		courses.POST("/:id/lessons/:lessonId/complete", authMiddleware, deps.LearningHandler.CompleteLesson)
+	}
+
+	admin := rg.Group("/admin/courses", authMiddleware, adminMiddleware)
+	{
+		admin.POST("", deps.LearningHandler.CreateCourse)
+		admin.GET("", deps.LearningHandler.ListAllCourses)
+		admin.GET("/:id", deps.LearningHandler.GetCourseDetail)
+		admin.PUT("/:id", deps.LearningHandler.UpdateCourse)
+		admin.POST("/:id/lessons", deps.LearningHandler.AddLesson)
+		admin.PUT("/:id/lessons/:lessonId", deps.LearningHandler.UpdateLesson)
+		admin.DELETE("/:id/lessons/:lessonId", deps.LearningHandler.DeleteLesson)
+	}
+}
@@ -0,0 +1,13 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+func LocaleRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	locales := rg.Group("/locales")
+	{
+		locales.GET("", deps.LocaleHandler.GetLocales)
+		locales.GET("/enums", deps.LocaleHandler.GetEnums)
+	}
+}
@@ -0,0 +1,11 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// MediaRoutes needs no auth middleware: tokens are bound to the viewer they
+// were issued to, so they also work from <img> and <audio> tags.
+func MediaRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	rg.GET("/media/:token", deps.MediaHandler.Redirect)
+}
@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+)
+
+func MentorshipRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+
+	mentorship := rg.Group("/mentorship", authMiddleware)
+	{
+		mentorship.GET("/profiles", deps.MentorshipHandler.GetProfiles)
+		mentorship.PUT("/profiles/:role", deps.MentorshipHandler.UpsertProfile)
+		mentorship.DELETE("/profiles/:role", deps.MentorshipHandler.DeleteProfile)
+
+		mentorship.GET("/matches", deps.MentorshipHandler.GetMatches)
+		mentorship.POST("/matches/:id/accept", deps.MentorshipHandler.AcceptMatch)
+		mentorship.POST("/matches/:id/decline", deps.MentorshipHandler.DeclineMatch)
+		mentorship.POST("/matches/:id/end", deps.MentorshipHandler.EndMatch)
+		mentorship.POST("/matches/:id/check-in", deps.MentorshipHandler.CheckIn)
+	}
+}
@@ -0,0 +1,39 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+)
+
+func MessageRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+
+	messages := rg.Group("/messages", authMiddleware)
+	{
+		messages.GET("/unread-count", deps.MessageHandler.GetUnreadCount)
+		messages.GET("/settings", deps.MessageHandler.GetSettings)
+		messages.PUT("/settings", deps.MessageHandler.UpdateSettings)
+
+		messages.POST("/conversations", deps.MessageHandler.StartConversation)
+		messages.GET("/conversations", deps.MessageHandler.GetConversations)
+		messages.GET("/conversations/:id/messages", deps.MessageHandler.GetMessages)
+		routeTimeout(deps, messages, "POST", "/conversations/:id/messages", deps.Server.UploadRequestTimeout)
+		messages.POST("/conversations/:id/messages",
+			middleware.FileUploadMiddleware(25<<20, []string{
+				".jpg", ".jpeg", ".png", ".gif", ".webp",
+				".mp3", ".m4a", ".ogg", ".wav", ".webm",
+				".pdf", ".doc", ".docx", ".txt", ".csv", ".xls", ".xlsx",
+			}),
+			middleware.UploadFeatureMiddleware(deps.FeatureFlags, deps.Logger),
+			deps.MessageHandler.SendMessage,
+		)
+		messages.POST("/conversations/:id/read", deps.MessageHandler.MarkConversationRead)
+		messages.POST("/conversations/:id/messages/:messageId/read", deps.MessageHandler.MarkMessageRead)
+
+		messages.POST("/conversations/:id/archive", deps.MessageHandler.ArchiveConversation)
+		messages.DELETE("/conversations/:id/archive", deps.MessageHandler.UnarchiveConversation)
+		messages.POST("/conversations/:id/mute", deps.MessageHandler.MuteConversation)
+		messages.DELETE("/conversations/:id/mute", deps.MessageHandler.UnmuteConversation)
+		messages.DELETE("/conversations/:id", deps.MessageHandler.DeleteConversation)
+	}
+}
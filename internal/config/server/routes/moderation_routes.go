@@ -0,0 +1,38 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+	"time"
+)
+
+func ModerationRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+	adminMiddleware := middleware.AdminMiddleware(deps.UserRepository, deps.Logger)
+
+	reports := rg.Group("/reports", authMiddleware)
+	{
+		reports.POST("",
+			middleware.RateLimitMiddleware(time.Hour, 20, deps.Logger),
+			deps.ModerationHandler.CreateReport)
+		reports.GET("/mine", deps.ModerationHandler.GetMyReports)
+	}
+
+	moderation := rg.Group("/moderation", authMiddleware, adminMiddleware)
+	{
+		moderation.GET("/reports", deps.ModerationHandler.GetReports)
+		moderation.GET("/reports/:id", deps.ModerationHandler.GetReport)
+		moderation.POST("/reports/:id/claim", deps.ModerationHandler.ClaimReport)
+		moderation.POST("/reports/:id/release", deps.ModerationHandler.ReleaseReport)
+		moderation.POST("/reports/:id/reopen", deps.ModerationHandler.ReopenReport)
+		moderation.POST("/reports/:id/dismiss", deps.ModerationHandler.DismissReport)
+		moderation.POST("/reports/:id/action", deps.ModerationHandler.ActionReport)
+
+		moderation.POST("/content/:type/:id/hide", deps.ModerationHandler.HideContent)
+		moderation.POST("/content/:type/:id/restore", deps.ModerationHandler.RestoreContent)
+
+		moderation.GET("/users/:id/strikes", deps.ModerationHandler.GetStrikes)
+		moderation.POST("/users/:id/strikes", deps.ModerationHandler.IssueStrike)
+		moderation.POST("/strikes/:id/revoke", deps.ModerationHandler.RevokeStrike)
+	}
+}
@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+)
+
+func NetworkRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+
+	network := rg.Group("/network", authMiddleware)
+	{
+		network.GET("/hiring", deps.JobHandler.GetNetworkHiring)
+	}
+}
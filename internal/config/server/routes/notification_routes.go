@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+)
+
+func NotificationRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+
+	notifications := rg.Group("/notifications", authMiddleware)
+	{
+		notifications.GET("", deps.NotificationHandler.GetNotifications)
+		notifications.GET("/unread-count", deps.NotificationHandler.GetUnreadCount)
+		notifications.POST("/read-all", deps.NotificationHandler.MarkAllAsRead)
+		notifications.GET("/settings", deps.NotificationHandler.GetSettings)
+		notifications.PUT("/settings", deps.NotificationHandler.UpdateSettings)
+		notifications.POST("/:id/read", deps.NotificationHandler.MarkAsRead)
+	}
+}
@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+)
+
+func PolicyRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+	adminMiddleware := middleware.AdminMiddleware(deps.UserRepository, deps.Logger)
+
+	policies := rg.Group("/policies")
+	{
+		policies.GET("/current", deps.PolicyHandler.GetCurrentPolicies)
+		policies.GET("/status", authMiddleware, deps.PolicyHandler.GetStatus)
+		policies.POST("/accept", authMiddleware, deps.PolicyHandler.AcceptPolicies)
+	}
+
+	admin := rg.Group("/admin/policies", authMiddleware, adminMiddleware)
+	{
+		admin.POST("", deps.PolicyHandler.PublishVersion)
+		admin.GET("/:type", deps.PolicyHandler.GetVersions)
+	}
+}
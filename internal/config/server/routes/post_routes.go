@@ -7,29 +7,46 @@ import (
 
 func PostRoutes(rg *gin.RouterGroup, deps *Dependencies) {
 	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+	optionalAuthMiddleware := middleware.OptionalAuthMiddleware(deps.JWTService)
 
 	posts := rg.Group("/posts")
 	{
 
-		posts.GET("/:id", deps.PostHandler.GetPost)
+		posts.GET("/:id", optionalAuthMiddleware, deps.PostHandler.GetPost)
 		posts.GET("/user/:user_id", deps.PostHandler.GetUserPosts)
 		posts.GET("/:id/comments", deps.PostHandler.GetComments)
-		posts.GET("/:id/likes", deps.PostHandler.GetPostLikes)
+		posts.GET("/:id/reactions", deps.PostHandler.GetPostReactions)
+		posts.GET("/comments/:commentId/replies", deps.PostHandler.GetCommentReplies)
 
 		posts.GET("", authMiddleware, deps.PostHandler.GetFeed)
 		posts.PUT("/:id", authMiddleware, deps.PostHandler.UpdatePost)
 		posts.DELETE("/:id", authMiddleware, deps.PostHandler.DeletePost)
 
-		posts.POST("/:id/like", authMiddleware, deps.PostHandler.LikePost)
-		posts.DELETE("/:id/like", authMiddleware, deps.PostHandler.UnlikePost)
+		posts.POST("/:id/share", authMiddleware, deps.PostHandler.SharePost)
+
+		posts.PUT("/:id/reactions", authMiddleware, deps.PostHandler.ReactToPost)
+		posts.DELETE("/:id/reactions", authMiddleware, deps.PostHandler.RemoveReaction)
+
+		posts.POST("/:id/feedback", authMiddleware, deps.PostHandler.GiveFeedback)
+		posts.DELETE("/:id/feedback", authMiddleware, deps.PostHandler.RemoveFeedback)
 
 		posts.POST("/:id/comments", authMiddleware, deps.PostHandler.AddComment)
 		posts.PUT("/comments/:commentId", authMiddleware, deps.PostHandler.UpdateComment)
 		posts.DELETE("/comments/:commentId", authMiddleware, deps.PostHandler.DeleteComment)
+		posts.POST("/comments/:commentId/replies", authMiddleware, deps.PostHandler.ReplyToComment)
+		posts.PUT("/comments/:commentId/reactions", authMiddleware, deps.PostHandler.ReactToComment)
+		posts.DELETE("/comments/:commentId/reactions", authMiddleware, deps.PostHandler.RemoveCommentReaction)
+
+		posts.POST("/life-events", authMiddleware, deps.PostHandler.CreateLifeEventPost)
+		posts.GET("/suggestions", authMiddleware, deps.PostHandler.GetPostSuggestions)
+		posts.POST("/suggestions/:id/publish", authMiddleware, deps.PostHandler.PublishSuggestion)
+		posts.DELETE("/suggestions/:id", authMiddleware, deps.PostHandler.DismissSuggestion)
 
+		routeTimeout(deps, posts, "POST", "", deps.Server.UploadRequestTimeout)
 		posts.POST("",
 			authMiddleware,
 			middleware.FileUploadMiddleware(10<<20, []string{".jpg", ".jpeg", ".png", ".gif", ".webp"}),
+			middleware.UploadFeatureMiddleware(deps.FeatureFlags, deps.Logger),
 			deps.PostHandler.CreatePost,
 		)
 	}
@@ -0,0 +1,83 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"linked-clone/internal/config"
+	"linked-clone/pkg/featureflag"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/redis"
+	email "linked-clone/pkg/smtp"
+	"linked-clone/pkg/storage"
+)
+
+type dependencyProbe struct {
+	name     string
+	features []featureflag.Feature
+	check    func(ctx context.Context) error
+}
+
+func probeDependencies(cfg *config.Config, storageService storage.StorageService, redisClient redis.RedisClient, emailService email.EmailService, flags *featureflag.Flags, logger logger.Logger) error {
+	probes := []dependencyProbe{
+		{
+			name:     "s3",
+			features: []featureflag.Feature{featureflag.FeatureUploads},
+			check: func(ctx context.Context) error {
+				return runWithContext(ctx, storageService.TestConnection)
+			},
+		},
+		{
+			name:     "redis",
+			features: []featureflag.Feature{featureflag.FeatureCache},
+			check:    redisClient.Ping,
+		},
+		{
+			name:     "smtp",
+			features: []featureflag.Feature{featureflag.FeatureEmail},
+			check: func(ctx context.Context) error {
+				return emailService.TestConnection(cfg.Startup.ProbeTimeout)
+			},
+		},
+	}
+
+	required := make(map[string]bool, len(cfg.Startup.RequiredDependencies))
+	for _, name := range cfg.Startup.RequiredDependencies {
+		required[name] = true
+	}
+
+	for _, probe := range probes {
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.Startup.ProbeTimeout)
+		err := probe.check(ctx)
+		cancel()
+
+		if err == nil {
+			logger.Info("Dependency probe succeeded", "dependency", probe.name)
+			continue
+		}
+
+		if required[probe.name] {
+			return fmt.Errorf("required dependency %s is unavailable: %w", probe.name, err)
+		}
+
+		logger.Warn("Dependency probe failed, starting in degraded mode", "dependency", probe.name, "error", err)
+		for _, feature := range probe.features {
+			flags.Disable(feature, probe.name+" unavailable at startup")
+		}
+	}
+
+	return nil
+}
+
+func runWithContext(ctx context.Context, fn func() error) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- fn()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,9 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+func RealtimeRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	rg.GET("/ws", deps.WebSocketHandler.Connect)
+}
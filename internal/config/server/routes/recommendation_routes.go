@@ -0,0 +1,29 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+)
+
+func RecommendationRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+
+	recommendations := rg.Group("/recommendations")
+	{
+		recommendations.GET("/users/:userId", deps.RecommendationHandler.GetUserRecommendations)
+
+		recommendations.POST("", authMiddleware, deps.RecommendationHandler.WriteRecommendation)
+		recommendations.POST("/requests", authMiddleware, deps.RecommendationHandler.RequestRecommendation)
+		recommendations.GET("/received", authMiddleware, deps.RecommendationHandler.GetReceived)
+		recommendations.GET("/given", authMiddleware, deps.RecommendationHandler.GetGiven)
+		recommendations.PUT("/order", authMiddleware, deps.RecommendationHandler.Reorder)
+
+		recommendations.GET("/:id", authMiddleware, deps.RecommendationHandler.GetRecommendation)
+		recommendations.PUT("/:id", authMiddleware, deps.RecommendationHandler.UpdateRecommendation)
+		recommendations.DELETE("/:id", authMiddleware, deps.RecommendationHandler.DeleteRecommendation)
+		recommendations.POST("/:id/approve", authMiddleware, deps.RecommendationHandler.Approve)
+		recommendations.POST("/:id/decline", authMiddleware, deps.RecommendationHandler.Decline)
+		recommendations.POST("/:id/revision", authMiddleware, deps.RecommendationHandler.RequestRevision)
+		recommendations.PUT("/:id/visibility", authMiddleware, deps.RecommendationHandler.SetVisibility)
+	}
+}
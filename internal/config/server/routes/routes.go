@@ -2,18 +2,18 @@ package routes
 
 import (
 	"github.com/gin-gonic/gin"
-	"gorm.io/gorm"
-	"linked-clone/internal/config"
-	"linked-clone/pkg/logger"
+	"linked-clone/internal/middleware"
 )
 
-func SetupRoutes(router *gin.Engine, cfg *config.Config, db *gorm.DB, logger logger.StructuredLogger) error {
-	deps, err := InitializeDependencies(cfg, db, logger)
-	if err != nil {
-		return err
-	}
-
-	v1 := router.Group("/api/v1")
+func SetupRoutes(router *gin.Engine, deps *Dependencies) error {
+	v1 := router.Group("/api/v1",
+		middleware.RouteMetricsMiddleware(deps.HTTPMetrics),
+		middleware.LocaleMiddleware(deps.Locales),
+		middleware.PolicyAcceptanceMiddleware(deps.JWTService, deps.PolicyRepository, deps.Logger),
+		middleware.PresenceMiddleware(deps.PresenceTracker, deps.FeatureFlags, deps.Logger),
+		middleware.RequestCaptureMiddleware(deps.RequestCapture, deps.FeatureFlags, deps.Logger),
+		middleware.ChaosMiddleware(deps.Chaos, deps.Logger),
+	)
 	{
 
 		AuthRoutes(v1, deps)
@@ -22,8 +22,62 @@ func SetupRoutes(router *gin.Engine, cfg *config.Config, db *gorm.DB, logger log
 
 		PostRoutes(v1, deps)
 
+		HashtagRoutes(v1, deps)
+
 		JobRoutes(v1, deps)
 
+		CompanyRoutes(v1, deps)
+
+		IdentityRoutes(v1, deps)
+
+		NotificationRoutes(v1, deps)
+
+		MessageRoutes(v1, deps)
+
+		RealtimeRoutes(v1, deps)
+
+		AnalyticsRoutes(v1, deps)
+
+		PolicyRoutes(v1, deps)
+
+		AccountRoutes(v1, deps)
+
+		NetworkRoutes(v1, deps)
+
+		SearchRoutes(v1, deps)
+
+		EmailRoutes(v1, deps)
+
+		ClusterRoutes(v1, deps)
+
+		ExperimentRoutes(v1, deps)
+
+		AssessmentRoutes(v1, deps)
+
+		LearningRoutes(v1, deps)
+
+		MentorshipRoutes(v1, deps)
+		RecommendationRoutes(v1, deps)
+		TaxonomyRoutes(v1, deps)
+		LocaleRoutes(v1, deps)
+		BookmarkRoutes(v1, deps)
+
+		MediaRoutes(v1, deps)
+
+		UploadRoutes(v1, deps)
+
+		SecurityRoutes(v1, deps)
+
+		SSORoutes(v1, deps)
+
+		APIKeyRoutes(v1, deps)
+
+		InviteRoutes(v1, deps)
+
+		AdminRoutes(v1, deps)
+
+		ModerationRoutes(v1, deps)
+
 	}
 
 	return nil
@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+)
+
+func SearchRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+
+	search := rg.Group("/search", authMiddleware)
+	{
+		search.GET("", deps.SearchHandler.Search)
+		search.GET("/recent", deps.SearchHandler.GetRecentSearches)
+		search.DELETE("/recent", deps.SearchHandler.ClearRecentSearches)
+		search.DELETE("/recent/:id", deps.SearchHandler.DeleteRecentSearch)
+		search.GET("/settings", deps.SearchHandler.GetSettings)
+		search.PUT("/settings", deps.SearchHandler.UpdateSettings)
+	}
+}
@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+	"time"
+)
+
+func SecurityRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+
+	security := rg.Group("/security", authMiddleware)
+	{
+		security.GET("/activity",
+			middleware.RateLimitMiddleware(time.Minute, 30, deps.Logger),
+			deps.SecurityHandler.GetActivity)
+
+		security.POST("/activity/:id/not-me",
+			middleware.RateLimitMiddleware(time.Minute, 3, deps.Logger),
+			deps.SecurityHandler.ReportNotMe)
+	}
+}
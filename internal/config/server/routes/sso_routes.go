@@ -0,0 +1,62 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+	"linked-clone/pkg/featureflag"
+	"time"
+)
+
+func SSORoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+
+	config := rg.Group("/companies/:id/sso", authMiddleware)
+	{
+		config.GET("", deps.SSOHandler.GetConfig)
+		config.PUT("",
+			middleware.RateLimitMiddleware(time.Minute, 10, deps.Logger),
+			deps.SSOHandler.UpsertConfig)
+		config.DELETE("", deps.SSOHandler.DeleteConfig)
+		config.GET("/domain", deps.SSOHandler.GetDomainVerification)
+		config.POST("/domain/verify",
+			middleware.RateLimitMiddleware(time.Minute, 10, deps.Logger),
+			deps.SSOHandler.VerifyDomain)
+	}
+
+	// SSO sign-in waits on the identity provider, so it keeps the default
+	// timeout rather than the short one for the rest of /auth.
+	sso := rg.Group("/auth/sso")
+	groupTimeout(deps, sso, deps.Server.RequestTimeout)
+	{
+		sso.GET("/discover",
+			middleware.RateLimitMiddleware(time.Minute, 20, deps.Logger),
+			deps.SSOHandler.Discover)
+
+		sso.GET("/start/:id",
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
+			middleware.RateLimitMiddleware(time.Minute, 20, deps.Logger),
+			deps.SSOHandler.Start)
+
+		sso.GET("/oidc/callback",
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
+			middleware.RateLimitMiddleware(time.Minute, 20, deps.Logger),
+			deps.SSOHandler.OIDCCallback)
+
+		sso.POST("/saml/acs",
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
+			middleware.RateLimitMiddleware(time.Minute, 20, deps.Logger),
+			deps.SSOHandler.SAMLACS)
+
+		sso.GET("/saml/metadata", deps.SSOHandler.SAMLMetadata)
+
+		sso.POST("/exchange",
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
+			middleware.RateLimitMiddleware(time.Minute, 10, deps.Logger),
+			deps.SSOHandler.Exchange)
+
+		sso.POST("/link",
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
+			middleware.RateLimitMiddleware(time.Minute, 10, deps.Logger),
+			deps.SSOHandler.ConfirmLink)
+	}
+}
@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+)
+
+func TaxonomyRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+	adminMiddleware := middleware.AdminMiddleware(deps.UserRepository, deps.Logger)
+
+	taxonomy := rg.Group("/taxonomy")
+	{
+		taxonomy.GET("/industries", deps.TaxonomyHandler.SearchIndustries)
+		taxonomy.GET("/locations", deps.TaxonomyHandler.SearchLocations)
+	}
+
+	admin := rg.Group("/admin/taxonomy", authMiddleware, adminMiddleware)
+	{
+		admin.POST("/industries", deps.TaxonomyHandler.CreateIndustry)
+		admin.POST("/locations", deps.TaxonomyHandler.CreateLocation)
+	}
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// groupTimeout gives every route under group its own request timeout in place
+// of REQUEST_TIMEOUT_SECONDS.
+func groupTimeout(deps *Dependencies, group *gin.RouterGroup, timeout time.Duration) {
+	deps.RouteTimeouts.SetPrefix(group.BasePath(), timeout)
+}
+
+// routeTimeout gives one route its own request timeout. Call it next to the
+// route's registration so the two stay together.
+func routeTimeout(deps *Dependencies, group *gin.RouterGroup, method, relativePath string, timeout time.Duration) {
+	deps.RouteTimeouts.SetRoute(method, group.BasePath()+relativePath, timeout)
+}
@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"linked-clone/internal/middleware"
+	"time"
+)
+
+func UploadRoutes(rg *gin.RouterGroup, deps *Dependencies) {
+	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+
+	uploads := rg.Group("/uploads", authMiddleware)
+	{
+		uploads.GET("/:id/status",
+			middleware.RateLimitMiddleware(time.Minute, 120, deps.Logger),
+			deps.UploadHandler.GetStatus)
+	}
+}
@@ -2,25 +2,79 @@ package routes
 
 import (
 	"github.com/gin-gonic/gin"
+	"linked-clone/internal/domain/entities"
 	"linked-clone/internal/middleware"
+	"linked-clone/pkg/featureflag"
+	"time"
 )
 
 func UserRoutes(rg *gin.RouterGroup, deps *Dependencies) {
 	authMiddleware := middleware.AuthMiddleware(deps.JWTService, deps.Logger)
+	optionalAuthMiddleware := middleware.OptionalAuthMiddleware(deps.JWTService)
 
 	users := rg.Group("/users")
 	{
 
-		users.GET("/search", deps.UserHandler.SearchUsers)
-		users.GET("/:id", deps.UserHandler.GetUserByID)
+		users.GET("/search", optionalAuthMiddleware, deps.UserHandler.SearchUsers)
+		users.GET("/talent",
+			authMiddleware,
+			middleware.EntitlementMiddleware(deps.UserRepository, entities.EntitlementTalentSearch, deps.Logger),
+			middleware.RateLimitMiddleware(time.Minute, 30, deps.Logger),
+			deps.UserHandler.SearchTalent,
+		)
+		users.GET("/:id", optionalAuthMiddleware, deps.UserHandler.GetUserByID)
+		users.GET("/:id/experiences", deps.UserHandler.GetUserExperiences)
+		users.GET("/:id/sections", deps.UserHandler.GetUserProfileSections)
+
+		users.POST("/email/change",
+			authMiddleware,
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureEmail, deps.Logger),
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
+			middleware.RateLimitMiddleware(time.Minute, 3, deps.Logger),
+			deps.AuthHandler.RequestEmailChange,
+		)
+		users.POST("/email/change/confirm",
+			authMiddleware,
+			middleware.FeatureMiddleware(deps.FeatureFlags, featureflag.FeatureCache, deps.Logger),
+			middleware.RateLimitMiddleware(time.Minute, 5, deps.Logger),
+			deps.AuthHandler.ConfirmEmailChange,
+		)
 
+		users.GET("/me/usage", authMiddleware, deps.UserHandler.GetUsage)
 		users.GET("/profile", authMiddleware, deps.UserHandler.GetProfile)
 		users.PUT("/profile", authMiddleware, deps.UserHandler.UpdateProfile)
+		routeTimeout(deps, users, "POST", "/profile/picture", deps.Server.UploadRequestTimeout)
 		users.POST("/profile/picture",
 			authMiddleware,
 			middleware.FileUploadMiddleware(5<<20, []string{".jpg", ".jpeg", ".png", ".gif", ".webp"}),
+			middleware.UploadFeatureMiddleware(deps.FeatureFlags, deps.Logger),
 			deps.UserHandler.UploadProfilePicture,
 		)
+		routeTimeout(deps, users, "POST", "/profile/banner", deps.Server.UploadRequestTimeout)
+		users.POST("/profile/banner",
+			authMiddleware,
+			middleware.FileUploadMiddleware(8<<20, []string{".jpg", ".jpeg", ".png", ".gif"}),
+			middleware.UploadFeatureMiddleware(deps.FeatureFlags, deps.Logger),
+			deps.UserHandler.UploadProfileBanner,
+		)
+		users.DELETE("/profile/banner", authMiddleware, deps.UserHandler.DeleteProfileBanner)
+
+		experiences := users.Group("/profile/experiences", authMiddleware)
+		{
+			experiences.GET("", deps.UserHandler.GetExperiences)
+			experiences.POST("", deps.UserHandler.AddExperience)
+			experiences.PUT("/:id", deps.UserHandler.UpdateExperience)
+			experiences.DELETE("/:id", deps.UserHandler.DeleteExperience)
+		}
+
+		sections := users.Group("/profile/sections", authMiddleware)
+		{
+			sections.GET("", deps.UserHandler.GetProfileSections)
+			sections.POST("", deps.UserHandler.AddProfileSection)
+			sections.PUT("/order", deps.UserHandler.UpdateSectionOrder)
+			sections.PUT("/:id", deps.UserHandler.UpdateProfileSection)
+			sections.DELETE("/:id", deps.UserHandler.DeleteProfileSection)
+		}
 
 		connections := users.Group("/connections", authMiddleware)
 		{
@@ -37,9 +91,33 @@ func UserRoutes(rg *gin.RouterGroup, deps *Dependencies) {
 
 			connections.GET("/status/:userId", deps.ConnectionHandler.GetConnectionStatus)
 			connections.GET("/mutual/:userId", deps.ConnectionHandler.GetMutualConnections)
+			connections.GET("/degree/:userId", deps.ConnectionHandler.GetConnectionDegree)
+
+			connections.GET("/suggestions", deps.ConnectionHandler.GetSuggestions)
+			connections.DELETE("/suggestions/:userId", deps.ConnectionHandler.DismissSuggestion)
+
+			routeTimeout(deps, connections, "GET", "/export", deps.Server.ExportRequestTimeout)
+			connections.GET("/export", deps.ConnectionHandler.ExportConnections)
+			routeTimeout(deps, connections, "POST", "/import", deps.Server.UploadRequestTimeout)
+			connections.POST("/import",
+				middleware.FileUploadMiddleware(10<<20, []string{".csv", ".zip"}),
+				deps.ConnectionHandler.ImportConnections,
+			)
+			connections.GET("/import/:id", deps.ConnectionHandler.GetImport)
 
 			connections.POST("/block/:userId", deps.ConnectionHandler.BlockUser)
 			connections.DELETE("/block/:userId", deps.ConnectionHandler.UnblockUser)
 		}
 	}
+
+	connectionRequests := rg.Group("/connections/requests", authMiddleware)
+	{
+		connectionRequests.POST("/bulk", deps.ConnectionHandler.BulkRespondToRequests)
+	}
+
+	connectionSuggestions := rg.Group("/connections/suggestions", authMiddleware)
+	{
+		connectionSuggestions.GET("", deps.ConnectionHandler.GetSuggestions)
+		connectionSuggestions.DELETE("/:userId", deps.ConnectionHandler.DismissSuggestion)
+	}
 }
@@ -6,6 +6,7 @@ import (
 	"linked-clone/internal/background"
 	"linked-clone/internal/config"
 	"linked-clone/internal/config/server/routes"
+	"linked-clone/internal/infrastructure/database"
 	"linked-clone/pkg/logger"
 	"net/http"
 	"time"
@@ -19,22 +20,108 @@ type Server struct {
 	httpServer            *http.Server
 	logger                logger.StructuredLogger
 	sessionCleanupService *background.SessionCleanupService
+	unreadReconciliation  *background.UnreadReconciliationService
+	partitionMaintenance  *background.PartitionMaintenanceService
+	dataRetention         *background.DataRetentionService
+	viewRollup            *background.ViewRollupService
+	companyAnalytics      *background.CompanyAnalyticsRollupService
+	jobDeadline           *background.JobDeadlineService
+	accountPurge          *background.AccountPurgeService
+	lifeEventReminders    *background.LifeEventReminderService
+	connectionSuggestions *background.ConnectionSuggestionService
+	mentorshipMatching    *background.MentorshipMatchingService
+	jobAlertDigest        *background.JobAlertDigestService
+	dataExport            *background.DataExportService
+	presenceFlush         *background.PresenceFlushService
+	emailDispatch         *background.EmailDispatchService
+	instanceHeartbeat     *background.InstanceHeartbeatService
+	realtimeRelay         *background.RealtimeRelayService
+	uploadPipeline        *background.UploadPipelineService
 }
 
 func NewServer(cfg *config.Config, db *gorm.DB, logger logger.StructuredLogger) (*Server, error) {
 
-	router := config.NewGinEngine(cfg, logger)
-
 	deps, err := routes.InitializeDependencies(cfg, db, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize dependencies: %w", err)
 	}
 
-	if err := routes.SetupRoutes(router, cfg, db, logger); err != nil {
+	backgroundRegistry := background.NewRegistry()
+	router := config.NewGinEngine(cfg, logger, backgroundRegistry, deps.HTTPMetrics, deps.RouteTimeouts, deps.FeatureFlags)
+
+	if err := routes.SetupRoutes(router, deps); err != nil {
 		return nil, fmt.Errorf("failed to setup routes: %w", err)
 	}
 
-	sessionCleanupService := background.NewSessionCleanupService(deps.JWTService, logger)
+	sessionCleanupService := background.NewSessionCleanupService(deps.JWTService, cfg.Background.SessionCleanupInterval, logger)
+	unreadReconciliation := background.NewUnreadReconciliationService(deps.NotificationRepository, deps.MessageRepository, deps.UnreadCounter, cfg.Background.UnreadReconciliationHour, logger)
+	partitionMaintenance := background.NewPartitionMaintenanceService(database.NewPartitionManager(db), database.PartitionedTables, cfg.Background.PartitionMonthsAhead, deps.Coordinator, logger)
+	dataRetention := background.NewDataRetentionService(deps.NotificationRepository, deps.AnalyticsRepository, database.NewPartitionManager(db), background.RetentionConfig{
+		Mode:             cfg.Retention.Mode,
+		BatchSize:        cfg.Retention.BatchSize,
+		Interval:         cfg.Retention.Interval,
+		NotificationDays: cfg.Retention.NotificationDays,
+		AnalyticsDays:    cfg.Retention.AnalyticsDays,
+	}, deps.Coordinator, logger)
+	viewRollup := background.NewViewRollupService(deps.AnalyticsRepository, deps.ViewCounter, cfg.Background.ViewRollupInterval, logger)
+	companyAnalytics := background.NewCompanyAnalyticsRollupService(deps.CompanyAnalyticsRepository, background.CompanyAnalyticsRollupConfig{
+		Interval:     cfg.Background.CompanyAnalyticsInterval,
+		LookbackDays: cfg.Background.CompanyAnalyticsLookbackDays,
+	}, deps.Coordinator, logger)
+	jobDeadline := background.NewJobDeadlineService(deps.JobRepository, cfg.Background.JobDeadlineInterval, deps.Coordinator, logger)
+	accountPurge := background.NewAccountPurgeService(deps.AccountDeletionRepository, deps.StorageService, background.AccountPurgeConfig{
+		Interval:    cfg.Background.AccountPurgeInterval,
+		BatchSize:   cfg.Background.AccountPurgeBatchSize,
+		MaxAttempts: cfg.Background.AccountPurgeMaxAttempts,
+	}, deps.Coordinator, logger)
+	lifeEventReminders := background.NewLifeEventReminderService(deps.ReminderRunRepository, deps.ExperienceRepository, deps.UserRepository, deps.ConnectionRepository, deps.NotificationService, cfg.Background.LifeEventReminderInterval, deps.Coordinator, logger)
+	connectionSuggestions := background.NewConnectionSuggestionService(deps.ConnectionSuggestionRepository, background.ConnectionSuggestionConfig{
+		RefreshInterval: cfg.Background.ConnectionSuggestionRefresh,
+		PerUserLimit:    cfg.Background.ConnectionSuggestionPerUser,
+	}, deps.Coordinator, logger)
+	mentorshipMatching := background.NewMentorshipMatchingService(deps.MentorshipService, background.MentorshipMatchingConfig{
+		CheckInInterval: cfg.Background.MentorshipCheckInInterval,
+		MatchInterval:   cfg.Background.MentorshipMatchInterval,
+	}, deps.Coordinator, logger)
+	jobAlertDigest := background.NewJobAlertDigestService(deps.JobAlertService, cfg.Background.JobAlertInterval, deps.Coordinator, logger)
+	dataExport := background.NewDataExportService(deps.DataExportRepository, deps.ExportStore, deps.ExportConfig, background.DataExportSchedule{
+		Interval:     cfg.Background.ExportInterval,
+		LookbackDays: cfg.Background.ExportLookbackDays,
+	}, deps.Coordinator, logger)
+	presenceFlush := background.NewPresenceFlushService(deps.PresenceTracker, deps.UserRepository, cfg.Background.PresenceFlushInterval, logger)
+	emailDispatch := background.NewEmailDispatchService(deps.OutboundEmailRepository, deps.EmailService, background.EmailDispatchConfig{
+		Interval:    cfg.Background.EmailDispatchInterval,
+		BatchSize:   cfg.Background.EmailDispatchBatchSize,
+		MaxAttempts: cfg.Background.EmailDispatchMaxAttempts,
+	}, logger)
+	instanceHeartbeat := background.NewInstanceHeartbeatService(deps.Coordinator, cfg.Background.HeartbeatInterval, logger)
+	realtimeRelay := background.NewRealtimeRelayService(deps.RealtimeRelay, cfg.Background.RouteRefreshInterval, logger)
+	uploadPipeline := background.NewUploadPipelineService(deps.UploadService, background.UploadPipelineConfig{
+		Workers:     cfg.Background.UploadWorkers,
+		StaleAfter:  cfg.Background.UploadStaleAfter,
+		TaskTimeout: cfg.Background.UploadTaskTimeout,
+	}, deps.Coordinator, logger)
+
+	backgroundRegistry.Register("session_cleanup", sessionCleanupService)
+	backgroundRegistry.Register("unread_reconciliation", unreadReconciliation)
+	backgroundRegistry.Register("partition_maintenance", partitionMaintenance)
+	backgroundRegistry.Register("data_retention", dataRetention)
+	backgroundRegistry.Register("view_rollup", viewRollup)
+	backgroundRegistry.Register("company_analytics_rollup", companyAnalytics)
+	backgroundRegistry.Register("job_deadline", jobDeadline)
+	backgroundRegistry.Register("account_purge", accountPurge)
+	backgroundRegistry.Register("life_event_reminders", lifeEventReminders)
+	backgroundRegistry.Register("connection_suggestions", connectionSuggestions)
+	backgroundRegistry.Register("mentorship_matching", mentorshipMatching)
+	backgroundRegistry.Register("job_alert_digest", jobAlertDigest)
+	backgroundRegistry.Register("data_export", dataExport)
+	backgroundRegistry.Register("presence_flush", presenceFlush)
+	backgroundRegistry.Register("email_dispatch", emailDispatch)
+	backgroundRegistry.Register("instance_heartbeat", instanceHeartbeat)
+	backgroundRegistry.Register("realtime_relay", realtimeRelay)
+	backgroundRegistry.Register("upload_pipeline", uploadPipeline)
+	backgroundRegistry.RegisterQueue("realtime_events", deps.RealtimeHub)
+	backgroundRegistry.RegisterQueue("uploads", deps.UploadService)
 
 	httpServer := &http.Server{
 		Addr:         ":" + cfg.Server.Port,
@@ -48,13 +135,47 @@ func NewServer(cfg *config.Config, db *gorm.DB, logger logger.StructuredLogger)
 		httpServer:            httpServer,
 		logger:                logger,
 		sessionCleanupService: sessionCleanupService,
+		unreadReconciliation:  unreadReconciliation,
+		partitionMaintenance:  partitionMaintenance,
+		dataRetention:         dataRetention,
+		viewRollup:            viewRollup,
+		companyAnalytics:      companyAnalytics,
+		jobDeadline:           jobDeadline,
+		accountPurge:          accountPurge,
+		lifeEventReminders:    lifeEventReminders,
+		connectionSuggestions: connectionSuggestions,
+		mentorshipMatching:    mentorshipMatching,
+		jobAlertDigest:        jobAlertDigest,
+		dataExport:            dataExport,
+		presenceFlush:         presenceFlush,
+		emailDispatch:         emailDispatch,
+		instanceHeartbeat:     instanceHeartbeat,
+		realtimeRelay:         realtimeRelay,
+		uploadPipeline:        uploadPipeline,
 	}, nil
 }
 
 func (s *Server) Start() error {
 
 	ctx := context.Background()
+	s.instanceHeartbeat.Start(ctx)
 	s.sessionCleanupService.Start(ctx)
+	s.unreadReconciliation.Start(ctx)
+	s.partitionMaintenance.Start(ctx)
+	s.dataRetention.Start(ctx)
+	s.viewRollup.Start(ctx)
+	s.companyAnalytics.Start(ctx)
+	s.jobDeadline.Start(ctx)
+	s.accountPurge.Start(ctx)
+	s.lifeEventReminders.Start(ctx)
+	s.connectionSuggestions.Start(ctx)
+	s.mentorshipMatching.Start(ctx)
+	s.jobAlertDigest.Start(ctx)
+	s.dataExport.Start(ctx)
+	s.presenceFlush.Start(ctx)
+	s.emailDispatch.Start(ctx)
+	s.realtimeRelay.Start(ctx)
+	s.uploadPipeline.Start(ctx)
 
 	s.logger.Info("Starting HTTP server", "addr", s.httpServer.Addr)
 	s.logger.Info("Session cleanup service started")
@@ -67,6 +188,24 @@ func (s *Server) Shutdown() error {
 	s.sessionCleanupService.Stop()
 	s.logger.Info("Session cleanup service stopped")
 
+	s.unreadReconciliation.Stop()
+	s.partitionMaintenance.Stop()
+	s.dataRetention.Stop()
+	s.viewRollup.Stop()
+	s.companyAnalytics.Stop()
+	s.jobDeadline.Stop()
+	s.accountPurge.Stop()
+	s.lifeEventReminders.Stop()
+	s.connectionSuggestions.Stop()
+	s.mentorshipMatching.Stop()
+	s.jobAlertDigest.Stop()
+	s.dataExport.Stop()
+	s.presenceFlush.Stop()
+	s.emailDispatch.Stop()
+	s.realtimeRelay.Stop()
+	s.uploadPipeline.Stop()
+	s.instanceHeartbeat.Stop()
+
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
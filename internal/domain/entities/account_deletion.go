@@ -0,0 +1,88 @@
+package entities
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+type AccountDeletionStatus string
+type PurgeStage string
+
+const (
+	AccountDeletionScheduled  AccountDeletionStatus = "scheduled"
+	AccountDeletionCancelled  AccountDeletionStatus = "cancelled"
+	AccountDeletionProcessing AccountDeletionStatus = "processing"
+	AccountDeletionCompleted  AccountDeletionStatus = "completed"
+	AccountDeletionFailed     AccountDeletionStatus = "failed"
+
+	PurgeStageContent   PurgeStage = "content"
+	PurgeStageAuditLogs PurgeStage = "audit_logs"
+	PurgeStageStorage   PurgeStage = "storage"
+	PurgeStageTombstone PurgeStage = "tombstone"
+	PurgeStageVerify    PurgeStage = "verify"
+)
+
+var PurgeStages = []PurgeStage{
+	PurgeStageContent,
+	PurgeStageAuditLogs,
+	PurgeStageStorage,
+	PurgeStageTombstone,
+	PurgeStageVerify,
+}
+
+type PurgeStageResult struct {
+	Stage       PurgeStage       `json:"stage"`
+	Affected    map[string]int64 `json:"affected"`
+	CompletedAt time.Time        `json:"completed_at"`
+}
+
+type PurgeReport struct {
+	Stages   []PurgeStageResult `json:"stages"`
+	Residual map[string]int64   `json:"residual,omitempty"`
+	Verified bool               `json:"verified"`
+	Digest   string             `json:"digest,omitempty"`
+}
+
+func (r PurgeReport) ComputeDigest(userID uint) string {
+	r.Digest = ""
+	payload, _ := json.Marshal(r)
+	sum := sha256.Sum256(append([]byte(strconv.FormatUint(uint64(userID), 10)+":"), payload...))
+	return hex.EncodeToString(sum[:])
+}
+
+type AccountDeletion struct {
+	ID             uint                  `gorm:"primaryKey" json:"id"`
+	UserID         uint                  `gorm:"not null;uniqueIndex" json:"user_id"`
+	Status         AccountDeletionStatus `gorm:"not null;index" json:"status"`
+	Reason         string                `gorm:"type:text" json:"reason,omitempty"`
+	RequestedAt    time.Time             `gorm:"not null" json:"requested_at"`
+	PurgeAfter     time.Time             `gorm:"not null;index" json:"purge_after"`
+	CancelledBy    *uint                 `json:"cancelled_by,omitempty"`
+	CancelledAt    *time.Time            `json:"cancelled_at,omitempty"`
+	StartedAt      *time.Time            `json:"started_at,omitempty"`
+	CompletedAt    *time.Time            `json:"completed_at,omitempty"`
+	CompletedStage PurgeStage            `json:"completed_stage,omitempty"`
+	Attempts       int                   `gorm:"default:0" json:"attempts"`
+	LastError      string                `gorm:"type:text" json:"last_error,omitempty"`
+	Report         PurgeReport           `gorm:"type:jsonb;serializer:json;default:'{}'" json:"report"`
+	CreatedAt      time.Time             `json:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+}
+
+func (d *AccountDeletion) StageCompleted(stage PurgeStage) bool {
+	if d.CompletedStage == "" {
+		return false
+	}
+	for _, s := range PurgeStages {
+		if s == stage {
+			return true
+		}
+		if s == d.CompletedStage {
+			return false
+		}
+	}
+	return false
+}
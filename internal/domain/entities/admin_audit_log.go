@@ -0,0 +1,56 @@
+package entities
+
+import "time"
+
+type AdminAction string
+
+const (
+	AdminActionUsersSearched       AdminAction = "users_searched"
+	AdminActionUserViewed          AdminAction = "user_viewed"
+	AdminActionUserSuspended       AdminAction = "user_suspended"
+	AdminActionUserUnsuspended     AdminAction = "user_unsuspended"
+	AdminActionPasswordResetForced AdminAction = "password_reset_forced"
+	AdminActionReportActioned      AdminAction = "report_actioned"
+	AdminActionReportDismissed     AdminAction = "report_dismissed"
+	AdminActionContentHidden       AdminAction = "content_hidden"
+	AdminActionContentRestored     AdminAction = "content_restored"
+	AdminActionStrikeIssued        AdminAction = "strike_issued"
+	AdminActionStrikeRevoked       AdminAction = "strike_revoked"
+)
+
+// AdminAuditLog records what an admin did to whom and why. Rows are never
+// updated; deleting a target account keeps its rows with the user unset.
+type AdminAuditLog struct {
+	ID           uint                   `gorm:"primaryKey" json:"id"`
+	ActorID      uint                   `gorm:"not null;index" json:"actor_id"`
+	TargetUserID *uint                  `gorm:"index" json:"target_user_id,omitempty"`
+	Action       AdminAction            `gorm:"type:varchar(32);not null" json:"action"`
+	Reason       string                 `gorm:"type:text" json:"reason,omitempty"`
+	Details      map[string]interface{} `gorm:"type:jsonb;serializer:json" json:"details,omitempty"`
+	IPAddress    *string                `gorm:"type:text;serializer:encrypted" json:"ip_address,omitempty"`
+	UserAgent    *string                `json:"user_agent,omitempty"`
+	CreatedAt    time.Time              `gorm:"index" json:"created_at"`
+}
+
+func (AdminAuditLog) TableName() string {
+	return "admin_audit_logs"
+}
+
+// AdminUserFilter narrows the admin user list. Query matches the email,
+// username or full name; Status is "active", "suspended" or "admin".
+type AdminUserFilter struct {
+	Query  string
+	Status string
+}
+
+// UserActivity is what the admin user page shows of an account's use.
+type UserActivity struct {
+	ActiveSessions   int64
+	LastSessionAt    *time.Time
+	PostCount        int64
+	CommentCount     int64
+	JobCount         int64
+	ApplicationCount int64
+	ConnectionCount  int64
+	ActiveAPIKeys    int64
+}
@@ -0,0 +1,17 @@
+package entities
+
+import (
+	"time"
+)
+
+const EventExperimentExposure = "experiment_exposure"
+
+type AnalyticsEvent struct {
+	ID         uint64    `gorm:"primaryKey" json:"id"`
+	UserID     *uint     `json:"user_id,omitempty"`
+	EventType  string    `gorm:"not null" json:"event_type"`
+	EntityType string    `json:"entity_type,omitempty"`
+	EntityID   *uint     `json:"entity_id,omitempty"`
+	Metadata   string    `gorm:"type:jsonb" json:"metadata,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
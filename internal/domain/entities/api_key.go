@@ -0,0 +1,58 @@
+package entities
+
+import "time"
+
+type APIKeyScope string
+
+const (
+	APIKeyScopeJobsRead         APIKeyScope = "jobs:read"
+	APIKeyScopeJobsWrite        APIKeyScope = "jobs:write"
+	APIKeyScopeApplicationsRead APIKeyScope = "applications:read"
+)
+
+var APIKeyScopes = []APIKeyScope{
+	APIKeyScopeJobsRead,
+	APIKeyScopeJobsWrite,
+	APIKeyScopeApplicationsRead,
+}
+
+// APIKey lets a user's own systems call a few routes without a session,
+// such as an applicant tracking system posting jobs. Only the HMAC of the
+// key is stored; Prefix is its first characters, kept so users can tell
+// their keys apart.
+type APIKey struct {
+	ID         uint          `gorm:"primaryKey" json:"id"`
+	UserID     uint          `gorm:"not null;index" json:"user_id"`
+	Name       string        `gorm:"size:100;not null" json:"name"`
+	Prefix     string        `gorm:"size:16;not null" json:"prefix"`
+	KeyHash    string        `gorm:"not null;uniqueIndex" json:"-"`
+	Scopes     []APIKeyScope `gorm:"type:jsonb;serializer:json;not null" json:"scopes"`
+	RateLimit  int           `gorm:"not null" json:"rate_limit"`
+	LastUsedAt *time.Time    `json:"last_used_at,omitempty"`
+	ExpiresAt  *time.Time    `json:"expires_at,omitempty"`
+	RevokedAt  *time.Time    `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time     `json:"created_at"`
+	UpdatedAt  time.Time     `json:"updated_at"`
+
+	User User `gorm:"foreignKey:UserID" json:"-"`
+}
+
+func (APIKey) TableName() string {
+	return "api_keys"
+}
+
+func (k *APIKey) HasScope(scope APIKeyScope) bool {
+	for _, s := range k.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func (k *APIKey) Usable(now time.Time) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	return k.ExpiresAt == nil || k.ExpiresAt.After(now)
+}
@@ -8,24 +8,62 @@ import (
 type ApplicationStatus string
 
 const (
-	ApplicationPending  ApplicationStatus = "pending"
-	ApplicationReviewed ApplicationStatus = "reviewed"
-	ApplicationAccepted ApplicationStatus = "accepted"
-	ApplicationRejected ApplicationStatus = "rejected"
+	ApplicationPending      ApplicationStatus = "pending"
+	ApplicationReviewed     ApplicationStatus = "reviewed"
+	ApplicationShortlisted  ApplicationStatus = "shortlisted"
+	ApplicationInterviewing ApplicationStatus = "interviewing"
+	ApplicationHired        ApplicationStatus = "hired"
+	ApplicationRejected     ApplicationStatus = "rejected"
+	ApplicationWithdrawn    ApplicationStatus = "withdrawn"
 )
 
+// Candidates move forward one stage at a time, though a fresh application
+// may be shortlisted straight away. Rejection and withdrawal end the
+// pipeline from any open stage.
+var applicationTransitions = map[ApplicationStatus][]ApplicationStatus{
+	ApplicationPending:      {ApplicationReviewed, ApplicationShortlisted, ApplicationRejected, ApplicationWithdrawn},
+	ApplicationReviewed:     {ApplicationShortlisted, ApplicationRejected, ApplicationWithdrawn},
+	ApplicationShortlisted:  {ApplicationInterviewing, ApplicationRejected, ApplicationWithdrawn},
+	ApplicationInterviewing: {ApplicationHired, ApplicationRejected, ApplicationWithdrawn},
+}
+
+func (s ApplicationStatus) CanTransitionTo(next ApplicationStatus) bool {
+	for _, allowed := range applicationTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
 type Application struct {
-	ID          uint              `gorm:"primaryKey" json:"id"`
-	UserID      uint              `gorm:"not null" json:"user_id"`
-	JobID       uint              `gorm:"not null" json:"job_id"`
-	CoverLetter string            `gorm:"type:text" json:"cover_letter"`
-	ResumeURL   string            `json:"resume_url,omitempty"`
-	Status      ApplicationStatus `gorm:"default:'pending'" json:"status"`
-	AppliedAt   time.Time         `json:"applied_at"`
-	CreatedAt   time.Time         `json:"created_at"`
-	UpdatedAt   time.Time         `json:"updated_at"`
-	DeletedAt   gorm.DeletedAt    `gorm:"index" json:"-"`
+	ID               uint              `gorm:"primaryKey" json:"id"`
+	UserID           uint              `gorm:"not null;uniqueIndex:idx_applications_active_user_job,where:withdrawn_at IS NULL AND deleted_at IS NULL" json:"user_id"`
+	JobID            uint              `gorm:"not null;uniqueIndex:idx_applications_active_user_job,where:withdrawn_at IS NULL AND deleted_at IS NULL" json:"job_id"`
+	CoverLetter      string            `gorm:"type:text" json:"cover_letter"`
+	ResumeURL        string            `json:"resume_url,omitempty"`
+	Status           ApplicationStatus `gorm:"size:20;default:'pending'" json:"status"`
+	AppliedAt        time.Time         `json:"applied_at"`
+	WithdrawnAt      *time.Time        `json:"withdrawn_at,omitempty"`
+	WithdrawalReason string            `gorm:"type:text" json:"withdrawal_reason,omitempty"`
+	CreatedAt        time.Time         `json:"created_at"`
+	UpdatedAt        time.Time         `json:"updated_at"`
+	DeletedAt        gorm.DeletedAt    `gorm:"index" json:"-"`
 
 	User User `gorm:"foreignKey:UserID" json:"user,omitempty"`
 	Job  Job  `gorm:"foreignKey:JobID" json:"job,omitempty"`
 }
+
+// ApplicationStatusHistory records each move of an application through the
+// pipeline, whether by the hiring side or by the applicant withdrawing.
+type ApplicationStatusHistory struct {
+	ID            uint              `gorm:"primaryKey" json:"id"`
+	ApplicationID uint              `gorm:"not null;index" json:"application_id"`
+	FromStatus    ApplicationStatus `gorm:"size:20;not null" json:"from_status"`
+	ToStatus      ApplicationStatus `gorm:"size:20;not null" json:"to_status"`
+	ChangedBy     uint              `gorm:"not null" json:"changed_by"`
+	Note          string            `gorm:"type:text" json:"note,omitempty"`
+	CreatedAt     time.Time         `json:"created_at"`
+
+	ChangedByUser User `gorm:"foreignKey:ChangedBy" json:"-"`
+}
@@ -0,0 +1,74 @@
+package entities
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type SkillAssessment struct {
+	ID                 uint      `gorm:"primaryKey" json:"id"`
+	Skill              string    `gorm:"index;size:50;not null" json:"skill"`
+	Title              string    `gorm:"size:200;not null" json:"title"`
+	Description        string    `gorm:"type:text" json:"description,omitempty"`
+	QuestionCount      int       `gorm:"not null;default:15" json:"question_count"`
+	TimeLimitMinutes   int       `gorm:"not null;default:15" json:"time_limit_minutes"`
+	PassPercent        int       `gorm:"not null;default:70" json:"pass_percent"`
+	RetakeCooldownDays int       `gorm:"not null;default:30" json:"retake_cooldown_days"`
+	IsActive           bool      `gorm:"not null;default:false" json:"is_active"`
+	CreatedBy          *uint     `json:"created_by,omitempty"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+// AssessmentQuestion is a multiple choice question in an assessment's bank.
+// Questions are soft-deleted so attempts that drew them can still be scored.
+type AssessmentQuestion struct {
+	ID            uint           `gorm:"primaryKey" json:"id"`
+	AssessmentID  uint           `gorm:"index;not null" json:"assessment_id"`
+	Prompt        string         `gorm:"type:text;not null" json:"prompt"`
+	Options       []string       `gorm:"type:jsonb;serializer:json;not null" json:"options"`
+	CorrectOption int            `gorm:"not null" json:"-"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+	DeletedAt     gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+type AssessmentAttemptStatus string
+
+const (
+	AttemptInProgress AssessmentAttemptStatus = "in_progress"
+	AttemptPassed     AssessmentAttemptStatus = "passed"
+	AttemptFailed     AssessmentAttemptStatus = "failed"
+	AttemptExpired    AssessmentAttemptStatus = "expired"
+)
+
+// AssessmentAttempt is one timed quiz session. QuestionIDs fixes the questions
+// drawn at start, and Answers holds the chosen option per question (-1 when
+// left blank) once submitted.
+type AssessmentAttempt struct {
+	ID           uint                    `gorm:"primaryKey" json:"id"`
+	UserID       uint                    `gorm:"index:idx_assessment_attempts_user_assessment;not null" json:"user_id"`
+	AssessmentID uint                    `gorm:"index:idx_assessment_attempts_user_assessment;not null" json:"assessment_id"`
+	Status       AssessmentAttemptStatus `gorm:"size:20;not null;default:'in_progress'" json:"status"`
+	QuestionIDs  []uint                  `gorm:"type:jsonb;serializer:json;not null" json:"question_ids"`
+	Answers      []int                   `gorm:"type:jsonb;serializer:json" json:"answers,omitempty"`
+	CorrectCount int                     `gorm:"not null;default:0" json:"correct_count"`
+	Score        int                     `gorm:"not null;default:0" json:"score"`
+	StartedAt    time.Time               `gorm:"not null" json:"started_at"`
+	ExpiresAt    time.Time               `gorm:"not null" json:"expires_at"`
+	FinishedAt   *time.Time              `json:"finished_at,omitempty"`
+	CreatedAt    time.Time               `json:"created_at"`
+	UpdatedAt    time.Time               `json:"updated_at"`
+}
+
+// SkillBadge is awarded the first time a user passes an assessment and is
+// shown on their profile.
+type SkillBadge struct {
+	UserID       uint      `gorm:"primaryKey" json:"user_id"`
+	AssessmentID uint      `gorm:"primaryKey;index" json:"assessment_id"`
+	AttemptID    uint      `gorm:"not null" json:"attempt_id"`
+	Skill        string    `gorm:"size:50;not null" json:"skill"`
+	Score        int       `gorm:"not null" json:"score"`
+	EarnedAt     time.Time `gorm:"not null" json:"earned_at"`
+}
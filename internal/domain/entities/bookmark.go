@@ -0,0 +1,23 @@
+package entities
+
+import "time"
+
+// SavedJob is a job on a user's shortlist.
+type SavedJob struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	UserID    uint      `gorm:"not null;uniqueIndex:idx_saved_jobs_user_job" json:"user_id"`
+	JobID     uint      `gorm:"not null;uniqueIndex:idx_saved_jobs_user_job;index" json:"job_id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	Job Job `gorm:"foreignKey:JobID" json:"job,omitempty"`
+}
+
+// SavedPost is a post on a user's reading list.
+type SavedPost struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	UserID    uint      `gorm:"not null;uniqueIndex:idx_saved_posts_user_post" json:"user_id"`
+	PostID    uint      `gorm:"not null;uniqueIndex:idx_saved_posts_user_post;index" json:"post_id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	Post Post `gorm:"foreignKey:PostID" json:"post,omitempty"`
+}
@@ -0,0 +1,144 @@
+package entities
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+type CompanyVerificationMethod string
+type CompanyVerificationStatus string
+type CompanyMemberRole string
+type TeamMemberRole string
+type SSOProtocol string
+
+const (
+	CompanyVerificationBusinessEmail CompanyVerificationMethod = "business_email"
+	CompanyVerificationDocument      CompanyVerificationMethod = "document"
+
+	CompanyVerificationAwaitingEmail CompanyVerificationStatus = "awaiting_email"
+	CompanyVerificationPending       CompanyVerificationStatus = "pending"
+	CompanyVerificationApproved      CompanyVerificationStatus = "approved"
+	CompanyVerificationRejected      CompanyVerificationStatus = "rejected"
+
+	CompanyRoleAdmin     CompanyMemberRole = "admin"
+	CompanyRoleRecruiter CompanyMemberRole = "recruiter"
+
+	TeamRoleLead   TeamMemberRole = "lead"
+	TeamRoleMember TeamMemberRole = "member"
+
+	SSOProtocolOIDC SSOProtocol = "oidc"
+	SSOProtocolSAML SSOProtocol = "saml"
+)
+
+type Company struct {
+	ID          uint           `gorm:"primaryKey" json:"id"`
+	OwnerID     uint           `gorm:"not null" json:"owner_id"`
+	Name        string         `gorm:"not null" json:"name"`
+	Domain      string         `gorm:"unique;not null" json:"domain"`
+	Website     string         `json:"website,omitempty"`
+	Description string         `gorm:"type:text" json:"description,omitempty"`
+	Industry    string         `gorm:"size:100" json:"industry,omitempty"`
+	IndustryID  *uint          `gorm:"index" json:"industry_id,omitempty"`
+	IsVerified  bool           `gorm:"default:false" json:"is_verified"`
+	VerifiedAt  *time.Time     `json:"verified_at,omitempty"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
+
+	// DomainToken is the value the company publishes in a DNS TXT record to
+	// prove it controls Domain; DomainVerifiedAt is set once it was found.
+	DomainToken      string     `gorm:"not null;default:''" json:"-"`
+	DomainVerifiedAt *time.Time `json:"domain_verified_at,omitempty"`
+
+	Owner User `gorm:"foreignKey:OwnerID" json:"owner,omitempty"`
+}
+
+type CompanyVerification struct {
+	ID               uint                      `gorm:"primaryKey" json:"id"`
+	CompanyID        uint                      `gorm:"not null" json:"company_id"`
+	SubmittedBy      uint                      `gorm:"not null" json:"submitted_by"`
+	Method           CompanyVerificationMethod `gorm:"not null" json:"method"`
+	Status           CompanyVerificationStatus `gorm:"not null" json:"status"`
+	BusinessEmail    string                    `json:"business_email,omitempty"`
+	DocumentKey      string                    `json:"-"`
+	EmailConfirmedAt *time.Time                `json:"email_confirmed_at,omitempty"`
+	ReviewerID       *uint                     `json:"reviewer_id,omitempty"`
+	ReviewNote       string                    `gorm:"type:text" json:"review_note,omitempty"`
+	ReviewedAt       *time.Time                `json:"reviewed_at,omitempty"`
+	CreatedAt        time.Time                 `json:"created_at"`
+	UpdatedAt        time.Time                 `json:"updated_at"`
+
+	Company   Company `gorm:"foreignKey:CompanyID" json:"company,omitempty"`
+	Submitter User    `gorm:"foreignKey:SubmittedBy" json:"submitter,omitempty"`
+}
+
+type CompanyMember struct {
+	CompanyID uint              `gorm:"primaryKey" json:"company_id"`
+	UserID    uint              `gorm:"primaryKey" json:"user_id"`
+	Role      CompanyMemberRole `gorm:"not null" json:"role"`
+	AddedBy   uint              `gorm:"not null" json:"added_by"`
+	CreatedAt time.Time         `json:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at"`
+
+	User User `gorm:"foreignKey:UserID" json:"user,omitempty"`
+}
+
+// CompanyTeam is a department or a team inside a company. A team may sit
+// under a department through ParentID; departments cannot be nested further.
+type CompanyTeam struct {
+	ID          uint           `gorm:"primaryKey" json:"id"`
+	CompanyID   uint           `gorm:"not null;index" json:"company_id"`
+	ParentID    *uint          `gorm:"index" json:"parent_id,omitempty"`
+	Name        string         `gorm:"not null" json:"name"`
+	Description string         `gorm:"type:text" json:"description,omitempty"`
+	CreatedBy   uint           `gorm:"not null" json:"created_by"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
+
+	Company Company `gorm:"foreignKey:CompanyID" json:"-"`
+}
+
+type TeamMember struct {
+	TeamID    uint           `gorm:"primaryKey" json:"team_id"`
+	UserID    uint           `gorm:"primaryKey" json:"user_id"`
+	Role      TeamMemberRole `gorm:"not null" json:"role"`
+	AddedBy   uint           `gorm:"not null" json:"added_by"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+
+	User User `gorm:"foreignKey:UserID" json:"user,omitempty"`
+}
+
+// CompanySSOConfig connects a company workspace to its identity provider.
+// Only the fields for Protocol are used. When Enforced is set, anyone with an
+// email on the company domain must sign in through SSO.
+type CompanySSOConfig struct {
+	ID               uint        `gorm:"primaryKey" json:"id"`
+	CompanyID        uint        `gorm:"not null;uniqueIndex" json:"company_id"`
+	Protocol         SSOProtocol `gorm:"type:varchar(8);not null" json:"protocol"`
+	OIDCIssuer       string      `json:"oidc_issuer,omitempty"`
+	OIDCClientID     string      `json:"oidc_client_id,omitempty"`
+	OIDCClientSecret string      `gorm:"type:text;serializer:encrypted" json:"-"`
+	SAMLEntityID     string      `json:"saml_entity_id,omitempty"`
+	SAMLSSOURL       string      `json:"saml_sso_url,omitempty"`
+	SAMLCertificate  string      `gorm:"type:text" json:"-"`
+	Enforced         bool        `gorm:"default:false" json:"enforced"`
+	JITProvisioning  bool        `gorm:"default:true" json:"jit_provisioning"`
+	UpdatedBy        uint        `gorm:"not null" json:"updated_by"`
+	CreatedAt        time.Time   `json:"created_at"`
+	UpdatedAt        time.Time   `json:"updated_at"`
+
+	Company Company `gorm:"foreignKey:CompanyID" json:"-"`
+}
+
+// SSOIdentity links an identity provider subject to a local user, so a
+// changed email at the IdP still resolves to the same account.
+type SSOIdentity struct {
+	ID          uint       `gorm:"primaryKey" json:"id"`
+	CompanyID   uint       `gorm:"not null;uniqueIndex:idx_sso_identities_subject,priority:1" json:"company_id"`
+	Subject     string     `gorm:"not null;uniqueIndex:idx_sso_identities_subject,priority:2" json:"subject"`
+	UserID      uint       `gorm:"not null;index" json:"user_id"`
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
@@ -0,0 +1,47 @@
+package entities
+
+import "time"
+
+// CompanyFollow is a member following a company page.
+type CompanyFollow struct {
+	CompanyID uint      `gorm:"primaryKey" json:"company_id"`
+	UserID    uint      `gorm:"primaryKey;index" json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CompanyDailyStats is the rollup of company-wide activity for one UTC day.
+// Followers is the follower total at the end of the day. Employees are the
+// company owner, company members and members of the company's teams.
+type CompanyDailyStats struct {
+	CompanyID             uint      `gorm:"primaryKey" json:"company_id"`
+	Day                   time.Time `gorm:"primaryKey;type:date" json:"day"`
+	Followers             int64     `gorm:"not null;default:0" json:"followers"`
+	NewFollowers          int64     `gorm:"not null;default:0" json:"new_followers"`
+	EmployeePosts         int64     `gorm:"not null;default:0" json:"employee_posts"`
+	EmployeePostReactions int64     `gorm:"not null;default:0" json:"employee_post_reactions"`
+	EmployeePosters       int64     `gorm:"not null;default:0" json:"employee_posters"`
+	UpdatedAt             time.Time `json:"updated_at"`
+}
+
+// JobDailyStats is the rollup of one company job's activity for one UTC day.
+// Application status counts are for applications submitted that day, as of
+// the last time the day was rolled up.
+type JobDailyStats struct {
+	JobID         uint      `gorm:"primaryKey" json:"job_id"`
+	Day           time.Time `gorm:"primaryKey;type:date" json:"day"`
+	CompanyID     uint      `gorm:"not null;index" json:"company_id"`
+	Views         int64     `gorm:"not null;default:0" json:"views"`
+	UniqueViewers int64     `gorm:"not null;default:0" json:"unique_viewers"`
+	Saves         int64     `gorm:"not null;default:0" json:"saves"`
+	Applications  int64     `gorm:"not null;default:0" json:"applications"`
+	Pending       int64     `gorm:"not null;default:0" json:"pending"`
+	Reviewed      int64     `gorm:"not null;default:0" json:"reviewed"`
+	Shortlisted   int64     `gorm:"not null;default:0" json:"shortlisted"`
+	Interviewing  int64     `gorm:"not null;default:0" json:"interviewing"`
+	Hired         int64     `gorm:"not null;default:0" json:"hired"`
+	Rejected      int64     `gorm:"not null;default:0" json:"rejected"`
+	Withdrawn     int64     `gorm:"not null;default:0" json:"withdrawn"`
+	UpdatedAt     time.Time `json:"updated_at"`
+
+	Job Job `gorm:"foreignKey:JobID" json:"-"`
+}
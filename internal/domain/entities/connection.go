@@ -36,3 +36,39 @@ type ConnectionIndex struct {
 	RequesterID uint `gorm:"index:idx_connection_requester_addressee,unique"`
 	AddresseeID uint `gorm:"index:idx_connection_requester_addressee,unique"`
 }
+
+type ConnectionSuggestionReason string
+
+const (
+	SuggestionCurrentColleague  ConnectionSuggestionReason = "current_colleague"
+	SuggestionFormerColleague   ConnectionSuggestionReason = "former_colleague"
+	SuggestionMutualConnections ConnectionSuggestionReason = "mutual_connections"
+	SuggestionRecentInteraction ConnectionSuggestionReason = "recent_interaction"
+)
+
+// ConnectionSuggestion is a precomputed "people you may know" entry. Candidates
+// come from mutual connections, overlapping experience at the same company and
+// recent engagement on each other's posts; Score blends those signals with a
+// shared location, and Reason names the strongest one. The company and
+// overlap fields are only set for colleagues.
+type ConnectionSuggestion struct {
+	UserID           uint                       `gorm:"primaryKey" json:"user_id"`
+	SuggestedUserID  uint                       `gorm:"primaryKey;index" json:"suggested_user_id"`
+	Reason           ConnectionSuggestionReason `gorm:"size:30;not null" json:"reason"`
+	Company          string                     `gorm:"not null;default:''" json:"company,omitempty"`
+	OverlapStart     *time.Time                 `gorm:"type:date" json:"overlap_start,omitempty"`
+	OverlapEnd       *time.Time                 `gorm:"type:date" json:"overlap_end,omitempty"`
+	OverlapDays      int                        `gorm:"not null;default:0" json:"overlap_days"`
+	MutualCount      int                        `gorm:"not null;default:0" json:"mutual_count"`
+	SharedLocation   bool                       `gorm:"not null;default:false" json:"shared_location"`
+	InteractionScore int                        `gorm:"not null;default:0" json:"interaction_score"`
+	Score            int                        `gorm:"not null;default:0" json:"score"`
+	DismissedAt      *time.Time                 `json:"dismissed_at,omitempty"`
+	ComputedAt       time.Time                  `gorm:"not null;index" json:"computed_at"`
+
+	SuggestedUser User `gorm:"foreignKey:SuggestedUserID" json:"suggested_user,omitempty"`
+}
+
+func (ConnectionSuggestion) TableName() string {
+	return "connection_suggestions"
+}
@@ -0,0 +1,42 @@
+package entities
+
+import "time"
+
+type ConnectionImportStatus string
+
+const (
+	ConnectionImportPending    ConnectionImportStatus = "pending"
+	ConnectionImportProcessing ConnectionImportStatus = "processing"
+	ConnectionImportCompleted  ConnectionImportStatus = "completed"
+	ConnectionImportFailed     ConnectionImportStatus = "failed"
+)
+
+type ConnectionImportMatch struct {
+	UserID      uint   `json:"user_id"`
+	Username    string `json:"username"`
+	FullName    string `json:"full_name"`
+	MatchedBy   string `json:"matched_by"`
+	ContactName string `json:"contact_name"`
+	Company     string `json:"company,omitempty"`
+	Position    string `json:"position,omitempty"`
+}
+
+type ConnectionImport struct {
+	ID            uint                    `gorm:"primaryKey" json:"id"`
+	UserID        uint                    `gorm:"not null;index" json:"user_id"`
+	UploadID      *uint                   `json:"upload_id,omitempty"`
+	Filename      string                  `gorm:"size:255" json:"filename"`
+	Status        ConnectionImportStatus  `gorm:"not null" json:"status"`
+	TotalRows     int                     `gorm:"default:0" json:"total_rows"`
+	ProcessedRows int                     `gorm:"default:0" json:"processed_rows"`
+	Matches       []ConnectionImportMatch `gorm:"type:jsonb;serializer:json;default:'[]'" json:"matches"`
+	Error         string                  `gorm:"type:text" json:"error,omitempty"`
+	StartedAt     *time.Time              `json:"started_at,omitempty"`
+	CompletedAt   *time.Time              `json:"completed_at,omitempty"`
+	CreatedAt     time.Time               `json:"created_at"`
+	UpdatedAt     time.Time               `json:"updated_at"`
+}
+
+func (ConnectionImport) TableName() string {
+	return "connection_imports"
+}
@@ -0,0 +1,32 @@
+package entities
+
+import (
+	"time"
+)
+
+type DataExportRunStatus string
+
+const (
+	DataExportRunProcessing DataExportRunStatus = "processing"
+	DataExportRunCompleted  DataExportRunStatus = "completed"
+	DataExportRunFailed     DataExportRunStatus = "failed"
+)
+
+type DataExportRun struct {
+	Day         time.Time           `gorm:"type:date;primaryKey" json:"day"`
+	Source      string              `gorm:"primaryKey;size:50" json:"source"`
+	Status      DataExportRunStatus `gorm:"not null" json:"status"`
+	RowCount    int64               `gorm:"default:0" json:"row_count"`
+	ObjectKey   string              `json:"object_key,omitempty"`
+	Error       string              `gorm:"type:text" json:"error,omitempty"`
+	StartedAt   time.Time           `json:"started_at"`
+	CompletedAt *time.Time          `json:"completed_at,omitempty"`
+}
+
+type DataExportRecord struct {
+	UserID     *uint
+	EventType  string
+	EntityType string
+	EntityID   *uint
+	OccurredAt time.Time
+}
@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+type Experience struct {
+	ID          uint           `gorm:"primaryKey" json:"id"`
+	UserID      uint           `gorm:"not null;index" json:"user_id"`
+	Title       string         `gorm:"not null" json:"title"`
+	Company     string         `gorm:"not null" json:"company"`
+	Location    string         `json:"location,omitempty"`
+	LocationID  *uint          `gorm:"index" json:"location_id,omitempty"`
+	Description string         `gorm:"type:text" json:"description,omitempty"`
+	StartDate   time.Time      `gorm:"type:date;not null" json:"start_date"`
+	EndDate     *time.Time     `gorm:"type:date" json:"end_date,omitempty"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
+
+	User User `gorm:"foreignKey:UserID" json:"-"`
+}
+
+func (e *Experience) IsCurrent() bool {
+	return e.EndDate == nil
+}
+
+func (e *Experience) YearsAt(now time.Time) int {
+	years := now.Year() - e.StartDate.Year()
+	if now.Month() < e.StartDate.Month() || (now.Month() == e.StartDate.Month() && now.Day() < e.StartDate.Day()) {
+		years--
+	}
+	return max(years, 0)
+}
@@ -0,0 +1,48 @@
+package entities
+
+import (
+	"time"
+)
+
+type ExperimentStatus string
+
+const (
+	ExperimentRunning ExperimentStatus = "running"
+	ExperimentStopped ExperimentStatus = "stopped"
+)
+
+type ExperimentVariant struct {
+	Name   string            `json:"name"`
+	Weight int               `json:"weight"`
+	Params map[string]string `json:"params,omitempty"`
+}
+
+type Experiment struct {
+	ID          uint                `gorm:"primaryKey" json:"id"`
+	Key         string              `gorm:"index;size:100;not null" json:"key"`
+	Description string              `gorm:"type:text" json:"description,omitempty"`
+	Status      ExperimentStatus    `gorm:"not null;default:'running'" json:"status"`
+	Variants    []ExperimentVariant `gorm:"type:jsonb;serializer:json;not null" json:"variants"`
+	Winner      string              `gorm:"size:50" json:"winner,omitempty"`
+	CreatedBy   *uint               `json:"created_by,omitempty"`
+	StartedAt   time.Time           `json:"started_at"`
+	StoppedAt   *time.Time          `json:"stopped_at,omitempty"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
+
+func (e *Experiment) Variant(name string) *ExperimentVariant {
+	for i := range e.Variants {
+		if e.Variants[i].Name == name {
+			return &e.Variants[i]
+		}
+	}
+	return nil
+}
+
+type ExperimentAssignment struct {
+	ExperimentID uint      `gorm:"primaryKey" json:"experiment_id"`
+	UserID       uint      `gorm:"primaryKey" json:"user_id"`
+	Variant      string    `gorm:"size:50;not null" json:"variant"`
+	CreatedAt    time.Time `json:"created_at"`
+}
@@ -0,0 +1,50 @@
+package entities
+
+import (
+	"time"
+)
+
+type IdentityDocumentType string
+type IdentityVerificationStatus string
+
+const (
+	IdentityDocumentPassport       IdentityDocumentType = "passport"
+	IdentityDocumentNationalID     IdentityDocumentType = "national_id"
+	IdentityDocumentDriversLicense IdentityDocumentType = "drivers_license"
+
+	IdentityVerificationPending  IdentityVerificationStatus = "pending"
+	IdentityVerificationInReview IdentityVerificationStatus = "in_review"
+	IdentityVerificationApproved IdentityVerificationStatus = "approved"
+	IdentityVerificationRejected IdentityVerificationStatus = "rejected"
+	IdentityVerificationRevoked  IdentityVerificationStatus = "revoked"
+)
+
+type IdentityVerification struct {
+	ID                uint                       `gorm:"primaryKey" json:"id"`
+	UserID            uint                       `gorm:"not null" json:"user_id"`
+	DocumentType      IdentityDocumentType       `gorm:"not null" json:"document_type"`
+	DocumentKey       string                     `gorm:"not null;serializer:encrypted" json:"-"`
+	SelfieKey         string                     `gorm:"serializer:encrypted" json:"-"`
+	Status            IdentityVerificationStatus `gorm:"not null" json:"status"`
+	Provider          string                     `gorm:"not null" json:"provider"`
+	ProviderReference string                     `gorm:"type:text;serializer:encrypted" json:"provider_reference,omitempty"`
+	ReviewerID        *uint                      `json:"reviewer_id,omitempty"`
+	ReviewNote        string                     `gorm:"type:text" json:"review_note,omitempty"`
+	ReviewedAt        *time.Time                 `json:"reviewed_at,omitempty"`
+	CreatedAt         time.Time                  `json:"created_at"`
+	UpdatedAt         time.Time                  `json:"updated_at"`
+
+	User User `gorm:"foreignKey:UserID" json:"user,omitempty"`
+}
+
+type IdentityVerificationAudit struct {
+	ID             uint                       `gorm:"primaryKey" json:"id"`
+	VerificationID uint                       `gorm:"not null" json:"verification_id"`
+	UserID         uint                       `gorm:"not null" json:"user_id"`
+	ActorID        *uint                      `json:"actor_id,omitempty"`
+	Action         string                     `gorm:"not null" json:"action"`
+	FromStatus     IdentityVerificationStatus `json:"from_status,omitempty"`
+	ToStatus       IdentityVerificationStatus `gorm:"not null" json:"to_status"`
+	Note           string                     `gorm:"type:text" json:"note,omitempty"`
+	CreatedAt      time.Time                  `json:"created_at"`
+}
@@ -0,0 +1,32 @@
+package entities
+
+import "time"
+
+// Invite is a code that lets people register while registration is invite
+// only. A code can be shared with up to MaxUses people; each user who
+// joins with it records the invite and its creator.
+type Invite struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	Code      string     `gorm:"size:32;uniqueIndex;not null" json:"code"`
+	InviterID uint       `gorm:"not null;index" json:"inviter_id"`
+	Note      string     `gorm:"size:200" json:"note,omitempty"`
+	MaxUses   int        `gorm:"not null;default:1" json:"max_uses"`
+	UseCount  int        `gorm:"not null;default:0" json:"use_count"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+
+	Inviter User `gorm:"foreignKey:InviterID" json:"-"`
+}
+
+func (Invite) TableName() string {
+	return "invites"
+}
+
+func (i *Invite) Usable(now time.Time) bool {
+	if i.RevokedAt != nil || i.UseCount >= i.MaxUses {
+		return false
+	}
+	return i.ExpiresAt == nil || now.Before(*i.ExpiresAt)
+}
@@ -7,6 +7,7 @@ import (
 
 type JobType string
 type ExperienceLevel string
+type JobStatus string
 
 const (
 	JobTypeFullTime   JobType = "full_time"
@@ -18,26 +19,68 @@ const (
 	ExperienceMid       ExperienceLevel = "mid"
 	ExperienceSenior    ExperienceLevel = "senior"
 	ExperienceExecutive ExperienceLevel = "executive"
+
+	JobDraft           JobStatus = "draft"
+	JobPendingApproval JobStatus = "pending_approval"
+	JobPublished       JobStatus = "published"
+	JobRejected        JobStatus = "rejected"
 )
 
+var jobTransitions = map[JobStatus][]JobStatus{
+	JobDraft:           {JobPendingApproval, JobPublished},
+	JobPendingApproval: {JobPublished, JobRejected, JobDraft},
+	JobRejected:        {JobPendingApproval, JobDraft},
+}
+
+func (s JobStatus) CanTransitionTo(next JobStatus) bool {
+	for _, allowed := range jobTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
 type Job struct {
-	ID               uint            `gorm:"primaryKey" json:"id"`
-	UserID           uint            `gorm:"not null" json:"user_id"`
-	Title            string          `gorm:"not null" json:"title"`
-	Company          string          `gorm:"not null" json:"company"`
-	Location         string          `gorm:"not null" json:"location"`
-	Description      string          `gorm:"type:text;not null" json:"description"`
-	Requirements     string          `gorm:"type:text" json:"requirements"`
-	JobType          JobType         `gorm:"not null" json:"job_type"`
-	ExperienceLevel  ExperienceLevel `gorm:"not null" json:"experience_level"`
-	SalaryMin        *int            `json:"salary_min,omitempty"`
-	SalaryMax        *int            `json:"salary_max,omitempty"`
-	IsActive         bool            `gorm:"default:true" json:"is_active"`
-	ApplicationCount int             `gorm:"default:0" json:"application_count"`
-	CreatedAt        time.Time       `json:"created_at"`
-	UpdatedAt        time.Time       `json:"updated_at"`
-	DeletedAt        gorm.DeletedAt  `gorm:"index" json:"-"`
-
-	User         User          `gorm:"foreignKey:UserID" json:"user,omitempty"`
-	Applications []Application `gorm:"foreignKey:JobID" json:"applications,omitempty"`
+	ID                  uint                `gorm:"primaryKey" json:"id"`
+	UserID              uint                `gorm:"not null" json:"user_id"`
+	CompanyID           *uint               `json:"company_id,omitempty"`
+	TeamID              *uint               `gorm:"index" json:"team_id,omitempty"`
+	Title               string              `gorm:"not null" json:"title"`
+	Company             string              `gorm:"not null" json:"company"`
+	Location            string              `gorm:"not null" json:"location"`
+	LocationID          *uint               `gorm:"index" json:"location_id,omitempty"`
+	Industry            string              `gorm:"size:100" json:"industry,omitempty"`
+	IndustryID          *uint               `gorm:"index" json:"industry_id,omitempty"`
+	Description         string              `gorm:"type:text;not null" json:"description"`
+	Requirements        string              `gorm:"type:text" json:"requirements"`
+	JobType             JobType             `gorm:"not null" json:"job_type"`
+	ExperienceLevel     ExperienceLevel     `gorm:"not null" json:"experience_level"`
+	SalaryMin           *int                `json:"salary_min,omitempty"`
+	SalaryMax           *int                `json:"salary_max,omitempty"`
+	IsActive            bool                `gorm:"default:true" json:"is_active"`
+	Status              JobStatus           `gorm:"default:'published'" json:"status"`
+	ReviewerID          *uint               `json:"reviewer_id,omitempty"`
+	ReviewNote          string              `gorm:"type:text" json:"review_note,omitempty"`
+	ReviewedAt          *time.Time          `json:"reviewed_at,omitempty"`
+	PublishedAt         *time.Time          `gorm:"index" json:"published_at,omitempty"`
+	ApplicationCount    int                 `gorm:"default:0" json:"application_count"`
+	ViewCount           int64               `gorm:"default:0" json:"view_count"`
+	ReapplyCooldownDays int                 `gorm:"default:30" json:"reapply_cooldown_days"`
+	ApplicationDeadline *time.Time          `json:"application_deadline,omitempty"`
+	DeadlineTimezone    string              `gorm:"default:'UTC'" json:"deadline_timezone"`
+	ScreeningQuestions  []ScreeningQuestion `gorm:"type:jsonb;serializer:json;default:'[]'" json:"screening_questions"`
+	HiddenAt            *time.Time          `json:"hidden_at,omitempty"`
+	CreatedAt           time.Time           `json:"created_at"`
+	UpdatedAt           time.Time           `json:"updated_at"`
+	DeletedAt           gorm.DeletedAt      `gorm:"index" json:"-"`
+
+	User           User          `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	CompanyProfile *Company      `gorm:"foreignKey:CompanyID" json:"company_profile,omitempty"`
+	Team           *CompanyTeam  `gorm:"foreignKey:TeamID" json:"team,omitempty"`
+	Applications   []Application `gorm:"foreignKey:JobID" json:"applications,omitempty"`
+}
+
+func (j *Job) DeadlinePassed(now time.Time) bool {
+	return j.ApplicationDeadline != nil && !now.Before(*j.ApplicationDeadline)
 }
@@ -0,0 +1,48 @@
+package entities
+
+import "time"
+
+type JobAlertFrequency string
+
+const (
+	JobAlertDaily  JobAlertFrequency = "daily"
+	JobAlertWeekly JobAlertFrequency = "weekly"
+)
+
+func (f JobAlertFrequency) Interval() time.Duration {
+	if f == JobAlertWeekly {
+		return 7 * 24 * time.Hour
+	}
+	return 24 * time.Hour
+}
+
+type JobAlertFilters struct {
+	Location        string          `json:"location,omitempty"`
+	LocationID      *uint           `json:"location_id,omitempty"`
+	IndustryID      *uint           `json:"industry_id,omitempty"`
+	CompanyID       *uint           `json:"company_id,omitempty"`
+	JobType         JobType         `json:"job_type,omitempty"`
+	ExperienceLevel ExperienceLevel `json:"experience_level,omitempty"`
+	SalaryMin       *int            `json:"salary_min,omitempty"`
+}
+
+// JobAlert is a saved job search. The digest worker emails jobs published
+// between LastCheckedAt and the run time once NextRunAt passes, and then
+// moves both forward whether or not anything matched.
+type JobAlert struct {
+	ID               uint              `gorm:"primaryKey" json:"id"`
+	UserID           uint              `gorm:"not null;index" json:"user_id"`
+	Name             string            `gorm:"size:100;not null" json:"name"`
+	Query            string            `gorm:"size:200" json:"query,omitempty"`
+	Filters          JobAlertFilters   `gorm:"type:jsonb;serializer:json;default:'{}'" json:"filters"`
+	Frequency        JobAlertFrequency `gorm:"size:10;not null" json:"frequency"`
+	IsActive         bool              `gorm:"not null;default:true" json:"is_active"`
+	UnsubscribeToken string            `gorm:"size:64;not null;uniqueIndex" json:"-"`
+	LastCheckedAt    time.Time         `gorm:"not null" json:"last_checked_at"`
+	NextRunAt        time.Time         `gorm:"not null;index" json:"next_run_at"`
+	LastSentAt       *time.Time        `json:"last_sent_at,omitempty"`
+	CreatedAt        time.Time         `json:"created_at"`
+	UpdatedAt        time.Time         `json:"updated_at"`
+
+	User User `gorm:"foreignKey:UserID" json:"user,omitempty"`
+}
@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+type ScreeningQuestionType string
+
+const (
+	ScreeningQuestionText  ScreeningQuestionType = "text"
+	ScreeningQuestionYesNo ScreeningQuestionType = "yes_no"
+)
+
+type ScreeningQuestion struct {
+	Question string                `json:"question"`
+	Type     ScreeningQuestionType `json:"type"`
+	Required bool                  `json:"required"`
+}
+
+type JobTemplate struct {
+	ID                 uint                `gorm:"primaryKey" json:"id"`
+	UserID             uint                `gorm:"not null" json:"user_id"`
+	CompanyID          *uint               `json:"company_id,omitempty"`
+	Name               string              `gorm:"not null" json:"name"`
+	Title              string              `gorm:"not null" json:"title"`
+	Description        string              `gorm:"type:text;not null" json:"description"`
+	Requirements       string              `gorm:"type:text" json:"requirements"`
+	JobType            JobType             `json:"job_type,omitempty"`
+	ExperienceLevel    ExperienceLevel     `json:"experience_level,omitempty"`
+	ScreeningQuestions []ScreeningQuestion `gorm:"type:jsonb;serializer:json;default:'[]'" json:"screening_questions"`
+	CreatedAt          time.Time           `json:"created_at"`
+	UpdatedAt          time.Time           `json:"updated_at"`
+	DeletedAt          gorm.DeletedAt      `gorm:"index" json:"-"`
+
+	User    User     `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Company *Company `gorm:"foreignKey:CompanyID" json:"company,omitempty"`
+}
@@ -0,0 +1,71 @@
+package entities
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type CourseLevel string
+
+const (
+	CourseBeginner     CourseLevel = "beginner"
+	CourseIntermediate CourseLevel = "intermediate"
+	CourseAdvanced     CourseLevel = "advanced"
+)
+
+type Course struct {
+	ID          uint        `gorm:"primaryKey" json:"id"`
+	Title       string      `gorm:"size:200;not null" json:"title"`
+	Description string      `gorm:"type:text" json:"description,omitempty"`
+	Skill       string      `gorm:"index;size:50" json:"skill,omitempty"`
+	Level       CourseLevel `gorm:"size:20;not null;default:'beginner'" json:"level"`
+	IsPublished bool        `gorm:"not null;default:false" json:"is_published"`
+	CreatedBy   *uint       `json:"created_by,omitempty"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+// Lesson is one step of a course. Lessons are soft-deleted so completions
+// recorded against them stay intact, while progress only counts live ones.
+type Lesson struct {
+	ID              uint           `gorm:"primaryKey" json:"id"`
+	CourseID        uint           `gorm:"index;not null" json:"course_id"`
+	Position        int            `gorm:"not null;default:0" json:"position"`
+	Title           string         `gorm:"size:200;not null" json:"title"`
+	Content         string         `gorm:"type:text" json:"content,omitempty"`
+	VideoURL        string         `json:"video_url,omitempty"`
+	DurationMinutes int            `gorm:"not null;default:0" json:"duration_minutes"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	DeletedAt       gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+type CourseEnrollment struct {
+	UserID      uint       `gorm:"primaryKey" json:"user_id"`
+	CourseID    uint       `gorm:"primaryKey;index" json:"course_id"`
+	EnrolledAt  time.Time  `gorm:"not null" json:"enrolled_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+
+	Course Course `gorm:"foreignKey:CourseID" json:"course,omitempty"`
+}
+
+type LessonCompletion struct {
+	UserID      uint      `gorm:"primaryKey" json:"user_id"`
+	LessonID    uint      `gorm:"primaryKey" json:"lesson_id"`
+	CourseID    uint      `gorm:"not null;index" json:"course_id"`
+	CompletedAt time.Time `gorm:"not null" json:"completed_at"`
+}
+
+// CourseCertificate is issued once a user completes every lesson of a course.
+// Code is a public identifier anyone can use to verify the certificate.
+type CourseCertificate struct {
+	ID       uint      `gorm:"primaryKey" json:"id"`
+	UserID   uint      `gorm:"not null;uniqueIndex:idx_course_certificates_user_course" json:"user_id"`
+	CourseID uint      `gorm:"not null;uniqueIndex:idx_course_certificates_user_course" json:"course_id"`
+	Code     string    `gorm:"size:32;not null;uniqueIndex" json:"code"`
+	IssuedAt time.Time `gorm:"not null" json:"issued_at"`
+
+	Course Course `gorm:"foreignKey:CourseID" json:"course,omitempty"`
+	User   User   `gorm:"foreignKey:UserID" json:"user,omitempty"`
+}
@@ -0,0 +1,59 @@
+package entities
+
+import "time"
+
+type MentorshipRole string
+
+const (
+	MentorshipMentor MentorshipRole = "mentor"
+	MentorshipMentee MentorshipRole = "mentee"
+)
+
+// MentorshipProfile records a user's opt-in to the mentorship program for one
+// role. A user may hold both a mentor and a mentee profile.
+type MentorshipProfile struct {
+	ID           uint           `gorm:"primaryKey" json:"id"`
+	UserID       uint           `gorm:"not null;uniqueIndex:idx_mentorship_profiles_user_role" json:"user_id"`
+	Role         MentorshipRole `gorm:"size:10;not null;uniqueIndex:idx_mentorship_profiles_user_role" json:"role"`
+	Topics       []string       `gorm:"type:jsonb;serializer:json;not null;default:'[]'" json:"topics"`
+	Availability string         `gorm:"size:500" json:"availability,omitempty"`
+	Bio          string         `gorm:"type:text" json:"bio,omitempty"`
+	MaxMentees   int            `gorm:"not null" json:"max_mentees"`
+	IsActive     bool           `gorm:"not null" json:"is_active"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+}
+
+type MentorshipMatchStatus string
+
+const (
+	MentorshipMatchProposed MentorshipMatchStatus = "proposed"
+	MentorshipMatchAccepted MentorshipMatchStatus = "accepted"
+	MentorshipMatchDeclined MentorshipMatchStatus = "declined"
+	MentorshipMatchExpired  MentorshipMatchStatus = "expired"
+	MentorshipMatchEnded    MentorshipMatchStatus = "ended"
+)
+
+// MentorshipMatch is a proposed or active pairing. It becomes accepted once
+// both sides accept, at which point a dedicated conversation is opened and
+// check-in reminders are scheduled from NextCheckInAt.
+type MentorshipMatch struct {
+	ID               uint                  `gorm:"primaryKey" json:"id"`
+	MentorID         uint                  `gorm:"not null;uniqueIndex:idx_mentorship_matches_pair" json:"mentor_id"`
+	MenteeID         uint                  `gorm:"not null;uniqueIndex:idx_mentorship_matches_pair;index" json:"mentee_id"`
+	Topics           []string              `gorm:"type:jsonb;serializer:json;not null;default:'[]'" json:"topics"`
+	Score            int                   `gorm:"not null;default:0" json:"score"`
+	Status           MentorshipMatchStatus `gorm:"size:20;not null;default:'proposed';index" json:"status"`
+	MentorAcceptedAt *time.Time            `json:"mentor_accepted_at,omitempty"`
+	MenteeAcceptedAt *time.Time            `json:"mentee_accepted_at,omitempty"`
+	ConversationID   *uint                 `json:"conversation_id,omitempty"`
+	AcceptedAt       *time.Time            `json:"accepted_at,omitempty"`
+	EndedAt          *time.Time            `json:"ended_at,omitempty"`
+	LastCheckInAt    *time.Time            `json:"last_check_in_at,omitempty"`
+	NextCheckInAt    *time.Time            `gorm:"index" json:"next_check_in_at,omitempty"`
+	CreatedAt        time.Time             `json:"created_at"`
+	UpdatedAt        time.Time             `json:"updated_at"`
+
+	Mentor User `gorm:"foreignKey:MentorID" json:"mentor,omitempty"`
+	Mentee User `gorm:"foreignKey:MenteeID" json:"mentee,omitempty"`
+}
@@ -0,0 +1,82 @@
+package entities
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+// Conversation is a direct thread between participants unless it has a
+// Subject, which marks a dedicated conversation such as a mentorship's.
+type Conversation struct {
+	ID            uint           `gorm:"primaryKey" json:"id"`
+	Subject       string         `gorm:"size:200;not null;default:''" json:"subject,omitempty"`
+	LastMessageAt *time.Time     `json:"last_message_at,omitempty"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+	DeletedAt     gorm.DeletedAt `gorm:"index" json:"-"`
+
+	Participants []ConversationParticipant `gorm:"foreignKey:ConversationID" json:"participants,omitempty"`
+	Messages     []Message                 `gorm:"foreignKey:ConversationID" json:"messages,omitempty"`
+}
+
+type ConversationFilter string
+
+const (
+	ConversationFilterInbox    ConversationFilter = "inbox"
+	ConversationFilterArchived ConversationFilter = "archived"
+	ConversationFilterMuted    ConversationFilter = "muted"
+	ConversationFilterAll      ConversationFilter = "all"
+)
+
+type ConversationParticipant struct {
+	ID             uint       `gorm:"primaryKey" json:"id"`
+	ConversationID uint       `gorm:"not null" json:"conversation_id"`
+	UserID         uint       `gorm:"not null" json:"user_id"`
+	LastReadAt     *time.Time `json:"last_read_at,omitempty"`
+	ArchivedAt     *time.Time `json:"archived_at,omitempty"`
+	MutedAt        *time.Time `json:"muted_at,omitempty"`
+	ClearedAt      *time.Time `json:"cleared_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+
+	User User `gorm:"foreignKey:UserID" json:"user,omitempty"`
+}
+
+type Message struct {
+	ID             uint           `gorm:"primaryKey" json:"id"`
+	ConversationID uint           `gorm:"not null" json:"conversation_id"`
+	SenderID       uint           `gorm:"not null" json:"sender_id"`
+	Content        string         `gorm:"type:text" json:"content"`
+	DeliveredAt    *time.Time     `json:"delivered_at,omitempty"`
+	ReadAt         *time.Time     `json:"read_at,omitempty"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+	DeletedAt      gorm.DeletedAt `gorm:"index" json:"-"`
+
+	Sender      User                `gorm:"foreignKey:SenderID" json:"sender,omitempty"`
+	Attachments []MessageAttachment `gorm:"foreignKey:MessageID" json:"attachments,omitempty"`
+}
+
+type AttachmentType string
+
+const (
+	AttachmentImage AttachmentType = "image"
+	AttachmentFile  AttachmentType = "file"
+	AttachmentVoice AttachmentType = "voice"
+)
+
+// MessageAttachment references its message by id and created_at together,
+// the primary key of the partitioned messages table.
+type MessageAttachment struct {
+	ID               uint           `gorm:"primaryKey" json:"id"`
+	MessageID        uint           `gorm:"not null" json:"message_id"`
+	MessageCreatedAt time.Time      `gorm:"not null" json:"-"`
+	Type             AttachmentType `gorm:"not null" json:"type"`
+	FileKey          string         `gorm:"not null" json:"file_key"`
+	ThumbnailKey     string         `json:"thumbnail_key,omitempty"`
+	FileName         string         `gorm:"not null" json:"file_name"`
+	ContentType      string         `json:"content_type"`
+	Size             int64          `json:"size"`
+	DurationSeconds  *int           `json:"duration_seconds,omitempty"`
+	CreatedAt        time.Time      `json:"created_at"`
+}
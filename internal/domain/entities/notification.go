@@ -0,0 +1,73 @@
+package entities
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+type NotificationType string
+
+const (
+	NotificationConnectionRequest               NotificationType = "connection_request"
+	NotificationConnectionAccepted              NotificationType = "connection_accepted"
+	NotificationPostLiked                       NotificationType = "post_liked"
+	NotificationPostCommented                   NotificationType = "post_commented"
+	NotificationPostShared                      NotificationType = "post_shared"
+	NotificationCommentReplied                  NotificationType = "comment_replied"
+	NotificationCommentLiked                    NotificationType = "comment_liked"
+	NotificationMentionedInPost                 NotificationType = "mentioned_in_post"
+	NotificationMentionedInComment              NotificationType = "mentioned_in_comment"
+	NotificationApplicationUpdated              NotificationType = "application_updated"
+	NotificationApplicationWithdrawn            NotificationType = "application_withdrawn"
+	NotificationJobApproved                     NotificationType = "job_approved"
+	NotificationJobRejected                     NotificationType = "job_rejected"
+	NotificationNewMessage                      NotificationType = "new_message"
+	NotificationBirthday                        NotificationType = "birthday"
+	NotificationWorkAnniversary                 NotificationType = "work_anniversary"
+	NotificationNewPosition                     NotificationType = "new_position"
+	NotificationMentorshipProposed              NotificationType = "mentorship_proposed"
+	NotificationMentorshipAccepted              NotificationType = "mentorship_accepted"
+	NotificationMentorshipCheckIn               NotificationType = "mentorship_check_in"
+	NotificationRecommendationRequested         NotificationType = "recommendation_requested"
+	NotificationRecommendationReceived          NotificationType = "recommendation_received"
+	NotificationRecommendationRevisionRequested NotificationType = "recommendation_revision_requested"
+	NotificationRecommendationApproved          NotificationType = "recommendation_approved"
+	NotificationReportResolved                  NotificationType = "report_resolved"
+	NotificationContentHidden                   NotificationType = "content_hidden"
+	NotificationStrikeIssued                    NotificationType = "strike_issued"
+)
+
+type ReminderRunStatus string
+
+const (
+	ReminderRunProcessing ReminderRunStatus = "processing"
+	ReminderRunCompleted  ReminderRunStatus = "completed"
+	ReminderRunFailed     ReminderRunStatus = "failed"
+)
+
+type Notification struct {
+	ID         uint             `gorm:"primaryKey" json:"id"`
+	UserID     uint             `gorm:"not null" json:"user_id"`
+	ActorID    *uint            `json:"actor_id,omitempty"`
+	Type       NotificationType `gorm:"not null" json:"type"`
+	EntityType string           `json:"entity_type,omitempty"`
+	EntityID   *uint            `json:"entity_id,omitempty"`
+	Message    string           `gorm:"type:text;not null" json:"message"`
+	IsRead     bool             `gorm:"default:false" json:"is_read"`
+	ReadAt     *time.Time       `json:"read_at,omitempty"`
+	CreatedAt  time.Time        `json:"created_at"`
+	UpdatedAt  time.Time        `json:"updated_at"`
+	DeletedAt  gorm.DeletedAt   `gorm:"index" json:"-"`
+
+	User  User  `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Actor *User `gorm:"foreignKey:ActorID" json:"actor,omitempty"`
+}
+
+type ReminderRun struct {
+	Day         time.Time         `gorm:"type:date;primaryKey" json:"day"`
+	Status      ReminderRunStatus `gorm:"not null" json:"status"`
+	Sent        int               `gorm:"default:0" json:"sent"`
+	Error       string            `gorm:"type:text" json:"error,omitempty"`
+	StartedAt   time.Time         `json:"started_at"`
+	CompletedAt *time.Time        `json:"completed_at,omitempty"`
+}
@@ -0,0 +1,41 @@
+package entities
+
+import "time"
+
+type OutboundEmailKind string
+type OutboundEmailStatus string
+
+const (
+	OutboundEmailDigest OutboundEmailKind = "digest"
+	OutboundEmailInvite OutboundEmailKind = "invite"
+	// OutboundEmailApplicationStatus tells an applicant their application
+	// moved to a new stage.
+	OutboundEmailApplicationStatus OutboundEmailKind = "application_status"
+
+	OutboundEmailPending   OutboundEmailStatus = "pending"
+	OutboundEmailSending   OutboundEmailStatus = "sending"
+	OutboundEmailSent      OutboundEmailStatus = "sent"
+	OutboundEmailCancelled OutboundEmailStatus = "cancelled"
+	OutboundEmailFailed    OutboundEmailStatus = "failed"
+)
+
+type OutboundEmail struct {
+	ID          uint                `gorm:"primaryKey" json:"id"`
+	UserID      *uint               `gorm:"index" json:"user_id,omitempty"`
+	Kind        OutboundEmailKind   `gorm:"not null" json:"kind"`
+	Recipient   string              `gorm:"not null" json:"recipient"`
+	Subject     string              `gorm:"not null" json:"subject"`
+	Body        string              `gorm:"type:text;not null" json:"-"`
+	Status      OutboundEmailStatus `gorm:"not null;default:'pending'" json:"status"`
+	Attempts    int                 `gorm:"default:0" json:"attempts"`
+	LastError   string              `gorm:"type:text" json:"last_error,omitempty"`
+	SendAfter   time.Time           `gorm:"not null;index" json:"send_after"`
+	SentAt      *time.Time          `json:"sent_at,omitempty"`
+	CancelledAt *time.Time          `json:"cancelled_at,omitempty"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
+
+func (OutboundEmail) TableName() string {
+	return "outbound_emails"
+}
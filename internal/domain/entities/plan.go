@@ -0,0 +1,53 @@
+package entities
+
+import "time"
+
+type Plan string
+
+const (
+	PlanBasic     Plan = "basic"
+	PlanPremium   Plan = "premium"
+	PlanRecruiter Plan = "recruiter"
+)
+
+type Entitlement string
+
+const (
+	EntitlementTalentSearch Entitlement = "talent_search"
+)
+
+var planEntitlements = map[Plan][]Entitlement{
+	PlanRecruiter: {EntitlementTalentSearch},
+}
+
+func (u *User) HasEntitlement(entitlement Entitlement) bool {
+	for _, e := range u.Entitlements() {
+		if e == entitlement {
+			return true
+		}
+	}
+	return false
+}
+
+// Entitlements lists what the user's plan grants, which is nothing once a
+// paid plan has lapsed.
+func (u *User) Entitlements() []Entitlement {
+	return planEntitlements[u.CurrentPlan()]
+}
+
+// CurrentPlan is the plan the user is on now: basic once a paid plan has
+// lapsed or when none was ever set.
+func (u *User) CurrentPlan() Plan {
+	if u.Plan == "" || (u.PremiumUntil != nil && u.PremiumUntil.Before(time.Now())) {
+		return PlanBasic
+	}
+	return u.Plan
+}
+
+// UserUsage is what a user currently holds of the resources the service
+// keeps per account.
+type UserUsage struct {
+	AttachmentCount    int64
+	AttachmentBytes    int64
+	PendingInvitations int64
+}
@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"time"
+)
+
+type PolicyType string
+
+const (
+	PolicyTermsOfService PolicyType = "terms_of_service"
+	PolicyPrivacyPolicy  PolicyType = "privacy_policy"
+)
+
+var RequiredPolicyTypes = []PolicyType{PolicyTermsOfService, PolicyPrivacyPolicy}
+
+type PolicyVersion struct {
+	ID          uint       `gorm:"primaryKey" json:"id"`
+	Type        PolicyType `gorm:"not null;uniqueIndex:idx_policy_versions_type_version" json:"type"`
+	Version     string     `gorm:"not null;uniqueIndex:idx_policy_versions_type_version" json:"version"`
+	Title       string     `gorm:"not null" json:"title"`
+	Content     string     `gorm:"type:text" json:"content,omitempty"`
+	URL         string     `json:"url,omitempty"`
+	Summary     string     `gorm:"type:text" json:"summary,omitempty"`
+	PublishedBy *uint      `json:"published_by,omitempty"`
+	PublishedAt time.Time  `gorm:"not null;index" json:"published_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type PolicyAcceptance struct {
+	ID              uint      `gorm:"primaryKey" json:"id"`
+	UserID          uint      `gorm:"not null;uniqueIndex:idx_policy_acceptances_user_version" json:"user_id"`
+	PolicyVersionID uint      `gorm:"not null;uniqueIndex:idx_policy_acceptances_user_version" json:"policy_version_id"`
+	IPAddress       string    `gorm:"type:text;serializer:encrypted" json:"-"`
+	UserAgent       string    `json:"-"`
+	AcceptedAt      time.Time `gorm:"not null" json:"accepted_at"`
+
+	PolicyVersion PolicyVersion `gorm:"foreignKey:PolicyVersionID" json:"policy_version,omitempty"`
+}
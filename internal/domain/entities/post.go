@@ -5,41 +5,182 @@ import (
 	"time"
 )
 
+type PostType string
+
+type PostSuggestionStatus string
+
+type ReactionType string
+
+const (
+	PostTypeStandard        PostType = "standard"
+	PostTypeNewJob          PostType = "new_job"
+	PostTypeWorkAnniversary PostType = "work_anniversary"
+	PostTypePromotion       PostType = "promotion"
+	PostTypeCertification   PostType = "certification"
+
+	PostSuggestionPending   PostSuggestionStatus = "pending"
+	PostSuggestionPublished PostSuggestionStatus = "published"
+	PostSuggestionDismissed PostSuggestionStatus = "dismissed"
+
+	ReactionLike       ReactionType = "like"
+	ReactionCelebrate  ReactionType = "celebrate"
+	ReactionSupport    ReactionType = "support"
+	ReactionInsightful ReactionType = "insightful"
+	ReactionFunny      ReactionType = "funny"
+)
+
+type PostEvent struct {
+	ExperienceID  *uint      `json:"experience_id,omitempty"`
+	Title         string     `json:"title,omitempty"`
+	PreviousTitle string     `json:"previous_title,omitempty"`
+	Company       string     `json:"company,omitempty"`
+	Years         int        `json:"years,omitempty"`
+	Certification string     `json:"certification,omitempty"`
+	Issuer        string     `json:"issuer,omitempty"`
+	Date          *time.Time `json:"date,omitempty"`
+}
+
 type Post struct {
-	ID        uint           `gorm:"primaryKey" json:"id"`
-	UserID    uint           `gorm:"not null" json:"user_id"`
-	Content   string         `gorm:"type:text;not null" json:"content"`
-	ImageURL  string         `json:"image_url,omitempty"`
-	LikeCount int            `gorm:"default:0" json:"like_count"`
-	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
-	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+	ID              uint           `gorm:"primaryKey" json:"id"`
+	UserID          uint           `gorm:"not null" json:"user_id"`
+	Content         string         `gorm:"type:text;not null" json:"content"`
+	RenderedContent string         `gorm:"type:text;not null;default:''" json:"rendered_content"`
+	ImageURL        string         `json:"image_url,omitempty"`
+	ImageAltText    string         `json:"image_alt_text,omitempty"`
+	Type            PostType       `gorm:"default:'standard'" json:"type"`
+	Event           *PostEvent     `gorm:"column:event_data;type:jsonb;serializer:json" json:"event,omitempty"`
+	SharedPostID    *uint          `gorm:"index" json:"shared_post_id,omitempty"`
+	ReactionCount   int            `gorm:"default:0" json:"reaction_count"`
+	HiddenAt        *time.Time     `gorm:"index" json:"-"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	DeletedAt       gorm.DeletedAt `gorm:"index" json:"-"`
 
-	User     User      `gorm:"foreignKey:UserID" json:"user,omitempty"`
-	Likes    []Like    `gorm:"foreignKey:PostID" json:"likes,omitempty"`
-	Comments []Comment `gorm:"foreignKey:PostID" json:"comments,omitempty"`
+	User       User       `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	SharedPost *Post      `gorm:"foreignKey:SharedPostID" json:"shared_post,omitempty"`
+	Reactions  []Reaction `gorm:"foreignKey:PostID" json:"reactions,omitempty"`
+	Comments   []Comment  `gorm:"foreignKey:PostID" json:"comments,omitempty"`
 }
 
-type Like struct {
+type Reaction struct {
 	ID        uint           `gorm:"primaryKey" json:"id"`
-	UserID    uint           `gorm:"not null" json:"user_id"`
-	PostID    uint           `gorm:"not null" json:"post_id"`
+	UserID    uint           `gorm:"not null;uniqueIndex:idx_reactions_user_post" json:"user_id"`
+	PostID    uint           `gorm:"not null;uniqueIndex:idx_reactions_user_post" json:"post_id"`
+	Type      ReactionType   `gorm:"size:20;not null;default:'like'" json:"type"`
 	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
 	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
 
 	User User `gorm:"foreignKey:UserID" json:"user,omitempty"`
 	Post Post `gorm:"foreignKey:PostID" json:"post,omitempty"`
 }
 
+// PostReactionSummary is what a post shows about its reactions: the count
+// of each reaction type and the first people to react, in reaction order.
+type PostReactionSummary struct {
+	Counts        map[ReactionType]int64
+	FirstReactors []User
+}
+
 type Comment struct {
+	ID              uint           `gorm:"primaryKey" json:"id"`
+	UserID          uint           `gorm:"not null" json:"user_id"`
+	PostID          uint           `gorm:"not null" json:"post_id"`
+	ParentCommentID *uint          `gorm:"index" json:"parent_comment_id,omitempty"`
+	Content         string         `gorm:"type:text;not null" json:"content"`
+	RenderedContent string         `gorm:"type:text;not null;default:''" json:"rendered_content"`
+	HiddenAt        *time.Time     `gorm:"index" json:"-"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	DeletedAt       gorm.DeletedAt `gorm:"index" json:"-"`
+
+	User User `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Post Post `gorm:"foreignKey:PostID" json:"post,omitempty"`
+}
+
+type CommentReaction struct {
 	ID        uint           `gorm:"primaryKey" json:"id"`
-	UserID    uint           `gorm:"not null" json:"user_id"`
-	PostID    uint           `gorm:"not null" json:"post_id"`
-	Content   string         `gorm:"type:text;not null" json:"content"`
+	UserID    uint           `gorm:"not null;uniqueIndex:idx_comment_reactions_user_comment" json:"user_id"`
+	CommentID uint           `gorm:"not null;uniqueIndex:idx_comment_reactions_user_comment;index" json:"comment_id"`
+	Type      ReactionType   `gorm:"size:20;not null;default:'like'" json:"type"`
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
 	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
 
-	User User `gorm:"foreignKey:UserID" json:"user,omitempty"`
-	Post Post `gorm:"foreignKey:PostID" json:"post,omitempty"`
+	User    User    `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Comment Comment `gorm:"foreignKey:CommentID" json:"comment,omitempty"`
+}
+
+type Hashtag struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	Name      string    `gorm:"size:100;not null;uniqueIndex" json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type PostHashtag struct {
+	PostID    uint      `gorm:"primaryKey" json:"post_id"`
+	HashtagID uint      `gorm:"primaryKey;index" json:"hashtag_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type HashtagFollow struct {
+	UserID    uint      `gorm:"primaryKey" json:"user_id"`
+	HashtagID uint      `gorm:"primaryKey;index" json:"hashtag_id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	Hashtag Hashtag `gorm:"foreignKey:HashtagID" json:"hashtag,omitempty"`
+}
+
+type HashtagStats struct {
+	Name      string `json:"name"`
+	PostCount int64  `json:"post_count"`
+}
+
+type MentionEntityType string
+
+const (
+	MentionInPost    MentionEntityType = "post"
+	MentionInComment MentionEntityType = "comment"
+)
+
+// Mention records that a post or comment @mentions a user.
+type Mention struct {
+	ID              uint              `gorm:"primaryKey" json:"id"`
+	EntityType      MentionEntityType `gorm:"size:20;not null;uniqueIndex:idx_mentions_entity_user" json:"entity_type"`
+	EntityID        uint              `gorm:"not null;uniqueIndex:idx_mentions_entity_user" json:"entity_id"`
+	MentionedUserID uint              `gorm:"not null;uniqueIndex:idx_mentions_entity_user;index" json:"mentioned_user_id"`
+	MentionerID     uint              `gorm:"not null;index" json:"mentioner_id"`
+	CreatedAt       time.Time         `json:"created_at"`
+
+	MentionedUser User `gorm:"foreignKey:MentionedUserID" json:"mentioned_user,omitempty"`
+}
+
+type PostSuggestion struct {
+	ID           uint                 `gorm:"primaryKey" json:"id"`
+	UserID       uint                 `gorm:"not null;index" json:"user_id"`
+	ExperienceID *uint                `json:"experience_id,omitempty"`
+	Type         PostType             `gorm:"not null" json:"type"`
+	Event        PostEvent            `gorm:"column:event_data;type:jsonb;serializer:json" json:"event"`
+	Status       PostSuggestionStatus `gorm:"default:'pending'" json:"status"`
+	PostID       *uint                `json:"post_id,omitempty"`
+	CreatedAt    time.Time            `json:"created_at"`
+	UpdatedAt    time.Time            `json:"updated_at"`
+}
+
+type FeedFeedback string
+
+const (
+	FeedbackShowLess FeedFeedback = "show_less"
+	FeedbackHide     FeedFeedback = "hide"
+)
+
+// HiddenPost is a post a user asked to stop seeing in their feed. Reshares
+// of it are hidden too, and the feedback counts against the post's author
+// when the user's feed is ranked.
+type HiddenPost struct {
+	ID        uint         `gorm:"primaryKey" json:"id"`
+	UserID    uint         `gorm:"not null;uniqueIndex:idx_hidden_posts_user_post" json:"user_id"`
+	PostID    uint         `gorm:"not null;uniqueIndex:idx_hidden_posts_user_post;index" json:"post_id"`
+	Feedback  FeedFeedback `gorm:"size:20;not null" json:"feedback"`
+	CreatedAt time.Time    `json:"created_at"`
 }
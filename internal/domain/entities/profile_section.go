@@ -0,0 +1,37 @@
+package entities
+
+import "time"
+
+type ProfileSectionType string
+
+const (
+	ProfileSectionProjects     ProfileSectionType = "projects"
+	ProfileSectionPublications ProfileSectionType = "publications"
+	ProfileSectionVolunteering ProfileSectionType = "volunteering"
+	ProfileSectionLanguages    ProfileSectionType = "languages"
+)
+
+// DefaultProfileSectionOrder lists every section a profile can show, built-in
+// and custom, in the order used when the user has not arranged them.
+var DefaultProfileSectionOrder = []string{
+	"about",
+	"experience",
+	"skills",
+	"certificates",
+	string(ProfileSectionProjects),
+	string(ProfileSectionPublications),
+	string(ProfileSectionVolunteering),
+	string(ProfileSectionLanguages),
+	"recommendations",
+}
+
+// ProfileSection is a single entry in one of the custom profile sections. The
+// payload shape depends on Type and is validated before it is stored.
+type ProfileSection struct {
+	ID        uint                   `gorm:"primaryKey" json:"id"`
+	UserID    uint                   `gorm:"not null;index:idx_profile_sections_user_id_type" json:"user_id"`
+	Type      ProfileSectionType     `gorm:"size:20;not null;index:idx_profile_sections_user_id_type" json:"type"`
+	Payload   map[string]interface{} `gorm:"type:jsonb;serializer:json;not null" json:"payload"`
+	CreatedAt time.Time              `json:"created_at"`
+	UpdatedAt time.Time              `json:"updated_at"`
+}
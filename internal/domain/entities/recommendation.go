@@ -0,0 +1,53 @@
+package entities
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type RecommendationStatus string
+
+const (
+	RecommendationRequested         RecommendationStatus = "requested"
+	RecommendationDraft             RecommendationStatus = "draft"
+	RecommendationPending           RecommendationStatus = "pending"
+	RecommendationRevisionRequested RecommendationStatus = "revision_requested"
+	RecommendationApproved          RecommendationStatus = "approved"
+	RecommendationDeclined          RecommendationStatus = "declined"
+)
+
+// Recommendation is a written endorsement from AuthorID about RecipientID.
+// It starts either as a request from the recipient or as a draft by the
+// author, and only shows on the recipient's profile once they approve it.
+// Approved recommendations are shown in Position order unless hidden.
+type Recommendation struct {
+	ID             uint                 `gorm:"primaryKey" json:"id"`
+	AuthorID       uint                 `gorm:"not null;index" json:"author_id"`
+	RecipientID    uint                 `gorm:"not null;index" json:"recipient_id"`
+	Relationship   string               `gorm:"size:100" json:"relationship,omitempty"`
+	Body           string               `gorm:"type:text" json:"body,omitempty"`
+	Status         RecommendationStatus `gorm:"size:20;not null;index" json:"status"`
+	RequestMessage string               `gorm:"type:text" json:"request_message,omitempty"`
+	RevisionNote   string               `gorm:"type:text" json:"revision_note,omitempty"`
+	IsHidden       bool                 `gorm:"not null;default:false" json:"is_hidden"`
+	Position       int                  `gorm:"not null;default:0" json:"position"`
+	SubmittedAt    *time.Time           `json:"submitted_at,omitempty"`
+	ApprovedAt     *time.Time           `json:"approved_at,omitempty"`
+	CreatedAt      time.Time            `json:"created_at"`
+	UpdatedAt      time.Time            `json:"updated_at"`
+	DeletedAt      gorm.DeletedAt       `gorm:"index" json:"-"`
+
+	Author    User `gorm:"foreignKey:AuthorID" json:"author,omitempty"`
+	Recipient User `gorm:"foreignKey:RecipientID" json:"recipient,omitempty"`
+}
+
+// RecommendationRevision snapshots the text each time the author submits it
+// for approval, so both sides can see how it changed across revisions.
+type RecommendationRevision struct {
+	ID               uint      `gorm:"primaryKey" json:"id"`
+	RecommendationID uint      `gorm:"not null;index" json:"recommendation_id"`
+	Relationship     string    `gorm:"size:100" json:"relationship,omitempty"`
+	Body             string    `gorm:"type:text;not null" json:"body"`
+	CreatedAt        time.Time `json:"created_at"`
+}
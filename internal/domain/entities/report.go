@@ -0,0 +1,107 @@
+package entities
+
+import "time"
+
+type ReportTargetType string
+type ReportReason string
+type ReportStatus string
+
+const (
+	ReportTargetPost    ReportTargetType = "post"
+	ReportTargetComment ReportTargetType = "comment"
+	ReportTargetJob     ReportTargetType = "job"
+	ReportTargetUser    ReportTargetType = "user"
+
+	ReportReasonSpam           ReportReason = "spam"
+	ReportReasonHarassment     ReportReason = "harassment"
+	ReportReasonHateSpeech     ReportReason = "hate_speech"
+	ReportReasonViolence       ReportReason = "violence"
+	ReportReasonSexualContent  ReportReason = "sexual_content"
+	ReportReasonMisinformation ReportReason = "misinformation"
+	ReportReasonScam           ReportReason = "scam"
+	ReportReasonImpersonation  ReportReason = "impersonation"
+	ReportReasonOther          ReportReason = "other"
+
+	ReportOpen      ReportStatus = "open"
+	ReportInReview  ReportStatus = "in_review"
+	ReportActioned  ReportStatus = "actioned"
+	ReportDismissed ReportStatus = "dismissed"
+)
+
+// Actioned reports are final: undoing the action is done on the content or
+// the strike, not by reopening the report.
+var reportTransitions = map[ReportStatus][]ReportStatus{
+	ReportOpen:      {ReportInReview, ReportActioned, ReportDismissed},
+	ReportInReview:  {ReportOpen, ReportActioned, ReportDismissed},
+	ReportDismissed: {ReportOpen},
+}
+
+func (s ReportStatus) CanTransitionTo(next ReportStatus) bool {
+	for _, allowed := range reportTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// Hideable reports whether a moderator can hide the target. Users are dealt
+// with through strikes and suspension instead.
+func (t ReportTargetType) Hideable() bool {
+	return t == ReportTargetPost || t == ReportTargetComment || t == ReportTargetJob
+}
+
+// Report is one user's report of a piece of content or an account. A user
+// can report the same target once; TargetUserID is the account the target
+// belongs to, kept so strikes and notifications do not depend on the
+// content still existing.
+type Report struct {
+	ID            uint             `gorm:"primaryKey" json:"id"`
+	ReporterID    *uint            `gorm:"index;uniqueIndex:idx_reports_reporter_target" json:"reporter_id,omitempty"`
+	TargetType    ReportTargetType `gorm:"type:varchar(20);not null;uniqueIndex:idx_reports_reporter_target;index:idx_reports_target" json:"target_type"`
+	TargetID      uint             `gorm:"not null;uniqueIndex:idx_reports_reporter_target;index:idx_reports_target" json:"target_id"`
+	TargetUserID  *uint            `gorm:"index" json:"target_user_id,omitempty"`
+	Reason        ReportReason     `gorm:"type:varchar(32);not null" json:"reason"`
+	Details       string           `gorm:"type:text" json:"details,omitempty"`
+	Status        ReportStatus     `gorm:"type:varchar(20);not null;default:'open';index" json:"status"`
+	ModeratorID   *uint            `json:"moderator_id,omitempty"`
+	ModeratorNote string           `gorm:"type:text" json:"moderator_note,omitempty"`
+	ContentHidden bool             `gorm:"default:false" json:"content_hidden"`
+	StrikeID      *uint            `json:"strike_id,omitempty"`
+	ResolvedAt    *time.Time       `json:"resolved_at,omitempty"`
+	CreatedAt     time.Time        `json:"created_at"`
+	UpdatedAt     time.Time        `json:"updated_at"`
+}
+
+// ReportFilter narrows the moderation queue. Empty fields match everything.
+type ReportFilter struct {
+	Status     ReportStatus
+	TargetType ReportTargetType
+	Reason     ReportReason
+}
+
+// ReportTarget is what the moderation queue shows of a reported item.
+type ReportTarget struct {
+	Type     ReportTargetType
+	ID       uint
+	UserID   uint
+	Summary  string
+	HiddenAt *time.Time
+}
+
+// Strike is a moderator's mark against an account. Strikes count towards
+// automatic suspension until they expire or are revoked.
+type Strike struct {
+	ID          uint       `gorm:"primaryKey" json:"id"`
+	UserID      uint       `gorm:"not null;index" json:"user_id"`
+	ReportID    *uint      `json:"report_id,omitempty"`
+	ModeratorID *uint      `json:"moderator_id,omitempty"`
+	Reason      string     `gorm:"type:text;not null" json:"reason"`
+	ExpiresAt   time.Time  `gorm:"not null" json:"expires_at"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+func (s *Strike) Active(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
@@ -0,0 +1,24 @@
+package entities
+
+import "time"
+
+type SearchType string
+
+const (
+	SearchTypePeople SearchType = "people"
+	SearchTypeJobs   SearchType = "jobs"
+)
+
+type RecentSearch struct {
+	ID         uint              `gorm:"primaryKey" json:"id"`
+	UserID     uint              `gorm:"not null;uniqueIndex:idx_recent_searches_user_query" json:"user_id"`
+	Type       SearchType        `gorm:"not null;uniqueIndex:idx_recent_searches_user_query" json:"type"`
+	Query      string            `gorm:"not null;uniqueIndex:idx_recent_searches_user_query" json:"query"`
+	Filters    map[string]string `gorm:"type:jsonb;serializer:json;default:'{}'" json:"filters,omitempty"`
+	SearchedAt time.Time         `gorm:"not null" json:"searched_at"`
+	CreatedAt  time.Time         `json:"created_at"`
+}
+
+func (RecentSearch) TableName() string {
+	return "recent_searches"
+}
@@ -11,16 +11,24 @@ const (
 	SessionActive  SessionStatus = "active"
 	SessionRevoked SessionStatus = "revoked"
 	SessionExpired SessionStatus = "expired"
+
+	AuthMethodPassword = "password"
+	AuthMethodSSO      = "sso"
 )
 
 type Session struct {
 	ID           uint           `gorm:"primaryKey" json:"id"`
 	UserID       uint           `gorm:"not null" json:"user_id"`
-	RefreshToken string         `gorm:"not null;unique" json:"refresh_token"`
-	TokenHash    string         `gorm:"not null" json:"-"`
+	TokenHash    string         `gorm:"not null;uniqueIndex" json:"-"`
 	Status       SessionStatus  `gorm:"default:'active'" json:"status"`
 	UserAgent    *string        `json:"user_agent,omitempty"`
-	IPAddress    *string        `gorm:"type:inet" json:"ip_address,omitempty"`
+	IPAddress    *string        `gorm:"type:text;serializer:encrypted" json:"ip_address,omitempty"`
+	DeviceType   string         `gorm:"size:16" json:"device_type,omitempty"`
+	OS           string         `gorm:"column:os;size:64" json:"os,omitempty"`
+	Browser      string         `gorm:"size:64" json:"browser,omitempty"`
+	Location     string         `gorm:"type:text;serializer:encrypted" json:"location,omitempty"`
+	AuthMethod   string         `gorm:"size:16;default:'password'" json:"auth_method"`
+	SSOCompanyID *uint          `json:"sso_company_id,omitempty"`
 	ExpiresAt    time.Time      `gorm:"not null" json:"expires_at"`
 	LastUsedAt   *time.Time     `json:"last_used_at,omitempty"`
 	CreatedAt    time.Time      `json:"created_at"`
@@ -55,3 +63,48 @@ func (s *Session) SetUserAgent(ua string) {
 
 	s.UserAgent = &ua
 }
+
+// RecoveryCode is a one-time code for regaining access without email. Only
+// the HMAC of the code is stored.
+type RecoveryCode struct {
+	ID        uint       `gorm:"primaryKey" json:"id"`
+	UserID    uint       `gorm:"not null;index" json:"user_id"`
+	CodeHash  string     `gorm:"not null;uniqueIndex" json:"-"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type SecurityEventType string
+
+const (
+	SecurityEventLogin                SecurityEventType = "login"
+	SecurityEventNewDevice            SecurityEventType = "new_device"
+	SecurityEventPasswordChanged      SecurityEventType = "password_changed"
+	SecurityEventEmailChanged         SecurityEventType = "email_changed"
+	SecurityEventSessionRevoked       SecurityEventType = "session_revoked"
+	SecurityEventSessionsRevoked      SecurityEventType = "all_sessions_revoked"
+	SecurityEventOtherSessionsRevoked SecurityEventType = "other_sessions_revoked"
+	SecurityEventReported             SecurityEventType = "reported_not_me"
+	SecurityEventTwoFactorOn          SecurityEventType = "two_factor_enabled"
+	SecurityEventTwoFactorOff         SecurityEventType = "two_factor_disabled"
+	SecurityEventPhoneAdded           SecurityEventType = "phone_added"
+	SecurityEventPhoneRemoved         SecurityEventType = "phone_removed"
+	SecurityEventAPIKeyCreated        SecurityEventType = "api_key_created"
+	SecurityEventAPIKeyRevoked        SecurityEventType = "api_key_revoked"
+	SecurityEventPasswordResetForced  SecurityEventType = "password_reset_forced"
+	SecurityEventSuspended            SecurityEventType = "account_suspended"
+	SecurityEventUnsuspended          SecurityEventType = "account_unsuspended"
+)
+
+// SecurityEvent is a user-visible record of sensitive account activity,
+// listed on the security activity page. ReportedAt is set when the user
+// flags the event as not theirs.
+type SecurityEvent struct {
+	ID         uint              `gorm:"primaryKey" json:"id"`
+	UserID     uint              `gorm:"not null;index:idx_security_events_user_created,priority:1" json:"user_id"`
+	Type       SecurityEventType `gorm:"type:varchar(32);not null" json:"type"`
+	UserAgent  *string           `json:"user_agent,omitempty"`
+	IPAddress  *string           `gorm:"type:text;serializer:encrypted" json:"ip_address,omitempty"`
+	ReportedAt *time.Time        `json:"reported_at,omitempty"`
+	CreatedAt  time.Time         `gorm:"index:idx_security_events_user_created,priority:2" json:"created_at"`
+}
@@ -0,0 +1,57 @@
+package entities
+
+import (
+	"strings"
+	"time"
+)
+
+// Industry is a reference entry that users, companies and jobs point at
+// instead of typing their own industry names.
+type Industry struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	Name      string    `gorm:"size:100;not null;uniqueIndex" json:"name"`
+	Slug      string    `gorm:"size:100;not null;uniqueIndex" json:"slug"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Location is a normalized place. City and Region are empty for coarser
+// entries, so a country-level location covers every city inside it when
+// filtering. Aliases hold alternative spellings used by the matcher.
+type Location struct {
+	ID          uint      `gorm:"primaryKey" json:"id"`
+	City        string    `gorm:"size:100;not null;default:'';uniqueIndex:idx_locations_place,priority:3" json:"city,omitempty"`
+	Region      string    `gorm:"size:100;not null;default:'';uniqueIndex:idx_locations_place,priority:2" json:"region,omitempty"`
+	Country     string    `gorm:"size:2;not null;uniqueIndex:idx_locations_place,priority:1" json:"country"`
+	CountryName string    `gorm:"size:100;not null" json:"country_name"`
+	Aliases     []string  `gorm:"type:jsonb;serializer:json;default:'[]'" json:"aliases,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// DisplayName is the label stored alongside location IDs on profiles and
+// jobs, e.g. "Bandung, West Java, Indonesia".
+func (l *Location) DisplayName() string {
+	parts := make([]string, 0, 3)
+	for _, part := range []string{l.City, l.Region, l.CountryName} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// MatchNames lists the spellings free text may use for this location.
+func (l *Location) MatchNames() []string {
+	names := append([]string{l.DisplayName()}, l.Aliases...)
+	switch {
+	case l.City != "":
+		names = append(names, l.City, l.City+", "+l.CountryName, l.City+", "+l.Country)
+		if l.Region != "" {
+			names = append(names, l.City+", "+l.Region)
+		}
+	case l.Region != "":
+		names = append(names, l.Region, l.Region+", "+l.Country)
+	default:
+		names = append(names, l.CountryName, l.Country)
+	}
+	return names
+}
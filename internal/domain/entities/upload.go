@@ -0,0 +1,50 @@
+package entities
+
+import "time"
+
+type UploadKind string
+type UploadStage string
+
+const (
+	UploadKindConnectionsImport UploadKind = "connections_import"
+
+	// An upload moves through the stages in this order; it stops at
+	// failed from whichever stage rejected it.
+	UploadQueued     UploadStage = "queued"
+	UploadValidating UploadStage = "validating"
+	UploadScanning   UploadStage = "scanning"
+	UploadProcessing UploadStage = "processing"
+	UploadCompleted  UploadStage = "completed"
+	UploadFailed     UploadStage = "failed"
+)
+
+// UploadJob tracks a file accepted by the API while the upload pipeline
+// validates, scans and processes it. ResourceID points at what the file
+// feeds, such as a connection import, and FailedStage records where a
+// failed job stopped.
+type UploadJob struct {
+	ID             uint        `gorm:"primaryKey" json:"id"`
+	UserID         uint        `gorm:"not null;index" json:"user_id"`
+	Kind           UploadKind  `gorm:"size:50;not null" json:"kind"`
+	ResourceID     *uint       `json:"resource_id,omitempty"`
+	Filename       string      `gorm:"size:255" json:"filename"`
+	Size           int64       `gorm:"default:0" json:"size"`
+	Stage          UploadStage `gorm:"size:20;not null;default:'queued';index" json:"stage"`
+	FailedStage    UploadStage `gorm:"size:20" json:"failed_stage,omitempty"`
+	TotalItems     int         `gorm:"default:0" json:"total_items"`
+	ProcessedItems int         `gorm:"default:0" json:"processed_items"`
+	Error          string      `gorm:"type:text" json:"error,omitempty"`
+	ValidatedAt    *time.Time  `json:"validated_at,omitempty"`
+	ScannedAt      *time.Time  `json:"scanned_at,omitempty"`
+	CompletedAt    *time.Time  `json:"completed_at,omitempty"`
+	CreatedAt      time.Time   `json:"created_at"`
+	UpdatedAt      time.Time   `json:"updated_at"`
+}
+
+func (UploadJob) TableName() string {
+	return "upload_jobs"
+}
+
+func (j *UploadJob) Finished() bool {
+	return j.Stage == UploadCompleted || j.Stage == UploadFailed
+}
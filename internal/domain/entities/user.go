@@ -6,25 +6,68 @@ import (
 )
 
 type User struct {
-	ID             uint           `gorm:"primaryKey" json:"id"`
-	Email          string         `gorm:"unique;not null" json:"email"`
-	Username       string         `gorm:"unique;not null" json:"username"`
-	FullName       string         `gorm:"not null" json:"full_name"`
-	Password       string         `gorm:"not null" json:"-"`
-	ProfilePicture string         `json:"profile_picture,omitempty"`
-	Bio            string         `json:"bio,omitempty"`
-	Location       string         `json:"location,omitempty"`
-	Website        string         `json:"website,omitempty"`
-	IsVerified     bool           `gorm:"default:false" json:"is_verified"`
-	IsPremium      bool           `gorm:"default:false" json:"is_premium"`
-	PremiumUntil   *time.Time     `json:"premium_until,omitempty"`
-	CreatedAt      time.Time      `json:"created_at"`
-	UpdatedAt      time.Time      `json:"updated_at"`
-	DeletedAt      gorm.DeletedAt `gorm:"index" json:"-"`
+	ID                 uint           `gorm:"primaryKey" json:"id"`
+	Email              string         `gorm:"unique;not null" json:"email"`
+	Username           string         `gorm:"unique;not null" json:"username"`
+	FullName           string         `gorm:"not null" json:"full_name"`
+	Password           string         `gorm:"not null" json:"-"`
+	ProfilePicture     string         `json:"profile_picture,omitempty"`
+	ProfileThumbnail   string         `json:"profile_thumbnail,omitempty"`
+	ProfileAltText     string         `json:"profile_alt_text,omitempty"`
+	ProfileBanner      string         `json:"profile_banner,omitempty"`
+	ProfileBannerLarge string         `json:"profile_banner_large,omitempty"`
+	ProfileBannerSmall string         `json:"profile_banner_small,omitempty"`
+	Bio                string         `json:"bio,omitempty"`
+	Location           string         `json:"location,omitempty"`
+	LocationID         *uint          `gorm:"index" json:"location_id,omitempty"`
+	Industry           string         `gorm:"size:100" json:"industry,omitempty"`
+	IndustryID         *uint          `gorm:"index" json:"industry_id,omitempty"`
+	Website            string         `json:"website,omitempty"`
+	DateOfBirth        string         `gorm:"type:text;serializer:encrypted" json:"-"`
+	Region             string         `gorm:"size:2" json:"region,omitempty"`
+	Headline           string         `json:"headline,omitempty"`
+	Skills             []string       `gorm:"type:jsonb;serializer:json;default:'[]'" json:"skills,omitempty"`
+	SectionOrder       []string       `gorm:"type:jsonb;serializer:json;default:'[]'" json:"section_order,omitempty"`
+	YearsOfExperience  int            `gorm:"default:0" json:"years_of_experience"`
+	OpenToWork         bool           `gorm:"default:false" json:"open_to_work"`
+	RecruiterVisible   bool           `gorm:"default:true" json:"recruiter_visible"`
+	EmailVerified      bool           `gorm:"default:false" json:"email_verified"`
+	RecoveryEmail      string         `json:"-"`
+	PhoneNumber        string         `gorm:"type:text;serializer:encrypted" json:"-"`
+	PhoneVerifiedAt    *time.Time     `json:"-"`
+	TOTPSecret         string         `gorm:"column:totp_secret;type:text;serializer:encrypted" json:"-"`
+	TwoFactorEnabled   bool           `gorm:"default:false" json:"-"`
+	TwoFactorEnabledAt *time.Time     `json:"-"`
+	IsVerified         bool           `gorm:"default:false" json:"is_verified"`
+	IdentityVerifiedAt *time.Time     `json:"identity_verified_at,omitempty"`
+	IsPremium          bool           `gorm:"default:false" json:"is_premium"`
+	PremiumUntil       *time.Time     `json:"premium_until,omitempty"`
+	Plan               Plan           `gorm:"default:'basic'" json:"plan"`
+	ReadReceipts       bool           `gorm:"default:true" json:"read_receipts"`
+	LifeEventReminders bool           `gorm:"default:true" json:"life_event_reminders"`
+	ShareBirthday      bool           `gorm:"default:false" json:"share_birthday"`
+	ShowPresence       bool           `gorm:"default:true" json:"show_presence"`
+	LastActiveAt       *time.Time     `json:"last_active_at,omitempty"`
+	PersonalizedSearch bool           `gorm:"default:true" json:"personalized_search"`
+	IsAdmin            bool           `gorm:"default:false" json:"-"`
+	InviteID           *uint          `gorm:"index" json:"-"`
+	InvitedByID        *uint          `gorm:"index" json:"invited_by_id,omitempty"`
+	SuspendedAt        *time.Time     `json:"-"`
+	SuspensionReason   string         `gorm:"type:text" json:"-"`
+	CreatedAt          time.Time      `json:"created_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
+	DeletedAt          gorm.DeletedAt `gorm:"index" json:"-"`
 
 	Posts        []Post        `gorm:"foreignKey:UserID" json:"posts,omitempty"`
 	Jobs         []Job         `gorm:"foreignKey:UserID" json:"jobs,omitempty"`
 	Applications []Application `gorm:"foreignKey:UserID" json:"applications,omitempty"`
-	Likes        []Like        `gorm:"foreignKey:UserID" json:"-"`
+	Reactions    []Reaction    `gorm:"foreignKey:UserID" json:"-"`
 	Comments     []Comment     `gorm:"foreignKey:UserID" json:"-"`
 }
+
+// IsSuspended reports whether an admin has suspended the account. A
+// suspended user cannot sign in, refresh a session or use API keys, and
+// access tokens issued before the suspension are rejected.
+func (u *User) IsSuspended() bool {
+	return u.SuspendedAt != nil
+}
@@ -0,0 +1,14 @@
+package entities
+
+import (
+	"time"
+)
+
+type ViewRollup struct {
+	EntityType    string    `gorm:"primaryKey;size:50" json:"entity_type"`
+	EntityID      uint      `gorm:"primaryKey" json:"entity_id"`
+	Day           time.Time `gorm:"primaryKey;type:date" json:"day"`
+	UniqueViewers int64     `gorm:"not null;default:0" json:"unique_viewers"`
+	TotalViews    int64     `gorm:"not null;default:0" json:"total_views"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type AccountDeletionRepository interface {
+	Create(ctx context.Context, deletion *entities.AccountDeletion) error
+	GetByID(ctx context.Context, id uint) (*entities.AccountDeletion, error)
+	GetByUserID(ctx context.Context, userID uint) (*entities.AccountDeletion, error)
+	GetByStatus(ctx context.Context, status entities.AccountDeletionStatus, limit, offset int) ([]*entities.AccountDeletion, error)
+	Update(ctx context.Context, deletion *entities.AccountDeletion) error
+	ClaimDue(ctx context.Context, now, staleBefore time.Time, maxAttempts, limit int) ([]*entities.AccountDeletion, error)
+
+	ScrubContent(ctx context.Context, userID uint) (map[string]int64, error)
+	ScrubAuditLogs(ctx context.Context, userID uint) (map[string]int64, error)
+	GetStorageKeys(ctx context.Context, userID uint) ([]string, error)
+	ClearStorageReferences(ctx context.Context, userID uint) (map[string]int64, error)
+	TombstoneUser(ctx context.Context, userID uint) error
+	CountResidualData(ctx context.Context, userID uint) (map[string]int64, error)
+}
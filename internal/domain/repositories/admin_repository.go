@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type AdminUserRepository interface {
+	Search(ctx context.Context, filter entities.AdminUserFilter, limit, offset int) ([]*entities.User, int64, error)
+	GetActivity(ctx context.Context, userID uint) (*entities.UserActivity, error)
+	// Suspend and Unsuspend write the audit entry in the same transaction,
+	// so the change is not made unless it is recorded.
+	Suspend(ctx context.Context, userID uint, reason string, suspendedAt time.Time, audit *entities.AdminAuditLog) error
+	Unsuspend(ctx context.Context, userID uint, audit *entities.AdminAuditLog) error
+}
+
+type AdminAuditLogRepository interface {
+	Create(ctx context.Context, entry *entities.AdminAuditLog) error
+	// List returns entries newest first; a zero actorID or targetUserID
+	// leaves that filter off.
+	List(ctx context.Context, actorID, targetUserID uint, limit, offset int) ([]*entities.AdminAuditLog, int64, error)
+}
@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type AnalyticsRepository interface {
+	Create(ctx context.Context, event *entities.AnalyticsEvent) error
+	CreateBatch(ctx context.Context, events []*entities.AnalyticsEvent) error
+	GetByRange(ctx context.Context, eventType string, from, to time.Time, limit, offset int) ([]*entities.AnalyticsEvent, error)
+	CountByType(ctx context.Context, from, to time.Time) (map[string]int64, error)
+	ArchiveOlderThan(ctx context.Context, cutoff time.Time, batchSize int) (int64, error)
+	DeleteOlderThan(ctx context.Context, cutoff time.Time, batchSize int) (int64, error)
+
+	UpsertViewRollups(ctx context.Context, rollups []*entities.ViewRollup) error
+	GetViewRollups(ctx context.Context, entityType string, entityID uint, from, to time.Time) ([]*entities.ViewRollup, error)
+}
+
+type DataExportRepository interface {
+	Claim(ctx context.Context, run *entities.DataExportRun) (bool, error)
+	Update(ctx context.Context, run *entities.DataExportRun) error
+	StreamRecords(ctx context.Context, source string, from, to time.Time, fn func(record *entities.DataExportRecord) error) error
+}
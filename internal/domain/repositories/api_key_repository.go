@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *entities.APIKey) error
+	GetByHash(ctx context.Context, keyHash string) (*entities.APIKey, error)
+	ListByUser(ctx context.Context, userID uint) ([]*entities.APIKey, error)
+	CountActiveByUser(ctx context.Context, userID uint) (int64, error)
+	Revoke(ctx context.Context, userID, id uint, revokedAt time.Time) error
+	UpdateLastUsedAt(ctx context.Context, id uint, lastUsedAt time.Time) error
+}
@@ -0,0 +1,33 @@
+package repositories
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+)
+
+type AssessmentRepository interface {
+	Create(ctx context.Context, assessment *entities.SkillAssessment) error
+	GetByID(ctx context.Context, id uint) (*entities.SkillAssessment, error)
+	List(ctx context.Context, activeOnly bool, limit, offset int) ([]*entities.SkillAssessment, error)
+	Update(ctx context.Context, assessment *entities.SkillAssessment) error
+
+	CreateQuestion(ctx context.Context, question *entities.AssessmentQuestion) error
+	GetQuestion(ctx context.Context, id uint) (*entities.AssessmentQuestion, error)
+	GetQuestions(ctx context.Context, assessmentID uint) ([]*entities.AssessmentQuestion, error)
+	GetQuestionsByIDs(ctx context.Context, ids []uint) ([]*entities.AssessmentQuestion, error)
+	UpdateQuestion(ctx context.Context, question *entities.AssessmentQuestion) error
+	DeleteQuestion(ctx context.Context, id uint) error
+	CountQuestions(ctx context.Context, assessmentIDs []uint) (map[uint]int64, error)
+	RandomQuestionIDs(ctx context.Context, assessmentID uint, count int) ([]uint, error)
+}
+
+type AssessmentAttemptRepository interface {
+	Create(ctx context.Context, attempt *entities.AssessmentAttempt) error
+	GetByID(ctx context.Context, id uint) (*entities.AssessmentAttempt, error)
+	GetLatest(ctx context.Context, userID uint, assessmentIDs []uint) (map[uint]*entities.AssessmentAttempt, error)
+	Finish(ctx context.Context, attempt *entities.AssessmentAttempt, badge *entities.SkillBadge) error
+}
+
+type SkillBadgeRepository interface {
+	GetByUserID(ctx context.Context, userID uint) ([]*entities.SkillBadge, error)
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+)
+
+type BookmarkRepository interface {
+	SaveJob(ctx context.Context, userID, jobID uint) error
+	UnsaveJob(ctx context.Context, userID, jobID uint) error
+	GetSavedJobs(ctx context.Context, userID uint, limit, offset int) ([]*entities.SavedJob, error)
+
+	SavePost(ctx context.Context, userID, postID uint) error
+	UnsavePost(ctx context.Context, userID, postID uint) error
+	GetSavedPosts(ctx context.Context, userID uint, limit, offset int) ([]*entities.SavedPost, error)
+}
@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type CompanyRepository interface {
+	Create(ctx context.Context, company *entities.Company) error
+	GetByID(ctx context.Context, id uint) (*entities.Company, error)
+	GetByDomain(ctx context.Context, domain string) (*entities.Company, error)
+	GetByOwnerID(ctx context.Context, ownerID uint) ([]*entities.Company, error)
+	Update(ctx context.Context, company *entities.Company) error
+	SetVerified(ctx context.Context, id uint, verified bool, verifiedAt *time.Time) error
+	SetDomainVerification(ctx context.Context, id uint, token string, verifiedAt *time.Time) error
+}
+
+type CompanyVerificationRepository interface {
+	Create(ctx context.Context, verification *entities.CompanyVerification) error
+	GetByID(ctx context.Context, id uint) (*entities.CompanyVerification, error)
+	GetByCompanyID(ctx context.Context, companyID uint) ([]*entities.CompanyVerification, error)
+	GetByStatus(ctx context.Context, status entities.CompanyVerificationStatus, limit, offset int) ([]*entities.CompanyVerification, error)
+	HasPendingRequest(ctx context.Context, companyID uint) (bool, error)
+	Update(ctx context.Context, verification *entities.CompanyVerification) error
+}
+
+type CompanyMemberRepository interface {
+	Upsert(ctx context.Context, member *entities.CompanyMember) error
+	Get(ctx context.Context, companyID, userID uint) (*entities.CompanyMember, error)
+	GetByCompanyID(ctx context.Context, companyID uint) ([]*entities.CompanyMember, error)
+	GetCompanyIDsByRole(ctx context.Context, userID uint, role entities.CompanyMemberRole) ([]uint, error)
+	GetByUserIDs(ctx context.Context, userIDs []uint) ([]*entities.CompanyMember, error)
+	Delete(ctx context.Context, companyID, userID uint) error
+}
+
+type CompanyTeamRepository interface {
+	Create(ctx context.Context, team *entities.CompanyTeam) error
+	GetByID(ctx context.Context, id uint) (*entities.CompanyTeam, error)
+	GetByCompanyID(ctx context.Context, companyID uint) ([]*entities.CompanyTeam, error)
+	Update(ctx context.Context, team *entities.CompanyTeam) error
+	Delete(ctx context.Context, id uint) error
+}
+
+type TeamMemberRepository interface {
+	Upsert(ctx context.Context, member *entities.TeamMember) error
+	Get(ctx context.Context, teamID, userID uint) (*entities.TeamMember, error)
+	GetByTeamID(ctx context.Context, teamID uint) ([]*entities.TeamMember, error)
+	Delete(ctx context.Context, teamID, userID uint) error
+}
+
+type CompanySSORepository interface {
+	GetByCompanyID(ctx context.Context, companyID uint) (*entities.CompanySSOConfig, error)
+	GetByDomain(ctx context.Context, domain string) (*entities.CompanySSOConfig, error)
+	Save(ctx context.Context, config *entities.CompanySSOConfig) error
+	Delete(ctx context.Context, companyID uint) error
+}
+
+type SSOIdentityRepository interface {
+	GetBySubject(ctx context.Context, companyID uint, subject string) (*entities.SSOIdentity, error)
+	Create(ctx context.Context, identity *entities.SSOIdentity) error
+	TouchLastLogin(ctx context.Context, id uint, at time.Time) error
+}
+
+type CompanyFollowRepository interface {
+	Follow(ctx context.Context, companyID, userID uint) error
+	Unfollow(ctx context.Context, companyID, userID uint) error
+	IsFollowing(ctx context.Context, companyID, userID uint) (bool, error)
+	CountFollowers(ctx context.Context, companyID uint) (int64, error)
+}
+
+type CompanyAnalyticsRepository interface {
+	RollupDay(ctx context.Context, day time.Time) (int64, error)
+	GetDailyStats(ctx context.Context, companyID uint, from, to time.Time) ([]*entities.CompanyDailyStats, error)
+	GetJobStats(ctx context.Context, companyID uint, from, to time.Time) ([]*entities.JobDailyStats, error)
+}
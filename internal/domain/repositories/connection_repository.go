@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"linked-clone/internal/domain/entities"
+	"time"
 )
 
 type ConnectionRepository interface {
@@ -15,4 +16,21 @@ type ConnectionRepository interface {
 	Update(ctx context.Context, connection *entities.Connection) error
 	Delete(ctx context.Context, id uint) error
 	GetMutualConnections(ctx context.Context, userID1, userID2 uint, limit, offset int) ([]*entities.Connection, error)
+	RespondToRequests(ctx context.Context, addresseeID uint, ids []uint, accept bool) ([]*entities.Connection, error)
+	GetConnectionIDs(ctx context.Context, userID uint) ([]uint, error)
+	GetBlockedIDs(ctx context.Context, userID uint) ([]uint, error)
+}
+
+type ConnectionImportRepository interface {
+	Create(ctx context.Context, connectionImport *entities.ConnectionImport) error
+	GetByID(ctx context.Context, id uint) (*entities.ConnectionImport, error)
+	Update(ctx context.Context, connectionImport *entities.ConnectionImport) error
+	UpdateProgress(ctx context.Context, id uint, processedRows int) error
+}
+
+type ConnectionSuggestionRepository interface {
+	Rebuild(ctx context.Context, computedAt time.Time, perUserLimit int) (int64, error)
+	LatestComputedAt(ctx context.Context) (*time.Time, error)
+	GetByUserID(ctx context.Context, userID uint, limit, offset int) ([]*entities.ConnectionSuggestion, error)
+	Dismiss(ctx context.Context, userID, suggestedUserID uint) error
 }
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+)
+
+type ExperienceRepository interface {
+	Create(ctx context.Context, experience *entities.Experience) error
+	GetByID(ctx context.Context, id uint) (*entities.Experience, error)
+	GetByUserID(ctx context.Context, userID uint) ([]*entities.Experience, error)
+	Update(ctx context.Context, experience *entities.Experience) error
+	Delete(ctx context.Context, id uint) error
+	GetCurrentStartingOn(ctx context.Context, month int, days []int, afterID uint, limit int) ([]*entities.Experience, error)
+}
@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+)
+
+type ExperimentRepository interface {
+	Create(ctx context.Context, experiment *entities.Experiment) error
+	GetByID(ctx context.Context, id uint) (*entities.Experiment, error)
+	GetCurrentByKey(ctx context.Context, key string) (*entities.Experiment, error)
+	List(ctx context.Context, status entities.ExperimentStatus, limit, offset int) ([]*entities.Experiment, error)
+	Update(ctx context.Context, experiment *entities.Experiment) error
+
+	Assign(ctx context.Context, assignment *entities.ExperimentAssignment) (*entities.ExperimentAssignment, error)
+	CountAssignments(ctx context.Context, experimentID uint) (map[string]int64, error)
+	CountExposures(ctx context.Context, experimentID uint) (map[string]int64, error)
+}
@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+)
+
+type IdentityVerificationRepository interface {
+	Create(ctx context.Context, verification *entities.IdentityVerification) error
+	GetByID(ctx context.Context, id uint) (*entities.IdentityVerification, error)
+	GetLatestByUserID(ctx context.Context, userID uint) (*entities.IdentityVerification, error)
+	GetByStatus(ctx context.Context, status entities.IdentityVerificationStatus, limit, offset int) ([]*entities.IdentityVerification, error)
+	HasOpenRequest(ctx context.Context, userID uint) (bool, error)
+	Update(ctx context.Context, verification *entities.IdentityVerification) error
+
+	CreateAudit(ctx context.Context, audit *entities.IdentityVerificationAudit) error
+	GetAuditTrail(ctx context.Context, verificationID uint) ([]*entities.IdentityVerificationAudit, error)
+}
@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type InviteRepository interface {
+	Create(ctx context.Context, invite *entities.Invite) error
+	GetByID(ctx context.Context, id uint) (*entities.Invite, error)
+	GetByCode(ctx context.Context, code string) (*entities.Invite, error)
+	Update(ctx context.Context, invite *entities.Invite) error
+	ListByInviter(ctx context.Context, inviterID uint, limit, offset int) ([]*entities.Invite, int64, error)
+	// CountSeats is how many people the inviter's codes can still or did
+	// admit: every seat of an open code, and the used seats of a revoked one.
+	CountSeats(ctx context.Context, inviterID uint) (int64, error)
+	// Redeem takes one use of a usable code, or returns ErrRecordNotFound.
+	Redeem(ctx context.Context, code string, now time.Time) (*entities.Invite, error)
+	// Release gives back a use taken by Redeem when registration fails.
+	Release(ctx context.Context, id uint) error
+	GetInvitees(ctx context.Context, inviteID uint) ([]*entities.User, error)
+}
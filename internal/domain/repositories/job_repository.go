@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"linked-clone/internal/domain/entities"
+	"time"
 )
 
 type JobRepository interface {
@@ -12,8 +13,13 @@ type JobRepository interface {
 	GetAll(ctx context.Context, filters map[string]interface{}, limit, offset int) ([]*entities.Job, error)
 	Update(ctx context.Context, job *entities.Job) error
 	Delete(ctx context.Context, id uint) error
+	GetByCompanyIDsAndStatus(ctx context.Context, companyIDs []uint, status entities.JobStatus, limit, offset int) ([]*entities.Job, error)
+	GetActiveByPostersOrCompanies(ctx context.Context, posterIDs, companyIDs []uint, excludeUserID uint, limit, offset int) ([]*entities.Job, error)
 	IncrementApplicationCount(ctx context.Context, jobID uint) error
-	Search(ctx context.Context, query string, filters map[string]interface{}, limit, offset int) ([]*entities.Job, error)
+	IncrementViewCount(ctx context.Context, jobID uint) error
+	DeactivatePastDeadline(ctx context.Context, now time.Time) (int64, error)
+	GetByIDs(ctx context.Context, ids []uint) ([]*entities.Job, error)
+	GetPublishedBetween(ctx context.Context, query string, filters map[string]interface{}, since, until time.Time, limit int) ([]*entities.Job, error)
 }
 
 type ApplicationRepository interface {
@@ -22,6 +28,29 @@ type ApplicationRepository interface {
 	GetByUserID(ctx context.Context, userID uint, limit, offset int) ([]*entities.Application, error)
 	GetByJobID(ctx context.Context, jobID uint, limit, offset int) ([]*entities.Application, error)
 	Update(ctx context.Context, application *entities.Application) error
+	UpdateStatus(ctx context.Context, application *entities.Application, from entities.ApplicationStatus, change *entities.ApplicationStatusHistory) (bool, error)
+	GetStatusHistory(ctx context.Context, applicationID uint) ([]*entities.ApplicationStatusHistory, error)
 	Delete(ctx context.Context, id uint) error
 	FindByUserAndJob(ctx context.Context, userID, jobID uint) (*entities.Application, error)
 }
+
+type JobTemplateRepository interface {
+	Create(ctx context.Context, template *entities.JobTemplate) error
+	GetByID(ctx context.Context, id uint) (*entities.JobTemplate, error)
+	GetPersonal(ctx context.Context, userID uint) ([]*entities.JobTemplate, error)
+	GetByCompanyID(ctx context.Context, companyID uint) ([]*entities.JobTemplate, error)
+	Update(ctx context.Context, template *entities.JobTemplate) error
+	Delete(ctx context.Context, id uint) error
+}
+
+type JobAlertRepository interface {
+	Create(ctx context.Context, alert *entities.JobAlert) error
+	GetByID(ctx context.Context, id uint) (*entities.JobAlert, error)
+	GetByUserID(ctx context.Context, userID uint) ([]*entities.JobAlert, error)
+	GetByUnsubscribeToken(ctx context.Context, token string) (*entities.JobAlert, error)
+	CountByUserID(ctx context.Context, userID uint) (int64, error)
+	Update(ctx context.Context, alert *entities.JobAlert) error
+	Delete(ctx context.Context, id uint) error
+	GetDue(ctx context.Context, now time.Time, limit int) ([]*entities.JobAlert, error)
+	MarkChecked(ctx context.Context, id uint, checkedAt, nextRunAt time.Time, sent bool) error
+}
@@ -0,0 +1,38 @@
+package repositories
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+)
+
+type CourseRepository interface {
+	Create(ctx context.Context, course *entities.Course) error
+	GetByID(ctx context.Context, id uint) (*entities.Course, error)
+	List(ctx context.Context, publishedOnly bool, skill string, limit, offset int) ([]*entities.Course, error)
+	Update(ctx context.Context, course *entities.Course) error
+
+	CreateLesson(ctx context.Context, lesson *entities.Lesson) error
+	GetLesson(ctx context.Context, id uint) (*entities.Lesson, error)
+	GetLessons(ctx context.Context, courseID uint) ([]*entities.Lesson, error)
+	UpdateLesson(ctx context.Context, lesson *entities.Lesson) error
+	DeleteLesson(ctx context.Context, id uint) error
+	CountLessons(ctx context.Context, courseIDs []uint) (map[uint]int64, error)
+}
+
+type EnrollmentRepository interface {
+	Enroll(ctx context.Context, enrollment *entities.CourseEnrollment) error
+	Unenroll(ctx context.Context, userID, courseID uint) error
+	Get(ctx context.Context, userID, courseID uint) (*entities.CourseEnrollment, error)
+	GetByUserID(ctx context.Context, userID uint, limit, offset int) ([]*entities.CourseEnrollment, error)
+	GetByCourseIDs(ctx context.Context, userID uint, courseIDs []uint) (map[uint]*entities.CourseEnrollment, error)
+
+	CompleteLesson(ctx context.Context, completion *entities.LessonCompletion) error
+	GetCompletedLessonIDs(ctx context.Context, userID, courseID uint) ([]uint, error)
+	CountCompletedLessons(ctx context.Context, userID uint, courseIDs []uint) (map[uint]int64, error)
+	CompleteCourse(ctx context.Context, userID, courseID uint, certificate *entities.CourseCertificate) (*entities.CourseCertificate, error)
+}
+
+type CertificateRepository interface {
+	GetByUserID(ctx context.Context, userID uint) ([]*entities.CourseCertificate, error)
+	GetByCode(ctx context.Context, code string) (*entities.CourseCertificate, error)
+}
@@ -0,0 +1,30 @@
+package repositories
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type MentorshipProfileRepository interface {
+	Upsert(ctx context.Context, profile *entities.MentorshipProfile) error
+	Get(ctx context.Context, userID uint, role entities.MentorshipRole) (*entities.MentorshipProfile, error)
+	GetByUserID(ctx context.Context, userID uint) ([]*entities.MentorshipProfile, error)
+	GetActive(ctx context.Context, role entities.MentorshipRole) ([]*entities.MentorshipProfile, error)
+	Delete(ctx context.Context, userID uint, role entities.MentorshipRole) error
+}
+
+type MentorshipMatchRepository interface {
+	Create(ctx context.Context, match *entities.MentorshipMatch) error
+	GetByID(ctx context.Context, id uint) (*entities.MentorshipMatch, error)
+	GetByUserID(ctx context.Context, userID uint, status entities.MentorshipMatchStatus, limit, offset int) ([]*entities.MentorshipMatch, error)
+	GetPairs(ctx context.Context) (map[uint]map[uint]entities.MentorshipMatchStatus, error)
+	CountOpenByMentor(ctx context.Context) (map[uint]int64, error)
+	RecordAcceptance(ctx context.Context, id uint, role entities.MentorshipRole, at time.Time) (*entities.MentorshipMatch, error)
+	Transition(ctx context.Context, id uint, from, to entities.MentorshipMatchStatus, updates map[string]interface{}) (bool, error)
+	Update(ctx context.Context, match *entities.MentorshipMatch) error
+	DeclineOpenForRole(ctx context.Context, userID uint, role entities.MentorshipRole) (int64, error)
+	ExpireProposals(ctx context.Context, before time.Time) (int64, error)
+	GetDueCheckIns(ctx context.Context, now time.Time, limit int) ([]*entities.MentorshipMatch, error)
+	ScheduleCheckIn(ctx context.Context, id uint, previous, next time.Time) (bool, error)
+}
@@ -0,0 +1,31 @@
+package repositories
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type ConversationRepository interface {
+	Create(ctx context.Context, conversation *entities.Conversation) error
+	GetByID(ctx context.Context, id uint) (*entities.Conversation, error)
+	FindDirect(ctx context.Context, userID1, userID2 uint) (*entities.Conversation, error)
+	GetUserConversations(ctx context.Context, userID uint, filter entities.ConversationFilter, limit, offset int) ([]*entities.Conversation, error)
+	GetParticipant(ctx context.Context, conversationID, userID uint) (*entities.ConversationParticipant, error)
+	UpdateParticipant(ctx context.Context, participant *entities.ConversationParticipant) error
+	Unarchive(ctx context.Context, conversationID uint) error
+	UpdateLastMessageAt(ctx context.Context, conversationID uint, lastMessageAt time.Time) error
+	UpdateLastReadAt(ctx context.Context, conversationID, userID uint, lastReadAt time.Time) error
+}
+
+type MessageRepository interface {
+	Create(ctx context.Context, message *entities.Message) error
+	GetByID(ctx context.Context, id uint) (*entities.Message, error)
+	GetByIDAt(ctx context.Context, id uint, createdAt time.Time) (*entities.Message, error)
+	GetByConversationID(ctx context.Context, conversationID uint, since time.Time, limit, offset int) ([]*entities.Message, error)
+	CountUnreadInConversation(ctx context.Context, conversationID, userID uint, since time.Time) (int64, error)
+	CountUnread(ctx context.Context, userID uint) (int64, error)
+	CountUnreadByUser(ctx context.Context) (map[uint]int64, error)
+	MarkDelivered(ctx context.Context, conversationID, recipientID uint, since, deliveredAt time.Time) (int64, error)
+	MarkRead(ctx context.Context, conversationID, readerID uint, since, upTo, readAt time.Time) (int64, error)
+}
@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type NotificationRepository interface {
+	Create(ctx context.Context, notification *entities.Notification) error
+	GetByID(ctx context.Context, id uint) (*entities.Notification, error)
+	GetByUserID(ctx context.Context, userID uint, unreadOnly bool, limit, offset int) ([]*entities.Notification, error)
+	MarkAsRead(ctx context.Context, userID, notificationID uint) (bool, error)
+	MarkAllAsRead(ctx context.Context, userID uint) (int64, error)
+	CountUnread(ctx context.Context, userID uint) (int64, error)
+	CountUnreadByUser(ctx context.Context) (map[uint]int64, error)
+	Delete(ctx context.Context, id uint) error
+	ArchiveOlderThan(ctx context.Context, cutoff time.Time, batchSize int) (int64, error)
+	DeleteOlderThan(ctx context.Context, cutoff time.Time, batchSize int) (int64, error)
+}
+
+type ReminderRunRepository interface {
+	Claim(ctx context.Context, run *entities.ReminderRun) (bool, error)
+	Update(ctx context.Context, run *entities.ReminderRun) error
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type OutboundEmailRepository interface {
+	Create(ctx context.Context, email *entities.OutboundEmail) error
+	GetByID(ctx context.Context, id uint) (*entities.OutboundEmail, error)
+	GetPendingByUserID(ctx context.Context, userID uint, limit, offset int) ([]*entities.OutboundEmail, error)
+	Cancel(ctx context.Context, id uint, cancelledAt time.Time) (bool, error)
+	ClaimDue(ctx context.Context, now, staleBefore time.Time, limit int) ([]*entities.OutboundEmail, error)
+	Update(ctx context.Context, email *entities.OutboundEmail) error
+}
@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+)
+
+type PolicyRepository interface {
+	CreateVersion(ctx context.Context, version *entities.PolicyVersion) error
+	GetVersionByID(ctx context.Context, id uint) (*entities.PolicyVersion, error)
+	GetVersion(ctx context.Context, policyType entities.PolicyType, version string) (*entities.PolicyVersion, error)
+	GetVersionsByType(ctx context.Context, policyType entities.PolicyType) ([]*entities.PolicyVersion, error)
+	GetCurrentVersions(ctx context.Context) ([]*entities.PolicyVersion, error)
+	GetPendingForUser(ctx context.Context, userID uint) ([]*entities.PolicyVersion, error)
+
+	CreateAcceptance(ctx context.Context, acceptance *entities.PolicyAcceptance) error
+	GetUserAcceptances(ctx context.Context, userID uint) ([]*entities.PolicyAcceptance, error)
+}
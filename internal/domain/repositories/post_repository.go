@@ -3,30 +3,81 @@ package repositories
 import (
 	"context"
 	"linked-clone/internal/domain/entities"
+	"time"
 )
 
 type PostRepository interface {
 	Create(ctx context.Context, post *entities.Post) error
 	GetByID(ctx context.Context, id uint) (*entities.Post, error)
 	GetByUserID(ctx context.Context, userID uint, limit, offset int) ([]*entities.Post, error)
-	GetFeed(ctx context.Context, userID uint, limit, offset int) ([]*entities.Post, error)
+	GetFeed(ctx context.Context, viewerID uint, authorIDs []uint, limit, offset int) ([]*entities.Post, error)
+	GetFeedPostIDs(ctx context.Context, viewerID uint, authorIDs []uint, limit int) ([]uint, error)
+	GetByIDs(ctx context.Context, ids []uint) ([]*entities.Post, error)
 	Update(ctx context.Context, post *entities.Post) error
 	Delete(ctx context.Context, id uint) error
-	IncrementLikeCount(ctx context.Context, postID uint) error
-	DecrementLikeCount(ctx context.Context, postID uint) error
+	IncrementReactionCount(ctx context.Context, postID uint) error
+	DecrementReactionCount(ctx context.Context, postID uint) error
 }
 
-type LikeRepository interface {
-	Create(ctx context.Context, like *entities.Like) error
+type ReactionRepository interface {
+	Create(ctx context.Context, reaction *entities.Reaction) error
+	UpdateType(ctx context.Context, reactionID uint, reactionType entities.ReactionType) error
 	Delete(ctx context.Context, userID, postID uint) error
-	FindByUserAndPost(ctx context.Context, userID, postID uint) (*entities.Like, error)
-	GetPostLikes(ctx context.Context, postID uint, limit, offset int) ([]*entities.Like, error)
+	FindByUserAndPost(ctx context.Context, userID, postID uint) (*entities.Reaction, error)
+	GetPostReactions(ctx context.Context, postID uint, reactionType entities.ReactionType, limit, offset int) ([]*entities.Reaction, error)
+	SummarizeByPostIDs(ctx context.Context, postIDs []uint, reactorLimit int) (map[uint]*entities.PostReactionSummary, error)
 }
 
 type CommentRepository interface {
 	Create(ctx context.Context, comment *entities.Comment) error
 	GetByID(ctx context.Context, id uint) (*entities.Comment, error)
 	GetByPostID(ctx context.Context, postID uint, limit, offset int) ([]*entities.Comment, error)
+	GetReplies(ctx context.Context, parentID uint, limit, offset int) ([]*entities.Comment, error)
+	GetReplyPreviews(ctx context.Context, parentIDs []uint, perThread int) (map[uint][]*entities.Comment, error)
+	CountReplies(ctx context.Context, parentIDs []uint) (map[uint]int64, error)
+	CountByPostIDs(ctx context.Context, postIDs []uint) (map[uint]int64, error)
 	Update(ctx context.Context, comment *entities.Comment) error
 	Delete(ctx context.Context, id uint) error
 }
+
+type CommentReactionRepository interface {
+	Create(ctx context.Context, reaction *entities.CommentReaction) error
+	UpdateType(ctx context.Context, reactionID uint, reactionType entities.ReactionType) error
+	Delete(ctx context.Context, userID, commentID uint) error
+	FindByUserAndComment(ctx context.Context, userID, commentID uint) (*entities.CommentReaction, error)
+	CountByCommentIDs(ctx context.Context, commentIDs []uint) (map[uint]map[entities.ReactionType]int64, error)
+}
+
+type HiddenPostRepository interface {
+	Hide(ctx context.Context, hidden *entities.HiddenPost) error
+	Unhide(ctx context.Context, userID, postID uint) error
+	// CountByAuthor counts the user's feedback given since the given time,
+	// keyed by the author of the post it was given on.
+	CountByAuthor(ctx context.Context, userID uint, since time.Time) (map[uint]map[entities.FeedFeedback]int64, error)
+}
+
+type PostSuggestionRepository interface {
+	Create(ctx context.Context, suggestion *entities.PostSuggestion) error
+	GetByID(ctx context.Context, id uint) (*entities.PostSuggestion, error)
+	GetPendingByUserID(ctx context.Context, userID uint) ([]*entities.PostSuggestion, error)
+	ExistsForExperience(ctx context.Context, experienceID uint, postType entities.PostType) (bool, error)
+	Update(ctx context.Context, suggestion *entities.PostSuggestion) error
+}
+
+type HashtagRepository interface {
+	SetPostHashtags(ctx context.Context, postID uint, names []string) error
+	GetOrCreate(ctx context.Context, name string) (*entities.Hashtag, error)
+	GetByName(ctx context.Context, name string) (*entities.Hashtag, error)
+	GetPostIDs(ctx context.Context, hashtagID uint, limit, offset int) ([]uint, error)
+	GetTrending(ctx context.Context, since time.Time, limit int) ([]*entities.HashtagStats, error)
+	Follow(ctx context.Context, userID, hashtagID uint) error
+	Unfollow(ctx context.Context, userID, hashtagID uint) error
+	IsFollowing(ctx context.Context, userID, hashtagID uint) (bool, error)
+	CountFollowers(ctx context.Context, hashtagID uint) (int64, error)
+	GetFollowed(ctx context.Context, userID uint) ([]*entities.Hashtag, error)
+}
+
+type MentionRepository interface {
+	SetMentions(ctx context.Context, entityType entities.MentionEntityType, entityID, mentionerID uint, userIDs []uint) ([]uint, error)
+	GetByEntityIDs(ctx context.Context, entityType entities.MentionEntityType, entityIDs []uint) (map[uint][]*entities.Mention, error)
+}
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+)
+
+type ProfileSectionRepository interface {
+	Create(ctx context.Context, section *entities.ProfileSection) error
+	GetByID(ctx context.Context, id uint) (*entities.ProfileSection, error)
+	GetByUserID(ctx context.Context, userID uint) ([]*entities.ProfileSection, error)
+	CountByType(ctx context.Context, userID uint, sectionType entities.ProfileSectionType) (int64, error)
+	Update(ctx context.Context, section *entities.ProfileSection) error
+	Delete(ctx context.Context, id uint) error
+}
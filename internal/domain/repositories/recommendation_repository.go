@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+)
+
+type RecommendationRepository interface {
+	Create(ctx context.Context, recommendation *entities.Recommendation) error
+	GetByID(ctx context.Context, id uint) (*entities.Recommendation, error)
+	FindOpen(ctx context.Context, authorID, recipientID uint) (*entities.Recommendation, error)
+	Update(ctx context.Context, recommendation *entities.Recommendation) error
+	Submit(ctx context.Context, recommendation *entities.Recommendation) error
+	Delete(ctx context.Context, id uint) error
+
+	GetReceived(ctx context.Context, recipientID uint, status entities.RecommendationStatus, limit, offset int) ([]*entities.Recommendation, error)
+	GetGiven(ctx context.Context, authorID uint, status entities.RecommendationStatus, limit, offset int) ([]*entities.Recommendation, error)
+	GetVisible(ctx context.Context, recipientID uint, limit, offset int) ([]*entities.Recommendation, error)
+	GetApprovedIDs(ctx context.Context, recipientID uint) ([]uint, error)
+	NextPosition(ctx context.Context, recipientID uint) (int, error)
+	Reorder(ctx context.Context, recipientID uint, ids []uint) error
+
+	GetRevisions(ctx context.Context, recommendationID uint) ([]*entities.RecommendationRevision, error)
+}
@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type ReportRepository interface {
+	Create(ctx context.Context, report *entities.Report) error
+	GetByID(ctx context.Context, id uint) (*entities.Report, error)
+	// UpdateStatus saves a move between undecided states only if the report
+	// is still in status from. It reports false when someone else changed
+	// the report first.
+	UpdateStatus(ctx context.Context, report *entities.Report, from entities.ReportStatus) (bool, error)
+	// Decide saves the decision on a report that is still open or in review
+	// in one transaction with its effects: the target is hidden at
+	// ResolvedAt when ContentHidden is set, and strike, when not nil, is
+	// created and linked to the report. It reports false, having written
+	// nothing, when another moderator decided the report first.
+	Decide(ctx context.Context, report *entities.Report, strike *entities.Strike) (bool, error)
+	// List returns the queue oldest first, so reports are worked in the
+	// order they came in.
+	List(ctx context.Context, filter entities.ReportFilter, limit, offset int) ([]*entities.Report, int64, error)
+	ListByReporter(ctx context.Context, reporterID uint, limit, offset int) ([]*entities.Report, error)
+	HasReported(ctx context.Context, reporterID uint, targetType entities.ReportTargetType, targetID uint) (bool, error)
+	CountUnresolvedForTarget(ctx context.Context, targetType entities.ReportTargetType, targetID uint) (int64, error)
+	// ResolveForTarget closes the other open and in-review reports of a
+	// target with the outcome of the one a moderator actioned, and returns
+	// them.
+	ResolveForTarget(ctx context.Context, decided *entities.Report) ([]*entities.Report, error)
+}
+
+// ModeratedContentRepository reads and hides reported items directly, since
+// the content modules' own repositories leave hidden items out.
+type ModeratedContentRepository interface {
+	GetTarget(ctx context.Context, targetType entities.ReportTargetType, targetID uint) (*entities.ReportTarget, error)
+	// SetHidden hides the item, or restores it when hiddenAt is nil. Hidden
+	// jobs are also deactivated, and stay inactive when restored until their
+	// owner reopens them.
+	SetHidden(ctx context.Context, targetType entities.ReportTargetType, targetID uint, hiddenAt *time.Time) error
+}
+
+type StrikeRepository interface {
+	Create(ctx context.Context, strike *entities.Strike) error
+	GetByID(ctx context.Context, id uint) (*entities.Strike, error)
+	Update(ctx context.Context, strike *entities.Strike) error
+	ListByUser(ctx context.Context, userID uint) ([]*entities.Strike, error)
+	CountActive(ctx context.Context, userID uint, now time.Time) (int64, error)
+}
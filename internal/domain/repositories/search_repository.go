@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+)
+
+type RecentSearchRepository interface {
+	Upsert(ctx context.Context, search *entities.RecentSearch) error
+	GetByID(ctx context.Context, id uint) (*entities.RecentSearch, error)
+	GetByUserID(ctx context.Context, userID uint, searchType entities.SearchType, limit int) ([]*entities.RecentSearch, error)
+	Delete(ctx context.Context, id uint) error
+	DeleteByUserID(ctx context.Context, userID uint) error
+	Prune(ctx context.Context, userID uint, keep int) error
+}
@@ -8,14 +8,30 @@ import (
 
 type SessionRepository interface {
 	Create(ctx context.Context, session *entities.Session) error
-	GetByRefreshToken(ctx context.Context, refreshToken string) (*entities.Session, error)
+	GetByID(ctx context.Context, id uint) (*entities.Session, error)
 	GetByTokenHash(ctx context.Context, tokenHash string) (*entities.Session, error)
 	GetUserActiveSessions(ctx context.Context, userID uint, limit, offset int) ([]*entities.Session, error)
 	Update(ctx context.Context, session *entities.Session) error
 	UpdateLastUsedAt(ctx context.Context, sessionID uint, lastUsedAt time.Time) error
 	RevokeSession(ctx context.Context, sessionID uint) error
 	RevokeUserSessions(ctx context.Context, userID uint) error
-	RevokeSessionByToken(ctx context.Context, refreshToken string) error
+	RevokeUserSessionsExcept(ctx context.Context, userID, keepSessionID uint) (int64, error)
+	RevokeSessionByTokenHash(ctx context.Context, tokenHash string) error
 	DeleteExpiredSessions(ctx context.Context) error
 	Delete(ctx context.Context, id uint) error
 }
+
+type RecoveryCodeRepository interface {
+	Replace(ctx context.Context, userID uint, codes []*entities.RecoveryCode) error
+	Consume(ctx context.Context, userID uint, codeHash string) (bool, error)
+	CountUnused(ctx context.Context, userID uint) (int64, error)
+}
+
+type SecurityEventRepository interface {
+	Create(ctx context.Context, event *entities.SecurityEvent) error
+	GetByID(ctx context.Context, id uint) (*entities.SecurityEvent, error)
+	ListByUser(ctx context.Context, userID uint, limit, offset int) ([]*entities.SecurityEvent, error)
+	HasUserAgent(ctx context.Context, userID uint, userAgent string, since time.Time) (bool, error)
+	CountByType(ctx context.Context, userID uint, eventType entities.SecurityEventType) (int64, error)
+	MarkReported(ctx context.Context, id uint, reportedAt time.Time) error
+}
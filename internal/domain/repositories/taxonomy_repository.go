@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+)
+
+type IndustryRepository interface {
+	Create(ctx context.Context, industry *entities.Industry) (bool, error)
+	GetByID(ctx context.Context, id uint) (*entities.Industry, error)
+	Search(ctx context.Context, query string, limit int) ([]*entities.Industry, error)
+}
+
+type LocationRepository interface {
+	Create(ctx context.Context, location *entities.Location) (bool, error)
+	GetByID(ctx context.Context, id uint) (*entities.Location, error)
+	Search(ctx context.Context, query, country string, limit int) ([]*entities.Location, error)
+	GetAll(ctx context.Context) ([]*entities.Location, error)
+}
@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+	"linked-clone/internal/domain/entities"
+	"time"
+)
+
+type UploadJobRepository interface {
+	Create(ctx context.Context, job *entities.UploadJob) error
+	GetByID(ctx context.Context, id uint) (*entities.UploadJob, error)
+	Update(ctx context.Context, job *entities.UploadJob) error
+	UpdateProgress(ctx context.Context, id uint, processed, total int) error
+	// FailStale fails unfinished jobs not touched since staleBefore and
+	// returns them.
+	FailStale(ctx context.Context, staleBefore time.Time, reason string) ([]*entities.UploadJob, error)
+}
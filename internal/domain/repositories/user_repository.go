@@ -13,7 +13,17 @@ type UserRepository interface {
 	GetByUsername(ctx context.Context, username string) (*entities.User, error)
 	Update(ctx context.Context, user *entities.User) error
 	Delete(ctx context.Context, id uint) error
-	Search(ctx context.Context, query string, limit, offset int) ([]*entities.User, error)
+	Restore(ctx context.Context, id uint) error
+	GetByIDs(ctx context.Context, ids []uint) ([]*entities.User, error)
+	FindByEmails(ctx context.Context, emails []string) ([]*entities.User, error)
+	FindByUsernames(ctx context.Context, usernames []string) ([]*entities.User, error)
+	FindByFullNames(ctx context.Context, names []string) ([]*entities.User, error)
+	GetBirthdaySharers(ctx context.Context, afterID uint, limit int) ([]*entities.User, error)
+	FilterReminderRecipients(ctx context.Context, ids []uint) ([]uint, error)
+	SearchTalent(ctx context.Context, filters map[string]interface{}, limit, offset int) ([]*entities.User, error)
+	UpdateLastActive(ctx context.Context, userID uint, lastActiveAt time.Time) error
 	VerifyEmail(ctx context.Context, userID uint) error
+	SetIdentityVerified(ctx context.Context, userID uint, verified bool, verifiedAt *time.Time) error
 	UpdatePremiumStatus(ctx context.Context, userID uint, isPremium bool, premiumUntil *time.Time) error
+	GetUsage(ctx context.Context, userID uint) (*entities.UserUsage, error)
 }
@@ -0,0 +1,329 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"linked-clone/pkg/backup"
+	"linked-clone/pkg/storage"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const consistencySampleSize = 10
+
+// ConsistencyChecks lists the checks RunConsistencyChecks knows, in the order
+// they run.
+var ConsistencyChecks = []string{"reaction_counts", "application_counts", "connections", "s3_keys"}
+
+// StorageColumn holds a reference to an uploaded object, either as a bare key
+// or as a full S3 URL.
+type StorageColumn struct {
+	Table  string
+	Column string
+}
+
+var StorageColumns = []StorageColumn{
+	{Table: "users", Column: "profile_picture"},
+	{Table: "users", Column: "profile_thumbnail"},
+	{Table: "users", Column: "profile_banner"},
+	{Table: "users", Column: "profile_banner_large"},
+	{Table: "users", Column: "profile_banner_small"},
+	{Table: "posts", Column: "image_url"},
+	{Table: "applications", Column: "resume_url"},
+	{Table: "company_verifications", Column: "document_key"},
+	{Table: "identity_verifications", Column: "document_key"},
+	{Table: "identity_verifications", Column: "selfie_key"},
+	{Table: "message_attachments", Column: "file_key"},
+	{Table: "message_attachments", Column: "thumbnail_key"},
+}
+
+// StoragePrefixes are the upload folders in the media bucket. Objects outside
+// them, such as backups and exports sharing the bucket, are never touched.
+var StoragePrefixes = []string{"profile-pictures", "profile-banners", "posts", "resumes", "messages", "verifications", "secure/identity"}
+
+type ConsistencyOptions struct {
+	Repair    bool
+	BatchSize int
+	// Store lists and deletes objects in the media bucket; the s3_keys check
+	// needs it.
+	Store backup.Store
+	// OrphanGrace skips objects newer than this, since an upload lands in
+	// the bucket before the row that references it is committed.
+	OrphanGrace time.Duration
+}
+
+type ConsistencyResult struct {
+	Check    string   `json:"check"`
+	Found    int      `json:"found"`
+	Repaired int      `json:"repaired"`
+	Samples  []string `json:"samples,omitempty"`
+}
+
+func (r *ConsistencyResult) Outstanding() int {
+	return r.Found - r.Repaired
+}
+
+func (r *ConsistencyResult) add(sample string) {
+	r.Found++
+	if len(r.Samples) < consistencySampleSize {
+		r.Samples = append(r.Samples, sample)
+	}
+}
+
+// RunConsistencyChecks runs the named checks and, with opts.Repair, fixes what
+// they find. Results gathered before a failing check are returned with its
+// error.
+func RunConsistencyChecks(ctx context.Context, db *gorm.DB, checks []string, opts ConsistencyOptions) ([]*ConsistencyResult, error) {
+	results := make([]*ConsistencyResult, 0, len(checks))
+	for _, check := range checks {
+		var result *ConsistencyResult
+		var err error
+
+		switch check {
+		case "reaction_counts", "application_counts":
+			result, err = checkCounter(ctx, db, check, counterColumns[check], opts)
+		case "connections":
+			result, err = checkConnections(ctx, db, opts)
+		case "s3_keys":
+			result, err = checkStorageKeys(ctx, db, opts)
+		default:
+			return results, fmt.Errorf("unknown consistency check: %s", check)
+		}
+
+		if result != nil {
+			results = append(results, result)
+		}
+		if err != nil {
+			return results, fmt.Errorf("%s check failed: %w", check, err)
+		}
+	}
+	return results, nil
+}
+
+type counterColumn struct {
+	table  string
+	column string
+	// count is a correlated subquery counting the rows the counter tracks.
+	count string
+}
+
+var counterColumns = map[string]counterColumn{
+	"reaction_counts": {
+		table:  "posts",
+		column: "reaction_count",
+		count:  "SELECT COUNT(*) FROM reactions WHERE reactions.post_id = posts.id AND reactions.deleted_at IS NULL",
+	},
+	"application_counts": {
+		table:  "jobs",
+		column: "application_count",
+		count:  "SELECT COUNT(*) FROM applications WHERE applications.job_id = jobs.id AND applications.deleted_at IS NULL",
+	},
+}
+
+func checkCounter(ctx context.Context, db *gorm.DB, check string, counter counterColumn, opts ConsistencyOptions) (*ConsistencyResult, error) {
+	type row struct {
+		ID     uint
+		Stored int64
+		Actual int64
+	}
+
+	result := &ConsistencyResult{Check: check}
+	var lastID uint
+
+	for {
+		var rows []row
+		err := db.WithContext(ctx).Table(counter.table).
+			Select(fmt.Sprintf("id, %s AS stored, (%s) AS actual", counter.column, counter.count)).
+			Where("id > ?", lastID).
+			Order("id").
+			Limit(opts.BatchSize).
+			Scan(&rows).Error
+		if err != nil {
+			return result, fmt.Errorf("failed to read %s.%s: %w", counter.table, counter.column, err)
+		}
+		if len(rows) == 0 {
+			return result, nil
+		}
+
+		var drifted []uint
+		for _, r := range rows {
+			lastID = r.ID
+			if r.Stored == r.Actual {
+				continue
+			}
+			result.add(fmt.Sprintf("%s %d: %s is %d, counted %d", counter.table, r.ID, counter.column, r.Stored, r.Actual))
+			drifted = append(drifted, r.ID)
+		}
+
+		if !opts.Repair || len(drifted) == 0 {
+			continue
+		}
+
+		// Recount in the update rather than writing the value read above, so
+		// reactions or applications added in between are not lost.
+		update := db.WithContext(ctx).Table(counter.table).
+			Where("id IN ?", drifted).
+			UpdateColumn(counter.column, gorm.Expr("("+counter.count+")"))
+		if update.Error != nil {
+			return result, fmt.Errorf("failed to repair %s.%s: %w", counter.table, counter.column, update.Error)
+		}
+		result.Repaired += int(update.RowsAffected)
+	}
+}
+
+// checkConnections finds live connections where either side is a deleted
+// account. Deleted users are soft deleted, so the foreign key cascade never
+// removes these rows; repairing soft deletes the connection too.
+func checkConnections(ctx context.Context, db *gorm.DB, opts ConsistencyOptions) (*ConsistencyResult, error) {
+	type row struct {
+		ID          uint
+		RequesterID uint
+		AddresseeID uint
+	}
+
+	result := &ConsistencyResult{Check: "connections"}
+	var lastID uint
+
+	for {
+		var rows []row
+		err := db.WithContext(ctx).Table("connections").
+			Select("id, requester_id, addressee_id").
+			Where("id > ? AND deleted_at IS NULL", lastID).
+			Where("EXISTS (SELECT 1 FROM users WHERE users.id IN (connections.requester_id, connections.addressee_id) AND users.deleted_at IS NOT NULL)").
+			Order("id").
+			Limit(opts.BatchSize).
+			Scan(&rows).Error
+		if err != nil {
+			return result, fmt.Errorf("failed to read connections: %w", err)
+		}
+		if len(rows) == 0 {
+			return result, nil
+		}
+
+		ids := make([]uint, 0, len(rows))
+		for _, r := range rows {
+			lastID = r.ID
+			result.add(fmt.Sprintf("connection %d: between users %d and %d includes a deleted user", r.ID, r.RequesterID, r.AddresseeID))
+			ids = append(ids, r.ID)
+		}
+
+		if !opts.Repair {
+			continue
+		}
+
+		update := db.WithContext(ctx).Table("connections").
+			Where("id IN ? AND deleted_at IS NULL", ids).
+			UpdateColumn("deleted_at", gorm.Expr("NOW()"))
+		if update.Error != nil {
+			return result, fmt.Errorf("failed to repair connections: %w", update.Error)
+		}
+		result.Repaired += int(update.RowsAffected)
+	}
+}
+
+// checkStorageKeys compares the upload folders in the bucket with the keys the
+// database references. Objects nobody references are orphans and are deleted
+// on repair; references to objects that no longer exist are reported only,
+// since there is nothing to restore them from.
+func checkStorageKeys(ctx context.Context, db *gorm.DB, opts ConsistencyOptions) (*ConsistencyResult, error) {
+	result := &ConsistencyResult{Check: "s3_keys"}
+	if opts.Store == nil {
+		return result, fmt.Errorf("object store is not configured")
+	}
+
+	referenced := make(map[string]string)
+	for _, column := range StorageColumns {
+		if err := collectStorageKeys(ctx, db, column, opts.BatchSize, referenced); err != nil {
+			return result, err
+		}
+	}
+
+	stored := make(map[string]struct{})
+	cutoff := time.Now().Add(-opts.OrphanGrace)
+	for _, prefix := range StoragePrefixes {
+		objects, err := opts.Store.List(ctx, prefix)
+		if err != nil {
+			return result, fmt.Errorf("failed to list %s objects: %w", prefix, err)
+		}
+
+		for _, object := range objects {
+			stored[object.Key] = struct{}{}
+			if _, ok := referenced[object.Key]; ok || object.LastModified.After(cutoff) {
+				continue
+			}
+
+			result.add(fmt.Sprintf("orphaned object %s (%d bytes, modified %s)", object.Key, object.Size, object.LastModified.UTC().Format(time.RFC3339)))
+			if !opts.Repair {
+				continue
+			}
+			if err := opts.Store.Delete(ctx, object.Key); err != nil {
+				return result, fmt.Errorf("failed to delete orphaned object %s: %w", object.Key, err)
+			}
+			result.Repaired++
+		}
+	}
+
+	for key, source := range referenced {
+		if _, ok := stored[key]; ok || !hasStoragePrefix(key) {
+			continue
+		}
+		result.add(fmt.Sprintf("missing object %s referenced by %s", key, source))
+	}
+
+	return result, nil
+}
+
+func collectStorageKeys(ctx context.Context, db *gorm.DB, column StorageColumn, batchSize int, referenced map[string]string) error {
+	type row struct {
+		ID    uint
+		Value string
+	}
+
+	name := column.Table + "." + column.Column
+	encrypted := false
+	for _, c := range EncryptedColumns {
+		if c.Table == column.Table && c.Column == column.Column {
+			encrypted = true
+			break
+		}
+	}
+
+	var lastID uint
+	for {
+		var rows []row
+		err := db.WithContext(ctx).Table(column.Table).
+			Select("id, "+column.Column+" AS value").
+			Where("id > ? AND "+column.Column+" IS NOT NULL AND "+column.Column+" <> ''", lastID).
+			Order("id").
+			Limit(batchSize).
+			Scan(&rows).Error
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", name, err)
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+
+		for _, r := range rows {
+			lastID = r.ID
+			value := r.Value
+			if encrypted {
+				if value, err = currentKeyring().Decrypt(value, columnAssociatedData(column.Table, column.Column)); err != nil {
+					return fmt.Errorf("failed to decrypt %s for id %d: %w", name, r.ID, err)
+				}
+			}
+			referenced[storage.ObjectKey(value)] = fmt.Sprintf("%s %d", name, r.ID)
+		}
+	}
+}
+
+func hasStoragePrefix(key string) bool {
+	for _, prefix := range StoragePrefixes {
+		if strings.HasPrefix(key, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,188 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"linked-clone/internal/config"
+	"linked-clone/pkg/encryption"
+	"reflect"
+	"sync"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+type EncryptedColumn struct {
+	Table  string
+	Column string
+}
+
+var EncryptedColumns = []EncryptedColumn{
+	{Table: "users", Column: "date_of_birth"},
+	{Table: "users", Column: "totp_secret"},
+	{Table: "users", Column: "phone_number"},
+	{Table: "sessions", Column: "ip_address"},
+	{Table: "sessions", Column: "location"},
+	{Table: "identity_verifications", Column: "document_key"},
+	{Table: "identity_verifications", Column: "selfie_key"},
+	{Table: "identity_verifications", Column: "provider_reference"},
+	{Table: "policy_acceptances", Column: "ip_address"},
+	{Table: "admin_audit_logs", Column: "ip_address"},
+}
+
+var (
+	keyringMu sync.RWMutex
+	keyring   = &encryption.Keyring{}
+)
+
+func init() {
+	schema.RegisterSerializer("encrypted", EncryptedSerializer{})
+}
+
+func ConfigureEncryption(cfg config.EncryptionConfig) (*encryption.Keyring, error) {
+	keys, err := encryption.ParseKeys(cfg.Keys)
+	if err != nil {
+		return nil, err
+	}
+
+	configured, err := encryption.NewKeyring(keys, cfg.PrimaryKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	keyringMu.Lock()
+	keyring = configured
+	keyringMu.Unlock()
+
+	return configured, nil
+}
+
+func currentKeyring() *encryption.Keyring {
+	keyringMu.RLock()
+	defer keyringMu.RUnlock()
+	return keyring
+}
+
+func columnAssociatedData(table, column string) string {
+	return table + "." + column
+}
+
+type EncryptedSerializer struct{}
+
+func (EncryptedSerializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	fieldValue := reflect.New(field.FieldType)
+
+	if dbValue != nil {
+		var stored string
+		switch v := dbValue.(type) {
+		case []byte:
+			stored = string(v)
+		case string:
+			stored = v
+		default:
+			return fmt.Errorf("failed to decrypt %s: unsupported value type %T", field.DBName, dbValue)
+		}
+
+		plaintext, err := currentKeyring().Decrypt(stored, columnAssociatedData(field.Schema.Table, field.DBName))
+		if err != nil {
+			return fmt.Errorf("failed to decrypt %s: %w", field.DBName, err)
+		}
+
+		if field.FieldType.Kind() == reflect.Ptr {
+			fieldValue.Elem().Set(reflect.ValueOf(&plaintext))
+		} else {
+			fieldValue.Elem().SetString(plaintext)
+		}
+	}
+
+	field.ReflectValueOf(ctx, dst).Set(fieldValue.Elem())
+	return nil
+}
+
+func (EncryptedSerializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	var plaintext string
+	switch v := fieldValue.(type) {
+	case string:
+		plaintext = v
+	case *string:
+		if v == nil {
+			return nil, nil
+		}
+		plaintext = *v
+	default:
+		return nil, fmt.Errorf("failed to encrypt %s: unsupported field type %T", field.DBName, fieldValue)
+	}
+
+	keys := currentKeyring()
+	if plaintext == "" || !keys.Enabled() {
+		return plaintext, nil
+	}
+
+	return keys.Encrypt(plaintext, columnAssociatedData(field.Schema.Table, field.DBName))
+}
+
+func ReencryptColumns(ctx context.Context, db *gorm.DB, keys *encryption.Keyring, batchSize int) (map[string]int, error) {
+	if !keys.Enabled() {
+		return nil, encryption.ErrEncryptionMissing
+	}
+
+	updated := make(map[string]int, len(EncryptedColumns))
+	for _, column := range EncryptedColumns {
+		count, err := reencryptColumn(ctx, db, keys, column, batchSize)
+		updated[column.Table+"."+column.Column] = count
+		if err != nil {
+			return updated, err
+		}
+	}
+	return updated, nil
+}
+
+func reencryptColumn(ctx context.Context, db *gorm.DB, keys *encryption.Keyring, column EncryptedColumn, batchSize int) (int, error) {
+	type row struct {
+		ID    uint
+		Value string
+	}
+
+	associatedData := columnAssociatedData(column.Table, column.Column)
+	updated := 0
+	var lastID uint
+
+	for {
+		var rows []row
+		err := db.WithContext(ctx).Table(column.Table).
+			Select("id, "+column.Column+" AS value").
+			Where("id > ? AND "+column.Column+" IS NOT NULL AND "+column.Column+" <> ''", lastID).
+			Order("id").
+			Limit(batchSize).
+			Scan(&rows).Error
+		if err != nil {
+			return updated, fmt.Errorf("failed to read %s: %w", associatedData, err)
+		}
+		if len(rows) == 0 {
+			return updated, nil
+		}
+
+		for _, r := range rows {
+			lastID = r.ID
+			if !keys.NeedsRotation(r.Value) {
+				continue
+			}
+
+			plaintext, err := keys.Decrypt(r.Value, associatedData)
+			if err != nil {
+				return updated, fmt.Errorf("failed to decrypt %s for id %d: %w", associatedData, r.ID, err)
+			}
+			ciphertext, err := keys.Encrypt(plaintext, associatedData)
+			if err != nil {
+				return updated, err
+			}
+
+			if err := db.WithContext(ctx).Table(column.Table).
+				Where("id = ?", r.ID).
+				UpdateColumn(column.Column, ciphertext).Error; err != nil {
+				return updated, fmt.Errorf("failed to update %s for id %d: %w", associatedData, r.ID, err)
+			}
+			updated++
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/pkg/taxonomy"
+
+	"gorm.io/gorm"
+)
+
+// LocationTextTables hold a free-text location column next to location_id.
+var LocationTextTables = []string{"users", "jobs", "experiences"}
+
+// MatchLocations links rows that only carry free-text locations to the
+// locations reference table. Text that matches nothing, or matches more than
+// one place, is left as is for the owner to pick from autocomplete later.
+func MatchLocations(ctx context.Context, db *gorm.DB, batchSize int) (map[string]int, error) {
+	var locations []*entities.Location
+	if err := db.WithContext(ctx).Find(&locations).Error; err != nil {
+		return nil, fmt.Errorf("failed to load locations: %w", err)
+	}
+
+	matcher := taxonomy.NewMatcher()
+	for _, location := range locations {
+		matcher.Add(location.ID, location.MatchNames()...)
+	}
+
+	matched := make(map[string]int, len(LocationTextTables))
+	for _, table := range LocationTextTables {
+		count, err := matchTableLocations(ctx, db, matcher, table, batchSize)
+		matched[table] = count
+		if err != nil {
+			return matched, err
+		}
+	}
+	return matched, nil
+}
+
+func matchTableLocations(ctx context.Context, db *gorm.DB, matcher *taxonomy.Matcher, table string, batchSize int) (int, error) {
+	type row struct {
+		ID       uint
+		Location string
+	}
+
+	matched := 0
+	var lastID uint
+
+	for {
+		var rows []row
+		err := db.WithContext(ctx).Table(table).
+			Select("id, location").
+			Where("id > ? AND location_id IS NULL AND location <> ''", lastID).
+			Order("id").
+			Limit(batchSize).
+			Scan(&rows).Error
+		if err != nil {
+			return matched, fmt.Errorf("failed to read %s locations: %w", table, err)
+		}
+		if len(rows) == 0 {
+			return matched, nil
+		}
+
+		for _, r := range rows {
+			lastID = r.ID
+			locationID, ok := matcher.Match(r.Location)
+			if !ok {
+				continue
+			}
+
+			if err := db.WithContext(ctx).Table(table).
+				Where("id = ?", r.ID).
+				UpdateColumn("location_id", locationID).Error; err != nil {
+				return matched, fmt.Errorf("failed to update %s location for id %d: %w", table, r.ID, err)
+			}
+			matched++
+		}
+	}
+}
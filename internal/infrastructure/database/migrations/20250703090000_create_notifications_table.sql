@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE notifications (
+                               id SERIAL PRIMARY KEY,
+                               user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                               actor_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+                               type VARCHAR(50) NOT NULL,
+                               entity_type VARCHAR(50),
+                               entity_id INTEGER,
+                               message TEXT NOT NULL,
+                               is_read BOOLEAN DEFAULT FALSE,
+                               read_at TIMESTAMP,
+                               created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                               updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                               deleted_at TIMESTAMP
+);
+
+-- Create indexes
+CREATE INDEX idx_notifications_user_id ON notifications(user_id);
+CREATE INDEX idx_notifications_created_at ON notifications(created_at);
+CREATE INDEX idx_notifications_deleted_at ON notifications(deleted_at);
+
+-- Partial index for unread counts
+CREATE INDEX idx_notifications_unread ON notifications(user_id)
+    WHERE is_read = FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS notifications;
+-- +goose StatementEnd
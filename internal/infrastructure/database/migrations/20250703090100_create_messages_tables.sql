@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE conversations (
+                               id SERIAL PRIMARY KEY,
+                               last_message_at TIMESTAMP,
+                               created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                               updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                               deleted_at TIMESTAMP
+);
+
+CREATE TABLE conversation_participants (
+                                           id SERIAL PRIMARY KEY,
+                                           conversation_id INTEGER NOT NULL REFERENCES conversations(id) ON DELETE CASCADE,
+                                           user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                           last_read_at TIMESTAMP,
+                                           created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                           updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                           UNIQUE(conversation_id, user_id)
+);
+
+CREATE TABLE messages (
+                          id SERIAL PRIMARY KEY,
+                          conversation_id INTEGER NOT NULL REFERENCES conversations(id) ON DELETE CASCADE,
+                          sender_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                          content TEXT NOT NULL,
+                          created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                          updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                          deleted_at TIMESTAMP
+);
+
+-- Create indexes
+CREATE INDEX idx_conversations_last_message_at ON conversations(last_message_at);
+CREATE INDEX idx_conversations_deleted_at ON conversations(deleted_at);
+CREATE INDEX idx_conversation_participants_user_id ON conversation_participants(user_id);
+CREATE INDEX idx_messages_conversation_created ON messages(conversation_id, created_at);
+CREATE INDEX idx_messages_sender_id ON messages(sender_id);
+CREATE INDEX idx_messages_deleted_at ON messages(deleted_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS messages;
+DROP TABLE IF EXISTS conversation_participants;
+DROP TABLE IF EXISTS conversations;
+-- +goose StatementEnd
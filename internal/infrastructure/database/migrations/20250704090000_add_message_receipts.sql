@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE messages
+    ADD COLUMN delivered_at TIMESTAMP,
+    ADD COLUMN read_at TIMESTAMP;
+
+ALTER TABLE users
+    ADD COLUMN read_receipts BOOLEAN DEFAULT TRUE;
+
+CREATE INDEX idx_messages_undelivered ON messages(conversation_id)
+    WHERE delivered_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_messages_undelivered;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS read_receipts;
+
+ALTER TABLE messages
+    DROP COLUMN IF EXISTS read_at,
+    DROP COLUMN IF EXISTS delivered_at;
+-- +goose StatementEnd
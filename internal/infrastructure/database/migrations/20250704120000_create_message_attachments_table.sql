@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE message_attachments (
+                                     id SERIAL PRIMARY KEY,
+                                     message_id INTEGER NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+                                     type VARCHAR(20) NOT NULL,
+                                     file_key TEXT NOT NULL,
+                                     thumbnail_key TEXT,
+                                     file_name VARCHAR(255) NOT NULL,
+                                     content_type VARCHAR(100),
+                                     size BIGINT DEFAULT 0,
+                                     duration_seconds INTEGER,
+                                     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes
+CREATE INDEX idx_message_attachments_message_id ON message_attachments(message_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS message_attachments;
+-- +goose StatementEnd
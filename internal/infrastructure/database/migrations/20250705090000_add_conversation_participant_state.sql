@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE conversation_participants
+    ADD COLUMN archived_at TIMESTAMP,
+    ADD COLUMN muted_at TIMESTAMP,
+    ADD COLUMN cleared_at TIMESTAMP;
+
+CREATE INDEX idx_conversation_participants_user_archived ON conversation_participants(user_id, archived_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_conversation_participants_user_archived;
+
+ALTER TABLE conversation_participants
+    DROP COLUMN IF EXISTS cleared_at,
+    DROP COLUMN IF EXISTS muted_at,
+    DROP COLUMN IF EXISTS archived_at;
+-- +goose StatementEnd
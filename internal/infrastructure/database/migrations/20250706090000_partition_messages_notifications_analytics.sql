@@ -0,0 +1,206 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Monthly range partition helpers (pg_partman style)
+CREATE OR REPLACE FUNCTION create_monthly_partition(parent_table TEXT, partition_start DATE)
+RETURNS TEXT AS $$
+DECLARE
+    month_start DATE := date_trunc('month', partition_start)::DATE;
+    partition_name TEXT := parent_table || '_p' || to_char(month_start, 'YYYY_MM');
+BEGIN
+    IF to_regclass(partition_name) IS NULL THEN
+        EXECUTE format('CREATE TABLE %I PARTITION OF %I FOR VALUES FROM (%L) TO (%L)',
+                       partition_name, parent_table, month_start, (month_start + INTERVAL '1 month')::DATE);
+    END IF;
+    RETURN partition_name;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION ensure_monthly_partitions(parent_table TEXT, from_date DATE, months_ahead INTEGER)
+RETURNS INTEGER AS $$
+DECLARE
+    current_month DATE := date_trunc('month', from_date)::DATE;
+    last_month DATE := (date_trunc('month', CURRENT_DATE) + make_interval(months => months_ahead))::DATE;
+    created INTEGER := 0;
+BEGIN
+    WHILE current_month <= last_month LOOP
+        IF to_regclass(parent_table || '_p' || to_char(current_month, 'YYYY_MM')) IS NULL THEN
+            PERFORM create_monthly_partition(parent_table, current_month);
+            created := created + 1;
+        END IF;
+        current_month := (current_month + INTERVAL '1 month')::DATE;
+    END LOOP;
+    RETURN created;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Messages
+ALTER TABLE message_attachments DROP CONSTRAINT IF EXISTS message_attachments_message_id_fkey;
+
+ALTER TABLE messages RENAME TO messages_legacy;
+ALTER INDEX messages_pkey RENAME TO messages_legacy_pkey;
+
+CREATE TABLE messages (
+                          id INTEGER NOT NULL DEFAULT nextval('messages_id_seq'),
+                          conversation_id INTEGER NOT NULL REFERENCES conversations(id) ON DELETE CASCADE,
+                          sender_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                          content TEXT NOT NULL,
+                          delivered_at TIMESTAMP,
+                          read_at TIMESTAMP,
+                          created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                          updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                          deleted_at TIMESTAMP,
+                          PRIMARY KEY (id, created_at)
+) PARTITION BY RANGE (created_at);
+
+ALTER SEQUENCE messages_id_seq OWNED BY messages.id;
+
+CREATE TABLE messages_default PARTITION OF messages DEFAULT;
+
+SELECT ensure_monthly_partitions('messages',
+                                 COALESCE((SELECT MIN(created_at) FROM messages_legacy), CURRENT_DATE)::DATE, 3);
+
+INSERT INTO messages (id, conversation_id, sender_id, content, delivered_at, read_at, created_at, updated_at, deleted_at)
+SELECT id, conversation_id, sender_id, content, delivered_at, read_at, COALESCE(created_at, CURRENT_TIMESTAMP), updated_at, deleted_at
+FROM messages_legacy;
+
+DROP TABLE messages_legacy;
+
+CREATE INDEX idx_messages_conversation_created ON messages(conversation_id, created_at);
+CREATE INDEX idx_messages_sender_id ON messages(sender_id);
+CREATE INDEX idx_messages_deleted_at ON messages(deleted_at);
+CREATE INDEX idx_messages_id ON messages(id);
+CREATE INDEX idx_messages_undelivered ON messages(conversation_id)
+    WHERE delivered_at IS NULL;
+
+-- Notifications
+ALTER TABLE notifications RENAME TO notifications_legacy;
+ALTER INDEX notifications_pkey RENAME TO notifications_legacy_pkey;
+
+CREATE TABLE notifications (
+                               id INTEGER NOT NULL DEFAULT nextval('notifications_id_seq'),
+                               user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                               actor_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+                               type VARCHAR(50) NOT NULL,
+                               entity_type VARCHAR(50),
+                               entity_id INTEGER,
+                               message TEXT NOT NULL,
+                               is_read BOOLEAN DEFAULT FALSE,
+                               read_at TIMESTAMP,
+                               created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                               updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                               deleted_at TIMESTAMP,
+                               PRIMARY KEY (id, created_at)
+) PARTITION BY RANGE (created_at);
+
+ALTER SEQUENCE notifications_id_seq OWNED BY notifications.id;
+
+CREATE TABLE notifications_default PARTITION OF notifications DEFAULT;
+
+SELECT ensure_monthly_partitions('notifications',
+                                 COALESCE((SELECT MIN(created_at) FROM notifications_legacy), CURRENT_DATE)::DATE, 3);
+
+INSERT INTO notifications (id, user_id, actor_id, type, entity_type, entity_id, message, is_read, read_at, created_at, updated_at, deleted_at)
+SELECT id, user_id, actor_id, type, entity_type, entity_id, message, is_read, read_at, COALESCE(created_at, CURRENT_TIMESTAMP), updated_at, deleted_at
+FROM notifications_legacy;
+
+DROP TABLE notifications_legacy;
+
+CREATE INDEX idx_notifications_user_id ON notifications(user_id);
+CREATE INDEX idx_notifications_created_at ON notifications(created_at);
+CREATE INDEX idx_notifications_deleted_at ON notifications(deleted_at);
+CREATE INDEX idx_notifications_id ON notifications(id);
+CREATE INDEX idx_notifications_unread ON notifications(user_id)
+    WHERE is_read = FALSE;
+
+-- Analytics events
+CREATE TABLE analytics_events (
+                                  id BIGSERIAL,
+                                  user_id INTEGER,
+                                  event_type VARCHAR(100) NOT NULL,
+                                  entity_type VARCHAR(50),
+                                  entity_id INTEGER,
+                                  metadata JSONB,
+                                  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                  PRIMARY KEY (id, created_at)
+) PARTITION BY RANGE (created_at);
+
+CREATE TABLE analytics_events_default PARTITION OF analytics_events DEFAULT;
+
+SELECT ensure_monthly_partitions('analytics_events', CURRENT_DATE, 3);
+
+CREATE INDEX idx_analytics_events_type_created ON analytics_events(event_type, created_at);
+CREATE INDEX idx_analytics_events_entity ON analytics_events(entity_type, entity_id);
+CREATE INDEX idx_analytics_events_user_id ON analytics_events(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS analytics_events;
+
+-- Notifications
+ALTER TABLE notifications RENAME TO notifications_partitioned;
+ALTER INDEX notifications_pkey RENAME TO notifications_partitioned_pkey;
+
+CREATE TABLE notifications (
+                               id INTEGER PRIMARY KEY DEFAULT nextval('notifications_id_seq'),
+                               user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                               actor_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+                               type VARCHAR(50) NOT NULL,
+                               entity_type VARCHAR(50),
+                               entity_id INTEGER,
+                               message TEXT NOT NULL,
+                               is_read BOOLEAN DEFAULT FALSE,
+                               read_at TIMESTAMP,
+                               created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                               updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                               deleted_at TIMESTAMP
+);
+
+ALTER SEQUENCE notifications_id_seq OWNED BY notifications.id;
+
+INSERT INTO notifications SELECT id, user_id, actor_id, type, entity_type, entity_id, message, is_read, read_at, created_at, updated_at, deleted_at
+FROM notifications_partitioned;
+
+DROP TABLE notifications_partitioned;
+
+CREATE INDEX idx_notifications_user_id ON notifications(user_id);
+CREATE INDEX idx_notifications_created_at ON notifications(created_at);
+CREATE INDEX idx_notifications_deleted_at ON notifications(deleted_at);
+CREATE INDEX idx_notifications_unread ON notifications(user_id)
+    WHERE is_read = FALSE;
+
+-- Messages
+ALTER TABLE messages RENAME TO messages_partitioned;
+ALTER INDEX messages_pkey RENAME TO messages_partitioned_pkey;
+
+CREATE TABLE messages (
+                          id INTEGER PRIMARY KEY DEFAULT nextval('messages_id_seq'),
+                          conversation_id INTEGER NOT NULL REFERENCES conversations(id) ON DELETE CASCADE,
+                          sender_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                          content TEXT NOT NULL,
+                          delivered_at TIMESTAMP,
+                          read_at TIMESTAMP,
+                          created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                          updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                          deleted_at TIMESTAMP
+);
+
+ALTER SEQUENCE messages_id_seq OWNED BY messages.id;
+
+INSERT INTO messages SELECT id, conversation_id, sender_id, content, delivered_at, read_at, created_at, updated_at, deleted_at
+FROM messages_partitioned;
+
+DROP TABLE messages_partitioned;
+
+CREATE INDEX idx_messages_conversation_created ON messages(conversation_id, created_at);
+CREATE INDEX idx_messages_sender_id ON messages(sender_id);
+CREATE INDEX idx_messages_deleted_at ON messages(deleted_at);
+CREATE INDEX idx_messages_undelivered ON messages(conversation_id)
+    WHERE delivered_at IS NULL;
+
+ALTER TABLE message_attachments
+    ADD CONSTRAINT message_attachments_message_id_fkey FOREIGN KEY (message_id) REFERENCES messages(id) ON DELETE CASCADE;
+
+DROP FUNCTION IF EXISTS ensure_monthly_partitions(TEXT, DATE, INTEGER);
+DROP FUNCTION IF EXISTS create_monthly_partition(TEXT, DATE);
+-- +goose StatementEnd
@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE notifications_archive (
+                                       id INTEGER NOT NULL,
+                                       user_id INTEGER NOT NULL,
+                                       actor_id INTEGER,
+                                       type VARCHAR(50) NOT NULL,
+                                       entity_type VARCHAR(50),
+                                       entity_id INTEGER,
+                                       message TEXT NOT NULL,
+                                       is_read BOOLEAN DEFAULT FALSE,
+                                       read_at TIMESTAMP,
+                                       created_at TIMESTAMP NOT NULL,
+                                       updated_at TIMESTAMP,
+                                       deleted_at TIMESTAMP,
+                                       archived_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                       PRIMARY KEY (id, created_at)
+);
+
+CREATE INDEX idx_notifications_archive_user_id ON notifications_archive(user_id);
+CREATE INDEX idx_notifications_archive_archived_at ON notifications_archive(archived_at);
+
+CREATE TABLE analytics_events_archive (
+                                          id BIGINT NOT NULL,
+                                          user_id INTEGER,
+                                          event_type VARCHAR(100) NOT NULL,
+                                          entity_type VARCHAR(50),
+                                          entity_id INTEGER,
+                                          metadata JSONB,
+                                          created_at TIMESTAMP NOT NULL,
+                                          archived_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                          PRIMARY KEY (id, created_at)
+);
+
+CREATE INDEX idx_analytics_events_archive_event_type ON analytics_events_archive(event_type, created_at);
+CREATE INDEX idx_analytics_events_archive_archived_at ON analytics_events_archive(archived_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS analytics_events_archive;
+DROP TABLE IF EXISTS notifications_archive;
+-- +goose StatementEnd
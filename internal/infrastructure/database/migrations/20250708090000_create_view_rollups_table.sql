@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE view_rollups (
+                              entity_type VARCHAR(50) NOT NULL,
+                              entity_id INTEGER NOT NULL,
+                              day DATE NOT NULL,
+                              unique_viewers BIGINT NOT NULL DEFAULT 0,
+                              total_views BIGINT NOT NULL DEFAULT 0,
+                              updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                              PRIMARY KEY (entity_type, entity_id, day)
+);
+
+CREATE INDEX idx_view_rollups_day ON view_rollups(day);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS view_rollups;
+-- +goose StatementEnd
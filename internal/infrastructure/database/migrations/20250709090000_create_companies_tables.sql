@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN is_admin BOOLEAN DEFAULT FALSE;
+
+CREATE TABLE companies (
+                           id SERIAL PRIMARY KEY,
+                           owner_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                           name VARCHAR(100) NOT NULL,
+                           domain VARCHAR(255) UNIQUE NOT NULL,
+                           website VARCHAR(255),
+                           description TEXT,
+                           is_verified BOOLEAN DEFAULT FALSE,
+                           verified_at TIMESTAMP,
+                           created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                           updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                           deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_companies_owner_id ON companies(owner_id);
+CREATE INDEX idx_companies_deleted_at ON companies(deleted_at);
+
+CREATE TABLE company_verifications (
+                                       id SERIAL PRIMARY KEY,
+                                       company_id INTEGER NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+                                       submitted_by INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                       method VARCHAR(20) NOT NULL CHECK (method IN ('business_email', 'document')),
+                                       status VARCHAR(20) NOT NULL CHECK (status IN ('awaiting_email', 'pending', 'approved', 'rejected')),
+                                       business_email VARCHAR(255),
+                                       document_key TEXT,
+                                       email_confirmed_at TIMESTAMP,
+                                       reviewer_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+                                       review_note TEXT,
+                                       reviewed_at TIMESTAMP,
+                                       created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                       updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_company_verifications_company_id ON company_verifications(company_id);
+CREATE INDEX idx_company_verifications_status ON company_verifications(status, created_at);
+
+ALTER TABLE jobs ADD COLUMN company_id INTEGER REFERENCES companies(id) ON DELETE SET NULL;
+CREATE INDEX idx_jobs_company_id ON jobs(company_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_jobs_company_id;
+ALTER TABLE jobs DROP COLUMN IF EXISTS company_id;
+DROP TABLE IF EXISTS company_verifications;
+DROP TABLE IF EXISTS companies;
+ALTER TABLE users DROP COLUMN IF EXISTS is_admin;
+-- +goose StatementEnd
@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN email_verified BOOLEAN DEFAULT FALSE;
+ALTER TABLE users ADD COLUMN identity_verified_at TIMESTAMP;
+
+UPDATE users SET email_verified = is_verified;
+UPDATE users SET is_verified = FALSE;
+
+CREATE TABLE identity_verifications (
+                                        id SERIAL PRIMARY KEY,
+                                        user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                        document_type VARCHAR(30) NOT NULL CHECK (document_type IN ('passport', 'national_id', 'drivers_license')),
+                                        document_key TEXT NOT NULL,
+                                        selfie_key TEXT,
+                                        status VARCHAR(20) NOT NULL CHECK (status IN ('pending', 'in_review', 'approved', 'rejected', 'revoked')),
+                                        provider VARCHAR(50) NOT NULL,
+                                        provider_reference VARCHAR(255),
+                                        reviewer_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+                                        review_note TEXT,
+                                        reviewed_at TIMESTAMP,
+                                        created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                        updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_identity_verifications_user_id ON identity_verifications(user_id, created_at);
+CREATE INDEX idx_identity_verifications_status ON identity_verifications(status, created_at);
+
+CREATE TABLE identity_verification_audits (
+                                              id SERIAL PRIMARY KEY,
+                                              verification_id INTEGER NOT NULL REFERENCES identity_verifications(id) ON DELETE CASCADE,
+                                              user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                              actor_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+                                              action VARCHAR(50) NOT NULL,
+                                              from_status VARCHAR(20),
+                                              to_status VARCHAR(20) NOT NULL,
+                                              note TEXT,
+                                              created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_identity_verification_audits_verification_id ON identity_verification_audits(verification_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS identity_verification_audits;
+DROP TABLE IF EXISTS identity_verifications;
+
+UPDATE users SET is_verified = email_verified;
+
+ALTER TABLE users DROP COLUMN IF EXISTS identity_verified_at;
+ALTER TABLE users DROP COLUMN IF EXISTS email_verified;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE posts ADD COLUMN image_alt_text VARCHAR(1000);
+ALTER TABLE users ADD COLUMN profile_alt_text VARCHAR(1000);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN IF EXISTS profile_alt_text;
+ALTER TABLE posts DROP COLUMN IF EXISTS image_alt_text;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE applications ADD COLUMN withdrawn_at TIMESTAMP;
+ALTER TABLE applications ADD COLUMN withdrawal_reason TEXT;
+ALTER TABLE jobs ADD COLUMN reapply_cooldown_days INTEGER NOT NULL DEFAULT 30;
+
+ALTER TYPE application_status ADD VALUE IF NOT EXISTS 'withdrawn';
+
+-- A withdrawn application is kept when the user reapplies, so only one
+-- application per user and job may be open at a time.
+ALTER TABLE applications DROP CONSTRAINT IF EXISTS applications_user_id_job_id_key;
+CREATE UNIQUE INDEX idx_applications_active_user_job ON applications(user_id, job_id)
+    WHERE withdrawn_at IS NULL AND deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Enum values cannot be dropped, so 'withdrawn' stays on application_status.
+DROP INDEX IF EXISTS idx_applications_active_user_job;
+DELETE FROM applications a
+    USING applications newer
+    WHERE a.user_id = newer.user_id AND a.job_id = newer.job_id AND a.id < newer.id;
+ALTER TABLE applications ADD CONSTRAINT applications_user_id_job_id_key UNIQUE (user_id, job_id);
+
+ALTER TABLE jobs DROP COLUMN IF EXISTS reapply_cooldown_days;
+ALTER TABLE applications DROP COLUMN IF EXISTS withdrawal_reason;
+ALTER TABLE applications DROP COLUMN IF EXISTS withdrawn_at;
+-- +goose StatementEnd
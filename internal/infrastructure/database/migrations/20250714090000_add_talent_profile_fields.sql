@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN headline VARCHAR(200);
+ALTER TABLE users ADD COLUMN skills JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE users ADD COLUMN years_of_experience INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE users ADD COLUMN open_to_work BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE users ADD COLUMN recruiter_visible BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE users ADD COLUMN plan VARCHAR(20) NOT NULL DEFAULT 'basic';
+
+CREATE INDEX idx_users_skills ON users USING GIN (skills);
+CREATE INDEX idx_users_talent ON users(recruiter_visible, open_to_work, years_of_experience);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_users_talent;
+DROP INDEX IF EXISTS idx_users_skills;
+ALTER TABLE users DROP COLUMN IF EXISTS plan;
+ALTER TABLE users DROP COLUMN IF EXISTS recruiter_visible;
+ALTER TABLE users DROP COLUMN IF EXISTS open_to_work;
+ALTER TABLE users DROP COLUMN IF EXISTS years_of_experience;
+ALTER TABLE users DROP COLUMN IF EXISTS skills;
+ALTER TABLE users DROP COLUMN IF EXISTS headline;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE company_members (
+                                 company_id INTEGER NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+                                 user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                 role VARCHAR(20) NOT NULL CHECK (role IN ('admin', 'recruiter')),
+                                 added_by INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                 created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                 updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                 PRIMARY KEY (company_id, user_id)
+);
+
+CREATE INDEX idx_company_members_user_role ON company_members(user_id, role);
+
+ALTER TABLE jobs ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'published' CHECK (status IN ('draft', 'pending_approval', 'published', 'rejected'));
+ALTER TABLE jobs ADD COLUMN reviewer_id INTEGER REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE jobs ADD COLUMN review_note TEXT;
+ALTER TABLE jobs ADD COLUMN reviewed_at TIMESTAMP;
+
+CREATE INDEX idx_jobs_company_status ON jobs(company_id, status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_jobs_company_status;
+ALTER TABLE jobs DROP COLUMN IF EXISTS reviewed_at;
+ALTER TABLE jobs DROP COLUMN IF EXISTS review_note;
+ALTER TABLE jobs DROP COLUMN IF EXISTS reviewer_id;
+ALTER TABLE jobs DROP COLUMN IF EXISTS status;
+DROP TABLE IF EXISTS company_members;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE jobs ADD COLUMN view_count BIGINT NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_jobs_view_count ON jobs(view_count DESC) WHERE is_active = true;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_jobs_view_count;
+ALTER TABLE jobs DROP COLUMN IF EXISTS view_count;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE jobs ADD COLUMN application_deadline TIMESTAMP;
+ALTER TABLE jobs ADD COLUMN deadline_timezone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+
+CREATE INDEX idx_jobs_active_deadline ON jobs(application_deadline) WHERE is_active = true AND application_deadline IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_jobs_active_deadline;
+ALTER TABLE jobs DROP COLUMN IF EXISTS deadline_timezone;
+ALTER TABLE jobs DROP COLUMN IF EXISTS application_deadline;
+-- +goose StatementEnd
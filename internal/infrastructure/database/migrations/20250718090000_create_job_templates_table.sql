@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE job_templates (
+                               id SERIAL PRIMARY KEY,
+                               user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                               company_id INTEGER REFERENCES companies(id) ON DELETE CASCADE,
+                               name VARCHAR(100) NOT NULL,
+                               title VARCHAR(200) NOT NULL,
+                               description TEXT NOT NULL,
+                               requirements TEXT,
+                               job_type VARCHAR(20),
+                               experience_level VARCHAR(20),
+                               screening_questions JSONB NOT NULL DEFAULT '[]',
+                               created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                               updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                               deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_job_templates_user_id ON job_templates(user_id) WHERE company_id IS NULL;
+CREATE INDEX idx_job_templates_company_id ON job_templates(company_id);
+CREATE INDEX idx_job_templates_deleted_at ON job_templates(deleted_at);
+
+ALTER TABLE jobs ADD COLUMN screening_questions JSONB NOT NULL DEFAULT '[]';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE jobs DROP COLUMN IF EXISTS screening_questions;
+DROP TABLE IF EXISTS job_templates;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+UPDATE sessions SET token_hash = encode(sha256(convert_to(refresh_token, 'UTF8')), 'hex');
+
+DROP INDEX IF EXISTS idx_sessions_refresh_token;
+DROP INDEX IF EXISTS idx_sessions_token_hash;
+ALTER TABLE sessions DROP COLUMN refresh_token;
+
+CREATE UNIQUE INDEX idx_sessions_token_hash ON sessions(token_hash);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_sessions_token_hash;
+
+ALTER TABLE sessions ADD COLUMN refresh_token TEXT;
+UPDATE sessions SET refresh_token = token_hash, status = 'revoked' WHERE status = 'active';
+UPDATE sessions SET refresh_token = token_hash WHERE refresh_token IS NULL;
+ALTER TABLE sessions ALTER COLUMN refresh_token SET NOT NULL;
+ALTER TABLE sessions ADD CONSTRAINT sessions_refresh_token_key UNIQUE (refresh_token);
+
+CREATE INDEX idx_sessions_refresh_token ON sessions(refresh_token);
+CREATE INDEX idx_sessions_token_hash ON sessions(token_hash);
+-- +goose StatementEnd
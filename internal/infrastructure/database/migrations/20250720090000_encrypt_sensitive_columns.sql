@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE sessions ALTER COLUMN ip_address TYPE TEXT USING host(ip_address);
+ALTER TABLE identity_verifications ALTER COLUMN provider_reference TYPE TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE sessions ALTER COLUMN ip_address TYPE INET
+    USING CASE WHEN ip_address LIKE 'enc:%' THEN NULL ELSE ip_address::inet END;
+ALTER TABLE identity_verifications ALTER COLUMN provider_reference TYPE VARCHAR(255)
+    USING CASE WHEN provider_reference LIKE 'enc:%' THEN NULL ELSE provider_reference END;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE policy_versions (
+                                 id SERIAL PRIMARY KEY,
+                                 type VARCHAR(30) NOT NULL,
+                                 version VARCHAR(50) NOT NULL,
+                                 title VARCHAR(200) NOT NULL,
+                                 content TEXT,
+                                 url TEXT,
+                                 summary TEXT,
+                                 published_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+                                 published_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                 created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_policy_versions_type_version ON policy_versions(type, version);
+CREATE INDEX idx_policy_versions_published_at ON policy_versions(type, published_at DESC);
+
+CREATE TABLE policy_acceptances (
+                                    id SERIAL PRIMARY KEY,
+                                    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                    policy_version_id INTEGER NOT NULL REFERENCES policy_versions(id) ON DELETE CASCADE,
+                                    ip_address TEXT,
+                                    user_agent TEXT,
+                                    accepted_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_policy_acceptances_user_version ON policy_acceptances(user_id, policy_version_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS policy_acceptances;
+DROP TABLE IF EXISTS policy_versions;
+-- +goose StatementEnd
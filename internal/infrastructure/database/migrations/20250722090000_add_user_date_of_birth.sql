@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN date_of_birth TEXT;
+ALTER TABLE users ADD COLUMN region VARCHAR(2);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN IF EXISTS region;
+ALTER TABLE users DROP COLUMN IF EXISTS date_of_birth;
+-- +goose StatementEnd
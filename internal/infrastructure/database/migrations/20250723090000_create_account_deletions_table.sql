@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE account_deletions (
+                                   id SERIAL PRIMARY KEY,
+                                   user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                   status VARCHAR(20) NOT NULL,
+                                   reason TEXT,
+                                   requested_at TIMESTAMP NOT NULL,
+                                   purge_after TIMESTAMP NOT NULL,
+                                   cancelled_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+                                   cancelled_at TIMESTAMP,
+                                   started_at TIMESTAMP,
+                                   completed_at TIMESTAMP,
+                                   completed_stage VARCHAR(20),
+                                   attempts INTEGER NOT NULL DEFAULT 0,
+                                   last_error TEXT,
+                                   report JSONB NOT NULL DEFAULT '{}',
+                                   created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                   updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_account_deletions_user_id ON account_deletions(user_id);
+CREATE INDEX idx_account_deletions_status ON account_deletions(status);
+CREATE INDEX idx_account_deletions_purge_after ON account_deletions(purge_after) WHERE status IN ('scheduled', 'failed', 'processing');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS account_deletions;
+-- +goose StatementEnd
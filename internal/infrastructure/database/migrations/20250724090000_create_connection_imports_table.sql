@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE connection_imports (
+                                    id SERIAL PRIMARY KEY,
+                                    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                    filename VARCHAR(255),
+                                    status VARCHAR(20) NOT NULL,
+                                    total_rows INTEGER NOT NULL DEFAULT 0,
+                                    processed_rows INTEGER NOT NULL DEFAULT 0,
+                                    matches JSONB NOT NULL DEFAULT '[]',
+                                    error TEXT,
+                                    started_at TIMESTAMP,
+                                    completed_at TIMESTAMP,
+                                    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_connection_imports_user_id ON connection_imports(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS connection_imports;
+-- +goose StatementEnd
@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE experiences (
+                             id SERIAL PRIMARY KEY,
+                             user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                             title VARCHAR(255) NOT NULL,
+                             company VARCHAR(255) NOT NULL,
+                             location VARCHAR(255),
+                             description TEXT,
+                             start_date DATE NOT NULL,
+                             end_date DATE,
+                             created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                             updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                             deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_experiences_user_id ON experiences(user_id);
+CREATE INDEX idx_experiences_deleted_at ON experiences(deleted_at);
+
+ALTER TABLE posts ADD COLUMN type VARCHAR(30) NOT NULL DEFAULT 'standard';
+ALTER TABLE posts ADD COLUMN event_data JSONB;
+
+CREATE TABLE post_suggestions (
+                                  id SERIAL PRIMARY KEY,
+                                  user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                  experience_id INTEGER REFERENCES experiences(id) ON DELETE SET NULL,
+                                  type VARCHAR(30) NOT NULL,
+                                  event_data JSONB NOT NULL DEFAULT '{}',
+                                  status VARCHAR(20) NOT NULL DEFAULT 'pending',
+                                  post_id INTEGER REFERENCES posts(id) ON DELETE SET NULL,
+                                  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_post_suggestions_user_status ON post_suggestions(user_id, status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS post_suggestions;
+ALTER TABLE posts DROP COLUMN IF EXISTS event_data;
+ALTER TABLE posts DROP COLUMN IF EXISTS type;
+DROP TABLE IF EXISTS experiences;
+-- +goose StatementEnd
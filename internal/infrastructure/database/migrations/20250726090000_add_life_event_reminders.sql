@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN life_event_reminders BOOLEAN DEFAULT TRUE;
+ALTER TABLE users ADD COLUMN share_birthday BOOLEAN DEFAULT FALSE;
+
+CREATE TABLE reminder_runs (
+                               day DATE PRIMARY KEY,
+                               status VARCHAR(20) NOT NULL,
+                               sent INTEGER NOT NULL DEFAULT 0,
+                               error TEXT,
+                               started_at TIMESTAMP NOT NULL,
+                               completed_at TIMESTAMP
+);
+
+CREATE INDEX idx_experiences_current_start ON experiences (EXTRACT(MONTH FROM start_date), EXTRACT(DAY FROM start_date)) WHERE end_date IS NULL AND deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_experiences_current_start;
+DROP TABLE IF EXISTS reminder_runs;
+ALTER TABLE users DROP COLUMN IF EXISTS share_birthday;
+ALTER TABLE users DROP COLUMN IF EXISTS life_event_reminders;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN show_presence BOOLEAN DEFAULT TRUE;
+ALTER TABLE users ADD COLUMN last_active_at TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN IF EXISTS last_active_at;
+ALTER TABLE users DROP COLUMN IF EXISTS show_presence;
+-- +goose StatementEnd
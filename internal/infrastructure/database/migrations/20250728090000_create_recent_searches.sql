@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN personalized_search BOOLEAN DEFAULT TRUE;
+
+CREATE TABLE recent_searches (
+                                 id SERIAL PRIMARY KEY,
+                                 user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                 type VARCHAR(20) NOT NULL,
+                                 query VARCHAR(200) NOT NULL,
+                                 filters JSONB DEFAULT '{}',
+                                 searched_at TIMESTAMP NOT NULL,
+                                 created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_recent_searches_user_query ON recent_searches (user_id, type, query);
+CREATE INDEX idx_recent_searches_user_searched ON recent_searches (user_id, searched_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS recent_searches;
+ALTER TABLE users DROP COLUMN IF EXISTS personalized_search;
+-- +goose StatementEnd
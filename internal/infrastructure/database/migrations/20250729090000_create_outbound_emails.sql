@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE outbound_emails (
+                                 id SERIAL PRIMARY KEY,
+                                 user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+                                 kind VARCHAR(30) NOT NULL,
+                                 recipient VARCHAR(255) NOT NULL,
+                                 subject VARCHAR(255) NOT NULL,
+                                 body TEXT NOT NULL,
+                                 status VARCHAR(20) NOT NULL DEFAULT 'pending',
+                                 attempts INTEGER DEFAULT 0,
+                                 last_error TEXT,
+                                 send_after TIMESTAMP NOT NULL,
+                                 sent_at TIMESTAMP,
+                                 cancelled_at TIMESTAMP,
+                                 created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                 updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_outbound_emails_user_id ON outbound_emails(user_id);
+CREATE INDEX idx_outbound_emails_due ON outbound_emails(send_after) WHERE status IN ('pending', 'sending');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS outbound_emails;
+-- +goose StatementEnd
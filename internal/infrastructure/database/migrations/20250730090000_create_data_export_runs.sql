@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE data_export_runs (
+                                  day DATE NOT NULL,
+                                  source VARCHAR(50) NOT NULL,
+                                  status VARCHAR(20) NOT NULL,
+                                  row_count BIGINT DEFAULT 0,
+                                  object_key VARCHAR(500),
+                                  error TEXT,
+                                  started_at TIMESTAMP NOT NULL,
+                                  completed_at TIMESTAMP,
+                                  PRIMARY KEY (day, source)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS data_export_runs;
+-- +goose StatementEnd
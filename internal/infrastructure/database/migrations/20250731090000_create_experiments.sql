@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE experiments (
+                             id SERIAL PRIMARY KEY,
+                             key VARCHAR(100) NOT NULL,
+                             description TEXT,
+                             status VARCHAR(20) NOT NULL DEFAULT 'running',
+                             variants JSONB NOT NULL,
+                             winner VARCHAR(50),
+                             created_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+                             started_at TIMESTAMP NOT NULL,
+                             stopped_at TIMESTAMP,
+                             created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                             updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_experiments_key ON experiments(key);
+CREATE UNIQUE INDEX idx_experiments_running_key ON experiments(key) WHERE status = 'running';
+
+CREATE TABLE experiment_assignments (
+                                        experiment_id INTEGER NOT NULL REFERENCES experiments(id) ON DELETE CASCADE,
+                                        user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                        variant VARCHAR(50) NOT NULL,
+                                        created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                        PRIMARY KEY (experiment_id, user_id)
+);
+
+CREATE INDEX idx_experiment_assignments_user_id ON experiment_assignments(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS experiment_assignments;
+DROP TABLE IF EXISTS experiments;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN recovery_email VARCHAR(255) DEFAULT '';
+
+CREATE TABLE recovery_codes (
+                                id SERIAL PRIMARY KEY,
+                                user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                code_hash VARCHAR(64) NOT NULL UNIQUE,
+                                used_at TIMESTAMP,
+                                created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_recovery_codes_user_id ON recovery_codes (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS recovery_codes;
+ALTER TABLE users DROP COLUMN IF EXISTS recovery_email;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE security_events (
+                                 id SERIAL PRIMARY KEY,
+                                 user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                 type VARCHAR(32) NOT NULL,
+                                 user_agent TEXT,
+                                 ip_address TEXT,
+                                 reported_at TIMESTAMP,
+                                 created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_security_events_user_created ON security_events (user_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS security_events;
+-- +goose StatementEnd
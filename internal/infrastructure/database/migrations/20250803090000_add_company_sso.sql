@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE company_sso_configs (
+                                     id SERIAL PRIMARY KEY,
+                                     company_id INTEGER NOT NULL UNIQUE REFERENCES companies(id) ON DELETE CASCADE,
+                                     protocol VARCHAR(8) NOT NULL,
+                                     oidc_issuer VARCHAR(255),
+                                     oidc_client_id VARCHAR(255),
+                                     oidc_client_secret TEXT,
+                                     saml_entity_id VARCHAR(255),
+                                     saml_sso_url VARCHAR(255),
+                                     saml_certificate TEXT,
+                                     enforced BOOLEAN DEFAULT FALSE,
+                                     jit_provisioning BOOLEAN DEFAULT TRUE,
+                                     updated_by INTEGER NOT NULL REFERENCES users(id),
+                                     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE sso_identities (
+                                id SERIAL PRIMARY KEY,
+                                company_id INTEGER NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+                                subject VARCHAR(255) NOT NULL,
+                                user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                last_login_at TIMESTAMP,
+                                created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_sso_identities_subject ON sso_identities (company_id, subject);
+CREATE INDEX idx_sso_identities_user_id ON sso_identities (user_id);
+
+ALTER TABLE sessions ADD COLUMN auth_method VARCHAR(16) DEFAULT 'password';
+ALTER TABLE sessions ADD COLUMN sso_company_id INTEGER REFERENCES companies(id) ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE sessions DROP COLUMN IF EXISTS sso_company_id;
+ALTER TABLE sessions DROP COLUMN IF EXISTS auth_method;
+DROP TABLE IF EXISTS sso_identities;
+DROP TABLE IF EXISTS company_sso_configs;
+-- +goose StatementEnd
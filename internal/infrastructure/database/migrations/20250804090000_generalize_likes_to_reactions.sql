@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE likes RENAME TO reactions;
+ALTER TABLE reactions RENAME CONSTRAINT likes_user_id_post_id_key TO idx_reactions_user_post;
+ALTER INDEX idx_likes_user_id RENAME TO idx_reactions_user_id;
+ALTER INDEX idx_likes_post_id RENAME TO idx_reactions_post_id;
+ALTER INDEX idx_likes_deleted_at RENAME TO idx_reactions_deleted_at;
+
+ALTER TABLE reactions
+    ADD COLUMN type VARCHAR(20) NOT NULL DEFAULT 'like',
+    ADD COLUMN updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+
+CREATE INDEX idx_reactions_post_type ON reactions (post_id, type) WHERE deleted_at IS NULL;
+
+ALTER TABLE posts RENAME COLUMN like_count TO reaction_count;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE posts RENAME COLUMN reaction_count TO like_count;
+
+DROP INDEX IF EXISTS idx_reactions_post_type;
+ALTER TABLE reactions
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS type;
+
+ALTER INDEX idx_reactions_deleted_at RENAME TO idx_likes_deleted_at;
+ALTER INDEX idx_reactions_post_id RENAME TO idx_likes_post_id;
+ALTER INDEX idx_reactions_user_id RENAME TO idx_likes_user_id;
+ALTER TABLE reactions RENAME CONSTRAINT idx_reactions_user_post TO likes_user_id_post_id_key;
+ALTER TABLE reactions RENAME TO likes;
+-- +goose StatementEnd
@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE company_teams (
+                               id SERIAL PRIMARY KEY,
+                               company_id INTEGER NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+                               parent_id INTEGER REFERENCES company_teams(id) ON DELETE SET NULL,
+                               name VARCHAR(100) NOT NULL,
+                               description TEXT,
+                               created_by INTEGER NOT NULL REFERENCES users(id),
+                               created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                               updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                               deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_company_teams_company_id ON company_teams(company_id);
+CREATE INDEX idx_company_teams_parent_id ON company_teams(parent_id);
+CREATE INDEX idx_company_teams_deleted_at ON company_teams(deleted_at);
+CREATE UNIQUE INDEX idx_company_teams_name ON company_teams(company_id, LOWER(name)) WHERE deleted_at IS NULL;
+
+CREATE TABLE team_members (
+                              team_id INTEGER NOT NULL REFERENCES company_teams(id) ON DELETE CASCADE,
+                              user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                              role VARCHAR(20) NOT NULL,
+                              added_by INTEGER NOT NULL REFERENCES users(id),
+                              created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                              updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                              PRIMARY KEY (team_id, user_id)
+);
+
+CREATE INDEX idx_team_members_user_id ON team_members(user_id);
+
+ALTER TABLE jobs ADD COLUMN team_id INTEGER REFERENCES company_teams(id) ON DELETE SET NULL;
+CREATE INDEX idx_jobs_team_id ON jobs(team_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_jobs_team_id;
+ALTER TABLE jobs DROP COLUMN IF EXISTS team_id;
+DROP TABLE IF EXISTS team_members;
+DROP TABLE IF EXISTS company_teams;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE posts ADD COLUMN shared_post_id INTEGER REFERENCES posts(id) ON DELETE SET NULL;
+CREATE INDEX idx_posts_shared_post_id ON posts(shared_post_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_posts_shared_post_id;
+ALTER TABLE posts DROP COLUMN IF EXISTS shared_post_id;
+-- +goose StatementEnd
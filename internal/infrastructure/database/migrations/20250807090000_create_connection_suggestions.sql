@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE connection_suggestions (
+                                        user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                        suggested_user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                        reason VARCHAR(30) NOT NULL,
+                                        company VARCHAR(255) NOT NULL,
+                                        overlap_start DATE NOT NULL,
+                                        overlap_end DATE,
+                                        overlap_days INTEGER NOT NULL DEFAULT 0,
+                                        dismissed_at TIMESTAMP,
+                                        computed_at TIMESTAMP NOT NULL,
+                                        PRIMARY KEY (user_id, suggested_user_id)
+);
+
+CREATE INDEX idx_connection_suggestions_suggested_user_id ON connection_suggestions(suggested_user_id);
+CREATE INDEX idx_connection_suggestions_computed_at ON connection_suggestions(computed_at);
+CREATE INDEX idx_experiences_company_lower ON experiences(LOWER(TRIM(company))) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_experiences_company_lower;
+DROP TABLE IF EXISTS connection_suggestions;
+-- +goose StatementEnd
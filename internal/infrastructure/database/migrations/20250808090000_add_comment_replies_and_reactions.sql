@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE comments ADD COLUMN parent_comment_id INTEGER REFERENCES comments(id) ON DELETE CASCADE;
+CREATE INDEX idx_comments_parent_comment_id ON comments(parent_comment_id, created_at);
+
+CREATE TABLE comment_reactions (
+                                   id SERIAL PRIMARY KEY,
+                                   user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                   comment_id INTEGER NOT NULL REFERENCES comments(id) ON DELETE CASCADE,
+                                   type VARCHAR(20) NOT NULL DEFAULT 'like',
+                                   created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                   updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                   deleted_at TIMESTAMP,
+                                   CONSTRAINT idx_comment_reactions_user_comment UNIQUE (user_id, comment_id)
+);
+
+CREATE INDEX idx_comment_reactions_comment_id ON comment_reactions(comment_id);
+CREATE INDEX idx_comment_reactions_deleted_at ON comment_reactions(deleted_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS comment_reactions;
+DROP INDEX IF EXISTS idx_comments_parent_comment_id;
+ALTER TABLE comments DROP COLUMN IF EXISTS parent_comment_id;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE hashtags (
+                          id SERIAL PRIMARY KEY,
+                          name VARCHAR(100) NOT NULL UNIQUE,
+                          created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE post_hashtags (
+                               post_id INTEGER NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+                               hashtag_id INTEGER NOT NULL REFERENCES hashtags(id) ON DELETE CASCADE,
+                               created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                               PRIMARY KEY (post_id, hashtag_id)
+);
+
+CREATE INDEX idx_post_hashtags_hashtag_id ON post_hashtags(hashtag_id);
+
+CREATE TABLE hashtag_follows (
+                                 user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                 hashtag_id INTEGER NOT NULL REFERENCES hashtags(id) ON DELETE CASCADE,
+                                 created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                 PRIMARY KEY (user_id, hashtag_id)
+);
+
+CREATE INDEX idx_hashtag_follows_hashtag_id ON hashtag_follows(hashtag_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS hashtag_follows;
+DROP TABLE IF EXISTS post_hashtags;
+DROP TABLE IF EXISTS hashtags;
+-- +goose StatementEnd
@@ -0,0 +1,72 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE skill_assessments (
+                                   id SERIAL PRIMARY KEY,
+                                   skill VARCHAR(50) NOT NULL,
+                                   title VARCHAR(200) NOT NULL,
+                                   description TEXT,
+                                   question_count INTEGER NOT NULL DEFAULT 15,
+                                   time_limit_minutes INTEGER NOT NULL DEFAULT 15,
+                                   pass_percent INTEGER NOT NULL DEFAULT 70,
+                                   retake_cooldown_days INTEGER NOT NULL DEFAULT 30,
+                                   is_active BOOLEAN NOT NULL DEFAULT FALSE,
+                                   created_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+                                   created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                   updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_skill_assessments_skill ON skill_assessments(skill);
+
+CREATE TABLE assessment_questions (
+                                      id SERIAL PRIMARY KEY,
+                                      assessment_id INTEGER NOT NULL REFERENCES skill_assessments(id) ON DELETE CASCADE,
+                                      prompt TEXT NOT NULL,
+                                      options JSONB NOT NULL,
+                                      correct_option INTEGER NOT NULL,
+                                      created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                      updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                      deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_assessment_questions_assessment_id ON assessment_questions(assessment_id);
+CREATE INDEX idx_assessment_questions_deleted_at ON assessment_questions(deleted_at);
+
+CREATE TABLE assessment_attempts (
+                                     id SERIAL PRIMARY KEY,
+                                     user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                     assessment_id INTEGER NOT NULL REFERENCES skill_assessments(id) ON DELETE CASCADE,
+                                     status VARCHAR(20) NOT NULL DEFAULT 'in_progress',
+                                     question_ids JSONB NOT NULL,
+                                     answers JSONB,
+                                     correct_count INTEGER NOT NULL DEFAULT 0,
+                                     score INTEGER NOT NULL DEFAULT 0,
+                                     started_at TIMESTAMP NOT NULL,
+                                     expires_at TIMESTAMP NOT NULL,
+                                     finished_at TIMESTAMP,
+                                     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_assessment_attempts_user_assessment ON assessment_attempts(user_id, assessment_id);
+CREATE UNIQUE INDEX idx_assessment_attempts_in_progress ON assessment_attempts(user_id, assessment_id) WHERE status = 'in_progress';
+
+CREATE TABLE skill_badges (
+                              user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                              assessment_id INTEGER NOT NULL REFERENCES skill_assessments(id) ON DELETE CASCADE,
+                              attempt_id INTEGER NOT NULL REFERENCES assessment_attempts(id) ON DELETE CASCADE,
+                              skill VARCHAR(50) NOT NULL,
+                              score INTEGER NOT NULL,
+                              earned_at TIMESTAMP NOT NULL,
+                              PRIMARY KEY (user_id, assessment_id)
+);
+
+CREATE INDEX idx_skill_badges_assessment_id ON skill_badges(assessment_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS skill_badges;
+DROP TABLE IF EXISTS assessment_attempts;
+DROP TABLE IF EXISTS assessment_questions;
+DROP TABLE IF EXISTS skill_assessments;
+-- +goose StatementEnd
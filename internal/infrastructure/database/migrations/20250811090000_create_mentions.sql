@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE mentions (
+                          id SERIAL PRIMARY KEY,
+                          entity_type VARCHAR(20) NOT NULL,
+                          entity_id INTEGER NOT NULL,
+                          mentioned_user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                          mentioner_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                          created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_mentions_entity_user ON mentions(entity_type, entity_id, mentioned_user_id);
+CREATE INDEX idx_mentions_mentioned_user_id ON mentions(mentioned_user_id);
+CREATE INDEX idx_mentions_mentioner_id ON mentions(mentioner_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS mentions;
+-- +goose StatementEnd
@@ -0,0 +1,72 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE courses (
+                         id SERIAL PRIMARY KEY,
+                         title VARCHAR(200) NOT NULL,
+                         description TEXT,
+                         skill VARCHAR(50),
+                         level VARCHAR(20) NOT NULL DEFAULT 'beginner',
+                         is_published BOOLEAN NOT NULL DEFAULT FALSE,
+                         created_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+                         created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                         updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_courses_skill ON courses(skill);
+
+CREATE TABLE lessons (
+                         id SERIAL PRIMARY KEY,
+                         course_id INTEGER NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+                         position INTEGER NOT NULL DEFAULT 0,
+                         title VARCHAR(200) NOT NULL,
+                         content TEXT,
+                         video_url VARCHAR(255),
+                         duration_minutes INTEGER NOT NULL DEFAULT 0,
+                         created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                         updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                         deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_lessons_course_id ON lessons(course_id);
+CREATE INDEX idx_lessons_deleted_at ON lessons(deleted_at);
+
+CREATE TABLE course_enrollments (
+                                    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                    course_id INTEGER NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+                                    enrolled_at TIMESTAMP NOT NULL,
+                                    completed_at TIMESTAMP,
+                                    PRIMARY KEY (user_id, course_id)
+);
+
+CREATE INDEX idx_course_enrollments_course_id ON course_enrollments(course_id);
+
+CREATE TABLE lesson_completions (
+                                    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                    lesson_id INTEGER NOT NULL REFERENCES lessons(id) ON DELETE CASCADE,
+                                    course_id INTEGER NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+                                    completed_at TIMESTAMP NOT NULL,
+                                    PRIMARY KEY (user_id, lesson_id)
+);
+
+CREATE INDEX idx_lesson_completions_course_id ON lesson_completions(course_id);
+
+CREATE TABLE course_certificates (
+                                     id SERIAL PRIMARY KEY,
+                                     user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                     course_id INTEGER NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+                                     code VARCHAR(32) NOT NULL,
+                                     issued_at TIMESTAMP NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_course_certificates_user_course ON course_certificates(user_id, course_id);
+CREATE UNIQUE INDEX idx_course_certificates_code ON course_certificates(code);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS course_certificates;
+DROP TABLE IF EXISTS lesson_completions;
+DROP TABLE IF EXISTS course_enrollments;
+DROP TABLE IF EXISTS lessons;
+DROP TABLE IF EXISTS courses;
+-- +goose StatementEnd
@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE conversations ADD COLUMN subject VARCHAR(200) NOT NULL DEFAULT '';
+
+CREATE TABLE mentorship_profiles (
+                                     id SERIAL PRIMARY KEY,
+                                     user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                     role VARCHAR(10) NOT NULL,
+                                     topics JSONB NOT NULL DEFAULT '[]',
+                                     availability VARCHAR(500),
+                                     bio TEXT,
+                                     max_mentees INTEGER NOT NULL DEFAULT 0,
+                                     is_active BOOLEAN NOT NULL DEFAULT TRUE,
+                                     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_mentorship_profiles_user_role ON mentorship_profiles(user_id, role);
+
+CREATE TABLE mentorship_matches (
+                                    id SERIAL PRIMARY KEY,
+                                    mentor_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                    mentee_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                    topics JSONB NOT NULL DEFAULT '[]',
+                                    score INTEGER NOT NULL DEFAULT 0,
+                                    status VARCHAR(20) NOT NULL DEFAULT 'proposed',
+                                    mentor_accepted_at TIMESTAMP,
+                                    mentee_accepted_at TIMESTAMP,
+                                    conversation_id INTEGER REFERENCES conversations(id) ON DELETE SET NULL,
+                                    accepted_at TIMESTAMP,
+                                    ended_at TIMESTAMP,
+                                    last_check_in_at TIMESTAMP,
+                                    next_check_in_at TIMESTAMP,
+                                    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_mentorship_matches_pair ON mentorship_matches(mentor_id, mentee_id);
+CREATE INDEX idx_mentorship_matches_mentee_id ON mentorship_matches(mentee_id);
+CREATE INDEX idx_mentorship_matches_status ON mentorship_matches(status);
+CREATE INDEX idx_mentorship_matches_next_check_in_at ON mentorship_matches(next_check_in_at) WHERE status = 'accepted';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS mentorship_matches;
+DROP TABLE IF EXISTS mentorship_profiles;
+ALTER TABLE conversations DROP COLUMN IF EXISTS subject;
+-- +goose StatementEnd
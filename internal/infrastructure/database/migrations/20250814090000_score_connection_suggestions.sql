@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE connection_suggestions ALTER COLUMN company SET DEFAULT '';
+ALTER TABLE connection_suggestions ALTER COLUMN overlap_start DROP NOT NULL;
+ALTER TABLE connection_suggestions ADD COLUMN mutual_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE connection_suggestions ADD COLUMN shared_location BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE connection_suggestions ADD COLUMN interaction_score INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE connection_suggestions ADD COLUMN score INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_connection_suggestions_user_score ON connection_suggestions(user_id, score DESC);
+CREATE INDEX idx_reactions_created_at ON reactions(created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_reactions_created_at;
+DROP INDEX IF EXISTS idx_connection_suggestions_user_score;
+DELETE FROM connection_suggestions WHERE overlap_start IS NULL;
+ALTER TABLE connection_suggestions DROP COLUMN IF EXISTS score;
+ALTER TABLE connection_suggestions DROP COLUMN IF EXISTS interaction_score;
+ALTER TABLE connection_suggestions DROP COLUMN IF EXISTS shared_location;
+ALTER TABLE connection_suggestions DROP COLUMN IF EXISTS mutual_count;
+ALTER TABLE connection_suggestions ALTER COLUMN overlap_start SET NOT NULL;
+ALTER TABLE connection_suggestions ALTER COLUMN company DROP DEFAULT;
+-- +goose StatementEnd
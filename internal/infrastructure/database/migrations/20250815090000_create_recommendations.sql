@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE recommendations (
+                                 id SERIAL PRIMARY KEY,
+                                 author_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                 recipient_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                 relationship VARCHAR(100),
+                                 body TEXT,
+                                 status VARCHAR(20) NOT NULL,
+                                 request_message TEXT,
+                                 revision_note TEXT,
+                                 is_hidden BOOLEAN NOT NULL DEFAULT FALSE,
+                                 position INTEGER NOT NULL DEFAULT 0,
+                                 submitted_at TIMESTAMP,
+                                 approved_at TIMESTAMP,
+                                 created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                 updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                 deleted_at TIMESTAMP
+);
+
+CREATE INDEX idx_recommendations_author_id ON recommendations(author_id);
+CREATE INDEX idx_recommendations_recipient_id ON recommendations(recipient_id);
+CREATE INDEX idx_recommendations_status ON recommendations(status);
+CREATE INDEX idx_recommendations_deleted_at ON recommendations(deleted_at);
+CREATE UNIQUE INDEX idx_recommendations_open_pair ON recommendations(author_id, recipient_id)
+    WHERE status <> 'declined' AND deleted_at IS NULL;
+
+CREATE TABLE recommendation_revisions (
+                                          id SERIAL PRIMARY KEY,
+                                          recommendation_id INTEGER NOT NULL REFERENCES recommendations(id) ON DELETE CASCADE,
+                                          relationship VARCHAR(100),
+                                          body TEXT NOT NULL,
+                                          created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_recommendation_revisions_recommendation_id ON recommendation_revisions(recommendation_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS recommendation_revisions;
+DROP TABLE IF EXISTS recommendations;
+-- +goose StatementEnd
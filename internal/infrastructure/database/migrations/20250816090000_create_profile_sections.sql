@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN section_order JSONB NOT NULL DEFAULT '[]';
+
+CREATE TABLE profile_sections (
+                                  id SERIAL PRIMARY KEY,
+                                  user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                  type VARCHAR(20) NOT NULL,
+                                  payload JSONB NOT NULL,
+                                  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_profile_sections_user_id_type ON profile_sections(user_id, type);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS profile_sections;
+ALTER TABLE users DROP COLUMN IF EXISTS section_order;
+-- +goose StatementEnd
@@ -0,0 +1,142 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE industries (
+                            id SERIAL PRIMARY KEY,
+                            name VARCHAR(100) NOT NULL,
+                            slug VARCHAR(100) NOT NULL,
+                            created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_industries_name ON industries(name);
+CREATE UNIQUE INDEX idx_industries_slug ON industries(slug);
+
+CREATE TABLE locations (
+                           id SERIAL PRIMARY KEY,
+                           city VARCHAR(100) NOT NULL DEFAULT '',
+                           region VARCHAR(100) NOT NULL DEFAULT '',
+                           country VARCHAR(2) NOT NULL,
+                           country_name VARCHAR(100) NOT NULL,
+                           aliases JSONB NOT NULL DEFAULT '[]',
+                           created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_locations_place ON locations(country, region, city);
+
+INSERT INTO industries (name, slug) VALUES
+    ('Accounting', 'accounting'),
+    ('Advertising & Marketing', 'advertising-marketing'),
+    ('Agriculture', 'agriculture'),
+    ('Automotive', 'automotive'),
+    ('Banking', 'banking'),
+    ('Biotechnology', 'biotechnology'),
+    ('Construction', 'construction'),
+    ('Consulting', 'consulting'),
+    ('Consumer Goods', 'consumer-goods'),
+    ('Design', 'design'),
+    ('E-Commerce', 'e-commerce'),
+    ('Education', 'education'),
+    ('Energy & Mining', 'energy-mining'),
+    ('Entertainment', 'entertainment'),
+    ('Financial Services', 'financial-services'),
+    ('Food & Beverages', 'food-beverages'),
+    ('Government', 'government'),
+    ('Healthcare', 'healthcare'),
+    ('Hospitality', 'hospitality'),
+    ('Human Resources', 'human-resources'),
+    ('Information Technology', 'information-technology'),
+    ('Insurance', 'insurance'),
+    ('Legal Services', 'legal-services'),
+    ('Logistics & Supply Chain', 'logistics-supply-chain'),
+    ('Manufacturing', 'manufacturing'),
+    ('Media & Publishing', 'media-publishing'),
+    ('Non-profit', 'non-profit'),
+    ('Pharmaceuticals', 'pharmaceuticals'),
+    ('Real Estate', 'real-estate'),
+    ('Retail', 'retail'),
+    ('Software Development', 'software-development'),
+    ('Telecommunications', 'telecommunications'),
+    ('Transportation', 'transportation'),
+    ('Travel & Tourism', 'travel-tourism');
+
+INSERT INTO locations (city, region, country, country_name, aliases) VALUES
+    ('', '', 'ID', 'Indonesia', '[]'),
+    ('', '', 'SG', 'Singapore', '[]'),
+    ('', '', 'MY', 'Malaysia', '[]'),
+    ('', '', 'US', 'United States', '["USA", "United States of America"]'),
+    ('', '', 'GB', 'United Kingdom', '["UK"]'),
+    ('', '', 'AU', 'Australia', '[]'),
+    ('', '', 'JP', 'Japan', '[]'),
+    ('', '', 'DE', 'Germany', '[]'),
+    ('', '', 'NL', 'Netherlands', '[]'),
+    ('', '', 'IN', 'India', '[]'),
+    ('', 'DKI Jakarta', 'ID', 'Indonesia', '[]'),
+    ('', 'West Java', 'ID', 'Indonesia', '["Jawa Barat"]'),
+    ('', 'East Java', 'ID', 'Indonesia', '["Jawa Timur"]'),
+    ('', 'Central Java', 'ID', 'Indonesia', '["Jawa Tengah"]'),
+    ('', 'DI Yogyakarta', 'ID', 'Indonesia', '[]'),
+    ('', 'Bali', 'ID', 'Indonesia', '[]'),
+    ('', 'Banten', 'ID', 'Indonesia', '[]'),
+    ('', 'California', 'US', 'United States', '[]'),
+    ('', 'New York', 'US', 'United States', '[]'),
+    ('', 'England', 'GB', 'United Kingdom', '[]'),
+    ('Jakarta', 'DKI Jakarta', 'ID', 'Indonesia', '["Jakarta Raya"]'),
+    ('South Jakarta', 'DKI Jakarta', 'ID', 'Indonesia', '["Jakarta Selatan"]'),
+    ('Bandung', 'West Java', 'ID', 'Indonesia', '[]'),
+    ('Bekasi', 'West Java', 'ID', 'Indonesia', '[]'),
+    ('Bogor', 'West Java', 'ID', 'Indonesia', '[]'),
+    ('Depok', 'West Java', 'ID', 'Indonesia', '[]'),
+    ('Surabaya', 'East Java', 'ID', 'Indonesia', '[]'),
+    ('Malang', 'East Java', 'ID', 'Indonesia', '[]'),
+    ('Semarang', 'Central Java', 'ID', 'Indonesia', '[]'),
+    ('Yogyakarta', 'DI Yogyakarta', 'ID', 'Indonesia', '["Jogja", "Jogjakarta"]'),
+    ('Denpasar', 'Bali', 'ID', 'Indonesia', '[]'),
+    ('Tangerang', 'Banten', 'ID', 'Indonesia', '[]'),
+    ('Medan', 'North Sumatra', 'ID', 'Indonesia', '[]'),
+    ('Makassar', 'South Sulawesi', 'ID', 'Indonesia', '[]'),
+    ('Kuala Lumpur', '', 'MY', 'Malaysia', '["KL"]'),
+    ('San Francisco', 'California', 'US', 'United States', '["SF"]'),
+    ('Los Angeles', 'California', 'US', 'United States', '["LA"]'),
+    ('New York City', 'New York', 'US', 'United States', '["New York", "NYC"]'),
+    ('Seattle', 'Washington', 'US', 'United States', '[]'),
+    ('London', 'England', 'GB', 'United Kingdom', '[]'),
+    ('Sydney', 'New South Wales', 'AU', 'Australia', '[]'),
+    ('Melbourne', 'Victoria', 'AU', 'Australia', '[]'),
+    ('Tokyo', '', 'JP', 'Japan', '[]'),
+    ('Berlin', '', 'DE', 'Germany', '[]'),
+    ('Amsterdam', 'North Holland', 'NL', 'Netherlands', '[]'),
+    ('Bengaluru', 'Karnataka', 'IN', 'India', '["Bangalore"]');
+
+ALTER TABLE users ADD COLUMN location_id INTEGER REFERENCES locations(id) ON DELETE SET NULL;
+ALTER TABLE users ADD COLUMN industry VARCHAR(100);
+ALTER TABLE users ADD COLUMN industry_id INTEGER REFERENCES industries(id) ON DELETE SET NULL;
+CREATE INDEX idx_users_location_id ON users(location_id);
+CREATE INDEX idx_users_industry_id ON users(industry_id);
+
+ALTER TABLE jobs ADD COLUMN location_id INTEGER REFERENCES locations(id) ON DELETE SET NULL;
+ALTER TABLE jobs ADD COLUMN industry VARCHAR(100);
+ALTER TABLE jobs ADD COLUMN industry_id INTEGER REFERENCES industries(id) ON DELETE SET NULL;
+CREATE INDEX idx_jobs_location_id ON jobs(location_id);
+CREATE INDEX idx_jobs_industry_id ON jobs(industry_id);
+
+ALTER TABLE experiences ADD COLUMN location_id INTEGER REFERENCES locations(id) ON DELETE SET NULL;
+CREATE INDEX idx_experiences_location_id ON experiences(location_id);
+
+ALTER TABLE companies ADD COLUMN industry VARCHAR(100);
+ALTER TABLE companies ADD COLUMN industry_id INTEGER REFERENCES industries(id) ON DELETE SET NULL;
+CREATE INDEX idx_companies_industry_id ON companies(industry_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE companies DROP COLUMN IF EXISTS industry_id;
+ALTER TABLE companies DROP COLUMN IF EXISTS industry;
+ALTER TABLE experiences DROP COLUMN IF EXISTS location_id;
+ALTER TABLE jobs DROP COLUMN IF EXISTS industry_id;
+ALTER TABLE jobs DROP COLUMN IF EXISTS industry;
+ALTER TABLE jobs DROP COLUMN IF EXISTS location_id;
+ALTER TABLE users DROP COLUMN IF EXISTS industry_id;
+ALTER TABLE users DROP COLUMN IF EXISTS industry;
+ALTER TABLE users DROP COLUMN IF EXISTS location_id;
+DROP TABLE IF EXISTS locations;
+DROP TABLE IF EXISTS industries;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE saved_jobs (
+                            id SERIAL PRIMARY KEY,
+                            user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                            job_id INTEGER NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+                            created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_saved_jobs_user_job ON saved_jobs(user_id, job_id);
+CREATE INDEX idx_saved_jobs_job_id ON saved_jobs(job_id);
+
+CREATE TABLE saved_posts (
+                             id SERIAL PRIMARY KEY,
+                             user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                             post_id INTEGER NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+                             created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_saved_posts_user_post ON saved_posts(user_id, post_id);
+CREATE INDEX idx_saved_posts_post_id ON saved_posts(post_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS saved_posts;
+DROP TABLE IF EXISTS saved_jobs;
+-- +goose StatementEnd
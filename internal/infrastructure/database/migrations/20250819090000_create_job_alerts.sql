@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE jobs ADD COLUMN published_at TIMESTAMP;
+UPDATE jobs SET published_at = COALESCE(reviewed_at, created_at) WHERE status = 'published';
+CREATE INDEX idx_jobs_published_at ON jobs(published_at);
+
+CREATE TABLE job_alerts (
+                            id SERIAL PRIMARY KEY,
+                            user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                            name VARCHAR(100) NOT NULL,
+                            query VARCHAR(200),
+                            filters JSONB NOT NULL DEFAULT '{}',
+                            frequency VARCHAR(10) NOT NULL,
+                            is_active BOOLEAN NOT NULL DEFAULT TRUE,
+                            unsubscribe_token VARCHAR(64) NOT NULL,
+                            last_checked_at TIMESTAMP NOT NULL,
+                            next_run_at TIMESTAMP NOT NULL,
+                            last_sent_at TIMESTAMP,
+                            created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                            updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_job_alerts_user_id ON job_alerts(user_id);
+CREATE UNIQUE INDEX idx_job_alerts_unsubscribe_token ON job_alerts(unsubscribe_token);
+CREATE INDEX idx_job_alerts_next_run_at ON job_alerts(next_run_at) WHERE is_active = TRUE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS job_alerts;
+DROP INDEX IF EXISTS idx_jobs_published_at;
+ALTER TABLE jobs DROP COLUMN IF EXISTS published_at;
+-- +goose StatementEnd
@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE company_follows (
+                                 company_id INTEGER NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+                                 user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                                 created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                 PRIMARY KEY (company_id, user_id)
+);
+
+CREATE INDEX idx_company_follows_user_id ON company_follows(user_id);
+
+CREATE TABLE company_daily_stats (
+                                     company_id INTEGER NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+                                     day DATE NOT NULL,
+                                     followers BIGINT NOT NULL DEFAULT 0,
+                                     new_followers BIGINT NOT NULL DEFAULT 0,
+                                     employee_posts BIGINT NOT NULL DEFAULT 0,
+                                     employee_post_reactions BIGINT NOT NULL DEFAULT 0,
+                                     employee_posters BIGINT NOT NULL DEFAULT 0,
+                                     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                     PRIMARY KEY (company_id, day)
+);
+
+CREATE TABLE job_daily_stats (
+                                 job_id INTEGER NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+                                 day DATE NOT NULL,
+                                 company_id INTEGER NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+                                 views BIGINT NOT NULL DEFAULT 0,
+                                 unique_viewers BIGINT NOT NULL DEFAULT 0,
+                                 saves BIGINT NOT NULL DEFAULT 0,
+                                 applications BIGINT NOT NULL DEFAULT 0,
+                                 pending BIGINT NOT NULL DEFAULT 0,
+                                 reviewed BIGINT NOT NULL DEFAULT 0,
+                                 accepted BIGINT NOT NULL DEFAULT 0,
+                                 rejected BIGINT NOT NULL DEFAULT 0,
+                                 withdrawn BIGINT NOT NULL DEFAULT 0,
+                                 updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                 PRIMARY KEY (job_id, day)
+);
+
+CREATE INDEX idx_job_daily_stats_company_day ON job_daily_stats(company_id, day);
+CREATE INDEX idx_applications_job_applied_at ON applications(job_id, applied_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_applications_job_applied_at;
+DROP TABLE IF EXISTS job_daily_stats;
+DROP TABLE IF EXISTS company_daily_stats;
+DROP TABLE IF EXISTS company_follows;
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE applications ALTER COLUMN status DROP DEFAULT;
+ALTER TABLE applications ALTER COLUMN status TYPE VARCHAR(20) USING status::text;
+ALTER TABLE applications ALTER COLUMN status SET DEFAULT 'pending';
+DROP TYPE IF EXISTS application_status;
+
+UPDATE applications SET status = 'hired' WHERE status = 'accepted';
+
+CREATE TABLE application_status_histories (
+                                              id SERIAL PRIMARY KEY,
+                                              application_id INTEGER NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+                                              from_status VARCHAR(20) NOT NULL,
+                                              to_status VARCHAR(20) NOT NULL,
+                                              changed_by INTEGER NOT NULL REFERENCES users(id),
+                                              note TEXT,
+                                              created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_application_status_histories_application_id ON application_status_histories(application_id);
+
+ALTER TABLE job_daily_stats RENAME COLUMN accepted TO hired;
+ALTER TABLE job_daily_stats ADD COLUMN shortlisted BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE job_daily_stats ADD COLUMN interviewing BIGINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE job_daily_stats DROP COLUMN IF EXISTS interviewing;
+ALTER TABLE job_daily_stats DROP COLUMN IF EXISTS shortlisted;
+ALTER TABLE job_daily_stats RENAME COLUMN hired TO accepted;
+
+DROP TABLE IF EXISTS application_status_histories;
+
+-- The column stays VARCHAR: the old enum never had 'withdrawn'.
+UPDATE applications SET status = 'reviewed' WHERE status IN ('shortlisted', 'interviewing');
+UPDATE applications SET status = 'accepted' WHERE status = 'hired';
+-- +goose StatementEnd
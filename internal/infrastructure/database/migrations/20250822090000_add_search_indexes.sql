@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Each expression must match internal/infrastructure/search exactly or the planner ignores the index.
+CREATE INDEX idx_users_search ON users USING GIN ((
+    setweight(to_tsvector('simple', coalesce(full_name, '') || ' ' || coalesce(username, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(headline, '')), 'B') ||
+    setweight(to_tsvector('simple', coalesce(bio, '')), 'D')
+)) WHERE deleted_at IS NULL;
+
+CREATE INDEX idx_users_lower_email ON users(LOWER(email));
+
+CREATE INDEX idx_jobs_search ON jobs USING GIN ((
+    setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(company, '')), 'B') ||
+    setweight(to_tsvector('simple', coalesce(description, '')), 'D')
+)) WHERE deleted_at IS NULL;
+
+CREATE INDEX idx_posts_search ON posts USING GIN ((
+    to_tsvector('simple', coalesce(content, ''))
+)) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_posts_search;
+DROP INDEX IF EXISTS idx_jobs_search;
+DROP INDEX IF EXISTS idx_users_lower_email;
+DROP INDEX IF EXISTS idx_users_search;
+-- +goose StatementEnd
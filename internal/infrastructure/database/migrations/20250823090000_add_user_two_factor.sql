@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN totp_secret TEXT;
+ALTER TABLE users ADD COLUMN two_factor_enabled BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE users ADD COLUMN two_factor_enabled_at TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN IF EXISTS two_factor_enabled_at;
+ALTER TABLE users DROP COLUMN IF EXISTS two_factor_enabled;
+ALTER TABLE users DROP COLUMN IF EXISTS totp_secret;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE upload_jobs (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(50) NOT NULL,
+    resource_id INTEGER,
+    filename VARCHAR(255),
+    size BIGINT DEFAULT 0,
+    stage VARCHAR(20) NOT NULL DEFAULT 'queued',
+    failed_stage VARCHAR(20),
+    total_items INTEGER DEFAULT 0,
+    processed_items INTEGER DEFAULT 0,
+    error TEXT,
+    validated_at TIMESTAMP,
+    scanned_at TIMESTAMP,
+    completed_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_upload_jobs_user_id ON upload_jobs(user_id);
+-- Only unfinished jobs are swept for staleness.
+CREATE INDEX idx_upload_jobs_unfinished ON upload_jobs(updated_at) WHERE stage NOT IN ('completed', 'failed');
+
+ALTER TABLE connection_imports ADD COLUMN upload_id INTEGER REFERENCES upload_jobs(id) ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE connection_imports DROP COLUMN IF EXISTS upload_id;
+DROP TABLE IF EXISTS upload_jobs;
+-- +goose StatementEnd
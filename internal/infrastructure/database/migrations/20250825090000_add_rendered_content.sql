@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE posts ADD COLUMN rendered_content TEXT NOT NULL DEFAULT '';
+ALTER TABLE comments ADD COLUMN rendered_content TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE comments DROP COLUMN IF EXISTS rendered_content;
+ALTER TABLE posts DROP COLUMN IF EXISTS rendered_content;
+-- +goose StatementEnd
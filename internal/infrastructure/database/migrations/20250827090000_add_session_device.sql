@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE sessions ADD COLUMN device_type VARCHAR(16) NOT NULL DEFAULT '';
+ALTER TABLE sessions ADD COLUMN os VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE sessions ADD COLUMN browser VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE sessions ADD COLUMN location TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE sessions DROP COLUMN IF EXISTS location;
+ALTER TABLE sessions DROP COLUMN IF EXISTS browser;
+ALTER TABLE sessions DROP COLUMN IF EXISTS os;
+ALTER TABLE sessions DROP COLUMN IF EXISTS device_type;
+-- +goose StatementEnd
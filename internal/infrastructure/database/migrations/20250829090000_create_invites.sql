@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE invites (
+    id SERIAL PRIMARY KEY,
+    code VARCHAR(32) NOT NULL,
+    inviter_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    note VARCHAR(200),
+    max_uses INTEGER NOT NULL DEFAULT 1,
+    use_count INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (use_count <= max_uses)
+);
+
+CREATE UNIQUE INDEX idx_invites_code ON invites(code);
+CREATE INDEX idx_invites_inviter_id ON invites(inviter_id);
+
+ALTER TABLE users
+    ADD COLUMN invite_id INTEGER REFERENCES invites(id) ON DELETE SET NULL,
+    ADD COLUMN invited_by_id INTEGER REFERENCES users(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_users_invite_id ON users(invite_id);
+CREATE INDEX idx_users_invited_by_id ON users(invited_by_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users
+    DROP COLUMN IF EXISTS invited_by_id,
+    DROP COLUMN IF EXISTS invite_id;
+
+DROP TABLE IF EXISTS invites;
+-- +goose StatementEnd
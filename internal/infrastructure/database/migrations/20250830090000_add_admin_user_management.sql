@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    ADD COLUMN suspended_at TIMESTAMP,
+    ADD COLUMN suspension_reason TEXT;
+
+CREATE INDEX idx_users_suspended_at ON users(suspended_at) WHERE suspended_at IS NOT NULL;
+
+CREATE TABLE admin_audit_logs (
+    id SERIAL PRIMARY KEY,
+    actor_id INTEGER NOT NULL REFERENCES users(id),
+    target_user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    action VARCHAR(32) NOT NULL,
+    reason TEXT,
+    details JSONB,
+    ip_address TEXT,
+    user_agent TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_admin_audit_logs_actor_id ON admin_audit_logs(actor_id);
+CREATE INDEX idx_admin_audit_logs_target_user_id ON admin_audit_logs(target_user_id);
+CREATE INDEX idx_admin_audit_logs_created_at ON admin_audit_logs(created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS admin_audit_logs;
+
+DROP INDEX IF EXISTS idx_users_suspended_at;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS suspension_reason,
+    DROP COLUMN IF EXISTS suspended_at;
+-- +goose StatementEnd
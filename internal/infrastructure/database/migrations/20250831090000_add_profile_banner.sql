@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN profile_banner TEXT;
+ALTER TABLE users ADD COLUMN profile_banner_large TEXT;
+ALTER TABLE users ADD COLUMN profile_banner_small TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN IF EXISTS profile_banner_small;
+ALTER TABLE users DROP COLUMN IF EXISTS profile_banner_large;
+ALTER TABLE users DROP COLUMN IF EXISTS profile_banner;
+-- +goose StatementEnd
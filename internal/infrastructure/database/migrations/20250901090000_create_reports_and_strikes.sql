@@ -0,0 +1,62 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE posts ADD COLUMN hidden_at TIMESTAMP;
+ALTER TABLE comments ADD COLUMN hidden_at TIMESTAMP;
+ALTER TABLE jobs ADD COLUMN hidden_at TIMESTAMP;
+
+CREATE INDEX idx_posts_hidden_at ON posts(hidden_at) WHERE hidden_at IS NOT NULL;
+CREATE INDEX idx_comments_hidden_at ON comments(hidden_at) WHERE hidden_at IS NOT NULL;
+
+CREATE TABLE strikes (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id),
+    report_id INTEGER,
+    moderator_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    reason TEXT NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_strikes_user_id ON strikes(user_id);
+
+CREATE TABLE reports (
+    id SERIAL PRIMARY KEY,
+    reporter_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    target_type VARCHAR(20) NOT NULL,
+    target_id INTEGER NOT NULL,
+    target_user_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    reason VARCHAR(32) NOT NULL,
+    details TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    moderator_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    moderator_note TEXT,
+    content_hidden BOOLEAN DEFAULT FALSE,
+    strike_id INTEGER REFERENCES strikes(id) ON DELETE SET NULL,
+    resolved_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_reports_reporter_target ON reports(reporter_id, target_type, target_id);
+CREATE INDEX idx_reports_target ON reports(target_type, target_id);
+CREATE INDEX idx_reports_reporter_id ON reports(reporter_id);
+CREATE INDEX idx_reports_target_user_id ON reports(target_user_id);
+CREATE INDEX idx_reports_status ON reports(status);
+
+ALTER TABLE strikes ADD CONSTRAINT fk_strikes_report FOREIGN KEY (report_id) REFERENCES reports(id) ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE strikes DROP CONSTRAINT IF EXISTS fk_strikes_report;
+DROP TABLE IF EXISTS reports;
+DROP TABLE IF EXISTS strikes;
+
+DROP INDEX IF EXISTS idx_comments_hidden_at;
+DROP INDEX IF EXISTS idx_posts_hidden_at;
+
+ALTER TABLE jobs DROP COLUMN IF EXISTS hidden_at;
+ALTER TABLE comments DROP COLUMN IF EXISTS hidden_at;
+ALTER TABLE posts DROP COLUMN IF EXISTS hidden_at;
+-- +goose StatementEnd
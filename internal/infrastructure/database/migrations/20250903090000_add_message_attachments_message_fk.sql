@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Partitioning messages dropped the attachment foreign key, since the primary
+-- key became (id, created_at). Attachments now carry the message's created_at
+-- so they can reference that key again and go away with their message.
+ALTER TABLE message_attachments ADD COLUMN message_created_at TIMESTAMP;
+
+-- Attachments of messages deleted while the key was missing; their files are
+-- left for the s3_keys consistency check to remove.
+DELETE FROM message_attachments a
+WHERE NOT EXISTS (SELECT 1 FROM messages m WHERE m.id = a.message_id);
+
+UPDATE message_attachments a
+SET message_created_at = m.created_at
+FROM messages m
+WHERE m.id = a.message_id;
+
+ALTER TABLE message_attachments ALTER COLUMN message_created_at SET NOT NULL;
+
+ALTER TABLE message_attachments
+    ADD CONSTRAINT message_attachments_message_fkey
+        FOREIGN KEY (message_id, message_created_at) REFERENCES messages(id, created_at) ON DELETE CASCADE;
+
+DROP INDEX IF EXISTS idx_message_attachments_message_id;
+CREATE INDEX idx_message_attachments_message ON message_attachments(message_id, message_created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE message_attachments DROP CONSTRAINT IF EXISTS message_attachments_message_fkey;
+DROP INDEX IF EXISTS idx_message_attachments_message;
+CREATE INDEX idx_message_attachments_message_id ON message_attachments(message_id);
+ALTER TABLE message_attachments DROP COLUMN IF EXISTS message_created_at;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE companies ADD COLUMN domain_token TEXT NOT NULL DEFAULT '';
+ALTER TABLE companies ADD COLUMN domain_verified_at TIMESTAMP;
+
+-- A confirmed business email on exactly the company domain already proves
+-- control of it; other companies must publish the DNS record before their
+-- SSO works again.
+UPDATE companies c
+SET domain_verified_at = NOW()
+WHERE EXISTS (
+    SELECT 1 FROM company_verifications v
+    WHERE v.company_id = c.id
+      AND v.method = 'business_email'
+      AND v.status = 'approved'
+      AND v.email_confirmed_at IS NOT NULL
+      AND LOWER(split_part(v.business_email, '@', 2)) = LOWER(c.domain)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE companies DROP COLUMN IF EXISTS domain_verified_at;
+ALTER TABLE companies DROP COLUMN IF EXISTS domain_token;
+-- +goose StatementEnd
@@ -0,0 +1,187 @@
+package database
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"linked-clone/internal/config"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pressly/goose/v3"
+)
+
+// migrationLockID is the pg advisory lock key shared by every process that
+// applies migrations, so replicas booting together run them one at a time.
+const migrationLockID int64 = 7_342_001_845
+
+const checksumTable = "schema_migration_checksums"
+
+var ErrChecksumMismatch = errors.New("applied migration files have been modified")
+
+type MigrationReport struct {
+	Applied    int
+	Pending    int
+	Recorded   int
+	Mismatched []string
+}
+
+func openMigrationDB(cfg config.DatabaseConfig) (*sql.DB, error) {
+	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=%s",
+		cfg.Host, cfg.User, cfg.Password, cfg.DBName, cfg.Port, cfg.SSLMode)
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database connection for migrations: %w", err)
+	}
+	if err := goose.SetDialect("postgres"); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to set goose dialect: %w", err)
+	}
+	return db, nil
+}
+
+func withMigrationLock(ctx context.Context, db *sql.DB, timeout time.Duration, fn func() error) error {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to reserve migration lock connection: %w", err)
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(timeout)
+	for {
+		var locked bool
+		if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", migrationLockID).Scan(&locked); err != nil {
+			return fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+		if locked {
+			break
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out after %s waiting for migration lock", timeout)
+		}
+
+		select {
+		case <-time.After(time.Second):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", migrationLockID)
+
+	return fn()
+}
+
+func verifyChecksums(ctx context.Context, db *sql.DB, migrationsDir string, record bool) (*MigrationReport, error) {
+	if _, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+checksumTable+` (
+		version BIGINT PRIMARY KEY,
+		filename VARCHAR(255) NOT NULL,
+		checksum CHAR(64) NOT NULL,
+		recorded_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	)`); err != nil {
+		return nil, fmt.Errorf("failed to create migration checksum table: %w", err)
+	}
+
+	migrations, err := goose.CollectMigrations(migrationsDir, 0, goose.MaxVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect migrations: %w", err)
+	}
+
+	applied, err := appliedVersions(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	stored := make(map[int64]string)
+	rows, err := db.QueryContext(ctx, "SELECT version, checksum FROM "+checksumTable)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load migration checksums: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var version int64
+		var checksum string
+		if err := rows.Scan(&version, &checksum); err != nil {
+			return nil, fmt.Errorf("failed to load migration checksums: %w", err)
+		}
+		stored[version] = checksum
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to load migration checksums: %w", err)
+	}
+
+	report := &MigrationReport{}
+	for _, migration := range migrations {
+		if !applied[migration.Version] {
+			report.Pending++
+			continue
+		}
+		report.Applied++
+
+		checksum, err := fileChecksum(migration.Source)
+		if err != nil {
+			return nil, err
+		}
+		filename := filepath.Base(migration.Source)
+
+		previous, ok := stored[migration.Version]
+		switch {
+		case !ok || (record && previous != checksum):
+			if _, err := db.ExecContext(ctx, `INSERT INTO `+checksumTable+` (version, filename, checksum) VALUES ($1, $2, $3)
+				ON CONFLICT (version) DO UPDATE SET filename = EXCLUDED.filename, checksum = EXCLUDED.checksum, recorded_at = NOW()`,
+				migration.Version, filename, checksum); err != nil {
+				return nil, fmt.Errorf("failed to record checksum for %s: %w", filename, err)
+			}
+			report.Recorded++
+		case previous != checksum:
+			report.Mismatched = append(report.Mismatched, filename)
+		}
+	}
+
+	if len(report.Mismatched) > 0 {
+		return report, fmt.Errorf("%w: %s", ErrChecksumMismatch, strings.Join(report.Mismatched, ", "))
+	}
+	return report, nil
+}
+
+func appliedVersions(ctx context.Context, db *sql.DB) (map[int64]bool, error) {
+	applied := make(map[int64]bool)
+
+	var exists bool
+	if err := db.QueryRowContext(ctx, "SELECT to_regclass($1) IS NOT NULL", goose.TableName()).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("failed to check migration table: %w", err)
+	}
+	if !exists {
+		return applied, nil
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT version_id, is_applied FROM "+goose.TableName()+" ORDER BY id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load applied migrations: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var version int64
+		var isApplied bool
+		if err := rows.Scan(&version, &isApplied); err != nil {
+			return nil, fmt.Errorf("failed to load applied migrations: %w", err)
+		}
+		applied[version] = isApplied
+	}
+	return applied, rows.Err()
+}
+
+func fileChecksum(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read migration %s: %w", path, err)
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,107 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var PartitionedTables = []string{"messages", "notifications", "analytics_events"}
+
+var partitionBoundPattern = regexp.MustCompile(`FROM \('(\d{4}-\d{2}-\d{2})[^']*'\) TO \('(\d{4}-\d{2}-\d{2})`)
+
+type PartitionInfo struct {
+	Name       string
+	RangeStart *time.Time
+	RangeEnd   *time.Time
+}
+
+type PartitionManager struct {
+	db *gorm.DB
+}
+
+func NewPartitionManager(db *gorm.DB) *PartitionManager {
+	return &PartitionManager{db: db}
+}
+
+func (m *PartitionManager) EnsureMonthlyPartitions(ctx context.Context, table string, monthsAhead int) (int, error) {
+	var created int
+	err := m.db.WithContext(ctx).
+		Raw("SELECT ensure_monthly_partitions(?, CURRENT_DATE, ?)", table, monthsAhead).
+		Scan(&created).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to ensure partitions for %s: %w", table, err)
+	}
+	return created, nil
+}
+
+func (m *PartitionManager) ListPartitions(ctx context.Context, table string) ([]PartitionInfo, error) {
+	var rows []struct {
+		Name  string
+		Bound string
+	}
+
+	err := m.db.WithContext(ctx).Raw(`
+		SELECT child.relname AS name, pg_get_expr(child.relpartbound, child.oid) AS bound
+		FROM pg_inherits
+		JOIN pg_class parent ON pg_inherits.inhparent = parent.oid
+		JOIN pg_class child ON pg_inherits.inhrelid = child.oid
+		WHERE parent.relname = ?
+		ORDER BY child.relname`, table).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list partitions for %s: %w", table, err)
+	}
+
+	partitions := make([]PartitionInfo, 0, len(rows))
+	for _, row := range rows {
+		info := PartitionInfo{Name: row.Name}
+		if match := partitionBoundPattern.FindStringSubmatch(row.Bound); match != nil {
+			if start, err := time.Parse("2006-01-02", match[1]); err == nil {
+				info.RangeStart = &start
+			}
+			if end, err := time.Parse("2006-01-02", match[2]); err == nil {
+				info.RangeEnd = &end
+			}
+		}
+		partitions = append(partitions, info)
+	}
+
+	return partitions, nil
+}
+
+// DropPartitionsBefore drops the monthly partitions of table that end by
+// before and hold no rows, such as those retention has archived or deleted.
+// Partitions that still hold rows are kept, so nothing is lost if retention
+// stopped part way.
+func (m *PartitionManager) DropPartitionsBefore(ctx context.Context, table string, before time.Time) ([]string, error) {
+	partitions, err := m.ListPartitions(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	var dropped []string
+	for _, partition := range partitions {
+		if partition.RangeEnd == nil || partition.RangeEnd.After(before) {
+			continue
+		}
+
+		var populated bool
+		if err := m.db.WithContext(ctx).Raw(fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %q)", partition.Name)).Scan(&populated).Error; err != nil {
+			return dropped, fmt.Errorf("failed to check partition %s: %w", partition.Name, err)
+		}
+		if populated {
+			continue
+		}
+
+		if err := m.db.WithContext(ctx).Exec(fmt.Sprintf("DROP TABLE IF EXISTS %q", partition.Name)).Error; err != nil {
+			return dropped, fmt.Errorf("failed to drop partition %s: %w", partition.Name, err)
+		}
+		dropped = append(dropped, partition.Name)
+	}
+
+	return dropped, nil
+}
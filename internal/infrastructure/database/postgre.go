@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"linked-clone/internal/config"
@@ -15,6 +16,9 @@ import (
 func NewPostgreSQLConnection(cfg config.DatabaseConfig) (*gorm.DB, error) {
 	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=%s",
 		cfg.Host, cfg.User, cfg.Password, cfg.DBName, cfg.Port, cfg.SSLMode)
+	if cfg.StatementTimeout > 0 {
+		dsn += fmt.Sprintf(" statement_timeout=%d", cfg.StatementTimeout.Milliseconds())
+	}
 
 	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Info),
@@ -23,6 +27,10 @@ func NewPostgreSQLConnection(cfg config.DatabaseConfig) (*gorm.DB, error) {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
+	if err := RegisterQueryTimeout(db, cfg.QueryTimeout); err != nil {
+		return nil, fmt.Errorf("failed to register query timeout: %w", err)
+	}
+
 	return db, nil
 }
 
@@ -31,24 +39,52 @@ func GetSQLDB(gormDB *gorm.DB) (*sql.DB, error) {
 }
 
 func RunMigrations(cfg config.DatabaseConfig, migrationsDir string) error {
-	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=%s",
-		cfg.Host, cfg.User, cfg.Password, cfg.DBName, cfg.Port, cfg.SSLMode)
-
-	db, err := sql.Open("postgres", dsn)
+	db, err := openMigrationDB(cfg)
 	if err != nil {
-		return fmt.Errorf("failed to open database connection for migrations: %w", err)
+		return err
 	}
 	defer db.Close()
 
-	if err := goose.SetDialect("postgres"); err != nil {
-		return fmt.Errorf("failed to set goose dialect: %w", err)
+	ctx := context.Background()
+	return withMigrationLock(ctx, db, cfg.MigrationLockTimeout, func() error {
+		if _, err := verifyChecksums(ctx, db, migrationsDir, false); err != nil {
+			return err
+		}
+
+		if err := goose.UpContext(ctx, db, migrationsDir); err != nil {
+			return fmt.Errorf("failed to run migrations: %w", err)
+		}
+
+		_, err := verifyChecksums(ctx, db, migrationsDir, false)
+		return err
+	})
+}
+
+func VerifyMigrations(cfg config.DatabaseConfig, migrationsDir string) (*MigrationReport, error) {
+	db, err := openMigrationDB(cfg)
+	if err != nil {
+		return nil, err
 	}
+	defer db.Close()
 
-	if err := goose.Up(db, migrationsDir); err != nil {
-		return fmt.Errorf("failed to run migrations: %w", err)
+	return verifyChecksums(context.Background(), db, migrationsDir, false)
+}
+
+func RehashMigrations(cfg config.DatabaseConfig, migrationsDir string) (*MigrationReport, error) {
+	db, err := openMigrationDB(cfg)
+	if err != nil {
+		return nil, err
 	}
+	defer db.Close()
 
-	return nil
+	var report *MigrationReport
+	ctx := context.Background()
+	err = withMigrationLock(ctx, db, cfg.MigrationLockTimeout, func() error {
+		var err error
+		report, err = verifyChecksums(ctx, db, migrationsDir, true)
+		return err
+	})
+	return report, err
 }
 
 func CreateMigration(migrationsDir, name string) error {
@@ -0,0 +1,56 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const queryTimeoutCancelKey = "query_timeout:cancel"
+
+func RegisterQueryTimeout(db *gorm.DB, timeout time.Duration) error {
+	if timeout <= 0 {
+		return nil
+	}
+
+	before := func(tx *gorm.DB) {
+		ctx := tx.Statement.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		if _, ok := ctx.Deadline(); ok {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		tx.Statement.Context = ctx
+		tx.InstanceSet(queryTimeoutCancelKey, cancel)
+	}
+
+	after := func(tx *gorm.DB) {
+		if cancel, ok := tx.InstanceGet(queryTimeoutCancelKey); ok {
+			cancel.(context.CancelFunc)()
+		}
+	}
+
+	callbacks := db.Callback()
+	for _, err := range []error{
+		callbacks.Create().Before("gorm:create").Register("timeout:before_create", before),
+		callbacks.Create().After("gorm:create").Register("timeout:after_create", after),
+		callbacks.Query().Before("gorm:query").Register("timeout:before_query", before),
+		callbacks.Query().After("gorm:query").Register("timeout:after_query", after),
+		callbacks.Update().Before("gorm:update").Register("timeout:before_update", before),
+		callbacks.Update().After("gorm:update").Register("timeout:after_update", after),
+		callbacks.Delete().Before("gorm:delete").Register("timeout:before_delete", before),
+		callbacks.Delete().After("gorm:delete").Register("timeout:after_delete", after),
+		callbacks.Raw().Before("gorm:raw").Register("timeout:before_raw", before),
+		callbacks.Raw().After("gorm:raw").Register("timeout:after_raw", after),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"linked-clone/internal/domain/entities"
+	"linked-clone/pkg/taxonomy"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// The document vectors must stay identical to the expression indexes created
+// in the 20250822090000_add_search_indexes migration, or the planner falls back
+// to scanning the table. The 'simple' configuration skips stemming so names and
+// company names match as typed.
+const (
+	userVector = `setweight(to_tsvector('simple', coalesce(full_name, '') || ' ' || coalesce(username, '')), 'A') || ` +
+		`setweight(to_tsvector('simple', coalesce(headline, '')), 'B') || ` +
+		`setweight(to_tsvector('simple', coalesce(bio, '')), 'D')`
+	jobVector = `setweight(to_tsvector('simple', coalesce(title, '')), 'A') || ` +
+		`setweight(to_tsvector('simple', coalesce(company, '')), 'B') || ` +
+		`setweight(to_tsvector('simple', coalesce(description, '')), 'D')`
+	postVector = `to_tsvector('simple', coalesce(content, ''))`
+
+	// Normalization 32 maps each rank into [0, 1) so users, jobs and posts
+	// can share one ordering.
+	rankNormalization = 32
+)
+
+type postgresSearchService struct {
+	db *gorm.DB
+}
+
+func NewPostgresSearchService(db *gorm.DB) SearchService {
+	return &postgresSearchService{db: db}
+}
+
+func (s *postgresSearchService) Search(ctx context.Context, query Query) ([]Hit, error) {
+	tsquery := prefixQuery(Terms(query.Text))
+	if tsquery == "" {
+		return nil, nil
+	}
+
+	types := query.Types
+	if len(types) == 0 {
+		types = DocumentTypes
+	}
+
+	db := s.db.WithContext(ctx)
+	parts := make([]string, 0, len(types))
+	args := make([]interface{}, 0, len(types)+2)
+	for _, docType := range types {
+		var sub *gorm.DB
+		switch docType {
+		case TypeUser:
+			sub = s.users(db, tsquery, query.Text)
+		case TypeJob:
+			sub = s.jobs(db, tsquery, query.Filters)
+		case TypePost:
+			sub = s.posts(db, tsquery)
+		default:
+			return nil, fmt.Errorf("unknown document type %q", docType)
+		}
+		parts = append(parts, "(?)")
+		args = append(args, sub)
+	}
+	args = append(args, query.Limit, query.Offset)
+
+	var hits []Hit
+	err := db.Raw(`SELECT type, id, score FROM (`+strings.Join(parts, " UNION ALL ")+`) hits
+		ORDER BY score DESC, type, id DESC
+		LIMIT ? OFFSET ?`, args...).
+		Scan(&hits).Error
+	return hits, err
+}
+
+// users also matches an exact email address, which the text vector does not
+// index, and ranks it above any name match.
+func (s *postgresSearchService) users(db *gorm.DB, tsquery, text string) *gorm.DB {
+	email := strings.ToLower(strings.TrimSpace(text))
+	return db.Table("users").
+		Select(fmt.Sprintf("'%s' AS type, id, ts_rank_cd(%s, to_tsquery('simple', ?), %d) + CASE WHEN LOWER(email) = ? THEN 1 ELSE 0 END AS score", TypeUser, userVector, rankNormalization), tsquery, email).
+		Where("deleted_at IS NULL").
+		Where("("+userVector+" @@ to_tsquery('simple', ?) OR LOWER(email) = ?)", tsquery, email)
+}
+
+func (s *postgresSearchService) jobs(db *gorm.DB, tsquery string, filters map[string]interface{}) *gorm.DB {
+	sub := db.Table("jobs").
+		Select(fmt.Sprintf("'%s' AS type, id, ts_rank_cd(%s, to_tsquery('simple', ?), %d) AS score", TypeJob, jobVector, rankNormalization), tsquery).
+		Where("deleted_at IS NULL").
+		Where("is_active = true AND status = ?", entities.JobPublished).
+		Where("hidden_at IS NULL").
+		Where(jobVector+" @@ to_tsquery('simple', ?)", tsquery)
+
+	for key, value := range filters {
+		switch key {
+		case "job_type":
+			sub = sub.Where("job_type = ?", value)
+		case "experience_level":
+			sub = sub.Where("experience_level = ?", value)
+		case "location":
+			sub = sub.Where("location ILIKE ?", "%"+fmt.Sprint(value)+"%")
+		case "location_id":
+			sub = sub.Where("location_id IN (?)", db.Raw(taxonomy.LocationScopeSQL, value))
+		case "industry_id":
+			sub = sub.Where("industry_id = ?", value)
+		}
+	}
+	return sub
+}
+
+// posts leaves out posts hidden by a moderator or whose author has deleted
+// their account.
+func (s *postgresSearchService) posts(db *gorm.DB, tsquery string) *gorm.DB {
+	return db.Table("posts").
+		Select(fmt.Sprintf("'%s' AS type, id, ts_rank_cd(%s, to_tsquery('simple', ?), %d) AS score", TypePost, postVector, rankNormalization), tsquery).
+		Where("deleted_at IS NULL AND hidden_at IS NULL").
+		Where("user_id IN (SELECT id FROM users WHERE deleted_at IS NULL)").
+		Where(postVector+" @@ to_tsquery('simple', ?)", tsquery)
+}
+
+// prefixQuery builds a tsquery that requires every term, each matched as a
+// prefix so a partly typed word still finds results.
+func prefixQuery(terms []string) string {
+	parts := make([]string, 0, len(terms))
+	for _, term := range terms {
+		parts = append(parts, term+":*")
+	}
+	return strings.Join(parts, " & ")
+}
@@ -0,0 +1,74 @@
+package search
+
+import (
+	"context"
+	"strings"
+	"unicode"
+)
+
+type DocumentType string
+
+const (
+	TypeUser DocumentType = "user"
+	TypeJob  DocumentType = "job"
+	TypePost DocumentType = "post"
+
+	maxQueryTerms = 8
+)
+
+var DocumentTypes = []DocumentType{TypeUser, TypeJob, TypePost}
+
+// Query is a text search over one or more document types. Filters narrow job
+// hits and use the same keys as the job search endpoint.
+type Query struct {
+	Text    string
+	Types   []DocumentType
+	Filters map[string]interface{}
+	Limit   int
+	Offset  int
+}
+
+// Hit is a matching document, best first. Scores are comparable across types
+// within one result set only.
+type Hit struct {
+	Type  DocumentType
+	ID    uint
+	Score float64
+}
+
+// SearchService finds documents by text. Postgres full-text search backs it
+// today; another engine such as Elasticsearch only has to return the same
+// hits for the same query.
+type SearchService interface {
+	Search(ctx context.Context, query Query) ([]Hit, error)
+}
+
+func ValidType(docType DocumentType) bool {
+	for _, known := range DocumentTypes {
+		if docType == known {
+			return true
+		}
+	}
+	return false
+}
+
+// IDs returns the document IDs of hits in rank order.
+func IDs(hits []Hit) []uint {
+	ids := make([]uint, 0, len(hits))
+	for _, hit := range hits {
+		ids = append(ids, hit.ID)
+	}
+	return ids
+}
+
+// Terms splits free text into lowercase words, dropping punctuation so the
+// result is always safe to hand to a query parser.
+func Terms(text string) []string {
+	terms := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(terms) > maxQueryTerms {
+		terms = terms[:maxQueryTerms]
+	}
+	return terms
+}
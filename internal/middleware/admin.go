@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func AdminMiddleware(userRepo repositories.UserRepository, logger logger.Logger) gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		userID := GetUserID(c)
+		if userID == 0 {
+			response.Error(c, http.StatusUnauthorized, "Unauthorized", "User not authenticated")
+			c.Abort()
+			return
+		}
+
+		user, err := userRepo.GetByID(c.Request.Context(), userID)
+		if err != nil {
+			logger.Error("Failed to get user for admin check", "error", err, "user_id", userID)
+			response.Error(c, http.StatusInternalServerError, "Failed to verify admin status", "")
+			c.Abort()
+			return
+		}
+
+		if !user.IsAdmin {
+			response.Error(c, http.StatusForbidden, "Admin access required", "")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	})
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"errors"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/agegate"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func AgeGateMiddleware(userRepo repositories.UserRepository, feature agegate.Feature, logger logger.Logger) gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		userID := GetUserID(c)
+		if userID == 0 {
+			response.Error(c, http.StatusUnauthorized, "Unauthorized", "User not authenticated")
+			c.Abort()
+			return
+		}
+
+		user, err := userRepo.GetByID(c.Request.Context(), userID)
+		if err != nil {
+			logger.Error("Failed to get user for age check", "error", err, "user_id", userID)
+			response.Error(c, http.StatusInternalServerError, "Failed to verify age", "")
+			c.Abort()
+			return
+		}
+
+		if err := agegate.CheckFeature(user.DateOfBirth, user.Region, feature, time.Now()); err != nil {
+			if errors.Is(err, agegate.ErrMissingDateOfBirth) {
+				response.Error(c, http.StatusForbidden, "Date of birth required", "Add your date of birth to your profile to use this feature")
+			} else {
+				response.Error(c, http.StatusForbidden, "Age requirement not met", err.Error())
+			}
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	})
+}
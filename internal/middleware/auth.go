@@ -16,6 +16,7 @@ const (
 	UserIDKey           = "user_id"
 	UserEmailKey        = "user_email"
 	UsernameKey         = "username"
+	SessionIDKey        = "session_id"
 )
 
 func AuthMiddleware(jwtService auth.JWTService, logger logger.Logger) gin.HandlerFunc {
@@ -40,7 +41,7 @@ func AuthMiddleware(jwtService auth.JWTService, logger logger.Logger) gin.Handle
 			return
 		}
 
-		claims, err := jwtService.ValidateToken(token)
+		claims, err := jwtService.ValidateToken(c.Request.Context(), token)
 		if err != nil {
 			logger.Error("Token validation failed", "error", err)
 			response.Error(c, http.StatusUnauthorized, "Invalid or expired token", err.Error())
@@ -51,6 +52,24 @@ func AuthMiddleware(jwtService auth.JWTService, logger logger.Logger) gin.Handle
 		c.Set(UserIDKey, claims.UserID)
 		c.Set(UserEmailKey, claims.Email)
 		c.Set(UsernameKey, claims.Username)
+		c.Set(SessionIDKey, claims.SessionID)
+
+		c.Next()
+	})
+}
+
+func OptionalAuthMiddleware(jwtService auth.JWTService) gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		authHeader := c.GetHeader(AuthorizationHeader)
+		if strings.HasPrefix(authHeader, BearerPrefix) {
+			token := strings.TrimPrefix(authHeader, BearerPrefix)
+			if claims, err := jwtService.ValidateToken(c.Request.Context(), token); err == nil {
+				c.Set(UserIDKey, claims.UserID)
+				c.Set(UserEmailKey, claims.Email)
+				c.Set(UsernameKey, claims.Username)
+				c.Set(SessionIDKey, claims.SessionID)
+			}
+		}
 
 		c.Next()
 	})
@@ -79,3 +98,13 @@ func GetUsername(c *gin.Context) string {
 	}
 	return username.(string)
 }
+
+// GetSessionID returns the session the access token was issued for, or 0
+// when the request is unauthenticated.
+func GetSessionID(c *gin.Context) uint {
+	sessionID, exists := c.Get(SessionIDKey)
+	if !exists {
+		return 0
+	}
+	return sessionID.(uint)
+}
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"linked-clone/pkg/capture"
+	"linked-clone/pkg/featureflag"
+	"linked-clone/pkg/logger"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequestCaptureMiddleware records a sanitized envelope for a sampled share of
+// API requests so they can be replayed against staging with cmd/replay. It is
+// a no-op when recorder is nil.
+func RequestCaptureMiddleware(recorder *capture.Recorder, flags *featureflag.Flags, logger logger.Logger) gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		if !recorder.Sample(c.Request) {
+			c.Next()
+			return
+		}
+
+		start := time.Now()
+
+		var body []byte
+		truncated := false
+		if c.Request.Body != nil {
+			limit := recorder.MaxBodyBytes()
+			body, _ = io.ReadAll(io.LimitReader(c.Request.Body, limit+1))
+			if int64(len(body)) > limit {
+				truncated = true
+			}
+			c.Request.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), c.Request.Body))
+		}
+
+		c.Next()
+
+		if !flags.Enabled(featureflag.FeatureCache) {
+			return
+		}
+
+		envelope := capture.NewEnvelope(c.Request, body, truncated)
+		envelope.ID = c.GetString("request_id")
+		envelope.Route = c.FullPath()
+		envelope.Authenticated = GetUserID(c) != 0
+		envelope.Status = c.Writer.Status()
+		envelope.LatencyMs = time.Since(start).Milliseconds()
+		if size := c.Writer.Size(); size > 0 {
+			envelope.ResponseBytes = size
+		}
+
+		if err := recorder.Record(c.Request.Context(), envelope); err != nil {
+			logger.Debug("Failed to capture request", "error", err, "path", c.Request.URL.Path)
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"linked-clone/pkg/chaos"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ChaosMiddleware injects faults into API requests in development and test. A
+// request can ask for its own faults with the X-Chaos-* headers; otherwise the
+// standing CHAOS_RULES for the matched route apply. It is a no-op when
+// injector is nil.
+func ChaosMiddleware(injector *chaos.Injector, logger logger.Logger) gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		if injector == nil || IsWebSocketUpgrade(c) {
+			c.Next()
+			return
+		}
+
+		fault, dependencies, err := chaos.ParseRequestFaults(c.Request.Header)
+		if err != nil {
+			response.Error(c, http.StatusBadRequest, "Invalid chaos header", err.Error())
+			c.Abort()
+			return
+		}
+		if fault == nil {
+			if standing, ok := injector.RouteFault(c.Request.Method, c.FullPath()); ok {
+				fault = &standing
+			}
+		}
+
+		c.Request = c.Request.WithContext(chaos.WithRequestFaults(c.Request.Context(), dependencies))
+		if fault == nil {
+			c.Next()
+			return
+		}
+
+		logger.Debug("Injecting fault", "kind", fault.Kind, "path", c.Request.URL.Path, "request_id", c.GetString("request_id"))
+		c.Header("X-Chaos-Injected", string(fault.Kind))
+
+		switch fault.Kind {
+		case chaos.KindLatency:
+			timer := time.NewTimer(fault.Delay)
+			select {
+			case <-timer.C:
+			case <-c.Request.Context().Done():
+				timer.Stop()
+				c.Abort()
+				return
+			}
+			c.Next()
+		case chaos.KindError:
+			response.Error(c, fault.Status, "Injected fault", "The request failed on purpose for resilience testing")
+			c.Abort()
+		case chaos.KindTimeout:
+			// Hold the request until the timeout middleware gives up on it.
+			<-c.Request.Context().Done()
+			c.Abort()
+		}
+	})
+}
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"linked-clone/internal/domain/entities"
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func EntitlementMiddleware(userRepo repositories.UserRepository, entitlement entities.Entitlement, logger logger.Logger) gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		userID := GetUserID(c)
+		if userID == 0 {
+			response.Error(c, http.StatusUnauthorized, "Unauthorized", "User not authenticated")
+			c.Abort()
+			return
+		}
+
+		user, err := userRepo.GetByID(c.Request.Context(), userID)
+		if err != nil {
+			logger.Error("Failed to get user for entitlement check", "error", err, "user_id", userID)
+			response.Error(c, http.StatusInternalServerError, "Failed to verify plan", "")
+			c.Abort()
+			return
+		}
+
+		if !user.HasEntitlement(entitlement) {
+			response.Error(c, http.StatusForbidden, "Plan upgrade required", "Your plan does not include "+string(entitlement))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	})
+}
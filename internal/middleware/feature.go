@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"linked-clone/pkg/featureflag"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func FeatureMiddleware(flags *featureflag.Flags, feature featureflag.Feature, logger logger.Logger) gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		if !flags.Enabled(feature) {
+			logger.Warn("Request rejected, feature disabled", "feature", feature, "path", c.Request.URL.Path)
+			response.ErrorWithCode(c, http.StatusServiceUnavailable, response.ErrCodeFeatureDisabled,
+				"Feature temporarily unavailable", "The "+string(feature)+" feature is disabled while a dependency is unavailable")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	})
+}
+
+func UploadFeatureMiddleware(flags *featureflag.Flags, logger logger.Logger) gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		form := c.Request.MultipartForm
+		if form != nil && len(form.File) > 0 && !flags.Enabled(featureflag.FeatureUploads) {
+			logger.Warn("Upload rejected, uploads disabled", "path", c.Request.URL.Path)
+			response.ErrorWithCode(c, http.StatusServiceUnavailable, response.ErrCodeFeatureDisabled,
+				"Uploads temporarily unavailable", "File uploads are disabled while storage is unavailable")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	})
+}
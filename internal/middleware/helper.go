@@ -2,6 +2,8 @@ package middleware
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -84,3 +86,15 @@ func GetUserIDFromContext(ctx context.Context) uint {
 	}
 	return 0
 }
+
+func IsWebSocketUpgrade(c *gin.Context) bool {
+	return strings.EqualFold(c.GetHeader("Upgrade"), "websocket") &&
+		strings.Contains(strings.ToLower(c.GetHeader("Connection")), "upgrade")
+}
+
+func GetViewerKey(c *gin.Context) string {
+	if userID := GetUserID(c); userID != 0 {
+		return fmt.Sprintf("user:%d", userID)
+	}
+	return "ip:" + c.ClientIP()
+}
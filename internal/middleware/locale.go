@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"linked-clone/pkg/i18n"
+
+	"github.com/gin-gonic/gin"
+)
+
+const LocaleKey = "locale"
+
+// LocaleMiddleware negotiates the response locale from Accept-Language and
+// stores it on the request context, where services pick it up to label enum
+// values. Responses vary by the header so shared caches keep one copy per
+// language.
+func LocaleMiddleware(bundle *i18n.Bundle) gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		locale := bundle.Negotiate(c.GetHeader("Accept-Language"))
+
+		c.Set(LocaleKey, locale)
+		c.Request = c.Request.WithContext(i18n.WithLocale(c.Request.Context(), locale))
+		c.Header("Content-Language", locale)
+		c.Writer.Header().Add("Vary", "Accept-Language")
+
+		c.Next()
+	})
+}
+
+func GetLocale(c *gin.Context) string {
+	return c.GetString(LocaleKey)
+}
@@ -42,7 +42,6 @@ func LoggerMiddleware(logger logger.Logger) gin.HandlerFunc {
 			"request_id":    requestID,
 			"method":        c.Request.Method,
 			"path":          c.Request.URL.Path,
-			"query":         c.Request.URL.RawQuery,
 			"status":        c.Writer.Status(),
 			"latency":       latency.String(),
 			"latency_ms":    latency.Milliseconds(),
@@ -51,6 +50,11 @@ func LoggerMiddleware(logger logger.Logger) gin.HandlerFunc {
 			"response_size": w.body.Len(),
 		}
 
+		// Sign-in links and realtime sockets carry their token in the query string.
+		if !containsSensitiveData(c.Request.URL.Path) {
+			fields["query"] = c.Request.URL.RawQuery
+		}
+
 		if userID := GetUserID(c); userID != 0 {
 			fields["user_id"] = userID
 		}
@@ -80,6 +84,11 @@ func containsSensitiveData(path string) bool {
 		"/auth/reset-password",
 		"/auth/forgot-password",
 		"/auth/verify-email",
+		"/auth/magic-link",
+		"/auth/2fa",
+		// Browsers cannot set headers on a socket, so it authenticates
+		// with the access token in the query string.
+		"/ws",
 	}
 
 	for _, sensitivePath := range sensitivePaths {
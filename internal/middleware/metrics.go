@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"linked-clone/pkg/httpmetrics"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RouteMetricsMiddleware records the rate, status class and duration of every
+// matched API request under its route template. WebSocket upgrades are left
+// out: a connection that stays open for hours would swamp the duration
+// histogram.
+func RouteMetricsMiddleware(registry *httpmetrics.Registry) gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		if IsWebSocketUpgrade(c) {
+			c.Next()
+			return
+		}
+
+		start := time.Now()
+		c.Next()
+		registry.Observe(c.Request.Method, c.FullPath(), c.Writer.Status(), time.Since(start))
+	})
+}
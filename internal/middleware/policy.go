@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/auth"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/response"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+var policyExemptPrefixes = []string{
+	"/api/v1/auth/",
+	"/api/v1/policies",
+	"/api/v1/account/",
+}
+
+func PolicyAcceptanceMiddleware(jwtService auth.JWTService, policyRepo repositories.PolicyRepository, logger logger.Logger) gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		path := c.Request.URL.Path
+		for _, prefix := range policyExemptPrefixes {
+			if strings.HasPrefix(path, prefix) {
+				c.Next()
+				return
+			}
+		}
+
+		authHeader := c.GetHeader(AuthorizationHeader)
+		if !strings.HasPrefix(authHeader, BearerPrefix) {
+			c.Next()
+			return
+		}
+
+		claims, err := jwtService.ValidateToken(c.Request.Context(), strings.TrimPrefix(authHeader, BearerPrefix))
+		if err != nil {
+			c.Next()
+			return
+		}
+
+		pending, err := policyRepo.GetPendingForUser(c.Request.Context(), claims.UserID)
+		if err != nil {
+			logger.Error("Failed to check policy acceptance", "error", err, "user_id", claims.UserID)
+			c.Next()
+			return
+		}
+
+		if len(pending) > 0 {
+			versions := make([]string, len(pending))
+			for i, version := range pending {
+				versions[i] = string(version.Type) + "@" + version.Version
+			}
+			response.ErrorWithCode(c, http.StatusForbidden, response.ErrCodePolicyAcceptanceRequired,
+				"Policy acceptance required", "Accept the current policies at /api/v1/policies/accept: "+strings.Join(versions, ", "))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	})
+}
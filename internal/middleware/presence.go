@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"linked-clone/pkg/featureflag"
+	"linked-clone/pkg/logger"
+	"linked-clone/pkg/presence"
+
+	"github.com/gin-gonic/gin"
+)
+
+func PresenceMiddleware(tracker presence.Tracker, flags *featureflag.Flags, logger logger.Logger) gin.HandlerFunc {
+	return gin.HandlerFunc(func(c *gin.Context) {
+		c.Next()
+
+		userID := GetUserID(c)
+		if userID == 0 || !flags.Enabled(featureflag.FeatureCache) {
+			return
+		}
+
+		if err := tracker.Touch(c.Request.Context(), userID); err != nil {
+			logger.Debug("Failed to record user activity", "error", err, "user_id", userID)
+		}
+	})
+}
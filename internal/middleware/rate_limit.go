@@ -103,6 +103,12 @@ func RateLimitMiddleware(rate time.Duration, burst int, logger logger.Logger) gi
 	limiter := NewRateLimiter(rate, burst)
 
 	return gin.HandlerFunc(func(c *gin.Context) {
+		// API keys carry their own limit, checked by APIKeyMiddleware.
+		if GetAPIKeyID(c) != 0 {
+			c.Next()
+			return
+		}
+
 		ip := c.ClientIP()
 
 		if !limiter.allow(ip) {
@@ -104,6 +104,9 @@ func SQLInjectionProtection(logger logger.Logger) gin.HandlerFunc {
 	}
 }
 
+// XSSProtection rejects script-like query parameters. Request bodies are not
+// pattern-matched: user-written text is escaped when it is rendered, so post
+// and comment bodies go through pkg/markdown instead.
 func XSSProtection(logger logger.Logger) gin.HandlerFunc {
 
 	xssPatterns := []string{
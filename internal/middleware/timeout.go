@@ -5,13 +5,96 @@ import (
 	"linked-clone/pkg/logger"
 	"linked-clone/pkg/response"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
-func TimeoutMiddleware(timeout time.Duration, logger logger.Logger) gin.HandlerFunc {
+// deadlineGrace is how long past the request timeout the connection stays
+// open, so the timeout response itself can still be written.
+const deadlineGrace = 5 * time.Second
+
+// RouteTimeouts holds the request timeout for each part of the API. Route
+// files declare timeouts as they register routes; a route takes its own
+// timeout if it has one, else the one of the longest declared path prefix,
+// else the default. A nil RouteTimeouts ignores declarations.
+type RouteTimeouts struct {
+	mu       sync.RWMutex
+	fallback time.Duration
+	routes   map[string]time.Duration
+	prefixes map[string]time.Duration
+}
+
+func NewRouteTimeouts(fallback time.Duration) *RouteTimeouts {
+	return &RouteTimeouts{
+		fallback: fallback,
+		routes:   make(map[string]time.Duration),
+		prefixes: make(map[string]time.Duration),
+	}
+}
+
+// SetPrefix applies timeout to every route under prefix, e.g. /api/v1/auth.
+func (t *RouteTimeouts) SetPrefix(prefix string, timeout time.Duration) {
+	if t == nil || timeout <= 0 {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.prefixes[strings.TrimSuffix(prefix, "/")] = timeout
+}
+
+// SetRoute applies timeout to one route template.
+func (t *RouteTimeouts) SetRoute(method, route string, timeout time.Duration) {
+	if t == nil || timeout <= 0 {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.routes[method+" "+route] = timeout
+}
+
+func (t *RouteTimeouts) For(method, route string) time.Duration {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if timeout, ok := t.routes[method+" "+route]; ok {
+		return timeout
+	}
+
+	timeout, matched := t.fallback, ""
+	for prefix, candidate := range t.prefixes {
+		if len(prefix) > len(matched) && (route == prefix || strings.HasPrefix(route, prefix+"/")) {
+			timeout, matched = candidate, prefix
+		}
+	}
+	return timeout
+}
+
+// TimeoutMiddleware cancels the request context once the matched route's
+// timeout passes and answers 408. Handlers pass that context down, so database
+// queries and S3 calls made for the request stop at the same deadline.
+func TimeoutMiddleware(timeouts *RouteTimeouts, logger logger.Logger) gin.HandlerFunc {
 	return gin.HandlerFunc(func(c *gin.Context) {
+		if IsWebSocketUpgrade(c) {
+			c.Next()
+			return
+		}
+
+		timeout := timeouts.For(c.Request.Method, c.FullPath())
+
+		// The server's connection deadlines are fixed at startup; move them
+		// so a slow upload can finish sending its body and a long export can
+		// still write its response.
+		deadline := time.Now().Add(timeout + deadlineGrace)
+		controller := http.NewResponseController(c.Writer)
+		if err := controller.SetReadDeadline(deadline); err != nil {
+			logger.Debug("Failed to extend read deadline", "error", err, "path", c.Request.URL.Path)
+		}
+		if err := controller.SetWriteDeadline(deadline); err != nil {
+			logger.Debug("Failed to extend write deadline", "error", err, "path", c.Request.URL.Path)
+		}
 
 		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
 		defer cancel()
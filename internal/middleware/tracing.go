@@ -60,7 +60,7 @@ func TracingMiddleware(serviceName string, structuredLogger logger.StructuredLog
 			StartTime: time.Now(),
 			Tags: map[string]interface{}{
 				"http.method":      c.Request.Method,
-				"http.url":         c.Request.URL.String(),
+				"http.url":         traceURL(c),
 				"http.path":        c.Request.URL.Path,
 				"http.user_agent":  c.Request.UserAgent(),
 				"http.remote_addr": c.ClientIP(),
@@ -286,3 +286,10 @@ func getSpanIDFromContext(ctx context.Context) string {
 	}
 	return ""
 }
+
+func traceURL(c *gin.Context) string {
+	if containsSensitiveData(c.Request.URL.Path) {
+		return c.Request.URL.Path
+	}
+	return c.Request.URL.String()
+}
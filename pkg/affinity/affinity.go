@@ -0,0 +1,80 @@
+package affinity
+
+import (
+	"context"
+	"fmt"
+	"linked-clone/pkg/redis"
+	"strconv"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+type Kind string
+
+const (
+	KindUser    Kind = "user"
+	KindCompany Kind = "company"
+
+	WeightView    int64 = 1
+	WeightLike    int64 = 2
+	WeightComment int64 = 3
+	WeightShare   int64 = 3
+	WeightApply   int64 = 3
+
+	scoreTTL = 90 * 24 * time.Hour
+)
+
+type Tracker interface {
+	Record(ctx context.Context, userID uint, kind Kind, targetID uint, weight int64) error
+	Scores(ctx context.Context, userID uint, kind Kind, targetIDs []uint) (map[uint]int64, error)
+}
+
+type redisTracker struct {
+	redisClient redis.RedisClient
+}
+
+func NewRedisTracker(redisClient redis.RedisClient) Tracker {
+	return &redisTracker{redisClient: redisClient}
+}
+
+func (t *redisTracker) Record(ctx context.Context, userID uint, kind Kind, targetID uint, weight int64) error {
+	if userID == 0 || targetID == 0 || (kind == KindUser && userID == targetID) {
+		return nil
+	}
+
+	key := scoreKey(userID, kind, targetID)
+	if _, err := t.redisClient.IncrBy(ctx, key, weight); err != nil {
+		return err
+	}
+	return t.redisClient.Expire(ctx, key, scoreTTL)
+}
+
+func (t *redisTracker) Scores(ctx context.Context, userID uint, kind Kind, targetIDs []uint) (map[uint]int64, error) {
+	scores := make(map[uint]int64, len(targetIDs))
+	for _, targetID := range targetIDs {
+		if _, seen := scores[targetID]; seen {
+			continue
+		}
+
+		raw, err := t.redisClient.Get(ctx, scoreKey(userID, kind, targetID))
+		if err != nil {
+			if err == goredis.Nil {
+				scores[targetID] = 0
+				continue
+			}
+			return scores, err
+		}
+
+		score, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return scores, fmt.Errorf("invalid affinity score %q: %w", raw, err)
+		}
+		scores[targetID] = score
+	}
+	return scores, nil
+}
+
+func scoreKey(userID uint, kind Kind, targetID uint) string {
+	return fmt.Sprintf("affinity:%d:%s:%d", userID, kind, targetID)
+}
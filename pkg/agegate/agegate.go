@@ -0,0 +1,129 @@
+package agegate
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	DateLayout        = "2006-01-02"
+	DefaultMinimumAge = 16
+	maximumAge        = 120
+)
+
+type Feature string
+
+const (
+	FeatureJobApplications Feature = "job_applications"
+	FeatureJobPosting      Feature = "job_posting"
+)
+
+var (
+	ErrInvalidDateOfBirth = errors.New("date of birth must be a valid date in YYYY-MM-DD format")
+	ErrFutureDateOfBirth  = errors.New("date of birth cannot be in the future")
+	ErrMissingDateOfBirth = errors.New("date of birth is required")
+)
+
+var regionMinimumAges = map[string]int{
+	"AU": 13, "BE": 13, "CA": 13, "DK": 13, "EE": 13, "FI": 13, "GB": 13, "LV": 13, "MT": 13, "PT": 13, "SE": 13, "US": 13,
+	"AT": 14, "BG": 14, "CN": 14, "CY": 14, "ES": 14, "IT": 14, "KR": 14, "LT": 14,
+	"CZ": 15, "FR": 15, "GR": 15, "SI": 15,
+	"IN": 18,
+}
+
+var featureMinimumAges = map[Feature]int{
+	FeatureJobApplications: 16,
+	FeatureJobPosting:      18,
+}
+
+type BelowMinimumAgeError struct {
+	MinimumAge int
+	Region     string
+	Feature    Feature
+}
+
+func (e *BelowMinimumAgeError) Error() string {
+	if e.Feature != "" {
+		return fmt.Sprintf("you must be at least %d years old to use %s", e.MinimumAge, strings.ReplaceAll(string(e.Feature), "_", " "))
+	}
+	return fmt.Sprintf("you must be at least %d years old to register in %s", e.MinimumAge, e.Region)
+}
+
+func NormalizeRegion(region string) string {
+	return strings.ToUpper(strings.TrimSpace(region))
+}
+
+func MinimumAge(region string) int {
+	if age, ok := regionMinimumAges[NormalizeRegion(region)]; ok {
+		return age
+	}
+	return DefaultMinimumAge
+}
+
+func MinimumFeatureAge(feature Feature, region string) int {
+	minimum := MinimumAge(region)
+	if age := featureMinimumAges[feature]; age > minimum {
+		return age
+	}
+	return minimum
+}
+
+func ParseDateOfBirth(value string, now time.Time) (time.Time, error) {
+	dob, err := time.Parse(DateLayout, strings.TrimSpace(value))
+	if err != nil {
+		return time.Time{}, ErrInvalidDateOfBirth
+	}
+	if dob.After(now) {
+		return time.Time{}, ErrFutureDateOfBirth
+	}
+	if Age(dob, now) > maximumAge {
+		return time.Time{}, ErrInvalidDateOfBirth
+	}
+	return dob, nil
+}
+
+func Age(dob, now time.Time) int {
+	years := now.Year() - dob.Year()
+	if now.Month() < dob.Month() || (now.Month() == dob.Month() && now.Day() < dob.Day()) {
+		years--
+	}
+	return years
+}
+
+func CheckRegistration(dateOfBirth, region string, now time.Time) error {
+	dob, err := ParseDateOfBirth(dateOfBirth, now)
+	if err != nil {
+		return err
+	}
+
+	if minimum := MinimumAge(region); Age(dob, now) < minimum {
+		return &BelowMinimumAgeError{MinimumAge: minimum, Region: NormalizeRegion(region)}
+	}
+	return nil
+}
+
+func CheckFeature(dateOfBirth, region string, feature Feature, now time.Time) error {
+	if dateOfBirth == "" {
+		return ErrMissingDateOfBirth
+	}
+
+	dob, err := ParseDateOfBirth(dateOfBirth, now)
+	if err != nil {
+		return err
+	}
+
+	if minimum := MinimumFeatureAge(feature, region); Age(dob, now) < minimum {
+		return &BelowMinimumAgeError{MinimumAge: minimum, Region: NormalizeRegion(region), Feature: feature}
+	}
+	return nil
+}
+
+func IsAgeError(err error) bool {
+	var belowMinimum *BelowMinimumAgeError
+	return errors.As(err, &belowMinimum) ||
+		errors.Is(err, ErrInvalidDateOfBirth) ||
+		errors.Is(err, ErrFutureDateOfBirth) ||
+		errors.Is(err, ErrMissingDateOfBirth)
+}
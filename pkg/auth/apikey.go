@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"strings"
+)
+
+const (
+	// APIKeyPrefix marks the keys so they are easy to spot in code and
+	// secret scanners.
+	APIKeyPrefix = "lkc_"
+
+	apiKeyAlphabet      = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	apiKeySecretLen     = 40
+	apiKeyDisplayPrefix = 12
+)
+
+// GenerateAPIKey returns a new key and the part of it that may be shown
+// again later. The key itself is only ever returned here.
+func GenerateAPIKey() (key, displayPrefix string, err error) {
+	var b strings.Builder
+	b.WriteString(APIKeyPrefix)
+	max := big.NewInt(int64(len(apiKeyAlphabet)))
+	for i := 0; i < apiKeySecretLen; i++ {
+		idx, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", "", err
+		}
+		b.WriteByte(apiKeyAlphabet[idx.Int64()])
+	}
+
+	key = b.String()
+	return key, key[:apiKeyDisplayPrefix], nil
+}
+
+// HashAPIKey keys the hash with the pepper, like refresh tokens, so a copy
+// of the table alone cannot be used to check guesses.
+func HashAPIKey(pepper []byte, key string) string {
+	mac := hmac.New(sha256.New, pepper)
+	mac.Write([]byte(strings.TrimSpace(key)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
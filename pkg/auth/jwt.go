@@ -2,24 +2,32 @@ package auth
 
 import (
 	"context"
+	"crypto/hmac"
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"linked-clone/internal/domain/entities"
 	"linked-clone/internal/domain/repositories"
+	"linked-clone/pkg/geoip"
+	"linked-clone/pkg/redis"
+	"linked-clone/pkg/useragent"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
 )
 
 type JWTClaims struct {
-	UserID    uint   `json:"user_id"`
-	Email     string `json:"email"`
-	Username  string `json:"username"`
-	TokenType string `json:"token_type"`
-	SessionID uint   `json:"session_id,omitempty"`
+	UserID     uint   `json:"user_id"`
+	Email      string `json:"email"`
+	Username   string `json:"username"`
+	TokenType  string `json:"token_type"`
+	SessionID  uint   `json:"session_id,omitempty"`
+	AuthMethod string `json:"auth_method,omitempty"`
+	OrgID      uint   `json:"org_id,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -33,11 +41,13 @@ type TokenResponse struct {
 
 type JWTService interface {
 	GenerateTokens(ctx context.Context, userID uint, email, username, userAgent, ipAddress string) (*TokenResponse, error)
-	ValidateToken(tokenString string) (*JWTClaims, error)
+	GenerateSSOTokens(ctx context.Context, userID uint, email, username, userAgent, ipAddress string, companyID uint) (*TokenResponse, error)
+	ValidateToken(ctx context.Context, tokenString string) (*JWTClaims, error)
 	ValidateRefreshToken(ctx context.Context, refreshToken string) (*JWTClaims, error)
 	RefreshAccessToken(ctx context.Context, refreshToken, userAgent, ipAddress string) (*TokenResponse, error)
 	RevokeSession(ctx context.Context, sessionID uint) error
 	RevokeUserSessions(ctx context.Context, userID uint) error
+	RevokeOtherUserSessions(ctx context.Context, userID, keepSessionID uint) (int64, error)
 	RevokeRefreshToken(ctx context.Context, refreshToken string) error
 	GetUserActiveSessions(ctx context.Context, userID uint, limit, offset int) ([]*entities.Session, error)
 	CleanupExpiredSessions(ctx context.Context) error
@@ -48,9 +58,27 @@ type jwtService struct {
 	accessTokenExpiry  int
 	refreshTokenExpiry int
 	sessionRepo        repositories.SessionRepository
+	locator            geoip.Locator
+	pepper             []byte
+	redisClient        redis.RedisClient
 }
 
-func NewJWTService(secretKey string, accessTokenExpiryHours int, sessionRepo repositories.SessionRepository) JWTService {
+// sessionStateTTL is how long a session that passed the database check is
+// trusted without looking again. Revocations clear the cached state, so the
+// TTL only bounds how stale it gets when Redis cannot be reached.
+const sessionStateTTL = time.Minute
+
+// NewJWTService creates the token service. redisClient caches session checks
+// for access tokens and may be nil, in which case every check reads the
+// database.
+func NewJWTService(secretKey string, accessTokenExpiryHours int, sessionRepo repositories.SessionRepository, locator geoip.Locator, pepper string, redisClient redis.RedisClient) (JWTService, error) {
+	if secretKey == "" {
+		return nil, errors.New("JWT secret key is required")
+	}
+	if accessTokenExpiryHours <= 0 {
+		return nil, fmt.Errorf("invalid JWT expiry hours: %d", accessTokenExpiryHours)
+	}
+
 	refreshTokenExpiryDays := 30
 	if accessTokenExpiryHours > 24 {
 		refreshTokenExpiryDays = accessTokenExpiryHours / 24 * 2
@@ -61,11 +89,24 @@ func NewJWTService(secretKey string, accessTokenExpiryHours int, sessionRepo rep
 		accessTokenExpiry:  accessTokenExpiryHours,
 		refreshTokenExpiry: refreshTokenExpiryDays,
 		sessionRepo:        sessionRepo,
-	}
+		locator:            locator,
+		pepper:             []byte(pepper),
+		redisClient:        redisClient,
+	}, nil
 }
 
 func (s *jwtService) GenerateTokens(ctx context.Context, userID uint, email, username, userAgent, ipAddress string) (*TokenResponse, error) {
+	return s.generateTokens(ctx, userID, email, username, userAgent, ipAddress, entities.AuthMethodPassword, nil)
+}
+
+// GenerateSSOTokens issues tokens for a session established through the
+// company's identity provider; the session and access token are tagged with
+// the company so policies can tell SSO sessions apart.
+func (s *jwtService) GenerateSSOTokens(ctx context.Context, userID uint, email, username, userAgent, ipAddress string, companyID uint) (*TokenResponse, error) {
+	return s.generateTokens(ctx, userID, email, username, userAgent, ipAddress, entities.AuthMethodSSO, &companyID)
+}
 
+func (s *jwtService) generateTokens(ctx context.Context, userID uint, email, username, userAgent, ipAddress, authMethod string, companyID *uint) (*TokenResponse, error) {
 	refreshToken, err := s.generateSecureToken()
 	if err != nil {
 		return nil, err
@@ -78,9 +119,10 @@ func (s *jwtService) GenerateTokens(ctx context.Context, userID uint, email, use
 
 	session := &entities.Session{
 		UserID:       userID,
-		RefreshToken: refreshToken,
 		TokenHash:    tokenHash,
 		Status:       entities.SessionActive,
+		AuthMethod:   authMethod,
+		SSOCompanyID: companyID,
 		ExpiresAt:    refreshExpiresAt,
 		LastUsedAt:   nil,
 	}
@@ -96,17 +138,20 @@ func (s *jwtService) GenerateTokens(ctx context.Context, userID uint, email, use
 			session.IPAddress = &ipAddress
 		}
 	}
+	s.describeDevice(session)
 
 	if err := s.sessionRepo.Create(ctx, session); err != nil {
 		return nil, err
 	}
 
 	accessClaims := &JWTClaims{
-		UserID:    userID,
-		Email:     email,
-		Username:  username,
-		TokenType: "access",
-		SessionID: session.ID,
+		UserID:     userID,
+		Email:      email,
+		Username:   username,
+		TokenType:  "access",
+		SessionID:  session.ID,
+		AuthMethod: session.AuthMethod,
+		OrgID:      sessionOrgID(session),
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(accessExpiresAt),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -134,7 +179,7 @@ func (s *jwtService) GenerateTokens(ctx context.Context, userID uint, email, use
 
 func (s *jwtService) RefreshAccessToken(ctx context.Context, refreshToken, userAgent, ipAddress string) (*TokenResponse, error) {
 
-	session, err := s.sessionRepo.GetByRefreshToken(ctx, refreshToken)
+	session, err := s.findSession(ctx, refreshToken)
 	if err != nil {
 		return nil, errors.New("invalid refresh token")
 	}
@@ -179,17 +224,20 @@ func (s *jwtService) RefreshAccessToken(ctx context.Context, refreshToken, userA
 	}
 
 	if updated {
+		s.describeDevice(session)
 		s.sessionRepo.Update(ctx, session)
 	}
 
 	accessExpiresAt := time.Now().Add(time.Duration(s.accessTokenExpiry) * time.Hour)
 
 	accessClaims := &JWTClaims{
-		UserID:    session.UserID,
-		Email:     session.User.Email,
-		Username:  session.User.Username,
-		TokenType: "access",
-		SessionID: session.ID,
+		UserID:     session.UserID,
+		Email:      session.User.Email,
+		Username:   session.User.Username,
+		TokenType:  "access",
+		SessionID:  session.ID,
+		AuthMethod: session.AuthMethod,
+		OrgID:      sessionOrgID(session),
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(accessExpiresAt),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -215,7 +263,11 @@ func (s *jwtService) RefreshAccessToken(ctx context.Context, refreshToken, userA
 	}, nil
 }
 
-func (s *jwtService) ValidateToken(tokenString string) (*JWTClaims, error) {
+// ValidateToken checks the access token's signature and expiry and that the
+// session it was issued for is still active and its user is not suspended,
+// so signing out, revoking a session or suspending an account takes effect
+// before the token expires.
+func (s *jwtService) ValidateToken(ctx context.Context, tokenString string) (*JWTClaims, error) {
 	claims, err := s.parseToken(tokenString)
 	if err != nil {
 		return nil, err
@@ -225,12 +277,98 @@ func (s *jwtService) ValidateToken(tokenString string) (*JWTClaims, error) {
 		return nil, errors.New("invalid token type: expected access token")
 	}
 
+	if err := s.checkSession(ctx, claims); err != nil {
+		return nil, err
+	}
+
 	return claims, nil
 }
 
+// checkSession looks the token's session up in the database, trusting a
+// recent successful check cached in Redis. The cached entry records the
+// user's session generation, which revoking all of a user's sessions bumps,
+// so one write invalidates every cached session of that user.
+func (s *jwtService) checkSession(ctx context.Context, claims *JWTClaims) error {
+	if claims.SessionID == 0 {
+		return errors.New("session is not active")
+	}
+
+	generation, cached := s.cachedSession(ctx, claims)
+	if cached {
+		return nil
+	}
+
+	session, err := s.sessionRepo.GetByID(ctx, claims.SessionID)
+	if err != nil {
+		return errors.New("session is not active")
+	}
+	if session.UserID != claims.UserID || session.Status != entities.SessionActive || session.ExpiresAt.Before(time.Now()) {
+		return errors.New("session is not active")
+	}
+	if session.User.IsSuspended() {
+		return errors.New("account is suspended")
+	}
+
+	if s.redisClient != nil && generation != "" {
+		s.redisClient.Set(ctx, sessionStateKey(claims.SessionID), generation, sessionStateTTL)
+	}
+	return nil
+}
+
+// cachedSession reports whether the session passed a check recently. It also
+// returns the user's current generation, read before the database check so
+// a revocation that lands in between is not cached over; the generation is
+// empty when Redis is unavailable.
+func (s *jwtService) cachedSession(ctx context.Context, claims *JWTClaims) (string, bool) {
+	if s.redisClient == nil {
+		return "", false
+	}
+
+	generation, err := s.redisClient.Get(ctx, sessionGenerationKey(claims.UserID))
+	if err == goredis.Nil {
+		generation = "0"
+	} else if err != nil {
+		return "", false
+	}
+
+	state, err := s.redisClient.Get(ctx, sessionStateKey(claims.SessionID))
+	if err != nil {
+		return generation, false
+	}
+	return generation, state == generation
+}
+
+// forgetSession drops the cached check of one session.
+func (s *jwtService) forgetSession(ctx context.Context, sessionID uint) {
+	if s.redisClient != nil {
+		s.redisClient.Delete(ctx, sessionStateKey(sessionID))
+	}
+}
+
+// forgetUserSessions invalidates the cached checks of all the user's
+// sessions by bumping their generation. The generation outlives any entry
+// cached under it, so it may expire without reviving one.
+func (s *jwtService) forgetUserSessions(ctx context.Context, userID uint) {
+	if s.redisClient == nil {
+		return
+	}
+	key := sessionGenerationKey(userID)
+	if _, err := s.redisClient.IncrBy(ctx, key, 1); err == nil {
+		s.redisClient.Expire(ctx, key, 2*sessionStateTTL)
+	}
+}
+
+func sessionStateKey(sessionID uint) string {
+	return fmt.Sprintf("session_state:%d", sessionID)
+}
+
+func sessionGenerationKey(userID uint) string {
+	return fmt.Sprintf("session_generation:%d", userID)
+}
+
 func (s *jwtService) ValidateRefreshToken(ctx context.Context, refreshToken string) (*JWTClaims, error) {
 
-	session, err := s.sessionRepo.GetByRefreshToken(ctx, refreshToken)
+	session, err := s.findSession(ctx, refreshToken)
 	if err != nil {
 		return nil, errors.New("invalid refresh token")
 	}
@@ -250,24 +388,57 @@ func (s *jwtService) ValidateRefreshToken(ctx context.Context, refreshToken stri
 	s.sessionRepo.UpdateLastUsedAt(ctx, session.ID, now)
 
 	return &JWTClaims{
-		UserID:    session.UserID,
-		Email:     session.User.Email,
-		Username:  session.User.Username,
-		TokenType: "refresh",
-		SessionID: session.ID,
+		UserID:     session.UserID,
+		Email:      session.User.Email,
+		Username:   session.User.Username,
+		TokenType:  "refresh",
+		SessionID:  session.ID,
+		AuthMethod: session.AuthMethod,
+		OrgID:      sessionOrgID(session),
 	}, nil
 }
 
 func (s *jwtService) RevokeSession(ctx context.Context, sessionID uint) error {
-	return s.sessionRepo.RevokeSession(ctx, sessionID)
+	if err := s.sessionRepo.RevokeSession(ctx, sessionID); err != nil {
+		return err
+	}
+	s.forgetSession(ctx, sessionID)
+	return nil
 }
 
 func (s *jwtService) RevokeUserSessions(ctx context.Context, userID uint) error {
-	return s.sessionRepo.RevokeUserSessions(ctx, userID)
+	if err := s.sessionRepo.RevokeUserSessions(ctx, userID); err != nil {
+		return err
+	}
+	s.forgetUserSessions(ctx, userID)
+	return nil
+}
+
+func (s *jwtService) RevokeOtherUserSessions(ctx context.Context, userID, keepSessionID uint) (int64, error) {
+	revoked, err := s.sessionRepo.RevokeUserSessionsExcept(ctx, userID, keepSessionID)
+	if err != nil {
+		return 0, err
+	}
+	s.forgetUserSessions(ctx, userID)
+	return revoked, nil
 }
 
 func (s *jwtService) RevokeRefreshToken(ctx context.Context, refreshToken string) error {
-	return s.sessionRepo.RevokeSessionByToken(ctx, refreshToken)
+	session, lookupErr := s.findSession(ctx, refreshToken)
+
+	if err := s.sessionRepo.RevokeSessionByTokenHash(ctx, s.hashToken(refreshToken)); err != nil {
+		return err
+	}
+	if len(s.pepper) > 0 {
+		if err := s.sessionRepo.RevokeSessionByTokenHash(ctx, legacyHashToken(refreshToken)); err != nil {
+			return err
+		}
+	}
+
+	if lookupErr == nil {
+		s.forgetSession(ctx, session.ID)
+	}
+	return nil
 }
 
 func (s *jwtService) GetUserActiveSessions(ctx context.Context, userID uint, limit, offset int) ([]*entities.Session, error) {
@@ -278,6 +449,26 @@ func (s *jwtService) CleanupExpiredSessions(ctx context.Context) error {
 	return s.sessionRepo.DeleteExpiredSessions(ctx)
 }
 
+// describeDevice fills in the device, browser and location shown on the
+// session list from the session's user agent and IP address. They are
+// worked out once here rather than on every listing, so the list stays
+// cheap and keeps showing where a session signed in from.
+func (s *jwtService) describeDevice(session *entities.Session) {
+	ua := ""
+	if session.UserAgent != nil {
+		ua = *session.UserAgent
+	}
+	info := useragent.Parse(ua)
+	session.DeviceType = info.Device
+	session.OS = info.OS
+	session.Browser = info.Browser
+
+	session.Location = ""
+	if session.IPAddress != nil && s.locator != nil {
+		session.Location = s.locator.Locate(*session.IPAddress).String()
+	}
+}
+
 func (s *jwtService) parseToken(tokenString string) (*JWTClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
@@ -297,6 +488,13 @@ func (s *jwtService) parseToken(tokenString string) (*JWTClaims, error) {
 	return nil, errors.New("invalid token")
 }
 
+func sessionOrgID(session *entities.Session) uint {
+	if session.SSOCompanyID == nil {
+		return 0
+	}
+	return *session.SSOCompanyID
+}
+
 func (s *jwtService) generateSecureToken() (string, error) {
 	bytes := make([]byte, 32)
 	if _, err := rand.Read(bytes); err != nil {
@@ -305,7 +503,36 @@ func (s *jwtService) generateSecureToken() (string, error) {
 	return hex.EncodeToString(bytes), nil
 }
 
+func (s *jwtService) findSession(ctx context.Context, refreshToken string) (*entities.Session, error) {
+	tokenHash := s.hashToken(refreshToken)
+	session, err := s.sessionRepo.GetByTokenHash(ctx, tokenHash)
+	if err == nil || len(s.pepper) == 0 {
+		return session, err
+	}
+
+	session, err = s.sessionRepo.GetByTokenHash(ctx, legacyHashToken(refreshToken))
+	if err != nil {
+		return nil, err
+	}
+
+	session.TokenHash = tokenHash
+	if err := s.sessionRepo.Update(ctx, session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
 func (s *jwtService) hashToken(token string) string {
+	if len(s.pepper) == 0 {
+		return legacyHashToken(token)
+	}
+
+	mac := hmac.New(sha256.New, s.pepper)
+	mac.Write([]byte(token))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func legacyHashToken(token string) string {
 	hash := sha256.Sum256([]byte(token))
 	return hex.EncodeToString(hash[:])
 }
@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"strings"
+)
+
+const (
+	RecoveryCodeCount = 10
+
+	// Crockford-style alphabet without 0/1/i/l/o to avoid transcription errors.
+	recoveryAlphabet = "23456789abcdefghjkmnpqrstuvwxyz"
+	recoveryHalfLen  = 5
+)
+
+// GenerateRecoveryCodes returns n codes formatted as "xxxxx-xxxxx".
+func GenerateRecoveryCodes(n int) ([]string, error) {
+	codes := make([]string, 0, n)
+	max := big.NewInt(int64(len(recoveryAlphabet)))
+	for i := 0; i < n; i++ {
+		var b strings.Builder
+		for j := 0; j < recoveryHalfLen*2; j++ {
+			if j == recoveryHalfLen {
+				b.WriteByte('-')
+			}
+			idx, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return nil, err
+			}
+			b.WriteByte(recoveryAlphabet[idx.Int64()])
+		}
+		codes = append(codes, b.String())
+	}
+	return codes, nil
+}
+
+// HashRecoveryCode normalizes case, spaces and dashes before hashing so
+// codes typed back by hand still match.
+func HashRecoveryCode(pepper []byte, code string) string {
+	normalized := strings.ToLower(code)
+	normalized = strings.NewReplacer("-", "", " ", "").Replace(normalized)
+
+	mac := hmac.New(sha256.New, pepper)
+	mac.Write([]byte(normalized))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,69 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+var AnalyticsTables = []string{"analytics_events*", "view_rollups"}
+
+type Database struct {
+	Host     string
+	Port     string
+	User     string
+	Password string
+	Name     string
+	SSLMode  string
+}
+
+type DumpOptions struct {
+	ExcludeDataFor []string
+}
+
+// Dump streams a pg_dump custom-format archive of the database to w. Tables in
+// ExcludeDataFor keep their schema but are dumped without rows.
+func Dump(ctx context.Context, db Database, opts DumpOptions, w io.Writer) error {
+	args := []string{"--format=custom", "--no-owner", "--no-privileges"}
+	for _, pattern := range opts.ExcludeDataFor {
+		args = append(args, "--exclude-table-data="+pattern)
+	}
+	args = append(args, db.connArgs()...)
+
+	return run(ctx, db, "pg_dump", args, nil, w)
+}
+
+// Restore replays an archive produced by Dump, dropping existing objects first.
+func Restore(ctx context.Context, db Database, r io.Reader) error {
+	args := []string{"--clean", "--if-exists", "--no-owner", "--no-privileges", "--single-transaction", "--exit-on-error"}
+	args = append(args, db.connArgs()...)
+
+	return run(ctx, db, "pg_restore", args, r, io.Discard)
+}
+
+func ObjectKey(prefix, database string, at time.Time) string {
+	return fmt.Sprintf("%s/%s/%s.dump", strings.Trim(prefix, "/"), database, at.UTC().Format("20060102T150405Z"))
+}
+
+func (db Database) connArgs() []string {
+	return []string{"--host=" + db.Host, "--port=" + db.Port, "--username=" + db.User, "--dbname=" + db.Name}
+}
+
+func run(ctx context.Context, db Database, name string, args []string, stdin io.Reader, stdout io.Writer) error {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Env = append(os.Environ(), "PGPASSWORD="+db.Password, "PGSSLMODE="+db.SSLMode)
+	cmd.Stdin = stdin
+	cmd.Stdout = stdout
+
+	var stderr strings.Builder
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s failed: %w: %s", name, err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
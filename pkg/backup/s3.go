@@ -0,0 +1,133 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+type Object struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+type Store interface {
+	Upload(ctx context.Context, key string, body io.Reader) error
+	Download(ctx context.Context, key string, w io.WriterAt) (int64, error)
+	List(ctx context.Context, prefix string) ([]Object, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type s3Store struct {
+	client     *s3.S3
+	uploader   *s3manager.Uploader
+	downloader *s3manager.Downloader
+	bucket     string
+}
+
+func NewS3Store(accessKey, secretKey, region, bucket string) (Store, error) {
+	if region == "" {
+		return nil, fmt.Errorf("AWS region is required")
+	}
+	if bucket == "" {
+		return nil, fmt.Errorf("S3 bucket name is required")
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String(region),
+		Credentials: credentials.NewStaticCredentials(accessKey, secretKey, ""),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AWS session: %w", err)
+	}
+
+	return &s3Store{
+		client:     s3.New(sess),
+		uploader:   s3manager.NewUploader(sess),
+		downloader: s3manager.NewDownloader(sess),
+		bucket:     bucket,
+	}, nil
+}
+
+func (s *s3Store) Upload(ctx context.Context, key string, body io.Reader) error {
+	_, err := s.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket:               aws.String(s.bucket),
+		Key:                  aws.String(key),
+		Body:                 body,
+		ContentType:          aws.String("application/octet-stream"),
+		ServerSideEncryption: aws.String(s3.ServerSideEncryptionAes256),
+	})
+	return err
+}
+
+func (s *s3Store) Download(ctx context.Context, key string, w io.WriterAt) (int64, error) {
+	return s.downloader.DownloadWithContext(ctx, w, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+}
+
+func (s *s3Store) List(ctx context.Context, prefix string) ([]Object, error) {
+	var objects []Object
+	err := s.client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(strings.Trim(prefix, "/") + "/"),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, item := range page.Contents {
+			objects = append(objects, Object{
+				Key:          aws.StringValue(item.Key),
+				Size:         aws.Int64Value(item.Size),
+				LastModified: aws.TimeValue(item.LastModified),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].LastModified.After(objects[j].LastModified)
+	})
+	return objects, nil
+}
+
+func (s *s3Store) Delete(ctx context.Context, key string) error {
+	_, err := s.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	return err
+}
+
+// Prune deletes backups older than retention, always keeping the newest
+// minKeep objects so a stalled schedule never empties the bucket.
+func Prune(ctx context.Context, store Store, prefix string, retention time.Duration, minKeep int) ([]Object, error) {
+	objects, err := store.List(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	cutoff := time.Now().Add(-retention)
+	var deleted []Object
+	for i, object := range objects {
+		if i < minKeep || object.LastModified.After(cutoff) {
+			continue
+		}
+		if err := store.Delete(ctx, object.Key); err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, object)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,192 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	apperrors "linked-clone/pkg/errors"
+)
+
+type State int
+
+const (
+	StateClosed State = iota
+	StateHalfOpen
+	StateOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateHalfOpen:
+		return "half_open"
+	case StateOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+var (
+	ErrOpenState       = errors.New("circuit breaker is open")
+	ErrTooManyRequests = errors.New("circuit breaker is half-open and probing")
+)
+
+type Settings struct {
+	Name                string
+	MaxFailures         uint32
+	OpenTimeout         time.Duration
+	HalfOpenMaxRequests uint32
+	IsFailure           func(err error) bool
+}
+
+type CircuitBreaker struct {
+	mu                  sync.Mutex
+	settings            Settings
+	state               State
+	consecutiveFailures uint32
+	halfOpenInFlight    uint32
+	openedAt            time.Time
+	metrics             map[string]interface{}
+}
+
+func New(settings Settings) *CircuitBreaker {
+	if settings.MaxFailures == 0 {
+		settings.MaxFailures = 5
+	}
+	if settings.OpenTimeout <= 0 {
+		settings.OpenTimeout = 30 * time.Second
+	}
+	if settings.HalfOpenMaxRequests == 0 {
+		settings.HalfOpenMaxRequests = 1
+	}
+	if settings.IsFailure == nil {
+		settings.IsFailure = DefaultIsFailure
+	}
+
+	return &CircuitBreaker{
+		settings: settings,
+		state:    StateClosed,
+		metrics: map[string]interface{}{
+			"requests":        int64(0),
+			"failures":        int64(0),
+			"rejected":        int64(0),
+			"trips":           int64(0),
+			"last_trip":       nil,
+			"last_state_from": nil,
+		},
+	}
+}
+
+func DefaultIsFailure(err error) bool {
+	return !errors.Is(err, context.Canceled)
+}
+
+func (cb *CircuitBreaker) Name() string {
+	return cb.settings.Name
+}
+
+func (cb *CircuitBreaker) Execute(fn func() error) error {
+	if err := cb.before(); err != nil {
+		return apperrors.ExternalServiceError(cb.settings.Name, err).WithContext("breaker_state", cb.State().String())
+	}
+
+	err := fn()
+	cb.after(err == nil || !cb.settings.IsFailure(err))
+	return err
+}
+
+func (cb *CircuitBreaker) State() State {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return cb.currentState(time.Now())
+}
+
+func (cb *CircuitBreaker) GetMetrics() map[string]interface{} {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	metrics := make(map[string]interface{}, len(cb.metrics)+3)
+	for k, v := range cb.metrics {
+		metrics[k] = v
+	}
+	metrics["state"] = cb.currentState(time.Now()).String()
+	metrics["consecutive_failures"] = cb.consecutiveFailures
+	metrics["max_failures"] = cb.settings.MaxFailures
+	return metrics
+}
+
+func (cb *CircuitBreaker) before() error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.currentState(time.Now()) {
+	case StateOpen:
+		cb.metrics["rejected"] = cb.metrics["rejected"].(int64) + 1
+		return ErrOpenState
+	case StateHalfOpen:
+		if cb.halfOpenInFlight >= cb.settings.HalfOpenMaxRequests {
+			cb.metrics["rejected"] = cb.metrics["rejected"].(int64) + 1
+			return ErrTooManyRequests
+		}
+		cb.halfOpenInFlight++
+	}
+
+	cb.metrics["requests"] = cb.metrics["requests"].(int64) + 1
+	return nil
+}
+
+func (cb *CircuitBreaker) after(success bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	now := time.Now()
+	state := cb.currentState(now)
+	if state == StateHalfOpen && cb.halfOpenInFlight > 0 {
+		cb.halfOpenInFlight--
+	}
+
+	if success {
+		cb.consecutiveFailures = 0
+		if state == StateHalfOpen {
+			cb.setState(StateClosed, now)
+		}
+		return
+	}
+
+	cb.metrics["failures"] = cb.metrics["failures"].(int64) + 1
+	cb.consecutiveFailures++
+
+	if state == StateHalfOpen || (state == StateClosed && cb.consecutiveFailures >= cb.settings.MaxFailures) {
+		cb.setState(StateOpen, now)
+	}
+}
+
+func (cb *CircuitBreaker) currentState(now time.Time) State {
+	if cb.state == StateOpen && now.Sub(cb.openedAt) >= cb.settings.OpenTimeout {
+		cb.setState(StateHalfOpen, now)
+	}
+	return cb.state
+}
+
+func (cb *CircuitBreaker) setState(state State, now time.Time) {
+	if cb.state == state {
+		return
+	}
+
+	cb.metrics["last_state_from"] = cb.state.String()
+	cb.state = state
+	cb.halfOpenInFlight = 0
+
+	switch state {
+	case StateOpen:
+		cb.openedAt = now
+		cb.metrics["trips"] = cb.metrics["trips"].(int64) + 1
+		cb.metrics["last_trip"] = now.UTC().Format(time.RFC3339)
+	case StateClosed:
+		cb.consecutiveFailures = 0
+	}
+}
@@ -0,0 +1,26 @@
+package breaker
+
+import "sync"
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]*CircuitBreaker)
+)
+
+func Register(cb *CircuitBreaker) *CircuitBreaker {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[cb.Name()] = cb
+	return cb
+}
+
+func Snapshot() map[string]interface{} {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	snapshot := make(map[string]interface{}, len(registry))
+	for name, cb := range registry {
+		snapshot[name] = cb.GetMetrics()
+	}
+	return snapshot
+}
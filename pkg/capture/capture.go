@@ -0,0 +1,250 @@
+package capture
+
+import (
+	"context"
+	"encoding/json"
+	"linked-clone/pkg/redis"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	listKey  = "capture:requests"
+	redacted = "[REDACTED]"
+	apiBase  = "/api/v1"
+)
+
+// skippedPrefixes are never captured: credentials, identity documents,
+// payments and account or admin actions must not leave production, and
+// realtime connections and signed media links cannot be replayed.
+var skippedPrefixes = []string{
+	"/auth",
+	"/account",
+	"/admin",
+	"/identity",
+	"/security",
+	"/premium",
+	"/emails",
+	"/ws",
+	"/media",
+}
+
+// keptHeaders is the allowlist of request headers worth replaying; cookies,
+// authorization and forwarding headers are dropped.
+var keptHeaders = []string{"Accept", "Accept-Language", "Content-Type"}
+
+var sensitiveKeys = map[string]bool{
+	"code":           true,
+	"otp":            true,
+	"email":          true,
+	"recovery_email": true,
+	"phone":          true,
+	"phone_number":   true,
+	"sms_code":       true,
+	"date_of_birth":  true,
+	"card_number":    true,
+	"cvv":            true,
+}
+
+var sensitiveFragments = []string{"password", "token", "secret"}
+
+// Envelope is a sanitized record of one API request and how it was answered.
+// It carries whether the caller was signed in but not who they were.
+type Envelope struct {
+	ID            string            `json:"id"`
+	CapturedAt    time.Time         `json:"captured_at"`
+	Method        string            `json:"method"`
+	Route         string            `json:"route"`
+	Path          string            `json:"path"`
+	Query         string            `json:"query,omitempty"`
+	Headers       map[string]string `json:"headers,omitempty"`
+	Body          json.RawMessage   `json:"body,omitempty"`
+	BodyOmitted   bool              `json:"body_omitted,omitempty"`
+	Authenticated bool              `json:"authenticated"`
+	Status        int               `json:"status"`
+	LatencyMs     int64             `json:"latency_ms"`
+	ResponseBytes int               `json:"response_bytes"`
+}
+
+type Store interface {
+	Save(ctx context.Context, envelope *Envelope) error
+	// List returns up to limit envelopes, newest first.
+	List(ctx context.Context, limit int) ([]*Envelope, error)
+}
+
+type redisStore struct {
+	redisClient redis.RedisClient
+	maxEntries  int
+	ttl         time.Duration
+}
+
+// NewRedisStore keeps the newest maxEntries envelopes in a capped list that
+// expires ttl after the last capture.
+func NewRedisStore(redisClient redis.RedisClient, maxEntries int, ttl time.Duration) Store {
+	return &redisStore{redisClient: redisClient, maxEntries: maxEntries, ttl: ttl}
+}
+
+func (s *redisStore) Save(ctx context.Context, envelope *Envelope) error {
+	payload, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+
+	if err := s.redisClient.LPush(ctx, listKey, payload); err != nil {
+		return err
+	}
+	if err := s.redisClient.LTrim(ctx, listKey, 0, int64(s.maxEntries-1)); err != nil {
+		return err
+	}
+	return s.redisClient.Expire(ctx, listKey, s.ttl)
+}
+
+func (s *redisStore) List(ctx context.Context, limit int) ([]*Envelope, error) {
+	values, err := s.redisClient.LRange(ctx, listKey, 0, int64(limit-1))
+	if err != nil {
+		return nil, err
+	}
+
+	envelopes := make([]*Envelope, 0, len(values))
+	for _, value := range values {
+		var envelope Envelope
+		if err := json.Unmarshal([]byte(value), &envelope); err != nil {
+			continue
+		}
+		envelopes = append(envelopes, &envelope)
+	}
+	return envelopes, nil
+}
+
+// Recorder decides which requests to capture and saves them. A nil Recorder
+// captures nothing, so callers can pass one only when capture is enabled.
+type Recorder struct {
+	store        Store
+	sampleRate   float64
+	maxBodyBytes int64
+}
+
+func NewRecorder(store Store, sampleRate float64, maxBodyBytes int64) *Recorder {
+	return &Recorder{store: store, sampleRate: sampleRate, maxBodyBytes: maxBodyBytes}
+}
+
+// Sample reports whether this request should be captured.
+func (r *Recorder) Sample(req *http.Request) bool {
+	if r == nil || r.sampleRate <= 0 || !Capturable(req) {
+		return false
+	}
+	return rand.Float64() < r.sampleRate
+}
+
+func (r *Recorder) MaxBodyBytes() int64 {
+	return r.maxBodyBytes
+}
+
+func (r *Recorder) Record(ctx context.Context, envelope *Envelope) error {
+	return r.store.Save(ctx, envelope)
+}
+
+// Capturable reports whether a request may be captured at all, regardless of
+// sampling.
+func Capturable(req *http.Request) bool {
+	if req.Header.Get("Upgrade") != "" {
+		return false
+	}
+
+	path := strings.TrimPrefix(req.URL.Path, apiBase)
+	if strings.Contains(path, "/sso/") || strings.HasSuffix(path, "/sso") {
+		return false
+	}
+	for _, prefix := range skippedPrefixes {
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return false
+		}
+	}
+	return true
+}
+
+// NewEnvelope builds a sanitized envelope from the request and the body read
+// off it. Bodies that are not JSON, or were cut off at the size limit, are
+// dropped and marked omitted rather than stored partly.
+func NewEnvelope(req *http.Request, body []byte, truncated bool) *Envelope {
+	envelope := &Envelope{
+		CapturedAt: time.Now().UTC(),
+		Method:     req.Method,
+		Path:       req.URL.Path,
+		Query:      sanitizeQuery(req.URL.Query()),
+		Headers:    make(map[string]string),
+	}
+
+	for _, name := range keptHeaders {
+		if value := req.Header.Get(name); value != "" {
+			envelope.Headers[name] = value
+		}
+	}
+
+	if len(body) == 0 && !truncated {
+		return envelope
+	}
+	if truncated || !strings.HasPrefix(req.Header.Get("Content-Type"), "application/json") {
+		envelope.BodyOmitted = true
+		return envelope
+	}
+
+	sanitized, err := sanitizeJSON(body)
+	if err != nil {
+		envelope.BodyOmitted = true
+		return envelope
+	}
+	envelope.Body = sanitized
+	return envelope
+}
+
+func sanitizeQuery(values url.Values) string {
+	for key := range values {
+		if isSensitive(key) {
+			values[key] = []string{redacted}
+		}
+	}
+	return values.Encode()
+}
+
+func sanitizeJSON(body []byte) (json.RawMessage, error) {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return nil, err
+	}
+	return json.Marshal(redact(value))
+}
+
+func redact(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if isSensitive(key) {
+				v[key] = redacted
+			} else {
+				v[key] = redact(field)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redact(item)
+		}
+	}
+	return value
+}
+
+func isSensitive(key string) bool {
+	key = strings.ToLower(key)
+	if sensitiveKeys[key] {
+		return true
+	}
+	for _, fragment := range sensitiveFragments {
+		if strings.Contains(key, fragment) {
+			return true
+		}
+	}
+	return false
+}
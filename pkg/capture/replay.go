@@ -0,0 +1,91 @@
+package capture
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Replayer re-issues captured requests against another instance, normally
+// staging, so a change can be checked against real traffic shapes.
+type Replayer struct {
+	client *http.Client
+	target string
+	token  string
+}
+
+// NewReplayer sends requests to target, the base URL the /api/v1 paths are
+// appended to. Captured requests that were signed in are sent with token,
+// since the original credentials are never stored.
+func NewReplayer(target, token string, timeout time.Duration) *Replayer {
+	return &Replayer{
+		client: &http.Client{
+			Timeout: timeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		target: strings.TrimSuffix(target, "/"),
+		token:  token,
+	}
+}
+
+type Result struct {
+	Status        int   `json:"status"`
+	LatencyMs     int64 `json:"latency_ms"`
+	ResponseBytes int64 `json:"response_bytes"`
+}
+
+// Replayable reports whether the envelope can be sent as captured.
+func (r *Replayer) Replayable(envelope *Envelope) bool {
+	if envelope.BodyOmitted {
+		return false
+	}
+	return !envelope.Authenticated || r.token != ""
+}
+
+func (r *Replayer) Replay(ctx context.Context, envelope *Envelope) (*Result, error) {
+	url := r.target + envelope.Path
+	if envelope.Query != "" {
+		url += "?" + envelope.Query
+	}
+
+	var body io.Reader
+	if len(envelope.Body) > 0 {
+		body = bytes.NewReader(envelope.Body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, envelope.Method, url, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	for name, value := range envelope.Headers {
+		req.Header.Set(name, value)
+	}
+	if envelope.Authenticated {
+		req.Header.Set("Authorization", "Bearer "+r.token)
+	}
+	req.Header.Set("X-Replay-Of", envelope.ID)
+
+	start := time.Now()
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	size, err := io.Copy(io.Discard, resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	return &Result{
+		Status:        resp.StatusCode,
+		LatencyMs:     time.Since(start).Milliseconds(),
+		ResponseBytes: size,
+	}, nil
+}
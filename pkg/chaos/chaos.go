@@ -0,0 +1,255 @@
+package chaos
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	apperrors "linked-clone/pkg/errors"
+)
+
+type Kind string
+
+const (
+	KindLatency Kind = "latency"
+	KindError   Kind = "error"
+	KindTimeout Kind = "timeout"
+)
+
+const (
+	DependencyStorage = "storage"
+	DependencyEmail   = "email"
+
+	// Request headers that inject faults into a single request.
+	HeaderLatency    = "X-Chaos-Latency"
+	HeaderStatus     = "X-Chaos-Status"
+	HeaderTimeout    = "X-Chaos-Timeout"
+	HeaderDependency = "X-Chaos-Dependency"
+
+	// defaultTimeout bounds a timeout fault on a call that has no deadline of
+	// its own, such as an SMTP send.
+	defaultTimeout = 30 * time.Second
+)
+
+var ErrInjected = errors.New("injected fault")
+
+// Fault is what to do to a request or a dependency call. Rate is the share of
+// matching calls it applies to; zero means every call.
+type Fault struct {
+	Kind   Kind
+	Delay  time.Duration
+	Status int
+	Rate   float64
+}
+
+// Rule is the CHAOS_RULES form of a fault. Target is a dependency name, a
+// route as "METHOD /api/v1/path/:param", or a route path for every method.
+type Rule struct {
+	Target string  `json:"target"`
+	Kind   Kind    `json:"kind"`
+	Delay  string  `json:"delay,omitempty"`
+	Status int     `json:"status,omitempty"`
+	Rate   float64 `json:"rate,omitempty"`
+}
+
+// ParseRules reads the JSON list of standing rules, e.g.
+// [{"target":"storage","kind":"error","rate":0.5}].
+func ParseRules(spec string) ([]Rule, error) {
+	if strings.TrimSpace(spec) == "" {
+		return nil, nil
+	}
+
+	var rules []Rule
+	if err := json.Unmarshal([]byte(spec), &rules); err != nil {
+		return nil, fmt.Errorf("invalid chaos rules: %w", err)
+	}
+	for _, rule := range rules {
+		if rule.Target == "" {
+			return nil, fmt.Errorf("chaos rule is missing a target")
+		}
+		if _, err := rule.fault(); err != nil {
+			return nil, fmt.Errorf("chaos rule for %s: %w", rule.Target, err)
+		}
+	}
+	return rules, nil
+}
+
+func (r Rule) fault() (Fault, error) {
+	fault := Fault{Kind: r.Kind, Status: r.Status, Rate: r.Rate}
+	if r.Rate < 0 || r.Rate > 1 {
+		return fault, fmt.Errorf("rate must be between 0 and 1")
+	}
+	if r.Delay != "" {
+		delay, err := time.ParseDuration(r.Delay)
+		if err != nil {
+			return fault, fmt.Errorf("invalid delay: %w", err)
+		}
+		fault.Delay = delay
+	}
+
+	switch r.Kind {
+	case KindLatency:
+		if fault.Delay <= 0 {
+			return fault, fmt.Errorf("latency needs a delay")
+		}
+	case KindError:
+		if fault.Status == 0 {
+			fault.Status = http.StatusServiceUnavailable
+		}
+	case KindTimeout:
+	default:
+		return fault, fmt.Errorf("unknown kind %q", r.Kind)
+	}
+	return fault, nil
+}
+
+// Injector holds the standing rules and applies them, together with any
+// faults the current request asked for, to routes and dependency calls.
+type Injector struct {
+	faults map[string]Fault
+}
+
+func NewInjector(rules []Rule) *Injector {
+	injector := &Injector{faults: make(map[string]Fault, len(rules))}
+	for _, rule := range rules {
+		fault, err := rule.fault()
+		if err != nil {
+			continue
+		}
+		injector.faults[rule.Target] = fault
+	}
+	return injector
+}
+
+// RouteFault returns the standing fault for a matched route, if any applies
+// to this call.
+func (i *Injector) RouteFault(method, route string) (Fault, bool) {
+	if fault, ok := i.faults[method+" "+route]; ok {
+		return fault, fault.applies()
+	}
+	fault, ok := i.faults[route]
+	return fault, ok && fault.applies()
+}
+
+// Apply runs the fault for a dependency call: it delays, fails, or blocks
+// until the call's deadline. Faults set on the request take precedence over
+// standing rules.
+func (i *Injector) Apply(ctx context.Context, dependency string) error {
+	fault, ok := requestFaults(ctx)[dependency]
+	if !ok {
+		fault, ok = i.faults[dependency]
+	}
+	if !ok || !fault.applies() {
+		return nil
+	}
+	return fault.apply(ctx, dependency)
+}
+
+func (f Fault) applies() bool {
+	return f.Rate == 0 || rand.Float64() < f.Rate
+}
+
+func (f Fault) apply(ctx context.Context, dependency string) error {
+	switch f.Kind {
+	case KindLatency:
+		return sleep(ctx, f.Delay)
+	case KindError:
+		return apperrors.ExternalServiceError(dependency, ErrInjected)
+	case KindTimeout:
+		delay := f.Delay
+		if delay <= 0 {
+			delay = defaultTimeout
+		}
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+		return apperrors.ExternalServiceError(dependency, context.DeadlineExceeded)
+	}
+	return nil
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type contextKey struct{}
+
+// WithRequestFaults attaches dependency faults parsed from the
+// X-Chaos-Dependency header to the request context.
+func WithRequestFaults(ctx context.Context, faults map[string]Fault) context.Context {
+	if len(faults) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, contextKey{}, faults)
+}
+
+func requestFaults(ctx context.Context) map[string]Fault {
+	if ctx == nil {
+		return nil
+	}
+	faults, _ := ctx.Value(contextKey{}).(map[string]Fault)
+	return faults
+}
+
+// ParseRequestFaults reads the chaos headers. The route fault comes from
+// X-Chaos-Status, X-Chaos-Timeout or X-Chaos-Latency, checked in that order;
+// dependency faults come from X-Chaos-Dependency, a comma-separated list such
+// as "storage=error, email=latency:2s, storage=timeout:5s".
+func ParseRequestFaults(header http.Header) (*Fault, map[string]Fault, error) {
+	var route *Fault
+	switch {
+	case header.Get(HeaderStatus) != "":
+		status, err := strconv.Atoi(header.Get(HeaderStatus))
+		if err != nil || status < 400 || status > 599 {
+			return nil, nil, fmt.Errorf("%s must be a 4xx or 5xx status", HeaderStatus)
+		}
+		route = &Fault{Kind: KindError, Status: status}
+	case header.Get(HeaderTimeout) != "":
+		route = &Fault{Kind: KindTimeout}
+	case header.Get(HeaderLatency) != "":
+		delay, err := time.ParseDuration(header.Get(HeaderLatency))
+		if err != nil || delay <= 0 {
+			return nil, nil, fmt.Errorf("%s must be a duration such as 500ms", HeaderLatency)
+		}
+		route = &Fault{Kind: KindLatency, Delay: delay}
+	}
+
+	var dependencies map[string]Fault
+	for _, entry := range strings.Split(header.Get(HeaderDependency), ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, spec, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, nil, fmt.Errorf("%s entries must be dependency=kind[:delay]", HeaderDependency)
+		}
+		kind, delay, _ := strings.Cut(spec, ":")
+		fault, err := Rule{Target: name, Kind: Kind(kind), Delay: delay}.fault()
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s for %s: %w", HeaderDependency, name, err)
+		}
+
+		if dependencies == nil {
+			dependencies = make(map[string]Fault)
+		}
+		dependencies[strings.TrimSpace(name)] = fault
+	}
+
+	return route, dependencies, nil
+}
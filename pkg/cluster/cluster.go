@@ -0,0 +1,212 @@
+package cluster
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"linked-clone/pkg/redis"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+const (
+	instancesKey = "cluster:instances"
+	leaderKey    = "cluster:leader"
+
+	defaultLeaseTTL = 30 * time.Second
+)
+
+type Instance struct {
+	ID               string    `json:"id"`
+	Hostname         string    `json:"hostname"`
+	PID              int       `json:"pid"`
+	StartedAt        time.Time `json:"started_at"`
+	LastHeartbeat    time.Time `json:"last_heartbeat"`
+	Leader           bool      `json:"leader"`
+	Responsibilities []string  `json:"responsibilities,omitempty"`
+}
+
+type Coordinator interface {
+	ID() string
+	Heartbeat(ctx context.Context) error
+	IsLeader() bool
+	Responsibilities() []string
+	Instances(ctx context.Context) ([]Instance, error)
+	Leave(ctx context.Context) error
+}
+
+type redisCoordinator struct {
+	redisClient      redis.RedisClient
+	leaseTTL         time.Duration
+	responsibilities []string
+	self             Instance
+
+	mu          sync.RWMutex
+	leaderUntil time.Time
+}
+
+func NewRedisCoordinator(redisClient redis.RedisClient, instanceID string, leaseTTL time.Duration, responsibilities []string) Coordinator {
+	hostname, _ := os.Hostname()
+	if instanceID == "" {
+		instanceID = newInstanceID(hostname)
+	}
+	if leaseTTL <= 0 {
+		leaseTTL = defaultLeaseTTL
+	}
+
+	return &redisCoordinator{
+		redisClient:      redisClient,
+		leaseTTL:         leaseTTL,
+		responsibilities: responsibilities,
+		self: Instance{
+			ID:        instanceID,
+			Hostname:  hostname,
+			PID:       os.Getpid(),
+			StartedAt: time.Now().UTC(),
+		},
+	}
+}
+
+func (c *redisCoordinator) ID() string {
+	return c.self.ID
+}
+
+func (c *redisCoordinator) Heartbeat(ctx context.Context) error {
+	now := time.Now()
+	leader, err := c.elect(ctx)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	if leader {
+		c.leaderUntil = now.Add(c.leaseTTL * 3 / 4)
+	} else {
+		c.leaderUntil = time.Time{}
+	}
+	c.mu.Unlock()
+
+	instance := c.self
+	instance.LastHeartbeat = now.UTC()
+	payload, err := json.Marshal(instance)
+	if err != nil {
+		return err
+	}
+	if err := c.redisClient.Set(ctx, instanceKey(c.self.ID), payload, c.leaseTTL); err != nil {
+		return err
+	}
+	return c.redisClient.SAdd(ctx, instancesKey, c.self.ID)
+}
+
+func (c *redisCoordinator) IsLeader() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return time.Now().Before(c.leaderUntil)
+}
+
+func (c *redisCoordinator) Responsibilities() []string {
+	return c.responsibilities
+}
+
+func (c *redisCoordinator) Instances(ctx context.Context) ([]Instance, error) {
+	ids, err := c.redisClient.SMembers(ctx, instancesKey)
+	if err != nil {
+		return nil, err
+	}
+
+	leaderID, err := c.redisClient.Get(ctx, leaderKey)
+	if err != nil && !errors.Is(err, goredis.Nil) {
+		return nil, err
+	}
+
+	instances := make([]Instance, 0, len(ids))
+	for _, id := range ids {
+		raw, err := c.redisClient.Get(ctx, instanceKey(id))
+		if err != nil {
+			if errors.Is(err, goredis.Nil) {
+				if err := c.redisClient.SRem(ctx, instancesKey, id); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			return nil, err
+		}
+
+		var instance Instance
+		if err := json.Unmarshal([]byte(raw), &instance); err != nil {
+			return nil, fmt.Errorf("invalid instance record %s: %w", id, err)
+		}
+		instance.Leader = instance.ID == leaderID
+		if instance.Leader {
+			instance.Responsibilities = c.responsibilities
+		}
+		instances = append(instances, instance)
+	}
+
+	sort.Slice(instances, func(i, j int) bool {
+		return instances[i].StartedAt.Before(instances[j].StartedAt)
+	})
+	return instances, nil
+}
+
+func (c *redisCoordinator) Leave(ctx context.Context) error {
+	c.mu.Lock()
+	c.leaderUntil = time.Time{}
+	c.mu.Unlock()
+
+	leaderID, err := c.redisClient.Get(ctx, leaderKey)
+	if err != nil && !errors.Is(err, goredis.Nil) {
+		return err
+	}
+	if leaderID == c.self.ID {
+		if err := c.redisClient.Delete(ctx, leaderKey); err != nil {
+			return err
+		}
+	}
+
+	if err := c.redisClient.Delete(ctx, instanceKey(c.self.ID)); err != nil {
+		return err
+	}
+	return c.redisClient.SRem(ctx, instancesKey, c.self.ID)
+}
+
+func (c *redisCoordinator) elect(ctx context.Context) (bool, error) {
+	acquired, err := c.redisClient.SetNX(ctx, leaderKey, c.self.ID, c.leaseTTL)
+	if err != nil || acquired {
+		return acquired, err
+	}
+
+	leaderID, err := c.redisClient.Get(ctx, leaderKey)
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return false, nil
+		}
+		return false, err
+	}
+	if leaderID != c.self.ID {
+		return false, nil
+	}
+	return true, c.redisClient.Expire(ctx, leaderKey, c.leaseTTL)
+}
+
+func instanceKey(id string) string {
+	return fmt.Sprintf("cluster:instance:%s", id)
+}
+
+func newInstanceID(hostname string) string {
+	if hostname == "" {
+		hostname = "instance"
+	}
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return fmt.Sprintf("%s-%d", hostname, os.Getpid())
+	}
+	return fmt.Sprintf("%s-%s", hostname, hex.EncodeToString(suffix))
+}
@@ -0,0 +1,110 @@
+package counter
+
+import (
+	"context"
+	"fmt"
+	"linked-clone/pkg/redis"
+	"strconv"
+	"strings"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+type UnreadKind string
+
+const (
+	UnreadNotifications UnreadKind = "notifications"
+	UnreadMessages      UnreadKind = "messages"
+)
+
+type UnreadCounter interface {
+	Increment(ctx context.Context, kind UnreadKind, userID uint, delta int64) (int64, error)
+	Decrement(ctx context.Context, kind UnreadKind, userID uint, delta int64) (int64, error)
+	Get(ctx context.Context, kind UnreadKind, userID uint) (int64, bool, error)
+	Set(ctx context.Context, kind UnreadKind, userID uint, value int64) error
+	Reset(ctx context.Context, kind UnreadKind, userID uint) error
+	TrackedUsers(ctx context.Context, kind UnreadKind) ([]uint, error)
+}
+
+type unreadCounter struct {
+	redisClient redis.RedisClient
+}
+
+func NewUnreadCounter(redisClient redis.RedisClient) UnreadCounter {
+	return &unreadCounter{redisClient: redisClient}
+}
+
+func (c *unreadCounter) Increment(ctx context.Context, kind UnreadKind, userID uint, delta int64) (int64, error) {
+	return c.redisClient.IncrBy(ctx, unreadKey(kind, userID), delta)
+}
+
+func (c *unreadCounter) Decrement(ctx context.Context, kind UnreadKind, userID uint, delta int64) (int64, error) {
+	key := unreadKey(kind, userID)
+
+	value, err := c.redisClient.DecrBy(ctx, key, delta)
+	if err != nil {
+		return 0, err
+	}
+
+	if value < 0 {
+		return c.redisClient.IncrBy(ctx, key, -value)
+	}
+
+	return value, nil
+}
+
+func (c *unreadCounter) Get(ctx context.Context, kind UnreadKind, userID uint) (int64, bool, error) {
+	raw, err := c.redisClient.Get(ctx, unreadKey(kind, userID))
+	if err != nil {
+		if err == goredis.Nil {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+
+	value, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid unread counter value %q: %w", raw, err)
+	}
+
+	if value < 0 {
+		value = 0
+	}
+
+	return value, true, nil
+}
+
+func (c *unreadCounter) Set(ctx context.Context, kind UnreadKind, userID uint, value int64) error {
+	if value < 0 {
+		value = 0
+	}
+	return c.redisClient.Set(ctx, unreadKey(kind, userID), value, 0)
+}
+
+func (c *unreadCounter) Reset(ctx context.Context, kind UnreadKind, userID uint) error {
+	return c.redisClient.Delete(ctx, unreadKey(kind, userID))
+}
+
+func (c *unreadCounter) TrackedUsers(ctx context.Context, kind UnreadKind) ([]uint, error) {
+	prefix := fmt.Sprintf("unread:%s:", kind)
+
+	keys, err := c.redisClient.Keys(ctx, prefix+"*")
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]uint, 0, len(keys))
+	for _, key := range keys {
+		id, err := strconv.ParseUint(strings.TrimPrefix(key, prefix), 10, 32)
+		if err != nil {
+			continue
+		}
+		userIDs = append(userIDs, uint(id))
+	}
+
+	return userIDs, nil
+}
+
+func unreadKey(kind UnreadKind, userID uint) string {
+	return fmt.Sprintf("unread:%s:%d", kind, userID)
+}
@@ -0,0 +1,127 @@
+package counter
+
+import (
+	"context"
+	"fmt"
+	"linked-clone/pkg/redis"
+	"strconv"
+	"strings"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+type ViewKind string
+
+const (
+	ViewPost    ViewKind = "post"
+	ViewProfile ViewKind = "profile"
+	ViewJob     ViewKind = "job"
+)
+
+const (
+	viewDayFormat = "20060102"
+	viewKeyTTL    = 72 * time.Hour
+)
+
+type ViewCount struct {
+	Unique int64
+	Total  int64
+}
+
+type ViewCounter interface {
+	Record(ctx context.Context, kind ViewKind, entityID uint, viewer string) error
+	RecordOnce(ctx context.Context, kind ViewKind, entityID uint, viewer string, window time.Duration) (bool, error)
+	Get(ctx context.Context, kind ViewKind, entityID uint, day time.Time) (*ViewCount, error)
+	TrackedEntities(ctx context.Context, kind ViewKind, day time.Time) ([]uint, error)
+}
+
+type viewCounter struct {
+	redisClient redis.RedisClient
+}
+
+func NewViewCounter(redisClient redis.RedisClient) ViewCounter {
+	return &viewCounter{redisClient: redisClient}
+}
+
+func (c *viewCounter) Record(ctx context.Context, kind ViewKind, entityID uint, viewer string) error {
+	day := time.Now().UTC()
+	uniqueKey := uniqueViewKey(kind, entityID, day)
+	totalKey := totalViewKey(kind, entityID, day)
+
+	if err := c.redisClient.PFAdd(ctx, uniqueKey, viewer); err != nil {
+		return err
+	}
+	if _, err := c.redisClient.IncrBy(ctx, totalKey, 1); err != nil {
+		return err
+	}
+
+	if err := c.redisClient.Expire(ctx, uniqueKey, viewKeyTTL); err != nil {
+		return err
+	}
+	return c.redisClient.Expire(ctx, totalKey, viewKeyTTL)
+}
+
+func (c *viewCounter) RecordOnce(ctx context.Context, kind ViewKind, entityID uint, viewer string, window time.Duration) (bool, error) {
+	fresh, err := c.redisClient.SetNX(ctx, seenViewKey(kind, entityID, viewer), 1, window)
+	if err != nil || !fresh {
+		return false, err
+	}
+
+	return true, c.Record(ctx, kind, entityID, viewer)
+}
+
+func (c *viewCounter) Get(ctx context.Context, kind ViewKind, entityID uint, day time.Time) (*ViewCount, error) {
+	unique, err := c.redisClient.PFCount(ctx, uniqueViewKey(kind, entityID, day))
+	if err != nil {
+		return nil, err
+	}
+
+	var total int64
+	raw, err := c.redisClient.Get(ctx, totalViewKey(kind, entityID, day))
+	if err != nil && err != goredis.Nil {
+		return nil, err
+	}
+	if raw != "" {
+		total, err = strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid view counter value %q: %w", raw, err)
+		}
+	}
+
+	return &ViewCount{Unique: unique, Total: total}, nil
+}
+
+func (c *viewCounter) TrackedEntities(ctx context.Context, kind ViewKind, day time.Time) ([]uint, error) {
+	prefix := fmt.Sprintf("views:unique:%s:", kind)
+	suffix := ":" + day.UTC().Format(viewDayFormat)
+
+	keys, err := c.redisClient.Keys(ctx, prefix+"*"+suffix)
+	if err != nil {
+		return nil, err
+	}
+
+	entityIDs := make([]uint, 0, len(keys))
+	for _, key := range keys {
+		raw := strings.TrimSuffix(strings.TrimPrefix(key, prefix), suffix)
+		id, err := strconv.ParseUint(raw, 10, 32)
+		if err != nil {
+			continue
+		}
+		entityIDs = append(entityIDs, uint(id))
+	}
+
+	return entityIDs, nil
+}
+
+func uniqueViewKey(kind ViewKind, entityID uint, day time.Time) string {
+	return fmt.Sprintf("views:unique:%s:%d:%s", kind, entityID, day.UTC().Format(viewDayFormat))
+}
+
+func seenViewKey(kind ViewKind, entityID uint, viewer string) string {
+	return fmt.Sprintf("views:seen:%s:%d:%s", kind, entityID, viewer)
+}
+
+func totalViewKey(kind ViewKind, entityID uint, day time.Time) string {
+	return fmt.Sprintf("views:total:%s:%d:%s", kind, entityID, day.UTC().Format(viewDayFormat))
+}
@@ -0,0 +1,207 @@
+package dataexport
+
+import (
+	"compress/gzip"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"linked-clone/internal/domain/entities"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FormatVersion = 1
+	dayLayout     = "2006-01-02"
+)
+
+type Column struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+}
+
+type Table struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Columns is the layout shared by every exported table. User IDs, including
+// entity_ref when entity_type is "user", are replaced by a keyed HMAC so rows
+// can be joined across tables and days but not mapped back to accounts.
+var Columns = []Column{
+	{Name: "user_hash", Type: "string", Description: "HMAC-SHA256 of the acting user's ID, hex encoded; empty for anonymous events"},
+	{Name: "event_type", Type: "string", Description: "What happened, e.g. post_view, like, connection_accepted"},
+	{Name: "entity_type", Type: "string", Description: "Kind of object acted on: post, job, company or user; may be empty"},
+	{Name: "entity_ref", Type: "string", Description: "ID of the object acted on; hashed like user_hash when entity_type is user"},
+	{Name: "occurred_at", Type: "timestamp", Description: "UTC time of the interaction, RFC 3339"},
+}
+
+var Tables = []Table{
+	{Name: "analytics_events", Description: "Tracked product events such as profile, post and job views. Event metadata is not exported."},
+	{Name: "reactions", Description: "Post reactions with the reaction type (like, celebrate, ...) in event_type; removed reactions are excluded."},
+	{Name: "comments", Description: "Post comments and replies (event_type comment or reply) without their content; deleted comments are excluded."},
+	{Name: "connections", Description: "Connection requests keyed by requester, with the addressee as entity_ref and the current status in event_type."},
+	{Name: "applications", Description: "Job applications keyed by applicant; cover letters and resumes are not exported."},
+}
+
+type Config struct {
+	Tables []Table
+	Prefix string
+	Hasher *Hasher
+}
+
+// NewConfig validates the export settings. With no tables opted in the export
+// is disabled and no hash key is needed.
+func NewConfig(tableNames []string, prefix, hashKey string) (*Config, error) {
+	tables, err := ParseTables(tableNames)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &Config{Tables: tables, Prefix: prefix}
+	if len(tables) == 0 {
+		return cfg, nil
+	}
+
+	cfg.Hasher, err = NewHasher(hashKey)
+	if err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (c *Config) Enabled() bool {
+	return len(c.Tables) > 0
+}
+
+type Manifest struct {
+	Version int      `json:"version"`
+	Format  string   `json:"format"`
+	Layout  string   `json:"layout"`
+	Columns []Column `json:"columns"`
+	Tables  []Table  `json:"tables"`
+}
+
+func LookupTable(name string) (Table, bool) {
+	for _, table := range Tables {
+		if table.Name == name {
+			return table, true
+		}
+	}
+	return Table{}, false
+}
+
+// ParseTables resolves the opted-in table names, rejecting unknown ones so a
+// typo doesn't silently drop a table from the export.
+func ParseTables(names []string) ([]Table, error) {
+	tables := make([]Table, 0, len(names))
+	for _, name := range names {
+		table, ok := LookupTable(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown export table %q", name)
+		}
+		tables = append(tables, table)
+	}
+	return tables, nil
+}
+
+func NewManifest(tables []Table) *Manifest {
+	return &Manifest{
+		Version: FormatVersion,
+		Format:  "csv+gzip",
+		Layout:  "<table>/dt=<YYYY-MM-DD>/part-0000.csv.gz",
+		Columns: Columns,
+		Tables:  tables,
+	}
+}
+
+func (m *Manifest) JSON() ([]byte, error) {
+	return json.MarshalIndent(m, "", "  ")
+}
+
+func ObjectKey(prefix, table string, day time.Time) string {
+	return fmt.Sprintf("%s/%s/dt=%s/part-0000.csv.gz", strings.Trim(prefix, "/"), table, day.UTC().Format(dayLayout))
+}
+
+func ManifestKey(prefix string) string {
+	return strings.Trim(prefix, "/") + "/schema.json"
+}
+
+type Hasher struct {
+	key []byte
+}
+
+func NewHasher(key string) (*Hasher, error) {
+	if key == "" {
+		return nil, fmt.Errorf("export hash key is required")
+	}
+	return &Hasher{key: []byte(key)}, nil
+}
+
+func (h *Hasher) Hash(id uint) string {
+	mac := hmac.New(sha256.New, h.key)
+	mac.Write([]byte(strconv.FormatUint(uint64(id), 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Writer encodes records as gzip-compressed CSV with a header row.
+type Writer struct {
+	hasher *Hasher
+	gz     *gzip.Writer
+	csv    *csv.Writer
+	rows   int64
+}
+
+func NewWriter(w io.Writer, hasher *Hasher) (*Writer, error) {
+	gz := gzip.NewWriter(w)
+	out := &Writer{hasher: hasher, gz: gz, csv: csv.NewWriter(gz)}
+
+	header := make([]string, len(Columns))
+	for i, column := range Columns {
+		header[i] = column.Name
+	}
+	if err := out.csv.Write(header); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *Writer) Write(record *entities.DataExportRecord) error {
+	var userHash, entityRef string
+	if record.UserID != nil {
+		userHash = w.hasher.Hash(*record.UserID)
+	}
+	if record.EntityID != nil {
+		if record.EntityType == "user" {
+			entityRef = w.hasher.Hash(*record.EntityID)
+		} else {
+			entityRef = strconv.FormatUint(uint64(*record.EntityID), 10)
+		}
+	}
+
+	w.rows++
+	return w.csv.Write([]string{
+		userHash,
+		record.EventType,
+		record.EntityType,
+		entityRef,
+		record.OccurredAt.UTC().Format(time.RFC3339),
+	})
+}
+
+func (w *Writer) Rows() int64 {
+	return w.rows
+}
+
+func (w *Writer) Close() error {
+	w.csv.Flush()
+	if err := w.csv.Error(); err != nil {
+		return err
+	}
+	return w.gz.Close()
+}
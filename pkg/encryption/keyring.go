@@ -0,0 +1,143 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const prefix = "enc:"
+
+var (
+	ErrUnknownKey        = errors.New("unknown encryption key")
+	ErrMalformedValue    = errors.New("malformed encrypted value")
+	ErrEncryptionMissing = errors.New("no encryption key configured")
+)
+
+type Keyring struct {
+	primaryID string
+	ciphers   map[string]cipher.AEAD
+}
+
+func NewKeyring(keys map[string][]byte, primaryID string) (*Keyring, error) {
+	keyring := &Keyring{ciphers: make(map[string]cipher.AEAD, len(keys))}
+	if len(keys) == 0 {
+		return keyring, nil
+	}
+
+	for id, key := range keys {
+		if id == "" || strings.Contains(id, ":") {
+			return nil, fmt.Errorf("invalid encryption key id %q", id)
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid encryption key %s: %w", id, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("invalid encryption key %s: %w", id, err)
+		}
+		keyring.ciphers[id] = aead
+	}
+
+	if _, ok := keyring.ciphers[primaryID]; !ok {
+		return nil, fmt.Errorf("primary encryption key %q is not configured", primaryID)
+	}
+	keyring.primaryID = primaryID
+
+	return keyring, nil
+}
+
+func ParseKeys(spec string) (map[string][]byte, error) {
+	keys := make(map[string][]byte)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		id, encoded, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, fmt.Errorf("encryption key entry must be id:base64key")
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("encryption key %s is not valid base64: %w", id, err)
+		}
+		keys[id] = key
+	}
+	return keys, nil
+}
+
+func (k *Keyring) Enabled() bool {
+	return k != nil && k.primaryID != ""
+}
+
+func (k *Keyring) PrimaryKeyID() string {
+	return k.primaryID
+}
+
+func (k *Keyring) Encrypt(plaintext, associatedData string) (string, error) {
+	if !k.Enabled() {
+		return "", ErrEncryptionMissing
+	}
+
+	aead := k.ciphers[k.primaryID]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), []byte(associatedData))
+	return prefix + k.primaryID + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (k *Keyring) Decrypt(value, associatedData string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	id, encoded, ok := strings.Cut(strings.TrimPrefix(value, prefix), ":")
+	if !ok {
+		return "", ErrMalformedValue
+	}
+
+	aead, ok := k.cipher(id)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownKey, id)
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", ErrMalformedValue
+	}
+
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(associatedData))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt value with key %s: %w", id, err)
+	}
+	return string(plaintext), nil
+}
+
+func (k *Keyring) NeedsRotation(value string) bool {
+	if !k.Enabled() || value == "" {
+		return false
+	}
+	return !strings.HasPrefix(value, prefix+k.primaryID+":")
+}
+
+func (k *Keyring) cipher(id string) (cipher.AEAD, bool) {
+	if k == nil {
+		return nil, false
+	}
+	aead, ok := k.ciphers[id]
+	return aead, ok
+}
+
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
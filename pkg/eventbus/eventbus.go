@@ -0,0 +1,86 @@
+package eventbus
+
+import (
+	"context"
+	"linked-clone/pkg/logger"
+	"sync"
+	"time"
+)
+
+const (
+	ConnectionRequested = "connection.request"
+	ConnectionAccepted  = "connection.accepted"
+	PostLiked           = "post.liked"
+	PostCommented       = "post.commented"
+	PostShared          = "post.shared"
+	CommentReplied      = "comment.replied"
+	CommentLiked        = "comment.liked"
+	PostMentioned       = "post.mentioned"
+	CommentMentioned    = "comment.mentioned"
+)
+
+// Event is a domain event addressed to a single user. EntityType and
+// EntityID identify what the event is about, e.g. "post" and its ID, while
+// Data carries the payload pushed to realtime clients.
+type Event struct {
+	Type        string
+	RecipientID uint
+	ActorID     uint
+	EntityType  string
+	EntityID    uint
+	Data        interface{}
+	OccurredAt  time.Time
+}
+
+type Handler func(ctx context.Context, event *Event)
+
+// Bus decouples services that produce events from consumers such as the
+// realtime gateway. Handlers run synchronously on the publisher's goroutine,
+// so they must not block.
+type Bus interface {
+	Publish(ctx context.Context, event *Event)
+	Subscribe(eventType string, handler Handler)
+}
+
+type bus struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+	logger   logger.Logger
+}
+
+func New(logger logger.Logger) Bus {
+	return &bus{
+		handlers: make(map[string][]Handler),
+		logger:   logger,
+	}
+}
+
+func (b *bus) Publish(ctx context.Context, event *Event) {
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+
+	b.mu.RLock()
+	handlers := b.handlers[event.Type]
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		b.dispatch(ctx, handler, event)
+	}
+}
+
+func (b *bus) Subscribe(eventType string, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[eventType] = append(b.handlers[eventType], handler)
+}
+
+// dispatch isolates the publisher from a misbehaving handler.
+func (b *bus) dispatch(ctx context.Context, handler Handler, event *Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			b.logger.Error("Event handler panicked", "event", event.Type, "panic", r)
+		}
+	}()
+	handler(ctx, event)
+}
@@ -0,0 +1,74 @@
+package experiment
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"linked-clone/internal/domain/entities"
+	"strconv"
+)
+
+const (
+	FeedRanking = "feed_ranking"
+
+	// buckets is the assignment resolution; variant weights are relative, so
+	// any weights work, but splits finer than 1/10000 round.
+	buckets = 10000
+)
+
+func EmailSubject(kind string) string {
+	return "email_subject_" + kind
+}
+
+type Assignment struct {
+	ExperimentID uint
+	Key          string
+	Variant      string
+	Params       map[string]string
+}
+
+// Is reports whether the assignment is for the given variant. It is safe to
+// call on a nil assignment, which callers get when no experiment is running.
+func (a *Assignment) Is(variant string) bool {
+	return a != nil && a.Variant == variant
+}
+
+func (a *Assignment) Param(name, fallback string) string {
+	if a == nil {
+		return fallback
+	}
+	if value, ok := a.Params[name]; ok && value != "" {
+		return value
+	}
+	return fallback
+}
+
+type Assigner interface {
+	Assign(ctx context.Context, key string, userID uint) *Assignment
+}
+
+// Bucket deterministically maps a user to [0, buckets) per experiment key, so
+// the same user lands in independent buckets across experiments.
+func Bucket(key string, userID uint) int {
+	sum := sha256.Sum256([]byte(key + ":" + strconv.FormatUint(uint64(userID), 10)))
+	return int(binary.BigEndian.Uint64(sum[:8]) % buckets)
+}
+
+func Pick(key string, userID uint, variants []entities.ExperimentVariant) *entities.ExperimentVariant {
+	total := 0
+	for _, variant := range variants {
+		total += variant.Weight
+	}
+	if total <= 0 {
+		return nil
+	}
+
+	point := Bucket(key, userID) * total / buckets
+	for i := range variants {
+		point -= variants[i].Weight
+		if point < 0 {
+			return &variants[i]
+		}
+	}
+	return &variants[len(variants)-1]
+}
@@ -0,0 +1,92 @@
+package featureflag
+
+import "sync"
+
+type Feature string
+
+const (
+	FeatureUploads Feature = "uploads"
+	FeatureEmail   Feature = "email"
+	FeatureCache   Feature = "cache"
+
+	FeatureFeedFanout Feature = "feed_fanout"
+	// FeatureInviteOnly asks for an invite code at registration.
+	FeatureInviteOnly Feature = "invite_only"
+)
+
+type Flags struct {
+	mu      sync.RWMutex
+	enabled map[Feature]bool
+	reasons map[Feature]string
+}
+
+func New(features ...Feature) *Flags {
+	flags := &Flags{
+		enabled: make(map[Feature]bool, len(features)),
+		reasons: make(map[Feature]string),
+	}
+	for _, feature := range features {
+		flags.enabled[feature] = true
+	}
+	return flags
+}
+
+func (f *Flags) Enabled(feature Feature) bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	enabled, ok := f.enabled[feature]
+	return !ok || enabled
+}
+
+func (f *Flags) Enable(feature Feature) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.enabled[feature] = true
+	delete(f.reasons, feature)
+}
+
+func (f *Flags) Disable(feature Feature, reason string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.enabled[feature] = false
+	f.reasons[feature] = reason
+}
+
+func (f *Flags) Configure(feature Feature, enabled bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.enabled[feature] = enabled
+	delete(f.reasons, feature)
+}
+
+func (f *Flags) Reason(feature Feature) string {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.reasons[feature]
+}
+
+func (f *Flags) Degraded() bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	for feature, enabled := range f.enabled {
+		if _, failed := f.reasons[feature]; !enabled && failed {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *Flags) Snapshot() map[string]interface{} {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	snapshot := make(map[string]interface{}, len(f.enabled))
+	for feature, enabled := range f.enabled {
+		status := map[string]interface{}{"enabled": enabled}
+		if reason, ok := f.reasons[feature]; ok {
+			status["reason"] = reason
+		}
+		snapshot[string(feature)] = status
+	}
+	return snapshot
+}
@@ -0,0 +1,115 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+	"linked-clone/pkg/redis"
+	"strconv"
+	"time"
+)
+
+const (
+	MaxItems = 500
+	feedTTL  = 7 * 24 * time.Hour
+)
+
+type Store interface {
+	Push(ctx context.Context, postID uint, recipients []uint) error
+	Range(ctx context.Context, userID uint, offset, limit int) ([]uint, bool, error)
+	Fill(ctx context.Context, userID uint, postIDs []uint) error
+	Invalidate(ctx context.Context, userIDs ...uint) error
+}
+
+type redisStore struct {
+	redisClient redis.RedisClient
+}
+
+func NewRedisStore(redisClient redis.RedisClient) Store {
+	return &redisStore{redisClient: redisClient}
+}
+
+func (s *redisStore) Push(ctx context.Context, postID uint, recipients []uint) error {
+	for _, userID := range recipients {
+		key := feedKey(userID)
+
+		warm, err := s.redisClient.Exists(ctx, key)
+		if err != nil {
+			return err
+		}
+		if !warm {
+			continue
+		}
+
+		if err := s.redisClient.LPush(ctx, key, postID); err != nil {
+			return err
+		}
+		if err := s.redisClient.LTrim(ctx, key, 0, MaxItems-1); err != nil {
+			return err
+		}
+		if err := s.redisClient.Expire(ctx, key, feedTTL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *redisStore) Range(ctx context.Context, userID uint, offset, limit int) ([]uint, bool, error) {
+	if offset+limit > MaxItems {
+		return nil, false, nil
+	}
+
+	key := feedKey(userID)
+	warm, err := s.redisClient.Exists(ctx, key)
+	if err != nil || !warm {
+		return nil, false, err
+	}
+
+	values, err := s.redisClient.LRange(ctx, key, int64(offset), int64(offset+limit-1))
+	if err != nil {
+		return nil, false, err
+	}
+
+	postIDs := make([]uint, 0, len(values))
+	for _, value := range values {
+		id, err := strconv.ParseUint(value, 10, 32)
+		if err != nil || id == 0 {
+			continue
+		}
+		postIDs = append(postIDs, uint(id))
+	}
+
+	return postIDs, true, nil
+}
+
+func (s *redisStore) Fill(ctx context.Context, userID uint, postIDs []uint) error {
+	key := feedKey(userID)
+	if err := s.redisClient.Delete(ctx, key); err != nil {
+		return err
+	}
+
+	values := make([]interface{}, 0, len(postIDs)+1)
+	for _, id := range postIDs {
+		values = append(values, id)
+	}
+	if len(values) == 0 {
+		values = append(values, 0)
+	}
+
+	if err := s.redisClient.RPush(ctx, key, values...); err != nil {
+		return err
+	}
+	return s.redisClient.Expire(ctx, key, feedTTL)
+}
+
+func (s *redisStore) Invalidate(ctx context.Context, userIDs ...uint) error {
+	for _, userID := range userIDs {
+		if err := s.redisClient.Delete(ctx, feedKey(userID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func feedKey(userID uint) string {
+	return fmt.Sprintf("feed:%d", userID)
+}
@@ -0,0 +1,152 @@
+// Package geoip finds the rough location of an IP address in a range
+// database loaded into memory, so sessions can be shown as "Jakarta, ID"
+// without calling out to a lookup service on every sign-in.
+//
+// The database is a CSV file with one range per line:
+//
+//	start_ip,end_ip,country[,region,city]
+//
+// which is the layout of the free DB-IP "IP to Country/City Lite" exports;
+// further columns are ignored. IPv4 and IPv6 ranges may be mixed.
+package geoip
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"sort"
+	"strings"
+)
+
+type Location struct {
+	Country string
+	Region  string
+	City    string
+}
+
+// String joins the known parts from the most to the least specific, such
+// as "Jakarta, ID". Regions are left out when the city is known.
+func (l Location) String() string {
+	var parts []string
+	if l.City != "" {
+		parts = append(parts, l.City)
+	} else if l.Region != "" {
+		parts = append(parts, l.Region)
+	}
+	if l.Country != "" {
+		parts = append(parts, l.Country)
+	}
+	return strings.Join(parts, ", ")
+}
+
+type Locator interface {
+	// Locate returns the location of ip, or the zero Location when the
+	// address is unparsable, private or not in the database.
+	Locate(ip string) Location
+}
+
+// Nop locates nothing. It stands in when no database is configured.
+type Nop struct{}
+
+func (Nop) Locate(string) Location { return Location{} }
+
+type ipRange struct {
+	start, end netip.Addr
+	location   Location
+}
+
+type rangeLocator struct {
+	ranges []ipRange
+}
+
+// Open loads the database at path. An empty path yields Nop.
+func Open(path string) (Locator, error) {
+	if path == "" {
+		return Nop{}, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open geoip database: %w", err)
+	}
+	defer file.Close()
+
+	return Load(file)
+}
+
+func Load(r io.Reader) (Locator, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	var ranges []ipRange
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read geoip database: %w", err)
+		}
+		if len(record) < 3 || strings.HasPrefix(record[0], "#") {
+			continue
+		}
+
+		start, err := netip.ParseAddr(strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, fmt.Errorf("geoip database line %d: invalid start address", line)
+		}
+		start = start.Unmap()
+		end, err := netip.ParseAddr(strings.TrimSpace(record[1]))
+		if err != nil || end.Unmap().Less(start) || start.Is4() != end.Unmap().Is4() {
+			return nil, fmt.Errorf("geoip database line %d: invalid end address", line)
+		}
+
+		location := Location{Country: strings.ToUpper(strings.TrimSpace(record[2]))}
+		if len(record) >= 5 {
+			location.Region = strings.TrimSpace(record[3])
+			location.City = strings.TrimSpace(record[4])
+		}
+		// DB-IP marks unassigned space with "ZZ".
+		if location.Country == "ZZ" {
+			continue
+		}
+
+		ranges = append(ranges, ipRange{start: start, end: end.Unmap(), location: location})
+	}
+
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].start.Less(ranges[j].start)
+	})
+
+	return &rangeLocator{ranges: ranges}, nil
+}
+
+func (l *rangeLocator) Locate(ip string) Location {
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		return Location{}
+	}
+	addr = addr.Unmap()
+	if addr.IsPrivate() || addr.IsLoopback() || addr.IsLinkLocalUnicast() || addr.IsUnspecified() {
+		return Location{}
+	}
+
+	// The last range starting at or before addr is the only one that can
+	// hold it, since ranges in the database do not overlap.
+	i := sort.Search(len(l.ranges), func(i int) bool {
+		return addr.Less(l.ranges[i].start)
+	}) - 1
+	if i < 0 {
+		return Location{}
+	}
+
+	r := l.ranges[i]
+	if r.start.Is4() != addr.Is4() || r.end.Less(addr) {
+		return Location{}
+	}
+	return r.location
+}
@@ -0,0 +1,291 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"linked-clone/pkg/redis"
+	"strconv"
+	"time"
+)
+
+const (
+	DegreeSelf   = 0
+	DegreeFirst  = 1
+	DegreeSecond = 2
+	DegreeThird  = 3
+
+	setTTL      = 24 * time.Hour
+	emptyMember = 0
+)
+
+type Loader func(ctx context.Context, userID uint) ([]uint, error)
+
+type Graph interface {
+	Connections(ctx context.Context, userID uint) ([]uint, error)
+	IsConnected(ctx context.Context, userID, otherID uint) (bool, error)
+	MutualConnections(ctx context.Context, userID, otherID uint) ([]uint, error)
+	SecondDegree(ctx context.Context, userID uint) ([]uint, error)
+	IsBlocked(ctx context.Context, userID, otherID uint) (bool, error)
+	Degree(ctx context.Context, userID, otherID uint) (int, error)
+	Connect(ctx context.Context, userID, otherID uint) error
+	Disconnect(ctx context.Context, userID, otherID uint) error
+	Block(ctx context.Context, userID, otherID uint) error
+	Unblock(ctx context.Context, userID, otherID uint) error
+}
+
+type relation struct {
+	prefix string
+	load   Loader
+}
+
+type redisGraph struct {
+	redisClient redis.RedisClient
+	connections relation
+	blocks      relation
+}
+
+func NewRedisGraph(redisClient redis.RedisClient, loadConnections, loadBlocks Loader) Graph {
+	return &redisGraph{
+		redisClient: redisClient,
+		connections: relation{prefix: "graph:connections", load: loadConnections},
+		blocks:      relation{prefix: "graph:blocks", load: loadBlocks},
+	}
+}
+
+func (g *redisGraph) Connections(ctx context.Context, userID uint) ([]uint, error) {
+	return g.members(ctx, g.connections, userID)
+}
+
+func (g *redisGraph) IsConnected(ctx context.Context, userID, otherID uint) (bool, error) {
+	return g.contains(ctx, g.connections, userID, otherID)
+}
+
+func (g *redisGraph) MutualConnections(ctx context.Context, userID, otherID uint) ([]uint, error) {
+	if userID == otherID {
+		return nil, nil
+	}
+	return g.intersect(ctx, g.connections, userID, otherID)
+}
+
+func (g *redisGraph) SecondDegree(ctx context.Context, userID uint) ([]uint, error) {
+	first, err := g.Connections(ctx, userID)
+	if err != nil || len(first) == 0 {
+		return nil, err
+	}
+
+	exclude := map[uint]bool{userID: true}
+	for _, id := range first {
+		exclude[id] = true
+	}
+
+	reachable, err := g.union(ctx, g.connections, first)
+	if err != nil {
+		return nil, err
+	}
+
+	var second []uint
+	for _, id := range reachable {
+		if !exclude[id] {
+			exclude[id] = true
+			second = append(second, id)
+		}
+	}
+	return second, nil
+}
+
+func (g *redisGraph) IsBlocked(ctx context.Context, userID, otherID uint) (bool, error) {
+	return g.contains(ctx, g.blocks, userID, otherID)
+}
+
+func (g *redisGraph) Degree(ctx context.Context, userID, otherID uint) (int, error) {
+	if userID == otherID {
+		return DegreeSelf, nil
+	}
+
+	connected, err := g.IsConnected(ctx, userID, otherID)
+	if err != nil {
+		return 0, err
+	}
+	if connected {
+		return DegreeFirst, nil
+	}
+
+	mutual, err := g.MutualConnections(ctx, userID, otherID)
+	if err != nil {
+		return 0, err
+	}
+	if len(mutual) > 0 {
+		return DegreeSecond, nil
+	}
+	return DegreeThird, nil
+}
+
+func (g *redisGraph) Connect(ctx context.Context, userID, otherID uint) error {
+	return g.link(ctx, g.connections, userID, otherID)
+}
+
+func (g *redisGraph) Disconnect(ctx context.Context, userID, otherID uint) error {
+	return g.unlink(ctx, g.connections, userID, otherID)
+}
+
+func (g *redisGraph) Block(ctx context.Context, userID, otherID uint) error {
+	if err := g.unlink(ctx, g.connections, userID, otherID); err != nil {
+		return err
+	}
+	return g.link(ctx, g.blocks, userID, otherID)
+}
+
+func (g *redisGraph) Unblock(ctx context.Context, userID, otherID uint) error {
+	return g.unlink(ctx, g.blocks, userID, otherID)
+}
+
+func (g *redisGraph) members(ctx context.Context, rel relation, userID uint) ([]uint, error) {
+	if err := g.warm(ctx, rel, userID); err != nil {
+		return rel.load(ctx, userID)
+	}
+
+	values, err := g.redisClient.SMembers(ctx, rel.key(userID))
+	if err != nil {
+		return rel.load(ctx, userID)
+	}
+	return parseMembers(values), nil
+}
+
+func (g *redisGraph) contains(ctx context.Context, rel relation, userID, otherID uint) (bool, error) {
+	if err := g.warm(ctx, rel, userID); err == nil {
+		if ok, err := g.redisClient.SIsMember(ctx, rel.key(userID), otherID); err == nil {
+			return ok, nil
+		}
+	}
+
+	ids, err := rel.load(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	for _, id := range ids {
+		if id == otherID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (g *redisGraph) intersect(ctx context.Context, rel relation, userID, otherID uint) ([]uint, error) {
+	if g.warm(ctx, rel, userID) == nil && g.warm(ctx, rel, otherID) == nil {
+		if values, err := g.redisClient.SInter(ctx, rel.key(userID), rel.key(otherID)); err == nil {
+			return parseMembers(values), nil
+		}
+	}
+
+	left, err := rel.load(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	right, err := rel.load(ctx, otherID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uint]struct{}, len(left))
+	for _, id := range left {
+		seen[id] = struct{}{}
+	}
+	var shared []uint
+	for _, id := range right {
+		if _, ok := seen[id]; ok {
+			shared = append(shared, id)
+		}
+	}
+	return shared, nil
+}
+
+func (g *redisGraph) union(ctx context.Context, rel relation, userIDs []uint) ([]uint, error) {
+	keys := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		if g.warm(ctx, rel, id) != nil {
+			keys = nil
+			break
+		}
+		keys = append(keys, rel.key(id))
+	}
+	if len(keys) > 0 {
+		if values, err := g.redisClient.SUnion(ctx, keys...); err == nil {
+			return parseMembers(values), nil
+		}
+	}
+
+	var ids []uint
+	for _, id := range userIDs {
+		members, err := rel.load(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, members...)
+	}
+	return ids, nil
+}
+
+func (g *redisGraph) warm(ctx context.Context, rel relation, userID uint) error {
+	key := rel.key(userID)
+	exists, err := g.redisClient.Exists(ctx, key)
+	if err != nil || exists {
+		return err
+	}
+
+	ids, err := rel.load(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	members := make([]interface{}, 0, len(ids)+1)
+	members = append(members, emptyMember)
+	for _, id := range ids {
+		members = append(members, id)
+	}
+	if err := g.redisClient.SAdd(ctx, key, members...); err != nil {
+		return err
+	}
+	return g.redisClient.Expire(ctx, key, setTTL)
+}
+
+func (g *redisGraph) link(ctx context.Context, rel relation, userID, otherID uint) error {
+	for _, pair := range [][2]uint{{userID, otherID}, {otherID, userID}} {
+		key := rel.key(pair[0])
+		exists, err := g.redisClient.Exists(ctx, key)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			continue
+		}
+		if err := g.redisClient.SAdd(ctx, key, pair[1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *redisGraph) unlink(ctx context.Context, rel relation, userID, otherID uint) error {
+	for _, pair := range [][2]uint{{userID, otherID}, {otherID, userID}} {
+		if err := g.redisClient.SRem(ctx, rel.key(pair[0]), pair[1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r relation) key(userID uint) string {
+	return fmt.Sprintf("%s:%d", r.prefix, userID)
+}
+
+func parseMembers(values []string) []uint {
+	ids := make([]uint, 0, len(values))
+	for _, value := range values {
+		id, err := strconv.ParseUint(value, 10, 32)
+		if err != nil || id == emptyMember {
+			continue
+		}
+		ids = append(ids, uint(id))
+	}
+	return ids
+}
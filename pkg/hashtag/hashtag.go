@@ -0,0 +1,51 @@
+package hashtag
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+const MaxLength = 100
+
+// A tag starts after a # that is not glued to a preceding word, so URL
+// fragments like example.com/page#section and HTML entities are skipped.
+var pattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_&/#])#([\p{L}\p{N}_]+)`)
+
+// Extract returns the distinct, normalized hashtags in content in order of
+// first appearance.
+func Extract(content string) []string {
+	matches := pattern.FindAllStringSubmatch(content, -1)
+	seen := make(map[string]bool, len(matches))
+	tags := make([]string, 0, len(matches))
+	for _, match := range matches {
+		tag, ok := Normalize(match[1])
+		if !ok || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+// Normalize lowercases a tag and strips a leading #. Tags must contain at
+// least one letter, so "#1" or "#2024" are not hashtags.
+func Normalize(tag string) (string, bool) {
+	tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+	if tag == "" || len([]rune(tag)) > MaxLength {
+		return "", false
+	}
+
+	hasLetter := false
+	for _, r := range tag {
+		switch {
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsDigit(r), r == '_':
+		default:
+			return "", false
+		}
+	}
+	return tag, hasLetter
+}
@@ -0,0 +1,198 @@
+package httpmetrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	dashboardUID  = "linkedin-clone-api-red"
+	panelWidth    = 8
+	panelHeight   = 8
+	rateInterval  = "[$__rate_interval]"
+	datasourceVar = "${datasource}"
+)
+
+// Route is one entry of the router's route table.
+type Route struct {
+	Method string
+	Path   string
+}
+
+type dashboard struct {
+	UID           string     `json:"uid"`
+	Title         string     `json:"title"`
+	Tags          []string   `json:"tags"`
+	SchemaVersion int        `json:"schemaVersion"`
+	Version       int        `json:"version"`
+	Editable      bool       `json:"editable"`
+	Refresh       string     `json:"refresh"`
+	Time          timeRange  `json:"time"`
+	Templating    templating `json:"templating"`
+	Panels        []*panel   `json:"panels"`
+}
+
+type timeRange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type templating struct {
+	List []variable `json:"list"`
+}
+
+type variable struct {
+	Name  string `json:"name"`
+	Label string `json:"label"`
+	Type  string `json:"type"`
+	Query string `json:"query"`
+}
+
+type datasource struct {
+	Type string `json:"type"`
+	UID  string `json:"uid"`
+}
+
+type gridPos struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	W int `json:"w"`
+	H int `json:"h"`
+}
+
+type target struct {
+	RefID        string `json:"refId"`
+	Expr         string `json:"expr"`
+	LegendFormat string `json:"legendFormat"`
+}
+
+type fieldConfig struct {
+	Defaults  fieldDefaults `json:"defaults"`
+	Overrides []struct{}    `json:"overrides"`
+}
+
+type fieldDefaults struct {
+	Unit string `json:"unit"`
+}
+
+type panel struct {
+	ID          int          `json:"id"`
+	Type        string       `json:"type"`
+	Title       string       `json:"title"`
+	Description string       `json:"description,omitempty"`
+	GridPos     gridPos      `json:"gridPos"`
+	Collapsed   bool         `json:"collapsed,omitempty"`
+	Datasource  *datasource  `json:"datasource,omitempty"`
+	Targets     []target     `json:"targets,omitempty"`
+	FieldConfig *fieldConfig `json:"fieldConfig,omitempty"`
+	Panels      []*panel     `json:"panels,omitempty"`
+}
+
+// Dashboard builds a Grafana dashboard with rate, error and duration panels
+// for the API as a whole and a collapsed row per route group found in routes.
+func Dashboard(routes []Route) ([]byte, error) {
+	byGroup := make(map[string][]string)
+	for _, route := range routes {
+		if !strings.HasPrefix(route.Path, APIPrefix) {
+			continue
+		}
+		group := Group(route.Path)
+		byGroup[group] = append(byGroup[group], route.Method+" "+route.Path)
+	}
+
+	groups := make([]string, 0, len(byGroup))
+	for group := range byGroup {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+
+	b := &builder{}
+	d := &dashboard{
+		UID:           dashboardUID,
+		Title:         "LinkedIn Clone API - RED",
+		Tags:          []string{"api", "red", "generated"},
+		SchemaVersion: 39,
+		Version:       1,
+		Editable:      true,
+		Refresh:       "30s",
+		Time:          timeRange{From: "now-6h", To: "now"},
+		Templating: templating{List: []variable{
+			{Name: "datasource", Label: "Data source", Type: "datasource", Query: "prometheus"},
+		}},
+	}
+
+	d.Panels = append(d.Panels, b.row("Overview", false))
+	d.Panels = append(d.Panels, b.red("", "group", "{{group}}", "All API routes by group.")...)
+
+	for _, group := range groups {
+		routes := byGroup[group]
+		sort.Strings(routes)
+
+		row := b.row("/"+group, true)
+		selector := fmt.Sprintf("group=%q", group)
+		row.Panels = b.red(selector, "method, route", "{{method}} {{route}}", "Routes:\n"+strings.Join(routes, "\n"))
+		d.Panels = append(d.Panels, row)
+		// A collapsed row takes a single line; its panels are laid out
+		// below it only when it is expanded.
+		b.y -= panelHeight
+	}
+
+	return json.MarshalIndent(d, "", "  ")
+}
+
+type builder struct {
+	nextID int
+	y      int
+}
+
+func (b *builder) id() int {
+	b.nextID++
+	return b.nextID
+}
+
+func (b *builder) row(title string, collapsed bool) *panel {
+	p := &panel{ID: b.id(), Type: "row", Title: title, Collapsed: collapsed, GridPos: gridPos{Y: b.y, W: 3 * panelWidth, H: 1}}
+	b.y++
+	return p
+}
+
+// red returns the rate, error ratio and p95 duration panels for the series
+// matching selector, split by the by labels.
+func (b *builder) red(selector, by, legend, description string) []*panel {
+	requests := "http_requests_total{" + selector + "}"
+	errors := "http_requests_total{" + joinSelector(selector, `status_class="5xx"`) + "}"
+	buckets := "http_request_duration_seconds_bucket{" + selector + "}"
+
+	panels := []*panel{
+		b.timeseries(0, "Rate", "reqps", description,
+			fmt.Sprintf("sum by (%s) (rate(%s%s))", by, requests, rateInterval), legend),
+		b.timeseries(1, "Errors (5xx share)", "percentunit", description,
+			fmt.Sprintf("sum by (%s) (rate(%s%s)) / sum by (%s) (rate(%s%s))", by, errors, rateInterval, by, requests, rateInterval), legend),
+		b.timeseries(2, "Duration (p95)", "s", description,
+			fmt.Sprintf("histogram_quantile(0.95, sum by (le, %s) (rate(%s%s)))", by, buckets, rateInterval), legend),
+	}
+	b.y += panelHeight
+	return panels
+}
+
+func (b *builder) timeseries(column int, title, unit, description, expr, legend string) *panel {
+	return &panel{
+		ID:          b.id(),
+		Type:        "timeseries",
+		Title:       title,
+		Description: description,
+		GridPos:     gridPos{X: column * panelWidth, Y: b.y, W: panelWidth, H: panelHeight},
+		Datasource:  &datasource{Type: "prometheus", UID: datasourceVar},
+		Targets:     []target{{RefID: "A", Expr: expr, LegendFormat: legend}},
+		FieldConfig: &fieldConfig{Defaults: fieldDefaults{Unit: unit}, Overrides: []struct{}{}},
+	}
+}
+
+func joinSelector(selector, matcher string) string {
+	if selector == "" {
+		return matcher
+	}
+	return selector + "," + matcher
+}
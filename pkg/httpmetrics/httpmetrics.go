@@ -0,0 +1,180 @@
+package httpmetrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const APIPrefix = "/api/v1"
+
+// DurationBuckets are the upper bounds, in seconds, of the request duration
+// histogram.
+var DurationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+type seriesKey struct {
+	group  string
+	route  string
+	method string
+}
+
+type series struct {
+	statuses map[string]uint64
+	buckets  []uint64
+	count    uint64
+	sum      float64
+}
+
+// Registry keeps request rate, error and duration counters per route
+// template. Routes are labelled by template, never by raw path, so the
+// number of series stays bounded by the route table.
+type Registry struct {
+	mu      sync.Mutex
+	series  map[seriesKey]*series
+	started time.Time
+}
+
+func NewRegistry() *Registry {
+	return &Registry{series: make(map[seriesKey]*series), started: time.Now()}
+}
+
+func (r *Registry) Observe(method, route string, status int, duration time.Duration) {
+	key := seriesKey{group: Group(route), route: route, method: method}
+	seconds := duration.Seconds()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s, ok := r.series[key]
+	if !ok {
+		s = &series{statuses: make(map[string]uint64), buckets: make([]uint64, len(DurationBuckets))}
+		r.series[key] = s
+	}
+
+	s.statuses[StatusClass(status)]++
+	s.count++
+	s.sum += seconds
+	for i, bound := range DurationBuckets {
+		if seconds <= bound {
+			s.buckets[i]++
+			break
+		}
+	}
+}
+
+// Summary is the all-routes view shown on the JSON /metrics endpoint.
+type Summary struct {
+	Requests          uint64  `json:"requests_total"`
+	Errors            uint64  `json:"errors_total"`
+	RequestsPerSecond float64 `json:"requests_per_second"`
+	ErrorRate         float64 `json:"error_rate"`
+	AvgResponseTimeMs float64 `json:"avg_response_time_ms"`
+}
+
+// Summary averages over the whole process lifetime. Errors are 5xx
+// responses; client errors are the caller's fault and count as served.
+func (r *Registry) Summary() Summary {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var summary Summary
+	var seconds float64
+	for _, s := range r.series {
+		summary.Requests += s.count
+		summary.Errors += s.statuses["5xx"]
+		seconds += s.sum
+	}
+
+	if uptime := time.Since(r.started).Seconds(); uptime > 0 {
+		summary.RequestsPerSecond = float64(summary.Requests) / uptime
+	}
+	if summary.Requests > 0 {
+		summary.ErrorRate = float64(summary.Errors) / float64(summary.Requests)
+		summary.AvgResponseTimeMs = seconds * 1000 / float64(summary.Requests)
+	}
+	return summary
+}
+
+// WriteOpenMetrics writes the request counter and duration histogram
+// families. It leaves out the closing "# EOF" so the output can be joined
+// with other families.
+func (r *Registry) WriteOpenMetrics(w io.Writer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	keys := make([]seriesKey, 0, len(r.series))
+	for key := range r.series {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].route != keys[j].route {
+			return keys[i].route < keys[j].route
+		}
+		return keys[i].method < keys[j].method
+	})
+
+	buf := bufio.NewWriter(w)
+
+	fmt.Fprintf(buf, "# TYPE http_requests counter\n")
+	fmt.Fprintf(buf, "# HELP http_requests Requests served, by route template and status class.\n")
+	for _, key := range keys {
+		s := r.series[key]
+		classes := make([]string, 0, len(s.statuses))
+		for class := range s.statuses {
+			classes = append(classes, class)
+		}
+		sort.Strings(classes)
+		for _, class := range classes {
+			fmt.Fprintf(buf, "http_requests_total{%s,status_class=%s} %d\n", key.labels(), strconv.Quote(class), s.statuses[class])
+		}
+	}
+
+	fmt.Fprintf(buf, "# TYPE http_request_duration_seconds histogram\n")
+	fmt.Fprintf(buf, "# HELP http_request_duration_seconds Time to serve a request, by route template.\n")
+	for _, key := range keys {
+		s := r.series[key]
+		labels := key.labels()
+		var cumulative uint64
+		for i, bound := range DurationBuckets {
+			cumulative += s.buckets[i]
+			fmt.Fprintf(buf, "http_request_duration_seconds_bucket{%s,le=\"%s\"} %d\n", labels, strconv.FormatFloat(bound, 'g', -1, 64), cumulative)
+		}
+		fmt.Fprintf(buf, "http_request_duration_seconds_bucket{%s,le=\"+Inf\"} %d\n", labels, s.count)
+		fmt.Fprintf(buf, "http_request_duration_seconds_sum{%s} %s\n", labels, strconv.FormatFloat(s.sum, 'g', -1, 64))
+		fmt.Fprintf(buf, "http_request_duration_seconds_count{%s} %d\n", labels, s.count)
+	}
+
+	return buf.Flush()
+}
+
+func (k seriesKey) labels() string {
+	return "group=" + strconv.Quote(k.group) + ",method=" + strconv.Quote(k.method) + ",route=" + strconv.Quote(k.route)
+}
+
+// Group names the route group a route template belongs to: the first path
+// segment after the API prefix. Connection routes mounted under /users are
+// counted with the rest of the connections group.
+func Group(route string) string {
+	path := strings.TrimPrefix(route, APIPrefix)
+	if strings.HasPrefix(path, "/users/connections") {
+		return "connections"
+	}
+
+	segment, _, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	if segment == "" {
+		return "root"
+	}
+	return segment
+}
+
+func StatusClass(status int) string {
+	if status < 100 || status > 599 {
+		return "other"
+	}
+	return strconv.Itoa(status/100) + "xx"
+}
@@ -0,0 +1,225 @@
+// Package i18n holds the translated labels the API sends next to enum
+// values, so clients can show "Full-time" or "Penuh waktu" for full_time
+// without keeping their own copy of every label.
+//
+// Messages live in one flat JSON file per locale, keyed by "group.value"
+// such as "job_type.full_time". The bundled files can be extended or
+// overridden with a directory of files in the same format. Lookups fall
+// back from a regional locale to its language and then to the default
+// locale, so "id-ID" finds "id" and a missing key shows the default text.
+package i18n
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed locales/*.json
+var bundled embed.FS
+
+type Bundle struct {
+	fallback string
+	locales  []string
+	messages map[string]map[string]string
+}
+
+// NewBundle loads the bundled locales, then any *.json files in dir on top
+// of them. fallback must be one of the loaded locales.
+func NewBundle(fallback, dir string) (*Bundle, error) {
+	b := &Bundle{
+		fallback: normalize(fallback),
+		messages: make(map[string]map[string]string),
+	}
+
+	if err := b.load(bundled, "locales"); err != nil {
+		return nil, err
+	}
+	if dir != "" {
+		if err := b.load(os.DirFS(dir), "."); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, ok := b.messages[b.fallback]; !ok {
+		return nil, fmt.Errorf("default locale %q has no messages", fallback)
+	}
+
+	for locale := range b.messages {
+		b.locales = append(b.locales, locale)
+	}
+	sort.Strings(b.locales)
+
+	return b, nil
+}
+
+func (b *Bundle) load(fsys fs.FS, dir string) error {
+	files, err := fs.Glob(fsys, path.Join(dir, "*.json"))
+	if err != nil {
+		return fmt.Errorf("failed to list locales: %w", err)
+	}
+
+	for _, file := range files {
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return fmt.Errorf("failed to read locale %s: %w", file, err)
+		}
+
+		var messages map[string]string
+		if err := json.Unmarshal(data, &messages); err != nil {
+			return fmt.Errorf("failed to parse locale %s: %w", file, err)
+		}
+
+		locale := normalize(strings.TrimSuffix(path.Base(file), ".json"))
+		if b.messages[locale] == nil {
+			b.messages[locale] = make(map[string]string, len(messages))
+		}
+		for key, message := range messages {
+			b.messages[locale][key] = message
+		}
+	}
+	return nil
+}
+
+// Default is the locale used when a request asks for none we have.
+func (b *Bundle) Default() string {
+	return b.fallback
+}
+
+// Locales lists the loaded locales in order.
+func (b *Bundle) Locales() []string {
+	return b.locales
+}
+
+// Negotiate picks the best loaded locale for an Accept-Language header,
+// honouring its quality values. A language matches any of its regional
+// variants we have, so "en-GB" is served by "en".
+func (b *Bundle) Negotiate(acceptLanguage string) string {
+	for _, tag := range parseAcceptLanguage(acceptLanguage) {
+		if tag == "*" {
+			return b.fallback
+		}
+		if _, ok := b.messages[tag]; ok {
+			return tag
+		}
+		if base, _, found := strings.Cut(tag, "-"); found {
+			if _, ok := b.messages[base]; ok {
+				return base
+			}
+		}
+	}
+	return b.fallback
+}
+
+// Translate returns the message for key in locale, falling back to the
+// locale's language, then the default locale. A key missing everywhere is
+// returned as is.
+func (b *Bundle) Translate(locale, key string) string {
+	for _, candidate := range b.chain(locale) {
+		if message, ok := b.messages[candidate][key]; ok {
+			return message
+		}
+	}
+	return key
+}
+
+// Label returns the display text for an enum value, such as the
+// "application_status" group's "shortlisted". Values without a message are
+// turned into words so new values still read sensibly before they are
+// translated.
+func (b *Bundle) Label(locale, group, value string) string {
+	if value == "" {
+		return ""
+	}
+
+	key := group + "." + value
+	if message := b.Translate(locale, key); message != key {
+		return message
+	}
+	return humanize(value)
+}
+
+func (b *Bundle) chain(locale string) []string {
+	locale = normalize(locale)
+	chain := make([]string, 0, 3)
+	if locale != "" {
+		chain = append(chain, locale)
+		if base, _, found := strings.Cut(locale, "-"); found {
+			chain = append(chain, base)
+		}
+	}
+	return append(chain, b.fallback)
+}
+
+type contextKey struct{}
+
+// WithLocale returns a copy of ctx carrying the locale a request was
+// negotiated to.
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, contextKey{}, locale)
+}
+
+// FromContext returns the locale stored by WithLocale, or "" so lookups use
+// the default locale.
+func FromContext(ctx context.Context) string {
+	locale, _ := ctx.Value(contextKey{}).(string)
+	return locale
+}
+
+// parseAcceptLanguage returns the header's language tags from the most to
+// the least preferred, leaving out those with a quality of zero.
+func parseAcceptLanguage(header string) []string {
+	type weighted struct {
+		tag     string
+		quality float64
+	}
+
+	var tags []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = normalize(tag)
+		if tag == "" {
+			continue
+		}
+
+		quality := 1.0
+		if q, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		tags = append(tags, weighted{tag: tag, quality: quality})
+	}
+
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].quality > tags[j].quality
+	})
+
+	ordered := make([]string, len(tags))
+	for i, t := range tags {
+		ordered[i] = t.tag
+	}
+	return ordered
+}
+
+func normalize(tag string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+}
+
+func humanize(value string) string {
+	words := strings.ReplaceAll(value, "_", " ")
+	return strings.ToUpper(words[:1]) + words[1:]
+}
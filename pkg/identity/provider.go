@@ -0,0 +1,52 @@
+package identity
+
+import (
+	"context"
+	"errors"
+)
+
+type Decision string
+
+const (
+	DecisionApproved Decision = "approved"
+	DecisionRejected Decision = "rejected"
+	DecisionReview   Decision = "review"
+)
+
+var ErrProviderUnavailable = errors.New("identity provider unavailable")
+
+type VerificationRequest struct {
+	UserID       uint
+	FullName     string
+	DocumentType string
+	DocumentKey  string
+	SelfieKey    string
+}
+
+type VerificationResult struct {
+	Decision  Decision
+	Reference string
+	Reason    string
+}
+
+type Provider interface {
+	Name() string
+	Verify(ctx context.Context, req *VerificationRequest) (*VerificationResult, error)
+}
+
+type manualReviewProvider struct{}
+
+func NewManualReviewProvider() Provider {
+	return &manualReviewProvider{}
+}
+
+func (p *manualReviewProvider) Name() string {
+	return "manual"
+}
+
+func (p *manualReviewProvider) Verify(ctx context.Context, req *VerificationRequest) (*VerificationResult, error) {
+	return &VerificationResult{
+		Decision: DecisionReview,
+		Reason:   "queued for manual review",
+	}, nil
+}
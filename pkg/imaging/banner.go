@@ -0,0 +1,65 @@
+package imaging
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"io"
+)
+
+// Banners run as a strip across the top of a profile, so anything much
+// narrower than 2:1 would lose most of the picture to the crop.
+const (
+	BannerMinWidth  = 800
+	BannerMinAspect = 2.0
+	BannerMaxAspect = 6.0
+)
+
+var ErrBannerDimensions = fmt.Errorf("banner must be at least %dpx wide with an aspect ratio between %g:1 and %g:1", BannerMinWidth, BannerMinAspect, BannerMaxAspect)
+
+// CheckBanner reads only the image header and rejects images too small or
+// too tall to use as a banner.
+func CheckBanner(r io.Reader) error {
+	config, _, err := image.DecodeConfig(r)
+	if err != nil {
+		return fmt.Errorf("failed to decode image: %w", err)
+	}
+	if config.Height == 0 {
+		return errors.New("image has no pixels")
+	}
+
+	aspect := float64(config.Width) / float64(config.Height)
+	if config.Width < BannerMinWidth || aspect < BannerMinAspect || aspect > BannerMaxAspect {
+		return ErrBannerDimensions
+	}
+	return nil
+}
+
+// CoverCrop scales the image to fill width by height, cropping the centre
+// of whichever side is too long for that aspect ratio.
+func CoverCrop(r io.Reader, width, height int) ([]byte, error) {
+	src, _, err := image.Decode(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	bounds := src.Bounds()
+	if bounds.Dx() == 0 || bounds.Dy() == 0 {
+		return nil, fmt.Errorf("image has no pixels")
+	}
+
+	return encodeJPEG(scale(src, coverRegion(bounds, width, height), width, height))
+}
+
+func coverRegion(bounds image.Rectangle, width, height int) image.Rectangle {
+	cropWidth, cropHeight := bounds.Dx(), bounds.Dy()
+	if cropWidth*height > cropHeight*width {
+		cropWidth = cropHeight * width / height
+	} else {
+		cropHeight = cropWidth * height / width
+	}
+
+	x := bounds.Min.X + (bounds.Dx()-cropWidth)/2
+	y := bounds.Min.Y + (bounds.Dy()-cropHeight)/2
+	return image.Rect(x, y, x+cropWidth, y+cropHeight)
+}
@@ -0,0 +1,78 @@
+package imaging
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"io"
+)
+
+const faceCropMargin = 0.6
+
+type FaceDetector interface {
+	DetectFaces(ctx context.Context, img image.Image) ([]image.Rectangle, error)
+}
+
+type noopFaceDetector struct{}
+
+func NewNoopFaceDetector() FaceDetector {
+	return &noopFaceDetector{}
+}
+
+func (d *noopFaceDetector) DetectFaces(ctx context.Context, img image.Image) ([]image.Rectangle, error) {
+	return nil, nil
+}
+
+func FaceCrop(ctx context.Context, r io.Reader, detector FaceDetector, size int) ([]byte, error) {
+	src, _, err := image.Decode(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	bounds := src.Bounds()
+	if bounds.Dx() == 0 || bounds.Dy() == 0 {
+		return nil, fmt.Errorf("image has no pixels")
+	}
+
+	var face image.Rectangle
+	if detector != nil {
+		faces, err := detector.DetectFaces(ctx, src)
+		if err != nil {
+			return nil, fmt.Errorf("failed to detect faces: %w", err)
+		}
+		for _, candidate := range faces {
+			candidate = candidate.Intersect(bounds)
+			if candidate.Dx()*candidate.Dy() > face.Dx()*face.Dy() {
+				face = candidate
+			}
+		}
+	}
+
+	return encodeJPEG(scale(src, squareRegion(bounds, face), size, size))
+}
+
+func squareRegion(bounds, face image.Rectangle) image.Rectangle {
+	side := min(bounds.Dx(), bounds.Dy())
+	center := image.Pt(bounds.Min.X+bounds.Dx()/2, bounds.Min.Y+bounds.Dy()/2)
+
+	if !face.Empty() {
+		faceSide := max(face.Dx(), face.Dy())
+		side = min(side, faceSide+int(float64(faceSide)*faceCropMargin*2))
+		center = image.Pt(face.Min.X+face.Dx()/2, face.Min.Y+face.Dy()/2)
+	}
+
+	minX := clamp(center.X-side/2, bounds.Min.X, bounds.Max.X-side)
+	minY := clamp(center.Y-side/2, bounds.Min.Y, bounds.Max.Y-side)
+
+	return image.Rect(minX, minY, minX+side, minY+side)
+}
+
+func clamp(value, low, high int) int {
+	if value < low {
+		return low
+	}
+	if value > high {
+		return high
+	}
+	return value
+}
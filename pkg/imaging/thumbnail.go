@@ -0,0 +1,67 @@
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+)
+
+func Thumbnail(r io.Reader, maxDimension int) ([]byte, error) {
+	src, _, err := image.Decode(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width == 0 || height == 0 {
+		return nil, fmt.Errorf("image has no pixels")
+	}
+
+	targetWidth, targetHeight := width, height
+	if width > maxDimension || height > maxDimension {
+		if width >= height {
+			targetWidth = maxDimension
+			targetHeight = height * maxDimension / width
+		} else {
+			targetHeight = maxDimension
+			targetWidth = width * maxDimension / height
+		}
+	}
+
+	return encodeJPEG(scale(src, bounds, targetWidth, targetHeight))
+}
+
+func scale(src image.Image, region image.Rectangle, targetWidth, targetHeight int) image.Image {
+	if targetWidth < 1 {
+		targetWidth = 1
+	}
+	if targetHeight < 1 {
+		targetHeight = 1
+	}
+
+	width, height := region.Dx(), region.Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, targetWidth, targetHeight))
+	for y := 0; y < targetHeight; y++ {
+		srcY := region.Min.Y + y*height/targetHeight
+		for x := 0; x < targetWidth; x++ {
+			srcX := region.Min.X + x*width/targetWidth
+			dst.Set(x, y, src.At(srcX, srcY))
+		}
+	}
+
+	return dst
+}
+
+func encodeJPEG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 80}); err != nil {
+		return nil, fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,114 @@
+package linkedin
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"path"
+	"strings"
+)
+
+const (
+	connectionsFile = "connections.csv"
+	bom             = "\ufeff"
+)
+
+var (
+	ErrConnectionsNotFound = errors.New("connections file not found in archive")
+	ErrInvalidConnections  = errors.New("connections file is missing the expected header")
+)
+
+type Contact struct {
+	FirstName   string
+	LastName    string
+	Email       string
+	Company     string
+	Position    string
+	ConnectedOn string
+}
+
+func (c Contact) FullName() string {
+	return strings.TrimSpace(c.FirstName + " " + c.LastName)
+}
+
+func ParseExport(filename string, data []byte) ([]Contact, error) {
+	if strings.EqualFold(path.Ext(filename), ".zip") {
+		return parseArchive(data)
+	}
+	return ParseConnections(bytes.NewReader(data))
+}
+
+func ParseConnections(r io.Reader) ([]Contact, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	var columns map[string]int
+	var contacts []Contact
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if columns == nil {
+			if len(record) > 0 && strings.EqualFold(strings.TrimSpace(strings.TrimPrefix(record[0], bom)), "First Name") {
+				columns = make(map[string]int, len(record))
+				for i, name := range record {
+					columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, bom)))] = i
+				}
+			}
+			continue
+		}
+
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		contact := Contact{
+			FirstName:   field("first name"),
+			LastName:    field("last name"),
+			Email:       strings.ToLower(field("email address")),
+			Company:     field("company"),
+			Position:    field("position"),
+			ConnectedOn: field("connected on"),
+		}
+		if contact.FullName() == "" && contact.Email == "" {
+			continue
+		}
+		contacts = append(contacts, contact)
+	}
+
+	if columns == nil {
+		return nil, ErrInvalidConnections
+	}
+	return contacts, nil
+}
+
+func parseArchive(data []byte) ([]Contact, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range archive.File {
+		if !strings.EqualFold(path.Base(file.Name), connectionsFile) {
+			continue
+		}
+		rc, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return ParseConnections(rc)
+	}
+	return nil, ErrConnectionsNotFound
+}
@@ -0,0 +1,280 @@
+// Package markdown renders the small markdown subset allowed in posts and
+// comments to HTML that is safe to insert into a page as is.
+//
+// Supported are paragraphs and line breaks, **bold**, *italic* or _italic_,
+// ~~strikethrough~~, `inline code`, fenced code blocks, "- " and "1. " lists,
+// "> " quotes, [links](https://example.com) and bare http(s) URLs. Headings
+// are left out so a line starting with a #hashtag stays text.
+//
+// Any HTML in the source is escaped rather than filtered: the only tags in
+// the output are the ones the renderer writes itself, and links are limited
+// to http, https and mailto.
+package markdown
+
+import (
+	"html"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const linkRel = "nofollow noopener noreferrer ugc"
+
+var (
+	bulletItem  = regexp.MustCompile(`^[-*+][ \t]+(.*)$`)
+	orderedItem = regexp.MustCompile(`^(\d{1,9})[.)][ \t]+(.*)$`)
+)
+
+// Render converts source to sanitized HTML. Empty or blank input renders
+// to an empty string.
+func Render(source string) string {
+	source = strings.ReplaceAll(source, "\r\n", "\n")
+	lines := strings.Split(source, "\n")
+
+	var out strings.Builder
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			i++
+
+		case strings.HasPrefix(trimmed, "```"):
+			i++
+			var code []string
+			for i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), "```") {
+				code = append(code, lines[i])
+				i++
+			}
+			i++ // closing fence, if any
+			out.WriteString("<pre><code>")
+			out.WriteString(html.EscapeString(strings.Join(code, "\n")))
+			out.WriteString("</code></pre>\n")
+
+		case strings.HasPrefix(trimmed, ">"):
+			var quoted []string
+			for i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), ">") {
+				text := strings.TrimPrefix(strings.TrimSpace(lines[i]), ">")
+				quoted = append(quoted, strings.TrimSpace(text))
+				i++
+			}
+			out.WriteString("<blockquote>")
+			writeLines(&out, quoted)
+			out.WriteString("</blockquote>\n")
+
+		case bulletItem.MatchString(trimmed):
+			out.WriteString("<ul>\n")
+			for i < len(lines) {
+				match := bulletItem.FindStringSubmatch(strings.TrimSpace(lines[i]))
+				if match == nil {
+					break
+				}
+				out.WriteString("<li>" + inline(match[1], true) + "</li>\n")
+				i++
+			}
+			out.WriteString("</ul>\n")
+
+		case orderedItem.MatchString(trimmed):
+			start := orderedItem.FindStringSubmatch(trimmed)[1]
+			if n, err := strconv.Atoi(start); err == nil && n != 1 {
+				out.WriteString(`<ol start="` + strconv.Itoa(n) + `">` + "\n")
+			} else {
+				out.WriteString("<ol>\n")
+			}
+			for i < len(lines) {
+				match := orderedItem.FindStringSubmatch(strings.TrimSpace(lines[i]))
+				if match == nil {
+					break
+				}
+				out.WriteString("<li>" + inline(match[2], true) + "</li>\n")
+				i++
+			}
+			out.WriteString("</ol>\n")
+
+		default:
+			var paragraph []string
+			for i < len(lines) && !startsBlock(lines[i]) {
+				paragraph = append(paragraph, strings.TrimSpace(lines[i]))
+				i++
+			}
+			out.WriteString("<p>")
+			writeLines(&out, paragraph)
+			out.WriteString("</p>\n")
+		}
+	}
+
+	return strings.TrimSuffix(out.String(), "\n")
+}
+
+// startsBlock reports whether line ends a paragraph, either because it is
+// blank or because it opens a block of its own.
+func startsBlock(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return trimmed == "" ||
+		strings.HasPrefix(trimmed, "```") ||
+		strings.HasPrefix(trimmed, ">") ||
+		bulletItem.MatchString(trimmed) ||
+		orderedItem.MatchString(trimmed)
+}
+
+// writeLines renders lines as one block, keeping the author's line breaks.
+func writeLines(out *strings.Builder, lines []string) {
+	for i, line := range lines {
+		if i > 0 {
+			out.WriteString("<br>\n")
+		}
+		out.WriteString(inline(line, true))
+	}
+}
+
+// inline renders the spans of a single line. links is false inside link
+// text, so links never nest.
+func inline(s string, links bool) string {
+	var out strings.Builder
+	for i := 0; i < len(s); {
+		rest := s[i:]
+
+		switch {
+		case rest[0] == '\\' && len(rest) > 1 && strings.IndexByte("\\`*_~[]()#>-!", rest[1]) >= 0:
+			out.WriteString(html.EscapeString(rest[1:2]))
+			i += 2
+			continue
+
+		case rest[0] == '`':
+			if end := strings.IndexByte(rest[1:], '`'); end > 0 {
+				out.WriteString("<code>" + html.EscapeString(rest[1:1+end]) + "</code>")
+				i += end + 2
+				continue
+			}
+
+		case strings.HasPrefix(rest, "**"), strings.HasPrefix(rest, "__"):
+			if inner, n, ok := delimited(s, i, rest[:2]); ok {
+				out.WriteString("<strong>" + inline(inner, links) + "</strong>")
+				i += n
+				continue
+			}
+
+		case strings.HasPrefix(rest, "~~"):
+			if inner, n, ok := delimited(s, i, "~~"); ok {
+				out.WriteString("<del>" + inline(inner, links) + "</del>")
+				i += n
+				continue
+			}
+
+		case rest[0] == '*', rest[0] == '_':
+			if inner, n, ok := delimited(s, i, rest[:1]); ok {
+				out.WriteString("<em>" + inline(inner, links) + "</em>")
+				i += n
+				continue
+			}
+
+		case rest[0] == '[' && links:
+			if text, href, n, ok := link(rest); ok {
+				out.WriteString(anchor(href, inline(text, false)))
+				i += n
+				continue
+			}
+
+		case links && (strings.HasPrefix(rest, "http://") || strings.HasPrefix(rest, "https://")) && !wordBefore(s, i):
+			if href, n := bareURL(rest); n > 0 {
+				out.WriteString(anchor(href, html.EscapeString(href)))
+				i += n
+				continue
+			}
+		}
+
+		_, size := utf8.DecodeRuneInString(rest)
+		out.WriteString(html.EscapeString(rest[:size]))
+		i += size
+	}
+	return out.String()
+}
+
+// delimited finds the span that opens with delim at s[i] and closes with the
+// next delim. The span must not start or end with a space, and an
+// underscore must not sit inside a word, so snake_case stays as typed.
+func delimited(s string, i int, delim string) (inner string, length int, ok bool) {
+	if delim[0] == '_' && wordBefore(s, i) {
+		return "", 0, false
+	}
+
+	body := s[i+len(delim):]
+	end := strings.Index(body, delim)
+	if end <= 0 {
+		return "", 0, false
+	}
+
+	inner = body[:end]
+	if strings.TrimSpace(inner) != inner {
+		return "", 0, false
+	}
+	if delim[0] == '_' {
+		if r, _ := utf8.DecodeRuneInString(body[end+len(delim):]); isWordRune(r) {
+			return "", 0, false
+		}
+	}
+	return inner, len(delim)*2 + end, true
+}
+
+// link parses "[text](url)" at the start of s.
+func link(s string) (text, href string, length int, ok bool) {
+	closeText := strings.Index(s, "](")
+	if closeText <= 1 {
+		return "", "", 0, false
+	}
+	closeURL := strings.IndexByte(s[closeText+2:], ')')
+	if closeURL <= 0 {
+		return "", "", 0, false
+	}
+
+	href = s[closeText+2 : closeText+2+closeURL]
+	if strings.ContainsAny(href, " \t") || !safeURL(href) {
+		return "", "", 0, false
+	}
+	return s[1:closeText], href, closeText + 3 + closeURL, true
+}
+
+// bareURL takes a URL up to the next space, leaving off trailing
+// punctuation that more likely ends the sentence than the URL.
+func bareURL(s string) (string, int) {
+	end := strings.IndexFunc(s, func(r rune) bool { return unicode.IsSpace(r) || r == '<' })
+	if end < 0 {
+		end = len(s)
+	}
+	href := strings.TrimRight(s[:end], ".,;:!?)'\"")
+	if !safeURL(href) {
+		return "", 0
+	}
+	return href, len(href)
+}
+
+func safeURL(raw string) bool {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(parsed.Scheme) {
+	case "http", "https":
+		return parsed.Host != ""
+	case "mailto":
+		return parsed.Opaque != ""
+	}
+	return false
+}
+
+func anchor(href, text string) string {
+	return `<a href="` + html.EscapeString(href) + `" rel="` + linkRel + `">` + text + "</a>"
+}
+
+func wordBefore(s string, i int) bool {
+	r, _ := utf8.DecodeLastRuneInString(s[:i])
+	return isWordRune(r)
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,96 @@
+package media
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+const KindMessageAttachment = "message_attachment"
+
+var (
+	ErrInvalidToken = errors.New("invalid media token")
+	ErrExpiredToken = errors.New("media token expired")
+)
+
+// Claims identify one stored object and the viewer it was issued to. The
+// object key itself is never embedded: it is looked up again on access so
+// deletions and permission changes take effect immediately.
+type Claims struct {
+	ViewerID  uint   `json:"v"`
+	Kind      string `json:"k"`
+	ParentID  uint   `json:"p,omitempty"`
+	ID        uint   `json:"i"`
+	Thumbnail bool   `json:"t,omitempty"`
+	ExpiresAt int64  `json:"e"`
+}
+
+// Signer issues and verifies the opaque tokens behind /media/:token URLs.
+type Signer struct {
+	secret   []byte
+	ttl      time.Duration
+	basePath string
+}
+
+func NewSigner(secret string, ttl time.Duration, basePath string) *Signer {
+	return &Signer{
+		secret:   []byte(secret),
+		ttl:      ttl,
+		basePath: strings.TrimSuffix(basePath, "/"),
+	}
+}
+
+// URL returns the proxy path for claims, or "" when the token cannot be built.
+func (s *Signer) URL(claims Claims) string {
+	token, err := s.Sign(claims)
+	if err != nil {
+		return ""
+	}
+	return s.basePath + "/" + token
+}
+
+func (s *Signer) Sign(claims Claims) (string, error) {
+	claims.ExpiresAt = time.Now().Add(s.ttl).Unix()
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.mac(encoded)), nil
+}
+
+func (s *Signer) Verify(token string) (*Claims, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	expected, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, s.mac(encoded)) {
+		return nil, ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	var claims Claims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, ErrInvalidToken
+	}
+	if time.Now().Unix() > claims.ExpiresAt {
+		return nil, ErrExpiredToken
+	}
+	return &claims, nil
+}
+
+func (s *Signer) mac(data string) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
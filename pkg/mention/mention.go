@@ -0,0 +1,37 @@
+package mention
+
+import (
+	"regexp"
+	"strings"
+)
+
+// MaxPerContent caps how many people one post or comment can mention, so a
+// single piece of content cannot fan out an unbounded number of notifications.
+const MaxPerContent = 20
+
+// A mention starts after an @ that is not glued to a preceding word, so email
+// addresses like jane@example.com are skipped. The whole word is captured and
+// validated afterwards, which keeps "@jane_doe" from mentioning "jane".
+var pattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_@./])@([\p{L}\p{N}_]+)`)
+
+var username = regexp.MustCompile(`^[a-z0-9]{3,30}$`)
+
+// Extract returns the distinct, lowercased usernames mentioned in content in
+// order of first appearance, up to MaxPerContent.
+func Extract(content string) []string {
+	matches := pattern.FindAllStringSubmatch(content, -1)
+	seen := make(map[string]bool, len(matches))
+	usernames := make([]string, 0, len(matches))
+	for _, match := range matches {
+		name := strings.ToLower(match[1])
+		if !username.MatchString(name) || seen[name] {
+			continue
+		}
+		seen[name] = true
+		usernames = append(usernames, name)
+		if len(usernames) == MaxPerContent {
+			break
+		}
+	}
+	return usernames
+}
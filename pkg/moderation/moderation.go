@@ -0,0 +1,69 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+type Category string
+
+const (
+	CategoryNudity   Category = "nudity"
+	CategoryViolence Category = "violence"
+)
+
+var ErrImageRejected = errors.New("image rejected by moderation")
+
+var DefaultThresholds = map[Category]float64{
+	CategoryNudity:   0.8,
+	CategoryViolence: 0.8,
+}
+
+type Classifier interface {
+	Classify(ctx context.Context, data []byte) (map[Category]float64, error)
+}
+
+type ImageModerator interface {
+	Check(ctx context.Context, data []byte) error
+}
+
+type imageModerator struct {
+	classifier Classifier
+	thresholds map[Category]float64
+}
+
+func NewImageModerator(classifier Classifier, thresholds map[Category]float64) ImageModerator {
+	if thresholds == nil {
+		thresholds = DefaultThresholds
+	}
+	return &imageModerator{
+		classifier: classifier,
+		thresholds: thresholds,
+	}
+}
+
+func (m *imageModerator) Check(ctx context.Context, data []byte) error {
+	scores, err := m.classifier.Classify(ctx, data)
+	if err != nil {
+		return fmt.Errorf("failed to classify image: %w", err)
+	}
+
+	for category, threshold := range m.thresholds {
+		if score, ok := scores[category]; ok && score >= threshold {
+			return fmt.Errorf("%w: %s", ErrImageRejected, category)
+		}
+	}
+
+	return nil
+}
+
+type noopClassifier struct{}
+
+func NewNoopClassifier() Classifier {
+	return &noopClassifier{}
+}
+
+func (c *noopClassifier) Classify(ctx context.Context, data []byte) (map[Category]float64, error) {
+	return map[Category]float64{}, nil
+}
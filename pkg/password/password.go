@@ -0,0 +1,258 @@
+package password
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	_ "embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+//go:embed common_passwords.txt
+var defaultCommonPasswords string
+
+// bcrypt ignores everything past 72 bytes and newer versions refuse such
+// input outright, so no policy may allow longer passwords.
+const MaxBytes = 72
+
+// PolicyError is a password the policy refuses. Its message is meant for the
+// user.
+type PolicyError struct {
+	Reason string
+}
+
+func (e *PolicyError) Error() string {
+	return e.Reason
+}
+
+var (
+	ErrCommon   = &PolicyError{"this password is too common, please choose another"}
+	ErrPersonal = &PolicyError{"password must not contain your name, username or email"}
+	ErrBreached = &PolicyError{"this password has appeared in a data breach, please choose another"}
+)
+
+type Config struct {
+	MinLength           int
+	MaxLength           int
+	MinCharacterClasses int
+	BlockCommon         bool
+	CommonPasswordsFile string
+	BreachCheck         bool
+	BreachAPIURL        string
+	BreachTimeout       time.Duration
+	BreachThreshold     int
+}
+
+type Policy interface {
+	// Check validates password for an account described by personal, such as
+	// its email, username and full name. Errors that are not a PolicyError
+	// mean the breach check could not run; callers decide whether to go on.
+	Check(ctx context.Context, password string, personal ...string) error
+}
+
+type policy struct {
+	config     Config
+	common     map[string]struct{}
+	httpClient *http.Client
+}
+
+func NewPolicy(config Config) (Policy, error) {
+	if config.MaxLength <= 0 || config.MaxLength > MaxBytes {
+		config.MaxLength = MaxBytes
+	}
+	if config.MinLength > config.MaxLength {
+		return nil, fmt.Errorf("password minimum length %d exceeds maximum %d", config.MinLength, config.MaxLength)
+	}
+	if config.BreachThreshold <= 0 {
+		config.BreachThreshold = 1
+	}
+
+	common := make(map[string]struct{})
+	if config.BlockCommon {
+		if err := readPasswords(strings.NewReader(defaultCommonPasswords), common); err != nil {
+			return nil, fmt.Errorf("failed to load common passwords: %w", err)
+		}
+		if config.CommonPasswordsFile != "" {
+			file, err := os.Open(config.CommonPasswordsFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to open common passwords file: %w", err)
+			}
+			defer file.Close()
+			if err := readPasswords(file, common); err != nil {
+				return nil, fmt.Errorf("failed to load common passwords file: %w", err)
+			}
+		}
+	}
+
+	return &policy{
+		config:     config,
+		common:     common,
+		httpClient: &http.Client{Timeout: config.BreachTimeout},
+	}, nil
+}
+
+func (p *policy) Check(ctx context.Context, password string, personal ...string) error {
+	if length := utf8.RuneCountInString(password); length < p.config.MinLength {
+		return &PolicyError{fmt.Sprintf("password must be at least %d characters", p.config.MinLength)}
+	}
+	if len(password) > p.config.MaxLength {
+		return &PolicyError{fmt.Sprintf("password must be at most %d bytes", p.config.MaxLength)}
+	}
+
+	if classes := characterClasses(password); classes < p.config.MinCharacterClasses {
+		return &PolicyError{fmt.Sprintf("password must mix at least %d of: lowercase letters, uppercase letters, digits and symbols", p.config.MinCharacterClasses)}
+	}
+
+	if p.isCommon(password) {
+		return ErrCommon
+	}
+
+	lowered := strings.ToLower(password)
+	for _, value := range personalTokens(personal) {
+		if strings.Contains(lowered, value) {
+			return ErrPersonal
+		}
+	}
+
+	if p.config.BreachCheck {
+		count, err := p.breachCount(ctx, password)
+		if err != nil {
+			return err
+		}
+		if count >= p.config.BreachThreshold {
+			return ErrBreached
+		}
+	}
+
+	return nil
+}
+
+func (p *policy) isCommon(password string) bool {
+	if len(p.common) == 0 {
+		return false
+	}
+
+	lowered := strings.ToLower(password)
+	if _, ok := p.common[lowered]; ok {
+		return true
+	}
+	base := strings.TrimRightFunc(lowered, func(r rune) bool {
+		return unicode.IsDigit(r) || unicode.IsPunct(r) || unicode.IsSymbol(r)
+	})
+	_, ok := p.common[base]
+	return ok
+}
+
+// breachCount asks the Pwned Passwords range API how often password appears
+// in known breaches. Only the first five hex digits of its SHA-1 hash leave
+// the server, and padding hides how many suffixes share the prefix.
+func (p *policy) breachCount(ctx context.Context, password string) (int, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:5], hash[5:]
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.config.BreachAPIURL+prefix, nil)
+	if err != nil {
+		return 0, fmt.Errorf("breach check failed: %w", err)
+	}
+	req.Header.Set("Add-Padding", "true")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("breach check failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("breach check failed: status %d", resp.StatusCode)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		candidate, count, ok := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !ok || !strings.EqualFold(candidate, suffix) {
+			continue
+		}
+		n, err := strconv.Atoi(count)
+		if err != nil {
+			return 0, fmt.Errorf("breach check failed: %w", err)
+		}
+		return n, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("breach check failed: %w", err)
+	}
+	return 0, nil
+}
+
+func IsRejected(err error) bool {
+	var policyErr *PolicyError
+	return errors.As(err, &policyErr)
+}
+
+func characterClasses(password string) int {
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLetter(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	classes := 0
+	for _, present := range []bool{lower, upper, digit, symbol} {
+		if present {
+			classes++
+		}
+	}
+	return classes
+}
+
+// personalTokens splits the account's details into the parts worth looking
+// for in a password: the email's local part and the words of the name.
+// Parts shorter than four characters match too much to be useful.
+func personalTokens(personal []string) []string {
+	var tokens []string
+	for _, value := range personal {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if at := strings.LastIndex(value, "@"); at >= 0 {
+			value = value[:at]
+		}
+		for _, token := range strings.FieldsFunc(value, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}) {
+			if utf8.RuneCountInString(token) >= 4 {
+				tokens = append(tokens, token)
+			}
+		}
+	}
+	return tokens
+}
+
+func readPasswords(r io.Reader, into map[string]struct{}) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.ToLower(strings.TrimSpace(scanner.Text()))
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		into[line] = struct{}{}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,134 @@
+package presence
+
+import (
+	"context"
+	"fmt"
+	"linked-clone/pkg/redis"
+	"strconv"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+type Status string
+
+const (
+	StatusOnline         Status = "online"
+	StatusRecentlyActive Status = "recently_active"
+	StatusOffline        Status = "offline"
+	StatusHidden         Status = "hidden"
+
+	OnlineWindow = 5 * time.Minute
+	RecentWindow = 24 * time.Hour
+
+	touchInterval = time.Minute
+	lastActiveTTL = 30 * 24 * time.Hour
+	dirtyKey      = "presence:dirty"
+)
+
+type Tracker interface {
+	Touch(ctx context.Context, userID uint) error
+	LastActive(ctx context.Context, userID uint) (*time.Time, error)
+	Resolve(ctx context.Context, userID uint, stored *time.Time) (Status, *time.Time)
+	Drain(ctx context.Context) (map[uint]time.Time, error)
+}
+
+type redisTracker struct {
+	redisClient redis.RedisClient
+	online      func(userID uint) bool
+}
+
+func NewRedisTracker(redisClient redis.RedisClient, online func(userID uint) bool) Tracker {
+	return &redisTracker{redisClient: redisClient, online: online}
+}
+
+func (t *redisTracker) Touch(ctx context.Context, userID uint) error {
+	fresh, err := t.redisClient.SetNX(ctx, touchKey(userID), 1, touchInterval)
+	if err != nil || !fresh {
+		return err
+	}
+
+	if err := t.redisClient.Set(ctx, lastActiveKey(userID), time.Now().Unix(), lastActiveTTL); err != nil {
+		return err
+	}
+	return t.redisClient.SAdd(ctx, dirtyKey, userID)
+}
+
+func (t *redisTracker) LastActive(ctx context.Context, userID uint) (*time.Time, error) {
+	raw, err := t.redisClient.Get(ctx, lastActiveKey(userID))
+	if err != nil {
+		if err == goredis.Nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	seconds, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid last active value %q: %w", raw, err)
+	}
+	lastActive := time.Unix(seconds, 0).UTC()
+	return &lastActive, nil
+}
+
+func (t *redisTracker) Resolve(ctx context.Context, userID uint, stored *time.Time) (Status, *time.Time) {
+	lastActive := stored
+	if cached, err := t.LastActive(ctx, userID); err == nil && cached != nil {
+		if lastActive == nil || cached.After(*lastActive) {
+			lastActive = cached
+		}
+	}
+
+	if t.online != nil && t.online(userID) {
+		return StatusOnline, lastActive
+	}
+	return statusFor(lastActive, time.Now()), lastActive
+}
+
+func (t *redisTracker) Drain(ctx context.Context) (map[uint]time.Time, error) {
+	members, err := t.redisClient.SMembers(ctx, dirtyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	drained := make(map[uint]time.Time, len(members))
+	for _, member := range members {
+		id, err := strconv.ParseUint(member, 10, 32)
+		if err != nil {
+			continue
+		}
+
+		lastActive, err := t.LastActive(ctx, uint(id))
+		if err != nil {
+			return drained, err
+		}
+		if err := t.redisClient.SRem(ctx, dirtyKey, member); err != nil {
+			return drained, err
+		}
+		if lastActive != nil {
+			drained[uint(id)] = *lastActive
+		}
+	}
+	return drained, nil
+}
+
+func statusFor(lastActive *time.Time, now time.Time) Status {
+	switch {
+	case lastActive == nil:
+		return StatusOffline
+	case now.Sub(*lastActive) <= OnlineWindow:
+		return StatusOnline
+	case now.Sub(*lastActive) <= RecentWindow:
+		return StatusRecentlyActive
+	default:
+		return StatusOffline
+	}
+}
+
+func touchKey(userID uint) string {
+	return fmt.Sprintf("presence:touch:%d", userID)
+}
+
+func lastActiveKey(userID uint) string {
+	return fmt.Sprintf("presence:last_active:%d", userID)
+}
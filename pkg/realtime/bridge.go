@@ -0,0 +1,24 @@
+package realtime
+
+import (
+	"context"
+	"linked-clone/pkg/eventbus"
+)
+
+// Forward subscribes the hub to the given bus event types and pushes each
+// event to its recipient's sockets, on whichever instance they are connected.
+// Delivery runs in its own goroutine since it may publish to remote instances.
+func Forward(bus eventbus.Bus, hub Hub, eventTypes ...string) {
+	for _, eventType := range eventTypes {
+		bus.Subscribe(eventType, func(ctx context.Context, event *eventbus.Event) {
+			if event.RecipientID == 0 || event.RecipientID == event.ActorID {
+				return
+			}
+			go hub.SendToUser(event.RecipientID, &Event{
+				Type:      event.Type,
+				Data:      event.Data,
+				Timestamp: event.OccurredAt,
+			})
+		})
+	}
+}
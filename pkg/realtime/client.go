@@ -0,0 +1,120 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	writeWait      = 10 * time.Second
+	pongWait       = 60 * time.Second
+	pingPeriod     = (pongWait * 9) / 10
+	maxMessageSize = 4096
+	sendBufferSize = 64
+)
+
+type inboundFrame struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+type Client struct {
+	hub    Hub
+	conn   *websocket.Conn
+	userID uint
+	send   chan []byte
+	once   sync.Once
+}
+
+func NewClient(hub Hub, conn *websocket.Conn, userID uint) *Client {
+	return &Client{
+		hub:    hub,
+		conn:   conn,
+		userID: userID,
+		send:   make(chan []byte, sendBufferSize),
+	}
+}
+
+func (c *Client) UserID() uint {
+	return c.userID
+}
+
+func (c *Client) Serve(ctx context.Context) {
+	c.hub.Register(c)
+	go c.writePump()
+	c.readPump(ctx)
+}
+
+func (c *Client) enqueue(payload []byte) bool {
+	select {
+	case c.send <- payload:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *Client) close() {
+	c.once.Do(func() {
+		close(c.send)
+	})
+}
+
+func (c *Client) readPump(ctx context.Context) {
+	defer func() {
+		c.hub.Unregister(c)
+		c.conn.Close()
+	}()
+
+	c.conn.SetReadLimit(maxMessageSize)
+	c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		c.conn.SetReadDeadline(time.Now().Add(pongWait))
+		return nil
+	})
+
+	for {
+		_, message, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var frame inboundFrame
+		if err := json.Unmarshal(message, &frame); err != nil || frame.Type == "" {
+			continue
+		}
+
+		c.hub.Dispatch(ctx, c.userID, frame.Type, frame.Data)
+	}
+}
+
+func (c *Client) writePump() {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+	}()
+
+	for {
+		select {
+		case message, ok := <-c.send:
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if !ok {
+				c.conn.WriteMessage(websocket.CloseMessage, []byte{})
+				return
+			}
+			if err := c.conn.WriteMessage(websocket.TextMessage, message); err != nil {
+				return
+			}
+		case <-ticker.C:
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
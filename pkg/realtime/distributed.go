@@ -0,0 +1,204 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"linked-clone/pkg/redis"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	routeTTL       = 5 * time.Minute
+	routeOpTimeout = 2 * time.Second
+)
+
+type Relay interface {
+	Listen(ctx context.Context) error
+	RefreshRoutes(ctx context.Context) error
+	GetMetrics() map[string]interface{}
+}
+
+type envelope struct {
+	UserID  uint            `json:"user_id"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// DistributedHub delivers to local connections directly and relays events for
+// users connected to other instances over Redis pub/sub. Each instance records
+// itself in a per-user route set while it holds at least one of that user's
+// connections and listens on its own channel.
+type DistributedHub struct {
+	*hub
+	redisClient redis.RedisClient
+	instanceID  string
+
+	published   int64
+	received    int64
+	staleRoutes int64
+	routeErrors int64
+}
+
+func NewDistributedHub(redisClient redis.RedisClient, instanceID string) *DistributedHub {
+	return &DistributedHub{
+		hub:         newHub(),
+		redisClient: redisClient,
+		instanceID:  instanceID,
+	}
+}
+
+func (h *DistributedHub) Register(client *Client) {
+	first := !h.hub.IsOnline(client.userID)
+	h.hub.Register(client)
+	if !first {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), routeOpTimeout)
+	defer cancel()
+	if err := h.addRoute(ctx, client.userID); err != nil {
+		atomic.AddInt64(&h.routeErrors, 1)
+	}
+}
+
+func (h *DistributedHub) Unregister(client *Client) {
+	h.hub.Unregister(client)
+	if h.hub.IsOnline(client.userID) {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), routeOpTimeout)
+	defer cancel()
+	if err := h.redisClient.SRem(ctx, routeKey(client.userID), h.instanceID); err != nil {
+		atomic.AddInt64(&h.routeErrors, 1)
+	}
+}
+
+func (h *DistributedHub) SendToUser(userID uint, event *Event) bool {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return false
+	}
+	delivered := h.hub.deliver(userID, payload)
+
+	ctx, cancel := context.WithTimeout(context.Background(), routeOpTimeout)
+	defer cancel()
+
+	instances, err := h.remoteInstances(ctx, userID)
+	if err != nil || len(instances) == 0 {
+		return delivered
+	}
+
+	message, err := json.Marshal(&envelope{UserID: userID, Payload: payload})
+	if err != nil {
+		return delivered
+	}
+
+	for _, instanceID := range instances {
+		receivers, err := h.redisClient.Publish(ctx, instanceChannel(instanceID), message)
+		if err != nil {
+			atomic.AddInt64(&h.routeErrors, 1)
+			continue
+		}
+		if receivers == 0 {
+			atomic.AddInt64(&h.staleRoutes, 1)
+			h.redisClient.SRem(ctx, routeKey(userID), instanceID)
+			continue
+		}
+		atomic.AddInt64(&h.published, 1)
+		delivered = true
+	}
+	return delivered
+}
+
+func (h *DistributedHub) IsOnline(userID uint) bool {
+	if h.hub.IsOnline(userID) {
+		return true
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), routeOpTimeout)
+	defer cancel()
+
+	instances, err := h.remoteInstances(ctx, userID)
+	return err == nil && len(instances) > 0
+}
+
+func (h *DistributedHub) Listen(ctx context.Context) error {
+	return h.redisClient.Subscribe(ctx, instanceChannel(h.instanceID), func(message string) {
+		var env envelope
+		if err := json.Unmarshal([]byte(message), &env); err != nil {
+			return
+		}
+		atomic.AddInt64(&h.received, 1)
+		h.hub.deliver(env.UserID, env.Payload)
+	})
+}
+
+func (h *DistributedHub) RefreshRoutes(ctx context.Context) error {
+	for _, userID := range h.localUsers() {
+		if err := h.addRoute(ctx, userID); err != nil {
+			atomic.AddInt64(&h.routeErrors, 1)
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *DistributedHub) GetMetrics() map[string]interface{} {
+	return map[string]interface{}{
+		"instance_id":  h.instanceID,
+		"local_users":  len(h.localUsers()),
+		"published":    atomic.LoadInt64(&h.published),
+		"received":     atomic.LoadInt64(&h.received),
+		"stale_routes": atomic.LoadInt64(&h.staleRoutes),
+		"route_errors": atomic.LoadInt64(&h.routeErrors),
+	}
+}
+
+func (h *DistributedHub) addRoute(ctx context.Context, userID uint) error {
+	key := routeKey(userID)
+	if err := h.redisClient.SAdd(ctx, key, h.instanceID); err != nil {
+		return err
+	}
+	return h.redisClient.Expire(ctx, key, routeTTL)
+}
+
+func (h *DistributedHub) remoteInstances(ctx context.Context, userID uint) ([]string, error) {
+	members, err := h.redisClient.SMembers(ctx, routeKey(userID))
+	if err != nil {
+		atomic.AddInt64(&h.routeErrors, 1)
+		return nil, err
+	}
+
+	instances := make([]string, 0, len(members))
+	for _, instanceID := range members {
+		if instanceID != h.instanceID {
+			instances = append(instances, instanceID)
+		}
+	}
+	return instances, nil
+}
+
+func (h *DistributedHub) localUsers() []uint {
+	h.hub.mu.RLock()
+	defer h.hub.mu.RUnlock()
+
+	users := make([]uint, 0, len(h.hub.clients))
+	for userID := range h.hub.clients {
+		users = append(users, userID)
+	}
+	return users
+}
+
+func routeKey(userID uint) string {
+	return fmt.Sprintf("realtime:routes:%d", userID)
+}
+
+func instanceChannel(instanceID string) string {
+	return fmt.Sprintf("realtime:instance:%s", instanceID)
+}
@@ -0,0 +1,20 @@
+package realtime
+
+import "linked-clone/pkg/eventbus"
+
+const (
+	EventMessageNew       = "message.new"
+	EventMessageDelivered = "message.delivered"
+	EventMessageRead      = "message.read"
+	EventTyping           = "typing"
+
+	EventConnectionRequest  = eventbus.ConnectionRequested
+	EventConnectionAccepted = eventbus.ConnectionAccepted
+	EventPostLiked          = eventbus.PostLiked
+	EventPostCommented      = eventbus.PostCommented
+	EventPostShared         = eventbus.PostShared
+	EventCommentReplied     = eventbus.CommentReplied
+	EventCommentLiked       = eventbus.CommentLiked
+	EventPostMentioned      = eventbus.PostMentioned
+	EventCommentMentioned   = eventbus.CommentMentioned
+)
@@ -0,0 +1,139 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type Event struct {
+	Type      string      `json:"type"`
+	Data      interface{} `json:"data,omitempty"`
+	Timestamp time.Time   `json:"timestamp"`
+}
+
+type InboundHandler func(ctx context.Context, userID uint, data json.RawMessage)
+
+type Hub interface {
+	Register(client *Client)
+	Unregister(client *Client)
+	SendToUser(userID uint, event *Event) bool
+	IsOnline(userID uint) bool
+	HandleFunc(eventType string, handler InboundHandler)
+	Dispatch(ctx context.Context, userID uint, eventType string, data json.RawMessage)
+	QueueDepth() int64
+	DeadLetterCount() int64
+}
+
+type hub struct {
+	mu       sync.RWMutex
+	clients  map[uint]map[*Client]struct{}
+	handlers map[string]InboundHandler
+	dropped  int64
+}
+
+func NewHub() Hub {
+	return newHub()
+}
+
+func newHub() *hub {
+	return &hub{
+		clients:  make(map[uint]map[*Client]struct{}),
+		handlers: make(map[string]InboundHandler),
+	}
+}
+
+func (h *hub) Register(client *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.clients[client.userID]; !ok {
+		h.clients[client.userID] = make(map[*Client]struct{})
+	}
+	h.clients[client.userID][client] = struct{}{}
+}
+
+func (h *hub) Unregister(client *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	clients, ok := h.clients[client.userID]
+	if !ok {
+		return
+	}
+	if _, ok := clients[client]; ok {
+		delete(clients, client)
+		client.close()
+	}
+	if len(clients) == 0 {
+		delete(h.clients, client.userID)
+	}
+}
+
+func (h *hub) SendToUser(userID uint, event *Event) bool {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return false
+	}
+	return h.deliver(userID, payload)
+}
+
+func (h *hub) deliver(userID uint, payload []byte) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	delivered := false
+	for client := range h.clients[userID] {
+		if client.enqueue(payload) {
+			delivered = true
+		} else {
+			atomic.AddInt64(&h.dropped, 1)
+		}
+	}
+	return delivered
+}
+
+func (h *hub) IsOnline(userID uint) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.clients[userID]) > 0
+}
+
+func (h *hub) QueueDepth() int64 {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	var depth int64
+	for _, clients := range h.clients {
+		for client := range clients {
+			depth += int64(len(client.send))
+		}
+	}
+	return depth
+}
+
+func (h *hub) DeadLetterCount() int64 {
+	return atomic.LoadInt64(&h.dropped)
+}
+
+func (h *hub) HandleFunc(eventType string, handler InboundHandler) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.handlers[eventType] = handler
+}
+
+func (h *hub) Dispatch(ctx context.Context, userID uint, eventType string, data json.RawMessage) {
+	h.mu.RLock()
+	handler, ok := h.handlers[eventType]
+	h.mu.RUnlock()
+
+	if ok {
+		handler(ctx, userID, data)
+	}
+}
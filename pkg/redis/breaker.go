@@ -0,0 +1,223 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"linked-clone/pkg/breaker"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type breakerClient struct {
+	next RedisClient
+	cb   *breaker.CircuitBreaker
+}
+
+func NewCircuitBreakerClient(next RedisClient, cb *breaker.CircuitBreaker) RedisClient {
+	return &breakerClient{next: next, cb: cb}
+}
+
+func IsFailure(err error) bool {
+	return !errors.Is(err, redis.Nil) && breaker.DefaultIsFailure(err)
+}
+
+func (r *breakerClient) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	return r.cb.Execute(func() error {
+		return r.next.Set(ctx, key, value, expiration)
+	})
+}
+
+func (r *breakerClient) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	var ok bool
+	err := r.cb.Execute(func() error {
+		var err error
+		ok, err = r.next.SetNX(ctx, key, value, expiration)
+		return err
+	})
+	return ok, err
+}
+
+func (r *breakerClient) Get(ctx context.Context, key string) (string, error) {
+	var value string
+	err := r.cb.Execute(func() error {
+		var err error
+		value, err = r.next.Get(ctx, key)
+		return err
+	})
+	return value, err
+}
+
+func (r *breakerClient) Delete(ctx context.Context, key string) error {
+	return r.cb.Execute(func() error {
+		return r.next.Delete(ctx, key)
+	})
+}
+
+func (r *breakerClient) Exists(ctx context.Context, key string) (bool, error) {
+	var exists bool
+	err := r.cb.Execute(func() error {
+		var err error
+		exists, err = r.next.Exists(ctx, key)
+		return err
+	})
+	return exists, err
+}
+
+func (r *breakerClient) IncrBy(ctx context.Context, key string, value int64) (int64, error) {
+	var result int64
+	err := r.cb.Execute(func() error {
+		var err error
+		result, err = r.next.IncrBy(ctx, key, value)
+		return err
+	})
+	return result, err
+}
+
+func (r *breakerClient) DecrBy(ctx context.Context, key string, value int64) (int64, error) {
+	var result int64
+	err := r.cb.Execute(func() error {
+		var err error
+		result, err = r.next.DecrBy(ctx, key, value)
+		return err
+	})
+	return result, err
+}
+
+func (r *breakerClient) Keys(ctx context.Context, pattern string) ([]string, error) {
+	var keys []string
+	err := r.cb.Execute(func() error {
+		var err error
+		keys, err = r.next.Keys(ctx, pattern)
+		return err
+	})
+	return keys, err
+}
+
+func (r *breakerClient) Expire(ctx context.Context, key string, expiration time.Duration) error {
+	return r.cb.Execute(func() error {
+		return r.next.Expire(ctx, key, expiration)
+	})
+}
+
+func (r *breakerClient) PFAdd(ctx context.Context, key string, elements ...interface{}) error {
+	return r.cb.Execute(func() error {
+		return r.next.PFAdd(ctx, key, elements...)
+	})
+}
+
+func (r *breakerClient) PFCount(ctx context.Context, keys ...string) (int64, error) {
+	var count int64
+	err := r.cb.Execute(func() error {
+		var err error
+		count, err = r.next.PFCount(ctx, keys...)
+		return err
+	})
+	return count, err
+}
+
+func (r *breakerClient) LPush(ctx context.Context, key string, values ...interface{}) error {
+	return r.cb.Execute(func() error {
+		return r.next.LPush(ctx, key, values...)
+	})
+}
+
+func (r *breakerClient) RPush(ctx context.Context, key string, values ...interface{}) error {
+	return r.cb.Execute(func() error {
+		return r.next.RPush(ctx, key, values...)
+	})
+}
+
+func (r *breakerClient) LTrim(ctx context.Context, key string, start, stop int64) error {
+	return r.cb.Execute(func() error {
+		return r.next.LTrim(ctx, key, start, stop)
+	})
+}
+
+func (r *breakerClient) LRange(ctx context.Context, key string, start, stop int64) ([]string, error) {
+	var values []string
+	err := r.cb.Execute(func() error {
+		var err error
+		values, err = r.next.LRange(ctx, key, start, stop)
+		return err
+	})
+	return values, err
+}
+
+func (r *breakerClient) SAdd(ctx context.Context, key string, members ...interface{}) error {
+	return r.cb.Execute(func() error {
+		return r.next.SAdd(ctx, key, members...)
+	})
+}
+
+func (r *breakerClient) SRem(ctx context.Context, key string, members ...interface{}) error {
+	return r.cb.Execute(func() error {
+		return r.next.SRem(ctx, key, members...)
+	})
+}
+
+func (r *breakerClient) SMembers(ctx context.Context, key string) ([]string, error) {
+	var members []string
+	err := r.cb.Execute(func() error {
+		var err error
+		members, err = r.next.SMembers(ctx, key)
+		return err
+	})
+	return members, err
+}
+
+func (r *breakerClient) SIsMember(ctx context.Context, key string, member interface{}) (bool, error) {
+	var ok bool
+	err := r.cb.Execute(func() error {
+		var err error
+		ok, err = r.next.SIsMember(ctx, key, member)
+		return err
+	})
+	return ok, err
+}
+
+func (r *breakerClient) SInter(ctx context.Context, keys ...string) ([]string, error) {
+	var members []string
+	err := r.cb.Execute(func() error {
+		var err error
+		members, err = r.next.SInter(ctx, keys...)
+		return err
+	})
+	return members, err
+}
+
+func (r *breakerClient) SUnion(ctx context.Context, keys ...string) ([]string, error) {
+	var members []string
+	err := r.cb.Execute(func() error {
+		var err error
+		members, err = r.next.SUnion(ctx, keys...)
+		return err
+	})
+	return members, err
+}
+
+func (r *breakerClient) Publish(ctx context.Context, channel string, message interface{}) (int64, error) {
+	var receivers int64
+	err := r.cb.Execute(func() error {
+		var err error
+		receivers, err = r.next.Publish(ctx, channel, message)
+		return err
+	})
+	return receivers, err
+}
+
+func (r *breakerClient) Subscribe(ctx context.Context, channel string, handler func(payload string)) error {
+	if err := r.cb.Execute(func() error {
+		return r.next.Ping(ctx)
+	}); err != nil {
+		return err
+	}
+	return r.next.Subscribe(ctx, channel, handler)
+}
+
+func (r *breakerClient) Ping(ctx context.Context) error {
+	return r.cb.Execute(func() error {
+		return r.next.Ping(ctx)
+	})
+}
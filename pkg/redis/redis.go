@@ -2,45 +2,397 @@ package redis
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/redis/go-redis/v9"
 )
 
+const (
+	ModeStandalone = "standalone"
+	ModeCluster    = "cluster"
+	ModeSentinel   = "sentinel"
+)
+
 type RedisClient interface {
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
+	SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error)
 	Get(ctx context.Context, key string) (string, error)
 	Delete(ctx context.Context, key string) error
 	Exists(ctx context.Context, key string) (bool, error)
+	IncrBy(ctx context.Context, key string, value int64) (int64, error)
+	DecrBy(ctx context.Context, key string, value int64) (int64, error)
+	Keys(ctx context.Context, pattern string) ([]string, error)
+	Expire(ctx context.Context, key string, expiration time.Duration) error
+	PFAdd(ctx context.Context, key string, elements ...interface{}) error
+	PFCount(ctx context.Context, keys ...string) (int64, error)
+	LPush(ctx context.Context, key string, values ...interface{}) error
+	RPush(ctx context.Context, key string, values ...interface{}) error
+	LTrim(ctx context.Context, key string, start, stop int64) error
+	LRange(ctx context.Context, key string, start, stop int64) ([]string, error)
+	SAdd(ctx context.Context, key string, members ...interface{}) error
+	SRem(ctx context.Context, key string, members ...interface{}) error
+	SMembers(ctx context.Context, key string) ([]string, error)
+	SIsMember(ctx context.Context, key string, member interface{}) (bool, error)
+	SInter(ctx context.Context, keys ...string) ([]string, error)
+	SUnion(ctx context.Context, keys ...string) ([]string, error)
+	Publish(ctx context.Context, channel string, message interface{}) (int64, error)
+	Subscribe(ctx context.Context, channel string, handler func(payload string)) error
+	Ping(ctx context.Context) error
+}
+
+type Config struct {
+	Mode             string
+	Host             string
+	Port             string
+	Addrs            []string
+	MasterName       string
+	Password         string
+	SentinelPassword string
+	DB               int
+	PoolSize         int
+	MinIdleConns     int
+	DialTimeout      time.Duration
+	ReadTimeout      time.Duration
+	WriteTimeout     time.Duration
+	OperationTimeout time.Duration
+	TLSEnabled       bool
+	TLSSkipVerify    bool
 }
 
 type redisClient struct {
-	client *redis.Client
+	client           redis.UniversalClient
+	operationTimeout time.Duration
 }
 
-func NewRedisClient(host, port, password string, db int) RedisClient {
-	rdb := redis.NewClient(&redis.Options{
-		Addr:     host + ":" + port,
-		Password: password,
-		DB:       db,
-	})
+func NewRedisClient(cfg Config) (RedisClient, error) {
+	if cfg.Mode == "" {
+		cfg.Mode = ModeStandalone
+	}
+	addrs := cfg.Addrs
+	if len(addrs) == 0 && cfg.Host != "" && cfg.Port != "" {
+		addrs = []string{cfg.Host + ":" + cfg.Port}
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("redis host and port are required")
+	}
+	if cfg.DB < 0 {
+		return nil, fmt.Errorf("invalid redis database index: %d", cfg.DB)
+	}
+	if cfg.PoolSize < 0 || cfg.MinIdleConns < 0 {
+		return nil, fmt.Errorf("invalid redis pool size: %d (min idle %d)", cfg.PoolSize, cfg.MinIdleConns)
+	}
+
+	var tlsConfig *tls.Config
+	if cfg.TLSEnabled {
+		tlsConfig = &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: cfg.TLSSkipVerify,
+		}
+	}
 
-	return &redisClient{client: rdb}
+	var client redis.UniversalClient
+	switch cfg.Mode {
+	case ModeStandalone:
+		client = redis.NewClient(&redis.Options{
+			Addr:         addrs[0],
+			Password:     cfg.Password,
+			DB:           cfg.DB,
+			PoolSize:     cfg.PoolSize,
+			MinIdleConns: cfg.MinIdleConns,
+			DialTimeout:  cfg.DialTimeout,
+			ReadTimeout:  cfg.ReadTimeout,
+			WriteTimeout: cfg.WriteTimeout,
+			TLSConfig:    tlsConfig,
+		})
+	case ModeCluster:
+		if cfg.DB != 0 {
+			return nil, fmt.Errorf("redis cluster mode does not support database index %d", cfg.DB)
+		}
+		client = redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:        addrs,
+			Password:     cfg.Password,
+			PoolSize:     cfg.PoolSize,
+			MinIdleConns: cfg.MinIdleConns,
+			DialTimeout:  cfg.DialTimeout,
+			ReadTimeout:  cfg.ReadTimeout,
+			WriteTimeout: cfg.WriteTimeout,
+			TLSConfig:    tlsConfig,
+		})
+	case ModeSentinel:
+		if cfg.MasterName == "" {
+			return nil, fmt.Errorf("redis sentinel mode requires a master name")
+		}
+		client = redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:       cfg.MasterName,
+			SentinelAddrs:    addrs,
+			SentinelPassword: cfg.SentinelPassword,
+			Password:         cfg.Password,
+			DB:               cfg.DB,
+			PoolSize:         cfg.PoolSize,
+			MinIdleConns:     cfg.MinIdleConns,
+			DialTimeout:      cfg.DialTimeout,
+			ReadTimeout:      cfg.ReadTimeout,
+			WriteTimeout:     cfg.WriteTimeout,
+			TLSConfig:        tlsConfig,
+		})
+	default:
+		return nil, fmt.Errorf("invalid redis mode: %s", cfg.Mode)
+	}
+
+	return &redisClient{client: client, operationTimeout: cfg.OperationTimeout}, nil
 }
 
 func (r *redisClient) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
-	return r.client.Set(ctx, key, value, expiration).Err()
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	return wrap("set", key, r.client.Set(ctx, key, value, expiration).Err())
+}
+
+func (r *redisClient) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	result, err := r.client.SetNX(ctx, key, value, expiration).Result()
+	return result, wrap("setnx", key, err)
 }
 
 func (r *redisClient) Get(ctx context.Context, key string) (string, error) {
-	return r.client.Get(ctx, key).Result()
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	result, err := r.client.Get(ctx, key).Result()
+	return result, wrap("get", key, err)
 }
 
 func (r *redisClient) Delete(ctx context.Context, key string) error {
-	return r.client.Del(ctx, key).Err()
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	return wrap("delete", key, r.client.Del(ctx, key).Err())
 }
 
 func (r *redisClient) Exists(ctx context.Context, key string) (bool, error) {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
 	result, err := r.client.Exists(ctx, key).Result()
-	return result > 0, err
+	return result > 0, wrap("exists", key, err)
+}
+
+func (r *redisClient) IncrBy(ctx context.Context, key string, value int64) (int64, error) {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	result, err := r.client.IncrBy(ctx, key, value).Result()
+	return result, wrap("incrby", key, err)
+}
+
+func (r *redisClient) DecrBy(ctx context.Context, key string, value int64) (int64, error) {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	result, err := r.client.DecrBy(ctx, key, value).Result()
+	return result, wrap("decrby", key, err)
+}
+
+func (r *redisClient) Keys(ctx context.Context, pattern string) ([]string, error) {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	scan := func(ctx context.Context, client *redis.Client) ([]string, error) {
+		var keys []string
+		iter := client.Scan(ctx, 0, pattern, 100).Iterator()
+		for iter.Next(ctx) {
+			keys = append(keys, iter.Val())
+		}
+		return keys, iter.Err()
+	}
+
+	cluster, ok := r.client.(*redis.ClusterClient)
+	if !ok {
+		keys, err := scan(ctx, r.client.(*redis.Client))
+		return keys, wrap("keys", pattern, err)
+	}
+
+	var (
+		mu   sync.Mutex
+		keys []string
+	)
+	err := cluster.ForEachMaster(ctx, func(ctx context.Context, node *redis.Client) error {
+		nodeKeys, err := scan(ctx, node)
+		mu.Lock()
+		keys = append(keys, nodeKeys...)
+		mu.Unlock()
+		return err
+	})
+	return keys, wrap("keys", pattern, err)
+}
+
+func (r *redisClient) Expire(ctx context.Context, key string, expiration time.Duration) error {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	return wrap("expire", key, r.client.Expire(ctx, key, expiration).Err())
+}
+
+func (r *redisClient) PFAdd(ctx context.Context, key string, elements ...interface{}) error {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	return wrap("pfadd", key, r.client.PFAdd(ctx, key, elements...).Err())
+}
+
+func (r *redisClient) PFCount(ctx context.Context, keys ...string) (int64, error) {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	result, err := r.client.PFCount(ctx, keys...).Result()
+	return result, wrap("pfcount", keyList(keys), err)
+}
+
+func (r *redisClient) LPush(ctx context.Context, key string, values ...interface{}) error {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	return wrap("lpush", key, r.client.LPush(ctx, key, values...).Err())
+}
+
+func (r *redisClient) RPush(ctx context.Context, key string, values ...interface{}) error {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	return wrap("rpush", key, r.client.RPush(ctx, key, values...).Err())
+}
+
+func (r *redisClient) LTrim(ctx context.Context, key string, start, stop int64) error {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	return wrap("ltrim", key, r.client.LTrim(ctx, key, start, stop).Err())
+}
+
+func (r *redisClient) LRange(ctx context.Context, key string, start, stop int64) ([]string, error) {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	result, err := r.client.LRange(ctx, key, start, stop).Result()
+	return result, wrap("lrange", key, err)
+}
+
+func (r *redisClient) SAdd(ctx context.Context, key string, members ...interface{}) error {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	return wrap("sadd", key, r.client.SAdd(ctx, key, members...).Err())
+}
+
+func (r *redisClient) SRem(ctx context.Context, key string, members ...interface{}) error {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	return wrap("srem", key, r.client.SRem(ctx, key, members...).Err())
+}
+
+func (r *redisClient) SMembers(ctx context.Context, key string) ([]string, error) {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	result, err := r.client.SMembers(ctx, key).Result()
+	return result, wrap("smembers", key, err)
+}
+
+func (r *redisClient) SIsMember(ctx context.Context, key string, member interface{}) (bool, error) {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	result, err := r.client.SIsMember(ctx, key, member).Result()
+	return result, wrap("sismember", key, err)
+}
+
+func (r *redisClient) SInter(ctx context.Context, keys ...string) ([]string, error) {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	result, err := r.client.SInter(ctx, keys...).Result()
+	return result, wrap("sinter", keyList(keys), err)
+}
+
+func (r *redisClient) SUnion(ctx context.Context, keys ...string) ([]string, error) {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	result, err := r.client.SUnion(ctx, keys...).Result()
+	return result, wrap("sunion", keyList(keys), err)
+}
+
+func (r *redisClient) Publish(ctx context.Context, channel string, message interface{}) (int64, error) {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	result, err := r.client.Publish(ctx, channel, message).Result()
+	return result, wrap("publish", channel, err)
+}
+
+// Subscribe blocks delivering messages to handler until ctx is cancelled or the
+// subscription fails; it is not bound by the operation timeout.
+func (r *redisClient) Subscribe(ctx context.Context, channel string, handler func(payload string)) error {
+	sub := r.client.Subscribe(ctx, channel)
+	defer sub.Close()
+
+	if _, err := sub.Receive(ctx); err != nil {
+		return wrap("subscribe", channel, err)
+	}
+
+	messages := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-messages:
+			if !ok {
+				return wrap("subscribe", channel, errors.New("subscription closed"))
+			}
+			handler(msg.Payload)
+		}
+	}
+}
+
+func (r *redisClient) Ping(ctx context.Context) error {
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	return wrap("ping", "", r.client.Ping(ctx).Err())
+}
+
+func (r *redisClient) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok || r.operationTimeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, r.operationTimeout)
+}
+
+func wrap(op, key string, err error) error {
+	if err == nil || errors.Is(err, redis.Nil) {
+		return err
+	}
+	if key == "" {
+		return fmt.Errorf("redis %s: %w", op, err)
+	}
+	return fmt.Errorf("redis %s %s: %w", op, key, err)
+}
+
+func keyList(keys []string) string {
+	switch len(keys) {
+	case 0:
+		return ""
+	case 1:
+		return keys[0]
+	default:
+		return fmt.Sprintf("%s (+%d more)", keys[0], len(keys)-1)
+	}
 }
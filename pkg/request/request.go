@@ -0,0 +1,53 @@
+package request
+
+import (
+	"context"
+	"linked-clone/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+// ParseID reads a numeric path parameter. When it is not a valid ID it
+// answers 400 with message and returns false.
+func ParseID(c *gin.Context, param, message string) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param(param), 10, 32)
+	if err != nil {
+		response.Error(c, http.StatusBadRequest, message, err.Error())
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// Pagination reads the limit and offset query parameters, falling back to
+// DefaultLimit for a missing or out-of-range limit.
+func Pagination(c *gin.Context) (int, int) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(DefaultLimit)))
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if limit <= 0 || limit > MaxLimit {
+		limit = DefaultLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+// ClientInfo returns the user agent and IP address of the request behind
+// ctx. Handlers pass the gin context under the "gin_context" key; the
+// "user_agent" and "ip_address" values are read when it is missing.
+func ClientInfo(ctx context.Context) (userAgent, ipAddress string) {
+	if ginCtx, ok := ctx.Value("gin_context").(*gin.Context); ok {
+		return ginCtx.Request.UserAgent(), ginCtx.ClientIP()
+	}
+
+	userAgent, _ = ctx.Value("user_agent").(string)
+	ipAddress, _ = ctx.Value("ip_address").(string)
+	return userAgent, ipAddress
+}
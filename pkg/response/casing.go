@@ -0,0 +1,149 @@
+package response
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	CaseHeader     = "X-JSON-Case"
+	CaseQueryParam = "json_case"
+)
+
+// wantsCamelCase reports whether the client asked for camelCase keys, via
+// the X-JSON-Case header or the json_case query parameter.
+func wantsCamelCase(c *gin.Context) bool {
+	value := c.Query(CaseQueryParam)
+	if value == "" {
+		value = c.GetHeader(CaseHeader)
+	}
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "camel", "camelcase":
+		return true
+	default:
+		return false
+	}
+}
+
+// toCamelCase re-encodes v with the keys of struct fields converted from
+// snake_case to camelCase. Keys of other maps are data, such as experiment
+// params or search filters, and are kept as they are; gin.H and structs
+// narrowed by Project stand in for response structs and are converted.
+func toCamelCase(v interface{}) (interface{}, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+	return camelizeKeys(reflect.ValueOf(v), decoded), nil
+}
+
+var (
+	ginHType       = reflect.TypeOf(gin.H{})
+	projectionType = reflect.TypeOf(projection{})
+)
+
+// camelizeKeys walks decoded, the JSON form of v, alongside v so that it can
+// tell the keys that come from struct fields from those of data maps.
+func camelizeKeys(v reflect.Value, decoded interface{}) interface{} {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return decoded
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() || isMarshaler(v.Type()) {
+		return decoded
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		object, ok := decoded.(map[string]interface{})
+		if !ok {
+			return decoded
+		}
+		out := make(map[string]interface{}, len(object))
+		for _, field := range jsonFields(v.Type()) {
+			child, ok := object[field.name]
+			if !ok {
+				continue
+			}
+			value, err := v.FieldByIndexErr(field.index)
+			if err != nil {
+				continue
+			}
+			out[camelize(field.name)] = camelizeKeys(value, child)
+		}
+		return out
+
+	case reflect.Map:
+		object, ok := decoded.(map[string]interface{})
+		if !ok {
+			return decoded
+		}
+		values := make(map[string]reflect.Value, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			values[fmt.Sprint(iter.Key().Interface())] = iter.Value()
+		}
+
+		rename := v.Type() == ginHType || v.Type() == projectionType
+		out := make(map[string]interface{}, len(object))
+		for key, child := range object {
+			if value, ok := values[key]; ok {
+				child = camelizeKeys(value, child)
+			}
+			if rename {
+				key = camelize(key)
+			}
+			out[key] = child
+		}
+		return out
+
+	case reflect.Slice, reflect.Array:
+		items, ok := decoded.([]interface{})
+		if !ok || len(items) != v.Len() {
+			return decoded
+		}
+		for i := range items {
+			items[i] = camelizeKeys(v.Index(i), items[i])
+		}
+		return items
+
+	default:
+		return decoded
+	}
+}
+
+func camelize(key string) string {
+	if !strings.Contains(key, "_") {
+		return key
+	}
+
+	var b strings.Builder
+	b.Grow(len(key))
+	upper := false
+	for _, r := range key {
+		if r == '_' {
+			upper = b.Len() > 0
+			continue
+		}
+		if upper && r >= 'a' && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		upper = false
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,251 @@
+package response
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const (
+	maxSelectedFields = 50
+	maxFieldDepth     = 4
+)
+
+var fieldNamePattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// FieldSet is a parsed ?fields= selection. A nil child means the whole value
+// of that field is kept; a non-nil child narrows the nested object further.
+type FieldSet map[string]FieldSet
+
+// ParseFields parses a comma-separated list of JSON field names, using dots
+// for nested fields, e.g. "id,content,user.full_name". An empty string
+// returns a nil set, which Project treats as "everything".
+func ParseFields(raw string) (FieldSet, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+
+	paths := strings.Split(raw, ",")
+	if len(paths) > maxSelectedFields {
+		return nil, fmt.Errorf("at most %d fields can be selected", maxSelectedFields)
+	}
+
+	set := FieldSet{}
+	for _, path := range paths {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+		parts := strings.Split(path, ".")
+		if len(parts) > maxFieldDepth {
+			return nil, fmt.Errorf("field %q is nested too deeply", path)
+		}
+
+		current := set
+		for i, part := range parts {
+			if !fieldNamePattern.MatchString(part) {
+				return nil, fmt.Errorf("invalid field %q", path)
+			}
+			child, seen := current[part]
+			if i == len(parts)-1 {
+				// A bare "user" wins over "user.full_name" in either order.
+				current[part] = nil
+				break
+			}
+			if seen && child == nil {
+				break
+			}
+			if child == nil {
+				child = FieldSet{}
+				current[part] = child
+			}
+			current = child
+		}
+	}
+	if len(set) == 0 {
+		return nil, nil
+	}
+	return set, nil
+}
+
+// Project returns a copy of v containing only the selected fields. Structs
+// are read through their json tags, honouring omitempty, and slices are
+// projected element by element. Unknown field names are ignored.
+func Project(v interface{}, fields FieldSet) interface{} {
+	if fields == nil {
+		return v
+	}
+	return project(reflect.ValueOf(v), fields)
+}
+
+// projection is a struct narrowed to the selected fields. Its keys are still
+// field names, which toCamelCase relies on.
+type projection map[string]interface{}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+func project(v reflect.Value, fields FieldSet) interface{} {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil
+	}
+	if isMarshaler(v.Type()) {
+		return v.Interface()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		out := make(projection, len(fields))
+		for _, field := range jsonFields(v.Type()) {
+			child, ok := fields[field.name]
+			if !ok {
+				continue
+			}
+			value, err := v.FieldByIndexErr(field.index)
+			if err != nil || (field.omitEmpty && isEmptyValue(value)) {
+				continue
+			}
+			if child == nil {
+				out[field.name] = value.Interface()
+			} else {
+				out[field.name] = project(value, child)
+			}
+		}
+		return out
+
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return v.Interface()
+		}
+		out := make(map[string]interface{}, len(fields))
+		for name, child := range fields {
+			value := v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key()))
+			if !value.IsValid() {
+				continue
+			}
+			if child == nil {
+				out[name] = value.Interface()
+			} else {
+				out[name] = project(value, child)
+			}
+		}
+		return out
+
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && (v.IsNil() || v.Type().Elem().Kind() == reflect.Uint8) {
+			return v.Interface()
+		}
+		out := make([]interface{}, v.Len())
+		for i := range out {
+			out[i] = project(v.Index(i), fields)
+		}
+		return out
+
+	default:
+		return v.Interface()
+	}
+}
+
+type jsonField struct {
+	name      string
+	index     []int
+	omitEmpty bool
+}
+
+var jsonFieldCache sync.Map
+
+// jsonFields lists the fields encoding/json would emit for t, flattening
+// untagged embedded structs. Outer fields shadow promoted ones of the same name.
+func jsonFields(t reflect.Type) []jsonField {
+	if cached, ok := jsonFieldCache.Load(t); ok {
+		return cached.([]jsonField)
+	}
+
+	var fields []jsonField
+	seen := make(map[string]bool)
+	var walk func(t reflect.Type, index []int)
+	walk = func(t reflect.Type, index []int) {
+		var promoted []reflect.StructField
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			tag := sf.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			name, opts, _ := strings.Cut(tag, ",")
+
+			if sf.Anonymous && name == "" {
+				ft := sf.Type
+				if ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+				if ft.Kind() == reflect.Struct {
+					promoted = append(promoted, sf)
+					continue
+				}
+			}
+			if !sf.IsExported() {
+				continue
+			}
+			if name == "" {
+				name = sf.Name
+			}
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			fields = append(fields, jsonField{
+				name:      name,
+				index:     append(append([]int{}, index...), i),
+				omitEmpty: strings.Contains(","+opts+",", ",omitempty,"),
+			})
+		}
+		for _, sf := range promoted {
+			ft := sf.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			walk(ft, append(append([]int{}, index...), sf.Index...))
+		}
+	}
+	walk(t, nil)
+
+	jsonFieldCache.Store(t, fields)
+	return fields
+}
+
+func isMarshaler(t reflect.Type) bool {
+	return t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType) ||
+		t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType)
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
@@ -51,6 +51,11 @@ const (
 	ErrCodeBadRequest   = "BAD_REQUEST"
 	ErrCodeTimeout      = "TIMEOUT"
 	ErrCodeServiceError = "SERVICE_ERROR"
+
+	ErrCodeContentRejected          = "CONTENT_REJECTED"
+	ErrCodeApplicationDeadlinePast  = "APPLICATION_DEADLINE_PASSED"
+	ErrCodeFeatureDisabled          = "FEATURE_DISABLED"
+	ErrCodePolicyAcceptanceRequired = "POLICY_ACCEPTANCE_REQUIRED"
 )
 
 func Success(c *gin.Context, data interface{}) {
@@ -73,6 +78,10 @@ func CreatedWithMessage(c *gin.Context, message string, data interface{}) {
 	respond(c, http.StatusCreated, true, message, data, nil, nil)
 }
 
+func AcceptedWithMessage(c *gin.Context, message string, data interface{}) {
+	respond(c, http.StatusAccepted, true, message, data, nil, nil)
+}
+
 func NoContent(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
@@ -104,7 +113,7 @@ func ValidationErrors(c *gin.Context, err error) {
 				Field:   fieldError.Field(),
 				Tag:     fieldError.Tag(),
 				Message: getValidationMessage(fieldError),
-				Value:   fieldError.Value().(string),
+				Value:   fieldValue(fieldError),
 			})
 		}
 	}
@@ -117,6 +126,19 @@ func ValidationErrors(c *gin.Context, err error) {
 	respond(c, http.StatusBadRequest, false, "", nil, errorInfo, nil)
 }
 
+func FieldValidationError(c *gin.Context, field, tag, message string) {
+	errorInfo := &ErrorInfo{
+		Code:    ErrCodeValidation,
+		Message: "Validation failed",
+		Fields: []ValidationErrorDetail{{
+			Field:   field,
+			Tag:     tag,
+			Message: message,
+		}},
+	}
+	respond(c, http.StatusBadRequest, false, "", nil, errorInfo, nil)
+}
+
 func BadRequest(c *gin.Context, message, details string) {
 	ErrorWithCode(c, http.StatusBadRequest, ErrCodeBadRequest, message, details)
 }
@@ -193,6 +215,14 @@ func respond(c *gin.Context, statusCode int, success bool, message string, data
 		Timestamp: time.Now().UTC(),
 	}
 
+	c.Writer.Header().Add("Vary", CaseHeader)
+	if wantsCamelCase(c) {
+		if converted, err := toCamelCase(response); err == nil {
+			c.JSON(statusCode, converted)
+			return
+		}
+	}
+
 	c.JSON(statusCode, response)
 }
 
@@ -262,11 +292,22 @@ func getValidationMessage(fieldError validator.FieldError) string {
 		return fieldError.Field() + " must be a valid number"
 	case "alpha":
 		return fieldError.Field() + " must contain only alphabetic characters"
+	case "datetime":
+		return fieldError.Field() + " must be a date in the format " + fieldError.Param()
+	case "iso3166_1_alpha2":
+		return fieldError.Field() + " must be a two-letter ISO 3166-1 country code"
 	default:
 		return fieldError.Field() + " is invalid"
 	}
 }
 
+func fieldValue(fieldError validator.FieldError) string {
+	if value, ok := fieldError.Value().(string); ok {
+		return value
+	}
+	return ""
+}
+
 func CreateMeta(page, limit, total int) *MetaInfo {
 	totalPages := (total + limit - 1) / limit
 	offset := (page - 1) * limit
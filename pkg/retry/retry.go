@@ -0,0 +1,79 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	apperrors "linked-clone/pkg/errors"
+)
+
+type Policy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	RetryIf     func(err error) bool
+}
+
+func DefaultPolicy() Policy {
+	return Policy{
+		MaxAttempts: 3,
+		BaseDelay:   200 * time.Millisecond,
+		MaxDelay:    2 * time.Second,
+		RetryIf:     IsRetryable,
+	}
+}
+
+func IsRetryable(err error) bool {
+	var appErr *apperrors.AppError
+	if errors.As(err, &appErr) {
+		return appErr.Retryable
+	}
+	return false
+}
+
+func Do(ctx context.Context, policy Policy, fn func(ctx context.Context) error) error {
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
+	}
+	if policy.RetryIf == nil {
+		policy.RetryIf = IsRetryable
+	}
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = fn(ctx); err == nil {
+			return nil
+		}
+		if attempt >= policy.MaxAttempts || !policy.RetryIf(err) {
+			return err
+		}
+
+		timer := time.NewTimer(policy.Backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+func (p Policy) Backoff(attempt int) time.Duration {
+	if p.BaseDelay <= 0 {
+		return 0
+	}
+
+	delay := p.BaseDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if p.MaxDelay > 0 && delay >= p.MaxDelay {
+			delay = p.MaxDelay
+			break
+		}
+	}
+
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
@@ -0,0 +1,30 @@
+package scanner
+
+import (
+	"context"
+	"errors"
+	"mime/multipart"
+)
+
+var ErrInfected = errors.New("file failed virus scan")
+
+type Scanner interface {
+	Scan(ctx context.Context, file *multipart.FileHeader) error
+	// ScanData scans a file already read into memory, as held by the
+	// upload pipeline.
+	ScanData(ctx context.Context, filename string, data []byte) error
+}
+
+type noopScanner struct{}
+
+func NewNoopScanner() Scanner {
+	return &noopScanner{}
+}
+
+func (s *noopScanner) Scan(ctx context.Context, file *multipart.FileHeader) error {
+	return nil
+}
+
+func (s *noopScanner) ScanData(ctx context.Context, filename string, data []byte) error {
+	return nil
+}
@@ -0,0 +1,52 @@
+package signup
+
+import (
+	"crypto/rand"
+	"math/big"
+	"strings"
+)
+
+// Invite codes leave out letters and digits that are easy to confuse when
+// read aloud or copied by hand (0/O, 1/I/L).
+const (
+	inviteAlphabet  = "23456789ABCDEFGHJKMNPQRSTUVWXYZ"
+	inviteGroupLen  = 4
+	inviteGroupSize = 3
+)
+
+// GenerateInviteCode returns a code such as "K7QM-2XRP-WD9A".
+func GenerateInviteCode() (string, error) {
+	var b strings.Builder
+	max := big.NewInt(int64(len(inviteAlphabet)))
+	for group := 0; group < inviteGroupSize; group++ {
+		if group > 0 {
+			b.WriteByte('-')
+		}
+		for i := 0; i < inviteGroupLen; i++ {
+			idx, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return "", err
+			}
+			b.WriteByte(inviteAlphabet[idx.Int64()])
+		}
+	}
+	return b.String(), nil
+}
+
+// NormalizeInviteCode accepts codes typed in lower case, with spaces or
+// without the dashes.
+func NormalizeInviteCode(code string) string {
+	code = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	if len(code) != inviteGroupLen*inviteGroupSize {
+		return code
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(code); i += inviteGroupLen {
+		if i > 0 {
+			b.WriteByte('-')
+		}
+		b.WriteString(code[i : i+inviteGroupLen])
+	}
+	return b.String()
+}
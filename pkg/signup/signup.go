@@ -0,0 +1,215 @@
+package signup
+
+import (
+	"bufio"
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"linked-clone/pkg/redis"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+//go:embed disposable_domains.txt
+var defaultDisposableDomains string
+
+var (
+	ErrDomainNotAllowed  = errors.New("registrations from this email domain are not allowed")
+	ErrDisposableDomain  = errors.New("disposable email addresses are not allowed")
+	ErrInvalidEmail      = errors.New("invalid email address")
+	ErrTooManyFromIP     = errors.New("too many registrations from this network, please try again later")
+	ErrTooManyFromDomain = errors.New("too many registrations from this email domain, please try again later")
+)
+
+type Config struct {
+	IPLimit               int
+	IPWindow              time.Duration
+	DomainLimit           int
+	DomainWindow          time.Duration
+	DomainLimitExempt     []string
+	BlockDisposable       bool
+	DisposableDomainsFile string
+	AllowedDomains        []string
+}
+
+type Guard interface {
+	Check(ctx context.Context, email, ip string) error
+	Record(ctx context.Context, email, ip string) error
+}
+
+type guard struct {
+	redisClient  redis.RedisClient
+	config       Config
+	disposable   map[string]struct{}
+	allowed      map[string]struct{}
+	domainExempt map[string]struct{}
+}
+
+func NewGuard(redisClient redis.RedisClient, config Config) (Guard, error) {
+	disposable := make(map[string]struct{})
+	if config.BlockDisposable {
+		if err := readDomains(strings.NewReader(defaultDisposableDomains), disposable); err != nil {
+			return nil, fmt.Errorf("failed to load disposable domains: %w", err)
+		}
+		if config.DisposableDomainsFile != "" {
+			file, err := os.Open(config.DisposableDomainsFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to open disposable domains file: %w", err)
+			}
+			defer file.Close()
+			if err := readDomains(file, disposable); err != nil {
+				return nil, fmt.Errorf("failed to load disposable domains file: %w", err)
+			}
+		}
+	}
+
+	return &guard{
+		redisClient:  redisClient,
+		config:       config,
+		disposable:   disposable,
+		allowed:      domainSet(config.AllowedDomains),
+		domainExempt: domainSet(config.DomainLimitExempt),
+	}, nil
+}
+
+func (g *guard) Check(ctx context.Context, email, ip string) error {
+	domain := Domain(email)
+	if domain == "" {
+		return ErrInvalidEmail
+	}
+
+	if len(g.allowed) > 0 {
+		if !matchesDomain(g.allowed, domain) {
+			return ErrDomainNotAllowed
+		}
+	} else if matchesDomain(g.disposable, domain) {
+		return ErrDisposableDomain
+	}
+
+	if g.config.IPLimit > 0 && ip != "" {
+		count, err := g.count(ctx, ipKey(ip))
+		if err != nil {
+			return err
+		}
+		if count >= int64(g.config.IPLimit) {
+			return ErrTooManyFromIP
+		}
+	}
+
+	if g.limitsDomain(domain) {
+		count, err := g.count(ctx, domainKey(domain))
+		if err != nil {
+			return err
+		}
+		if count >= int64(g.config.DomainLimit) {
+			return ErrTooManyFromDomain
+		}
+	}
+
+	return nil
+}
+
+func (g *guard) Record(ctx context.Context, email, ip string) error {
+	if g.config.IPLimit > 0 && ip != "" {
+		if err := g.increment(ctx, ipKey(ip), g.config.IPWindow); err != nil {
+			return err
+		}
+	}
+
+	if domain := Domain(email); domain != "" && g.limitsDomain(domain) {
+		return g.increment(ctx, domainKey(domain), g.config.DomainWindow)
+	}
+	return nil
+}
+
+func (g *guard) limitsDomain(domain string) bool {
+	return g.config.DomainLimit > 0 && !matchesDomain(g.domainExempt, domain)
+}
+
+func (g *guard) count(ctx context.Context, key string) (int64, error) {
+	raw, err := g.redisClient.Get(ctx, key)
+	if err != nil {
+		if err == goredis.Nil {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return strconv.ParseInt(raw, 10, 64)
+}
+
+func (g *guard) increment(ctx context.Context, key string, window time.Duration) error {
+	count, err := g.redisClient.IncrBy(ctx, key, 1)
+	if err != nil {
+		return err
+	}
+	if count == 1 {
+		return g.redisClient.Expire(ctx, key, window)
+	}
+	return nil
+}
+
+func Domain(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 || at == len(email)-1 {
+		return ""
+	}
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(email[at+1:])), ".")
+}
+
+func IsThrottled(err error) bool {
+	return errors.Is(err, ErrTooManyFromIP) || errors.Is(err, ErrTooManyFromDomain)
+}
+
+func IsRejected(err error) bool {
+	return errors.Is(err, ErrDomainNotAllowed) || errors.Is(err, ErrDisposableDomain) || errors.Is(err, ErrInvalidEmail)
+}
+
+func matchesDomain(domains map[string]struct{}, domain string) bool {
+	for candidate := domain; candidate != ""; {
+		if _, ok := domains[candidate]; ok {
+			return true
+		}
+		dot := strings.Index(candidate, ".")
+		if dot < 0 {
+			return false
+		}
+		candidate = candidate[dot+1:]
+	}
+	return false
+}
+
+func readDomains(r io.Reader, into map[string]struct{}) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.ToLower(strings.TrimSpace(scanner.Text()))
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		into[line] = struct{}{}
+	}
+	return scanner.Err()
+}
+
+func domainSet(domains []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(domains))
+	for _, domain := range domains {
+		if domain = strings.ToLower(strings.TrimSpace(domain)); domain != "" {
+			set[domain] = struct{}{}
+		}
+	}
+	return set
+}
+
+func ipKey(ip string) string {
+	return fmt.Sprintf("registration:ip:%s", ip)
+}
+
+func domainKey(domain string) string {
+	return fmt.Sprintf("registration:domain:%s", domain)
+}
@@ -0,0 +1,22 @@
+package sms
+
+import (
+	"context"
+
+	"linked-clone/pkg/breaker"
+)
+
+type breakerSender struct {
+	next Sender
+	cb   *breaker.CircuitBreaker
+}
+
+func NewCircuitBreakerSender(next Sender, cb *breaker.CircuitBreaker) Sender {
+	return &breakerSender{next: next, cb: cb}
+}
+
+func (s *breakerSender) Send(ctx context.Context, to, body string) error {
+	return s.cb.Execute(func() error {
+		return s.next.Send(ctx, to, body)
+	})
+}
@@ -0,0 +1,67 @@
+package sms
+
+import (
+	"context"
+	"errors"
+	"linked-clone/pkg/logger"
+	"regexp"
+	"strings"
+)
+
+const (
+	DriverLog    = "log"
+	DriverTwilio = "twilio"
+)
+
+var ErrInvalidNumber = errors.New("phone number must be in E.164 format, e.g. +14155552671")
+
+// e164 is a plus sign, a country code that does not start with zero, and at
+// most fifteen digits in all.
+var e164 = regexp.MustCompile(`^\+[1-9][0-9]{7,14}$`)
+
+// Sender delivers a text message to a phone number in E.164 format.
+type Sender interface {
+	Send(ctx context.Context, to, body string) error
+}
+
+// Normalize strips the spaces, dashes, dots and brackets people type into
+// phone numbers and checks that what is left is in E.164 format.
+func Normalize(number string) (string, error) {
+	cleaned := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(number))
+
+	if !e164.MatchString(cleaned) {
+		return "", ErrInvalidNumber
+	}
+	return cleaned, nil
+}
+
+// Mask hides all but the last four digits, for showing a number back to
+// someone who may not own it.
+func Mask(number string) string {
+	if len(number) <= 4 {
+		return ""
+	}
+	return strings.Repeat("*", len(number)-4) + number[len(number)-4:]
+}
+
+type logSender struct {
+	logger logger.Logger
+}
+
+// NewLogSender notes in the log that a message was due instead of sending
+// it. It is meant for development; the body is never logged because it
+// carries sign-in codes.
+func NewLogSender(logger logger.Logger) Sender {
+	return &logSender{logger: logger}
+}
+
+func (s *logSender) Send(ctx context.Context, to, body string) error {
+	s.logger.Info("SMS not sent (log driver)", "to", Mask(to))
+	return nil
+}
@@ -0,0 +1,77 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const twilioAPIURL = "https://api.twilio.com/2010-04-01"
+
+type twilioSender struct {
+	accountSID string
+	authToken  string
+	from       string
+	httpClient *http.Client
+}
+
+// NewTwilioSender sends through the Twilio Messages API. from is either a
+// Twilio phone number or a messaging service SID.
+func NewTwilioSender(accountSID, authToken, from string, timeout time.Duration) (Sender, error) {
+	if accountSID == "" || authToken == "" {
+		return nil, fmt.Errorf("twilio account SID and auth token are required")
+	}
+	if from == "" {
+		return nil, fmt.Errorf("twilio sender number or messaging service is required")
+	}
+
+	return &twilioSender{
+		accountSID: accountSID,
+		authToken:  authToken,
+		from:       from,
+		httpClient: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+func (s *twilioSender) Send(ctx context.Context, to, body string) error {
+	form := url.Values{}
+	form.Set("To", to)
+	form.Set("Body", body)
+	if strings.HasPrefix(s.from, "MG") {
+		form.Set("MessagingServiceSid", s.from)
+	} else {
+		form.Set("From", s.from)
+	}
+
+	endpoint := fmt.Sprintf("%s/Accounts/%s/Messages.json", twilioAPIURL, url.PathEscape(s.accountSID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("twilio send failed: %w", err)
+	}
+	req.SetBasicAuth(s.accountSID, s.authToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("twilio send failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	var apiErr struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&apiErr); err != nil || apiErr.Message == "" {
+		return fmt.Errorf("twilio send failed: status %d", resp.StatusCode)
+	}
+	return fmt.Errorf("twilio send failed: status %d: %d %s", resp.StatusCode, apiErr.Code, apiErr.Message)
+}
@@ -0,0 +1,46 @@
+package email
+
+import (
+	"time"
+
+	"linked-clone/pkg/breaker"
+)
+
+type breakerEmailService struct {
+	next EmailService
+	cb   *breaker.CircuitBreaker
+}
+
+func NewCircuitBreakerEmailService(next EmailService, cb *breaker.CircuitBreaker) EmailService {
+	return &breakerEmailService{next: next, cb: cb}
+}
+
+func (s *breakerEmailService) SendVerificationEmail(to, fullName, code string) error {
+	return s.cb.Execute(func() error {
+		return s.next.SendVerificationEmail(to, fullName, code)
+	})
+}
+
+func (s *breakerEmailService) SendPasswordResetEmail(to, fullName, code string) error {
+	return s.cb.Execute(func() error {
+		return s.next.SendPasswordResetEmail(to, fullName, code)
+	})
+}
+
+func (s *breakerEmailService) SendCompanyVerificationEmail(to, companyName, code string) error {
+	return s.cb.Execute(func() error {
+		return s.next.SendCompanyVerificationEmail(to, companyName, code)
+	})
+}
+
+func (s *breakerEmailService) SendEmail(to, subject, body string) error {
+	return s.cb.Execute(func() error {
+		return s.next.SendEmail(to, subject, body)
+	})
+}
+
+func (s *breakerEmailService) TestConnection(timeout time.Duration) error {
+	return s.cb.Execute(func() error {
+		return s.next.TestConnection(timeout)
+	})
+}
@@ -0,0 +1,58 @@
+package email
+
+import (
+	"context"
+	"time"
+
+	"linked-clone/pkg/chaos"
+)
+
+type chaosEmailService struct {
+	next     EmailService
+	injector *chaos.Injector
+}
+
+// NewChaosEmailService injects the configured email faults before each send.
+// Sends carry no request context, so only standing CHAOS_RULES apply here,
+// not X-Chaos-Dependency headers.
+func NewChaosEmailService(next EmailService, injector *chaos.Injector) EmailService {
+	return &chaosEmailService{next: next, injector: injector}
+}
+
+func (s *chaosEmailService) SendVerificationEmail(to, fullName, code string) error {
+	if err := s.injector.Apply(context.Background(), chaos.DependencyEmail); err != nil {
+		return err
+	}
+	return s.next.SendVerificationEmail(to, fullName, code)
+}
+
+func (s *chaosEmailService) SendPasswordResetEmail(to, fullName, code string) error {
+	if err := s.injector.Apply(context.Background(), chaos.DependencyEmail); err != nil {
+		return err
+	}
+	return s.next.SendPasswordResetEmail(to, fullName, code)
+}
+
+func (s *chaosEmailService) SendCompanyVerificationEmail(to, companyName, code string) error {
+	if err := s.injector.Apply(context.Background(), chaos.DependencyEmail); err != nil {
+		return err
+	}
+	return s.next.SendCompanyVerificationEmail(to, companyName, code)
+}
+
+func (s *chaosEmailService) SendEmail(to, subject, body string) error {
+	if err := s.injector.Apply(context.Background(), chaos.DependencyEmail); err != nil {
+		return err
+	}
+	return s.next.SendEmail(to, subject, body)
+}
+
+func (s *chaosEmailService) TestConnection(timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if err := s.injector.Apply(ctx, chaos.DependencyEmail); err != nil {
+		return err
+	}
+	return s.next.TestConnection(timeout)
+}
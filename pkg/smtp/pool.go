@@ -0,0 +1,401 @@
+package email
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	apperrors "linked-clone/pkg/errors"
+)
+
+const defaultProvider = "default"
+
+var ErrPoolClosed = errors.New("smtp pool is closed")
+
+type PoolConfig struct {
+	MaxConcurrency int
+	IdleTimeout    time.Duration
+	AcquireTimeout time.Duration
+	RateLimit      int
+	ProviderLimits map[string]int
+}
+
+func ParseProviderLimits(entries []string) (map[string]int, error) {
+	limits := make(map[string]int, len(entries))
+	for _, entry := range entries {
+		domain, raw, ok := strings.Cut(entry, "=")
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		if !ok || domain == "" {
+			return nil, fmt.Errorf("invalid provider rate limit %q", entry)
+		}
+		limit, err := strconv.Atoi(strings.TrimSpace(raw))
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("invalid provider rate limit %q", entry)
+		}
+		limits[domain] = limit
+	}
+	return limits, nil
+}
+
+type pooledConn struct {
+	client   *smtp.Client
+	lastUsed time.Time
+}
+
+type pool struct {
+	host     string
+	port     int
+	username string
+	password string
+	config   PoolConfig
+
+	slots    chan struct{}
+	mu       sync.Mutex
+	idle     []*pooledConn
+	closed   bool
+	relay    *rateLimiter
+	limiters map[string]*rateLimiter
+
+	sent          int64
+	failed        int64
+	waiting       int64
+	inFlight      int64
+	dials         int64
+	reuses        int64
+	throttled     int64
+	latencyTotal  int64
+	latencyMax    int64
+	latencyLast   int64
+	acquireMax    int64
+	acquireTotal  int64
+	providerSends sync.Map
+}
+
+func newPool(host string, port int, username, password string, config PoolConfig) *pool {
+	if config.MaxConcurrency <= 0 {
+		config.MaxConcurrency = 4
+	}
+	if config.IdleTimeout <= 0 {
+		config.IdleTimeout = 30 * time.Second
+	}
+	if config.AcquireTimeout <= 0 {
+		config.AcquireTimeout = 30 * time.Second
+	}
+
+	p := &pool{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		config:   config,
+		slots:    make(chan struct{}, config.MaxConcurrency),
+		relay:    newRateLimiter(config.RateLimit),
+		limiters: make(map[string]*rateLimiter, len(config.ProviderLimits)),
+	}
+	for domain, limit := range config.ProviderLimits {
+		p.limiters[domain] = newRateLimiter(limit)
+	}
+	register(p)
+	return p
+}
+
+func (p *pool) Send(ctx context.Context, from, to string, msg []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, p.config.AcquireTimeout)
+	defer cancel()
+
+	provider := providerFor(to)
+	start := time.Now()
+	atomic.AddInt64(&p.waiting, 1)
+	err := p.acquire(ctx, provider)
+	atomic.AddInt64(&p.waiting, -1)
+	if err != nil {
+		return err
+	}
+	defer func() { <-p.slots }()
+	p.observeAcquire(time.Since(start))
+
+	atomic.AddInt64(&p.inFlight, 1)
+	defer atomic.AddInt64(&p.inFlight, -1)
+
+	sendStart := time.Now()
+	conn, err := p.conn()
+	if err == nil {
+		err = deliver(conn.client, from, to, msg)
+		p.release(conn, err)
+	}
+	p.observeSend(provider, time.Since(sendStart), err)
+	return err
+}
+
+func (p *pool) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.closed = true
+	for _, conn := range p.idle {
+		conn.client.Close()
+	}
+	p.idle = nil
+}
+
+func (p *pool) GetMetrics() map[string]interface{} {
+	sent := atomic.LoadInt64(&p.sent)
+	failed := atomic.LoadInt64(&p.failed)
+
+	var avgLatency, avgAcquire float64
+	if attempts := sent + failed; attempts > 0 {
+		avgLatency = time.Duration(atomic.LoadInt64(&p.latencyTotal)/attempts).Seconds() * 1000
+		avgAcquire = time.Duration(atomic.LoadInt64(&p.acquireTotal)/attempts).Seconds() * 1000
+	}
+
+	providers := make(map[string]int64)
+	p.providerSends.Range(func(key, value interface{}) bool {
+		providers[key.(string)] = atomic.LoadInt64(value.(*int64))
+		return true
+	})
+
+	p.mu.Lock()
+	idle := len(p.idle)
+	p.mu.Unlock()
+
+	return map[string]interface{}{
+		"host":                 p.host,
+		"max_concurrency":      p.config.MaxConcurrency,
+		"in_flight":            atomic.LoadInt64(&p.inFlight),
+		"waiting":              atomic.LoadInt64(&p.waiting),
+		"idle_connections":     idle,
+		"sent":                 sent,
+		"failed":               failed,
+		"throttled":            atomic.LoadInt64(&p.throttled),
+		"dials":                atomic.LoadInt64(&p.dials),
+		"reuses":               atomic.LoadInt64(&p.reuses),
+		"avg_latency_ms":       avgLatency,
+		"max_latency_ms":       time.Duration(atomic.LoadInt64(&p.latencyMax)).Seconds() * 1000,
+		"last_latency_ms":      time.Duration(atomic.LoadInt64(&p.latencyLast)).Seconds() * 1000,
+		"avg_acquire_wait_ms":  avgAcquire,
+		"max_acquire_wait_ms":  time.Duration(atomic.LoadInt64(&p.acquireMax)).Seconds() * 1000,
+		"sends_by_provider":    providers,
+		"provider_rate_limits": p.config.ProviderLimits,
+		"relay_rate_limit":     p.config.RateLimit,
+	}
+}
+
+func (p *pool) acquire(ctx context.Context, provider string) error {
+	select {
+	case p.slots <- struct{}{}:
+	case <-ctx.Done():
+		atomic.AddInt64(&p.throttled, 1)
+		return apperrors.ExternalServiceError("smtp", fmt.Errorf("timed out waiting for smtp connection: %w", ctx.Err()))
+	}
+
+	limiters := []*rateLimiter{p.relay}
+	if limiter, ok := p.limiters[provider]; ok {
+		limiters = append(limiters, limiter)
+	}
+	for _, limiter := range limiters {
+		if err := limiter.Wait(ctx); err != nil {
+			<-p.slots
+			atomic.AddInt64(&p.throttled, 1)
+			return apperrors.ExternalServiceError("smtp", fmt.Errorf("smtp rate limit for %s: %w", provider, err))
+		}
+	}
+	return nil
+}
+
+func (p *pool) conn() (*pooledConn, error) {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil, ErrPoolClosed
+	}
+	for len(p.idle) > 0 {
+		conn := p.idle[len(p.idle)-1]
+		p.idle = p.idle[:len(p.idle)-1]
+		if time.Since(conn.lastUsed) < p.config.IdleTimeout {
+			p.mu.Unlock()
+			atomic.AddInt64(&p.reuses, 1)
+			return conn, nil
+		}
+		conn.client.Close()
+	}
+	p.mu.Unlock()
+
+	client, err := p.dial()
+	if err != nil {
+		return nil, err
+	}
+	atomic.AddInt64(&p.dials, 1)
+	return &pooledConn{client: client}, nil
+}
+
+func (p *pool) release(conn *pooledConn, err error) {
+	if err != nil {
+		conn.client.Close()
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed || len(p.idle) >= p.config.MaxConcurrency {
+		conn.client.Close()
+		return
+	}
+	conn.lastUsed = time.Now()
+	p.idle = append(p.idle, conn)
+}
+
+func (p *pool) dial() (*smtp.Client, error) {
+	client, err := smtp.Dial(fmt.Sprintf("%s:%d", p.host, p.port))
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: false,
+		ServerName:         p.host,
+	}
+	if err := client.StartTLS(tlsConfig); err != nil {
+		client.Close()
+		return nil, err
+	}
+
+	if err := client.Auth(smtp.PlainAuth("", p.username, p.password, p.host)); err != nil {
+		client.Close()
+		return nil, err
+	}
+	return client, nil
+}
+
+func (p *pool) observeAcquire(wait time.Duration) {
+	atomic.AddInt64(&p.acquireTotal, int64(wait))
+	storeMax(&p.acquireMax, int64(wait))
+}
+
+func (p *pool) observeSend(provider string, latency time.Duration, err error) {
+	atomic.AddInt64(&p.latencyTotal, int64(latency))
+	atomic.StoreInt64(&p.latencyLast, int64(latency))
+	storeMax(&p.latencyMax, int64(latency))
+
+	if err != nil {
+		atomic.AddInt64(&p.failed, 1)
+		return
+	}
+	atomic.AddInt64(&p.sent, 1)
+
+	counter, _ := p.providerSends.LoadOrStore(provider, new(int64))
+	atomic.AddInt64(counter.(*int64), 1)
+}
+
+func deliver(client *smtp.Client, from, to string, msg []byte) error {
+	if err := client.Mail(from); err != nil {
+		client.Reset()
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		client.Reset()
+		return err
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(msg); err != nil {
+		writer.Close()
+		return err
+	}
+	return writer.Close()
+}
+
+func providerFor(to string) string {
+	at := strings.LastIndex(to, "@")
+	if at < 0 || at == len(to)-1 {
+		return defaultProvider
+	}
+	return strings.ToLower(to[at+1:])
+}
+
+func storeMax(addr *int64, value int64) {
+	for {
+		current := atomic.LoadInt64(addr)
+		if value <= current || atomic.CompareAndSwapInt64(addr, current, value) {
+			return
+		}
+	}
+}
+
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// newRateLimiter spaces sends evenly so at most perSecond go out each second;
+// zero or negative disables the limit.
+func newRateLimiter(perSecond int) *rateLimiter {
+	if perSecond <= 0 {
+		return nil
+	}
+	return &rateLimiter{interval: time.Second / time.Duration(perSecond)}
+}
+
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	wait := l.next.Sub(now)
+	if deadline, ok := ctx.Deadline(); ok && now.Add(wait).After(deadline) {
+		l.mu.Unlock()
+		return context.DeadlineExceeded
+	}
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]*pool)
+)
+
+func register(p *pool) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[p.host] = p
+}
+
+func Snapshot() map[string]interface{} {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	snapshot := make(map[string]interface{}, len(registry))
+	for host, p := range registry {
+		snapshot[host] = p.GetMetrics()
+	}
+	return snapshot
+}
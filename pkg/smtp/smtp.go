@@ -1,112 +1,130 @@
 package email
 
 import (
-	"crypto/tls"
+	"context"
+	"errors"
 	"fmt"
+	"net"
 	"net/smtp"
+	"net/textproto"
+	"time"
+
+	apperrors "linked-clone/pkg/errors"
+	"linked-clone/pkg/retry"
 )
 
 type EmailService interface {
 	SendVerificationEmail(to, fullName, code string) error
 	SendPasswordResetEmail(to, fullName, code string) error
+	SendCompanyVerificationEmail(to, companyName, code string) error
+	SendEmail(to, subject, body string) error
+	TestConnection(timeout time.Duration) error
 }
 
 type emailService struct {
-	host     string
-	port     int
-	username string
-	password string
-	from     string
+	host        string
+	port        int
+	username    string
+	password    string
+	from        string
+	retryPolicy retry.Policy
+	pool        *pool
 }
 
-func NewEmailService(host string, port int, username, password string) EmailService {
-	return &emailService{
-		host:     host,
-		port:     port,
-		username: username,
-		password: password,
-		from:     username,
+func NewEmailService(host string, port int, username, password string, retryPolicy retry.Policy, poolConfig PoolConfig) (EmailService, error) {
+	if host == "" {
+		return nil, fmt.Errorf("SMTP host is required")
+	}
+	if port <= 0 || port > 65535 {
+		return nil, fmt.Errorf("invalid SMTP port: %d", port)
 	}
+
+	return &emailService{
+		host:        host,
+		port:        port,
+		username:    username,
+		password:    password,
+		from:        username,
+		retryPolicy: retryPolicy,
+		pool:        newPool(host, port, username, password, poolConfig),
+	}, nil
 }
 
 func (s *emailService) SendVerificationEmail(to, fullName, code string) error {
-	subject := "Verify Your Email - LinkedIn Clone"
-	body := fmt.Sprintf(`
-		<html>
-		<body>
-			<h2>Email Verification</h2>
-			<p>Hi %s,</p>
-			<p>Thank you for signing up! Please use the following code to verify your email address:</p>
-			<h3 style="color: #0073b1; font-size: 24px; letter-spacing: 2px;">%s</h3>
-			<p>This code will expire in 15 minutes.</p>
-			<p>If you didn't create an account with us, you can safely ignore this email.</p>
-			<br>
-			<p>Best regards,<br>LinkedIn Clone Team</p>
-		</body>
-		</html>
-	`, fullName, code)
-
-	return s.sendEmail(to, subject, body)
+	return s.sendTemplate(to, TemplateVerification, map[string]string{"full_name": fullName, "code": code})
 }
 
 func (s *emailService) SendPasswordResetEmail(to, fullName, code string) error {
-	subject := "Reset Your Password - LinkedIn Clone"
-	body := fmt.Sprintf(`
-		<html>
-		<body>
-			<h2>Password Reset</h2>
-			<p>Hi %s,</p>
-			<p>You requested to reset your password. Please use the following code:</p>
-			<h3 style="color: #0073b1; font-size: 24px; letter-spacing: 2px;">%s</h3>
-			<p>This code will expire in 15 minutes.</p>
-			<p>If you didn't request a password reset, you can safely ignore this email.</p>
-			<br>
-			<p>Best regards,<br>LinkedIn Clone Team</p>
-		</body>
-		</html>
-	`, fullName, code)
-
-	return s.sendEmail(to, subject, body)
+	return s.sendTemplate(to, TemplatePasswordReset, map[string]string{"full_name": fullName, "code": code})
 }
 
-func (s *emailService) sendEmail(to, subject, body string) error {
-	auth := smtp.PlainAuth("", s.username, s.password, s.host)
+func (s *emailService) SendCompanyVerificationEmail(to, companyName, code string) error {
+	return s.sendTemplate(to, TemplateCompanyVerification, map[string]string{"company_name": companyName, "code": code})
+}
 
-	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n"+
-		"MIME-Version: 1.0\r\nContent-Type: text/html; charset=UTF-8\r\n\r\n%s",
-		s.from, to, subject, body)
+func (s *emailService) SendEmail(to, subject, body string) error {
+	return s.sendEmail(to, subject, body)
+}
 
-	client, err := smtp.Dial(fmt.Sprintf("%s:%d", s.host, s.port))
+func (s *emailService) TestConnection(timeout time.Duration) error {
+	conn, err := net.DialTimeout("tcp", fmt.Sprintf("%s:%d", s.host, s.port), timeout)
 	if err != nil {
 		return err
 	}
-	defer client.Close()
-
-	tlsConfig := &tls.Config{
-		InsecureSkipVerify: false,
-		ServerName:         s.host,
-	}
-	if err = client.StartTLS(tlsConfig); err != nil {
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		conn.Close()
 		return err
 	}
 
-	if err = client.Auth(auth); err != nil {
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
 		return err
 	}
+	defer client.Close()
+
+	return client.Quit()
+}
 
-	if err = client.Mail(s.from); err != nil {
+func (s *emailService) sendTemplate(to, name string, data map[string]string) error {
+	subject, body, err := Render(name, data)
+	if err != nil {
 		return err
 	}
-	if err = client.Rcpt(to); err != nil {
-		return err
+	return s.sendEmail(to, subject, body)
+}
+
+func (s *emailService) sendEmail(to, subject, body string) error {
+	return retry.Do(context.Background(), s.retryPolicy, func(ctx context.Context) error {
+		return classifySMTPError(s.send(ctx, to, subject, body))
+	})
+}
+
+func (s *emailService) send(ctx context.Context, to, subject, body string) error {
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n"+
+		"MIME-Version: 1.0\r\nContent-Type: text/html; charset=UTF-8\r\n\r\n%s",
+		s.from, to, subject, body)
+
+	return s.pool.Send(ctx, s.from, to, []byte(msg))
+}
+
+func classifySMTPError(err error) error {
+	if err == nil {
+		return nil
 	}
 
-	writer, err := client.Data()
-	if err != nil {
+	var protoErr *textproto.Error
+	if errors.As(err, &protoErr) {
+		if protoErr.Code >= 400 && protoErr.Code < 500 {
+			return apperrors.ExternalServiceError("smtp", err)
+		}
 		return err
 	}
-	defer writer.Close()
 
-	_, err = writer.Write([]byte(msg))
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return apperrors.ExternalServiceError("smtp", err)
+	}
+
 	return err
 }
@@ -0,0 +1,228 @@
+package email
+
+import (
+	"bytes"
+	"errors"
+	"html/template"
+	"sort"
+)
+
+var ErrUnknownTemplate = errors.New("unknown email template")
+
+const (
+	TemplateVerification        = "verification"
+	TemplatePasswordReset       = "password_reset"
+	TemplateCompanyVerification = "company_verification"
+	TemplateApplicationStatus   = "application_status"
+	TemplateMagicLink           = "magic_link"
+	TemplateEmailChange         = "email_change"
+	TemplateEmailChanged        = "email_changed"
+	TemplateSSOLink             = "sso_link"
+)
+
+type Template struct {
+	Name        string
+	Description string
+	Subject     string
+	Variables   []string
+	Sample      map[string]string
+	body        *template.Template
+}
+
+var templates = map[string]*Template{
+	TemplateVerification: {
+		Name:        TemplateVerification,
+		Description: "Sent after registration to confirm the account email address",
+		Subject:     "Verify Your Email - LinkedIn Clone",
+		Variables:   []string{"full_name", "code"},
+		Sample:      map[string]string{"full_name": "Jane Doe", "code": "482913"},
+		body: template.Must(template.New(TemplateVerification).Parse(`
+		<html>
+		<body>
+			<h2>Email Verification</h2>
+			<p>Hi {{.full_name}},</p>
+			<p>Thank you for signing up! Please use the following code to verify your email address:</p>
+			<h3 style="color: #0073b1; font-size: 24px; letter-spacing: 2px;">{{.code}}</h3>
+			<p>This code will expire in 15 minutes.</p>
+			<p>If you didn't create an account with us, you can safely ignore this email.</p>
+			<br>
+			<p>Best regards,<br>LinkedIn Clone Team</p>
+		</body>
+		</html>
+	`)),
+	},
+	TemplatePasswordReset: {
+		Name:        TemplatePasswordReset,
+		Description: "Sent when a user requests a password reset code",
+		Subject:     "Reset Your Password - LinkedIn Clone",
+		Variables:   []string{"full_name", "code"},
+		Sample:      map[string]string{"full_name": "Jane Doe", "code": "715204"},
+		body: template.Must(template.New(TemplatePasswordReset).Parse(`
+		<html>
+		<body>
+			<h2>Password Reset</h2>
+			<p>Hi {{.full_name}},</p>
+			<p>You requested to reset your password. Please use the following code:</p>
+			<h3 style="color: #0073b1; font-size: 24px; letter-spacing: 2px;">{{.code}}</h3>
+			<p>This code will expire in 15 minutes.</p>
+			<p>If you didn't request a password reset, you can safely ignore this email.</p>
+			<br>
+			<p>Best regards,<br>LinkedIn Clone Team</p>
+		</body>
+		</html>
+	`)),
+	},
+	TemplateCompanyVerification: {
+		Name:        TemplateCompanyVerification,
+		Description: "Sent to a business address to confirm company ownership",
+		Subject:     "Verify Your Company - LinkedIn Clone",
+		Variables:   []string{"company_name", "code"},
+		Sample:      map[string]string{"company_name": "Acme Corporation", "code": "906351"},
+		body: template.Must(template.New(TemplateCompanyVerification).Parse(`
+		<html>
+		<body>
+			<h2>Company Verification</h2>
+			<p>Hello,</p>
+			<p>A request was made to verify {{.company_name}} using this business email address. Please use the following code to confirm:</p>
+			<h3 style="color: #0073b1; font-size: 24px; letter-spacing: 2px;">{{.code}}</h3>
+			<p>This code will expire in 30 minutes.</p>
+			<p>If you didn't request this, you can safely ignore this email.</p>
+			<br>
+			<p>Best regards,<br>LinkedIn Clone Team</p>
+		</body>
+		</html>
+	`)),
+	},
+	TemplateApplicationStatus: {
+		Name:        TemplateApplicationStatus,
+		Description: "Sent to an applicant when the hiring team moves their application to a new stage",
+		Subject:     "Update on Your Application - LinkedIn Clone",
+		Variables:   []string{"full_name", "job_title", "company", "status"},
+		Sample:      map[string]string{"full_name": "Jane Doe", "job_title": "Backend Engineer", "company": "Acme Corporation", "status": "shortlisted"},
+		body: template.Must(template.New(TemplateApplicationStatus).Parse(`
+		<html>
+		<body>
+			<h2>Application Update</h2>
+			<p>Hi {{.full_name}},</p>
+			<p>Your application for <strong>{{.job_title}}</strong> at {{.company}} has been moved to:</p>
+			<h3 style="color: #0073b1; font-size: 20px;">{{.status}}</h3>
+			<p>You can follow your application from the My Applications page.</p>
+			<br>
+			<p>Best regards,<br>LinkedIn Clone Team</p>
+		</body>
+		</html>
+	`)),
+	},
+	TemplateMagicLink: {
+		Name:        TemplateMagicLink,
+		Description: "Sent when a user asks to sign in without a password",
+		Subject:     "Your Sign-in Link - LinkedIn Clone",
+		Variables:   []string{"full_name", "link", "minutes"},
+		Sample:      map[string]string{"full_name": "Jane Doe", "link": "https://example.com/auth/magic-link?token=sample", "minutes": "15"},
+		body: template.Must(template.New(TemplateMagicLink).Parse(`
+		<html>
+		<body>
+			<h2>Sign in to LinkedIn Clone</h2>
+			<p>Hi {{.full_name}},</p>
+			<p>Click the button below to sign in. The link works once and expires in {{.minutes}} minutes.</p>
+			<p><a href="{{.link}}" style="display: inline-block; padding: 10px 20px; background: #0073b1; color: #ffffff; text-decoration: none; border-radius: 4px;">Sign in</a></p>
+			<p>If you didn't ask to sign in, you can safely ignore this email; your account stays as it is.</p>
+			<br>
+			<p>Best regards,<br>LinkedIn Clone Team</p>
+		</body>
+		</html>
+	`)),
+	},
+	TemplateEmailChange: {
+		Name:        TemplateEmailChange,
+		Description: "Sent to the new address when a user asks to change their account email",
+		Subject:     "Confirm Your New Email - LinkedIn Clone",
+		Variables:   []string{"full_name", "code", "minutes"},
+		Sample:      map[string]string{"full_name": "Jane Doe", "code": "305817", "minutes": "15"},
+		body: template.Must(template.New(TemplateEmailChange).Parse(`
+		<html>
+		<body>
+			<h2>Confirm Your New Email</h2>
+			<p>Hi {{.full_name}},</p>
+			<p>You asked to use this address for your LinkedIn Clone account. Please use the following code to confirm the change:</p>
+			<h3 style="color: #0073b1; font-size: 24px; letter-spacing: 2px;">{{.code}}</h3>
+			<p>This code will expire in {{.minutes}} minutes.</p>
+			<p>If you didn't ask for this, you can safely ignore this email.</p>
+			<br>
+			<p>Best regards,<br>LinkedIn Clone Team</p>
+		</body>
+		</html>
+	`)),
+	},
+	TemplateEmailChanged: {
+		Name:        TemplateEmailChanged,
+		Description: "Sent to the previous address after an account email change is confirmed",
+		Subject:     "Your Email Was Changed - LinkedIn Clone",
+		Variables:   []string{"full_name", "new_email"},
+		Sample:      map[string]string{"full_name": "Jane Doe", "new_email": "j***@example.com"},
+		body: template.Must(template.New(TemplateEmailChanged).Parse(`
+		<html>
+		<body>
+			<h2>Email Address Changed</h2>
+			<p>Hi {{.full_name}},</p>
+			<p>The email address on your LinkedIn Clone account was changed to {{.new_email}}, and all sessions were signed out.</p>
+			<p>If you didn't make this change, recover your account with a recovery code or contact support right away.</p>
+			<br>
+			<p>Best regards,<br>LinkedIn Clone Team</p>
+		</body>
+		</html>
+	`)),
+	},
+	TemplateSSOLink: {
+		Name:        TemplateSSOLink,
+		Description: "Sent when a company SSO login matches an existing account that is not yet connected to it",
+		Subject:     "Connect Your Company Sign-in - LinkedIn Clone",
+		Variables:   []string{"full_name", "company", "link", "minutes"},
+		Sample:      map[string]string{"full_name": "Jane Doe", "company": "Acme", "link": "https://example.com/sso/link?token=sample", "minutes": "30"},
+		body: template.Must(template.New(TemplateSSOLink).Parse(`
+		<html>
+		<body>
+			<h2>Connect Your Company Sign-in</h2>
+			<p>Hi {{.full_name}},</p>
+			<p>Someone signed in through {{.company}}'s single sign-on with this email address. Click the button below to let that sign-in open your existing account. The link works once and expires in {{.minutes}} minutes.</p>
+			<p><a href="{{.link}}" style="display: inline-block; padding: 10px 20px; background: #0073b1; color: #ffffff; text-decoration: none; border-radius: 4px;">Connect sign-in</a></p>
+			<p>If this wasn't you, ignore this email; your account stays as it is.</p>
+			<br>
+			<p>Best regards,<br>LinkedIn Clone Team</p>
+		</body>
+		</html>
+	`)),
+	},
+}
+
+func Templates() []*Template {
+	list := make([]*Template, 0, len(templates))
+	for _, tmpl := range templates {
+		list = append(list, tmpl)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
+
+func LookupTemplate(name string) (*Template, error) {
+	tmpl, ok := templates[name]
+	if !ok {
+		return nil, ErrUnknownTemplate
+	}
+	return tmpl, nil
+}
+
+func Render(name string, data map[string]string) (string, string, error) {
+	tmpl, err := LookupTemplate(name)
+	if err != nil {
+		return "", "", err
+	}
+
+	var body bytes.Buffer
+	if err := tmpl.body.Execute(&body, data); err != nil {
+		return "", "", err
+	}
+	return tmpl.Subject, body.String(), nil
+}
@@ -0,0 +1,297 @@
+package sso
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	discoveryTTL = time.Hour
+	maxBodySize  = 1 << 20
+)
+
+var idTokenMethods = []string{"RS256", "RS384", "RS512", "PS256", "ES256", "ES384"}
+
+// OIDCConfig is a workspace's registration with its identity provider.
+type OIDCConfig struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+}
+
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type cachedProvider struct {
+	discovery *discovery
+	keys      map[string]interface{}
+	fetchedAt time.Time
+}
+
+// OIDCClient runs the authorization code flow with PKCE against any
+// standards-compliant provider, caching discovery documents and signing keys
+// per issuer.
+type OIDCClient struct {
+	httpClient *http.Client
+	mu         sync.Mutex
+	providers  map[string]*cachedProvider
+}
+
+func NewOIDCClient(timeout time.Duration) *OIDCClient {
+	return &OIDCClient{
+		httpClient: &http.Client{Timeout: timeout},
+		providers:  make(map[string]*cachedProvider),
+	}
+}
+
+// AuthCodeURL returns the provider login URL. The verifier is the PKCE
+// secret that Exchange needs later, alongside the nonce.
+func (c *OIDCClient) AuthCodeURL(ctx context.Context, cfg OIDCConfig, redirectURI, state, nonce, verifier string) (string, error) {
+	provider, err := c.provider(ctx, cfg.Issuer, false)
+	if err != nil {
+		return "", err
+	}
+
+	target, err := url.Parse(provider.discovery.AuthorizationEndpoint)
+	if err != nil {
+		return "", errors.New("invalid authorization endpoint")
+	}
+	challenge := sha256.Sum256([]byte(verifier))
+
+	query := target.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", cfg.ClientID)
+	query.Set("redirect_uri", redirectURI)
+	query.Set("scope", "openid email profile")
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:]))
+	query.Set("code_challenge_method", "S256")
+	target.RawQuery = query.Encode()
+
+	return target.String(), nil
+}
+
+type idTokenClaims struct {
+	Email         string      `json:"email"`
+	EmailVerified interface{} `json:"email_verified"`
+	Name          string      `json:"name"`
+	Nonce         string      `json:"nonce"`
+	jwt.RegisteredClaims
+}
+
+// Exchange redeems an authorization code and verifies the returned ID token
+// against the provider's published keys.
+func (c *OIDCClient) Exchange(ctx context.Context, cfg OIDCConfig, redirectURI, code, nonce, verifier string) (*Identity, error) {
+	provider, err := c.provider(ctx, cfg.Issuer, false)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+		"code_verifier": {verifier},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, provider.discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(cfg.ClientID), url.QueryEscape(cfg.ClientSecret))
+
+	var tokens struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := c.do(req, &tokens); err != nil {
+		return nil, fmt.Errorf("token exchange failed: %w", err)
+	}
+	if tokens.IDToken == "" {
+		return nil, errors.New("identity provider returned no id token")
+	}
+
+	claims := &idTokenClaims{}
+	_, err = jwt.ParseWithClaims(tokens.IDToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return c.key(ctx, cfg.Issuer, kid)
+	},
+		jwt.WithValidMethods(idTokenMethods),
+		jwt.WithIssuer(provider.discovery.Issuer),
+		jwt.WithAudience(cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid id token: %w", err)
+	}
+	if claims.Nonce != nonce {
+		return nil, errors.New("id token nonce mismatch")
+	}
+	if claims.Email == "" {
+		return nil, errors.New("identity provider did not supply an email address")
+	}
+	if verified, ok := claims.EmailVerified.(bool); ok && !verified {
+		return nil, errors.New("identity provider has not verified the email address")
+	}
+	if s, ok := claims.EmailVerified.(string); ok && s == "false" {
+		return nil, errors.New("identity provider has not verified the email address")
+	}
+
+	return &Identity{Subject: claims.Subject, Email: claims.Email, Name: claims.Name}, nil
+}
+
+func (c *OIDCClient) provider(ctx context.Context, issuer string, refresh bool) (*cachedProvider, error) {
+	issuer = strings.TrimSuffix(issuer, "/")
+
+	c.mu.Lock()
+	cached, ok := c.providers[issuer]
+	c.mu.Unlock()
+	if ok && !refresh && time.Since(cached.fetchedAt) < discoveryTTL {
+		return cached, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+	doc := &discovery{}
+	if err := c.do(req, doc); err != nil {
+		return nil, fmt.Errorf("oidc discovery failed: %w", err)
+	}
+	if strings.TrimSuffix(doc.Issuer, "/") != issuer || doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JWKSURI == "" {
+		return nil, errors.New("oidc discovery document is incomplete or for another issuer")
+	}
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, doc.JWKSURI, nil)
+	if err != nil {
+		return nil, err
+	}
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := c.do(req, &set); err != nil {
+		return nil, fmt.Errorf("failed to fetch signing keys: %w", err)
+	}
+
+	keys := make(map[string]interface{}, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		if key, err := jwk.publicKey(); err == nil {
+			keys[jwk.Kid] = key
+		}
+	}
+
+	cached = &cachedProvider{discovery: doc, keys: keys, fetchedAt: time.Now()}
+	c.mu.Lock()
+	c.providers[issuer] = cached
+	c.mu.Unlock()
+	return cached, nil
+}
+
+// key finds the signing key by ID, refetching once so rotated keys are
+// picked up without waiting for the cache to expire.
+func (c *OIDCClient) key(ctx context.Context, issuer, kid string) (interface{}, error) {
+	for _, refresh := range []bool{false, true} {
+		provider, err := c.provider(ctx, issuer, refresh)
+		if err != nil {
+			return nil, err
+		}
+		if key, ok := provider.keys[kid]; ok {
+			return key, nil
+		}
+		if kid == "" && len(provider.keys) == 1 {
+			for _, key := range provider.keys {
+				return key, nil
+			}
+		}
+	}
+	return nil, errors.New("unknown signing key")
+}
+
+func (c *OIDCClient) do(req *http.Request, out interface{}) error {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return json.Unmarshal(body, out)
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jsonWebKey) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		default:
+			return nil, errors.New("unsupported curve")
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	default:
+		return nil, errors.New("unsupported key type")
+	}
+}
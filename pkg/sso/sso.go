@@ -0,0 +1,24 @@
+package sso
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+)
+
+// Identity is the user an identity provider vouched for. Subject is the
+// provider's stable identifier and, unlike Email, never changes.
+type Identity struct {
+	Subject string
+	Email   string
+	Name    string
+}
+
+// RandomToken returns n random bytes encoded for use in URLs, suitable for
+// state, nonce and PKCE verifier values.
+func RandomToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,97 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"time"
+
+	"linked-clone/pkg/breaker"
+)
+
+type breakerStorageService struct {
+	next StorageService
+	cb   *breaker.CircuitBreaker
+}
+
+func NewCircuitBreakerStorageService(next StorageService, cb *breaker.CircuitBreaker) StorageService {
+	return &breakerStorageService{next: next, cb: cb}
+}
+
+func IsFailure(err error) bool {
+	return !errors.Is(err, ErrFileNotFound) && breaker.DefaultIsFailure(err)
+}
+
+func (s *breakerStorageService) UploadImage(ctx context.Context, file *multipart.FileHeader, folder string) (string, error) {
+	if file != nil && !isImageFile(file.Filename) {
+		return "", fmt.Errorf("invalid image file type: %s", file.Filename)
+	}
+	if err := validateUpload(file); err != nil {
+		return "", err
+	}
+
+	var key string
+	err := s.cb.Execute(func() error {
+		var err error
+		key, err = s.next.UploadImage(ctx, file, folder)
+		return err
+	})
+	return key, err
+}
+
+func (s *breakerStorageService) UploadFile(ctx context.Context, file *multipart.FileHeader, folder string) (string, error) {
+	if err := validateUpload(file); err != nil {
+		return "", err
+	}
+
+	var key string
+	err := s.cb.Execute(func() error {
+		var err error
+		key, err = s.next.UploadFile(ctx, file, folder)
+		return err
+	})
+	return key, err
+}
+
+func (s *breakerStorageService) UploadBytes(ctx context.Context, data []byte, folder, ext string) (string, error) {
+	if len(data) == 0 {
+		return "", fmt.Errorf("data is empty")
+	}
+
+	var key string
+	err := s.cb.Execute(func() error {
+		var err error
+		key, err = s.next.UploadBytes(ctx, data, folder, ext)
+		return err
+	})
+	return key, err
+}
+
+func (s *breakerStorageService) DeleteFile(ctx context.Context, url string) error {
+	if url == "" {
+		return nil
+	}
+
+	return s.cb.Execute(func() error {
+		return s.next.DeleteFile(ctx, url)
+	})
+}
+
+func (s *breakerStorageService) GeneratePresignedURL(fileUrl string, expiry time.Duration) (string, error) {
+	if fileUrl == "" {
+		return "", nil
+	}
+
+	var url string
+	err := s.cb.Execute(func() error {
+		var err error
+		url, err = s.next.GeneratePresignedURL(fileUrl, expiry)
+		return err
+	})
+	return url, err
+}
+
+func (s *breakerStorageService) TestConnection() error {
+	return s.cb.Execute(s.next.TestConnection)
+}
@@ -0,0 +1,62 @@
+package storage
+
+import (
+	"context"
+	"mime/multipart"
+	"time"
+
+	"linked-clone/pkg/chaos"
+)
+
+type chaosStorageService struct {
+	next     StorageService
+	injector *chaos.Injector
+}
+
+// NewChaosStorageService injects the configured storage faults before each
+// call. It belongs inside the circuit breaker so injected failures trip it.
+func NewChaosStorageService(next StorageService, injector *chaos.Injector) StorageService {
+	return &chaosStorageService{next: next, injector: injector}
+}
+
+func (s *chaosStorageService) UploadImage(ctx context.Context, file *multipart.FileHeader, folder string) (string, error) {
+	if err := s.injector.Apply(ctx, chaos.DependencyStorage); err != nil {
+		return "", err
+	}
+	return s.next.UploadImage(ctx, file, folder)
+}
+
+func (s *chaosStorageService) UploadFile(ctx context.Context, file *multipart.FileHeader, folder string) (string, error) {
+	if err := s.injector.Apply(ctx, chaos.DependencyStorage); err != nil {
+		return "", err
+	}
+	return s.next.UploadFile(ctx, file, folder)
+}
+
+func (s *chaosStorageService) UploadBytes(ctx context.Context, data []byte, folder, ext string) (string, error) {
+	if err := s.injector.Apply(ctx, chaos.DependencyStorage); err != nil {
+		return "", err
+	}
+	return s.next.UploadBytes(ctx, data, folder, ext)
+}
+
+func (s *chaosStorageService) DeleteFile(ctx context.Context, url string) error {
+	if err := s.injector.Apply(ctx, chaos.DependencyStorage); err != nil {
+		return err
+	}
+	return s.next.DeleteFile(ctx, url)
+}
+
+func (s *chaosStorageService) GeneratePresignedURL(fileUrl string, expiry time.Duration) (string, error) {
+	if err := s.injector.Apply(context.Background(), chaos.DependencyStorage); err != nil {
+		return "", err
+	}
+	return s.next.GeneratePresignedURL(fileUrl, expiry)
+}
+
+func (s *chaosStorageService) TestConnection() error {
+	if err := s.injector.Apply(context.Background(), chaos.DependencyStorage); err != nil {
+		return err
+	}
+	return s.next.TestConnection()
+}
@@ -1,7 +1,9 @@
 package storage
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"mime/multipart"
 	"net/url"
@@ -9,50 +11,68 @@ import (
 	"strings"
 	"time"
 
+	apperrors "linked-clone/pkg/errors"
+	"linked-clone/pkg/retry"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/google/uuid"
 )
 
+const presignCheckTimeout = 5 * time.Second
+
+var ErrFileNotFound = errors.New("file not found in S3")
+
 type StorageService interface {
 	UploadImage(ctx context.Context, file *multipart.FileHeader, folder string) (string, error)
 	UploadFile(ctx context.Context, file *multipart.FileHeader, folder string) (string, error)
+	UploadBytes(ctx context.Context, data []byte, folder, ext string) (string, error)
 	DeleteFile(ctx context.Context, url string) error
 	GeneratePresignedURL(fileUrl string, expiry time.Duration) (string, error)
 	TestConnection() error
 }
 
 type s3StorageService struct {
-	s3Client *s3.S3
-	uploader *s3manager.Uploader
-	bucket   string
-	region   string
-	session  *session.Session
+	s3Client    *s3.S3
+	uploader    *s3manager.Uploader
+	bucket      string
+	region      string
+	session     *session.Session
+	retryPolicy retry.Policy
 }
 
-func NewS3StorageService(accessKey, secretKey, region, bucket string) StorageService {
+func NewS3StorageService(accessKey, secretKey, region, bucket string, retryPolicy retry.Policy) (StorageService, error) {
+	if region == "" {
+		return nil, fmt.Errorf("AWS region is required")
+	}
+	if bucket == "" {
+		return nil, fmt.Errorf("S3 bucket name is required")
+	}
 
 	sess, err := session.NewSession(&aws.Config{
 		Region:      aws.String(region),
 		Credentials: credentials.NewStaticCredentials(accessKey, secretKey, ""),
+		MaxRetries:  aws.Int(0),
 
 		LogLevel: aws.LogLevel(aws.LogDebugWithHTTPBody),
 	})
 	if err != nil {
-		panic(fmt.Sprintf("Failed to create AWS session: %v", err))
+		return nil, fmt.Errorf("failed to create AWS session: %w", err)
 	}
 
 	return &s3StorageService{
-		s3Client: s3.New(sess),
-		uploader: s3manager.NewUploader(sess),
-		bucket:   bucket,
-		region:   region,
-		session:  sess,
-	}
+		s3Client:    s3.New(sess),
+		uploader:    s3manager.NewUploader(sess),
+		bucket:      bucket,
+		region:      region,
+		session:     sess,
+		retryPolicy: retryPolicy,
+	}, nil
 }
 
 func (s *s3StorageService) TestConnection() error {
@@ -94,25 +114,56 @@ func (s *s3StorageService) UploadFile(ctx context.Context, file *multipart.FileH
 	return s.uploadFile(ctx, file, folder)
 }
 
-func (s *s3StorageService) uploadFile(ctx context.Context, file *multipart.FileHeader, folder string) (string, error) {
+func (s *s3StorageService) UploadBytes(ctx context.Context, data []byte, folder, ext string) (string, error) {
+	if len(data) == 0 {
+		return "", fmt.Errorf("data is empty")
+	}
+
+	folder = strings.Trim(folder, "/")
+	if folder == "" {
+		folder = "uploads"
+	}
 
+	filename := fmt.Sprintf("%s/%s_%d%s", folder, uuid.New().String(), time.Now().Unix(), ext)
+
+	err := retry.Do(ctx, s.retryPolicy, func(ctx context.Context) error {
+		_, err := s.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+			Bucket:      aws.String(s.bucket),
+			Key:         aws.String(filename),
+			Body:        bytes.NewReader(data),
+			ContentType: aws.String(getContentType(ext)),
+			ACL:         aws.String("private"),
+		})
+		return classifyS3Error(err)
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to upload data to S3: %w", err)
+	}
+
+	return filename, nil
+}
+
+func validateUpload(file *multipart.FileHeader) error {
 	if file == nil {
-		return "", fmt.Errorf("file is nil")
+		return fmt.Errorf("file is nil")
 	}
 
 	if file.Size == 0 {
-		return "", fmt.Errorf("file is empty")
+		return fmt.Errorf("file is empty")
 	}
 
 	if file.Size > 100*1024*1024 {
-		return "", fmt.Errorf("file too large: %d bytes (max: 100MB)", file.Size)
+		return fmt.Errorf("file too large: %d bytes (max: 100MB)", file.Size)
 	}
 
-	src, err := file.Open()
-	if err != nil {
-		return "", fmt.Errorf("failed to open file %s: %w", file.Filename, err)
+	return nil
+}
+
+func (s *s3StorageService) uploadFile(ctx context.Context, file *multipart.FileHeader, folder string) (string, error) {
+
+	if err := validateUpload(file); err != nil {
+		return "", err
 	}
-	defer src.Close()
 
 	ext := filepath.Ext(file.Filename)
 	if ext == "" {
@@ -127,22 +178,30 @@ func (s *s3StorageService) uploadFile(ctx context.Context, file *multipart.FileH
 	filename := fmt.Sprintf("%s/%s_%d%s", folder, uuid.New().String(), time.Now().Unix(), ext)
 	contentType := getContentType(ext)
 
-	uploadInput := &s3manager.UploadInput{
-		Bucket:      aws.String(s.bucket),
-		Key:         aws.String(filename),
-		Body:        src,
-		ContentType: aws.String(contentType),
-		ACL:         aws.String("private"),
-		Metadata: map[string]*string{
-			"original-filename": aws.String(file.Filename),
-			"uploaded-at":       aws.String(time.Now().UTC().Format(time.RFC3339)),
-		},
-	}
-
-	result, err := s.uploader.UploadWithContext(ctx, uploadInput)
+	err := retry.Do(ctx, s.retryPolicy, func(ctx context.Context) error {
+		src, err := file.Open()
+		if err != nil {
+			return fmt.Errorf("failed to open file %s: %w", file.Filename, err)
+		}
+		defer src.Close()
+
+		_, err = s.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+			Bucket:      aws.String(s.bucket),
+			Key:         aws.String(filename),
+			Body:        src,
+			ContentType: aws.String(contentType),
+			ACL:         aws.String("private"),
+			Metadata: map[string]*string{
+				"original-filename": aws.String(file.Filename),
+				"uploaded-at":       aws.String(time.Now().UTC().Format(time.RFC3339)),
+			},
+		})
+		return classifyS3Error(err)
+	})
 	if err != nil {
 
-		if aerr, ok := err.(awserr.Error); ok {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) {
 			switch aerr.Code() {
 			case "AccessDenied":
 				return "", fmt.Errorf("access denied to bucket %s: %v", s.bucket, aerr)
@@ -163,27 +222,29 @@ func (s *s3StorageService) uploadFile(ctx context.Context, file *multipart.FileH
 		return "", fmt.Errorf("failed to upload file to S3: %w", err)
 	}
 
-	if err := s.verifyUpload(filename); err != nil {
+	if err := s.verifyUpload(ctx, filename); err != nil {
 		return "", fmt.Errorf("upload verification failed: %w", err)
 	}
 
-	if result.Location != "" {
-		return filename, nil
-	}
-
 	return filename, nil
 }
 
-func (s *s3StorageService) verifyUpload(key string) error {
+func (s *s3StorageService) headObject(ctx context.Context, key string) error {
+	return retry.Do(ctx, s.retryPolicy, func(ctx context.Context) error {
+		_, err := s.s3Client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+			Bucket: aws.String(s.bucket),
+			Key:    aws.String(key),
+		})
+		return classifyS3Error(err)
+	})
+}
 
-	input := &s3.HeadObjectInput{
-		Bucket: aws.String(s.bucket),
-		Key:    aws.String(key),
-	}
+func (s *s3StorageService) verifyUpload(ctx context.Context, key string) error {
 
-	_, err := s.s3Client.HeadObject(input)
+	err := s.headObject(ctx, key)
 	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) {
 			if aerr.Code() == "NotFound" {
 				return fmt.Errorf("uploaded file not found in S3")
 			}
@@ -201,15 +262,15 @@ func (s *s3StorageService) GeneratePresignedURL(fileKey string, expiry time.Dura
 
 	key := extractKeyFromS3Url(fileKey)
 
-	_, err := s.s3Client.HeadObject(&s3.HeadObjectInput{
-		Bucket: aws.String(s.bucket),
-		Key:    aws.String(key),
-	})
-	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
+	ctx, cancel := context.WithTimeout(context.Background(), presignCheckTimeout)
+	defer cancel()
+
+	if err := s.headObject(ctx, key); err != nil && !retry.IsRetryable(err) {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) {
 			switch aerr.Code() {
 			case "NotFound":
-				return "", fmt.Errorf("file not found in S3: %s", key)
+				return "", fmt.Errorf("%w: %s", ErrFileNotFound, key)
 			case "AccessDenied":
 				return "", fmt.Errorf("access denied to file: %s", key)
 			default:
@@ -312,6 +373,10 @@ func getContentType(ext string) string {
 		".xls":  "application/vnd.ms-excel",
 		".mp4":  "video/mp4",
 		".mp3":  "audio/mpeg",
+		".m4a":  "audio/mp4",
+		".ogg":  "audio/ogg",
+		".wav":  "audio/wav",
+		".webm": "audio/webm",
 	}
 
 	ext = strings.ToLower(ext)
@@ -322,6 +387,16 @@ func getContentType(ext string) string {
 	return "application/octet-stream"
 }
 
+func GetContentType(filename string) string {
+	return getContentType(filepath.Ext(filename))
+}
+
+// ObjectKey returns the bucket key for a stored file reference, which may be
+// a bare key or a full S3 URL.
+func ObjectKey(fileUrl string) string {
+	return extractKeyFromS3Url(fileUrl)
+}
+
 func isImageFile(filename string) bool {
 	ext := strings.ToLower(filepath.Ext(filename))
 	imageExts := []string{".jpg", ".jpeg", ".png", ".gif", ".webp", ".bmp", ".tiff"}
@@ -361,3 +436,13 @@ func ValidateAWSCredentials(accessKey, secretKey, region, bucket string) error {
 
 	return nil
 }
+
+func classifyS3Error(err error) error {
+	if err == nil {
+		return nil
+	}
+	if request.IsErrorRetryable(err) || request.IsErrorThrottle(err) {
+		return apperrors.ExternalServiceError("s3", err)
+	}
+	return err
+}
@@ -0,0 +1,10 @@
+package taxonomy
+
+// LocationScopeSQL selects a location and every finer location inside it, so
+// filtering by a country also finds entries tagged with one of its cities.
+// The single placeholder is the ID of the location being filtered on.
+const LocationScopeSQL = `SELECT l.id FROM locations l
+	JOIN locations scope ON scope.id = ?
+	WHERE l.country = scope.country
+	  AND (scope.region = '' OR l.region = scope.region)
+	  AND (scope.city = '' OR l.city = scope.city)`
@@ -0,0 +1,89 @@
+package taxonomy
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Normalize lowercases s, drops punctuation other than commas and collapses
+// whitespace, so "  São Paulo ,BR." and "são paulo, br" compare equal.
+func Normalize(s string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(s) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			space = false
+			b.WriteRune(r)
+		case r == ',':
+			b.WriteByte(',')
+			space = false
+		default:
+			space = true
+		}
+	}
+
+	parts := strings.Split(b.String(), ",")
+	kept := parts[:0]
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			kept = append(kept, part)
+		}
+	}
+	return strings.Join(kept, ", ")
+}
+
+// Matcher maps free text onto reference IDs by exact normalized name. A name
+// registered for two different IDs is ambiguous and never matches, so
+// "Springfield" stays unmatched rather than guessing a state.
+type Matcher struct {
+	names     map[string]uint
+	ambiguous map[string]bool
+}
+
+func NewMatcher() *Matcher {
+	return &Matcher{names: make(map[string]uint), ambiguous: make(map[string]bool)}
+}
+
+func (m *Matcher) Add(id uint, names ...string) {
+	for _, name := range names {
+		key := Normalize(name)
+		if key == "" || m.ambiguous[key] {
+			continue
+		}
+		if existing, ok := m.names[key]; ok && existing != id {
+			delete(m.names, key)
+			m.ambiguous[key] = true
+			continue
+		}
+		m.names[key] = id
+	}
+}
+
+// Match tries the whole text first, then falls back to its first and last
+// comma-separated parts ("Bandung, West Java, Indonesia" as "bandung,
+// indonesia"), and finally the first part alone.
+func (m *Matcher) Match(text string) (uint, bool) {
+	key := Normalize(text)
+	if key == "" {
+		return 0, false
+	}
+	if id, ok := m.names[key]; ok {
+		return id, true
+	}
+
+	parts := strings.Split(key, ", ")
+	if len(parts) < 2 {
+		return 0, false
+	}
+	if len(parts) > 2 {
+		if id, ok := m.names[parts[0]+", "+parts[len(parts)-1]]; ok {
+			return id, true
+		}
+	}
+	id, ok := m.names[parts[0]]
+	return id, ok
+}
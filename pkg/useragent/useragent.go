@@ -0,0 +1,145 @@
+// Package useragent turns a User-Agent header into the few facts worth
+// showing on a session list: the kind of device, its operating system and
+// the browser or app. It recognises the clients people actually sign in
+// with rather than every agent in the wild; anything else comes back with
+// empty fields and the device set to DeviceUnknown.
+package useragent
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	DeviceDesktop = "desktop"
+	DeviceMobile  = "mobile"
+	DeviceTablet  = "tablet"
+	DeviceBot     = "bot"
+	DeviceUnknown = "unknown"
+)
+
+// Info is what Parse makes of an agent. BrowserVersion is the major version
+// only; the rest changes with every update and would make one device look
+// like many.
+type Info struct {
+	Device         string
+	OS             string
+	OSVersion      string
+	Browser        string
+	BrowserVersion string
+}
+
+type pattern struct {
+	name string
+	re   *regexp.Regexp
+}
+
+// Order matters: many browsers carry the tokens of the ones they are built
+// on, so Edge says Chrome and Safari, and Chrome says Safari.
+var browsers = []pattern{
+	{"Edge", regexp.MustCompile(`(?:Edg|EdgA|EdgiOS|Edge)/([\d.]+)`)},
+	{"Opera", regexp.MustCompile(`(?:OPR|OPiOS|Opera)/([\d.]+)`)},
+	{"Samsung Internet", regexp.MustCompile(`SamsungBrowser/([\d.]+)`)},
+	{"Firefox", regexp.MustCompile(`(?:Firefox|FxiOS)/([\d.]+)`)},
+	{"Chrome", regexp.MustCompile(`(?:Chrome|CriOS)/([\d.]+)`)},
+	{"Safari", regexp.MustCompile(`Version/([\d.]+).*Safari/`)},
+	{"Internet Explorer", regexp.MustCompile(`(?:MSIE |Trident/.*rv:)([\d.]+)`)},
+	{"curl", regexp.MustCompile(`^curl/([\d.]+)`)},
+	{"Postman", regexp.MustCompile(`PostmanRuntime/([\d.]+)`)},
+	{"okhttp", regexp.MustCompile(`okhttp/([\d.]+)`)},
+}
+
+var (
+	windowsVersion = regexp.MustCompile(`Windows NT ([\d.]+)`)
+	iosVersion     = regexp.MustCompile(`OS (\d+(?:_\d+)*) like Mac OS X`)
+	macVersion     = regexp.MustCompile(`Mac OS X (\d+(?:[_.]\d+)*)`)
+	androidVersion = regexp.MustCompile(`Android ([\d.]+)`)
+	chromeOS       = regexp.MustCompile(`CrOS \S+ ([\d.]+)`)
+	botToken       = regexp.MustCompile(`(?i)bot|crawler|spider|slurp|facebookexternalhit|headless`)
+)
+
+var windowsReleases = map[string]string{
+	"10.0": "10",
+	"6.3":  "8.1",
+	"6.2":  "8",
+	"6.1":  "7",
+}
+
+// Parse reads ua. It never fails; unrecognised parts stay empty.
+func Parse(ua string) Info {
+	ua = strings.TrimSpace(ua)
+	if ua == "" {
+		return Info{Device: DeviceUnknown}
+	}
+
+	info := Info{}
+	info.OS, info.OSVersion = parseOS(ua)
+
+	for _, b := range browsers {
+		if match := b.re.FindStringSubmatch(ua); match != nil {
+			info.Browser = b.name
+			info.BrowserVersion = majorVersion(match[1])
+			break
+		}
+	}
+
+	info.Device = device(ua, info.OS)
+	return info
+}
+
+func parseOS(ua string) (string, string) {
+	switch {
+	case strings.Contains(ua, "iPhone"), strings.Contains(ua, "iPad"), strings.Contains(ua, "iPod"):
+		if match := iosVersion.FindStringSubmatch(ua); match != nil {
+			return "iOS", strings.ReplaceAll(match[1], "_", ".")
+		}
+		return "iOS", ""
+	case strings.Contains(ua, "Android"):
+		if match := androidVersion.FindStringSubmatch(ua); match != nil {
+			return "Android", match[1]
+		}
+		return "Android", ""
+	case strings.Contains(ua, "Windows"):
+		if match := windowsVersion.FindStringSubmatch(ua); match != nil {
+			if release, ok := windowsReleases[match[1]]; ok {
+				return "Windows", release
+			}
+		}
+		return "Windows", ""
+	case strings.Contains(ua, "CrOS"):
+		if match := chromeOS.FindStringSubmatch(ua); match != nil {
+			return "ChromeOS", match[1]
+		}
+		return "ChromeOS", ""
+	case strings.Contains(ua, "Macintosh"), strings.Contains(ua, "Mac OS X"):
+		if match := macVersion.FindStringSubmatch(ua); match != nil {
+			return "macOS", strings.ReplaceAll(match[1], "_", ".")
+		}
+		return "macOS", ""
+	case strings.Contains(ua, "Linux"):
+		return "Linux", ""
+	}
+	return "", ""
+}
+
+func device(ua, os string) string {
+	switch {
+	case botToken.MatchString(ua):
+		return DeviceBot
+	case strings.Contains(ua, "iPad"), strings.Contains(ua, "Tablet"):
+		return DeviceTablet
+	// Android tablets leave "Mobile" out of the agent.
+	case os == "Android" && !strings.Contains(ua, "Mobile"):
+		return DeviceTablet
+	case strings.Contains(ua, "Mobi"), os == "iOS", os == "Android":
+		return DeviceMobile
+	case os != "":
+		return DeviceDesktop
+	}
+	return DeviceUnknown
+}
+
+func majorVersion(version string) string {
+	major, _, _ := strings.Cut(version, ".")
+	return major
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -39,10 +40,12 @@ func (suite *AuthTestSuite) TestUserRegistration() {
 		)
 
 		reqBody := map[string]string{
-			"email":     "duplicate@example.com",
-			"username":  "user2",
-			"full_name": "User Two",
-			"password":  "password123",
+			"email":         "duplicate@example.com",
+			"username":      "user2",
+			"full_name":     "User Two",
+			"password":      "password123",
+			"date_of_birth": "1990-01-01",
+			"region":        "US",
 		}
 
 		w := suite.AuthHelper.MakeAuthenticatedRequest("POST", "/api/v1/auth/register", "", reqBody)
@@ -65,6 +68,25 @@ func (suite *AuthTestSuite) TestUserRegistration() {
 		w := suite.AuthHelper.MakeAuthenticatedRequest("POST", "/api/v1/auth/register", "", reqBody)
 		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
 	})
+
+	suite.Run("below regional minimum age", func() {
+		reqBody := map[string]string{
+			"email":         "young@example.com",
+			"username":      "younguser",
+			"full_name":     "Young User",
+			"password":      "password123",
+			"date_of_birth": time.Now().AddDate(-14, 0, 0).Format("2006-01-02"),
+			"region":        "DE",
+		}
+
+		w := suite.AuthHelper.MakeAuthenticatedRequest("POST", "/api/v1/auth/register", "", reqBody)
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "VALIDATION_ERROR", response["error"].(map[string]interface{})["code"])
+	})
 }
 
 func (suite *AuthTestSuite) TestUserLogin() {
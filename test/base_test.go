@@ -1,10 +1,12 @@
 package test
 
 import (
+	"context"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/suite"
 	"linked-clone/internal/config/server"
 	"linked-clone/pkg/logger"
+	"linked-clone/pkg/redis"
 	testConfig "linked-clone/test/config"
 	testDB "linked-clone/test/database"
 	"linked-clone/test/helpers"
@@ -15,6 +17,7 @@ type BaseTestSuite struct {
 	suite.Suite
 	Router     *gin.Engine
 	TestDB     *testDB.TestDB
+	Redis      redis.RedisClient
 	AuthHelper *helpers.AuthHelper
 }
 
@@ -30,6 +33,16 @@ func (suite *BaseTestSuite) SetupSuite() {
 	suite.Require().NoError(err, "Failed to setup test database")
 	suite.TestDB = db
 
+	redisClient, err := redis.NewRedisClient(redis.Config{
+		Host:     cfg.Redis.Host,
+		Port:     cfg.Redis.Port,
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	})
+	if err == nil {
+		suite.Redis = redisClient
+	}
+
 	structuredLogger := logger.NewStructuredLogger()
 
 	srv, err := server.NewServer(cfg, db.DB, structuredLogger)
@@ -49,4 +62,15 @@ func (suite *BaseTestSuite) SetupTest() {
 
 	err := suite.TestDB.Clean()
 	suite.Require().NoError(err, "Failed to clean test database")
+
+	if suite.Redis != nil {
+		ctx := context.Background()
+		for _, pattern := range []string{"graph:*", "feed:*"} {
+			keys, err := suite.Redis.Keys(ctx, pattern)
+			suite.Require().NoError(err, "Failed to list cached keys")
+			for _, key := range keys {
+				suite.Require().NoError(suite.Redis.Delete(ctx, key), "Failed to clean cached keys")
+			}
+		}
+	}
 }
@@ -19,6 +19,10 @@ func LoadTestConfig() *config.Config {
 	os.Setenv("REDIS_PORT", "6379")
 	os.Setenv("REDIS_DB", "1")
 	os.Setenv("LOG_LEVEL", "error")
+	os.Setenv("SIGNUP_IP_LIMIT", "0")
+	os.Setenv("SIGNUP_DOMAIN_LIMIT", "0")
+	os.Setenv("PASSWORD_MIN_CHARACTER_CLASSES", "2")
+	os.Setenv("PASSWORD_BLOCK_COMMON", "false")
 
 	cfg, err := config.Load()
 	if err != nil {
@@ -0,0 +1,133 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"linked-clone/test/helpers"
+)
+
+type ConnectionTestSuite struct {
+	BaseTestSuite
+}
+
+func (suite *ConnectionTestSuite) connect(requester, addressee *helpers.TestUser) {
+	reqBody := map[string]interface{}{"user_id": addressee.ID}
+	w := suite.AuthHelper.MakeAuthenticatedRequest("POST", "/api/v1/users/connections/request", requester.Token, reqBody)
+	suite.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+
+	var response struct {
+		Data struct {
+			ID uint `json:"id"`
+		} `json:"data"`
+	}
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+
+	path := fmt.Sprintf("/api/v1/users/connections/%d/accept", response.Data.ID)
+	w = suite.AuthHelper.MakeAuthenticatedRequest("POST", path, addressee.Token, nil)
+	suite.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+}
+
+func (suite *ConnectionTestSuite) TestMutualConnections() {
+	alice := suite.AuthHelper.RegisterUser("alice@example.com", "alice", "Alice Example", "password123")
+	bob := suite.AuthHelper.RegisterUser("bob@example.com", "bob", "Bob Example", "password123")
+	carol := suite.AuthHelper.RegisterUser("carol@example.com", "carol", "Carol Example", "password123")
+	dave := suite.AuthHelper.RegisterUser("dave@example.com", "dave", "Dave Example", "password123")
+	erin := suite.AuthHelper.RegisterUser("erin@example.com", "erin", "Erin Example", "password123")
+
+	suite.connect(alice, carol)
+	suite.connect(carol, bob)
+	suite.connect(alice, dave)
+	suite.connect(bob, erin)
+
+	pending := map[string]interface{}{"user_id": dave.ID}
+	w := suite.AuthHelper.MakeAuthenticatedRequest("POST", "/api/v1/users/connections/request", bob.Token, pending)
+	suite.Require().Equal(http.StatusOK, w.Code)
+
+	suite.Run("lists only shared accepted connections", func() {
+		path := fmt.Sprintf("/api/v1/users/connections/mutual/%d", bob.ID)
+		w := suite.AuthHelper.MakeAuthenticatedRequest("GET", path, alice.Token, nil)
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+		var response struct {
+			Data struct {
+				MutualConnections []struct {
+					RequesterID uint `json:"requester_id"`
+					AddresseeID uint `json:"addressee_id"`
+				} `json:"mutual_connections"`
+				Total int `json:"total"`
+			} `json:"data"`
+		}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), 1, response.Data.Total)
+		if assert.Len(suite.T(), response.Data.MutualConnections, 1) {
+			connection := response.Data.MutualConnections[0]
+			assert.ElementsMatch(suite.T(), []uint{alice.ID, carol.ID}, []uint{connection.RequesterID, connection.AddresseeID})
+		}
+	})
+
+	suite.Run("returns mutual count on profile view", func() {
+		path := fmt.Sprintf("/api/v1/users/%d", bob.ID)
+		w := suite.AuthHelper.MakeAuthenticatedRequest("GET", path, alice.Token, nil)
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(suite.T(), err)
+		data := response["data"].(map[string]interface{})
+		assert.Equal(suite.T(), float64(1), data["mutual_connections_count"])
+	})
+
+	suite.Run("returns mutual count in search results", func() {
+		w := suite.AuthHelper.MakeAuthenticatedRequest("GET", "/api/v1/users/search?q=erin", alice.Token, nil)
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(suite.T(), err)
+		users := response["data"].(map[string]interface{})["users"].([]interface{})
+		if assert.Len(suite.T(), users, 1) {
+			assert.Equal(suite.T(), float64(0), users[0].(map[string]interface{})["mutual_connections_count"])
+		}
+	})
+
+	suite.Run("omits mutual count for anonymous viewers", func() {
+		path := fmt.Sprintf("/api/v1/users/%d", bob.ID)
+		w := suite.AuthHelper.MakeAuthenticatedRequest("GET", path, "", nil)
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(suite.T(), err)
+		assert.NotContains(suite.T(), response["data"].(map[string]interface{}), "mutual_connections_count")
+	})
+
+	suite.Run("removing a connection updates the count", func() {
+		w := suite.AuthHelper.MakeAuthenticatedRequest("POST", fmt.Sprintf("/api/v1/users/connections/block/%d", carol.ID), bob.Token, nil)
+		suite.Require().Equal(http.StatusOK, w.Code)
+
+		path := fmt.Sprintf("/api/v1/users/connections/mutual/%d", bob.ID)
+		w = suite.AuthHelper.MakeAuthenticatedRequest("GET", path, alice.Token, nil)
+		assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+		var response struct {
+			Data struct {
+				MutualConnections []interface{} `json:"mutual_connections"`
+				Total             int           `json:"total"`
+			} `json:"data"`
+		}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), 0, response.Data.Total)
+		assert.Empty(suite.T(), response.Data.MutualConnections)
+	})
+}
+
+func TestConnectionTestSuite(t *testing.T) {
+	suite.Run(t, new(ConnectionTestSuite))
+}
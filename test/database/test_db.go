@@ -21,10 +21,77 @@ func NewTestDB(cfg *config.Config) (*TestDB, error) {
 	err = db.AutoMigrate(
 		&entities.User{},
 		&entities.Post{},
-		&entities.Like{},
+		&entities.Reaction{},
 		&entities.Comment{},
+		&entities.CommentReaction{},
+		&entities.Hashtag{},
+		&entities.PostHashtag{},
+		&entities.HashtagFollow{},
+		&entities.Mention{},
+		&entities.Company{},
+		&entities.CompanyVerification{},
+		&entities.CompanyMember{},
+		&entities.CompanyTeam{},
+		&entities.TeamMember{},
+		&entities.IdentityVerification{},
+		&entities.IdentityVerificationAudit{},
 		&entities.Job{},
+		&entities.JobTemplate{},
 		&entities.Application{},
+		&entities.ApplicationStatusHistory{},
+		&entities.Notification{},
+		&entities.Conversation{},
+		&entities.ConversationParticipant{},
+		&entities.Message{},
+		&entities.MessageAttachment{},
+		&entities.AnalyticsEvent{},
+		&entities.ViewRollup{},
+		&entities.PolicyVersion{},
+		&entities.PolicyAcceptance{},
+		&entities.AccountDeletion{},
+		&entities.UploadJob{},
+		&entities.ConnectionImport{},
+		&entities.ConnectionSuggestion{},
+		&entities.Experience{},
+		&entities.PostSuggestion{},
+		&entities.ReminderRun{},
+		&entities.RecentSearch{},
+		&entities.OutboundEmail{},
+		&entities.DataExportRun{},
+		&entities.Experiment{},
+		&entities.ExperimentAssignment{},
+		&entities.SkillAssessment{},
+		&entities.AssessmentQuestion{},
+		&entities.AssessmentAttempt{},
+		&entities.SkillBadge{},
+		&entities.Course{},
+		&entities.Lesson{},
+		&entities.CourseEnrollment{},
+		&entities.LessonCompletion{},
+		&entities.CourseCertificate{},
+		&entities.MentorshipProfile{},
+		&entities.MentorshipMatch{},
+		&entities.Recommendation{},
+		&entities.RecommendationRevision{},
+		&entities.ProfileSection{},
+		&entities.Industry{},
+		&entities.Location{},
+		&entities.SavedJob{},
+		&entities.SavedPost{},
+		&entities.HiddenPost{},
+		&entities.JobAlert{},
+		&entities.CompanyFollow{},
+		&entities.CompanyDailyStats{},
+		&entities.JobDailyStats{},
+		&entities.RecoveryCode{},
+		&entities.SecurityEvent{},
+		&entities.CompanySSOConfig{},
+		&entities.SSOIdentity{},
+		&entities.APIKey{},
+		&entities.Invite{},
+		&entities.AdminAuditLog{},
+		&entities.Report{},
+		&entities.Strike{},
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to migrate test database: %w", err)
@@ -36,7 +103,8 @@ func NewTestDB(cfg *config.Config) (*TestDB, error) {
 func (tdb *TestDB) Clean() error {
 
 	tables := []string{
-		"likes", "comments", "applications", "posts", "jobs", "users",
+		"job_daily_stats", "company_daily_stats", "company_follows", "job_alerts", "hidden_posts", "saved_posts", "saved_jobs", "profile_sections", "recommendation_revisions", "recommendations", "mentorship_matches", "mentorship_profiles", "course_certificates", "lesson_completions", "course_enrollments", "lessons", "courses", "skill_badges", "assessment_attempts", "assessment_questions", "skill_assessments", "strikes", "reports", "admin_audit_logs", "invites", "api_keys", "sso_identities", "company_sso_configs", "security_events", "recovery_codes", "experiment_assignments", "experiments", "data_export_runs", "outbound_emails", "recent_searches", "reminder_runs", "post_suggestions", "experiences", "connection_suggestions", "connection_imports", "upload_jobs", "account_deletions", "policy_acceptances", "policy_versions", "view_rollups", "analytics_events", "message_attachments", "messages", "conversation_participants", "conversations", "notifications",
+		"mentions", "hashtag_follows", "post_hashtags", "hashtags", "reactions", "comment_reactions", "comments", "application_status_histories", "applications", "posts", "job_templates", "jobs", "team_members", "company_teams", "company_verifications", "company_members", "companies", "identity_verification_audits", "identity_verifications", "users", "locations", "industries",
 	}
 
 	for _, table := range tables {
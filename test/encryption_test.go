@@ -0,0 +1,164 @@
+package test
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"linked-clone/pkg/encryption"
+)
+
+type EncryptionTestSuite struct {
+	suite.Suite
+	oldKey []byte
+	newKey []byte
+}
+
+func (suite *EncryptionTestSuite) SetupTest() {
+	suite.oldKey = []byte(strings.Repeat("a", 32))
+	suite.newKey = []byte(strings.Repeat("b", 32))
+}
+
+func (suite *EncryptionTestSuite) keyring(keys map[string][]byte, primary string) *encryption.Keyring {
+	keyring, err := encryption.NewKeyring(keys, primary)
+	suite.Require().NoError(err)
+	return keyring
+}
+
+func (suite *EncryptionTestSuite) TestRoundTrip() {
+	keyring := suite.keyring(map[string][]byte{"k1": suite.oldKey}, "k1")
+
+	for _, plaintext := range []string{"", "secret", "ünïcode ✓", strings.Repeat("x", 4096)} {
+		encrypted, err := keyring.Encrypt(plaintext, "users.totp_secret:1")
+		suite.Require().NoError(err)
+		suite.True(encryption.IsEncrypted(encrypted))
+		suite.True(strings.HasPrefix(encrypted, "enc:k1:"))
+		if plaintext != "" {
+			suite.NotContains(encrypted, plaintext)
+		}
+
+		decrypted, err := keyring.Decrypt(encrypted, "users.totp_secret:1")
+		suite.Require().NoError(err)
+		suite.Equal(plaintext, decrypted)
+	}
+
+	suite.Run("uses a fresh nonce per value", func() {
+		first, err := keyring.Encrypt("same", "")
+		suite.Require().NoError(err)
+		second, err := keyring.Encrypt("same", "")
+		suite.Require().NoError(err)
+		suite.NotEqual(first, second)
+	})
+
+	suite.Run("passes plaintext through", func() {
+		decrypted, err := keyring.Decrypt("stored before encryption", "")
+		suite.NoError(err)
+		suite.Equal("stored before encryption", decrypted)
+	})
+}
+
+func (suite *EncryptionTestSuite) TestKeyRotation() {
+	old := suite.keyring(map[string][]byte{"k1": suite.oldKey}, "k1")
+	encrypted, err := old.Encrypt("secret", "ad")
+	suite.Require().NoError(err)
+
+	rotated := suite.keyring(map[string][]byte{"k1": suite.oldKey, "k2": suite.newKey}, "k2")
+
+	suite.Run("still reads values under the old key", func() {
+		decrypted, err := rotated.Decrypt(encrypted, "ad")
+		suite.Require().NoError(err)
+		suite.Equal("secret", decrypted)
+	})
+
+	suite.Run("flags old values for rotation", func() {
+		suite.True(rotated.NeedsRotation(encrypted))
+		suite.True(rotated.NeedsRotation("plaintext"))
+		suite.False(rotated.NeedsRotation(""))
+	})
+
+	suite.Run("writes new values under the primary key", func() {
+		reencrypted, err := rotated.Encrypt("secret", "ad")
+		suite.Require().NoError(err)
+		suite.True(strings.HasPrefix(reencrypted, "enc:k2:"))
+		suite.False(rotated.NeedsRotation(reencrypted))
+	})
+
+	suite.Run("fails once the old key is dropped", func() {
+		retired := suite.keyring(map[string][]byte{"k2": suite.newKey}, "k2")
+		_, err := retired.Decrypt(encrypted, "ad")
+		suite.True(errors.Is(err, encryption.ErrUnknownKey))
+	})
+}
+
+func (suite *EncryptionTestSuite) TestTampering() {
+	keyring := suite.keyring(map[string][]byte{"k1": suite.oldKey, "k2": suite.newKey}, "k1")
+	encrypted, err := keyring.Encrypt("secret", "users.totp_secret:1")
+	suite.Require().NoError(err)
+
+	encoded := strings.TrimPrefix(encrypted, "enc:k1:")
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	suite.Require().NoError(err)
+
+	suite.Run("rejects a flipped ciphertext bit", func() {
+		for _, i := range []int{0, len(sealed) / 2, len(sealed) - 1} {
+			flipped := append([]byte(nil), sealed...)
+			flipped[i] ^= 0x01
+			_, err := keyring.Decrypt("enc:k1:"+base64.StdEncoding.EncodeToString(flipped), "users.totp_secret:1")
+			suite.Error(err, "byte %d", i)
+		}
+	})
+
+	suite.Run("rejects a value moved to another row", func() {
+		_, err := keyring.Decrypt(encrypted, "users.totp_secret:2")
+		suite.Error(err)
+	})
+
+	suite.Run("rejects a value relabelled with another key", func() {
+		_, err := keyring.Decrypt("enc:k2:"+encoded, "users.totp_secret:1")
+		suite.Error(err)
+	})
+
+	suite.Run("rejects malformed values", func() {
+		for _, value := range []string{"enc:", "enc:k1", "enc:k1:not base64", "enc:k1:" + base64.StdEncoding.EncodeToString([]byte("short"))} {
+			_, err := keyring.Decrypt(value, "")
+			suite.True(errors.Is(err, encryption.ErrMalformedValue), "value %q: %v", value, err)
+		}
+	})
+}
+
+func (suite *EncryptionTestSuite) TestConfiguration() {
+	suite.Run("refuses to encrypt without keys", func() {
+		keyring := suite.keyring(nil, "")
+		suite.False(keyring.Enabled())
+		_, err := keyring.Encrypt("secret", "")
+		suite.True(errors.Is(err, encryption.ErrEncryptionMissing))
+	})
+
+	suite.Run("rejects bad keys", func() {
+		_, err := encryption.NewKeyring(map[string][]byte{"k1": []byte("too short")}, "k1")
+		suite.Error(err)
+		_, err = encryption.NewKeyring(map[string][]byte{"k:1": suite.oldKey}, "k:1")
+		suite.Error(err)
+		_, err = encryption.NewKeyring(map[string][]byte{"k1": suite.oldKey}, "k2")
+		suite.Error(err)
+	})
+
+	suite.Run("parses key specs", func() {
+		spec := "k1:" + base64.StdEncoding.EncodeToString(suite.oldKey) + ", k2:" + base64.StdEncoding.EncodeToString(suite.newKey)
+		keys, err := encryption.ParseKeys(spec)
+		suite.Require().NoError(err)
+		suite.Equal(suite.oldKey, keys["k1"])
+		suite.Equal(suite.newKey, keys["k2"])
+
+		_, err = encryption.ParseKeys("k1")
+		suite.Error(err)
+		_, err = encryption.ParseKeys("k1:%%%")
+		suite.Error(err)
+	})
+}
+
+func TestEncryptionSuite(t *testing.T) {
+	suite.Run(t, new(EncryptionTestSuite))
+}
@@ -0,0 +1,170 @@
+package test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"linked-clone/pkg/feed"
+)
+
+type FeedRankingTestSuite struct {
+	suite.Suite
+	now time.Time
+}
+
+func (suite *FeedRankingTestSuite) SetupTest() {
+	suite.now = time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+}
+
+func (suite *FeedRankingTestSuite) ago(hours float64) time.Time {
+	return suite.now.Add(-time.Duration(hours * float64(time.Hour)))
+}
+
+func (suite *FeedRankingTestSuite) TestScore() {
+	recency := feed.Weights{Recency: 1, HalfLifeHours: 24}
+	degree := feed.Weights{Degree: 1, HalfLifeHours: 24}
+	engagement := feed.Weights{Engagement: 1, HalfLifeHours: 24}
+	affinity := feed.Weights{Affinity: 1, HalfLifeHours: 24}
+	feedback := feed.Weights{Feedback: 1, HalfLifeHours: 24}
+
+	cases := []struct {
+		name      string
+		weights   feed.Weights
+		candidate feed.Candidate
+		want      float64
+	}{
+		{"a new post has full recency", recency, feed.Candidate{CreatedAt: suite.ago(0)}, 1},
+		{"recency halves after one half-life", recency, feed.Candidate{CreatedAt: suite.ago(24)}, 0.5},
+		{"recency quarters after two half-lives", recency, feed.Candidate{CreatedAt: suite.ago(48)}, 0.25},
+		{"a post dated in the future counts as new", recency, feed.Candidate{CreatedAt: suite.ago(-5)}, 1},
+		{"an unset half-life falls back to the default", feed.Weights{Recency: 1}, feed.Candidate{CreatedAt: suite.ago(24)}, 0.5},
+		{"a shorter half-life decays faster", feed.Weights{Recency: 1, HalfLifeHours: 6}, feed.Candidate{CreatedAt: suite.ago(12)}, 0.25},
+
+		{"first-degree authors get the full degree weight", degree, feed.Candidate{CreatedAt: suite.ago(0), Degree: 1}, 1},
+		{"second-degree authors get half", degree, feed.Candidate{CreatedAt: suite.ago(0), Degree: 2}, 0.5},
+		{"third-degree authors get a third", degree, feed.Candidate{CreatedAt: suite.ago(0), Degree: 3}, 1.0 / 3},
+		{"the viewer's own posts get no degree weight", degree, feed.Candidate{CreatedAt: suite.ago(0), Degree: 0}, 0},
+		{"degree does not decay with age", degree, feed.Candidate{CreatedAt: suite.ago(240), Degree: 1}, 1},
+
+		{"no engagement scores nothing", engagement, feed.Candidate{CreatedAt: suite.ago(0)}, 0},
+		{"reactions are log-damped", engagement, feed.Candidate{CreatedAt: suite.ago(0), Reactions: 3}, math.Log(4)},
+		{"comments count double", engagement, feed.Candidate{CreatedAt: suite.ago(0), Reactions: 1, Comments: 1}, math.Log(4)},
+		{"a viral post is damped", engagement, feed.Candidate{CreatedAt: suite.ago(0), Reactions: 9999}, math.Log(10000)},
+		{"engagement decays with age", engagement, feed.Candidate{CreatedAt: suite.ago(24), Reactions: 3}, math.Log(4) / 2},
+
+		{"affinity is log-damped", affinity, feed.Candidate{CreatedAt: suite.ago(0), Affinity: 3}, math.Log(4)},
+		{"negative affinity counts as none", affinity, feed.Candidate{CreatedAt: suite.ago(0), Affinity: -5}, 0},
+
+		{"feedback is subtracted", feedback, feed.Candidate{CreatedAt: suite.ago(0), Feedback: 3}, -math.Log(4)},
+		{"feedback does not decay with age", feedback, feed.Candidate{CreatedAt: suite.ago(240), Feedback: 3}, -math.Log(4)},
+		{"negative feedback counts as none", feedback, feed.Candidate{CreatedAt: suite.ago(0), Feedback: -3}, 0},
+
+		{
+			"signals add up",
+			feed.Weights{Recency: 1, Degree: 0.5, Engagement: 1, Affinity: 0.5, Feedback: 1, HalfLifeHours: 24},
+			feed.Candidate{CreatedAt: suite.ago(24), Reactions: 1, Comments: 1, Degree: 2, Affinity: 3, Feedback: 1},
+			0.5 + math.Log(4)*0.5 + 0.5*0.5 + 0.5*math.Log(4) - math.Log(2),
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			suite.InDelta(tc.want, feed.Score(tc.weights, tc.candidate, suite.now), 1e-9)
+		})
+	}
+}
+
+func (suite *FeedRankingTestSuite) TestRank() {
+	cases := []struct {
+		name       string
+		weights    feed.Weights
+		candidates []feed.Candidate
+		want       []uint
+	}{
+		{
+			name:    "newer posts rank first on recency alone",
+			weights: feed.Weights{Recency: 1, HalfLifeHours: 24},
+			candidates: []feed.Candidate{
+				{PostID: 1, CreatedAt: suite.ago(48)},
+				{PostID: 2, CreatedAt: suite.ago(1)},
+				{PostID: 3, CreatedAt: suite.ago(12)},
+			},
+			want: []uint{2, 3, 1},
+		},
+		{
+			name:    "closer authors rank first",
+			weights: feed.Weights{Degree: 1, HalfLifeHours: 24},
+			candidates: []feed.Candidate{
+				{PostID: 1, CreatedAt: suite.ago(1), Degree: 3},
+				{PostID: 2, CreatedAt: suite.ago(1), Degree: 1},
+				{PostID: 3, CreatedAt: suite.ago(1), Degree: 2},
+			},
+			want: []uint{2, 3, 1},
+		},
+		{
+			name:    "engagement lifts an older post over a quiet new one",
+			weights: feed.DefaultWeights(),
+			candidates: []feed.Candidate{
+				{PostID: 1, CreatedAt: suite.ago(1), Degree: 1},
+				{PostID: 2, CreatedAt: suite.ago(12), Degree: 1, Reactions: 50, Comments: 10},
+			},
+			want: []uint{2, 1},
+		},
+		{
+			name:    "damping keeps a stale viral post below a fresh engaged one",
+			weights: feed.DefaultWeights(),
+			candidates: []feed.Candidate{
+				{PostID: 1, CreatedAt: suite.ago(96), Degree: 1, Reactions: 10000},
+				{PostID: 2, CreatedAt: suite.ago(2), Degree: 1, Reactions: 20},
+			},
+			want: []uint{2, 1},
+		},
+		{
+			name:    "feedback pushes a post below an otherwise equal one",
+			weights: feed.DefaultWeights(),
+			candidates: []feed.Candidate{
+				{PostID: 1, CreatedAt: suite.ago(2), Degree: 1, Reactions: 5, Feedback: 2},
+				{PostID: 2, CreatedAt: suite.ago(2), Degree: 1, Reactions: 5},
+			},
+			want: []uint{2, 1},
+		},
+		{
+			name:    "equal scores are broken by recency",
+			weights: feed.Weights{Degree: 1, HalfLifeHours: 24},
+			candidates: []feed.Candidate{
+				{PostID: 1, CreatedAt: suite.ago(30), Degree: 1},
+				{PostID: 2, CreatedAt: suite.ago(3), Degree: 1},
+				{PostID: 3, CreatedAt: suite.ago(10), Degree: 1},
+			},
+			want: []uint{2, 3, 1},
+		},
+		{
+			name:    "equal scores and times keep their input order",
+			weights: feed.DefaultWeights(),
+			candidates: []feed.Candidate{
+				{PostID: 3, CreatedAt: suite.ago(5), Degree: 1},
+				{PostID: 1, CreatedAt: suite.ago(5), Degree: 1},
+				{PostID: 2, CreatedAt: suite.ago(5), Degree: 1},
+			},
+			want: []uint{3, 1, 2},
+		},
+		{
+			name:       "no candidates",
+			weights:    feed.DefaultWeights(),
+			candidates: nil,
+			want:       []uint{},
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			suite.Equal(tc.want, feed.Rank(tc.weights, tc.candidates, suite.now))
+		})
+	}
+}
+
+func TestFeedRankingSuite(t *testing.T) {
+	suite.Run(t, new(FeedRankingTestSuite))
+}
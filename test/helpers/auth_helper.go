@@ -33,10 +33,12 @@ type TestUser struct {
 
 func (ah *AuthHelper) RegisterUser(email, username, fullName, password string) *TestUser {
 	reqBody := map[string]string{
-		"email":     email,
-		"username":  username,
-		"full_name": fullName,
-		"password":  password,
+		"email":         email,
+		"username":      username,
+		"full_name":     fullName,
+		"password":      password,
+		"date_of_birth": "1990-01-01",
+		"region":        "US",
 	}
 
 	body, _ := json.Marshal(reqBody)
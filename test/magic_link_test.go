@@ -0,0 +1,123 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"linked-clone/pkg/auth"
+	testConfig "linked-clone/test/config"
+)
+
+type MagicLinkTokenTestSuite struct {
+	suite.Suite
+}
+
+func (suite *MagicLinkTokenTestSuite) TestSignature() {
+	secret := []byte("magic-link-secret")
+
+	token, nonce, err := auth.NewMagicLinkToken(secret)
+	suite.Require().NoError(err)
+
+	suite.Run("returns the nonce of a valid token", func() {
+		got, ok := auth.VerifyMagicLinkToken(secret, token)
+		suite.True(ok)
+		suite.Equal(nonce, got)
+	})
+
+	suite.Run("rejects a token signed with another secret", func() {
+		_, ok := auth.VerifyMagicLinkToken([]byte("other-secret"), token)
+		suite.False(ok)
+	})
+
+	suite.Run("rejects a changed nonce", func() {
+		_, signature, _ := strings.Cut(token, ".")
+		other, _, err := auth.NewMagicLinkToken(secret)
+		suite.Require().NoError(err)
+		otherNonce, _, _ := strings.Cut(other, ".")
+
+		_, ok := auth.VerifyMagicLinkToken(secret, otherNonce+"."+signature)
+		suite.False(ok)
+	})
+
+	suite.Run("rejects malformed tokens", func() {
+		for _, malformed := range []string{"", nonce, "." + token, nonce + ".", nonce + ".!!!"} {
+			_, ok := auth.VerifyMagicLinkToken(secret, malformed)
+			suite.False(ok, "token %q", malformed)
+		}
+	})
+
+	suite.Run("issues a different nonce each time", func() {
+		_, again, err := auth.NewMagicLinkToken(secret)
+		suite.Require().NoError(err)
+		suite.NotEqual(nonce, again)
+	})
+}
+
+func TestMagicLinkTokenSuite(t *testing.T) {
+	suite.Run(t, new(MagicLinkTokenTestSuite))
+}
+
+// MagicLinkTestSuite covers what the token alone cannot: links expire with
+// their Redis entry and work once.
+type MagicLinkTestSuite struct {
+	BaseTestSuite
+	secret []byte
+}
+
+func (suite *MagicLinkTestSuite) SetupTest() {
+	if suite.Redis == nil {
+		suite.T().Skip("Magic links need Redis")
+	}
+	suite.BaseTestSuite.SetupTest()
+	suite.secret = []byte(testConfig.LoadTestConfig().MagicLink.Secret)
+}
+
+// issue stores a link for userID the way RequestMagicLink does, without
+// going through email.
+func (suite *MagicLinkTestSuite) issue(userID uint, ttl time.Duration) string {
+	token, nonce, err := auth.NewMagicLinkToken(suite.secret)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.Redis.Set(context.Background(), "magic_link:"+nonce, fmt.Sprint(userID), ttl))
+	return token
+}
+
+func (suite *MagicLinkTestSuite) follow(token string) int {
+	w := suite.AuthHelper.MakeAuthenticatedRequest("GET", "/api/v1/auth/magic-link/verify?token="+url.QueryEscape(token), "", nil)
+	return w.Code
+}
+
+func (suite *MagicLinkTestSuite) TestSingleUse() {
+	user := suite.AuthHelper.RegisterUser("magic@example.com", "magicuser", "Magic User", "password123")
+	token := suite.issue(user.ID, time.Minute)
+
+	suite.Equal(http.StatusOK, suite.follow(token))
+	suite.Equal(http.StatusUnauthorized, suite.follow(token), "A link must not sign in twice")
+}
+
+func (suite *MagicLinkTestSuite) TestExpiry() {
+	user := suite.AuthHelper.RegisterUser("expired@example.com", "expireduser", "Expired User", "password123")
+	token := suite.issue(user.ID, time.Second)
+
+	time.Sleep(1500 * time.Millisecond)
+
+	suite.Equal(http.StatusUnauthorized, suite.follow(token))
+}
+
+func (suite *MagicLinkTestSuite) TestForgedToken() {
+	user := suite.AuthHelper.RegisterUser("forged@example.com", "forgeduser", "Forged User", "password123")
+	token := suite.issue(user.ID, time.Minute)
+
+	nonce, _, _ := strings.Cut(token, ".")
+	suite.Equal(http.StatusUnauthorized, suite.follow(nonce+".AAAA"))
+	suite.Equal(http.StatusOK, suite.follow(token), "A rejected forgery must not use up the real link")
+}
+
+func TestMagicLinkSuite(t *testing.T) {
+	suite.Run(t, new(MagicLinkTestSuite))
+}
@@ -0,0 +1,125 @@
+package test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"linked-clone/pkg/markdown"
+)
+
+var (
+	renderedTag     = regexp.MustCompile(`<(/?)([a-zA-Z][a-zA-Z0-9]*)([^>]*)>`)
+	renderedHref    = regexp.MustCompile(`^ href="([^"]*)" rel="nofollow noopener noreferrer ugc"$`)
+	markdownOutTags = map[string]bool{
+		"p": true, "br": true, "strong": true, "em": true, "del": true, "code": true,
+		"pre": true, "ul": true, "ol": true, "li": true, "blockquote": true, "a": true,
+	}
+)
+
+type MarkdownTestSuite struct {
+	suite.Suite
+}
+
+// assertSafe checks that every tag in html is one the renderer writes and
+// that the only attributes are its own href and rel on links, and start on
+// ordered lists.
+func (suite *MarkdownTestSuite) assertSafe(source, html string) {
+	for _, match := range renderedTag.FindAllStringSubmatch(html, -1) {
+		closing, name, attrs := match[1], match[2], match[3]
+		suite.True(markdownOutTags[name], "unexpected <%s> rendering %q: %s", name, source, html)
+		if closing != "" || attrs == "" {
+			continue
+		}
+
+		switch name {
+		case "a":
+			href := renderedHref.FindStringSubmatch(attrs)
+			if suite.NotNil(href, "unexpected link attributes rendering %q: %s", source, html) {
+				suite.Regexp(`^(https?://|mailto:)`, href[1], "unsafe link rendering %q", source)
+			}
+		case "ol":
+			suite.Regexp(`^ start="\d+"$`, attrs, "unexpected list attributes rendering %q", source)
+		default:
+			suite.Failf("unexpected attributes", "<%s%s> rendering %q", name, attrs, source)
+		}
+	}
+}
+
+func (suite *MarkdownTestSuite) TestScriptLinks() {
+	cases := []string{
+		"[click](javascript:alert(1))",
+		"[click](JaVaScRiPt:alert(document.cookie))",
+		"[click](vbscript:msgbox(1))",
+		"[click](data:text/html;base64,PHNjcmlwdD5hbGVydCgxKTwvc2NyaXB0Pg==)",
+		"[click](//evil.example.com)",
+		"[click](https:alert(1))",
+		"javascript:alert(1)",
+	}
+
+	for _, source := range cases {
+		html := markdown.Render(source)
+		suite.NotContains(html, "<a", "no link rendering %q", source)
+		suite.assertSafe(source, html)
+	}
+}
+
+func (suite *MarkdownTestSuite) TestRawHTML() {
+	cases := []string{
+		"<script>alert(1)</script>",
+		"<img src=x onerror=alert(1)>",
+		"<a href=\"javascript:alert(1)\">x</a>",
+		"<iframe src=\"https://evil.example.com\"></iframe>",
+		"**<svg onload=alert(1)>**",
+		"- <b onclick=alert(1)>item</b>",
+		"> <style>body{display:none}</style>",
+		"```\n<script>alert(1)</script>\n```",
+		"`<script>alert(1)</script>`",
+		"[<img src=x onerror=alert(1)>](https://example.com)",
+	}
+
+	for _, source := range cases {
+		html := markdown.Render(source)
+		suite.NotContains(html, "<script", "rendering %q", source)
+		suite.assertSafe(source, html)
+	}
+
+	suite.Equal("<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>", markdown.Render("<script>alert(1)</script>"))
+}
+
+func (suite *MarkdownTestSuite) TestAttributeInjection() {
+	cases := []string{
+		`[x](https://example.com/"onmouseover="alert(1))`,
+		`[x](https://example.com/'onmouseover='alert(1))`,
+		`https://example.com/"onmouseover="alert(1)`,
+		`https://example.com/"><script>alert(1)</script>`,
+		`https://example.com/?q=<img/src/onerror=alert(1)>`,
+		`[x](mailto:a@example.com"onclick="alert(1))`,
+	}
+
+	for _, source := range cases {
+		html := markdown.Render(source)
+		suite.NotContains(html, `"onmouseover=`, "rendering %q", source)
+		suite.NotContains(html, `"onclick=`, "rendering %q", source)
+		suite.assertSafe(source, html)
+	}
+}
+
+func (suite *MarkdownTestSuite) TestAllowedLinks() {
+	suite.Equal(
+		`<p><a href="https://example.com/a?b=1&amp;c=2" rel="nofollow noopener noreferrer ugc">site</a></p>`,
+		markdown.Render("[site](https://example.com/a?b=1&c=2)"),
+	)
+	suite.Equal(
+		`<p><a href="mailto:someone@example.com" rel="nofollow noopener noreferrer ugc">mail</a></p>`,
+		markdown.Render("[mail](mailto:someone@example.com)"),
+	)
+	suite.Equal(
+		`<p>see <a href="https://example.com" rel="nofollow noopener noreferrer ugc">https://example.com</a>.</p>`,
+		markdown.Render("see https://example.com."),
+	)
+}
+
+func TestMarkdownSuite(t *testing.T) {
+	suite.Run(t, new(MarkdownTestSuite))
+}
@@ -0,0 +1,94 @@
+package test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"linked-clone/pkg/media"
+)
+
+type MediaTokenTestSuite struct {
+	suite.Suite
+	signer *media.Signer
+	claims media.Claims
+}
+
+func (suite *MediaTokenTestSuite) SetupTest() {
+	suite.signer = media.NewSigner("media-secret", time.Minute, "/api/v1/media/")
+	suite.claims = media.Claims{
+		ViewerID: 7,
+		Kind:     media.KindMessageAttachment,
+		ParentID: 3,
+		ID:       42,
+	}
+}
+
+func (suite *MediaTokenTestSuite) TestRoundTrip() {
+	token, err := suite.signer.Sign(suite.claims)
+	suite.Require().NoError(err)
+
+	claims, err := suite.signer.Verify(token)
+	suite.Require().NoError(err)
+	suite.Equal(suite.claims.ViewerID, claims.ViewerID)
+	suite.Equal(suite.claims.Kind, claims.Kind)
+	suite.Equal(suite.claims.ParentID, claims.ParentID)
+	suite.Equal(suite.claims.ID, claims.ID)
+	suite.InDelta(time.Now().Add(time.Minute).Unix(), claims.ExpiresAt, 2)
+
+	url := suite.signer.URL(suite.claims)
+	suite.True(strings.HasPrefix(url, "/api/v1/media/") && !strings.HasPrefix(url, "/api/v1/media//"), url)
+	_, err = suite.signer.Verify(strings.TrimPrefix(url, "/api/v1/media/"))
+	suite.NoError(err)
+}
+
+func (suite *MediaTokenTestSuite) TestSignature() {
+	token, err := suite.signer.Sign(suite.claims)
+	suite.Require().NoError(err)
+	encoded, signature, _ := strings.Cut(token, ".")
+
+	suite.Run("rejects another secret", func() {
+		other := media.NewSigner("other-secret", time.Minute, "/api/v1/media")
+		_, err := other.Verify(token)
+		suite.True(errors.Is(err, media.ErrInvalidToken))
+	})
+
+	suite.Run("rejects changed claims", func() {
+		payload, err := base64.RawURLEncoding.DecodeString(encoded)
+		suite.Require().NoError(err)
+
+		var claims media.Claims
+		suite.Require().NoError(json.Unmarshal(payload, &claims))
+		claims.ViewerID = 8
+		claims.ExpiresAt = time.Now().Add(time.Hour).Unix()
+		forged, err := json.Marshal(claims)
+		suite.Require().NoError(err)
+
+		_, err = suite.signer.Verify(base64.RawURLEncoding.EncodeToString(forged) + "." + signature)
+		suite.True(errors.Is(err, media.ErrInvalidToken))
+	})
+
+	suite.Run("rejects malformed tokens", func() {
+		for _, malformed := range []string{"", encoded, encoded + ".", "." + signature, encoded + ".!!!", token + "x"} {
+			_, err := suite.signer.Verify(malformed)
+			suite.True(errors.Is(err, media.ErrInvalidToken), "token %q", malformed)
+		}
+	})
+}
+
+func (suite *MediaTokenTestSuite) TestExpiry() {
+	expired := media.NewSigner("media-secret", -time.Second, "/api/v1/media")
+	token, err := expired.Sign(suite.claims)
+	suite.Require().NoError(err)
+
+	_, err = suite.signer.Verify(token)
+	suite.True(errors.Is(err, media.ErrExpiredToken), "a validly signed token past its expiry: %v", err)
+}
+
+func TestMediaTokenSuite(t *testing.T) {
+	suite.Run(t, new(MediaTokenTestSuite))
+}
@@ -0,0 +1,164 @@
+package test
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/suite"
+	testConfig "linked-clone/test/config"
+)
+
+const migrationsDir = "../internal/infrastructure/database/migrations"
+
+// MigrationTestSuite runs the SQL migrations into a scratch schema, since the
+// other suites build their tables with AutoMigrate and would not notice a
+// migration that leaves the real schema behind the entities.
+type MigrationTestSuite struct {
+	suite.Suite
+	admin  *sql.DB
+	db     *sql.DB
+	schema string
+}
+
+func (suite *MigrationTestSuite) SetupTest() {
+	cfg := testConfig.LoadTestConfig().Database
+	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=%s",
+		cfg.Host, cfg.User, cfg.Password, cfg.DBName, cfg.Port, cfg.SSLMode)
+
+	admin, err := sql.Open("postgres", dsn)
+	suite.Require().NoError(err)
+	suite.admin = admin
+
+	suite.schema = fmt.Sprintf("migration_test_%d", time.Now().UnixNano())
+	_, err = admin.Exec("CREATE SCHEMA " + suite.schema)
+	suite.Require().NoError(err, "Failed to create scratch schema")
+
+	db, err := sql.Open("postgres", dsn+" search_path="+suite.schema)
+	suite.Require().NoError(err)
+	// One connection, so every statement sees the same search path.
+	db.SetMaxOpenConns(1)
+	suite.db = db
+
+	suite.Require().NoError(goose.SetDialect("postgres"))
+}
+
+func (suite *MigrationTestSuite) TearDownTest() {
+	if suite.db != nil {
+		suite.db.Close()
+	}
+	if suite.admin != nil {
+		suite.admin.Exec("DROP SCHEMA IF EXISTS " + suite.schema + " CASCADE")
+		suite.admin.Close()
+	}
+}
+
+func (suite *MigrationTestSuite) seedUserAndJob() (uint, uint) {
+	var userID, jobID uint
+	err := suite.db.QueryRow(`INSERT INTO users (email, username, full_name, password)
+		VALUES ('applicant@example.com', 'applicant', 'Applicant Example', 'x') RETURNING id`).Scan(&userID)
+	suite.Require().NoError(err)
+
+	err = suite.db.QueryRow(`INSERT INTO jobs (user_id, title, company, location, description, job_type, experience_level)
+		VALUES ($1, 'Engineer', 'Acme', 'Remote', 'Build things', 'full_time', 'mid') RETURNING id`, userID).Scan(&jobID)
+	suite.Require().NoError(err)
+
+	return userID, jobID
+}
+
+func (suite *MigrationTestSuite) apply(userID, jobID uint) (uint, error) {
+	var id uint
+	err := suite.db.QueryRow(`INSERT INTO applications (user_id, job_id, status) VALUES ($1, $2, 'pending') RETURNING id`,
+		userID, jobID).Scan(&id)
+	return id, err
+}
+
+func (suite *MigrationTestSuite) withdraw(applicationID uint) error {
+	_, err := suite.db.Exec(`UPDATE applications SET status = 'withdrawn', withdrawn_at = NOW() WHERE id = $1`, applicationID)
+	return err
+}
+
+func (suite *MigrationTestSuite) TestApplicationWithdrawalMigration() {
+	suite.Require().NoError(goose.UpTo(suite.db, migrationsDir, 20250713090000))
+
+	userID, jobID := suite.seedUserAndJob()
+
+	first, err := suite.apply(userID, jobID)
+	suite.Require().NoError(err)
+
+	suite.Run("rejects a second open application", func() {
+		_, err := suite.apply(userID, jobID)
+		suite.Error(err)
+	})
+
+	suite.Run("stores the withdrawn status", func() {
+		suite.NoError(suite.withdraw(first))
+	})
+
+	suite.Run("accepts a new application after withdrawing", func() {
+		_, err := suite.apply(userID, jobID)
+		suite.NoError(err)
+	})
+}
+
+func (suite *MigrationTestSuite) TestReapplyOnLatestSchema() {
+	suite.Require().NoError(goose.Up(suite.db, migrationsDir))
+
+	userID, jobID := suite.seedUserAndJob()
+
+	first, err := suite.apply(userID, jobID)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.withdraw(first))
+
+	second, err := suite.apply(userID, jobID)
+	suite.Require().NoError(err, "Reapplying after a withdrawal should insert a new row")
+
+	_, err = suite.apply(userID, jobID)
+	suite.Error(err, "Only one open application per user and job")
+
+	suite.Require().NoError(suite.withdraw(second))
+	_, err = suite.apply(userID, jobID)
+	suite.NoError(err)
+}
+
+func (suite *MigrationTestSuite) TestMessageAttachmentForeignKey() {
+	suite.Require().NoError(goose.Up(suite.db, migrationsDir))
+
+	userID, _ := suite.seedUserAndJob()
+
+	var conversationID, messageID uint
+	var createdAt time.Time
+	err := suite.db.QueryRow(`INSERT INTO conversations DEFAULT VALUES RETURNING id`).Scan(&conversationID)
+	suite.Require().NoError(err)
+	err = suite.db.QueryRow(`INSERT INTO messages (conversation_id, sender_id, content) VALUES ($1, $2, 'hello') RETURNING id, created_at`,
+		conversationID, userID).Scan(&messageID, &createdAt)
+	suite.Require().NoError(err)
+
+	attach := func(messageID uint, createdAt time.Time) error {
+		_, err := suite.db.Exec(`INSERT INTO message_attachments (message_id, message_created_at, type, file_key, file_name)
+			VALUES ($1, $2, 'file', 'messages/a.pdf', 'a.pdf')`, messageID, createdAt)
+		return err
+	}
+
+	suite.Run("rejects an attachment without its message", func() {
+		suite.Error(attach(messageID+1, createdAt))
+		suite.Error(attach(messageID, createdAt.Add(time.Hour)))
+	})
+
+	suite.Run("removes attachments with their message", func() {
+		suite.Require().NoError(attach(messageID, createdAt))
+
+		_, err := suite.db.Exec(`DELETE FROM messages WHERE id = $1`, messageID)
+		suite.Require().NoError(err)
+
+		var remaining int
+		suite.Require().NoError(suite.db.QueryRow(`SELECT COUNT(*) FROM message_attachments`).Scan(&remaining))
+		suite.Zero(remaining)
+	})
+}
+
+func TestMigrationSuite(t *testing.T) {
+	suite.Run(t, new(MigrationTestSuite))
+}
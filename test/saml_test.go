@@ -0,0 +1,143 @@
+package test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/stretchr/testify/suite"
+	"linked-clone/pkg/sso"
+)
+
+const samlRequestID = "_request"
+
+type SAMLTestSuite struct {
+	suite.Suite
+	key  *rsa.PrivateKey
+	cert []byte
+	cfg  sso.SAMLConfig
+	now  time.Time
+}
+
+func (suite *SAMLTestSuite) SetupTest() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.acme.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	suite.Require().NoError(err)
+
+	suite.key = key
+	suite.cert = cert
+	suite.now = time.Now().UTC()
+	suite.cfg = sso.SAMLConfig{
+		IdPEntityID:    "https://idp.acme.com",
+		IdPCertificate: base64.StdEncoding.EncodeToString(cert),
+		SPEntityID:     "https://api.example.com/saml/metadata",
+		ACSURL:         "https://api.example.com/saml/acs",
+	}
+}
+
+func (suite *SAMLTestSuite) assertion(nameID string) string {
+	at := func(d time.Duration) string { return suite.now.Add(d).Format(time.RFC3339) }
+	return `<saml:Assertion xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_assertion" Version="2.0" IssueInstant="` + at(0) + `">` +
+		`<saml:Issuer>` + suite.cfg.IdPEntityID + `</saml:Issuer>` +
+		`<saml:Subject><saml:NameID Format="urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress">` + nameID + `</saml:NameID>` +
+		`<saml:SubjectConfirmation Method="urn:oasis:names:tc:SAML:2.0:cm:bearer">` +
+		`<saml:SubjectConfirmationData InResponseTo="` + samlRequestID + `" Recipient="` + suite.cfg.ACSURL + `" NotOnOrAfter="` + at(5*time.Minute) + `"/>` +
+		`</saml:SubjectConfirmation></saml:Subject>` +
+		`<saml:Conditions NotBefore="` + at(-time.Minute) + `" NotOnOrAfter="` + at(5*time.Minute) + `">` +
+		`<saml:AudienceRestriction><saml:Audience>` + suite.cfg.SPEntityID + `</saml:Audience></saml:AudienceRestriction>` +
+		`</saml:Conditions></saml:Assertion>`
+}
+
+func (suite *SAMLTestSuite) sign(xml string) string {
+	doc := etree.NewDocument()
+	suite.Require().NoError(doc.ReadFromString(xml))
+
+	signer := dsig.NewDefaultSigningContext(dsig.TLSCertKeyStore(tls.Certificate{
+		Certificate: [][]byte{suite.cert},
+		PrivateKey:  suite.key,
+	}))
+	signer.Canonicalizer = dsig.MakeC14N10ExclusiveCanonicalizerWithPrefixList("")
+	signed, err := signer.SignEnveloped(doc.Root())
+	suite.Require().NoError(err)
+
+	out := etree.NewDocument()
+	out.SetRoot(signed)
+	result, err := out.WriteToString()
+	suite.Require().NoError(err)
+	return result
+}
+
+func (suite *SAMLTestSuite) response(assertion string) string {
+	return `<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" ID="_response" Version="2.0" InResponseTo="` + samlRequestID + `" Destination="` + suite.cfg.ACSURL + `">` +
+		`<samlp:Status><samlp:StatusCode Value="urn:oasis:names:tc:SAML:2.0:status:Success"/></samlp:Status>` +
+		assertion + `</samlp:Response>`
+}
+
+func (suite *SAMLTestSuite) parse(response string) (*sso.Identity, error) {
+	return sso.ParseSAMLResponse(suite.cfg, base64.StdEncoding.EncodeToString([]byte(response)), samlRequestID, suite.now)
+}
+
+func (suite *SAMLTestSuite) TestSignedAssertion() {
+	identity, err := suite.parse(suite.response(suite.sign(suite.assertion("jane@acme.com"))))
+	suite.Require().NoError(err)
+	suite.Equal("jane@acme.com", identity.Email)
+	suite.Equal("jane@acme.com", identity.Subject)
+}
+
+func (suite *SAMLTestSuite) TestSignedResponse() {
+	identity, err := suite.parse(suite.sign(suite.response(suite.assertion("jane@acme.com"))))
+	suite.Require().NoError(err)
+	suite.Equal("jane@acme.com", identity.Email)
+}
+
+func (suite *SAMLTestSuite) TestRejectsForgeries() {
+	suite.Run("unsigned", func() {
+		_, err := suite.parse(suite.response(suite.assertion("jane@acme.com")))
+		suite.Error(err)
+	})
+
+	suite.Run("changed after signing", func() {
+		signed := suite.response(suite.sign(suite.assertion("jane@acme.com")))
+		_, err := suite.parse(strings.Replace(signed, "jane@acme.com", "ceo@acme.com", 1))
+		suite.Error(err)
+	})
+
+	suite.Run("signed by another key", func() {
+		signed := suite.response(suite.sign(suite.assertion("jane@acme.com")))
+		suite.SetupTest()
+		_, err := suite.parse(signed)
+		suite.Error(err)
+	})
+
+	suite.Run("wrapped assertion", func() {
+		signed := suite.sign(suite.assertion("jane@acme.com"))
+		forged := strings.Replace(suite.assertion("ceo@acme.com"), `ID="_assertion"`, `ID="_forged"`, 1)
+		_, err := suite.parse(suite.response(forged + signed))
+		suite.Error(err)
+	})
+
+	suite.Run("for another request", func() {
+		_, err := sso.ParseSAMLResponse(suite.cfg, base64.StdEncoding.EncodeToString([]byte(suite.response(suite.sign(suite.assertion("jane@acme.com"))))), "_other", suite.now)
+		suite.Error(err)
+	})
+}
+
+func TestSAMLSuite(t *testing.T) {
+	suite.Run(t, new(SAMLTestSuite))
+}
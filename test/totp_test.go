@@ -0,0 +1,106 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"linked-clone/pkg/auth"
+	"linked-clone/test/helpers"
+)
+
+// rfc6238Secret is the SHA-1 seed from RFC 6238 appendix B, the ASCII
+// string "12345678901234567890", in base32.
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+type TOTPTestSuite struct {
+	suite.Suite
+}
+
+// The RFC lists eight-digit codes; six-digit codes are their last six digits.
+var rfc6238Vectors = []struct {
+	unix int64
+	code string
+}{
+	{59, "287082"},
+	{1111111109, "081804"},
+	{1111111111, "050471"},
+	{1234567890, "005924"},
+	{2000000000, "279037"},
+	{20000000000, "353130"},
+}
+
+func (suite *TOTPTestSuite) TestRFC6238Vectors() {
+	for _, vector := range rfc6238Vectors {
+		at := time.Unix(vector.unix, 0)
+
+		step, ok := auth.ValidateTOTP(rfc6238Secret, vector.code, at)
+		suite.True(ok, "code %s should be valid at %d", vector.code, vector.unix)
+		suite.Equal(vector.unix/30, step, "matched step at %d", vector.unix)
+
+		suite.Equal(vector.code, helpers.TOTPCode(rfc6238Secret, at), "helper code at %d", vector.unix)
+	}
+}
+
+func (suite *TOTPTestSuite) TestSkewWindow() {
+	now := time.Unix(1700000000, 0)
+	current := now.Unix() / 30
+
+	suite.Run("accepts the previous and next step", func() {
+		step, ok := auth.ValidateTOTP(rfc6238Secret, helpers.TOTPCode(rfc6238Secret, now.Add(-30*time.Second)), now)
+		suite.True(ok)
+		suite.Equal(current-1, step)
+
+		step, ok = auth.ValidateTOTP(rfc6238Secret, helpers.TOTPCode(rfc6238Secret, now.Add(30*time.Second)), now)
+		suite.True(ok)
+		suite.Equal(current+1, step)
+	})
+
+	suite.Run("rejects codes two steps away", func() {
+		_, ok := auth.ValidateTOTP(rfc6238Secret, helpers.TOTPCode(rfc6238Secret, now.Add(-60*time.Second)), now)
+		suite.False(ok)
+
+		_, ok = auth.ValidateTOTP(rfc6238Secret, helpers.TOTPCode(rfc6238Secret, now.Add(60*time.Second)), now)
+		suite.False(ok)
+	})
+}
+
+func (suite *TOTPTestSuite) TestMalformedInput() {
+	at := time.Unix(59, 0)
+
+	suite.Run("allows spaces inside the code", func() {
+		_, ok := auth.ValidateTOTP(rfc6238Secret, " 287 082 ", at)
+		suite.True(ok)
+	})
+
+	suite.Run("accepts a lowercase secret", func() {
+		_, ok := auth.ValidateTOTP("gezdgnbvgy3tqojqgezdgnbvgy3tqojq", "287082", at)
+		suite.True(ok)
+	})
+
+	suite.Run("rejects codes of the wrong length", func() {
+		for _, code := range []string{"", "28708", "2870820", "94287082"} {
+			_, ok := auth.ValidateTOTP(rfc6238Secret, code, at)
+			suite.False(ok, "code %q", code)
+		}
+	})
+
+	suite.Run("rejects a secret that is not base32", func() {
+		_, ok := auth.ValidateTOTP("not-base32!", "287082", at)
+		suite.False(ok)
+	})
+}
+
+func (suite *TOTPTestSuite) TestGeneratedSecret() {
+	secret, err := auth.GenerateTOTPSecret()
+	suite.Require().NoError(err)
+	suite.Len(secret, 32, "160 bits in unpadded base32")
+
+	now := time.Now()
+	_, ok := auth.ValidateTOTP(secret, helpers.TOTPCode(secret, now), now)
+	suite.True(ok)
+}
+
+func TestTOTPSuite(t *testing.T) {
+	suite.Run(t, new(TOTPTestSuite))
+}
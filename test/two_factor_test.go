@@ -0,0 +1,96 @@
+package test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"linked-clone/test/helpers"
+)
+
+type TwoFactorTestSuite struct {
+	BaseTestSuite
+}
+
+func (suite *TwoFactorTestSuite) SetupTest() {
+	if suite.Redis == nil {
+		suite.T().Skip("Two-factor sign-in needs Redis")
+	}
+	suite.BaseTestSuite.SetupTest()
+}
+
+// enableTwoFactor turns two-factor on for user and returns the TOTP secret.
+func (suite *TwoFactorTestSuite) enableTwoFactor(user *helpers.TestUser, password string) string {
+	w := suite.AuthHelper.MakeAuthenticatedRequest("POST", "/api/v1/auth/2fa/setup", user.Token, map[string]string{
+		"password": password,
+	})
+	suite.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+
+	var setup struct {
+		Data struct {
+			Secret string `json:"secret"`
+		} `json:"data"`
+	}
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &setup))
+
+	w = suite.AuthHelper.MakeAuthenticatedRequest("POST", "/api/v1/auth/2fa/enable", user.Token, map[string]string{
+		"code": helpers.TOTPCode(setup.Data.Secret, time.Now()),
+	})
+	suite.Require().Equal(http.StatusCreated, w.Code, w.Body.String())
+
+	return setup.Data.Secret
+}
+
+// startChallenge signs in with a password and returns the challenge token.
+func (suite *TwoFactorTestSuite) startChallenge(email, password string) string {
+	w := suite.AuthHelper.MakeAuthenticatedRequest("POST", "/api/v1/auth/login", "", map[string]string{
+		"email":    email,
+		"password": password,
+	})
+	suite.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+
+	var login struct {
+		Data struct {
+			TwoFactorRequired bool   `json:"two_factor_required"`
+			ChallengeToken    string `json:"challenge_token"`
+		} `json:"data"`
+	}
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &login))
+	suite.Require().True(login.Data.TwoFactorRequired)
+
+	return login.Data.ChallengeToken
+}
+
+func (suite *TwoFactorTestSuite) verify(challenge, code string) int {
+	w := suite.AuthHelper.MakeAuthenticatedRequest("POST", "/api/v1/auth/2fa/verify", "", map[string]string{
+		"challenge_token": challenge,
+		"code":            code,
+	})
+	return w.Code
+}
+
+func (suite *TwoFactorTestSuite) TestCodeReplay() {
+	const password = "password123"
+	user := suite.AuthHelper.RegisterUser("totp@example.com", "totpuser", "TOTP User", password)
+	secret := suite.enableTwoFactor(user, password)
+
+	code := helpers.TOTPCode(secret, time.Now())
+
+	suite.Run("accepts a fresh code", func() {
+		suite.Equal(http.StatusOK, suite.verify(suite.startChallenge(user.Email, password), code))
+	})
+
+	suite.Run("rejects the same code on a new challenge", func() {
+		suite.Equal(http.StatusUnauthorized, suite.verify(suite.startChallenge(user.Email, password), code))
+	})
+
+	suite.Run("rejects a wrong code", func() {
+		suite.Equal(http.StatusUnauthorized, suite.verify(suite.startChallenge(user.Email, password), "000000"))
+	})
+}
+
+func TestTwoFactorSuite(t *testing.T) {
+	suite.Run(t, new(TwoFactorTestSuite))
+}